@@ -0,0 +1,354 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/bandwidth_limit.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MeshBandwidthLimit limits the bandwidth of HTTP traffic going through the
+// inbound and outbound listeners of the selected dataplanes.
+type MeshBandwidthLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes.
+	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Configuration of the bandwidth limits.
+	Conf *MeshBandwidthLimit_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *MeshBandwidthLimit) Reset() {
+	*x = MeshBandwidthLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshBandwidthLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshBandwidthLimit) ProtoMessage() {}
+
+func (x *MeshBandwidthLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshBandwidthLimit.ProtoReflect.Descriptor instead.
+func (*MeshBandwidthLimit) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_bandwidth_limit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeshBandwidthLimit) GetSelectors() []*Selector {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *MeshBandwidthLimit) GetConf() *MeshBandwidthLimit_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines the bandwidth limits.
+type MeshBandwidthLimit_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bandwidth limit of every HTTP inbound listener.
+	Inbound *MeshBandwidthLimit_Conf_Limit `protobuf:"bytes,1,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// The bandwidth limit of every HTTP outbound listener.
+	Outbound *MeshBandwidthLimit_Conf_Limit `protobuf:"bytes,2,opt,name=outbound,proto3" json:"outbound,omitempty"`
+}
+
+func (x *MeshBandwidthLimit_Conf) Reset() {
+	*x = MeshBandwidthLimit_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshBandwidthLimit_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshBandwidthLimit_Conf) ProtoMessage() {}
+
+func (x *MeshBandwidthLimit_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshBandwidthLimit_Conf.ProtoReflect.Descriptor instead.
+func (*MeshBandwidthLimit_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_bandwidth_limit_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MeshBandwidthLimit_Conf) GetInbound() *MeshBandwidthLimit_Conf_Limit {
+	if x != nil {
+		return x.Inbound
+	}
+	return nil
+}
+
+func (x *MeshBandwidthLimit_Conf) GetOutbound() *MeshBandwidthLimit_Conf_Limit {
+	if x != nil {
+		return x.Outbound
+	}
+	return nil
+}
+
+// Limit defines the bandwidth limit of both requests and responses.
+type MeshBandwidthLimit_Conf_Limit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bandwidth limit in bytes per second. Envoy limits the bandwidth
+	// with the granularity of KiB, so it has to be a multiple of 1024.
+	BytesPerSecond uint64 `protobuf:"varint,1,opt,name=bytesPerSecond,proto3" json:"bytesPerSecond,omitempty"`
+	// The interval in which the token bucket is refilled, between 20ms and
+	// 1s. Defaults to 50ms.
+	FillInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=fillInterval,proto3" json:"fillInterval,omitempty"`
+}
+
+func (x *MeshBandwidthLimit_Conf_Limit) Reset() {
+	*x = MeshBandwidthLimit_Conf_Limit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshBandwidthLimit_Conf_Limit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshBandwidthLimit_Conf_Limit) ProtoMessage() {}
+
+func (x *MeshBandwidthLimit_Conf_Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshBandwidthLimit_Conf_Limit.ProtoReflect.Descriptor instead.
+func (*MeshBandwidthLimit_Conf_Limit) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_bandwidth_limit_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *MeshBandwidthLimit_Conf_Limit) GetBytesPerSecond() uint64 {
+	if x != nil {
+		return x.BytesPerSecond
+	}
+	return 0
+}
+
+func (x *MeshBandwidthLimit_Conf_Limit) GetFillInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FillInterval
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_bandwidth_limit_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_bandwidth_limit_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d,
+	0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x04, 0x0a, 0x12, 0x4d, 0x65,
+	0x73, 0x68, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x45, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0x98, 0x02, 0x0a, 0x04, 0x43, 0x6f,
+	0x6e, 0x66, 0x12, 0x4b, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x42, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x4d, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x42, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x74,
+	0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x3a, 0x6d, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x67, 0x0a, 0x1a, 0x4d, 0x65,
+	0x73, 0x68, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x4d, 0x65, 0x73, 0x68, 0x42, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x04, 0x6d, 0x65,
+	0x73, 0x68, 0x3a, 0x29, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x13, 0x6d, 0x65, 0x73, 0x68, 0x62, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x02, 0x10,
+	0x01, 0x68, 0x01, 0x42, 0x5a, 0x8a, 0xb5, 0x18, 0x2c, 0x50, 0x01, 0xa2, 0x01, 0x12, 0x4d, 0x65,
+	0x73, 0x68, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0xf2, 0x01, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_bandwidth_limit_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_bandwidth_limit_proto_rawDescData = file_mesh_v1alpha1_bandwidth_limit_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_bandwidth_limit_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_bandwidth_limit_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_bandwidth_limit_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_bandwidth_limit_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_bandwidth_limit_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_mesh_v1alpha1_bandwidth_limit_proto_goTypes = []interface{}{
+	(*MeshBandwidthLimit)(nil),            // 0: kuma.mesh.v1alpha1.MeshBandwidthLimit
+	(*MeshBandwidthLimit_Conf)(nil),       // 1: kuma.mesh.v1alpha1.MeshBandwidthLimit.Conf
+	(*MeshBandwidthLimit_Conf_Limit)(nil), // 2: kuma.mesh.v1alpha1.MeshBandwidthLimit.Conf.Limit
+	(*Selector)(nil),                      // 3: kuma.mesh.v1alpha1.Selector
+	(*durationpb.Duration)(nil),           // 4: google.protobuf.Duration
+}
+var file_mesh_v1alpha1_bandwidth_limit_proto_depIdxs = []int32{
+	3, // 0: kuma.mesh.v1alpha1.MeshBandwidthLimit.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 1: kuma.mesh.v1alpha1.MeshBandwidthLimit.conf:type_name -> kuma.mesh.v1alpha1.MeshBandwidthLimit.Conf
+	2, // 2: kuma.mesh.v1alpha1.MeshBandwidthLimit.Conf.inbound:type_name -> kuma.mesh.v1alpha1.MeshBandwidthLimit.Conf.Limit
+	2, // 3: kuma.mesh.v1alpha1.MeshBandwidthLimit.Conf.outbound:type_name -> kuma.mesh.v1alpha1.MeshBandwidthLimit.Conf.Limit
+	4, // 4: kuma.mesh.v1alpha1.MeshBandwidthLimit.Conf.Limit.fillInterval:type_name -> google.protobuf.Duration
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_bandwidth_limit_proto_init() }
+func file_mesh_v1alpha1_bandwidth_limit_proto_init() {
+	if File_mesh_v1alpha1_bandwidth_limit_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshBandwidthLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshBandwidthLimit_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshBandwidthLimit_Conf_Limit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_bandwidth_limit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_bandwidth_limit_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_bandwidth_limit_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_bandwidth_limit_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_bandwidth_limit_proto = out.File
+	file_mesh_v1alpha1_bandwidth_limit_proto_rawDesc = nil
+	file_mesh_v1alpha1_bandwidth_limit_proto_goTypes = nil
+	file_mesh_v1alpha1_bandwidth_limit_proto_depIdxs = nil
+}
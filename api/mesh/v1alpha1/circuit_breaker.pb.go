@@ -103,10 +103,13 @@ type CircuitBreaker_Conf struct {
 	// The maximum percent of an upstream cluster that can be ejected due to
 	// outlier detection, has to be in [0 - 100] range
 	MaxEjectionPercent *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=maxEjectionPercent,proto3" json:"maxEjectionPercent,omitempty"`
-	// Enables Split Mode in which local and external errors are distinguished
-	SplitExternalAndLocalErrors bool                            `protobuf:"varint,4,opt,name=splitExternalAndLocalErrors,proto3" json:"splitExternalAndLocalErrors,omitempty"`
-	Detectors                   *CircuitBreaker_Conf_Detectors  `protobuf:"bytes,5,opt,name=detectors,proto3" json:"detectors,omitempty"`
-	Thresholds                  *CircuitBreaker_Conf_Thresholds `protobuf:"bytes,6,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	// Enables Split Mode in which local and external errors are distinguished.
+	// Locally originated errors (e.g. connection timeouts or resets) are then
+	// counted only by 'localErrors' instead of 'totalErrors'
+	SplitExternalAndLocalErrors bool                                `protobuf:"varint,4,opt,name=splitExternalAndLocalErrors,proto3" json:"splitExternalAndLocalErrors,omitempty"`
+	Detectors                   *CircuitBreaker_Conf_Detectors      `protobuf:"bytes,5,opt,name=detectors,proto3" json:"detectors,omitempty"`
+	Thresholds                  *CircuitBreaker_Conf_Thresholds     `protobuf:"bytes,6,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	ConnectionPool              *CircuitBreaker_Conf_ConnectionPool `protobuf:"bytes,7,opt,name=connectionPool,proto3" json:"connectionPool,omitempty"`
 }
 
 func (x *CircuitBreaker_Conf) Reset() {
@@ -183,6 +186,13 @@ func (x *CircuitBreaker_Conf) GetThresholds() *CircuitBreaker_Conf_Thresholds {
 	return nil
 }
 
+func (x *CircuitBreaker_Conf) GetConnectionPool() *CircuitBreaker_Conf_ConnectionPool {
+	if x != nil {
+		return x.ConnectionPool
+	}
+	return nil
+}
+
 type CircuitBreaker_Conf_Detectors struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -199,6 +209,10 @@ type CircuitBreaker_Conf_Detectors struct {
 	LocalErrors       *CircuitBreaker_Conf_Detectors_Errors            `protobuf:"bytes,3,opt,name=localErrors,proto3" json:"localErrors,omitempty"`
 	StandardDeviation *CircuitBreaker_Conf_Detectors_StandardDeviation `protobuf:"bytes,4,opt,name=standardDeviation,proto3" json:"standardDeviation,omitempty"`
 	Failure           *CircuitBreaker_Conf_Detectors_Failure           `protobuf:"bytes,5,opt,name=failure,proto3" json:"failure,omitempty"`
+	// Errors of gRPC requests, gRPC status codes are mapped to HTTP status
+	// codes (e.g. UNAVAILABLE to 503). For services with gRPC protocol it is
+	// used instead of 'totalErrors'
+	GrpcErrors *CircuitBreaker_Conf_Detectors_Errors `protobuf:"bytes,6,opt,name=grpcErrors,proto3" json:"grpcErrors,omitempty"`
 }
 
 func (x *CircuitBreaker_Conf_Detectors) Reset() {
@@ -268,6 +282,13 @@ func (x *CircuitBreaker_Conf_Detectors) GetFailure() *CircuitBreaker_Conf_Detect
 	return nil
 }
 
+func (x *CircuitBreaker_Conf_Detectors) GetGrpcErrors() *CircuitBreaker_Conf_Detectors_Errors {
+	if x != nil {
+		return x.GrpcErrors
+	}
+	return nil
+}
+
 type CircuitBreaker_Conf_Thresholds struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -347,6 +368,94 @@ func (x *CircuitBreaker_Conf_Thresholds) GetMaxRequests() *wrapperspb.UInt32Valu
 	return nil
 }
 
+type CircuitBreaker_Conf_ConnectionPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TCP keepalive of connections to the upstream cluster.
+	TcpKeepalive *CircuitBreaker_Conf_ConnectionPool_TcpKeepalive `protobuf:"bytes,1,opt,name=tcpKeepalive,proto3" json:"tcpKeepalive,omitempty"`
+	// The maximum number of connections that Envoy will make to the upstream
+	// cluster. Takes precedence over thresholds.maxConnections.
+	MaxConnections *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=maxConnections,proto3" json:"maxConnections,omitempty"`
+	// The maximum number of pending requests that Envoy will allow to the
+	// upstream cluster. Takes precedence over thresholds.maxPendingRequests.
+	MaxPendingRequests *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=maxPendingRequests,proto3" json:"maxPendingRequests,omitempty"`
+	// The maximum number of parallel retries that Envoy will allow to the
+	// upstream cluster. Takes precedence over thresholds.maxRetries.
+	MaxRetries *wrapperspb.UInt32Value `protobuf:"bytes,4,opt,name=maxRetries,proto3" json:"maxRetries,omitempty"`
+	// Soft limit on size of the read and write buffers of connections to the
+	// upstream cluster. If not specified, the default is 1MiB.
+	PerConnectionBufferLimitBytes *wrapperspb.UInt32Value `protobuf:"bytes,5,opt,name=perConnectionBufferLimitBytes,proto3" json:"perConnectionBufferLimitBytes,omitempty"`
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool) Reset() {
+	*x = CircuitBreaker_Conf_ConnectionPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker_Conf_ConnectionPool) ProtoMessage() {}
+
+func (x *CircuitBreaker_Conf_ConnectionPool) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreaker_Conf_ConnectionPool.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker_Conf_ConnectionPool) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_circuit_breaker_proto_rawDescGZIP(), []int{0, 0, 2}
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool) GetTcpKeepalive() *CircuitBreaker_Conf_ConnectionPool_TcpKeepalive {
+	if x != nil {
+		return x.TcpKeepalive
+	}
+	return nil
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool) GetMaxConnections() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return nil
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool) GetMaxPendingRequests() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxPendingRequests
+	}
+	return nil
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool) GetMaxRetries() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return nil
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool) GetPerConnectionBufferLimitBytes() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.PerConnectionBufferLimitBytes
+	}
+	return nil
+}
+
 // Detector based on counting consecutive number of errors
 type CircuitBreaker_Conf_Detectors_Errors struct {
 	state         protoimpl.MessageState
@@ -359,7 +468,7 @@ type CircuitBreaker_Conf_Detectors_Errors struct {
 func (x *CircuitBreaker_Conf_Detectors_Errors) Reset() {
 	*x = CircuitBreaker_Conf_Detectors_Errors{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[4]
+		mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -372,7 +481,7 @@ func (x *CircuitBreaker_Conf_Detectors_Errors) String() string {
 func (*CircuitBreaker_Conf_Detectors_Errors) ProtoMessage() {}
 
 func (x *CircuitBreaker_Conf_Detectors_Errors) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[4]
+	mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,7 +523,7 @@ type CircuitBreaker_Conf_Detectors_StandardDeviation struct {
 func (x *CircuitBreaker_Conf_Detectors_StandardDeviation) Reset() {
 	*x = CircuitBreaker_Conf_Detectors_StandardDeviation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[5]
+		mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -427,7 +536,7 @@ func (x *CircuitBreaker_Conf_Detectors_StandardDeviation) String() string {
 func (*CircuitBreaker_Conf_Detectors_StandardDeviation) ProtoMessage() {}
 
 func (x *CircuitBreaker_Conf_Detectors_StandardDeviation) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[5]
+	mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +593,7 @@ type CircuitBreaker_Conf_Detectors_Failure struct {
 func (x *CircuitBreaker_Conf_Detectors_Failure) Reset() {
 	*x = CircuitBreaker_Conf_Detectors_Failure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[6]
+		mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -497,7 +606,7 @@ func (x *CircuitBreaker_Conf_Detectors_Failure) String() string {
 func (*CircuitBreaker_Conf_Detectors_Failure) ProtoMessage() {}
 
 func (x *CircuitBreaker_Conf_Detectors_Failure) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[6]
+	mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,6 +643,77 @@ func (x *CircuitBreaker_Conf_Detectors_Failure) GetThreshold() *wrapperspb.UInt3
 	return nil
 }
 
+type CircuitBreaker_Conf_ConnectionPool_TcpKeepalive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of keepalive probes to send without response
+	// before deciding the connection is dead. If not specified, the
+	// default of the operating system is used.
+	Probes *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=probes,proto3" json:"probes,omitempty"`
+	// The time a connection needs to be idle before keepalive probes start
+	// being sent, it's rounded down to seconds. If not specified, the
+	// default of the operating system is used.
+	Time *durationpb.Duration `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// The time between keepalive probes, it's rounded down to seconds. If
+	// not specified, the default of the operating system is used.
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool_TcpKeepalive) Reset() {
+	*x = CircuitBreaker_Conf_ConnectionPool_TcpKeepalive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool_TcpKeepalive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker_Conf_ConnectionPool_TcpKeepalive) ProtoMessage() {}
+
+func (x *CircuitBreaker_Conf_ConnectionPool_TcpKeepalive) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreaker_Conf_ConnectionPool_TcpKeepalive.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker_Conf_ConnectionPool_TcpKeepalive) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_circuit_breaker_proto_rawDescGZIP(), []int{0, 0, 2, 0}
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool_TcpKeepalive) GetProbes() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool_TcpKeepalive) GetTime() *durationpb.Duration {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *CircuitBreaker_Conf_ConnectionPool_TcpKeepalive) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
 var File_mesh_v1alpha1_circuit_breaker_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_circuit_breaker_proto_rawDesc = []byte{
@@ -548,7 +728,7 @@ var file_mesh_v1alpha1_circuit_breaker_proto_rawDesc = []byte{
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61,
 	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x0f, 0x0a, 0x0e, 0x43, 0x69,
+	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x16, 0x0a, 0x0e, 0x43, 0x69,
 	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x07,
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
@@ -562,7 +742,7 @@ var file_mesh_v1alpha1_circuit_breaker_proto_rawDesc = []byte{
 	0x32, 0x27, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
 	0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
-	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xb2, 0x0d, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x35,
+	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xcb, 0x13, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x35,
 	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74,
@@ -590,7 +770,13 @@ var file_mesh_v1alpha1_circuit_breaker_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
 	0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73,
 	0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x73, 0x1a, 0xd5, 0x07, 0x0a, 0x09, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x73, 0x12, 0x5e, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x1a, 0xaf, 0x08, 0x0a, 0x09, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
 	0x5a, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69,
@@ -620,69 +806,112 @@ var file_mesh_v1alpha1_circuit_breaker_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
 	0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e,
 	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2e, 0x46,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x1a,
-	0x48, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63, 0x6f,
-	0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x1a, 0xcf, 0x01, 0x0a, 0x11, 0x53, 0x74,
-	0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x42, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x48, 0x6f,
-	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
-	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
-	0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0xcb, 0x01, 0x0a, 0x07,
-	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x6d,
-	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x3a, 0x0a,
-	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x1a, 0x9e, 0x02, 0x0a, 0x0a, 0x54, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e,
-	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c,
-	0x0a, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0a,
-	0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x58, 0x0a, 0x0a, 0x67, 0x72, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x52, 0x0a, 0x67,
+	0x72, 0x70, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x48, 0x0a, 0x06, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x76, 0x65, 0x1a, 0xcf, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a,
-	0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x61,
-	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x40, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x34, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6d,
-	0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x3a, 0x6b, 0xaa, 0x8c, 0x89, 0xa6,
-	0x01, 0x18, 0x0a, 0x16, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x10,
-	0x12, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
-	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6,
-	0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x13, 0x3a, 0x11, 0x0a, 0x0f,
-	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x2d, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0xaa,
-	0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x53, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d,
-	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x25, 0x50, 0x01, 0xa2, 0x01, 0x0e, 0x43, 0x69, 0x72, 0x63,
-	0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0xf2, 0x01, 0x0f, 0x63, 0x69, 0x72,
-	0x63, 0x75, 0x69, 0x74, 0x2d, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0xcb, 0x01, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x12, 0x42, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x1a, 0x9e, 0x02, 0x0a, 0x0a, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x1a, 0xdc, 0x04, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x67, 0x0a, 0x0c, 0x74, 0x63, 0x70, 0x4b, 0x65,
+	0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x70, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69,
+	0x76, 0x65, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65,
+	0x12, 0x44, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x62, 0x0a, 0x1d, 0x70, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1d, 0x70, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0xaa, 0x01, 0x0a, 0x0c, 0x54, 0x63, 0x70, 0x4b, 0x65,
+	0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x3a, 0x6b, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x18, 0x0a, 0x16, 0x43, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x10, 0x12, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22,
+	0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x13, 0x3a, 0x11, 0x0a, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x2d, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01,
+	0x42, 0x53, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x25,
+	0x50, 0x01, 0xa2, 0x01, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0xf2, 0x01, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x2d, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -697,50 +926,62 @@ func file_mesh_v1alpha1_circuit_breaker_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_circuit_breaker_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_circuit_breaker_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_mesh_v1alpha1_circuit_breaker_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_mesh_v1alpha1_circuit_breaker_proto_goTypes = []interface{}{
 	(*CircuitBreaker)(nil),                                  // 0: kuma.mesh.v1alpha1.CircuitBreaker
 	(*CircuitBreaker_Conf)(nil),                             // 1: kuma.mesh.v1alpha1.CircuitBreaker.Conf
 	(*CircuitBreaker_Conf_Detectors)(nil),                   // 2: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors
 	(*CircuitBreaker_Conf_Thresholds)(nil),                  // 3: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds
-	(*CircuitBreaker_Conf_Detectors_Errors)(nil),            // 4: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors
-	(*CircuitBreaker_Conf_Detectors_StandardDeviation)(nil), // 5: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.StandardDeviation
-	(*CircuitBreaker_Conf_Detectors_Failure)(nil),           // 6: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Failure
-	(*Selector)(nil),                                        // 7: kuma.mesh.v1alpha1.Selector
-	(*durationpb.Duration)(nil),                             // 8: google.protobuf.Duration
-	(*wrapperspb.UInt32Value)(nil),                          // 9: google.protobuf.UInt32Value
-	(*wrapperspb.DoubleValue)(nil),                          // 10: google.protobuf.DoubleValue
+	(*CircuitBreaker_Conf_ConnectionPool)(nil),              // 4: kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool
+	(*CircuitBreaker_Conf_Detectors_Errors)(nil),            // 5: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors
+	(*CircuitBreaker_Conf_Detectors_StandardDeviation)(nil), // 6: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.StandardDeviation
+	(*CircuitBreaker_Conf_Detectors_Failure)(nil),           // 7: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Failure
+	(*CircuitBreaker_Conf_ConnectionPool_TcpKeepalive)(nil), // 8: kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool.TcpKeepalive
+	(*Selector)(nil),                                        // 9: kuma.mesh.v1alpha1.Selector
+	(*durationpb.Duration)(nil),                             // 10: google.protobuf.Duration
+	(*wrapperspb.UInt32Value)(nil),                          // 11: google.protobuf.UInt32Value
+	(*wrapperspb.DoubleValue)(nil),                          // 12: google.protobuf.DoubleValue
 }
 var file_mesh_v1alpha1_circuit_breaker_proto_depIdxs = []int32{
-	7,  // 0: kuma.mesh.v1alpha1.CircuitBreaker.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	7,  // 1: kuma.mesh.v1alpha1.CircuitBreaker.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	9,  // 0: kuma.mesh.v1alpha1.CircuitBreaker.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	9,  // 1: kuma.mesh.v1alpha1.CircuitBreaker.destinations:type_name -> kuma.mesh.v1alpha1.Selector
 	1,  // 2: kuma.mesh.v1alpha1.CircuitBreaker.conf:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf
-	8,  // 3: kuma.mesh.v1alpha1.CircuitBreaker.Conf.interval:type_name -> google.protobuf.Duration
-	8,  // 4: kuma.mesh.v1alpha1.CircuitBreaker.Conf.baseEjectionTime:type_name -> google.protobuf.Duration
-	9,  // 5: kuma.mesh.v1alpha1.CircuitBreaker.Conf.maxEjectionPercent:type_name -> google.protobuf.UInt32Value
+	10, // 3: kuma.mesh.v1alpha1.CircuitBreaker.Conf.interval:type_name -> google.protobuf.Duration
+	10, // 4: kuma.mesh.v1alpha1.CircuitBreaker.Conf.baseEjectionTime:type_name -> google.protobuf.Duration
+	11, // 5: kuma.mesh.v1alpha1.CircuitBreaker.Conf.maxEjectionPercent:type_name -> google.protobuf.UInt32Value
 	2,  // 6: kuma.mesh.v1alpha1.CircuitBreaker.Conf.detectors:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors
 	3,  // 7: kuma.mesh.v1alpha1.CircuitBreaker.Conf.thresholds:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds
-	4,  // 8: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.totalErrors:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors
-	4,  // 9: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.gatewayErrors:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors
-	4,  // 10: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.localErrors:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors
-	5,  // 11: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.standardDeviation:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.StandardDeviation
-	6,  // 12: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.failure:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Failure
-	9,  // 13: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds.maxConnections:type_name -> google.protobuf.UInt32Value
-	9,  // 14: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds.maxPendingRequests:type_name -> google.protobuf.UInt32Value
-	9,  // 15: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds.maxRetries:type_name -> google.protobuf.UInt32Value
-	9,  // 16: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds.maxRequests:type_name -> google.protobuf.UInt32Value
-	9,  // 17: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors.consecutive:type_name -> google.protobuf.UInt32Value
-	9,  // 18: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.StandardDeviation.requestVolume:type_name -> google.protobuf.UInt32Value
-	9,  // 19: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.StandardDeviation.minimumHosts:type_name -> google.protobuf.UInt32Value
-	10, // 20: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.StandardDeviation.factor:type_name -> google.protobuf.DoubleValue
-	9,  // 21: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Failure.requestVolume:type_name -> google.protobuf.UInt32Value
-	9,  // 22: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Failure.minimumHosts:type_name -> google.protobuf.UInt32Value
-	9,  // 23: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Failure.threshold:type_name -> google.protobuf.UInt32Value
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	4,  // 8: kuma.mesh.v1alpha1.CircuitBreaker.Conf.connectionPool:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool
+	5,  // 9: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.totalErrors:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors
+	5,  // 10: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.gatewayErrors:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors
+	5,  // 11: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.localErrors:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors
+	6,  // 12: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.standardDeviation:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.StandardDeviation
+	7,  // 13: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.failure:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Failure
+	5,  // 14: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.grpcErrors:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors
+	11, // 15: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds.maxConnections:type_name -> google.protobuf.UInt32Value
+	11, // 16: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds.maxPendingRequests:type_name -> google.protobuf.UInt32Value
+	11, // 17: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds.maxRetries:type_name -> google.protobuf.UInt32Value
+	11, // 18: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds.maxRequests:type_name -> google.protobuf.UInt32Value
+	8,  // 19: kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool.tcpKeepalive:type_name -> kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool.TcpKeepalive
+	11, // 20: kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool.maxConnections:type_name -> google.protobuf.UInt32Value
+	11, // 21: kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool.maxPendingRequests:type_name -> google.protobuf.UInt32Value
+	11, // 22: kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool.maxRetries:type_name -> google.protobuf.UInt32Value
+	11, // 23: kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool.perConnectionBufferLimitBytes:type_name -> google.protobuf.UInt32Value
+	11, // 24: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Errors.consecutive:type_name -> google.protobuf.UInt32Value
+	11, // 25: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.StandardDeviation.requestVolume:type_name -> google.protobuf.UInt32Value
+	11, // 26: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.StandardDeviation.minimumHosts:type_name -> google.protobuf.UInt32Value
+	12, // 27: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.StandardDeviation.factor:type_name -> google.protobuf.DoubleValue
+	11, // 28: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Failure.requestVolume:type_name -> google.protobuf.UInt32Value
+	11, // 29: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Failure.minimumHosts:type_name -> google.protobuf.UInt32Value
+	11, // 30: kuma.mesh.v1alpha1.CircuitBreaker.Conf.Detectors.Failure.threshold:type_name -> google.protobuf.UInt32Value
+	11, // 31: kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool.TcpKeepalive.probes:type_name -> google.protobuf.UInt32Value
+	10, // 32: kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool.TcpKeepalive.time:type_name -> google.protobuf.Duration
+	10, // 33: kuma.mesh.v1alpha1.CircuitBreaker.Conf.ConnectionPool.TcpKeepalive.interval:type_name -> google.protobuf.Duration
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_circuit_breaker_proto_init() }
@@ -799,7 +1040,7 @@ func file_mesh_v1alpha1_circuit_breaker_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CircuitBreaker_Conf_Detectors_Errors); i {
+			switch v := v.(*CircuitBreaker_Conf_ConnectionPool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -811,7 +1052,7 @@ func file_mesh_v1alpha1_circuit_breaker_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CircuitBreaker_Conf_Detectors_StandardDeviation); i {
+			switch v := v.(*CircuitBreaker_Conf_Detectors_Errors); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -823,6 +1064,18 @@ func file_mesh_v1alpha1_circuit_breaker_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker_Conf_Detectors_StandardDeviation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CircuitBreaker_Conf_Detectors_Failure); i {
 			case 0:
 				return &v.state
@@ -834,6 +1087,18 @@ func file_mesh_v1alpha1_circuit_breaker_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_circuit_breaker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker_Conf_ConnectionPool_TcpKeepalive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -841,7 +1106,7 @@ func file_mesh_v1alpha1_circuit_breaker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_circuit_breaker_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
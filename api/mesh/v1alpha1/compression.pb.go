@@ -0,0 +1,351 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/compression.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MeshCompression_Conf_Library int32
+
+const (
+	// Compresses responses with gzip.
+	MeshCompression_Conf_GZIP MeshCompression_Conf_Library = 0
+	// Compresses responses with brotli.
+	MeshCompression_Conf_BROTLI MeshCompression_Conf_Library = 1
+)
+
+// Enum value maps for MeshCompression_Conf_Library.
+var (
+	MeshCompression_Conf_Library_name = map[int32]string{
+		0: "GZIP",
+		1: "BROTLI",
+	}
+	MeshCompression_Conf_Library_value = map[string]int32{
+		"GZIP":   0,
+		"BROTLI": 1,
+	}
+)
+
+func (x MeshCompression_Conf_Library) Enum() *MeshCompression_Conf_Library {
+	p := new(MeshCompression_Conf_Library)
+	*p = x
+	return p
+}
+
+func (x MeshCompression_Conf_Library) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MeshCompression_Conf_Library) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_compression_proto_enumTypes[0].Descriptor()
+}
+
+func (MeshCompression_Conf_Library) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_compression_proto_enumTypes[0]
+}
+
+func (x MeshCompression_Conf_Library) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MeshCompression_Conf_Library.Descriptor instead.
+func (MeshCompression_Conf_Library) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_compression_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+// MeshCompression enables compression of HTTP responses sent by the inbound
+// listeners of the selected dataplanes and by the listeners of the selected
+// gateways.
+type MeshCompression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes.
+	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Configuration of the compression.
+	Conf *MeshCompression_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *MeshCompression) Reset() {
+	*x = MeshCompression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_compression_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshCompression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshCompression) ProtoMessage() {}
+
+func (x *MeshCompression) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_compression_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshCompression.ProtoReflect.Descriptor instead.
+func (*MeshCompression) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_compression_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeshCompression) GetSelectors() []*Selector {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *MeshCompression) GetConf() *MeshCompression_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines how responses are compressed.
+type MeshCompression_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Compression libraries in the order of preference. The library is
+	// selected based on the Accept-Encoding header of the request.
+	Libraries []MeshCompression_Conf_Library `protobuf:"varint,1,rep,packed,name=libraries,proto3,enum=kuma.mesh.v1alpha1.MeshCompression_Conf_Library" json:"libraries,omitempty"`
+	// Minimum length of the response in bytes to be compressed. Defaults to
+	// 30.
+	MinContentLength *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=minContentLength,proto3" json:"minContentLength,omitempty"`
+	// Content types of the responses to be compressed. Defaults to common
+	// text types like application/json, text/html and text/plain.
+	ContentTypes []string `protobuf:"bytes,3,rep,name=contentTypes,proto3" json:"contentTypes,omitempty"`
+	// If true, responses with the ETag header are not compressed.
+	DisableOnEtagHeader bool `protobuf:"varint,4,opt,name=disableOnEtagHeader,proto3" json:"disableOnEtagHeader,omitempty"`
+}
+
+func (x *MeshCompression_Conf) Reset() {
+	*x = MeshCompression_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_compression_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshCompression_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshCompression_Conf) ProtoMessage() {}
+
+func (x *MeshCompression_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_compression_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshCompression_Conf.ProtoReflect.Descriptor instead.
+func (*MeshCompression_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_compression_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MeshCompression_Conf) GetLibraries() []MeshCompression_Conf_Library {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+func (x *MeshCompression_Conf) GetMinContentLength() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MinContentLength
+	}
+	return nil
+}
+
+func (x *MeshCompression_Conf) GetContentTypes() []string {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *MeshCompression_Conf) GetDisableOnEtagHeader() bool {
+	if x != nil {
+		return x.DisableOnEtagHeader
+	}
+	return false
+}
+
+var File_mesh_v1alpha1_compression_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_compression_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x04, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x04, 0x63,
+	0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a,
+	0x9d, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x54, 0x0a, 0x09, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x48,
+	0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x13,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x45, 0x74, 0x61, 0x67, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x4f, 0x6e, 0x45, 0x74, 0x61, 0x67, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x1f,
+	0x0a, 0x07, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x5a, 0x49,
+	0x50, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x4f, 0x54, 0x4c, 0x49, 0x10, 0x01, 0x3a,
+	0x61, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x5b, 0x0a, 0x17, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x0f, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x3a, 0x23, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x68, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x6d, 0x65, 0x73, 0x68,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x02, 0x10, 0x01,
+	0x68, 0x01, 0x42, 0x54, 0x8a, 0xb5, 0x18, 0x26, 0x50, 0x01, 0xa2, 0x01, 0x0f, 0x4d, 0x65, 0x73,
+	0x68, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0xf2, 0x01, 0x0f, 0x6d,
+	0x65, 0x73, 0x68, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5a, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68,
+	0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_compression_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_compression_proto_rawDescData = file_mesh_v1alpha1_compression_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_compression_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_compression_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_compression_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_compression_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_compression_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_compression_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mesh_v1alpha1_compression_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mesh_v1alpha1_compression_proto_goTypes = []interface{}{
+	(MeshCompression_Conf_Library)(0), // 0: kuma.mesh.v1alpha1.MeshCompression.Conf.Library
+	(*MeshCompression)(nil),           // 1: kuma.mesh.v1alpha1.MeshCompression
+	(*MeshCompression_Conf)(nil),      // 2: kuma.mesh.v1alpha1.MeshCompression.Conf
+	(*Selector)(nil),                  // 3: kuma.mesh.v1alpha1.Selector
+	(*wrapperspb.UInt32Value)(nil),    // 4: google.protobuf.UInt32Value
+}
+var file_mesh_v1alpha1_compression_proto_depIdxs = []int32{
+	3, // 0: kuma.mesh.v1alpha1.MeshCompression.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	2, // 1: kuma.mesh.v1alpha1.MeshCompression.conf:type_name -> kuma.mesh.v1alpha1.MeshCompression.Conf
+	0, // 2: kuma.mesh.v1alpha1.MeshCompression.Conf.libraries:type_name -> kuma.mesh.v1alpha1.MeshCompression.Conf.Library
+	4, // 3: kuma.mesh.v1alpha1.MeshCompression.Conf.minContentLength:type_name -> google.protobuf.UInt32Value
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_compression_proto_init() }
+func file_mesh_v1alpha1_compression_proto_init() {
+	if File_mesh_v1alpha1_compression_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_compression_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshCompression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_compression_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshCompression_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_compression_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_compression_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_compression_proto_depIdxs,
+		EnumInfos:         file_mesh_v1alpha1_compression_proto_enumTypes,
+		MessageInfos:      file_mesh_v1alpha1_compression_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_compression_proto = out.File
+	file_mesh_v1alpha1_compression_proto_rawDesc = nil
+	file_mesh_v1alpha1_compression_proto_goTypes = nil
+	file_mesh_v1alpha1_compression_proto_depIdxs = nil
+}
@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/cors.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MeshCORS defines the Cross-Origin Resource Sharing policy applied to HTTP
+// requests received by the inbound listeners of the selected dataplanes and
+// by the listeners of the selected gateways.
+type MeshCORS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes. Selectors of gateways are matched
+	// against the tags of the gateway listeners.
+	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Configuration of the policy.
+	Conf *MeshCORS_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *MeshCORS) Reset() {
+	*x = MeshCORS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_cors_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshCORS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshCORS) ProtoMessage() {}
+
+func (x *MeshCORS) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_cors_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshCORS.ProtoReflect.Descriptor instead.
+func (*MeshCORS) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_cors_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeshCORS) GetSelectors() []*Selector {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *MeshCORS) GetConf() *MeshCORS_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines which cross-origin requests are allowed.
+type MeshCORS_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Origins allowed to make cross-origin requests.
+	AllowOrigins []*MeshCORS_Conf_Origin `protobuf:"bytes,1,rep,name=allowOrigins,proto3" json:"allowOrigins,omitempty"`
+	// Methods allowed in cross-origin requests, sent in the
+	// Access-Control-Allow-Methods header.
+	AllowMethods []string `protobuf:"bytes,2,rep,name=allowMethods,proto3" json:"allowMethods,omitempty"`
+	// Headers allowed in cross-origin requests, sent in the
+	// Access-Control-Allow-Headers header.
+	AllowHeaders []string `protobuf:"bytes,3,rep,name=allowHeaders,proto3" json:"allowHeaders,omitempty"`
+	// Headers exposed to the browser, sent in the
+	// Access-Control-Expose-Headers header.
+	ExposeHeaders []string `protobuf:"bytes,4,rep,name=exposeHeaders,proto3" json:"exposeHeaders,omitempty"`
+	// How long the result of a preflight request can be cached, sent in the
+	// Access-Control-Max-Age header.
+	MaxAge *durationpb.Duration `protobuf:"bytes,5,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	// If true, credentials are allowed in cross-origin requests.
+	AllowCredentials bool `protobuf:"varint,6,opt,name=allowCredentials,proto3" json:"allowCredentials,omitempty"`
+}
+
+func (x *MeshCORS_Conf) Reset() {
+	*x = MeshCORS_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_cors_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshCORS_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshCORS_Conf) ProtoMessage() {}
+
+func (x *MeshCORS_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_cors_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshCORS_Conf.ProtoReflect.Descriptor instead.
+func (*MeshCORS_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_cors_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MeshCORS_Conf) GetAllowOrigins() []*MeshCORS_Conf_Origin {
+	if x != nil {
+		return x.AllowOrigins
+	}
+	return nil
+}
+
+func (x *MeshCORS_Conf) GetAllowMethods() []string {
+	if x != nil {
+		return x.AllowMethods
+	}
+	return nil
+}
+
+func (x *MeshCORS_Conf) GetAllowHeaders() []string {
+	if x != nil {
+		return x.AllowHeaders
+	}
+	return nil
+}
+
+func (x *MeshCORS_Conf) GetExposeHeaders() []string {
+	if x != nil {
+		return x.ExposeHeaders
+	}
+	return nil
+}
+
+func (x *MeshCORS_Conf) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *MeshCORS_Conf) GetAllowCredentials() bool {
+	if x != nil {
+		return x.AllowCredentials
+	}
+	return false
+}
+
+// Origin defines how the Origin header of the request is matched.
+type MeshCORS_Conf_Origin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Match:
+	//	*MeshCORS_Conf_Origin_Exact
+	//	*MeshCORS_Conf_Origin_Prefix
+	//	*MeshCORS_Conf_Origin_Regex
+	Match isMeshCORS_Conf_Origin_Match `protobuf_oneof:"match"`
+}
+
+func (x *MeshCORS_Conf_Origin) Reset() {
+	*x = MeshCORS_Conf_Origin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_cors_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshCORS_Conf_Origin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshCORS_Conf_Origin) ProtoMessage() {}
+
+func (x *MeshCORS_Conf_Origin) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_cors_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshCORS_Conf_Origin.ProtoReflect.Descriptor instead.
+func (*MeshCORS_Conf_Origin) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_cors_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (m *MeshCORS_Conf_Origin) GetMatch() isMeshCORS_Conf_Origin_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (x *MeshCORS_Conf_Origin) GetExact() string {
+	if x, ok := x.GetMatch().(*MeshCORS_Conf_Origin_Exact); ok {
+		return x.Exact
+	}
+	return ""
+}
+
+func (x *MeshCORS_Conf_Origin) GetPrefix() string {
+	if x, ok := x.GetMatch().(*MeshCORS_Conf_Origin_Prefix); ok {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *MeshCORS_Conf_Origin) GetRegex() string {
+	if x, ok := x.GetMatch().(*MeshCORS_Conf_Origin_Regex); ok {
+		return x.Regex
+	}
+	return ""
+}
+
+type isMeshCORS_Conf_Origin_Match interface {
+	isMeshCORS_Conf_Origin_Match()
+}
+
+type MeshCORS_Conf_Origin_Exact struct {
+	// The Origin header has to be equal to the value.
+	Exact string `protobuf:"bytes,1,opt,name=exact,proto3,oneof"`
+}
+
+type MeshCORS_Conf_Origin_Prefix struct {
+	// The Origin header has to start with the value.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
+}
+
+type MeshCORS_Conf_Origin_Regex struct {
+	// The whole Origin header has to match the RE2 regular expression.
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3,oneof"`
+}
+
+func (*MeshCORS_Conf_Origin_Exact) isMeshCORS_Conf_Origin_Match() {}
+
+func (*MeshCORS_Conf_Origin_Prefix) isMeshCORS_Conf_Origin_Match() {}
+
+func (*MeshCORS_Conf_Origin_Regex) isMeshCORS_Conf_Origin_Match() {}
+
+var File_mesh_v1alpha1_cors_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_cors_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x63, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8,
+	0x04, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x4f, 0x52, 0x53, 0x12, 0x40, 0x0a, 0x09, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5,
+	0x18, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3b, 0x0a,
+	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x4f, 0x52, 0x53, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04,
+	0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0x84, 0x03, 0x0a, 0x04, 0x43,
+	0x6f, 0x6e, 0x66, 0x12, 0x52, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x43, 0x4f, 0x52, 0x53, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x1a, 0x5b, 0x0a, 0x06, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16,
+	0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x16, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x3a, 0x46, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x40, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x43,
+	0x4f, 0x52, 0x53, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x08, 0x4d, 0x65, 0x73,
+	0x68, 0x43, 0x4f, 0x52, 0x53, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x3a, 0x16, 0x0a, 0x08, 0x6d,
+	0x65, 0x73, 0x68, 0x63, 0x6f, 0x72, 0x73, 0x12, 0x0a, 0x6d, 0x65, 0x73, 0x68, 0x63, 0x6f, 0x72,
+	0x73, 0x65, 0x73, 0x52, 0x02, 0x10, 0x01, 0x68, 0x01, 0x42, 0x46, 0x8a, 0xb5, 0x18, 0x18, 0x50,
+	0x01, 0xa2, 0x01, 0x08, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x4f, 0x52, 0x53, 0xf2, 0x01, 0x08, 0x6d,
+	0x65, 0x73, 0x68, 0x63, 0x6f, 0x72, 0x73, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_cors_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_cors_proto_rawDescData = file_mesh_v1alpha1_cors_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_cors_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_cors_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_cors_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_cors_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_cors_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_cors_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_mesh_v1alpha1_cors_proto_goTypes = []interface{}{
+	(*MeshCORS)(nil),             // 0: kuma.mesh.v1alpha1.MeshCORS
+	(*MeshCORS_Conf)(nil),        // 1: kuma.mesh.v1alpha1.MeshCORS.Conf
+	(*MeshCORS_Conf_Origin)(nil), // 2: kuma.mesh.v1alpha1.MeshCORS.Conf.Origin
+	(*Selector)(nil),             // 3: kuma.mesh.v1alpha1.Selector
+	(*durationpb.Duration)(nil),  // 4: google.protobuf.Duration
+}
+var file_mesh_v1alpha1_cors_proto_depIdxs = []int32{
+	3, // 0: kuma.mesh.v1alpha1.MeshCORS.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 1: kuma.mesh.v1alpha1.MeshCORS.conf:type_name -> kuma.mesh.v1alpha1.MeshCORS.Conf
+	2, // 2: kuma.mesh.v1alpha1.MeshCORS.Conf.allowOrigins:type_name -> kuma.mesh.v1alpha1.MeshCORS.Conf.Origin
+	4, // 3: kuma.mesh.v1alpha1.MeshCORS.Conf.maxAge:type_name -> google.protobuf.Duration
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_cors_proto_init() }
+func file_mesh_v1alpha1_cors_proto_init() {
+	if File_mesh_v1alpha1_cors_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_cors_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshCORS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_cors_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshCORS_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_cors_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshCORS_Conf_Origin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mesh_v1alpha1_cors_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*MeshCORS_Conf_Origin_Exact)(nil),
+		(*MeshCORS_Conf_Origin_Prefix)(nil),
+		(*MeshCORS_Conf_Origin_Regex)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_cors_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_cors_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_cors_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_cors_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_cors_proto = out.File
+	file_mesh_v1alpha1_cors_proto_rawDesc = nil
+	file_mesh_v1alpha1_cors_proto_goTypes = nil
+	file_mesh_v1alpha1_cors_proto_depIdxs = nil
+}
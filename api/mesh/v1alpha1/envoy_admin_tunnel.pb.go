@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/envoy_admin_tunnel.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EnvoyAdminTunnelRequest is a request to the Envoy Admin API that is executed
+// by kuma-dp.
+type EnvoyAdminTunnelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RequestID is a UUID of a request so we can correlate requests with response
+	// on one stream.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// HTTP method of the request (GET, POST).
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Path of the Envoy Admin API endpoint (ex. /config_dump).
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Encoded query of the request.
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// Body of the request, i.e. the configuration of /tap.
+	Body []byte `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	// If true then kuma-dp streams the body of the response in chunks as soon
+	// as Envoy writes them, so endpoints that never finish the response (i.e.
+	// /tap) can be used. The first response carries the status code, every
+	// response but the last one has more set.
+	Stream bool `protobuf:"varint,6,opt,name=stream,proto3" json:"stream,omitempty"`
+	// If true then kuma-dp stops streaming the response of the request with the
+	// request_id. Other fields are not set.
+	Cancel bool `protobuf:"varint,7,opt,name=cancel,proto3" json:"cancel,omitempty"`
+}
+
+func (x *EnvoyAdminTunnelRequest) Reset() {
+	*x = EnvoyAdminTunnelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_envoy_admin_tunnel_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvoyAdminTunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvoyAdminTunnelRequest) ProtoMessage() {}
+
+func (x *EnvoyAdminTunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_envoy_admin_tunnel_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvoyAdminTunnelRequest.ProtoReflect.Descriptor instead.
+func (*EnvoyAdminTunnelRequest) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnvoyAdminTunnelRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *EnvoyAdminTunnelRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *EnvoyAdminTunnelRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *EnvoyAdminTunnelRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *EnvoyAdminTunnelRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *EnvoyAdminTunnelRequest) GetStream() bool {
+	if x != nil {
+		return x.Stream
+	}
+	return false
+}
+
+func (x *EnvoyAdminTunnelRequest) GetCancel() bool {
+	if x != nil {
+		return x.Cancel
+	}
+	return false
+}
+
+// EnvoyAdminTunnelResponse is a response containing result of the request
+// to the Envoy Admin API executed by kuma-dp.
+type EnvoyAdminTunnelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RequestID is a UUID that was set by the Zone CP.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Error that was captured by kuma-dp when executing the request, i.e. Envoy
+	// Admin API is not reachable. It is not set when Envoy responded with an
+	// error status code.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Status code returned by Envoy.
+	StatusCode uint32 `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Body of the response returned by Envoy. It is a chunk of the body when the
+	// response is streamed.
+	Body []byte `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// If true then more chunks of the body of the streamed response follow.
+	More bool `protobuf:"varint,5,opt,name=more,proto3" json:"more,omitempty"`
+}
+
+func (x *EnvoyAdminTunnelResponse) Reset() {
+	*x = EnvoyAdminTunnelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_envoy_admin_tunnel_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvoyAdminTunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvoyAdminTunnelResponse) ProtoMessage() {}
+
+func (x *EnvoyAdminTunnelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_envoy_admin_tunnel_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvoyAdminTunnelResponse.ProtoReflect.Descriptor instead.
+func (*EnvoyAdminTunnelResponse) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnvoyAdminTunnelResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *EnvoyAdminTunnelResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EnvoyAdminTunnelResponse) GetStatusCode() uint32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *EnvoyAdminTunnelResponse) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *EnvoyAdminTunnelResponse) GetMore() bool {
+	if x != nil {
+		return x.More
+	}
+	return false
+}
+
+var File_mesh_v1alpha1_envoy_admin_tunnel_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0xbe, 0x01, 0x0a,
+	0x17, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x22, 0x98, 0x01,
+	0x0a, 0x18, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x32, 0x94, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x76,
+	0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
+	0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x2b,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x28, 0x01, 0x30, 0x01, 0x42,
+	0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75,
+	0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDescData = file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_envoy_admin_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mesh_v1alpha1_envoy_admin_tunnel_proto_goTypes = []interface{}{
+	(*EnvoyAdminTunnelRequest)(nil),  // 0: kuma.mesh.v1alpha1.EnvoyAdminTunnelRequest
+	(*EnvoyAdminTunnelResponse)(nil), // 1: kuma.mesh.v1alpha1.EnvoyAdminTunnelResponse
+}
+var file_mesh_v1alpha1_envoy_admin_tunnel_proto_depIdxs = []int32{
+	1, // 0: kuma.mesh.v1alpha1.EnvoyAdminTunnelService.StreamEnvoyAdminRequests:input_type -> kuma.mesh.v1alpha1.EnvoyAdminTunnelResponse
+	0, // 1: kuma.mesh.v1alpha1.EnvoyAdminTunnelService.StreamEnvoyAdminRequests:output_type -> kuma.mesh.v1alpha1.EnvoyAdminTunnelRequest
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_envoy_admin_tunnel_proto_init() }
+func file_mesh_v1alpha1_envoy_admin_tunnel_proto_init() {
+	if File_mesh_v1alpha1_envoy_admin_tunnel_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_envoy_admin_tunnel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvoyAdminTunnelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_envoy_admin_tunnel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvoyAdminTunnelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mesh_v1alpha1_envoy_admin_tunnel_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_envoy_admin_tunnel_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_envoy_admin_tunnel_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_envoy_admin_tunnel_proto = out.File
+	file_mesh_v1alpha1_envoy_admin_tunnel_proto_rawDesc = nil
+	file_mesh_v1alpha1_envoy_admin_tunnel_proto_goTypes = nil
+	file_mesh_v1alpha1_envoy_admin_tunnel_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EnvoyAdminTunnelServiceClient is the client API for EnvoyAdminTunnelService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EnvoyAdminTunnelServiceClient interface {
+	// StreamEnvoyAdminRequests is logically a service exposed by kuma-dp so
+	// Zone CP can execute requests on the Envoy Admin API without connecting to
+	// the proxy directly. It is realized as a stream opened by kuma-dp to the
+	// Dataplane Server of the Zone CP, because the proxy may not be reachable by
+	// the Zone CP (i.e. it is behind NAT or in a different network).
+	StreamEnvoyAdminRequests(ctx context.Context, opts ...grpc.CallOption) (EnvoyAdminTunnelService_StreamEnvoyAdminRequestsClient, error)
+}
+
+type envoyAdminTunnelServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEnvoyAdminTunnelServiceClient(cc grpc.ClientConnInterface) EnvoyAdminTunnelServiceClient {
+	return &envoyAdminTunnelServiceClient{cc}
+}
+
+func (c *envoyAdminTunnelServiceClient) StreamEnvoyAdminRequests(ctx context.Context, opts ...grpc.CallOption) (EnvoyAdminTunnelService_StreamEnvoyAdminRequestsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EnvoyAdminTunnelService_serviceDesc.Streams[0], "/kuma.mesh.v1alpha1.EnvoyAdminTunnelService/StreamEnvoyAdminRequests", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &envoyAdminTunnelServiceStreamEnvoyAdminRequestsClient{stream}
+	return x, nil
+}
+
+type EnvoyAdminTunnelService_StreamEnvoyAdminRequestsClient interface {
+	Send(*EnvoyAdminTunnelResponse) error
+	Recv() (*EnvoyAdminTunnelRequest, error)
+	grpc.ClientStream
+}
+
+type envoyAdminTunnelServiceStreamEnvoyAdminRequestsClient struct {
+	grpc.ClientStream
+}
+
+func (x *envoyAdminTunnelServiceStreamEnvoyAdminRequestsClient) Send(m *EnvoyAdminTunnelResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *envoyAdminTunnelServiceStreamEnvoyAdminRequestsClient) Recv() (*EnvoyAdminTunnelRequest, error) {
+	m := new(EnvoyAdminTunnelRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EnvoyAdminTunnelServiceServer is the server API for EnvoyAdminTunnelService service.
+type EnvoyAdminTunnelServiceServer interface {
+	// StreamEnvoyAdminRequests is logically a service exposed by kuma-dp so
+	// Zone CP can execute requests on the Envoy Admin API without connecting to
+	// the proxy directly. It is realized as a stream opened by kuma-dp to the
+	// Dataplane Server of the Zone CP, because the proxy may not be reachable by
+	// the Zone CP (i.e. it is behind NAT or in a different network).
+	StreamEnvoyAdminRequests(EnvoyAdminTunnelService_StreamEnvoyAdminRequestsServer) error
+}
+
+// UnimplementedEnvoyAdminTunnelServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedEnvoyAdminTunnelServiceServer struct {
+}
+
+func (*UnimplementedEnvoyAdminTunnelServiceServer) StreamEnvoyAdminRequests(EnvoyAdminTunnelService_StreamEnvoyAdminRequestsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEnvoyAdminRequests not implemented")
+}
+
+func RegisterEnvoyAdminTunnelServiceServer(s *grpc.Server, srv EnvoyAdminTunnelServiceServer) {
+	s.RegisterService(&_EnvoyAdminTunnelService_serviceDesc, srv)
+}
+
+func _EnvoyAdminTunnelService_StreamEnvoyAdminRequests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EnvoyAdminTunnelServiceServer).StreamEnvoyAdminRequests(&envoyAdminTunnelServiceStreamEnvoyAdminRequestsServer{stream})
+}
+
+type EnvoyAdminTunnelService_StreamEnvoyAdminRequestsServer interface {
+	Send(*EnvoyAdminTunnelRequest) error
+	Recv() (*EnvoyAdminTunnelResponse, error)
+	grpc.ServerStream
+}
+
+type envoyAdminTunnelServiceStreamEnvoyAdminRequestsServer struct {
+	grpc.ServerStream
+}
+
+func (x *envoyAdminTunnelServiceStreamEnvoyAdminRequestsServer) Send(m *EnvoyAdminTunnelRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *envoyAdminTunnelServiceStreamEnvoyAdminRequestsServer) Recv() (*EnvoyAdminTunnelResponse, error) {
+	m := new(EnvoyAdminTunnelResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _EnvoyAdminTunnelService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kuma.mesh.v1alpha1.EnvoyAdminTunnelService",
+	HandlerType: (*EnvoyAdminTunnelServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEnvoyAdminRequests",
+			Handler:       _EnvoyAdminTunnelService_StreamEnvoyAdminRequests_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "mesh/v1alpha1/envoy_admin_tunnel.proto",
+}
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/envoy_runtime.proto
+
+package v1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EnvoyRuntime defines runtime settings of Envoy run by a data plane proxy.
+type EnvoyRuntime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of Envoy worker threads. If not specified, it is derived from the
+	// CPUs available to the data plane proxy.
+	Concurrency *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// Soft limit on size of the read and write buffers of connections accepted
+	// by listeners of the data plane proxy. If not specified, the default is
+	// 1MiB.
+	PerConnectionBufferLimitBytes *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=perConnectionBufferLimitBytes,proto3" json:"perConnectionBufferLimitBytes,omitempty"`
+}
+
+func (x *EnvoyRuntime) Reset() {
+	*x = EnvoyRuntime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_envoy_runtime_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvoyRuntime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvoyRuntime) ProtoMessage() {}
+
+func (x *EnvoyRuntime) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_envoy_runtime_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvoyRuntime.ProtoReflect.Descriptor instead.
+func (*EnvoyRuntime) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_envoy_runtime_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnvoyRuntime) GetConcurrency() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Concurrency
+	}
+	return nil
+}
+
+func (x *EnvoyRuntime) GetPerConnectionBufferLimitBytes() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.PerConnectionBufferLimitBytes
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_envoy_runtime_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_envoy_runtime_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x6f,
+	0x79, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x62, 0x0a, 0x1d, 0x70, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1d, 0x70,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x2a, 0x5a, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68,
+	0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_envoy_runtime_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_envoy_runtime_proto_rawDescData = file_mesh_v1alpha1_envoy_runtime_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_envoy_runtime_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_envoy_runtime_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_envoy_runtime_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_envoy_runtime_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_envoy_runtime_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_envoy_runtime_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mesh_v1alpha1_envoy_runtime_proto_goTypes = []interface{}{
+	(*EnvoyRuntime)(nil),           // 0: kuma.mesh.v1alpha1.EnvoyRuntime
+	(*wrapperspb.UInt32Value)(nil), // 1: google.protobuf.UInt32Value
+}
+var file_mesh_v1alpha1_envoy_runtime_proto_depIdxs = []int32{
+	1, // 0: kuma.mesh.v1alpha1.EnvoyRuntime.concurrency:type_name -> google.protobuf.UInt32Value
+	1, // 1: kuma.mesh.v1alpha1.EnvoyRuntime.perConnectionBufferLimitBytes:type_name -> google.protobuf.UInt32Value
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_envoy_runtime_proto_init() }
+func file_mesh_v1alpha1_envoy_runtime_proto_init() {
+	if File_mesh_v1alpha1_envoy_runtime_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_envoy_runtime_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvoyRuntime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_envoy_runtime_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_envoy_runtime_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_envoy_runtime_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_envoy_runtime_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_envoy_runtime_proto = out.File
+	file_mesh_v1alpha1_envoy_runtime_proto_rawDesc = nil
+	file_mesh_v1alpha1_envoy_runtime_proto_goTypes = nil
+	file_mesh_v1alpha1_envoy_runtime_proto_depIdxs = nil
+}
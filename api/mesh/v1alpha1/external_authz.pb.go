@@ -0,0 +1,595 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/external_authz.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MeshExternalAuthz defines an external authorization service that is asked
+// to authorize HTTP requests received by the inbound listeners of the selected
+// dataplanes.
+type MeshExternalAuthz struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes.
+	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Configuration of the authorization.
+	Conf *MeshExternalAuthz_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *MeshExternalAuthz) Reset() {
+	*x = MeshExternalAuthz{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_external_authz_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshExternalAuthz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshExternalAuthz) ProtoMessage() {}
+
+func (x *MeshExternalAuthz) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_external_authz_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshExternalAuthz.ProtoReflect.Descriptor instead.
+func (*MeshExternalAuthz) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_external_authz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeshExternalAuthz) GetSelectors() []*Selector {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *MeshExternalAuthz) GetConf() *MeshExternalAuthz_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines the authorization service.
+type MeshExternalAuthz_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Service:
+	//	*MeshExternalAuthz_Conf_Grpc
+	//	*MeshExternalAuthz_Conf_Http
+	Service isMeshExternalAuthz_Conf_Service `protobuf_oneof:"service"`
+	// If true, requests are allowed when the authorization service fails or
+	// is unavailable, otherwise they are rejected with 403.
+	FailureModeAllow bool `protobuf:"varint,3,opt,name=failureModeAllow,proto3" json:"failureModeAllow,omitempty"`
+	// If defined, the body of the authorized request is sent to the service.
+	RequestBody *MeshExternalAuthz_Conf_RequestBody `protobuf:"bytes,4,opt,name=requestBody,proto3" json:"requestBody,omitempty"`
+}
+
+func (x *MeshExternalAuthz_Conf) Reset() {
+	*x = MeshExternalAuthz_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_external_authz_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshExternalAuthz_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshExternalAuthz_Conf) ProtoMessage() {}
+
+func (x *MeshExternalAuthz_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_external_authz_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshExternalAuthz_Conf.ProtoReflect.Descriptor instead.
+func (*MeshExternalAuthz_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_external_authz_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (m *MeshExternalAuthz_Conf) GetService() isMeshExternalAuthz_Conf_Service {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (x *MeshExternalAuthz_Conf) GetGrpc() *MeshExternalAuthz_Conf_GrpcService {
+	if x, ok := x.GetService().(*MeshExternalAuthz_Conf_Grpc); ok {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *MeshExternalAuthz_Conf) GetHttp() *MeshExternalAuthz_Conf_HttpService {
+	if x, ok := x.GetService().(*MeshExternalAuthz_Conf_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *MeshExternalAuthz_Conf) GetFailureModeAllow() bool {
+	if x != nil {
+		return x.FailureModeAllow
+	}
+	return false
+}
+
+func (x *MeshExternalAuthz_Conf) GetRequestBody() *MeshExternalAuthz_Conf_RequestBody {
+	if x != nil {
+		return x.RequestBody
+	}
+	return nil
+}
+
+type isMeshExternalAuthz_Conf_Service interface {
+	isMeshExternalAuthz_Conf_Service()
+}
+
+type MeshExternalAuthz_Conf_Grpc struct {
+	// Authorization service called over gRPC.
+	Grpc *MeshExternalAuthz_Conf_GrpcService `protobuf:"bytes,1,opt,name=grpc,proto3,oneof"`
+}
+
+type MeshExternalAuthz_Conf_Http struct {
+	// Authorization service called over HTTP.
+	Http *MeshExternalAuthz_Conf_HttpService `protobuf:"bytes,2,opt,name=http,proto3,oneof"`
+}
+
+func (*MeshExternalAuthz_Conf_Grpc) isMeshExternalAuthz_Conf_Service() {}
+
+func (*MeshExternalAuthz_Conf_Http) isMeshExternalAuthz_Conf_Service() {}
+
+// GrpcService defines an authorization service implementing the Envoy
+// external authorization gRPC API.
+type MeshExternalAuthz_Conf_GrpcService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the service in the host:port format.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Timeout of the authorization request. Defaults to 200ms.
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *MeshExternalAuthz_Conf_GrpcService) Reset() {
+	*x = MeshExternalAuthz_Conf_GrpcService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_external_authz_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshExternalAuthz_Conf_GrpcService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshExternalAuthz_Conf_GrpcService) ProtoMessage() {}
+
+func (x *MeshExternalAuthz_Conf_GrpcService) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_external_authz_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshExternalAuthz_Conf_GrpcService.ProtoReflect.Descriptor instead.
+func (*MeshExternalAuthz_Conf_GrpcService) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_external_authz_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *MeshExternalAuthz_Conf_GrpcService) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MeshExternalAuthz_Conf_GrpcService) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// HttpService defines an authorization service called over HTTP. The
+// request is authorized when the service responds with 200.
+type MeshExternalAuthz_Conf_HttpService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL of the service. Path of the authorized request is appended to the
+	// path of the URL.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Timeout of the authorization request. Defaults to 200ms.
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Headers of the authorized request sent to the service in addition to
+	// Host, Method, Path, Content-Length and Authorization.
+	AllowedHeaders []string `protobuf:"bytes,3,rep,name=allowedHeaders,proto3" json:"allowedHeaders,omitempty"`
+	// Headers of the authorization response added to the authorized request.
+	AllowedUpstreamHeaders []string `protobuf:"bytes,4,rep,name=allowedUpstreamHeaders,proto3" json:"allowedUpstreamHeaders,omitempty"`
+}
+
+func (x *MeshExternalAuthz_Conf_HttpService) Reset() {
+	*x = MeshExternalAuthz_Conf_HttpService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_external_authz_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshExternalAuthz_Conf_HttpService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshExternalAuthz_Conf_HttpService) ProtoMessage() {}
+
+func (x *MeshExternalAuthz_Conf_HttpService) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_external_authz_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshExternalAuthz_Conf_HttpService.ProtoReflect.Descriptor instead.
+func (*MeshExternalAuthz_Conf_HttpService) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_external_authz_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *MeshExternalAuthz_Conf_HttpService) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MeshExternalAuthz_Conf_HttpService) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *MeshExternalAuthz_Conf_HttpService) GetAllowedHeaders() []string {
+	if x != nil {
+		return x.AllowedHeaders
+	}
+	return nil
+}
+
+func (x *MeshExternalAuthz_Conf_HttpService) GetAllowedUpstreamHeaders() []string {
+	if x != nil {
+		return x.AllowedUpstreamHeaders
+	}
+	return nil
+}
+
+// RequestBody defines how the body of the authorized request is sent to
+// the service.
+type MeshExternalAuthz_Conf_RequestBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of bytes of the body buffered and sent to the service.
+	MaxRequestBytes uint32 `protobuf:"varint,1,opt,name=maxRequestBytes,proto3" json:"maxRequestBytes,omitempty"`
+	// If true, only the first maxRequestBytes of a bigger body are sent,
+	// otherwise the request is rejected with 413.
+	AllowPartialMessage bool `protobuf:"varint,2,opt,name=allowPartialMessage,proto3" json:"allowPartialMessage,omitempty"`
+}
+
+func (x *MeshExternalAuthz_Conf_RequestBody) Reset() {
+	*x = MeshExternalAuthz_Conf_RequestBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_external_authz_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshExternalAuthz_Conf_RequestBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshExternalAuthz_Conf_RequestBody) ProtoMessage() {}
+
+func (x *MeshExternalAuthz_Conf_RequestBody) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_external_authz_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshExternalAuthz_Conf_RequestBody.ProtoReflect.Descriptor instead.
+func (*MeshExternalAuthz_Conf_RequestBody) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_external_authz_proto_rawDescGZIP(), []int{0, 0, 2}
+}
+
+func (x *MeshExternalAuthz_Conf_RequestBody) GetMaxRequestBytes() uint32 {
+	if x != nil {
+		return x.MaxRequestBytes
+	}
+	return 0
+}
+
+func (x *MeshExternalAuthz_Conf_RequestBody) GetAllowPartialMessage() bool {
+	if x != nil {
+		return x.AllowPartialMessage
+	}
+	return false
+}
+
+var File_mesh_v1alpha1_external_authz_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_external_authz_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x07, 0x0a, 0x11, 0x4d, 0x65, 0x73,
+	0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x12, 0x40,
+	0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x44, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x41, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xc5, 0x05, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12,
+	0x4c, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
+	0x75, 0x74, 0x68, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x4c, 0x0a,
+	0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x75, 0x74,
+	0x68, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x58, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x75,
+	0x74, 0x68, 0x7a, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64,
+	0x79, 0x1a, 0x62, 0x0a, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1e, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0xba, 0x01, 0x0a, 0x0b, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x33, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x1a, 0x6f, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x2e, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x69,
+	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x63, 0x0a, 0x19, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x11, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x41,
+	0x75, 0x74, 0x68, 0x7a, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x3a, 0x27, 0x0a, 0x11, 0x6d, 0x65,
+	0x73, 0x68, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x12,
+	0x12, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x61, 0x75, 0x74,
+	0x68, 0x7a, 0x73, 0x52, 0x02, 0x10, 0x01, 0x68, 0x01, 0x42, 0x58, 0x8a, 0xb5, 0x18, 0x2a, 0x50,
+	0x01, 0xa2, 0x01, 0x11, 0x4d, 0x65, 0x73, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x41, 0x75, 0x74, 0x68, 0x7a, 0xf2, 0x01, 0x11, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d,
+	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_external_authz_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_external_authz_proto_rawDescData = file_mesh_v1alpha1_external_authz_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_external_authz_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_external_authz_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_external_authz_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_external_authz_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_external_authz_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_external_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_mesh_v1alpha1_external_authz_proto_goTypes = []interface{}{
+	(*MeshExternalAuthz)(nil),                  // 0: kuma.mesh.v1alpha1.MeshExternalAuthz
+	(*MeshExternalAuthz_Conf)(nil),             // 1: kuma.mesh.v1alpha1.MeshExternalAuthz.Conf
+	(*MeshExternalAuthz_Conf_GrpcService)(nil), // 2: kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.GrpcService
+	(*MeshExternalAuthz_Conf_HttpService)(nil), // 3: kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.HttpService
+	(*MeshExternalAuthz_Conf_RequestBody)(nil), // 4: kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.RequestBody
+	(*Selector)(nil),                           // 5: kuma.mesh.v1alpha1.Selector
+	(*durationpb.Duration)(nil),                // 6: google.protobuf.Duration
+}
+var file_mesh_v1alpha1_external_authz_proto_depIdxs = []int32{
+	5, // 0: kuma.mesh.v1alpha1.MeshExternalAuthz.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 1: kuma.mesh.v1alpha1.MeshExternalAuthz.conf:type_name -> kuma.mesh.v1alpha1.MeshExternalAuthz.Conf
+	2, // 2: kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.grpc:type_name -> kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.GrpcService
+	3, // 3: kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.http:type_name -> kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.HttpService
+	4, // 4: kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.requestBody:type_name -> kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.RequestBody
+	6, // 5: kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.GrpcService.timeout:type_name -> google.protobuf.Duration
+	6, // 6: kuma.mesh.v1alpha1.MeshExternalAuthz.Conf.HttpService.timeout:type_name -> google.protobuf.Duration
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_external_authz_proto_init() }
+func file_mesh_v1alpha1_external_authz_proto_init() {
+	if File_mesh_v1alpha1_external_authz_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_external_authz_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshExternalAuthz); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_external_authz_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshExternalAuthz_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_external_authz_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshExternalAuthz_Conf_GrpcService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_external_authz_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshExternalAuthz_Conf_HttpService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_external_authz_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshExternalAuthz_Conf_RequestBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mesh_v1alpha1_external_authz_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*MeshExternalAuthz_Conf_Grpc)(nil),
+		(*MeshExternalAuthz_Conf_Http)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_external_authz_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_external_authz_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_external_authz_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_external_authz_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_external_authz_proto = out.File
+	file_mesh_v1alpha1_external_authz_proto_rawDesc = nil
+	file_mesh_v1alpha1_external_authz_proto_goTypes = nil
+	file_mesh_v1alpha1_external_authz_proto_depIdxs = nil
+}
@@ -13,6 +13,7 @@ import (
 	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -25,6 +26,220 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ExternalService_Networking_TLS_SubjectAltName_MatchType int32
+
+const (
+	// Matches the whole value
+	ExternalService_Networking_TLS_SubjectAltName_EXACT ExternalService_Networking_TLS_SubjectAltName_MatchType = 0
+	// Matches the beginning of the value
+	ExternalService_Networking_TLS_SubjectAltName_PREFIX ExternalService_Networking_TLS_SubjectAltName_MatchType = 1
+	// Matches the end of the value
+	ExternalService_Networking_TLS_SubjectAltName_SUFFIX ExternalService_Networking_TLS_SubjectAltName_MatchType = 2
+	// Matches the value with RE2 regular expression
+	ExternalService_Networking_TLS_SubjectAltName_REGEX ExternalService_Networking_TLS_SubjectAltName_MatchType = 3
+)
+
+// Enum value maps for ExternalService_Networking_TLS_SubjectAltName_MatchType.
+var (
+	ExternalService_Networking_TLS_SubjectAltName_MatchType_name = map[int32]string{
+		0: "EXACT",
+		1: "PREFIX",
+		2: "SUFFIX",
+		3: "REGEX",
+	}
+	ExternalService_Networking_TLS_SubjectAltName_MatchType_value = map[string]int32{
+		"EXACT":  0,
+		"PREFIX": 1,
+		"SUFFIX": 2,
+		"REGEX":  3,
+	}
+)
+
+func (x ExternalService_Networking_TLS_SubjectAltName_MatchType) Enum() *ExternalService_Networking_TLS_SubjectAltName_MatchType {
+	p := new(ExternalService_Networking_TLS_SubjectAltName_MatchType)
+	*p = x
+	return p
+}
+
+func (x ExternalService_Networking_TLS_SubjectAltName_MatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExternalService_Networking_TLS_SubjectAltName_MatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_externalservice_proto_enumTypes[0].Descriptor()
+}
+
+func (ExternalService_Networking_TLS_SubjectAltName_MatchType) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_externalservice_proto_enumTypes[0]
+}
+
+func (x ExternalService_Networking_TLS_SubjectAltName_MatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExternalService_Networking_TLS_SubjectAltName_MatchType.Descriptor instead.
+func (ExternalService_Networking_TLS_SubjectAltName_MatchType) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_externalservice_proto_rawDescGZIP(), []int{0, 0, 0, 0, 0}
+}
+
+type ExternalService_Networking_ProxyProtocol_Version int32
+
+const (
+	// PROXY protocol version 1, human readable format
+	ExternalService_Networking_ProxyProtocol_V1 ExternalService_Networking_ProxyProtocol_Version = 0
+	// PROXY protocol version 2, binary format
+	ExternalService_Networking_ProxyProtocol_V2 ExternalService_Networking_ProxyProtocol_Version = 1
+)
+
+// Enum value maps for ExternalService_Networking_ProxyProtocol_Version.
+var (
+	ExternalService_Networking_ProxyProtocol_Version_name = map[int32]string{
+		0: "V1",
+		1: "V2",
+	}
+	ExternalService_Networking_ProxyProtocol_Version_value = map[string]int32{
+		"V1": 0,
+		"V2": 1,
+	}
+)
+
+func (x ExternalService_Networking_ProxyProtocol_Version) Enum() *ExternalService_Networking_ProxyProtocol_Version {
+	p := new(ExternalService_Networking_ProxyProtocol_Version)
+	*p = x
+	return p
+}
+
+func (x ExternalService_Networking_ProxyProtocol_Version) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExternalService_Networking_ProxyProtocol_Version) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_externalservice_proto_enumTypes[1].Descriptor()
+}
+
+func (ExternalService_Networking_ProxyProtocol_Version) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_externalservice_proto_enumTypes[1]
+}
+
+func (x ExternalService_Networking_ProxyProtocol_Version) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExternalService_Networking_ProxyProtocol_Version.Descriptor instead.
+func (ExternalService_Networking_ProxyProtocol_Version) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_externalservice_proto_rawDescGZIP(), []int{0, 0, 1, 0}
+}
+
+type ExternalService_Networking_DNS_DiscoveryType int32
+
+const (
+	// Resolves the address continuously and load balances between all the
+	// returned IP addresses
+	ExternalService_Networking_DNS_STRICT ExternalService_Networking_DNS_DiscoveryType = 0
+	// Uses only the first returned IP address for new connections, the
+	// existing connections are not drained when the address changes
+	ExternalService_Networking_DNS_LOGICAL ExternalService_Networking_DNS_DiscoveryType = 1
+)
+
+// Enum value maps for ExternalService_Networking_DNS_DiscoveryType.
+var (
+	ExternalService_Networking_DNS_DiscoveryType_name = map[int32]string{
+		0: "STRICT",
+		1: "LOGICAL",
+	}
+	ExternalService_Networking_DNS_DiscoveryType_value = map[string]int32{
+		"STRICT":  0,
+		"LOGICAL": 1,
+	}
+)
+
+func (x ExternalService_Networking_DNS_DiscoveryType) Enum() *ExternalService_Networking_DNS_DiscoveryType {
+	p := new(ExternalService_Networking_DNS_DiscoveryType)
+	*p = x
+	return p
+}
+
+func (x ExternalService_Networking_DNS_DiscoveryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExternalService_Networking_DNS_DiscoveryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_externalservice_proto_enumTypes[2].Descriptor()
+}
+
+func (ExternalService_Networking_DNS_DiscoveryType) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_externalservice_proto_enumTypes[2]
+}
+
+func (x ExternalService_Networking_DNS_DiscoveryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExternalService_Networking_DNS_DiscoveryType.Descriptor instead.
+func (ExternalService_Networking_DNS_DiscoveryType) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_externalservice_proto_rawDescGZIP(), []int{0, 0, 2, 0}
+}
+
+type ExternalService_Networking_DNS_LookupFamily int32
+
+const (
+	// AUTO for dataplanes with IPv6 address, V4_ONLY otherwise
+	ExternalService_Networking_DNS_DEFAULT ExternalService_Networking_DNS_LookupFamily = 0
+	// Looks up IPv6 addresses first and falls back to IPv4
+	ExternalService_Networking_DNS_AUTO ExternalService_Networking_DNS_LookupFamily = 1
+	// Looks up only IPv4 addresses
+	ExternalService_Networking_DNS_V4_ONLY ExternalService_Networking_DNS_LookupFamily = 2
+	// Looks up only IPv6 addresses
+	ExternalService_Networking_DNS_V6_ONLY ExternalService_Networking_DNS_LookupFamily = 3
+	// Looks up IPv4 addresses first and falls back to IPv6
+	ExternalService_Networking_DNS_V4_PREFERRED ExternalService_Networking_DNS_LookupFamily = 4
+)
+
+// Enum value maps for ExternalService_Networking_DNS_LookupFamily.
+var (
+	ExternalService_Networking_DNS_LookupFamily_name = map[int32]string{
+		0: "DEFAULT",
+		1: "AUTO",
+		2: "V4_ONLY",
+		3: "V6_ONLY",
+		4: "V4_PREFERRED",
+	}
+	ExternalService_Networking_DNS_LookupFamily_value = map[string]int32{
+		"DEFAULT":      0,
+		"AUTO":         1,
+		"V4_ONLY":      2,
+		"V6_ONLY":      3,
+		"V4_PREFERRED": 4,
+	}
+)
+
+func (x ExternalService_Networking_DNS_LookupFamily) Enum() *ExternalService_Networking_DNS_LookupFamily {
+	p := new(ExternalService_Networking_DNS_LookupFamily)
+	*p = x
+	return p
+}
+
+func (x ExternalService_Networking_DNS_LookupFamily) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExternalService_Networking_DNS_LookupFamily) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_externalservice_proto_enumTypes[3].Descriptor()
+}
+
+func (ExternalService_Networking_DNS_LookupFamily) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_externalservice_proto_enumTypes[3]
+}
+
+func (x ExternalService_Networking_DNS_LookupFamily) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExternalService_Networking_DNS_LookupFamily.Descriptor instead.
+func (ExternalService_Networking_DNS_LookupFamily) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_externalservice_proto_rawDescGZIP(), []int{0, 0, 2, 1}
+}
+
 // ExternalService defines configuration of the externally accessible service
 type ExternalService struct {
 	state         protoimpl.MessageState
@@ -92,6 +307,11 @@ type ExternalService_Networking struct {
 	// Address of the external service
 	Address string                          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Tls     *ExternalService_Networking_TLS `protobuf:"bytes,2,opt,name=tls,proto3" json:"tls,omitempty"`
+	// ProxyProtocol enables sending PROXY protocol header to the external
+	// service, so it can learn the original source address of the connection.
+	ProxyProtocol *ExternalService_Networking_ProxyProtocol `protobuf:"bytes,3,opt,name=proxyProtocol,proto3" json:"proxyProtocol,omitempty"`
+	// DNS defines how the address is resolved when it is a domain name.
+	Dns *ExternalService_Networking_DNS `protobuf:"bytes,4,opt,name=dns,proto3" json:"dns,omitempty"`
 }
 
 func (x *ExternalService_Networking) Reset() {
@@ -140,6 +360,20 @@ func (x *ExternalService_Networking) GetTls() *ExternalService_Networking_TLS {
 	return nil
 }
 
+func (x *ExternalService_Networking) GetProxyProtocol() *ExternalService_Networking_ProxyProtocol {
+	if x != nil {
+		return x.ProxyProtocol
+	}
+	return nil
+}
+
+func (x *ExternalService_Networking) GetDns() *ExternalService_Networking_DNS {
+	if x != nil {
+		return x.Dns
+	}
+	return nil
+}
+
 // TLS
 type ExternalService_Networking_TLS struct {
 	state         protoimpl.MessageState
@@ -162,6 +396,16 @@ type ExternalService_Networking_TLS struct {
 	// ServerName overrides the default Server Name Indicator set by Kuma.
 	// The default value is set to "address" specified in "networking".
 	ServerName *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// List of matchers of subject alternative names of the certificate
+	// presented by the external service, at least one of them has to match.
+	// If not specified and "caCert" is defined, the certificate has to be
+	// issued for the "address" specified in "networking".
+	SubjectAltNames []*ExternalService_Networking_TLS_SubjectAltName `protobuf:"bytes,7,rep,name=subject_alt_names,json=subjectAltNames,proto3" json:"subject_alt_names,omitempty"`
+	// List of base64 encoded SHA-256 hashes of the Subject Public Key
+	// Information of the certificate presented by the external service.
+	// If specified, the certificate has to match one of the hashes, even if
+	// "caCert" is not defined.
+	SpkiPins []string `protobuf:"bytes,8,rep,name=spki_pins,json=spkiPins,proto3" json:"spki_pins,omitempty"`
 }
 
 func (x *ExternalService_Networking_TLS) Reset() {
@@ -238,6 +482,217 @@ func (x *ExternalService_Networking_TLS) GetServerName() *wrapperspb.StringValue
 	return nil
 }
 
+func (x *ExternalService_Networking_TLS) GetSubjectAltNames() []*ExternalService_Networking_TLS_SubjectAltName {
+	if x != nil {
+		return x.SubjectAltNames
+	}
+	return nil
+}
+
+func (x *ExternalService_Networking_TLS) GetSpkiPins() []string {
+	if x != nil {
+		return x.SpkiPins
+	}
+	return nil
+}
+
+// ProxyProtocol
+type ExternalService_Networking_ProxyProtocol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version of the PROXY protocol header sent to the external service.
+	Version ExternalService_Networking_ProxyProtocol_Version `protobuf:"varint,1,opt,name=version,proto3,enum=kuma.mesh.v1alpha1.ExternalService_Networking_ProxyProtocol_Version" json:"version,omitempty"`
+}
+
+func (x *ExternalService_Networking_ProxyProtocol) Reset() {
+	*x = ExternalService_Networking_ProxyProtocol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_externalservice_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalService_Networking_ProxyProtocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalService_Networking_ProxyProtocol) ProtoMessage() {}
+
+func (x *ExternalService_Networking_ProxyProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_externalservice_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalService_Networking_ProxyProtocol.ProtoReflect.Descriptor instead.
+func (*ExternalService_Networking_ProxyProtocol) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_externalservice_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *ExternalService_Networking_ProxyProtocol) GetVersion() ExternalService_Networking_ProxyProtocol_Version {
+	if x != nil {
+		return x.Version
+	}
+	return ExternalService_Networking_ProxyProtocol_V1
+}
+
+// DNS
+type ExternalService_Networking_DNS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the DNS discovery of the external service endpoints.
+	Type ExternalService_Networking_DNS_DiscoveryType `protobuf:"varint,1,opt,name=type,proto3,enum=kuma.mesh.v1alpha1.ExternalService_Networking_DNS_DiscoveryType" json:"type,omitempty"`
+	// IP version of the addresses the address is resolved to.
+	LookupFamily ExternalService_Networking_DNS_LookupFamily `protobuf:"varint,2,opt,name=lookupFamily,proto3,enum=kuma.mesh.v1alpha1.ExternalService_Networking_DNS_LookupFamily" json:"lookupFamily,omitempty"`
+	// Interval of resolving the address, if not specified, the default of
+	// Envoy (5s) is used.
+	RefreshRate *durationpb.Duration `protobuf:"bytes,3,opt,name=refreshRate,proto3" json:"refreshRate,omitempty"`
+	// If true then the TTL of the DNS records is used as the interval of
+	// resolving the address instead of "refreshRate".
+	RespectDnsTtl bool `protobuf:"varint,4,opt,name=respectDnsTtl,proto3" json:"respectDnsTtl,omitempty"`
+	// List of addresses of DNS servers used to resolve the address, in the
+	// form of "ip" or "ip:port". If not specified, the resolvers of the
+	// system are used.
+	Resolvers []string `protobuf:"bytes,5,rep,name=resolvers,proto3" json:"resolvers,omitempty"`
+}
+
+func (x *ExternalService_Networking_DNS) Reset() {
+	*x = ExternalService_Networking_DNS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_externalservice_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalService_Networking_DNS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalService_Networking_DNS) ProtoMessage() {}
+
+func (x *ExternalService_Networking_DNS) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_externalservice_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalService_Networking_DNS.ProtoReflect.Descriptor instead.
+func (*ExternalService_Networking_DNS) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_externalservice_proto_rawDescGZIP(), []int{0, 0, 2}
+}
+
+func (x *ExternalService_Networking_DNS) GetType() ExternalService_Networking_DNS_DiscoveryType {
+	if x != nil {
+		return x.Type
+	}
+	return ExternalService_Networking_DNS_STRICT
+}
+
+func (x *ExternalService_Networking_DNS) GetLookupFamily() ExternalService_Networking_DNS_LookupFamily {
+	if x != nil {
+		return x.LookupFamily
+	}
+	return ExternalService_Networking_DNS_DEFAULT
+}
+
+func (x *ExternalService_Networking_DNS) GetRefreshRate() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshRate
+	}
+	return nil
+}
+
+func (x *ExternalService_Networking_DNS) GetRespectDnsTtl() bool {
+	if x != nil {
+		return x.RespectDnsTtl
+	}
+	return false
+}
+
+func (x *ExternalService_Networking_DNS) GetResolvers() []string {
+	if x != nil {
+		return x.Resolvers
+	}
+	return nil
+}
+
+// SubjectAltName defines how the subject alternative name of the
+// certificate presented by the external service is matched.
+type ExternalService_Networking_TLS_SubjectAltName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the match.
+	Match ExternalService_Networking_TLS_SubjectAltName_MatchType `protobuf:"varint,1,opt,name=match,proto3,enum=kuma.mesh.v1alpha1.ExternalService_Networking_TLS_SubjectAltName_MatchType" json:"match,omitempty"`
+	// Value to match the subject alternative name with.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ExternalService_Networking_TLS_SubjectAltName) Reset() {
+	*x = ExternalService_Networking_TLS_SubjectAltName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_externalservice_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalService_Networking_TLS_SubjectAltName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalService_Networking_TLS_SubjectAltName) ProtoMessage() {}
+
+func (x *ExternalService_Networking_TLS_SubjectAltName) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_externalservice_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalService_Networking_TLS_SubjectAltName.ProtoReflect.Descriptor instead.
+func (*ExternalService_Networking_TLS_SubjectAltName) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_externalservice_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *ExternalService_Networking_TLS_SubjectAltName) GetMatch() ExternalService_Networking_TLS_SubjectAltName_MatchType {
+	if x != nil {
+		return x.Match
+	}
+	return ExternalService_Networking_TLS_SubjectAltName_EXACT
+}
+
+func (x *ExternalService_Networking_TLS_SubjectAltName) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 var File_mesh_v1alpha1_externalservice_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_externalservice_proto_rawDesc = []byte{
@@ -248,11 +703,13 @@ var file_mesh_v1alpha1_externalservice_proto_rawDesc = []byte{
 	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76,
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x06, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x0f, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x72,
 	0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x0a, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
 	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
@@ -264,53 +721,122 @@ var file_mesh_v1alpha1_externalservice_proto_rawDesc = []byte{
 	0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0c, 0xfa,
 	0x42, 0x05, 0x9a, 0x01, 0x02, 0x08, 0x01, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x1a, 0xde, 0x03, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x73, 0x1a, 0xaf, 0x0c, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
 	0x12, 0x1e, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x12, 0x44, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
 	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x4c,
-	0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x1a, 0xe9, 0x02, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x12, 0x18,
-	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x61, 0x5f, 0x63,
-	0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x63, 0x61, 0x43,
-	0x65, 0x72, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65,
-	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d,
-	0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x4a, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x66, 0xaa, 0x8c, 0x89,
-	0xa6, 0x01, 0x19, 0x0a, 0x17, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6,
-	0x01, 0x11, 0x12, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa,
-	0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x14, 0x3a,
-	0x12, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x42, 0x55, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a,
-	0xb5, 0x18, 0x27, 0x50, 0x01, 0xa2, 0x01, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xf2, 0x01, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x62, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x0d, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x44, 0x0a, 0x03, 0x64, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x4e, 0x53, 0x52, 0x03, 0x64, 0x6e, 0x73,
+	0x1a, 0xc2, 0x05, 0x0a, 0x03, 0x54, 0x4c, 0x53, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x41, 0x0a,
+	0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74,
+	0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x4a, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f,
+	0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x6d, 0x0a, 0x11,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x41, 0x6c, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x41, 0x6c, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x70, 0x6b, 0x69, 0x5f, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x70, 0x6b, 0x69, 0x50, 0x69, 0x6e, 0x73, 0x1a, 0xca, 0x01, 0x0a, 0x0e, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x41, 0x6c, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x61, 0x0a, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4b, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x4c, 0x53, 0x2e, 0x53,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x6c, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x39, 0x0a, 0x09, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x53, 0x55, 0x46, 0x46, 0x49, 0x58, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45,
+	0x47, 0x45, 0x58, 0x10, 0x03, 0x1a, 0x8a, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x5e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x44, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x31, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x32,
+	0x10, 0x01, 0x1a, 0xbe, 0x03, 0x0a, 0x03, 0x44, 0x4e, 0x53, 0x12, 0x54, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x4e, 0x53, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x63, 0x0a, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x4e, 0x53, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x52, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x65, 0x63, 0x74, 0x44, 0x6e, 0x73,
+	0x54, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x44, 0x6e, 0x73, 0x54, 0x74, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x73, 0x22, 0x28, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x43,
+	0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01,
+	0x22, 0x51, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x34, 0x5f, 0x4f, 0x4e,
+	0x4c, 0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x36, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10,
+	0x03, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x34, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45,
+	0x44, 0x10, 0x04, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x66, 0xaa, 0x8c,
+	0x89, 0xa6, 0x01, 0x19, 0x0a, 0x17, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89,
+	0xa6, 0x01, 0x11, 0x12, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68,
+	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x14,
+	0x3a, 0x12, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x42, 0x55, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x8a, 0xb5, 0x18, 0x27, 0x50, 0x01, 0xa2, 0x01, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xf2, 0x01, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -325,30 +851,47 @@ func file_mesh_v1alpha1_externalservice_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_externalservice_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_externalservice_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mesh_v1alpha1_externalservice_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_mesh_v1alpha1_externalservice_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_mesh_v1alpha1_externalservice_proto_goTypes = []interface{}{
-	(*ExternalService)(nil),                // 0: kuma.mesh.v1alpha1.ExternalService
-	(*ExternalService_Networking)(nil),     // 1: kuma.mesh.v1alpha1.ExternalService.Networking
-	nil,                                    // 2: kuma.mesh.v1alpha1.ExternalService.TagsEntry
-	(*ExternalService_Networking_TLS)(nil), // 3: kuma.mesh.v1alpha1.ExternalService.Networking.TLS
-	(*v1alpha1.DataSource)(nil),            // 4: kuma.system.v1alpha1.DataSource
-	(*wrapperspb.BoolValue)(nil),           // 5: google.protobuf.BoolValue
-	(*wrapperspb.StringValue)(nil),         // 6: google.protobuf.StringValue
+	(ExternalService_Networking_TLS_SubjectAltName_MatchType)(0), // 0: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.SubjectAltName.MatchType
+	(ExternalService_Networking_ProxyProtocol_Version)(0),        // 1: kuma.mesh.v1alpha1.ExternalService.Networking.ProxyProtocol.Version
+	(ExternalService_Networking_DNS_DiscoveryType)(0),            // 2: kuma.mesh.v1alpha1.ExternalService.Networking.DNS.DiscoveryType
+	(ExternalService_Networking_DNS_LookupFamily)(0),             // 3: kuma.mesh.v1alpha1.ExternalService.Networking.DNS.LookupFamily
+	(*ExternalService)(nil),                                      // 4: kuma.mesh.v1alpha1.ExternalService
+	(*ExternalService_Networking)(nil),                           // 5: kuma.mesh.v1alpha1.ExternalService.Networking
+	nil,                                                          // 6: kuma.mesh.v1alpha1.ExternalService.TagsEntry
+	(*ExternalService_Networking_TLS)(nil),                       // 7: kuma.mesh.v1alpha1.ExternalService.Networking.TLS
+	(*ExternalService_Networking_ProxyProtocol)(nil),             // 8: kuma.mesh.v1alpha1.ExternalService.Networking.ProxyProtocol
+	(*ExternalService_Networking_DNS)(nil),                       // 9: kuma.mesh.v1alpha1.ExternalService.Networking.DNS
+	(*ExternalService_Networking_TLS_SubjectAltName)(nil),        // 10: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.SubjectAltName
+	(*v1alpha1.DataSource)(nil),                                  // 11: kuma.system.v1alpha1.DataSource
+	(*wrapperspb.BoolValue)(nil),                                 // 12: google.protobuf.BoolValue
+	(*wrapperspb.StringValue)(nil),                               // 13: google.protobuf.StringValue
+	(*durationpb.Duration)(nil),                                  // 14: google.protobuf.Duration
 }
 var file_mesh_v1alpha1_externalservice_proto_depIdxs = []int32{
-	1, // 0: kuma.mesh.v1alpha1.ExternalService.networking:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking
-	2, // 1: kuma.mesh.v1alpha1.ExternalService.tags:type_name -> kuma.mesh.v1alpha1.ExternalService.TagsEntry
-	3, // 2: kuma.mesh.v1alpha1.ExternalService.Networking.tls:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking.TLS
-	4, // 3: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.ca_cert:type_name -> kuma.system.v1alpha1.DataSource
-	4, // 4: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.client_cert:type_name -> kuma.system.v1alpha1.DataSource
-	4, // 5: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.client_key:type_name -> kuma.system.v1alpha1.DataSource
-	5, // 6: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.allowRenegotiation:type_name -> google.protobuf.BoolValue
-	6, // 7: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.server_name:type_name -> google.protobuf.StringValue
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	5,  // 0: kuma.mesh.v1alpha1.ExternalService.networking:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking
+	6,  // 1: kuma.mesh.v1alpha1.ExternalService.tags:type_name -> kuma.mesh.v1alpha1.ExternalService.TagsEntry
+	7,  // 2: kuma.mesh.v1alpha1.ExternalService.Networking.tls:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking.TLS
+	8,  // 3: kuma.mesh.v1alpha1.ExternalService.Networking.proxyProtocol:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking.ProxyProtocol
+	9,  // 4: kuma.mesh.v1alpha1.ExternalService.Networking.dns:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking.DNS
+	11, // 5: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.ca_cert:type_name -> kuma.system.v1alpha1.DataSource
+	11, // 6: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.client_cert:type_name -> kuma.system.v1alpha1.DataSource
+	11, // 7: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.client_key:type_name -> kuma.system.v1alpha1.DataSource
+	12, // 8: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.allowRenegotiation:type_name -> google.protobuf.BoolValue
+	13, // 9: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.server_name:type_name -> google.protobuf.StringValue
+	10, // 10: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.subject_alt_names:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking.TLS.SubjectAltName
+	1,  // 11: kuma.mesh.v1alpha1.ExternalService.Networking.ProxyProtocol.version:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking.ProxyProtocol.Version
+	2,  // 12: kuma.mesh.v1alpha1.ExternalService.Networking.DNS.type:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking.DNS.DiscoveryType
+	3,  // 13: kuma.mesh.v1alpha1.ExternalService.Networking.DNS.lookupFamily:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking.DNS.LookupFamily
+	14, // 14: kuma.mesh.v1alpha1.ExternalService.Networking.DNS.refreshRate:type_name -> google.protobuf.Duration
+	0,  // 15: kuma.mesh.v1alpha1.ExternalService.Networking.TLS.SubjectAltName.match:type_name -> kuma.mesh.v1alpha1.ExternalService.Networking.TLS.SubjectAltName.MatchType
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_externalservice_proto_init() }
@@ -393,19 +936,56 @@ func file_mesh_v1alpha1_externalservice_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_externalservice_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalService_Networking_ProxyProtocol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_externalservice_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalService_Networking_DNS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_externalservice_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalService_Networking_TLS_SubjectAltName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_externalservice_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_mesh_v1alpha1_externalservice_proto_goTypes,
 		DependencyIndexes: file_mesh_v1alpha1_externalservice_proto_depIdxs,
+		EnumInfos:         file_mesh_v1alpha1_externalservice_proto_enumTypes,
 		MessageInfos:      file_mesh_v1alpha1_externalservice_proto_msgTypes,
 	}.Build()
 	File_mesh_v1alpha1_externalservice_proto = out.File
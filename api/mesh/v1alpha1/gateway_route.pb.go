@@ -979,6 +979,7 @@ type MeshGatewayRoute_HttpRoute_Filter struct {
 	//	*MeshGatewayRoute_HttpRoute_Filter_Mirror_
 	//	*MeshGatewayRoute_HttpRoute_Filter_Redirect_
 	//	*MeshGatewayRoute_HttpRoute_Filter_Rewrite_
+	//	*MeshGatewayRoute_HttpRoute_Filter_DirectResponse_
 	Filter isMeshGatewayRoute_HttpRoute_Filter_Filter `protobuf_oneof:"filter"`
 }
 
@@ -1049,6 +1050,13 @@ func (x *MeshGatewayRoute_HttpRoute_Filter) GetRewrite() *MeshGatewayRoute_HttpR
 	return nil
 }
 
+func (x *MeshGatewayRoute_HttpRoute_Filter) GetDirectResponse() *MeshGatewayRoute_HttpRoute_Filter_DirectResponse {
+	if x, ok := x.GetFilter().(*MeshGatewayRoute_HttpRoute_Filter_DirectResponse_); ok {
+		return x.DirectResponse
+	}
+	return nil
+}
+
 type isMeshGatewayRoute_HttpRoute_Filter_Filter interface {
 	isMeshGatewayRoute_HttpRoute_Filter_Filter()
 }
@@ -1069,6 +1077,10 @@ type MeshGatewayRoute_HttpRoute_Filter_Rewrite_ struct {
 	Rewrite *MeshGatewayRoute_HttpRoute_Filter_Rewrite `protobuf:"bytes,4,opt,name=rewrite,proto3,oneof"`
 }
 
+type MeshGatewayRoute_HttpRoute_Filter_DirectResponse_ struct {
+	DirectResponse *MeshGatewayRoute_HttpRoute_Filter_DirectResponse `protobuf:"bytes,5,opt,name=direct_response,json=directResponse,proto3,oneof"`
+}
+
 func (*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_) isMeshGatewayRoute_HttpRoute_Filter_Filter() {
 }
 
@@ -1078,6 +1090,9 @@ func (*MeshGatewayRoute_HttpRoute_Filter_Redirect_) isMeshGatewayRoute_HttpRoute
 
 func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite_) isMeshGatewayRoute_HttpRoute_Filter_Filter() {}
 
+func (*MeshGatewayRoute_HttpRoute_Filter_DirectResponse_) isMeshGatewayRoute_HttpRoute_Filter_Filter() {
+}
+
 type MeshGatewayRoute_HttpRoute_Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1089,13 +1104,13 @@ type MeshGatewayRoute_HttpRoute_Rule struct {
 	// Filters are request processing steps that are applied to
 	// matched requests.
 	//
-	// If the redirect filter is specified, it must be the only
-	// filter given.
+	// If the redirect or direct response filter is specified, it must
+	// be the only filter given.
 	Filters []*MeshGatewayRoute_HttpRoute_Filter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
 	// Backends is the set of services to which the gateway will
-	// forward requests. If a redirect filter is specified, no
-	// backends are allowed. Otherwise, at least one backend
-	// must be given.
+	// forward requests. If a redirect or direct response filter is
+	// specified, no backends are allowed. Otherwise, at least one
+	// backend must be given.
 	Backends []*MeshGatewayRoute_Backend `protobuf:"bytes,3,rep,name=backends,proto3" json:"backends,omitempty"`
 }
 
@@ -1486,6 +1501,10 @@ type MeshGatewayRoute_HttpRoute_Filter_Redirect struct {
 	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
 	// The HTTP response status code. This must be in the range 300 - 308.
 	StatusCode uint32 `protobuf:"varint,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Path defines parameters used to modify the path of the incoming
+	// request in the redirect URL. If not given, the path of the request
+	// is kept.
+	Path *MeshGatewayRoute_HttpRoute_Filter_Rewrite `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
 }
 
 func (x *MeshGatewayRoute_HttpRoute_Filter_Redirect) Reset() {
@@ -1548,6 +1567,73 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_Redirect) GetStatusCode() uint32 {
 	return 0
 }
 
+func (x *MeshGatewayRoute_HttpRoute_Filter_Redirect) GetPath() *MeshGatewayRoute_HttpRoute_Filter_Rewrite {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+// The direct response filter responds to the HTTP request immediately,
+// without forwarding it to any backend. The response has the given
+// status code and body.
+type MeshGatewayRoute_HttpRoute_Filter_DirectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The HTTP response status code. This must be in the range 200 - 599.
+	StatusCode uint32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The body of the response.
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_DirectResponse) Reset() {
+	*x = MeshGatewayRoute_HttpRoute_Filter_DirectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_DirectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshGatewayRoute_HttpRoute_Filter_DirectResponse) ProtoMessage() {}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_DirectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshGatewayRoute_HttpRoute_Filter_DirectResponse.ProtoReflect.Descriptor instead.
+func (*MeshGatewayRoute_HttpRoute_Filter_DirectResponse) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 3}
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_DirectResponse) GetStatusCode() uint32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *MeshGatewayRoute_HttpRoute_Filter_DirectResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
 type MeshGatewayRoute_HttpRoute_Filter_Rewrite struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1562,7 +1648,7 @@ type MeshGatewayRoute_HttpRoute_Filter_Rewrite struct {
 func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) Reset() {
 	*x = MeshGatewayRoute_HttpRoute_Filter_Rewrite{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[23]
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1575,7 +1661,7 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) String() string {
 func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite) ProtoMessage() {}
 
 func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[23]
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1588,7 +1674,7 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_Rewrite) ProtoReflect() protoreflect.
 
 // Deprecated: Use MeshGatewayRoute_HttpRoute_Filter_Rewrite.ProtoReflect.Descriptor instead.
 func (*MeshGatewayRoute_HttpRoute_Filter_Rewrite) Descriptor() ([]byte, []int) {
-	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 3}
+	return file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP(), []int{0, 4, 1, 4}
 }
 
 func (m *MeshGatewayRoute_HttpRoute_Filter_Rewrite) GetPath() isMeshGatewayRoute_HttpRoute_Filter_Rewrite_Path {
@@ -1642,7 +1728,7 @@ type MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header struct {
 func (x *MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header) Reset() {
 	*x = MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[24]
+		mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1655,7 +1741,7 @@ func (x *MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header) String() string
 func (*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header) ProtoMessage() {}
 
 func (x *MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[24]
+	mi := &file_mesh_v1alpha1_gateway_route_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1701,7 +1787,7 @@ var file_mesh_v1alpha1_gateway_route_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
 	0x68, 0x74, 0x74, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x89, 0x21, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x6f, 0x22, 0xa5, 0x23, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
 	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
 	0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
 	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
@@ -1783,7 +1869,7 @@ var file_mesh_v1alpha1_gateway_route_proto_rawDesc = []byte{
 	0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42,
 	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92,
 	0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a, 0x04,
-	0x88, 0xb5, 0x18, 0x01, 0x1a, 0xdb, 0x13, 0x0a, 0x09, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75,
+	0x88, 0xb5, 0x18, 0x01, 0x1a, 0xf7, 0x15, 0x0a, 0x09, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75,
 	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73,
 	0x12, 0x57, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
@@ -1850,7 +1936,7 @@ var file_mesh_v1alpha1_gateway_route_proto_rawDesc = []byte{
 	0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x76, 0x61,
 	0x6c, 0x75, 0x65, 0x22, 0x21, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65,
 	0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x52,
-	0x45, 0x47, 0x45, 0x58, 0x10, 0x01, 0x1a, 0xa1, 0x09, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x45, 0x47, 0x45, 0x58, 0x10, 0x01, 0x1a, 0xbd, 0x0b, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65,
 	0x72, 0x12, 0x6c, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61,
 	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
 	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
@@ -1875,103 +1961,121 @@ var file_mesh_v1alpha1_gateway_route_proto_rawDesc = []byte{
 	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74,
 	0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65,
 	0x77, 0x72, 0x69, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x1a, 0xb3, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x12, 0x5c, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x4a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03, 0x73, 0x65, 0x74,
-	0x12, 0x5c, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e,
-	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x4e, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xb0, 0x01, 0x0a, 0x06, 0x4d, 0x69, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x54, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x50, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x12, 0x88, 0xb5, 0x18, 0x01, 0xfa,
-	0x42, 0x0b, 0x12, 0x09, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x0a, 0x70,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0xb8, 0x01, 0x0a, 0x08, 0x52, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a,
-	0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x08,
-	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x68, 0x6f,
-	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1c, 0x88, 0xb5, 0x18, 0x01,
-	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x28, 0xac, 0x02,
-	0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18, 0xb4, 0x02, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x1a, 0x6a, 0x0a, 0x07, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12,
-	0x23, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x46, 0x75, 0x6c, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x85, 0x02, 0x0a, 0x04, 0x52,
-	0x75, 0x6c, 0x65, 0x12, 0x5c, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01,
-	0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
-	0x73, 0x12, 0x4f, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0x4e, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x12, 0x6f, 0x0a, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x1a, 0xb3, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x4a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03, 0x73, 0x65,
+	0x74, 0x12, 0x5c, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x4e, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xb0, 0x01, 0x0a, 0x06, 0x4d, 0x69, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x54, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x50, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x12, 0x88, 0xb5, 0x18, 0x01,
+	0xfa, 0x42, 0x0b, 0x12, 0x09, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0x8b, 0x02, 0x0a, 0x08, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x10, 0xff, 0xff, 0x03,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1c, 0x88, 0xb5, 0x18,
+	0x01, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x28, 0xac,
+	0x02, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18, 0xb4, 0x02, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x51, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x56, 0x0a, 0x0e, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x0f, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x08, 0x2a, 0x06, 0x18, 0xd7, 0x04, 0x28, 0xc8, 0x01,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x1a, 0x6a, 0x0a, 0x07, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x46, 0x75, 0x6c, 0x6c,
+	0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x42, 0x08, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x85, 0x02, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x5c, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x0c, 0x88, 0xb5, 0x18, 0x01, 0xfa, 0x42, 0x05, 0x92,
+	0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x4f, 0x0a,
+	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4e,
+	0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x42, 0x04,
+	0x88, 0xb5, 0x18, 0x00, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x9e,
+	0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x41, 0x0a, 0x03, 0x75, 0x64, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x00, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x73, 0x1a, 0x9e, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x41, 0x0a, 0x03, 0x75,
-	0x64, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
-	0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55,
-	0x64, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x75, 0x64, 0x70, 0x12, 0x41,
-	0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63,
-	0x70, 0x12, 0x41, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x55, 0x64, 0x70, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x75, 0x64, 0x70, 0x12, 0x41, 0x0a, 0x03, 0x74, 0x63,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x63,
+	0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x41, 0x0a,
+	0x03, 0x74, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x03, 0x74, 0x6c, 0x73,
+	0x12, 0x44, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
 	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52,
-	0x03, 0x74, 0x6c, 0x73, 0x12, 0x44, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x3a, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x1a, 0x0a, 0x18, 0x4d, 0x65, 0x73,
-	0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x12, 0x12, 0x10, 0x4d, 0x65, 0x73,
-	0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xaa, 0x8c, 0x89,
-	0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52,
-	0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x14, 0x3a, 0x12, 0x0a, 0x10, 0x6d, 0x65, 0x73,
-	0x68, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x56, 0x5a,
-	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61,
-	0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68,
-	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x28, 0x50, 0x01, 0xa2,
-	0x01, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0xf2, 0x01, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00,
+	0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x3a,
+	0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x1a, 0x0a, 0x18, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x12, 0x12, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22,
+	0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x14, 0x3a, 0x12, 0x0a, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x56, 0x5a, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x28, 0x50, 0x01, 0xa2, 0x01, 0x10, 0x4d, 0x65,
+	0x73, 0x68, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0xf2, 0x01,
+	0x10, 0x6d, 0x65, 0x73, 0x68, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1987,7 +2091,7 @@ func file_mesh_v1alpha1_gateway_route_proto_rawDescGZIP() []byte {
 }
 
 var file_mesh_v1alpha1_gateway_route_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_mesh_v1alpha1_gateway_route_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_mesh_v1alpha1_gateway_route_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_mesh_v1alpha1_gateway_route_proto_goTypes = []interface{}{
 	(MeshGatewayRoute_HttpRoute_Match_Path_MatchType)(0),           // 0: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.MatchType
 	(MeshGatewayRoute_HttpRoute_Match_Header_MatchType)(0),         // 1: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.MatchType
@@ -2015,14 +2119,15 @@ var file_mesh_v1alpha1_gateway_route_proto_goTypes = []interface{}{
 	(*MeshGatewayRoute_HttpRoute_Filter_RequestHeader)(nil),        // 23: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader
 	(*MeshGatewayRoute_HttpRoute_Filter_Mirror)(nil),               // 24: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror
 	(*MeshGatewayRoute_HttpRoute_Filter_Redirect)(nil),             // 25: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Redirect
-	(*MeshGatewayRoute_HttpRoute_Filter_Rewrite)(nil),              // 26: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Rewrite
-	(*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header)(nil), // 27: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
-	(*Selector)(nil),                                               // 28: kuma.mesh.v1alpha1.Selector
-	(HttpMethod)(0),                                                // 29: kuma.mesh.v1alpha1.HttpMethod
-	(*wrapperspb.DoubleValue)(nil),                                 // 30: google.protobuf.DoubleValue
+	(*MeshGatewayRoute_HttpRoute_Filter_DirectResponse)(nil),       // 26: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.DirectResponse
+	(*MeshGatewayRoute_HttpRoute_Filter_Rewrite)(nil),              // 27: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Rewrite
+	(*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header)(nil), // 28: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	(*Selector)(nil),                                               // 29: kuma.mesh.v1alpha1.Selector
+	(HttpMethod)(0),                                                // 30: kuma.mesh.v1alpha1.HttpMethod
+	(*wrapperspb.DoubleValue)(nil),                                 // 31: google.protobuf.DoubleValue
 }
 var file_mesh_v1alpha1_gateway_route_proto_depIdxs = []int32{
-	28, // 0: kuma.mesh.v1alpha1.MeshGatewayRoute.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	29, // 0: kuma.mesh.v1alpha1.MeshGatewayRoute.selectors:type_name -> kuma.mesh.v1alpha1.Selector
 	9,  // 1: kuma.mesh.v1alpha1.MeshGatewayRoute.conf:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Conf
 	10, // 2: kuma.mesh.v1alpha1.MeshGatewayRoute.Backend.destination:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend.DestinationEntry
 	12, // 3: kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.rules:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.UdpRoute.Rule
@@ -2040,28 +2145,30 @@ var file_mesh_v1alpha1_gateway_route_proto_depIdxs = []int32{
 	15, // 15: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Match
 	4,  // 16: kuma.mesh.v1alpha1.MeshGatewayRoute.TlsRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
 	20, // 17: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.path:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path
-	29, // 18: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.method:type_name -> kuma.mesh.v1alpha1.HttpMethod
+	30, // 18: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.method:type_name -> kuma.mesh.v1alpha1.HttpMethod
 	21, // 19: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.headers:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header
 	22, // 20: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.query_parameters:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query
 	23, // 21: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.request_header:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader
 	24, // 22: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.mirror:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror
 	25, // 23: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.redirect:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Redirect
-	26, // 24: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.rewrite:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Rewrite
-	17, // 25: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match
-	18, // 26: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.filters:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter
-	4,  // 27: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
-	0,  // 28: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.MatchType
-	1,  // 29: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.MatchType
-	2,  // 30: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query.MatchType
-	27, // 31: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.set:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
-	27, // 32: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.add:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
-	4,  // 33: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror.backend:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
-	30, // 34: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror.percentage:type_name -> google.protobuf.DoubleValue
-	35, // [35:35] is the sub-list for method output_type
-	35, // [35:35] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	27, // 24: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.rewrite:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Rewrite
+	26, // 25: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.direct_response:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.DirectResponse
+	17, // 26: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.matches:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match
+	18, // 27: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.filters:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter
+	4,  // 28: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Rule.backends:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
+	0,  // 29: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Path.MatchType
+	1,  // 30: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Header.MatchType
+	2,  // 31: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query.match:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Match.Query.MatchType
+	28, // 32: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.set:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	28, // 33: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.add:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.RequestHeader.Header
+	4,  // 34: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror.backend:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.Backend
+	31, // 35: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Mirror.percentage:type_name -> google.protobuf.DoubleValue
+	27, // 36: kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Redirect.path:type_name -> kuma.mesh.v1alpha1.MeshGatewayRoute.HttpRoute.Filter.Rewrite
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_gateway_route_proto_init() }
@@ -2337,7 +2444,7 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_Rewrite); i {
+			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_DirectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2349,6 +2456,18 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_gateway_route_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_Rewrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_gateway_route_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MeshGatewayRoute_HttpRoute_Filter_RequestHeader_Header); i {
 			case 0:
 				return &v.state
@@ -2372,8 +2491,9 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 		(*MeshGatewayRoute_HttpRoute_Filter_Mirror_)(nil),
 		(*MeshGatewayRoute_HttpRoute_Filter_Redirect_)(nil),
 		(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_)(nil),
+		(*MeshGatewayRoute_HttpRoute_Filter_DirectResponse_)(nil),
 	}
-	file_mesh_v1alpha1_gateway_route_proto_msgTypes[23].OneofWrappers = []interface{}{
+	file_mesh_v1alpha1_gateway_route_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull)(nil),
 		(*MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch)(nil),
 	}
@@ -2383,7 +2503,7 @@ func file_mesh_v1alpha1_gateway_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_gateway_route_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   25,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/header_modifier.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MeshHeaderModifier defines how headers of requests and responses passing
+// through the listeners of the selected dataplanes are modified.
+type MeshHeaderModifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes.
+	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Configuration of the header modifications.
+	Conf *MeshHeaderModifier_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *MeshHeaderModifier) Reset() {
+	*x = MeshHeaderModifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_header_modifier_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshHeaderModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshHeaderModifier) ProtoMessage() {}
+
+func (x *MeshHeaderModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_header_modifier_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshHeaderModifier.ProtoReflect.Descriptor instead.
+func (*MeshHeaderModifier) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_header_modifier_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeshHeaderModifier) GetSelectors() []*Selector {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *MeshHeaderModifier) GetConf() *MeshHeaderModifier_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines the header modifications.
+type MeshHeaderModifier_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Modifications of the traffic received by HTTP inbound listeners.
+	Inbound *MeshHeaderModifier_Conf_Modifications `protobuf:"bytes,1,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// Modifications of the traffic sent through HTTP outbound listeners.
+	Outbound *MeshHeaderModifier_Conf_Modifications `protobuf:"bytes,2,opt,name=outbound,proto3" json:"outbound,omitempty"`
+}
+
+func (x *MeshHeaderModifier_Conf) Reset() {
+	*x = MeshHeaderModifier_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_header_modifier_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshHeaderModifier_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshHeaderModifier_Conf) ProtoMessage() {}
+
+func (x *MeshHeaderModifier_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_header_modifier_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshHeaderModifier_Conf.ProtoReflect.Descriptor instead.
+func (*MeshHeaderModifier_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_header_modifier_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MeshHeaderModifier_Conf) GetInbound() *MeshHeaderModifier_Conf_Modifications {
+	if x != nil {
+		return x.Inbound
+	}
+	return nil
+}
+
+func (x *MeshHeaderModifier_Conf) GetOutbound() *MeshHeaderModifier_Conf_Modifications {
+	if x != nil {
+		return x.Outbound
+	}
+	return nil
+}
+
+// Headers defines operations on HTTP headers.
+type MeshHeaderModifier_Conf_Headers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Headers appended to the existing values of the header.
+	Add []*MeshHeaderModifier_Conf_Headers_Header `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`
+	// Headers replacing all existing values of the header.
+	Set []*MeshHeaderModifier_Conf_Headers_Header `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty"`
+	// Names of headers to remove.
+	Remove []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (x *MeshHeaderModifier_Conf_Headers) Reset() {
+	*x = MeshHeaderModifier_Conf_Headers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_header_modifier_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshHeaderModifier_Conf_Headers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshHeaderModifier_Conf_Headers) ProtoMessage() {}
+
+func (x *MeshHeaderModifier_Conf_Headers) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_header_modifier_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshHeaderModifier_Conf_Headers.ProtoReflect.Descriptor instead.
+func (*MeshHeaderModifier_Conf_Headers) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_header_modifier_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *MeshHeaderModifier_Conf_Headers) GetAdd() []*MeshHeaderModifier_Conf_Headers_Header {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *MeshHeaderModifier_Conf_Headers) GetSet() []*MeshHeaderModifier_Conf_Headers_Header {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *MeshHeaderModifier_Conf_Headers) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+// Modifications defines operations on the headers of requests and
+// responses.
+type MeshHeaderModifier_Conf_Modifications struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Operations on the headers of requests.
+	RequestHeaders *MeshHeaderModifier_Conf_Headers `protobuf:"bytes,1,opt,name=requestHeaders,proto3" json:"requestHeaders,omitempty"`
+	// Operations on the headers of responses.
+	ResponseHeaders *MeshHeaderModifier_Conf_Headers `protobuf:"bytes,2,opt,name=responseHeaders,proto3" json:"responseHeaders,omitempty"`
+}
+
+func (x *MeshHeaderModifier_Conf_Modifications) Reset() {
+	*x = MeshHeaderModifier_Conf_Modifications{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_header_modifier_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshHeaderModifier_Conf_Modifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshHeaderModifier_Conf_Modifications) ProtoMessage() {}
+
+func (x *MeshHeaderModifier_Conf_Modifications) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_header_modifier_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshHeaderModifier_Conf_Modifications.ProtoReflect.Descriptor instead.
+func (*MeshHeaderModifier_Conf_Modifications) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_header_modifier_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *MeshHeaderModifier_Conf_Modifications) GetRequestHeaders() *MeshHeaderModifier_Conf_Headers {
+	if x != nil {
+		return x.RequestHeaders
+	}
+	return nil
+}
+
+func (x *MeshHeaderModifier_Conf_Modifications) GetResponseHeaders() *MeshHeaderModifier_Conf_Headers {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+type MeshHeaderModifier_Conf_Headers_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the header.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Value of the header, Envoy command operators like
+	// %DOWNSTREAM_REMOTE_ADDRESS% can be used.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MeshHeaderModifier_Conf_Headers_Header) Reset() {
+	*x = MeshHeaderModifier_Conf_Headers_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_header_modifier_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshHeaderModifier_Conf_Headers_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshHeaderModifier_Conf_Headers_Header) ProtoMessage() {}
+
+func (x *MeshHeaderModifier_Conf_Headers_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_header_modifier_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshHeaderModifier_Conf_Headers_Header.ProtoReflect.Descriptor instead.
+func (*MeshHeaderModifier_Conf_Headers_Header) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_header_modifier_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *MeshHeaderModifier_Conf_Headers_Header) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeshHeaderModifier_Conf_Headers_Header) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_mesh_v1alpha1_header_modifier_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_header_modifier_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d,
+	0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x07, 0x0a, 0x12, 0x4d, 0x65,
+	0x73, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x45, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0x80, 0x05, 0x0a, 0x04, 0x43, 0x6f,
+	0x6e, 0x66, 0x12, 0x53, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07,
+	0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x55, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0xfd,
+	0x01, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x03, 0x61, 0x64,
+	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x4c, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x3e,
+	0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xcb,
+	0x01, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x5b, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
+	0x73, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x0e, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x5d, 0x0a,
+	0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x6d, 0xaa, 0x8c,
+	0x89, 0xa6, 0x01, 0x67, 0x0a, 0x1a, 0x4d, 0x65, 0x73, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x12, 0x4d, 0x65, 0x73, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x3a, 0x29, 0x0a, 0x12, 0x6d, 0x65,
+	0x73, 0x68, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x13, 0x6d, 0x65, 0x73, 0x68, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x02, 0x10, 0x01, 0x68, 0x01, 0x42, 0x5a, 0x8a, 0xb5, 0x18,
+	0x2c, 0x50, 0x01, 0xa2, 0x01, 0x12, 0x4d, 0x65, 0x73, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0xf2, 0x01, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5a, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71,
+	0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_header_modifier_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_header_modifier_proto_rawDescData = file_mesh_v1alpha1_header_modifier_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_header_modifier_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_header_modifier_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_header_modifier_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_header_modifier_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_header_modifier_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_header_modifier_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_mesh_v1alpha1_header_modifier_proto_goTypes = []interface{}{
+	(*MeshHeaderModifier)(nil),                     // 0: kuma.mesh.v1alpha1.MeshHeaderModifier
+	(*MeshHeaderModifier_Conf)(nil),                // 1: kuma.mesh.v1alpha1.MeshHeaderModifier.Conf
+	(*MeshHeaderModifier_Conf_Headers)(nil),        // 2: kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Headers
+	(*MeshHeaderModifier_Conf_Modifications)(nil),  // 3: kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Modifications
+	(*MeshHeaderModifier_Conf_Headers_Header)(nil), // 4: kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Headers.Header
+	(*Selector)(nil),                               // 5: kuma.mesh.v1alpha1.Selector
+}
+var file_mesh_v1alpha1_header_modifier_proto_depIdxs = []int32{
+	5, // 0: kuma.mesh.v1alpha1.MeshHeaderModifier.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 1: kuma.mesh.v1alpha1.MeshHeaderModifier.conf:type_name -> kuma.mesh.v1alpha1.MeshHeaderModifier.Conf
+	3, // 2: kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.inbound:type_name -> kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Modifications
+	3, // 3: kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.outbound:type_name -> kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Modifications
+	4, // 4: kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Headers.add:type_name -> kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Headers.Header
+	4, // 5: kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Headers.set:type_name -> kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Headers.Header
+	2, // 6: kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Modifications.requestHeaders:type_name -> kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Headers
+	2, // 7: kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Modifications.responseHeaders:type_name -> kuma.mesh.v1alpha1.MeshHeaderModifier.Conf.Headers
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_header_modifier_proto_init() }
+func file_mesh_v1alpha1_header_modifier_proto_init() {
+	if File_mesh_v1alpha1_header_modifier_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_header_modifier_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshHeaderModifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_header_modifier_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshHeaderModifier_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_header_modifier_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshHeaderModifier_Conf_Headers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_header_modifier_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshHeaderModifier_Conf_Modifications); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_header_modifier_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshHeaderModifier_Conf_Headers_Header); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_header_modifier_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_header_modifier_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_header_modifier_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_header_modifier_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_header_modifier_proto = out.File
+	file_mesh_v1alpha1_header_modifier_proto_rawDesc = nil
+	file_mesh_v1alpha1_header_modifier_proto_goTypes = nil
+	file_mesh_v1alpha1_header_modifier_proto_depIdxs = nil
+}
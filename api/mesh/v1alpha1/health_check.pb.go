@@ -351,7 +351,9 @@ func (x *HealthCheck_Conf_Tcp) GetReceive() []*wrapperspb.BytesValue {
 // Http defines optional Http configuration which will instruct the service
 // the health check will be made for is an http service. It's mutually
 // exclusive with the Tcp block so when provided you can't provide
-// the Tcp configuration
+// the Tcp configuration. For services with "kuma.io/protocol: grpc" it's
+// translated to the gRPC health check (grpc.health.v1.Health) and the path
+// without the leading slash is used as the name of the checked service.
 type HealthCheck_Conf_Http struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -0,0 +1,710 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/jwt.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	v1alpha1 "github.com/kumahq/kuma/api/system/v1alpha1"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MeshJWT defines how JSON Web Tokens of HTTP requests received by the inbound
+// listeners of the selected dataplanes are validated. Requests with a missing
+// or invalid token are rejected with 401 before they reach the service.
+type MeshJWT struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes.
+	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Configuration of the token validation.
+	Conf *MeshJWT_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *MeshJWT) Reset() {
+	*x = MeshJWT{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshJWT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshJWT) ProtoMessage() {}
+
+func (x *MeshJWT) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshJWT.ProtoReflect.Descriptor instead.
+func (*MeshJWT) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_jwt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeshJWT) GetSelectors() []*Selector {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *MeshJWT) GetConf() *MeshJWT_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines the providers of tokens and the requirements.
+type MeshJWT_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Providers of the tokens.
+	Providers []*MeshJWT_Conf_Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	// Requirements of the requests. If empty, a valid token of any of the
+	// providers is required for all requests.
+	Rules []*MeshJWT_Conf_Rule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *MeshJWT_Conf) Reset() {
+	*x = MeshJWT_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshJWT_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshJWT_Conf) ProtoMessage() {}
+
+func (x *MeshJWT_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshJWT_Conf.ProtoReflect.Descriptor instead.
+func (*MeshJWT_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_jwt_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MeshJWT_Conf) GetProviders() []*MeshJWT_Conf_Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *MeshJWT_Conf) GetRules() []*MeshJWT_Conf_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Provider defines how tokens of a single issuer are validated. The token
+// is taken from the Authorization header with the Bearer prefix.
+type MeshJWT_Conf_Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the provider, referenced by the rules.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// If defined, the iss claim of the token has to be equal to the issuer.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// If defined, the aud claim of the token has to contain one of the
+	// audiences.
+	Audiences []string `protobuf:"bytes,3,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	// Types that are assignable to Jwks:
+	//	*MeshJWT_Conf_Provider_RemoteJwks_
+	//	*MeshJWT_Conf_Provider_LocalJwks
+	Jwks isMeshJWT_Conf_Provider_Jwks `protobuf_oneof:"jwks"`
+	// Claims of the validated token copied to the headers of the request.
+	ClaimToHeaders []*MeshJWT_Conf_Provider_ClaimToHeader `protobuf:"bytes,6,rep,name=claimToHeaders,proto3" json:"claimToHeaders,omitempty"`
+	// If true, the token is forwarded to the service, otherwise it's
+	// removed from the request.
+	Forward bool `protobuf:"varint,7,opt,name=forward,proto3" json:"forward,omitempty"`
+}
+
+func (x *MeshJWT_Conf_Provider) Reset() {
+	*x = MeshJWT_Conf_Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshJWT_Conf_Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshJWT_Conf_Provider) ProtoMessage() {}
+
+func (x *MeshJWT_Conf_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshJWT_Conf_Provider.ProtoReflect.Descriptor instead.
+func (*MeshJWT_Conf_Provider) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_jwt_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *MeshJWT_Conf_Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeshJWT_Conf_Provider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *MeshJWT_Conf_Provider) GetAudiences() []string {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+func (m *MeshJWT_Conf_Provider) GetJwks() isMeshJWT_Conf_Provider_Jwks {
+	if m != nil {
+		return m.Jwks
+	}
+	return nil
+}
+
+func (x *MeshJWT_Conf_Provider) GetRemoteJwks() *MeshJWT_Conf_Provider_RemoteJwks {
+	if x, ok := x.GetJwks().(*MeshJWT_Conf_Provider_RemoteJwks_); ok {
+		return x.RemoteJwks
+	}
+	return nil
+}
+
+func (x *MeshJWT_Conf_Provider) GetLocalJwks() *v1alpha1.DataSource {
+	if x, ok := x.GetJwks().(*MeshJWT_Conf_Provider_LocalJwks); ok {
+		return x.LocalJwks
+	}
+	return nil
+}
+
+func (x *MeshJWT_Conf_Provider) GetClaimToHeaders() []*MeshJWT_Conf_Provider_ClaimToHeader {
+	if x != nil {
+		return x.ClaimToHeaders
+	}
+	return nil
+}
+
+func (x *MeshJWT_Conf_Provider) GetForward() bool {
+	if x != nil {
+		return x.Forward
+	}
+	return false
+}
+
+type isMeshJWT_Conf_Provider_Jwks interface {
+	isMeshJWT_Conf_Provider_Jwks()
+}
+
+type MeshJWT_Conf_Provider_RemoteJwks_ struct {
+	// Key set fetched by Envoy.
+	RemoteJwks *MeshJWT_Conf_Provider_RemoteJwks `protobuf:"bytes,4,opt,name=remoteJwks,proto3,oneof"`
+}
+
+type MeshJWT_Conf_Provider_LocalJwks struct {
+	// Key set loaded by the control plane from a mesh Secret or inline
+	// bytes and sent to Envoy in the listener configuration.
+	LocalJwks *v1alpha1.DataSource `protobuf:"bytes,5,opt,name=localJwks,proto3,oneof"`
+}
+
+func (*MeshJWT_Conf_Provider_RemoteJwks_) isMeshJWT_Conf_Provider_Jwks() {}
+
+func (*MeshJWT_Conf_Provider_LocalJwks) isMeshJWT_Conf_Provider_Jwks() {}
+
+// Rule defines which providers are required for the requests matching
+// the path prefix. Rules are evaluated in order, the first matching rule
+// is applied.
+type MeshJWT_Conf_Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Prefix of the path of the request.
+	PathPrefix string `protobuf:"bytes,1,opt,name=pathPrefix,proto3" json:"pathPrefix,omitempty"`
+	// A valid token of any of the providers is required. If empty, the
+	// requests are not validated.
+	Providers []string `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *MeshJWT_Conf_Rule) Reset() {
+	*x = MeshJWT_Conf_Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshJWT_Conf_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshJWT_Conf_Rule) ProtoMessage() {}
+
+func (x *MeshJWT_Conf_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshJWT_Conf_Rule.ProtoReflect.Descriptor instead.
+func (*MeshJWT_Conf_Rule) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_jwt_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *MeshJWT_Conf_Rule) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *MeshJWT_Conf_Rule) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// RemoteJwks defines JSON Web Key Set fetched by Envoy over HTTP(S).
+type MeshJWT_Conf_Provider_RemoteJwks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL of the JSON Web Key Set.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// If defined, the key set is fetched through the mesh from the
+	// service with the kuma.io/service tag, otherwise it's fetched
+	// directly from the host of the URL. The service has to be reachable
+	// from the dataplane.
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Timeout of fetching the key set. Defaults to 5s.
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Duration after which the fetched key set expires. Defaults to 5m.
+	CacheDuration *durationpb.Duration `protobuf:"bytes,4,opt,name=cacheDuration,proto3" json:"cacheDuration,omitempty"`
+}
+
+func (x *MeshJWT_Conf_Provider_RemoteJwks) Reset() {
+	*x = MeshJWT_Conf_Provider_RemoteJwks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshJWT_Conf_Provider_RemoteJwks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshJWT_Conf_Provider_RemoteJwks) ProtoMessage() {}
+
+func (x *MeshJWT_Conf_Provider_RemoteJwks) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshJWT_Conf_Provider_RemoteJwks.ProtoReflect.Descriptor instead.
+func (*MeshJWT_Conf_Provider_RemoteJwks) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_jwt_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *MeshJWT_Conf_Provider_RemoteJwks) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MeshJWT_Conf_Provider_RemoteJwks) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *MeshJWT_Conf_Provider_RemoteJwks) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *MeshJWT_Conf_Provider_RemoteJwks) GetCacheDuration() *durationpb.Duration {
+	if x != nil {
+		return x.CacheDuration
+	}
+	return nil
+}
+
+// ClaimToHeader defines a claim of the validated token copied to the
+// header of the request.
+type MeshJWT_Conf_Provider_ClaimToHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the claim. Only top level claims with string values are
+	// supported.
+	Claim string `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	// Name of the header. The header sent by the client is removed, so
+	// the service can trust its value.
+	Header string `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (x *MeshJWT_Conf_Provider_ClaimToHeader) Reset() {
+	*x = MeshJWT_Conf_Provider_ClaimToHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshJWT_Conf_Provider_ClaimToHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshJWT_Conf_Provider_ClaimToHeader) ProtoMessage() {}
+
+func (x *MeshJWT_Conf_Provider_ClaimToHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_jwt_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshJWT_Conf_Provider_ClaimToHeader.ProtoReflect.Descriptor instead.
+func (*MeshJWT_Conf_Provider_ClaimToHeader) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_jwt_proto_rawDescGZIP(), []int{0, 0, 0, 1}
+}
+
+func (x *MeshJWT_Conf_Provider_ClaimToHeader) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *MeshJWT_Conf_Provider_ClaimToHeader) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+var File_mesh_v1alpha1_jwt_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_jwt_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x6a, 0x77, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d,
+	0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa7, 0x08, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x12, 0x40, 0x0a, 0x09, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5,
+	0x18, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3a, 0x0a,
+	0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xda, 0x06, 0x0a, 0x04, 0x43, 0x6f,
+	0x6e, 0x66, 0x12, 0x4d, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x4a,
+	0x57, 0x54, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x3b, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0xf9,
+	0x04, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4a, 0x77, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x4a, 0x77, 0x6b, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4a,
+	0x77, 0x6b, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4a, 0x77, 0x6b, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4a, 0x77, 0x6b, 0x73, 0x12, 0x5f, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x1a, 0xb4, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4a, 0x77, 0x6b, 0x73, 0x12,
+	0x16, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5,
+	0x18, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x61, 0x63, 0x68, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x61, 0x63, 0x68, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x49, 0x0a, 0x0d, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1c, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x6a, 0x77, 0x6b, 0x73, 0x1a, 0x4a, 0x0a, 0x04, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x61,
+	0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x41, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x3b, 0x0a, 0x0f,
+	0x4d, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x07, 0x4d, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x3a, 0x13,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x68, 0x6a, 0x77, 0x74, 0x12, 0x08, 0x6d, 0x65, 0x73, 0x68, 0x6a,
+	0x77, 0x74, 0x73, 0x52, 0x02, 0x10, 0x01, 0x68, 0x01, 0x42, 0x44, 0x8a, 0xb5, 0x18, 0x16, 0x50,
+	0x01, 0xa2, 0x01, 0x07, 0x4d, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0xf2, 0x01, 0x07, 0x6d, 0x65,
+	0x73, 0x68, 0x6a, 0x77, 0x74, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_jwt_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_jwt_proto_rawDescData = file_mesh_v1alpha1_jwt_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_jwt_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_jwt_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_jwt_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_jwt_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_jwt_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_jwt_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_mesh_v1alpha1_jwt_proto_goTypes = []interface{}{
+	(*MeshJWT)(nil),                             // 0: kuma.mesh.v1alpha1.MeshJWT
+	(*MeshJWT_Conf)(nil),                        // 1: kuma.mesh.v1alpha1.MeshJWT.Conf
+	(*MeshJWT_Conf_Provider)(nil),               // 2: kuma.mesh.v1alpha1.MeshJWT.Conf.Provider
+	(*MeshJWT_Conf_Rule)(nil),                   // 3: kuma.mesh.v1alpha1.MeshJWT.Conf.Rule
+	(*MeshJWT_Conf_Provider_RemoteJwks)(nil),    // 4: kuma.mesh.v1alpha1.MeshJWT.Conf.Provider.RemoteJwks
+	(*MeshJWT_Conf_Provider_ClaimToHeader)(nil), // 5: kuma.mesh.v1alpha1.MeshJWT.Conf.Provider.ClaimToHeader
+	(*Selector)(nil),                            // 6: kuma.mesh.v1alpha1.Selector
+	(*v1alpha1.DataSource)(nil),                 // 7: kuma.system.v1alpha1.DataSource
+	(*durationpb.Duration)(nil),                 // 8: google.protobuf.Duration
+}
+var file_mesh_v1alpha1_jwt_proto_depIdxs = []int32{
+	6, // 0: kuma.mesh.v1alpha1.MeshJWT.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 1: kuma.mesh.v1alpha1.MeshJWT.conf:type_name -> kuma.mesh.v1alpha1.MeshJWT.Conf
+	2, // 2: kuma.mesh.v1alpha1.MeshJWT.Conf.providers:type_name -> kuma.mesh.v1alpha1.MeshJWT.Conf.Provider
+	3, // 3: kuma.mesh.v1alpha1.MeshJWT.Conf.rules:type_name -> kuma.mesh.v1alpha1.MeshJWT.Conf.Rule
+	4, // 4: kuma.mesh.v1alpha1.MeshJWT.Conf.Provider.remoteJwks:type_name -> kuma.mesh.v1alpha1.MeshJWT.Conf.Provider.RemoteJwks
+	7, // 5: kuma.mesh.v1alpha1.MeshJWT.Conf.Provider.localJwks:type_name -> kuma.system.v1alpha1.DataSource
+	5, // 6: kuma.mesh.v1alpha1.MeshJWT.Conf.Provider.claimToHeaders:type_name -> kuma.mesh.v1alpha1.MeshJWT.Conf.Provider.ClaimToHeader
+	8, // 7: kuma.mesh.v1alpha1.MeshJWT.Conf.Provider.RemoteJwks.timeout:type_name -> google.protobuf.Duration
+	8, // 8: kuma.mesh.v1alpha1.MeshJWT.Conf.Provider.RemoteJwks.cacheDuration:type_name -> google.protobuf.Duration
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_jwt_proto_init() }
+func file_mesh_v1alpha1_jwt_proto_init() {
+	if File_mesh_v1alpha1_jwt_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_jwt_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshJWT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_jwt_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshJWT_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_jwt_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshJWT_Conf_Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_jwt_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshJWT_Conf_Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_jwt_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshJWT_Conf_Provider_RemoteJwks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_jwt_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshJWT_Conf_Provider_ClaimToHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mesh_v1alpha1_jwt_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*MeshJWT_Conf_Provider_RemoteJwks_)(nil),
+		(*MeshJWT_Conf_Provider_LocalJwks)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_jwt_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_jwt_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_jwt_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_jwt_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_jwt_proto = out.File
+	file_mesh_v1alpha1_jwt_proto_rawDesc = nil
+	file_mesh_v1alpha1_jwt_proto_goTypes = nil
+	file_mesh_v1alpha1_jwt_proto_depIdxs = nil
+}
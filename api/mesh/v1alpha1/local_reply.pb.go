@@ -0,0 +1,471 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/local_reply.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LocalReply defines how responses generated by the selected dataplanes
+// themselves (i.e. 503 when there is no healthy upstream or 504 on timeout)
+// are rewritten.
+type LocalReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes.
+	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Configuration of the local replies.
+	Conf *LocalReply_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *LocalReply) Reset() {
+	*x = LocalReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_local_reply_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalReply) ProtoMessage() {}
+
+func (x *LocalReply) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_local_reply_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalReply.ProtoReflect.Descriptor instead.
+func (*LocalReply) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_local_reply_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LocalReply) GetSelectors() []*Selector {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *LocalReply) GetConf() *LocalReply_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines settings of the local replies.
+type LocalReply_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of mappers, the first one that matches the status code of the
+	// local reply is applied.
+	Mappers []*LocalReply_Conf_Mapper `protobuf:"bytes,1,rep,name=mappers,proto3" json:"mappers,omitempty"`
+	// Format of the body of all local replies.
+	BodyFormat *LocalReply_Conf_BodyFormat `protobuf:"bytes,2,opt,name=bodyFormat,proto3" json:"bodyFormat,omitempty"`
+}
+
+func (x *LocalReply_Conf) Reset() {
+	*x = LocalReply_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_local_reply_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalReply_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalReply_Conf) ProtoMessage() {}
+
+func (x *LocalReply_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_local_reply_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalReply_Conf.ProtoReflect.Descriptor instead.
+func (*LocalReply_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_local_reply_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *LocalReply_Conf) GetMappers() []*LocalReply_Conf_Mapper {
+	if x != nil {
+		return x.Mappers
+	}
+	return nil
+}
+
+func (x *LocalReply_Conf) GetBodyFormat() *LocalReply_Conf_BodyFormat {
+	if x != nil {
+		return x.BodyFormat
+	}
+	return nil
+}
+
+// BodyFormat defines a format of the body of the local reply.
+type LocalReply_Conf_BodyFormat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Text format string, Envoy command operators like %LOCAL_REPLY_BODY%
+	// or %RESPONSE_CODE% can be used, either text or json has to be set.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// JSON object, Envoy command operators can be used in its values,
+	// either text or json has to be set.
+	Json *structpb.Struct `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	// Content type of the body. If not specified, the default is
+	// text/plain for text and application/json for json.
+	ContentType string `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+}
+
+func (x *LocalReply_Conf_BodyFormat) Reset() {
+	*x = LocalReply_Conf_BodyFormat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_local_reply_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalReply_Conf_BodyFormat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalReply_Conf_BodyFormat) ProtoMessage() {}
+
+func (x *LocalReply_Conf_BodyFormat) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_local_reply_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalReply_Conf_BodyFormat.ProtoReflect.Descriptor instead.
+func (*LocalReply_Conf_BodyFormat) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_local_reply_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *LocalReply_Conf_BodyFormat) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *LocalReply_Conf_BodyFormat) GetJson() *structpb.Struct {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *LocalReply_Conf_BodyFormat) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// Mapper rewrites local replies with given status codes.
+type LocalReply_Conf_Mapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status codes of local replies this mapper applies to.
+	StatusCodes []uint32 `protobuf:"varint,1,rep,packed,name=statusCodes,proto3" json:"statusCodes,omitempty"`
+	// Status code the local reply is rewritten with. If not specified, the
+	// status code is not changed.
+	StatusCode *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	// Body the local reply is rewritten with, available as
+	// %LOCAL_REPLY_BODY% in the body format.
+	Body string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// Format of the body overriding the one defined in the configuration.
+	BodyFormat *LocalReply_Conf_BodyFormat `protobuf:"bytes,4,opt,name=bodyFormat,proto3" json:"bodyFormat,omitempty"`
+}
+
+func (x *LocalReply_Conf_Mapper) Reset() {
+	*x = LocalReply_Conf_Mapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_local_reply_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalReply_Conf_Mapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalReply_Conf_Mapper) ProtoMessage() {}
+
+func (x *LocalReply_Conf_Mapper) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_local_reply_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalReply_Conf_Mapper.ProtoReflect.Descriptor instead.
+func (*LocalReply_Conf_Mapper) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_local_reply_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *LocalReply_Conf_Mapper) GetStatusCodes() []uint32 {
+	if x != nil {
+		return x.StatusCodes
+	}
+	return nil
+}
+
+func (x *LocalReply_Conf_Mapper) GetStatusCode() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.StatusCode
+	}
+	return nil
+}
+
+func (x *LocalReply_Conf_Mapper) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *LocalReply_Conf_Mapper) GetBodyFormat() *LocalReply_Conf_BodyFormat {
+	if x != nil {
+		return x.BodyFormat
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_local_reply_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_local_reply_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x05, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63,
+	0x6f, 0x6e, 0x66, 0x1a, 0xe2, 0x03, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x44, 0x0a, 0x07,
+	0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x42, 0x6f, 0x64, 0x79,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x1a, 0x6f, 0x0a, 0x0a, 0x42, 0x6f, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x6a, 0x73, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x1a, 0xd2, 0x01, 0x0a, 0x06, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x26,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0d, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x4e, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x62, 0x6f,
+	0x64, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x3a, 0x50, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x4a,
+	0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x3a, 0x1c, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2d,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2d, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x65, 0x73, 0x52, 0x02, 0x10, 0x01, 0x68, 0x01, 0x42, 0x4b, 0x8a, 0xb5, 0x18, 0x1d,
+	0x50, 0x01, 0xa2, 0x01, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0xf2,
+	0x01, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2d, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5a, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71,
+	0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_local_reply_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_local_reply_proto_rawDescData = file_mesh_v1alpha1_local_reply_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_local_reply_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_local_reply_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_local_reply_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_local_reply_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_local_reply_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_local_reply_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mesh_v1alpha1_local_reply_proto_goTypes = []interface{}{
+	(*LocalReply)(nil),                 // 0: kuma.mesh.v1alpha1.LocalReply
+	(*LocalReply_Conf)(nil),            // 1: kuma.mesh.v1alpha1.LocalReply.Conf
+	(*LocalReply_Conf_BodyFormat)(nil), // 2: kuma.mesh.v1alpha1.LocalReply.Conf.BodyFormat
+	(*LocalReply_Conf_Mapper)(nil),     // 3: kuma.mesh.v1alpha1.LocalReply.Conf.Mapper
+	(*Selector)(nil),                   // 4: kuma.mesh.v1alpha1.Selector
+	(*structpb.Struct)(nil),            // 5: google.protobuf.Struct
+	(*wrapperspb.UInt32Value)(nil),     // 6: google.protobuf.UInt32Value
+}
+var file_mesh_v1alpha1_local_reply_proto_depIdxs = []int32{
+	4, // 0: kuma.mesh.v1alpha1.LocalReply.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 1: kuma.mesh.v1alpha1.LocalReply.conf:type_name -> kuma.mesh.v1alpha1.LocalReply.Conf
+	3, // 2: kuma.mesh.v1alpha1.LocalReply.Conf.mappers:type_name -> kuma.mesh.v1alpha1.LocalReply.Conf.Mapper
+	2, // 3: kuma.mesh.v1alpha1.LocalReply.Conf.bodyFormat:type_name -> kuma.mesh.v1alpha1.LocalReply.Conf.BodyFormat
+	5, // 4: kuma.mesh.v1alpha1.LocalReply.Conf.BodyFormat.json:type_name -> google.protobuf.Struct
+	6, // 5: kuma.mesh.v1alpha1.LocalReply.Conf.Mapper.statusCode:type_name -> google.protobuf.UInt32Value
+	2, // 6: kuma.mesh.v1alpha1.LocalReply.Conf.Mapper.bodyFormat:type_name -> kuma.mesh.v1alpha1.LocalReply.Conf.BodyFormat
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_local_reply_proto_init() }
+func file_mesh_v1alpha1_local_reply_proto_init() {
+	if File_mesh_v1alpha1_local_reply_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_local_reply_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_local_reply_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalReply_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_local_reply_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalReply_Conf_BodyFormat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_local_reply_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalReply_Conf_Mapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_local_reply_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_local_reply_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_local_reply_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_local_reply_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_local_reply_proto = out.File
+	file_mesh_v1alpha1_local_reply_proto_rawDesc = nil
+	file_mesh_v1alpha1_local_reply_proto_goTypes = nil
+	file_mesh_v1alpha1_local_reply_proto_depIdxs = nil
+}
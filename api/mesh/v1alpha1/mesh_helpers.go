@@ -7,3 +7,26 @@ func (m *Mesh) IsPassthrough() bool {
 	}
 	return passthrough.GetValue()
 }
+
+// IsPassthroughFor returns true when the traffic of the dataplane to the
+// destinations outside of the mesh is passed through. The dataplane can
+// disable the passthrough that is enabled in the mesh.
+func (m *Mesh) IsPassthroughFor(dataplane *Dataplane) bool {
+	return m.IsPassthrough() && !dataplane.GetNetworking().GetTransparentProxying().GetDisablePassthrough()
+}
+
+func (m *Mesh) IsDynamicForwardProxyEnabled() bool {
+	return len(m.GetNetworking().GetOutbound().GetDynamicForwardProxy().GetDomains()) > 0
+}
+
+// LastPort returns the last port of the range, which defaults to the first one.
+func (r *Networking_Outbound_PortRange) LastPort() uint32 {
+	if r.GetTo() == 0 {
+		return r.GetFrom()
+	}
+	return r.GetTo()
+}
+
+func (r *Networking_Outbound_PortRange) IsSinglePort() bool {
+	return r.LastPort() == r.GetFrom()
+}
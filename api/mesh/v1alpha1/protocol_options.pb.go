@@ -0,0 +1,598 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/protocol_options.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UpstreamProtocol defines the HTTP version of the upstream connections.
+type MeshProtocolOptions_Conf_UpstreamProtocol int32
+
+const (
+	// The version inferred from the kuma.io/protocol tag of the service.
+	MeshProtocolOptions_Conf_DEFAULT MeshProtocolOptions_Conf_UpstreamProtocol = 0
+	// HTTP/1.1. Ignored for services tagged with kuma.io/protocol: grpc.
+	MeshProtocolOptions_Conf_HTTP1 MeshProtocolOptions_Conf_UpstreamProtocol = 1
+	// HTTP/2 with prior knowledge, without negotiating the version.
+	MeshProtocolOptions_Conf_HTTP2 MeshProtocolOptions_Conf_UpstreamProtocol = 2
+	// The version is negotiated with ALPN, HTTP/2 is preferred. The version
+	// inferred from the kuma.io/protocol tag is used when the connection is
+	// not secured with TLS.
+	MeshProtocolOptions_Conf_AUTO MeshProtocolOptions_Conf_UpstreamProtocol = 3
+)
+
+// Enum value maps for MeshProtocolOptions_Conf_UpstreamProtocol.
+var (
+	MeshProtocolOptions_Conf_UpstreamProtocol_name = map[int32]string{
+		0: "DEFAULT",
+		1: "HTTP1",
+		2: "HTTP2",
+		3: "AUTO",
+	}
+	MeshProtocolOptions_Conf_UpstreamProtocol_value = map[string]int32{
+		"DEFAULT": 0,
+		"HTTP1":   1,
+		"HTTP2":   2,
+		"AUTO":    3,
+	}
+)
+
+func (x MeshProtocolOptions_Conf_UpstreamProtocol) Enum() *MeshProtocolOptions_Conf_UpstreamProtocol {
+	p := new(MeshProtocolOptions_Conf_UpstreamProtocol)
+	*p = x
+	return p
+}
+
+func (x MeshProtocolOptions_Conf_UpstreamProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MeshProtocolOptions_Conf_UpstreamProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_protocol_options_proto_enumTypes[0].Descriptor()
+}
+
+func (MeshProtocolOptions_Conf_UpstreamProtocol) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_protocol_options_proto_enumTypes[0]
+}
+
+func (x MeshProtocolOptions_Conf_UpstreamProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MeshProtocolOptions_Conf_UpstreamProtocol.Descriptor instead.
+func (MeshProtocolOptions_Conf_UpstreamProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_protocol_options_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+// MeshProtocolOptions defines HTTP protocol options of the upstream
+// connections opened by the dataplanes to services tagged with
+// kuma.io/protocol: http, http2 or grpc.
+type MeshProtocolOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes that are sources of traffic.
+	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// List of selectors to match services that are destinations of traffic.
+	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Configuration of the protocol options.
+	Conf *MeshProtocolOptions_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *MeshProtocolOptions) Reset() {
+	*x = MeshProtocolOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_protocol_options_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshProtocolOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshProtocolOptions) ProtoMessage() {}
+
+func (x *MeshProtocolOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_protocol_options_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshProtocolOptions.ProtoReflect.Descriptor instead.
+func (*MeshProtocolOptions) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_protocol_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeshProtocolOptions) GetSources() []*Selector {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *MeshProtocolOptions) GetDestinations() []*Selector {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *MeshProtocolOptions) GetConf() *MeshProtocolOptions_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines the protocol options.
+type MeshProtocolOptions_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// HTTP version of the connections to external services. Dataplanes always
+	// talk to each other over HTTP/2.
+	UpstreamProtocol MeshProtocolOptions_Conf_UpstreamProtocol `protobuf:"varint,1,opt,name=upstreamProtocol,proto3,enum=kuma.mesh.v1alpha1.MeshProtocolOptions_Conf_UpstreamProtocol" json:"upstreamProtocol,omitempty"`
+	// Time after which a connection without active requests is closed. Takes
+	// precedence over http.idleTimeout of the Timeout policy.
+	IdleTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=idleTimeout,proto3" json:"idleTimeout,omitempty"`
+	// Maximum number of requests sent over a single connection.
+	MaxRequestsPerConnection *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=maxRequestsPerConnection,proto3" json:"maxRequestsPerConnection,omitempty"`
+	// Options of the HTTP/1.1 connections.
+	Http1 *MeshProtocolOptions_Conf_Http1 `protobuf:"bytes,4,opt,name=http1,proto3" json:"http1,omitempty"`
+	// Options of the HTTP/2 connections.
+	Http2 *MeshProtocolOptions_Conf_Http2 `protobuf:"bytes,5,opt,name=http2,proto3" json:"http2,omitempty"`
+}
+
+func (x *MeshProtocolOptions_Conf) Reset() {
+	*x = MeshProtocolOptions_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_protocol_options_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshProtocolOptions_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshProtocolOptions_Conf) ProtoMessage() {}
+
+func (x *MeshProtocolOptions_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_protocol_options_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshProtocolOptions_Conf.ProtoReflect.Descriptor instead.
+func (*MeshProtocolOptions_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_protocol_options_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MeshProtocolOptions_Conf) GetUpstreamProtocol() MeshProtocolOptions_Conf_UpstreamProtocol {
+	if x != nil {
+		return x.UpstreamProtocol
+	}
+	return MeshProtocolOptions_Conf_DEFAULT
+}
+
+func (x *MeshProtocolOptions_Conf) GetIdleTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
+func (x *MeshProtocolOptions_Conf) GetMaxRequestsPerConnection() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxRequestsPerConnection
+	}
+	return nil
+}
+
+func (x *MeshProtocolOptions_Conf) GetHttp1() *MeshProtocolOptions_Conf_Http1 {
+	if x != nil {
+		return x.Http1
+	}
+	return nil
+}
+
+func (x *MeshProtocolOptions_Conf) GetHttp2() *MeshProtocolOptions_Conf_Http2 {
+	if x != nil {
+		return x.Http2
+	}
+	return nil
+}
+
+// Http1 defines options of the HTTP/1.1 connections. Requests are never
+// pipelined, a connection kept alive carries one request at a time.
+type MeshProtocolOptions_Conf_Http1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If true, the connection is closed after every request instead of
+	// being kept alive for subsequent requests.
+	DisableKeepAlive bool `protobuf:"varint,1,opt,name=disableKeepAlive,proto3" json:"disableKeepAlive,omitempty"`
+	// If true, names of the headers are sent in the Proper-Case format
+	// instead of lower case.
+	ProperCaseHeaders bool `protobuf:"varint,2,opt,name=properCaseHeaders,proto3" json:"properCaseHeaders,omitempty"`
+	// If true, trailers of the requests are sent to the service.
+	EnableTrailers bool `protobuf:"varint,3,opt,name=enableTrailers,proto3" json:"enableTrailers,omitempty"`
+}
+
+func (x *MeshProtocolOptions_Conf_Http1) Reset() {
+	*x = MeshProtocolOptions_Conf_Http1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_protocol_options_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshProtocolOptions_Conf_Http1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshProtocolOptions_Conf_Http1) ProtoMessage() {}
+
+func (x *MeshProtocolOptions_Conf_Http1) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_protocol_options_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshProtocolOptions_Conf_Http1.ProtoReflect.Descriptor instead.
+func (*MeshProtocolOptions_Conf_Http1) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_protocol_options_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *MeshProtocolOptions_Conf_Http1) GetDisableKeepAlive() bool {
+	if x != nil {
+		return x.DisableKeepAlive
+	}
+	return false
+}
+
+func (x *MeshProtocolOptions_Conf_Http1) GetProperCaseHeaders() bool {
+	if x != nil {
+		return x.ProperCaseHeaders
+	}
+	return false
+}
+
+func (x *MeshProtocolOptions_Conf_Http1) GetEnableTrailers() bool {
+	if x != nil {
+		return x.EnableTrailers
+	}
+	return false
+}
+
+// Http2 defines options of the HTTP/2 connections.
+type MeshProtocolOptions_Conf_Http2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of concurrent streams of a connection.
+	MaxConcurrentStreams *wrapperspb.UInt32Value `protobuf:"bytes,1,opt,name=maxConcurrentStreams,proto3" json:"maxConcurrentStreams,omitempty"`
+	// Initial flow-control window size of a stream in bytes.
+	InitialStreamWindowSize *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=initialStreamWindowSize,proto3" json:"initialStreamWindowSize,omitempty"`
+	// Initial flow-control window size of a connection in bytes.
+	InitialConnectionWindowSize *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=initialConnectionWindowSize,proto3" json:"initialConnectionWindowSize,omitempty"`
+}
+
+func (x *MeshProtocolOptions_Conf_Http2) Reset() {
+	*x = MeshProtocolOptions_Conf_Http2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_protocol_options_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshProtocolOptions_Conf_Http2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshProtocolOptions_Conf_Http2) ProtoMessage() {}
+
+func (x *MeshProtocolOptions_Conf_Http2) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_protocol_options_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshProtocolOptions_Conf_Http2.ProtoReflect.Descriptor instead.
+func (*MeshProtocolOptions_Conf_Http2) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_protocol_options_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *MeshProtocolOptions_Conf_Http2) GetMaxConcurrentStreams() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxConcurrentStreams
+	}
+	return nil
+}
+
+func (x *MeshProtocolOptions_Conf_Http2) GetInitialStreamWindowSize() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.InitialStreamWindowSize
+	}
+	return nil
+}
+
+func (x *MeshProtocolOptions_Conf_Http2) GetInitialConnectionWindowSize() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.InitialConnectionWindowSize
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_protocol_options_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_protocol_options_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68,
+	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x09, 0x0a, 0x13, 0x4d,
+	0x65, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x46, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66,
+	0x1a, 0xfd, 0x06, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x69, 0x0a, 0x10, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x3d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x52, 0x10, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x3b, 0x0a, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x58, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x50, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x05, 0x68,
+	0x74, 0x74, 0x70, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x31, 0x52, 0x05,
+	0x68, 0x74, 0x74, 0x70, 0x31, 0x12, 0x48, 0x0a, 0x05, 0x68, 0x74, 0x74, 0x70, 0x32, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x32, 0x52, 0x05, 0x68, 0x74, 0x74, 0x70, 0x32, 0x1a,
+	0x89, 0x01, 0x0a, 0x05, 0x48, 0x74, 0x74, 0x70, 0x31, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x65, 0x65, 0x70,
+	0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x43,
+	0x61, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x43, 0x61, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x72, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x1a, 0x91, 0x02, 0x0a, 0x05,
+	0x48, 0x74, 0x74, 0x70, 0x32, 0x12, 0x50, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x56, 0x0a, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x5e, 0x0a, 0x1b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x1b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x3f, 0x0a, 0x10, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x48, 0x54, 0x54, 0x50, 0x31, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x48,
+	0x54, 0x54, 0x50, 0x32, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x03,
+	0x3a, 0x6f, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x69, 0x0a, 0x1b, 0x4d, 0x65, 0x73, 0x68, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x13, 0x4d, 0x65, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68,
+	0x3a, 0x29, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x02, 0x10, 0x01, 0x68,
+	0x01, 0x42, 0x5c, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18,
+	0x2e, 0x50, 0x01, 0xa2, 0x01, 0x13, 0x4d, 0x65, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xf2, 0x01, 0x13, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_protocol_options_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_protocol_options_proto_rawDescData = file_mesh_v1alpha1_protocol_options_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_protocol_options_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_protocol_options_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_protocol_options_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_protocol_options_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_protocol_options_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_protocol_options_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mesh_v1alpha1_protocol_options_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mesh_v1alpha1_protocol_options_proto_goTypes = []interface{}{
+	(MeshProtocolOptions_Conf_UpstreamProtocol)(0), // 0: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.UpstreamProtocol
+	(*MeshProtocolOptions)(nil),                    // 1: kuma.mesh.v1alpha1.MeshProtocolOptions
+	(*MeshProtocolOptions_Conf)(nil),               // 2: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf
+	(*MeshProtocolOptions_Conf_Http1)(nil),         // 3: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.Http1
+	(*MeshProtocolOptions_Conf_Http2)(nil),         // 4: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.Http2
+	(*Selector)(nil),                               // 5: kuma.mesh.v1alpha1.Selector
+	(*durationpb.Duration)(nil),                    // 6: google.protobuf.Duration
+	(*wrapperspb.UInt32Value)(nil),                 // 7: google.protobuf.UInt32Value
+}
+var file_mesh_v1alpha1_protocol_options_proto_depIdxs = []int32{
+	5,  // 0: kuma.mesh.v1alpha1.MeshProtocolOptions.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	5,  // 1: kuma.mesh.v1alpha1.MeshProtocolOptions.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	2,  // 2: kuma.mesh.v1alpha1.MeshProtocolOptions.conf:type_name -> kuma.mesh.v1alpha1.MeshProtocolOptions.Conf
+	0,  // 3: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.upstreamProtocol:type_name -> kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.UpstreamProtocol
+	6,  // 4: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.idleTimeout:type_name -> google.protobuf.Duration
+	7,  // 5: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.maxRequestsPerConnection:type_name -> google.protobuf.UInt32Value
+	3,  // 6: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.http1:type_name -> kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.Http1
+	4,  // 7: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.http2:type_name -> kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.Http2
+	7,  // 8: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.Http2.maxConcurrentStreams:type_name -> google.protobuf.UInt32Value
+	7,  // 9: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.Http2.initialStreamWindowSize:type_name -> google.protobuf.UInt32Value
+	7,  // 10: kuma.mesh.v1alpha1.MeshProtocolOptions.Conf.Http2.initialConnectionWindowSize:type_name -> google.protobuf.UInt32Value
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_protocol_options_proto_init() }
+func file_mesh_v1alpha1_protocol_options_proto_init() {
+	if File_mesh_v1alpha1_protocol_options_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_protocol_options_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshProtocolOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_protocol_options_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshProtocolOptions_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_protocol_options_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshProtocolOptions_Conf_Http1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_protocol_options_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshProtocolOptions_Conf_Http2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_protocol_options_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_protocol_options_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_protocol_options_proto_depIdxs,
+		EnumInfos:         file_mesh_v1alpha1_protocol_options_proto_enumTypes,
+		MessageInfos:      file_mesh_v1alpha1_protocol_options_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_protocol_options_proto = out.File
+	file_mesh_v1alpha1_protocol_options_proto_rawDesc = nil
+	file_mesh_v1alpha1_protocol_options_proto_goTypes = nil
+	file_mesh_v1alpha1_protocol_options_proto_depIdxs = nil
+}
@@ -429,6 +429,7 @@ type ProxyTemplate_Modifications struct {
 	//	*ProxyTemplate_Modifications_NetworkFilter_
 	//	*ProxyTemplate_Modifications_HttpFilter_
 	//	*ProxyTemplate_Modifications_VirtualHost_
+	//	*ProxyTemplate_Modifications_Lua_
 	Type isProxyTemplate_Modifications_Type `protobuf_oneof:"type"`
 }
 
@@ -506,6 +507,13 @@ func (x *ProxyTemplate_Modifications) GetVirtualHost() *ProxyTemplate_Modificati
 	return nil
 }
 
+func (x *ProxyTemplate_Modifications) GetLua() *ProxyTemplate_Modifications_Lua {
+	if x, ok := x.GetType().(*ProxyTemplate_Modifications_Lua_); ok {
+		return x.Lua
+	}
+	return nil
+}
+
 type isProxyTemplate_Modifications_Type interface {
 	isProxyTemplate_Modifications_Type()
 }
@@ -535,6 +543,11 @@ type ProxyTemplate_Modifications_VirtualHost_ struct {
 	VirtualHost *ProxyTemplate_Modifications_VirtualHost `protobuf:"bytes,5,opt,name=virtualHost,proto3,oneof"`
 }
 
+type ProxyTemplate_Modifications_Lua_ struct {
+	// Lua filter modification
+	Lua *ProxyTemplate_Modifications_Lua `protobuf:"bytes,6,opt,name=lua,proto3,oneof"`
+}
+
 func (*ProxyTemplate_Modifications_Cluster_) isProxyTemplate_Modifications_Type() {}
 
 func (*ProxyTemplate_Modifications_Listener_) isProxyTemplate_Modifications_Type() {}
@@ -545,6 +558,8 @@ func (*ProxyTemplate_Modifications_HttpFilter_) isProxyTemplate_Modifications_Ty
 
 func (*ProxyTemplate_Modifications_VirtualHost_) isProxyTemplate_Modifications_Type() {}
 
+func (*ProxyTemplate_Modifications_Lua_) isProxyTemplate_Modifications_Type() {}
+
 // Cluster defines modifications to generated clusters
 type ProxyTemplate_Modifications_Cluster struct {
 	state         protoimpl.MessageState
@@ -882,6 +897,84 @@ func (x *ProxyTemplate_Modifications_VirtualHost) GetValue() string {
 	return ""
 }
 
+// Lua defines a Lua HTTP filter inserted right before the router of the
+// generated HTTP connection managers
+type ProxyTemplate_Modifications_Lua struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only listeners that match will be modified
+	Match *ProxyTemplate_Modifications_Lua_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Name of the HTTP filter
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Lua script of the filter. It has to define envoy_on_request or
+	// envoy_on_response function
+	Script string `protobuf:"bytes,3,opt,name=script,proto3" json:"script,omitempty"`
+	// Overrides of the script for specific virtual hosts or routes
+	RouteOverrides []*ProxyTemplate_Modifications_Lua_RouteOverride `protobuf:"bytes,4,rep,name=routeOverrides,proto3" json:"routeOverrides,omitempty"`
+}
+
+func (x *ProxyTemplate_Modifications_Lua) Reset() {
+	*x = ProxyTemplate_Modifications_Lua{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyTemplate_Modifications_Lua) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyTemplate_Modifications_Lua) ProtoMessage() {}
+
+func (x *ProxyTemplate_Modifications_Lua) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyTemplate_Modifications_Lua.ProtoReflect.Descriptor instead.
+func (*ProxyTemplate_Modifications_Lua) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_proxy_template_proto_rawDescGZIP(), []int{0, 1, 5}
+}
+
+func (x *ProxyTemplate_Modifications_Lua) GetMatch() *ProxyTemplate_Modifications_Lua_Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *ProxyTemplate_Modifications_Lua) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProxyTemplate_Modifications_Lua) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *ProxyTemplate_Modifications_Lua) GetRouteOverrides() []*ProxyTemplate_Modifications_Lua_RouteOverride {
+	if x != nil {
+		return x.RouteOverrides
+	}
+	return nil
+}
+
 // Match defines match for cluster
 type ProxyTemplate_Modifications_Cluster_Match struct {
 	state         protoimpl.MessageState
@@ -898,7 +991,7 @@ type ProxyTemplate_Modifications_Cluster_Match struct {
 func (x *ProxyTemplate_Modifications_Cluster_Match) Reset() {
 	*x = ProxyTemplate_Modifications_Cluster_Match{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[12]
+		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -911,7 +1004,7 @@ func (x *ProxyTemplate_Modifications_Cluster_Match) String() string {
 func (*ProxyTemplate_Modifications_Cluster_Match) ProtoMessage() {}
 
 func (x *ProxyTemplate_Modifications_Cluster_Match) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[12]
+	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1052,7 @@ type ProxyTemplate_Modifications_Listener_Match struct {
 func (x *ProxyTemplate_Modifications_Listener_Match) Reset() {
 	*x = ProxyTemplate_Modifications_Listener_Match{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[13]
+		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -972,7 +1065,7 @@ func (x *ProxyTemplate_Modifications_Listener_Match) String() string {
 func (*ProxyTemplate_Modifications_Listener_Match) ProtoMessage() {}
 
 func (x *ProxyTemplate_Modifications_Listener_Match) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[13]
+	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,7 +1124,7 @@ type ProxyTemplate_Modifications_NetworkFilter_Match struct {
 func (x *ProxyTemplate_Modifications_NetworkFilter_Match) Reset() {
 	*x = ProxyTemplate_Modifications_NetworkFilter_Match{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[15]
+		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1044,7 +1137,7 @@ func (x *ProxyTemplate_Modifications_NetworkFilter_Match) String() string {
 func (*ProxyTemplate_Modifications_NetworkFilter_Match) ProtoMessage() {}
 
 func (x *ProxyTemplate_Modifications_NetworkFilter_Match) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[15]
+	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1110,7 +1203,7 @@ type ProxyTemplate_Modifications_HttpFilter_Match struct {
 func (x *ProxyTemplate_Modifications_HttpFilter_Match) Reset() {
 	*x = ProxyTemplate_Modifications_HttpFilter_Match{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[17]
+		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1123,7 +1216,7 @@ func (x *ProxyTemplate_Modifications_HttpFilter_Match) String() string {
 func (*ProxyTemplate_Modifications_HttpFilter_Match) ProtoMessage() {}
 
 func (x *ProxyTemplate_Modifications_HttpFilter_Match) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[17]
+	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1185,7 +1278,7 @@ type ProxyTemplate_Modifications_VirtualHost_Match struct {
 func (x *ProxyTemplate_Modifications_VirtualHost_Match) Reset() {
 	*x = ProxyTemplate_Modifications_VirtualHost_Match{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[19]
+		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1198,7 +1291,7 @@ func (x *ProxyTemplate_Modifications_VirtualHost_Match) String() string {
 func (*ProxyTemplate_Modifications_VirtualHost_Match) ProtoMessage() {}
 
 func (x *ProxyTemplate_Modifications_VirtualHost_Match) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[19]
+	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1235,6 +1328,220 @@ func (x *ProxyTemplate_Modifications_VirtualHost_Match) GetRouteConfigurationNam
 	return ""
 }
 
+// Match defines match for Lua filter
+type ProxyTemplate_Modifications_Lua_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Origin of the resource generation. (inbound, outbound, prometheus,
+	// transparent, ingress)
+	Origin string `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	// Name of the listener that Lua filter will be added to
+	ListenerName string `protobuf:"bytes,2,opt,name=listenerName,proto3" json:"listenerName,omitempty"`
+	// ListenerTags available in
+	// Listener#Metadata#FilterMetadata[io.kuma.tags]
+	ListenerTags map[string]string `protobuf:"bytes,3,rep,name=listenerTags,proto3" json:"listenerTags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ProxyTemplate_Modifications_Lua_Match) Reset() {
+	*x = ProxyTemplate_Modifications_Lua_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyTemplate_Modifications_Lua_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyTemplate_Modifications_Lua_Match) ProtoMessage() {}
+
+func (x *ProxyTemplate_Modifications_Lua_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyTemplate_Modifications_Lua_Match.ProtoReflect.Descriptor instead.
+func (*ProxyTemplate_Modifications_Lua_Match) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_proxy_template_proto_rawDescGZIP(), []int{0, 1, 5, 0}
+}
+
+func (x *ProxyTemplate_Modifications_Lua_Match) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *ProxyTemplate_Modifications_Lua_Match) GetListenerName() string {
+	if x != nil {
+		return x.ListenerName
+	}
+	return ""
+}
+
+func (x *ProxyTemplate_Modifications_Lua_Match) GetListenerTags() map[string]string {
+	if x != nil {
+		return x.ListenerTags
+	}
+	return nil
+}
+
+// RouteOverride defines an override of the script for virtual hosts or
+// routes
+type ProxyTemplate_Modifications_Lua_RouteOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only virtual hosts and routes that match will be overridden
+	Match *ProxyTemplate_Modifications_Lua_RouteOverride_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Disables the filter
+	Disabled bool `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Lua script used instead of the script of the filter
+	Script string `protobuf:"bytes,3,opt,name=script,proto3" json:"script,omitempty"`
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride) Reset() {
+	*x = ProxyTemplate_Modifications_Lua_RouteOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyTemplate_Modifications_Lua_RouteOverride) ProtoMessage() {}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyTemplate_Modifications_Lua_RouteOverride.ProtoReflect.Descriptor instead.
+func (*ProxyTemplate_Modifications_Lua_RouteOverride) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_proxy_template_proto_rawDescGZIP(), []int{0, 1, 5, 1}
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride) GetMatch() *ProxyTemplate_Modifications_Lua_RouteOverride_Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+// Match defines match for route override. If neither name nor prefix
+// is defined, the override is applied to the whole virtual host
+type ProxyTemplate_Modifications_Lua_RouteOverride_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the route configuration
+	RouteConfigurationName string `protobuf:"bytes,1,opt,name=routeConfigurationName,proto3" json:"routeConfigurationName,omitempty"`
+	// Name of the virtual host
+	VirtualHostName string `protobuf:"bytes,2,opt,name=virtualHostName,proto3" json:"virtualHostName,omitempty"`
+	// Name of the route
+	RouteName string `protobuf:"bytes,3,opt,name=routeName,proto3" json:"routeName,omitempty"`
+	// Prefix of the route match
+	Prefix string `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride_Match) Reset() {
+	*x = ProxyTemplate_Modifications_Lua_RouteOverride_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyTemplate_Modifications_Lua_RouteOverride_Match) ProtoMessage() {}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_proxy_template_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyTemplate_Modifications_Lua_RouteOverride_Match.ProtoReflect.Descriptor instead.
+func (*ProxyTemplate_Modifications_Lua_RouteOverride_Match) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_proxy_template_proto_rawDescGZIP(), []int{0, 1, 5, 1, 0}
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride_Match) GetRouteConfigurationName() string {
+	if x != nil {
+		return x.RouteConfigurationName
+	}
+	return ""
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride_Match) GetVirtualHostName() string {
+	if x != nil {
+		return x.VirtualHostName
+	}
+	return ""
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride_Match) GetRouteName() string {
+	if x != nil {
+		return x.RouteName
+	}
+	return ""
+}
+
+func (x *ProxyTemplate_Modifications_Lua_RouteOverride_Match) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
 var File_mesh_v1alpha1_proxy_template_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_proxy_template_proto_rawDesc = []byte{
@@ -1245,7 +1552,7 @@ var file_mesh_v1alpha1_proxy_template_proto_rawDesc = []byte{
 	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65,
 	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x15, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
+	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x1c, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
 	0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65,
 	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
@@ -1267,7 +1574,7 @@ var file_mesh_v1alpha1_proxy_template_proto_rawDesc = []byte{
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54,
 	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xbd, 0x11, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xcd, 0x18, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
 	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f,
@@ -1297,116 +1604,173 @@ var file_mesh_v1alpha1_proxy_template_proto_rawDesc = []byte{
 	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
 	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56,
 	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x69,
-	0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x1a, 0xd3, 0x01, 0x0a, 0x07, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x03, 0x6c, 0x75, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x75, 0x61, 0x48, 0x00, 0x52, 0x03, 0x6c,
+	0x75, 0x61, 0x1a, 0xd3, 0x01, 0x0a, 0x07, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x53,
+	0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x39, 0x0a,
+	0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x18,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5,
+	0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0xed, 0x02, 0x0a, 0x08, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x54, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
 	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54,
 	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x09, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88,
-	0xb5, 0x18, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x39, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a,
-	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a,
-	0xed, 0x02, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x54, 0x0a, 0x05,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d,
-	0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xd0, 0x01, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x5c, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x48, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e,
+	0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a,
+	0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xc0, 0x03, 0x0a, 0x0d, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a,
+	0x99, 0x02, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x79, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xb1, 0x03, 0x0a, 0x0a,
+	0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74,
 	0x63, 0x68, 0x12, 0x22, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x65,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xd0, 0x01, 0x0a,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x90, 0x02, 0x0a,
 	0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x18,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5,
-	0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x5c, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78,
-	0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x76, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x3f,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0xc0, 0x03, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x12, 0x59, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x43, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x09,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x99, 0x02, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x79, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x55, 0x2e, 0x6b,
+	0x93, 0x02, 0x0a, 0x0b, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x12,
+	0x57, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18,
+	0x01, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x1a, 0x71, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
+	0x16, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xc4, 0x06, 0x0a, 0x03, 0x4c, 0x75, 0x61, 0x12, 0x4f, 0x0a,
+	0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b,
 	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
 	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e,
-	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67,
-	0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0xb1, 0x03, 0x0a, 0x0a, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x12, 0x56, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x40, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x09, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5,
-	0x18, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x90, 0x02, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a,
-	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x76, 0x0a,
-	0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x52, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x54, 0x61,
-	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-	0x72, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-	0x72, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x93, 0x02, 0x0a, 0x0b, 0x56, 0x69, 0x72, 0x74, 0x75,
-	0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f,
-	0x73, 0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x22, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x71, 0x0a, 0x05, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x75,
+	0x61, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5,
+	0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x69, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x4c, 0x75, 0x61, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x1a, 0xf5, 0x01, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x6f, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4b, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c,
+	0x75, 0x61, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xd0, 0x02, 0x0a, 0x0d, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x63, 0x0a, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x75, 0x61, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x1a, 0xa5, 0x01, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x36,
+	0x0a, 0x16, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x06, 0x0a, 0x04,
 	0x74, 0x79, 0x70, 0x65, 0x3a, 0x67, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x17, 0x0a, 0x15, 0x50, 0x72,
 	0x6f, 0x78, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0f, 0x12, 0x0d, 0x50, 0x72, 0x6f, 0x78, 0x79,
@@ -1472,7 +1836,7 @@ func file_mesh_v1alpha1_proxy_template_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_proxy_template_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_proxy_template_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_mesh_v1alpha1_proxy_template_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_mesh_v1alpha1_proxy_template_proto_goTypes = []interface{}{
 	(*ProxyTemplate)(nil),                              // 0: kuma.mesh.v1alpha1.ProxyTemplate
 	(*ProxyTemplateSource)(nil),                        // 1: kuma.mesh.v1alpha1.ProxyTemplateSource
@@ -1486,23 +1850,28 @@ var file_mesh_v1alpha1_proxy_template_proto_goTypes = []interface{}{
 	(*ProxyTemplate_Modifications_NetworkFilter)(nil),  // 9: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter
 	(*ProxyTemplate_Modifications_HttpFilter)(nil),     // 10: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter
 	(*ProxyTemplate_Modifications_VirtualHost)(nil),    // 11: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.VirtualHost
-	(*ProxyTemplate_Modifications_Cluster_Match)(nil),  // 12: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Cluster.Match
-	(*ProxyTemplate_Modifications_Listener_Match)(nil), // 13: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match
-	nil, // 14: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match.TagsEntry
-	(*ProxyTemplate_Modifications_NetworkFilter_Match)(nil), // 15: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match
-	nil, // 16: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match.ListenerTagsEntry
-	(*ProxyTemplate_Modifications_HttpFilter_Match)(nil), // 17: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match
-	nil, // 18: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match.ListenerTagsEntry
-	(*ProxyTemplate_Modifications_VirtualHost_Match)(nil), // 19: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.VirtualHost.Match
-	nil,              // 20: kuma.mesh.v1alpha1.ProxyTemplateProfileSource.ParamsEntry
-	(*Selector)(nil), // 21: kuma.mesh.v1alpha1.Selector
+	(*ProxyTemplate_Modifications_Lua)(nil),            // 12: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua
+	(*ProxyTemplate_Modifications_Cluster_Match)(nil),  // 13: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Cluster.Match
+	(*ProxyTemplate_Modifications_Listener_Match)(nil), // 14: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match
+	nil, // 15: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match.TagsEntry
+	(*ProxyTemplate_Modifications_NetworkFilter_Match)(nil), // 16: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match
+	nil, // 17: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match.ListenerTagsEntry
+	(*ProxyTemplate_Modifications_HttpFilter_Match)(nil), // 18: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match
+	nil, // 19: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match.ListenerTagsEntry
+	(*ProxyTemplate_Modifications_VirtualHost_Match)(nil), // 20: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.VirtualHost.Match
+	(*ProxyTemplate_Modifications_Lua_Match)(nil),         // 21: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.Match
+	(*ProxyTemplate_Modifications_Lua_RouteOverride)(nil), // 22: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.RouteOverride
+	nil, // 23: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.Match.ListenerTagsEntry
+	(*ProxyTemplate_Modifications_Lua_RouteOverride_Match)(nil), // 24: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.RouteOverride.Match
+	nil,              // 25: kuma.mesh.v1alpha1.ProxyTemplateProfileSource.ParamsEntry
+	(*Selector)(nil), // 26: kuma.mesh.v1alpha1.Selector
 }
 var file_mesh_v1alpha1_proxy_template_proto_depIdxs = []int32{
-	21, // 0: kuma.mesh.v1alpha1.ProxyTemplate.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	26, // 0: kuma.mesh.v1alpha1.ProxyTemplate.selectors:type_name -> kuma.mesh.v1alpha1.Selector
 	5,  // 1: kuma.mesh.v1alpha1.ProxyTemplate.conf:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Conf
 	2,  // 2: kuma.mesh.v1alpha1.ProxyTemplateSource.profile:type_name -> kuma.mesh.v1alpha1.ProxyTemplateProfileSource
 	3,  // 3: kuma.mesh.v1alpha1.ProxyTemplateSource.raw:type_name -> kuma.mesh.v1alpha1.ProxyTemplateRawSource
-	20, // 4: kuma.mesh.v1alpha1.ProxyTemplateProfileSource.params:type_name -> kuma.mesh.v1alpha1.ProxyTemplateProfileSource.ParamsEntry
+	25, // 4: kuma.mesh.v1alpha1.ProxyTemplateProfileSource.params:type_name -> kuma.mesh.v1alpha1.ProxyTemplateProfileSource.ParamsEntry
 	4,  // 5: kuma.mesh.v1alpha1.ProxyTemplateRawSource.resources:type_name -> kuma.mesh.v1alpha1.ProxyTemplateRawResource
 	4,  // 6: kuma.mesh.v1alpha1.ProxyTemplate.Conf.resources:type_name -> kuma.mesh.v1alpha1.ProxyTemplateRawResource
 	6,  // 7: kuma.mesh.v1alpha1.ProxyTemplate.Conf.modifications:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications
@@ -1511,19 +1880,24 @@ var file_mesh_v1alpha1_proxy_template_proto_depIdxs = []int32{
 	9,  // 10: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.networkFilter:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter
 	10, // 11: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.httpFilter:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter
 	11, // 12: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.virtualHost:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.VirtualHost
-	12, // 13: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Cluster.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Cluster.Match
-	13, // 14: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match
-	15, // 15: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match
-	17, // 16: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match
-	19, // 17: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.VirtualHost.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.VirtualHost.Match
-	14, // 18: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match.tags:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match.TagsEntry
-	16, // 19: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match.listenerTags:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match.ListenerTagsEntry
-	18, // 20: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match.listenerTags:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match.ListenerTagsEntry
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	12, // 13: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.lua:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua
+	13, // 14: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Cluster.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Cluster.Match
+	14, // 15: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match
+	16, // 16: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match
+	18, // 17: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match
+	20, // 18: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.VirtualHost.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.VirtualHost.Match
+	21, // 19: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.Match
+	22, // 20: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.routeOverrides:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.RouteOverride
+	15, // 21: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match.tags:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match.TagsEntry
+	17, // 22: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match.listenerTags:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match.ListenerTagsEntry
+	19, // 23: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match.listenerTags:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match.ListenerTagsEntry
+	23, // 24: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.Match.listenerTags:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.Match.ListenerTagsEntry
+	24, // 25: kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.RouteOverride.match:type_name -> kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Lua.RouteOverride.Match
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_proxy_template_proto_init() }
@@ -1678,7 +2052,7 @@ func file_mesh_v1alpha1_proxy_template_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_proxy_template_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProxyTemplate_Modifications_Cluster_Match); i {
+			switch v := v.(*ProxyTemplate_Modifications_Lua); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1690,6 +2064,18 @@ func file_mesh_v1alpha1_proxy_template_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_proxy_template_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyTemplate_Modifications_Cluster_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_proxy_template_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProxyTemplate_Modifications_Listener_Match); i {
 			case 0:
 				return &v.state
@@ -1701,7 +2087,7 @@ func file_mesh_v1alpha1_proxy_template_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_proxy_template_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_proxy_template_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProxyTemplate_Modifications_NetworkFilter_Match); i {
 			case 0:
 				return &v.state
@@ -1713,7 +2099,7 @@ func file_mesh_v1alpha1_proxy_template_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_proxy_template_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_proxy_template_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProxyTemplate_Modifications_HttpFilter_Match); i {
 			case 0:
 				return &v.state
@@ -1725,7 +2111,7 @@ func file_mesh_v1alpha1_proxy_template_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_proxy_template_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_proxy_template_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProxyTemplate_Modifications_VirtualHost_Match); i {
 			case 0:
 				return &v.state
@@ -1737,6 +2123,42 @@ func file_mesh_v1alpha1_proxy_template_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_proxy_template_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyTemplate_Modifications_Lua_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_proxy_template_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyTemplate_Modifications_Lua_RouteOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_proxy_template_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyTemplate_Modifications_Lua_RouteOverride_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_mesh_v1alpha1_proxy_template_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*ProxyTemplateSource_Profile)(nil),
@@ -1748,6 +2170,7 @@ func file_mesh_v1alpha1_proxy_template_proto_init() {
 		(*ProxyTemplate_Modifications_NetworkFilter_)(nil),
 		(*ProxyTemplate_Modifications_HttpFilter_)(nil),
 		(*ProxyTemplate_Modifications_VirtualHost_)(nil),
+		(*ProxyTemplate_Modifications_Lua_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1755,7 +2178,7 @@ func file_mesh_v1alpha1_proxy_template_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_proxy_template_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -100,6 +100,10 @@ type RateLimit_Conf struct {
 	// The HTTP RateLimit configuration
 	// +optional
 	Http *RateLimit_Conf_Http `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	// The global RateLimit configuration that uses an external rate limit
+	// service
+	// +optional
+	Global *RateLimit_Conf_Global `protobuf:"bytes,2,opt,name=global,proto3" json:"global,omitempty"`
 }
 
 func (x *RateLimit_Conf) Reset() {
@@ -141,6 +145,13 @@ func (x *RateLimit_Conf) GetHttp() *RateLimit_Conf_Http {
 	return nil
 }
 
+func (x *RateLimit_Conf) GetGlobal() *RateLimit_Conf_Global {
+	if x != nil {
+		return x.Global
+	}
+	return nil
+}
+
 type RateLimit_Conf_Http struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -210,6 +221,97 @@ func (x *RateLimit_Conf_Http) GetOnRateLimit() *RateLimit_Conf_Http_OnRateLimit
 	return nil
 }
 
+type RateLimit_Conf_Global struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the rate limit service implementing the Envoy rate limit
+	// gRPC API in the host:port format
+	// +required
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The rate limit domain sent to the rate limit service
+	// +required
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Timeout of the request to the rate limit service. Defaults to 20ms.
+	// +optional
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// If true, requests are rejected when the rate limit service fails or
+	// is unavailable
+	// +optional
+	FailureModeDeny bool `protobuf:"varint,4,opt,name=failureModeDeny,proto3" json:"failureModeDeny,omitempty"`
+	// The descriptors sent to the rate limit service
+	// +required
+	Descriptors []*RateLimit_Conf_Global_Descriptor `protobuf:"bytes,5,rep,name=descriptors,proto3" json:"descriptors,omitempty"`
+}
+
+func (x *RateLimit_Conf_Global) Reset() {
+	*x = RateLimit_Conf_Global{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimit_Conf_Global) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit_Conf_Global) ProtoMessage() {}
+
+func (x *RateLimit_Conf_Global) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit_Conf_Global.ProtoReflect.Descriptor instead.
+func (*RateLimit_Conf_Global) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_rate_limit_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *RateLimit_Conf_Global) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RateLimit_Conf_Global) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *RateLimit_Conf_Global) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *RateLimit_Conf_Global) GetFailureModeDeny() bool {
+	if x != nil {
+		return x.FailureModeDeny
+	}
+	return false
+}
+
+func (x *RateLimit_Conf_Global) GetDescriptors() []*RateLimit_Conf_Global_Descriptor {
+	if x != nil {
+		return x.Descriptors
+	}
+	return nil
+}
+
 type RateLimit_Conf_Http_OnRateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -226,7 +328,7 @@ type RateLimit_Conf_Http_OnRateLimit struct {
 func (x *RateLimit_Conf_Http_OnRateLimit) Reset() {
 	*x = RateLimit_Conf_Http_OnRateLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[3]
+		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -239,7 +341,7 @@ func (x *RateLimit_Conf_Http_OnRateLimit) String() string {
 func (*RateLimit_Conf_Http_OnRateLimit) ProtoMessage() {}
 
 func (x *RateLimit_Conf_Http_OnRateLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[3]
+	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +390,7 @@ type RateLimit_Conf_Http_OnRateLimit_HeaderValue struct {
 func (x *RateLimit_Conf_Http_OnRateLimit_HeaderValue) Reset() {
 	*x = RateLimit_Conf_Http_OnRateLimit_HeaderValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[4]
+		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -301,7 +403,7 @@ func (x *RateLimit_Conf_Http_OnRateLimit_HeaderValue) String() string {
 func (*RateLimit_Conf_Http_OnRateLimit_HeaderValue) ProtoMessage() {}
 
 func (x *RateLimit_Conf_Http_OnRateLimit_HeaderValue) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[4]
+	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,6 +440,151 @@ func (x *RateLimit_Conf_Http_OnRateLimit_HeaderValue) GetAppend() *wrapperspb.Bo
 	return nil
 }
 
+type RateLimit_Conf_Global_Descriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entries of the descriptor
+	// +required
+	Entries []*RateLimit_Conf_Global_Descriptor_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *RateLimit_Conf_Global_Descriptor) Reset() {
+	*x = RateLimit_Conf_Global_Descriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimit_Conf_Global_Descriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit_Conf_Global_Descriptor) ProtoMessage() {}
+
+func (x *RateLimit_Conf_Global_Descriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit_Conf_Global_Descriptor.ProtoReflect.Descriptor instead.
+func (*RateLimit_Conf_Global_Descriptor) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_rate_limit_proto_rawDescGZIP(), []int{0, 0, 1, 0}
+}
+
+func (x *RateLimit_Conf_Global_Descriptor) GetEntries() []*RateLimit_Conf_Global_Descriptor_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RateLimit_Conf_Global_Descriptor_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key of the descriptor entry
+	// +required
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are assignable to Source:
+	//	*RateLimit_Conf_Global_Descriptor_Entry_Value
+	//	*RateLimit_Conf_Global_Descriptor_Entry_Header
+	Source isRateLimit_Conf_Global_Descriptor_Entry_Source `protobuf_oneof:"source"`
+}
+
+func (x *RateLimit_Conf_Global_Descriptor_Entry) Reset() {
+	*x = RateLimit_Conf_Global_Descriptor_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimit_Conf_Global_Descriptor_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit_Conf_Global_Descriptor_Entry) ProtoMessage() {}
+
+func (x *RateLimit_Conf_Global_Descriptor_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_rate_limit_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit_Conf_Global_Descriptor_Entry.ProtoReflect.Descriptor instead.
+func (*RateLimit_Conf_Global_Descriptor_Entry) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_rate_limit_proto_rawDescGZIP(), []int{0, 0, 1, 0, 0}
+}
+
+func (x *RateLimit_Conf_Global_Descriptor_Entry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (m *RateLimit_Conf_Global_Descriptor_Entry) GetSource() isRateLimit_Conf_Global_Descriptor_Entry_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *RateLimit_Conf_Global_Descriptor_Entry) GetValue() string {
+	if x, ok := x.GetSource().(*RateLimit_Conf_Global_Descriptor_Entry_Value); ok {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *RateLimit_Conf_Global_Descriptor_Entry) GetHeader() string {
+	if x, ok := x.GetSource().(*RateLimit_Conf_Global_Descriptor_Entry_Header); ok {
+		return x.Header
+	}
+	return ""
+}
+
+type isRateLimit_Conf_Global_Descriptor_Entry_Source interface {
+	isRateLimit_Conf_Global_Descriptor_Entry_Source()
+}
+
+type RateLimit_Conf_Global_Descriptor_Entry_Value struct {
+	// The static value of the descriptor entry
+	Value string `protobuf:"bytes,2,opt,name=value,proto3,oneof"`
+}
+
+type RateLimit_Conf_Global_Descriptor_Entry_Header struct {
+	// The name of the request header that the value of the descriptor
+	// entry is taken from. The descriptor is not sent if the header
+	// is missing.
+	Header string `protobuf:"bytes,3,opt,name=header,proto3,oneof"`
+}
+
+func (*RateLimit_Conf_Global_Descriptor_Entry_Value) isRateLimit_Conf_Global_Descriptor_Entry_Source() {
+}
+
+func (*RateLimit_Conf_Global_Descriptor_Entry_Header) isRateLimit_Conf_Global_Descriptor_Entry_Source() {
+}
+
 var File_mesh_v1alpha1_rate_limit_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_rate_limit_proto_rawDesc = []byte{
@@ -353,7 +600,7 @@ var file_mesh_v1alpha1_rate_limit_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
 	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x06,
+	0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x0a,
 	0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x07, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b,
 	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
@@ -368,51 +615,84 @@ var file_mesh_v1alpha1_rate_limit_proto_rawDesc = []byte{
 	0x22, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
 	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43,
 	0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a,
-	0x8e, 0x04, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x3b, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70,
+	0x9f, 0x08, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x3b, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
 	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65,
 	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52,
-	0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0xc8, 0x03, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x20,
-	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x12, 0x3b, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x88,
-	0xb5, 0x18, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x55, 0x0a,
-	0x0b, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4f, 0x6e, 0x52, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0b, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x1a, 0x89, 0x02, 0x0a, 0x0b, 0x4f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x07, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
-	0x48, 0x74, 0x74, 0x70, 0x2e, 0x4f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x69, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x06,
-	0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
-	0x3a, 0x5c, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x13, 0x0a, 0x11, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
-	0x0b, 0x12, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0xaa, 0x8c, 0x89, 0xa6,
-	0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02,
-	0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0e, 0x3a, 0x0c, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65,
-	0x2d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x49,
-	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d,
-	0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73,
-	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x1b, 0x50, 0x01,
-	0xa2, 0x01, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0xf2, 0x01, 0x0a, 0x72,
-	0x61, 0x74, 0x65, 0x2d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x41, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x1a, 0xc8, 0x03, 0x0a, 0x04, 0x48, 0x74, 0x74,
+	0x70, 0x12, 0x20, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x55, 0x0a, 0x0b, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4f,
+	0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0b, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x89, 0x02, 0x0a, 0x0b, 0x4f, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x69, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x32, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x61, 0x70, 0x70,
+	0x65, 0x6e, 0x64, 0x1a, 0xcb, 0x03, 0x0a, 0x06, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x1e,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c,
+	0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0x88, 0xb5, 0x18, 0x01, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x44, 0x65, 0x6e, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6e, 0x79, 0x12, 0x5c, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0xc5, 0x01, 0x0a, 0x0a, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x5a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x3a, 0x5c, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x13, 0x0a, 0x11, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6,
+	0x01, 0x0b, 0x12, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0xaa, 0x8c, 0x89,
+	0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52,
+	0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x0e, 0x3a, 0x0c, 0x0a, 0x0a, 0x72, 0x61, 0x74,
+	0x65, 0x2d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42,
+	0x49, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75,
+	0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x1b, 0x50,
+	0x01, 0xa2, 0x01, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0xf2, 0x01, 0x0a,
+	0x72, 0x61, 0x74, 0x65, 0x2d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -427,33 +707,40 @@ func file_mesh_v1alpha1_rate_limit_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_rate_limit_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_rate_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_mesh_v1alpha1_rate_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_mesh_v1alpha1_rate_limit_proto_goTypes = []interface{}{
 	(*RateLimit)(nil),                                   // 0: kuma.mesh.v1alpha1.RateLimit
 	(*RateLimit_Conf)(nil),                              // 1: kuma.mesh.v1alpha1.RateLimit.Conf
 	(*RateLimit_Conf_Http)(nil),                         // 2: kuma.mesh.v1alpha1.RateLimit.Conf.Http
-	(*RateLimit_Conf_Http_OnRateLimit)(nil),             // 3: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit
-	(*RateLimit_Conf_Http_OnRateLimit_HeaderValue)(nil), // 4: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue
-	(*Selector)(nil),                                    // 5: kuma.mesh.v1alpha1.Selector
-	(*durationpb.Duration)(nil),                         // 6: google.protobuf.Duration
-	(*wrapperspb.UInt32Value)(nil),                      // 7: google.protobuf.UInt32Value
-	(*wrapperspb.BoolValue)(nil),                        // 8: google.protobuf.BoolValue
+	(*RateLimit_Conf_Global)(nil),                       // 3: kuma.mesh.v1alpha1.RateLimit.Conf.Global
+	(*RateLimit_Conf_Http_OnRateLimit)(nil),             // 4: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit
+	(*RateLimit_Conf_Http_OnRateLimit_HeaderValue)(nil), // 5: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue
+	(*RateLimit_Conf_Global_Descriptor)(nil),            // 6: kuma.mesh.v1alpha1.RateLimit.Conf.Global.Descriptor
+	(*RateLimit_Conf_Global_Descriptor_Entry)(nil),      // 7: kuma.mesh.v1alpha1.RateLimit.Conf.Global.Descriptor.Entry
+	(*Selector)(nil),                                    // 8: kuma.mesh.v1alpha1.Selector
+	(*durationpb.Duration)(nil),                         // 9: google.protobuf.Duration
+	(*wrapperspb.UInt32Value)(nil),                      // 10: google.protobuf.UInt32Value
+	(*wrapperspb.BoolValue)(nil),                        // 11: google.protobuf.BoolValue
 }
 var file_mesh_v1alpha1_rate_limit_proto_depIdxs = []int32{
-	5, // 0: kuma.mesh.v1alpha1.RateLimit.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	5, // 1: kuma.mesh.v1alpha1.RateLimit.destinations:type_name -> kuma.mesh.v1alpha1.Selector
-	1, // 2: kuma.mesh.v1alpha1.RateLimit.conf:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf
-	2, // 3: kuma.mesh.v1alpha1.RateLimit.Conf.http:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http
-	6, // 4: kuma.mesh.v1alpha1.RateLimit.Conf.Http.interval:type_name -> google.protobuf.Duration
-	3, // 5: kuma.mesh.v1alpha1.RateLimit.Conf.Http.onRateLimit:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit
-	7, // 6: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.status:type_name -> google.protobuf.UInt32Value
-	4, // 7: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.headers:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue
-	8, // 8: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue.append:type_name -> google.protobuf.BoolValue
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	8,  // 0: kuma.mesh.v1alpha1.RateLimit.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	8,  // 1: kuma.mesh.v1alpha1.RateLimit.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	1,  // 2: kuma.mesh.v1alpha1.RateLimit.conf:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf
+	2,  // 3: kuma.mesh.v1alpha1.RateLimit.Conf.http:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http
+	3,  // 4: kuma.mesh.v1alpha1.RateLimit.Conf.global:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Global
+	9,  // 5: kuma.mesh.v1alpha1.RateLimit.Conf.Http.interval:type_name -> google.protobuf.Duration
+	4,  // 6: kuma.mesh.v1alpha1.RateLimit.Conf.Http.onRateLimit:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit
+	9,  // 7: kuma.mesh.v1alpha1.RateLimit.Conf.Global.timeout:type_name -> google.protobuf.Duration
+	6,  // 8: kuma.mesh.v1alpha1.RateLimit.Conf.Global.descriptors:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Global.Descriptor
+	10, // 9: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.status:type_name -> google.protobuf.UInt32Value
+	5,  // 10: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.headers:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue
+	11, // 11: kuma.mesh.v1alpha1.RateLimit.Conf.Http.OnRateLimit.HeaderValue.append:type_name -> google.protobuf.BoolValue
+	7,  // 12: kuma.mesh.v1alpha1.RateLimit.Conf.Global.Descriptor.entries:type_name -> kuma.mesh.v1alpha1.RateLimit.Conf.Global.Descriptor.Entry
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_rate_limit_proto_init() }
@@ -500,7 +787,7 @@ func file_mesh_v1alpha1_rate_limit_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_rate_limit_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RateLimit_Conf_Http_OnRateLimit); i {
+			switch v := v.(*RateLimit_Conf_Global); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -512,6 +799,18 @@ func file_mesh_v1alpha1_rate_limit_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_rate_limit_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimit_Conf_Http_OnRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_rate_limit_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RateLimit_Conf_Http_OnRateLimit_HeaderValue); i {
 			case 0:
 				return &v.state
@@ -523,6 +822,34 @@ func file_mesh_v1alpha1_rate_limit_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_rate_limit_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimit_Conf_Global_Descriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_rate_limit_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimit_Conf_Global_Descriptor_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mesh_v1alpha1_rate_limit_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*RateLimit_Conf_Global_Descriptor_Entry_Value)(nil),
+		(*RateLimit_Conf_Global_Descriptor_Entry_Header)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -530,7 +857,7 @@ func file_mesh_v1alpha1_rate_limit_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_rate_limit_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,484 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/request_protection.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MeshRequestProtection protects the inbound listeners of the selected
+// dataplanes against cross-site request forgery and too big requests. A
+// policy selecting all services can be overridden by a more specific one.
+type MeshRequestProtection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes.
+	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Configuration of the protections.
+	Conf *MeshRequestProtection_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *MeshRequestProtection) Reset() {
+	*x = MeshRequestProtection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_request_protection_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshRequestProtection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshRequestProtection) ProtoMessage() {}
+
+func (x *MeshRequestProtection) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_request_protection_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshRequestProtection.ProtoReflect.Descriptor instead.
+func (*MeshRequestProtection) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_request_protection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeshRequestProtection) GetSelectors() []*Selector {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *MeshRequestProtection) GetConf() *MeshRequestProtection_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines the protections.
+type MeshRequestProtection_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If defined, requests are checked against cross-site request forgery.
+	Csrf *MeshRequestProtection_Conf_Csrf `protobuf:"bytes,1,opt,name=csrf,proto3" json:"csrf,omitempty"`
+	// If defined, requests with the body bigger than the number of bytes are
+	// rejected with 413. Requests are buffered before they are forwarded.
+	MaxRequestBytes *wrapperspb.UInt32Value `protobuf:"bytes,2,opt,name=maxRequestBytes,proto3" json:"maxRequestBytes,omitempty"`
+}
+
+func (x *MeshRequestProtection_Conf) Reset() {
+	*x = MeshRequestProtection_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_request_protection_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshRequestProtection_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshRequestProtection_Conf) ProtoMessage() {}
+
+func (x *MeshRequestProtection_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_request_protection_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshRequestProtection_Conf.ProtoReflect.Descriptor instead.
+func (*MeshRequestProtection_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_request_protection_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MeshRequestProtection_Conf) GetCsrf() *MeshRequestProtection_Conf_Csrf {
+	if x != nil {
+		return x.Csrf
+	}
+	return nil
+}
+
+func (x *MeshRequestProtection_Conf) GetMaxRequestBytes() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxRequestBytes
+	}
+	return nil
+}
+
+// Csrf defines the protection against cross-site request forgery. Unsafe
+// requests with the Origin header not matching the destination are
+// rejected with 403.
+type MeshRequestProtection_Conf_Csrf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Origins allowed in addition to the destination of the request.
+	AdditionalOrigins []*MeshRequestProtection_Conf_Csrf_Origin `protobuf:"bytes,1,rep,name=additionalOrigins,proto3" json:"additionalOrigins,omitempty"`
+	// If true, requests failing the check are only counted in the stats
+	// and not rejected.
+	Shadow bool `protobuf:"varint,2,opt,name=shadow,proto3" json:"shadow,omitempty"`
+}
+
+func (x *MeshRequestProtection_Conf_Csrf) Reset() {
+	*x = MeshRequestProtection_Conf_Csrf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_request_protection_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshRequestProtection_Conf_Csrf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshRequestProtection_Conf_Csrf) ProtoMessage() {}
+
+func (x *MeshRequestProtection_Conf_Csrf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_request_protection_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshRequestProtection_Conf_Csrf.ProtoReflect.Descriptor instead.
+func (*MeshRequestProtection_Conf_Csrf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_request_protection_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *MeshRequestProtection_Conf_Csrf) GetAdditionalOrigins() []*MeshRequestProtection_Conf_Csrf_Origin {
+	if x != nil {
+		return x.AdditionalOrigins
+	}
+	return nil
+}
+
+func (x *MeshRequestProtection_Conf_Csrf) GetShadow() bool {
+	if x != nil {
+		return x.Shadow
+	}
+	return false
+}
+
+// Origin defines how the Origin header of the request is matched.
+type MeshRequestProtection_Conf_Csrf_Origin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Match:
+	//	*MeshRequestProtection_Conf_Csrf_Origin_Exact
+	//	*MeshRequestProtection_Conf_Csrf_Origin_Prefix
+	//	*MeshRequestProtection_Conf_Csrf_Origin_Regex
+	Match isMeshRequestProtection_Conf_Csrf_Origin_Match `protobuf_oneof:"match"`
+}
+
+func (x *MeshRequestProtection_Conf_Csrf_Origin) Reset() {
+	*x = MeshRequestProtection_Conf_Csrf_Origin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_request_protection_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshRequestProtection_Conf_Csrf_Origin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshRequestProtection_Conf_Csrf_Origin) ProtoMessage() {}
+
+func (x *MeshRequestProtection_Conf_Csrf_Origin) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_request_protection_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshRequestProtection_Conf_Csrf_Origin.ProtoReflect.Descriptor instead.
+func (*MeshRequestProtection_Conf_Csrf_Origin) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_request_protection_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (m *MeshRequestProtection_Conf_Csrf_Origin) GetMatch() isMeshRequestProtection_Conf_Csrf_Origin_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (x *MeshRequestProtection_Conf_Csrf_Origin) GetExact() string {
+	if x, ok := x.GetMatch().(*MeshRequestProtection_Conf_Csrf_Origin_Exact); ok {
+		return x.Exact
+	}
+	return ""
+}
+
+func (x *MeshRequestProtection_Conf_Csrf_Origin) GetPrefix() string {
+	if x, ok := x.GetMatch().(*MeshRequestProtection_Conf_Csrf_Origin_Prefix); ok {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *MeshRequestProtection_Conf_Csrf_Origin) GetRegex() string {
+	if x, ok := x.GetMatch().(*MeshRequestProtection_Conf_Csrf_Origin_Regex); ok {
+		return x.Regex
+	}
+	return ""
+}
+
+type isMeshRequestProtection_Conf_Csrf_Origin_Match interface {
+	isMeshRequestProtection_Conf_Csrf_Origin_Match()
+}
+
+type MeshRequestProtection_Conf_Csrf_Origin_Exact struct {
+	// The Origin header has to be equal to the value.
+	Exact string `protobuf:"bytes,1,opt,name=exact,proto3,oneof"`
+}
+
+type MeshRequestProtection_Conf_Csrf_Origin_Prefix struct {
+	// The Origin header has to start with the value.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
+}
+
+type MeshRequestProtection_Conf_Csrf_Origin_Regex struct {
+	// The whole Origin header has to match the RE2 regular expression.
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3,oneof"`
+}
+
+func (*MeshRequestProtection_Conf_Csrf_Origin_Exact) isMeshRequestProtection_Conf_Csrf_Origin_Match() {
+}
+
+func (*MeshRequestProtection_Conf_Csrf_Origin_Prefix) isMeshRequestProtection_Conf_Csrf_Origin_Match() {
+}
+
+func (*MeshRequestProtection_Conf_Csrf_Origin_Regex) isMeshRequestProtection_Conf_Csrf_Origin_Match() {
+}
+
+var File_mesh_v1alpha1_request_protection_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_request_protection_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x05, 0x0a,
+	0x15, 0x4d, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x09, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x04, 0x63, 0x6f, 0x6e, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f,
+	0x6e, 0x66, 0x1a, 0xff, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x47, 0x0a, 0x04, 0x63,
+	0x73, 0x72, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x43, 0x73, 0x72, 0x66, 0x52, 0x04,
+	0x63, 0x73, 0x72, 0x66, 0x12, 0x46, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0xe5, 0x01, 0x0a,
+	0x04, 0x43, 0x73, 0x72, 0x66, 0x12, 0x68, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3a, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x43, 0x73, 0x72, 0x66, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x11, 0x61, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x1a, 0x5b, 0x0a, 0x06, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x12, 0x16, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x07, 0x0a, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x3a, 0x79, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x73, 0x0a, 0x1d, 0x4d, 0x65,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x4d, 0x65, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x3a, 0x2f, 0x0a, 0x15, 0x6d, 0x65, 0x73, 0x68,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x6d, 0x65, 0x73, 0x68, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x02, 0x10, 0x01, 0x68, 0x01, 0x42,
+	0x60, 0x8a, 0xb5, 0x18, 0x32, 0x50, 0x01, 0xa2, 0x01, 0x15, 0x4d, 0x65, 0x73, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xf2,
+	0x01, 0x15, 0x6d, 0x65, 0x73, 0x68, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_request_protection_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_request_protection_proto_rawDescData = file_mesh_v1alpha1_request_protection_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_request_protection_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_request_protection_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_request_protection_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_request_protection_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_request_protection_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_request_protection_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mesh_v1alpha1_request_protection_proto_goTypes = []interface{}{
+	(*MeshRequestProtection)(nil),                  // 0: kuma.mesh.v1alpha1.MeshRequestProtection
+	(*MeshRequestProtection_Conf)(nil),             // 1: kuma.mesh.v1alpha1.MeshRequestProtection.Conf
+	(*MeshRequestProtection_Conf_Csrf)(nil),        // 2: kuma.mesh.v1alpha1.MeshRequestProtection.Conf.Csrf
+	(*MeshRequestProtection_Conf_Csrf_Origin)(nil), // 3: kuma.mesh.v1alpha1.MeshRequestProtection.Conf.Csrf.Origin
+	(*Selector)(nil),                               // 4: kuma.mesh.v1alpha1.Selector
+	(*wrapperspb.UInt32Value)(nil),                 // 5: google.protobuf.UInt32Value
+}
+var file_mesh_v1alpha1_request_protection_proto_depIdxs = []int32{
+	4, // 0: kuma.mesh.v1alpha1.MeshRequestProtection.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 1: kuma.mesh.v1alpha1.MeshRequestProtection.conf:type_name -> kuma.mesh.v1alpha1.MeshRequestProtection.Conf
+	2, // 2: kuma.mesh.v1alpha1.MeshRequestProtection.Conf.csrf:type_name -> kuma.mesh.v1alpha1.MeshRequestProtection.Conf.Csrf
+	5, // 3: kuma.mesh.v1alpha1.MeshRequestProtection.Conf.maxRequestBytes:type_name -> google.protobuf.UInt32Value
+	3, // 4: kuma.mesh.v1alpha1.MeshRequestProtection.Conf.Csrf.additionalOrigins:type_name -> kuma.mesh.v1alpha1.MeshRequestProtection.Conf.Csrf.Origin
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_request_protection_proto_init() }
+func file_mesh_v1alpha1_request_protection_proto_init() {
+	if File_mesh_v1alpha1_request_protection_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_request_protection_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshRequestProtection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_request_protection_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshRequestProtection_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_request_protection_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshRequestProtection_Conf_Csrf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_request_protection_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshRequestProtection_Conf_Csrf_Origin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mesh_v1alpha1_request_protection_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*MeshRequestProtection_Conf_Csrf_Origin_Exact)(nil),
+		(*MeshRequestProtection_Conf_Csrf_Origin_Prefix)(nil),
+		(*MeshRequestProtection_Conf_Csrf_Origin_Regex)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_request_protection_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_request_protection_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_request_protection_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_request_protection_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_request_protection_proto = out.File
+	file_mesh_v1alpha1_request_protection_proto_rawDesc = nil
+	file_mesh_v1alpha1_request_protection_proto_goTypes = nil
+	file_mesh_v1alpha1_request_protection_proto_depIdxs = nil
+}
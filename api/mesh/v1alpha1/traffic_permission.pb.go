@@ -22,6 +22,68 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Action defines what happens with the traffic matched by the policy.
+//
+// Precedence: deny rules are evaluated before allow rules. The connection
+// is rejected when any DENY policy matches it, even if there is a more
+// specific ALLOW policy. SHADOW_DENY policies never reject the connection,
+// they only update the shadow stats of the RBAC filter, so the deny policy
+// can be audited before it is enforced. The connection that is not denied
+// is accepted only when the best matching ALLOW policy allows it.
+// Deny rules are applied on inbounds of dataplanes only.
+type TrafficPermission_Action int32
+
+const (
+	// ALLOW permits the traffic. Only the most specific ALLOW policy is applied.
+	TrafficPermission_ALLOW TrafficPermission_Action = 0
+	// DENY rejects the traffic. All matching DENY policies are applied.
+	TrafficPermission_DENY TrafficPermission_Action = 1
+	// SHADOW_DENY does not reject the traffic, but reports it in
+	// <stat_prefix>.deny.rbac.shadow_denied stat of the inbound.
+	TrafficPermission_SHADOW_DENY TrafficPermission_Action = 2
+)
+
+// Enum value maps for TrafficPermission_Action.
+var (
+	TrafficPermission_Action_name = map[int32]string{
+		0: "ALLOW",
+		1: "DENY",
+		2: "SHADOW_DENY",
+	}
+	TrafficPermission_Action_value = map[string]int32{
+		"ALLOW":       0,
+		"DENY":        1,
+		"SHADOW_DENY": 2,
+	}
+)
+
+func (x TrafficPermission_Action) Enum() *TrafficPermission_Action {
+	p := new(TrafficPermission_Action)
+	*p = x
+	return p
+}
+
+func (x TrafficPermission_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrafficPermission_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_traffic_permission_proto_enumTypes[0].Descriptor()
+}
+
+func (TrafficPermission_Action) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_traffic_permission_proto_enumTypes[0]
+}
+
+func (x TrafficPermission_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrafficPermission_Action.Descriptor instead.
+func (TrafficPermission_Action) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_permission_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // TrafficPermission defines permission for traffic between dataplanes.
 type TrafficPermission struct {
 	state         protoimpl.MessageState
@@ -32,6 +94,8 @@ type TrafficPermission struct {
 	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
 	// List of selectors to match services that are destinations of traffic.
 	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Action of the policy. ALLOW by default.
+	Action TrafficPermission_Action `protobuf:"varint,3,opt,name=action,proto3,enum=kuma.mesh.v1alpha1.TrafficPermission_Action" json:"action,omitempty"`
 }
 
 func (x *TrafficPermission) Reset() {
@@ -80,6 +144,13 @@ func (x *TrafficPermission) GetDestinations() []*Selector {
 	return nil
 }
 
+func (x *TrafficPermission) GetAction() TrafficPermission_Action {
+	if x != nil {
+		return x.Action
+	}
+	return TrafficPermission_ALLOW
+}
+
 var File_mesh_v1alpha1_traffic_permission_proto protoreflect.FileDescriptor
 
 var file_mesh_v1alpha1_traffic_permission_proto_rawDesc = []byte{
@@ -90,7 +161,7 @@ var file_mesh_v1alpha1_traffic_permission_proto_rawDesc = []byte{
 	0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
 	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x02, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x03, 0x0a,
 	0x11, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
@@ -100,21 +171,28 @@ var file_mesh_v1alpha1_traffic_permission_proto_rawDesc = []byte{
 	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
 	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x74, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x1b,
-	0x0a, 0x19, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01,
-	0x13, 0x12, 0x11, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06, 0x22, 0x04, 0x6d, 0x65, 0x73, 0x68,
-	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x16,
-	0x3a, 0x14, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x02, 0x68, 0x01, 0x42, 0x5b,
-	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d,
-	0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73,
-	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x2d, 0x50, 0x01,
-	0xa2, 0x01, 0x12, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0xf2, 0x01, 0x13, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2d,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e,
+	0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f,
+	0x57, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a,
+	0x0b, 0x53, 0x48, 0x41, 0x44, 0x4f, 0x57, 0x5f, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x02, 0x3a, 0x74,
+	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x1b, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x13, 0x12, 0x11, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x06,
+	0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x04, 0x52, 0x02, 0x10, 0x01,
+	0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x16, 0x3a, 0x14, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x2d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0xaa, 0x8c, 0x89, 0xa6,
+	0x01, 0x02, 0x68, 0x01, 0x42, 0x5b, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x8a, 0xb5, 0x18, 0x2d, 0x50, 0x01, 0xa2, 0x01, 0x12, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0xf2, 0x01, 0x13, 0x74, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x2d, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -129,19 +207,22 @@ func file_mesh_v1alpha1_traffic_permission_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_traffic_permission_proto_rawDescData
 }
 
+var file_mesh_v1alpha1_traffic_permission_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_mesh_v1alpha1_traffic_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_mesh_v1alpha1_traffic_permission_proto_goTypes = []interface{}{
-	(*TrafficPermission)(nil), // 0: kuma.mesh.v1alpha1.TrafficPermission
-	(*Selector)(nil),          // 1: kuma.mesh.v1alpha1.Selector
+	(TrafficPermission_Action)(0), // 0: kuma.mesh.v1alpha1.TrafficPermission.Action
+	(*TrafficPermission)(nil),     // 1: kuma.mesh.v1alpha1.TrafficPermission
+	(*Selector)(nil),              // 2: kuma.mesh.v1alpha1.Selector
 }
 var file_mesh_v1alpha1_traffic_permission_proto_depIdxs = []int32{
-	1, // 0: kuma.mesh.v1alpha1.TrafficPermission.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	1, // 1: kuma.mesh.v1alpha1.TrafficPermission.destinations:type_name -> kuma.mesh.v1alpha1.Selector
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: kuma.mesh.v1alpha1.TrafficPermission.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	2, // 1: kuma.mesh.v1alpha1.TrafficPermission.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	0, // 2: kuma.mesh.v1alpha1.TrafficPermission.action:type_name -> kuma.mesh.v1alpha1.TrafficPermission.Action
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_traffic_permission_proto_init() }
@@ -169,13 +250,14 @@ func file_mesh_v1alpha1_traffic_permission_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_traffic_permission_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_mesh_v1alpha1_traffic_permission_proto_goTypes,
 		DependencyIndexes: file_mesh_v1alpha1_traffic_permission_proto_depIdxs,
+		EnumInfos:         file_mesh_v1alpha1_traffic_permission_proto_enumTypes,
 		MessageInfos:      file_mesh_v1alpha1_traffic_permission_proto_msgTypes,
 	}.Build()
 	File_mesh_v1alpha1_traffic_permission_proto = out.File
@@ -12,6 +12,7 @@ import (
 	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -174,6 +175,10 @@ type TrafficRoute_LoadBalancer struct {
 	//	*TrafficRoute_LoadBalancer_Random_
 	//	*TrafficRoute_LoadBalancer_Maglev_
 	LbType isTrafficRoute_LoadBalancer_LbType `protobuf_oneof:"lb_type"`
+	// List of hash policies evaluated in order, used only by "ringHash" and
+	// "maglev" load balancers. If no hash key is computed, a random host is
+	// selected.
+	HashPolicies []*TrafficRoute_LoadBalancer_HashPolicy `protobuf:"bytes,6,rep,name=hash_policies,json=hashPolicies,proto3" json:"hash_policies,omitempty"`
 }
 
 func (x *TrafficRoute_LoadBalancer) Reset() {
@@ -250,6 +255,13 @@ func (x *TrafficRoute_LoadBalancer) GetMaglev() *TrafficRoute_LoadBalancer_Magle
 	return nil
 }
 
+func (x *TrafficRoute_LoadBalancer) GetHashPolicies() []*TrafficRoute_LoadBalancer_HashPolicy {
+	if x != nil {
+		return x.HashPolicies
+	}
+	return nil
+}
+
 type isTrafficRoute_LoadBalancer_LbType interface {
 	isTrafficRoute_LoadBalancer_LbType()
 }
@@ -381,6 +393,9 @@ type TrafficRoute_Http struct {
 	// One destination that the traffic will be redirected to.
 	// When used, "split" is not allowed.
 	Destination map[string]string `protobuf:"bytes,4,rep,name=destination,proto3" json:"destination,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Mirroring of the traffic matched by the match section. Responses to
+	// the mirrored requests are ignored.
+	Mirror *TrafficRoute_Http_Mirror `protobuf:"bytes,5,opt,name=mirror,proto3" json:"mirror,omitempty"`
 }
 
 func (x *TrafficRoute_Http) Reset() {
@@ -443,6 +458,13 @@ func (x *TrafficRoute_Http) GetDestination() map[string]string {
 	return nil
 }
 
+func (x *TrafficRoute_Http) GetMirror() *TrafficRoute_Http_Mirror {
+	if x != nil {
+		return x.Mirror
+	}
+	return nil
+}
+
 // RoundRobin is a simple policy in which each available upstream host is
 // selected in round robin order.
 type TrafficRoute_LoadBalancer_RoundRobin struct {
@@ -647,6 +669,10 @@ type TrafficRoute_LoadBalancer_Maglev struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The table size for Maglev hashing, has to be a prime number. The
+	// value defaults to 65537.
+	TableSize uint64 `protobuf:"varint,1,opt,name=table_size,json=tableSize,proto3" json:"table_size,omitempty"`
 }
 
 func (x *TrafficRoute_LoadBalancer_Maglev) Reset() {
@@ -681,6 +707,278 @@ func (*TrafficRoute_LoadBalancer_Maglev) Descriptor() ([]byte, []int) {
 	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 4}
 }
 
+func (x *TrafficRoute_LoadBalancer_Maglev) GetTableSize() uint64 {
+	if x != nil {
+		return x.TableSize
+	}
+	return 0
+}
+
+// HashPolicy specifies how the hash key of the request is computed for
+// the consistent hashing load balancers.
+type TrafficRoute_LoadBalancer_HashPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to PolicySpecifier:
+	//	*TrafficRoute_LoadBalancer_HashPolicy_Header_
+	//	*TrafficRoute_LoadBalancer_HashPolicy_Cookie_
+	//	*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_
+	PolicySpecifier isTrafficRoute_LoadBalancer_HashPolicy_PolicySpecifier `protobuf_oneof:"policy_specifier"`
+	// If true and the hash key was computed, the remaining hash policies
+	// are skipped.
+	Terminal bool `protobuf:"varint,4,opt,name=terminal,proto3" json:"terminal,omitempty"`
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) Reset() {
+	*x = TrafficRoute_LoadBalancer_HashPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy) ProtoMessage() {}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_LoadBalancer_HashPolicy.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_LoadBalancer_HashPolicy) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 5}
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy) GetPolicySpecifier() isTrafficRoute_LoadBalancer_HashPolicy_PolicySpecifier {
+	if m != nil {
+		return m.PolicySpecifier
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) GetHeader() *TrafficRoute_LoadBalancer_HashPolicy_Header {
+	if x, ok := x.GetPolicySpecifier().(*TrafficRoute_LoadBalancer_HashPolicy_Header_); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) GetCookie() *TrafficRoute_LoadBalancer_HashPolicy_Cookie {
+	if x, ok := x.GetPolicySpecifier().(*TrafficRoute_LoadBalancer_HashPolicy_Cookie_); ok {
+		return x.Cookie
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) GetSourceIp() *TrafficRoute_LoadBalancer_HashPolicy_SourceIp {
+	if x, ok := x.GetPolicySpecifier().(*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_); ok {
+		return x.SourceIp
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy) GetTerminal() bool {
+	if x != nil {
+		return x.Terminal
+	}
+	return false
+}
+
+type isTrafficRoute_LoadBalancer_HashPolicy_PolicySpecifier interface {
+	isTrafficRoute_LoadBalancer_HashPolicy_PolicySpecifier()
+}
+
+type TrafficRoute_LoadBalancer_HashPolicy_Header_ struct {
+	Header *TrafficRoute_LoadBalancer_HashPolicy_Header `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_HashPolicy_Cookie_ struct {
+	Cookie *TrafficRoute_LoadBalancer_HashPolicy_Cookie `protobuf:"bytes,2,opt,name=cookie,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_HashPolicy_SourceIp_ struct {
+	SourceIp *TrafficRoute_LoadBalancer_HashPolicy_SourceIp `protobuf:"bytes,3,opt,name=source_ip,json=sourceIp,proto3,oneof"`
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_Header_) isTrafficRoute_LoadBalancer_HashPolicy_PolicySpecifier() {
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_Cookie_) isTrafficRoute_LoadBalancer_HashPolicy_PolicySpecifier() {
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_) isTrafficRoute_LoadBalancer_HashPolicy_PolicySpecifier() {
+}
+
+// Header hashes the value of the request header.
+type TrafficRoute_LoadBalancer_HashPolicy_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the request header.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Header) Reset() {
+	*x = TrafficRoute_LoadBalancer_HashPolicy_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_Header) ProtoMessage() {}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_LoadBalancer_HashPolicy_Header.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_LoadBalancer_HashPolicy_Header) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 5, 0}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Header) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Cookie hashes the value of the cookie.
+type TrafficRoute_LoadBalancer_HashPolicy_Cookie struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the cookie.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// If specified, the cookie is generated with this TTL when it is not
+	// present in the request.
+	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Path of the generated cookie.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) Reset() {
+	*x = TrafficRoute_LoadBalancer_HashPolicy_Cookie{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_Cookie) ProtoMessage() {}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_LoadBalancer_HashPolicy_Cookie.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_LoadBalancer_HashPolicy_Cookie) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 5, 1}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_Cookie) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// SourceIp hashes the source IP address of the connection.
+type TrafficRoute_LoadBalancer_HashPolicy_SourceIp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) Reset() {
+	*x = TrafficRoute_LoadBalancer_HashPolicy_SourceIp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_SourceIp) ProtoMessage() {}
+
+func (x *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_LoadBalancer_HashPolicy_SourceIp.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_LoadBalancer_HashPolicy_SourceIp) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 1, 5, 2}
+}
+
 // Match defines a series of matching criteria to apply modification and
 // reroute the traffic.
 type TrafficRoute_Http_Match struct {
@@ -699,7 +997,7 @@ type TrafficRoute_Http_Match struct {
 func (x *TrafficRoute_Http_Match) Reset() {
 	*x = TrafficRoute_Http_Match{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[12]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -712,7 +1010,7 @@ func (x *TrafficRoute_Http_Match) String() string {
 func (*TrafficRoute_Http_Match) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Match) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[12]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +1066,7 @@ type TrafficRoute_Http_Modify struct {
 func (x *TrafficRoute_Http_Modify) Reset() {
 	*x = TrafficRoute_Http_Modify{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[13]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -781,7 +1079,7 @@ func (x *TrafficRoute_Http_Modify) String() string {
 func (*TrafficRoute_Http_Modify) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[13]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,6 +1123,66 @@ func (x *TrafficRoute_Http_Modify) GetResponseHeaders() *TrafficRoute_Http_Modif
 	return nil
 }
 
+// Mirror defines a destination that the matched requests are mirrored to.
+type TrafficRoute_Http_Mirror struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Selector to match individual endpoints of the destination that the
+	// requests are mirrored to.
+	Destination map[string]string `protobuf:"bytes,1,rep,name=destination,proto3" json:"destination,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Percentage of the requests to mirror (in the range 0.0 - 100.0,
+	// inclusive). If not specified, all requests are mirrored.
+	Percentage *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *TrafficRoute_Http_Mirror) Reset() {
+	*x = TrafficRoute_Http_Mirror{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficRoute_Http_Mirror) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRoute_Http_Mirror) ProtoMessage() {}
+
+func (x *TrafficRoute_Http_Mirror) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRoute_Http_Mirror.ProtoReflect.Descriptor instead.
+func (*TrafficRoute_Http_Mirror) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP(), []int{0, 3, 2}
+}
+
+func (x *TrafficRoute_Http_Mirror) GetDestination() map[string]string {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *TrafficRoute_Http_Mirror) GetPercentage() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Percentage
+	}
+	return nil
+}
+
 // StringMatcher matches the string value.
 type TrafficRoute_Http_Match_StringMatcher struct {
 	state         protoimpl.MessageState
@@ -841,7 +1199,7 @@ type TrafficRoute_Http_Match_StringMatcher struct {
 func (x *TrafficRoute_Http_Match_StringMatcher) Reset() {
 	*x = TrafficRoute_Http_Match_StringMatcher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[15]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -854,7 +1212,7 @@ func (x *TrafficRoute_Http_Match_StringMatcher) String() string {
 func (*TrafficRoute_Http_Match_StringMatcher) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Match_StringMatcher) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[15]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,7 +1303,7 @@ type TrafficRoute_Http_Modify_RegexReplace struct {
 func (x *TrafficRoute_Http_Modify_RegexReplace) Reset() {
 	*x = TrafficRoute_Http_Modify_RegexReplace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[17]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -958,7 +1316,7 @@ func (x *TrafficRoute_Http_Modify_RegexReplace) String() string {
 func (*TrafficRoute_Http_Modify_RegexReplace) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_RegexReplace) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[17]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +1361,7 @@ type TrafficRoute_Http_Modify_Path struct {
 func (x *TrafficRoute_Http_Modify_Path) Reset() {
 	*x = TrafficRoute_Http_Modify_Path{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[18]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1016,7 +1374,7 @@ func (x *TrafficRoute_Http_Modify_Path) String() string {
 func (*TrafficRoute_Http_Modify_Path) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_Path) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[18]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,7 +1444,7 @@ type TrafficRoute_Http_Modify_Host struct {
 func (x *TrafficRoute_Http_Modify_Host) Reset() {
 	*x = TrafficRoute_Http_Modify_Host{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[19]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1099,7 +1457,7 @@ func (x *TrafficRoute_Http_Modify_Host) String() string {
 func (*TrafficRoute_Http_Modify_Host) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_Host) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[19]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1169,7 +1527,7 @@ type TrafficRoute_Http_Modify_Headers struct {
 func (x *TrafficRoute_Http_Modify_Headers) Reset() {
 	*x = TrafficRoute_Http_Modify_Headers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[20]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1182,7 +1540,7 @@ func (x *TrafficRoute_Http_Modify_Headers) String() string {
 func (*TrafficRoute_Http_Modify_Headers) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_Headers) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[20]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1230,7 +1588,7 @@ type TrafficRoute_Http_Modify_Headers_Add struct {
 func (x *TrafficRoute_Http_Modify_Headers_Add) Reset() {
 	*x = TrafficRoute_Http_Modify_Headers_Add{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[21]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1243,7 +1601,7 @@ func (x *TrafficRoute_Http_Modify_Headers_Add) String() string {
 func (*TrafficRoute_Http_Modify_Headers_Add) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_Headers_Add) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[21]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,7 +1651,7 @@ type TrafficRoute_Http_Modify_Headers_Remove struct {
 func (x *TrafficRoute_Http_Modify_Headers_Remove) Reset() {
 	*x = TrafficRoute_Http_Modify_Headers_Remove{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[22]
+		mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1306,7 +1664,7 @@ func (x *TrafficRoute_Http_Modify_Headers_Remove) String() string {
 func (*TrafficRoute_Http_Modify_Headers_Remove) ProtoMessage() {}
 
 func (x *TrafficRoute_Http_Modify_Headers_Remove) ProtoReflect() protoreflect.Message {
-	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[22]
+	mi := &file_mesh_v1alpha1_traffic_route_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,13 +1695,15 @@ var file_mesh_v1alpha1_traffic_route_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61,
 	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73,
 	0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63,
 	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x99, 0x1b, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
+	0x22, 0xb9, 0x22, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
 	0x65, 0x12, 0x44, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
 	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
@@ -1373,7 +1733,7 @@ var file_mesh_v1alpha1_traffic_route_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
 	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x9e, 0x05, 0x0a, 0x0c,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x88, 0x0a, 0x0a, 0x0c,
 	0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x0b,
 	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x62, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
@@ -1402,62 +1762,105 @@ var file_mesh_v1alpha1_traffic_route_proto_rawDesc = []byte{
 	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
 	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
 	0x65, 0x72, 0x2e, 0x4d, 0x61, 0x67, 0x6c, 0x65, 0x76, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x67,
-	0x6c, 0x65, 0x76, 0x1a, 0x0c, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69,
-	0x6e, 0x1a, 0x31, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x77, 0x0a, 0x08, 0x52, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x46, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69,
-	0x6e, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
-	0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x08, 0x0a,
-	0x06, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x1a, 0x08, 0x0a, 0x06, 0x4d, 0x61, 0x67, 0x6c, 0x65,
-	0x76, 0x42, 0x09, 0x0a, 0x07, 0x6c, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xf7, 0x02, 0x0a,
-	0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x46, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x52, 0x0a,
-	0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x72, 0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x72, 0x12, 0x58, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66,
-	0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x44, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x04, 0x68,
-	0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70,
-	0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xac, 0x0e, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12,
-	0x41, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x6c, 0x65, 0x76, 0x12, 0x5d, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x1a, 0x0c, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x62, 0x69, 0x6e,
+	0x1a, 0x31, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x77, 0x0a, 0x08, 0x52, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x23, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e,
+	0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x08, 0x0a, 0x06,
+	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x1a, 0x27, 0x0a, 0x06, 0x4d, 0x61, 0x67, 0x6c, 0x65, 0x76,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x1a,
+	0xe9, 0x03, 0x0a, 0x0a, 0x48, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x59,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f,
 	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x44, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
-	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69,
-	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x48, 0x61,
+	0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x59, 0x0a, 0x06, 0x63, 0x6f, 0x6f,
+	0x6b, 0x69, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
 	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52,
-	0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x58, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75,
-	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74,
-	0x74, 0x70, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x70, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x1a, 0x22, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x63, 0x0a, 0x06, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
+	0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x1a, 0x0a, 0x0a, 0x08, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x70, 0x42, 0x12, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x6c,
+	0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xf7, 0x02, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12,
+	0x46, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01,
+	0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x52, 0x0a, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x52, 0x0c, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70,
+	0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0xe2, 0x10, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x41, 0x0a, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x44, 0x0a, 0x06,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x12, 0x58, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x06, 0x6d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72,
 	0x1a, 0xde, 0x03, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x51, 0x0a, 0x06, 0x6d, 0x65,
 	0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6b, 0x75, 0x6d,
 	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
@@ -1550,7 +1953,22 @@ var file_mesh_v1alpha1_traffic_route_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e,
 	0x64, 0x1a, 0x22, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0xed, 0x01, 0x0a, 0x06, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x65, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4d, 0x69, 0x72,
+	0x72, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
@@ -1581,70 +1999,86 @@ func file_mesh_v1alpha1_traffic_route_proto_rawDescGZIP() []byte {
 	return file_mesh_v1alpha1_traffic_route_proto_rawDescData
 }
 
-var file_mesh_v1alpha1_traffic_route_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_mesh_v1alpha1_traffic_route_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_mesh_v1alpha1_traffic_route_proto_goTypes = []interface{}{
-	(*TrafficRoute)(nil),                           // 0: kuma.mesh.v1alpha1.TrafficRoute
-	(*TrafficRoute_Split)(nil),                     // 1: kuma.mesh.v1alpha1.TrafficRoute.Split
-	(*TrafficRoute_LoadBalancer)(nil),              // 2: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer
-	(*TrafficRoute_Conf)(nil),                      // 3: kuma.mesh.v1alpha1.TrafficRoute.Conf
-	(*TrafficRoute_Http)(nil),                      // 4: kuma.mesh.v1alpha1.TrafficRoute.Http
-	nil,                                            // 5: kuma.mesh.v1alpha1.TrafficRoute.Split.DestinationEntry
-	(*TrafficRoute_LoadBalancer_RoundRobin)(nil),   // 6: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RoundRobin
-	(*TrafficRoute_LoadBalancer_LeastRequest)(nil), // 7: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.LeastRequest
-	(*TrafficRoute_LoadBalancer_RingHash)(nil),     // 8: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RingHash
-	(*TrafficRoute_LoadBalancer_Random)(nil),       // 9: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Random
-	(*TrafficRoute_LoadBalancer_Maglev)(nil),       // 10: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Maglev
-	nil,                                            // 11: kuma.mesh.v1alpha1.TrafficRoute.Conf.DestinationEntry
-	(*TrafficRoute_Http_Match)(nil),                // 12: kuma.mesh.v1alpha1.TrafficRoute.Http.Match
-	(*TrafficRoute_Http_Modify)(nil),               // 13: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify
-	nil,                                            // 14: kuma.mesh.v1alpha1.TrafficRoute.Http.DestinationEntry
-	(*TrafficRoute_Http_Match_StringMatcher)(nil),  // 15: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
-	nil, // 16: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry
-	(*TrafficRoute_Http_Modify_RegexReplace)(nil),   // 17: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
-	(*TrafficRoute_Http_Modify_Path)(nil),           // 18: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path
-	(*TrafficRoute_Http_Modify_Host)(nil),           // 19: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host
-	(*TrafficRoute_Http_Modify_Headers)(nil),        // 20: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
-	(*TrafficRoute_Http_Modify_Headers_Add)(nil),    // 21: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Add
-	(*TrafficRoute_Http_Modify_Headers_Remove)(nil), // 22: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Remove
-	(*Selector)(nil),               // 23: kuma.mesh.v1alpha1.Selector
-	(*wrapperspb.UInt32Value)(nil), // 24: google.protobuf.UInt32Value
+	(*TrafficRoute)(nil),              // 0: kuma.mesh.v1alpha1.TrafficRoute
+	(*TrafficRoute_Split)(nil),        // 1: kuma.mesh.v1alpha1.TrafficRoute.Split
+	(*TrafficRoute_LoadBalancer)(nil), // 2: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer
+	(*TrafficRoute_Conf)(nil),         // 3: kuma.mesh.v1alpha1.TrafficRoute.Conf
+	(*TrafficRoute_Http)(nil),         // 4: kuma.mesh.v1alpha1.TrafficRoute.Http
+	nil,                               // 5: kuma.mesh.v1alpha1.TrafficRoute.Split.DestinationEntry
+	(*TrafficRoute_LoadBalancer_RoundRobin)(nil),          // 6: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RoundRobin
+	(*TrafficRoute_LoadBalancer_LeastRequest)(nil),        // 7: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.LeastRequest
+	(*TrafficRoute_LoadBalancer_RingHash)(nil),            // 8: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RingHash
+	(*TrafficRoute_LoadBalancer_Random)(nil),              // 9: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Random
+	(*TrafficRoute_LoadBalancer_Maglev)(nil),              // 10: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Maglev
+	(*TrafficRoute_LoadBalancer_HashPolicy)(nil),          // 11: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy
+	(*TrafficRoute_LoadBalancer_HashPolicy_Header)(nil),   // 12: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Header
+	(*TrafficRoute_LoadBalancer_HashPolicy_Cookie)(nil),   // 13: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Cookie
+	(*TrafficRoute_LoadBalancer_HashPolicy_SourceIp)(nil), // 14: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.SourceIp
+	nil,                              // 15: kuma.mesh.v1alpha1.TrafficRoute.Conf.DestinationEntry
+	(*TrafficRoute_Http_Match)(nil),  // 16: kuma.mesh.v1alpha1.TrafficRoute.Http.Match
+	(*TrafficRoute_Http_Modify)(nil), // 17: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify
+	(*TrafficRoute_Http_Mirror)(nil), // 18: kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror
+	nil,                              // 19: kuma.mesh.v1alpha1.TrafficRoute.Http.DestinationEntry
+	(*TrafficRoute_Http_Match_StringMatcher)(nil), // 20: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	nil, // 21: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry
+	(*TrafficRoute_Http_Modify_RegexReplace)(nil),   // 22: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
+	(*TrafficRoute_Http_Modify_Path)(nil),           // 23: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path
+	(*TrafficRoute_Http_Modify_Host)(nil),           // 24: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host
+	(*TrafficRoute_Http_Modify_Headers)(nil),        // 25: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
+	(*TrafficRoute_Http_Modify_Headers_Add)(nil),    // 26: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Add
+	(*TrafficRoute_Http_Modify_Headers_Remove)(nil), // 27: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Remove
+	nil,                            // 28: kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror.DestinationEntry
+	(*Selector)(nil),               // 29: kuma.mesh.v1alpha1.Selector
+	(*wrapperspb.UInt32Value)(nil), // 30: google.protobuf.UInt32Value
+	(*durationpb.Duration)(nil),    // 31: google.protobuf.Duration
+	(*wrapperspb.DoubleValue)(nil), // 32: google.protobuf.DoubleValue
 }
 var file_mesh_v1alpha1_traffic_route_proto_depIdxs = []int32{
-	23, // 0: kuma.mesh.v1alpha1.TrafficRoute.sources:type_name -> kuma.mesh.v1alpha1.Selector
-	23, // 1: kuma.mesh.v1alpha1.TrafficRoute.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	29, // 0: kuma.mesh.v1alpha1.TrafficRoute.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	29, // 1: kuma.mesh.v1alpha1.TrafficRoute.destinations:type_name -> kuma.mesh.v1alpha1.Selector
 	3,  // 2: kuma.mesh.v1alpha1.TrafficRoute.conf:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Conf
-	24, // 3: kuma.mesh.v1alpha1.TrafficRoute.Split.weight:type_name -> google.protobuf.UInt32Value
+	30, // 3: kuma.mesh.v1alpha1.TrafficRoute.Split.weight:type_name -> google.protobuf.UInt32Value
 	5,  // 4: kuma.mesh.v1alpha1.TrafficRoute.Split.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split.DestinationEntry
 	6,  // 5: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.round_robin:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RoundRobin
 	7,  // 6: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.least_request:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.LeastRequest
 	8,  // 7: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.ring_hash:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RingHash
 	9,  // 8: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.random:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Random
 	10, // 9: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.maglev:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Maglev
-	1,  // 10: kuma.mesh.v1alpha1.TrafficRoute.Conf.split:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split
-	2,  // 11: kuma.mesh.v1alpha1.TrafficRoute.Conf.load_balancer:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer
-	11, // 12: kuma.mesh.v1alpha1.TrafficRoute.Conf.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Conf.DestinationEntry
-	4,  // 13: kuma.mesh.v1alpha1.TrafficRoute.Conf.http:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http
-	12, // 14: kuma.mesh.v1alpha1.TrafficRoute.Http.match:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match
-	13, // 15: kuma.mesh.v1alpha1.TrafficRoute.Http.modify:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify
-	1,  // 16: kuma.mesh.v1alpha1.TrafficRoute.Http.split:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split
-	14, // 17: kuma.mesh.v1alpha1.TrafficRoute.Http.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.DestinationEntry
-	15, // 18: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.method:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
-	15, // 19: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.path:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
-	16, // 20: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.headers:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry
-	18, // 21: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.path:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path
-	19, // 22: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.host:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host
-	20, // 23: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.requestHeaders:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
-	20, // 24: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.responseHeaders:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
-	15, // 25: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry.value:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
-	17, // 26: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path.regex:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
-	17, // 27: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host.fromPath:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
-	21, // 28: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.add:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Add
-	22, // 29: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.remove:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Remove
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	11, // 10: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.hash_policies:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy
+	1,  // 11: kuma.mesh.v1alpha1.TrafficRoute.Conf.split:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split
+	2,  // 12: kuma.mesh.v1alpha1.TrafficRoute.Conf.load_balancer:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer
+	15, // 13: kuma.mesh.v1alpha1.TrafficRoute.Conf.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Conf.DestinationEntry
+	4,  // 14: kuma.mesh.v1alpha1.TrafficRoute.Conf.http:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http
+	16, // 15: kuma.mesh.v1alpha1.TrafficRoute.Http.match:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match
+	17, // 16: kuma.mesh.v1alpha1.TrafficRoute.Http.modify:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify
+	1,  // 17: kuma.mesh.v1alpha1.TrafficRoute.Http.split:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Split
+	19, // 18: kuma.mesh.v1alpha1.TrafficRoute.Http.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.DestinationEntry
+	18, // 19: kuma.mesh.v1alpha1.TrafficRoute.Http.mirror:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror
+	12, // 20: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.header:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Header
+	13, // 21: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.cookie:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Cookie
+	14, // 22: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.source_ip:type_name -> kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.SourceIp
+	31, // 23: kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Cookie.ttl:type_name -> google.protobuf.Duration
+	20, // 24: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.method:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	20, // 25: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.path:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	21, // 26: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.headers:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry
+	23, // 27: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.path:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path
+	24, // 28: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.host:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host
+	25, // 29: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.requestHeaders:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
+	25, // 30: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.responseHeaders:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers
+	28, // 31: kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror.destination:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror.DestinationEntry
+	32, // 32: kuma.mesh.v1alpha1.TrafficRoute.Http.Mirror.percentage:type_name -> google.protobuf.DoubleValue
+	20, // 33: kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry.value:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher
+	22, // 34: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Path.regex:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
+	22, // 35: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Host.fromPath:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.RegexReplace
+	26, // 36: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.add:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Add
+	27, // 37: kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.remove:type_name -> kuma.mesh.v1alpha1.TrafficRoute.Http.Modify.Headers.Remove
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_mesh_v1alpha1_traffic_route_proto_init() }
@@ -1774,8 +2208,20 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_LoadBalancer_HashPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 		file_mesh_v1alpha1_traffic_route_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficRoute_Http_Match); i {
+			switch v := v.(*TrafficRoute_LoadBalancer_HashPolicy_Header); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1787,6 +2233,42 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 			}
 		}
 		file_mesh_v1alpha1_traffic_route_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_LoadBalancer_HashPolicy_Cookie); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_LoadBalancer_HashPolicy_SourceIp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_Http_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify); i {
 			case 0:
 				return &v.state
@@ -1798,7 +2280,19 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRoute_Http_Mirror); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Match_StringMatcher); i {
 			case 0:
 				return &v.state
@@ -1810,7 +2304,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_RegexReplace); i {
 			case 0:
 				return &v.state
@@ -1822,7 +2316,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_Path); i {
 			case 0:
 				return &v.state
@@ -1834,7 +2328,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_Host); i {
 			case 0:
 				return &v.state
@@ -1846,7 +2340,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_Headers); i {
 			case 0:
 				return &v.state
@@ -1858,7 +2352,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_Headers_Add); i {
 			case 0:
 				return &v.state
@@ -1870,7 +2364,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 				return nil
 			}
 		}
-		file_mesh_v1alpha1_traffic_route_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_mesh_v1alpha1_traffic_route_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficRoute_Http_Modify_Headers_Remove); i {
 			case 0:
 				return &v.state
@@ -1890,16 +2384,21 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 		(*TrafficRoute_LoadBalancer_Random_)(nil),
 		(*TrafficRoute_LoadBalancer_Maglev_)(nil),
 	}
-	file_mesh_v1alpha1_traffic_route_proto_msgTypes[15].OneofWrappers = []interface{}{
+	file_mesh_v1alpha1_traffic_route_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*TrafficRoute_LoadBalancer_HashPolicy_Header_)(nil),
+		(*TrafficRoute_LoadBalancer_HashPolicy_Cookie_)(nil),
+		(*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_)(nil),
+	}
+	file_mesh_v1alpha1_traffic_route_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*TrafficRoute_Http_Match_StringMatcher_Prefix)(nil),
 		(*TrafficRoute_Http_Match_StringMatcher_Exact)(nil),
 		(*TrafficRoute_Http_Match_StringMatcher_Regex)(nil),
 	}
-	file_mesh_v1alpha1_traffic_route_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_mesh_v1alpha1_traffic_route_proto_msgTypes[23].OneofWrappers = []interface{}{
 		(*TrafficRoute_Http_Modify_Path_RewritePrefix)(nil),
 		(*TrafficRoute_Http_Modify_Path_Regex)(nil),
 	}
-	file_mesh_v1alpha1_traffic_route_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_mesh_v1alpha1_traffic_route_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*TrafficRoute_Http_Modify_Host_Value)(nil),
 		(*TrafficRoute_Http_Modify_Host_FromPath)(nil),
 	}
@@ -1909,7 +2408,7 @@ func file_mesh_v1alpha1_traffic_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mesh_v1alpha1_traffic_route_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -34,6 +34,18 @@ func (x *TrafficRoute_Http) GetSplitWithDestination() []*TrafficRoute_Split {
 	return x.GetSplit()
 }
 
+func (x *TrafficRoute_Http_Mirror) GetSplitWithDestination() []*TrafficRoute_Split {
+	if len(x.GetDestination()) > 0 {
+		return []*TrafficRoute_Split{
+			{
+				Weight:      util_proto.UInt32(1),
+				Destination: x.GetDestination(),
+			},
+		}
+	}
+	return nil
+}
+
 func (x *TrafficRoute_Conf) GetSplitOrdered() []*TrafficRoute_Split {
 	c := make([]*TrafficRoute_Split, len(x.GetSplitWithDestination()))
 	copy(c, x.GetSplitWithDestination())
@@ -0,0 +1,665 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/wasm_plugin.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	v1alpha1 "github.com/kumahq/kuma/api/system/v1alpha1"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Position defines where the filter is inserted relative to the HTTP
+// filters generated by Kuma (fault injection, rate limit, gRPC stats
+// etc.). Plugins with the same position keep the order of the list.
+type MeshWasmPlugin_Conf_Plugin_Position int32
+
+const (
+	// Inserted before all HTTP filters generated by Kuma.
+	MeshWasmPlugin_Conf_Plugin_BEFORE_KUMA MeshWasmPlugin_Conf_Plugin_Position = 0
+	// Inserted after all HTTP filters generated by Kuma, right before
+	// the router.
+	MeshWasmPlugin_Conf_Plugin_AFTER_KUMA MeshWasmPlugin_Conf_Plugin_Position = 1
+)
+
+// Enum value maps for MeshWasmPlugin_Conf_Plugin_Position.
+var (
+	MeshWasmPlugin_Conf_Plugin_Position_name = map[int32]string{
+		0: "BEFORE_KUMA",
+		1: "AFTER_KUMA",
+	}
+	MeshWasmPlugin_Conf_Plugin_Position_value = map[string]int32{
+		"BEFORE_KUMA": 0,
+		"AFTER_KUMA":  1,
+	}
+)
+
+func (x MeshWasmPlugin_Conf_Plugin_Position) Enum() *MeshWasmPlugin_Conf_Plugin_Position {
+	p := new(MeshWasmPlugin_Conf_Plugin_Position)
+	*p = x
+	return p
+}
+
+func (x MeshWasmPlugin_Conf_Plugin_Position) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MeshWasmPlugin_Conf_Plugin_Position) Descriptor() protoreflect.EnumDescriptor {
+	return file_mesh_v1alpha1_wasm_plugin_proto_enumTypes[0].Descriptor()
+}
+
+func (MeshWasmPlugin_Conf_Plugin_Position) Type() protoreflect.EnumType {
+	return &file_mesh_v1alpha1_wasm_plugin_proto_enumTypes[0]
+}
+
+func (x MeshWasmPlugin_Conf_Plugin_Position) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MeshWasmPlugin_Conf_Plugin_Position.Descriptor instead.
+func (MeshWasmPlugin_Conf_Plugin_Position) EnumDescriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_wasm_plugin_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+// MeshWasmPlugin defines WASM HTTP filters inserted into the listeners of the
+// selected dataplanes.
+type MeshWasmPlugin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes.
+	Selectors []*Selector `protobuf:"bytes,1,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// Configuration of the plugins.
+	Conf *MeshWasmPlugin_Conf `protobuf:"bytes,2,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *MeshWasmPlugin) Reset() {
+	*x = MeshWasmPlugin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshWasmPlugin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshWasmPlugin) ProtoMessage() {}
+
+func (x *MeshWasmPlugin) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshWasmPlugin.ProtoReflect.Descriptor instead.
+func (*MeshWasmPlugin) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_wasm_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeshWasmPlugin) GetSelectors() []*Selector {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
+}
+
+func (x *MeshWasmPlugin) GetConf() *MeshWasmPlugin_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines the plugins.
+type MeshWasmPlugin_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Plugins inserted into HTTP inbound listeners.
+	Inbound []*MeshWasmPlugin_Conf_Plugin `protobuf:"bytes,1,rep,name=inbound,proto3" json:"inbound,omitempty"`
+	// Plugins inserted into HTTP outbound listeners.
+	Outbound []*MeshWasmPlugin_Conf_Plugin `protobuf:"bytes,2,rep,name=outbound,proto3" json:"outbound,omitempty"`
+}
+
+func (x *MeshWasmPlugin_Conf) Reset() {
+	*x = MeshWasmPlugin_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshWasmPlugin_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshWasmPlugin_Conf) ProtoMessage() {}
+
+func (x *MeshWasmPlugin_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshWasmPlugin_Conf.ProtoReflect.Descriptor instead.
+func (*MeshWasmPlugin_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_wasm_plugin_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MeshWasmPlugin_Conf) GetInbound() []*MeshWasmPlugin_Conf_Plugin {
+	if x != nil {
+		return x.Inbound
+	}
+	return nil
+}
+
+func (x *MeshWasmPlugin_Conf) GetOutbound() []*MeshWasmPlugin_Conf_Plugin {
+	if x != nil {
+		return x.Outbound
+	}
+	return nil
+}
+
+// Plugin defines a single WASM HTTP filter.
+type MeshWasmPlugin_Conf_Plugin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the plugin, used as the name of the HTTP filter.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Root ID of the plugin, has to match the root ID of the module if it
+	// defines more than one root context.
+	RootId string `protobuf:"bytes,2,opt,name=rootId,proto3" json:"rootId,omitempty"`
+	// Module of the plugin.
+	Module *MeshWasmPlugin_Conf_Plugin_Module `protobuf:"bytes,3,opt,name=module,proto3" json:"module,omitempty"`
+	// Configuration passed to the plugin as a string.
+	Configuration string `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	// Position of the filter in the HTTP filter chain.
+	Position MeshWasmPlugin_Conf_Plugin_Position `protobuf:"varint,5,opt,name=position,proto3,enum=kuma.mesh.v1alpha1.MeshWasmPlugin_Conf_Plugin_Position" json:"position,omitempty"`
+	// If true, requests are passed through when the plugin fails,
+	// otherwise they are rejected.
+	FailOpen bool `protobuf:"varint,6,opt,name=failOpen,proto3" json:"failOpen,omitempty"`
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin) Reset() {
+	*x = MeshWasmPlugin_Conf_Plugin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshWasmPlugin_Conf_Plugin) ProtoMessage() {}
+
+func (x *MeshWasmPlugin_Conf_Plugin) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshWasmPlugin_Conf_Plugin.ProtoReflect.Descriptor instead.
+func (*MeshWasmPlugin_Conf_Plugin) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_wasm_plugin_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin) GetRootId() string {
+	if x != nil {
+		return x.RootId
+	}
+	return ""
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin) GetModule() *MeshWasmPlugin_Conf_Plugin_Module {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin) GetConfiguration() string {
+	if x != nil {
+		return x.Configuration
+	}
+	return ""
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin) GetPosition() MeshWasmPlugin_Conf_Plugin_Position {
+	if x != nil {
+		return x.Position
+	}
+	return MeshWasmPlugin_Conf_Plugin_BEFORE_KUMA
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin) GetFailOpen() bool {
+	if x != nil {
+		return x.FailOpen
+	}
+	return false
+}
+
+// Module defines where the WASM module is fetched from.
+type MeshWasmPlugin_Conf_Plugin_Module struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Source:
+	//	*MeshWasmPlugin_Conf_Plugin_Module_Local
+	//	*MeshWasmPlugin_Conf_Plugin_Module_Remote_
+	Source isMeshWasmPlugin_Conf_Plugin_Module_Source `protobuf_oneof:"source"`
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module) Reset() {
+	*x = MeshWasmPlugin_Conf_Plugin_Module{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshWasmPlugin_Conf_Plugin_Module) ProtoMessage() {}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshWasmPlugin_Conf_Plugin_Module.ProtoReflect.Descriptor instead.
+func (*MeshWasmPlugin_Conf_Plugin_Module) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_wasm_plugin_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (m *MeshWasmPlugin_Conf_Plugin_Module) GetSource() isMeshWasmPlugin_Conf_Plugin_Module_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module) GetLocal() *v1alpha1.DataSource {
+	if x, ok := x.GetSource().(*MeshWasmPlugin_Conf_Plugin_Module_Local); ok {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module) GetRemote() *MeshWasmPlugin_Conf_Plugin_Module_Remote {
+	if x, ok := x.GetSource().(*MeshWasmPlugin_Conf_Plugin_Module_Remote_); ok {
+		return x.Remote
+	}
+	return nil
+}
+
+type isMeshWasmPlugin_Conf_Plugin_Module_Source interface {
+	isMeshWasmPlugin_Conf_Plugin_Module_Source()
+}
+
+type MeshWasmPlugin_Conf_Plugin_Module_Local struct {
+	// Module loaded by the control plane from a mesh Secret or inline
+	// bytes and sent to Envoy in the listener configuration.
+	Local *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=local,proto3,oneof"`
+}
+
+type MeshWasmPlugin_Conf_Plugin_Module_Remote_ struct {
+	// Module fetched by Envoy over HTTP(S). Images from OCI registries
+	// can be used by referencing the blob of the module layer, for
+	// example https://registry/v2/<repository>/blobs/sha256:<digest>.
+	Remote *MeshWasmPlugin_Conf_Plugin_Module_Remote `protobuf:"bytes,2,opt,name=remote,proto3,oneof"`
+}
+
+func (*MeshWasmPlugin_Conf_Plugin_Module_Local) isMeshWasmPlugin_Conf_Plugin_Module_Source() {}
+
+func (*MeshWasmPlugin_Conf_Plugin_Module_Remote_) isMeshWasmPlugin_Conf_Plugin_Module_Source() {}
+
+// Remote defines a module fetched by Envoy over HTTP(S).
+type MeshWasmPlugin_Conf_Plugin_Module_Remote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL of the module.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// SHA256 of the module, used to verify its integrity.
+	Sha256 string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Timeout of fetching the module. Defaults to 10s.
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module_Remote) Reset() {
+	*x = MeshWasmPlugin_Conf_Plugin_Module_Remote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module_Remote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshWasmPlugin_Conf_Plugin_Module_Remote) ProtoMessage() {}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module_Remote) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshWasmPlugin_Conf_Plugin_Module_Remote.ProtoReflect.Descriptor instead.
+func (*MeshWasmPlugin_Conf_Plugin_Module_Remote) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_wasm_plugin_proto_rawDescGZIP(), []int{0, 0, 0, 0, 0}
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module_Remote) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module_Remote) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *MeshWasmPlugin_Conf_Plugin_Module_Remote) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_wasm_plugin_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_wasm_plugin_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x77, 0x61, 0x73, 0x6d, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x08, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x68,
+	0x57, 0x61, 0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18,
+	0x01, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x04,
+	0x63, 0x6f, 0x6e, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x6e, 0x66, 0x1a,
+	0x8e, 0x06, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x48, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x4a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x57, 0x61,
+	0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0xef,
+	0x04, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x06, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x65,
+	0x73, 0x68, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x1a, 0x99, 0x02, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x56, 0x0a, 0x06,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x1a, 0x73, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18,
+	0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x22, 0x2b, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0f, 0x0a, 0x0b, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x5f, 0x4b, 0x55, 0x4d, 0x41, 0x10, 0x00,
+	0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x4b, 0x55, 0x4d, 0x41, 0x10, 0x01,
+	0x3a, 0x5d, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x57, 0x0a, 0x16, 0x4d, 0x65, 0x73, 0x68, 0x57, 0x61,
+	0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x0e, 0x4d, 0x65, 0x73, 0x68, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x22, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x3a, 0x21, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x68, 0x77, 0x61,
+	0x73, 0x6d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x0f, 0x6d, 0x65, 0x73, 0x68, 0x77, 0x61,
+	0x73, 0x6d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x02, 0x10, 0x01, 0x68, 0x01, 0x42,
+	0x52, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75,
+	0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x24, 0x50,
+	0x01, 0xa2, 0x01, 0x0e, 0x4d, 0x65, 0x73, 0x68, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0xf2, 0x01, 0x0e, 0x6d, 0x65, 0x73, 0x68, 0x77, 0x61, 0x73, 0x6d, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_wasm_plugin_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_wasm_plugin_proto_rawDescData = file_mesh_v1alpha1_wasm_plugin_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_wasm_plugin_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_wasm_plugin_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_wasm_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_wasm_plugin_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_wasm_plugin_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_wasm_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mesh_v1alpha1_wasm_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_mesh_v1alpha1_wasm_plugin_proto_goTypes = []interface{}{
+	(MeshWasmPlugin_Conf_Plugin_Position)(0),         // 0: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.Position
+	(*MeshWasmPlugin)(nil),                           // 1: kuma.mesh.v1alpha1.MeshWasmPlugin
+	(*MeshWasmPlugin_Conf)(nil),                      // 2: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf
+	(*MeshWasmPlugin_Conf_Plugin)(nil),               // 3: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin
+	(*MeshWasmPlugin_Conf_Plugin_Module)(nil),        // 4: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.Module
+	(*MeshWasmPlugin_Conf_Plugin_Module_Remote)(nil), // 5: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.Module.Remote
+	(*Selector)(nil),                                 // 6: kuma.mesh.v1alpha1.Selector
+	(*v1alpha1.DataSource)(nil),                      // 7: kuma.system.v1alpha1.DataSource
+	(*durationpb.Duration)(nil),                      // 8: google.protobuf.Duration
+}
+var file_mesh_v1alpha1_wasm_plugin_proto_depIdxs = []int32{
+	6, // 0: kuma.mesh.v1alpha1.MeshWasmPlugin.selectors:type_name -> kuma.mesh.v1alpha1.Selector
+	2, // 1: kuma.mesh.v1alpha1.MeshWasmPlugin.conf:type_name -> kuma.mesh.v1alpha1.MeshWasmPlugin.Conf
+	3, // 2: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.inbound:type_name -> kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin
+	3, // 3: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.outbound:type_name -> kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin
+	4, // 4: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.module:type_name -> kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.Module
+	0, // 5: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.position:type_name -> kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.Position
+	7, // 6: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.Module.local:type_name -> kuma.system.v1alpha1.DataSource
+	5, // 7: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.Module.remote:type_name -> kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.Module.Remote
+	8, // 8: kuma.mesh.v1alpha1.MeshWasmPlugin.Conf.Plugin.Module.Remote.timeout:type_name -> google.protobuf.Duration
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_wasm_plugin_proto_init() }
+func file_mesh_v1alpha1_wasm_plugin_proto_init() {
+	if File_mesh_v1alpha1_wasm_plugin_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshWasmPlugin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshWasmPlugin_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshWasmPlugin_Conf_Plugin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshWasmPlugin_Conf_Plugin_Module); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshWasmPlugin_Conf_Plugin_Module_Remote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mesh_v1alpha1_wasm_plugin_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*MeshWasmPlugin_Conf_Plugin_Module_Local)(nil),
+		(*MeshWasmPlugin_Conf_Plugin_Module_Remote_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_wasm_plugin_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_wasm_plugin_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_wasm_plugin_proto_depIdxs,
+		EnumInfos:         file_mesh_v1alpha1_wasm_plugin_proto_enumTypes,
+		MessageInfos:      file_mesh_v1alpha1_wasm_plugin_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_wasm_plugin_proto = out.File
+	file_mesh_v1alpha1_wasm_plugin_proto_rawDesc = nil
+	file_mesh_v1alpha1_wasm_plugin_proto_goTypes = nil
+	file_mesh_v1alpha1_wasm_plugin_proto_depIdxs = nil
+}
@@ -0,0 +1,370 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: mesh/v1alpha1/zone_traffic_route.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	_ "github.com/kumahq/protoc-gen-kumadoc/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MeshZoneTrafficRoute defines the priorities and the weights of the zones
+// that the traffic to the destination services is load balanced to. The
+// traffic to other zones goes through their zone ingresses.
+type MeshZoneTrafficRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of selectors to match dataplanes that are sources of traffic.
+	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// List of selectors to match services that are destinations of traffic.
+	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Configuration of the zones the traffic is routed to.
+	Conf *MeshZoneTrafficRoute_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+}
+
+func (x *MeshZoneTrafficRoute) Reset() {
+	*x = MeshZoneTrafficRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshZoneTrafficRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshZoneTrafficRoute) ProtoMessage() {}
+
+func (x *MeshZoneTrafficRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshZoneTrafficRoute.ProtoReflect.Descriptor instead.
+func (*MeshZoneTrafficRoute) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_zone_traffic_route_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MeshZoneTrafficRoute) GetSources() []*Selector {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *MeshZoneTrafficRoute) GetDestinations() []*Selector {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *MeshZoneTrafficRoute) GetConf() *MeshZoneTrafficRoute_Conf {
+	if x != nil {
+		return x.Conf
+	}
+	return nil
+}
+
+// Configuration defines the zones the traffic is routed to.
+type MeshZoneTrafficRoute_Conf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Zones that the traffic is routed to. The zones that are not listed,
+	// including the zone of the source when it is not listed, have the
+	// lowest priority unless the '*' zone is listed.
+	Zones []*MeshZoneTrafficRoute_Conf_Zone `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+}
+
+func (x *MeshZoneTrafficRoute_Conf) Reset() {
+	*x = MeshZoneTrafficRoute_Conf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshZoneTrafficRoute_Conf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshZoneTrafficRoute_Conf) ProtoMessage() {}
+
+func (x *MeshZoneTrafficRoute_Conf) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshZoneTrafficRoute_Conf.ProtoReflect.Descriptor instead.
+func (*MeshZoneTrafficRoute_Conf) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_zone_traffic_route_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MeshZoneTrafficRoute_Conf) GetZones() []*MeshZoneTrafficRoute_Conf_Zone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+// Zone defines how the traffic is routed to the zone.
+type MeshZoneTrafficRoute_Conf_Zone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the zone. The zone named '*' matches all the zones that are
+	// not listed.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Priority of the zone. The traffic is routed to the zones of the
+	// highest priority (the lowest number) and fails over to the zones of
+	// the next priority when they do not have enough healthy endpoints.
+	Priority uint32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Weight of the zone. The traffic is split between the zones of the
+	// same priority proportionally to their weights. When no zone has a
+	// weight, the traffic is split proportionally to the number of the
+	// endpoints of the zones.
+	Weight *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *MeshZoneTrafficRoute_Conf_Zone) Reset() {
+	*x = MeshZoneTrafficRoute_Conf_Zone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshZoneTrafficRoute_Conf_Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshZoneTrafficRoute_Conf_Zone) ProtoMessage() {}
+
+func (x *MeshZoneTrafficRoute_Conf_Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshZoneTrafficRoute_Conf_Zone.ProtoReflect.Descriptor instead.
+func (*MeshZoneTrafficRoute_Conf_Zone) Descriptor() ([]byte, []int) {
+	return file_mesh_v1alpha1_zone_traffic_route_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *MeshZoneTrafficRoute_Conf_Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeshZoneTrafficRoute_Conf_Zone) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MeshZoneTrafficRoute_Conf_Zone) GetWeight() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+var File_mesh_v1alpha1_zone_traffic_route_proto protoreflect.FileDescriptor
+
+var file_mesh_v1alpha1_zone_traffic_route_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65,
+	0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa9, 0x04, 0x0a,
+	0x14, 0x4d, 0x65, 0x73, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x0c, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x04, 0x63,
+	0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04,
+	0x63, 0x6f, 0x6e, 0x66, 0x1a, 0xca, 0x01, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4e, 0x0a,
+	0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b,
+	0x75, 0x6d, 0x61, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65,
+	0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x1a, 0x72, 0x0a,
+	0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x3a, 0x75, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x6f, 0x0a, 0x1c, 0x4d, 0x65, 0x73, 0x68, 0x5a,
+	0x6f, 0x6e, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x4d, 0x65, 0x73, 0x68, 0x5a, 0x6f, 0x6e,
+	0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x04, 0x6d,
+	0x65, 0x73, 0x68, 0x3a, 0x2d, 0x0a, 0x14, 0x6d, 0x65, 0x73, 0x68, 0x7a, 0x6f, 0x6e, 0x65, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x15, 0x6d, 0x65, 0x73,
+	0x68, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x02, 0x10, 0x01, 0x68, 0x01, 0x42, 0x5e, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75,
+	0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x8a, 0xb5, 0x18, 0x30, 0x50, 0x01, 0xa2, 0x01, 0x14, 0x4d, 0x65, 0x73,
+	0x68, 0x5a, 0x6f, 0x6e, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0xf2, 0x01, 0x14, 0x6d, 0x65, 0x73, 0x68, 0x7a, 0x6f, 0x6e, 0x65, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mesh_v1alpha1_zone_traffic_route_proto_rawDescOnce sync.Once
+	file_mesh_v1alpha1_zone_traffic_route_proto_rawDescData = file_mesh_v1alpha1_zone_traffic_route_proto_rawDesc
+)
+
+func file_mesh_v1alpha1_zone_traffic_route_proto_rawDescGZIP() []byte {
+	file_mesh_v1alpha1_zone_traffic_route_proto_rawDescOnce.Do(func() {
+		file_mesh_v1alpha1_zone_traffic_route_proto_rawDescData = protoimpl.X.CompressGZIP(file_mesh_v1alpha1_zone_traffic_route_proto_rawDescData)
+	})
+	return file_mesh_v1alpha1_zone_traffic_route_proto_rawDescData
+}
+
+var file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_mesh_v1alpha1_zone_traffic_route_proto_goTypes = []interface{}{
+	(*MeshZoneTrafficRoute)(nil),           // 0: kuma.mesh.v1alpha1.MeshZoneTrafficRoute
+	(*MeshZoneTrafficRoute_Conf)(nil),      // 1: kuma.mesh.v1alpha1.MeshZoneTrafficRoute.Conf
+	(*MeshZoneTrafficRoute_Conf_Zone)(nil), // 2: kuma.mesh.v1alpha1.MeshZoneTrafficRoute.Conf.Zone
+	(*Selector)(nil),                       // 3: kuma.mesh.v1alpha1.Selector
+	(*wrapperspb.UInt32Value)(nil),         // 4: google.protobuf.UInt32Value
+}
+var file_mesh_v1alpha1_zone_traffic_route_proto_depIdxs = []int32{
+	3, // 0: kuma.mesh.v1alpha1.MeshZoneTrafficRoute.sources:type_name -> kuma.mesh.v1alpha1.Selector
+	3, // 1: kuma.mesh.v1alpha1.MeshZoneTrafficRoute.destinations:type_name -> kuma.mesh.v1alpha1.Selector
+	1, // 2: kuma.mesh.v1alpha1.MeshZoneTrafficRoute.conf:type_name -> kuma.mesh.v1alpha1.MeshZoneTrafficRoute.Conf
+	2, // 3: kuma.mesh.v1alpha1.MeshZoneTrafficRoute.Conf.zones:type_name -> kuma.mesh.v1alpha1.MeshZoneTrafficRoute.Conf.Zone
+	4, // 4: kuma.mesh.v1alpha1.MeshZoneTrafficRoute.Conf.Zone.weight:type_name -> google.protobuf.UInt32Value
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_mesh_v1alpha1_zone_traffic_route_proto_init() }
+func file_mesh_v1alpha1_zone_traffic_route_proto_init() {
+	if File_mesh_v1alpha1_zone_traffic_route_proto != nil {
+		return
+	}
+	file_mesh_v1alpha1_selector_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshZoneTrafficRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshZoneTrafficRoute_Conf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshZoneTrafficRoute_Conf_Zone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mesh_v1alpha1_zone_traffic_route_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mesh_v1alpha1_zone_traffic_route_proto_goTypes,
+		DependencyIndexes: file_mesh_v1alpha1_zone_traffic_route_proto_depIdxs,
+		MessageInfos:      file_mesh_v1alpha1_zone_traffic_route_proto_msgTypes,
+	}.Build()
+	File_mesh_v1alpha1_zone_traffic_route_proto = out.File
+	file_mesh_v1alpha1_zone_traffic_route_proto_rawDesc = nil
+	file_mesh_v1alpha1_zone_traffic_route_proto_goTypes = nil
+	file_mesh_v1alpha1_zone_traffic_route_proto_depIdxs = nil
+}
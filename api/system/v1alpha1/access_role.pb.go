@@ -0,0 +1,314 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: system/v1alpha1/access_role.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Access defines what can be done with the resources.
+type AccessRole_Access int32
+
+const (
+	// READ allows to get and list resources.
+	AccessRole_READ AccessRole_Access = 0
+	// WRITE allows to create, update and delete resources. It also allows
+	// to get and list them.
+	AccessRole_WRITE AccessRole_Access = 1
+	// GENERATE_DATAPLANE_TOKEN allows to generate dataplane tokens for the
+	// meshes of the rule. Zone ingress tokens can be generated only with the
+	// rule that applies to all meshes.
+	AccessRole_GENERATE_DATAPLANE_TOKEN AccessRole_Access = 2
+)
+
+// Enum value maps for AccessRole_Access.
+var (
+	AccessRole_Access_name = map[int32]string{
+		0: "READ",
+		1: "WRITE",
+		2: "GENERATE_DATAPLANE_TOKEN",
+	}
+	AccessRole_Access_value = map[string]int32{
+		"READ":                     0,
+		"WRITE":                    1,
+		"GENERATE_DATAPLANE_TOKEN": 2,
+	}
+)
+
+func (x AccessRole_Access) Enum() *AccessRole_Access {
+	p := new(AccessRole_Access)
+	*p = x
+	return p
+}
+
+func (x AccessRole_Access) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessRole_Access) Descriptor() protoreflect.EnumDescriptor {
+	return file_system_v1alpha1_access_role_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessRole_Access) Type() protoreflect.EnumType {
+	return &file_system_v1alpha1_access_role_proto_enumTypes[0]
+}
+
+func (x AccessRole_Access) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessRole_Access.Descriptor instead.
+func (AccessRole_Access) EnumDescriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// AccessRole defines a set of permissions to resources of the Control Plane.
+// The role is granted to users and groups with AccessRoleBinding.
+type AccessRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rules of the role.
+	Rules []*AccessRole_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *AccessRole) Reset() {
+	*x = AccessRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_access_role_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRole) ProtoMessage() {}
+
+func (x *AccessRole) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_access_role_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRole.ProtoReflect.Descriptor instead.
+func (*AccessRole) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessRole) GetRules() []*AccessRole_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Rule grants access to resources of given types in given meshes.
+type AccessRole_Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types of resources, e.g. TrafficPermission. If empty, the rule applies
+	// to all types except the admin types (Secret, GlobalSecret, AccessRole
+	// and AccessRoleBinding), which have to be listed explicitly.
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// Meshes of resources. If empty, the rule applies to all meshes.
+	// Mesh resources are matched by their names, other resources of global
+	// scope are matched only by the rule that applies to all meshes.
+	Meshes []string `protobuf:"bytes,2,rep,name=meshes,proto3" json:"meshes,omitempty"`
+	// Access granted by the rule.
+	Access []AccessRole_Access `protobuf:"varint,3,rep,packed,name=access,proto3,enum=kuma.system.v1alpha1.AccessRole_Access" json:"access,omitempty"`
+}
+
+func (x *AccessRole_Rule) Reset() {
+	*x = AccessRole_Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_access_role_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRole_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRole_Rule) ProtoMessage() {}
+
+func (x *AccessRole_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_access_role_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRole_Rule.ProtoReflect.Descriptor instead.
+func (*AccessRole_Rule) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AccessRole_Rule) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *AccessRole_Rule) GetMeshes() []string {
+	if x != nil {
+		return x.Meshes
+	}
+	return nil
+}
+
+func (x *AccessRole_Rule) GetAccess() []AccessRole_Access {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+var File_system_v1alpha1_access_role_proto protoreflect.FileDescriptor
+
+var file_system_v1alpha1_access_role_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x02,
+	0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6b, 0x75,
+	0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x75, 0x0a, 0x04, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x12,
+	0x3f, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c,
+	0x65, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x3b, 0x0a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45,
+	0x41, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41,
+	0x50, 0x4c, 0x41, 0x4e, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x02, 0x3a, 0x45, 0xaa,
+	0x8c, 0x89, 0xa6, 0x01, 0x3f, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x22, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x3a,
+	0x0f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2d, 0x72, 0x6f, 0x6c, 0x65, 0x20, 0x01,
+	0x52, 0x02, 0x10, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_system_v1alpha1_access_role_proto_rawDescOnce sync.Once
+	file_system_v1alpha1_access_role_proto_rawDescData = file_system_v1alpha1_access_role_proto_rawDesc
+)
+
+func file_system_v1alpha1_access_role_proto_rawDescGZIP() []byte {
+	file_system_v1alpha1_access_role_proto_rawDescOnce.Do(func() {
+		file_system_v1alpha1_access_role_proto_rawDescData = protoimpl.X.CompressGZIP(file_system_v1alpha1_access_role_proto_rawDescData)
+	})
+	return file_system_v1alpha1_access_role_proto_rawDescData
+}
+
+var file_system_v1alpha1_access_role_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_system_v1alpha1_access_role_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_system_v1alpha1_access_role_proto_goTypes = []interface{}{
+	(AccessRole_Access)(0),  // 0: kuma.system.v1alpha1.AccessRole.Access
+	(*AccessRole)(nil),      // 1: kuma.system.v1alpha1.AccessRole
+	(*AccessRole_Rule)(nil), // 2: kuma.system.v1alpha1.AccessRole.Rule
+}
+var file_system_v1alpha1_access_role_proto_depIdxs = []int32{
+	2, // 0: kuma.system.v1alpha1.AccessRole.rules:type_name -> kuma.system.v1alpha1.AccessRole.Rule
+	0, // 1: kuma.system.v1alpha1.AccessRole.Rule.access:type_name -> kuma.system.v1alpha1.AccessRole.Access
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_system_v1alpha1_access_role_proto_init() }
+func file_system_v1alpha1_access_role_proto_init() {
+	if File_system_v1alpha1_access_role_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_system_v1alpha1_access_role_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_system_v1alpha1_access_role_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRole_Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_system_v1alpha1_access_role_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_system_v1alpha1_access_role_proto_goTypes,
+		DependencyIndexes: file_system_v1alpha1_access_role_proto_depIdxs,
+		EnumInfos:         file_system_v1alpha1_access_role_proto_enumTypes,
+		MessageInfos:      file_system_v1alpha1_access_role_proto_msgTypes,
+	}.Build()
+	File_system_v1alpha1_access_role_proto = out.File
+	file_system_v1alpha1_access_role_proto_rawDesc = nil
+	file_system_v1alpha1_access_role_proto_goTypes = nil
+	file_system_v1alpha1_access_role_proto_depIdxs = nil
+}
@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: system/v1alpha1/access_role_binding.proto
+
+package v1alpha1
+
+import (
+	_ "github.com/kumahq/kuma/api/mesh"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AccessRoleBinding grants AccessRoles to users and groups.
+type AccessRoleBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subjects that the roles are granted to.
+	Subjects []*AccessRoleBinding_Subject `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	// Names of AccessRoles granted to the subjects.
+	Roles []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *AccessRoleBinding) Reset() {
+	*x = AccessRoleBinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_access_role_binding_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRoleBinding) ProtoMessage() {}
+
+func (x *AccessRoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_access_role_binding_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRoleBinding.ProtoReflect.Descriptor instead.
+func (*AccessRoleBinding) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_binding_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessRoleBinding) GetSubjects() []*AccessRoleBinding_Subject {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *AccessRoleBinding) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Subject is a user or a group that the roles are granted to.
+type AccessRoleBinding_Subject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the subject, either "User" or "Group".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Name of the user or the group, e.g. john.doe@example.com or team-a.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *AccessRoleBinding_Subject) Reset() {
+	*x = AccessRoleBinding_Subject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_system_v1alpha1_access_role_binding_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessRoleBinding_Subject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRoleBinding_Subject) ProtoMessage() {}
+
+func (x *AccessRoleBinding_Subject) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1alpha1_access_role_binding_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRoleBinding_Subject.ProtoReflect.Descriptor instead.
+func (*AccessRoleBinding_Subject) Descriptor() ([]byte, []int) {
+	return file_system_v1alpha1_access_role_binding_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AccessRoleBinding_Subject) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AccessRoleBinding_Subject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_system_v1alpha1_access_role_binding_proto protoreflect.FileDescriptor
+
+var file_system_v1alpha1_access_role_binding_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x1a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86, 0x02, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x08, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x31,
+	0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x3a, 0x5b, 0xaa, 0x8c, 0x89, 0xa6, 0x01, 0x55, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x22, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x3a, 0x17, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2d, 0x72, 0x6f, 0x6c, 0x65,
+	0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x01, 0x52, 0x02, 0x10, 0x01, 0x42, 0x2c,
+	0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d,
+	0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_system_v1alpha1_access_role_binding_proto_rawDescOnce sync.Once
+	file_system_v1alpha1_access_role_binding_proto_rawDescData = file_system_v1alpha1_access_role_binding_proto_rawDesc
+)
+
+func file_system_v1alpha1_access_role_binding_proto_rawDescGZIP() []byte {
+	file_system_v1alpha1_access_role_binding_proto_rawDescOnce.Do(func() {
+		file_system_v1alpha1_access_role_binding_proto_rawDescData = protoimpl.X.CompressGZIP(file_system_v1alpha1_access_role_binding_proto_rawDescData)
+	})
+	return file_system_v1alpha1_access_role_binding_proto_rawDescData
+}
+
+var file_system_v1alpha1_access_role_binding_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_system_v1alpha1_access_role_binding_proto_goTypes = []interface{}{
+	(*AccessRoleBinding)(nil),         // 0: kuma.system.v1alpha1.AccessRoleBinding
+	(*AccessRoleBinding_Subject)(nil), // 1: kuma.system.v1alpha1.AccessRoleBinding.Subject
+}
+var file_system_v1alpha1_access_role_binding_proto_depIdxs = []int32{
+	1, // 0: kuma.system.v1alpha1.AccessRoleBinding.subjects:type_name -> kuma.system.v1alpha1.AccessRoleBinding.Subject
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_system_v1alpha1_access_role_binding_proto_init() }
+func file_system_v1alpha1_access_role_binding_proto_init() {
+	if File_system_v1alpha1_access_role_binding_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_system_v1alpha1_access_role_binding_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRoleBinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_system_v1alpha1_access_role_binding_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessRoleBinding_Subject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_system_v1alpha1_access_role_binding_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_system_v1alpha1_access_role_binding_proto_goTypes,
+		DependencyIndexes: file_system_v1alpha1_access_role_binding_proto_depIdxs,
+		MessageInfos:      file_system_v1alpha1_access_role_binding_proto_msgTypes,
+	}.Build()
+	File_system_v1alpha1_access_role_binding_proto = out.File
+	file_system_v1alpha1_access_role_binding_proto_rawDesc = nil
+	file_system_v1alpha1_access_role_binding_proto_goTypes = nil
+	file_system_v1alpha1_access_role_binding_proto_depIdxs = nil
+}
@@ -56,6 +56,24 @@ func (x *ZoneInsight) Sum(v func(*KDSSubscription) uint64) uint64 {
 	return result
 }
 
+// PendingChanges returns the number of responses that were sent and neither ACKed nor NACKed yet.
+func (x *KDSServiceStats) PendingChanges() uint64 {
+	answered := x.GetResponsesAcknowledged() + x.GetResponsesRejected()
+	if x.GetResponsesSent() < answered {
+		return 0
+	}
+	return x.GetResponsesSent() - answered
+}
+
+// Uptime returns for how long the subscription has been connected at the given time.
+// It returns zero for subscriptions that are disconnected.
+func (x *KDSSubscription) Uptime(now time.Time) time.Duration {
+	if x.GetConnectTime() == nil || x.GetDisconnectTime() != nil {
+		return 0
+	}
+	return now.Sub(x.GetConnectTime().AsTime())
+}
+
 func (x *ZoneInsight) UpdateSubscription(s generic.Subscription) error {
 	if x == nil {
 		return nil
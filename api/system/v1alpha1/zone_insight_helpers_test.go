@@ -66,4 +66,53 @@ var _ = Describe("Zone Insights", func() {
 			Expect(err.Error()).To(Equal("invalid type *v1alpha1.DiscoverySubscription for ZoneInsight"))
 		})
 	})
+
+	Context("PendingChanges", func() {
+		It("should count responses that were not answered yet", func() {
+			// given
+			stats := &system_proto.KDSServiceStats{
+				ResponsesSent:         5,
+				ResponsesAcknowledged: 2,
+				ResponsesRejected:     1,
+			}
+
+			// expect
+			Expect(stats.PendingChanges()).To(Equal(uint64(2)))
+		})
+
+		It("should not underflow", func() {
+			// given
+			stats := &system_proto.KDSServiceStats{
+				ResponsesAcknowledged: 1,
+			}
+
+			// expect
+			Expect(stats.PendingChanges()).To(Equal(uint64(0)))
+		})
+	})
+
+	Context("Uptime", func() {
+		t1, _ := time.Parse(time.RFC3339, "2018-07-17T16:05:36.995+00:00")
+
+		It("should return time since the connection", func() {
+			// given
+			subscription := &system_proto.KDSSubscription{
+				ConnectTime: util_proto.MustTimestampProto(t1),
+			}
+
+			// expect
+			Expect(subscription.Uptime(t1.Add(time.Hour))).To(Equal(time.Hour))
+		})
+
+		It("should return zero for disconnected subscription", func() {
+			// given
+			subscription := &system_proto.KDSSubscription{
+				ConnectTime:    util_proto.MustTimestampProto(t1),
+				DisconnectTime: util_proto.MustTimestampProto(t1.Add(time.Minute)),
+			}
+
+			// expect
+			Expect(subscription.Uptime(t1.Add(time.Hour))).To(BeZero())
+		})
+	})
 })
@@ -15,6 +15,7 @@ import (
 	"github.com/kumahq/kuma/pkg/defaults"
 	"github.com/kumahq/kuma/pkg/diagnostics"
 	dp_server "github.com/kumahq/kuma/pkg/dp-server"
+	envoy_admin_tunnel "github.com/kumahq/kuma/pkg/envoy/admin/tunnel/server"
 	"github.com/kumahq/kuma/pkg/gc"
 	"github.com/kumahq/kuma/pkg/hds"
 	"github.com/kumahq/kuma/pkg/insights"
@@ -95,6 +96,10 @@ func newRunCmdWithOpts(opts kuma_cmd.RunCmdOpts) *cobra.Command {
 				runLog.Error(err, "unable to set up HDS")
 				return err
 			}
+			if err := envoy_admin_tunnel.Setup(rt); err != nil {
+				runLog.Error(err, "unable to set up Envoy Admin Tunnel")
+				return err
+			}
 			if err := dp_server.SetupServer(rt); err != nil {
 				runLog.Error(err, "unable to set up DP Server")
 				return err
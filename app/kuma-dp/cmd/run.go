@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -13,6 +16,7 @@ import (
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/accesslogs"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/dnsserver"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoyadmin"
 	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/metrics"
 	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
 	"github.com/kumahq/kuma/pkg/config"
@@ -23,10 +27,12 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	envoy_admin "github.com/kumahq/kuma/pkg/envoy/admin"
 	util_net "github.com/kumahq/kuma/pkg/util/net"
 	"github.com/kumahq/kuma/pkg/util/proto"
 	kuma_version "github.com/kumahq/kuma/pkg/version"
 	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
+	envoy_xds "github.com/kumahq/kuma/pkg/xds/envoy"
 )
 
 var runLog = dataplaneLog.WithName("run")
@@ -224,13 +230,23 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 			if err != nil {
 				return errors.Errorf("Failed to generate Envoy bootstrap config. %v", err)
 			}
-			runLog.Info("received bootstrap configuration", "adminPort", bootstrap.GetAdmin().GetAddress().GetSocketAddress().GetPortValue())
+			opts.AdminPort = bootstrap.GetAdmin().GetAddress().GetSocketAddress().GetPortValue()
+			if adminPipe := bootstrap.GetAdmin().GetAddress().GetPipe(); adminPipe != nil {
+				opts.AdminSocketPath = adminPipe.GetPath()
+				// Envoy does not bind the admin port when Admin API is bound to the unix domain socket, kuma-dp exposes the admin listener on it
+				opts.AdminPort = core_xds.DataplaneMetadataFromXdsMetadata(bootstrap.GetNode().GetMetadata()).GetAdminPort()
+			}
+			runLog.Info("received bootstrap configuration", "adminPort", opts.AdminPort, "adminSocket", opts.AdminSocketPath)
 
 			opts.BootstrapConfig, err = proto.ToYAML(bootstrap)
 			if err != nil {
 				return errors.Errorf("could not convert to yaml. %v", err)
 			}
-			opts.AdminPort = bootstrap.GetAdmin().GetAddress().GetSocketAddress().GetPortValue()
+
+			// explicitly configured concurrency takes precedence over the one defined in Dataplane or Mesh
+			if opts.Config.DataplaneRuntime.Concurrency == 0 && kumaSidecarConfiguration != nil {
+				opts.Config.DataplaneRuntime.Concurrency = kumaSidecarConfiguration.Envoy.Concurrency
+			}
 
 			dataplane, err := envoy.New(opts)
 			if err != nil {
@@ -238,8 +254,24 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 			}
 
 			components = append(components, dataplane)
-			metricsServer := metrics.New(cfg.Dataplane, getApplicationsToScrape(kumaSidecarConfiguration, bootstrap.GetAdmin().GetAddress().GetSocketAddress().GetPortValue()))
+			metricsServer := metrics.New(cfg.Dataplane, getApplicationsToScrape(kumaSidecarConfiguration, opts.AdminPort, opts.AdminSocketPath))
 			components = append(components, metricsServer)
+			if opts.AdminSocketPath != "" {
+				adminProxyAddress := net.JoinHostPort("", strconv.Itoa(int(opts.AdminPort)))
+				components = append(components, envoyadmin.NewProxy(adminProxyAddress, envoy_xds.EnvoyAdminListenerSocketName(cfg.Dataplane.Name, cfg.Dataplane.Mesh)))
+			}
+			if cfg.DataplaneRuntime.EnvoyAdminTunnel {
+				adminHost := bootstrap.GetAdmin().GetAddress().GetSocketAddress().GetAddress()
+				if ip := net.ParseIP(adminHost); ip == nil || ip.IsUnspecified() {
+					adminHost = "127.0.0.1"
+				}
+				adminAddress := net.JoinHostPort(adminHost, strconv.Itoa(int(opts.AdminPort)))
+				var adminTransport http.RoundTripper
+				if opts.AdminSocketPath != "" {
+					adminTransport = envoy_admin.UnixSocketTransport(opts.AdminSocketPath)
+				}
+				components = append(components, envoyadmin.New(*cfg, adminAddress, adminTransport))
+			}
 
 			if err := rootCtx.ComponentManager.Add(components...); err != nil {
 				return err
@@ -282,7 +314,7 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 	cmd.PersistentFlags().StringVar(&cfg.ControlPlane.URL, "cp-address", cfg.ControlPlane.URL, "URL of the Control Plane Dataplane Server. Example: https://localhost:5678")
 	cmd.PersistentFlags().StringVar(&cfg.ControlPlane.CaCertFile, "ca-cert-file", cfg.ControlPlane.CaCertFile, "Path to CA cert by which connection to the Control Plane will be verified if HTTPS is used")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.BinaryPath, "binary-path", cfg.DataplaneRuntime.BinaryPath, "Binary path of Envoy executable")
-	cmd.PersistentFlags().Uint32Var(&cfg.DataplaneRuntime.Concurrency, "concurrency", cfg.DataplaneRuntime.Concurrency, "Number of Envoy worker threads")
+	cmd.PersistentFlags().Uint32Var(&cfg.DataplaneRuntime.Concurrency, "concurrency", cfg.DataplaneRuntime.Concurrency, "Number of Envoy worker threads. Takes precedence over envoyRuntime.concurrency of the Dataplane or the Mesh")
 	// todo(lobkovilya): delete deprecated bootstrap-version flag. Issue https://github.com/kumahq/kuma/issues/2986
 	cmd.PersistentFlags().StringVar(&bootstrapVersion, "bootstrap-version", "", "Bootstrap version (and API version) of xDS config. If empty, default version defined in Kuma CP will be used. (ex. '2', '3')")
 	_ = cmd.PersistentFlags().MarkDeprecated("bootstrap-version", "Envoy API v3 is used and can not be changed")
@@ -293,6 +325,13 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 	cmd.PersistentFlags().StringVarP(&cfg.DataplaneRuntime.ResourcePath, "dataplane-file", "d", "", "Path to Dataplane template to apply (YAML or JSON)")
 	cmd.PersistentFlags().StringToStringVarP(&cfg.DataplaneRuntime.ResourceVars, "dataplane-var", "v", map[string]string{}, "Variables to replace Dataplane template")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.EnvoyLogLevel, "envoy-log-level", "", "Envoy log level. Available values are: [trace][debug][info][warning|warn][error][critical][off]. By default it inherits Kuma DP logging level.")
+	cmd.PersistentFlags().BoolVar(&cfg.DataplaneRuntime.EnvoyAdminTunnel, "envoy-admin-tunnel", cfg.DataplaneRuntime.EnvoyAdminTunnel, "If true then the Control Plane executes requests to the Envoy Admin API over a tunnel opened by Kuma DP instead of connecting to the Envoy Admin API directly")
+	cmd.PersistentFlags().BoolVar(&cfg.DataplaneRuntime.EnvoyAdminSocket, "envoy-admin-socket", cfg.DataplaneRuntime.EnvoyAdminSocket, "If true then Envoy Admin API listens on a unix domain socket instead of the loopback interface and the Control Plane can reach it only through the admin port exposed by Kuma DP that accepts only connections secured with mTLS or the tunnel")
+	cmd.PersistentFlags().StringToStringVar(&cfg.DataplaneRuntime.EnvoyRuntime, "envoy-runtime", cfg.DataplaneRuntime.EnvoyRuntime, "Envoy runtime key/values put into a runtime layer on top of the defaults set by the Control Plane. Example: overload.global_downstream_max_connections=1000")
+	cmd.PersistentFlags().DurationVar(&cfg.DataplaneRuntime.EnvoyStatsFlushInterval, "envoy-stats-flush-interval", cfg.DataplaneRuntime.EnvoyStatsFlushInterval, "Interval between flushes of Envoy stats to the sinks. If not set, Envoy flushes stats every 5s")
+	cmd.PersistentFlags().Uint64Var(&cfg.DataplaneRuntime.EnvoyOverloadManager.MaxHeapSizeBytes, "envoy-overload-max-heap-size-bytes", cfg.DataplaneRuntime.EnvoyOverloadManager.MaxHeapSizeBytes, "Heap size of Envoy from which the overload manager thresholds are computed. If not set, the overload manager is disabled")
+	cmd.PersistentFlags().Float64Var(&cfg.DataplaneRuntime.EnvoyOverloadManager.ShrinkHeapThreshold, "envoy-overload-shrink-heap-threshold", cfg.DataplaneRuntime.EnvoyOverloadManager.ShrinkHeapThreshold, "Fraction of the max heap size on which Envoy starts to release free memory to the system. If not set, the Control Plane defaults it to 0.95")
+	cmd.PersistentFlags().Float64Var(&cfg.DataplaneRuntime.EnvoyOverloadManager.StopAcceptingRequestsThreshold, "envoy-overload-stop-accepting-requests-threshold", cfg.DataplaneRuntime.EnvoyOverloadManager.StopAcceptingRequestsThreshold, "Fraction of the max heap size on which Envoy stops accepting new requests. If not set, the Control Plane defaults it to 0.98")
 	cmd.PersistentFlags().BoolVar(&cfg.DNS.Enabled, "dns-enabled", cfg.DNS.Enabled, "If true then builtin DNS functionality is enabled and CoreDNS server is started")
 	cmd.PersistentFlags().Uint32Var(&cfg.DNS.EnvoyDNSPort, "dns-envoy-port", cfg.DNS.EnvoyDNSPort, "A port that handles Virtual IP resolving by Envoy. CoreDNS should be configured that it first tries to use this DNS resolver and then the real one")
 	cmd.PersistentFlags().Uint32Var(&cfg.DNS.CoreDNSPort, "dns-coredns-port", cfg.DNS.CoreDNSPort, "A port that handles DNS requests. When transparent proxy is enabled then iptables will redirect DNS traffic to this port.")
@@ -304,7 +343,7 @@ func newRunCmd(opts kuma_cmd.RunCmdOpts, rootCtx *RootContext) *cobra.Command {
 	return cmd
 }
 
-func getApplicationsToScrape(kumaSidecarConfiguration *types.KumaSidecarConfiguration, envoyAdminPort uint32) []metrics.ApplicationToScrape {
+func getApplicationsToScrape(kumaSidecarConfiguration *types.KumaSidecarConfiguration, envoyAdminPort uint32, envoyAdminSocketPath string) []metrics.ApplicationToScrape {
 	applicationsToScrape := []metrics.ApplicationToScrape{}
 	if kumaSidecarConfiguration != nil {
 		for _, item := range kumaSidecarConfiguration.Metrics.Aggregate {
@@ -323,6 +362,7 @@ func getApplicationsToScrape(kumaSidecarConfiguration *types.KumaSidecarConfigur
 		Port:          envoyAdminPort,
 		QueryModifier: metrics.AddPrometheusFormat,
 		Mutator:       metrics.MergeClusters,
+		SocketPath:    envoyAdminSocketPath,
 	})
 	return applicationsToScrape
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	envoy_admin "github.com/kumahq/kuma/pkg/envoy/admin"
 	"github.com/kumahq/kuma/pkg/util/files"
 	"github.com/kumahq/kuma/pkg/xds/bootstrap/types"
 )
@@ -43,6 +44,7 @@ type Opts struct {
 	Config          kuma_dp.Config
 	BootstrapConfig []byte
 	AdminPort       uint32
+	AdminSocketPath string
 	Dataplane       *rest.Resource
 	Stdout          io.Writer
 	Stderr          io.Writer
@@ -170,7 +172,11 @@ func (e *Envoy) WaitForDone() {
 }
 
 func (e *Envoy) DrainConnections() error {
-	resp, err := http.Post(fmt.Sprintf("http://127.0.0.1:%d/healthcheck/fail", e.opts.AdminPort), "", nil)
+	client := &http.Client{}
+	if e.opts.AdminSocketPath != "" {
+		client.Transport = envoy_admin.UnixSocketTransport(e.opts.AdminSocketPath)
+	}
+	resp, err := client.Post(fmt.Sprintf("http://127.0.0.1:%d/healthcheck/fail", e.opts.AdminPort), "", nil)
 	if err != nil {
 		return err
 	}
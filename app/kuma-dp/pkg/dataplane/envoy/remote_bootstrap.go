@@ -137,6 +137,7 @@ func (b *remoteBootstrap) requestForBootstrap(ctx context.Context, url *net_url.
 		// if not set in config, the 0 will be sent which will result in providing default admin port
 		// that is set in the control plane bootstrap params
 		AdminPort:          cfg.Dataplane.AdminPort.Lowest(),
+		AdminSocket:        cfg.DataplaneRuntime.EnvoyAdminSocket,
 		DataplaneToken:     token,
 		DataplaneTokenPath: cfg.DataplaneRuntime.TokenPath,
 		DataplaneResource:  dataplaneResource,
@@ -154,11 +155,20 @@ func (b *remoteBootstrap) requestForBootstrap(ctx context.Context, url *net_url.
 				KumaDpCompatible: params.EnvoyVersion.KumaDpCompatible,
 			},
 		},
-		DynamicMetadata: params.DynamicMetadata,
-		DNSPort:         params.DNSPort,
-		EmptyDNSPort:    params.EmptyDNSPort,
-		OperatingSystem: b.operatingSystem,
-		Features:        b.features,
+		DynamicMetadata:    params.DynamicMetadata,
+		DNSPort:            params.DNSPort,
+		EmptyDNSPort:       params.EmptyDNSPort,
+		OperatingSystem:    b.operatingSystem,
+		Features:           b.features,
+		EnvoyRuntime:       cfg.DataplaneRuntime.EnvoyRuntime,
+		StatsFlushInterval: cfg.DataplaneRuntime.EnvoyStatsFlushInterval,
+	}
+	if overloadManager := cfg.DataplaneRuntime.EnvoyOverloadManager; overloadManager.MaxHeapSizeBytes != 0 {
+		request.OverloadManager = &types.OverloadManager{
+			MaxHeapSizeBytes:               overloadManager.MaxHeapSizeBytes,
+			ShrinkHeapThreshold:            overloadManager.ShrinkHeapThreshold,
+			StopAcceptingRequestsThreshold: overloadManager.StopAcceptingRequestsThreshold,
+		}
 	}
 	jsonBytes, err := json.Marshal(request)
 	if err != nil {
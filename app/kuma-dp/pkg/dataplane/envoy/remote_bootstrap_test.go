@@ -277,6 +277,66 @@ var _ = Describe("Remote Bootstrap", func() {
                     }`,
 				}
 			}()),
+		Entry("should pass Envoy runtime, stats flush interval and overload manager",
+			func() testCase {
+				cfg := kuma_dp.DefaultConfig()
+				cfg.Dataplane.Mesh = "demo"
+				cfg.Dataplane.Name = "sample"
+				cfg.Dataplane.AdminPort = config_types.MustExactPort(4321)
+				cfg.DataplaneRuntime.Token = "token"
+				cfg.DataplaneRuntime.EnvoyRuntime = map[string]string{
+					"overload.global_downstream_max_connections": "1000",
+				}
+				cfg.DataplaneRuntime.EnvoyStatsFlushInterval = 10 * time.Second
+				cfg.DataplaneRuntime.EnvoyOverloadManager.MaxHeapSizeBytes = 1073741824
+				cfg.DataplaneRuntime.EnvoyOverloadManager.ShrinkHeapThreshold = 0.9
+
+				return testCase{
+					config:               cfg,
+					sidecarConfiguration: &types.KumaSidecarConfiguration{},
+					dataplane: &rest.Resource{
+						Meta: rest.ResourceMeta{
+							Type: "Dataplane",
+							Mesh: "demo",
+							Name: "sample",
+						},
+					},
+					expectedBootstrapRequest: `
+					{
+					  "mesh": "demo",
+					  "name": "sample",
+					  "proxyType": "dataplane",
+					  "adminPort": 4321,
+					  "dataplaneToken": "token",
+					  "dataplaneResource": "{\"type\":\"Dataplane\",\"mesh\":\"demo\",\"name\":\"sample\",\"creationTime\":\"0001-01-01T00:00:00Z\",\"modificationTime\":\"0001-01-01T00:00:00Z\"}",
+					  "version": {
+						"kumaDp": {
+						  "version": "0.0.1",
+						  "gitTag": "v0.0.1",
+						  "gitCommit": "91ce236824a9d875601679aa80c63783fb0e8725",
+						  "buildDate": "2019-08-07T11:26:06Z"
+						},
+						"envoy": {
+						  "version": "1.15.0",
+						  "build": "hash/1.15.0/RELEASE",
+						  "kumaDpCompatible": false
+						}
+					  },
+					  "caCert": "",
+					  "dynamicMetadata": null,
+					  "operatingSystem": "linux",
+					  "features": [],
+					  "envoyRuntime": {
+					    "overload.global_downstream_max_connections": "1000"
+					  },
+					  "statsFlushInterval": 10000000000,
+					  "overloadManager": {
+					    "maxHeapSizeBytes": 1073741824,
+					    "shrinkHeapThreshold": 0.9
+					  }
+					}`,
+				}
+			}()),
 	)
 
 	It("should get configuration of kuma sidecar", func() {
@@ -0,0 +1,11 @@
+package envoyadmin_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestEnvoyAdmin(t *testing.T) {
+	test.RunSpecs(t, "Envoy Admin Suite")
+}
@@ -0,0 +1,111 @@
+package envoyadmin
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+)
+
+var proxyLog = core.Log.WithName("envoy-admin-proxy")
+
+const (
+	// tlsHandshakeRecord is the content type of the first record the client sends to establish TLS connection.
+	tlsHandshakeRecord = 0x16
+	handshakeTimeout   = 10 * time.Second
+)
+
+// Proxy exposes the Envoy Admin API bound to the unix domain socket to the Control Plane.
+// It passes only TLS connections to the admin listener of Envoy bound to the unix domain socket,
+// which requires the client certificate of the Control Plane, so the Admin API cannot be reached
+// from outside the host without being authenticated as the Control Plane.
+type Proxy struct {
+	address    string
+	socketPath string
+}
+
+var _ component.Component = &Proxy{}
+
+// NewProxy creates a Proxy that listens on address and forwards connections to the admin listener bound to socketPath.
+func NewProxy(address string, socketPath string) *Proxy {
+	return &Proxy{
+		address:    address,
+		socketPath: socketPath,
+	}
+}
+
+func (p *Proxy) Start(stop <-chan struct{}) error {
+	listener, err := net.Listen("tcp", p.address)
+	if err != nil {
+		return errors.Wrapf(err, "could not listen on %s", p.address)
+	}
+	proxyLog.Info("starting Envoy Admin proxy", "address", p.address, "socket", p.socketPath)
+
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			go p.handle(conn)
+		}
+	}()
+
+	select {
+	case <-stop:
+		proxyLog.Info("stopping Envoy Admin proxy")
+		return listener.Close()
+	case err := <-errCh:
+		return err
+	}
+}
+
+func (p *Proxy) NeedLeaderElection() bool {
+	return false
+}
+
+func (p *Proxy) handle(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	if err := conn.SetReadDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return
+	}
+	first, err := reader.Peek(1)
+	if err != nil {
+		return
+	}
+	if first[0] != tlsHandshakeRecord {
+		proxyLog.V(1).Info("rejecting the connection that does not start with TLS handshake", "remoteAddr", conn.RemoteAddr())
+		return
+	}
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return
+	}
+
+	upstream, err := net.Dial("unix", p.socketPath)
+	if err != nil {
+		proxyLog.Error(err, "could not connect to the admin listener", "socket", p.socketPath)
+		return
+	}
+	defer upstream.Close()
+
+	// the connection is done as soon as one of the sides closes it
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(upstream, reader)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, upstream)
+		done <- struct{}{}
+	}()
+	<-done
+}
@@ -0,0 +1,91 @@
+package envoyadmin_test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoyadmin"
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+var _ = Describe("Proxy", func() {
+	var address string
+	var stop chan struct{}
+	var adminListener net.Listener
+
+	BeforeEach(func() {
+		dir, err := os.MkdirTemp("", "envoy-admin-proxy")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(os.RemoveAll, dir)
+		socketPath := filepath.Join(dir, "admin.sock")
+
+		// simulates the admin listener of Envoy by echoing the lines it receives
+		adminListener, err = net.Listen("unix", socketPath)
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			for {
+				conn, err := adminListener.Accept()
+				if err != nil {
+					return
+				}
+				go func() {
+					defer conn.Close()
+					line, _ := bufio.NewReader(conn).ReadString('\n')
+					_, _ = conn.Write([]byte(line))
+				}()
+			}
+		}()
+
+		port, err := test.FindFreePort("127.0.0.1")
+		Expect(err).ToNot(HaveOccurred())
+		address = fmt.Sprintf("127.0.0.1:%d", port)
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(envoyadmin.NewProxy(address, socketPath).Start(stop)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		close(stop)
+		Expect(adminListener.Close()).To(Succeed())
+	})
+
+	send := func(data string) string {
+		var conn net.Conn
+		Eventually(func() error {
+			c, err := net.Dial("tcp", address)
+			conn = c
+			return err
+		}).Should(Succeed())
+		defer conn.Close()
+		_, err := conn.Write([]byte(data))
+		Expect(err).ToNot(HaveOccurred())
+		resp, err := io.ReadAll(conn)
+		Expect(err).ToNot(HaveOccurred())
+		return string(resp)
+	}
+
+	It("should forward TLS connections to the admin listener", func() {
+		// when
+		resp := send("\x16tls-handshake\n")
+
+		// then
+		Expect(resp).To(Equal("\x16tls-handshake\n"))
+	})
+
+	It("should reject connections that do not start with TLS handshake", func() {
+		// when
+		resp := send("GET /config_dump HTTP/1.1\n")
+
+		// then
+		Expect(resp).To(BeEmpty())
+	})
+})
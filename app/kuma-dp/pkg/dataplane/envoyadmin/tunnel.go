@@ -0,0 +1,254 @@
+package envoyadmin
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	net_url "net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	kumadp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/envoy/admin/tunnel"
+)
+
+var log = core.Log.WithName("envoy-admin-tunnel")
+
+// Tunnel opens a stream to the Control Plane over which the Control Plane sends requests to the Envoy Admin API.
+// This way the Control Plane does not have to connect to the Envoy Admin API directly.
+// Tunnel reconnects when the stream is broken until the component is stopped.
+type Tunnel struct {
+	cfg          kumadp.Config
+	adminAddress string
+	httpClient   *http.Client
+}
+
+var _ component.Component = &Tunnel{}
+
+// New creates a Tunnel that forwards requests to the Envoy Admin API listening on adminAddress.
+// The transport is used to connect to the Admin API, nil means the default transport.
+func New(cfg kumadp.Config, adminAddress string, transport http.RoundTripper) *Tunnel {
+	return &Tunnel{
+		cfg:          cfg,
+		adminAddress: adminAddress,
+		httpClient:   &http.Client{Transport: transport},
+	}
+}
+
+func (t *Tunnel) Start(stop <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	for {
+		if err := t.stream(ctx); err != nil && ctx.Err() == nil {
+			log.Error(err, "Envoy Admin tunnel is broken, reconnecting", "backoff", t.cfg.ControlPlane.Retry.Backoff)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(t.cfg.ControlPlane.Retry.Backoff):
+		}
+	}
+}
+
+func (t *Tunnel) NeedLeaderElection() bool {
+	return false
+}
+
+func (t *Tunnel) stream(ctx context.Context) (errs error) {
+	conn, err := t.dial()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			errs = errors.Wrap(err, "failed to close a connection")
+		}
+	}()
+
+	ctx, err = t.streamContext(ctx)
+	if err != nil {
+		return err
+	}
+	stream, err := mesh_proto.NewEnvoyAdminTunnelServiceClient(conn).StreamEnvoyAdminRequests(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not open the stream")
+	}
+	log.Info("Envoy Admin tunnel opened")
+
+	sendLock := sync.Mutex{} // concurrent sends are not allowed by gRPC
+	send := func(resp *mesh_proto.EnvoyAdminTunnelResponse) error {
+		sendLock.Lock()
+		defer sendLock.Unlock()
+		return stream.Send(resp)
+	}
+	cancels := map[string]context.CancelFunc{}
+	cancelsLock := sync.Mutex{}
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return errors.Wrap(err, "could not receive a request")
+		}
+		if req.GetCancel() {
+			cancelsLock.Lock()
+			if cancel, ok := cancels[req.GetRequestId()]; ok {
+				cancel()
+			}
+			cancelsLock.Unlock()
+			continue
+		}
+		reqCtx, cancel := context.WithCancel(ctx)
+		cancelsLock.Lock()
+		cancels[req.GetRequestId()] = cancel
+		cancelsLock.Unlock()
+		go func() {
+			defer func() {
+				cancel()
+				cancelsLock.Lock()
+				delete(cancels, req.GetRequestId())
+				cancelsLock.Unlock()
+			}()
+			if err := t.execute(reqCtx, req, send); err != nil {
+				log.Error(err, "could not send the response", "requestId", req.GetRequestId())
+			}
+		}()
+	}
+}
+
+func (t *Tunnel) dial() (*grpc.ClientConn, error) {
+	u, err := net_url.Parse(t.cfg.ControlPlane.URL)
+	if err != nil {
+		return nil, err
+	}
+	var dialOpts []grpc.DialOption
+	switch u.Scheme {
+	case "http":
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	case "https":
+		tlsConfig := &tls.Config{}
+		if t.cfg.ControlPlane.CaCert != "" {
+			certPool := x509.NewCertPool()
+			if ok := certPool.AppendCertsFromPEM([]byte(t.cfg.ControlPlane.CaCert)); !ok {
+				return nil, errors.New("could not add certificate")
+			}
+			tlsConfig.RootCAs = certPool
+		}
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	default:
+		return nil, errors.Errorf("unsupported scheme %q. Use one of %s", u.Scheme, []string{"http", "https"})
+	}
+	return grpc.Dial(u.Host, dialOpts...)
+}
+
+func (t *Tunnel) streamContext(ctx context.Context) (context.Context, error) {
+	md := metadata.Pairs(
+		tunnel.ProxyTypeMetadataKey, t.cfg.Dataplane.ProxyType,
+		tunnel.ProxyMeshMetadataKey, t.cfg.Dataplane.Mesh,
+		tunnel.ProxyNameMetadataKey, t.cfg.Dataplane.Name,
+	)
+	if t.cfg.DataplaneRuntime.TokenPath != "" {
+		token, err := os.ReadFile(t.cfg.DataplaneRuntime.TokenPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read the token")
+		}
+		md.Set("authorization", string(token))
+	}
+	return metadata.NewOutgoingContext(ctx, md), nil
+}
+
+// execute executes the request on the Envoy Admin API. Errors are sent back to the Control Plane in the response.
+// If the request is streamed, the body is sent in many responses as soon as Envoy writes it until the request is cancelled.
+// Only errors of sending the responses are returned.
+func (t *Tunnel) execute(
+	ctx context.Context,
+	req *mesh_proto.EnvoyAdminTunnelRequest,
+	send func(*mesh_proto.EnvoyAdminTunnelResponse) error,
+) error {
+	resp := &mesh_proto.EnvoyAdminTunnelResponse{
+		RequestId: req.GetRequestId(),
+	}
+	u := net_url.URL{
+		Scheme:   "http",
+		Host:     t.adminAddress,
+		Path:     req.GetPath(),
+		RawQuery: req.GetQuery(),
+	}
+	var reqBody io.Reader
+	if len(req.GetBody()) > 0 {
+		reqBody = bytes.NewReader(req.GetBody())
+	}
+	request, err := http.NewRequestWithContext(ctx, req.GetMethod(), u.String(), reqBody)
+	if err != nil {
+		resp.Error = err.Error()
+		return send(resp)
+	}
+	response, err := t.httpClient.Do(request)
+	if err != nil {
+		resp.Error = err.Error()
+		return send(resp)
+	}
+	defer response.Body.Close()
+	resp.StatusCode = uint32(response.StatusCode)
+	if req.GetStream() {
+		return streamBody(ctx, resp, response.Body, send)
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		resp.Error = err.Error()
+		resp.StatusCode = 0
+		return send(resp)
+	}
+	resp.Body = body
+	return send(resp)
+}
+
+// streamBody sends the status code in the first response and the body in chunks in the next responses.
+func streamBody(
+	ctx context.Context,
+	resp *mesh_proto.EnvoyAdminTunnelResponse,
+	body io.Reader,
+	send func(*mesh_proto.EnvoyAdminTunnelResponse) error,
+) error {
+	resp.More = true
+	if err := send(resp); err != nil {
+		return err
+	}
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := body.Read(buf)
+		chunk := &mesh_proto.EnvoyAdminTunnelResponse{
+			RequestId: resp.RequestId,
+			Body:      append([]byte{}, buf[:n]...),
+			More:      err == nil,
+		}
+		if err != nil && err != io.EOF && ctx.Err() == nil {
+			chunk.Error = err.Error()
+		}
+		if n == 0 && chunk.More {
+			continue
+		}
+		if err := send(chunk); err != nil {
+			return err
+		}
+		if !chunk.More {
+			return nil
+		}
+	}
+}
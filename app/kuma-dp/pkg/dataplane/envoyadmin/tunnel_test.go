@@ -0,0 +1,143 @@
+package envoyadmin_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kuma-dp/pkg/dataplane/envoyadmin"
+	kumadp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
+)
+
+// tunnelServer simulates the Control Plane by sending requests to kuma-dp and collecting its responses.
+type tunnelServer struct {
+	requests  chan *mesh_proto.EnvoyAdminTunnelRequest
+	responses chan *mesh_proto.EnvoyAdminTunnelResponse
+}
+
+func (s *tunnelServer) StreamEnvoyAdminRequests(stream mesh_proto.EnvoyAdminTunnelService_StreamEnvoyAdminRequestsServer) error {
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			s.responses <- resp
+		}
+	}()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case req := <-s.requests:
+			if err := stream.Send(req); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var _ = Describe("Tunnel", func() {
+	var cp *tunnelServer
+	var envoyAdmin *httptest.Server
+	var adminRequestDone chan struct{}
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		adminRequestDone = make(chan struct{}, 1)
+		// simulates the Envoy Admin API which streams the response of /tap until the request is cancelled
+		envoyAdmin = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/tap" {
+				_, _ = writer.Write([]byte(req.URL.Path))
+				return
+			}
+			_, _ = writer.Write([]byte("entry"))
+			writer.(http.Flusher).Flush()
+			<-req.Context().Done()
+			adminRequestDone <- struct{}{}
+		}))
+
+		cp = &tunnelServer{
+			requests:  make(chan *mesh_proto.EnvoyAdminTunnelRequest),
+			responses: make(chan *mesh_proto.EnvoyAdminTunnelResponse, 10),
+		}
+		grpcServer := grpc.NewServer()
+		mesh_proto.RegisterEnvoyAdminTunnelServiceServer(grpcServer, cp)
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			_ = grpcServer.Serve(lis)
+		}()
+		DeferCleanup(grpcServer.Stop)
+
+		cfg := kumadp.DefaultConfig()
+		cfg.ControlPlane.URL = "http://" + lis.Addr().String()
+		cfg.Dataplane.Mesh = "default"
+		cfg.Dataplane.Name = "dp-1"
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(envoyadmin.New(cfg, strings.TrimPrefix(envoyAdmin.URL, "http://"), nil).Start(stop)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		close(stop)
+		envoyAdmin.Close()
+	})
+
+	It("should execute the request on the Envoy Admin API", func() {
+		// when
+		cp.requests <- &mesh_proto.EnvoyAdminTunnelRequest{
+			RequestId: "1",
+			Method:    http.MethodGet,
+			Path:      "/stats",
+		}
+
+		// then
+		var resp *mesh_proto.EnvoyAdminTunnelResponse
+		Eventually(cp.responses, "10s").Should(Receive(&resp))
+		Expect(resp.RequestId).To(Equal("1"))
+		Expect(resp.StatusCode).To(Equal(uint32(http.StatusOK)))
+		Expect(string(resp.Body)).To(Equal("/stats"))
+		Expect(resp.More).To(BeFalse())
+	})
+
+	It("should stream the response until the request is cancelled", func() {
+		// when
+		cp.requests <- &mesh_proto.EnvoyAdminTunnelRequest{
+			RequestId: "1",
+			Method:    http.MethodPost,
+			Path:      "/tap",
+			Stream:    true,
+		}
+
+		// then
+		var resp *mesh_proto.EnvoyAdminTunnelResponse
+		Eventually(cp.responses, "10s").Should(Receive(&resp))
+		Expect(resp.StatusCode).To(Equal(uint32(http.StatusOK)))
+		Expect(resp.More).To(BeTrue())
+		Eventually(cp.responses, "10s").Should(Receive(&resp))
+		Expect(string(resp.Body)).To(Equal("entry"))
+		Expect(resp.More).To(BeTrue())
+
+		// when
+		cp.requests <- &mesh_proto.EnvoyAdminTunnelRequest{
+			RequestId: "1",
+			Cancel:    true,
+		}
+
+		// then
+		Eventually(cp.responses, "10s").Should(Receive(&resp))
+		Expect(resp.RequestId).To(Equal("1"))
+		Expect(resp.Error).To(BeEmpty())
+		Expect(resp.More).To(BeFalse())
+		Eventually(adminRequestDone, "10s").Should(Receive())
+	})
+})
@@ -16,6 +16,7 @@ import (
 	kumadp "github.com/kumahq/kuma/pkg/config/app/kuma-dp"
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	envoy_admin "github.com/kumahq/kuma/pkg/envoy/admin"
 	"github.com/kumahq/kuma/pkg/xds/envoy"
 )
 
@@ -42,18 +43,30 @@ type ApplicationToScrape struct {
 	Port          uint32
 	QueryModifier QueryParametersModifier
 	Mutator       MetricsMutator
+	// SocketPath of the unix domain socket the application listens on instead of the Port.
+	SocketPath string
 }
 
 type Hijacker struct {
 	socketPath           string
 	httpClient           http.Client
+	socketHTTPClients    map[string]*http.Client
 	applicationsToScrape []ApplicationToScrape
 }
 
 func New(dataplane kumadp.Dataplane, applicationsToScrape []ApplicationToScrape) *Hijacker {
+	socketHTTPClients := map[string]*http.Client{}
+	for _, app := range applicationsToScrape {
+		if app.SocketPath != "" {
+			socketHTTPClients[app.SocketPath] = &http.Client{
+				Transport: envoy_admin.UnixSocketTransport(app.SocketPath),
+			}
+		}
+	}
 	return &Hijacker{
 		socketPath:           envoy.MetricsHijackerSocketName(dataplane.Name, dataplane.Mesh),
 		httpClient:           http.Client{},
+		socketHTTPClients:    socketHTTPClients,
 		applicationsToScrape: applicationsToScrape,
 	}
 }
@@ -159,7 +172,11 @@ func (s *Hijacker) getStats(ctx context.Context, url *url.URL, app ApplicationTo
 		return nil
 	}
 	req = req.WithContext(ctx)
-	resp, err := s.httpClient.Do(req)
+	httpClient := &s.httpClient
+	if app.SocketPath != "" {
+		httpClient = s.socketHTTPClients[app.SocketPath]
+	}
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		logger.Error(err, "failed call", "name", app.Name, "path", app.Path, "port", app.Port)
 		return nil
@@ -1,41 +1,65 @@
 package apply
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	ghodss_yaml "github.com/ghodss/yaml"
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
 
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/openapi"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	"github.com/kumahq/kuma/pkg/util/template"
 	"github.com/kumahq/kuma/pkg/util/yaml"
+	kuma_version "github.com/kumahq/kuma/pkg/version"
 )
 
 const (
 	timeout = 10 * time.Second
+	// defaultConflictRetries is the number of times the resource is applied again when it was modified in the meantime
+	defaultConflictRetries = 3
+)
+
+const (
+	dryRunNone   = "none"
+	dryRunClient = "client"
+	dryRunServer = "server"
 )
 
 type applyContext struct {
 	*kumactl_cmd.RootContext
 
 	args struct {
-		file   string
-		vars   map[string]string
-		dryRun bool
+		file            string
+		vars            map[string]string
+		dryRun          string
+		prune           bool
+		conflictRetries uint
+		atomic          bool
+		validate        bool
 	}
 }
 
@@ -44,7 +68,29 @@ func NewApplyCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "apply",
 		Short: "Create or modify Kuma resources",
-		Long:  `Create or modify Kuma resources.`,
+		Long: `Create or modify Kuma resources.
+
+Many resources can be applied at once, either as a stream of YAML documents separated by "---"
+or as a "kind: List" document with resources under "items". Resources are applied in the order
+that satisfies references between them: Meshes first, then secrets and then the rest of the resources.
+
+When the resource is modified by someone else while it is applied, the changes are applied again on top of
+the newest revision of the resource, up to --conflict-retries times. When the resource in the input has a "revision",
+it is applied only if the resource on the control plane was not modified since this revision.
+
+With --prune, resources that exist on the control plane but are not in the input are deleted.
+Only types of the resources in the input are pruned and mesh-scoped resources are pruned only in Meshes
+of the resources in the input. Pruning a Mesh deletes all resources in it, so use --dry-run=server to preview
+which resources are going to be deleted.
+
+With --atomic, all resources are applied in a single transaction on the control plane, so either all of them
+are created or updated or none of them is. It requires the control plane with a store that supports transactions (Postgres).
+
+Resources are validated against their OpenAPI schemas before they are sent to the control plane, so unknown fields
+and values of wrong types are reported without contacting it. The schemas are also served by the control plane
+at /api/openapi.yaml. Use --validate=false to skip the validation.
+
+When the file is a directory, all YAML and JSON files in it are applied.`,
 		Example: `
 Apply a resource from file
 $ kumactl apply -f resource.yaml
@@ -57,74 +103,70 @@ name: demo
 
 Apply a resource from external URL
 $ kumactl apply -f https://example.com/resource.yaml
+
+Preview changes that the control plane would make to the resource
+$ kumactl apply -f resource.yaml --dry-run=server
+
+Apply all resources from a directory, so either all of them are applied or none of them is
+$ kumactl apply -f resources/ --atomic
+
+Apply a list of resources and delete resources of the same types that are not in the list
+$ echo "
+kind: List
+items:
+- type: Mesh
+  name: demo
+- type: TrafficPermission
+  mesh: demo
+  name: allow-all
+  sources:
+  - match:
+      kuma.io/service: '*'
+  destinations:
+  - match:
+      kuma.io/service: '*'
+" | kumactl apply -f - --prune
 `,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			switch ctx.args.dryRun {
+			case dryRunNone, dryRunClient, dryRunServer:
+			default:
+				return errors.Errorf("invalid value of --dry-run %q, expected one of %s, %s, %s", ctx.args.dryRun, dryRunNone, dryRunClient, dryRunServer)
+			}
+			if ctx.args.prune && ctx.args.dryRun == dryRunClient {
+				return errors.New("--prune can't be used with --dry-run=client, use --dry-run=server to preview which resources are going to be pruned")
+			}
+			if ctx.args.atomic && ctx.args.dryRun == dryRunServer {
+				return errors.New("--atomic can't be used with --dry-run=server")
+			}
+			if ctx.args.atomic && ctx.args.prune {
+				return errors.New("--atomic can't be used with --prune")
+			}
 			if err := pctx.CheckServerVersionCompatibility(); err != nil {
 				cmd.PrintErrln(err)
 			}
 
-			var b []byte
-			var err error
-
-			if ctx.args.file == "-" {
-				b, err = io.ReadAll(cmd.InOrStdin())
-				if err != nil {
-					return err
-				}
-			} else {
-				if strings.HasPrefix(ctx.args.file, "http://") || strings.HasPrefix(ctx.args.file, "https://") {
-					client := &http.Client{
-						Timeout:   timeout,
-						Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
-					}
-					req, err := http.NewRequest("GET", ctx.args.file, nil)
-					if err != nil {
-						return errors.Wrap(err, "error creating new http request")
-					}
-					resp, err := client.Do(req)
-					if err != nil {
-						return errors.Wrap(err, "error with GET http request")
-					}
-					if resp.StatusCode != http.StatusOK {
-						return errors.Wrap(err, "error while retrieving URL")
-					}
-					defer resp.Body.Close()
-					b, err = io.ReadAll(resp.Body)
-					if err != nil {
-						return errors.Wrap(err, "error while reading provided file")
-					}
-				} else {
-					b, err = os.ReadFile(ctx.args.file)
-					if err != nil {
-						return errors.Wrap(err, "error while reading provided file")
-					}
-				}
+			var validator *openapi.Validator
+			if ctx.args.validate {
+				validator = openapi.NewValidator(openapi.NewDocument(pctx.Runtime.Registry.ObjectDescriptors(), kuma_version.Build.Version))
 			}
-			if len(b) == 0 {
-				return fmt.Errorf("no resource(s) passed to apply")
+			resources, err := readResources(cmd, ctx.args.file, ctx.args.vars, validator)
+			if err != nil {
+				return err
 			}
-			var resources []model.Resource
-			rawResources := yaml.SplitYAML(string(b))
-			for _, rawResource := range rawResources {
-				if len(rawResource) == 0 {
-					continue
-				}
-				bytes := []byte(rawResource)
-				if len(ctx.args.vars) > 0 {
-					bytes = template.Render(rawResource, ctx.args.vars)
-				}
-				res, err := rest_types.UnmarshallToCore(bytes)
+			sortByApplyOrder(resources)
+			if ctx.args.atomic && ctx.args.dryRun == dryRunNone {
+				client, err := pctx.CurrentApplyClient()
 				if err != nil {
-					return errors.Wrap(err, "YAML contains invalid resource")
-				}
-				if err := mesh.ValidateMeta(res.GetMeta().GetName(), res.GetMeta().GetMesh(), res.Descriptor().Scope); err.HasViolations() {
-					return err.OrNil()
+					return err
 				}
-				resources = append(resources, res)
+				_, err = client.Apply(context.Background(), resources)
+				return err
 			}
 			for _, resource := range resources {
-				if ctx.args.dryRun {
+				switch ctx.args.dryRun {
+				case dryRunClient:
 					p, err := printers.NewGenericPrinter(output.YAMLFormat)
 					if err != nil {
 						return err
@@ -132,42 +174,424 @@ $ kumactl apply -f https://example.com/resource.yaml
 					if err := p.Print(rest_types.From.Resource(resource), cmd.OutOrStdout()); err != nil {
 						return err
 					}
-				} else {
+				case dryRunServer:
 					rs, err := pctx.CurrentResourceStore()
 					if err != nil {
 						return err
 					}
 
-					if err := upsert(pctx.Runtime.Registry, rs, resource); err != nil {
+					live, err := upsertDryRun(pctx.Runtime.Registry, rs, resource)
+					if err != nil {
 						return err
 					}
+					if err := printDiff(live, resource, cmd.OutOrStdout()); err != nil {
+						return err
+					}
+				default:
+					rs, err := pctx.CurrentResourceStore()
+					if err != nil {
+						return err
+					}
+
+					if err := upsert(pctx.Runtime.Registry, rs, resource, ctx.args.conflictRetries); err != nil {
+						return err
+					}
+				}
+			}
+			if ctx.args.prune {
+				rs, err := pctx.CurrentResourceStore()
+				if err != nil {
+					return err
 				}
+				return prune(cmd, pctx.Runtime.Registry, rs, resources, ctx.args.dryRun == dryRunServer)
 			}
 			return nil
 		},
 	}
-	cmd.Flags().StringVarP(&ctx.args.file, "file", "f", "", "Path to file to apply. Pass `-` to read from stdin")
+	cmd.Flags().StringVarP(&ctx.args.file, "file", "f", "", "Path to file or directory to apply. Pass `-` to read from stdin")
 	_ = cmd.MarkFlagRequired("file")
 	cmd.Flags().StringToStringVarP(&ctx.args.vars, "var", "v", map[string]string{}, "Variable to replace in configuration")
-	cmd.Flags().BoolVar(&ctx.args.dryRun, "dry-run", false, "Resolve variable and prints result out without actual applying")
+	cmd.Flags().StringVar(&ctx.args.dryRun, "dry-run", dryRunNone, kuma_cmd.UsageOptions("Apply resources without persisting them. "+
+		"client resolves variables and prints the result, server validates and defaults resources on the control plane and prints the diff of changes", dryRunNone, dryRunClient, dryRunServer))
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = dryRunClient
+	cmd.Flags().BoolVar(&ctx.args.prune, "prune", false, "Delete resources of the applied types that exist on the control plane but are not in the input")
+	cmd.Flags().BoolVar(&ctx.args.atomic, "atomic", false, "Apply all resources in a single transaction, so either all of them are applied or none of them is")
+	cmd.Flags().BoolVar(&ctx.args.validate, "validate", true, "Validate resources against their OpenAPI schemas before sending them to the control plane")
+	cmd.Flags().UintVar(&ctx.args.conflictRetries, "conflict-retries", defaultConflictRetries, "Number of times the resource is applied again on top of its newest revision when it was modified in the meantime")
 	return cmd
 }
 
-func upsert(typeRegistry registry.TypeRegistry, rs store.ResourceStore, res model.Resource) error {
-	newRes, err := typeRegistry.NewObject(res.Descriptor().Name)
+// readResources reads resources from the file, URL or the standard input if the file is "-" and renders variables in them.
+// Resources are validated against their schemas when the validator is not nil.
+func readResources(cmd *cobra.Command, file string, vars map[string]string, validator *openapi.Validator) ([]model.Resource, error) {
+	var b []byte
+	var err error
+
+	if file == "-" {
+		b, err = io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://") {
+			client := &http.Client{
+				Timeout:   timeout,
+				Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+			}
+			req, err := http.NewRequest("GET", file, nil)
+			if err != nil {
+				return nil, errors.Wrap(err, "error creating new http request")
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return nil, errors.Wrap(err, "error with GET http request")
+			}
+			if resp.StatusCode != http.StatusOK {
+				return nil, errors.Wrap(err, "error while retrieving URL")
+			}
+			defer resp.Body.Close()
+			b, err = io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, errors.Wrap(err, "error while reading provided file")
+			}
+		} else if info, err := os.Stat(file); err == nil && info.IsDir() {
+			b, err = readDir(file)
+			if err != nil {
+				return nil, errors.Wrap(err, "error while reading provided directory")
+			}
+		} else {
+			b, err = os.ReadFile(file)
+			if err != nil {
+				return nil, errors.Wrap(err, "error while reading provided file")
+			}
+		}
+	}
+	if len(b) == 0 {
+		return nil, fmt.Errorf("no resource(s) passed to apply")
+	}
+	var resources []model.Resource
+	rawResources := yaml.SplitYAML(string(b))
+	for _, rawResource := range rawResources {
+		if len(rawResource) == 0 {
+			continue
+		}
+		bytes := []byte(rawResource)
+		if len(vars) > 0 {
+			bytes = template.Render(rawResource, vars)
+		}
+		items, err := listItems(bytes)
+		if err != nil {
+			return nil, err
+		}
+		if items == nil {
+			items = [][]byte{bytes}
+		}
+		for _, item := range items {
+			res, err := rest_types.UnmarshallToCore(item)
+			if err != nil {
+				return nil, errors.Wrap(err, "YAML contains invalid resource")
+			}
+			if err := mesh.ValidateMeta(res.GetMeta().GetName(), res.GetMeta().GetMesh(), res.Descriptor().Scope); err.HasViolations() {
+				return nil, err.OrNil()
+			}
+			if validator != nil {
+				if err := validate(validator, res, item); err != nil {
+					return nil, err
+				}
+			}
+			resources = append(resources, res)
+		}
+	}
+	return resources, nil
+}
+
+// validate validates the resource in YAML or JSON against the schema of its type.
+func validate(validator *openapi.Validator, res model.Resource, item []byte) error {
+	jsonItem, err := ghodss_yaml.YAMLToJSON(item)
+	if err != nil {
+		return errors.Wrap(err, "YAML contains invalid resource")
+	}
+	if err := validator.Validate(res.Descriptor().Name, jsonItem); err != nil {
+		return errors.Wrapf(err, "%s %q does not match the schema", res.Descriptor().Name, res.GetMeta().GetName())
+	}
+	return nil
+}
+
+// readDir reads YAML and JSON files of the directory in the order of their names as a stream of documents.
+// Subdirectories are not read.
+func readDir(dir string) ([]byte, error) {
+	entries, err := os.ReadDir(dir)
 	if err != nil {
+		return nil, err
+	}
+	var docs [][]byte
+	for _, entry := range entries {
+		switch filepath.Ext(entry.Name()) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		if entry.IsDir() {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, b)
+	}
+	return bytes.Join(docs, []byte("\n---\n")), nil
+}
+
+// listKind is the kind of the Kubernetes-style wrapper of many resources in a single document.
+const listKind = "List"
+
+// listItems returns items of the document when it is a list in format of "kind: List" with resources under "items".
+// Nil is returned when the document is a single resource.
+func listItems(doc []byte) ([][]byte, error) {
+	list := struct {
+		Kind  string            `json:"kind"`
+		Type  string            `json:"type"`
+		Items []json.RawMessage `json:"items"`
+	}{}
+	if err := ghodss_yaml.Unmarshal(doc, &list); err != nil {
+		return nil, errors.Wrap(err, "YAML contains invalid resource")
+	}
+	if list.Type != "" || list.Kind != listKind {
+		return nil, nil
+	}
+	items := make([][]byte, 0, len(list.Items))
+	for _, item := range list.Items {
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// sortByApplyOrder sorts the resources, so they are applied in the order that satisfies references between them.
+func sortByApplyOrder(resources []model.Resource) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		return kumactl_resources.ApplyOrder(resources[i].Descriptor().Name) < kumactl_resources.ApplyOrder(resources[j].Descriptor().Name)
+	})
+}
+
+// upsert creates or updates the resource. When the resource was modified between fetching and updating it,
+// the update is retried on top of the newest revision unless the revision was pinned in the input.
+func upsert(typeRegistry registry.TypeRegistry, rs store.ResourceStore, res model.Resource, conflictRetries uint) error {
+	meta := res.GetMeta()
+	for attempt := uint(0); ; attempt++ {
+		newRes, err := typeRegistry.NewObject(res.Descriptor().Name)
+		if err != nil {
+			return err
+		}
+		if err := rs.Get(context.Background(), newRes, store.GetByKey(meta.GetName(), meta.GetMesh())); err != nil {
+			if store.IsResourceNotFound(err) {
+				return rs.Create(context.Background(), res, store.CreateByKey(meta.GetName(), meta.GetMesh()))
+			} else {
+				return err
+			}
+		}
+		revision := meta.GetVersion()
+		if revision != "" && newRes.GetMeta().GetVersion() != "" && revision != newRes.GetMeta().GetVersion() {
+			return store.ErrorResourceConflict(res.Descriptor().Name, meta.GetName(), meta.GetMesh())
+		}
+		if err := newRes.SetSpec(res.GetSpec()); err != nil {
+			return err
+		}
+		err = rs.Update(context.Background(), newRes)
+		if store.IsResourceConflict(err) && revision == "" && attempt < conflictRetries {
+			continue
+		}
 		return err
 	}
+}
+
+// prunePageSize is the size of pages in which the resources to prune are listed.
+const prunePageSize = 100
+
+// prune deletes resources that are not in the applied resources. Only types of the applied resources are pruned
+// and mesh-scoped resources only in Meshes of the applied resources. Resources are deleted in the reverse of the apply order,
+// so resources are deleted before secrets and Meshes they reference.
+func prune(cmd *cobra.Command, typeRegistry registry.TypeRegistry, rs store.ResourceStore, applied []model.Resource, dryRun bool) error {
+	type key struct {
+		typ  model.ResourceType
+		mesh string
+		name string
+	}
+	keep := map[key]bool{}
+	var types []model.ResourceType
+	// meshesOfType are Meshes in which the resources of the type are pruned, global resources have an empty Mesh
+	meshesOfType := map[model.ResourceType][]string{}
+	for _, res := range applied {
+		typ := res.Descriptor().Name
+		meta := res.GetMeta()
+		keep[key{typ: typ, mesh: meta.GetMesh(), name: meta.GetName()}] = true
+		meshes, seen := meshesOfType[typ]
+		if !seen {
+			types = append(types, typ)
+		}
+		if !containsString(meshes, meta.GetMesh()) {
+			meshesOfType[typ] = append(meshes, meta.GetMesh())
+		}
+	}
+
+	var toPrune []model.Resource
+	for _, typ := range types {
+		for _, mesh := range meshesOfType[typ] {
+			items, err := listAll(typeRegistry, rs, typ, mesh)
+			if err != nil {
+				return err
+			}
+			for _, item := range items {
+				meta := item.GetMeta()
+				if !keep[key{typ: typ, mesh: meta.GetMesh(), name: meta.GetName()}] {
+					toPrune = append(toPrune, item)
+				}
+			}
+		}
+	}
+	sort.SliceStable(toPrune, func(i, j int) bool {
+		return kumactl_resources.ApplyOrder(toPrune[i].Descriptor().Name) > kumactl_resources.ApplyOrder(toPrune[j].Descriptor().Name)
+	})
+
+	for _, res := range toPrune {
+		meta := res.GetMeta()
+		name := fmt.Sprintf("%s %q", res.Descriptor().Name, meta.GetName())
+		if meta.GetMesh() != "" {
+			name = fmt.Sprintf("%s in Mesh %q", name, meta.GetMesh())
+		}
+		if dryRun {
+			cmd.Printf("%s would be pruned\n", name)
+			continue
+		}
+		if err := rs.Delete(context.Background(), res, store.DeleteByKey(meta.GetName(), meta.GetMesh())); err != nil {
+			if store.IsResourceNotFound(err) {
+				// it was deleted in the meantime, e.g. together with its Mesh
+				continue
+			}
+			return errors.Wrapf(err, "failed to prune %s", name)
+		}
+		cmd.Printf("pruned %s\n", name)
+	}
+	return nil
+}
+
+// listAll lists all pages of the resources of the type in the Mesh. Resources in all Meshes are listed when the Mesh is empty.
+func listAll(typeRegistry registry.TypeRegistry, rs store.ResourceStore, typ model.ResourceType, mesh string) ([]model.Resource, error) {
+	var resources []model.Resource
+	offset := ""
+	for {
+		list, err := typeRegistry.NewList(typ)
+		if err != nil {
+			return nil, err
+		}
+		if err := rs.List(context.Background(), list, store.ListByMesh(mesh), store.ListByPage(prunePageSize, offset)); err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s", typ)
+		}
+		resources = append(resources, list.GetItems()...)
+		offset = list.GetPagination().NextOffset
+		if offset == "" {
+			return resources, nil
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// upsertDryRun creates or updates the resource in dry run mode. The resource is replaced with the result of the dry run
+// and the resource as it currently exists is returned, nil if it does not exist yet.
+func upsertDryRun(typeRegistry registry.TypeRegistry, rs store.ResourceStore, res model.Resource) (model.Resource, error) {
+	live, err := typeRegistry.NewObject(res.Descriptor().Name)
+	if err != nil {
+		return nil, err
+	}
 	meta := res.GetMeta()
-	if err := rs.Get(context.Background(), newRes, store.GetByKey(meta.GetName(), meta.GetMesh())); err != nil {
+	if err := rs.Get(context.Background(), live, store.GetByKey(meta.GetName(), meta.GetMesh())); err != nil {
 		if store.IsResourceNotFound(err) {
-			return rs.Create(context.Background(), res, store.CreateByKey(meta.GetName(), meta.GetMesh()))
-		} else {
+			return nil, rs.Create(context.Background(), res, store.CreateByKey(meta.GetName(), meta.GetMesh()), store.CreateDryRun())
+		}
+		return nil, err
+	}
+	updated, err := typeRegistry.NewObject(res.Descriptor().Name)
+	if err != nil {
+		return nil, err
+	}
+	updated.SetMeta(live.GetMeta())
+	if err := updated.SetSpec(proto.Clone(res.GetSpec())); err != nil {
+		return nil, err
+	}
+	if err := rs.Update(context.Background(), updated, store.UpdateDryRun()); err != nil {
+		return nil, err
+	}
+	res.SetMeta(updated.GetMeta())
+	return live, res.SetSpec(updated.GetSpec())
+}
+
+// printDiff prints the unified diff between the live resource and the result of the dry run.
+func printDiff(live model.Resource, dryRun model.Resource, out io.Writer) error {
+	name := dryRun.GetMeta().GetName()
+	if mesh := dryRun.GetMeta().GetMesh(); mesh != "" {
+		name = mesh + "/" + name
+	}
+	name = fmt.Sprintf("%s %s", dryRun.Descriptor().Name, name)
+
+	var liveLines []string
+	var liveYAML string
+	if live != nil {
+		var err error
+		if liveYAML, err = diffableYAML(live); err != nil {
 			return err
 		}
+		liveLines = difflib.SplitLines(strings.TrimSuffix(liveYAML, "\n"))
+	}
+	dryRunYAML, err := diffableYAML(dryRun)
+	if err != nil {
+		return err
 	}
-	if err := newRes.SetSpec(res.GetSpec()); err != nil {
+	if liveYAML == dryRunYAML {
+		_, err := fmt.Fprintf(out, "%s unchanged\n", name)
 		return err
 	}
-	return rs.Update(context.Background(), newRes)
+	return difflib.WriteUnifiedDiff(out, difflib.UnifiedDiff{
+		A:        liveLines,
+		B:        difflib.SplitLines(strings.TrimSuffix(dryRunYAML, "\n")),
+		FromFile: "live " + name,
+		ToFile:   "dry run " + name,
+		Context:  3,
+	})
+}
+
+// diffableYAML returns the resource in YAML. Creation and modification time are skipped, they are not part of the change.
+func diffableYAML(res model.Resource) (string, error) {
+	meta := rest_types.From.Resource(res).Meta
+	p, err := printers.NewGenericPrinter(output.YAMLFormat)
+	if err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	if err := p.Print(struct {
+		Type string `json:"type"`
+		Mesh string `json:"mesh,omitempty"`
+		Name string `json:"name"`
+	}{
+		Type: meta.Type,
+		Mesh: meta.Mesh,
+		Name: meta.Name,
+	}, buf); err != nil {
+		return "", err
+	}
+	spec, err := util_proto.ToYAML(res.GetSpec())
+	if err != nil {
+		return "", err
+	}
+	if !bytes.HasPrefix(spec, []byte("{}")) {
+		buf.Write(spec)
+	}
+	return buf.String(), nil
 }
@@ -14,16 +14,22 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/cmd"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	"github.com/kumahq/kuma/pkg/events"
 	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
 	"github.com/kumahq/kuma/pkg/test/resources/model"
@@ -365,6 +371,66 @@ var _ = Describe("kumactl apply", func() {
 `))
 	})
 
+	Describe("conflicts", func() {
+		var conflicting *conflictingStore
+
+		BeforeEach(func() {
+			conflicting = &conflictingStore{ResourceStore: store}
+			rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+				return conflicting
+			}
+			Expect(store.Create(context.Background(), mesh.NewMeshResource(), core_store.CreateByKey("sample", core_model.NoMesh))).To(Succeed())
+		})
+
+		It("should retry an update that failed on conflict", func() {
+			// given
+			conflicting.failures = 2
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"apply", "-f", filepath.Join("testdata", "apply-mesh.yaml")},
+			)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conflicting.updates).To(Equal(3))
+		})
+
+		It("should fail when conflicts exceed --conflict-retries", func() {
+			// given
+			conflicting.failures = 2
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"apply", "--conflict-retries", "1", "-f", filepath.Join("testdata", "apply-mesh.yaml")},
+			)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(core_store.IsResourceConflict(err)).To(BeTrue())
+			Expect(conflicting.updates).To(Equal(2))
+		})
+
+		It("should not update a resource when the revision in the input is stale", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"apply", "-f", "-"},
+			)
+			rootCmd.SetIn(strings.NewReader("type: Mesh\nname: sample\nrevision: \"100\"\n"))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(core_store.IsResourceConflict(err)).To(BeTrue())
+			Expect(conflicting.updates).To(Equal(0))
+		})
+	})
+
 	It("should print configuration with resolved variable without applying", func() {
 		// setup
 		err := store.Create(context.Background(), &mesh.DataplaneResource{
@@ -411,6 +477,355 @@ networking:
 `))
 	})
 
+	Describe("--dry-run=server", func() {
+		BeforeEach(func() {
+			// resource manager runs the dry run without persisting resources, same as the API server
+			rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+				return managerStore{ResourceManager: core_manager.NewResourceManager(store)}
+			}
+		})
+
+		It("should print diff of the changes without applying", func() {
+			// setup
+			err := store.Create(context.Background(), &mesh.DataplaneResource{
+				Meta: &model.ResourceMeta{
+					Name: "sample",
+					Mesh: "default",
+				},
+				Spec: &v1alpha1.Dataplane{
+					Networking: &v1alpha1.Dataplane_Networking{
+						Address: "1.1.1.1",
+						Inbound: []*v1alpha1.Dataplane_Networking_Inbound{{
+							Port:        80,
+							ServicePort: 8080,
+							Tags: map[string]string{
+								"kuma.io/service": "web",
+								"version":         "1.0",
+							},
+						}},
+					},
+				},
+			}, core_store.CreateByKey("sample", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// given
+			rootCmd.SetArgs([]string{
+				"apply", "-f", filepath.Join("testdata", "apply-dataplane-dry-run.yaml"),
+				"--dry-run=server",
+			})
+			buf := &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+			rootCmd.SetErr(buf)
+
+			// when
+			err = rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			resource := mesh.NewDataplaneResource()
+			err = store.Get(context.Background(), resource, core_store.GetByKey("sample", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.Spec.Networking.Address).To(Equal("1.1.1.1"))
+			// and
+			Expect(buf.String()).To(Equal(
+				`--- live Dataplane default/sample
++++ dry run Dataplane default/sample
+@@ -2,10 +2,10 @@
+ name: sample
+ type: Dataplane
+ networking:
+-  address: 1.1.1.1
++  address: 2.2.2.2
+   inbound:
+   - port: 80
+     servicePort: 8080
+     tags:
+       kuma.io/service: web
+-      version: "1.0"
++      version: "2.0"
+`))
+		})
+
+		It("should print the whole resource that does not exist", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"apply", "-f", filepath.Join("testdata", "apply-mesh.yaml"),
+				"--dry-run=server",
+			})
+			buf := &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+			rootCmd.SetErr(buf)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			err = store.Get(context.Background(), mesh.NewMeshResource(), core_store.GetByKey("sample", core_model.NoMesh))
+			Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+			// and
+			Expect(buf.String()).To(Equal(
+				`--- live Mesh sample
++++ dry run Mesh sample
+@@ -0,0 +1,3 @@
++name: sample
++type: Mesh
++mtls: {}
+`))
+		})
+
+		It("should report unchanged resource", func() {
+			// setup
+			err := store.Create(context.Background(), &mesh.MeshResource{
+				Spec: &v1alpha1.Mesh{
+					Mtls: &v1alpha1.Mesh_Mtls{},
+				},
+			}, core_store.CreateByKey("sample", core_model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+
+			// given
+			rootCmd.SetArgs([]string{
+				"apply", "-f", filepath.Join("testdata", "apply-mesh.yaml"),
+				"--dry-run=server",
+			})
+			buf := &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+			rootCmd.SetErr(buf)
+
+			// when
+			err = rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(Equal("Mesh sample unchanged\n"))
+		})
+	})
+
+	Describe("lists of resources", func() {
+		BeforeEach(func() {
+			// resource manager rejects resources in the Mesh that does not exist yet
+			rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+				return managerStore{ResourceManager: core_manager.NewResourceManager(store)}
+			}
+		})
+
+		createTrafficPermission := func(name, meshName string) {
+			Expect(store.Create(context.Background(), &mesh.TrafficPermissionResource{
+				Spec: &v1alpha1.TrafficPermission{
+					Sources:      []*v1alpha1.Selector{{Match: map[string]string{v1alpha1.ServiceTag: "*"}}},
+					Destinations: []*v1alpha1.Selector{{Match: map[string]string{v1alpha1.ServiceTag: "*"}}},
+				},
+			}, core_store.CreateByKey(name, meshName))).To(Succeed())
+		}
+
+		It("should apply List and documents from stdin in dependency order", func() {
+			// setup
+			mockStdin, err := os.Open(filepath.Join("testdata", "apply-list.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			defer mockStdin.Close()
+
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"apply", "-f", "-",
+			})
+			rootCmd.SetIn(mockStdin)
+
+			// when
+			err = rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(store.Get(context.Background(), mesh.NewMeshResource(), core_store.GetByKey("demo", core_model.NoMesh))).To(Succeed())
+			Expect(store.Get(context.Background(), system.NewSecretResource(), core_store.GetByKey("secret-1", "demo"))).To(Succeed())
+			Expect(store.Get(context.Background(), mesh.NewTrafficPermissionResource(), core_store.GetByKey("tp-1", "demo"))).To(Succeed())
+			Expect(store.Get(context.Background(), mesh.NewTrafficPermissionResource(), core_store.GetByKey("tp-2", "demo"))).To(Succeed())
+		})
+
+		It("should prune resources of the applied types in the applied meshes", func() {
+			// setup
+			Expect(store.Create(context.Background(), mesh.NewMeshResource(), core_store.CreateByKey("demo", core_model.NoMesh))).To(Succeed())
+			Expect(store.Create(context.Background(), mesh.NewMeshResource(), core_store.CreateByKey("other", core_model.NoMesh))).To(Succeed())
+			createTrafficPermission("tp-old", "demo")
+			createTrafficPermission("tp-other", "other")
+			Expect(store.Create(context.Background(), &system.SecretResource{
+				Spec: &system_proto.Secret{Data: &wrapperspb.BytesValue{Value: []byte("secret")}},
+			}, core_store.CreateByKey("secret-old", "demo"))).To(Succeed())
+
+			// given
+			rootCmd.SetArgs([]string{
+				"apply", "-f", filepath.Join("testdata", "apply-prune.yaml"), "--prune",
+			})
+			buf := &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(`pruned TrafficPermission "tp-old" in Mesh "demo"` + "\n"))
+			// and
+			Expect(store.Get(context.Background(), mesh.NewTrafficPermissionResource(), core_store.GetByKey("tp-1", "demo"))).To(Succeed())
+			err = store.Get(context.Background(), mesh.NewTrafficPermissionResource(), core_store.GetByKey("tp-old", "demo"))
+			Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+			// and resources of other meshes and types are kept
+			Expect(store.Get(context.Background(), mesh.NewTrafficPermissionResource(), core_store.GetByKey("tp-other", "other"))).To(Succeed())
+			Expect(store.Get(context.Background(), system.NewSecretResource(), core_store.GetByKey("secret-old", "demo"))).To(Succeed())
+		})
+
+		It("should print resources that would be pruned with --dry-run=server", func() {
+			// setup
+			Expect(store.Create(context.Background(), mesh.NewMeshResource(), core_store.CreateByKey("demo", core_model.NoMesh))).To(Succeed())
+			createTrafficPermission("tp-old", "demo")
+
+			// given
+			rootCmd.SetArgs([]string{
+				"apply", "-f", filepath.Join("testdata", "apply-prune.yaml"), "--prune", "--dry-run=server",
+			})
+			buf := &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(HaveSuffix(`TrafficPermission "tp-old" in Mesh "demo" would be pruned` + "\n"))
+			// and
+			Expect(store.Get(context.Background(), mesh.NewTrafficPermissionResource(), core_store.GetByKey("tp-old", "demo"))).To(Succeed())
+			err = store.Get(context.Background(), mesh.NewTrafficPermissionResource(), core_store.GetByKey("tp-1", "demo"))
+			Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should not allow --prune with --dry-run=client", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"apply", "-f", filepath.Join("testdata", "apply-prune.yaml"), "--prune", "--dry-run=client",
+			})
+			rootCmd.SetOut(&bytes.Buffer{})
+			rootCmd.SetErr(&bytes.Buffer{})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError("--prune can't be used with --dry-run=client, use --dry-run=server to preview which resources are going to be pruned"))
+		})
+	})
+
+	It("should apply resources from a directory", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "-f", filepath.Join("testdata", "apply-dir")},
+		)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(store.Get(context.Background(), mesh.NewMeshResource(), core_store.GetByKey("default", core_model.NoMesh))).To(Succeed())
+		ValidatePersistedResource()
+	})
+
+	Describe("--atomic", func() {
+		var client *testApplyClient
+
+		BeforeEach(func() {
+			client = &testApplyClient{}
+			rootCtx.Runtime.NewApplyClient = func(util_http.Client) kumactl_resources.ApplyClient {
+				return client
+			}
+		})
+
+		It("should apply all resources in a single request in the apply order", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"apply", "-f", filepath.Join("testdata", "apply-dir"), "--atomic"},
+			)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client.applied).To(HaveLen(2))
+			Expect(client.applied[0].Descriptor().Name).To(Equal(mesh.MeshType))
+			Expect(client.applied[1].Descriptor().Name).To(Equal(mesh.DataplaneType))
+			// and nothing is applied resource by resource
+			err = store.Get(context.Background(), mesh.NewMeshResource(), core_store.GetByKey("default", core_model.NoMesh))
+			Expect(core_store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should return the error of the control plane", func() {
+			// given
+			client.err = &types.Error{
+				Title:   `Could not apply Dataplane "sample" (items[1]), none of the resources was applied`,
+				Details: "Mesh is not found",
+				Causes: []types.Cause{
+					{
+						Field:   "mesh",
+						Message: "mesh of name default is not found",
+					},
+				},
+			}
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"apply", "-f", filepath.Join("testdata", "apply-dataplane.yaml"), "--atomic"},
+			)
+			buf := &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+			rootCmd.SetErr(buf)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(buf.String()).To(Equal(
+				`Error: Could not apply Dataplane "sample" (items[1]), none of the resources was applied (Mesh is not found)
+* mesh: mesh of name default is not found
+`))
+		})
+
+		It("should not allow --atomic with --prune", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"apply", "-f", filepath.Join("testdata", "apply-dir"), "--atomic", "--prune"},
+			)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError("--atomic can't be used with --prune"))
+			Expect(client.applied).To(BeEmpty())
+		})
+	})
+
+	It("should fail on invalid value of --dry-run", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"apply", "-f", filepath.Join("testdata", "apply-mesh.yaml"),
+			"--dry-run=all",
+		})
+		buf := &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+		rootCmd.SetErr(buf)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`invalid value of --dry-run "all", expected one of none, client, server`))
+	})
+
 	It("should support variable names that include dot character", func() {
 		// given
 		rootCmd.SetArgs([]string{
@@ -483,5 +898,89 @@ networking:
 			resource: ``,
 			err:      "no resource(s) passed to apply",
 		}),
+		Entry("unknown field", testCase{
+			resource: `
+type: Dataplane
+name: dp-1
+mesh: default
+networking:
+  address: 192.168.0.1
+  inbounds: []
+`,
+			err: `Dataplane "dp-1" does not match the schema: networking.inbounds: unknown field`,
+		}),
+		Entry("field of wrong type", testCase{
+			resource: `
+type: Dataplane
+name: dp-1
+mesh: default
+networking:
+  address: 192.168.0.1
+  inbound:
+  - port: "8080"
+`,
+			err: `Dataplane "dp-1" does not match the schema: networking.inbound.0.port: Invalid type. Expected: integer, given: string`,
+		}),
 	)
+
+	It("should not validate resources against the schemas with --validate=false", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"apply", "--validate=false", "-f", "-"},
+		)
+		rootCmd.SetIn(strings.NewReader(`
+type: Mesh
+name: legacy
+mtls:
+  ca:
+    builtin: {}
+`))
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(store.Get(context.Background(), mesh.NewMeshResource(), core_store.GetByKey("legacy", core_model.NoMesh))).To(Succeed())
+	})
 })
+
+// managerStore exposes the resource manager as a resource store
+type managerStore struct {
+	core_manager.ResourceManager
+}
+
+func (managerStore) Watch(context.Context, ...core_store.WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return nil, core_store.ErrorWatchNotSupported
+}
+
+// conflictingStore fails the first updates with a conflict as if the resource was modified in the meantime
+type conflictingStore struct {
+	core_store.ResourceStore
+	failures int
+	updates  int
+}
+
+func (c *conflictingStore) Update(ctx context.Context, r core_model.Resource, fs ...core_store.UpdateOptionsFunc) error {
+	c.updates++
+	if c.updates <= c.failures {
+		return core_store.ErrorResourceConflict(r.Descriptor().Name, r.GetMeta().GetName(), r.GetMeta().GetMesh())
+	}
+	return c.ResourceStore.Update(ctx, r, fs...)
+}
+
+type testApplyClient struct {
+	applied []core_model.Resource
+	err     error
+}
+
+var _ kumactl_resources.ApplyClient = &testApplyClient{}
+
+func (t *testApplyClient) Apply(_ context.Context, resources []core_model.Resource) (api_server_types.ApplyResponse, error) {
+	if t.err != nil {
+		return api_server_types.ApplyResponse{}, t.err
+	}
+	t.applied = resources
+	return api_server_types.ApplyResponse{}, nil
+}
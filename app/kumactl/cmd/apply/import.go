@@ -0,0 +1,61 @@
+package apply
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+)
+
+func NewImportCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import Kuma resources",
+		Long: `Import Kuma resources exported with "kumactl export".
+Resources are created or updated in the order that satisfies references between them: Meshes first, then secrets and then the rest of the resources.`,
+		Example: `
+Import resources from the backup
+$ kumactl import -f backup.yaml
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := pctx.CheckServerVersionCompatibility(); err != nil {
+				cmd.PrintErrln(err)
+			}
+
+			resources, err := readResources(cmd, file, nil, nil)
+			if err != nil {
+				return err
+			}
+			sortByApplyOrder(resources)
+
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			for _, resource := range resources {
+				meta := resource.GetMeta()
+				// revisions of the exported resources are not related to the revisions of the resources on the control plane
+				resource.SetMeta(&rest_types.ResourceMeta{
+					Type: string(resource.Descriptor().Name),
+					Name: meta.GetName(),
+					Mesh: meta.GetMesh(),
+				})
+				if err := upsert(pctx.Runtime.Registry, rs, resource, defaultConflictRetries); err != nil {
+					return errors.Wrapf(err, "failed to import %s %q", resource.Descriptor().Name, meta.GetName())
+				}
+				if meta.GetMesh() != "" {
+					cmd.Printf("imported %s %q in Mesh %q\n", resource.Descriptor().Name, meta.GetName(), meta.GetMesh())
+				} else {
+					cmd.Printf("imported %s %q\n", resource.Descriptor().Name, meta.GetName())
+				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Path to file to import. Pass `-` to read from stdin")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
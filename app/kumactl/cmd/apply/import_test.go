@@ -0,0 +1,94 @@
+package apply_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+var _ = Describe("kumactl import", func() {
+
+	var store core_store.ResourceStore
+	var buf *bytes.Buffer
+	var execute func(args ...string) error
+
+	BeforeEach(func() {
+		store = core_store.NewPaginationStore(memory_resources.NewStore())
+		rootCtx := test_kumactl.MakeMinimalRootContext()
+		rootCtx.Runtime.Registry = registry.Global()
+		// resource manager rejects resources in the Mesh that does not exist yet
+		rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+			return managerStore{ResourceManager: core_manager.NewResourceManager(store)}
+		}
+
+		buf = &bytes.Buffer{}
+		execute = func(args ...string) error {
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			}, args...))
+			return rootCmd.Execute()
+		}
+	})
+
+	It("should import Mesh and secrets before the rest of the resources", func() {
+		// when
+		err := execute("import", "-f", filepath.Join("testdata", "import-backup.yaml"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`imported Mesh "demo"
+imported Secret "secret-1" in Mesh "demo"
+imported TrafficPermission "tp-1" in Mesh "demo"
+imported Zone "zone-1"
+`))
+		// and
+		Expect(store.Get(context.Background(), mesh.NewMeshResource(), core_store.GetByKey("demo", core_model.NoMesh))).To(Succeed())
+		Expect(store.Get(context.Background(), system.NewSecretResource(), core_store.GetByKey("secret-1", "demo"))).To(Succeed())
+		Expect(store.Get(context.Background(), system.NewZoneResource(), core_store.GetByKey("zone-1", core_model.NoMesh))).To(Succeed())
+	})
+
+	It("should update existing resources", func() {
+		// given
+		Expect(store.Create(context.Background(), mesh.NewMeshResource(), core_store.CreateByKey("demo", core_model.NoMesh))).To(Succeed())
+		Expect(store.Create(context.Background(), &mesh.TrafficPermissionResource{
+			Spec: &mesh_proto.TrafficPermission{
+				Sources:      []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "*"}}},
+				Destinations: []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "*"}}},
+			},
+		}, core_store.CreateByKey("tp-1", "demo"))).To(Succeed())
+
+		// when
+		err := execute("import", "-f", filepath.Join("testdata", "import-backup.yaml"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		tp := mesh.NewTrafficPermissionResource()
+		Expect(store.Get(context.Background(), tp, core_store.GetByKey("tp-1", "demo"))).To(Succeed())
+		Expect(tp.Spec.Sources[0].Match).To(Equal(map[string]string{mesh_proto.ServiceTag: "web"}))
+	})
+
+	It("should require -f arg", func() {
+		// when
+		err := execute("import")
+
+		// then
+		Expect(err).To(MatchError(`required flag(s) "file" not set`))
+	})
+})
@@ -2,11 +2,18 @@ package completion_test
 
 import (
 	"bytes"
+	"context"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
 
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	. "github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
 	"github.com/kumahq/kuma/pkg/util/test"
 )
 
@@ -64,3 +71,59 @@ var _ = Describe("kumactl completion", func() {
 		}),
 	)
 })
+
+type staticCompletionClient struct {
+	names map[string][]string
+}
+
+func (s *staticCompletionClient) ResourceNames(_ context.Context, desc core_model.ResourceTypeDescriptor, mesh string) ([]string, error) {
+	return s.names[string(desc.Name)+"/"+mesh], nil
+}
+
+var _ = Describe("kumactl dynamic completion", func() {
+
+	var stdout *bytes.Buffer
+	var rootCmd *cobra.Command
+
+	BeforeEach(func() {
+		stdout = &bytes.Buffer{}
+
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Args.ConfigType = kumactl_cmd.InMemory
+		rootCtx.Runtime.NewAPIServerClient = test.GetMockNewAPIServerClient()
+		rootCtx.Runtime.NewCompletionClient = func(util_http.Client) kumactl_resources.CompletionClient {
+			return &staticCompletionClient{
+				names: map[string][]string{
+					"Dataplane/default": {"backend-1", "web-1", "web-2"},
+					"Dataplane/demo":    {"demo-1"},
+					"Mesh/":             {"default", "demo"},
+					"Zone/":             {"zone-1", "zone-2"},
+				},
+			}
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(&bytes.Buffer{})
+	})
+
+	DescribeTable("should complete names of resources",
+		func(args []string, expected string) {
+			// given
+			rootCmd.SetArgs(append([]string{cobra.ShellCompRequestCmd}, args...))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout.String()).To(Equal(expected))
+		},
+		Entry("dataplane names in the default mesh", []string{"get", "dataplane", ""}, "backend-1\nweb-1\nweb-2\n:4\n"),
+		Entry("dataplane names with the prefix", []string{"get", "dataplane", "web"}, "web-1\nweb-2\n:4\n"),
+		Entry("dataplane names in the mesh", []string{"inspect", "dataplane", "--mesh", "demo", ""}, "demo-1\n:4\n"),
+		Entry("dataplane names to delete", []string{"delete", "dataplane", ""}, "backend-1\nweb-1\nweb-2\n:4\n"),
+		Entry("only the first argument", []string{"get", "dataplane", "web-1", ""}, ":4\n"),
+		Entry("mesh names", []string{"get", "dataplanes", "--mesh", ""}, "default\ndemo\n:4\n"),
+		Entry("zone names", []string{"generate", "zone-token", "--zone", "zone-"}, "zone-1\nzone-2\n:4\n"),
+	)
+})
@@ -10,10 +10,15 @@ func NewConfigCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config",
 		Short: "Manage kumactl config",
-		Long:  `Manage kumactl config.`,
+		Long: `Manage kumactl config.
+
+The configuration of the active Control Plane can be overridden for a single command with environment variables:
+KUMACTL_CONTEXT, KUMACTL_CONTROL_PLANE_ADDRESS, KUMACTL_AUTH_TOKEN, KUMACTL_CA_CERT_FILE,
+KUMACTL_CLIENT_CERT_FILE and KUMACTL_CLIENT_KEY_FILE. Overrides are never saved to the config file.`,
 	}
 	// sub-commands
 	cmd.AddCommand(newConfigViewCmd(pctx))
 	cmd.AddCommand(newConfigControlPlanesCmd(pctx))
+	cmd.AddCommand(newConfigUseContextCmd(pctx))
 	return cmd
 }
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func newConfigUseContextCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use-context NAME",
+		Short: "Switch active context",
+		Long: `Switch active context.
+
+The active context can be overridden for a single command with the KUMACTL_CONTEXT environment variable.`,
+		Example: `
+Switch to the context of the Global Control Plane
+$ kumactl config use-context global
+
+Run a single command in the context of a Zone Control Plane without switching
+$ KUMACTL_CONTEXT=zone-1 kumactl get dataplanes
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := pctx.Config()
+			if !cfg.SwitchContext(args[0]) {
+				return errors.Errorf("there is no context with name %q", args[0])
+			}
+			if err := pctx.SaveConfig(); err != nil {
+				return err
+			}
+			cmd.Printf("switched active context to %q\n", args[0])
+			return nil
+		},
+	}
+	return cmd
+}
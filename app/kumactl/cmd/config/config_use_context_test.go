@@ -0,0 +1,72 @@
+package config_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/pkg/util/test"
+)
+
+var _ = Describe("kumactl config use-context", func() {
+
+	var configFile *os.File
+
+	BeforeEach(func() {
+		var err error
+		configFile, err = os.CreateTemp("", "")
+		Expect(err).ToNot(HaveOccurred())
+	})
+	AfterEach(func() {
+		if configFile != nil {
+			Expect(os.Remove(configFile.Name())).To(Succeed())
+		}
+	})
+
+	var rootCmd *cobra.Command
+	var outbuf *bytes.Buffer
+
+	BeforeEach(func() {
+		rootCmd = test.DefaultTestingRootCmd()
+		outbuf = &bytes.Buffer{}
+		rootCmd.SetOut(outbuf)
+		rootCmd.SetErr(outbuf)
+	})
+
+	It("should fail to switch to unknown context", func() {
+		// given
+		rootCmd.SetArgs([]string{"--config-file", filepath.Join("testdata", "config-control-planes-use.01.initial.yaml"),
+			"config", "use-context", "example"})
+		// when
+		err := rootCmd.Execute()
+		// then
+		Expect(err).To(MatchError(`there is no context with name "example"`))
+	})
+
+	It("should switch to an existing context", func() {
+		// setup
+		initial, err := os.ReadFile(filepath.Join("testdata", "config-control-planes-use.11.initial.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(configFile.Name(), initial, 0600)).To(Succeed())
+
+		// given
+		rootCmd.SetArgs([]string{"--config-file", configFile.Name(),
+			"config", "use-context", "example"})
+		// when
+		err = rootCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		expected, err := os.ReadFile(filepath.Join("testdata", "config-control-planes-use.11.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		actual, err := os.ReadFile(configFile.Name())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+		Expect(outbuf.String()).To(Equal("switched active context to \"example\"\n"))
+	})
+})
@@ -1,6 +1,7 @@
 package delete
 
 import (
+	"bufio"
 	"context"
 	"sort"
 	"strings"
@@ -13,26 +14,71 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 )
 
+// listPageSize is the size of the page used to list resources for bulk deletion.
+const listPageSize = 100
+
+type deleteContext struct {
+	all    bool
+	filter map[string]string
+	yes    bool
+}
+
+func (c *deleteContext) bulk() bool {
+	return c.all || len(c.filter) > 0
+}
+
 func NewDeleteCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &deleteContext{}
 	byName := map[string]model.ResourceTypeDescriptor{}
 	allNames := []string{}
 	for _, desc := range pctx.Runtime.Registry.ObjectDescriptors(model.HasKumactlEnabled()) {
 		byName[desc.KumactlArg] = desc
+		if desc.KumactlListArg != "" {
+			byName[desc.KumactlListArg] = desc
+		}
 		allNames = append(allNames, desc.KumactlArg)
 	}
 	sort.Strings(allNames)
 	cmd := &cobra.Command{
-		Use:   "delete TYPE NAME",
+		Use:   "delete TYPE [NAME]",
 		Short: "Delete Kuma resources",
 		Long:  `Delete Kuma resources.`,
-		Args:  cobra.ExactArgs(2),
+		Example: `
+Delete a resource
+$ kumactl delete traffic-permission allow-all --mesh demo
+
+Delete all resources of the type in the mesh
+$ kumactl delete traffic-permissions --all --mesh demo
+
+Delete all dataplanes with the tag without the confirmation prompt
+$ kumactl delete dataplanes --filter kuma.io/service=web --mesh demo --yes
+`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if ctx.bulk() {
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			switch len(args) {
+			case 0:
+				return allNames, cobra.ShellCompDirectiveNoFileComp
+			case 1:
+				desc, ok := byName[args[0]]
+				if !ok || ctx.bulk() {
+					return nil, cobra.ShellCompDirectiveNoFileComp
+				}
+				return kumactl_cmd.CompleteResourceNames(pctx, desc)(cmd, nil, toComplete)
+			default:
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := pctx.CheckServerVersionCompatibility(); err != nil {
 				cmd.PrintErrln(err)
 			}
 
 			resourceTypeArg := args[0]
-			name := args[1]
 
 			desc, ok := byName[resourceTypeArg]
 			if !ok {
@@ -41,6 +87,11 @@ func NewDeleteCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 			if desc.ReadOnly {
 				return errors.Errorf("TYPE: %s is readOnly, can't use it for write action", resourceTypeArg)
 			}
+			if len(ctx.filter) > 0 {
+				if _, ok := desc.NewObject().GetSpec().(store.TagsMatcher); !ok {
+					return errors.Errorf("TYPE: %s can't be filtered by tags", resourceTypeArg)
+				}
+			}
 
 			rs, err := pctx.CurrentResourceStore()
 			if err != nil {
@@ -52,6 +103,11 @@ func NewDeleteCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 				mesh = pctx.CurrentMesh()
 			}
 
+			if ctx.bulk() {
+				return deleteResources(cmd, ctx, mesh, desc, rs)
+			}
+
+			name := args[1]
 			if err := deleteResource(name, mesh, desc, rs); err != nil {
 				return err
 			}
@@ -61,6 +117,9 @@ func NewDeleteCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 		},
 	}
 	cmd.PersistentFlags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	cmd.Flags().BoolVar(&ctx.all, "all", false, "delete all resources of the TYPE")
+	cmd.Flags().StringToStringVarP(&ctx.filter, "filter", "", map[string]string{}, "delete resources matching tag in format of key=value. You can provide many tags")
+	cmd.Flags().BoolVarP(&ctx.yes, "yes", "y", false, "do not prompt for confirmation of bulk deletion")
 	return cmd
 }
 
@@ -76,3 +135,58 @@ func deleteResource(name string, mesh string, desc model.ResourceTypeDescriptor,
 
 	return nil
 }
+
+// deleteResources deletes all resources of the type in the mesh that match the filter.
+// Names are collected before deletion, so removing resources does not shift the pages that are yet to be listed.
+func deleteResources(cmd *cobra.Command, ctx *deleteContext, mesh string, desc model.ResourceTypeDescriptor, rs store.ResourceStore) error {
+	names, err := listNames(mesh, ctx.filter, desc, rs)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		cmd.Printf("no %s resources found\n", desc.Name)
+		return nil
+	}
+
+	if !ctx.yes {
+		cmd.Printf("The following %d %s resources will be deleted:\n", len(names), desc.Name)
+		for _, name := range names {
+			cmd.Printf("  %s\n", name)
+		}
+		cmd.Print("Do you want to continue? [y/N]: ")
+		answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+		if err != nil && answer == "" {
+			cmd.Println()
+			return errors.New("deletion aborted")
+		}
+		if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+			return errors.New("deletion aborted")
+		}
+	}
+
+	for _, name := range names {
+		if err := deleteResource(name, mesh, desc, rs); err != nil {
+			return err
+		}
+		cmd.Printf("deleted %s %q\n", desc.Name, name)
+	}
+	return nil
+}
+
+func listNames(mesh string, tags map[string]string, desc model.ResourceTypeDescriptor, rs store.ResourceStore) ([]string, error) {
+	var names []string
+	offset := ""
+	for {
+		list := desc.NewList()
+		if err := rs.List(context.Background(), list, store.ListByMesh(mesh), store.ListByPage(listPageSize, offset), store.ListByTags(tags)); err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s", desc.Name)
+		}
+		for _, item := range list.GetItems() {
+			names = append(names, item.GetMeta().GetName())
+		}
+		if list.GetPagination() == nil || list.GetPagination().NextOffset == "" {
+			return names, nil
+		}
+		offset = list.GetPagination().NextOffset
+	}
+}
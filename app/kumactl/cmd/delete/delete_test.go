@@ -3,12 +3,15 @@ package delete_test
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"path/filepath"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/cmd"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
@@ -351,5 +354,141 @@ var _ = Describe("kumactl delete ", func() {
 				}),
 			)
 		})
+
+		Describe("kumactl delete TYPE --all", func() {
+
+			createTrafficPermissions := func(mesh string, count int) {
+				for i := 0; i < count; i++ {
+					err := store.Create(context.Background(), core_mesh.NewTrafficPermissionResource(), core_store.CreateByKey(fmt.Sprintf("tp-%03d", i), mesh))
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+
+			countTrafficPermissions := func(mesh string) int {
+				list := &core_mesh.TrafficPermissionResourceList{}
+				Expect(store.List(context.Background(), list, core_store.ListByMesh(mesh))).To(Succeed())
+				return len(list.Items)
+			}
+
+			It("should delete all resources in the mesh", func() {
+				// given
+				createTrafficPermissions("demo", 150)
+				createTrafficPermissions("default", 2)
+				rootCmd.SetArgs([]string{
+					"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+					"delete", "traffic-permissions", "--all", "--mesh", "demo", "--yes"})
+
+				// when
+				err := rootCmd.Execute()
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outbuf.String()).To(ContainSubstring("deleted TrafficPermission \"tp-000\"\n"))
+				Expect(outbuf.String()).To(ContainSubstring("deleted TrafficPermission \"tp-149\"\n"))
+				// and
+				Expect(countTrafficPermissions("demo")).To(Equal(0))
+				Expect(countTrafficPermissions("default")).To(Equal(2))
+			})
+
+			It("should delete resources when deletion is confirmed", func() {
+				// given
+				createTrafficPermissions("demo", 2)
+				rootCmd.SetIn(strings.NewReader("y\n"))
+				rootCmd.SetArgs([]string{
+					"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+					"delete", "traffic-permission", "--all", "--mesh", "demo"})
+
+				// when
+				err := rootCmd.Execute()
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outbuf.String()).To(Equal(`The following 2 TrafficPermission resources will be deleted:
+  tp-000
+  tp-001
+Do you want to continue? [y/N]: deleted TrafficPermission "tp-000"
+deleted TrafficPermission "tp-001"
+`))
+				// and
+				Expect(countTrafficPermissions("demo")).To(Equal(0))
+			})
+
+			It("should not delete resources when deletion is not confirmed", func() {
+				// given
+				createTrafficPermissions("demo", 2)
+				rootCmd.SetIn(strings.NewReader("n\n"))
+				rootCmd.SetArgs([]string{
+					"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+					"delete", "traffic-permissions", "--all", "--mesh", "demo"})
+
+				// when
+				err := rootCmd.Execute()
+
+				// then
+				Expect(err).To(MatchError("deletion aborted"))
+				// and
+				Expect(countTrafficPermissions("demo")).To(Equal(2))
+			})
+
+			It("should delete resources matching the filter", func() {
+				// given
+				for name, service := range map[string]string{"web-1": "web", "web-2": "web", "backend-1": "backend"} {
+					dp := core_mesh.NewDataplaneResource()
+					dp.Spec = &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "1.1.1.1",
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+								{
+									Port: 80,
+									Tags: map[string]string{mesh_proto.ServiceTag: service},
+								},
+							},
+						},
+					}
+					Expect(store.Create(context.Background(), dp, core_store.CreateByKey(name, "demo"))).To(Succeed())
+				}
+				rootCmd.SetArgs([]string{
+					"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+					"delete", "dataplanes", "--filter", "kuma.io/service=web", "--mesh", "demo", "--yes"})
+
+				// when
+				err := rootCmd.Execute()
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outbuf.String()).To(Equal("deleted Dataplane \"web-1\"\ndeleted Dataplane \"web-2\"\n"))
+				// and
+				list := &core_mesh.DataplaneResourceList{}
+				Expect(store.List(context.Background(), list, core_store.ListByMesh("demo"))).To(Succeed())
+				Expect(list.Items).To(HaveLen(1))
+				Expect(list.Items[0].GetMeta().GetName()).To(Equal("backend-1"))
+			})
+
+			It("should fail to filter resources that have no tags", func() {
+				// given
+				rootCmd.SetArgs([]string{
+					"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+					"delete", "traffic-permissions", "--filter", "kuma.io/service=web", "--yes"})
+
+				// when
+				err := rootCmd.Execute()
+
+				// then
+				Expect(err).To(MatchError("TYPE: traffic-permissions can't be filtered by tags"))
+			})
+
+			It("should fail when NAME is provided with --all", func() {
+				// given
+				rootCmd.SetArgs([]string{
+					"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+					"delete", "traffic-permission", "tp-1", "--all"})
+
+				// when
+				err := rootCmd.Execute()
+
+				// then
+				Expect(err).To(MatchError("accepts 1 arg(s), received 2"))
+			})
+		})
 	})
 })
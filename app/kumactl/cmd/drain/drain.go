@@ -0,0 +1,27 @@
+package drain
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewDrainCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	drainCmd := &cobra.Command{
+		Use:   "drain",
+		Short: "Drain Kuma proxies",
+		Long:  `Drain Kuma proxies.`,
+	}
+	drainCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := kumactl_cmd.RunParentPreRunE(drainCmd, args); err != nil {
+			return err
+		}
+		if err := pctx.CheckServerVersionCompatibility(); err != nil {
+			cmd.PrintErrln(err)
+		}
+		return nil
+	}
+	// sub-commands
+	drainCmd.AddCommand(newDrainDataplaneCmd(pctx))
+	return drainCmd
+}
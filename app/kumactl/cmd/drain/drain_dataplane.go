@@ -0,0 +1,45 @@
+package drain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+func newDrainDataplaneCmd(pctx *cmd.RootContext) *cobra.Command {
+	var graceful bool
+	cmd := &cobra.Command{
+		Use:   "dataplane NAME",
+		Short: "Drain listeners of Dataplane",
+		Long: `Drain listeners of Dataplane, so it stops accepting new connections.
+Contrary to shutting down the proxy, it keeps running after it is drained, so it can be used before maintenance of the node.`,
+		Example: `
+# Drain listeners of the backend-01 Dataplane letting existing connections finish in the drain time of the proxy
+$ kumactl drain dataplane backend-01 --mesh default --graceful
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := pctx.CurrentInspectEnvoyProxyClient(mesh.DataplaneResourceTypeDescriptor)
+			if err != nil {
+				return errors.Wrap(err, "failed to create a dataplane inspect client")
+			}
+
+			name := args[0]
+			resourceKey := core_model.ResourceKey{Name: name, Mesh: pctx.CurrentMesh()}
+			if err := client.Drain(context.Background(), resourceKey, graceful); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Listeners of Dataplane %q drained\n", name)
+			return err
+		},
+	}
+	cmd.PersistentFlags().BoolVar(&graceful, "graceful", false, "if set then existing connections are kept for the drain time of the proxy")
+	cmd.PersistentFlags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	return cmd
+}
@@ -0,0 +1,76 @@
+package drain_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testEnvoyProxyClient struct {
+	resources.InspectEnvoyProxyClient
+	drained  []core_model.ResourceKey
+	graceful bool
+}
+
+func (t *testEnvoyProxyClient) Drain(_ context.Context, rk core_model.ResourceKey, graceful bool) error {
+	t.drained = append(t.drained, rk)
+	t.graceful = graceful
+	return nil
+}
+
+var _ = Describe("kumactl drain dataplane", func() {
+
+	var client *testEnvoyProxyClient
+	var buf *bytes.Buffer
+	var execute func(args ...string) error
+
+	BeforeEach(func() {
+		client = &testEnvoyProxyClient{}
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewInspectEnvoyProxyClient = func(core_model.ResourceTypeDescriptor, util_http.Client) resources.InspectEnvoyProxyClient {
+			return client
+		}
+
+		buf = &bytes.Buffer{}
+		execute = func(args ...string) error {
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			}, args...))
+			return rootCmd.Execute()
+		}
+	})
+
+	It("should drain dataplane", func() {
+		// when
+		err := execute("drain", "dataplane", "backend-1", "--mesh", "demo")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.drained).To(Equal([]core_model.ResourceKey{{Mesh: "demo", Name: "backend-1"}}))
+		Expect(client.graceful).To(BeFalse())
+		Expect(buf.String()).To(Equal("Listeners of Dataplane \"backend-1\" drained\n"))
+	})
+
+	It("should drain dataplane gracefully", func() {
+		// when
+		err := execute("drain", "dataplane", "backend-1", "--graceful")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.drained).To(Equal([]core_model.ResourceKey{{Mesh: "default", Name: "backend-1"}}))
+		Expect(client.graceful).To(BeTrue())
+	})
+})
@@ -0,0 +1,11 @@
+package drain_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestDrainCmd(t *testing.T) {
+	test.RunSpecs(t, "Drain Cmd Suite")
+}
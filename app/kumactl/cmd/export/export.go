@@ -0,0 +1,43 @@
+package export
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewExportCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	var outputFile string
+	var allMeshes bool
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export Kuma resources",
+		Long: `Export Kuma resources.
+Without a sub-command, the Mesh and all resources in it are exported as YAML documents that can be restored with "kumactl import".
+Meshes are exported first, then secrets and the rest of the resources, so the order of the documents is stable.`,
+		Example: `
+# Export the demo Mesh with all resources in it to backup.yaml
+$ kumactl export --mesh demo -o backup.yaml
+
+# Export all Meshes and global resources, like Zones and GlobalSecrets
+$ kumactl export --all-meshes -o backup.yaml
+`,
+		Args: cobra.NoArgs,
+		RunE: exportRunE(pctx, &outputFile, &allMeshes),
+	}
+	exportCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := kumactl_cmd.RunParentPreRunE(exportCmd, args); err != nil {
+			return err
+		}
+		if err := pctx.CheckServerVersionCompatibility(); err != nil {
+			cmd.PrintErrln(err)
+		}
+		return nil
+	}
+	exportCmd.Flags().StringVarP(&outputFile, "output-file", "o", "-", `file to which resources are written. Use "-" to write them to the standard output`)
+	exportCmd.Flags().BoolVar(&allMeshes, "all-meshes", false, "export all Meshes and global resources instead of a single Mesh")
+	exportCmd.Flags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to export")
+	// sub-commands
+	exportCmd.AddCommand(newExportEnvoyConfigCmd(pctx))
+	return exportCmd
+}
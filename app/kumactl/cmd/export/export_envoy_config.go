@@ -0,0 +1,67 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+)
+
+func newExportEnvoyConfigCmd(pctx *cmd.RootContext) *cobra.Command {
+	var outputFile string
+	var concurrency int
+	var redaction string
+	cmd := &cobra.Command{
+		Use:   "envoy-config",
+		Short: "Export Envoy configuration of all Dataplanes in the Mesh",
+		Long: `Export Envoy configuration of all Dataplanes in the Mesh as a gzipped tar archive.
+Every config dump is stored in a "<dataplane>.json" file. Dataplanes from which the config dump could not be fetched are listed with the reason in "errors.json".
+Use --api-timeout to extend the timeout when exporting configuration of a big Mesh.`,
+		Example: `
+# Export Envoy configuration of all Dataplanes in the demo Mesh to demo-envoy-config.tar.gz
+$ kumactl export envoy-config --mesh demo
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := pctx.CurrentMeshEnvoyConfigClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a mesh envoy config client")
+			}
+
+			mesh := pctx.CurrentMesh()
+			if outputFile == "" {
+				outputFile = mesh + "-envoy-config.tar.gz"
+			}
+
+			var writer io.Writer = cmd.OutOrStdout()
+			if outputFile != "-" {
+				file, err := os.Create(outputFile)
+				if err != nil {
+					return errors.Wrap(err, "could not create output file")
+				}
+				defer file.Close()
+				writer = file
+			}
+
+			if err := client.Export(context.Background(), mesh, concurrency, redaction, writer); err != nil {
+				return err
+			}
+			if outputFile != "-" {
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "Envoy configuration of Dataplanes in Mesh %q exported to %s\n", mesh, outputFile)
+			}
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&outputFile, "output-file", "", `file to which the archive is written, "<mesh>-envoy-config.tar.gz" by default. Use "-" to write it to the standard output`)
+	cmd.Flags().IntVar(&concurrency, "concurrency", admin.DefaultMeshConfigDumpConcurrency, "maximum number of Dataplanes from which configuration is fetched at the same time")
+	cmd.Flags().StringVar(&redaction, "redaction", "", kuma_cmd.UsageOptions("redaction policy of config dumps", admin.RedactionPolicyNone, admin.RedactionPolicySecretsOnly, admin.RedactionPolicyFull))
+	cmd.Flags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	return cmd
+}
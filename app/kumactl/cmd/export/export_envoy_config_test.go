@@ -0,0 +1,91 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testMeshEnvoyConfigClient struct {
+	mesh        string
+	concurrency int
+	redaction   string
+}
+
+func (t *testMeshEnvoyConfigClient) Export(_ context.Context, mesh string, concurrency int, redaction string, writer io.Writer) error {
+	t.mesh = mesh
+	t.concurrency = concurrency
+	t.redaction = redaction
+	_, err := fmt.Fprint(writer, "archive")
+	return err
+}
+
+var _ = Describe("kumactl export envoy-config", func() {
+
+	var client *testMeshEnvoyConfigClient
+	var buf *bytes.Buffer
+	var execute func(args ...string) error
+
+	BeforeEach(func() {
+		client = &testMeshEnvoyConfigClient{}
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewMeshEnvoyConfigClient = func(util_http.Client) resources.MeshEnvoyConfigClient {
+			return client
+		}
+
+		buf = &bytes.Buffer{}
+		execute = func(args ...string) error {
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			}, args...))
+			return rootCmd.Execute()
+		}
+	})
+
+	It("should export envoy config to a file", func() {
+		// given
+		outputFile := filepath.Join(GinkgoT().TempDir(), "demo.tar.gz")
+
+		// when
+		err := execute("export", "envoy-config", "--mesh", "demo", "--output-file", outputFile, "--concurrency", "3", "--redaction", "full")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.mesh).To(Equal("demo"))
+		Expect(client.concurrency).To(Equal(3))
+		Expect(client.redaction).To(Equal("full"))
+		Expect(buf.String()).To(Equal(fmt.Sprintf("Envoy configuration of Dataplanes in Mesh \"demo\" exported to %s\n", outputFile)))
+
+		// and
+		content, err := os.ReadFile(outputFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("archive"))
+	})
+
+	It("should export envoy config to the standard output", func() {
+		// when
+		err := execute("export", "envoy-config", "--output-file", "-")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.mesh).To(Equal("default"))
+		Expect(client.concurrency).To(Equal(10))
+		Expect(client.redaction).To(BeEmpty())
+		Expect(buf.String()).To(Equal("archive"))
+	})
+})
@@ -0,0 +1,155 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+const listPageSize = 100
+
+// exportRunE exports resources as YAML documents that can be imported with "kumactl import" or applied with "kumactl apply".
+func exportRunE(pctx *cmd.RootContext, outputFile *string, allMeshes *bool) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		rs, err := pctx.CurrentResourceStore()
+		if err != nil {
+			return err
+		}
+
+		mesh := pctx.CurrentMesh()
+		if *allMeshes {
+			mesh = ""
+		}
+		resources, err := listResources(pctx.Runtime.Registry, rs, mesh)
+		if err != nil {
+			return err
+		}
+
+		var writer io.Writer = cmd.OutOrStdout()
+		if *outputFile != "-" {
+			file, err := os.Create(*outputFile)
+			if err != nil {
+				return errors.Wrap(err, "could not create output file")
+			}
+			defer file.Close()
+			writer = file
+		}
+		if err := printResources(resources, writer); err != nil {
+			return err
+		}
+		if *outputFile != "-" {
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "%d resources exported to %s\n", len(resources), *outputFile)
+		}
+		return err
+	}
+}
+
+// listResources lists all resources that can be managed by kumactl in a stable order.
+// Meshes are listed first, then secrets and then the rest of the types by name, so importing them in this order satisfies references between resources.
+// Empty mesh means all meshes together with global scoped resources.
+func listResources(typeRegistry registry.TypeRegistry, rs store.ResourceStore, mesh string) ([]model.Resource, error) {
+	var descriptors []model.ResourceTypeDescriptor
+	for _, desc := range typeRegistry.ObjectDescriptors(model.HasKumactlEnabled()) {
+		if desc.ReadOnly {
+			continue
+		}
+		if mesh != "" && desc.Scope == model.ScopeGlobal && desc.Name != core_mesh.MeshType {
+			continue
+		}
+		descriptors = append(descriptors, desc)
+	}
+	sort.SliceStable(descriptors, func(i, j int) bool {
+		if kumactl_resources.ApplyOrder(descriptors[i].Name) != kumactl_resources.ApplyOrder(descriptors[j].Name) {
+			return kumactl_resources.ApplyOrder(descriptors[i].Name) < kumactl_resources.ApplyOrder(descriptors[j].Name)
+		}
+		return descriptors[i].Name < descriptors[j].Name
+	})
+
+	var resources []model.Resource
+	for _, desc := range descriptors {
+		items, err := listAll(rs, desc, mesh)
+		if err != nil {
+			return nil, err
+		}
+		if desc.Name == core_mesh.MeshType && mesh != "" {
+			items = filterByName(items, mesh)
+			if len(items) == 0 {
+				return nil, errors.Errorf("there is no Mesh with name %q", mesh)
+			}
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].GetMeta().GetMesh() != items[j].GetMeta().GetMesh() {
+				return items[i].GetMeta().GetMesh() < items[j].GetMeta().GetMesh()
+			}
+			return items[i].GetMeta().GetName() < items[j].GetMeta().GetName()
+		})
+		resources = append(resources, items...)
+	}
+	return resources, nil
+}
+
+func listAll(rs store.ResourceStore, desc model.ResourceTypeDescriptor, mesh string) ([]model.Resource, error) {
+	var items []model.Resource
+	offset := ""
+	for {
+		list := desc.NewList()
+		opts := []store.ListOptionsFunc{store.ListByPage(listPageSize, offset)}
+		if desc.Scope == model.ScopeMesh {
+			opts = append(opts, store.ListByMesh(mesh))
+		}
+		if err := rs.List(context.Background(), list, opts...); err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s", desc.Name)
+		}
+		items = append(items, list.GetItems()...)
+		if list.GetPagination() == nil || list.GetPagination().NextOffset == "" {
+			return items, nil
+		}
+		offset = list.GetPagination().NextOffset
+	}
+}
+
+func filterByName(items []model.Resource, name string) []model.Resource {
+	var result []model.Resource
+	for _, item := range items {
+		if item.GetMeta().GetName() == name {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func printResources(resources []model.Resource, out io.Writer) error {
+	printer, err := printers.NewGenericPrinter(output.YAMLFormat)
+	if err != nil {
+		return err
+	}
+	for i, res := range resources {
+		if i > 0 {
+			if _, err := fmt.Fprintln(out, "---"); err != nil {
+				return err
+			}
+		}
+		restRes := rest_types.From.Resource(res)
+		// revisions are specific to the control plane, so they would prevent applying the export on another control plane
+		restRes.Meta.Revision = ""
+		if err := printer.Print(restRes, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+var _ = Describe("kumactl export", func() {
+
+	var buf *bytes.Buffer
+	var execute func(args ...string) error
+
+	BeforeEach(func() {
+		store := core_store.NewPaginationStore(memory_resources.NewStore())
+		rootCtx := test_kumactl.MakeMinimalRootContext()
+		rootCtx.Runtime.Registry = registry.Global()
+		rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+			return store
+		}
+
+		create := func(res core_model.Resource, name, mesh string) {
+			Expect(store.Create(context.Background(), res, core_store.CreateByKey(name, mesh))).To(Succeed())
+		}
+		trafficPermission := func() *core_mesh.TrafficPermissionResource {
+			return &core_mesh.TrafficPermissionResource{
+				Spec: &mesh_proto.TrafficPermission{
+					Sources:      []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "*"}}},
+					Destinations: []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "*"}}},
+				},
+			}
+		}
+		secret := func() *system.SecretResource {
+			return &system.SecretResource{
+				Spec: &system_proto.Secret{
+					Data: &wrapperspb.BytesValue{Value: []byte("secret")},
+				},
+			}
+		}
+		// created in the reversed order to check that the output is sorted
+		create(trafficPermission(), "tp-2", "demo")
+		create(trafficPermission(), "tp-1", "demo")
+		create(trafficPermission(), "tp-1", "default")
+		create(secret(), "secret-1", "demo")
+		create(&system.GlobalSecretResource{Spec: &system_proto.Secret{Data: &wrapperspb.BytesValue{Value: []byte("global")}}}, "global-secret-1", core_model.NoMesh)
+		create(system.NewZoneResource(), "zone-1", core_model.NoMesh)
+		create(core_mesh.NewMeshResource(), "demo", core_model.NoMesh)
+		create(core_mesh.NewMeshResource(), "default", core_model.NoMesh)
+
+		buf = &bytes.Buffer{}
+		execute = func(args ...string) error {
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			}, args...))
+			return rootCmd.Execute()
+		}
+	})
+
+	It("should export resources of the mesh", func() {
+		// when
+		err := execute("export", "--mesh", "demo")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "export-mesh.golden.yaml")))
+	})
+
+	It("should export all meshes and global resources", func() {
+		// when
+		err := execute("export", "--all-meshes")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "export-all-meshes.golden.yaml")))
+	})
+
+	It("should export resources to a file", func() {
+		// given
+		outputFile := filepath.Join(GinkgoT().TempDir(), "backup.yaml")
+
+		// when
+		err := execute("export", "--mesh", "demo", "-o", outputFile)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(fmt.Sprintf("4 resources exported to %s\n", outputFile)))
+		// and
+		content, err := os.ReadFile(outputFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "export-mesh.golden.yaml")))
+	})
+
+	It("should fail when mesh does not exist", func() {
+		// when
+		err := execute("export", "--mesh", "non-existing")
+
+		// then
+		Expect(err).To(MatchError(`there is no Mesh with name "non-existing"`))
+	})
+})
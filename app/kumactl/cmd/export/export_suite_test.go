@@ -0,0 +1,11 @@
+package export_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestExportCmd(t *testing.T) {
+	test.RunSpecs(t, "Export Cmd Suite")
+}
@@ -1,23 +1,34 @@
 package generate
 
 import (
+	"context"
+	"encoding/base64"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/tokens"
+	"github.com/kumahq/kuma/pkg/tokens/builtin"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
 )
 
 type generateDataplaneTokenContext struct {
 	*kumactl_cmd.RootContext
 
 	args struct {
-		name      string
-		proxyType string
-		tags      map[string]string
-		validFor  time.Duration
+		name           string
+		proxyType      string
+		tags           map[string]string
+		validFor       time.Duration
+		offline        bool
+		signingKeyPath string
+		serialNumber   int
 	}
 }
 
@@ -39,20 +50,32 @@ $ kumactl generate dataplane-token --type ingress --valid-for 24h
 
 Generate token bound by tag
 $ kumactl generate dataplane-token --mesh demo --tag kuma.io/service=web,web-api --valid-for 24h
+
+Generate token without access to the control plane using the signing key of the mesh
+$ kumactl generate dataplane-token --mesh demo --name demo-01 --valid-for 24h --offline --signing-key-path /tmp/key.pem
 `,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			client, err := pctx.CurrentDataplaneTokenClient()
-			if err != nil {
-				return errors.Wrap(err, "failed to create dataplane token client")
-			}
-
 			tags := map[string][]string{}
 			for k, v := range ctx.args.tags {
 				tags[k] = strings.Split(v, ",")
 			}
 			name := ctx.args.name
-			token, err := client.Generate(name, pctx.CurrentMesh(), tags, ctx.args.proxyType, ctx.args.validFor)
+
+			var token string
+			var err error
+			if ctx.args.offline {
+				token, err = ctx.generateOffline(cmd.Context(), name, pctx.CurrentMesh(), tags)
+			} else {
+				if ctx.args.signingKeyPath != "" {
+					return errors.New("--signing-key-path can only be used with --offline")
+				}
+				client, clientErr := pctx.CurrentDataplaneTokenClient()
+				if clientErr != nil {
+					return errors.Wrap(clientErr, "failed to create dataplane token client")
+				}
+				token, err = client.Generate(name, pctx.CurrentMesh(), tags, ctx.args.proxyType, ctx.args.validFor)
+			}
 			if err != nil {
 				return errors.Wrap(err, "failed to generate a dataplane token")
 			}
@@ -69,5 +92,41 @@ $ kumactl generate dataplane-token --mesh demo --tag kuma.io/service=web,web-api
 	// Backwards compatibility with 1.3.x. Right now we pick 10 years as default, but in the future this should be required argument without default.
 	// https://github.com/kumahq/kuma/issues/4001
 	cmd.Flags().DurationVar(&ctx.args.validFor, "valid-for", 24*time.Hour*365*10, `how long the token will be valid (for example "24h")`)
+	cmd.Flags().BoolVar(&ctx.args.offline, "offline", false, "sign the token locally with the signing key instead of requesting it from the control plane")
+	cmd.Flags().StringVar(&ctx.args.signingKeyPath, "signing-key-path", "", "path to the signing key of the mesh (PEM or base64 encoded PEM as stored in the Secret) used with --offline")
+	cmd.Flags().IntVar(&ctx.args.serialNumber, "signing-key-serial-number", tokens.DefaultSerialNumber, "serial number of the signing key used with --offline (number at the end of the Secret name)")
 	return cmd
 }
+
+func (c *generateDataplaneTokenContext) generateOffline(ctx context.Context, name string, mesh string, tags map[string][]string) (string, error) {
+	if c.args.signingKeyPath == "" {
+		return "", errors.New("--signing-key-path is required with --offline")
+	}
+	if c.args.proxyType != "" {
+		if err := mesh_proto.ProxyType(c.args.proxyType).IsValid(); err != nil {
+			return "", errors.Wrap(err, "invalid --proxy-type")
+		}
+	}
+	keyBytes, err := os.ReadFile(c.args.signingKeyPath)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read signing key")
+	}
+	// signing keys are stored in Secrets as base64 encoded PEM, so both forms are accepted
+	if !util_rsa.IsPrivateKeyPEMBytes(keyBytes) {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(keyBytes)))
+		if err != nil {
+			return "", errors.New("signing key is neither PEM nor base64 encoded PEM")
+		}
+		keyBytes = decoded
+	}
+	tokenIssuer, err := builtin.NewOfflineDataplaneTokenIssuer(keyBytes, c.args.serialNumber)
+	if err != nil {
+		return "", err
+	}
+	return tokenIssuer.Generate(ctx, issuer.DataplaneIdentity{
+		Name: name,
+		Mesh: mesh,
+		Type: mesh_proto.ProxyType(c.args.proxyType),
+		Tags: mesh_proto.MultiValueTagSetFrom(tags),
+	}, c.args.validFor)
+}
@@ -2,10 +2,15 @@ package generate_test
 
 import (
 	"bytes"
+	"crypto/rsa"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
@@ -14,7 +19,9 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/cmd"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/tokens"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
 	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
 	util_http "github.com/kumahq/kuma/pkg/util/http"
 )
 
@@ -92,4 +99,51 @@ var _ = Describe("kumactl generate dataplane-token", func() {
 		Expect(buf.String()).To(Equal("Error: failed to generate a dataplane token: could not connect to API\n"))
 	})
 
+	Describe("offline", func() {
+		var signingKey *rsa.PrivateKey
+		var signingKeyPath string
+
+		BeforeEach(func() {
+			keyBytes, err := core_tokens.NewSigningKey()
+			Expect(err).ToNot(HaveOccurred())
+			signingKey, err = core_tokens.ParseSigningKey(keyBytes)
+			Expect(err).ToNot(HaveOccurred())
+
+			// signing key in the format of kumactl generate signing-key
+			signingKeyPath = filepath.Join(GinkgoT().TempDir(), "signing-key")
+			Expect(os.WriteFile(signingKeyPath, []byte(base64.StdEncoding.EncodeToString(keyBytes)), 0600)).To(Succeed())
+		})
+
+		It("should sign a token with the signing key", func() {
+			// when
+			rootCmd.SetArgs([]string{"generate", "dataplane-token", "--mesh=demo", "--name=example", "--tag", "kuma.io/service=web",
+				"--offline", "--signing-key-path", signingKeyPath, "--signing-key-serial-number", "2"})
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and
+			claims := &issuer.DataplaneClaims{}
+			token, err := jwt.ParseWithClaims(buf.String(), claims, func(*jwt.Token) (interface{}, error) {
+				return &signingKey.PublicKey, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.Header[core_tokens.KeyIDHeader]).To(Equal("2"))
+			Expect(claims.Name).To(Equal("example"))
+			Expect(claims.Mesh).To(Equal("demo"))
+			Expect(claims.Tags).To(Equal(map[string][]string{"kuma.io/service": {"web"}}))
+		})
+
+		It("should require the signing key", func() {
+			// when
+			rootCmd.SetArgs([]string{"generate", "dataplane-token", "--name=example", "--offline"})
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(buf.String()).To(Equal("Error: failed to generate a dataplane token: --signing-key-path is required with --offline\n"))
+		})
+	})
+
 })
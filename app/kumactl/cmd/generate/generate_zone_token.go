@@ -58,6 +58,7 @@ $ kumactl generate zone-token --zone zone-1 --valid-for 24h --scope egress`,
 	}
 
 	cmd.Flags().StringVar(&ctx.args.zone, "zone", "", "name of the zone where resides")
+	_ = cmd.RegisterFlagCompletionFunc("zone", kumactl_cmd.CompleteZoneNames(pctx))
 	// TODO (bartsmykla): update when Zone Token will be available for dataplanes and ingresses
 	cmd.Flags().StringSliceVar(&ctx.args.scope, "scope", zone.FullScope, "scope of resources which the token will be able to identify (can be 'egress')")
 	cmd.Flags().DurationVar(&ctx.args.validFor, "valid-for", 0, `how long the token will be valid (for example "24h")`)
@@ -8,7 +8,11 @@ type GetContext struct {
 
 type ListContext struct {
 	Args struct {
-		Size   int
-		Offset string
+		Size      int
+		Offset    string
+		Filter    map[string]string
+		Watch     bool
+		AllPages  bool
+		ChunkSize int
 	}
 }
@@ -26,16 +26,22 @@ func NewGetCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 		return nil
 	}
 	// flags
-	getCmd.PersistentFlags().StringVarP(&pctx.GetContext.Args.OutputFormat, "output", "o", string(output.TableFormat), kuma_cmd.UsageOptions("output format", output.TableFormat, output.YAMLFormat, output.JSONFormat))
+	getCmd.PersistentFlags().StringVarP(&pctx.GetContext.Args.OutputFormat, "output", "o", string(output.TableFormat), kuma_cmd.UsageOptions("output format", output.TableFormat, output.YAMLFormat, output.JSONFormat, output.CustomColumnsFormat+"=HEADER:PATH[,HEADER:PATH]", output.JSONPathFormat+"=TEMPLATE"))
 	for _, cmdInst := range pctx.Runtime.Registry.ObjectDescriptors(model.HasKumactlEnabled()) {
 		getCmd.AddCommand(WithPaginationArgs(NewGetResourcesCmd(pctx, cmdInst), &pctx.ListContext))
 		getCmd.AddCommand(NewGetResourceCmd(pctx, cmdInst))
 	}
+	getCmd.AddCommand(NewGetAuditLogCmd(pctx))
 	return getCmd
 }
 
+// defaultChunkSize is the size of pages retrieved with --all-pages, it is the default page size of the API server.
+const defaultChunkSize = 100
+
 func WithPaginationArgs(cmd *cobra.Command, ctx *get_context.ListContext) *cobra.Command {
 	cmd.PersistentFlags().IntVarP(&ctx.Args.Size, "size", "", 0, "maximum number of elements to return")
 	cmd.PersistentFlags().StringVarP(&ctx.Args.Offset, "offset", "", "", "the offset that indicates starting element of the resources list to retrieve")
+	cmd.PersistentFlags().BoolVarP(&ctx.Args.AllPages, "all-pages", "", false, "retrieve all pages of the resources list. The table is printed page by page as they arrive, other formats are printed once all pages are retrieved")
+	cmd.PersistentFlags().IntVarP(&ctx.Args.ChunkSize, "chunk-size", "", defaultChunkSize, "number of elements retrieved in a single page when --all-pages is used")
 	return cmd
 }
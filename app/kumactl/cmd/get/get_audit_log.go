@@ -0,0 +1,84 @@
+package get
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_errors "github.com/kumahq/kuma/app/kumactl/pkg/errors"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+)
+
+func NewGetAuditLogCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	filter := kumactl_resources.AuditLogFilter{}
+	cmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "Show audit log",
+		Long: `Show mutations of resources recorded by the control plane from the newest to the oldest.
+The audit log has to be enabled in the configuration of the control plane. Every instance of the control plane keeps only the mutations that it executed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if filter.Size < 0 {
+				return kumactl_errors.NewValidationError(errors.New("--size has to be greater or equal to 0"))
+			}
+			client, err := pctx.CurrentAuditLogClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create an audit log client")
+			}
+			auditLog, err := client.List(context.Background(), filter)
+			if err != nil {
+				return err
+			}
+
+			switch format := output.Format(pctx.GetContext.Args.OutputFormat); format {
+			case output.TableFormat:
+				return printAuditLog(auditLog, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(auditLog, cmd.OutOrStdout())
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&filter.Type, "type", "", "type of resources, e.g. TrafficRoute")
+	cmd.PersistentFlags().StringVarP(&filter.Mesh, "mesh", "m", "", "mesh of resources. By default events of all meshes are shown")
+	cmd.PersistentFlags().StringVar(&filter.Name, "name", "", "name of resources")
+	cmd.PersistentFlags().IntVar(&filter.Size, "size", 0, "maximum number of events to show. By default all events are shown")
+	return cmd
+}
+
+func printAuditLog(auditLog api_server_types.AuditLogResponse, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"TIME", "USER", "GROUPS", "OPERATION", "TYPE", "MESH", "NAME"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(auditLog.Items) <= i {
+					return nil
+				}
+				event := auditLog.Items[i]
+				return []string{
+					event.Time.UTC().Format(time.RFC3339),   // TIME
+					event.Subject.Name,                      // USER
+					strings.Join(event.Subject.Groups, ","), // GROUPS
+					string(event.Operation),                 // OPERATION
+					event.Type,                              // TYPE
+					event.Mesh,                              // MESH
+					event.Name,                              // NAME
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
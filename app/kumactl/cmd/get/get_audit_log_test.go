@@ -0,0 +1,112 @@
+package get_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type testAuditLogClient struct {
+	filter   kumactl_resources.AuditLogFilter
+	response api_server_types.AuditLogResponse
+}
+
+func (t *testAuditLogClient) List(_ context.Context, filter kumactl_resources.AuditLogFilter) (api_server_types.AuditLogResponse, error) {
+	t.filter = filter
+	return t.response, nil
+}
+
+var _ kumactl_resources.AuditLogClient = &testAuditLogClient{}
+
+var _ = Describe("kumactl get audit-log", func() {
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var client *testAuditLogClient
+
+	BeforeEach(func() {
+		now := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+		client = &testAuditLogClient{
+			response: api_server_types.AuditLogResponse{
+				Total: 2,
+				Items: []audit.Event{
+					{
+						Time:      now.Add(time.Minute),
+						Subject:   audit.Subject{Name: "john.doe", Groups: []string{"team-a", "mesh-system:authenticated"}},
+						Operation: audit.UpdateOperation,
+						Type:      "TrafficRoute",
+						Mesh:      "default",
+						Name:      "route-all",
+						Diff:      "--- old\n+++ new\n@@ -1 +1 @@\n-a: b\n+a: c\n",
+					},
+					{
+						Time:      now,
+						Subject:   audit.ControlPlaneSubject,
+						Operation: audit.CreateOperation,
+						Type:      "Mesh",
+						Name:      "default",
+						Diff:      "--- old\n+++ new\n@@ -0,0 +1 @@\n+{}\n",
+					},
+				},
+			},
+		}
+		rootCtx, err := test_kumactl.MakeRootContext(now, nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewAuditLogClient = func(util_http.Client) kumactl_resources.AuditLogClient {
+			return client
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	DescribeTable("should print the audit log",
+		func(outputFormat string, goldenFile string) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"get", "audit-log"}, outputFormat))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matchers.MatchGoldenEqual("testdata", goldenFile))
+		},
+		Entry("table", "-otable", "get-audit-log.golden.txt"),
+		Entry("yaml", "-oyaml", "get-audit-log.golden.yaml"),
+	)
+
+	It("should pass filters to the API server", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"get", "audit-log", "--type", "TrafficRoute", "--mesh", "default", "--name", "route-all", "--size", "10",
+		})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.filter).To(Equal(kumactl_resources.AuditLogFilter{
+			Type: "TrafficRoute",
+			Mesh: "default",
+			Name: "route-all",
+			Size: 10,
+		}))
+	})
+})
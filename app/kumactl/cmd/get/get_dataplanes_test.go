@@ -3,6 +3,8 @@ package get_test
 import (
 	"bytes"
 	"context"
+	"io"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -12,6 +14,9 @@ import (
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	api_types "github.com/kumahq/kuma/pkg/api-server/types"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
@@ -19,6 +24,7 @@ import (
 	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
 	"github.com/kumahq/kuma/pkg/test/matchers"
 	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
 )
 
 var _ = Describe("kumactl get dataplanes", func() {
@@ -83,6 +89,7 @@ var _ = Describe("kumactl get dataplanes", func() {
 	Describe("GetDataplanesCmd", func() {
 
 		var rootCmd *cobra.Command
+		var rootCtx *kumactl_cmd.RootContext
 		var buf *bytes.Buffer
 		var store core_store.ResourceStore
 		rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
@@ -90,7 +97,8 @@ var _ = Describe("kumactl get dataplanes", func() {
 			// setup
 			store = core_store.NewPaginationStore(memory_resources.NewStore())
 
-			rootCtx, err := test_kumactl.MakeRootContext(rootTime, store, core_mesh.DataplaneResourceTypeDescriptor)
+			var err error
+			rootCtx, err = test_kumactl.MakeRootContext(rootTime, store, core_mesh.DataplaneResourceTypeDescriptor)
 			Expect(err).ToNot(HaveOccurred())
 
 			for _, pt := range dataplanes {
@@ -150,6 +158,116 @@ var _ = Describe("kumactl get dataplanes", func() {
 				goldenFile:   "get-dataplanes.golden.yaml",
 				matcher:      matchers.MatchGoldenYAML,
 			}),
+			Entry("should support custom columns output", testCase{
+				outputFormat: "-ocustom-columns=NAME:.name,ADDRESS:.networking.address,SERVICE:.networking.inbound[*].tags.service",
+				goldenFile:   "get-dataplanes.custom-columns.golden.txt",
+				matcher:      matchers.MatchGoldenEqual,
+			}),
+			Entry("should support JSONPath output", testCase{
+				outputFormat: "-ojsonpath={range .items[*]}{.name}{\"\\t\"}{.networking.inbound[0].tags}{\"\\n\"}{end}",
+				goldenFile:   "get-dataplanes.jsonpath.golden.txt",
+				matcher:      matchers.MatchGoldenEqual,
+			}),
 		)
+
+		It("should print all pages of dataplanes", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"get", "dataplanes", "--all-pages", "--chunk-size=1",
+			})
+
+			// when
+			Expect(rootCmd.Execute()).To(Succeed())
+
+			// then
+			Expect(buf.String()).To(matchers.MatchGoldenEqual("testdata", "get-dataplanes.all-pages.golden.txt"))
+		})
+
+		It("should collect all pages of dataplanes before printing JSON", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"get", "dataplanes", "--all-pages", "--chunk-size=1", "-ojson",
+			})
+
+			// when
+			Expect(rootCmd.Execute()).To(Succeed())
+
+			// then
+			Expect(buf.String()).To(matchers.MatchGoldenJSON("testdata", "get-dataplanes.all-pages.golden.json"))
+		})
+
+		It("should not allow --size with --all-pages", func() {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"get", "dataplanes", "--all-pages", "--size=1",
+			})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("--size can't be used with --all-pages")))
+		})
+
+		It("should filter dataplanes by tags", func() {
+			// when
+			Expect(
+				ExecuteRootCommand(rootCmd, "dataplanes", "", "--filter=service=web,version=v2"),
+			).To(Succeed())
+
+			// then
+			Expect(buf.String()).To(matchers.MatchGoldenEqual("testdata", "get-dataplanes.filter.golden.txt"))
+		})
+
+		It("should print changes of dataplanes when watching", func() {
+			// given
+			watchClient := &fakeResourceWatchClient{
+				events: []api_types.ResourceWatchEvent{
+					{Type: api_types.WatchEventAdded, Resource: []byte(`{"type":"Dataplane","mesh":"default","name":"web-1"}`)},
+					{Type: api_types.WatchEventModified, Resource: []byte(`{"type":"Dataplane","mesh":"default","name":"example"}`)},
+					{Type: api_types.WatchEventDeleted, Resource: []byte(`{"type":"Dataplane","mesh":"default","name":"experiment"}`)},
+				},
+			}
+			rootCtx.Runtime.NewResourceWatchClient = func(util_http.Client) kumactl_resources.ResourceWatchClient {
+				return watchClient
+			}
+
+			// when
+			Expect(
+				ExecuteRootCommand(rootCmd, "dataplanes", "", "--watch"),
+			).To(Succeed())
+
+			// then
+			Expect(watchClient.opts.Mesh).To(Equal("default"))
+			Expect(buf.String()).To(matchers.MatchGoldenEqual("testdata", "get-dataplanes.watch.golden.txt"))
+		})
 	})
 })
+
+type fakeResourceWatchClient struct {
+	opts   kumactl_resources.WatchOpts
+	events []api_types.ResourceWatchEvent
+}
+
+var _ kumactl_resources.ResourceWatchClient = &fakeResourceWatchClient{}
+
+func (f *fakeResourceWatchClient) Watch(_ context.Context, _ core_model.ResourceTypeDescriptor, opts kumactl_resources.WatchOpts) (kumactl_resources.ResourceWatchStream, error) {
+	f.opts = opts
+	return f, nil
+}
+
+func (f *fakeResourceWatchClient) Recv() (api_types.ResourceWatchEvent, error) {
+	if len(f.events) == 0 {
+		return api_types.ResourceWatchEvent{}, io.EOF
+	}
+	event := f.events[0]
+	f.events = f.events[1:]
+	return event, nil
+}
+
+func (f *fakeResourceWatchClient) Close() error {
+	return nil
+}
@@ -2,14 +2,18 @@ package get
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	kumactl_errors "github.com/kumahq/kuma/app/kumactl/pkg/errors"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	kumactl_resources "github.com/kumahq/kuma/app/kumactl/pkg/resources"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
@@ -27,28 +31,164 @@ func NewGetResourcesCmd(pctx *kumactl_cmd.RootContext, desc model.ResourceTypeDe
 				return err
 			}
 
+			if pctx.ListContext.Args.AllPages {
+				if pctx.ListContext.Args.Size != 0 {
+					return kumactl_errors.NewValidationError(errors.New("--size can't be used with --all-pages, use --chunk-size to change the size of pages"))
+				}
+				if pctx.ListContext.Args.ChunkSize <= 0 {
+					return kumactl_errors.NewValidationError(errors.New("--chunk-size has to be greater than 0"))
+				}
+			}
+
 			resources := desc.NewList()
 			currentMesh := pctx.CurrentMesh()
 			resource := resources.NewItem()
 			if resource.Descriptor().Scope == model.ScopeGlobal {
 				currentMesh = ""
 			}
-			if err := rs.List(context.Background(), resources, core_store.ListByMesh(currentMesh), core_store.ListByPage(pctx.ListContext.Args.Size, pctx.ListContext.Args.Offset)); err != nil {
-				return errors.Wrapf(err, "failed to list "+string(desc.Name))
-			}
 
-			switch format := output.Format(pctx.GetContext.Args.OutputFormat); format {
-			case output.TableFormat:
-				return ResolvePrinter(desc.Name, resource.Descriptor().Scope).Print(pctx.Now(), resources, cmd.OutOrStdout())
-			default:
-				printer, err := printers.NewGenericPrinter(format)
+			// subscribe before listing, so changes made in the meantime are not missed
+			var stream kumactl_resources.ResourceWatchStream
+			if pctx.ListContext.Args.Watch {
+				client, err := pctx.CurrentResourceWatchClient()
 				if err != nil {
 					return err
 				}
-				return printer.Print(rest_types.From.ResourceList(resources), cmd.OutOrStdout())
+				stream, err = client.Watch(context.Background(), desc, kumactl_resources.WatchOpts{
+					Mesh: currentMesh,
+					Tags: pctx.ListContext.Args.Filter,
+				})
+				if err != nil {
+					return errors.Wrapf(err, "failed to watch "+string(desc.Name))
+				}
+				defer stream.Close()
 			}
+
+			format := output.Format(pctx.GetContext.Args.OutputFormat)
+			if pctx.ListContext.Args.AllPages {
+				if err := printAllPages(pctx, rs, desc, currentMesh, format, cmd.OutOrStdout()); err != nil {
+					return err
+				}
+			} else {
+				if err := rs.List(context.Background(), resources, core_store.ListByMesh(currentMesh), core_store.ListByPage(pctx.ListContext.Args.Size, pctx.ListContext.Args.Offset), core_store.ListByTags(pctx.ListContext.Args.Filter)); err != nil {
+					return errors.Wrapf(err, "failed to list "+string(desc.Name))
+				}
+				if err := printResources(pctx, desc, resources, format, cmd.OutOrStdout()); err != nil {
+					return err
+				}
+			}
+			if stream == nil {
+				return nil
+			}
+			return printWatchEvents(stream, format, cmd.OutOrStdout())
 		},
 	}
 	cmd.PersistentFlags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	cmd.PersistentFlags().BoolVarP(&pctx.ListContext.Args.Watch, "watch", "w", false, "after listing the resources, watch for changes and print them until interrupted")
+	if _, ok := desc.NewObject().GetSpec().(core_store.TagsMatcher); ok {
+		cmd.PersistentFlags().StringToStringVarP(&pctx.ListContext.Args.Filter, "filter", "", map[string]string{}, "filter by tag in format of key=value. You can provide many tags")
+	}
 	return cmd
 }
+
+func printResources(pctx *kumactl_cmd.RootContext, desc model.ResourceTypeDescriptor, resources model.ResourceList, format output.Format, out io.Writer) error {
+	switch format {
+	case output.TableFormat:
+		return ResolvePrinter(desc.Name, desc.Scope).Print(pctx.Now(), resources, out)
+	default:
+		printer, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return err
+		}
+		return printer.Print(rest_types.From.ResourceList(resources), out)
+	}
+}
+
+// printAllPages follows the pagination of the list starting from --offset. The table is printed
+// page by page as they arrive. Other formats need the whole list to produce a single document,
+// so the pages are collected and printed at the end.
+func printAllPages(pctx *kumactl_cmd.RootContext, rs core_store.ResourceStore, desc model.ResourceTypeDescriptor, mesh string, format output.Format, out io.Writer) error {
+	if format != output.TableFormat {
+		// fail before retrieving all pages when the format is invalid
+		if _, err := printers.NewGenericPrinter(format); err != nil {
+			return err
+		}
+	}
+
+	all := desc.NewList()
+	offset := pctx.ListContext.Args.Offset
+	for first := true; ; first = false {
+		page := desc.NewList()
+		if err := rs.List(context.Background(), page, core_store.ListByMesh(mesh), core_store.ListByPage(pctx.ListContext.Args.ChunkSize, offset), core_store.ListByTags(pctx.ListContext.Args.Filter)); err != nil {
+			return errors.Wrapf(err, "failed to list "+string(desc.Name))
+		}
+		if format == output.TableFormat {
+			if err := ResolvePrinter(desc.Name, desc.Scope).PrintPage(pctx.Now(), page, first, out); err != nil {
+				return err
+			}
+		} else {
+			for _, item := range page.GetItems() {
+				if err := all.AddItem(item); err != nil {
+					return err
+				}
+			}
+		}
+		offset = page.GetPagination().NextOffset
+		if offset == "" {
+			break
+		}
+	}
+
+	if format == output.TableFormat {
+		return nil
+	}
+	all.GetPagination().SetTotal(uint32(len(all.GetItems())))
+	return printResources(pctx, desc, all, format, out)
+}
+
+// printWatchEvents prints the changes of the resources until the watch ends.
+// The table format prints one line per change, other formats print the whole event.
+func printWatchEvents(stream kumactl_resources.ResourceWatchStream, format output.Format, out io.Writer) error {
+	var printer output.Printer
+	if format != output.TableFormat {
+		p, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return err
+		}
+		printer = p
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		switch format {
+		case output.TableFormat:
+			meta := rest_types.ResourceMeta{}
+			if err := json.Unmarshal(event.Resource, &meta); err != nil {
+				return errors.Wrap(err, "could not parse the resource of the watch event")
+			}
+			name := meta.Name
+			if meta.Mesh != "" {
+				name = meta.Mesh + "/" + name
+			}
+			if _, err := fmt.Fprintf(out, "%s %s %s\n", event.Type, meta.Type, name); err != nil {
+				return err
+			}
+		case output.YAMLFormat:
+			if _, err := fmt.Fprintln(out, "---"); err != nil {
+				return err
+			}
+			if err := printer.Print(event, out); err != nil {
+				return err
+			}
+		default:
+			if err := printer.Print(event, out); err != nil {
+				return err
+			}
+		}
+	}
+}
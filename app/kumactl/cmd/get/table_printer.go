@@ -97,6 +97,9 @@ var CustomTablePrinters = map[model.ResourceType]TablePrinter{
 
 type TablePrinter interface {
 	Print(time.Time, model.ResourceList, io.Writer) error
+	// PrintPage prints a single page of the list without the pagination footer.
+	// Headers are printed only with the first page, so pages can be printed one after another.
+	PrintPage(rootTime time.Time, page model.ResourceList, first bool, out io.Writer) error
 }
 
 type RowPrinter struct {
@@ -105,9 +108,23 @@ type RowPrinter struct {
 }
 
 func (rp RowPrinter) Print(rootTime time.Time, resources model.ResourceList, out io.Writer) error {
+	data := rp.table(rootTime, resources)
+	data.Headers = rp.Headers
+	data.Footer = table.PaginationFooter(resources)
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func (rp RowPrinter) PrintPage(rootTime time.Time, page model.ResourceList, first bool, out io.Writer) error {
+	data := rp.table(rootTime, page)
+	if first {
+		data.Headers = rp.Headers
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func (rp RowPrinter) table(rootTime time.Time, resources model.ResourceList) printers.Table {
 	items := resources.GetItems()
-	data := printers.Table{
-		Headers: rp.Headers,
+	return printers.Table{
 		NextRow: func() func() []string {
 			i := 0
 			return func() []string {
@@ -118,9 +135,7 @@ func (rp RowPrinter) Print(rootTime time.Time, resources model.ResourceList, out
 				return rp.RowFn(rootTime, items[i])
 			}
 		}(),
-		Footer: table.PaginationFooter(resources),
 	}
-	return printers.NewTablePrinter().Print(data, out)
 }
 
 var BasicResourceTablePrinter = RowPrinter{
@@ -0,0 +1,276 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// envoyAdminFormat returns the format in which the Envoy Admin output is requested from the server.
+// Table is rendered from the default text format of Envoy, json and yaml are rendered from the json format.
+func envoyAdminFormat(format output.Format) (string, error) {
+	switch format {
+	case output.TableFormat:
+		return "", nil
+	case output.JSONFormat, output.YAMLFormat:
+		return "json", nil
+	default:
+		return "", errors.Errorf("unknown output format %q", format)
+	}
+}
+
+// printEnvoyAdminOutput prints the output of Envoy Admin that was requested in the format returned by envoyAdminFormat.
+func printEnvoyAdminOutput(format output.Format, content []byte, out io.Writer) error {
+	if format == output.YAMLFormat {
+		var err error
+		if content, err = yaml.JSONToYAML(content); err != nil {
+			return errors.Wrap(err, "could not convert the output to yaml")
+		}
+	}
+	_, err := fmt.Fprint(out, string(content))
+	return err
+}
+
+// printProxyInfo prints the information about the proxy that is returned by the server in the json format.
+func printProxyInfo(format output.Format, inspection string, content []byte, out io.Writer) error {
+	if format != output.TableFormat {
+		return printEnvoyAdminOutput(format, content, out)
+	}
+	var data printers.Table
+	var err error
+	switch inspection {
+	case InspectionTypeListeners:
+		data, err = listenersTable(content)
+	case InspectionTypeCerts:
+		data, err = certsTable(content)
+	case InspectionTypeRuntime:
+		data, err = runtimeTable(content)
+	case InspectionTypeServerInfo:
+		data, err = serverInfoTable(content)
+	default:
+		return errors.Errorf("unknown inspection type %q", inspection)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not parse %s of the proxy", inspection)
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func listenersTable(content []byte) (printers.Table, error) {
+	listeners := &envoy_admin_v3.Listeners{}
+	if err := util_proto.FromJSON(content, listeners); err != nil {
+		return printers.Table{}, err
+	}
+	var rows [][]string
+	for _, listener := range listeners.GetListenerStatuses() {
+		rows = append(rows, []string{
+			listener.GetName(),                        // NAME
+			formatAddress(listener.GetLocalAddress()), // ADDRESS
+		})
+	}
+	return rowsTable([]string{"NAME", "ADDRESS"}, rows), nil
+}
+
+func formatAddress(address *envoy_config_core_v3.Address) string {
+	if socket := address.GetSocketAddress(); socket != nil {
+		return net.JoinHostPort(socket.GetAddress(), strconv.FormatUint(uint64(socket.GetPortValue()), 10))
+	}
+	return address.GetPipe().GetPath()
+}
+
+// certsTable contains one row for every CA certificate and every certificate of the chains used by the proxy.
+func certsTable(content []byte) (printers.Table, error) {
+	certs := &envoy_admin_v3.Certificates{}
+	if err := util_proto.FromJSON(content, certs); err != nil {
+		return printers.Table{}, err
+	}
+	var rows [][]string
+	addRows := func(typ string, details []*envoy_admin_v3.CertificateDetails) {
+		for _, cert := range details {
+			var sans []string
+			for _, san := range cert.GetSubjectAltNames() {
+				switch {
+				case san.GetUri() != "":
+					sans = append(sans, san.GetUri())
+				case san.GetDns() != "":
+					sans = append(sans, san.GetDns())
+				case san.GetIpAddress() != "":
+					sans = append(sans, san.GetIpAddress())
+				}
+			}
+			rows = append(rows, []string{
+				typ,                     // TYPE
+				cert.GetPath(),          // PATH
+				cert.GetSerialNumber(),  // SERIAL NUMBER
+				strings.Join(sans, ","), // SUBJECT ALT NAMES
+				strconv.FormatUint(cert.GetDaysUntilExpiration(), 10), // DAYS UNTIL EXPIRATION
+			})
+		}
+	}
+	for _, cert := range certs.GetCertificates() {
+		addRows("ca", cert.GetCaCert())
+		addRows("cert", cert.GetCertChain())
+	}
+	return rowsTable([]string{"TYPE", "PATH", "SERIAL NUMBER", "SUBJECT ALT NAMES", "DAYS UNTIL EXPIRATION"}, rows), nil
+}
+
+func runtimeTable(content []byte) (printers.Table, error) {
+	runtime := admin.Runtime{}
+	if err := json.Unmarshal(content, &runtime); err != nil {
+		return printers.Table{}, err
+	}
+	var names []string
+	for name := range runtime.Entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var rows [][]string
+	for _, name := range names {
+		rows = append(rows, []string{
+			name,                             // NAME
+			runtime.Entries[name].FinalValue, // VALUE
+		})
+	}
+	return rowsTable([]string{"NAME", "VALUE"}, rows), nil
+}
+
+func serverInfoTable(content []byte) (printers.Table, error) {
+	info := &envoy_admin_v3.ServerInfo{}
+	if err := util_proto.FromJSON(content, info); err != nil {
+		return printers.Table{}, err
+	}
+	return rowsTable([]string{"VERSION", "STATE", "UPTIME"}, [][]string{{
+		info.GetVersion(),                                  // VERSION
+		info.GetState().String(),                           // STATE
+		info.GetUptimeCurrentEpoch().AsDuration().String(), // UPTIME
+	}}), nil
+}
+
+// printConfigDump prints the config dump. The table contains one row for every resource in the config dump.
+func printConfigDump(format output.Format, now time.Time, configDump []byte, out io.Writer) error {
+	if format != output.TableFormat {
+		return printEnvoyAdminOutput(format, configDump, out)
+	}
+	rows, err := configDumpRows(configDump, now)
+	if err != nil {
+		return err
+	}
+	data := printers.Table{
+		Headers: []string{"TYPE", "NAME", "VERSION", "LAST UPDATED AGO"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rows) <= i {
+					return nil
+				}
+				return rows[i]
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+// configDumpEntry is a resource in any section of the config dump. Every section wraps the resource in a different field.
+type configDumpEntry struct {
+	Name           string             `json:"name"`
+	VersionInfo    string             `json:"versionInfo"`
+	LastUpdated    *time.Time         `json:"lastUpdated"`
+	ActiveState    *configDumpEntry   `json:"activeState"`
+	Cluster        *configDumpPayload `json:"cluster"`
+	Listener       *configDumpPayload `json:"listener"`
+	RouteConfig    *configDumpPayload `json:"routeConfig"`
+	EndpointConfig *configDumpPayload `json:"endpointConfig"`
+}
+
+type configDumpPayload struct {
+	Name        string `json:"name"`
+	ClusterName string `json:"clusterName"`
+}
+
+// configDumpSections maps the config dump sections to the resource type and the fields with resources.
+var configDumpSections = map[string]struct {
+	typ    string
+	fields []string
+}{
+	"ClustersConfigDump":  {typ: "cluster", fields: []string{"staticClusters", "dynamicActiveClusters", "dynamicWarmingClusters"}},
+	"ListenersConfigDump": {typ: "listener", fields: []string{"staticListeners", "dynamicListeners"}},
+	"RoutesConfigDump":    {typ: "route", fields: []string{"staticRouteConfigs", "dynamicRouteConfigs"}},
+	"SecretsConfigDump":   {typ: "secret", fields: []string{"staticSecrets", "dynamicActiveSecrets", "dynamicWarmingSecrets"}},
+	"EndpointsConfigDump": {typ: "endpoints", fields: []string{"staticEndpointConfigs", "dynamicEndpointConfigs"}},
+}
+
+func configDumpRows(configDump []byte, now time.Time) ([][]string, error) {
+	dump := struct {
+		Configs []map[string]json.RawMessage `json:"configs"`
+	}{}
+	if err := json.Unmarshal(configDump, &dump); err != nil {
+		return nil, errors.Wrap(err, "could not parse the config dump")
+	}
+	var rows [][]string
+	for _, config := range dump.Configs {
+		var typeURL string
+		if err := json.Unmarshal(config["@type"], &typeURL); err != nil {
+			return nil, errors.Wrap(err, "could not parse the type of the config dump section")
+		}
+		section, ok := configDumpSections[typeURL[strings.LastIndex(typeURL, ".")+1:]]
+		if !ok {
+			continue
+		}
+		for _, field := range section.fields {
+			raw, ok := config[field]
+			if !ok {
+				continue
+			}
+			var entries []configDumpEntry
+			if err := json.Unmarshal(raw, &entries); err != nil {
+				return nil, errors.Wrapf(err, "could not parse %s of the config dump", field)
+			}
+			for _, entry := range entries {
+				if entry.ActiveState != nil {
+					entry.VersionInfo = entry.ActiveState.VersionInfo
+					entry.LastUpdated = entry.ActiveState.LastUpdated
+				}
+				rows = append(rows, []string{
+					section.typ,                       // TYPE
+					entry.resourceName(),              // NAME
+					entry.VersionInfo,                 // VERSION
+					table.Ago(entry.LastUpdated, now), // LAST UPDATED AGO
+				})
+			}
+		}
+	}
+	return rows, nil
+}
+
+func (e configDumpEntry) resourceName() string {
+	if e.Name != "" {
+		return e.Name
+	}
+	for _, payload := range []*configDumpPayload{e.Cluster, e.Listener, e.RouteConfig, e.EndpointConfig} {
+		if payload == nil {
+			continue
+		}
+		if payload.Name != "" {
+			return payload.Name
+		}
+		return payload.ClusterName
+	}
+	return ""
+}
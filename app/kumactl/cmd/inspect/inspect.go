@@ -36,8 +36,10 @@ func NewInspectCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	inspectCmd.AddCommand(newInspectZoneEgressesCmd(pctx))
 	inspectCmd.AddCommand(newInspectZoneEgressCmd(pctx))
 	inspectCmd.AddCommand(newInspectZonesCmd(pctx))
+	inspectCmd.AddCommand(newInspectZoneCmd(pctx))
 	inspectCmd.AddCommand(newInspectMeshesCmd(pctx))
 	inspectCmd.AddCommand(newInspectServicesCmd(pctx))
+	inspectCmd.AddCommand(newInspectControlPlaneCmd(pctx))
 
 	for _, desc := range registry.Global().ObjectDescriptors(core_model.AllowedToInspect()) {
 		inspectCmd.AddCommand(newInspectPolicyCmd(desc, pctx))
@@ -0,0 +1,256 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// unknownVersion is printed for proxies and zones that did not report their version.
+const unknownVersion = "unknown"
+
+// versionsPageSize is the size of pages in which the dataplane insights are listed.
+const versionsPageSize = 100
+
+type controlPlaneVersion struct {
+	Hostname   string `json:"hostname"`
+	InstanceId string `json:"instanceId"`
+	Version    string `json:"version"`
+}
+
+type zoneVersion struct {
+	Zone    string `json:"zone"`
+	Online  bool   `json:"online"`
+	Version string `json:"version"`
+}
+
+type versionCount struct {
+	Version    string `json:"version"`
+	Compatible bool   `json:"compatible"`
+	Dataplanes int    `json:"dataplanes"`
+}
+
+type versionMatrix struct {
+	ControlPlane controlPlaneVersion `json:"controlPlane"`
+	Zones        []zoneVersion       `json:"zones"`
+	KumaDp       []versionCount      `json:"kumaDp"`
+	Envoy        []versionCount      `json:"envoy"`
+}
+
+func newInspectControlPlaneCmd(ctx *cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "control-plane",
+		Short: "Inspect versions of Kuma components",
+		Long: `Inspect versions of Kuma components connected to the control plane.
+
+Shows the version of the control plane, versions of connected zone control planes
+and the number of data plane proxies running each version of kuma-dp and Envoy,
+so version skew between the components is visible in one place.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			matrix, err := buildVersionMatrix(context.Background(), ctx)
+			if err != nil {
+				return err
+			}
+
+			switch format := output.Format(ctx.InspectContext.Args.OutputFormat); format {
+			case output.TableFormat:
+				return printVersionMatrix(matrix, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(matrix, cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func buildVersionMatrix(ctx context.Context, rootCtx *cmd.RootContext) (*versionMatrix, error) {
+	matrix := &versionMatrix{}
+
+	apiClient, err := rootCtx.CurrentApiClient()
+	if err != nil {
+		return nil, err
+	}
+	index, err := apiClient.GetVersion(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve the version of the control plane")
+	}
+	matrix.ControlPlane = controlPlaneVersion{
+		Hostname:   index.Hostname,
+		InstanceId: index.InstanceId,
+		Version:    index.Version,
+	}
+
+	zoneClient, err := rootCtx.CurrentZoneOverviewClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a zone client")
+	}
+	zones, err := zoneClient.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, zone := range zones.Items {
+		zoneInsight := zone.Spec.GetZoneInsight()
+		version := unknownVersion
+		if subscription := zoneInsight.GetLastSubscription().(*system_proto.KDSSubscription); subscription.GetVersion().GetKumaCp().GetVersion() != "" {
+			version = subscription.GetVersion().GetKumaCp().GetVersion()
+		}
+		matrix.Zones = append(matrix.Zones, zoneVersion{
+			Zone:    zone.Meta.GetName(),
+			Online:  zoneInsight.IsOnline() && zone.Spec.GetZone().IsEnabled(),
+			Version: version,
+		})
+	}
+
+	rs, err := rootCtx.CurrentResourceStore()
+	if err != nil {
+		return nil, err
+	}
+	meshes := &mesh.MeshResourceList{}
+	if err := rs.List(ctx, meshes); err != nil {
+		return nil, errors.Wrap(err, "failed to list meshes")
+	}
+	kumaDp := map[versionCount]int{}
+	envoy := map[versionCount]int{}
+	for _, m := range meshes.Items {
+		if err := countDataplaneVersions(ctx, rs, m.Meta.GetName(), kumaDp, envoy); err != nil {
+			return nil, err
+		}
+	}
+	matrix.KumaDp = toVersionCounts(kumaDp)
+	matrix.Envoy = toVersionCounts(envoy)
+
+	return matrix, nil
+}
+
+func countDataplaneVersions(ctx context.Context, rs core_store.ResourceStore, meshName string, kumaDp, envoy map[versionCount]int) error {
+	offset := ""
+	for {
+		insights := &mesh.DataplaneInsightResourceList{}
+		if err := rs.List(ctx, insights, core_store.ListByMesh(meshName), core_store.ListByPage(versionsPageSize, offset)); err != nil {
+			return errors.Wrapf(err, "failed to list dataplane insights in mesh %q", meshName)
+		}
+		for _, insight := range insights.Items {
+			subscription := insight.Spec.GetLastSubscription().(*mesh_proto.DiscoverySubscription)
+			version := subscription.GetVersion()
+			kumaDp[versionCount{
+				Version:    versionOrUnknown(version.GetKumaDp().GetVersion()),
+				Compatible: version.GetKumaDp().GetKumaCpCompatible(),
+			}]++
+			envoy[versionCount{
+				Version:    versionOrUnknown(version.GetEnvoy().GetVersion()),
+				Compatible: version.GetEnvoy().GetKumaDpCompatible(),
+			}]++
+		}
+		offset = insights.GetPagination().NextOffset
+		if offset == "" {
+			return nil
+		}
+	}
+}
+
+func versionOrUnknown(version string) string {
+	if version == "" {
+		return unknownVersion
+	}
+	return version
+}
+
+func toVersionCounts(counts map[versionCount]int) []versionCount {
+	var result []versionCount
+	for key, count := range counts {
+		key.Dataplanes = count
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Version != result[j].Version {
+			return result[i].Version < result[j].Version
+		}
+		return result[i].Compatible && !result[j].Compatible
+	})
+	return result
+}
+
+func printVersionMatrix(matrix *versionMatrix, out io.Writer) error {
+	tables := []printers.Table{
+		rowsTable(
+			[]string{"CONTROL PLANE", "INSTANCE", "VERSION"},
+			[][]string{{matrix.ControlPlane.Hostname, matrix.ControlPlane.InstanceId, matrix.ControlPlane.Version}},
+		),
+	}
+
+	if len(matrix.Zones) > 0 {
+		var rows [][]string
+		for _, zone := range matrix.Zones {
+			status := "Offline"
+			if zone.Online {
+				status = "Online"
+			}
+			rows = append(rows, []string{zone.Zone, status, zone.Version})
+		}
+		tables = append(tables, rowsTable([]string{"ZONE", "STATUS", "KUMA-CP VERSION"}, rows))
+	}
+
+	tables = append(tables,
+		rowsTable([]string{"KUMA-DP VERSION", "KUMA-CP COMPATIBLE", "DATAPLANES"}, versionCountRows(matrix.KumaDp)),
+		rowsTable([]string{"ENVOY VERSION", "KUMA-DP COMPATIBLE", "DATAPLANES"}, versionCountRows(matrix.Envoy)),
+	)
+
+	for i, data := range tables {
+		if i > 0 {
+			if _, err := fmt.Fprintln(out); err != nil {
+				return err
+			}
+		}
+		if err := printers.NewTablePrinter().Print(data, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func versionCountRows(counts []versionCount) [][]string {
+	var rows [][]string
+	for _, count := range counts {
+		compatible := "no"
+		switch {
+		case count.Version == unknownVersion:
+			compatible = "-"
+		case count.Compatible:
+			compatible = "yes"
+		}
+		rows = append(rows, []string{count.Version, compatible, fmt.Sprintf("%d", count.Dataplanes)})
+	}
+	return rows
+}
+
+func rowsTable(headers []string, rows [][]string) printers.Table {
+	return printers.Table{
+		Headers: headers,
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rows) <= i {
+					return nil
+				}
+				return rows[i]
+			}
+		}(),
+	}
+}
@@ -0,0 +1,159 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomega_types "github.com/onsi/gomega/types"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	system_core "github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	util_test "github.com/kumahq/kuma/pkg/util/test"
+)
+
+var _ = Describe("kumactl inspect control-plane", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+
+	dataplaneInsight := func(kumaDp string, kumaCpCompatible bool, envoy string, kumaDpCompatible bool) *mesh_proto.DataplaneInsight {
+		return &mesh_proto.DataplaneInsight{
+			Subscriptions: []*mesh_proto.DiscoverySubscription{
+				{
+					Id:                     "1",
+					ControlPlaneInstanceId: "cp-1",
+					Version: &mesh_proto.Version{
+						KumaDp: &mesh_proto.KumaDpVersion{
+							Version:          kumaDp,
+							KumaCpCompatible: kumaCpCompatible,
+						},
+						Envoy: &mesh_proto.EnvoyVersion{
+							Version:          envoy,
+							KumaDpCompatible: kumaDpCompatible,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		now, _ := time.Parse(time.RFC3339, "2019-07-17T18:08:41+00:00")
+		store := core_store.NewPaginationStore(memory_resources.NewStore())
+
+		for _, meshName := range []string{"default", "demo"} {
+			Expect(store.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(meshName, core_model.NoMesh))).To(Succeed())
+		}
+		insights := map[core_model.ResourceKey]*mesh_proto.DataplaneInsight{
+			{Mesh: "default", Name: "web-1"}:  dataplaneInsight("1.8.0", true, "1.22.0", true),
+			{Mesh: "default", Name: "web-2"}:  dataplaneInsight("1.8.0", true, "1.22.0", true),
+			{Mesh: "demo", Name: "backend-1"}: dataplaneInsight("1.7.0", false, "1.21.1", true),
+			{Mesh: "demo", Name: "backend-2"}: {},
+		}
+		for key, insight := range insights {
+			Expect(store.Create(context.Background(), &core_mesh.DataplaneInsightResource{Spec: insight}, core_store.CreateByKey(key.Name, key.Mesh))).To(Succeed())
+		}
+
+		rootCtx, err := test_kumactl.MakeRootContext(now, store)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewAPIServerClient = func(util_http.Client) resources.ApiServerClient {
+			return &util_test.MockAPIServerClient{
+				Version: api_server_types.IndexResponse{
+					Hostname:   "kuma-cp",
+					InstanceId: "kuma-cp-1",
+					Version:    "1.8.0",
+				},
+			}
+		}
+		rootCtx.Runtime.NewZoneOverviewClient = func(util_http.Client) resources.ZoneOverviewClient {
+			return &testZoneOverviewClient{
+				total: 2,
+				overviews: []*system_core.ZoneOverviewResource{
+					{
+						Meta: &test_model.ResourceMeta{Name: "zone-1"},
+						Spec: &system_proto.ZoneOverview{
+							Zone: &system_proto.Zone{Enabled: util_proto.Bool(true)},
+							ZoneInsight: &system_proto.ZoneInsight{
+								Subscriptions: []*system_proto.KDSSubscription{
+									{
+										Id:          "1",
+										ConnectTime: util_proto.MustTimestampProto(now),
+										Version: &system_proto.Version{
+											KumaCp: &system_proto.KumaCpVersion{Version: "1.8.0"},
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						Meta: &test_model.ResourceMeta{Name: "zone-2"},
+						Spec: &system_proto.ZoneOverview{
+							Zone:        &system_proto.Zone{Enabled: util_proto.Bool(true)},
+							ZoneInsight: &system_proto.ZoneInsight{},
+						},
+					},
+				},
+			}
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		outputFormat string
+		goldenFile   string
+		matcher      func(path ...string) gomega_types.GomegaMatcher
+	}
+
+	DescribeTable("kumactl inspect control-plane -o table|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "control-plane"}, given.outputFormat))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(given.matcher("testdata", given.goldenFile))
+		},
+		Entry("should support Table output by default", testCase{
+			outputFormat: "",
+			goldenFile:   "inspect-control-plane.golden.txt",
+			matcher:      matchers.MatchGoldenEqual,
+		}),
+		Entry("should support JSON output", testCase{
+			outputFormat: "-ojson",
+			goldenFile:   "inspect-control-plane.golden.json",
+			matcher:      matchers.MatchGoldenJSON,
+		}),
+		Entry("should support YAML output", testCase{
+			outputFormat: "-oyaml",
+			goldenFile:   "inspect-control-plane.golden.yaml",
+			matcher:      matchers.MatchGoldenYAML,
+		}),
+	)
+})
@@ -3,16 +3,22 @@ package inspect
 import (
 	"context"
 	"fmt"
+	"io"
 	"text/template"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	output_tap "github.com/kumahq/kuma/app/kumactl/pkg/output/tap"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
 	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
 )
 
 const (
@@ -20,6 +26,10 @@ const (
 	InspectionTypeConfigDump = "config-dump"
 	InspectionTypeStats      = "stats"
 	InspectionTypeClusters   = "clusters"
+	InspectionTypeListeners  = "listeners"
+	InspectionTypeCerts      = "certs"
+	InspectionTypeRuntime    = "runtime"
+	InspectionTypeServerInfo = "server-info"
 )
 
 var dataplaneInspectTemplate = `{{ with IsSidecar . }}{{ range $num, $item := .Items }}{{ .AttachmentEntry | FormatAttachment }}:
@@ -61,16 +71,42 @@ func newInspectDataplaneCmd(pctx *cmd.RootContext) *cobra.Command {
 	}
 	var configDump bool
 	var inspectionType string
+	var redaction string
+	var includeEDS bool
+	var shadow bool
+	var explain bool
+	var logs bool
+	var logLevel string
 	cmd := &cobra.Command{
-		Use:   "dataplane NAME",
-		Short: "Inspect Dataplane",
-		Long:  "Inspect Dataplane.",
-		Args:  cobra.ExactArgs(1),
+		Use:               "dataplane NAME",
+		Short:             "Inspect Dataplane",
+		Long:              "Inspect Dataplane.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmd.CompleteResourceNames(pctx, mesh.DataplaneResourceTypeDescriptor),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
 			if configDump {
 				inspectionType = InspectionTypeConfigDump
 			}
+			if shadow && inspectionType != InspectionTypeConfigDump {
+				return errors.New("--shadow can only be used with --type=config-dump")
+			}
+			if includeEDS && inspectionType != InspectionTypeConfigDump {
+				return errors.New("--include-eds can only be used with --type=config-dump")
+			}
+			if explain && inspectionType != InspectionTypePolicies {
+				return errors.New("--explain can only be used with --type=policies")
+			}
+			if logs && cmd.Flags().Changed("type") {
+				return errors.New("--logs cannot be used with --type")
+			}
+			if logLevel != "" && !logs {
+				return errors.New("--envoy-log-level can only be used with --logs")
+			}
+			format := output.Format(pctx.InspectContext.Args.OutputFormat)
+			if logs {
+				return tailLogs(cmd.Context(), pctx, core_model.ResourceKey{Name: name, Mesh: pctx.CurrentMesh()}, format, logLevel, cmd.OutOrStdout())
+			}
 
 			client, err := pctx.CurrentInspectEnvoyProxyClient(mesh.DataplaneResourceTypeDescriptor)
 			if err != nil {
@@ -84,40 +120,133 @@ func newInspectDataplaneCmd(pctx *cmd.RootContext) *cobra.Command {
 				if err != nil {
 					return errors.Wrap(err, "failed to create a dataplane inspect client")
 				}
+				if explain {
+					result, err := client.Explain(context.Background(), pctx.CurrentMesh(), name)
+					if err != nil {
+						return err
+					}
+					return printExplain(format, tmpl, result, cmd.OutOrStdout())
+				}
 				entryList, err := client.InspectPolicies(context.Background(), pctx.CurrentMesh(), name)
 				if err != nil {
 					return err
 				}
 				return tmpl.Execute(cmd.OutOrStdout(), entryList)
 			case InspectionTypeConfigDump:
-				bytes, err := client.ConfigDump(context.Background(), resourceKey)
+				bytes, err := client.ConfigDump(context.Background(), resourceKey, resources.ConfigDumpOpts{
+					Redaction:  redaction,
+					IncludeEDS: includeEDS,
+					Shadow:     shadow,
+				})
 				if err != nil {
 					return err
 				}
-				_, err = fmt.Fprint(cmd.OutOrStdout(), string(bytes))
-				return err
+				return printConfigDump(format, pctx.Now(), bytes, cmd.OutOrStdout())
 			case InspectionTypeStats:
-				bytes, err := client.Stats(context.Background(), resourceKey)
+				envoyFormat, err := envoyAdminFormat(format)
 				if err != nil {
 					return err
 				}
-				_, err = fmt.Fprint(cmd.OutOrStdout(), string(bytes))
-				return err
+				bytes, err := client.Stats(context.Background(), resourceKey, resources.StatsOpts{Format: envoyFormat})
+				if err != nil {
+					return err
+				}
+				return printEnvoyAdminOutput(format, bytes, cmd.OutOrStdout())
 			case InspectionTypeClusters:
-				bytes, err := client.Clusters(context.Background(), resourceKey)
+				envoyFormat, err := envoyAdminFormat(format)
 				if err != nil {
 					return err
 				}
-				_, err = fmt.Fprint(cmd.OutOrStdout(), string(bytes))
-				return err
+				bytes, err := client.Clusters(context.Background(), resourceKey, envoyFormat)
+				if err != nil {
+					return err
+				}
+				return printEnvoyAdminOutput(format, bytes, cmd.OutOrStdout())
+			case InspectionTypeListeners, InspectionTypeCerts, InspectionTypeRuntime, InspectionTypeServerInfo:
+				bytes, err := client.ProxyInfo(context.Background(), resourceKey, inspectionType)
+				if err != nil {
+					return err
+				}
+				return printProxyInfo(format, inspectionType, bytes, cmd.OutOrStdout())
 			default:
 				return errors.New("invalid inspection type")
 			}
 		},
 	}
-	cmd.PersistentFlags().StringVar(&inspectionType, "type", InspectionTypePolicies, kuma_cmd.UsageOptions("inspection type", InspectionTypePolicies, InspectionTypeConfigDump, InspectionTypeStats, InspectionTypeClusters))
+	cmd.PersistentFlags().StringVar(&inspectionType, "type", InspectionTypePolicies, kuma_cmd.UsageOptions("inspection type", InspectionTypePolicies, InspectionTypeConfigDump, InspectionTypeStats, InspectionTypeClusters, InspectionTypeListeners, InspectionTypeCerts, InspectionTypeRuntime, InspectionTypeServerInfo))
+	cmd.PersistentFlags().StringVar(&redaction, "redaction", "", kuma_cmd.UsageOptions("redaction policy of the config dump", admin.RedactionPolicyNone, admin.RedactionPolicySecretsOnly, admin.RedactionPolicyFull))
+	cmd.PersistentFlags().BoolVar(&includeEDS, "include-eds", false, "include endpoints of the clusters in the config dump")
+	cmd.PersistentFlags().BoolVar(&shadow, "shadow", false, "return the config generated by the control plane for the dataplane instead of the config of the running proxy")
+	cmd.PersistentFlags().BoolVar(&explain, "explain", false, "explain the generation of the config of the dataplane: matched policies, generators of every resource and duration of every generation step")
+	cmd.PersistentFlags().BoolVar(&logs, "logs", false, "stream HTTP requests and responses captured by the inbound listeners of the dataplane until the command is interrupted")
+	cmd.PersistentFlags().StringVar(&logLevel, "envoy-log-level", "", kuma_cmd.UsageOptions("log level of Envoy set before the traffic is streamed with --logs", admin.LogLevelTrace, admin.LogLevelDebug, admin.LogLevelInfo, admin.LogLevelWarning, admin.LogLevelError, admin.LogLevelCritical, admin.LogLevelOff))
 	_ = cmd.PersistentFlags().MarkDeprecated("config-dump", "use --type=config-dump")
 	cmd.PersistentFlags().BoolVar(&configDump, "config-dump", false, "if set then the command returns envoy config dump for provided dataplane")
 	cmd.PersistentFlags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
 	return cmd
 }
+
+// tailLogs streams the traffic captured by the dataplane until the command is interrupted.
+func tailLogs(ctx context.Context, pctx *cmd.RootContext, resourceKey core_model.ResourceKey, format output.Format, logLevel string, out io.Writer) error {
+	var tapFormat output_tap.Format
+	switch format {
+	case output.TableFormat:
+		tapFormat = output_tap.TextFormat
+	case output.JSONFormat:
+		tapFormat = output_tap.JSONFormat
+	default:
+		return errors.Errorf("output format %q is not supported with --logs, use %q or %q", format, output.TableFormat, output.JSONFormat)
+	}
+	if err := admin.LogLevel(logLevel).Validate(); err != nil {
+		return err
+	}
+
+	client, err := pctx.CurrentStreamingInspectEnvoyProxyClient(mesh.DataplaneResourceTypeDescriptor)
+	if err != nil {
+		return errors.Wrap(err, "failed to create a dataplane inspect client")
+	}
+	stream, err := client.Tap(ctx, resourceKey, resources.TapOpts{LogLevel: logLevel})
+	if err != nil {
+		return errors.Wrap(err, "could not open the stream of the logs")
+	}
+	defer stream.Close()
+	return output_tap.PrintEntries(ctx, stream, out, tapFormat, 0)
+}
+
+// printExplain prints matched policies the same way as the default inspection followed by
+// the tables of generated resources and the generation steps.
+func printExplain(format output.Format, tmpl *template.Template, result api_server_types.DataplaneExplainResponse, out io.Writer) error {
+	if format != output.TableFormat {
+		printer, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return err
+		}
+		return printer.Print(result, out)
+	}
+	if err := tmpl.Execute(out, result.Policies); err != nil {
+		return err
+	}
+
+	var resourceRows [][]string
+	for _, resource := range result.Resources {
+		resourceRows = append(resourceRows, []string{resource.Type, resource.Name, resource.Origin})
+	}
+	var stepRows [][]string
+	for _, step := range result.Steps {
+		stepRows = append(stepRows, []string{step.Name, step.Duration})
+	}
+	for i, data := range []printers.Table{
+		rowsTable([]string{"TYPE", "NAME", "ORIGIN"}, resourceRows),
+		rowsTable([]string{"STEP", "DURATION"}, stepRows),
+	} {
+		if i > 0 {
+			if _, err := fmt.Fprintln(out); err != nil {
+				return err
+			}
+		}
+		if err := printers.NewTablePrinter().Print(data, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,6 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
@@ -24,19 +29,72 @@ import (
 )
 
 type testDataplaneInspectClient struct {
-	response api_server_types.DataplaneInspectResponse
+	response        api_server_types.DataplaneInspectResponse
+	explainResponse api_server_types.DataplaneExplainResponse
 }
 
 func (t *testDataplaneInspectClient) InspectPolicies(ctx context.Context, mesh, name string) (api_server_types.DataplaneInspectResponse, error) {
 	return t.response, nil
 }
 
+func (t *testDataplaneInspectClient) Explain(ctx context.Context, mesh, name string) (api_server_types.DataplaneExplainResponse, error) {
+	return t.explainResponse, nil
+}
+
 func (t *testDataplaneInspectClient) InspectConfigDump(ctx context.Context, mesh, name string) ([]byte, error) {
 	return nil, nil
 }
 
 var _ resources.DataplaneInspectClient = &testDataplaneInspectClient{}
 
+type testInspectEnvoyProxyClient struct {
+	configDumpOpts resources.ConfigDumpOpts
+	format         string
+	tapOpts        resources.TapOpts
+}
+
+func (t *testInspectEnvoyProxyClient) ConfigDump(ctx context.Context, rk model.ResourceKey, opts resources.ConfigDumpOpts) ([]byte, error) {
+	t.configDumpOpts = opts
+	return os.ReadFile(path.Join("testdata", "inspect-dataplane-config-dump.server-response.json"))
+}
+
+func (t *testInspectEnvoyProxyClient) Stats(ctx context.Context, rk model.ResourceKey, opts resources.StatsOpts) ([]byte, error) {
+	t.format = opts.Format
+	return t.response("stats")
+}
+
+func (t *testInspectEnvoyProxyClient) Clusters(ctx context.Context, rk model.ResourceKey, format string) ([]byte, error) {
+	t.format = format
+	return t.response("clusters")
+}
+
+func (t *testInspectEnvoyProxyClient) ProxyInfo(ctx context.Context, rk model.ResourceKey, inspection string) ([]byte, error) {
+	return os.ReadFile(path.Join("testdata", fmt.Sprintf("inspect-dataplane-%s.server-response.json", inspection)))
+}
+
+func (t *testInspectEnvoyProxyClient) Drain(ctx context.Context, rk model.ResourceKey, graceful bool) error {
+	return nil
+}
+
+func (t *testInspectEnvoyProxyClient) Forward(context.Context, model.ResourceKey, string, string, url.Values, io.Reader) (*http.Response, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (t *testInspectEnvoyProxyClient) Tap(_ context.Context, _ model.ResourceKey, opts resources.TapOpts) (io.ReadCloser, error) {
+	t.tapOpts = opts
+	return os.Open(path.Join("testdata", "inspect-dataplane-logs.server-response.json"))
+}
+
+func (t *testInspectEnvoyProxyClient) response(inspectionType string) ([]byte, error) {
+	ext := "txt"
+	if t.format == "json" {
+		ext = "json"
+	}
+	return os.ReadFile(path.Join("testdata", fmt.Sprintf("inspect-dataplane-%s.server-response.%s", inspectionType, ext)))
+}
+
+var _ resources.InspectEnvoyProxyClient = &testInspectEnvoyProxyClient{}
+
 var _ = Describe("kumactl inspect dataplane", func() {
 
 	var rootCmd *cobra.Command
@@ -100,4 +158,240 @@ var _ = Describe("kumactl inspect dataplane", func() {
 			matcher:      matchers.MatchGoldenEqual,
 		}),
 	)
+
+	type envoyAdminTestCase struct {
+		args                   []string
+		goldenFile             string
+		matcher                func(path ...string) gomega_types.GomegaMatcher
+		expectedFormat         string
+		expectedConfigDumpOpts resources.ConfigDumpOpts
+	}
+	DescribeTable("kumactl inspect dataplane with Envoy Admin inspection type",
+		func(given envoyAdminTestCase) {
+			// setup
+			testClient := &testInspectEnvoyProxyClient{}
+			rootCtx, err := test_kumactl.MakeRootContext(time.Date(2022, 5, 10, 10, 5, 0, 0, time.UTC), nil)
+			Expect(err).ToNot(HaveOccurred())
+			rootCtx.Runtime.NewInspectEnvoyProxyClient = func(descriptor model.ResourceTypeDescriptor, client util_http.Client) resources.InspectEnvoyProxyClient {
+				return testClient
+			}
+
+			rootCmd = cmd.NewRootCmd(rootCtx)
+			buf = &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "dataplane", "backend-1"}, given.args...))
+
+			// when
+			err = rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(given.matcher("testdata", given.goldenFile))
+			Expect(testClient.format).To(Equal(given.expectedFormat))
+			Expect(testClient.configDumpOpts).To(Equal(given.expectedConfigDumpOpts))
+		},
+		Entry("config dump as a table", envoyAdminTestCase{
+			args:       []string{"--type", "config-dump"},
+			goldenFile: "inspect-dataplane-config-dump.golden.txt",
+			matcher:    matchers.MatchGoldenEqual,
+		}),
+		Entry("config dump as json", envoyAdminTestCase{
+			args:       []string{"--type", "config-dump", "-o", "json", "--redaction", "full"},
+			goldenFile: "inspect-dataplane-config-dump.golden.json",
+			matcher:    matchers.MatchGoldenJSON,
+			expectedConfigDumpOpts: resources.ConfigDumpOpts{
+				Redaction: "full",
+			},
+		}),
+		Entry("shadow config dump with endpoints as yaml", envoyAdminTestCase{
+			args:       []string{"--type", "config-dump", "-o", "yaml", "--shadow", "--include-eds"},
+			goldenFile: "inspect-dataplane-config-dump.golden.yaml",
+			matcher:    matchers.MatchGoldenYAML,
+			expectedConfigDumpOpts: resources.ConfigDumpOpts{
+				IncludeEDS: true,
+				Shadow:     true,
+			},
+		}),
+		Entry("stats as a table", envoyAdminTestCase{
+			args:       []string{"--type", "stats"},
+			goldenFile: "inspect-dataplane-stats.golden.txt",
+			matcher:    matchers.MatchGoldenEqual,
+		}),
+		Entry("stats as yaml", envoyAdminTestCase{
+			args:           []string{"--type", "stats", "-o", "yaml"},
+			goldenFile:     "inspect-dataplane-stats.golden.yaml",
+			matcher:        matchers.MatchGoldenYAML,
+			expectedFormat: "json",
+		}),
+		Entry("clusters as a table", envoyAdminTestCase{
+			args:       []string{"--type", "clusters"},
+			goldenFile: "inspect-dataplane-clusters.golden.txt",
+			matcher:    matchers.MatchGoldenEqual,
+		}),
+		Entry("clusters as json", envoyAdminTestCase{
+			args:           []string{"--type", "clusters", "-o", "json"},
+			goldenFile:     "inspect-dataplane-clusters.golden.json",
+			matcher:        matchers.MatchGoldenJSON,
+			expectedFormat: "json",
+		}),
+		Entry("listeners as a table", envoyAdminTestCase{
+			args:       []string{"--type", "listeners"},
+			goldenFile: "inspect-dataplane-listeners.golden.txt",
+			matcher:    matchers.MatchGoldenEqual,
+		}),
+		Entry("certs as a table", envoyAdminTestCase{
+			args:       []string{"--type", "certs"},
+			goldenFile: "inspect-dataplane-certs.golden.txt",
+			matcher:    matchers.MatchGoldenEqual,
+		}),
+		Entry("certs as yaml", envoyAdminTestCase{
+			args:       []string{"--type", "certs", "-o", "yaml"},
+			goldenFile: "inspect-dataplane-certs.golden.yaml",
+			matcher:    matchers.MatchGoldenYAML,
+		}),
+		Entry("runtime as a table", envoyAdminTestCase{
+			args:       []string{"--type", "runtime"},
+			goldenFile: "inspect-dataplane-runtime.golden.txt",
+			matcher:    matchers.MatchGoldenEqual,
+		}),
+		Entry("server info as a table", envoyAdminTestCase{
+			args:       []string{"--type", "server-info"},
+			goldenFile: "inspect-dataplane-server-info.golden.txt",
+			matcher:    matchers.MatchGoldenEqual,
+		}),
+	)
+
+	DescribeTable("kumactl inspect dataplane --logs",
+		func(args []string, goldenFile string, matcher func(path ...string) gomega_types.GomegaMatcher, expectedOpts resources.TapOpts) {
+			// setup
+			testClient := &testInspectEnvoyProxyClient{}
+			rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			rootCtx.Runtime.NewInspectEnvoyProxyClient = func(descriptor model.ResourceTypeDescriptor, client util_http.Client) resources.InspectEnvoyProxyClient {
+				return testClient
+			}
+
+			rootCmd = cmd.NewRootCmd(rootCtx)
+			buf = &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "dataplane", "backend-1", "--logs"}, args...))
+
+			// when
+			err = rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matcher("testdata", goldenFile))
+			Expect(testClient.tapOpts).To(Equal(expectedOpts))
+		},
+		Entry("as text", nil, "inspect-dataplane-logs.golden.txt", matchers.MatchGoldenEqual, resources.TapOpts{}),
+		Entry("as json with log level", []string{"-o", "json", "--envoy-log-level", "debug"}, "inspect-dataplane-logs.golden.json", matchers.MatchGoldenEqual, resources.TapOpts{
+			LogLevel: "debug",
+		}),
+	)
+
+	It("should not allow --logs with an inspection type", func() {
+		// setup
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewInspectEnvoyProxyClient = func(descriptor model.ResourceTypeDescriptor, client util_http.Client) resources.InspectEnvoyProxyClient {
+			return &testInspectEnvoyProxyClient{}
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(&bytes.Buffer{})
+		rootCmd.SetErr(&bytes.Buffer{})
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "dataplane", "backend-1", "--type", "stats", "--logs"})
+
+		// when
+		err = rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("--logs cannot be used with --type"))
+	})
+
+	DescribeTable("kumactl inspect dataplane --explain",
+		func(args []string, goldenFile string, matcher func(path ...string) gomega_types.GomegaMatcher) {
+			// setup
+			rawResponse, err := os.ReadFile(path.Join("testdata", "inspect-dataplane-explain.server-response.json"))
+			Expect(err).ToNot(HaveOccurred())
+			testClient := &testDataplaneInspectClient{}
+			Expect(json.Unmarshal(rawResponse, &testClient.explainResponse)).To(Succeed())
+
+			rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			rootCtx.Runtime.NewDataplaneInspectClient = func(client util_http.Client) resources.DataplaneInspectClient {
+				return testClient
+			}
+			rootCtx.Runtime.NewInspectEnvoyProxyClient = func(descriptor model.ResourceTypeDescriptor, client util_http.Client) resources.InspectEnvoyProxyClient {
+				return nil
+			}
+
+			rootCmd = cmd.NewRootCmd(rootCtx)
+			buf = &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "dataplane", "backend-1", "--explain"}, args...))
+
+			// when
+			err = rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matcher("testdata", goldenFile))
+		},
+		Entry("as a table", nil, "inspect-dataplane-explain.golden.txt", matchers.MatchGoldenEqual),
+		Entry("as json", []string{"-o", "json"}, "inspect-dataplane-explain.golden.json", matchers.MatchGoldenJSON),
+	)
+
+	It("should not allow --explain with other inspection types than policies", func() {
+		// setup
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewInspectEnvoyProxyClient = func(descriptor model.ResourceTypeDescriptor, client util_http.Client) resources.InspectEnvoyProxyClient {
+			return &testInspectEnvoyProxyClient{}
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(&bytes.Buffer{})
+		rootCmd.SetErr(&bytes.Buffer{})
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "dataplane", "backend-1", "--type", "config-dump", "--explain"})
+
+		// when
+		err = rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("--explain can only be used with --type=policies"))
+	})
+
+	It("should not allow --shadow with other inspection types than config-dump", func() {
+		// setup
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewInspectEnvoyProxyClient = func(descriptor model.ResourceTypeDescriptor, client util_http.Client) resources.InspectEnvoyProxyClient {
+			return &testInspectEnvoyProxyClient{}
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(&bytes.Buffer{})
+		rootCmd.SetErr(&bytes.Buffer{})
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "dataplane", "backend-1", "--type", "stats", "--shadow"})
+
+		// when
+		err = rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("--shadow can only be used with --type=config-dump"))
+	})
 })
@@ -11,22 +11,23 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
-	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 )
 
 func newInspectMeshesCmd(ctx *cmd.RootContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "meshes",
 		Short: "Inspect Meshes",
-		Long:  `Inspect Meshes.`,
+		Long:  `Inspect Meshes with aggregated statistics of dataplanes, policies and mTLS.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			client, err := ctx.CurrentResourceStore()
+			client, err := ctx.CurrentMeshInsightsClient()
 			if err != nil {
 				return err
 			}
-			insights := &mesh.MeshInsightResourceList{}
-			if err := client.List(context.Background(), insights); err != nil {
+			insights, err := client.List(context.Background())
+			if err != nil {
 				return err
 			}
 
@@ -38,29 +39,37 @@ func newInspectMeshesCmd(ctx *cmd.RootContext) *cobra.Command {
 				if err != nil {
 					return err
 				}
-				return printer.Print(rest_types.From.ResourceList(insights), cmd.OutOrStdout())
+				return printer.Print(insights, cmd.OutOrStdout())
 			}
 		},
 	}
 	return cmd
 }
 
-func printMeshInsights(meshInsights *mesh.MeshInsightResourceList, out io.Writer) error {
+// policyColumns are policies that are printed in the table, in order.
+var policyColumns = []struct {
+	header     string
+	policyType core_model.ResourceType
+}{
+	{"TRAFFIC PERMISSIONS", mesh.TrafficPermissionType},
+	{"TRAFFIC ROUTES", mesh.TrafficRouteType},
+	{"CIRCUIT BREAKERS", mesh.CircuitBreakerType},
+	{"HEALTH CHECKS", mesh.HealthCheckType},
+	{"FAULT INJECTIONS", mesh.FaultInjectionType},
+	{"EXTERNAL SERVICES", mesh.ExternalServiceType},
+	{"TRAFFIC TRACES", mesh.TrafficTraceType},
+	{"TRAFFIC LOGS", mesh.TrafficLogType},
+	{"PROXY TEMPLATES", mesh.ProxyTemplateType},
+	{"RATE LIMITS", mesh.RateLimitType},
+}
+
+func printMeshInsights(meshInsights *api_server_types.MeshInsightsEntryList, out io.Writer) error {
+	headers := []string{"MESH", "DATAPLANES", "OFFLINE", "MTLS", "ISSUED CERTS"}
+	for _, column := range policyColumns {
+		headers = append(headers, column.header)
+	}
 	data := printers.Table{
-		Headers: []string{
-			"MESH",
-			"DATAPLANES",
-			"TRAFFIC PERMISSIONS",
-			"TRAFFIC ROUTES",
-			"CIRCUIT BREAKERS",
-			"HEALTH CHECKS",
-			"FAULT INJECTIONS",
-			"EXTERNAL SERVICES",
-			"TRAFFIC TRACES",
-			"TRAFFIC LOGS",
-			"PROXY TEMPLATES",
-			"RATE LIMITS",
-		},
+		Headers: headers,
 		NextRow: func() func() []string {
 			i := 0
 			return func() []string {
@@ -68,73 +77,24 @@ func printMeshInsights(meshInsights *mesh.MeshInsightResourceList, out io.Writer
 				if len(meshInsights.Items) <= i {
 					return nil
 				}
-				meta := meshInsights.Items[i].Meta
-				meshInsight := meshInsights.Items[i].Spec
-
-				var tp uint32
-				if stat, ok := meshInsight.Policies[string(mesh.TrafficPermissionType)]; ok {
-					tp = stat.Total
-				}
-
-				var tr uint32
-				if stat, ok := meshInsight.Policies[string(mesh.TrafficRouteType)]; ok {
-					tr = stat.Total
-				}
-
-				var cb uint32
-				if stat, ok := meshInsight.Policies[string(mesh.CircuitBreakerType)]; ok {
-					cb = stat.Total
-				}
+				entry := meshInsights.Items[i]
 
-				var hc uint32
-				if stat, ok := meshInsight.Policies[string(mesh.HealthCheckType)]; ok {
-					hc = stat.Total
+				mtls := "off"
+				if entry.MTLS.EnabledBackend != "" {
+					mtls = fmt.Sprintf("%s (%s)", entry.MTLS.EnabledBackend, entry.MTLS.BackendType)
 				}
 
-				var fi uint32
-				if stat, ok := meshInsight.Policies[string(mesh.FaultInjectionType)]; ok {
-					fi = stat.Total
+				row := []string{
+					entry.Mesh, // MESH
+					fmt.Sprintf("%d/%d", entry.Dataplanes.Online, entry.Dataplanes.Total), // DATAPLANES
+					table.Number(entry.Dataplanes.Offline),                                // OFFLINE
+					mtls,                                                                  // MTLS
+					table.Number(entry.MTLS.IssuedCertificates),                           // ISSUED CERTS
 				}
-
-				var es uint32
-				if stat, ok := meshInsight.Policies[string(mesh.ExternalServiceType)]; ok {
-					es = stat.Total
-				}
-
-				var tt uint32
-				if stat, ok := meshInsight.Policies[string(mesh.TrafficTraceType)]; ok {
-					tt = stat.Total
-				}
-
-				var tl uint32
-				if stat, ok := meshInsight.Policies[string(mesh.TrafficLogType)]; ok {
-					tl = stat.Total
-				}
-
-				var pt uint32
-				if stat, ok := meshInsight.Policies[string(mesh.ProxyTemplateType)]; ok {
-					pt = stat.Total
-				}
-
-				var rl uint32
-				if stat, ok := meshInsight.Policies[string(mesh.RateLimitType)]; ok {
-					rl = stat.Total
-				}
-
-				return []string{
-					meta.GetName(), // MESH
-					fmt.Sprintf("%d/%d", meshInsight.Dataplanes.Online, meshInsight.Dataplanes.Total), // DATAPLANES
-					table.Number(tp), // TRAFFIC PERMISSIONS
-					table.Number(tr), // TRAFFIC ROUTES
-					table.Number(cb), // CIRCUIT BREAKERS
-					table.Number(hc), // HEALTH CHECKS
-					table.Number(fi), // FAULT INJECTIONS
-					table.Number(es), // EXTERNAL SERVICES
-					table.Number(tt), // TRAFFIC TRACES
-					table.Number(tl), // TRAFFIC LOGS
-					table.Number(pt), // PROXY TEMPLATES
-					table.Number(rl), // RATE LIMITS
+				for _, column := range policyColumns {
+					row = append(row, table.Number(entry.Policies[string(column.policyType)]))
 				}
+				return row
 			}
 		}(),
 	}
@@ -11,61 +11,77 @@ import (
 	gomega_types "github.com/onsi/gomega/types"
 	"github.com/spf13/cobra"
 
-	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
-	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
-	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
-	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
 	"github.com/kumahq/kuma/pkg/test/matchers"
-	"github.com/kumahq/kuma/pkg/test/resources/model"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
 )
 
+type staticMeshInsightsClient struct {
+	entries *api_server_types.MeshInsightsEntryList
+}
+
+var _ resources.MeshInsightsClient = &staticMeshInsightsClient{}
+
+func (s *staticMeshInsightsClient) List(_ context.Context) (*api_server_types.MeshInsightsEntryList, error) {
+	return s.entries, nil
+}
+
 var _ = Describe("kumactl inspect meshes", func() {
 
-	meshInsightResources := []*mesh.MeshInsightResource{
-		{
-			Meta: &model.ResourceMeta{Name: "default"},
-			Spec: &mesh_proto.MeshInsight{
-				Dataplanes: &mesh_proto.MeshInsight_DataplaneStat{
+	meshInsights := &api_server_types.MeshInsightsEntryList{
+		Total: 2,
+		Items: []api_server_types.MeshInsightsEntry{
+			{
+				Mesh: "default",
+				Dataplanes: api_server_types.MeshDataplanesStat{
 					Total:   100,
 					Online:  90,
 					Offline: 10,
 				},
-				Policies: map[string]*mesh_proto.MeshInsight_PolicyStat{
-					string(mesh.TrafficTraceType):      {Total: 1},
-					string(mesh.TrafficRouteType):      {Total: 2},
-					string(mesh.TrafficLogType):        {Total: 3},
-					string(mesh.HealthCheckType):       {Total: 4},
-					string(mesh.CircuitBreakerType):    {Total: 5},
-					string(mesh.FaultInjectionType):    {Total: 6},
-					string(mesh.TrafficPermissionType): {Total: 7},
-					string(mesh.ProxyTemplateType):     {Total: 8},
-					string(mesh.ExternalServiceType):   {Total: 9},
-					string(mesh.RateLimitType):         {Total: 10},
+				Policies: map[string]uint32{
+					string(mesh.TrafficTraceType):      1,
+					string(mesh.TrafficRouteType):      2,
+					string(mesh.TrafficLogType):        3,
+					string(mesh.HealthCheckType):       4,
+					string(mesh.CircuitBreakerType):    5,
+					string(mesh.FaultInjectionType):    6,
+					string(mesh.TrafficPermissionType): 7,
+					string(mesh.ProxyTemplateType):     8,
+					string(mesh.ExternalServiceType):   9,
+					string(mesh.RateLimitType):         10,
+				},
+				MTLS: api_server_types.MeshMTLSInsightStat{
+					EnabledBackend:     "ca-1",
+					BackendType:        "builtin",
+					IssuedCertificates: 95,
+					IssuedBackends: map[string]uint32{
+						"ca-1": 90,
+						"ca-2": 5,
+					},
 				},
 			},
-		},
-		{
-			Meta: &model.ResourceMeta{Name: "mesh-1"},
-			Spec: &mesh_proto.MeshInsight{
-				Dataplanes: &mesh_proto.MeshInsight_DataplaneStat{
+			{
+				Mesh: "mesh-1",
+				Dataplanes: api_server_types.MeshDataplanesStat{
 					Total:   100,
 					Online:  90,
 					Offline: 10,
 				},
-				Policies: map[string]*mesh_proto.MeshInsight_PolicyStat{
-					string(mesh.TrafficTraceType):      {Total: 10},
-					string(mesh.TrafficRouteType):      {Total: 20},
-					string(mesh.TrafficLogType):        {Total: 30},
-					string(mesh.HealthCheckType):       {Total: 40},
-					string(mesh.CircuitBreakerType):    {Total: 50},
-					string(mesh.FaultInjectionType):    {Total: 60},
-					string(mesh.TrafficPermissionType): {Total: 70},
-					string(mesh.ProxyTemplateType):     {Total: 80},
-					string(mesh.ExternalServiceType):   {Total: 90},
-					string(mesh.RateLimitType):         {Total: 100},
+				Policies: map[string]uint32{
+					string(mesh.TrafficTraceType):      10,
+					string(mesh.TrafficRouteType):      20,
+					string(mesh.TrafficLogType):        30,
+					string(mesh.HealthCheckType):       40,
+					string(mesh.CircuitBreakerType):    50,
+					string(mesh.FaultInjectionType):    60,
+					string(mesh.TrafficPermissionType): 70,
+					string(mesh.ProxyTemplateType):     80,
+					string(mesh.ExternalServiceType):   90,
+					string(mesh.RateLimitType):         100,
 				},
 			},
 		},
@@ -75,18 +91,14 @@ var _ = Describe("kumactl inspect meshes", func() {
 
 		var rootCmd *cobra.Command
 		var buf *bytes.Buffer
-		var store core_store.ResourceStore
 		rootTime, _ := time.Parse(time.RFC3339, "2008-04-27T16:05:36.995Z")
 
 		BeforeEach(func() {
-			store = memory_resources.NewStore()
-			for _, cb := range meshInsightResources {
-				err := store.Create(context.Background(), cb, core_store.CreateBy(core_model.MetaToResourceKey(cb.GetMeta())))
-				Expect(err).ToNot(HaveOccurred())
-			}
-
-			rootCtx, err := test_kumactl.MakeRootContext(rootTime, store)
+			rootCtx, err := test_kumactl.MakeRootContext(rootTime, nil)
 			Expect(err).ToNot(HaveOccurred())
+			rootCtx.Runtime.NewMeshInsightsClient = func(util_http.Client) resources.MeshInsightsClient {
+				return &staticMeshInsightsClient{entries: meshInsights}
+			}
 
 			rootCmd = cmd.NewRootCmd(rootCtx)
 			buf = &bytes.Buffer{}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 )
 
 var inspectMeshGatewayTemplate = `{{ range $num, $item := .Items }}{{ .DataplaneKey.Name }}
@@ -22,10 +23,11 @@ func newInspectMeshGatewayCmd(pctx *cmd.RootContext) *cobra.Command {
 		panic(fmt.Sprintf("unable to parse template %v", err))
 	}
 	cmd := &cobra.Command{
-		Use:   "meshgateway NAME",
-		Short: "Inspect MeshGateway",
-		Long:  "List Dataplanes matched by this MeshGateway.",
-		Args:  cobra.ExactArgs(1),
+		Use:               "meshgateway NAME",
+		Short:             "Inspect MeshGateway",
+		Long:              "List Dataplanes matched by this MeshGateway.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmd.CompleteResourceNames(pctx, core_mesh.MeshGatewayResourceTypeDescriptor),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
 			client, err := pctx.CurrentMeshGatewayInspectClient()
@@ -56,10 +56,11 @@ func newInspectPolicyCmd(policyDesc core_model.ResourceTypeDescriptor, pctx *cmd
 	}
 
 	cmd := &cobra.Command{
-		Use:   fmt.Sprintf("%s NAME", policyDesc.KumactlArg),
-		Short: fmt.Sprintf("Inspect %s", policyDesc.Name),
-		Long:  fmt.Sprintf("Inspect %s.", policyDesc.Name),
-		Args:  cobra.ExactArgs(1),
+		Use:               fmt.Sprintf("%s NAME", policyDesc.KumactlArg),
+		Short:             fmt.Sprintf("Inspect %s", policyDesc.Name),
+		Long:              fmt.Sprintf("Inspect %s.", policyDesc.Name),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmd.CompleteResourceNames(pctx, policyDesc),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := pctx.CurrentPolicyInspectClient()
 			if err != nil {
@@ -4,18 +4,24 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 )
 
 type inspectServicesContext struct {
-	mesh string
+	mesh          string
+	mtlsReadiness bool
 }
 
 func newInspectServicesCmd(pctx *cmd.RootContext) *cobra.Command {
@@ -25,6 +31,9 @@ func newInspectServicesCmd(pctx *cmd.RootContext) *cobra.Command {
 		Short: "Inspect Services",
 		Long:  `Inspect Services.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if ctx.mtlsReadiness {
+				return inspectMTLSReadiness(pctx, ctx.mesh, cmd.OutOrStdout())
+			}
 			client, err := pctx.CurrentServiceOverviewClient()
 			if err != nil {
 				return err
@@ -47,6 +56,7 @@ func newInspectServicesCmd(pctx *cmd.RootContext) *cobra.Command {
 		},
 	}
 	cmd.PersistentFlags().StringVarP(&ctx.mesh, "mesh", "m", "default", "mesh")
+	cmd.PersistentFlags().BoolVar(&ctx.mtlsReadiness, "mtls-readiness", false, "show connections with and without mTLS of services to check if the mesh in PERMISSIVE mode is ready to switch to STRICT mode")
 
 	return cmd
 }
@@ -76,3 +86,69 @@ func printServiceInsights(overviews *mesh.ServiceOverviewResourceList, out io.Wr
 	}
 	return printers.NewTablePrinter().Print(data, out)
 }
+
+func inspectMTLSReadiness(pctx *cmd.RootContext, meshName string, out io.Writer) error {
+	rs, err := pctx.CurrentResourceStore()
+	if err != nil {
+		return err
+	}
+	insight := mesh.NewMeshInsightResource()
+	if err := rs.Get(context.Background(), insight, core_store.GetByKey(meshName, core_model.NoMesh)); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return errors.Errorf("there is no insight of Mesh %q yet", meshName)
+		}
+		return errors.Wrapf(err, "failed to get MeshInsight %q", meshName)
+	}
+	connections := insight.Spec.GetMTLS().GetPermissiveConnections()
+	if connections == nil {
+		return errors.Errorf("Mesh %q is not in PERMISSIVE mTLS mode", meshName)
+	}
+
+	switch format := output.Format(pctx.InspectContext.Args.OutputFormat); format {
+	case output.TableFormat:
+		return printMTLSReadiness(connections, out)
+	default:
+		printer, err := printers.NewGenericPrinter(format)
+		if err != nil {
+			return err
+		}
+		return printer.Print(rest_types.From.Resource(insight), out)
+	}
+}
+
+func printMTLSReadiness(connections map[string]*mesh_proto.MeshInsight_MTLS_PermissiveConnections, out io.Writer) error {
+	var services []string
+	for service := range connections {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	data := printers.Table{
+		Headers: []string{
+			"SERVICE",
+			"MTLS",
+			"PLAINTEXT",
+			"REPORTED",
+			"READY",
+		},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(services) <= i {
+					return nil
+				}
+				stat := connections[services[i]]
+				// a service is ready for STRICT mode when all its dataplanes reported connections and there is none without mTLS
+				ready := stat.GetReported() == stat.GetTotal() && stat.GetPlaintext() == 0
+				return []string{
+					services[i],                                               // SERVICE
+					fmt.Sprintf("%d", stat.GetMtls()),                         // MTLS
+					fmt.Sprintf("%d", stat.GetPlaintext()),                    // PLAINTEXT
+					fmt.Sprintf("%d/%d", stat.GetReported(), stat.GetTotal()), // REPORTED
+					fmt.Sprintf("%t", ready),                                  // READY
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
 	"github.com/kumahq/kuma/pkg/test/matchers"
 	"github.com/kumahq/kuma/pkg/test/resources/model"
@@ -133,4 +135,61 @@ var _ = Describe("kumactl inspect services", func() {
 			matcher:      matchers.MatchGoldenYAML,
 		}),
 	)
+
+	Describe("--mtls-readiness", func() {
+		var store core_store.ResourceStore
+
+		BeforeEach(func() {
+			store = memory_resources.NewStore()
+			rootCtx, err := test_kumactl.MakeRootContext(rootTime, store)
+			Expect(err).ToNot(HaveOccurred())
+			rootCmd = cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+		})
+
+		It("should show connections of services", func() {
+			// given
+			insight := &core_mesh.MeshInsightResource{
+				Spec: &v1alpha1.MeshInsight{
+					MTLS: &v1alpha1.MeshInsight_MTLS{
+						PermissiveConnections: map[string]*v1alpha1.MeshInsight_MTLS_PermissiveConnections{
+							"web":     {Mtls: 120, Reported: 2, Total: 2},
+							"backend": {Mtls: 80, Plaintext: 7, Reported: 3, Total: 3},
+							"orders":  {Mtls: 10, Reported: 1, Total: 2},
+						},
+					},
+				},
+			}
+			Expect(store.Create(context.Background(), insight, core_store.CreateByKey("mesh-1", core_model.NoMesh))).To(Succeed())
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "services", "--mesh", "mesh-1", "--mtls-readiness"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matchers.MatchGoldenEqual("testdata", "inspect-services-mtls-readiness.golden.txt"))
+		})
+
+		It("should fail when mesh is not in PERMISSIVE mode", func() {
+			// given
+			insight := &core_mesh.MeshInsightResource{
+				Spec: &v1alpha1.MeshInsight{
+					MTLS: &v1alpha1.MeshInsight_MTLS{},
+				},
+			}
+			Expect(store.Create(context.Background(), insight, core_store.CreateByKey("mesh-1", core_model.NoMesh))).To(Succeed())
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "services", "--mesh", "mesh-1", "--mtls-readiness"})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(`Mesh "mesh-1" is not in PERMISSIVE mTLS mode`))
+		})
+	})
 })
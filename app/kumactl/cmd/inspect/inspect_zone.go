@@ -0,0 +1,90 @@
+package inspect
+
+import (
+	"context"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+func newInspectZoneCmd(pctx *cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "zone NAME",
+		Short:             "Inspect Zone",
+		Long:              "Inspect Zone. The table output shows the sync status of the resources of each type sent to the Zone over the most recent KDS subscription.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmd.CompleteResourceNames(pctx, system.ZoneResourceTypeDescriptor),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := pctx.CurrentZoneOverviewClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a zone client")
+			}
+			overview, err := client.Get(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+
+			switch format := output.Format(pctx.InspectContext.Args.OutputFormat); format {
+			case output.TableFormat:
+				return printZoneSyncStatus(pctx.Now(), overview, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(rest_types.From.Resource(overview), cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printZoneSyncStatus(now time.Time, overview *system.ZoneOverviewResource, out io.Writer) error {
+	lastSubscription := overview.Spec.GetZoneInsight().GetLastSubscription().(*system_proto.KDSSubscription)
+	stats := lastSubscription.GetStatus().GetStat()
+	var types []string
+	for typ := range stats {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	data := printers.Table{
+		Headers: []string{"TYPE", "RESOURCES", "LAST SENT AGO", "LAST ACKNOWLEDGED AGO", "TOTAL UPDATES", "TOTAL ERRORS", "PENDING CHANGES", "ACKNOWLEDGED VERSION"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(types) <= i {
+					return nil
+				}
+				stat := stats[types[i]]
+				lastSent := util_proto.MustTimestampFromProto(stat.GetLastSentTime())
+				lastAcknowledged := util_proto.MustTimestampFromProto(stat.GetLastAcknowledgedTime())
+
+				return []string{
+					types[i],                                  // TYPE
+					table.Number(stat.GetResources()),         // RESOURCES
+					table.Ago(lastSent, now),                  // LAST SENT AGO
+					table.Ago(lastAcknowledged, now),          // LAST ACKNOWLEDGED AGO
+					table.Number(stat.GetResponsesSent()),     // TOTAL UPDATES
+					table.Number(stat.GetResponsesRejected()), // TOTAL ERRORS
+					table.Number(stat.PendingChanges()),       // PENDING CHANGES
+					stat.GetLastAcknowledgedVersion(),         // ACKNOWLEDGED VERSION
+				}
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -0,0 +1,126 @@
+package inspect_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	system_core "github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("kumactl inspect zone", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+
+	BeforeEach(func() {
+		now, _ := time.Parse(time.RFC3339, "2019-07-17T18:08:41+00:00")
+		connected := now.Add(-2 * time.Hour)
+		time.Local = time.UTC
+
+		testClient := &testZoneOverviewClient{
+			overviews: []*system_core.ZoneOverviewResource{
+				{
+					Meta: &test_model.ResourceMeta{
+						Name:             "zone-1",
+						CreationTime:     connected,
+						ModificationTime: now,
+					},
+					Spec: &system_proto.ZoneOverview{
+						Zone: &system_proto.Zone{Enabled: util_proto.Bool(true)},
+						ZoneInsight: &system_proto.ZoneInsight{
+							Subscriptions: []*system_proto.KDSSubscription{
+								{
+									Id:               "1",
+									GlobalInstanceId: "node-001",
+									ConnectTime:      util_proto.MustTimestampProto(connected),
+									Status: &system_proto.KDSSubscriptionStatus{
+										Total: &system_proto.KDSServiceStats{
+											ResponsesSent:         5,
+											ResponsesAcknowledged: 3,
+											ResponsesRejected:     1,
+										},
+										Stat: map[string]*system_proto.KDSServiceStats{
+											"TrafficRoute": {
+												ResponsesSent:           3,
+												ResponsesAcknowledged:   1,
+												ResponsesRejected:       1,
+												LastSentTime:            util_proto.MustTimestampProto(now.Add(-5 * time.Second)),
+												LastAcknowledgedTime:    util_proto.MustTimestampProto(now.Add(-10 * time.Minute)),
+												LastSentVersion:         "v3",
+												LastAcknowledgedVersion: "v1",
+												Resources:               4,
+											},
+											"Mesh": {
+												ResponsesSent:           2,
+												ResponsesAcknowledged:   2,
+												LastSentTime:            util_proto.MustTimestampProto(connected),
+												LastAcknowledgedTime:    util_proto.MustTimestampProto(connected),
+												LastSentVersion:         "v2",
+												LastAcknowledgedVersion: "v2",
+												Resources:               1,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		rootCtx, err := test_kumactl.MakeRootContext(now, nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewZoneOverviewClient = func(util_http.Client) resources.ZoneOverviewClient {
+			return testClient
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	DescribeTable("kumactl inspect zone -o table|yaml",
+		func(outputFormat string, goldenFile string) {
+			// given
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "zone", "zone-1", outputFormat})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(matchers.MatchGoldenEqual("testdata", goldenFile))
+		},
+		Entry("should print sync status per resource type", "-otable", "inspect-zone-sync-status.golden.txt"),
+		Entry("should support YAML output", "-oyaml", "inspect-zone-overview.golden.yaml"),
+	)
+
+	It("should return error when the zone does not exist", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "zone", "zone-2"})
+		rootCmd.SetErr(&bytes.Buffer{})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
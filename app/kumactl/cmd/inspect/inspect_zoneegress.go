@@ -8,9 +8,11 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
 	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
 )
 
 const inspectZoneEgressError = "Policies are not applied on ZoneEgress, please use '--config-dump' flag to get " +
@@ -19,11 +21,13 @@ const inspectZoneEgressError = "Policies are not applied on ZoneEgress, please u
 func newInspectZoneEgressCmd(pctx *cmd.RootContext) *cobra.Command {
 	var configDump bool
 	var inspectionType string
+	var redaction string
 	cmd := &cobra.Command{
-		Use:   "zoneegress NAME",
-		Short: "Inspect ZoneEgress",
-		Long:  "Inspect ZoneEgress.",
-		Args:  cobra.ExactArgs(1),
+		Use:               "zoneegress NAME",
+		Short:             "Inspect ZoneEgress",
+		Long:              "Inspect ZoneEgress.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmd.CompleteResourceNames(pctx, mesh.ZoneEgressResourceTypeDescriptor),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if configDump {
 				inspectionType = InspectionTypeConfigDump
@@ -38,21 +42,28 @@ func newInspectZoneEgressCmd(pctx *cmd.RootContext) *cobra.Command {
 
 			switch inspectionType {
 			case InspectionTypeConfigDump:
-				bytes, err := client.ConfigDump(context.Background(), resourceKey)
+				bytes, err := client.ConfigDump(context.Background(), resourceKey, resources.ConfigDumpOpts{Redaction: redaction})
 				if err != nil {
 					return err
 				}
 				_, err = fmt.Fprint(cmd.OutOrStdout(), string(bytes))
 				return err
 			case InspectionTypeStats:
-				bytes, err := client.Stats(context.Background(), resourceKey)
+				bytes, err := client.Stats(context.Background(), resourceKey, resources.StatsOpts{})
 				if err != nil {
 					return err
 				}
 				_, err = fmt.Fprint(cmd.OutOrStdout(), string(bytes))
 				return err
 			case InspectionTypeClusters:
-				bytes, err := client.Clusters(context.Background(), resourceKey)
+				bytes, err := client.Clusters(context.Background(), resourceKey, "")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprint(cmd.OutOrStdout(), string(bytes))
+				return err
+			case InspectionTypeListeners, InspectionTypeCerts, InspectionTypeRuntime, InspectionTypeServerInfo:
+				bytes, err := client.ProxyInfo(context.Background(), resourceKey, inspectionType)
 				if err != nil {
 					return err
 				}
@@ -67,6 +78,7 @@ func newInspectZoneEgressCmd(pctx *cmd.RootContext) *cobra.Command {
 	}
 	cmd.PersistentFlags().BoolVar(&configDump, "config-dump", false, "if set then the command returns envoy config dump for provided dataplane")
 	_ = cmd.PersistentFlags().MarkDeprecated("config-dump", "use --type=config-dump")
-	cmd.PersistentFlags().StringVar(&inspectionType, "type", InspectionTypeConfigDump, kuma_cmd.UsageOptions("inspection type", InspectionTypeConfigDump, InspectionTypeStats, InspectionTypeClusters))
+	cmd.PersistentFlags().StringVar(&inspectionType, "type", InspectionTypeConfigDump, kuma_cmd.UsageOptions("inspection type", InspectionTypeConfigDump, InspectionTypeStats, InspectionTypeClusters, InspectionTypeListeners, InspectionTypeCerts, InspectionTypeRuntime, InspectionTypeServerInfo))
+	cmd.PersistentFlags().StringVar(&redaction, "redaction", "", kuma_cmd.UsageOptions("redaction policy of the config dump", admin.RedactionPolicyNone, admin.RedactionPolicySecretsOnly, admin.RedactionPolicyFull))
 	return cmd
 }
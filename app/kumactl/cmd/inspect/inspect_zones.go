@@ -52,7 +52,7 @@ func newInspectZonesCmd(ctx *cmd.RootContext) *cobra.Command {
 func printZoneOverviews(now time.Time, zoneOverviews *system.ZoneOverviewResourceList, out io.Writer) error {
 	var unmarshallErr error
 	data := printers.Table{
-		Headers: []string{"NAME", "STATUS", "LAST CONNECTED AGO", "LAST UPDATED AGO", "TOTAL UPDATES", "TOTAL ERRORS", "ZONE-CP VERSION", "BACKEND"},
+		Headers: []string{"NAME", "STATUS", "LAST CONNECTED AGO", "UPTIME", "LAST UPDATED AGO", "TOTAL UPDATES", "TOTAL ERRORS", "PENDING CHANGES", "CLOCK SKEW", "ZONE-CP VERSION", "BACKEND"},
 		NextRow: func() func() []string {
 			i := 0
 			return func() []string {
@@ -71,9 +71,13 @@ func printZoneOverviews(now time.Time, zoneOverviews *system.ZoneOverviewResourc
 				totalResponsesRejected := zoneInsight.Sum(func(s *system_proto.KDSSubscription) uint64 {
 					return s.GetStatus().GetTotal().GetResponsesRejected()
 				})
+				// only the responses sent over the current stream can still be acknowledged
+				pendingChanges := lastSubscription.GetStatus().GetTotal().PendingChanges()
 				onlineStatus := "Offline"
+				uptime := "-"
 				if zoneInsight.IsOnline() && zone.IsEnabled() {
 					onlineStatus = "Online"
+					uptime = table.Duration(lastSubscription.Uptime(now))
 				}
 				lastConnected := util_proto.MustTimestampFromProto(lastSubscription.GetConnectTime())
 				lastUpdated := util_proto.MustTimestampFromProto(lastSubscription.GetStatus().GetLastUpdateTime())
@@ -104,9 +108,12 @@ func printZoneOverviews(now time.Time, zoneOverviews *system.ZoneOverviewResourc
 					meta.GetName(),                       // NAME,
 					onlineStatus,                         // STATUS
 					table.Ago(lastConnected, now),        // LAST CONNECTED AGO
+					uptime,                               // UPTIME
 					table.Ago(lastUpdated, now),          // LAST UPDATED AGO
 					table.Number(totalResponsesSent),     // TOTAL UPDATES
 					table.Number(totalResponsesRejected), // TOTAL ERRORS
+					table.Number(pendingChanges),         // PENDING CHANGES
+					clockSkew(lastSubscription),          // CLOCK SKEW
 					zoneCPVersion,                        // ZONE-CP VERSION
 					backend,                              // BACKEND
 				}
@@ -118,3 +125,10 @@ func printZoneOverviews(now time.Time, zoneOverviews *system.ZoneOverviewResourc
 	}
 	return unmarshallErr
 }
+
+func clockSkew(subscription *system_proto.KDSSubscription) string {
+	if subscription.GetClockSkew() == nil {
+		return "-"
+	}
+	return subscription.GetClockSkew().AsDuration().Round(time.Millisecond).String()
+}
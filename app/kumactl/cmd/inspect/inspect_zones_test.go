@@ -10,12 +10,14 @@ import (
 	. "github.com/onsi/gomega"
 	gomega_types "github.com/onsi/gomega/types"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/app/kumactl/cmd"
 	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
 	system_core "github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
 	"github.com/kumahq/kuma/pkg/test/matchers"
 	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
@@ -37,6 +39,15 @@ func (c *testZoneOverviewClient) List(_ context.Context) (*system_core.ZoneOverv
 	}, nil
 }
 
+func (c *testZoneOverviewClient) Get(_ context.Context, name string) (*system_core.ZoneOverviewResource, error) {
+	for _, overview := range c.overviews {
+		if overview.Meta.GetName() == name {
+			return overview, nil
+		}
+	}
+	return nil, store.ErrorResourceNotFound(system_core.ZoneOverviewType, name, model.NoMesh)
+}
+
 var _ resources.ZoneOverviewClient = &testZoneOverviewClient{}
 
 var _ = Describe("kumactl inspect zones", func() {
@@ -136,10 +147,12 @@ var _ = Describe("kumactl inspect zones", func() {
 								ConnectTime:      util_proto.MustTimestampProto(t2),
 								Status: &system_proto.KDSSubscriptionStatus{
 									Total: &system_proto.KDSServiceStats{
-										ResponsesSent:     20,
-										ResponsesRejected: 2,
+										ResponsesSent:         20,
+										ResponsesAcknowledged: 17,
+										ResponsesRejected:     2,
 									},
 								},
+								ClockSkew: durationpb.New(-150 * time.Millisecond),
 								Version: &system_proto.Version{
 									KumaCp: &system_proto.KumaCpVersion{
 										Version:   "1.0.0",
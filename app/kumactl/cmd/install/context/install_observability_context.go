@@ -16,6 +16,10 @@ type ObservabilityTemplateArgs struct {
 	Components        []string
 	ComponentsMap     map[string]bool
 	Dashboards        []Dashboard
+	// Values are defaults of the components merged with overrides provided via --set
+	Values map[string]interface{}
+	// Overrides of the values in format key1=val1,key2=val2
+	ValueOverrides []string
 }
 
 type InstallObservabilityContext struct {
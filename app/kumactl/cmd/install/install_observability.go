@@ -3,8 +3,10 @@ package install
 import (
 	"strings"
 
+	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"helm.sh/helm/v3/pkg/strvals"
 
 	"github.com/kumahq/kuma/app/kumactl/cmd/install/context"
 	kumactl_data "github.com/kumahq/kuma/app/kumactl/data"
@@ -24,9 +26,17 @@ func newInstallObservability(pctx *kumactl_cmd.RootContext) *cobra.Command {
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			componentsMap := map[string]bool{}
 			for _, component := range args.Components {
+				if !isValidComponent(component) {
+					return errors.Errorf("unknown component %q, available components: %s", component, strings.Join(components, ", "))
+				}
 				componentsMap[component] = true
 			}
 			args.ComponentsMap = componentsMap
+			values, err := getValues(args.ValueOverrides)
+			if err != nil {
+				return err
+			}
+			args.Values = values
 			metrics, err := getMetrics(&args)
 			if err != nil {
 				return err
@@ -58,10 +68,38 @@ func newInstallObservability(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.Flags().StringVar(&args.JaegerAddress, "jaeger-address", args.JaegerAddress, "the address of jaeger to query")
 	cmd.Flags().StringVar(&args.LokiAddress, "loki-address", args.LokiAddress, "the address of the loki to query")
 	cmd.Flags().StringVar(&args.PrometheusAddress, "prometheus-address", args.PrometheusAddress, "the address of the prometheus server")
-	cmd.Flags().StringSliceVar(&args.Components, "components", args.Components, "list of components")
+	cmd.Flags().StringSliceVar(&args.Components, "components", args.Components, "list of components ("+strings.Join(components, ", ")+")")
+	cmd.Flags().StringArrayVar(&args.ValueOverrides, "set", []string{}, "override default values of the components (can specify multiple or separate values with commas: prometheus.image=prom/prometheus:v2.36.0,grafana.image=grafana/grafana:9.0.0)")
 	return cmd
 }
 
+func isValidComponent(component string) bool {
+	for _, c := range components {
+		if c == component {
+			return true
+		}
+	}
+	return false
+}
+
+// getValues returns default values of the components with overrides applied the same way as helm applies --set.
+func getValues(overrides []string) (map[string]interface{}, error) {
+	valuesFile, err := data.ReadFile(kumactl_data.InstallObservabilityFS(), "values.yaml")
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read default values")
+	}
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal(valuesFile.Data, &values); err != nil {
+		return nil, errors.Wrap(err, "Failed to parse default values")
+	}
+	for _, override := range overrides {
+		if err := strvals.ParseInto(override, values); err != nil {
+			return nil, errors.Wrap(err, "failed parsing --set data")
+		}
+	}
+	return values, nil
+}
+
 func getMetrics(args *context.ObservabilityTemplateArgs) ([]data.File, error) {
 	installMetricsFS := kumactl_data.InstallMetricsFS()
 	templateFiles, err := data.ReadFiles(installMetricsFS)
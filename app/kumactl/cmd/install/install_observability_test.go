@@ -94,5 +94,29 @@ var _ = Describe("kumactl install observability", func() {
 			},
 			goldenFile: "install-observability.no-jaeger.golden.yaml",
 		}),
+		Entry("should generate Kubernetes resources with overridden values", testCase{
+			extraArgs: []string{
+				"--components", "prometheus,jaeger",
+				"--set", "prometheus.image=prom/prometheus:v2.36.0,prometheus.retention.time=30d",
+				"--set", "jaeger.image=jaegertracing/all-in-one:1.35.0",
+			},
+			goldenFile: "install-observability.with-set.golden.yaml",
+		}),
 	)
+
+	It("should fail on unknown component", func() {
+		// given
+		rootCtx := kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewAPIServerClient = test.GetMockNewAPIServerClient()
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetArgs([]string{"install", "observability", "--components", "prometheus,zipkin"})
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(stderr)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`unknown component "zipkin", available components: prometheus, grafana, loki, jaeger`))
+	})
 })
@@ -40,6 +40,7 @@ type transparentProxyArgs struct {
 	KumaCpIP                           net.IP
 	SkipDNSConntrackZoneSplit          bool
 	ExperimentalTransparentProxyEngine bool
+	StateFile                          string
 }
 
 var defaultCpIP = net.IPv4(0, 0, 0, 0)
@@ -65,6 +66,7 @@ func newInstallTransparentProxy() *cobra.Command {
 		KumaCpIP:                           defaultCpIP,
 		SkipDNSConntrackZoneSplit:          false,
 		ExperimentalTransparentProxyEngine: false,
+		StateFile:                          transparentproxy.DefaultStateFile,
 	}
 	cmd := &cobra.Command{
 		Use:   "transparent-proxy",
@@ -77,6 +79,7 @@ Follow the following steps to use the Kuma data plane proxy in Transparent Proxy
  2) run this command as a 'root' user to modify the host's iptables and /etc/resolv.conf
     - supply the dedicated username with '--kuma-dp-'
     - all changes are easly revertible by issuing 'kumactl uninstall transparent-proxy'
+    - the applied changes are recorded in the state file (see '--state-file') which is used by the uninstall
     - by default the SSH port tcp/22 will not be redirected to Envoy, but everything else will.
       Use '--exclude-inbound-ports' to provide a comma separated list of ports that should also be excluded
     - this command also creates a backup copy of the modified resolv.conf under /etc/resolv.conf
@@ -150,12 +153,17 @@ runuser -u kuma-dp -- \
 				_, _ = cmd.ErrOrStderr().Write([]byte("# `--redirect-dns-upstream-target-chain` is deprecated, please avoid using it"))
 			}
 
-			if err := modifyIpTables(cmd, &args); err != nil {
+			state, err := loadState(&args)
+			if err != nil {
+				return err
+			}
+
+			if err := modifyIpTables(cmd, &args, state); err != nil {
 				return err
 			}
 
 			if !args.SkipResolvConf {
-				if err := modifyResolvConf(cmd, &args); err != nil {
+				if err := modifyResolvConf(cmd, &args, state); err != nil {
 					return err
 				}
 			}
@@ -184,6 +192,7 @@ runuser -u kuma-dp -- \
 	cmd.Flags().IPVar(&args.KumaCpIP, "kuma-cp-ip", args.KumaCpIP, "the IP address of the Kuma CP which exposes the DNS service on port 53.")
 	cmd.Flags().BoolVar(&args.SkipDNSConntrackZoneSplit, "skip-dns-conntrack-zone-split", args.SkipDNSConntrackZoneSplit, "skip applying conntrack zone splitting iptables rules")
 	cmd.Flags().BoolVar(&args.ExperimentalTransparentProxyEngine, "experimental-transparent-proxy-engine", args.ExperimentalTransparentProxyEngine, "use experimental transparent proxy engine")
+	cmd.Flags().StringVar(&args.StateFile, "state-file", args.StateFile, "the file where the applied changes are recorded for 'kumactl uninstall transparent-proxy'")
 
 	return cmd
 }
@@ -208,8 +217,30 @@ func findUidGid(uid, user string) (string, string, error) {
 	return u.Uid, u.Gid, nil
 }
 
-func modifyIpTables(cmd *cobra.Command, args *transparentProxyArgs) error {
+// loadState returns the state of the previous installation, so the changes it recorded
+// are still reverted by the uninstall when the installation is run again.
+func loadState(args *transparentProxyArgs) (*transparentproxy.State, error) {
+	state, err := transparentproxy.LoadState(args.StateFile)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		state = &transparentproxy.State{}
+	}
+	return state, nil
+}
+
+// saveState records the changes applied so far. Nothing is recorded in dry run.
+func saveState(args *transparentProxyArgs, state *transparentproxy.State) error {
+	if args.DryRun {
+		return nil
+	}
+	return transparentproxy.SaveState(args.StateFile, state)
+}
+
+func modifyIpTables(cmd *cobra.Command, args *transparentProxyArgs, state *transparentproxy.State) error {
 	var tp transparentproxy.TransparentProxy
+	engine := transparentproxy.IstioEngine
 	if !args.ExperimentalTransparentProxyEngine {
 		tp = transparentproxy.DefaultTransparentProxy()
 
@@ -220,6 +251,7 @@ func modifyIpTables(cmd *cobra.Command, args *transparentProxyArgs) error {
 		}
 	} else {
 		tp = &transparentproxy.ExperimentalTransparentProxy{}
+		engine = transparentproxy.ExperimentalEngine
 	}
 
 	uid, gid, err := findUidGid(args.UID, args.User)
@@ -249,6 +281,13 @@ func modifyIpTables(cmd *cobra.Command, args *transparentProxyArgs) error {
 		SkipDNSConntrackZoneSplit: args.SkipDNSConntrackZoneSplit,
 	}
 
+	// the rules are recorded before they are applied, so the uninstall reverts also partially applied rules
+	state.Engine = engine
+	state.IPTables = true
+	if err := saveState(args, state); err != nil {
+		return err
+	}
+
 	output, err := tp.Setup(cfg)
 	if err != nil {
 		return errors.Wrap(err, "failed to setup transparent proxy")
@@ -267,12 +306,16 @@ func modifyIpTables(cmd *cobra.Command, args *transparentProxyArgs) error {
 			StoreRules(output); err != nil {
 			return err
 		}
+		state.Firewalld = true
+		if err := saveState(args, state); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func modifyResolvConf(cmd *cobra.Command, args *transparentProxyArgs) error {
+func modifyResolvConf(cmd *cobra.Command, args *transparentProxyArgs, state *transparentproxy.State) error {
 	kumaCPLine := fmt.Sprintf("nameserver %s", args.KumaCpIP.String())
 	content, err := os.ReadFile("/etc/resolv.conf")
 	if err != nil {
@@ -291,6 +334,10 @@ func modifyResolvConf(cmd *cobra.Command, args *transparentProxyArgs) error {
 		if err != nil {
 			return errors.Wrap(err, "unable to open /etc/resolv.conf.kuma-backup")
 		}
+		state.ResolvConfBackup = "/etc/resolv.conf.kuma-backup"
+		if err := saveState(args, state); err != nil {
+			return err
+		}
 		err = os.WriteFile("/etc/resolv.conf", []byte(newcontent), 0644)
 		if err != nil {
 			return errors.Wrap(err, "unable to write /etc/resolv.conf")
@@ -0,0 +1,50 @@
+package manage
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewManageCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	manageCmd := &cobra.Command{
+		Use:   "manage",
+		Short: "Guide through multi step operations on Kuma resources",
+		Long:  `Guide through multi step operations on Kuma resources.`,
+	}
+	manageCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := kumactl_cmd.RunParentPreRunE(manageCmd, args); err != nil {
+			return err
+		}
+		if err := pctx.CheckServerVersionCompatibility(); err != nil {
+			cmd.PrintErrln(err)
+		}
+		return nil
+	}
+	// sub-commands
+	manageCmd.AddCommand(newManageCaCmd(pctx))
+	manageCmd.AddCommand(newManageTokensCmd(pctx))
+	return manageCmd
+}
+
+func newManageCaCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	caCmd := &cobra.Command{
+		Use:   "ca",
+		Short: "Manage Certificate Authorities of meshes",
+		Long:  `Manage Certificate Authorities of meshes.`,
+	}
+	// sub-commands
+	caCmd.AddCommand(newManageCaRotateCmd(pctx))
+	return caCmd
+}
+
+func newManageTokensCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	tokensCmd := &cobra.Command{
+		Use:   "tokens",
+		Short: "Manage tokens of dataplanes",
+		Long:  `Manage tokens of dataplanes.`,
+	}
+	// sub-commands
+	tokensCmd.AddCommand(newManageTokensRevokeCmd(pctx))
+	return tokensCmd
+}
@@ -0,0 +1,157 @@
+package manage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type rotateArgs struct {
+	backendFile string
+	force       bool
+}
+
+func newManageCaRotateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := rotateArgs{}
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Rotate the Certificate Authority of the mesh",
+		Long: `Rotate the Certificate Authority of the mesh without downtime.
+
+Every execution of the command advances the rotation by one step:
+1. The backend from --backend-file is added to the mesh. Dataplanes trust CAs of both the enabled and the new backend.
+2. When all dataplanes trust the new CA, the new backend is enabled. Dataplanes receive certificates issued by it.
+3. When all dataplanes have certificates issued by the new backend, the previous backend is revoked and removed from the mesh.
+
+Progress of the rotation is tracked in the MeshInsight of the mesh. A step is not executed until all dataplanes are ready for it, unless --force is used.`,
+		Example: `
+# Start the rotation to the backend defined in the file
+$ kumactl manage ca rotate --mesh default --backend-file ca-2.yaml
+
+# Switch to the new backend and then revoke the previous one
+$ kumactl manage ca rotate --mesh default
+$ kumactl manage ca rotate --mesh default
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			meshName := pctx.CurrentMesh()
+			mesh := core_mesh.NewMeshResource()
+			if err := rs.Get(context.Background(), mesh, core_store.GetByKey(meshName, core_model.NoMesh)); err != nil {
+				return errors.Wrapf(err, "failed to get Mesh %q", meshName)
+			}
+			if !mesh.MTLSEnabled() {
+				return errors.Errorf("mTLS is not enabled in Mesh %q", meshName)
+			}
+
+			rotation := mesh.Spec.GetMtls().GetRotation()
+			if rotation == nil {
+				return startRotation(context.Background(), cmd.OutOrStdout(), rs, mesh, args.backendFile)
+			}
+			if args.backendFile != "" {
+				return errors.Errorf("rotation of the CA of Mesh %q from %q to %q is already in progress", meshName, rotation.GetFrom(), rotation.GetTo())
+			}
+
+			insight := core_mesh.NewMeshInsightResource()
+			if err := rs.Get(context.Background(), insight, core_store.GetByKey(meshName, core_model.NoMesh)); err != nil && !core_store.IsResourceNotFound(err) {
+				return errors.Wrapf(err, "failed to get MeshInsight %q", meshName)
+			}
+			progress := insight.Spec.GetMTLS().GetCaRotation()
+			if progress.GetFrom() != rotation.GetFrom() || progress.GetTo() != rotation.GetTo() {
+				progress = &mesh_proto.MeshInsight_MTLS_CaRotation{} // insight was not yet updated with the rotation
+			}
+
+			if mesh.Spec.GetMtls().GetEnabledBackend() == rotation.GetFrom() {
+				return switchBackend(context.Background(), cmd.OutOrStdout(), rs, mesh, progress, args.force)
+			}
+			return revokeBackend(context.Background(), cmd.OutOrStdout(), rs, mesh, progress, args.force)
+		},
+	}
+	cmd.Flags().StringVar(&args.backendFile, "backend-file", "", "path to the file with the definition of the Certificate Authority backend the mesh rotates to. Required to start the rotation")
+	cmd.Flags().BoolVar(&args.force, "force", false, "advance the rotation even if not all dataplanes are ready for the next step")
+	cmd.Flags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	return cmd
+}
+
+func startRotation(ctx context.Context, out io.Writer, rs core_store.ResourceStore, mesh *core_mesh.MeshResource, backendFile string) error {
+	if backendFile == "" {
+		return errors.New("--backend-file is required to start the rotation")
+	}
+	bytes, err := os.ReadFile(backendFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to read the file with the backend")
+	}
+	backend := &mesh_proto.CertificateAuthorityBackend{}
+	if err := util_proto.FromYAML(bytes, backend); err != nil {
+		return errors.Wrap(err, "failed to parse the backend")
+	}
+	if backend.GetName() == "" {
+		return errors.New("name of the backend has to be defined")
+	}
+	if mesh.GetCertificateAuthorityBackend(backend.GetName()) != nil {
+		return errors.Errorf("backend %q already exists in Mesh %q", backend.GetName(), mesh.GetMeta().GetName())
+	}
+
+	mtls := mesh.Spec.GetMtls()
+	mtls.Backends = append(mtls.Backends, backend)
+	mtls.Rotation = &mesh_proto.Mesh_Mtls_Rotation{
+		From: mtls.GetEnabledBackend(),
+		To:   backend.GetName(),
+	}
+	if err := rs.Update(ctx, mesh); err != nil {
+		return errors.Wrapf(err, "failed to start the rotation of the CA of Mesh %q", mesh.GetMeta().GetName())
+	}
+	_, err = fmt.Fprintf(out, `Rotation of the CA of Mesh %q from %q to %q started. Dataplanes trust CAs of both backends.
+Run the command again when all dataplanes trust the new CA to enable backend %q.
+`, mesh.GetMeta().GetName(), mtls.Rotation.From, mtls.Rotation.To, mtls.Rotation.To)
+	return err
+}
+
+func switchBackend(ctx context.Context, out io.Writer, rs core_store.ResourceStore, mesh *core_mesh.MeshResource, progress *mesh_proto.MeshInsight_MTLS_CaRotation, force bool) error {
+	mtls := mesh.Spec.GetMtls()
+	if (progress.GetTotal() == 0 || progress.GetTrusted() < progress.GetTotal()) && !force {
+		return errors.Errorf("%d of %d dataplanes trust the CA of backend %q. Wait until all dataplanes trust it or use --force", progress.GetTrusted(), progress.GetTotal(), mtls.Rotation.To)
+	}
+	mtls.EnabledBackend = mtls.Rotation.To
+	if err := rs.Update(ctx, mesh); err != nil {
+		return errors.Wrapf(err, "failed to enable backend %q in Mesh %q", mtls.Rotation.To, mesh.GetMeta().GetName())
+	}
+	_, err := fmt.Fprintf(out, `Backend %q enabled in Mesh %q. Dataplanes receive certificates issued by it.
+Run the command again when all dataplanes have the new certificates to revoke backend %q.
+`, mtls.Rotation.To, mesh.GetMeta().GetName(), mtls.Rotation.From)
+	return err
+}
+
+func revokeBackend(ctx context.Context, out io.Writer, rs core_store.ResourceStore, mesh *core_mesh.MeshResource, progress *mesh_proto.MeshInsight_MTLS_CaRotation, force bool) error {
+	mtls := mesh.Spec.GetMtls()
+	if (progress.GetTotal() == 0 || progress.GetReissued() < progress.GetTotal()) && !force {
+		return errors.Errorf("%d of %d dataplanes have certificates issued by backend %q. Wait until all dataplanes have them or use --force", progress.GetReissued(), progress.GetTotal(), mtls.Rotation.To)
+	}
+	revoked := mtls.Rotation.From
+	var backends []*mesh_proto.CertificateAuthorityBackend
+	for _, backend := range mtls.GetBackends() {
+		if backend.GetName() != revoked {
+			backends = append(backends, backend)
+		}
+	}
+	mtls.Backends = backends
+	mtls.Rotation = nil
+	if err := rs.Update(ctx, mesh); err != nil {
+		return errors.Wrapf(err, "failed to revoke backend %q in Mesh %q", revoked, mesh.GetMeta().GetName())
+	}
+	_, err := fmt.Fprintf(out, "Backend %q revoked in Mesh %q. Rotation of the CA finished.\n", revoked, mesh.GetMeta().GetName())
+	return err
+}
@@ -0,0 +1,167 @@
+package manage_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+)
+
+var _ = Describe("kumactl manage ca rotate", func() {
+
+	var store core_store.ResourceStore
+	var buf *bytes.Buffer
+	var execute func(args ...string) error
+
+	mesh := func() *core_mesh.MeshResource {
+		mesh := core_mesh.NewMeshResource()
+		Expect(store.Get(context.Background(), mesh, core_store.GetByKey("default", core_model.NoMesh))).To(Succeed())
+		return mesh
+	}
+
+	setProgress := func(progress *mesh_proto.MeshInsight_MTLS_CaRotation) {
+		insight := core_mesh.NewMeshInsightResource()
+		err := store.Get(context.Background(), insight, core_store.GetByKey("default", core_model.NoMesh))
+		if core_store.IsResourceNotFound(err) {
+			insight.Spec.MTLS = &mesh_proto.MeshInsight_MTLS{CaRotation: progress}
+			Expect(store.Create(context.Background(), insight, core_store.CreateByKey("default", core_model.NoMesh))).To(Succeed())
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		insight.Spec.MTLS = &mesh_proto.MeshInsight_MTLS{CaRotation: progress}
+		Expect(store.Update(context.Background(), insight)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		store = memory_resources.NewStore()
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), store)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = store.Create(context.Background(), &core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					EnabledBackend: "ca-1",
+					Backends: []*mesh_proto.CertificateAuthorityBackend{
+						{
+							Name: "ca-1",
+							Type: "builtin",
+						},
+					},
+				},
+			},
+		}, core_store.CreateByKey("default", core_model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		buf = &bytes.Buffer{}
+		execute = func(args ...string) error {
+			buf.Reset()
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"manage", "ca", "rotate",
+			}, args...))
+			return rootCmd.Execute()
+		}
+	})
+
+	It("should guide through the rotation", func() {
+		// when the rotation is started
+		err := execute("--backend-file", filepath.Join("testdata", "ca-2.yaml"))
+
+		// then the new backend is added and trusted
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`Rotation of the CA of Mesh "default" from "ca-1" to "ca-2" started. Dataplanes trust CAs of both backends.
+Run the command again when all dataplanes trust the new CA to enable backend "ca-2".
+`))
+		mtls := mesh().Spec.Mtls
+		Expect(mtls.EnabledBackend).To(Equal("ca-1"))
+		Expect(mtls.Backends).To(HaveLen(2))
+		Expect(mtls.Backends[1].Name).To(Equal("ca-2"))
+		Expect(mtls.Backends[1].DpCert.Rotation.Expiration).To(Equal("1d"))
+		Expect(mtls.Rotation.From).To(Equal("ca-1"))
+		Expect(mtls.Rotation.To).To(Equal("ca-2"))
+
+		// when not all dataplanes trust the new CA
+		setProgress(&mesh_proto.MeshInsight_MTLS_CaRotation{From: "ca-1", To: "ca-2", Total: 2, Trusted: 1})
+		err = execute()
+
+		// then
+		Expect(err).To(MatchError(`1 of 2 dataplanes trust the CA of backend "ca-2". Wait until all dataplanes trust it or use --force`))
+		Expect(mesh().Spec.Mtls.EnabledBackend).To(Equal("ca-1"))
+
+		// when all dataplanes trust the new CA
+		setProgress(&mesh_proto.MeshInsight_MTLS_CaRotation{From: "ca-1", To: "ca-2", Total: 2, Trusted: 2})
+		err = execute()
+
+		// then the new backend is enabled
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`Backend "ca-2" enabled in Mesh "default". Dataplanes receive certificates issued by it.
+Run the command again when all dataplanes have the new certificates to revoke backend "ca-1".
+`))
+		Expect(mesh().Spec.Mtls.EnabledBackend).To(Equal("ca-2"))
+		Expect(mesh().Spec.Mtls.Rotation).ToNot(BeNil())
+
+		// when not all dataplanes have new certificates
+		setProgress(&mesh_proto.MeshInsight_MTLS_CaRotation{From: "ca-1", To: "ca-2", Phase: mesh_proto.MeshInsight_MTLS_CaRotation_REISSUING, Total: 2, Trusted: 2, Reissued: 1})
+		err = execute()
+
+		// then
+		Expect(err).To(MatchError(`1 of 2 dataplanes have certificates issued by backend "ca-2". Wait until all dataplanes have them or use --force`))
+
+		// when all dataplanes have new certificates
+		setProgress(&mesh_proto.MeshInsight_MTLS_CaRotation{From: "ca-1", To: "ca-2", Phase: mesh_proto.MeshInsight_MTLS_CaRotation_REISSUING, Total: 2, Trusted: 2, Reissued: 2})
+		err = execute()
+
+		// then the previous backend is revoked
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("Backend \"ca-1\" revoked in Mesh \"default\". Rotation of the CA finished.\n"))
+		mtls = mesh().Spec.Mtls
+		Expect(mtls.EnabledBackend).To(Equal("ca-2"))
+		Expect(mtls.Backends).To(HaveLen(1))
+		Expect(mtls.Backends[0].Name).To(Equal("ca-2"))
+		Expect(mtls.Rotation).To(BeNil())
+	})
+
+	It("should advance the rotation when forced", func() {
+		// given
+		Expect(execute("--backend-file", filepath.Join("testdata", "ca-2.yaml"))).To(Succeed())
+
+		// when
+		err := execute("--force")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mesh().Spec.Mtls.EnabledBackend).To(Equal("ca-2"))
+	})
+
+	It("should require the backend to start the rotation", func() {
+		// when
+		err := execute()
+
+		// then
+		Expect(err).To(MatchError("--backend-file is required to start the rotation"))
+	})
+
+	It("should not start the rotation twice", func() {
+		// given
+		Expect(execute("--backend-file", filepath.Join("testdata", "ca-2.yaml"))).To(Succeed())
+
+		// when
+		err := execute("--backend-file", filepath.Join("testdata", "ca-2.yaml"))
+
+		// then
+		Expect(err).To(MatchError(`rotation of the CA of Mesh "default" from "ca-1" to "ca-2" is already in progress`))
+	})
+})
@@ -0,0 +1,11 @@
+package manage_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestManageCmd(t *testing.T) {
+	test.RunSpecs(t, "Manage Cmd Suite")
+}
@@ -0,0 +1,115 @@
+package manage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+type revokeTokenArgs struct {
+	tokenFile string
+}
+
+func newManageTokensRevokeCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := revokeTokenArgs{}
+	cmd := &cobra.Command{
+		Use:   "revoke [ID]",
+		Short: "Revoke a dataplane token",
+		Long: `Revoke a dataplane token without rotating the signing key of the mesh.
+
+The token is identified by its ID (the "jti" claim) or read from --token-file.
+IDs of revoked tokens are stored in the "dataplane-token-revocations-<mesh>" Secret, which is synced to all instances of the control plane.
+Dataplanes that use the revoked token are rejected when they connect to the control plane.`,
+		Example: `
+# Revoke the token by its ID
+$ kumactl manage tokens revoke --mesh default 0e120ec9-6b42-495d-9758-07b59fe86fb9
+
+# Revoke the token stored in the file
+$ kumactl manage tokens revoke --mesh default --token-file /tmp/token
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, cmdArgs []string) error {
+			if (len(cmdArgs) == 0) == (args.tokenFile == "") {
+				return errors.New("either ID of the token or --token-file has to be defined")
+			}
+			meshName := pctx.CurrentMesh()
+			var id string
+			if len(cmdArgs) == 1 {
+				id = cmdArgs[0]
+			} else {
+				var err error
+				if id, err = dataplaneTokenID(args.tokenFile, meshName); err != nil {
+					return err
+				}
+			}
+
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			key := issuer.DataplaneTokenRevocationsSecretKey(meshName)
+			secret := system.NewSecretResource()
+			exists := true
+			if err := rs.Get(context.Background(), secret, core_store.GetBy(key)); err != nil {
+				if !core_store.IsResourceNotFound(err) {
+					return errors.Wrapf(err, "failed to get revocations of tokens in Mesh %q", meshName)
+				}
+				exists = false
+			}
+			data, revoked := core_tokens.WithRevokedID(secret.Spec.GetData().GetValue(), id)
+			if !revoked {
+				_, err := fmt.Fprintf(cmd.OutOrStdout(), "Nothing to revoke, the token %q is already revoked in Mesh %q\n", id, meshName)
+				return err
+			}
+			secret.Spec = &system_proto.Secret{
+				Data: &wrapperspb.BytesValue{Value: data},
+			}
+			if exists {
+				err = rs.Update(context.Background(), secret)
+			} else {
+				err = rs.Create(context.Background(), secret, core_store.CreateBy(key))
+			}
+			if err != nil {
+				return errors.Wrapf(err, "failed to revoke the token in Mesh %q", meshName)
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Revoked the token %q in Mesh %q\n", id, meshName)
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&args.tokenFile, "token-file", "", "path to the file with the dataplane token to revoke")
+	cmd.Flags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	return cmd
+}
+
+// dataplaneTokenID returns ID of the dataplane token from the file.
+// The signature is not verified, the token is only revoked in the mesh it was issued for.
+func dataplaneTokenID(tokenFile string, mesh string) (string, error) {
+	token, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read the token")
+	}
+	claims := &issuer.DataplaneClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(strings.TrimSpace(string(token)), claims); err != nil {
+		return "", errors.Wrap(err, "could not parse the token")
+	}
+	if claims.Mesh != "" && claims.Mesh != mesh {
+		return "", errors.Errorf("the token is issued for Mesh %q", claims.Mesh)
+	}
+	if claims.ID() == "" {
+		return "", errors.New("the token has no ID and cannot be revoked, rotate the signing key instead")
+	}
+	return claims.ID(), nil
+}
@@ -0,0 +1,130 @@
+package manage_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	core_tokens "github.com/kumahq/kuma/pkg/core/tokens"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("kumactl manage tokens revoke", func() {
+
+	var store core_store.ResourceStore
+	var buf *bytes.Buffer
+	var execute func(args ...string) error
+
+	isRevoked := func(id string) bool {
+		revocations := core_tokens.NewRevocations(manager.NewResourceManager(store), issuer.DataplaneTokenRevocationsSecretKey("default"))
+		revoked, err := revocations.IsRevoked(context.Background(), id)
+		Expect(err).ToNot(HaveOccurred())
+		return revoked
+	}
+
+	tokenFile := func(claims *issuer.DataplaneClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("key"))
+		Expect(err).ToNot(HaveOccurred())
+		file := filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(file, []byte(token), 0600)).To(Succeed())
+		return file
+	}
+
+	BeforeEach(func() {
+		store = memory_resources.NewStore()
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), store)
+		Expect(err).ToNot(HaveOccurred())
+
+		buf = &bytes.Buffer{}
+		execute = func(args ...string) error {
+			buf.Reset()
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"manage", "tokens", "revoke",
+			}, args...))
+			return rootCmd.Execute()
+		}
+	})
+
+	It("should revoke tokens by ID", func() {
+		// when
+		Expect(execute("token-1")).To(Succeed())
+
+		// then
+		Expect(buf.String()).To(Equal("Revoked the token \"token-1\" in Mesh \"default\"\n"))
+		Expect(isRevoked("token-1")).To(BeTrue())
+
+		// when
+		Expect(execute("token-2")).To(Succeed())
+
+		// then
+		Expect(isRevoked("token-1")).To(BeTrue())
+		Expect(isRevoked("token-2")).To(BeTrue())
+		Expect(isRevoked("token-3")).To(BeFalse())
+	})
+
+	It("should revoke token from the file", func() {
+		// given
+		file := tokenFile(&issuer.DataplaneClaims{
+			Mesh: "default",
+			Tags: map[string][]string{"kuma.io/service": {"web"}},
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID: "token-1",
+			},
+		})
+
+		// when
+		Expect(execute("--token-file", file)).To(Succeed())
+
+		// then
+		Expect(isRevoked("token-1")).To(BeTrue())
+	})
+
+	It("should not duplicate revocations", func() {
+		// given
+		Expect(execute("token-1")).To(Succeed())
+
+		// when
+		Expect(execute("token-1")).To(Succeed())
+
+		// then
+		Expect(buf.String()).To(Equal("Nothing to revoke, the token \"token-1\" is already revoked in Mesh \"default\"\n"))
+	})
+
+	It("should reject token of another mesh", func() {
+		// given
+		file := tokenFile(&issuer.DataplaneClaims{
+			Mesh: "other",
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID: "token-1",
+			},
+		})
+
+		// when
+		err := execute("--token-file", file)
+
+		// then
+		Expect(err).To(MatchError(`the token is issued for Mesh "other"`))
+	})
+
+	It("should require either ID or the token file", func() {
+		// when
+		err := execute()
+
+		// then
+		Expect(err).To(MatchError("either ID of the token or --token-file has to be defined"))
+	})
+})
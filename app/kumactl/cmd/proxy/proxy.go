@@ -0,0 +1,27 @@
+package proxy
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewProxyCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	proxyCmd := &cobra.Command{
+		Use:   "proxy",
+		Short: "Forward local ports to Kuma proxies",
+		Long:  `Forward local ports to Kuma proxies through the control plane.`,
+	}
+	proxyCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := kumactl_cmd.RunParentPreRunE(proxyCmd, args); err != nil {
+			return err
+		}
+		if err := pctx.CheckServerVersionCompatibility(); err != nil {
+			cmd.PrintErrln(err)
+		}
+		return nil
+	}
+	// sub-commands
+	proxyCmd.AddCommand(newProxyDataplaneCmd(pctx))
+	return proxyCmd
+}
@@ -0,0 +1,109 @@
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+func newProxyDataplaneCmd(pctx *cmd.RootContext) *cobra.Command {
+	var address string
+	var port int
+	cmd := &cobra.Command{
+		Use:   "dataplane NAME",
+		Short: "Forward a local port to the Envoy Admin API of Dataplane",
+		Long: `Forward a local port to the Envoy Admin API of Dataplane.
+Requests are executed by the control plane with its own identity, the same way as "kumactl inspect dataplane",
+so the Admin API does not have to be reachable from the machine on which kumactl is running.
+Only GET and POST requests are forwarded. The command runs until it is interrupted.`,
+		Example: `
+# Open the Envoy Admin API of the backend-01 Dataplane on http://127.0.0.1:19000
+$ kumactl proxy dataplane backend-01 --mesh default --port 19000
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := pctx.CurrentInspectEnvoyProxyClient(mesh.DataplaneResourceTypeDescriptor)
+			if err != nil {
+				return errors.Wrap(err, "failed to create a dataplane inspect client")
+			}
+
+			resourceKey := core_model.ResourceKey{Name: args[0], Mesh: pctx.CurrentMesh()}
+			listener, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(port)))
+			if err != nil {
+				return errors.Wrap(err, "could not open the local port")
+			}
+			defer listener.Close()
+
+			server := &http.Server{
+				Handler:           newAdminProxyHandler(client, resourceKey),
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+			go func() {
+				<-cmd.Context().Done()
+				_ = server.Close()
+			}()
+
+			if _, err := fmt.Fprintf(cmd.OutOrStdout(), "Forwarding from http://%s to the Envoy Admin API of Dataplane %q in mesh %q\n", listener.Addr(), resourceKey.Name, resourceKey.Mesh); err != nil {
+				return err
+			}
+			if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+	cmd.PersistentFlags().StringVar(&address, "address", "127.0.0.1", "local address on which the port is opened")
+	cmd.PersistentFlags().IntVar(&port, "port", 19000, "local port forwarded to the Envoy Admin API. 0 means that a random port is used")
+	cmd.PersistentFlags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	return cmd
+}
+
+// newAdminProxyHandler returns the handler that forwards every request to the Envoy Admin API of the proxy and streams back the response.
+func newAdminProxyHandler(client resources.InspectEnvoyProxyClient, resourceKey core_model.ResourceKey) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet && request.Method != http.MethodPost {
+			http.Error(writer, fmt.Sprintf("method %s is not supported, only GET and POST requests are forwarded", request.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		response, err := client.Forward(request.Context(), resourceKey, request.Method, request.URL.Path, request.URL.Query(), request.Body)
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer response.Body.Close()
+
+		for name, values := range response.Header {
+			for _, value := range values {
+				writer.Header().Add(name, value)
+			}
+		}
+		writer.WriteHeader(response.StatusCode)
+		flusher, _ := writer.(http.Flusher)
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := response.Body.Read(buf)
+			if n > 0 {
+				if _, err := writer.Write(buf[:n]); err != nil {
+					return
+				}
+				if flusher != nil {
+					flusher.Flush()
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	})
+}
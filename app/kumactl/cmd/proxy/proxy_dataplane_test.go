@@ -0,0 +1,174 @@
+package proxy_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type forwardedRequest struct {
+	rk     core_model.ResourceKey
+	method string
+	path   string
+	query  url.Values
+	body   string
+}
+
+type testEnvoyProxyClient struct {
+	resources.InspectEnvoyProxyClient
+	sync.Mutex
+	requests []forwardedRequest
+}
+
+func (t *testEnvoyProxyClient) Forward(_ context.Context, rk core_model.ResourceKey, method, path string, query url.Values, body io.Reader) (*http.Response, error) {
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	t.Lock()
+	defer t.Unlock()
+	t.requests = append(t.requests, forwardedRequest{rk: rk, method: method, path: path, query: query, body: string(b)})
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/plain"}},
+		Body:       io.NopCloser(strings.NewReader("server.live: 1\n")),
+	}, nil
+}
+
+// syncBuffer is written by the command running in the background while the test reads it.
+type syncBuffer struct {
+	sync.Mutex
+	buf bytes.Buffer
+}
+
+func (s *syncBuffer) Write(p []byte) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+	return s.buf.Write(p)
+}
+
+func (s *syncBuffer) String() string {
+	s.Lock()
+	defer s.Unlock()
+	return s.buf.String()
+}
+
+var _ = Describe("kumactl proxy dataplane", func() {
+
+	var client *testEnvoyProxyClient
+	var buf *syncBuffer
+	var cancel context.CancelFunc
+	var done chan error
+
+	BeforeEach(func() {
+		client = &testEnvoyProxyClient{}
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewInspectEnvoyProxyClient = func(core_model.ResourceTypeDescriptor, util_http.Client) resources.InspectEnvoyProxyClient {
+			return client
+		}
+
+		buf = &syncBuffer{}
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan error, 1)
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetOut(buf)
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"proxy", "dataplane", "backend-1", "--mesh", "demo", "--port", "0",
+		})
+		go func() {
+			done <- rootCmd.ExecuteContext(ctx)
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	localAddress := func() string {
+		re := regexp.MustCompile(`Forwarding from (http://[^ ]+) `)
+		var address string
+		Eventually(func() bool {
+			matches := re.FindStringSubmatch(buf.String())
+			if matches == nil {
+				return false
+			}
+			address = matches[1]
+			return true
+		}, "5s", "10ms").Should(BeTrue())
+		return address
+	}
+
+	It("should forward requests to the admin API of dataplane", func() {
+		// given
+		address := localAddress()
+
+		// when
+		resp, err := http.Post(address+"/runtime_modify?key=value", "text/plain", strings.NewReader("body"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("text/plain"))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal("server.live: 1\n"))
+
+		client.Lock()
+		defer client.Unlock()
+		Expect(client.requests).To(Equal([]forwardedRequest{{
+			rk:     core_model.ResourceKey{Mesh: "demo", Name: "backend-1"},
+			method: http.MethodPost,
+			path:   "/runtime_modify",
+			query:  url.Values{"key": []string{"value"}},
+			body:   "body",
+		}}))
+		Expect(buf.String()).To(ContainSubstring(`to the Envoy Admin API of Dataplane "backend-1" in mesh "demo"`))
+	})
+
+	It("should reject methods other than GET and POST", func() {
+		// given
+		address := localAddress()
+		req, err := http.NewRequest(http.MethodDelete, address+"/stats", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := http.DefaultClient.Do(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusMethodNotAllowed))
+		Expect(client.requests).To(BeEmpty())
+	})
+
+	It("should stop when the context is cancelled", func() {
+		// given
+		localAddress()
+
+		// when
+		cancel()
+
+		// then
+		Eventually(done, "5s").Should(Receive(BeNil()))
+	})
+})
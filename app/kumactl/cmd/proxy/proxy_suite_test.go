@@ -0,0 +1,11 @@
+package proxy_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestProxyCmd(t *testing.T) {
+	test.RunSpecs(t, "Proxy Cmd Suite")
+}
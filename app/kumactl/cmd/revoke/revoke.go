@@ -0,0 +1,27 @@
+package revoke
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewRevokeCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	revokeCmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke certificates issued by Kuma",
+		Long:  `Revoke certificates issued by Kuma.`,
+	}
+	revokeCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := kumactl_cmd.RunParentPreRunE(revokeCmd, args); err != nil {
+			return err
+		}
+		if err := pctx.CheckServerVersionCompatibility(); err != nil {
+			cmd.PrintErrln(err)
+		}
+		return nil
+	}
+	// sub-commands
+	revokeCmd.AddCommand(newRevokeDataplaneCertCmd(pctx))
+	return revokeCmd
+}
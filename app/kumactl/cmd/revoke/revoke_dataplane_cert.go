@@ -0,0 +1,168 @@
+package revoke
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	xds_tls "github.com/kumahq/kuma/pkg/xds/envoy/tls"
+)
+
+type revokeDataplaneCertArgs struct {
+	serialNumber string
+	spiffeID     string
+}
+
+func newRevokeDataplaneCertCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := revokeDataplaneCertArgs{}
+	cmd := &cobra.Command{
+		Use:   "dataplane-cert",
+		Short: "Revoke a certificate or an identity of dataplanes",
+		Long: `Revoke a certificate or an identity of dataplanes without rotating the Certificate Authority of the mesh.
+
+The certificate with --serial-number is rejected by other dataplanes of the mesh.
+The identity with --spiffe-id is no longer issued certificates and current certificates of dataplanes with this identity are revoked.
+
+Revocations are stored in the mesh and enforced until revoked certificates expire.`,
+		Example: `
+# Revoke the certificate with the serial number
+$ kumactl revoke dataplane-cert --mesh default --serial-number 6b2d0a1f3c
+
+# Revoke the identity of the service
+$ kumactl revoke dataplane-cert --mesh default --spiffe-id spiffe://default/backend
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if (args.serialNumber == "") == (args.spiffeID == "") {
+				return errors.New("either --serial-number or --spiffe-id has to be defined")
+			}
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			meshName := pctx.CurrentMesh()
+			mesh := core_mesh.NewMeshResource()
+			if err := rs.Get(context.Background(), mesh, core_store.GetByKey(meshName, core_model.NoMesh)); err != nil {
+				return errors.Wrapf(err, "failed to get Mesh %q", meshName)
+			}
+			if !mesh.MTLSEnabled() {
+				return errors.Errorf("mTLS is not enabled in Mesh %q", meshName)
+			}
+
+			certs, err := listDataplaneCerts(context.Background(), rs, meshName)
+			if err != nil {
+				return err
+			}
+
+			var revocations []*mesh_proto.Mesh_Mtls_Revocation
+			if args.serialNumber != "" {
+				serialNumber, err := core_mesh.ParseSerialNumber(args.serialNumber)
+				if err != nil {
+					return err
+				}
+				revocation := &mesh_proto.Mesh_Mtls_Revocation{
+					SerialNumber: core_mesh.FormatSerialNumber(serialNumber),
+				}
+				for _, cert := range certs {
+					if cert.serialNumber == revocation.SerialNumber {
+						revocation.Expiration = cert.expiration
+					}
+				}
+				revocations = append(revocations, revocation)
+			} else {
+				service := strings.TrimPrefix(args.spiffeID, xds_tls.MeshSpiffeIDPrefix(meshName))
+				if service == args.spiffeID || service == "" {
+					return errors.Errorf("SPIFFE ID has to be in %s<service> format", xds_tls.MeshSpiffeIDPrefix(meshName))
+				}
+				revocations = append(revocations, &mesh_proto.Mesh_Mtls_Revocation{
+					SpiffeId: args.spiffeID,
+				})
+				for _, cert := range certs {
+					if cert.services[service] {
+						revocations = append(revocations, &mesh_proto.Mesh_Mtls_Revocation{
+							SerialNumber: cert.serialNumber,
+							Expiration:   cert.expiration,
+						})
+					}
+				}
+			}
+
+			revoked := 0
+			mtls := mesh.Spec.GetMtls()
+			for _, revocation := range revocations {
+				if !isRevoked(mtls, revocation) {
+					mtls.Revocations = append(mtls.Revocations, revocation)
+					revoked++
+				}
+			}
+			if revoked == 0 {
+				_, err := fmt.Fprintf(cmd.OutOrStdout(), "Nothing to revoke, it is already revoked in Mesh %q\n", meshName)
+				return err
+			}
+			if err := rs.Update(context.Background(), mesh); err != nil {
+				return errors.Wrapf(err, "failed to revoke certificates in Mesh %q", meshName)
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Added %d revocation(s) to Mesh %q\n", revoked, meshName)
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&args.serialNumber, "serial-number", "", "serial number of the certificate to revoke in hex format")
+	cmd.Flags().StringVar(&args.spiffeID, "spiffe-id", "", "SPIFFE ID of the identity to revoke")
+	cmd.Flags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	return cmd
+}
+
+type dataplaneCert struct {
+	serialNumber string
+	expiration   *timestamppb.Timestamp
+	services     map[string]bool
+}
+
+// listDataplaneCerts returns current certificates of dataplanes in the mesh reported in their insights.
+func listDataplaneCerts(ctx context.Context, rs core_store.ResourceStore, mesh string) ([]dataplaneCert, error) {
+	dataplanes := &core_mesh.DataplaneResourceList{}
+	if err := rs.List(ctx, dataplanes, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "failed to list Dataplanes")
+	}
+	insights := &core_mesh.DataplaneInsightResourceList{}
+	if err := rs.List(ctx, insights, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "failed to list DataplaneInsights")
+	}
+	services := map[string]map[string]bool{}
+	for _, dataplane := range dataplanes.Items {
+		services[dataplane.GetMeta().GetName()] = map[string]bool{}
+		for _, service := range dataplane.Spec.TagSet().Values(mesh_proto.ServiceTag) {
+			services[dataplane.GetMeta().GetName()][service] = true
+		}
+	}
+	var certs []dataplaneCert
+	for _, insight := range insights.Items {
+		mtls := insight.Spec.GetMTLS()
+		if mtls.GetCertificateSerialNumber() == "" {
+			continue
+		}
+		certs = append(certs, dataplaneCert{
+			serialNumber: mtls.GetCertificateSerialNumber(),
+			expiration:   mtls.GetCertificateExpirationTime(),
+			services:     services[insight.GetMeta().GetName()],
+		})
+	}
+	return certs, nil
+}
+
+func isRevoked(mtls *mesh_proto.Mesh_Mtls, revocation *mesh_proto.Mesh_Mtls_Revocation) bool {
+	for _, existing := range mtls.GetRevocations() {
+		if existing.GetSerialNumber() == revocation.GetSerialNumber() && existing.GetSpiffeId() == revocation.GetSpiffeId() {
+			return true
+		}
+	}
+	return false
+}
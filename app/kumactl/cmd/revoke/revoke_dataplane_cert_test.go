@@ -0,0 +1,150 @@
+package revoke_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("kumactl revoke dataplane-cert", func() {
+
+	var store core_store.ResourceStore
+	var buf *bytes.Buffer
+	var execute func(args ...string) error
+	expiration := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	revocations := func() []*mesh_proto.Mesh_Mtls_Revocation {
+		mesh := core_mesh.NewMeshResource()
+		Expect(store.Get(context.Background(), mesh, core_store.GetByKey("default", core_model.NoMesh))).To(Succeed())
+		return mesh.Spec.GetMtls().GetRevocations()
+	}
+
+	createDataplane := func(name string, service string, serialNumber string) {
+		err := store.Create(context.Background(), &core_mesh.DataplaneResource{
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+						Port: 8080,
+						Tags: map[string]string{mesh_proto.ServiceTag: service},
+					}},
+				},
+			},
+		}, core_store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+		err = store.Create(context.Background(), &core_mesh.DataplaneInsightResource{
+			Spec: &mesh_proto.DataplaneInsight{
+				MTLS: &mesh_proto.DataplaneInsight_MTLS{
+					CertificateSerialNumber:   serialNumber,
+					CertificateExpirationTime: util_proto.MustTimestampProto(expiration),
+				},
+			},
+		}, core_store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		store = memory_resources.NewStore()
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), store)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = store.Create(context.Background(), &core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					EnabledBackend: "ca-1",
+					Backends: []*mesh_proto.CertificateAuthorityBackend{
+						{
+							Name: "ca-1",
+							Type: "builtin",
+						},
+					},
+				},
+			},
+		}, core_store.CreateByKey("default", core_model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+		createDataplane("web-1", "web", "1a")
+		createDataplane("web-2", "web", "2b")
+		createDataplane("backend-1", "backend", "3c")
+
+		buf = &bytes.Buffer{}
+		execute = func(args ...string) error {
+			buf.Reset()
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"revoke", "dataplane-cert",
+			}, args...))
+			return rootCmd.Execute()
+		}
+	})
+
+	It("should revoke certificate by serial number", func() {
+		// when
+		Expect(execute("--serial-number", "1A")).To(Succeed())
+
+		// then
+		Expect(buf.String()).To(Equal("Added 1 revocation(s) to Mesh \"default\"\n"))
+		Expect(revocations()).To(HaveLen(1))
+		Expect(revocations()[0]).To(matchers.MatchProto(&mesh_proto.Mesh_Mtls_Revocation{
+			SerialNumber: "1a",
+			Expiration:   util_proto.MustTimestampProto(expiration),
+		}))
+	})
+
+	It("should revoke identity and current certificates of the identity", func() {
+		// when
+		Expect(execute("--spiffe-id", "spiffe://default/web")).To(Succeed())
+
+		// then
+		Expect(buf.String()).To(Equal("Added 3 revocation(s) to Mesh \"default\"\n"))
+		var serialNumbers []string
+		for _, revocation := range revocations()[1:] {
+			serialNumbers = append(serialNumbers, revocation.GetSerialNumber())
+		}
+		Expect(revocations()[0].GetSpiffeId()).To(Equal("spiffe://default/web"))
+		Expect(serialNumbers).To(ConsistOf("1a", "2b"))
+	})
+
+	It("should not duplicate revocations", func() {
+		// given
+		Expect(execute("--serial-number", "1a")).To(Succeed())
+
+		// when
+		Expect(execute("--serial-number", "1a")).To(Succeed())
+
+		// then
+		Expect(buf.String()).To(Equal("Nothing to revoke, it is already revoked in Mesh \"default\"\n"))
+		Expect(revocations()).To(HaveLen(1))
+	})
+
+	It("should reject SPIFFE ID of another mesh", func() {
+		// when
+		err := execute("--spiffe-id", "spiffe://other/web")
+
+		// then
+		Expect(err).To(MatchError("SPIFFE ID has to be in spiffe://default/<service> format"))
+	})
+
+	It("should require either serial number or SPIFFE ID", func() {
+		// when
+		err := execute()
+
+		// then
+		Expect(err).To(MatchError("either --serial-number or --spiffe-id has to be defined"))
+	})
+})
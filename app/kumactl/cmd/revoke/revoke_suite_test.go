@@ -0,0 +1,11 @@
+package revoke_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestRevokeCmd(t *testing.T) {
+	test.RunSpecs(t, "Revoke Cmd Suite")
+}
@@ -10,11 +10,19 @@ import (
 	"github.com/kumahq/kuma/app/kumactl/cmd/completion"
 	"github.com/kumahq/kuma/app/kumactl/cmd/config"
 	"github.com/kumahq/kuma/app/kumactl/cmd/delete"
+	"github.com/kumahq/kuma/app/kumactl/cmd/drain"
+	"github.com/kumahq/kuma/app/kumactl/cmd/export"
 	"github.com/kumahq/kuma/app/kumactl/cmd/generate"
 	"github.com/kumahq/kuma/app/kumactl/cmd/get"
 	"github.com/kumahq/kuma/app/kumactl/cmd/inspect"
 	"github.com/kumahq/kuma/app/kumactl/cmd/install"
+	"github.com/kumahq/kuma/app/kumactl/cmd/manage"
+	"github.com/kumahq/kuma/app/kumactl/cmd/proxy"
+	"github.com/kumahq/kuma/app/kumactl/cmd/revoke"
+	"github.com/kumahq/kuma/app/kumactl/cmd/tap"
+	"github.com/kumahq/kuma/app/kumactl/cmd/top"
 	"github.com/kumahq/kuma/app/kumactl/cmd/uninstall"
+	"github.com/kumahq/kuma/app/kumactl/cmd/validate"
 	"github.com/kumahq/kuma/app/kumactl/cmd/version"
 	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
 	kumactl_config "github.com/kumahq/kuma/app/kumactl/pkg/config"
@@ -22,6 +30,7 @@ import (
 	kuma_cmd "github.com/kumahq/kuma/pkg/cmd"
 	"github.com/kumahq/kuma/pkg/core"
 	kuma_log "github.com/kumahq/kuma/pkg/log"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/cli/login"
 	_ "github.com/kumahq/kuma/pkg/plugins/policies"
 	// Register gateway resources.
 	_ "github.com/kumahq/kuma/pkg/plugins/runtime/gateway/register"
@@ -38,7 +47,11 @@ func NewRootCmd(root *kumactl_cmd.RootContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "kumactl",
 		Short: "Management tool for Kuma",
-		Long:  `Management tool for Kuma.`,
+		Long: `Management tool for Kuma.
+
+When a command fails, the exit code depends on the type of the failure:
+1 - unknown, 2 - validation, 3 - not-found, 4 - conflict, 5 - unauthorized.
+Errors of commands executed with --output json are printed as JSON objects with the code of the failure.`,
 		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
 			level, err := kuma_log.ParseLogLevel(args.logLevel)
 			if err != nil {
@@ -52,6 +65,7 @@ func NewRootCmd(root *kumactl_cmd.RootContext) *cobra.Command {
 
 			if args.noConfig {
 				root.Runtime.Config = kumactl_config.DefaultConfiguration()
+				root.Args.EnvOverrides = kumactl_config.LoadEnvOverrides()
 				return nil
 			}
 
@@ -83,14 +97,28 @@ func NewRootCmd(root *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.AddCommand(completion.NewCompletionCommand())
 	cmd.AddCommand(config.NewConfigCmd(root))
 	cmd.AddCommand(delete.NewDeleteCmd(root))
+	cmd.AddCommand(drain.NewDrainCmd(root))
+	cmd.AddCommand(export.NewExportCmd(root))
 	cmd.AddCommand(generate.NewGenerateCmd(root))
 	cmd.AddCommand(get.NewGetCmd(root))
+	cmd.AddCommand(apply.NewImportCmd(root))
 	cmd.AddCommand(inspect.NewInspectCmd(root))
 	cmd.AddCommand(install.NewInstallCmd(root))
+	cmd.AddCommand(login.NewLoginCmd(root))
+	cmd.AddCommand(manage.NewManageCmd(root))
+	cmd.AddCommand(proxy.NewProxyCmd(root))
+	cmd.AddCommand(revoke.NewRevokeCmd(root))
+	cmd.AddCommand(tap.NewTapCmd(root))
+	cmd.AddCommand(top.NewTopCmd(root))
 	cmd.AddCommand(uninstall.NewUninstallCmd())
+	cmd.AddCommand(validate.NewValidateCmd(root))
 	cmd.AddCommand(version.NewCmd(root))
 
+	kumactl_cmd.RegisterMeshFlagCompletion(root, cmd)
 	kumactl_cmd.WrapRunnables(cmd, kumactl_errors.FormatErrorWrapper)
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return kumactl_errors.NewValidationError(err)
+	})
 	return cmd
 }
 
@@ -100,8 +128,13 @@ func DefaultRootCmd() *cobra.Command {
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
+// The exit code depends on the type of the failure, see kumactl_errors.Code.
 func Execute() {
-	if err := DefaultRootCmd().Execute(); err != nil {
-		os.Exit(1)
+	rootCmd := DefaultRootCmd()
+	// errors are printed by us, so they can be printed as JSON
+	rootCmd.SilenceErrors = true
+	if cmd, err := rootCmd.ExecuteC(); err != nil {
+		kumactl_errors.PrintError(cmd, err)
+		os.Exit(kumactl_errors.ExitCode(err))
 	}
 }
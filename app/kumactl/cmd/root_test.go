@@ -73,4 +73,48 @@ currentContext: local
 		Expect(err).To(HaveOccurred())
 		Expect(os.IsNotExist(err)).To(BeTrue())
 	})
+
+	It("should apply overrides from environment variables without saving them", func() {
+		// given
+		GinkgoT().Setenv(config.AddressEnv, "https://zone-1.internal:5682")
+		GinkgoT().Setenv(config.AuthTokenEnv, "secret")
+		GinkgoT().Setenv(config.CaCertFileEnv, "/tmp/ca.pem")
+		rootCtx := test_kumactl.MakeMinimalRootContext()
+		rootCmd := cmd.NewRootCmd(rootCtx)
+
+		// when
+		rootCmd.SetArgs([]string{"version"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and the current Control Plane is overridden
+		cp, err := rootCtx.CurrentControlPlane()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cp.Coordinates.ApiServer.Url).To(Equal("https://zone-1.internal:5682"))
+		Expect(cp.Coordinates.ApiServer.CaCertFile).To(Equal("/tmp/ca.pem"))
+		Expect(cp.Coordinates.ApiServer.AuthType).To(Equal("tokens"))
+		Expect(cp.Coordinates.ApiServer.AuthConf).To(Equal(map[string]string{"token": "secret"}))
+
+		// and the configuration is untouched
+		_, stored := rootCtx.Config().GetControlPlane("local")
+		Expect(stored.Coordinates.ApiServer.Url).To(Equal("http://localhost:5681"))
+		Expect(stored.Coordinates.ApiServer.AuthType).To(BeEmpty())
+	})
+
+	It("should fail when context from environment variable does not exist", func() {
+		// given
+		GinkgoT().Setenv(config.ContextEnv, "zone-1")
+		rootCtx := test_kumactl.MakeMinimalRootContext()
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetArgs([]string{"version"})
+		Expect(rootCmd.Execute()).To(Succeed())
+
+		// when
+		_, err := rootCtx.CurrentControlPlane()
+
+		// then
+		Expect(err).To(MatchError(`context "zone-1" set in KUMACTL_CONTEXT environment variable does not exist`))
+	})
 })
@@ -0,0 +1,29 @@
+package tap
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewTapCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	tapCmd := &cobra.Command{
+		Use:   "tap",
+		Short: "Capture traffic of Kuma proxies",
+		Long: `Capture traffic of Kuma proxies.
+Traffic is captured by the tap filter of Envoy, which is added to HTTP inbound listeners when the control plane
+runs with KUMA_EXPERIMENTAL_ENVOY_TAP enabled.`,
+	}
+	tapCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := kumactl_cmd.RunParentPreRunE(tapCmd, args); err != nil {
+			return err
+		}
+		if err := pctx.CheckServerVersionCompatibility(); err != nil {
+			cmd.PrintErrln(err)
+		}
+		return nil
+	}
+	// sub-commands
+	tapCmd.AddCommand(newTapDataplaneCmd(pctx))
+	return tapCmd
+}
@@ -0,0 +1,66 @@
+package tap
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	output_tap "github.com/kumahq/kuma/app/kumactl/pkg/output/tap"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+func newTapDataplaneCmd(pctx *cmd.RootContext) *cobra.Command {
+	var opts resources.TapOpts
+	var count int
+	var output string
+	cmd := &cobra.Command{
+		Use:   "dataplane NAME",
+		Short: "Capture HTTP requests and responses of Dataplane",
+		Long: `Capture HTTP requests and responses received by the inbound listeners of Dataplane.
+Requests are captured as they are forwarded to the application, together with the response of the application.
+Captured traffic is streamed through the control plane, the command runs until it is interrupted
+or the number of requests given by --count is captured.`,
+		Example: `
+# Capture all requests of the backend-01 Dataplane
+$ kumactl tap dataplane backend-01 --mesh default
+
+# Capture 10 POST requests to /api together with up to 4KiB of the bodies
+$ kumactl tap dataplane backend-01 --match :method=POST --match :path=/api --max-body-bytes 4096 --count 10
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format := output_tap.Format(output); format != output_tap.TextFormat && format != output_tap.JSONFormat {
+				return errors.Errorf("output format %q is not supported, use %q or %q", output, output_tap.TextFormat, output_tap.JSONFormat)
+			}
+			for _, match := range opts.Matches {
+				if name, _, ok := strings.Cut(match, "="); !ok || name == "" {
+					return errors.Errorf("match %q has to be in the name=value format", match)
+				}
+			}
+
+			client, err := pctx.CurrentStreamingInspectEnvoyProxyClient(mesh.DataplaneResourceTypeDescriptor)
+			if err != nil {
+				return errors.Wrap(err, "failed to create a dataplane inspect client")
+			}
+
+			resourceKey := core_model.ResourceKey{Name: args[0], Mesh: pctx.CurrentMesh()}
+			stream, err := client.Tap(cmd.Context(), resourceKey, opts)
+			if err != nil {
+				return errors.Wrap(err, "could not open the tap session")
+			}
+			defer stream.Close()
+
+			return output_tap.PrintEntries(cmd.Context(), stream, cmd.OutOrStdout(), output_tap.Format(output), count)
+		},
+	}
+	cmd.PersistentFlags().StringArrayVar(&opts.Matches, "match", nil, "capture only requests with the header equal to the value, in the name=value format. Pseudo-headers like :method and :path are accepted. Can be repeated, then all of them have to match")
+	cmd.PersistentFlags().Uint32Var(&opts.MaxBufferedBytes, "max-body-bytes", 0, "maximum number of body bytes captured for every request and response. 0 means the default of Envoy (1KiB)")
+	cmd.PersistentFlags().IntVar(&count, "count", 0, "number of captured requests after which the command exits. 0 means that the command runs until it is interrupted")
+	cmd.PersistentFlags().StringVarP(&output, "output", "o", string(output_tap.TextFormat), "output format: text or json")
+	cmd.PersistentFlags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	return cmd
+}
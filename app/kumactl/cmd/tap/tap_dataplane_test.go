@@ -0,0 +1,147 @@
+package tap_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+const capturedTraffic = `{
+ "http_buffered_trace": {
+  "request": {
+   "headers": [
+    {"key": ":authority", "value": "backend"},
+    {"key": ":path", "value": "/api"},
+    {"key": ":method", "value": "POST"},
+    {"key": "content-type", "value": "application/json"}
+   ],
+   "body": {"as_bytes": "eyJuYW1lIjogImt1bWEifQ==", "truncated": true}
+  },
+  "response": {
+   "headers": [
+    {"key": ":status", "value": "201"}
+   ]
+  }
+ }
+}
+{
+ "http_buffered_trace": {
+  "request": {
+   "headers": [
+    {"key": ":authority", "value": "backend"},
+    {"key": ":path", "value": "/health"},
+    {"key": ":method", "value": "GET"}
+   ]
+  },
+  "response": {
+   "headers": [
+    {"key": ":status", "value": "200"}
+   ],
+   "body": {"as_bytes": "AAEC"}
+  }
+ }
+}
+`
+
+type testEnvoyProxyClient struct {
+	resources.InspectEnvoyProxyClient
+	tapped []core_model.ResourceKey
+	opts   resources.TapOpts
+}
+
+func (t *testEnvoyProxyClient) Tap(_ context.Context, rk core_model.ResourceKey, opts resources.TapOpts) (io.ReadCloser, error) {
+	t.tapped = append(t.tapped, rk)
+	t.opts = opts
+	return io.NopCloser(strings.NewReader(capturedTraffic)), nil
+}
+
+var _ = Describe("kumactl tap dataplane", func() {
+
+	var client *testEnvoyProxyClient
+	var buf *bytes.Buffer
+	var execute func(args ...string) error
+
+	BeforeEach(func() {
+		client = &testEnvoyProxyClient{}
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.NewInspectEnvoyProxyClient = func(core_model.ResourceTypeDescriptor, util_http.Client) resources.InspectEnvoyProxyClient {
+			return client
+		}
+
+		buf = &bytes.Buffer{}
+		execute = func(args ...string) error {
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			}, args...))
+			return rootCmd.Execute()
+		}
+	})
+
+	It("should render captured traffic", func() {
+		// when
+		err := execute("tap", "dataplane", "backend-1", "--mesh", "demo",
+			"--match", ":method=POST", "--match", "x-user=alice", "--max-body-bytes", "16")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.tapped).To(Equal([]core_model.ResourceKey{{Mesh: "demo", Name: "backend-1"}}))
+		Expect(client.opts).To(Equal(resources.TapOpts{
+			Matches:          []string{":method=POST", "x-user=alice"},
+			MaxBufferedBytes: 16,
+		}))
+		Expect(buf.String()).To(Equal(`POST backend/api 201
+> :authority: backend
+> :path: /api
+> :method: POST
+> content-type: application/json
+>
+> {"name": "kuma"}
+> (truncated)
+< :status: 201
+
+GET backend/health 200
+> :authority: backend
+> :path: /health
+> :method: GET
+< :status: 200
+<
+< (3 bytes of binary data)
+
+`))
+	})
+
+	It("should stop after the number of captured requests", func() {
+		// when
+		err := execute("tap", "dataplane", "backend-1", "--count", "1", "-o", "json")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.tapped).To(Equal([]core_model.ResourceKey{{Mesh: "default", Name: "backend-1"}}))
+		Expect(strings.Count(buf.String(), "http_buffered_trace")).To(Equal(1))
+		Expect(buf.String()).To(ContainSubstring(`"value": "/api"`))
+	})
+
+	It("should reject invalid match", func() {
+		// when
+		err := execute("tap", "dataplane", "backend-1", "--match", "x-user")
+
+		// then
+		Expect(err).To(MatchError(`match "x-user" has to be in the name=value format`))
+		Expect(client.tapped).To(BeEmpty())
+	})
+})
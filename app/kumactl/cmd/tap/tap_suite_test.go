@@ -0,0 +1,11 @@
+package tap_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestTapCmd(t *testing.T) {
+	test.RunSpecs(t, "Tap Cmd Suite")
+}
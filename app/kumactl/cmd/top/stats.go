@@ -0,0 +1,248 @@
+package top
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+const listPageSize = 100
+
+// inboundStatsFilter matches the stats of local clusters, which are the clusters of the inbound traffic of a Dataplane.
+const inboundStatsFilter = `^cluster\.localhost_[0-9]+\.(upstream_rq_total|upstream_rq_5xx|upstream_rq_time)$`
+
+var inboundStatRegexp = regexp.MustCompile(`^cluster\.localhost_([0-9]+)\.(upstream_rq_total|upstream_rq_5xx|upstream_rq_time)$`)
+
+// inboundStats are the stats of the local cluster of an inbound, identified by the workload port.
+type inboundStats struct {
+	requests float64
+	errors   float64
+	// p99 is the 99th percentile of the request time in milliseconds in the last flush interval of Envoy, nil if unknown.
+	p99 *float64
+}
+
+type dataplaneSample struct {
+	key core_model.ResourceKey
+	// services are the services of the inbounds by the workload port.
+	services map[uint32]string
+	inbounds map[uint32]*inboundStats
+	err      error
+}
+
+type sample struct {
+	time       time.Time
+	dataplanes map[core_model.ResourceKey]*dataplaneSample
+}
+
+// traffic is the traffic computed from two consecutive samples.
+type traffic struct {
+	rps      float64
+	requests float64
+	errors   float64
+	p99      *float64
+}
+
+func (t *traffic) add(other traffic) {
+	t.rps += other.rps
+	t.requests += other.requests
+	t.errors += other.errors
+	if other.p99 != nil && (t.p99 == nil || *other.p99 > *t.p99) {
+		t.p99 = other.p99
+	}
+}
+
+// errorRate returns the percentage of requests that failed with 5xx, nil if there were no requests.
+func (t traffic) errorRate() *float64 {
+	if t.requests == 0 {
+		return nil
+	}
+	rate := t.errors / t.requests * 100
+	return &rate
+}
+
+type sampler struct {
+	pctx   *kumactl_cmd.RootContext
+	client resources.InspectEnvoyProxyClient
+}
+
+func newSampler(pctx *kumactl_cmd.RootContext) (*sampler, error) {
+	client, err := pctx.CurrentInspectEnvoyProxyClient(mesh.DataplaneResourceTypeDescriptor)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a dataplane inspect client")
+	}
+	return &sampler{
+		pctx:   pctx,
+		client: client,
+	}, nil
+}
+
+// sample lists Dataplanes in the mesh and fetches stats of their inbounds.
+// Errors of fetching stats of a single Dataplane do not fail the sample, the Dataplane might be offline.
+func (s *sampler) sample(ctx context.Context) (*sample, error) {
+	rs, err := s.pctx.CurrentResourceStore()
+	if err != nil {
+		return nil, err
+	}
+	result := &sample{
+		dataplanes: map[core_model.ResourceKey]*dataplaneSample{},
+	}
+	offset := ""
+	for {
+		dataplanes := &mesh.DataplaneResourceList{}
+		if err := rs.List(ctx, dataplanes, core_store.ListByMesh(s.pctx.CurrentMesh()), core_store.ListByPage(listPageSize, offset)); err != nil {
+			return nil, errors.Wrap(err, "failed to list Dataplanes")
+		}
+		for _, dp := range dataplanes.Items {
+			dpSample := s.sampleDataplane(ctx, dp)
+			result.dataplanes[dpSample.key] = dpSample
+		}
+		if dataplanes.Pagination.NextOffset == "" {
+			break
+		}
+		offset = dataplanes.Pagination.NextOffset
+	}
+	result.time = s.pctx.Now()
+	return result, nil
+}
+
+func (s *sampler) sampleDataplane(ctx context.Context, dp *mesh.DataplaneResource) *dataplaneSample {
+	dpSample := &dataplaneSample{
+		key:      core_model.MetaToResourceKey(dp.GetMeta()),
+		services: map[uint32]string{},
+	}
+	for _, inbound := range dp.Spec.GetNetworking().GetInbound() {
+		iface := dp.Spec.GetNetworking().ToInboundInterface(inbound)
+		dpSample.services[iface.WorkloadPort] = inbound.GetService()
+	}
+	if len(dpSample.services) == 0 {
+		return dpSample
+	}
+	stats, err := s.client.Stats(ctx, dpSample.key, resources.StatsOpts{
+		Format: "json",
+		Filter: inboundStatsFilter,
+	})
+	if err != nil {
+		dpSample.err = err
+		return dpSample
+	}
+	dpSample.inbounds, dpSample.err = parseInboundStats(stats)
+	return dpSample
+}
+
+// envoyStats is the JSON format of Envoy /stats endpoint.
+type envoyStats struct {
+	Stats []struct {
+		Name       string   `json:"name"`
+		Value      *float64 `json:"value"`
+		Histograms *struct {
+			SupportedQuantiles []float64 `json:"supported_quantiles"`
+			ComputedQuantiles  []struct {
+				Name   string `json:"name"`
+				Values []struct {
+					Interval   *float64 `json:"interval"`
+					Cumulative *float64 `json:"cumulative"`
+				} `json:"values"`
+			} `json:"computed_quantiles"`
+		} `json:"histograms"`
+	} `json:"stats"`
+}
+
+func parseInboundStats(b []byte) (map[uint32]*inboundStats, error) {
+	stats := envoyStats{}
+	if err := json.Unmarshal(b, &stats); err != nil {
+		return nil, errors.Wrap(err, "could not parse stats")
+	}
+	inbounds := map[uint32]*inboundStats{}
+	inbound := func(name string) (*inboundStats, string) {
+		matches := inboundStatRegexp.FindStringSubmatch(name)
+		if matches == nil {
+			return nil, ""
+		}
+		port, err := strconv.ParseUint(matches[1], 10, 32)
+		if err != nil {
+			return nil, ""
+		}
+		if _, ok := inbounds[uint32(port)]; !ok {
+			inbounds[uint32(port)] = &inboundStats{}
+		}
+		return inbounds[uint32(port)], matches[2]
+	}
+	for _, stat := range stats.Stats {
+		if stat.Histograms != nil {
+			p99Idx := -1
+			for i, quantile := range stat.Histograms.SupportedQuantiles {
+				if quantile == 99 {
+					p99Idx = i
+				}
+			}
+			for _, histogram := range stat.Histograms.ComputedQuantiles {
+				in, _ := inbound(histogram.Name)
+				if in == nil || p99Idx < 0 || p99Idx >= len(histogram.Values) {
+					continue
+				}
+				in.p99 = histogram.Values[p99Idx].Interval
+			}
+			continue
+		}
+		in, name := inbound(stat.Name)
+		if in == nil || stat.Value == nil {
+			continue
+		}
+		switch name {
+		case "upstream_rq_total":
+			in.requests = *stat.Value
+		case "upstream_rq_5xx":
+			in.errors = *stat.Value
+		}
+	}
+	return inbounds, nil
+}
+
+// inboundTraffic computes the traffic of every inbound of the Dataplane between the samples.
+// It returns false if the traffic can't be computed, because the Dataplane is missing in the previous sample or its stats can't be fetched.
+func inboundTraffic(prev, cur *sample, key core_model.ResourceKey) (map[uint32]traffic, bool) {
+	prevDp, ok := prev.dataplanes[key]
+	if !ok || prevDp.err != nil {
+		return nil, false
+	}
+	curDp := cur.dataplanes[key]
+	if curDp.err != nil {
+		return nil, false
+	}
+	elapsed := cur.time.Sub(prev.time).Seconds()
+	result := map[uint32]traffic{}
+	for port, curIn := range curDp.inbounds {
+		prevIn, ok := prevDp.inbounds[port]
+		if !ok {
+			prevIn = &inboundStats{}
+		}
+		t := traffic{
+			requests: delta(prevIn.requests, curIn.requests),
+			errors:   delta(prevIn.errors, curIn.errors),
+			p99:      curIn.p99,
+		}
+		if elapsed > 0 {
+			t.rps = t.requests / elapsed
+		}
+		result[port] = t
+	}
+	return result, true
+}
+
+// delta returns the increase of the counter. The counter is reset when the proxy restarts, in which case the current value is the increase.
+func delta(prev, cur float64) float64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
@@ -0,0 +1,118 @@
+package top
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+type topContext struct {
+	interval   time.Duration
+	iterations int
+}
+
+func NewTopCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &topContext{}
+	topCmd := &cobra.Command{
+		Use:   "top",
+		Short: "Show live traffic of Kuma proxies",
+		Long: `Show live traffic of Kuma proxies.
+Requests per second, error rate and latency are computed from Envoy stats of inbound traffic fetched periodically through the control plane.`,
+	}
+	topCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := kumactl_cmd.RunParentPreRunE(topCmd, args); err != nil {
+			return err
+		}
+		if err := pctx.CheckServerVersionCompatibility(); err != nil {
+			cmd.PrintErrln(err)
+		}
+		return nil
+	}
+	topCmd.PersistentFlags().DurationVar(&ctx.interval, "interval", 2*time.Second, "interval between refreshes of the stats")
+	topCmd.PersistentFlags().IntVar(&ctx.iterations, "iterations", 0, "number of refreshes after which the command exits. 0 means that the command runs until it is interrupted")
+	topCmd.PersistentFlags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	// sub-commands
+	topCmd.AddCommand(newTopDataplanesCmd(pctx, ctx))
+	topCmd.AddCommand(newTopServicesCmd(pctx, ctx))
+	return topCmd
+}
+
+// renderFn renders the traffic between two consecutive samples.
+type renderFn func(prev, cur *sample, out io.Writer) error
+
+// run periodically samples the stats and renders the traffic. The screen is cleared before every render when the output is a terminal.
+func run(cmd *cobra.Command, pctx *kumactl_cmd.RootContext, ctx *topContext, render renderFn) error {
+	s, err := newSampler(pctx)
+	if err != nil {
+		return err
+	}
+	prev, err := s.sample(cmd.Context())
+	if err != nil {
+		return err
+	}
+	out := cmd.OutOrStdout()
+	clear := isTerminal(out)
+	for i := 0; ctx.iterations == 0 || i < ctx.iterations; i++ {
+		select {
+		case <-cmd.Context().Done():
+			return nil
+		case <-time.After(ctx.interval):
+		}
+		cur, err := s.sample(cmd.Context())
+		if err != nil {
+			return err
+		}
+		buf := &bytes.Buffer{}
+		if err := render(prev, cur, buf); err != nil {
+			return err
+		}
+		switch {
+		case clear:
+			buf = bytes.NewBuffer(append([]byte(clearScreen), buf.Bytes()...))
+		case i > 0:
+			// separate refreshes when the output is not a terminal, i.e. it's piped to a file
+			buf = bytes.NewBuffer(append([]byte("\n"), buf.Bytes()...))
+		}
+		if _, err := buf.WriteTo(out); err != nil {
+			return err
+		}
+		prev = cur
+	}
+	return nil
+}
+
+const clearScreen = "\033[H\033[2J"
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func formatRPS(t traffic) string {
+	return fmt.Sprintf("%.1f", t.rps)
+}
+
+func formatErrorRate(t traffic) string {
+	rate := t.errorRate()
+	if rate == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f%%", *rate)
+}
+
+func formatP99(t traffic) string {
+	if t.p99 == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.0fms", *t.p99)
+}
@@ -0,0 +1,85 @@
+package top
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+)
+
+func newTopDataplanesCmd(pctx *kumactl_cmd.RootContext, ctx *topContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "dataplanes",
+		Short: "Show live inbound traffic of Dataplanes",
+		Long:  `Show live inbound traffic of Dataplanes in the mesh.`,
+		Example: `
+# Show traffic of Dataplanes in the demo mesh refreshed every 5 seconds
+$ kumactl top dataplanes --mesh demo --interval 5s
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return run(cmd, pctx, ctx, renderDataplanes)
+		},
+	}
+}
+
+type dataplaneRow struct {
+	name     string
+	services []string
+	traffic  traffic
+	known    bool
+}
+
+func renderDataplanes(prev, cur *sample, out io.Writer) error {
+	var rows []dataplaneRow
+	for key, dp := range cur.dataplanes {
+		row := dataplaneRow{
+			name: key.Name,
+		}
+		for _, service := range dp.services {
+			row.services = append(row.services, service)
+		}
+		sort.Strings(row.services)
+		inbounds, ok := inboundTraffic(prev, cur, key)
+		row.known = ok
+		for _, t := range inbounds {
+			row.traffic.add(t)
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].traffic.rps != rows[j].traffic.rps {
+			return rows[i].traffic.rps > rows[j].traffic.rps
+		}
+		return rows[i].name < rows[j].name
+	})
+
+	data := table.Table{
+		Headers: []string{"NAME", "SERVICES", "RPS", "ERROR RATE", "P99"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rows) <= i {
+					return nil
+				}
+				row := rows[i]
+				if !row.known {
+					return []string{row.name, strings.Join(row.services, ","), "-", "-", "-"}
+				}
+				return []string{
+					row.name,
+					strings.Join(row.services, ","),
+					formatRPS(row.traffic),
+					formatErrorRate(row.traffic),
+					formatP99(row.traffic),
+				}
+			}
+		}(),
+	}
+	return table.NewPrinter().Print(data, out)
+}
@@ -0,0 +1,89 @@
+package top
+
+import (
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+)
+
+func newTopServicesCmd(pctx *kumactl_cmd.RootContext, ctx *topContext) *cobra.Command {
+	return &cobra.Command{
+		Use:   "services",
+		Short: "Show live inbound traffic of services",
+		Long: `Show live inbound traffic of services in the mesh, aggregated from all Dataplanes of the service.
+P99 is the highest 99th percentile of the request time among Dataplanes of the service.`,
+		Example: `
+# Show traffic of services in the demo mesh
+$ kumactl top services --mesh demo
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return run(cmd, pctx, ctx, renderServices)
+		},
+	}
+}
+
+type serviceRow struct {
+	name       string
+	dataplanes int
+	traffic    traffic
+}
+
+func renderServices(prev, cur *sample, out io.Writer) error {
+	byName := map[string]*serviceRow{}
+	for key, dp := range cur.dataplanes {
+		inbounds, _ := inboundTraffic(prev, cur, key)
+		counted := map[string]bool{}
+		for port, service := range dp.services {
+			row, ok := byName[service]
+			if !ok {
+				row = &serviceRow{name: service}
+				byName[service] = row
+			}
+			if !counted[service] {
+				row.dataplanes++
+				counted[service] = true
+			}
+			if t, ok := inbounds[port]; ok {
+				row.traffic.add(t)
+			}
+		}
+	}
+	var rows []*serviceRow
+	for _, row := range byName {
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].traffic.rps != rows[j].traffic.rps {
+			return rows[i].traffic.rps > rows[j].traffic.rps
+		}
+		return rows[i].name < rows[j].name
+	})
+
+	data := table.Table{
+		Headers: []string{"SERVICE", "DATAPLANES", "RPS", "ERROR RATE", "P99"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rows) <= i {
+					return nil
+				}
+				row := rows[i]
+				return []string{
+					row.name,
+					strconv.Itoa(row.dataplanes),
+					formatRPS(row.traffic),
+					formatErrorRate(row.traffic),
+					formatP99(row.traffic),
+				}
+			}
+		}(),
+	}
+	return table.NewPrinter().Print(data, out)
+}
@@ -0,0 +1,11 @@
+package top_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestTopCmd(t *testing.T) {
+	test.RunSpecs(t, "Top Cmd Suite")
+}
@@ -0,0 +1,171 @@
+package top_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/resources"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type inboundCounters struct {
+	port     uint32
+	requests int
+	errors   int
+	p99      string
+}
+
+type testEnvoyProxyClient struct {
+	resources.InspectEnvoyProxyClient
+	// samples are the stats returned in the consecutive calls for the Dataplane
+	samples map[string][][]inboundCounters
+	calls   map[string]int
+	opts    resources.StatsOpts
+}
+
+func (t *testEnvoyProxyClient) Stats(_ context.Context, rk core_model.ResourceKey, opts resources.StatsOpts) ([]byte, error) {
+	t.opts = opts
+	samples, ok := t.samples[rk.Name]
+	if !ok {
+		return nil, errors.New("dataplane is offline")
+	}
+	inbounds := samples[t.calls[rk.Name]]
+	t.calls[rk.Name]++
+
+	stats := ""
+	quantiles := ""
+	for _, in := range inbounds {
+		stats += fmt.Sprintf(`{"name": "cluster.localhost_%d.upstream_rq_5xx", "value": %d},`, in.port, in.errors)
+		stats += fmt.Sprintf(`{"name": "cluster.localhost_%d.upstream_rq_total", "value": %d},`, in.port, in.requests)
+		if quantiles != "" {
+			quantiles += ","
+		}
+		quantiles += fmt.Sprintf(`{"name": "cluster.localhost_%d.upstream_rq_time", "values": [
+			{"interval": 1, "cumulative": 1}, {"interval": 5, "cumulative": 5}, {"interval": %s, "cumulative": 100}
+		]}`, in.port, in.p99)
+	}
+	return []byte(fmt.Sprintf(`{"stats": [%s {"histograms": {"supported_quantiles": [0, 50, 99], "computed_quantiles": [%s]}}]}`, stats, quantiles)), nil
+}
+
+var _ = Describe("kumactl top", func() {
+
+	var client *testEnvoyProxyClient
+	var buf *bytes.Buffer
+	var execute func(args ...string) error
+
+	dataplane := func(name string, inbounds ...*mesh_proto.Dataplane_Networking_Inbound) *core_mesh.DataplaneResource {
+		dp := core_mesh.NewDataplaneResource()
+		dp.Spec = &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "1.1.1.1",
+				Inbound: inbounds,
+			},
+		}
+		return dp
+	}
+
+	BeforeEach(func() {
+		store := memory_resources.NewStore()
+		dataplanes := map[string]*core_mesh.DataplaneResource{
+			"web-1": dataplane("web-1", &mesh_proto.Dataplane_Networking_Inbound{
+				Port: 8080,
+				Tags: map[string]string{mesh_proto.ServiceTag: "web"},
+			}),
+			"web-2": dataplane("web-2", &mesh_proto.Dataplane_Networking_Inbound{
+				Port: 8080,
+				Tags: map[string]string{mesh_proto.ServiceTag: "web"},
+			}),
+			"backend-1": dataplane("backend-1", &mesh_proto.Dataplane_Networking_Inbound{
+				Port:        80,
+				ServicePort: 9090,
+				Tags:        map[string]string{mesh_proto.ServiceTag: "backend"},
+			}),
+			"offline-1": dataplane("offline-1", &mesh_proto.Dataplane_Networking_Inbound{
+				Port: 8080,
+				Tags: map[string]string{mesh_proto.ServiceTag: "web"},
+			}),
+		}
+		for name, dp := range dataplanes {
+			Expect(store.Create(context.Background(), dp, core_store.CreateByKey(name, "demo"))).To(Succeed())
+		}
+		Expect(store.Create(context.Background(), dataplane("other-mesh"), core_store.CreateByKey("other-mesh", "default"))).To(Succeed())
+
+		client = &testEnvoyProxyClient{
+			samples: map[string][][]inboundCounters{
+				"web-1": {
+					{{port: 8080, requests: 100, errors: 0, p99: "10"}},
+					{{port: 8080, requests: 200, errors: 5, p99: "20"}},
+					{{port: 8080, requests: 250, errors: 5, p99: "null"}},
+				},
+				"web-2": {
+					{{port: 8080, requests: 1000, errors: 10, p99: "15"}},
+					{{port: 8080, requests: 1100, errors: 15, p99: "30"}},
+					{{port: 8080, requests: 50, errors: 0, p99: "40"}},
+				},
+				"backend-1": {
+					{{port: 9090, requests: 0, errors: 0, p99: "null"}},
+					{{port: 9090, requests: 0, errors: 0, p99: "null"}},
+					{{port: 9090, requests: 20, errors: 20, p99: "1000"}},
+				},
+			},
+			calls: map[string]int{},
+		}
+
+		now := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+		rootCtx, err := test_kumactl.MakeRootContext(now, core_store.NewPaginationStore(store))
+		Expect(err).ToNot(HaveOccurred())
+		rootCtx.Runtime.Now = func() time.Time {
+			now = now.Add(10 * time.Second)
+			return now
+		}
+		rootCtx.Runtime.NewInspectEnvoyProxyClient = func(core_model.ResourceTypeDescriptor, util_http.Client) resources.InspectEnvoyProxyClient {
+			return client
+		}
+
+		buf = &bytes.Buffer{}
+		execute = func(args ...string) error {
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			}, args...))
+			return rootCmd.Execute()
+		}
+	})
+
+	It("should show traffic of dataplanes", func() {
+		// when
+		err := execute("top", "dataplanes", "--mesh", "demo", "--interval", "1ms", "--iterations", "2")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "top-dataplanes.golden.txt")))
+		// and
+		Expect(client.opts.Format).To(Equal("json"))
+		Expect(client.opts.Filter).To(Equal(`^cluster\.localhost_[0-9]+\.(upstream_rq_total|upstream_rq_5xx|upstream_rq_time)$`))
+	})
+
+	It("should show traffic of services", func() {
+		// when
+		err := execute("top", "services", "--mesh", "demo", "--interval", "1ms", "--iterations", "2")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "top-services.golden.txt")))
+	})
+})
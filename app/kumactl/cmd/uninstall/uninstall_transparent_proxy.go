@@ -9,56 +9,61 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"go.uber.org/multierr"
 
 	"github.com/kumahq/kuma/pkg/transparentproxy"
 )
 
 type transparentProxyArgs struct {
-	DryRun  bool
-	Verbose bool
+	DryRun    bool
+	Verbose   bool
+	StateFile string
 }
 
 func newUninstallTransparentProxy() *cobra.Command {
 	args := transparentProxyArgs{
-		DryRun:  false,
-		Verbose: false,
+		DryRun:    false,
+		Verbose:   false,
+		StateFile: transparentproxy.DefaultStateFile,
 	}
 	cmd := &cobra.Command{
 		Use:   "transparent-proxy",
 		Short: "Uninstall Transparent Proxy pre-requisites on the host",
-		Long:  `Uninstall Transparent Proxy by restoring the hosts iptables and /etc/resolv.conf`,
+		Long: `Uninstall Transparent Proxy by restoring the hosts iptables and /etc/resolv.conf.
+
+The changes to revert are read from the state file recorded by 'kumactl install transparent-proxy'.
+The changes are reverted one by one and removed from the state file, so when the uninstall fails,
+it can be run again to revert the remaining changes. When there is no state file, the iptables rules
+of the default engine are removed and /etc/resolv.conf is restored from /etc/resolv.conf.kuma-backup.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if !args.DryRun && runtime.GOOS != "linux" {
 				return errors.Errorf("transparent proxy will work only on Linux OSes")
 			}
 
-			tp := transparentproxy.DefaultTransparentProxy()
-
-			output, err := tp.Cleanup(args.DryRun, args.Verbose)
+			state, err := transparentproxy.LoadState(args.StateFile)
 			if err != nil {
-				return errors.Wrap(err, "Failed to cleanup transparent proxy")
+				return err
 			}
-
-			if args.DryRun {
-				_, _ = cmd.OutOrStdout().Write([]byte(output))
-				_, _ = cmd.OutOrStdout().Write([]byte("\n"))
+			if state == nil {
+				state = legacyState()
 			}
 
-			if _, err := os.Stat("/etc/resolv.conf.kuma-backup"); !os.IsNotExist(err) {
-				content, err := os.ReadFile("/etc/resolv.conf.kuma-backup")
-				if err != nil {
-					return errors.Wrap(err, "unable to open /etc/resolv.conf.kuma-backup")
-				}
-
-				if !args.DryRun {
-					err = os.WriteFile("/etc/resolv.conf", content, 0644)
-					if err != nil {
-						return errors.Wrap(err, "unable to write /etc/resolv.conf")
+			if err := revert(cmd, &args, state); err != nil {
+				if !args.DryRun && !state.Empty() {
+					if saveErr := transparentproxy.SaveState(args.StateFile, state); saveErr != nil {
+						return multierr.Append(err, saveErr)
 					}
+					return errors.Wrapf(err, "transparent proxy was cleaned up partially, the remaining changes are recorded in %s. Fix the issue and run the command again", args.StateFile)
 				}
+				return err
+			}
 
-				_, _ = cmd.OutOrStdout().Write(content)
+			if !args.DryRun {
+				if err := transparentproxy.RemoveState(args.StateFile); err != nil {
+					return err
+				}
 			}
+
 			_, _ = cmd.OutOrStdout().Write([]byte("\nTransparent proxy cleaned up successfully\n"))
 
 			return nil
@@ -67,5 +72,75 @@ func newUninstallTransparentProxy() *cobra.Command {
 
 	cmd.Flags().BoolVar(&args.DryRun, "dry-run", args.DryRun, "dry run")
 	cmd.Flags().BoolVar(&args.Verbose, "verbose", args.Verbose, "verbose")
+	cmd.Flags().StringVar(&args.StateFile, "state-file", args.StateFile, "the file where 'kumactl install transparent-proxy' recorded the applied changes")
 	return cmd
 }
+
+// legacyState returns the state of an installation done by a version of kumactl that did not record it.
+func legacyState() *transparentproxy.State {
+	state := &transparentproxy.State{
+		Engine:   transparentproxy.IstioEngine,
+		IPTables: true,
+	}
+	if _, err := os.Stat("/etc/resolv.conf.kuma-backup"); !os.IsNotExist(err) {
+		state.ResolvConfBackup = "/etc/resolv.conf.kuma-backup"
+	}
+	return state
+}
+
+// revert reverts the changes in the reverse order to the installation. Every reverted change is removed
+// from the state, so on error the state contains only the changes that still have to be reverted.
+func revert(cmd *cobra.Command, args *transparentProxyArgs, state *transparentproxy.State) error {
+	if state.ResolvConfBackup != "" {
+		if err := restoreResolvConf(cmd, args, state.ResolvConfBackup); err != nil {
+			return err
+		}
+		state.ResolvConfBackup = ""
+	}
+
+	if state.Firewalld {
+		_, _ = cmd.ErrOrStderr().Write([]byte("# the iptables rules stored with firewalld are not removed, remove them from the firewalld direct configuration manually\n"))
+		state.Firewalld = false
+	}
+
+	if state.IPTables {
+		tp, err := transparentproxy.NewTransparentProxy(state.Engine)
+		if err != nil {
+			return err
+		}
+
+		output, err := tp.Cleanup(args.DryRun, args.Verbose)
+		if err != nil {
+			return errors.Wrap(err, "Failed to cleanup transparent proxy")
+		}
+
+		if args.DryRun {
+			_, _ = cmd.OutOrStdout().Write([]byte(output))
+			_, _ = cmd.OutOrStdout().Write([]byte("\n"))
+		}
+		state.IPTables = false
+	}
+
+	return nil
+}
+
+func restoreResolvConf(cmd *cobra.Command, args *transparentProxyArgs, backup string) error {
+	content, err := os.ReadFile(backup)
+	if os.IsNotExist(err) {
+		// the backup was already restored and removed manually
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "unable to open %s", backup)
+	}
+
+	if !args.DryRun {
+		err = os.WriteFile("/etc/resolv.conf", content, 0644)
+		if err != nil {
+			return errors.Wrap(err, "unable to write /etc/resolv.conf")
+		}
+	}
+
+	_, _ = cmd.OutOrStdout().Write(content)
+	return nil
+}
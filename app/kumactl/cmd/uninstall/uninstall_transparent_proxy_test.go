@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/kumahq/kuma/pkg/transparentproxy"
 	"github.com/kumahq/kuma/pkg/util/test"
 )
 
@@ -59,4 +60,62 @@ var _ = Describe("kumactl install tracing", func() {
 			goldenFile: "uninstall-transparent-proxy.defaults.golden.txt",
 		}),
 	)
+
+	Describe("with the state file", func() {
+		var stateFile string
+		var backupFile string
+
+		BeforeEach(func() {
+			dir := GinkgoT().TempDir()
+			stateFile = filepath.Join(dir, "transparent-proxy.json")
+			backupFile = filepath.Join(dir, "resolv.conf.kuma-backup")
+			Expect(os.WriteFile(backupFile, []byte("nameserver 8.8.8.8\n"), 0600)).To(Succeed())
+		})
+
+		It("should revert the recorded changes and keep the state file in dry run", func() {
+			// given
+			Expect(transparentproxy.SaveState(stateFile, &transparentproxy.State{
+				Engine:           transparentproxy.IstioEngine,
+				IPTables:         true,
+				ResolvConfBackup: backupFile,
+			})).To(Succeed())
+			rootCmd := test.DefaultTestingRootCmd()
+			rootCmd.SetArgs([]string{"uninstall", "transparent-proxy", "--dry-run", "--state-file", stateFile})
+			rootCmd.SetOut(stdout)
+			rootCmd.SetErr(stderr)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stderr.String()).To(BeEmpty())
+			Expect(stdout.String()).To(ContainSubstring("nameserver 8.8.8.8"))
+			Expect(stdout.String()).To(ContainSubstring("iptables -t nat -D OUTPUT -p tcp -j"))
+			Expect(stdout.String()).To(ContainSubstring("Transparent proxy cleaned up successfully"))
+			// and
+			state, err := transparentproxy.LoadState(stateFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(state.IPTables).To(BeTrue())
+			Expect(state.ResolvConfBackup).To(Equal(backupFile))
+		})
+
+		It("should fail on the engine that is not supported", func() {
+			// given
+			Expect(transparentproxy.SaveState(stateFile, &transparentproxy.State{
+				Engine:   transparentproxy.EbpfEngine,
+				IPTables: true,
+			})).To(Succeed())
+			rootCmd := test.DefaultTestingRootCmd()
+			rootCmd.SetArgs([]string{"uninstall", "transparent-proxy", "--dry-run", "--state-file", stateFile})
+			rootCmd.SetOut(stdout)
+			rootCmd.SetErr(stderr)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("ebpf engine is not supported")))
+		})
+	})
 })
@@ -0,0 +1,18 @@
+package validate
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewValidateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	validateCmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate Kuma resources",
+		Long:  `Validate Kuma resources without applying them to the control plane.`,
+	}
+	// sub-commands
+	validateCmd.AddCommand(newValidateProxyTemplateCmd(pctx))
+	return validateCmd
+}
@@ -0,0 +1,193 @@
+package validate
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	util_envoy "github.com/kumahq/kuma/pkg/util/envoy"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+	"github.com/kumahq/kuma/pkg/xds/generator/modifications"
+)
+
+// sampleDataplane is the Dataplane that ProxyTemplate is rendered against when no Dataplane is passed.
+const sampleDataplane = `
+type: Dataplane
+name: sample
+networking:
+  address: 192.168.0.1
+  inbound:
+  - port: 80
+    servicePort: 8080
+    tags:
+      kuma.io/service: backend
+      kuma.io/protocol: http
+  transparentProxying:
+    redirectPortInbound: 15006
+    redirectPortOutbound: 15001
+`
+
+type validateProxyTemplateContext struct {
+	args struct {
+		file      string
+		dataplane string
+	}
+}
+
+func newValidateProxyTemplateCmd(_ *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &validateProxyTemplateContext{}
+	cmd := &cobra.Command{
+		Use:   "proxytemplate",
+		Short: "Validate ProxyTemplate and render Envoy resources generated with it",
+		Long: `Validate ProxyTemplate and render Envoy resources generated with it.
+
+ProxyTemplate is validated the same way the control plane validates it on apply. Then the imported profiles,
+raw resources and modifications are applied to a Dataplane and generated Envoy resources are validated and printed.
+The Dataplane doesn't have to match selectors of the ProxyTemplate. Without --dataplane the following Dataplane is used:
+` + sampleDataplane,
+		Example: `
+Validate ProxyTemplate against the sample Dataplane
+$ kumactl validate proxytemplate -f proxy-template.yaml
+
+Validate ProxyTemplate against the Dataplane from file
+$ kumactl validate proxytemplate -f proxy-template.yaml --dataplane dataplane.yaml
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			b, err := readFile(cmd, ctx.args.file)
+			if err != nil {
+				return err
+			}
+			res, err := rest_types.UnmarshallToCore(b)
+			if err != nil {
+				return errors.Wrap(err, "YAML contains invalid resource")
+			}
+			proxyTemplate, ok := res.(*core_mesh.ProxyTemplateResource)
+			if !ok {
+				return errors.Errorf("expected %s, got %s", core_mesh.ProxyTemplateType, res.Descriptor().Name)
+			}
+			if err := proxyTemplate.Validate(); err != nil {
+				return err
+			}
+
+			dataplaneBytes := []byte(sampleDataplane)
+			if ctx.args.dataplane != "" {
+				if dataplaneBytes, err = readFile(cmd, ctx.args.dataplane); err != nil {
+					return err
+				}
+			}
+			dataplane, err := parseDataplane(dataplaneBytes, proxyTemplate.GetMeta().GetMesh())
+			if err != nil {
+				return err
+			}
+
+			rs, err := render(proxyTemplate, dataplane)
+			if err != nil {
+				return err
+			}
+			resp, err := rs.List().ToDeltaDiscoveryResponse()
+			if err != nil {
+				return err
+			}
+			out, err := util_proto.ToYAML(resp)
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(out)
+			return err
+		},
+	}
+	cmd.Flags().StringVarP(&ctx.args.file, "file", "f", "", "Path to file with ProxyTemplate. Pass `-` to read from stdin")
+	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().StringVar(&ctx.args.dataplane, "dataplane", "", "Path to file with Dataplane that ProxyTemplate is rendered against. If not specified, the sample Dataplane is used")
+	return cmd
+}
+
+func readFile(cmd *cobra.Command, file string) ([]byte, error) {
+	if file == "-" {
+		return io.ReadAll(cmd.InOrStdin())
+	}
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while reading provided file")
+	}
+	return b, nil
+}
+
+func parseDataplane(b []byte, mesh string) (*core_mesh.DataplaneResource, error) {
+	res, err := rest_types.UnmarshallToCore(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "YAML contains invalid Dataplane")
+	}
+	dataplane, ok := res.(*core_mesh.DataplaneResource)
+	if !ok {
+		return nil, errors.Errorf("expected %s, got %s", core_mesh.DataplaneType, res.Descriptor().Name)
+	}
+	// the Dataplane is always rendered in the Mesh of ProxyTemplate
+	dataplane.SetMeta(&rest_types.ResourceMeta{
+		Type: string(core_mesh.DataplaneType),
+		Mesh: mesh,
+		Name: dataplane.GetMeta().GetName(),
+	})
+	if err := dataplane.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Dataplane is not valid")
+	}
+	return dataplane, nil
+}
+
+// render generates Envoy resources of the Dataplane the same way the control plane does
+// for a Mesh without any policies.
+func render(proxyTemplate *core_mesh.ProxyTemplateResource, dataplane *core_mesh.DataplaneResource) (*core_xds.ResourceSet, error) {
+	meshName := dataplane.GetMeta().GetMesh()
+	mesh := core_mesh.NewMeshResource()
+	mesh.SetMeta(&rest_types.ResourceMeta{
+		Type: string(core_mesh.MeshType),
+		Mesh: core_model.NoMesh,
+		Name: meshName,
+	})
+	ctx := xds_context.Context{
+		ControlPlane: &xds_context.ControlPlaneContext{},
+		Mesh: xds_context.MeshContext{
+			Resource: mesh,
+		},
+	}
+	proxy := &core_xds.Proxy{
+		Id:             *core_xds.BuildProxyId(meshName, dataplane.GetMeta().GetName()),
+		Dataplane:      dataplane,
+		SecretsTracker: core_xds.NewSecretsTracker(meshName, []string{meshName}),
+		APIVersion:     envoy_common.APIV3,
+		Metadata:       &core_xds.DataplaneMetadata{},
+	}
+
+	gen := generator.ProxyTemplateGenerator{ProxyTemplate: proxyTemplate.Spec}
+	rs, err := gen.Generate(ctx, proxy)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate resources")
+	}
+	if err := modifications.Apply(rs, proxyTemplate.Spec.GetConf().GetModifications(), proxy.APIVersion); err != nil {
+		return nil, errors.Wrap(err, "could not apply modifications")
+	}
+	for _, resource := range rs.List() {
+		if v, ok := resource.Resource.(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return nil, fmt.Errorf("resource %q is not valid: %w", resource.Name, err)
+			}
+		}
+		if err := util_envoy.ValidateTypedConfigs(proto.MessageV2(resource.Resource)); err != nil {
+			return nil, fmt.Errorf("resource %q is not valid: %w", resource.Name, err)
+		}
+	}
+	return rs, nil
+}
@@ -0,0 +1,70 @@
+package validate_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	test_kumactl "github.com/kumahq/kuma/pkg/test/kumactl"
+	. "github.com/kumahq/kuma/pkg/test/matchers"
+)
+
+var _ = Describe("kumactl validate proxytemplate", func() {
+
+	var buf *bytes.Buffer
+	var execute func(args ...string) error
+
+	BeforeEach(func() {
+		rootCtx, err := test_kumactl.MakeRootContext(time.Now(), nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		buf = &bytes.Buffer{}
+		execute = func(args ...string) error {
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"validate", "proxytemplate",
+			}, args...))
+			return rootCmd.Execute()
+		}
+	})
+
+	It("should render resources of the sample Dataplane", func() {
+		// when
+		err := execute("-f", filepath.Join("testdata", "proxytemplate.yaml"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(MatchGoldenYAML(filepath.Join("testdata", "proxytemplate.golden.yaml")))
+	})
+
+	It("should render resources of the given Dataplane", func() {
+		// when
+		err := execute("-f", filepath.Join("testdata", "proxytemplate.yaml"), "--dataplane", filepath.Join("testdata", "dataplane.yaml"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(MatchGoldenYAML(filepath.Join("testdata", "proxytemplate-dataplane.golden.yaml")))
+	})
+
+	It("should fail when ProxyTemplate is not valid", func() {
+		// when
+		err := execute("-f", filepath.Join("testdata", "proxytemplate-invalid.yaml"))
+
+		// then
+		Expect(err).To(MatchError("conf.modifications[0].networkFilter.value: native Envoy resource is not valid: typedConfig: invalid TcpProxy.StatPrefix: value length must be at least 1 runes"))
+	})
+
+	It("should fail when rendered resource is not valid", func() {
+		// when
+		err := execute("-f", filepath.Join("testdata", "proxytemplate-invalid-patch.yaml"))
+
+		// then
+		Expect(err).To(MatchError(`resource "localhost:8080" is not valid: invalid Cluster.ConnectTimeout: value must be greater than 0s`))
+	})
+})
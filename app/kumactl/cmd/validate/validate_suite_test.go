@@ -0,0 +1,11 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestValidateCmd(t *testing.T) {
+	test.RunSpecs(t, "Validate Cmd Suite")
+}
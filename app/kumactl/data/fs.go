@@ -56,6 +56,14 @@ func InstallDeprecatedTracingFS() fs.FS {
 	return fsys
 }
 
+func InstallObservabilityFS() fs.FS {
+	fsys, err := fs.Sub(InstallData, "install/k8s/observability")
+	if err != nil {
+		panic(err)
+	}
+	return fsys
+}
+
 func InstallDemoFS() fs.FS {
 	fsys, err := fs.Sub(InstallData, "install/k8s/demo")
 	if err != nil {
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+// completionCacheTTL is how long names of resources are reused between invocations of the completion.
+const completionCacheTTL = 10 * time.Second
+
+// completionTimeout limits how long the completion waits for the control plane, so the shell does not freeze.
+const completionTimeout = 5 * time.Second
+
+type CompletionFunc = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// CompleteResourceNames completes the first argument with names of the resources of the type in the current mesh.
+func CompleteResourceNames(rc *RootContext, desc core_model.ResourceTypeDescriptor) CompletionFunc {
+	return func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		meshName := ""
+		if desc.Scope == core_model.ScopeMesh {
+			meshName = rc.CurrentMesh()
+		}
+		return rc.completeResourceNames(desc, meshName, toComplete)
+	}
+}
+
+// CompleteMeshNames completes the value of a flag with names of meshes.
+func CompleteMeshNames(rc *RootContext) CompletionFunc {
+	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return rc.completeResourceNames(mesh.MeshResourceTypeDescriptor, "", toComplete)
+	}
+}
+
+// CompleteZoneNames completes the value of a flag with names of zones.
+func CompleteZoneNames(rc *RootContext) CompletionFunc {
+	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return rc.completeResourceNames(system.ZoneResourceTypeDescriptor, "", toComplete)
+	}
+}
+
+// RegisterMeshFlagCompletion completes the --mesh flag of the command and all its sub-commands with names of meshes.
+func RegisterMeshFlagCompletion(rc *RootContext, cmd *cobra.Command) {
+	if cmd.LocalFlags().Lookup("mesh") != nil {
+		_ = cmd.RegisterFlagCompletionFunc("mesh", CompleteMeshNames(rc))
+	}
+	for _, sub := range cmd.Commands() {
+		RegisterMeshFlagCompletion(rc, sub)
+	}
+}
+
+func (rc *RootContext) completeResourceNames(desc core_model.ResourceTypeDescriptor, meshName string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	// completion does not execute PersistentPreRunE of the root command, so the configuration has to be loaded here
+	if rc.Args.ConfigType == InMemory {
+		rc.LoadInMemoryConfig()
+	}
+	if err := rc.LoadConfig(); err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	client, err := rc.CurrentCompletionClient()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	names, err := client.ResourceNames(ctx, desc, meshName)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	var completions []string
+	for _, name := range names {
+		if strings.HasPrefix(name, toComplete) {
+			completions = append(completions, name)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"path/filepath"
 	"time"
 
 	"github.com/pkg/errors"
@@ -21,6 +22,7 @@ import (
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	oidc_cli "github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/cli"
 	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/tokens/cli"
 	util_files "github.com/kumahq/kuma/pkg/util/files"
 	util_http "github.com/kumahq/kuma/pkg/util/http"
@@ -39,6 +41,8 @@ type RootArgs struct {
 	ConfigType ConfigType
 	Mesh       string
 	ApiTimeout time.Duration
+	// EnvOverrides are overrides of the configuration from environment variables, loaded together with the configuration.
+	EnvOverrides config.EnvOverrides
 }
 
 type RootRuntime struct {
@@ -51,7 +55,9 @@ type RootRuntime struct {
 	NewDataplaneInspectClient    func(util_http.Client) kumactl_resources.DataplaneInspectClient
 	NewMeshGatewayInspectClient  func(util_http.Client) kumactl_resources.MeshGatewayInspectClient
 	NewInspectEnvoyProxyClient   func(core_model.ResourceTypeDescriptor, util_http.Client) kumactl_resources.InspectEnvoyProxyClient
+	NewMeshEnvoyConfigClient     func(util_http.Client) kumactl_resources.MeshEnvoyConfigClient
 	NewPolicyInspectClient       func(util_http.Client) kumactl_resources.PolicyInspectClient
+	NewMeshInsightsClient        func(util_http.Client) kumactl_resources.MeshInsightsClient
 	NewZoneIngressOverviewClient func(util_http.Client) kumactl_resources.ZoneIngressOverviewClient
 	NewZoneEgressOverviewClient  func(util_http.Client) kumactl_resources.ZoneEgressOverviewClient
 	NewZoneOverviewClient        func(util_http.Client) kumactl_resources.ZoneOverviewClient
@@ -60,6 +66,10 @@ type RootRuntime struct {
 	NewZoneIngressTokenClient    func(util_http.Client) tokens.ZoneIngressTokenClient
 	NewZoneTokenClient           func(util_http.Client) tokens.ZoneTokenClient
 	NewAPIServerClient           func(util_http.Client) kumactl_resources.ApiServerClient
+	NewResourceWatchClient       func(util_http.Client) kumactl_resources.ResourceWatchClient
+	NewCompletionClient          func(util_http.Client) kumactl_resources.CompletionClient
+	NewAuditLogClient            func(util_http.Client) kumactl_resources.AuditLogClient
+	NewApplyClient               func(util_http.Client) kumactl_resources.ApplyClient
 	Registry                     registry.TypeRegistry
 }
 
@@ -96,7 +106,8 @@ func DefaultRootContext() *RootContext {
 			Registry:               registry.Global(),
 			NewBaseAPIServerClient: client.ApiServerClient,
 			AuthnPlugins: map[string]plugins.AuthnPlugin{
-				cli.AuthType: &cli.TokenAuthnPlugin{},
+				cli.AuthType:      &cli.TokenAuthnPlugin{},
+				oidc_cli.AuthType: &oidc_cli.OIDCAuthnPlugin{},
 			},
 			NewResourceStore: func(client util_http.Client) core_store.ResourceStore {
 				return kumactl_resources.NewResourceStore(client, registry.Global().ObjectDescriptors())
@@ -105,7 +116,9 @@ func DefaultRootContext() *RootContext {
 			NewDataplaneInspectClient:    kumactl_resources.NewDataplaneInspectClient,
 			NewMeshGatewayInspectClient:  kumactl_resources.NewMeshGatewayInspectClient,
 			NewInspectEnvoyProxyClient:   kumactl_resources.NewInspectEnvoyProxyClient,
+			NewMeshEnvoyConfigClient:     kumactl_resources.NewMeshEnvoyConfigClient,
 			NewPolicyInspectClient:       kumactl_resources.NewPolicyInspectClient,
+			NewMeshInsightsClient:        kumactl_resources.NewMeshInsightsClient,
 			NewZoneIngressOverviewClient: kumactl_resources.NewZoneIngressOverviewClient,
 			NewZoneEgressOverviewClient:  kumactl_resources.NewZoneEgressOverviewClient,
 			NewZoneOverviewClient:        kumactl_resources.NewZoneOverviewClient,
@@ -114,6 +127,10 @@ func DefaultRootContext() *RootContext {
 			NewZoneIngressTokenClient:    tokens.NewZoneIngressTokenClient,
 			NewZoneTokenClient:           tokens.NewZoneTokenClient,
 			NewAPIServerClient:           kumactl_resources.NewAPIServerClient,
+			NewResourceWatchClient:       kumactl_resources.NewResourceWatchClient,
+			NewCompletionClient:          kumactl_resources.NewCompletionClient,
+			NewAuditLogClient:            kumactl_resources.NewAuditLogClient,
+			NewApplyClient:               kumactl_resources.NewApplyClient,
 		},
 		InstallCpContext:                    install_context.DefaultInstallCpContext(),
 		InstallCRDContext:                   install_context.DefaultInstallCrdsContext(),
@@ -129,6 +146,7 @@ func DefaultRootContext() *RootContext {
 }
 
 func (rc *RootContext) LoadConfig() error {
+	rc.Args.EnvOverrides = config.LoadEnvOverrides()
 	return config.Load(rc.Args.ConfigFile, &rc.Runtime.Config)
 }
 
@@ -145,6 +163,13 @@ func (rc *RootContext) LoadInMemoryConfig() {
 }
 
 func (rc *RootContext) CurrentContext() (*config_proto.Context, error) {
+	if name := rc.Args.EnvOverrides.Context; name != "" {
+		_, currentContext := rc.Config().GetContext(name)
+		if currentContext == nil {
+			return nil, errors.Errorf("context %q set in %s environment variable does not exist", name, config.ContextEnv)
+		}
+		return currentContext, nil
+	}
 	if rc.Config().CurrentContext == "" {
 		return nil, errors.Errorf("active Control Plane is not set. Use `kumactl config control-planes add` to add a Control Plane and make it active")
 	}
@@ -164,7 +189,7 @@ func (rc *RootContext) CurrentControlPlane() (*config_proto.ControlPlane, error)
 	if controlPlane == nil {
 		return nil, errors.Errorf("apparently, configuration is broken. Use `kumactl config control-planes add` to add a Control Plane and make it active")
 	}
-	return controlPlane, nil
+	return rc.Args.EnvOverrides.Apply(controlPlane), nil
 }
 
 func (rc *RootContext) CurrentMesh() string {
@@ -179,11 +204,15 @@ func (rc *RootContext) Now() time.Time {
 }
 
 func (rc *RootContext) BaseAPIServerClient() (util_http.Client, error) {
+	return rc.apiServerClient(rc.Args.ApiTimeout)
+}
+
+func (rc *RootContext) apiServerClient(timeout time.Duration) (util_http.Client, error) {
 	controlPlane, err := rc.CurrentControlPlane()
 	if err != nil {
 		return nil, err
 	}
-	client, err := rc.Runtime.NewBaseAPIServerClient(controlPlane.Coordinates.ApiServer, rc.Args.ApiTimeout)
+	client, err := rc.Runtime.NewBaseAPIServerClient(controlPlane.Coordinates.ApiServer, timeout)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create a client for Control Plane %q", controlPlane.Name)
 	}
@@ -193,7 +222,20 @@ func (rc *RootContext) BaseAPIServerClient() (util_http.Client, error) {
 		if !ok {
 			return nil, errors.Errorf("authentication plugin of type %q not found", controlPlane.Coordinates.ApiServer.AuthType)
 		}
-		client, err = plugin.DecorateClient(client, controlPlane.Coordinates.ApiServer.AuthConf)
+		authConf := controlPlane.Coordinates.ApiServer.AuthConf
+		if refresher, ok := plugin.(plugins.AuthnRefresher); ok {
+			refreshed, err := refresher.Refresh(authConf)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to refresh credentials of authentication type %q", controlPlane.Coordinates.ApiServer.AuthType)
+			}
+			if refreshed != nil {
+				authConf = refreshed
+				if err := rc.saveAuthConf(controlPlane.Name, refreshed); err != nil {
+					return nil, errors.Wrap(err, "failed to save refreshed credentials")
+				}
+			}
+		}
+		client, err = plugin.DecorateClient(client, authConf)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to decorate client with authentication type %q", controlPlane.Coordinates.ApiServer.AuthType)
 		}
@@ -202,6 +244,16 @@ func (rc *RootContext) BaseAPIServerClient() (util_http.Client, error) {
 	return client, nil
 }
 
+// saveAuthConf persists refreshed credentials, so the next invocations of kumactl do not have to refresh them again.
+func (rc *RootContext) saveAuthConf(controlPlaneName string, authConf map[string]string) error {
+	_, controlPlane := rc.Config().GetControlPlane(controlPlaneName)
+	if controlPlane.GetCoordinates().GetApiServer() == nil || rc.Args.ConfigType == InMemory {
+		return nil
+	}
+	controlPlane.Coordinates.ApiServer.AuthConf = authConf
+	return rc.SaveConfig()
+}
+
 func (rc *RootContext) CurrentResourceStore() (core_store.ResourceStore, error) {
 	client, err := rc.BaseAPIServerClient()
 	if err != nil {
@@ -210,6 +262,38 @@ func (rc *RootContext) CurrentResourceStore() (core_store.ResourceStore, error)
 	return rc.Runtime.NewResourceStore(client), nil
 }
 
+// CurrentResourceWatchClient returns the client without the timeout of the API requests, the watch lasts until it is interrupted.
+func (rc *RootContext) CurrentResourceWatchClient() (kumactl_resources.ResourceWatchClient, error) {
+	client, err := rc.apiServerClient(0)
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewResourceWatchClient(client), nil
+}
+
+// CurrentCompletionClient returns the client that caches names of resources next to the configuration file.
+// The in-memory configuration is not persisted, so neither are the names.
+func (rc *RootContext) CurrentCompletionClient() (kumactl_resources.CompletionClient, error) {
+	controlPlane, err := rc.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	completionClient := rc.Runtime.NewCompletionClient(client)
+	if rc.Args.ConfigType == InMemory {
+		return completionClient, nil
+	}
+	configFile := config.DefaultConfigFile
+	if rc.Args.ConfigFile != "" {
+		configFile = rc.Args.ConfigFile
+	}
+	cacheDir := filepath.Join(filepath.Dir(configFile), "cache", "completion")
+	return kumactl_resources.NewCachingCompletionClient(completionClient, cacheDir, controlPlane.Name, completionCacheTTL, rc.Now), nil
+}
+
 func (rc *RootContext) CurrentDataplaneOverviewClient() (kumactl_resources.DataplaneOverviewClient, error) {
 	client, err := rc.BaseAPIServerClient()
 	if err != nil {
@@ -242,6 +326,24 @@ func (rc *RootContext) CurrentInspectEnvoyProxyClient(resDesc core_model.Resourc
 	return rc.Runtime.NewInspectEnvoyProxyClient(resDesc, client), nil
 }
 
+// CurrentStreamingInspectEnvoyProxyClient returns the client without the timeout of the API requests,
+// so streams like the tap session last until they are interrupted.
+func (rc *RootContext) CurrentStreamingInspectEnvoyProxyClient(resDesc core_model.ResourceTypeDescriptor) (kumactl_resources.InspectEnvoyProxyClient, error) {
+	client, err := rc.apiServerClient(0)
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewInspectEnvoyProxyClient(resDesc, client), nil
+}
+
+func (rc *RootContext) CurrentMeshEnvoyConfigClient() (kumactl_resources.MeshEnvoyConfigClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewMeshEnvoyConfigClient(client), nil
+}
+
 func (rc *RootContext) CurrentPolicyInspectClient() (kumactl_resources.PolicyInspectClient, error) {
 	client, err := rc.BaseAPIServerClient()
 	if err != nil {
@@ -250,6 +352,30 @@ func (rc *RootContext) CurrentPolicyInspectClient() (kumactl_resources.PolicyIns
 	return rc.Runtime.NewPolicyInspectClient(client), nil
 }
 
+func (rc *RootContext) CurrentMeshInsightsClient() (kumactl_resources.MeshInsightsClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewMeshInsightsClient(client), nil
+}
+
+func (rc *RootContext) CurrentAuditLogClient() (kumactl_resources.AuditLogClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewAuditLogClient(client), nil
+}
+
+func (rc *RootContext) CurrentApplyClient() (kumactl_resources.ApplyClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewApplyClient(client), nil
+}
+
 func (rc *RootContext) CurrentZoneOverviewClient() (kumactl_resources.ZoneOverviewClient, error) {
 	client, err := rc.BaseAPIServerClient()
 	if err != nil {
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"os"
+
+	"google.golang.org/protobuf/proto"
+
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/tokens/cli"
+)
+
+// Environment variables that override the configuration for a single invocation of kumactl.
+// They are never saved to the configuration file.
+const (
+	ContextEnv        = "KUMACTL_CONTEXT"
+	AddressEnv        = "KUMACTL_CONTROL_PLANE_ADDRESS"
+	AuthTokenEnv      = "KUMACTL_AUTH_TOKEN"
+	CaCertFileEnv     = "KUMACTL_CA_CERT_FILE"
+	ClientCertFileEnv = "KUMACTL_CLIENT_CERT_FILE"
+	ClientKeyFileEnv  = "KUMACTL_CLIENT_KEY_FILE"
+)
+
+type EnvOverrides struct {
+	Context        string
+	Address        string
+	AuthToken      string
+	CaCertFile     string
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+func LoadEnvOverrides() EnvOverrides {
+	return EnvOverrides{
+		Context:        os.Getenv(ContextEnv),
+		Address:        os.Getenv(AddressEnv),
+		AuthToken:      os.Getenv(AuthTokenEnv),
+		CaCertFile:     os.Getenv(CaCertFileEnv),
+		ClientCertFile: os.Getenv(ClientCertFileEnv),
+		ClientKeyFile:  os.Getenv(ClientKeyFileEnv),
+	}
+}
+
+// Apply returns a copy of the Control Plane with the overrides applied, so the configuration stays untouched.
+func (o EnvOverrides) Apply(cp *config_proto.ControlPlane) *config_proto.ControlPlane {
+	cp = proto.Clone(cp).(*config_proto.ControlPlane)
+	if cp.Coordinates == nil {
+		cp.Coordinates = &config_proto.ControlPlaneCoordinates{}
+	}
+	if cp.Coordinates.ApiServer == nil {
+		cp.Coordinates.ApiServer = &config_proto.ControlPlaneCoordinates_ApiServer{}
+	}
+	apiServer := cp.Coordinates.ApiServer
+	if o.Address != "" {
+		apiServer.Url = o.Address
+	}
+	if o.AuthToken != "" {
+		apiServer.AuthType = cli.AuthType
+		apiServer.AuthConf = map[string]string{
+			cli.TokenKey: o.AuthToken,
+		}
+	}
+	if o.CaCertFile != "" {
+		apiServer.CaCertFile = o.CaCertFile
+	}
+	if o.ClientCertFile != "" {
+		apiServer.ClientCertFile = o.ClientCertFile
+	}
+	if o.ClientKeyFile != "" {
+		apiServer.ClientKeyFile = o.ClientKeyFile
+	}
+	return cp
+}
@@ -0,0 +1,132 @@
+package errors
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// Code is a stable identifier of the type of the failure, so scripts can branch on it without parsing messages.
+type Code string
+
+const (
+	CodeUnknown      Code = "unknown"
+	CodeValidation   Code = "validation"
+	CodeNotFound     Code = "not-found"
+	CodeConflict     Code = "conflict"
+	CodeUnauthorized Code = "unauthorized"
+)
+
+// ExitCode is the exit code of kumactl process that failed with the error of the code.
+func (c Code) ExitCode() int {
+	switch c {
+	case CodeValidation:
+		return 2
+	case CodeNotFound:
+		return 3
+	case CodeConflict:
+		return 4
+	case CodeUnauthorized:
+		return 5
+	default:
+		return 1
+	}
+}
+
+// Error is the error returned by kumactl commands. It's printed as a JSON object when the command is executed with --output json.
+type Error struct {
+	Code    Code          `json:"code"`
+	Message string        `json:"message"`
+	Causes  []types.Cause `json:"causes,omitempty"`
+
+	err error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// NewValidationError marks the error as caused by invalid input of the user, e.g. invalid flags.
+func NewValidationError(err error) error {
+	return &Error{Code: CodeValidation, Message: err.Error(), err: err}
+}
+
+// CodeOf returns the code of the error. Errors of the API server are classified by the status of the response.
+func CodeOf(err error) Code {
+	var kumactlErr *Error
+	if errors.As(err, &kumactlErr) {
+		return kumactlErr.Code
+	}
+	var apiErr *types.Error
+	if errors.As(err, &apiErr) {
+		return codeOfStatus(apiErr)
+	}
+	// store errors are recognized by their messages, so the context added by wrapping has to be removed
+	cause := errors.Cause(err)
+	switch {
+	case store.IsResourceNotFound(cause):
+		return CodeNotFound
+	case store.IsResourceAlreadyExists(cause), store.IsResourceConflict(cause), store.IsResourcePreconditionFailed(cause):
+		return CodeConflict
+	case validators.IsValidationError(cause):
+		return CodeValidation
+	default:
+		return CodeUnknown
+	}
+}
+
+func codeOfStatus(apiErr *types.Error) Code {
+	switch apiErr.Status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return CodeValidation
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return CodeUnauthorized
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return CodeConflict
+	case 0:
+		// the status is not known when the error was not received from the API server
+		if len(apiErr.Causes) > 0 {
+			return CodeValidation
+		}
+	}
+	return CodeUnknown
+}
+
+// ExitCode returns the exit code of kumactl process that failed with the error.
+func ExitCode(err error) int {
+	return CodeOf(err).ExitCode()
+}
+
+// PrintError prints the error of the executed command to its error output. The error is printed as a JSON object
+// when the command has --output flag set to json, otherwise it's printed the same way as by cobra.
+func PrintError(cmd *cobra.Command, err error) {
+	if flag := cmd.Flags().Lookup("output"); flag != nil && flag.Value.String() == "json" {
+		if printErr := printJSON(cmd.ErrOrStderr(), err); printErr == nil {
+			return
+		}
+	}
+	cmd.PrintErrln("Error:", err.Error())
+}
+
+func printJSON(out io.Writer, err error) error {
+	kumactlErr := &Error{}
+	if !errors.As(err, &kumactlErr) {
+		kumactlErr = &Error{Code: CodeOf(err), Message: err.Error()}
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(kumactlErr)
+}
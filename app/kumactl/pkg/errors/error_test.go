@@ -0,0 +1,147 @@
+package errors_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_errors "github.com/kumahq/kuma/app/kumactl/pkg/errors"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+)
+
+var _ = Describe("Error", func() {
+
+	type testCase struct {
+		err      error
+		code     kumactl_errors.Code
+		exitCode int
+	}
+	DescribeTable("should classify errors",
+		func(given testCase) {
+			fn := kumactl_errors.FormatErrorWrapper(func(_ *cobra.Command, _ []string) error {
+				return given.err
+			})
+
+			// when
+			err := fn(nil, nil)
+
+			// then
+			Expect(kumactl_errors.CodeOf(err)).To(Equal(given.code))
+			Expect(kumactl_errors.ExitCode(err)).To(Equal(given.exitCode))
+		},
+		Entry("invalid resource", testCase{
+			err: &types.Error{
+				Title:   "Could not process the resource",
+				Details: "Resource is not valid",
+				Causes:  []types.Cause{{Field: "mesh", Message: "cannot be empty"}},
+				Status:  400,
+			},
+			code:     kumactl_errors.CodeValidation,
+			exitCode: 2,
+		}),
+		Entry("resource not found by the api server", testCase{
+			err: errors.Wrap(&types.Error{
+				Title:   "Could not retrieve a resource",
+				Details: "Not found",
+				Status:  404,
+			}, "failed"),
+			code:     kumactl_errors.CodeNotFound,
+			exitCode: 3,
+		}),
+		Entry("resource not found by the store", testCase{
+			err:      errors.Wrap(store.ErrorResourceNotFound(core_mesh.MeshType, "demo", ""), "failed to get mesh"),
+			code:     kumactl_errors.CodeNotFound,
+			exitCode: 3,
+		}),
+		Entry("conflict", testCase{
+			err: &types.Error{
+				Title:   "Could not update a resource",
+				Details: "Conflict",
+				Status:  409,
+			},
+			code:     kumactl_errors.CodeConflict,
+			exitCode: 4,
+		}),
+		Entry("access denied", testCase{
+			err: &types.Error{
+				Title:   "Access Denied",
+				Details: "action not allowed",
+				Status:  403,
+			},
+			code:     kumactl_errors.CodeUnauthorized,
+			exitCode: 5,
+		}),
+		Entry("invalid flags", testCase{
+			err:      kumactl_errors.NewValidationError(errors.New("--size has to be positive")),
+			code:     kumactl_errors.CodeValidation,
+			exitCode: 2,
+		}),
+		Entry("unknown error", testCase{
+			err:      errors.New("connection refused"),
+			code:     kumactl_errors.CodeUnknown,
+			exitCode: 1,
+		}),
+	)
+
+	Describe("PrintError", func() {
+		var cmd *cobra.Command
+		var buf *bytes.Buffer
+
+		BeforeEach(func() {
+			buf = &bytes.Buffer{}
+			cmd = &cobra.Command{}
+			cmd.Flags().StringP("output", "o", "table", "")
+			cmd.SetErr(buf)
+		})
+
+		err := &types.Error{
+			Title:   "Could not process the resource",
+			Details: "Resource is not valid",
+			Causes:  []types.Cause{{Field: "mesh", Message: "cannot be empty"}},
+			Status:  400,
+		}
+
+		It("should print error as text", func() {
+			// when
+			kumactl_errors.PrintError(cmd, kumactl_errors.FormatErrorWrapper(func(_ *cobra.Command, _ []string) error {
+				return err
+			})(nil, nil))
+
+			// then
+			Expect(buf.String()).To(Equal("Error: Could not process the resource (Resource is not valid)\n* mesh: cannot be empty\n"))
+		})
+
+		It("should print error as json", func() {
+			// given
+			Expect(cmd.Flags().Set("output", "json")).To(Succeed())
+
+			// when
+			kumactl_errors.PrintError(cmd, kumactl_errors.FormatErrorWrapper(func(_ *cobra.Command, _ []string) error {
+				return err
+			})(nil, nil))
+
+			// then
+			Expect(buf.String()).To(MatchJSON(`{
+				"code": "validation",
+				"message": "Could not process the resource (Resource is not valid)\n* mesh: cannot be empty",
+				"causes": [{"field": "mesh", "message": "cannot be empty"}]
+			}`))
+		})
+
+		It("should print error that was not formatted as json", func() {
+			// given
+			Expect(cmd.Flags().Set("output", "json")).To(Succeed())
+
+			// when
+			kumactl_errors.PrintError(cmd, errors.New("unknown flag: --bogus"))
+
+			// then
+			Expect(buf.String()).To(MatchJSON(`{"code": "unknown", "message": "unknown flag: --bogus"}`))
+		})
+	})
+})
@@ -12,12 +12,17 @@ import (
 func FormatErrorWrapper(fn func(*cobra.Command, []string) error) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		if err := fn(cmd, args); err != nil {
+			var kumactlErr *Error
+			if errors.As(err, &kumactlErr) {
+				// the error was already formatted, e.g. by the parent command
+				return err
+			}
 			cause := errors.Cause(err)
 			switch typedErr := cause.(type) {
 			case *types.Error:
 				return formatApiServerError(typedErr)
 			default:
-				return err
+				return &Error{Code: CodeOf(err), Message: err.Error(), err: err}
 			}
 		}
 		return nil
@@ -29,5 +34,5 @@ func formatApiServerError(apiErr *types.Error) error {
 	for _, cause := range apiErr.Causes {
 		msg += fmt.Sprintf("\n* %s: %s", cause.Field, cause.Message)
 	}
-	return errors.New(msg)
+	return &Error{Code: codeOfStatus(apiErr), Message: msg, Causes: apiErr.Causes, err: apiErr}
 }
@@ -6,4 +6,8 @@ const (
 	TableFormat Format = "table"
 	YAMLFormat  Format = "yaml"
 	JSONFormat  Format = "json"
+	// CustomColumnsFormat is used as custom-columns=HEADER:PATH[,HEADER:PATH]
+	CustomColumnsFormat Format = "custom-columns"
+	// JSONPathFormat is used as jsonpath=TEMPLATE
+	JSONPathFormat Format = "jsonpath"
 )
@@ -0,0 +1,11 @@
+package jsonpath_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestJSONPath(t *testing.T) {
+	test.RunSpecs(t, "JSONPath Suite")
+}
@@ -0,0 +1,49 @@
+package jsonpath_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/jsonpath"
+)
+
+var _ = Describe("printer", func() {
+
+	obj := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"name": "web-1", "port": 8080},
+			map[string]interface{}{"name": "web-2", "port": 8081},
+		},
+	}
+
+	DescribeTable("should print the object through the template",
+		func(template string, expected string) {
+			// given
+			printer, err := jsonpath.NewPrinter(template)
+			Expect(err).ToNot(HaveOccurred())
+			buf := &bytes.Buffer{}
+
+			// when
+			err = printer.Print(obj, buf)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(expected))
+		},
+		Entry("list of names", "{.items[*].name}", "web-1 web-2"),
+		Entry("template without braces", ".items[0].port", "8080"),
+		Entry("quoted template", "'{.items[1].name}'", "web-2"),
+		Entry("range", `{range .items[*]}{.name}:{.port}{"\n"}{end}`, "web-1:8080\nweb-2:8081\n"),
+		Entry("missing key", "{.items[0].address}", ""),
+	)
+
+	It("should return error on invalid template", func() {
+		// when
+		_, err := jsonpath.NewPrinter("{.items[}")
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
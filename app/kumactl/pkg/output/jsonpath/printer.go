@@ -0,0 +1,102 @@
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+)
+
+// NewPrinter returns the printer that prints the object through the JSONPath template, e.g. {.items[*].name}.
+// The template is applied to the JSON representation of the object, so paths are the same as in the output of -o json.
+// Like kubectl, no new line is added after the output of the template.
+func NewPrinter(template string) (output.Printer, error) {
+	path, err := Parse(template)
+	if err != nil {
+		return nil, err
+	}
+	return &printer{path: path}, nil
+}
+
+var _ output.Printer = &printer{}
+
+type printer struct {
+	path *jsonpath.JSONPath
+}
+
+func (p *printer) Print(obj interface{}, out io.Writer) error {
+	data, err := ToGeneric(obj)
+	if err != nil {
+		return err
+	}
+	return p.path.Execute(out, data)
+}
+
+// Parse parses the JSONPath template. Surrounding quotes are trimmed and a template
+// without braces is wrapped in them, so both ".name" and "{.name}" are accepted.
+func Parse(template string) (*jsonpath.JSONPath, error) {
+	template = strings.TrimSpace(template)
+	if len(template) >= 2 && (template[0] == '\'' || template[0] == '"') && template[len(template)-1] == template[0] {
+		template = template[1 : len(template)-1]
+	}
+	if template == "" {
+		return nil, errors.New("JSONPath template can't be empty")
+	}
+	if !strings.Contains(template, "{") {
+		template = "{" + template + "}"
+	}
+	path := jsonpath.New("output").AllowMissingKeys(true)
+	if err := path.Parse(template); err != nil {
+		return nil, errors.Wrapf(err, "invalid JSONPath template %q", template)
+	}
+	return path, nil
+}
+
+// Values returns values matched by the path in the data, formatted as text.
+// Strings are returned as they are, other values are encoded as compact JSON.
+func Values(path *jsonpath.JSONPath, data interface{}) ([]string, error) {
+	results, err := path.FindResults(data)
+	if err != nil {
+		return nil, err
+	}
+	var values []string
+	for _, result := range results {
+		for _, value := range result {
+			if !value.IsValid() || (value.Kind() == reflect.Interface && value.IsNil()) {
+				continue
+			}
+			v := value.Interface()
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+				continue
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, string(b))
+		}
+	}
+	return values, nil
+}
+
+// ToGeneric converts the object to maps and slices as they would be decoded from its JSON representation.
+func ToGeneric(obj interface{}) (interface{}, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
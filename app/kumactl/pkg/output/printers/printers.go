@@ -1,10 +1,13 @@
 package printers
 
 import (
+	"strings"
+
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/json"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/jsonpath"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
 	"github.com/kumahq/kuma/app/kumactl/pkg/output/yaml"
 )
@@ -21,6 +24,14 @@ func NewGenericPrinter(format output.Format) (output.Printer, error) {
 		return json.NewPrinter(), nil
 	case output.YAMLFormat:
 		return yaml.NewPrinter(), nil
+	}
+	// formats with an argument, e.g. jsonpath={.name}
+	name, arg, _ := strings.Cut(string(format), "=")
+	switch output.Format(name) {
+	case output.CustomColumnsFormat:
+		return table.NewCustomColumnsPrinter(arg)
+	case output.JSONPathFormat:
+		return jsonpath.NewPrinter(arg)
 	default:
 		return nil, errors.Errorf("unknown output format %q", format)
 	}
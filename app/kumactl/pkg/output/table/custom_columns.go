@@ -0,0 +1,94 @@
+package table
+
+import (
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	k8s_jsonpath "k8s.io/client-go/util/jsonpath"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/jsonpath"
+)
+
+type column struct {
+	header string
+	path   *k8s_jsonpath.JSONPath
+}
+
+// NewCustomColumnsPrinter returns the printer that prints a row per item of the list with columns
+// defined by the spec in format of HEADER:PATH[,HEADER:PATH], e.g. NAME:.name,SERVICE:.networking.inbound[0].tags.
+// Paths are JSONPath expressions evaluated on the JSON representation of the item.
+func NewCustomColumnsPrinter(spec string) (output.Printer, error) {
+	if spec == "" {
+		return nil, errors.New("custom-columns format requires columns in format of HEADER:PATH[,HEADER:PATH]")
+	}
+	var columns []column
+	for _, def := range strings.Split(spec, ",") {
+		parts := strings.SplitN(def, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("invalid custom column %q, expected format HEADER:PATH", def)
+		}
+		path, err := jsonpath.Parse(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, column{header: parts[0], path: path})
+	}
+	return &customColumnsPrinter{columns: columns}, nil
+}
+
+var _ output.Printer = &customColumnsPrinter{}
+
+type customColumnsPrinter struct {
+	columns []column
+}
+
+func (p *customColumnsPrinter) Print(obj interface{}, out io.Writer) error {
+	data, err := jsonpath.ToGeneric(obj)
+	if err != nil {
+		return err
+	}
+	// a list is printed as a row per item, a single object as a single row
+	items := []interface{}{data}
+	if list, ok := data.(map[string]interface{}); ok {
+		if listItems, ok := list["items"].([]interface{}); ok {
+			items = listItems
+		}
+	}
+
+	var headers []string
+	for _, c := range p.columns {
+		headers = append(headers, c.header)
+	}
+	var rows [][]string
+	for _, item := range items {
+		var row []string
+		for _, c := range p.columns {
+			values, err := jsonpath.Values(c.path, item)
+			if err != nil {
+				return err
+			}
+			if len(values) == 0 {
+				row = append(row, "<none>")
+			} else {
+				row = append(row, strings.Join(values, ","))
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return NewPrinter().Print(Table{
+		Headers: headers,
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rows) <= i {
+					return nil
+				}
+				return rows[i]
+			}
+		}(),
+	}, out)
+}
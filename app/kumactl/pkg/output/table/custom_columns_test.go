@@ -0,0 +1,62 @@
+package table_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/output/table"
+)
+
+var _ = Describe("custom columns printer", func() {
+
+	It("should print a row per item of the list", func() {
+		// given
+		printer, err := table.NewCustomColumnsPrinter("NAME:.name,TAGS:.tags,ADDRESS:.address")
+		Expect(err).ToNot(HaveOccurred())
+		buf := &bytes.Buffer{}
+
+		// when
+		err = printer.Print(map[string]interface{}{
+			"items": []interface{}{
+				map[string]interface{}{"name": "web-1", "tags": map[string]string{"service": "web"}, "address": "192.168.0.1"},
+				map[string]interface{}{"name": "backend-1"},
+			},
+		}, buf)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("" +
+			"NAME        TAGS                ADDRESS\n" +
+			"web-1       {\"service\":\"web\"}   192.168.0.1\n" +
+			"backend-1   <none>              <none>\n"))
+	})
+
+	It("should print a single object as a row", func() {
+		// given
+		printer, err := table.NewCustomColumnsPrinter("NAME:{.name}")
+		Expect(err).ToNot(HaveOccurred())
+		buf := &bytes.Buffer{}
+
+		// when
+		err = printer.Print(map[string]interface{}{"name": "web-1"}, buf)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("NAME\nweb-1\n"))
+	})
+
+	DescribeTable("should return error on invalid spec",
+		func(spec string, expectedErr string) {
+			// when
+			_, err := table.NewCustomColumnsPrinter(spec)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("empty spec", "", "custom-columns format requires columns"),
+		Entry("column without path", "NAME", `invalid custom column "NAME"`),
+		Entry("invalid path", "NAME:.items[", "invalid JSONPath template"),
+	)
+})
@@ -0,0 +1,124 @@
+package tap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_tap "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
+	"github.com/pkg/errors"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// Format is the format in which the captured traffic is printed.
+type Format string
+
+const (
+	TextFormat Format = "text"
+	JSONFormat Format = "json"
+)
+
+// PrintEntries prints the stream of the traffic captured by the proxy. Every entry of the stream is a JSON-encoded
+// envoy.data.tap.v3.TraceWrapper. Entries are printed until the stream ends, ctx is done or count entries are printed.
+// 0 count means that all entries are printed.
+func PrintEntries(ctx context.Context, stream io.Reader, out io.Writer, format Format, count int) error {
+	decoder := json.NewDecoder(stream)
+	for i := 0; count == 0 || i < count; i++ {
+		var entry json.RawMessage
+		if err := decoder.Decode(&entry); err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "could not read the captured traffic")
+		}
+		if err := printEntry(out, entry, format); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printEntry(out io.Writer, entry json.RawMessage, format Format) error {
+	if format == JSONFormat {
+		_, err := fmt.Fprintln(out, string(entry))
+		return err
+	}
+	trace := &envoy_data_tap.TraceWrapper{}
+	if err := util_proto.FromJSON(entry, trace); err != nil {
+		return errors.Wrap(err, "could not parse the captured traffic")
+	}
+	buffered := trace.GetHttpBufferedTrace()
+	if buffered == nil {
+		// only buffered HTTP traces are produced by the tap filter of Kuma, we print other traces as they are
+		_, err := fmt.Fprintln(out, string(entry))
+		return err
+	}
+
+	var sb strings.Builder
+	request, response := buffered.GetRequest(), buffered.GetResponse()
+	fmt.Fprintf(&sb, "%s %s%s %s\n",
+		headerValue(request.GetHeaders(), ":method"),
+		headerValue(request.GetHeaders(), ":authority"),
+		headerValue(request.GetHeaders(), ":path"),
+		headerValue(response.GetHeaders(), ":status"),
+	)
+	writeMessage(&sb, "> ", request)
+	writeMessage(&sb, "< ", response)
+	sb.WriteString("\n")
+	_, err := io.WriteString(out, sb.String())
+	return err
+}
+
+func writeMessage(sb *strings.Builder, prefix string, message *envoy_data_tap.HttpBufferedTrace_Message) {
+	for _, header := range message.GetHeaders() {
+		fmt.Fprintf(sb, "%s%s: %s\n", prefix, header.GetKey(), header.GetValue())
+	}
+	if body := message.GetBody(); body != nil {
+		sb.WriteString(strings.TrimSpace(prefix) + "\n")
+		content := body.GetAsBytes()
+		if content == nil {
+			content = []byte(body.GetAsString())
+		}
+		if isText(content) {
+			for _, line := range strings.Split(strings.TrimSuffix(string(content), "\n"), "\n") {
+				fmt.Fprintf(sb, "%s%s\n", prefix, line)
+			}
+		} else {
+			fmt.Fprintf(sb, "%s(%d bytes of binary data)\n", prefix, len(content))
+		}
+		if body.GetTruncated() {
+			fmt.Fprintf(sb, "%s(truncated)\n", prefix)
+		}
+	}
+	for _, trailer := range message.GetTrailers() {
+		fmt.Fprintf(sb, "%s%s: %s\n", prefix, trailer.GetKey(), trailer.GetValue())
+	}
+}
+
+// isText returns true when the body can be printed to the terminal as it is.
+func isText(content []byte) bool {
+	if !utf8.Valid(content) {
+		return false
+	}
+	for _, r := range string(content) {
+		if unicode.IsControl(r) && r != '\n' && r != '\r' && r != '\t' {
+			return false
+		}
+	}
+	return true
+}
+
+func headerValue(headers []*envoy_core.HeaderValue, name string) string {
+	for _, header := range headers {
+		if header.GetKey() == name {
+			return header.GetValue()
+		}
+	}
+	return ""
+}
@@ -6,3 +6,9 @@ type AuthnPlugin interface {
 	Validate(map[string]string) error
 	DecorateClient(util_http.Client, map[string]string) (util_http.Client, error)
 }
+
+// AuthnRefresher is implemented by authentication plugins with credentials that expire and can be refreshed.
+type AuthnRefresher interface {
+	// Refresh returns the authentication configuration with refreshed credentials or nil if the credentials are still valid.
+	Refresh(map[string]string) (map[string]string, error)
+}
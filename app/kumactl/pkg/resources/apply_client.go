@@ -0,0 +1,60 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+// ApplyClient applies resources atomically, either all of them are created or updated or none of them is.
+type ApplyClient interface {
+	Apply(ctx context.Context, resources []model.Resource) (api_server_types.ApplyResponse, error)
+}
+
+func NewApplyClient(client util_http.Client) ApplyClient {
+	return &httpApplyClient{
+		Client: client,
+	}
+}
+
+type httpApplyClient struct {
+	Client util_http.Client
+}
+
+func (h *httpApplyClient) Apply(ctx context.Context, resources []model.Resource) (api_server_types.ApplyResponse, error) {
+	items := make([]*rest.Resource, 0, len(resources))
+	for _, res := range resources {
+		items = append(items, rest.From.Resource(res))
+	}
+	body, err := json.Marshal(struct {
+		Items []*rest.Resource `json:"items"`
+	}{Items: items})
+	if err != nil {
+		return api_server_types.ApplyResponse{}, err
+	}
+	req, err := http.NewRequest("POST", "/apply", bytes.NewReader(body))
+	if err != nil {
+		return api_server_types.ApplyResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	statusCode, b, err := doRequest(h.Client, ctx, req)
+	if err != nil {
+		return api_server_types.ApplyResponse{}, err
+	}
+	if statusCode != 200 {
+		return api_server_types.ApplyResponse{}, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	response := api_server_types.ApplyResponse{}
+	if err := json.Unmarshal(b, &response); err != nil {
+		return api_server_types.ApplyResponse{}, err
+	}
+	return response, nil
+}
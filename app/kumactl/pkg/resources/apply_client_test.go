@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	error_types "github.com/kumahq/kuma/pkg/core/rest/errors/types"
+)
+
+var _ = Describe("ApplyClient", func() {
+	It("should send all resources in a single request", func() {
+		// given
+		client := httpApplyClient{
+			Client: &http.Client{
+				Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					Expect(req.Method).To(Equal("POST"))
+					Expect(req.URL.Path).To(Equal("/apply"))
+					body, err := io.ReadAll(req.Body)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(body).To(MatchJSON(`
+					{
+						"items": [
+							{"type": "Mesh", "name": "demo", "revision": "3", "creationTime": "0001-01-01T00:00:00Z", "modificationTime": "0001-01-01T00:00:00Z"}
+						]
+					}`))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"items": [{"type": "Mesh", "name": "demo", "revision": "4", "operation": "updated"}]}`)),
+					}, nil
+				}),
+			},
+		}
+		res := mesh.NewMeshResource()
+		res.SetMeta(&rest.ResourceMeta{Type: string(mesh.MeshType), Name: "demo", Revision: "3"})
+
+		// when
+		response, err := client.Apply(context.Background(), []core_model.Resource{res})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(Equal(api_server_types.ApplyResponse{
+			Items: []api_server_types.ApplyResult{
+				{Type: "Mesh", Name: "demo", Revision: "4", Operation: api_server_types.ApplyOperationUpdated},
+			},
+		}))
+	})
+
+	It("should return the error of the control plane", func() {
+		// given
+		client := httpApplyClient{
+			Client: &http.Client{
+				Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusNotImplemented,
+						Body:       io.NopCloser(strings.NewReader(`{"title": "Could not apply resources", "details": "Transactions are not supported by the store of the control plane"}`)),
+					}, nil
+				}),
+			},
+		}
+
+		res := mesh.NewMeshResource()
+		res.SetMeta(&rest.ResourceMeta{Type: string(mesh.MeshType), Name: "demo"})
+
+		// when
+		_, err := client.Apply(context.Background(), []core_model.Resource{res})
+
+		// then
+		Expect(err).To(Equal(&error_types.Error{
+			Title:   "Could not apply resources",
+			Details: "Transactions are not supported by the store of the control plane",
+			Status:  http.StatusNotImplemented,
+		}))
+	})
+})
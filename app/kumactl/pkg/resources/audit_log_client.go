@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type AuditLogFilter struct {
+	Type string
+	Mesh string
+	Name string
+	// Size is a maximum number of events. 0 means all events.
+	Size int
+}
+
+type AuditLogClient interface {
+	List(ctx context.Context, filter AuditLogFilter) (api_server_types.AuditLogResponse, error)
+}
+
+func NewAuditLogClient(client util_http.Client) AuditLogClient {
+	return &httpAuditLogClient{
+		Client: client,
+	}
+}
+
+type httpAuditLogClient struct {
+	Client util_http.Client
+}
+
+func (h *httpAuditLogClient) List(ctx context.Context, filter AuditLogFilter) (api_server_types.AuditLogResponse, error) {
+	query := url.Values{}
+	if filter.Type != "" {
+		query.Set("type", filter.Type)
+	}
+	if filter.Mesh != "" {
+		query.Set("mesh", filter.Mesh)
+	}
+	if filter.Name != "" {
+		query.Set("name", filter.Name)
+	}
+	if filter.Size > 0 {
+		query.Set("size", strconv.Itoa(filter.Size))
+	}
+	resUrl := url.URL{Path: "/audit-log", RawQuery: query.Encode()}
+	req, err := http.NewRequest("GET", resUrl.String(), nil)
+	if err != nil {
+		return api_server_types.AuditLogResponse{}, err
+	}
+	statusCode, b, err := doRequest(h.Client, ctx, req)
+	if err != nil {
+		return api_server_types.AuditLogResponse{}, err
+	}
+	if statusCode != 200 {
+		return api_server_types.AuditLogResponse{}, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	response := api_server_types.AuditLogResponse{}
+	if err := json.Unmarshal(b, &response); err != nil {
+		return api_server_types.AuditLogResponse{}, err
+	}
+	return response, nil
+}
@@ -0,0 +1,139 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+// completionPageSize is the size of the page used to list names of resources.
+const completionPageSize = 1000
+
+// CompletionClient returns names of resources for shell completion.
+type CompletionClient interface {
+	// ResourceNames lists names of the resources of the type. Empty mesh means all meshes.
+	ResourceNames(ctx context.Context, desc core_model.ResourceTypeDescriptor, mesh string) ([]string, error)
+}
+
+func NewCompletionClient(client util_http.Client) CompletionClient {
+	return &httpCompletionClient{
+		Client: client,
+	}
+}
+
+type httpCompletionClient struct {
+	Client util_http.Client
+}
+
+var _ CompletionClient = &httpCompletionClient{}
+
+func (h *httpCompletionClient) ResourceNames(ctx context.Context, desc core_model.ResourceTypeDescriptor, mesh string) ([]string, error) {
+	path := "/" + desc.WsPath
+	if desc.Scope == core_model.ScopeMesh && mesh != "" {
+		path = fmt.Sprintf("/meshes/%s/%s", mesh, desc.WsPath)
+	}
+	var names []string
+	offset := ""
+	for {
+		query := url.Values{}
+		query.Set("size", fmt.Sprint(completionPageSize))
+		if offset != "" {
+			query.Set("offset", offset)
+		}
+		req, err := http.NewRequest("GET", path+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		statusCode, b, err := doRequest(h.Client, ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if statusCode != 200 {
+			return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+		}
+		list := struct {
+			Items []struct {
+				Name string `json:"name"`
+			} `json:"items"`
+			Next *string `json:"next"`
+		}{}
+		if err := json.Unmarshal(b, &list); err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			names = append(names, item.Name)
+		}
+		if list.Next == nil {
+			return names, nil
+		}
+		next, err := url.Parse(*list.Next)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse the link to the next page")
+		}
+		offset = next.Query().Get("offset")
+		if offset == "" {
+			return names, nil
+		}
+	}
+}
+
+// NewCachingCompletionClient caches names returned by the completion client in the directory for the ttl.
+// Completion runs kumactl on every key press, so the cache has to be kept in files.
+func NewCachingCompletionClient(delegate CompletionClient, dir string, keyPrefix string, ttl time.Duration, now func() time.Time) CompletionClient {
+	return &cachingCompletionClient{
+		delegate:  delegate,
+		dir:       dir,
+		keyPrefix: keyPrefix,
+		ttl:       ttl,
+		now:       now,
+	}
+}
+
+type cachingCompletionClient struct {
+	delegate  CompletionClient
+	dir       string
+	keyPrefix string
+	ttl       time.Duration
+	now       func() time.Time
+}
+
+var _ CompletionClient = &cachingCompletionClient{}
+
+type completionCacheEntry struct {
+	Time  time.Time `json:"time"`
+	Names []string  `json:"names"`
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+func (c *cachingCompletionClient) ResourceNames(ctx context.Context, desc core_model.ResourceTypeDescriptor, mesh string) ([]string, error) {
+	file := filepath.Join(c.dir, unsafeFileNameChars.ReplaceAllString(fmt.Sprintf("%s_%s_%s.json", c.keyPrefix, desc.Name, mesh), "_"))
+	if b, err := os.ReadFile(file); err == nil {
+		entry := completionCacheEntry{}
+		if err := json.Unmarshal(b, &entry); err == nil && c.now().Sub(entry.Time) < c.ttl {
+			return entry.Names, nil
+		}
+	}
+	names, err := c.delegate.ResourceNames(ctx, desc, mesh)
+	if err != nil {
+		return nil, err
+	}
+	// the cache is only an optimization, names are returned even if they can't be cached
+	if b, err := json.Marshal(completionCacheEntry{Time: c.now(), Names: names}); err == nil {
+		if err := os.MkdirAll(c.dir, os.ModeDir|0700); err == nil {
+			_ = os.WriteFile(file, b, 0600)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+type countingCompletionClient struct {
+	calls int
+}
+
+func (c *countingCompletionClient) ResourceNames(context.Context, core_model.ResourceTypeDescriptor, string) ([]string, error) {
+	c.calls++
+	return []string{"web-1"}, nil
+}
+
+var _ = Describe("CompletionClient", func() {
+	Describe("httpCompletionClient", func() {
+		It("should list names from all pages", func() {
+			// given
+			client := httpCompletionClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						body := `{"items": [{"name": "web-2"}], "next": null}`
+						if req.URL.Query().Get("offset") == "" {
+							Expect(req.URL.String()).To(Equal("/meshes/default/dataplanes?size=1000"))
+							body = `{"items": [{"name": "web-1"}], "next": "http://localhost:5681/meshes/default/dataplanes?offset=1&size=1000"}`
+						}
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       io.NopCloser(strings.NewReader(body)),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			names, err := client.ResourceNames(context.Background(), mesh.DataplaneResourceTypeDescriptor, "default")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal([]string{"web-1", "web-2"}))
+		})
+	})
+
+	Describe("cachingCompletionClient", func() {
+		It("should reuse names until the cache expires", func() {
+			// given
+			now := time.Now()
+			delegate := &countingCompletionClient{}
+			client := NewCachingCompletionClient(delegate, GinkgoT().TempDir(), "local", 10*time.Second, func() time.Time {
+				return now
+			})
+
+			// when
+			_, err := client.ResourceNames(context.Background(), mesh.DataplaneResourceTypeDescriptor, "default")
+			Expect(err).ToNot(HaveOccurred())
+			names, err := client.ResourceNames(context.Background(), mesh.DataplaneResourceTypeDescriptor, "default")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal([]string{"web-1"}))
+			Expect(delegate.calls).To(Equal(1))
+
+			// when the cache expires
+			now = now.Add(11 * time.Second)
+			_, err = client.ResourceNames(context.Background(), mesh.DataplaneResourceTypeDescriptor, "default")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delegate.calls).To(Equal(2))
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 
 type DataplaneInspectClient interface {
 	InspectPolicies(ctx context.Context, mesh, name string) (api_server_types.DataplaneInspectResponse, error)
+	Explain(ctx context.Context, mesh, name string) (api_server_types.DataplaneExplainResponse, error)
 }
 
 func NewDataplaneInspectClient(client util_http.Client) DataplaneInspectClient {
@@ -51,3 +52,26 @@ func (h *httpDataplaneInspectClient) InspectPolicies(ctx context.Context, mesh,
 	}
 	return *response, nil
 }
+
+func (h *httpDataplaneInspectClient) Explain(ctx context.Context, mesh, name string) (api_server_types.DataplaneExplainResponse, error) {
+	resUrl, err := url.Parse(fmt.Sprintf("/xds/explain/%s/%s", mesh, name))
+	if err != nil {
+		return api_server_types.DataplaneExplainResponse{}, errors.Wrap(err, "could not construct the url")
+	}
+	req, err := http.NewRequest("GET", resUrl.String(), nil)
+	if err != nil {
+		return api_server_types.DataplaneExplainResponse{}, err
+	}
+	statusCode, b, err := doRequest(h.Client, ctx, req)
+	if err != nil {
+		return api_server_types.DataplaneExplainResponse{}, err
+	}
+	if statusCode != 200 {
+		return api_server_types.DataplaneExplainResponse{}, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	response := api_server_types.DataplaneExplainResponse{}
+	if err := json.Unmarshal(b, &response); err != nil {
+		return api_server_types.DataplaneExplainResponse{}, err
+	}
+	return response, nil
+}
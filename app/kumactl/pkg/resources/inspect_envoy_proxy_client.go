@@ -3,8 +3,11 @@ package resources
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -14,9 +17,47 @@ import (
 )
 
 type InspectEnvoyProxyClient interface {
-	ConfigDump(ctx context.Context, rk core_model.ResourceKey) ([]byte, error)
-	Stats(ctx context.Context, rk core_model.ResourceKey) ([]byte, error)
-	Clusters(ctx context.Context, rk core_model.ResourceKey) ([]byte, error)
+	ConfigDump(ctx context.Context, rk core_model.ResourceKey, opts ConfigDumpOpts) ([]byte, error)
+	Stats(ctx context.Context, rk core_model.ResourceKey, opts StatsOpts) ([]byte, error)
+	// Clusters returns the clusters of the proxy in the format. Empty format means the default text format of Envoy.
+	Clusters(ctx context.Context, rk core_model.ResourceKey, format string) ([]byte, error)
+	// ProxyInfo returns the information about the proxy in the json format. The inspection is one of
+	// "listeners", "certs", "runtime" or "server-info".
+	ProxyInfo(ctx context.Context, rk core_model.ResourceKey, inspection string) ([]byte, error)
+	Drain(ctx context.Context, rk core_model.ResourceKey, graceful bool) error
+	// Forward executes the request on the path of the Envoy Admin API of the proxy through the control plane.
+	// The response of Envoy is returned as it is, it is the responsibility of the caller to close its body.
+	Forward(ctx context.Context, rk core_model.ResourceKey, method, path string, query url.Values, body io.Reader) (*http.Response, error)
+	// Tap opens a stream of HTTP requests and responses captured by the proxy. Every entry is a JSON-encoded
+	// envoy.data.tap.v3.TraceWrapper. The stream is open until ctx is cancelled, it is the responsibility of the caller to close it.
+	Tap(ctx context.Context, rk core_model.ResourceKey, opts TapOpts) (io.ReadCloser, error)
+}
+
+type ConfigDumpOpts struct {
+	// Redaction is the redaction policy of the config dump. Empty policy means the default policy of the server.
+	Redaction string
+	// IncludeEDS adds endpoints of the clusters to the config dump.
+	IncludeEDS bool
+	// Shadow returns the config generated by the control plane for the proxy instead of the config of the running proxy.
+	Shadow bool
+}
+
+type StatsOpts struct {
+	// Format of the stats. Empty format means the default text format of Envoy.
+	Format string
+	// Filter is a regular expression, only stats with matching names are returned.
+	Filter string
+	// UsedOnly returns only stats that were updated by Envoy at least once.
+	UsedOnly bool
+}
+
+type TapOpts struct {
+	// Matches limit the captured traffic to requests with headers equal to the values. Every match is in the name=value format.
+	Matches []string
+	// MaxBufferedBytes limits the number of body bytes captured for every request and response. 0 means the default of Envoy.
+	MaxBufferedBytes uint32
+	// LogLevel is set on all loggers of Envoy before the traffic is captured. Empty means that the level is not changed.
+	LogLevel string
 }
 
 func NewInspectEnvoyProxyClient(resDesc core_model.ResourceTypeDescriptor, client util_http.Client) InspectEnvoyProxyClient {
@@ -33,23 +74,125 @@ type httpInspectEnvoyProxyClient struct {
 
 var _ InspectEnvoyProxyClient = &httpInspectEnvoyProxyClient{}
 
-func (h *httpInspectEnvoyProxyClient) ConfigDump(ctx context.Context, rk core_model.ResourceKey) ([]byte, error) {
-	return h.executeInspectRequest(ctx, rk, "xds")
+func (h *httpInspectEnvoyProxyClient) ConfigDump(ctx context.Context, rk core_model.ResourceKey, opts ConfigDumpOpts) ([]byte, error) {
+	query := url.Values{}
+	if opts.Redaction != "" {
+		query.Set("redaction", opts.Redaction)
+	}
+	if opts.IncludeEDS {
+		query.Set("include_eds", "true")
+	}
+	if opts.Shadow {
+		query.Set("shadow", "true")
+	}
+	return h.executeInspectRequest(ctx, rk, "xds", query)
+}
+
+func (h *httpInspectEnvoyProxyClient) Stats(ctx context.Context, rk core_model.ResourceKey, opts StatsOpts) ([]byte, error) {
+	query := formatQuery(opts.Format)
+	if opts.Filter != "" {
+		query.Set("filter", opts.Filter)
+	}
+	if opts.UsedOnly {
+		query.Set("usedonly", "true")
+	}
+	return h.executeInspectRequest(ctx, rk, "stats", query)
+}
+
+func (h *httpInspectEnvoyProxyClient) Clusters(ctx context.Context, rk core_model.ResourceKey, format string) ([]byte, error) {
+	return h.executeInspectRequest(ctx, rk, "clusters", formatQuery(format))
+}
+
+func (h *httpInspectEnvoyProxyClient) ProxyInfo(ctx context.Context, rk core_model.ResourceKey, inspection string) ([]byte, error) {
+	return h.executeInspectRequest(ctx, rk, inspection, url.Values{})
+}
+
+func formatQuery(format string) url.Values {
+	query := url.Values{}
+	if format != "" {
+		query.Set("format", format)
+	}
+	return query
+}
+
+func (h *httpInspectEnvoyProxyClient) Drain(ctx context.Context, rk core_model.ResourceKey, graceful bool) error {
+	resUrl, err := h.buildURL(rk, "drain")
+	if err != nil {
+		return errors.Wrap(err, "could not construct the url")
+	}
+	if graceful {
+		resUrl.RawQuery = "graceful=true"
+	}
+	req, err := http.NewRequest("POST", resUrl.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	statusCode, b, err := doRequest(h.client, ctx, req)
+	if err != nil {
+		return err
+	}
+	if statusCode != 200 {
+		return errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	return nil
 }
 
-func (h *httpInspectEnvoyProxyClient) Stats(ctx context.Context, rk core_model.ResourceKey) ([]byte, error) {
-	return h.executeInspectRequest(ctx, rk, "stats")
+func (h *httpInspectEnvoyProxyClient) Forward(ctx context.Context, rk core_model.ResourceKey, method, path string, query url.Values, body io.Reader) (*http.Response, error) {
+	resUrl, err := h.buildURL(rk, "admin/"+strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not construct the url")
+	}
+	resUrl.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, method, resUrl.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	return h.client.Do(req)
 }
 
-func (h *httpInspectEnvoyProxyClient) Clusters(ctx context.Context, rk core_model.ResourceKey) ([]byte, error) {
-	return h.executeInspectRequest(ctx, rk, "clusters")
+func (h *httpInspectEnvoyProxyClient) Tap(ctx context.Context, rk core_model.ResourceKey, opts TapOpts) (io.ReadCloser, error) {
+	resUrl, err := h.buildURL(rk, "tap")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not construct the url")
+	}
+	query := url.Values{}
+	for _, match := range opts.Matches {
+		query.Add("match", match)
+	}
+	if opts.MaxBufferedBytes > 0 {
+		query.Set("max_buffered_bytes", strconv.FormatUint(uint64(opts.MaxBufferedBytes), 10))
+	}
+	if opts.LogLevel != "" {
+		query.Set("log_level", opts.LogLevel)
+	}
+	resUrl.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, "GET", resUrl.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		defer resp.Body.Close()
+		// the error response is small, so we can use the common handling of Kuma errors
+		statusCode, b, err := readResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	return resp.Body, nil
 }
 
-func (h *httpInspectEnvoyProxyClient) executeInspectRequest(ctx context.Context, rk core_model.ResourceKey, inspectionPath string) ([]byte, error) {
+func (h *httpInspectEnvoyProxyClient) executeInspectRequest(ctx context.Context, rk core_model.ResourceKey, inspectionPath string, query url.Values) ([]byte, error) {
 	resUrl, err := h.buildURL(rk, inspectionPath)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not construct the url")
 	}
+	resUrl.RawQuery = query.Encode()
 	req, err := http.NewRequest("GET", resUrl.String(), nil)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type MeshEnvoyConfigClient interface {
+	// Export writes a gzipped tar archive with config dumps of all dataplanes in the mesh to the writer.
+	// Config dumps are redacted with the redaction policy, empty policy means the default policy of the server.
+	Export(ctx context.Context, mesh string, concurrency int, redaction string, writer io.Writer) error
+}
+
+func NewMeshEnvoyConfigClient(client util_http.Client) MeshEnvoyConfigClient {
+	return &httpMeshEnvoyConfigClient{
+		Client: client,
+	}
+}
+
+type httpMeshEnvoyConfigClient struct {
+	Client util_http.Client
+}
+
+var _ MeshEnvoyConfigClient = &httpMeshEnvoyConfigClient{}
+
+func (h *httpMeshEnvoyConfigClient) Export(ctx context.Context, mesh string, concurrency int, redaction string, writer io.Writer) error {
+	resUrl, err := url.Parse(fmt.Sprintf("/meshes/%s/xds", mesh))
+	if err != nil {
+		return errors.Wrap(err, "could not construct the url")
+	}
+	query := url.Values{"concurrency": []string{strconv.Itoa(concurrency)}}
+	if redaction != "" {
+		query.Set("redaction", redaction)
+	}
+	resUrl.RawQuery = query.Encode()
+	req, err := http.NewRequest("GET", resUrl.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := h.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		// the error response is small, so we can use the common handling of Kuma errors
+		statusCode, b, err := readResponse(resp)
+		if err != nil {
+			return err
+		}
+		return errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	// the archive can be big, so we stream it instead of reading it into memory
+	_, err = io.Copy(writer, resp.Body)
+	return err
+}
@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type MeshInsightsClient interface {
+	List(ctx context.Context) (*api_server_types.MeshInsightsEntryList, error)
+}
+
+func NewMeshInsightsClient(client util_http.Client) MeshInsightsClient {
+	return &httpMeshInsightsClient{
+		Client: client,
+	}
+}
+
+var _ MeshInsightsClient = &httpMeshInsightsClient{}
+
+type httpMeshInsightsClient struct {
+	Client util_http.Client
+}
+
+func (h *httpMeshInsightsClient) List(ctx context.Context) (*api_server_types.MeshInsightsEntryList, error) {
+	req, err := http.NewRequest("GET", "/meshes+insights", nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := doRequest(h.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	entryList := api_server_types.NewMeshInsightsEntryList()
+	if err := json.Unmarshal(b, entryList); err != nil {
+		return nil, err
+	}
+	return entryList, nil
+}
@@ -0,0 +1,20 @@
+package resources
+
+import (
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+// ApplyOrder returns the position of the resource type when many resources are applied at once.
+// Meshes have to be created before resources in them and secrets before policies that reference them.
+func ApplyOrder(typ core_model.ResourceType) int {
+	switch typ {
+	case core_mesh.MeshType:
+		return 0
+	case system.GlobalSecretType, system.SecretType:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -16,6 +16,10 @@ func doRequest(client util_http.Client, ctx context.Context, req *http.Request)
 		return 0, nil, err
 	}
 	defer resp.Body.Close()
+	return readResponse(resp)
+}
+
+func readResponse(resp *http.Response) (int, []byte, error) {
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return resp.StatusCode, nil, err
@@ -24,6 +28,7 @@ func doRequest(client util_http.Client, ctx context.Context, req *http.Request)
 		kumaErr := error_types.Error{}
 		if err := json.Unmarshal(b, &kumaErr); err == nil {
 			if kumaErr.Title != "" && kumaErr.Details != "" {
+				kumaErr.Status = resp.StatusCode
 				return resp.StatusCode, b, &kumaErr
 			}
 		}
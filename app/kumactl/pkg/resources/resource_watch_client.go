@@ -0,0 +1,162 @@
+package resources
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	api_types "github.com/kumahq/kuma/pkg/api-server/types"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+// maxWatchEventSize is the maximum size of a single event. It has to fit the biggest resource.
+const maxWatchEventSize = 4 * 1024 * 1024
+
+type ResourceWatchClient interface {
+	// Watch subscribes to the changes of the resources of the type. Changes that happen after Watch returns are delivered by the stream.
+	Watch(ctx context.Context, desc core_model.ResourceTypeDescriptor, opts WatchOpts) (ResourceWatchStream, error)
+}
+
+type WatchOpts struct {
+	// Mesh of the resources. Empty mesh means resources in all meshes.
+	Mesh string
+	// Tags are the tags that the resources have to match.
+	Tags map[string]string
+}
+
+type ResourceWatchStream interface {
+	// Recv blocks until the next change. When the connection is lost, the watch is resumed after the last received change.
+	// io.EOF is returned when the control plane ends the watch.
+	Recv() (api_types.ResourceWatchEvent, error)
+	Close() error
+}
+
+func NewResourceWatchClient(client util_http.Client) ResourceWatchClient {
+	return &httpResourceWatchClient{
+		Client: client,
+	}
+}
+
+type httpResourceWatchClient struct {
+	Client util_http.Client
+}
+
+var _ ResourceWatchClient = &httpResourceWatchClient{}
+
+func (h *httpResourceWatchClient) Watch(ctx context.Context, desc core_model.ResourceTypeDescriptor, opts WatchOpts) (ResourceWatchStream, error) {
+	path := "/" + desc.WsPath
+	if desc.Scope == core_model.ScopeMesh && opts.Mesh != "" {
+		path = fmt.Sprintf("/meshes/%s/%s", opts.Mesh, desc.WsPath)
+	}
+	query := url.Values{}
+	query.Set("watch", "true")
+	for k, v := range opts.Tags {
+		query.Add("tag", fmt.Sprintf("%s:%s", k, v))
+	}
+	stream := &httpResourceWatchStream{
+		ctx:    ctx,
+		client: h.Client,
+		path:   path,
+		query:  query,
+	}
+	if err := stream.open(); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// maxWatchResumes is the number of times in a row the watch is resumed when the connection is lost.
+const maxWatchResumes = 3
+
+type httpResourceWatchStream struct {
+	ctx    context.Context
+	client util_http.Client
+	path   string
+	query  url.Values
+
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+	// resumeToken is the token of the last received event, the watch is resumed after it when the connection is lost
+	resumeToken string
+	resumes     int
+	closed      bool
+}
+
+func (h *httpResourceWatchStream) open() error {
+	query := url.Values{}
+	for k, v := range h.query {
+		query[k] = v
+	}
+	if h.resumeToken != "" {
+		query.Set("resumeToken", h.resumeToken)
+	}
+	req, err := http.NewRequest("GET", h.path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.Do(req.WithContext(h.ctx))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		statusCode, b, err := readResponse(resp)
+		if err != nil {
+			return err
+		}
+		return errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(nil, maxWatchEventSize)
+	h.body = resp.Body
+	h.scanner = scanner
+	return nil
+}
+
+func (h *httpResourceWatchStream) Recv() (api_types.ResourceWatchEvent, error) {
+	for {
+		event := api_types.ResourceWatchEvent{}
+		if !h.scanner.Scan() {
+			err := h.scanner.Err()
+			if err == nil {
+				err = io.EOF
+			}
+			// control planes that don't send resume tokens end the watch by closing the stream
+			if h.closed || h.resumeToken == "" || h.resumes >= maxWatchResumes || h.ctx.Err() != nil {
+				return event, err
+			}
+			h.resumes++
+			_ = h.body.Close()
+			if err := h.open(); err != nil {
+				return event, errors.Wrap(err, "could not resume the watch")
+			}
+			continue
+		}
+		h.resumes = 0
+		if err := json.Unmarshal(h.scanner.Bytes(), &event); err != nil {
+			return event, errors.Wrap(err, "could not parse the watch event")
+		}
+		if event.ResumeToken != "" {
+			h.resumeToken = event.ResumeToken
+		}
+		switch event.Type {
+		case api_types.WatchEventError:
+			return event, errors.Errorf("watch ended by the control plane: %s", event.Message)
+		case api_types.WatchEventBookmark:
+			continue
+		}
+		return event, nil
+	}
+}
+
+func (h *httpResourceWatchStream) Close() error {
+	h.closed = true
+	return h.body.Close()
+}
@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_types "github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+var _ = Describe("httpResourceWatchClient", func() {
+	Describe("Watch()", func() {
+		It("should create url with mesh and tags and parse events", func() {
+			// given
+			client := httpResourceWatchClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.URL.String()).To(Equal("/meshes/default/dataplanes?tag=service%3Aweb&watch=true"))
+
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body: io.NopCloser(strings.NewReader(
+								`{"type":"ADDED","resource":{"type":"Dataplane","mesh":"default","name":"web-1"}}` + "\n" +
+									`{"type":"DELETED","resource":{"type":"Dataplane","mesh":"default","name":"web-1"}}` + "\n",
+							)),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			stream, err := client.Watch(context.Background(), mesh.DataplaneResourceTypeDescriptor, WatchOpts{
+				Mesh: "default",
+				Tags: map[string]string{"service": "web"},
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			event, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.Type).To(Equal(api_types.WatchEventAdded))
+			Expect(event.Resource).To(MatchJSON(`{"type":"Dataplane","mesh":"default","name":"web-1"}`))
+
+			event, err = stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.Type).To(Equal(api_types.WatchEventDeleted))
+
+			_, err = stream.Recv()
+			Expect(err).To(Equal(io.EOF))
+			Expect(stream.Close()).To(Succeed())
+		})
+
+		It("should skip bookmarks and resume the watch when the connection is lost", func() {
+			// given
+			var urls []string
+			client := httpResourceWatchClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						urls = append(urls, req.URL.String())
+						switch len(urls) {
+						case 1:
+							return &http.Response{
+								StatusCode: http.StatusOK,
+								Body: io.NopCloser(strings.NewReader(
+									`{"type":"BOOKMARK","resumeToken":"gen.1"}` + "\n" +
+										`{"type":"ADDED","resource":{"type":"Mesh","name":"demo"},"resumeToken":"gen.2"}` + "\n",
+								)),
+							}, nil
+						case 2:
+							return &http.Response{
+								StatusCode: http.StatusOK,
+								Body: io.NopCloser(strings.NewReader(
+									`{"type":"BOOKMARK","resumeToken":"gen.2"}` + "\n" +
+										`{"type":"DELETED","resource":{"type":"Mesh","name":"demo"},"resumeToken":"gen.3"}` + "\n",
+								)),
+							}, nil
+						default:
+							return &http.Response{
+								StatusCode: http.StatusGone,
+								Body:       io.NopCloser(strings.NewReader(`{"title":"Could not watch resources"}`)),
+							}, nil
+						}
+					}),
+				},
+			}
+
+			// when
+			stream, err := client.Watch(context.Background(), mesh.MeshResourceTypeDescriptor, WatchOpts{})
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			event, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.Type).To(Equal(api_types.WatchEventAdded))
+
+			event, err = stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.Type).To(Equal(api_types.WatchEventDeleted))
+
+			_, err = stream.Recv()
+			Expect(err).To(MatchError(ContainSubstring("could not resume the watch: (410)")))
+			Expect(urls).To(Equal([]string{
+				"/meshes?watch=true",
+				"/meshes?resumeToken=gen.2&watch=true",
+				"/meshes?resumeToken=gen.3&watch=true",
+			}))
+		})
+
+		It("should return error sent by the control plane", func() {
+			// given
+			client := httpResourceWatchClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.URL.String()).To(Equal("/meshes?watch=true"))
+
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       io.NopCloser(strings.NewReader(`{"type":"ERROR","message":"too slow"}` + "\n")),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			stream, err := client.Watch(context.Background(), mesh.MeshResourceTypeDescriptor, WatchOpts{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			_, err = stream.Recv()
+			Expect(err).To(MatchError("watch ended by the control plane: too slow"))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"net/http"
+	"net/url"
 
 	"github.com/pkg/errors"
 
@@ -13,6 +14,7 @@ import (
 
 type ZoneOverviewClient interface {
 	List(ctx context.Context) (*system.ZoneOverviewResourceList, error)
+	Get(ctx context.Context, name string) (*system.ZoneOverviewResource, error)
 }
 
 func NewZoneOverviewClient(client util_http.Client) ZoneOverviewClient {
@@ -43,3 +45,22 @@ func (d *httpZoneOverviewClient) List(ctx context.Context) (*system.ZoneOverview
 	}
 	return &overviews, nil
 }
+
+func (d *httpZoneOverviewClient) Get(ctx context.Context, name string) (*system.ZoneOverviewResource, error) {
+	req, err := http.NewRequest("GET", "/zones+insights/"+url.PathEscape(name), nil)
+	if err != nil {
+		return nil, err
+	}
+	statusCode, b, err := doRequest(d.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	overview := system.NewZoneOverviewResource()
+	if err := remote.Unmarshal(b, overview); err != nil {
+		return nil, err
+	}
+	return overview, nil
+}
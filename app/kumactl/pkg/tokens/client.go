@@ -60,6 +60,7 @@ func (h *httpDataplaneTokenClient) Generate(name string, mesh string, tags map[s
 		kumaErr := error_types.Error{}
 		if err := json.Unmarshal(body, &kumaErr); err == nil {
 			if kumaErr.Title != "" && kumaErr.Details != "" {
+				kumaErr.Status = resp.StatusCode
 				return "", &kumaErr
 			}
 		}
@@ -57,6 +57,7 @@ func (h *httpZoneIngressTokenClient) Generate(zone string, validFor time.Duratio
 		kumaErr := error_types.Error{}
 		if err := json.Unmarshal(body, &kumaErr); err == nil {
 			if kumaErr.Title != "" && kumaErr.Details != "" {
+				kumaErr.Status = resp.StatusCode
 				return "", &kumaErr
 			}
 		}
@@ -30,6 +30,9 @@ protoc/pkg/test/apis/sample/v1alpha1:
 protoc/plugins:
 	$(PROTOC_GO) --proto_path=./api pkg/plugins/ca/provided/config/*.proto
 	$(PROTOC_GO) --proto_path=./api pkg/plugins/ca/builtin/config/*.proto
+	$(PROTOC_GO) --proto_path=./api pkg/plugins/ca/vault/config/*.proto
+	$(PROTOC_GO) --proto_path=./api pkg/plugins/ca/acmpca/config/*.proto
+	$(PROTOC_GO) --proto_path=./api pkg/plugins/ca/gcpcas/config/*.proto
 
 POLICIES_DIR := pkg/plugins/policies
 
@@ -13,10 +13,13 @@ import (
 	. "github.com/onsi/gomega"
 
 	api_server "github.com/kumahq/kuma/pkg/api-server"
+	"github.com/kumahq/kuma/pkg/api-server/authn"
 	"github.com/kumahq/kuma/pkg/api-server/customization"
 	config_api_server "github.com/kumahq/kuma/pkg/config/api-server"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	audit_access "github.com/kumahq/kuma/pkg/core/audit/access"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -27,6 +30,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/dns/vips"
 	"github.com/kumahq/kuma/pkg/envoy/admin/access"
+	"github.com/kumahq/kuma/pkg/events"
 	core_metrics "github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/certs"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
@@ -34,8 +38,13 @@ import (
 	sample_proto "github.com/kumahq/kuma/pkg/test/apis/sample/v1alpha1"
 	sample_model "github.com/kumahq/kuma/pkg/test/resources/apis/sample"
 	test_runtime "github.com/kumahq/kuma/pkg/test/runtime"
+	"github.com/kumahq/kuma/pkg/test/xds"
+	"github.com/kumahq/kuma/pkg/tls"
+	"github.com/kumahq/kuma/pkg/xds/cache/cla"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
 	"github.com/kumahq/kuma/pkg/xds/server"
+	xds_server_v3 "github.com/kumahq/kuma/pkg/xds/server/v3"
 )
 
 func TestWs(t *testing.T) {
@@ -109,22 +118,28 @@ func putSampleResourceIntoStore(resourceStore store.ResourceStore, name string,
 }
 
 type testApiServerConfigurer struct {
-	stop      func()
-	store     store.ResourceStore
-	enableGui bool
-	config    *config_api_server.ApiServerConfig
-	metrics   core_metrics.Metrics
-	zone      string
-	global    bool
+	stop          func()
+	store         store.ResourceStore
+	enableGui     bool
+	config        *config_api_server.ApiServerConfig
+	metrics       core_metrics.Metrics
+	zone          string
+	global        bool
+	eventBus      *events.EventBus
+	auditLog      audit.Log
+	authenticator authn.Authenticator
 }
 
 func NewTestApiServerConfigurer() *testApiServerConfigurer {
 	m, _ := core_metrics.NewMetrics("Standalone")
 	return &testApiServerConfigurer{
-		enableGui: false,
-		metrics:   m,
-		config:    config_api_server.DefaultApiServerConfig(),
-		store:     memory.NewStore(),
+		enableGui:     false,
+		metrics:       m,
+		config:        config_api_server.DefaultApiServerConfig(),
+		store:         memory.NewStore(),
+		eventBus:      events.NewEventBus(),
+		auditLog:      audit.NewMemoryLog(0, 0),
+		authenticator: certs.ClientCertAuthenticator,
 	}
 }
 
@@ -156,16 +171,39 @@ func (t *testApiServerConfigurer) WithStore(resourceStore store.ResourceStore) *
 	return t
 }
 
+func (t *testApiServerConfigurer) WithEventBus(eventBus *events.EventBus) *testApiServerConfigurer {
+	t.eventBus = eventBus
+	return t
+}
+
 func (t *testApiServerConfigurer) WithMetrics(metrics core_metrics.Metrics) *testApiServerConfigurer {
 	t.metrics = metrics
 	return t
 }
 
+func (t *testApiServerConfigurer) WithAuditLog(auditLog audit.Log) *testApiServerConfigurer {
+	t.auditLog = auditLog
+	return t
+}
+
+func (t *testApiServerConfigurer) WithAuthenticator(authenticator authn.Authenticator) *testApiServerConfigurer {
+	t.authenticator = authenticator
+	return t
+}
+
 func (t *testApiServerConfigurer) WithConfigMutator(fn func(*config_api_server.ApiServerConfig)) *testApiServerConfigurer {
 	fn(t.config)
 	return t
 }
 
+// transactions returns the transactions of the store, so the atomic apply can be tested with the memory store.
+func (t *testApiServerConfigurer) transactions() store.Transactions {
+	if txs, ok := t.store.(store.Transactions); ok {
+		return txs
+	}
+	return store.NoTransactions{}
+}
+
 func StartApiServer(t *testApiServerConfigurer) (apiServer *api_server.ApiServer, stop func()) {
 	Eventually(func() (err error) {
 		apiServer, stop, err = tryStartApiServer(t)
@@ -208,6 +246,20 @@ func tryStartApiServer(t *testApiServerConfigurer) (*api_server.ApiServer, func(
 		cfg.Mode = config_core.Global
 	}
 
+	claCache, err := cla.NewCache(cfg.Store.Cache.ExpirationTime, t.metrics)
+	if err != nil {
+		return nil, stop, err
+	}
+	cpCtx := &xds_context.ControlPlaneContext{
+		AdminProxyKeyPair: &tls.KeyPair{
+			CertPEM: []byte("CERT"),
+			KeyPEM:  []byte("KEY"),
+		},
+		CLACache: claCache,
+		Secrets:  &xds.TestSecrets{},
+		Zone:     cfg.Multizone.Zone.Name,
+	}
+
 	apiServer, err := api_server.NewApiServer(
 		manager.NewResourceManager(t.store),
 		xds_context.NewMeshContextBuilder(
@@ -225,7 +277,7 @@ func tryStartApiServer(t *testApiServerConfigurer) (*api_server.ApiServer, func(
 		t.metrics,
 		func() string { return "instance-id" },
 		func() string { return "cluster-id" },
-		certs.ClientCertAuthenticator,
+		t.authenticator,
 		runtime.Access{
 			ResourceAccess:       resources_access.NewAdminResourceAccess(cfg.Access.Static.AdminResources),
 			DataplaneTokenAccess: nil,
@@ -233,9 +285,17 @@ func tryStartApiServer(t *testApiServerConfigurer) (*api_server.ApiServer, func(
 				cfg.Access.Static.ViewConfigDump,
 				cfg.Access.Static.ViewStats,
 				cfg.Access.Static.ViewClusters,
+				cfg.Access.Static.DrainDataplane,
+				cfg.Access.Static.ViewUnredactedConfigDump,
+				cfg.Access.Static.ProxyAdmin,
 			),
+			AuditLogAccess: audit_access.NewStaticAuditLogAccess(cfg.Access.Static.ViewAuditLog),
 		},
 		&test_runtime.DummyEnvoyAdminClient{},
+		xds_server_v3.NewShadowConfigDumper(manager.NewResourceManager(t.store), &xds_hooks.Hooks{}, cpCtx),
+		t.eventBus,
+		t.auditLog,
+		t.transactions(),
 	)
 	if err != nil {
 		return nil, stop, err
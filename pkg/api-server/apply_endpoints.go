@@ -0,0 +1,181 @@
+package api_server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core"
+	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+type applyEndpoints struct {
+	mode           config_core.CpMode
+	resManager     manager.ResourceManager
+	transactions   store.Transactions
+	descriptors    map[model.ResourceType]model.ResourceTypeDescriptor
+	resourceAccess resources_access.ResourceAccess
+}
+
+func (a *applyEndpoints) addEndpoint(ws *restful.WebService) {
+	ws.Route(ws.POST("/apply").To(a.apply).
+		Doc("Applies resources atomically, either all of them are created or updated or none of them is").
+		Reads(api_server_types.ApplyRequest{}).
+		Returns(200, "OK", api_server_types.ApplyResponse{}))
+}
+
+func (a *applyEndpoints) apply(request *restful.Request, response *restful.Response) {
+	applyRequest := api_server_types.ApplyRequest{}
+	if err := request.ReadEntity(&applyRequest); err != nil {
+		rest_errors.HandleError(response, err, "Could not apply resources")
+		return
+	}
+
+	resources, err := a.readResources(applyRequest)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not apply resources")
+		return
+	}
+
+	applyResponse := api_server_types.ApplyResponse{
+		Items: make([]api_server_types.ApplyResult, len(resources)),
+	}
+	failed := -1
+	err = store.InTx(request.Request.Context(), a.transactions, func(ctx context.Context) error {
+		for i, res := range resources {
+			result, err := a.upsert(ctx, res)
+			if err != nil {
+				failed = i
+				return err
+			}
+			applyResponse.Items[i] = result
+		}
+		return nil
+	})
+	if err != nil {
+		if failed < 0 {
+			rest_errors.HandleError(response, err, "Could not apply resources")
+			return
+		}
+		// the error is not wrapped, because the errors of the store are recognized by their messages
+		if validators.IsValidationError(err) {
+			verr := validators.ValidationError{}
+			verr.AddErrorAt(validators.RootedAt("items").Index(failed), *err.(*validators.ValidationError))
+			err = verr.OrNil()
+		}
+		res := resources[failed]
+		rest_errors.HandleError(response, err, fmt.Sprintf("Could not apply %s %q (items[%d]), none of the resources was applied", res.Meta.Type, res.Meta.Name, failed))
+		return
+	}
+
+	if err := response.WriteHeaderAndJson(http.StatusOK, applyResponse, restful.MIME_JSON); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
+
+// readResources parses and validates all resources before any of them is applied.
+func (a *applyEndpoints) readResources(applyRequest api_server_types.ApplyRequest) ([]*rest.Resource, error) {
+	var verr validators.ValidationError
+	if len(applyRequest.Items) == 0 {
+		verr.AddViolation("items", "must not be empty")
+		return nil, verr.OrNil()
+	}
+	seen := map[model.ResourceKey]map[string]bool{}
+	var resources []*rest.Resource
+	for i, item := range applyRequest.Items {
+		path := validators.RootedAt("items").Index(i)
+		meta := rest.ResourceMeta{}
+		if err := json.Unmarshal(item, &meta); err != nil {
+			verr.AddViolationAt(path, err.Error())
+			continue
+		}
+		desc, ok := a.descriptors[model.ResourceType(meta.Type)]
+		if !ok {
+			verr.AddViolationAt(path.Field("type"), fmt.Sprintf("unknown type %q", meta.Type))
+			continue
+		}
+		if desc.ReadOnly {
+			verr.AddViolationAt(path.Field("type"), readOnlyMessage(a.mode))
+			continue
+		}
+		res := &rest.Resource{
+			Spec: desc.NewObject().GetSpec(),
+		}
+		if err := json.Unmarshal(item, res); err != nil {
+			verr.AddViolationAt(path, err.Error())
+			continue
+		}
+		if err := mesh.ValidateMeta(res.Meta.Name, res.Meta.Mesh, desc.Scope); err.HasViolations() {
+			verr.AddErrorAt(path, err)
+			continue
+		}
+		key := model.ResourceKey{Mesh: res.Meta.Mesh, Name: res.Meta.Name}
+		if seen[key][res.Meta.Type] {
+			verr.AddViolationAt(path, fmt.Sprintf("%s %q is already in the list", res.Meta.Type, res.Meta.Name))
+			continue
+		}
+		if seen[key] == nil {
+			seen[key] = map[string]bool{}
+		}
+		seen[key][res.Meta.Type] = true
+		resources = append(resources, res)
+	}
+	return resources, verr.OrNil()
+}
+
+func (a *applyEndpoints) upsert(ctx context.Context, restRes *rest.Resource) (api_server_types.ApplyResult, error) {
+	desc := a.descriptors[model.ResourceType(restRes.Meta.Type)]
+	key := model.ResourceKey{Mesh: restRes.Meta.Mesh, Name: restRes.Meta.Name}
+	result := api_server_types.ApplyResult{
+		Type: restRes.Meta.Type,
+		Mesh: key.Mesh,
+		Name: key.Name,
+	}
+
+	res := desc.NewObject()
+	if err := a.resManager.Get(ctx, res, store.GetBy(key)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return result, err
+		}
+		if err := a.resourceAccess.ValidateCreate(key, restRes.Spec, desc, user.FromCtx(ctx)); err != nil {
+			return result, err
+		}
+		if err := res.SetSpec(restRes.Spec); err != nil {
+			return result, err
+		}
+		if err := a.resManager.Create(ctx, res, store.CreateBy(key)); err != nil {
+			return result, err
+		}
+		result.Operation = api_server_types.ApplyOperationCreated
+	} else {
+		// the revision is optional, without it the resource is updated regardless of the changes made in the meantime
+		if revision := restRes.Meta.Revision; revision != "" && revision != res.GetMeta().GetVersion() {
+			return result, store.ErrorResourceConflict(desc.Name, key.Name, key.Mesh)
+		}
+		if err := a.resourceAccess.ValidateUpdate(key, res.GetSpec(), restRes.Spec, desc, user.FromCtx(ctx)); err != nil {
+			return result, err
+		}
+		if err := res.SetSpec(restRes.Spec); err != nil {
+			return result, err
+		}
+		if err := a.resManager.Update(ctx, res); err != nil {
+			return result, err
+		}
+		result.Operation = api_server_types.ApplyOperationUpdated
+	}
+	result.Revision = res.GetMeta().GetVersion()
+	return result, nil
+}
@@ -0,0 +1,200 @@
+package api_server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	sample_model "github.com/kumahq/kuma/pkg/test/resources/apis/sample"
+)
+
+var _ = Describe("Apply Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop = func() {}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		apiServer, stop = StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore))
+	})
+
+	AfterEach(func() {
+		stop()
+	})
+
+	apply := func(body string) (int, []byte) {
+		response, err := http.Post("http://"+apiServer.Address()+"/apply", "application/json", strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		respBytes, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return response.StatusCode, respBytes
+	}
+
+	It("should create and update resources", func() {
+		// given
+		putSampleResourceIntoStore(resourceStore, "existing", "default")
+
+		// when
+		status, body := apply(`
+		{
+			"items": [
+				{"type": "Mesh", "name": "demo"},
+				{"type": "SampleTrafficRoute", "mesh": "demo", "name": "created", "path": "/created"},
+				{"type": "SampleTrafficRoute", "mesh": "default", "name": "existing", "path": "/updated"}
+			]
+		}`)
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(body).To(MatchJSON(`
+		{
+			"items": [
+				{"type": "Mesh", "name": "demo", "revision": "1", "operation": "created"},
+				{"type": "SampleTrafficRoute", "mesh": "demo", "name": "created", "revision": "1", "operation": "created"},
+				{"type": "SampleTrafficRoute", "mesh": "default", "name": "existing", "revision": "2", "operation": "updated"}
+			]
+		}`))
+
+		// and
+		created := sample_model.NewTrafficRouteResource()
+		Expect(resourceStore.Get(context.Background(), created, store.GetByKey("created", "demo"))).To(Succeed())
+		Expect(created.Spec.Path).To(Equal("/created"))
+		updated := sample_model.NewTrafficRouteResource()
+		Expect(resourceStore.Get(context.Background(), updated, store.GetByKey("existing", "default"))).To(Succeed())
+		Expect(updated.Spec.Path).To(Equal("/updated"))
+	})
+
+	It("should not apply any resource when one of them fails", func() {
+		// when
+		status, body := apply(`
+		{
+			"items": [
+				{"type": "Mesh", "name": "demo"},
+				{"type": "SampleTrafficRoute", "mesh": "demo", "name": "created", "path": "/created"},
+				{"type": "SampleTrafficRoute", "mesh": "missing", "name": "failed", "path": "/failed"}
+			]
+		}`)
+
+		// then
+		Expect(status).To(Equal(400))
+		Expect(body).To(MatchJSON(`
+		{
+			"title": "Could not apply SampleTrafficRoute \"failed\" (items[2]), none of the resources was applied",
+			"details": "Mesh is not found",
+			"causes": [
+				{
+					"field": "mesh",
+					"message": "mesh of name missing is not found"
+				}
+			]
+		}`))
+
+		// and
+		err := resourceStore.Get(context.Background(), core_mesh.NewMeshResource(), store.GetByKey("demo", model.NoMesh))
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		err = resourceStore.Get(context.Background(), sample_model.NewTrafficRouteResource(), store.GetByKey("created", "demo"))
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should point to the resource that is not valid", func() {
+		// when
+		status, body := apply(`
+		{
+			"items": [
+				{"type": "Mesh", "name": "demo"},
+				{"type": "SampleTrafficRoute", "mesh": "demo", "name": "invalid", "path": ""}
+			]
+		}`)
+
+		// then
+		Expect(status).To(Equal(400))
+		Expect(body).To(MatchJSON(`
+		{
+			"title": "Could not apply SampleTrafficRoute \"invalid\" (items[1]), none of the resources was applied",
+			"details": "Resource is not valid",
+			"causes": [
+				{
+					"field": "items[1].path",
+					"message": "cannot be empty"
+				}
+			]
+		}`))
+		err := resourceStore.Get(context.Background(), core_mesh.NewMeshResource(), store.GetByKey("demo", model.NoMesh))
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should validate the list before applying it", func() {
+		// when
+		status, body := apply(`
+		{
+			"items": [
+				{"type": "Mesh", "name": "demo"},
+				{"type": "Unknown", "name": "unknown"},
+				{"type": "Mesh", "name": "demo"}
+			]
+		}`)
+
+		// then
+		Expect(status).To(Equal(400))
+		Expect(body).To(MatchJSON(`
+		{
+			"title": "Could not apply resources",
+			"details": "Resource is not valid",
+			"causes": [
+				{
+					"field": "items[1].type",
+					"message": "unknown type \"Unknown\""
+				},
+				{
+					"field": "items[2]",
+					"message": "Mesh \"demo\" is already in the list"
+				}
+			]
+		}`))
+	})
+
+	It("should reject empty list", func() {
+		// when
+		status, body := apply(`{"items": []}`)
+
+		// then
+		Expect(status).To(Equal(400))
+		Expect(body).To(MatchJSON(`
+		{
+			"title": "Could not apply resources",
+			"details": "Resource is not valid",
+			"causes": [
+				{
+					"field": "items",
+					"message": "must not be empty"
+				}
+			]
+		}`))
+	})
+
+	It("should fail when the store doesn't support transactions", func() {
+		// given
+		stop()
+		apiServer, stop = StartApiServer(NewTestApiServerConfigurer().WithStore(store.NewPaginationStore(memory.NewStore())))
+
+		// when
+		status, body := apply(`{"items": [{"type": "Mesh", "name": "demo"}]}`)
+
+		// then
+		Expect(status).To(Equal(501))
+		Expect(body).To(MatchJSON(`
+		{
+			"title": "Could not apply resources",
+			"details": "Transactions are not supported by the store of the control plane"
+		}`))
+	})
+})
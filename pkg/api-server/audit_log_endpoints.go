@@ -0,0 +1,72 @@
+package api_server
+
+import (
+	"strconv"
+
+	"github.com/emicklei/go-restful"
+
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	audit_access "github.com/kumahq/kuma/pkg/core/audit/access"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+type auditLogEndpoints struct {
+	auditLog audit.Log
+	access   audit_access.AuditLogAccess
+}
+
+func (a *auditLogEndpoints) addEndpoint(ws *restful.WebService) {
+	ws.Route(ws.GET("/audit-log").To(a.listEvents).
+		Doc("List recorded mutations of resources from the newest to the oldest").
+		Param(ws.QueryParameter("type", "type of the resource").DataType("string")).
+		Param(ws.QueryParameter("mesh", "mesh of the resource").DataType("string")).
+		Param(ws.QueryParameter("name", "name of the resource").DataType("string")).
+		Param(ws.QueryParameter("size", "maximum number of events to return").DataType("int")).
+		Returns(200, "OK", nil))
+}
+
+func (a *auditLogEndpoints) listEvents(request *restful.Request, response *restful.Response) {
+	if err := a.access.ValidateViewAuditLog(user.FromCtx(request.Request.Context())); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+
+	size := 0
+	if sizeParam := request.QueryParameter("size"); sizeParam != "" {
+		var err error
+		size, err = strconv.Atoi(sizeParam)
+		if err != nil || size < 0 {
+			verr := validators.ValidationError{}
+			verr.AddViolation("size", "must be a non-negative number")
+			rest_errors.HandleError(response, verr.OrNil(), "Could not list audit log")
+			return
+		}
+	}
+	typ := request.QueryParameter("type")
+	mesh := request.QueryParameter("mesh")
+	name := request.QueryParameter("name")
+
+	events := a.auditLog.Events()
+	items := []audit.Event{}
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if (typ != "" && event.Type != typ) || (mesh != "" && event.Mesh != mesh) || (name != "" && event.Name != name) {
+			continue
+		}
+		items = append(items, event)
+		if size > 0 && len(items) == size {
+			break
+		}
+	}
+
+	res := api_server_types.AuditLogResponse{
+		Total: len(items),
+		Items: items,
+	}
+	if err := response.WriteAsJson(res); err != nil {
+		rest_errors.HandleError(response, err, "Could not list audit log")
+	}
+}
@@ -0,0 +1,108 @@
+package api_server_test
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+)
+
+var _ = Describe("Audit Log Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var stop = func() {}
+
+	now := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		core.Now = func() time.Time {
+			return now
+		}
+		auditLog := audit.NewMemoryLog(0, 0)
+		for i, name := range []string{"tp-1", "tp-2", "tr-1"} {
+			typ := "TrafficPermission"
+			if name == "tr-1" {
+				typ = "TrafficRoute"
+			}
+			auditLog.Append(audit.Event{
+				Time:      now.Add(time.Duration(i) * time.Minute),
+				Subject:   audit.Subject{Name: "john.doe", Groups: []string{"team-a"}},
+				Operation: audit.CreateOperation,
+				Type:      typ,
+				Mesh:      "default",
+				Name:      name,
+				Diff:      "--- old\n+++ new\n",
+			})
+		}
+		apiServer, stop = StartApiServer(NewTestApiServerConfigurer().WithAuditLog(auditLog))
+	})
+
+	AfterEach(func() {
+		stop()
+		core.Now = time.Now
+	})
+
+	get := func(query string) (int, []byte) {
+		response, err := http.Get("http://" + apiServer.Address() + "/audit-log" + query)
+		Expect(err).ToNot(HaveOccurred())
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return response.StatusCode, body
+	}
+
+	It("should list events from the newest", func() {
+		// when
+		status, body := get("?type=TrafficPermission")
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(body).To(MatchJSON(`
+{
+  "total": 2,
+  "items": [
+    {
+      "time": "2022-06-01T10:01:00Z",
+      "subject": {"name": "john.doe", "groups": ["team-a"]},
+      "operation": "CREATE",
+      "type": "TrafficPermission",
+      "mesh": "default",
+      "name": "tp-2",
+      "diff": "--- old\n+++ new\n"
+    },
+    {
+      "time": "2022-06-01T10:00:00Z",
+      "subject": {"name": "john.doe", "groups": ["team-a"]},
+      "operation": "CREATE",
+      "type": "TrafficPermission",
+      "mesh": "default",
+      "name": "tp-1",
+      "diff": "--- old\n+++ new\n"
+    }
+  ]
+}`))
+	})
+
+	It("should limit the number of events", func() {
+		// when
+		status, body := get("?size=1&mesh=default")
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(body).To(ContainSubstring(`"name": "tr-1"`))
+		Expect(body).To(ContainSubstring(`"total": 1`))
+	})
+
+	It("should validate the size", func() {
+		// when
+		status, body := get("?size=-1")
+
+		// then
+		Expect(status).To(Equal(400))
+		Expect(body).To(ContainSubstring("must be a non-negative number"))
+	})
+})
@@ -41,11 +41,35 @@ var _ = Describe("Config WS", func() {
 			  "type": "tokens",
 			  "tokens": {
 			    "bootstrapAdminToken": true
+			  },
+			  "oidc": {
+			    "issuer": "",
+			    "clientId": "",
+			    "scopes": [
+			      "openid",
+			      "profile",
+			      "email"
+			    ],
+			    "usernameClaim": "sub",
+			    "groupsClaim": "groups",
+			    "groupMappings": {},
+			    "tenantClaim": ""
 			  }
 			},
 			"corsAllowedDomains": [
 			  ".*"
 			],
+			"limits": {
+			  "maxRequestBodySize": 10485760,
+			  "perClientIp": {
+			    "requestsPerSecond": 0,
+			    "burst": 100
+			  },
+			  "perToken": {
+			    "requestsPerSecond": 0,
+			    "burst": 100
+			  }
+			},
 			"http": {
 			  "enabled": true,
 			  "interface": "0.0.0.0",
@@ -116,7 +140,9 @@ var _ = Describe("Config WS", func() {
 		  "metrics": {
 			"dataplane": {
 			  "subscriptionLimit": 2,
-			  "idleTimeout": "5m0s"
+			  "idleTimeout": "5m0s",
+			  "memoryStatsInterval": "0s",
+			  "connectionStatsInterval": "1m0s"
 			},
 			"mesh": {
 			  "maxResyncTimeout": "20s",
@@ -145,7 +171,8 @@ var _ = Describe("Config WS", func() {
 				"tlsCertFile": "",
 				"tlsKeyFile": "",
 				"zoneInsightFlushInterval": "10s",
-				"maxMsgSize": 10485760
+				"maxMsgSize": 10485760,
+				"zoneScopedTypes": []
 			  }
 			},
 			"zone": {
@@ -259,6 +286,14 @@ var _ = Describe("Config WS", func() {
               "refreshInterval": "10s"
             }
           },
+          "envoyAdminClient": {
+            "connectTimeout": "3s",
+            "requestTimeout": "5s",
+            "maxRetries": 0,
+            "retryBaseBackoff": "500ms",
+            "cacheSize": 1000,
+            "idleConnTimeout": "1m30s"
+          },
           "store": {
             "kubernetes": {
               "systemNamespace": "kuma-system"
@@ -283,14 +318,41 @@ var _ = Describe("Config WS", func() {
               },
               "user": "kuma"
             },
+            "etcd": {
+              "endpoints": ["127.0.0.1:2379"],
+              "username": "",
+              "password": "*****",
+              "prefix": "/kuma",
+              "dialTimeout": "5s",
+              "listPageSize": 500,
+              "leaderElectionLeaseTTL": "5s",
+              "tls": {
+                "enabled": false,
+                "certPath": "",
+                "keyPath": "",
+                "caPath": ""
+              }
+            },
             "cache": {
               "enabled": true,
-              "expirationTime": "1s"
+              "expirationTime": "1s",
+              "typeExpirationTimes": {}
             },
             "upsert": {
               "conflictRetryBaseBackoff": "100ms",
               "conflictRetryMaxTimes": 5
             },
+            "secretsEncryption": {
+              "type": "",
+              "static": {
+                "keyFile": ""
+              },
+              "awsKms": {
+                "keyId": "",
+                "region": "",
+                "endpoint": ""
+              }
+            },
             "type": "memory",
             "unsafeDelete": false
           },
@@ -298,7 +360,9 @@ var _ = Describe("Config WS", func() {
             "dataplaneConfigurationRefreshInterval": "1s",
             "dataplaneStatusFlushInterval": "10s",
             "dataplaneDeregistrationDelay": "10s",
-            "nackBackoff": "5s"
+            "nackBackoff": "5s",
+            "deltaXds": false,
+            "dataplaneConfigurationGenerationConcurrency": 0
           },
           "diagnostics": {
             "serverPort": 5680,
@@ -334,12 +398,74 @@ var _ = Describe("Config WS", func() {
               "viewClusters": {
                 "users": [ ],
                 "groups": ["mesh-system:unauthenticated","mesh-system:authenticated"]
+              },
+              "drainDataplane": {
+                "users": ["mesh-system:admin"],
+                "groups": ["mesh-system:admin"]
+              },
+              "viewUnredactedConfigDump": {
+                "users": ["mesh-system:admin"],
+                "groups": ["mesh-system:admin"]
+              },
+              "proxyAdmin": {
+                "users": ["mesh-system:admin"],
+                "groups": ["mesh-system:admin"]
+              },
+              "viewAuditLog": {
+                "users": ["mesh-system:admin"],
+                "groups": ["mesh-system:admin"]
+              }
+            },
+            "rbac": {
+              "admin": {
+                "users": ["mesh-system:admin"],
+                "groups": ["mesh-system:admin"]
               }
             }
           },
+          "audit": {
+            "enabled": false,
+            "retention": {
+              "maxEntries": 1000,
+              "maxAge": "24h0m0s"
+            },
+            "sinks": {
+              "file": {
+                "path": ""
+              },
+              "stdout": {
+                "enabled": false
+              },
+              "webhook": {
+                "url": "",
+                "timeout": "5s"
+              }
+            }
+          },
+          "keyProvider": {
+            "type": "",
+            "awsKms": {
+              "region": "",
+              "endpoint": ""
+            },
+            "pkcs11": {
+              "library": "",
+              "tokenLabel": "",
+              "pin": "*****"
+            },
+            "envoyAdminClient": {
+              "keyId": "",
+              "cert": ""
+            },
+            "userToken": {
+              "keyId": "",
+              "serialNumber": 1
+            }
+          },
           "experimental": {
             "gatewayAPI": false,
-            "kubeOutboundsAsVIPs": false
+            "kubeOutboundsAsVIPs": false,
+            "envoyTap": false
           }
         }
 		`, cfg.HTTP.Port, cfg.HTTPS.Port)
@@ -11,28 +11,32 @@ import (
 	"github.com/kumahq/kuma/pkg/api-server/customization"
 	config_api_server "github.com/kumahq/kuma/pkg/config/api-server"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
-	"github.com/kumahq/kuma/pkg/core/resources/store"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/dns/vips"
 	"github.com/kumahq/kuma/pkg/envoy/admin/access"
+	"github.com/kumahq/kuma/pkg/events"
 	core_metrics "github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/certs"
 	"github.com/kumahq/kuma/pkg/test"
 	test_runtime "github.com/kumahq/kuma/pkg/test/runtime"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
 	"github.com/kumahq/kuma/pkg/xds/server"
+	xds_server_v3 "github.com/kumahq/kuma/pkg/xds/server/v3"
 )
 
 func TestWs(t *testing.T) {
 	test.RunSpecs(t, "API Server Customization")
 }
 
-func createTestApiServer(store store.ResourceStore, config *config_api_server.ApiServerConfig, enableGUI bool, metrics core_metrics.Metrics, wsManager customization.APIManager) *api_server.ApiServer {
+func createTestApiServer(store core_store.ResourceStore, config *config_api_server.ApiServerConfig, enableGUI bool, metrics core_metrics.Metrics, wsManager customization.APIManager) *api_server.ApiServer {
 	// we have to manually search for port and put it into config. There is no way to retrieve port of running
 	// http.Server and we need it later for the client
 	port, err := test.GetFreePort()
@@ -77,6 +81,10 @@ func createTestApiServer(store store.ResourceStore, config *config_api_server.Ap
 			EnvoyAdminAccess:     access.NoopEnvoyAdminAccess{},
 		},
 		&test_runtime.DummyEnvoyAdminClient{},
+		xds_server_v3.NewShadowConfigDumper(manager.NewResourceManager(store), &xds_hooks.Hooks{}, &xds_context.ControlPlaneContext{}),
+		events.NewEventBus(),
+		audit.NewMemoryLog(0, 0),
+		core_store.NoTransactions{},
 	)
 	Expect(err).ToNot(HaveOccurred())
 	return apiServer
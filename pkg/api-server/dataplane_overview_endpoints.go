@@ -49,7 +49,7 @@ func (r *dataplaneOverviewEndpoints) inspectDataplane(request *restful.Request,
 
 	if err := r.resourceAccess.ValidateGet(
 		core_model.ResourceKey{Mesh: meshName, Name: name},
-		mesh.NewDataplaneOverviewResource().Descriptor(),
+		mesh.DataplaneResourceTypeDescriptor,
 		user.FromCtx(request.Request.Context()),
 	); err != nil {
 		rest_errors.HandleError(response, err, "Access Denied")
@@ -93,7 +93,8 @@ func (r *dataplaneOverviewEndpoints) inspectDataplanes(request *restful.Request,
 	meshName := request.PathParameter("mesh")
 
 	if err := r.resourceAccess.ValidateList(
-		mesh.NewDataplaneOverviewResource().Descriptor(),
+		meshName,
+		mesh.DataplaneResourceTypeDescriptor,
 		user.FromCtx(request.Request.Context()),
 	); err != nil {
 		rest_errors.HandleError(response, err, "Access Denied")
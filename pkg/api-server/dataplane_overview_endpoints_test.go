@@ -98,6 +98,7 @@ var _ = Describe("Dataplane Overview Endpoints", func() {
 	"mesh": "mesh1",
 	"creationTime": "2018-07-17T16:05:36.995Z",
 	"modificationTime": "2018-07-17T16:05:36.995Z",
+	"revision": "1",
 	"dataplane": {
 		"networking": {
 			"address": "127.0.0.1",
@@ -133,6 +134,7 @@ var _ = Describe("Dataplane Overview Endpoints", func() {
 	"mesh": "mesh1",
 	"creationTime": "2018-07-17T16:05:36.995Z",
 	"modificationTime": "2018-07-17T16:05:36.995Z",
+	"revision": "1",
 	"dataplane": {
 		"networking": {
 			"address": "127.0.0.1",
@@ -58,6 +58,7 @@ var _ = Describe("FaultInjection Endpoints", func() {
         mesh: default
         creationTime: "2018-07-17T16:05:36.995Z"
         modificationTime: "2018-07-17T16:05:36.995Z"
+        revision: "1"
         sources:
         - match:
             service: web
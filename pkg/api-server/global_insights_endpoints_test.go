@@ -65,6 +65,12 @@ var _ = Describe("Global Insights Endpoints", func() {
   "type": "GlobalInsights",
   "creationTime": "2018-07-17T16:05:36.995Z",
   "resources": {
+    "AccessRole": {
+      "total": 0
+    },
+    "AccessRoleBinding": {
+      "total": 0
+    },
     "GlobalSecret": {
       "total": 0
     },
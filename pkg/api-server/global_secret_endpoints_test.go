@@ -58,6 +58,7 @@ var _ = Describe("GlobalSecret Endpoints", func() {
         name: sec-1
         creationTime: "2018-07-17T16:05:36.995Z"
         modificationTime: "2018-07-17T16:05:36.995Z"
+        revision: "1"
         data: "dGVzdAo="
 `
 		It("GET should return data saved by PUT", func() {
@@ -59,6 +59,7 @@ var _ = Describe("HealthCheck Endpoints", func() {
         mesh: default
         creationTime: "2018-07-17T16:05:36.995Z"
         modificationTime: "2018-07-17T16:05:36.995Z"
+        revision: "1"
         sources:
         - match:
             kuma.io/service: web
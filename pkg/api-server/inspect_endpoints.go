@@ -1,6 +1,7 @@
 package api_server
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
@@ -60,6 +61,21 @@ func getMatchedPolicies(
 	}
 }
 
+// buildMeshContext builds MeshContext of the mesh if the mesh is accessible by the user.
+// MeshContextBuilder reads resources without restrictions of the tenant of the user, so the mesh is retrieved first
+// through the tenant aware manager which reports meshes of other tenants as not existing.
+func buildMeshContext(
+	ctx context.Context,
+	rm manager.ReadOnlyResourceManager,
+	builder xds_context.MeshContextBuilder,
+	meshName string,
+) (xds_context.MeshContext, error) {
+	if err := rm.Get(ctx, core_mesh.NewMeshResource(), store.GetByKey(meshName, core_model.NoMesh)); err != nil {
+		return xds_context.MeshContext{}, err
+	}
+	return builder.Build(ctx, meshName)
+}
+
 func addInspectEndpoints(
 	ws *restful.WebService,
 	cfg *kuma_cp.Config,
@@ -67,7 +83,7 @@ func addInspectEndpoints(
 	rm manager.ResourceManager,
 ) {
 	ws.Route(
-		ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/policies").To(inspectDataplane(cfg, builder)).
+		ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/policies").To(inspectDataplane(cfg, builder, rm)).
 			Doc("inspect dataplane matched policies").
 			Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
 			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")).
@@ -76,7 +92,7 @@ func addInspectEndpoints(
 
 	for _, desc := range registry.Global().ObjectDescriptors(core_model.AllowedToInspect()) {
 		ws.Route(
-			ws.GET(fmt.Sprintf("/meshes/{mesh}/%s/{name}/dataplanes", desc.WsPath)).To(inspectPolicies(desc.Name, builder, cfg)).
+			ws.GET(fmt.Sprintf("/meshes/{mesh}/%s/{name}/dataplanes", desc.WsPath)).To(inspectPolicies(desc.Name, builder, rm, cfg)).
 				Doc("inspect policies").
 				Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
 				Param(ws.PathParameter("name", "resource name").DataType("string")).
@@ -100,13 +116,13 @@ func addInspectEndpoints(
 	)
 }
 
-func inspectDataplane(cfg *kuma_cp.Config, builder xds_context.MeshContextBuilder) restful.RouteFunction {
+func inspectDataplane(cfg *kuma_cp.Config, builder xds_context.MeshContextBuilder, rm manager.ReadOnlyResourceManager) restful.RouteFunction {
 	return func(request *restful.Request, response *restful.Response) {
 		ctx := request.Request.Context()
 		meshName := request.PathParameter("mesh")
 		dataplaneName := request.PathParameter("dataplane")
 
-		meshContext, err := builder.Build(ctx, meshName)
+		meshContext, err := buildMeshContext(ctx, rm, builder, meshName)
 		if err != nil {
 			rest_errors.HandleError(response, err, "Could not build MeshContext")
 			return
@@ -248,6 +264,7 @@ func inspectGatewayRouteDataplanes(
 func inspectPolicies(
 	resType core_model.ResourceType,
 	builder xds_context.MeshContextBuilder,
+	rm manager.ReadOnlyResourceManager,
 	cfg *kuma_cp.Config,
 ) restful.RouteFunction {
 	return func(request *restful.Request, response *restful.Response) {
@@ -255,7 +272,7 @@ func inspectPolicies(
 		meshName := request.PathParameter("mesh")
 		policyName := request.PathParameter("name")
 
-		meshContext, err := builder.Build(ctx, meshName)
+		meshContext, err := buildMeshContext(ctx, rm, builder, meshName)
 		if err != nil {
 			rest_errors.HandleError(response, err, "Could not list Dataplanes")
 			return
@@ -1,6 +1,8 @@
 package api_server_test
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"context"
 	"io"
 	"net/http"
@@ -8,6 +10,7 @@ import (
 	"path"
 	"time"
 
+	"github.com/emicklei/go-restful"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
@@ -20,6 +23,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	"github.com/kumahq/kuma/pkg/test/kds/samples"
 	"github.com/kumahq/kuma/pkg/test/matchers"
@@ -208,6 +212,7 @@ var _ = Describe("Inspect WS", func() {
 
 	type testCase struct {
 		path      string
+		query     string
 		matcher   types.GomegaMatcher
 		resources []core_model.Resource
 		global    bool
@@ -242,9 +247,10 @@ var _ = Describe("Inspect WS", func() {
 
 			// when
 			resp, err := http.Get((&url.URL{
-				Scheme: "http",
-				Host:   apiServer.Address(),
-				Path:   given.path,
+				Scheme:   "http",
+				Host:     apiServer.Address(),
+				Path:     given.path,
+				RawQuery: given.query,
 			}).String())
 			Expect(err).ToNot(HaveOccurred())
 
@@ -1062,6 +1068,75 @@ var _ = Describe("Inspect WS", func() {
 					build(),
 			},
 		}),
+		Entry("inspect xds for dataplane without redaction", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/xds",
+			query:   "redaction=none",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_xds_dataplane.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					outbound8080("redis", "192.168.0.2").
+					outbound8080("gateway", "192.168.0.3").
+					outbound8080("web", "192.168.0.4").
+					build(),
+			},
+		}),
+		Entry("inspect xds for dataplane with unsupported redaction policy", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/xds",
+			query:   "redaction=partial",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_xds_dataplane_invalid_redaction.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			},
+		}),
+		Entry("inspect shadow xds for dataplane", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/xds",
+			query:   "shadow&include_eds",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_xds_dataplane_shadow.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				&core_mesh.TrafficRouteResource{
+					Meta: &test_model.ResourceMeta{Name: "t-1", Mesh: "mesh-1"},
+					Spec: &mesh_proto.TrafficRoute{
+						Sources:      anyService(),
+						Destinations: anyService(),
+						Conf:         samples.TrafficRoute.Conf,
+					},
+				},
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					outbound8080("redis", "192.168.0.2").
+					build(),
+				newDataplane().
+					meta("redis-1", "mesh-1").
+					inbound80to81("redis", "192.168.0.3").
+					build(),
+			},
+		}),
+		Entry("inspect shadow xds for dataplane on global", testCase{
+			global:  true,
+			path:    "/meshes/mesh-1/dataplanes/backend-1/xds",
+			query:   "shadow=true",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_xds_global_dataplane_shadow.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			},
+		}),
 		Entry("inspect xds for local zone ingress", testCase{
 			path:    "/zoneingresses/zi-1/xds",
 			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_xds_local_zoneingress.json")),
@@ -1145,6 +1220,32 @@ var _ = Describe("Inspect WS", func() {
 					build(),
 			},
 		}),
+		Entry("inspect stats for dataplane in json format", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/stats",
+			query:   "format=json&usedonly",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_stats_dataplane_json.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			},
+		}),
+		Entry("inspect stats for dataplane in unsupported format", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/stats",
+			query:   "format=xml",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_stats_dataplane_invalid_format.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			},
+		}),
 		Entry("inspect clusters for dataplane", testCase{
 			path:    "/meshes/mesh-1/dataplanes/backend-1/clusters",
 			matcher: matchers.MatchGoldenEqual(path.Join("testdata", "inspect_clusters_dataplane.out")),
@@ -1160,6 +1261,360 @@ var _ = Describe("Inspect WS", func() {
 					build(),
 			},
 		}),
+		Entry("inspect clusters for dataplane in json format", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/clusters",
+			query:   "format=json",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_clusters_dataplane_json.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			},
+		}),
+		Entry("inspect clusters for dataplane in unsupported format", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/clusters",
+			query:   "format=xml",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_clusters_dataplane_invalid_format.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			},
+		}),
+		Entry("inspect listeners for dataplane", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/listeners",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_listeners_dataplane.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			},
+		}),
+		Entry("inspect certs for dataplane", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/certs",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_certs_dataplane.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			},
+		}),
+		Entry("inspect runtime for dataplane", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/runtime",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_runtime_dataplane.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			},
+		}),
+		Entry("inspect server info for dataplane", testCase{
+			path:    "/meshes/mesh-1/dataplanes/backend-1/server-info",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_server_info_dataplane.json")),
+			resources: []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			},
+		}),
+		Entry("inspect certs for zone ingress", testCase{
+			path:    "/zoneingresses/zi-1/certs",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_certs_zoneingress.json")),
+			resources: []core_model.Resource{
+				newZoneIngress().
+					meta("zi-1").
+					zone("").
+					admin(2201).
+					address("2.2.2.2").port(8080).
+					advertisedAddress("3.3.3.3").advertisedPort(80).
+					build(),
+			},
+		}),
+		Entry("inspect server info for zone egress", testCase{
+			path:    "/zoneegresses/ze-1/server-info",
+			matcher: matchers.MatchGoldenJSON(path.Join("testdata", "inspect_server_info_zoneegress.json")),
+			resources: []core_model.Resource{
+				newZoneEgress().
+					meta("ze-1").
+					address("4.4.4.4").
+					port(8080).
+					admin(4321).
+					build(),
+			},
+		}),
+	)
+
+	DescribeTable("should drain dataplane",
+		func(query string, expectedStatus int) {
+			// setup
+			resourceStore := memory.NewStore()
+			rm := manager.NewResourceManager(resourceStore)
+			for _, resource := range []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			} {
+				err := rm.Create(context.Background(), resource,
+					store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			apiServer, stop := StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore).WithZone("local"))
+			defer stop()
+
+			// when
+			resp, err := http.Post((&url.URL{
+				Scheme:   "http",
+				Host:     apiServer.Address(),
+				Path:     "/meshes/mesh-1/dataplanes/backend-1/drain",
+				RawQuery: query,
+			}).String(), "application/json", nil)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(expectedStatus))
+		},
+		Entry("without graceful period", "", http.StatusOK),
+		Entry("with graceful flag", "graceful", http.StatusOK),
+		Entry("with explicit graceful value", "graceful=true", http.StatusOK),
+		Entry("with invalid graceful value", "graceful=maybe", http.StatusBadRequest),
+	)
+
+	DescribeTable("should stream traffic captured by the dataplane",
+		func(dataplane, query string, expectedStatus int, expectedBody string) {
+			// setup
+			resourceStore := memory.NewStore()
+			rm := manager.NewResourceManager(resourceStore)
+			for _, resource := range []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			} {
+				err := rm.Create(context.Background(), resource,
+					store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			apiServer, stop := StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore).WithZone("local"))
+			defer stop()
+
+			// when
+			resp, err := http.Get((&url.URL{
+				Scheme:   "http",
+				Host:     apiServer.Address(),
+				Path:     "/meshes/mesh-1/dataplanes/" + dataplane + "/tap",
+				RawQuery: query,
+			}).String())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(expectedStatus))
+			if expectedStatus != http.StatusOK {
+				return
+			}
+			Expect(resp.Header.Get("Content-Type")).To(Equal("application/json"))
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(expectedBody))
+		},
+		Entry("without matches", "backend-1", "", http.StatusOK,
+			`{"http_buffered_trace": {"request": {"headers": []}}}`),
+		Entry("with matches", "backend-1", "match=:method=GET&match=X-User=alice&max_buffered_bytes=1024", http.StatusOK,
+			`{"http_buffered_trace": {"request": {"headers": [{"key": ":method", "value": "GET"}, {"key": "x-user", "value": "alice"}]}}}`),
+		Entry("with invalid match", "backend-1", "match=x-user", http.StatusBadRequest, ""),
+		Entry("with invalid max buffered bytes", "backend-1", "max_buffered_bytes=-1", http.StatusBadRequest, ""),
+		Entry("non existing dataplane", "backend-2", "", http.StatusNotFound, ""),
+	)
+
+	DescribeTable("should forward requests to the admin API of dataplane",
+		func(method, dataplane, path, query string, expectedStatus int, expectedBody string) {
+			// setup
+			resourceStore := memory.NewStore()
+			rm := manager.NewResourceManager(resourceStore)
+			for _, resource := range []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+			} {
+				err := rm.Create(context.Background(), resource,
+					store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			apiServer, stop := StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore).WithZone("local"))
+			defer stop()
+
+			// when
+			req, err := http.NewRequest(method, (&url.URL{
+				Scheme:   "http",
+				Host:     apiServer.Address(),
+				Path:     "/meshes/mesh-1/dataplanes/" + dataplane + "/admin/" + path,
+				RawQuery: query,
+			}).String(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp, err := http.DefaultClient.Do(req)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(expectedStatus))
+			if expectedStatus != http.StatusOK {
+				return
+			}
+			Expect(resp.Header.Get("Content-Type")).To(Equal("text/plain"))
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(body)).To(Equal(expectedBody))
+		},
+		Entry("GET with query", "GET", "backend-1", "stats", "filter=http", http.StatusOK, "GET stats?filter=http\n"),
+		Entry("POST", "POST", "backend-1", "logging", "level=debug", http.StatusOK, "POST logging?level=debug\n"),
+		Entry("nested path", "GET", "backend-1", "stats/prometheus", "", http.StatusOK, "GET stats/prometheus?\n"),
+		Entry("non existing dataplane", "GET", "backend-2", "stats", "", http.StatusNotFound, ""),
+	)
+
+	DescribeTable("should export config dumps of all dataplanes in the mesh",
+		func(query string, expectedStatus int) {
+			// setup
+			resourceStore := memory.NewStore()
+			rm := manager.NewResourceManager(resourceStore)
+			for _, resource := range []core_model.Resource{
+				newMesh("mesh-1"),
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					admin(3301).
+					inbound80to81("backend", "192.168.0.1").
+					build(),
+				newDataplane().
+					meta("web-1", "mesh-1").
+					admin(3301).
+					inbound80to81("web", "192.168.0.2").
+					build(),
+			} {
+				err := rm.Create(context.Background(), resource,
+					store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			apiServer, stop := StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore).WithZone("local"))
+			defer stop()
+
+			// when
+			resp, err := http.Get((&url.URL{
+				Scheme:   "http",
+				Host:     apiServer.Address(),
+				Path:     "/meshes/mesh-1/xds",
+				RawQuery: query,
+			}).String())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(expectedStatus))
+			if expectedStatus != http.StatusOK {
+				return
+			}
+			Expect(resp.Header.Get("Content-Type")).To(Equal("application/gzip"))
+			Expect(resp.Header.Get("Content-Disposition")).To(Equal(`attachment; filename="mesh-1-envoy-config.tar.gz"`))
+
+			gzipReader, err := gzip.NewReader(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			tarReader := tar.NewReader(gzipReader)
+			var files []string
+			for {
+				header, err := tarReader.Next()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).ToNot(HaveOccurred())
+				files = append(files, header.Name)
+			}
+			Expect(files).To(ConsistOf("backend-1.json", "web-1.json"))
+		},
+		Entry("with default concurrency", "", http.StatusOK),
+		Entry("with explicit concurrency", "concurrency=1", http.StatusOK),
+		Entry("with invalid concurrency", "concurrency=many", http.StatusBadRequest),
+		Entry("with non positive concurrency", "concurrency=0", http.StatusBadRequest),
+	)
+
+	DescribeTable("should not expose meshes of other tenants",
+		func(tenant string, expectedStatus int) {
+			// setup
+			resourceStore := memory.NewStore()
+			rm := manager.NewResourceManager(resourceStore)
+			mesh := newMesh("mesh-1")
+			mesh.Spec.Tenant = "tenant-1"
+			for _, resource := range []core_model.Resource{
+				mesh,
+				&core_mesh.TrafficPermissionResource{
+					Meta: &test_model.ResourceMeta{Name: "tp-1", Mesh: "mesh-1"},
+					Spec: &mesh_proto.TrafficPermission{
+						Sources:      anyService(),
+						Destinations: anyService(),
+					},
+				},
+				newDataplane().
+					meta("backend-1", "mesh-1").
+					inbound80to81("backend", "192.168.0.1").
+					outbound8080("redis", "192.168.0.2").
+					build(),
+			} {
+				err := rm.Create(context.Background(), resource,
+					store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			authenticator := func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+				u := user.User{Name: "john", Tenant: tenant}
+				request.Request = request.Request.WithContext(user.Ctx(request.Request.Context(), u.Authenticated()))
+				chain.ProcessFilter(request, response)
+			}
+			apiServer, stop := StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore).WithZone("local").WithAuthenticator(authenticator))
+			defer stop()
+
+			for _, p := range []string{
+				"/meshes/mesh-1/dataplanes/backend-1/policies",
+				"/meshes/mesh-1/traffic-permissions/tp-1/dataplanes",
+				"/xds/explain/mesh-1/backend-1",
+			} {
+				// when
+				resp, err := http.Get((&url.URL{
+					Scheme: "http",
+					Host:   apiServer.Address(),
+					Path:   p,
+				}).String())
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Body.Close()).To(Succeed())
+				Expect(resp.StatusCode).To(Equal(expectedStatus), p)
+			}
+		},
+		Entry("user of the tenant of the mesh", "tenant-1", http.StatusOK),
+		Entry("user of another tenant", "tenant-2", http.StatusNotFound),
 	)
 
 	It("should change response if state changed", func() {
@@ -2,8 +2,13 @@ package api_server
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
 
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	"github.com/kumahq/kuma/pkg/config/core"
@@ -16,6 +21,10 @@ import (
 	"github.com/kumahq/kuma/pkg/core/validators"
 	"github.com/kumahq/kuma/pkg/envoy/admin"
 	"github.com/kumahq/kuma/pkg/envoy/admin/access"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+	xds_server_v3 "github.com/kumahq/kuma/pkg/xds/server/v3"
 )
 
 func addInspectEnvoyAdminEndpoints(
@@ -24,70 +33,361 @@ func addInspectEnvoyAdminEndpoints(
 	rm manager.ResourceManager,
 	adminAccess access.EnvoyAdminAccess,
 	envoyAdminClient admin.EnvoyAdminClient,
+	meshContextBuilder xds_context.MeshContextBuilder,
+	shadowConfigDumper *xds_server_v3.ShadowConfigDumper,
 ) {
 	ws.Route(
 		ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/xds").
-			To(inspectDataplaneAdmin(envoyAdminClient.ConfigDump, adminAccess.ValidateViewConfigDump, rm)).
+			To(inspectDataplaneAdmin(dataplaneConfigDumpFn(cfg, envoyAdminClient, adminAccess, meshContextBuilder, shadowConfigDumper), adminAccess.ValidateViewConfigDump, rm)).
 			Doc("inspect dataplane XDS configuration").
 			Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
-			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")),
+			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")).
+			Param(ws.QueryParameter("redaction", "redaction policy of the config dump (none, secrets-only or full), secrets-only by default").DataType("string")).
+			Param(ws.QueryParameter("include_eds", "include endpoints of the clusters in the config dump").DataType("boolean")).
+			Param(ws.QueryParameter("shadow", "return the config generated by the control plane instead of the config of the running proxy").DataType("boolean")),
+	)
+	ws.Route(
+		ws.GET("/meshes/{mesh}/xds").
+			To(inspectMeshAdmin(admin.NewMeshConfigDumper(rm, envoyAdminClient), adminAccess)).
+			Doc("export XDS configuration of all dataplanes in the mesh as a gzipped tar archive").
+			Produces("application/gzip").
+			Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
+			Param(ws.QueryParameter("concurrency", "maximum number of dataplanes from which XDS configuration is fetched at the same time").DataType("integer")).
+			Param(ws.QueryParameter("redaction", "redaction policy of the config dump (none, secrets-only or full), secrets-only by default").DataType("string")).
+			Param(ws.QueryParameter("include_eds", "include endpoints of the clusters in the config dump").DataType("boolean")),
 	)
 	ws.Route(
 		ws.GET("/zoneingresses/{zoneingress}/xds").
-			To(inspectZoneIngressAdmin(cfg.Mode, cfg.Multizone.Zone.Name, envoyAdminClient.ConfigDump, adminAccess.ValidateViewConfigDump, rm)).
+			To(inspectZoneIngressAdmin(cfg.Mode, cfg.Multizone.Zone.Name, configDumpFn(envoyAdminClient, adminAccess), adminAccess.ValidateViewConfigDump, rm)).
 			Doc("inspect zone ingresses XDS configuration").
-			Param(ws.PathParameter("zoneingress", "zoneingress name").DataType("string")),
+			Param(ws.PathParameter("zoneingress", "zoneingress name").DataType("string")).
+			Param(ws.QueryParameter("redaction", "redaction policy of the config dump (none, secrets-only or full), secrets-only by default").DataType("string")).
+			Param(ws.QueryParameter("include_eds", "include endpoints of the clusters in the config dump").DataType("boolean")),
 	)
 	ws.Route(
 		ws.GET("/zoneegresses/{zoneegress}/xds").
-			To(inspectZoneEgressAdmin(envoyAdminClient.ConfigDump, adminAccess.ValidateViewConfigDump, rm)).
+			To(inspectZoneEgressAdmin(configDumpFn(envoyAdminClient, adminAccess), adminAccess.ValidateViewConfigDump, rm)).
 			Doc("inspect zone egresses XDS configuration").
-			Param(ws.PathParameter("zoneegress", "zoneegress name").DataType("string")),
+			Param(ws.PathParameter("zoneegress", "zoneegress name").DataType("string")).
+			Param(ws.QueryParameter("redaction", "redaction policy of the config dump (none, secrets-only or full), secrets-only by default").DataType("string")).
+			Param(ws.QueryParameter("include_eds", "include endpoints of the clusters in the config dump").DataType("boolean")),
 	)
 
 	ws.Route(
 		ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/stats").
-			To(inspectDataplaneAdmin(envoyAdminClient.Stats, adminAccess.ValidateViewStats, rm)).
+			To(inspectDataplaneAdmin(statsFn(envoyAdminClient), adminAccess.ValidateViewStats, rm)).
 			Doc("inspect dataplane stats").
 			Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
-			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")),
+			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")).
+			Param(ws.QueryParameter("filter", "regular expression to filter stats by name").DataType("string")).
+			Param(ws.QueryParameter("usedonly", "return only stats that were updated at least once").DataType("boolean")).
+			Param(ws.QueryParameter("format", "format of the stats (json or prometheus)").DataType("string")),
 	)
 	ws.Route(
 		ws.GET("/zoneingresses/{zoneingress}/stats").
-			To(inspectZoneIngressAdmin(cfg.Mode, cfg.Multizone.Zone.Name, envoyAdminClient.Stats, adminAccess.ValidateViewStats, rm)).
+			To(inspectZoneIngressAdmin(cfg.Mode, cfg.Multizone.Zone.Name, statsFn(envoyAdminClient), adminAccess.ValidateViewStats, rm)).
 			Doc("inspect zone ingresses stats").
-			Param(ws.PathParameter("zoneingress", "zoneingress name").DataType("string")),
+			Param(ws.PathParameter("zoneingress", "zoneingress name").DataType("string")).
+			Param(ws.QueryParameter("filter", "regular expression to filter stats by name").DataType("string")).
+			Param(ws.QueryParameter("usedonly", "return only stats that were updated at least once").DataType("boolean")).
+			Param(ws.QueryParameter("format", "format of the stats (json or prometheus)").DataType("string")),
 	)
 	ws.Route(
 		ws.GET("/zoneegresses/{zoneegress}/stats").
-			To(inspectZoneEgressAdmin(envoyAdminClient.Stats, adminAccess.ValidateViewStats, rm)).
+			To(inspectZoneEgressAdmin(statsFn(envoyAdminClient), adminAccess.ValidateViewStats, rm)).
 			Doc("inspect zone egresses stats").
-			Param(ws.PathParameter("zoneegress", "zoneegress name").DataType("string")),
+			Param(ws.PathParameter("zoneegress", "zoneegress name").DataType("string")).
+			Param(ws.QueryParameter("filter", "regular expression to filter stats by name").DataType("string")).
+			Param(ws.QueryParameter("usedonly", "return only stats that were updated at least once").DataType("boolean")).
+			Param(ws.QueryParameter("format", "format of the stats (json or prometheus)").DataType("string")),
 	)
 
 	ws.Route(
 		ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/clusters").
-			To(inspectDataplaneAdmin(envoyAdminClient.Clusters, adminAccess.ValidateViewClusters, rm)).
+			To(inspectDataplaneAdmin(clustersFn(envoyAdminClient), adminAccess.ValidateViewClusters, rm)).
 			Doc("inspect dataplane clusters").
 			Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
-			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")),
+			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")).
+			Param(ws.QueryParameter("format", "format of the clusters (json)").DataType("string")),
 	)
 	ws.Route(
 		ws.GET("/zoneingresses/{zoneingress}/clusters").
-			To(inspectZoneIngressAdmin(cfg.Mode, cfg.Multizone.Zone.Name, envoyAdminClient.Clusters, adminAccess.ValidateViewClusters, rm)).
+			To(inspectZoneIngressAdmin(cfg.Mode, cfg.Multizone.Zone.Name, clustersFn(envoyAdminClient), adminAccess.ValidateViewClusters, rm)).
 			Doc("inspect zone ingresses clusters").
-			Param(ws.PathParameter("zoneingress", "zoneingress name").DataType("string")),
+			Param(ws.PathParameter("zoneingress", "zoneingress name").DataType("string")).
+			Param(ws.QueryParameter("format", "format of the clusters (json)").DataType("string")),
 	)
 	ws.Route(
 		ws.GET("/zoneegresses/{zoneegress}/clusters").
-			To(inspectZoneEgressAdmin(envoyAdminClient.Clusters, adminAccess.ValidateViewClusters, rm)).
+			To(inspectZoneEgressAdmin(clustersFn(envoyAdminClient), adminAccess.ValidateViewClusters, rm)).
 			Doc("inspect zone egresses clusters").
-			Param(ws.PathParameter("zoneegress", "zoneegress name").DataType("string")),
+			Param(ws.PathParameter("zoneegress", "zoneegress name").DataType("string")).
+			Param(ws.QueryParameter("format", "format of the clusters (json)").DataType("string")),
 	)
+
+	// these endpoints return the parts of the proxy state that are not in the config dump, like drain state of listeners,
+	// expiration of certificates or runtime flags. They don't contain secrets, so the access is the same as for the config dump.
+	for _, info := range []struct {
+		path     string
+		endpoint admin.ProxyInfoEndpoint
+		doc      string
+	}{
+		{path: "listeners", endpoint: admin.ProxyInfoListeners, doc: "listeners"},
+		{path: "certs", endpoint: admin.ProxyInfoCerts, doc: "certificates"},
+		{path: "runtime", endpoint: admin.ProxyInfoRuntime, doc: "runtime"},
+		{path: "server-info", endpoint: admin.ProxyInfoServerInfo, doc: "server info"},
+	} {
+		ws.Route(
+			ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/" + info.path).
+				To(inspectDataplaneAdmin(proxyInfoFn(envoyAdminClient, info.endpoint), adminAccess.ValidateViewConfigDump, rm)).
+				Doc("inspect dataplane " + info.doc).
+				Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
+				Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")),
+		)
+		ws.Route(
+			ws.GET("/zoneingresses/{zoneingress}/" + info.path).
+				To(inspectZoneIngressAdmin(cfg.Mode, cfg.Multizone.Zone.Name, proxyInfoFn(envoyAdminClient, info.endpoint), adminAccess.ValidateViewConfigDump, rm)).
+				Doc("inspect zone ingresses " + info.doc).
+				Param(ws.PathParameter("zoneingress", "zoneingress name").DataType("string")),
+		)
+		ws.Route(
+			ws.GET("/zoneegresses/{zoneegress}/" + info.path).
+				To(inspectZoneEgressAdmin(proxyInfoFn(envoyAdminClient, info.endpoint), adminAccess.ValidateViewConfigDump, rm)).
+				Doc("inspect zone egresses " + info.doc).
+				Param(ws.PathParameter("zoneegress", "zoneegress name").DataType("string")),
+		)
+	}
+
+	ws.Route(
+		ws.POST("/meshes/{mesh}/dataplanes/{dataplane}/drain").
+			To(inspectDataplaneAdmin(drainFn(envoyAdminClient), adminAccess.ValidateDrainDataplane, rm)).
+			Doc("drain listeners of the dataplane").
+			Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
+			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")).
+			Param(ws.QueryParameter("graceful", "keep existing connections for the drain time of the dataplane").DataType("boolean")),
+	)
+
+	ws.Route(
+		ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/tap").
+			To(tapDataplaneAdmin(envoyAdminClient, adminAccess, rm)).
+			Doc("stream HTTP requests and responses captured by the inbound listeners of the dataplane").
+			Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
+			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")).
+			Param(ws.QueryParameter("match", "capture only requests with the header equal to the value, in the name=value format. Can be repeated").DataType("string")).
+			Param(ws.QueryParameter("max_buffered_bytes", "maximum number of body bytes captured for every request and response").DataType("integer")).
+			Param(ws.QueryParameter("log_level", "log level of Envoy set before the traffic is captured (trace, debug, info, warning, error, critical or off)").DataType("string")),
+	)
+
+	for _, route := range []*restful.RouteBuilder{
+		ws.GET("/meshes/{mesh}/dataplanes/{dataplane}/admin/{path:*}"),
+		ws.POST("/meshes/{mesh}/dataplanes/{dataplane}/admin/{path:*}"),
+	} {
+		ws.Route(
+			route.
+				To(forwardDataplaneAdmin(envoyAdminClient, adminAccess, rm)).
+				Doc("forward the request to the Envoy Admin API of the dataplane").
+				Consumes("*/*").
+				Produces("*/*").
+				Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
+				Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")).
+				Param(ws.PathParameter("path", "path of the Envoy Admin API endpoint").DataType("string")),
+		)
+	}
+}
+
+// envoyAdminFn executes Envoy Admin operation on the proxy. The request is passed to read optional query parameters of the operation.
+type envoyAdminFn = func(ctx context.Context, request *restful.Request, proxy core_model.ResourceWithAddress) ([]byte, error)
+
+func configDumpFn(envoyAdminClient admin.EnvoyAdminClient, adminAccess access.EnvoyAdminAccess) envoyAdminFn {
+	return func(ctx context.Context, request *restful.Request, proxy core_model.ResourceWithAddress) ([]byte, error) {
+		opts, err := configDumpOpts(ctx, request, adminAccess)
+		if err != nil {
+			return nil, err
+		}
+		return envoyAdminClient.ConfigDump(ctx, proxy, opts)
+	}
+}
+
+// dataplaneConfigDumpFn returns the config dump of the proxy. When "shadow" is set, the config is not fetched from the proxy,
+// it's generated by the control plane the same way as the config that is delivered to the proxy.
+func dataplaneConfigDumpFn(
+	cfg *kuma_cp.Config,
+	envoyAdminClient admin.EnvoyAdminClient,
+	adminAccess access.EnvoyAdminAccess,
+	meshContextBuilder xds_context.MeshContextBuilder,
+	shadowConfigDumper *xds_server_v3.ShadowConfigDumper,
+) envoyAdminFn {
+	return func(ctx context.Context, request *restful.Request, proxy core_model.ResourceWithAddress) ([]byte, error) {
+		shadow, err := flagQueryParameter(request, "shadow")
+		if err != nil {
+			return nil, err
+		}
+		if !shadow {
+			return configDumpFn(envoyAdminClient, adminAccess)(ctx, request, proxy)
+		}
+		if cfg.Mode == core.Global {
+			verr := validators.ValidationError{}
+			verr.AddViolation("shadow", "shadow config is generated only by the zone control plane to which the dataplane is connected")
+			return nil, &verr
+		}
+		opts, err := configDumpOpts(ctx, request, adminAccess)
+		if err != nil {
+			return nil, err
+		}
+
+		meshContext, err := meshContextBuilder.Build(ctx, proxy.GetMeta().GetMesh())
+		if err != nil {
+			return nil, errors.Wrap(err, "could not build MeshContext")
+		}
+		_, _, xdsProxy, err := getMatchedPolicies(cfg, meshContext, core_model.MetaToResourceKey(proxy.GetMeta()))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not build proxy")
+		}
+		configDump, err := shadowConfigDumper.ConfigDump(meshContext, &xdsProxy, opts.IncludeEDS)
+		if err != nil {
+			return nil, err
+		}
+		if err := admin.Sanitize(configDump, opts.RedactionPolicy()); err != nil {
+			return nil, err
+		}
+		return util_proto.ToJSONIndent(configDump, " ")
+	}
+}
+
+// configDumpOpts reads the redaction policy from the request. Getting the config dump without any redaction
+// requires an additional permission, because it contains credentials of the proxy.
+func configDumpOpts(ctx context.Context, request *restful.Request, adminAccess access.EnvoyAdminAccess) (admin.ConfigDumpOpts, error) {
+	opts := admin.ConfigDumpOpts{
+		Redaction: admin.RedactionPolicy(request.QueryParameter("redaction")),
+	}
+	includeEDS, err := flagQueryParameter(request, "include_eds")
+	if err != nil {
+		return admin.ConfigDumpOpts{}, err
+	}
+	opts.IncludeEDS = includeEDS
+	if err := opts.RedactionPolicy().Validate(); err != nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation("redaction", err.Error())
+		return admin.ConfigDumpOpts{}, &verr
+	}
+	if opts.RedactionPolicy() == admin.RedactionPolicyNone {
+		if err := adminAccess.ValidateViewUnredactedConfigDump(user.FromCtx(ctx)); err != nil {
+			return admin.ConfigDumpOpts{}, err
+		}
+	}
+	return opts, nil
+}
+
+func statsFn(envoyAdminClient admin.EnvoyAdminClient) envoyAdminFn {
+	return func(ctx context.Context, request *restful.Request, proxy core_model.ResourceWithAddress) ([]byte, error) {
+		opts := admin.StatsOpts{
+			Filter: request.QueryParameter("filter"),
+			Format: admin.StatsFormat(request.QueryParameter("format")),
+		}
+		if usedOnly, ok := request.Request.URL.Query()["usedonly"]; ok {
+			// Envoy treats "usedonly" as a flag, we also accept an explicit boolean value
+			opts.UsedOnly = len(usedOnly) == 0 || usedOnly[0] == "" || usedOnly[0] == "true"
+		}
+		if err := opts.Format.Validate(); err != nil {
+			verr := validators.ValidationError{}
+			verr.AddViolation("format", err.Error())
+			return nil, &verr
+		}
+		return envoyAdminClient.Stats(ctx, proxy, opts)
+	}
+}
+
+func clustersFn(envoyAdminClient admin.EnvoyAdminClient) envoyAdminFn {
+	return func(ctx context.Context, request *restful.Request, proxy core_model.ResourceWithAddress) ([]byte, error) {
+		opts := admin.ClustersOpts{
+			Format: admin.ClustersFormat(request.QueryParameter("format")),
+		}
+		if err := opts.Format.Validate(); err != nil {
+			verr := validators.ValidationError{}
+			verr.AddViolation("format", err.Error())
+			return nil, &verr
+		}
+		return envoyAdminClient.Clusters(ctx, proxy, opts)
+	}
+}
+
+func proxyInfoFn(envoyAdminClient admin.EnvoyAdminClient, endpoint admin.ProxyInfoEndpoint) envoyAdminFn {
+	return func(ctx context.Context, _ *restful.Request, proxy core_model.ResourceWithAddress) ([]byte, error) {
+		return admin.ProxyInfo(ctx, envoyAdminClient, proxy, endpoint)
+	}
+}
+
+func drainFn(envoyAdminClient admin.EnvoyAdminClient) envoyAdminFn {
+	return func(ctx context.Context, request *restful.Request, proxy core_model.ResourceWithAddress) ([]byte, error) {
+		graceful, err := flagQueryParameter(request, "graceful")
+		if err != nil {
+			return nil, err
+		}
+		return nil, envoyAdminClient.Drain(ctx, proxy.(*core_mesh.DataplaneResource), graceful)
+	}
+}
+
+// flagQueryParameter reads a query parameter that same as in Envoy is treated as a flag, but also accepts an explicit boolean value.
+func flagQueryParameter(request *restful.Request, name string) (bool, error) {
+	values, ok := request.Request.URL.Query()[name]
+	if !ok {
+		return false, nil
+	}
+	if len(values) == 0 || values[0] == "" {
+		return true, nil
+	}
+	value, err := strconv.ParseBool(values[0])
+	if err != nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation(name, "must be a boolean")
+		return false, &verr
+	}
+	return value, nil
+}
+
+func inspectMeshAdmin(
+	dumper *admin.MeshConfigDumper,
+	adminAccess access.EnvoyAdminAccess,
+) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		ctx := request.Request.Context()
+		meshName := request.PathParameter("mesh")
+
+		if err := adminAccess.ValidateViewConfigDump(user.FromCtx(ctx)); err != nil {
+			rest_errors.HandleError(response, err, "Could not execute admin operation")
+			return
+		}
+
+		opts, err := configDumpOpts(ctx, request, adminAccess)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not execute admin operation")
+			return
+		}
+
+		concurrency := admin.DefaultMeshConfigDumpConcurrency
+		if value := request.QueryParameter("concurrency"); value != "" {
+			if concurrency, err = strconv.Atoi(value); err != nil || concurrency <= 0 {
+				verr := validators.ValidationError{}
+				verr.AddViolation("concurrency", "must be a positive integer")
+				rest_errors.HandleError(response, &verr, "Could not execute admin operation")
+				return
+			}
+		}
+
+		// The archive is streamed to the response. Nothing is written when dataplanes cannot be listed,
+		// so we can still respond with an error in this case.
+		response.Header().Set("Content-Type", "application/gzip")
+		response.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", meshName+"-envoy-config.tar.gz"))
+		if err := dumper.ConfigDumpForMesh(ctx, meshName, concurrency, opts, response); err != nil {
+			response.Header().Del("Content-Disposition")
+			rest_errors.HandleError(response, err, "Could not export XDS configuration")
+			return
+		}
+	}
 }
 
 func inspectDataplaneAdmin(
-	adminFn func(context.Context, core_model.ResourceWithAddress) ([]byte, error),
+	adminFn envoyAdminFn,
 	access func(user.User) error,
 	rm manager.ResourceManager,
 ) restful.RouteFunction {
@@ -107,7 +407,7 @@ func inspectDataplaneAdmin(
 			return
 		}
 
-		stats, err := adminFn(ctx, dp)
+		stats, err := adminFn(ctx, request, dp)
 		if err != nil {
 			rest_errors.HandleError(response, err, "Could not execute admin operation")
 			return
@@ -120,10 +420,151 @@ func inspectDataplaneAdmin(
 	}
 }
 
+// forwardDataplaneAdmin forwards the request to any endpoint of the Envoy Admin API of the dataplane and streams back the response of Envoy.
+// Contrary to other admin operations, the response is not processed, so config dumps are not redacted and the permission is required
+// to access any endpoint.
+func forwardDataplaneAdmin(
+	envoyAdminClient admin.EnvoyAdminClient,
+	adminAccess access.EnvoyAdminAccess,
+	rm manager.ResourceManager,
+) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		ctx := request.Request.Context()
+		meshName := request.PathParameter("mesh")
+		dataplaneName := request.PathParameter("dataplane")
+
+		if err := adminAccess.ValidateProxyAdmin(user.FromCtx(ctx)); err != nil {
+			rest_errors.HandleError(response, err, "Could not execute admin operation")
+			return
+		}
+
+		dp := core_mesh.NewDataplaneResource()
+		if err := rm.Get(ctx, dp, store.GetByKey(dataplaneName, meshName)); err != nil {
+			rest_errors.HandleError(response, err, "Could not get dataplane resource")
+			return
+		}
+
+		envoyResponse, err := envoyAdminClient.Forward(ctx, dp, request.Request.Method, request.PathParameter("path"), request.Request.URL.Query(), request.Request.Body)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not execute admin operation")
+			return
+		}
+		defer envoyResponse.Body.Close()
+
+		for name, values := range envoyResponse.Header {
+			for _, value := range values {
+				response.Header().Add(name, value)
+			}
+		}
+		response.WriteHeader(envoyResponse.StatusCode)
+		// the response is flushed after every read, so streaming endpoints like /tap are usable
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := envoyResponse.Body.Read(buf)
+			if n > 0 {
+				if _, err := response.Write(buf[:n]); err != nil {
+					return
+				}
+				response.Flush()
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// tapDataplaneAdmin opens a tap session on the dataplane and streams back captured traffic until the request is cancelled.
+// Same as forwarding, it requires the permission to access any endpoint, because the traffic is not redacted.
+func tapDataplaneAdmin(
+	envoyAdminClient admin.EnvoyAdminClient,
+	adminAccess access.EnvoyAdminAccess,
+	rm manager.ResourceManager,
+) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		ctx := request.Request.Context()
+		meshName := request.PathParameter("mesh")
+		dataplaneName := request.PathParameter("dataplane")
+
+		if err := adminAccess.ValidateProxyAdmin(user.FromCtx(ctx)); err != nil {
+			rest_errors.HandleError(response, err, "Could not execute admin operation")
+			return
+		}
+
+		opts, err := tailLogsOpts(request)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not execute admin operation")
+			return
+		}
+
+		dp := core_mesh.NewDataplaneResource()
+		if err := rm.Get(ctx, dp, store.GetByKey(dataplaneName, meshName)); err != nil {
+			rest_errors.HandleError(response, err, "Could not get dataplane resource")
+			return
+		}
+
+		stream, err := envoyAdminClient.TailLogs(ctx, dp, opts)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not execute admin operation")
+			return
+		}
+		defer stream.Close()
+
+		response.Header().Set("Content-Type", "application/json")
+		response.WriteHeader(http.StatusOK)
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := stream.Read(buf)
+			if n > 0 {
+				if _, err := response.Write(buf[:n]); err != nil {
+					return
+				}
+				response.Flush()
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func tailLogsOpts(request *restful.Request) (admin.TailLogsOpts, error) {
+	opts := admin.TailLogsOpts{
+		ConfigID: envoy_listeners_v3.TapConfigID,
+	}
+	verr := validators.ValidationError{}
+	for _, match := range request.QueryParameters("match") {
+		name, value, ok := strings.Cut(match, "=")
+		if !ok || name == "" {
+			verr.AddViolation("match", "must be in the name=value format")
+			continue
+		}
+		if opts.RequestHeaders == nil {
+			opts.RequestHeaders = map[string]string{}
+		}
+		opts.RequestHeaders[strings.ToLower(name)] = value
+	}
+	if value := request.QueryParameter("max_buffered_bytes"); value != "" {
+		maxBufferedBytes, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			verr.AddViolation("max_buffered_bytes", "must be a non-negative integer")
+		}
+		opts.MaxBufferedBytes = uint32(maxBufferedBytes)
+	}
+	opts.LogLevel = admin.LogLevel(request.QueryParameter("log_level"))
+	if err := opts.LogLevel.Validate(); err != nil {
+		verr.AddViolation("log_level", err.Error())
+	}
+	if err := verr.OrNil(); err != nil {
+		return admin.TailLogsOpts{}, err
+	}
+	return opts, nil
+}
+
 func inspectZoneIngressAdmin(
 	mode core.CpMode,
 	localZone string,
-	adminFn func(context.Context, core_model.ResourceWithAddress) ([]byte, error),
+	adminFn envoyAdminFn,
 	access func(user.User) error,
 	rm manager.ResourceManager,
 ) restful.RouteFunction {
@@ -148,7 +589,7 @@ func inspectZoneIngressAdmin(
 			return
 		}
 
-		stats, err := adminFn(ctx, zi)
+		stats, err := adminFn(ctx, request, zi)
 		if err != nil {
 			rest_errors.HandleError(response, err, "Could not execute admin operation")
 			return
@@ -162,7 +603,7 @@ func inspectZoneIngressAdmin(
 }
 
 func inspectZoneEgressAdmin(
-	adminFn func(context.Context, core_model.ResourceWithAddress) ([]byte, error),
+	adminFn envoyAdminFn,
 	access func(user.User) error,
 	rm manager.ResourceManager,
 ) restful.RouteFunction {
@@ -181,7 +622,7 @@ func inspectZoneEgressAdmin(
 			return
 		}
 
-		stats, err := adminFn(ctx, ze)
+		stats, err := adminFn(ctx, request, ze)
 		if err != nil {
 			rest_errors.HandleError(response, err, "Could not execute admin operation")
 			return
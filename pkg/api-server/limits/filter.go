@@ -0,0 +1,108 @@
+package limits
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+)
+
+var log = core.Log.WithName("api-server").WithName("limits")
+
+const (
+	reasonClientIPRateLimit = "client_ip_rate_limit"
+	reasonTokenRateLimit    = "token_rate_limit"
+	reasonBodyTooLarge      = "body_too_large"
+)
+
+type filter struct {
+	maxRequestBodySize int64
+	perClientIP        *keyedLimiter
+	perToken           *keyedLimiter
+	rejected           *prometheus.CounterVec
+}
+
+// NewFilter returns the filter that rejects the requests above the rate limits with 429 and the requests
+// with the body bigger than the limit with 413.
+// The filter is meant to be installed before the authentication, so the clients are rejected as early as possible.
+func NewFilter(cfg api_server.ApiServerLimits, registerer prometheus.Registerer) (restful.FilterFunction, error) {
+	rejected := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_server_rejected_requests",
+		Help: "Number of requests rejected by the limits of the API Server",
+	}, []string{"reason"})
+	if err := registerer.Register(rejected); err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if !errors.As(err, &alreadyRegistered) {
+			return nil, err
+		}
+		rejected = alreadyRegistered.ExistingCollector.(*prometheus.CounterVec)
+	}
+	f := &filter{
+		maxRequestBodySize: cfg.MaxRequestBodySize,
+		rejected:           rejected,
+	}
+	if cfg.PerClientIP.RequestsPerSecond > 0 {
+		f.perClientIP = newKeyedLimiter(cfg.PerClientIP.RequestsPerSecond, cfg.PerClientIP.Burst, time.Now)
+	}
+	if cfg.PerToken.RequestsPerSecond > 0 {
+		f.perToken = newKeyedLimiter(cfg.PerToken.RequestsPerSecond, cfg.PerToken.Burst, time.Now)
+	}
+	return f.filter, nil
+}
+
+func (f *filter) filter(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	if f.perClientIP != nil {
+		host, _, err := net.SplitHostPort(request.Request.RemoteAddr)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not parse Remote Address from the Request")
+			return
+		}
+		if ok, retryAfter := f.perClientIP.allow(host); !ok {
+			log.V(1).Info("rate limit of the client IP exceeded", "clientIP", host)
+			f.reject(response, reasonClientIPRateLimit, retryAfter)
+			return
+		}
+	}
+	if f.perToken != nil {
+		if token := request.HeaderParameter("Authorization"); token != "" {
+			if ok, retryAfter := f.perToken.allow(token); !ok {
+				log.V(1).Info("rate limit of the token exceeded")
+				f.reject(response, reasonTokenRateLimit, retryAfter)
+				return
+			}
+		}
+	}
+	if f.maxRequestBodySize > 0 {
+		if request.Request.ContentLength > f.maxRequestBodySize {
+			f.rejected.WithLabelValues(reasonBodyTooLarge).Inc()
+			rest_errors.WriteError(response, http.StatusRequestEntityTooLarge, types.Error{
+				Title:   "Request rejected",
+				Details: fmt.Sprintf("Request body is bigger than the limit of %d bytes", f.maxRequestBodySize),
+			})
+			return
+		}
+		// the body without Content-Length can still exceed the limit, in which case reading it fails
+		request.Request.Body = http.MaxBytesReader(response, request.Request.Body, f.maxRequestBodySize)
+	}
+	chain.ProcessFilter(request, response)
+}
+
+func (f *filter) reject(response *restful.Response, reason string, retryAfter time.Duration) {
+	f.rejected.WithLabelValues(reason).Inc()
+	response.AddHeader("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	rest_errors.WriteError(response, http.StatusTooManyRequests, types.Error{
+		Title:   "Request rejected",
+		Details: "Too many requests, retry later",
+	})
+}
@@ -0,0 +1,162 @@
+package limits_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/api-server/limits"
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	test_metrics "github.com/kumahq/kuma/pkg/test/metrics"
+)
+
+var _ = Describe("Limits filter", func() {
+	var metrics core_metrics.Metrics
+	var container *restful.Container
+
+	setup := func(cfg api_server.ApiServerLimits) {
+		var err error
+		metrics, err = core_metrics.NewMetrics("Standalone")
+		Expect(err).ToNot(HaveOccurred())
+		filter, err := limits.NewFilter(cfg, metrics)
+		Expect(err).ToNot(HaveOccurred())
+
+		container = restful.NewContainer()
+		container.Filter(filter)
+		ws := new(restful.WebService)
+		ws.Route(ws.POST("/echo").To(func(request *restful.Request, response *restful.Response) {
+			body, err := io.ReadAll(request.Request.Body)
+			if err != nil {
+				rest_errors.HandleError(response, err, "Could not read the body")
+				return
+			}
+			_, _ = response.Write(body)
+		}))
+		container.Add(ws)
+	}
+
+	send := func(remoteAddr string, token string, body io.Reader) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/echo", body)
+		req.RemoteAddr = remoteAddr
+		if token != "" {
+			req.Header.Set("Authorization", token)
+		}
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	rejected := func(reason string) float64 {
+		return test_metrics.FindMetric(metrics, "api_server_rejected_requests", "reason", reason).GetCounter().GetValue()
+	}
+
+	It("should reject requests of the client IP above the rate limit", func() {
+		// given
+		setup(api_server.ApiServerLimits{
+			PerClientIP: api_server.ApiServerClientIPRateLimit{
+				RequestsPerSecond: 0.001,
+				Burst:             2,
+			},
+		})
+
+		// when
+		Expect(send("192.168.0.1:1234", "", nil).Code).To(Equal(200))
+		Expect(send("192.168.0.1:1235", "", nil).Code).To(Equal(200))
+		resp := send("192.168.0.1:1236", "", nil)
+
+		// then
+		Expect(resp.Code).To(Equal(429))
+		Expect(resp.Header().Get("Retry-After")).ToNot(BeEmpty())
+		Expect(resp.Body.String()).To(MatchJSON(`
+		{
+			"title": "Request rejected",
+			"details": "Too many requests, retry later"
+		}`))
+		Expect(rejected("client_ip_rate_limit")).To(Equal(1.0))
+
+		// and other clients are not affected
+		Expect(send("192.168.0.2:1234", "", nil).Code).To(Equal(200))
+	})
+
+	It("should reject requests with the token above the rate limit", func() {
+		// given
+		setup(api_server.ApiServerLimits{
+			PerToken: api_server.ApiServerTokenRateLimit{
+				RequestsPerSecond: 0.001,
+				Burst:             1,
+			},
+		})
+
+		// when
+		Expect(send("192.168.0.1:1234", "Bearer a", nil).Code).To(Equal(200))
+		resp := send("192.168.0.2:1234", "Bearer a", nil)
+
+		// then
+		Expect(resp.Code).To(Equal(429))
+		Expect(rejected("token_rate_limit")).To(Equal(1.0))
+
+		// and other tokens and requests without the token are not affected
+		Expect(send("192.168.0.1:1234", "Bearer b", nil).Code).To(Equal(200))
+		Expect(send("192.168.0.1:1234", "", nil).Code).To(Equal(200))
+		Expect(send("192.168.0.1:1234", "", nil).Code).To(Equal(200))
+	})
+
+	It("should reject requests with the body above the limit", func() {
+		// given
+		setup(api_server.ApiServerLimits{
+			MaxRequestBodySize: 4,
+		})
+
+		// when
+		resp := send("192.168.0.1:1234", "", strings.NewReader("12345"))
+
+		// then
+		Expect(resp.Code).To(Equal(413))
+		Expect(resp.Body.String()).To(MatchJSON(`
+		{
+			"title": "Request rejected",
+			"details": "Request body is bigger than the limit of 4 bytes"
+		}`))
+		Expect(rejected("body_too_large")).To(Equal(1.0))
+
+		// and the body within the limit is accepted
+		resp = send("192.168.0.1:1234", "", strings.NewReader("1234"))
+		Expect(resp.Code).To(Equal(200))
+		Expect(resp.Body.String()).To(Equal("1234"))
+	})
+
+	It("should reject requests with the body of unknown length above the limit", func() {
+		// given
+		setup(api_server.ApiServerLimits{
+			MaxRequestBodySize: 4,
+		})
+
+		// when
+		resp := send("192.168.0.1:1234", "", io.MultiReader(strings.NewReader("123"), strings.NewReader("45")))
+
+		// then
+		Expect(resp.Code).To(Equal(413))
+		Expect(resp.Body.String()).To(MatchJSON(`
+		{
+			"title": "Could not read the body",
+			"details": "Request body is bigger than the limit"
+		}`))
+	})
+
+	It("should not limit requests when the limits are disabled", func() {
+		// given
+		setup(api_server.ApiServerLimits{})
+
+		// expect
+		for i := 0; i < 10; i++ {
+			Expect(send("192.168.0.1:1234", "Bearer a", strings.NewReader("body")).Code).To(Equal(http.StatusOK))
+		}
+	})
+})
@@ -0,0 +1,69 @@
+package limits
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// idleLimiterTTL is the time after which the limiter of a key that did not send any request is removed.
+const idleLimiterTTL = 10 * time.Minute
+
+type keyedLimiter struct {
+	sync.Mutex
+	limit     rate.Limit
+	burst     int
+	limiters  map[string]*limiterEntry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newKeyedLimiter(requestsPerSecond float64, burst int, now func() time.Time) *keyedLimiter {
+	return &keyedLimiter{
+		limit:     rate.Limit(requestsPerSecond),
+		burst:     burst,
+		limiters:  map[string]*limiterEntry{},
+		lastSweep: now(),
+		now:       now,
+	}
+}
+
+// allow returns whether the request of the key is allowed and if not, how long the client should wait before retrying.
+func (k *keyedLimiter) allow(key string) (bool, time.Duration) {
+	k.Lock()
+	defer k.Unlock()
+	now := k.now()
+	k.sweep(now)
+	entry, ok := k.limiters[key]
+	if !ok {
+		entry = &limiterEntry{limiter: rate.NewLimiter(k.limit, k.burst)}
+		k.limiters[key] = entry
+	}
+	entry.lastSeen = now
+	reservation := entry.limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return true, 0
+	}
+	reservation.CancelAt(now)
+	return false, delay
+}
+
+// sweep removes the limiters of the keys that are idle, so the clients that come and go don't accumulate in memory.
+func (k *keyedLimiter) sweep(now time.Time) {
+	if now.Sub(k.lastSweep) < idleLimiterTTL {
+		return
+	}
+	for key, entry := range k.limiters {
+		if now.Sub(entry.lastSeen) >= idleLimiterTTL {
+			delete(k.limiters, key)
+		}
+	}
+	k.lastSweep = now
+}
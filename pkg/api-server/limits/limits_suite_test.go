@@ -0,0 +1,11 @@
+package limits_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestLimits(t *testing.T) {
+	test.RunSpecs(t, "API Server Limits Suite")
+}
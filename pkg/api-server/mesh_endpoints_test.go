@@ -55,7 +55,8 @@ var _ = Describe("Resource Endpoints", func() {
 				"type": "Mesh",
 				"name": "mesh-1",
 				"creationTime": "2018-07-17T16:05:36.995Z",
-				"modificationTime": "2018-07-17T16:05:36.995Z"
+				"modificationTime": "2018-07-17T16:05:36.995Z",
+				"revision": "1"
 			}`
 			Expect(body).To(MatchJSON(json))
 		})
@@ -83,14 +84,16 @@ var _ = Describe("Resource Endpoints", func() {
 				"type": "Mesh",
 				"name": "mesh-1",
 				"creationTime": "2018-07-17T16:05:36.995Z",
-				"modificationTime": "2018-07-17T16:05:36.995Z"
+				"modificationTime": "2018-07-17T16:05:36.995Z",
+				"revision": "1"
 			}`
 			json2 := `
 			{
 				"type": "Mesh",
 				"name": "mesh-2",
 				"creationTime": "2018-07-17T16:05:36.995Z",
-				"modificationTime": "2018-07-17T16:05:36.995Z"
+				"modificationTime": "2018-07-17T16:05:36.995Z",
+				"revision": "1"
 			}`
 			body, err := io.ReadAll(response.Body)
 			Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,103 @@
+package api_server
+
+import (
+	"context"
+
+	"github.com/emicklei/go-restful"
+
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/access"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+type meshInsightsEndpoints struct {
+	resManager     manager.ResourceManager
+	resourceAccess access.ResourceAccess
+}
+
+func (r *meshInsightsEndpoints) addListEndpoint(ws *restful.WebService) {
+	ws.Route(ws.GET("/meshes+insights").To(r.inspectMeshes).
+		Doc("Inspect all meshes with aggregated statistics of dataplanes, policies and mTLS").
+		Returns(200, "OK", nil))
+}
+
+func (r *meshInsightsEndpoints) inspectMeshes(request *restful.Request, response *restful.Response) {
+	if err := r.resourceAccess.ValidateList(
+		"",
+		mesh.NewMeshResource().Descriptor(),
+		user.FromCtx(request.Request.Context()),
+	); err != nil {
+		rest_errors.HandleError(response, err, "Access Denied")
+		return
+	}
+
+	entries, err := r.fetchMeshInsights(request.Request.Context())
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve mesh insights")
+		return
+	}
+
+	if err := response.WriteAsJson(entries); err != nil {
+		rest_errors.HandleError(response, err, "Could not list mesh insights")
+	}
+}
+
+func (r *meshInsightsEndpoints) fetchMeshInsights(ctx context.Context) (*api_server_types.MeshInsightsEntryList, error) {
+	meshes := mesh.MeshResourceList{}
+	if err := r.resManager.List(ctx, &meshes); err != nil {
+		return nil, err
+	}
+
+	insights := mesh.MeshInsightResourceList{}
+	if err := r.resManager.List(ctx, &insights); err != nil {
+		return nil, err
+	}
+	insightsByMesh := map[string]*mesh.MeshInsightResource{}
+	for _, insight := range insights.Items {
+		insightsByMesh[insight.GetMeta().GetName()] = insight
+	}
+
+	entries := api_server_types.NewMeshInsightsEntryList()
+	for _, meshRes := range meshes.Items {
+		// a mesh may not have an insight yet if it was just created
+		entries.Items = append(entries.Items, newMeshInsightsEntry(meshRes, insightsByMesh[meshRes.GetMeta().GetName()]))
+	}
+	entries.Total = uint32(len(entries.Items))
+	return entries, nil
+}
+
+func newMeshInsightsEntry(meshRes *mesh.MeshResource, insight *mesh.MeshInsightResource) api_server_types.MeshInsightsEntry {
+	entry := api_server_types.MeshInsightsEntry{
+		Mesh:     meshRes.GetMeta().GetName(),
+		Policies: map[string]uint32{},
+	}
+	if backend := meshRes.GetEnabledCertificateAuthorityBackend(); backend != nil {
+		entry.MTLS.EnabledBackend = backend.GetName()
+		entry.MTLS.BackendType = backend.GetType()
+	}
+	if insight == nil {
+		return entry
+	}
+
+	dataplanes := insight.Spec.GetDataplanes()
+	entry.Dataplanes = api_server_types.MeshDataplanesStat{
+		Total:             dataplanes.GetTotal(),
+		Online:            dataplanes.GetOnline(),
+		Offline:           dataplanes.GetOffline(),
+		PartiallyDegraded: dataplanes.GetPartiallyDegraded(),
+	}
+	for policyType, stat := range insight.Spec.GetPolicies() {
+		entry.Policies[policyType] = stat.GetTotal()
+	}
+	for backend, stat := range insight.Spec.GetMTLS().GetIssuedBackends() {
+		if entry.MTLS.IssuedBackends == nil {
+			entry.MTLS.IssuedBackends = map[string]uint32{}
+		}
+		entry.MTLS.IssuedBackends[backend] = stat.GetTotal()
+		entry.MTLS.IssuedCertificates += stat.GetTotal()
+	}
+	return entry
+}
@@ -0,0 +1,101 @@
+package api_server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Mesh Insights Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop = func() {}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		apiServer, stop = StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore))
+	})
+
+	AfterEach(func() {
+		stop()
+	})
+
+	BeforeEach(func() {
+		meshWithMTLS := &core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					EnabledBackend: "ca-1",
+					Backends: []*mesh_proto.CertificateAuthorityBackend{
+						{Name: "ca-1", Type: "builtin"},
+					},
+				},
+			},
+		}
+		Expect(resourceStore.Create(context.Background(), meshWithMTLS, store.CreateByKey("mesh-1", core_model.NoMesh))).To(Succeed())
+		Expect(resourceStore.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-2", core_model.NoMesh))).To(Succeed())
+
+		insight := &core_mesh.MeshInsightResource{
+			Spec: &mesh_proto.MeshInsight{
+				Dataplanes: &mesh_proto.MeshInsight_DataplaneStat{
+					Total:   3,
+					Online:  2,
+					Offline: 1,
+				},
+				Policies: map[string]*mesh_proto.MeshInsight_PolicyStat{
+					string(core_mesh.TrafficPermissionType): {Total: 2},
+				},
+				MTLS: &mesh_proto.MeshInsight_MTLS{
+					IssuedBackends: map[string]*mesh_proto.MeshInsight_DataplaneStat{
+						"ca-1": {Total: 2, Online: 2},
+					},
+				},
+			},
+		}
+		Expect(resourceStore.Create(context.Background(), insight, store.CreateByKey("mesh-1", core_model.NoMesh))).To(Succeed())
+	})
+
+	It("should return meshes with aggregated insights", func() {
+		// when
+		response, err := http.Get("http://" + apiServer.Address() + "/meshes+insights")
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`
+{
+  "total": 2,
+  "items": [
+    {
+      "mesh": "mesh-1",
+      "dataplanes": {"total": 3, "online": 2, "offline": 1, "partiallyDegraded": 0},
+      "policies": {"TrafficPermission": 2},
+      "mtls": {
+        "enabledBackend": "ca-1",
+        "backendType": "builtin",
+        "issuedCertificates": 2,
+        "issuedBackends": {"ca-1": 2}
+      }
+    },
+    {
+      "mesh": "mesh-2",
+      "dataplanes": {"total": 0, "online": 0, "offline": 0, "partiallyDegraded": 0},
+      "policies": {},
+      "mtls": {"issuedCertificates": 0}
+    }
+  ]
+}
+`))
+	})
+})
@@ -0,0 +1,27 @@
+package api_server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/openapi"
+	kuma_version "github.com/kumahq/kuma/pkg/version"
+)
+
+// addOpenApiEndpoint serves the OpenAPI document of the resources endpoints.
+// The document is generated once, the descriptors are expected to have ReadOnly flags of the current mode.
+func addOpenApiEndpoint(ws *restful.WebService, descriptors []model.ResourceTypeDescriptor) error {
+	doc, err := openapi.NewDocument(descriptors, kuma_version.Build.Version).YAML()
+	if err != nil {
+		return err
+	}
+	ws.Route(ws.GET("/api/openapi.yaml").To(func(req *restful.Request, resp *restful.Response) {
+		resp.AddHeader("content-type", "application/yaml")
+		if _, err := resp.Write(doc); err != nil {
+			log.Error(err, "Could not write the OpenAPI document")
+		}
+	}).
+		Doc("Returns OpenAPI document of the resources endpoints").
+		Produces("application/yaml"))
+	return nil
+}
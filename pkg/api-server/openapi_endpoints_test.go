@@ -0,0 +1,66 @@
+package api_server_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	config_api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core/resources/openapi"
+)
+
+var _ = Describe("OpenAPI Endpoint", func() {
+	var apiServer *api_server.ApiServer
+	var stop = func() {}
+
+	AfterEach(func() {
+		stop()
+	})
+
+	get := func() *openapi.Document {
+		resp, err := http.Get(fmt.Sprintf("http://%s/api/openapi.yaml", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(200))
+		Expect(resp.Header.Get("content-type")).To(Equal("application/yaml"))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		doc := &openapi.Document{}
+		Expect(yaml.Unmarshal(body, doc)).To(Succeed())
+		return doc
+	}
+
+	It("should return the document of the resources endpoints", func() {
+		// given
+		apiServer, stop = StartApiServer(NewTestApiServerConfigurer())
+
+		// when
+		doc := get()
+
+		// then
+		Expect(doc.Components.Schemas).To(HaveKey("Mesh"))
+		Expect(doc.Components.Schemas).To(HaveKey("SampleTrafficRoute"))
+		Expect(doc.Paths).To(HaveKey("/meshes/{mesh}/sample-traffic-routes/{name}"))
+		Expect(doc.Paths["/meshes/{mesh}/sample-traffic-routes/{name}"].Put).ToNot(BeNil())
+	})
+
+	It("should not describe modifications of read only resources", func() {
+		// given
+		apiServer, stop = StartApiServer(NewTestApiServerConfigurer().WithConfigMutator(func(config *config_api_server.ApiServerConfig) {
+			config.ReadOnly = true
+		}))
+
+		// when
+		doc := get()
+
+		// then
+		item := doc.Paths["/meshes/{mesh}/sample-traffic-routes/{name}"]
+		Expect(item.Get).ToNot(BeNil())
+		Expect(item.Put).To(BeNil())
+		Expect(item.Delete).To(BeNil())
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/emicklei/go-restful"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/resources/access"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/fieldmanager"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
@@ -35,12 +37,16 @@ type resourceEndpoints struct {
 	resManager     manager.ResourceManager
 	descriptor     model.ResourceTypeDescriptor
 	resourceAccess access.ResourceAccess
+	watchHistory   *watchHistory
+	fieldManagers  *fieldmanager.Store
 }
 
 func (r *resourceEndpoints) addFindEndpoint(ws *restful.WebService, pathPrefix string) {
 	ws.Route(ws.GET(pathPrefix+"/{name}").To(r.findResource).
 		Doc(fmt.Sprintf("Get a %s", r.descriptor.WsPath)).
 		Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.descriptor.Name)).DataType("string")).
+		Param(ws.QueryParameter("fields", "comma separated fields to return, for example name,networking.address").DataType("string")).
+		Param(ws.QueryParameter("exclude", "comma separated fields not to return, spec excludes all fields except the meta").DataType("string")).
 		Returns(200, "OK", nil).
 		Returns(404, "Not found", nil))
 }
@@ -58,12 +64,18 @@ func (r *resourceEndpoints) findResource(request *restful.Request, response *res
 		return
 	}
 
-	resource := r.descriptor.NewObject()
-	err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName))
+	fields, err := fieldsFromRequest(request)
 	if err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve a resource")
+		return
+	}
+
+	resource := r.descriptor.NewObject()
+	if err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName)); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a resource")
 	} else {
 		res := rest.From.Resource(resource)
+		res.Fields = fields
 		if err := response.WriteAsJson(res); err != nil {
 			core.Log.Error(err, "Could not write the response")
 		}
@@ -75,6 +87,12 @@ func (r *resourceEndpoints) addListEndpoint(ws *restful.WebService, pathPrefix s
 		Doc(fmt.Sprintf("List of %s", r.descriptor.Name)).
 		Param(ws.PathParameter("size", "size of page").DataType("int")).
 		Param(ws.PathParameter("offset", "offset of page to list").DataType("string")).
+		Param(ws.QueryParameter("tag", "filter by tag in format of key:value. Multiple tags can be provided").DataType("string")).
+		Param(ws.QueryParameter("watch", "stream the changes of the resources as newline delimited JSON events or as server-sent events instead of returning the list").DataType("boolean")).
+		Param(ws.QueryParameter("resumeToken", "resume the watch after the event with this token").DataType("string")).
+		Param(ws.QueryParameter("fields", "comma separated fields of the resources to return, for example name,networking.address").DataType("string")).
+		Param(ws.QueryParameter("exclude", "comma separated fields of the resources not to return, spec excludes all fields except the meta").DataType("string")).
+		Produces(restful.MIME_JSON, mimeEventStream).
 		Returns(200, "OK", nil))
 }
 
@@ -82,6 +100,7 @@ func (r *resourceEndpoints) listResources(request *restful.Request, response *re
 	meshName := r.meshFromRequest(request)
 
 	if err := r.resourceAccess.ValidateList(
+		meshName,
 		r.descriptor,
 		user.FromCtx(request.Request.Context()),
 	); err != nil {
@@ -95,12 +114,35 @@ func (r *resourceEndpoints) listResources(request *restful.Request, response *re
 		return
 	}
 
+	tags, err := r.tagsFromRequest(request)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve resources")
+		return
+	}
+
+	fields, err := fieldsFromRequest(request)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve resources")
+		return
+	}
+
+	watch, err := flagQueryParameter(request, "watch")
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve resources")
+		return
+	}
+	if watch {
+		r.watchResources(request, response, meshName, tags, fields)
+		return
+	}
+
 	list := r.descriptor.NewList()
-	if err := r.resManager.List(request.Request.Context(), list, store.ListByMesh(meshName), store.ListByPage(page.size, page.offset)); err != nil {
+	if err := r.resManager.List(request.Request.Context(), list, store.ListByMesh(meshName), store.ListByPage(page.size, page.offset), store.ListByTags(tags)); err != nil {
 		rest_errors.HandleError(response, err, "Could not retrieve resources")
 	} else {
 		restList := rest.From.ResourceList(list)
 		restList.Next = nextLink(request, list.GetPagination().NextOffset)
+		restList.SelectFields(fields)
 		if err := response.WriteAsJson(restList); err != nil {
 			rest_errors.HandleError(response, err, "Could not list resources")
 		}
@@ -116,6 +158,7 @@ func (r *resourceEndpoints) addCreateOrUpdateEndpoint(ws *restful.WebService, pa
 		ws.Route(ws.PUT(pathPrefix+"/{name}").To(r.createOrUpdateResource).
 			Doc(fmt.Sprintf("Updates a %s", r.descriptor.WsPath)).
 			Param(ws.PathParameter("name", fmt.Sprintf("Name of the %s", r.descriptor.WsPath)).DataType("string")).
+			Param(ws.QueryParameter("dryRun", "validate and default the resource without persisting it. The resulting resource is returned").DataType("boolean")).
 			Returns(200, "OK", nil).
 			Returns(201, "Created", nil))
 	}
@@ -139,19 +182,30 @@ func (r *resourceEndpoints) createOrUpdateResource(request *restful.Request, res
 		return
 	}
 
+	dryRun, err := flagQueryParameter(request, "dryRun")
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not process a resource")
+		return
+	}
+
 	resource := r.descriptor.NewObject()
 	if err := r.resManager.Get(request.Request.Context(), resource, store.GetByKey(name, meshName)); err != nil {
 		if store.IsResourceNotFound(err) {
-			r.createResource(request.Request.Context(), name, meshName, resourceRes.Spec, response)
+			r.createResource(request.Request.Context(), name, meshName, resourceRes.Spec, dryRun, response)
 		} else {
 			rest_errors.HandleError(response, err, "Could not find a resource")
 		}
 	} else {
-		r.updateResource(request.Request.Context(), resource, resourceRes, response)
+		// the revision is optional, without it the resource is updated regardless of the changes made in the meantime
+		if revision := resourceRes.Meta.Revision; revision != "" && revision != resource.GetMeta().GetVersion() {
+			rest_errors.HandleError(response, store.ErrorResourceConflict(r.descriptor.Name, name, meshName), "Could not update a resource")
+			return
+		}
+		r.updateResource(request.Request.Context(), resource, resourceRes, dryRun, response)
 	}
 }
 
-func (r *resourceEndpoints) createResource(ctx context.Context, name string, meshName string, spec model.ResourceSpec, response *restful.Response) {
+func (r *resourceEndpoints) createResource(ctx context.Context, name string, meshName string, spec model.ResourceSpec, dryRun bool, response *restful.Response) {
 	if err := r.resourceAccess.ValidateCreate(
 		model.ResourceKey{Mesh: meshName, Name: name},
 		spec,
@@ -164,14 +218,31 @@ func (r *resourceEndpoints) createResource(ctx context.Context, name string, mes
 
 	res := r.descriptor.NewObject()
 	_ = res.SetSpec(spec)
-	if err := r.resManager.Create(ctx, res, store.CreateByKey(name, meshName)); err != nil {
+	opts := []store.CreateOptionsFunc{store.CreateByKey(name, meshName)}
+	if dryRun {
+		opts = append(opts, store.CreateDryRun())
+	}
+	if err := r.resManager.Create(ctx, res, opts...); err != nil {
 		rest_errors.HandleError(response, err, "Could not create a resource")
-	} else {
-		response.WriteHeader(201)
+		return
 	}
+	if dryRun {
+		// the resource is not persisted, so it has no meta
+		restRes := &rest.Resource{
+			Meta: rest.ResourceMeta{
+				Type: string(r.descriptor.Name),
+				Name: name,
+				Mesh: meshName,
+			},
+			Spec: res.GetSpec(),
+		}
+		r.writeDryRunResult(restRes, http.StatusCreated, response)
+		return
+	}
+	response.WriteHeader(201)
 }
 
-func (r *resourceEndpoints) updateResource(ctx context.Context, res model.Resource, restRes rest.Resource, response *restful.Response) {
+func (r *resourceEndpoints) updateResource(ctx context.Context, res model.Resource, restRes rest.Resource, dryRun bool, response *restful.Response) {
 	if err := r.resourceAccess.ValidateUpdate(
 		model.ResourceKey{Mesh: res.GetMeta().GetMesh(), Name: res.GetMeta().GetName()},
 		res.GetSpec(),
@@ -185,10 +256,25 @@ func (r *resourceEndpoints) updateResource(ctx context.Context, res model.Resour
 
 	_ = res.SetSpec(restRes.Spec)
 
-	if err := r.resManager.Update(ctx, res); err != nil {
+	var opts []store.UpdateOptionsFunc
+	if dryRun {
+		opts = append(opts, store.UpdateDryRun())
+	}
+	if err := r.resManager.Update(ctx, res, opts...); err != nil {
 		rest_errors.HandleError(response, err, "Could not update a resource")
-	} else {
-		response.WriteHeader(200)
+		return
+	}
+	if dryRun {
+		r.writeDryRunResult(rest.From.Resource(res), http.StatusOK, response)
+		return
+	}
+	response.WriteHeader(200)
+}
+
+// writeDryRunResult responds with the resource as it would be persisted, after validation and defaulting.
+func (r *resourceEndpoints) writeDryRunResult(res *rest.Resource, status int, response *restful.Response) {
+	if err := response.WriteHeaderAndJson(status, res, restful.MIME_JSON); err != nil {
+		core.Log.Error(err, "Could not write the response")
 	}
 }
 
@@ -262,6 +348,27 @@ func (r *resourceEndpoints) validateResourceRequest(request *restful.Request, re
 	return err.OrNil()
 }
 
+func (r *resourceEndpoints) tagsFromRequest(request *restful.Request) (map[string]string, error) {
+	tags := parseTags(request.QueryParameters("tag"))
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	if _, ok := r.descriptor.NewObject().GetSpec().(store.TagsMatcher); !ok {
+		verr := validators.ValidationError{}
+		verr.AddViolation("tag", fmt.Sprintf("filtering by tags is not supported for %s", r.descriptor.Name))
+		return nil, &verr
+	}
+	return tags, nil
+}
+
+// fieldsFromRequest returns the selector of the fields of the resources to return, nil when all fields are returned.
+func fieldsFromRequest(request *restful.Request) (*rest.FieldSelector, error) {
+	return rest.ParseFieldSelector(
+		strings.Join(request.QueryParameters("fields"), ","),
+		strings.Join(request.QueryParameters("exclude"), ","),
+	)
+}
+
 func (r *resourceEndpoints) meshFromRequest(request *restful.Request) string {
 	if r.descriptor.Scope == model.ScopeMesh {
 		return request.PathParameter("mesh")
@@ -270,7 +377,11 @@ func (r *resourceEndpoints) meshFromRequest(request *restful.Request) string {
 }
 
 func (r *resourceEndpoints) readOnlyMessage() string {
-	switch r.mode {
+	return readOnlyMessage(r.mode)
+}
+
+func readOnlyMessage(mode config_core.CpMode) string {
+	switch mode {
 	case config_core.Global:
 		return globalReadOnlyMessage
 	case config_core.Zone:
@@ -1,20 +1,26 @@
 package api_server_test
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/emicklei/go-restful"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	api_server "github.com/kumahq/kuma/pkg/api-server"
+	api_types "github.com/kumahq/kuma/pkg/api-server/types"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
 	core_metrics "github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	sample_proto "github.com/kumahq/kuma/pkg/test/apis/sample/v1alpha1"
@@ -28,6 +34,7 @@ var _ = Describe("Resource Endpoints", func() {
 	var client resourceApiClient
 	var stop = func() {}
 	var metrics core_metrics.Metrics
+	var eventBus *events.EventBus
 
 	const mesh = "default"
 
@@ -36,7 +43,8 @@ var _ = Describe("Resource Endpoints", func() {
 		m, err := core_metrics.NewMetrics("Standalone")
 		Expect(err).ToNot(HaveOccurred())
 		metrics = m
-		apiServer, stop = StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore).WithMetrics(m))
+		eventBus = events.NewEventBus()
+		apiServer, stop = StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore).WithMetrics(m).WithEventBus(eventBus))
 		client = resourceApiClient{
 			address: apiServer.Address(),
 			path:    "/meshes/" + mesh + "/sample-traffic-routes",
@@ -72,6 +80,7 @@ var _ = Describe("Resource Endpoints", func() {
 				"mesh": "default",
 				"creationTime": "0001-01-01T00:00:00Z",
 				"modificationTime": "0001-01-01T00:00:00Z",
+				"revision": "1",
 				"path": "/sample-path"
 			}`
 			Expect(body).To(MatchJSON(json))
@@ -112,6 +121,7 @@ var _ = Describe("Resource Endpoints", func() {
 				"mesh": "default",
 				"creationTime": "0001-01-01T00:00:00Z",
 				"modificationTime": "0001-01-01T00:00:00Z",
+				"revision": "1",
 				"path": "/sample-path"
 			}`
 			json2 := `
@@ -121,6 +131,7 @@ var _ = Describe("Resource Endpoints", func() {
 				"mesh": "default",
 				"creationTime": "0001-01-01T00:00:00Z",
 				"modificationTime": "0001-01-01T00:00:00Z",
+				"revision": "1",
 				"path": "/sample-path"
 			}`
 			body, err := io.ReadAll(response.Body)
@@ -131,6 +142,77 @@ var _ = Describe("Resource Endpoints", func() {
 			))
 		})
 
+		It("should return only selected fields of the resource", func() {
+			// given
+			putSampleResourceIntoStore(resourceStore, "tr-1", mesh)
+
+			// when
+			response, err := http.Get(client.fullAddress() + "/tr-1?fields=revision,path")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"type": "SampleTrafficRoute",
+				"name": "tr-1",
+				"mesh": "default",
+				"revision": "1",
+				"path": "/sample-path"
+			}`))
+		})
+
+		It("should list resources without specs", func() {
+			// given
+			putSampleResourceIntoStore(resourceStore, "tr-1", mesh)
+
+			// when
+			response, err := http.Get(client.fullAddress() + "?exclude=spec,creationTime,modificationTime")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"total": 1,
+				"items": [
+					{
+						"type": "SampleTrafficRoute",
+						"name": "tr-1",
+						"mesh": "default",
+						"revision": "1"
+					}
+				],
+				"next": null
+			}`))
+		})
+
+		It("should return 400 on invalid fields", func() {
+			// when
+			response, err := http.Get(client.fullAddress() + "?fields=path.")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(400))
+			body, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"title": "Could not retrieve resources",
+				"details": "Resource is not valid",
+				"causes": [
+					{
+						"field": "fields",
+						"message": "invalid field \"path.\""
+					}
+				]
+			}`))
+		})
+
 		It("should list resources from all meshes", func() {
 			// given
 			putSampleResourceIntoStore(resourceStore, "tr-1", "mesh-1")
@@ -152,6 +234,7 @@ var _ = Describe("Resource Endpoints", func() {
 				"mesh": "mesh-1",
 				"creationTime": "0001-01-01T00:00:00Z",
 				"modificationTime": "0001-01-01T00:00:00Z",
+				"revision": "1",
 				"path": "/sample-path"
 			}`
 			json2 := `
@@ -161,6 +244,7 @@ var _ = Describe("Resource Endpoints", func() {
 				"mesh": "mesh-2",
 				"creationTime": "0001-01-01T00:00:00Z",
 				"modificationTime": "0001-01-01T00:00:00Z",
+				"revision": "1",
 				"path": "/sample-path"
 			}`
 			body, err := io.ReadAll(response.Body)
@@ -196,6 +280,7 @@ var _ = Describe("Resource Endpoints", func() {
 						"mesh": "mesh-1",
 						"creationTime": "0001-01-01T00:00:00Z",
 						"modificationTime": "0001-01-01T00:00:00Z",
+						"revision": "1",
 						"path": "/sample-path"
 					},
 					{
@@ -204,6 +289,7 @@ var _ = Describe("Resource Endpoints", func() {
 						"mesh": "mesh-1",
 						"creationTime": "0001-01-01T00:00:00Z",
 						"modificationTime": "0001-01-01T00:00:00Z",
+						"revision": "1",
 						"path": "/sample-path"
 					}
 				],
@@ -232,6 +318,7 @@ var _ = Describe("Resource Endpoints", func() {
 						"mesh": "mesh-1",
 						"creationTime": "0001-01-01T00:00:00Z",
 				        "modificationTime": "0001-01-01T00:00:00Z",
+				        "revision": "1",
 						"path": "/sample-path"
 					}
 				],
@@ -322,6 +409,211 @@ var _ = Describe("Resource Endpoints", func() {
 			}
 			`))
 		})
+
+		It("should return 400 with error when resource does not support filtering by tags", func() {
+			// when
+			client = resourceApiClient{
+				address: apiServer.Address(),
+				path:    "/sample-traffic-routes?tag=kuma.io/service:backend",
+			}
+			response := client.list()
+
+			// then
+			Expect(response.StatusCode).To(Equal(400))
+			// and
+			bytes, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(MatchJSON(`
+			{
+				"title": "Could not retrieve resources",
+				"details": "Resource is not valid",
+				"causes": [
+					{
+						"field": "tag",
+						"message": "filtering by tags is not supported for SampleTrafficRoute"
+					}
+				]
+			}
+			`))
+		})
+	})
+
+	Describe("On GET with watch", func() {
+		watch := func(query string, header http.Header) *http.Response {
+			request, err := http.NewRequest("GET", "http://"+client.address+client.path+"?watch=true"+query, nil)
+			Expect(err).ToNot(HaveOccurred())
+			for k, v := range header {
+				request.Header[k] = v
+			}
+			response, err := http.DefaultClient.Do(request)
+			Expect(err).ToNot(HaveOccurred())
+			return response
+		}
+
+		readEvent := func(reader *bufio.Reader) api_types.ResourceWatchEvent {
+			line, err := reader.ReadBytes('\n')
+			Expect(err).ToNot(HaveOccurred())
+			event := api_types.ResourceWatchEvent{}
+			Expect(json.Unmarshal(line, &event)).To(Succeed())
+			Expect(event.ResumeToken).ToNot(BeEmpty())
+			return event
+		}
+
+		sendChange := func(op events.Op, typ model.ResourceType, mesh, name string) {
+			eventBus.Send(events.ResourceChangedEvent{
+				Operation: op,
+				Type:      typ,
+				Key:       model.ResourceKey{Mesh: mesh, Name: name},
+			})
+		}
+
+		It("should stream changes of resources", func() {
+			// given
+			response := watch("", nil)
+			defer response.Body.Close()
+			Expect(response.StatusCode).To(Equal(200))
+			reader := bufio.NewReader(response.Body)
+
+			// when
+			putSampleResourceIntoStore(resourceStore, "tr-1", mesh)
+			sendChange(events.Create, sample_model.TrafficRouteType, mesh, "tr-1")
+			sendChange(events.Create, sample_model.TrafficRouteType, "other", "tr-2")
+			sendChange(events.Create, core_mesh.MeshType, "", "other")
+			sendChange(events.Delete, sample_model.TrafficRouteType, mesh, "tr-1")
+
+			// then the stream starts with the resume token
+			Expect(readEvent(reader).Type).To(Equal(api_types.WatchEventBookmark))
+
+			// and
+			event := readEvent(reader)
+			Expect(event.Type).To(Equal(api_types.WatchEventAdded))
+			Expect(event.Resource).To(MatchJSON(`
+			{
+				"type": "SampleTrafficRoute",
+				"name": "tr-1",
+				"mesh": "default",
+				"creationTime": "0001-01-01T00:00:00Z",
+				"modificationTime": "0001-01-01T00:00:00Z",
+				"revision": "1",
+				"path": "/sample-path"
+			}`))
+
+			// and events of other meshes and types are skipped
+			event = readEvent(reader)
+			Expect(event.Type).To(Equal(api_types.WatchEventDeleted))
+			Expect(event.Resource).To(MatchJSON(`
+			{
+				"type": "SampleTrafficRoute",
+				"name": "tr-1",
+				"mesh": "default",
+				"creationTime": "0001-01-01T00:00:00Z",
+				"modificationTime": "0001-01-01T00:00:00Z"
+			}`))
+		})
+
+		It("should resume the watch after the event with the resume token", func() {
+			// given
+			response := watch("", nil)
+			reader := bufio.NewReader(response.Body)
+			Expect(readEvent(reader).Type).To(Equal(api_types.WatchEventBookmark))
+			putSampleResourceIntoStore(resourceStore, "tr-1", mesh)
+			putSampleResourceIntoStore(resourceStore, "tr-2", mesh)
+			sendChange(events.Create, sample_model.TrafficRouteType, mesh, "tr-1")
+			first := readEvent(reader)
+			Expect(first.Type).To(Equal(api_types.WatchEventAdded))
+			Expect(response.Body.Close()).To(Succeed())
+
+			// when changes are made while the client is disconnected
+			sendChange(events.Create, sample_model.TrafficRouteType, mesh, "tr-2")
+			sendChange(events.Delete, sample_model.TrafficRouteType, mesh, "tr-1")
+
+			// and the watch is resumed
+			response = watch("&resumeToken="+url.QueryEscape(first.ResumeToken), nil)
+			defer response.Body.Close()
+			Expect(response.StatusCode).To(Equal(200))
+			reader = bufio.NewReader(response.Body)
+
+			// then the changes since the token are replayed
+			bookmark := readEvent(reader)
+			Expect(bookmark.Type).To(Equal(api_types.WatchEventBookmark))
+			Expect(bookmark.ResumeToken).To(Equal(first.ResumeToken))
+			event := readEvent(reader)
+			Expect(event.Type).To(Equal(api_types.WatchEventAdded))
+			Expect(event.Resource).To(ContainSubstring(`"name":"tr-2"`))
+			event = readEvent(reader)
+			Expect(event.Type).To(Equal(api_types.WatchEventDeleted))
+			Expect(event.Resource).To(ContainSubstring(`"name":"tr-1"`))
+		})
+
+		It("should stream server-sent events and resume from Last-Event-ID", func() {
+			// given
+			response := watch("", nil)
+			bookmark := readEvent(bufio.NewReader(response.Body))
+			Expect(response.Body.Close()).To(Succeed())
+			putSampleResourceIntoStore(resourceStore, "tr-1", mesh)
+			sendChange(events.Create, sample_model.TrafficRouteType, mesh, "tr-1")
+
+			// when
+			response = watch("", http.Header{
+				"Accept":        []string{"text/event-stream"},
+				"Last-Event-Id": []string{bookmark.ResumeToken},
+			})
+			defer response.Body.Close()
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+			reader := bufio.NewReader(response.Body)
+			readLine := func() string {
+				line, err := reader.ReadString('\n')
+				Expect(err).ToNot(HaveOccurred())
+				return line
+			}
+			Expect(readLine()).To(Equal(fmt.Sprintf("id: %s\n", bookmark.ResumeToken)))
+			Expect(readLine()).To(Equal("event: BOOKMARK\n"))
+			Expect(readLine()).To(HavePrefix("data: "))
+			Expect(readLine()).To(Equal("\n"))
+
+			// and the change since the token is replayed
+			Expect(readLine()).To(HavePrefix("id: "))
+			Expect(readLine()).To(Equal("event: ADDED\n"))
+			data := strings.TrimPrefix(readLine(), "data: ")
+			event := api_types.ResourceWatchEvent{}
+			Expect(json.Unmarshal([]byte(data), &event)).To(Succeed())
+			Expect(event.Resource).To(ContainSubstring(`"name":"tr-1"`))
+		})
+
+		It("should return 410 when the resume token expired", func() {
+			// when
+			response := watch("&resumeToken=other-instance.1", nil)
+
+			// then
+			Expect(response.StatusCode).To(Equal(410))
+			body, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"title": "Could not watch resources",
+				"details": "Resume token expired, list the resources again and watch for changes since then"
+			}`))
+		})
+
+		It("should return 400 with error on invalid resume token", func() {
+			// when
+			response := watch("&resumeToken=invalid", nil)
+
+			// then
+			Expect(response.StatusCode).To(Equal(400))
+		})
+
+		It("should return 400 with error on invalid watch value", func() {
+			// when
+			response, err := http.Get("http://" + client.address + client.path + "?watch=sometimes")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(400))
+		})
 	})
 
 	Describe("On PUT", func() {
@@ -371,6 +663,144 @@ var _ = Describe("Resource Endpoints", func() {
 			Expect(resource.Spec.Path).To(Equal("/update-sample-path"))
 		})
 
+		It("should update a resource when the revision is up to date", func() {
+			// given
+			name := "tr-1"
+			putSampleResourceIntoStore(resourceStore, name, mesh)
+
+			// when
+			res := rest.Resource{
+				Meta: rest.ResourceMeta{
+					Name:     name,
+					Mesh:     mesh,
+					Type:     string(sample_model.TrafficRouteType),
+					Revision: "1",
+				},
+				Spec: &sample_proto.TrafficRoute{
+					Path: "/update-sample-path",
+				},
+			}
+			response := client.put(res)
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+		})
+
+		It("should return 409 when the revision is stale", func() {
+			// given a resource modified after revision 1
+			name := "tr-1"
+			putSampleResourceIntoStore(resourceStore, name, mesh)
+			resource := sample_model.NewTrafficRouteResource()
+			Expect(resourceStore.Get(context.Background(), resource, store.GetByKey(name, mesh))).To(Succeed())
+			Expect(resourceStore.Update(context.Background(), resource)).To(Succeed())
+
+			// when
+			res := rest.Resource{
+				Meta: rest.ResourceMeta{
+					Name:     name,
+					Mesh:     mesh,
+					Type:     string(sample_model.TrafficRouteType),
+					Revision: "1",
+				},
+				Spec: &sample_proto.TrafficRoute{
+					Path: "/update-sample-path",
+				},
+			}
+			response := client.put(res)
+
+			// then
+			Expect(response.StatusCode).To(Equal(409))
+			respBytes, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(respBytes).To(MatchJSON(`
+			{
+				"title": "Could not update a resource",
+				"details": "Conflict",
+				"causes": [
+					{
+						"field": "revision",
+						"message": "resource was modified in the meantime, fetch the newest revision of the resource and apply the changes again"
+					}
+				]
+			}
+			`))
+
+			// and the resource is not updated
+			Expect(resourceStore.Get(context.Background(), resource, store.GetByKey(name, mesh))).To(Succeed())
+			Expect(resource.Spec.Path).To(Equal("/sample-path"))
+		})
+
+		It("should not create a resource in dry run", func() {
+			// given
+			res := rest.Resource{
+				Meta: rest.ResourceMeta{
+					Name: "new-resource",
+					Mesh: mesh,
+					Type: string(sample_model.TrafficRouteType),
+				},
+				Spec: &sample_proto.TrafficRoute{
+					Path: "/sample-path",
+				},
+			}
+			jsonBytes, err := res.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			response := client.putJson(res.Meta.Name+"?dryRun=true", jsonBytes)
+
+			// then
+			Expect(response.StatusCode).To(Equal(201))
+			body, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"type": "SampleTrafficRoute",
+				"mesh": "default",
+				"name": "new-resource",
+				"creationTime": "0001-01-01T00:00:00Z",
+				"modificationTime": "0001-01-01T00:00:00Z",
+				"path": "/sample-path"
+			}
+			`))
+			// and
+			err = resourceStore.Get(context.Background(), sample_model.NewTrafficRouteResource(), store.GetByKey("new-resource", mesh))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should not update a resource in dry run", func() {
+			// given
+			name := "tr-1"
+			putSampleResourceIntoStore(resourceStore, name, mesh)
+			res := rest.Resource{
+				Meta: rest.ResourceMeta{
+					Name: name,
+					Mesh: mesh,
+					Type: string(sample_model.TrafficRouteType),
+				},
+				Spec: &sample_proto.TrafficRoute{
+					Path: "/update-sample-path",
+				},
+			}
+			jsonBytes, err := res.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			response := client.putJson(name+"?dryRun=true", jsonBytes)
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			dryRunRes := rest.Resource{}
+			Expect(json.Unmarshal(body, &dryRunRes)).To(Succeed())
+			Expect(dryRunRes.Spec.(*sample_proto.TrafficRoute).Path).To(Equal("/update-sample-path"))
+			// and
+			resource := sample_model.NewTrafficRouteResource()
+			err = resourceStore.Get(context.Background(), resource, store.GetByKey(name, mesh))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.Spec.Path).To(Equal("/sample-path"))
+		})
+
 		It("should return 400 on the type in url that is different from request", func() {
 			// given
 			json := `
@@ -0,0 +1,422 @@
+package api_server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	api_types "github.com/kumahq/kuma/pkg/api-server/types"
+	config_core "github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core"
+	core_access "github.com/kumahq/kuma/pkg/core/access"
+	"github.com/kumahq/kuma/pkg/core/resources/access"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/events"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var grpcLog = core.Log.WithName("api-server").WithName("grpc")
+
+// resourceService implements the same operations on the resources as resourceEndpoints for the gRPC clients.
+type resourceService struct {
+	system_proto.UnimplementedResourceServiceServer
+
+	mode           config_core.CpMode
+	resManager     manager.ResourceManager
+	descriptors    []model.ResourceTypeDescriptor
+	resourceAccess access.ResourceAccess
+	watchHistory   *watchHistory
+}
+
+var _ system_proto.ResourceServiceServer = &resourceService{}
+
+// addResourceServiceEndpoint serves the gRPC service on the same ports as the HTTP API.
+// The service is a route of the WebService, so every call goes through the same filters as the HTTP requests,
+// including the authentication. gRPC requires HTTP/2, which is negotiated by TLS on HTTPS and by h2c on HTTP.
+func addResourceServiceEndpoint(container *restful.Container, service system_proto.ResourceServiceServer) {
+	grpcServer := grpc.NewServer()
+	system_proto.RegisterResourceServiceServer(grpcServer, service)
+
+	ws := new(restful.WebService)
+	ws.Path("/kuma.system.v1alpha1.ResourceService").
+		Consumes("application/grpc", "application/grpc+proto").
+		Produces("application/grpc", "application/grpc+proto")
+	ws.Route(ws.POST("/{method}").To(func(request *restful.Request, response *restful.Response) {
+		grpcServer.ServeHTTP(response, request.Request)
+	}).Doc("gRPC ResourceService"))
+	container.Add(ws)
+}
+
+func (s *resourceService) ListResourceTypes(context.Context, *system_proto.ListResourceTypesRequest) (*system_proto.ListResourceTypesResponse, error) {
+	resp := &system_proto.ListResourceTypesResponse{}
+	for _, desc := range s.descriptors {
+		resp.Types = append(resp.Types, &system_proto.ResourceType{
+			Name:       string(desc.Name),
+			SpecType:   proto.MessageName(desc.NewObject().GetSpec()),
+			MeshScoped: desc.Scope == model.ScopeMesh,
+			ReadOnly:   desc.ReadOnly,
+		})
+	}
+	return resp, nil
+}
+
+func (s *resourceService) GetResource(ctx context.Context, req *system_proto.GetResourceRequest) (*system_proto.Resource, error) {
+	desc, err := s.descriptor(req.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resourceAccess.ValidateGet(model.ResourceKey{Mesh: req.Mesh, Name: req.Name}, desc, user.FromCtx(ctx)); err != nil {
+		return nil, grpcError(err)
+	}
+	res := desc.NewObject()
+	if err := s.resManager.Get(ctx, res, store.GetByKey(req.Name, req.Mesh)); err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoResource(res)
+}
+
+func (s *resourceService) ListResources(ctx context.Context, req *system_proto.ListResourcesRequest) (*system_proto.ListResourcesResponse, error) {
+	desc, err := s.descriptor(req.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resourceAccess.ValidateList(req.Mesh, desc, user.FromCtx(ctx)); err != nil {
+		return nil, grpcError(err)
+	}
+	size := defaultPageSize
+	if req.Size != 0 {
+		size = int(req.Size)
+	}
+	if size > maxPageSize {
+		return nil, grpcError(api_types.NewMaxPageSizeExceeded(size, maxPageSize))
+	}
+	if err := s.validateTags(desc, req.Tags); err != nil {
+		return nil, grpcError(err)
+	}
+
+	list := desc.NewList()
+	if err := s.resManager.List(ctx, list, store.ListByMesh(req.Mesh), store.ListByPage(size, req.Offset), store.ListByTags(req.Tags)); err != nil {
+		return nil, grpcError(err)
+	}
+	resp := &system_proto.ListResourcesResponse{
+		Total:      list.GetPagination().Total,
+		NextOffset: list.GetPagination().NextOffset,
+	}
+	for _, item := range list.GetItems() {
+		res, err := toProtoResource(item)
+		if err != nil {
+			return nil, err
+		}
+		resp.Items = append(resp.Items, res)
+	}
+	return resp, nil
+}
+
+func (s *resourceService) PutResource(ctx context.Context, req *system_proto.PutResourceRequest) (*system_proto.PutResourceResponse, error) {
+	if req.Resource == nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation("resource", "must be set")
+		return nil, grpcError(verr.OrNil())
+	}
+	desc, err := s.writableDescriptor(req.Resource.Type)
+	if err != nil {
+		return nil, err
+	}
+	key := model.ResourceKey{Mesh: req.Resource.Mesh, Name: req.Resource.Name}
+	spec, err := s.specFromRequest(desc, req.Resource)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	res := desc.NewObject()
+	created := false
+	if err := s.resManager.Get(ctx, res, store.GetBy(key)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return nil, grpcError(err)
+		}
+		created = true
+		if err := s.resourceAccess.ValidateCreate(key, spec, desc, user.FromCtx(ctx)); err != nil {
+			return nil, grpcError(err)
+		}
+		_ = res.SetSpec(spec)
+		opts := []store.CreateOptionsFunc{store.CreateBy(key)}
+		if req.DryRun {
+			opts = append(opts, store.CreateDryRun())
+		}
+		if err := s.resManager.Create(ctx, res, opts...); err != nil {
+			return nil, grpcError(err)
+		}
+		if req.DryRun {
+			// the resource is not persisted, so it has no meta
+			protoRes, err := toProtoSpec(desc, key, res.GetSpec())
+			if err != nil {
+				return nil, err
+			}
+			return &system_proto.PutResourceResponse{Resource: protoRes, Created: true}, nil
+		}
+	} else {
+		// the revision is optional, without it the resource is updated regardless of the changes made in the meantime
+		if revision := req.Resource.Revision; revision != "" && revision != res.GetMeta().GetVersion() {
+			return nil, grpcError(store.ErrorResourceConflict(desc.Name, key.Name, key.Mesh))
+		}
+		if err := s.resourceAccess.ValidateUpdate(key, res.GetSpec(), spec, desc, user.FromCtx(ctx)); err != nil {
+			return nil, grpcError(err)
+		}
+		_ = res.SetSpec(spec)
+		var opts []store.UpdateOptionsFunc
+		if req.DryRun {
+			opts = append(opts, store.UpdateDryRun())
+		}
+		if err := s.resManager.Update(ctx, res, opts...); err != nil {
+			return nil, grpcError(err)
+		}
+	}
+	protoRes, err := toProtoResource(res)
+	if err != nil {
+		return nil, err
+	}
+	return &system_proto.PutResourceResponse{Resource: protoRes, Created: created}, nil
+}
+
+func (s *resourceService) DeleteResource(ctx context.Context, req *system_proto.DeleteResourceRequest) (*system_proto.DeleteResourceResponse, error) {
+	desc, err := s.writableDescriptor(req.Type)
+	if err != nil {
+		return nil, err
+	}
+	res := desc.NewObject()
+	if err := s.resManager.Get(ctx, res, store.GetByKey(req.Name, req.Mesh)); err != nil {
+		return nil, grpcError(err)
+	}
+	if err := s.resourceAccess.ValidateDelete(model.ResourceKey{Mesh: req.Mesh, Name: req.Name}, res.GetSpec(), desc, user.FromCtx(ctx)); err != nil {
+		return nil, grpcError(err)
+	}
+	if err := s.resManager.Delete(ctx, res, store.DeleteByKey(req.Name, req.Mesh)); err != nil {
+		return nil, grpcError(err)
+	}
+	return &system_proto.DeleteResourceResponse{}, nil
+}
+
+func (s *resourceService) WatchResources(req *system_proto.WatchResourcesRequest, stream system_proto.ResourceService_WatchResourcesServer) error {
+	ctx := stream.Context()
+	desc, err := s.descriptor(req.Type)
+	if err != nil {
+		return err
+	}
+	if err := s.resourceAccess.ValidateList(req.Mesh, desc, user.FromCtx(ctx)); err != nil {
+		return grpcError(err)
+	}
+	if err := s.validateTags(desc, req.Tags); err != nil {
+		return grpcError(err)
+	}
+	seq, err := s.watchHistory.resume(req.ResumeToken)
+	if err != nil {
+		return grpcError(err)
+	}
+
+	filter := store.NewListOptions(store.ListByTags(req.Tags))
+	err = s.watchHistory.watch(ctx, seq,
+		func(seq uint64, changed events.ResourceChangedEvent) (bool, error) {
+			if changed.Type != desc.Name || (req.Mesh != "" && changed.Key.Mesh != req.Mesh) {
+				return false, nil
+			}
+			event, ok, err := s.watchEvent(ctx, desc, changed, filter)
+			if err != nil {
+				grpcLog.Error(err, "could not build the watch event", "type", changed.Type, "key", changed.Key)
+				return false, nil
+			}
+			if !ok {
+				return false, nil
+			}
+			event.ResumeToken = s.watchHistory.token(seq)
+			return true, stream.Send(event)
+		},
+		func(seq uint64) error {
+			return stream.Send(&system_proto.WatchResourcesEvent{
+				Type:        system_proto.WatchResourcesEvent_BOOKMARK,
+				ResumeToken: s.watchHistory.token(seq),
+			})
+		},
+	)
+	if err != nil {
+		return grpcError(err)
+	}
+	return nil
+}
+
+// watchEvent converts the change to the watch event. False is returned when the resource does not match the filter.
+func (s *resourceService) watchEvent(ctx context.Context, desc model.ResourceTypeDescriptor, changed events.ResourceChangedEvent, filter *store.ListOptions) (*system_proto.WatchResourcesEvent, bool, error) {
+	var eventType system_proto.WatchResourcesEvent_Type
+	switch changed.Operation {
+	case events.Create:
+		eventType = system_proto.WatchResourcesEvent_ADDED
+	case events.Update:
+		eventType = system_proto.WatchResourcesEvent_MODIFIED
+	case events.Delete:
+		if visible, err := deletionVisible(ctx, s.resManager, desc, changed.Key); err != nil || !visible {
+			return nil, false, err
+		}
+		return &system_proto.WatchResourcesEvent{
+			Type: system_proto.WatchResourcesEvent_DELETED,
+			Resource: &system_proto.Resource{
+				Type: string(desc.Name),
+				Mesh: changed.Key.Mesh,
+				Name: changed.Key.Name,
+			},
+		}, true, nil
+	}
+
+	res := desc.NewObject()
+	if err := s.resManager.Get(ctx, res, store.GetByKey(changed.Key.Name, changed.Key.Mesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			// the resource was deleted in the meantime, DELETED event will follow
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if !filter.Filter(res) {
+		return nil, false, nil
+	}
+	protoRes, err := toProtoResource(res)
+	if err != nil {
+		return nil, false, err
+	}
+	return &system_proto.WatchResourcesEvent{Type: eventType, Resource: protoRes}, true, nil
+}
+
+func (s *resourceService) descriptor(typ string) (model.ResourceTypeDescriptor, error) {
+	for _, desc := range s.descriptors {
+		if string(desc.Name) == typ {
+			return desc, nil
+		}
+	}
+	verr := validators.ValidationError{}
+	verr.AddViolation("type", fmt.Sprintf("unknown type %q", typ))
+	return model.ResourceTypeDescriptor{}, grpcError(verr.OrNil())
+}
+
+func (s *resourceService) writableDescriptor(typ string) (model.ResourceTypeDescriptor, error) {
+	desc, err := s.descriptor(typ)
+	if err != nil {
+		return desc, err
+	}
+	if desc.ReadOnly {
+		return desc, status.Error(codes.FailedPrecondition, readOnlyMessage(s.mode))
+	}
+	return desc, nil
+}
+
+func (s *resourceService) specFromRequest(desc model.ResourceTypeDescriptor, res *system_proto.Resource) (model.ResourceSpec, error) {
+	verr := validators.ValidationError{}
+	verr.AddErrorAt(validators.RootedAt("resource"), mesh.ValidateMeta(res.Name, res.Mesh, desc.Scope))
+	spec := desc.NewObject().GetSpec()
+	if res.Spec == nil {
+		verr.AddViolation("resource.spec", "must be set")
+	} else if err := util_proto.UnmarshalAnyToV2(res.Spec, spec); err != nil {
+		verr.AddViolation("resource.spec", fmt.Sprintf("has to be %s: %s", proto.MessageName(spec), err))
+	}
+	return spec, verr.OrNil()
+}
+
+func (s *resourceService) validateTags(desc model.ResourceTypeDescriptor, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	if _, ok := desc.NewObject().GetSpec().(store.TagsMatcher); !ok {
+		verr := validators.ValidationError{}
+		verr.AddViolation("tags", fmt.Sprintf("filtering by tags is not supported for %s", desc.Name))
+		return verr.OrNil()
+	}
+	return nil
+}
+
+func toProtoResource(res model.Resource) (*system_proto.Resource, error) {
+	meta := res.GetMeta()
+	protoRes, err := toProtoSpec(res.Descriptor(), model.MetaToResourceKey(meta), res.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+	protoRes.Revision = meta.GetVersion()
+	protoRes.CreationTime = util_proto.MustTimestampProto(meta.GetCreationTime())
+	protoRes.ModificationTime = util_proto.MustTimestampProto(meta.GetModificationTime())
+	return protoRes, nil
+}
+
+func toProtoSpec(desc model.ResourceTypeDescriptor, key model.ResourceKey, spec model.ResourceSpec) (*system_proto.Resource, error) {
+	any, err := util_proto.MarshalAnyDeterministic(spec)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &system_proto.Resource{
+		Type: string(desc.Name),
+		Mesh: key.Mesh,
+		Name: key.Name,
+		Spec: any,
+	}, nil
+}
+
+// grpcError converts the error to the gRPC status with the same meaning as the HTTP status returned by rest_errors.HandleError.
+func grpcError(err error) error {
+	switch {
+	case store.IsResourceNotFound(err):
+		return status.Error(codes.NotFound, "Resource not found")
+	case store.IsResourcePreconditionFailed(err):
+		return status.Error(codes.FailedPrecondition, "Precondition Failed")
+	case store.IsResourceAlreadyExists(err):
+		return status.Error(codes.AlreadyExists, "Resource already exists")
+	case store.IsResourceConflict(err):
+		return status.Error(codes.Aborted, "Resource was modified since the revision in the request")
+	case err == store.ErrorInvalidOffset:
+		return invalidArgument("Invalid offset", validators.Violation{Field: "offset", Message: "Invalid format"})
+	case manager.IsMeshNotFound(err):
+		return invalidArgument("Mesh is not found", validators.Violation{Field: "mesh", Message: err.Error()})
+	case validators.IsValidationError(err):
+		return invalidArgument("Resource is not valid", err.(*validators.ValidationError).Violations...)
+	case api_types.IsMaxPageSizeExceeded(err):
+		return invalidArgument("Invalid page size", validators.Violation{Field: "size", Message: err.Error()})
+	case err == api_types.InvalidResumeToken:
+		return invalidArgument("Invalid resume token", validators.Violation{Field: "resume_token", Message: "Invalid format"})
+	case err == api_types.ResumeTokenExpired:
+		return status.Error(codes.FailedPrecondition, "Resume token expired, list the resources again and watch for changes since then")
+	case errors.Is(err, &core_access.AccessDeniedError{}):
+		var accessErr *core_access.AccessDeniedError
+		errors.As(err, &accessErr)
+		return status.Error(codes.PermissionDenied, accessErr.Reason)
+	case errors.Is(err, &rest_errors.Unauthenticated{}):
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	grpcLog.Error(err, "request failed")
+	return status.Error(codes.Internal, http.StatusText(http.StatusInternalServerError))
+}
+
+func invalidArgument(msg string, violations ...validators.Violation) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Message,
+		})
+	}
+	st, err := status.New(codes.InvalidArgument, msg).WithDetails(badRequest)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return st.Err()
+}
@@ -0,0 +1,242 @@
+package api_server_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	sample_proto "github.com/kumahq/kuma/pkg/test/apis/sample/v1alpha1"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	sample_model "github.com/kumahq/kuma/pkg/test/resources/apis/sample"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Resource gRPC service", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var eventBus *events.EventBus
+	var stop = func() {}
+	var conn *grpc.ClientConn
+	var client system_proto.ResourceServiceClient
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		Expect(resourceStore.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", model.NoMesh))).To(Succeed())
+		eventBus = events.NewEventBus()
+		apiServer, stop = StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore).WithEventBus(eventBus))
+		var err error
+		conn, err = grpc.Dial(apiServer.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).ToNot(HaveOccurred())
+		client = system_proto.NewResourceServiceClient(conn)
+	})
+
+	AfterEach(func() {
+		Expect(conn.Close()).To(Succeed())
+		stop()
+	})
+
+	sampleResource := func(name string, path string) *system_proto.Resource {
+		return &system_proto.Resource{
+			Type: "SampleTrafficRoute",
+			Mesh: "default",
+			Name: name,
+			Spec: util_proto.MustMarshalAny(&sample_proto.TrafficRoute{Path: path}),
+		}
+	}
+
+	expectStatus := func(err error, code codes.Code) *status.Status {
+		Expect(err).To(HaveOccurred())
+		st, ok := status.FromError(err)
+		Expect(ok).To(BeTrue())
+		Expect(st.Code()).To(Equal(code))
+		return st
+	}
+
+	It("should list resource types", func() {
+		// when
+		resp, err := client.ListResourceTypes(context.Background(), &system_proto.ListResourceTypesRequest{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Types).To(ContainElement(matchers.MatchProto(&system_proto.ResourceType{
+			Name:       "SampleTrafficRoute",
+			SpecType:   "kuma.test.v1alpha1.TrafficRoute",
+			MeshScoped: true,
+		})))
+	})
+
+	It("should create, get, list and delete resources", func() {
+		// when
+		putResp, err := client.PutResource(context.Background(), &system_proto.PutResourceRequest{
+			Resource: sampleResource("tr-1", "/created"),
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(putResp.Created).To(BeTrue())
+		Expect(putResp.Resource.Revision).To(Equal("1"))
+
+		// when
+		res, err := client.GetResource(context.Background(), &system_proto.GetResourceRequest{
+			Type: "SampleTrafficRoute",
+			Mesh: "default",
+			Name: "tr-1",
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		spec := &sample_proto.TrafficRoute{}
+		Expect(util_proto.UnmarshalAnyTo(res.Spec, spec)).To(Succeed())
+		Expect(spec.Path).To(Equal("/created"))
+
+		// when
+		putResp, err = client.PutResource(context.Background(), &system_proto.PutResourceRequest{
+			Resource: sampleResource("tr-1", "/updated"),
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(putResp.Created).To(BeFalse())
+		Expect(putResp.Resource.Revision).To(Equal("2"))
+
+		// when
+		putSampleResourceIntoStore(resourceStore, "tr-2", "default")
+		list, err := client.ListResources(context.Background(), &system_proto.ListResourcesRequest{
+			Type: "SampleTrafficRoute",
+			Mesh: "default",
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Total).To(Equal(uint32(2)))
+		Expect(list.Items).To(HaveLen(2))
+		Expect(list.Items[0].Name).To(Equal("tr-1"))
+		Expect(list.Items[1].Name).To(Equal("tr-2"))
+
+		// when
+		_, err = client.DeleteResource(context.Background(), &system_proto.DeleteResourceRequest{
+			Type: "SampleTrafficRoute",
+			Mesh: "default",
+			Name: "tr-1",
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.GetResource(context.Background(), &system_proto.GetResourceRequest{
+			Type: "SampleTrafficRoute",
+			Mesh: "default",
+			Name: "tr-1",
+		})
+		expectStatus(err, codes.NotFound)
+	})
+
+	It("should reject stale updates", func() {
+		// given
+		putSampleResourceIntoStore(resourceStore, "tr-1", "default")
+		res := sampleResource("tr-1", "/updated")
+		res.Revision = "0"
+
+		// when
+		_, err := client.PutResource(context.Background(), &system_proto.PutResourceRequest{Resource: res})
+
+		// then
+		expectStatus(err, codes.Aborted)
+	})
+
+	It("should return the violations of invalid resources", func() {
+		// given
+		res := sampleResource("tr-1", "")
+		res.Spec = util_proto.MustMarshalAny(&sample_proto.TrafficRoute{})
+		res.Mesh = ""
+
+		// when
+		_, err := client.PutResource(context.Background(), &system_proto.PutResourceRequest{Resource: res})
+
+		// then
+		st := expectStatus(err, codes.InvalidArgument)
+		Expect(st.Message()).To(Equal("Resource is not valid"))
+		Expect(st.Details()).To(HaveLen(1))
+		Expect(st.Details()[0].(*errdetails.BadRequest).FieldViolations).To(ContainElement(matchers.MatchProto(&errdetails.BadRequest_FieldViolation{
+			Field:       "resource.mesh",
+			Description: "cannot be empty",
+		})))
+	})
+
+	It("should reject the spec of other type", func() {
+		// given
+		res := sampleResource("tr-1", "")
+		res.Spec = util_proto.MustMarshalAny(&system_proto.ResourceType{})
+
+		// when
+		_, err := client.PutResource(context.Background(), &system_proto.PutResourceRequest{Resource: res})
+
+		// then
+		st := expectStatus(err, codes.InvalidArgument)
+		Expect(st.Details()[0].(*errdetails.BadRequest).FieldViolations[0].Field).To(Equal("resource.spec"))
+	})
+
+	It("should reject unknown types", func() {
+		// when
+		_, err := client.GetResource(context.Background(), &system_proto.GetResourceRequest{Type: "Unknown", Name: "x"})
+
+		// then
+		st := expectStatus(err, codes.InvalidArgument)
+		Expect(st.Details()[0].(*errdetails.BadRequest).FieldViolations[0].Description).To(Equal(`unknown type "Unknown"`))
+	})
+
+	It("should stream changes of resources", func() {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream, err := client.WatchResources(ctx, &system_proto.WatchResourcesRequest{
+			Type: "SampleTrafficRoute",
+			Mesh: "default",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		bookmark, err := stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bookmark.Type).To(Equal(system_proto.WatchResourcesEvent_BOOKMARK))
+		Expect(bookmark.ResumeToken).ToNot(BeEmpty())
+
+		// when
+		putSampleResourceIntoStore(resourceStore, "tr-1", "default")
+		eventBus.Send(events.ResourceChangedEvent{
+			Operation: events.Create,
+			Type:      sample_model.TrafficRouteType,
+			Key:       model.ResourceKey{Mesh: "default", Name: "tr-1"},
+		})
+
+		// then
+		event, err := stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Type).To(Equal(system_proto.WatchResourcesEvent_ADDED))
+		Expect(event.Resource.Name).To(Equal("tr-1"))
+		Expect(event.ResumeToken).ToNot(Equal(bookmark.ResumeToken))
+	})
+
+	It("should reject expired resume token", func() {
+		// when
+		stream, err := client.WatchResources(context.Background(), &system_proto.WatchResourcesRequest{
+			Type:        "SampleTrafficRoute",
+			ResumeToken: "other-generation.1",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = stream.Recv()
+
+		// then
+		expectStatus(err, codes.FailedPrecondition)
+	})
+})
@@ -0,0 +1,144 @@
+package api_server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+
+	api_types "github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/events"
+)
+
+const mimeEventStream = "text/event-stream"
+
+var watchLog = core.Log.WithName("api-server").WithName("watch")
+
+// watchResources streams the changes of the resources until the client disconnects. Changes are streamed as newline
+// delimited JSON or as server-sent events when the client accepts text/event-stream. When the resume token is passed
+// in ?resumeToken or in Last-Event-ID header, the changes since the token are replayed first.
+func (r *resourceEndpoints) watchResources(request *restful.Request, response *restful.Response, meshName string, tags map[string]string, fields *rest.FieldSelector) {
+	ctx := request.Request.Context()
+	token := request.QueryParameter("resumeToken")
+	if token == "" {
+		token = request.HeaderParameter("Last-Event-ID")
+	}
+	seq, err := r.watchHistory.resume(token)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not watch resources")
+		return
+	}
+
+	sse := strings.Contains(request.HeaderParameter("Accept"), mimeEventStream)
+	if sse {
+		response.AddHeader("Content-Type", mimeEventStream)
+		response.AddHeader("Cache-Control", "no-cache")
+	} else {
+		response.AddHeader("Content-Type", restful.MIME_JSON)
+	}
+	response.WriteHeader(http.StatusOK)
+	filter := store.NewListOptions(store.ListByTags(tags))
+	err = r.watchHistory.watch(ctx, seq,
+		func(seq uint64, changed events.ResourceChangedEvent) (bool, error) {
+			if changed.Type != r.descriptor.Name || (meshName != "" && changed.Key.Mesh != meshName) {
+				return false, nil
+			}
+			event, ok, err := r.watchEvent(ctx, changed, filter, fields)
+			if err != nil {
+				watchLog.Error(err, "could not build the watch event", "type", changed.Type, "key", changed.Key)
+				return false, nil
+			}
+			if !ok {
+				return false, nil
+			}
+			event.ResumeToken = r.watchHistory.token(seq)
+			return true, writeWatchEvent(response, event, sse)
+		},
+		func(seq uint64) error {
+			return writeWatchEvent(response, api_types.ResourceWatchEvent{
+				Type:        api_types.WatchEventBookmark,
+				ResumeToken: r.watchHistory.token(seq),
+			}, sse)
+		},
+	)
+	if err == api_types.ResumeTokenExpired {
+		_ = writeWatchEvent(response, api_types.ResourceWatchEvent{
+			Type:    api_types.WatchEventError,
+			Message: "the client is too slow to receive the changes, watch has to be restarted",
+		}, sse)
+	}
+}
+
+// watchEvent converts the change to the watch event. False is returned when the resource does not match the filter.
+func (r *resourceEndpoints) watchEvent(ctx context.Context, changed events.ResourceChangedEvent, filter *store.ListOptions, fields *rest.FieldSelector) (api_types.ResourceWatchEvent, bool, error) {
+	var eventType api_types.WatchEventType
+	switch changed.Operation {
+	case events.Create:
+		eventType = api_types.WatchEventAdded
+	case events.Update:
+		eventType = api_types.WatchEventModified
+	case events.Delete:
+		if visible, err := deletionVisible(ctx, r.resManager, r.descriptor, changed.Key); err != nil || !visible {
+			return api_types.ResourceWatchEvent{}, false, err
+		}
+		meta, err := json.Marshal(rest.ResourceMeta{
+			Type: string(r.descriptor.Name),
+			Mesh: changed.Key.Mesh,
+			Name: changed.Key.Name,
+		})
+		if err != nil {
+			return api_types.ResourceWatchEvent{}, false, err
+		}
+		return api_types.ResourceWatchEvent{Type: api_types.WatchEventDeleted, Resource: meta}, true, nil
+	}
+
+	resource := r.descriptor.NewObject()
+	if err := r.resManager.Get(ctx, resource, store.GetByKey(changed.Key.Name, changed.Key.Mesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			// the resource was deleted in the meantime, DELETED event will follow
+			return api_types.ResourceWatchEvent{}, false, nil
+		}
+		return api_types.ResourceWatchEvent{}, false, err
+	}
+	if !filter.Filter(resource) {
+		return api_types.ResourceWatchEvent{}, false, nil
+	}
+	restResource := rest.From.Resource(resource)
+	restResource.Fields = fields
+	res, err := json.Marshal(restResource)
+	if err != nil {
+		return api_types.ResourceWatchEvent{}, false, err
+	}
+	return api_types.ResourceWatchEvent{Type: eventType, Resource: res}, true, nil
+}
+
+func writeWatchEvent(response *restful.Response, event api_types.ResourceWatchEvent, sse bool) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if sse {
+		// the resume token is the id of the event, so the browser sends it in Last-Event-ID header when it reconnects
+		var buf bytes.Buffer
+		if event.ResumeToken != "" {
+			fmt.Fprintf(&buf, "id: %s\n", event.ResumeToken)
+		}
+		fmt.Fprintf(&buf, "event: %s\ndata: %s\n\n", event.Type, b)
+		b = buf.Bytes()
+	} else {
+		b = append(b, '\n')
+	}
+	if _, err := response.Write(b); err != nil {
+		return err
+	}
+	response.Flush()
+	return nil
+}
@@ -18,22 +18,29 @@ import (
 	"github.com/pkg/errors"
 	http_prometheus "github.com/slok/go-http-metrics/metrics/prometheus"
 	"github.com/slok/go-http-metrics/middleware"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 
 	"github.com/kumahq/kuma/app/kuma-ui/pkg/resources"
 	"github.com/kumahq/kuma/pkg/api-server/authn"
 	"github.com/kumahq/kuma/pkg/api-server/customization"
+	"github.com/kumahq/kuma/pkg/api-server/limits"
 	api_server "github.com/kumahq/kuma/pkg/config/api-server"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/fieldmanager"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/dns/vips"
 	"github.com/kumahq/kuma/pkg/envoy/admin"
+	"github.com/kumahq/kuma/pkg/events"
 	"github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/certs"
 	"github.com/kumahq/kuma/pkg/tokens/builtin"
@@ -41,6 +48,7 @@ import (
 	util_prometheus "github.com/kumahq/kuma/pkg/util/prometheus"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	"github.com/kumahq/kuma/pkg/xds/server"
+	xds_server_v3 "github.com/kumahq/kuma/pkg/xds/server/v3"
 )
 
 var (
@@ -48,8 +56,9 @@ var (
 )
 
 type ApiServer struct {
-	mux    *http.ServeMux
-	config api_server.ApiServerConfig
+	mux          *http.ServeMux
+	config       api_server.ApiServerConfig
+	watchHistory *watchHistory
 }
 
 func (a *ApiServer) NeedLeaderElection() bool {
@@ -94,6 +103,10 @@ func NewApiServer(
 	authenticator authn.Authenticator,
 	access runtime.Access,
 	envoyAdminClient admin.EnvoyAdminClient,
+	shadowConfigDumper *xds_server_v3.ShadowConfigDumper,
+	eventReaderFactory events.ListenerFactory,
+	auditLog audit.Log,
+	transactions store.Transactions,
 ) (*ApiServer, error) {
 	serverConfig := cfg.ApiServer
 	container := restful.NewContainer()
@@ -105,6 +118,11 @@ func NewApiServer(
 		}),
 	})
 	container.Filter(util_prometheus.MetricsHandler("", promMiddleware))
+	limitsFilter, err := limits.NewFilter(serverConfig.Limits, metrics)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create limits filter")
+	}
+	container.Filter(limitsFilter)
 	if cfg.ApiServer.Authn.LocalhostIsAdmin {
 		container.Filter(authn.LocalhostAuthenticator)
 	}
@@ -125,9 +143,23 @@ func NewApiServer(
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
 
-	addResourcesEndpoints(ws, defs, resManager, cfg, access.ResourceAccess)
+	// the field managers are stored as system resources, so they are not changed on behalf of users of tenants
+	fieldManagers := fieldmanager.NewStore(resManager)
+	resManager = manager.NewTenantResourceManager(resManager)
+
+	watchHistory := newWatchHistory(eventReaderFactory)
+	descriptors := apiDescriptors(defs, cfg)
+	if err := addResourcesEndpoints(ws, descriptors, resManager, fieldManagers, cfg, access.ResourceAccess, watchHistory, transactions); err != nil {
+		return nil, err
+	}
 	addInspectEndpoints(ws, cfg, meshContextBuilder, resManager)
-	addInspectEnvoyAdminEndpoints(ws, cfg, resManager, access.EnvoyAdminAccess, envoyAdminClient)
+	addInspectEnvoyAdminEndpoints(ws, cfg, resManager, access.EnvoyAdminAccess, envoyAdminClient, meshContextBuilder, shadowConfigDumper)
+	addXdsExplainEndpoints(ws, cfg, meshContextBuilder, resManager, shadowConfigDumper)
+	auditLogEndpoints := auditLogEndpoints{
+		auditLog: auditLog,
+		access:   access.AuditLogAccess,
+	}
+	auditLogEndpoints.addEndpoint(ws)
 	container.Add(ws)
 
 	if err := addIndexWsEndpoints(ws, getInstanceId, getClusterId, enableGUI); err != nil {
@@ -140,12 +172,20 @@ func NewApiServer(
 	container.Add(configWs)
 	container.Add(zonesWs(resManager))
 	container.Add(tokenWs(resManager, access))
+	addResourceServiceEndpoint(container, &resourceService{
+		mode:           cfg.Mode,
+		resManager:     resManager,
+		descriptors:    descriptors,
+		resourceAccess: access.ResourceAccess,
+		watchHistory:   watchHistory,
+	})
 
 	container.Filter(cors.Filter)
 
 	newApiServer := &ApiServer{
-		mux:    container.ServeMux,
-		config: *serverConfig,
+		mux:          container.ServeMux,
+		config:       *serverConfig,
+		watchHistory: watchHistory,
 	}
 
 	// Handle the GUI
@@ -160,7 +200,20 @@ func NewApiServer(
 	return newApiServer, nil
 }
 
-func addResourcesEndpoints(ws *restful.WebService, defs []model.ResourceTypeDescriptor, resManager manager.ResourceManager, cfg *kuma_cp.Config, resourceAccess resources_access.ResourceAccess) {
+// apiDescriptors returns the descriptors of the resources with ReadOnly set for the types that cannot be changed via the API.
+func apiDescriptors(defs []model.ResourceTypeDescriptor, cfg *kuma_cp.Config) []model.ResourceTypeDescriptor {
+	var descriptors []model.ResourceTypeDescriptor
+	for _, definition := range defs {
+		defType := definition.Name
+		if cfg.ApiServer.ReadOnly || (defType == mesh.DataplaneType && cfg.Mode == config_core.Global) || (defType != mesh.DataplaneType && cfg.Mode == config_core.Zone) {
+			definition.ReadOnly = true
+		}
+		descriptors = append(descriptors, definition)
+	}
+	return descriptors
+}
+
+func addResourcesEndpoints(ws *restful.WebService, defs []model.ResourceTypeDescriptor, resManager manager.ResourceManager, fieldManagers *fieldmanager.Store, cfg *kuma_cp.Config, resourceAccess resources_access.ResourceAccess, watchHistory *watchHistory, transactions store.Transactions) error {
 	dpOverviewEndpoints := dataplaneOverviewEndpoints{
 		resManager:     resManager,
 		resourceAccess: resourceAccess,
@@ -196,16 +249,30 @@ func addResourcesEndpoints(ws *restful.WebService, defs []model.ResourceTypeDesc
 	}
 	globalInsightsEndpoints.addEndpoint(ws)
 
+	meshInsightsEndpoints := meshInsightsEndpoints{
+		resManager:     resManager,
+		resourceAccess: resourceAccess,
+	}
+	meshInsightsEndpoints.addListEndpoint(ws)
+
+	applyEndpoints := applyEndpoints{
+		mode:           cfg.Mode,
+		resManager:     resManager,
+		transactions:   transactions,
+		descriptors:    map[model.ResourceType]model.ResourceTypeDescriptor{},
+		resourceAccess: resourceAccess,
+	}
+
 	for _, definition := range defs {
 		defType := definition.Name
-		if cfg.ApiServer.ReadOnly || (defType == mesh.DataplaneType && cfg.Mode == config_core.Global) || (defType != mesh.DataplaneType && cfg.Mode == config_core.Zone) {
-			definition.ReadOnly = true
-		}
+		applyEndpoints.descriptors[defType] = definition
 		endpoints := resourceEndpoints{
 			mode:           cfg.Mode,
 			resManager:     resManager,
 			descriptor:     definition,
 			resourceAccess: resourceAccess,
+			watchHistory:   watchHistory,
+			fieldManagers:  fieldManagers,
 		}
 		switch defType {
 		case mesh.ServiceInsightType:
@@ -220,18 +287,25 @@ func addResourcesEndpoints(ws *restful.WebService, defs []model.ResourceTypeDesc
 			switch definition.Scope {
 			case model.ScopeMesh:
 				endpoints.addCreateOrUpdateEndpoint(ws, "/meshes/{mesh}/"+definition.WsPath)
+				endpoints.addServerSideApplyEndpoint(ws, "/meshes/{mesh}/"+definition.WsPath)
 				endpoints.addDeleteEndpoint(ws, "/meshes/{mesh}/"+definition.WsPath)
 				endpoints.addFindEndpoint(ws, "/meshes/{mesh}/"+definition.WsPath)
 				endpoints.addListEndpoint(ws, "/meshes/{mesh}/"+definition.WsPath)
 				endpoints.addListEndpoint(ws, "/"+definition.WsPath) // listing all resources in all meshes
 			case model.ScopeGlobal:
 				endpoints.addCreateOrUpdateEndpoint(ws, "/"+definition.WsPath)
+				endpoints.addServerSideApplyEndpoint(ws, "/"+definition.WsPath)
 				endpoints.addDeleteEndpoint(ws, "/"+definition.WsPath)
 				endpoints.addFindEndpoint(ws, "/"+definition.WsPath)
 				endpoints.addListEndpoint(ws, "/"+definition.WsPath)
 			}
 		}
 	}
+	applyEndpoints.addEndpoint(ws)
+	if err := addOpenApiEndpoint(ws, defs); err != nil {
+		return errors.Wrap(err, "could not generate OpenAPI document")
+	}
+	return nil
 }
 
 func tokenWs(resManager manager.ResourceManager, access runtime.Access) *restful.WebService {
@@ -246,6 +320,8 @@ func tokenWs(resManager manager.ResourceManager, access runtime.Access) *restful
 
 func (a *ApiServer) Start(stop <-chan struct{}) error {
 	errChan := make(chan error)
+	// changes are recorded before the servers start, so no change is missed by the watches
+	a.watchHistory.start(stop)
 
 	var httpServer, httpsServer *http.Server
 	if a.config.HTTP.Enabled {
@@ -271,8 +347,9 @@ func (a *ApiServer) Start(stop <-chan struct{}) error {
 
 func (a *ApiServer) startHttpServer(errChan chan error) *http.Server {
 	server := &http.Server{
-		Addr:    net.JoinHostPort(a.config.HTTP.Interface, strconv.FormatUint(uint64(a.config.HTTP.Port), 10)),
-		Handler: a.mux,
+		Addr: net.JoinHostPort(a.config.HTTP.Interface, strconv.FormatUint(uint64(a.config.HTTP.Port), 10)),
+		// HTTP/2 without TLS is needed by the gRPC clients
+		Handler: h2c.NewHandler(a.mux, &http2.Server{}),
 	}
 
 	go func() {
@@ -392,6 +469,10 @@ func SetupServer(rt runtime.Runtime) error {
 		rt.APIServerAuthenticator(),
 		rt.Access(),
 		rt.EnvoyAdminClient(),
+		xds_server_v3.NewShadowConfigDumper(rt.ReadOnlyResourceManager(), rt.XDSHooks(), rt.XDSControlPlaneContext()),
+		rt.EventReaderFactory(),
+		rt.AuditLog(),
+		rt.Transactions(),
 	)
 	if err != nil {
 		return err
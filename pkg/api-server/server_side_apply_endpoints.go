@@ -0,0 +1,238 @@
+package api_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/fieldmanager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// mimeApplyPatch is the content type of PATCH requests with the configuration applied by the field manager.
+const mimeApplyPatch = "application/apply-patch+yaml"
+
+// metaFields are the fields of the resource in the JSON format that are not a part of the spec.
+var metaFields = []string{"type", "mesh", "name", "creationTime", "modificationTime", "revision"}
+
+func (r *resourceEndpoints) addServerSideApplyEndpoint(ws *restful.WebService, pathPrefix string) {
+	if r.descriptor.ReadOnly {
+		ws.Route(ws.PATCH(pathPrefix+"/{name}").To(r.createOrUpdateResourceReadOnly).
+			Consumes(mimeApplyPatch).
+			Doc("Not allowed in read-only mode.").
+			Returns(http.StatusMethodNotAllowed, "Not allowed in read-only mode.", restful.ServiceError{}))
+		return
+	}
+	ws.Route(ws.PATCH(pathPrefix+"/{name}").To(r.serverSideApply).
+		Consumes(mimeApplyPatch).
+		Doc(fmt.Sprintf("Creates a %s or merges the fields of the field manager into it", r.descriptor.WsPath)).
+		Param(ws.PathParameter("name", fmt.Sprintf("Name of the %s", r.descriptor.WsPath)).DataType("string")).
+		Param(ws.QueryParameter("fieldManager", "name of the manager that owns the applied fields, for example kumactl").DataType("string").Required(true)).
+		Param(ws.QueryParameter("force", "take the ownership of the fields owned by other managers instead of failing with a conflict").DataType("boolean")).
+		Param(ws.QueryParameter("dryRun", "validate and default the resource without persisting it. The resulting resource is returned").DataType("boolean")).
+		Returns(200, "OK", nil).
+		Returns(201, "Created", nil).
+		Returns(409, "Conflict with other field managers", nil))
+}
+
+// serverSideApply merges the configuration applied by the field manager into the resource. Only the fields in the
+// configuration are changed, so many managers can change different fields of the same resource without overriding
+// each other. Applying the same configuration again does not change the resource.
+func (r *resourceEndpoints) serverSideApply(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	meshName := r.meshFromRequest(request)
+	key := model.ResourceKey{Mesh: meshName, Name: name}
+
+	fieldManager := request.QueryParameter("fieldManager")
+	if fieldManager == "" {
+		verr := validators.ValidationError{}
+		verr.AddViolation("fieldManager", "must be set")
+		rest_errors.HandleError(response, verr.OrNil(), "Could not apply a resource")
+		return
+	}
+	force, err := flagQueryParameter(request, "force")
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not apply a resource")
+		return
+	}
+	dryRun, err := flagQueryParameter(request, "dryRun")
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not apply a resource")
+		return
+	}
+
+	restRes, applied, err := r.readAppliedConfiguration(request)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not apply a resource")
+		return
+	}
+
+	ctx := request.Request.Context()
+	res := r.descriptor.NewObject()
+	created := false
+	current := map[string]interface{}{}
+	managed := fieldmanager.ManagedFields{}
+	if err := r.resManager.Get(ctx, res, store.GetBy(key)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			rest_errors.HandleError(response, err, "Could not apply a resource")
+			return
+		}
+		created = true
+	} else {
+		// the revision is optional, without it the fields are applied regardless of the changes made in the meantime
+		if revision := restRes.Meta.Revision; revision != "" && revision != res.GetMeta().GetVersion() {
+			rest_errors.HandleError(response, store.ErrorResourceConflict(r.descriptor.Name, name, meshName), "Could not apply a resource")
+			return
+		}
+		if current, err = specToMap(res.GetSpec()); err != nil {
+			rest_errors.HandleError(response, err, "Could not apply a resource")
+			return
+		}
+		if managed, err = r.fieldManagers.Get(ctx, res); err != nil {
+			rest_errors.HandleError(response, err, "Could not apply a resource")
+			return
+		}
+	}
+
+	merged, newManaged, err := fieldmanager.Apply(current, applied, managed, fieldManager, force)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not apply a resource")
+		return
+	}
+	spec, err := specFromMap(r.descriptor, merged)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not apply a resource")
+		return
+	}
+
+	if created {
+		if err := r.resourceAccess.ValidateCreate(key, spec, r.descriptor, user.FromCtx(ctx)); err != nil {
+			rest_errors.HandleError(response, err, "Access Denied")
+			return
+		}
+		_ = res.SetSpec(spec)
+		opts := []store.CreateOptionsFunc{store.CreateBy(key)}
+		if dryRun {
+			opts = append(opts, store.CreateDryRun())
+		}
+		if err := r.resManager.Create(ctx, res, opts...); err != nil {
+			rest_errors.HandleError(response, err, "Could not apply a resource")
+			return
+		}
+	} else if !proto.Equal(res.GetSpec(), spec) {
+		if err := r.resourceAccess.ValidateUpdate(key, res.GetSpec(), spec, r.descriptor, user.FromCtx(ctx)); err != nil {
+			rest_errors.HandleError(response, err, "Access Denied")
+			return
+		}
+		_ = res.SetSpec(spec)
+		var opts []store.UpdateOptionsFunc
+		if dryRun {
+			opts = append(opts, store.UpdateDryRun())
+		}
+		if err := r.resManager.Update(ctx, res, opts...); err != nil {
+			rest_errors.HandleError(response, err, "Could not apply a resource")
+			return
+		}
+	}
+
+	var result *rest.Resource
+	switch {
+	case dryRun && created:
+		// the resource is not persisted, so it has no meta
+		result = &rest.Resource{
+			Meta: rest.ResourceMeta{
+				Type: string(r.descriptor.Name),
+				Name: name,
+				Mesh: meshName,
+			},
+			Spec: res.GetSpec(),
+		}
+	case dryRun:
+		result = rest.From.Resource(res)
+	default:
+		if err := r.fieldManagers.Set(ctx, res, newManaged); err != nil {
+			rest_errors.HandleError(response, err, "Could not apply a resource")
+			return
+		}
+		result = rest.From.Resource(res)
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	if err := response.WriteHeaderAndJson(status, result, restful.MIME_JSON); err != nil {
+		core.Log.Error(err, "Could not write the response")
+	}
+}
+
+// readAppliedConfiguration returns the resource from the request and the fields of its spec in the JSON format.
+func (r *resourceEndpoints) readAppliedConfiguration(request *restful.Request) (*rest.Resource, map[string]interface{}, error) {
+	body, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	jsonBody, err := yaml.YAMLToJSON(body)
+	if err != nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation("", fmt.Sprintf("could not parse the body: %s", err))
+		return nil, nil, verr.OrNil()
+	}
+	restRes := &rest.Resource{
+		Spec: r.descriptor.NewObject().GetSpec(),
+	}
+	if err := json.Unmarshal(jsonBody, restRes); err != nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation("", err.Error())
+		return nil, nil, verr.OrNil()
+	}
+	if err := r.validateResourceRequest(request, restRes); err != nil {
+		return nil, nil, err
+	}
+	applied := map[string]interface{}{}
+	if err := json.Unmarshal(jsonBody, &applied); err != nil {
+		return nil, nil, err
+	}
+	for _, field := range metaFields {
+		delete(applied, field)
+	}
+	return restRes, applied, nil
+}
+
+func specToMap(spec model.ResourceSpec) (map[string]interface{}, error) {
+	bytes, err := util_proto.ToJSON(spec)
+	if err != nil {
+		return nil, err
+	}
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(bytes, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func specFromMap(descriptor model.ResourceTypeDescriptor, obj map[string]interface{}) (model.ResourceSpec, error) {
+	bytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	spec := descriptor.NewObject().GetSpec()
+	if err := util_proto.FromJSON(bytes, spec); err != nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation("", err.Error())
+		return nil, verr.OrNil()
+	}
+	return spec, nil
+}
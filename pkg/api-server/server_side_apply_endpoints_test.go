@@ -0,0 +1,177 @@
+package api_server_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Server-side apply", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop = func() {}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		apiServer, stop = StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore))
+	})
+
+	AfterEach(func() {
+		stop()
+	})
+
+	apply := func(query string, body string) (int, []byte) {
+		request, err := http.NewRequest("PATCH", "http://"+apiServer.Address()+"/meshes/demo?"+query, strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Content-Type", "application/apply-patch+yaml")
+		response, err := http.DefaultClient.Do(request)
+		Expect(err).ToNot(HaveOccurred())
+		respBytes, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return response.StatusCode, respBytes
+	}
+
+	getMesh := func() *core_mesh.MeshResource {
+		mesh := core_mesh.NewMeshResource()
+		Expect(resourceStore.Get(context.Background(), mesh, store.GetByKey("demo", model.NoMesh))).To(Succeed())
+		return mesh
+	}
+
+	It("should create the resource and merge the fields of other managers", func() {
+		// when
+		status, body := apply("fieldManager=kumactl", `
+type: Mesh
+name: demo
+routing:
+  localityAwareLoadBalancing: true
+`)
+
+		// then
+		Expect(status).To(Equal(201))
+		created := map[string]interface{}{}
+		Expect(json.Unmarshal(body, &created)).To(Succeed())
+		Expect(created["revision"]).To(Equal("1"))
+		Expect(created["routing"]).To(Equal(map[string]interface{}{"localityAwareLoadBalancing": true}))
+
+		// when other manager applies other field
+		status, _ = apply("fieldManager=gui", `{"type": "Mesh", "name": "demo", "routing": {"localityAwareLoadBalancingOptions": {"overprovisioningFactor": 150}}}`)
+
+		// then fields of both managers are kept
+		Expect(status).To(Equal(200))
+		mesh := getMesh()
+		Expect(mesh.Spec.Routing.LocalityAwareLoadBalancing).To(BeTrue())
+		Expect(mesh.Spec.Routing.LocalityAwareLoadBalancingOptions.OverprovisioningFactor.GetValue()).To(Equal(uint32(150)))
+		Expect(mesh.GetMeta().GetVersion()).To(Equal("2"))
+	})
+
+	It("should not change the resource when the same configuration is applied again", func() {
+		// given
+		config := `{"type": "Mesh", "name": "demo", "routing": {"localityAwareLoadBalancing": true}}`
+		status, _ := apply("fieldManager=kumactl", config)
+		Expect(status).To(Equal(201))
+
+		// when
+		status, _ = apply("fieldManager=kumactl", config)
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(getMesh().GetMeta().GetVersion()).To(Equal("1"))
+	})
+
+	It("should reject the change of the field owned by other manager unless it is forced", func() {
+		// given
+		status, _ := apply("fieldManager=kumactl", `{"type": "Mesh", "name": "demo", "routing": {"localityAwareLoadBalancing": true}}`)
+		Expect(status).To(Equal(201))
+
+		// when
+		status, body := apply("fieldManager=gui", `{"type": "Mesh", "name": "demo", "routing": {"localityAwareLoadBalancing": false}}`)
+
+		// then
+		Expect(status).To(Equal(409))
+		Expect(body).To(MatchJSON(`
+		{
+			"title": "Could not apply a resource",
+			"details": "Fields are owned by other field managers. Apply the resource with ?force=true to take the ownership of them",
+			"causes": [
+				{
+					"field": "routing.localityAwareLoadBalancing",
+					"message": "owned by \"kumactl\""
+				}
+			]
+		}`))
+		Expect(getMesh().Spec.Routing.LocalityAwareLoadBalancing).To(BeTrue())
+
+		// when
+		status, _ = apply("fieldManager=gui&force=true", `{"type": "Mesh", "name": "demo", "routing": {"localityAwareLoadBalancing": false}}`)
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(getMesh().Spec.GetRouting().GetLocalityAwareLoadBalancing()).To(BeFalse())
+
+		// and the previous manager lost the ownership
+		status, _ = apply("fieldManager=kumactl", `{"type": "Mesh", "name": "demo", "routing": {"localityAwareLoadBalancing": true}}`)
+		Expect(status).To(Equal(409))
+	})
+
+	It("should remove the fields the manager does not apply anymore", func() {
+		// given
+		status, _ := apply("fieldManager=kumactl", `{"type": "Mesh", "name": "demo", "routing": {"localityAwareLoadBalancing": true, "localityAwareLoadBalancingOptions": {"overprovisioningFactor": 150}}}`)
+		Expect(status).To(Equal(201))
+
+		// when
+		status, _ = apply("fieldManager=kumactl", `{"type": "Mesh", "name": "demo", "routing": {"localityAwareLoadBalancing": true}}`)
+
+		// then
+		Expect(status).To(Equal(200))
+		Expect(getMesh().Spec.Routing.LocalityAwareLoadBalancing).To(BeTrue())
+		Expect(getMesh().Spec.Routing.LocalityAwareLoadBalancingOptions).To(BeNil())
+	})
+
+	It("should require the field manager", func() {
+		// when
+		status, body := apply("", `{"type": "Mesh", "name": "demo"}`)
+
+		// then
+		Expect(status).To(Equal(400))
+		Expect(body).To(MatchJSON(`
+		{
+			"title": "Could not apply a resource",
+			"details": "Resource is not valid",
+			"causes": [
+				{
+					"field": "fieldManager",
+					"message": "must be set"
+				}
+			]
+		}`))
+	})
+
+	It("should not persist the resource on dry run", func() {
+		// when
+		status, body := apply("fieldManager=kumactl&dryRun=true", `{"type": "Mesh", "name": "demo", "routing": {"localityAwareLoadBalancing": true}}`)
+
+		// then
+		Expect(status).To(Equal(201))
+		Expect(body).To(MatchJSON(`
+		{
+			"type": "Mesh",
+			"name": "demo",
+			"creationTime": "0001-01-01T00:00:00Z",
+			"modificationTime": "0001-01-01T00:00:00Z",
+			"routing": {"localityAwareLoadBalancing": true}
+		}`))
+		err := resourceStore.Get(context.Background(), core_mesh.NewMeshResource(), store.GetByKey("demo", model.NoMesh))
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+	})
+})
@@ -104,6 +104,7 @@ var _ = Describe("Service Insight Endpoints", func() {
 	  "name": "backend",
 	  "creationTime": "2018-07-17T16:05:36.995Z",
 	  "modificationTime": "2018-07-17T16:05:36.995Z",
+	  "revision": "1",
 	  "status": "partially_degraded",
 	  "dataplanes": {
 	  	"total": 100,
@@ -117,6 +118,7 @@ var _ = Describe("Service Insight Endpoints", func() {
 	  "name": "frontend",
 	  "creationTime": "2018-07-17T16:05:36.995Z",
 	  "modificationTime": "2018-07-17T16:05:36.995Z",
+	  "revision": "1",
       "status": "partially_degraded",
       "dataplanes": {
         "total": 20,
@@ -147,6 +149,7 @@ var _ = Describe("Service Insight Endpoints", func() {
   "name": "backend",
   "creationTime": "2018-07-17T16:05:36.995Z",
   "modificationTime": "2018-07-17T16:05:36.995Z",
+  "revision": "1",
   "status": "partially_degraded",
   "dataplanes": {
     "total": 100,
@@ -197,6 +200,7 @@ var _ = Describe("Service Insight Endpoints", func() {
 	  "name": "backend",
 	  "creationTime": "2018-07-17T16:05:36.995Z",
 	  "modificationTime": "2018-07-17T16:05:36.995Z",
+	  "revision": "1",
       "status": "partially_degraded",
       "dataplanes": {
 	    "total": 100,
@@ -210,6 +214,7 @@ var _ = Describe("Service Insight Endpoints", func() {
 	  "name": "frontend",
 	  "creationTime": "2018-07-17T16:05:36.995Z",
 	  "modificationTime": "2018-07-17T16:05:36.995Z",
+	  "revision": "1",
       "status": "partially_degraded",
       "dataplanes": {
 	    "total": 20,
@@ -223,6 +228,7 @@ var _ = Describe("Service Insight Endpoints", func() {
 	  "name": "db",
 	  "creationTime": "2018-07-17T16:05:36.995Z",
 	  "modificationTime": "2018-07-17T16:05:36.995Z",
+	  "revision": "1",
       "status": "partially_degraded",
       "dataplanes": {
 	    "total": 10,
@@ -236,6 +242,7 @@ var _ = Describe("Service Insight Endpoints", func() {
 	  "name": "redis",
 	  "creationTime": "2018-07-17T16:05:36.995Z",
 	  "modificationTime": "2018-07-17T16:05:36.995Z",
+	  "revision": "1",
       "status": "partially_degraded",
       "dataplanes": {
 	    "total": 22,
@@ -260,6 +267,7 @@ var _ = Describe("Service Insight Endpoints", func() {
 	  "name": "backend",
 	  "creationTime": "2018-07-17T16:05:36.995Z",
 	  "modificationTime": "2018-07-17T16:05:36.995Z",
+	  "revision": "1",
       "status": "partially_degraded",
       "dataplanes": {
 	    "total": 100,
@@ -273,6 +281,7 @@ var _ = Describe("Service Insight Endpoints", func() {
 	  "name": "frontend",
 	  "creationTime": "2018-07-17T16:05:36.995Z",
 	  "modificationTime": "2018-07-17T16:05:36.995Z",
+	  "revision": "1",
       "status": "partially_degraded",
       "dataplanes": {
 	    "total": 20,
@@ -297,6 +306,7 @@ var _ = Describe("Service Insight Endpoints", func() {
 	  "name": "db",
 	  "creationTime": "2018-07-17T16:05:36.995Z",
 	  "modificationTime": "2018-07-17T16:05:36.995Z",
+	  "revision": "1",
       "status": "partially_degraded",
       "dataplanes": {
 	    "total": 10,
@@ -310,6 +320,7 @@ var _ = Describe("Service Insight Endpoints", func() {
 	  "name": "redis",
 	  "creationTime": "2018-07-17T16:05:36.995Z",
 	  "modificationTime": "2018-07-17T16:05:36.995Z",
+	  "revision": "1",
       "status": "partially_degraded",
       "dataplanes": {
 	    "total": 22,
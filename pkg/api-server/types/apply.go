@@ -0,0 +1,30 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+const (
+	ApplyOperationCreated = "created"
+	ApplyOperationUpdated = "updated"
+)
+
+// ApplyRequest is a list of resources that are applied atomically, either all of them are created or updated or none of them is.
+// Resources are applied in the order of the list, so the resources have to be after the resources they reference.
+type ApplyRequest struct {
+	Items []json.RawMessage `json:"items"`
+}
+
+// ApplyResponse lists the applied resources in the order of the request.
+type ApplyResponse struct {
+	Items []ApplyResult `json:"items"`
+}
+
+type ApplyResult struct {
+	Type     string `json:"type"`
+	Mesh     string `json:"mesh,omitempty"`
+	Name     string `json:"name"`
+	Revision string `json:"revision,omitempty"`
+	// Operation is either "created" or "updated"
+	Operation string `json:"operation"`
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	"github.com/kumahq/kuma/pkg/core/audit"
+)
+
+// AuditLogResponse lists recorded mutations of resources from the newest to the oldest.
+type AuditLogResponse struct {
+	Total int           `json:"total"`
+	Items []audit.Event `json:"items"`
+}
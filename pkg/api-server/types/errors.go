@@ -15,3 +15,14 @@ func IsMaxPageSizeExceeded(err error) bool {
 }
 
 var InvalidPageSize = errors.New("Invalid page size")
+
+var InvalidResumeToken = errors.New("Invalid resume token")
+
+// ResumeTokenExpired is returned when the changes since the resume token are no longer available,
+// either because there were too many changes since then or because the token was issued by other instance of the control plane.
+var ResumeTokenExpired = errors.New("Resume token expired")
+
+// IsRequestBodyTooLarge returns whether reading the body failed because it exceeded the limit of http.MaxBytesReader.
+func IsRequestBodyTooLarge(err error) bool {
+	return strings.Contains(err.Error(), "http: request body too large")
+}
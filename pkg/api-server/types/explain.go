@@ -0,0 +1,24 @@
+package types
+
+// DataplaneExplainResponse explains how the control plane generated XDS configuration of the dataplane.
+type DataplaneExplainResponse struct {
+	// Policies matched by the dataplane, the same as returned by the inspect of the dataplane.
+	Policies DataplaneInspectResponse `json:"policies"`
+	// Resources generated for the dataplane sorted by type and name.
+	Resources []ExplainResourceEntry `json:"resources"`
+	// Steps of the generation in the order they were executed.
+	Steps []ExplainStepEntry `json:"steps"`
+}
+
+type ExplainResourceEntry struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	// Origin is the marker of the generator that produced the resource, e.g. "inbound" or "outbound".
+	Origin string `json:"origin"`
+}
+
+type ExplainStepEntry struct {
+	Name string `json:"name"`
+	// Duration of the step, e.g. "1.5ms".
+	Duration string `json:"duration"`
+}
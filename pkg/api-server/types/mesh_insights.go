@@ -0,0 +1,39 @@
+package types
+
+// MeshInsightsEntry aggregates the state of a mesh: its dataplanes, policies and mTLS.
+type MeshInsightsEntry struct {
+	Mesh       string              `json:"mesh"`
+	Dataplanes MeshDataplanesStat  `json:"dataplanes"`
+	Policies   map[string]uint32   `json:"policies"`
+	MTLS       MeshMTLSInsightStat `json:"mtls"`
+}
+
+type MeshDataplanesStat struct {
+	Total             uint32 `json:"total"`
+	Online            uint32 `json:"online"`
+	Offline           uint32 `json:"offline"`
+	PartiallyDegraded uint32 `json:"partiallyDegraded"`
+}
+
+type MeshMTLSInsightStat struct {
+	// EnabledBackend is a name of the enabled CA backend, empty when mTLS is disabled.
+	EnabledBackend string `json:"enabledBackend,omitempty"`
+	// BackendType is a type of the enabled CA backend, for example "builtin" or "provided".
+	BackendType string `json:"backendType,omitempty"`
+	// IssuedCertificates is a number of dataplanes with a certificate issued by any backend.
+	IssuedCertificates uint32 `json:"issuedCertificates"`
+	// IssuedBackends is a number of dataplanes with a certificate grouped by the backend that issued it.
+	IssuedBackends map[string]uint32 `json:"issuedBackends,omitempty"`
+}
+
+type MeshInsightsEntryList struct {
+	Total uint32              `json:"total"`
+	Items []MeshInsightsEntry `json:"items"`
+}
+
+func NewMeshInsightsEntryList() *MeshInsightsEntryList {
+	return &MeshInsightsEntryList{
+		Total: 0,
+		Items: []MeshInsightsEntry{},
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+type WatchEventType string
+
+const (
+	WatchEventAdded    WatchEventType = "ADDED"
+	WatchEventModified WatchEventType = "MODIFIED"
+	WatchEventDeleted  WatchEventType = "DELETED"
+	// WatchEventError is sent as the last event of the stream when the server has to stop watching.
+	WatchEventError WatchEventType = "ERROR"
+	// WatchEventBookmark carries only the resume token. It is sent at the beginning of the stream
+	// and periodically when the changes of other resources advanced the resume token.
+	WatchEventBookmark WatchEventType = "BOOKMARK"
+)
+
+// ResourceWatchEvent is a single event of the stream returned when resources are listed with ?watch=true.
+// Events are sent as newline delimited JSON objects or as server-sent events when the client accepts text/event-stream.
+type ResourceWatchEvent struct {
+	Type WatchEventType `json:"type"`
+	// Resource is the resource after the change. DELETED events contain only the meta of the resource.
+	Resource json.RawMessage `json:"resource,omitempty"`
+	Message  string          `json:"message,omitempty"`
+	// ResumeToken is passed as ?resumeToken or Last-Event-ID header to continue watching after the event.
+	ResumeToken string `json:"resumeToken,omitempty"`
+}
@@ -0,0 +1,205 @@
+package api_server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	api_types "github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/events"
+)
+
+// watchHistorySize is the number of the most recent changes that are kept, so a watch can be resumed after the client reconnects.
+// A watch that is resumed from an older change or that falls behind by more changes has to be restarted with a fresh list.
+const watchHistorySize = 1000
+
+// watchBookmarkInterval is how often the resume token is sent to the client when only other resources changed,
+// so the client can resume the watch without replaying the changes it is not interested in.
+const watchBookmarkInterval = 30 * time.Second
+
+type watchChange struct {
+	seq    uint64
+	change events.ResourceChangedEvent
+}
+
+// watchHistory keeps the most recent changes of the resources in the order they were received from the event bus.
+// Every change gets a sequence number which together with the generation of the history is the resume token of the change.
+// The generation is different on every start of the control plane, so resume tokens of other instances are rejected.
+type watchHistory struct {
+	listenerFactory events.ListenerFactory
+	generation      string
+
+	sync.Mutex
+	seq     uint64
+	changes []watchChange
+	// notify is closed and replaced whenever a change is added
+	notify chan struct{}
+}
+
+func newWatchHistory(listenerFactory events.ListenerFactory) *watchHistory {
+	return &watchHistory{
+		listenerFactory: listenerFactory,
+		generation:      core.NewUUID(),
+		notify:          make(chan struct{}),
+	}
+}
+
+// start subscribes to the event bus and records the changes until stop is closed.
+func (h *watchHistory) start(stop <-chan struct{}) {
+	listener := h.listenerFactory.New()
+	go func() {
+		defer listener.Close()
+		for {
+			event, err := listener.Recv(stop)
+			if err != nil {
+				return
+			}
+			if changed, ok := event.(events.ResourceChangedEvent); ok {
+				h.add(changed)
+			}
+		}
+	}()
+}
+
+func (h *watchHistory) add(change events.ResourceChangedEvent) {
+	h.Lock()
+	defer h.Unlock()
+	h.seq++
+	h.changes = append(h.changes, watchChange{seq: h.seq, change: change})
+	if len(h.changes) > watchHistorySize {
+		h.changes = h.changes[1:]
+	}
+	close(h.notify)
+	h.notify = make(chan struct{})
+}
+
+// current returns the sequence number of the latest change.
+func (h *watchHistory) current() uint64 {
+	h.Lock()
+	defer h.Unlock()
+	return h.seq
+}
+
+// since returns the changes after the sequence number and the channel that is closed when the next change is added.
+func (h *watchHistory) since(seq uint64) ([]watchChange, <-chan struct{}, error) {
+	h.Lock()
+	defer h.Unlock()
+	if seq > h.seq {
+		return nil, nil, api_types.InvalidResumeToken
+	}
+	oldest := h.seq - uint64(len(h.changes))
+	if seq < oldest {
+		return nil, nil, api_types.ResumeTokenExpired
+	}
+	changes := make([]watchChange, h.seq-seq)
+	copy(changes, h.changes[seq-oldest:])
+	return changes, h.notify, nil
+}
+
+func (h *watchHistory) token(seq uint64) string {
+	return h.generation + "." + strconv.FormatUint(seq, 10)
+}
+
+// parseToken returns the sequence number of the change of the resume token.
+func (h *watchHistory) parseToken(token string) (uint64, error) {
+	i := strings.LastIndex(token, ".")
+	if i < 0 {
+		return 0, api_types.InvalidResumeToken
+	}
+	seq, err := strconv.ParseUint(token[i+1:], 10, 64)
+	if err != nil {
+		return 0, api_types.InvalidResumeToken
+	}
+	if token[:i] != h.generation {
+		return 0, api_types.ResumeTokenExpired
+	}
+	return seq, nil
+}
+
+// resume returns the sequence number of the change of the resume token or of the latest change when the token is empty.
+func (h *watchHistory) resume(token string) (uint64, error) {
+	if token == "" {
+		return h.current(), nil
+	}
+	seq, err := h.parseToken(token)
+	if err != nil {
+		return 0, err
+	}
+	if _, _, err := h.since(seq); err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
+// watch calls onChange for every change after the sequence number until ctx is done. onChange returns whether the
+// change was sent to the client. onBookmark is called at the beginning and then periodically when only the changes
+// that were not sent advanced the sequence number. ResumeTokenExpired is returned when the watch falls behind the history.
+func (h *watchHistory) watch(
+	ctx context.Context,
+	seq uint64,
+	onChange func(seq uint64, change events.ResourceChangedEvent) (bool, error),
+	onBookmark func(seq uint64) error,
+) error {
+	if err := onBookmark(seq); err != nil {
+		return err
+	}
+	sent := seq
+
+	ticker := time.NewTicker(watchBookmarkInterval)
+	defer ticker.Stop()
+	for {
+		changes, notify, err := h.since(seq)
+		if err != nil {
+			return err
+		}
+		for _, c := range changes {
+			seq = c.seq
+			ok, err := onChange(c.seq, c.change)
+			if err != nil {
+				return err
+			}
+			if ok {
+				sent = seq
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notify:
+		case <-ticker.C:
+			if sent != seq {
+				if err := onBookmark(seq); err != nil {
+					return err
+				}
+				sent = seq
+			}
+		}
+	}
+}
+
+// deletionVisible returns whether the deletion of the resource can be sent to the user. The deleted resource cannot be
+// checked against the tenant of the user anymore, so users of tenants only receive deletions of resources in meshes of
+// their tenant that still exist.
+func deletionVisible(ctx context.Context, resManager manager.ResourceManager, desc model.ResourceTypeDescriptor, key model.ResourceKey) (bool, error) {
+	if user.FromCtx(ctx).Tenant == "" {
+		return true, nil
+	}
+	if desc.Scope != model.ScopeMesh {
+		return false, nil
+	}
+	if err := resManager.Get(ctx, core_mesh.NewMeshResource(), store.GetByKey(key.Mesh, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
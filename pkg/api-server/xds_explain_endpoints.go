@@ -0,0 +1,108 @@
+package api_server
+
+import (
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/golang/protobuf/proto"
+
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/config/core"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	rest_errors "github.com/kumahq/kuma/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	xds_server_v3 "github.com/kumahq/kuma/pkg/xds/server/v3"
+)
+
+func addXdsExplainEndpoints(
+	ws *restful.WebService,
+	cfg *kuma_cp.Config,
+	builder xds_context.MeshContextBuilder,
+	rm manager.ReadOnlyResourceManager,
+	shadowConfigDumper *xds_server_v3.ShadowConfigDumper,
+) {
+	ws.Route(
+		ws.GET("/xds/explain/{mesh}/{dataplane}").To(explainDataplane(cfg, builder, rm, shadowConfigDumper)).
+			Doc("explain which policies matched the dataplane, which generators produced its XDS resources and how long the generation took").
+			Param(ws.PathParameter("mesh", "mesh name").DataType("string")).
+			Param(ws.PathParameter("dataplane", "dataplane name").DataType("string")).
+			Returns(200, "OK", nil),
+	)
+}
+
+func explainDataplane(
+	cfg *kuma_cp.Config,
+	builder xds_context.MeshContextBuilder,
+	rm manager.ReadOnlyResourceManager,
+	shadowConfigDumper *xds_server_v3.ShadowConfigDumper,
+) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		ctx := request.Request.Context()
+		meshName := request.PathParameter("mesh")
+		dataplaneName := request.PathParameter("dataplane")
+
+		if cfg.Mode == core.Global {
+			verr := validators.ValidationError{}
+			verr.AddViolation("mode", "XDS configuration is generated only by the zone control plane to which the dataplane is connected")
+			rest_errors.HandleError(response, verr.OrNil(), "Could not explain dataplane")
+			return
+		}
+
+		trace := &xds_context.GenerationTrace{}
+		start := time.Now()
+		meshContext, err := buildMeshContext(ctx, rm, builder, meshName)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not build MeshContext")
+			return
+		}
+		trace.Record("mesh context", start)
+
+		start = time.Now()
+		matchedPolicies, gatewayEntries, proxy, err := getMatchedPolicies(cfg, meshContext, core_model.ResourceKey{Mesh: meshName, Name: dataplaneName})
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not get MatchedPolicies")
+			return
+		}
+		trace.Record("policy matching", start)
+
+		rs, err := shadowConfigDumper.Explain(meshContext, &proxy, trace)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not generate XDS resources")
+			return
+		}
+
+		result := api_server_types.DataplaneExplainResponse{
+			Resources: []api_server_types.ExplainResourceEntry{},
+			Steps:     []api_server_types.ExplainStepEntry{},
+		}
+		if matchedPolicies != nil {
+			inner := api_server_types.NewDataplaneInspectEntryList()
+			inner.Items = append(inner.Items, newDataplaneInspectResponse(matchedPolicies, proxy.Dataplane)...)
+			inner.Total = uint32(len(inner.Items))
+			result.Policies = api_server_types.NewDataplaneInspectResponse(inner)
+		} else {
+			inner := newGatewayDataplaneInspectResponse(proxy, gatewayEntries)
+			result.Policies = api_server_types.NewDataplaneInspectResponse(&inner)
+		}
+		for _, resource := range rs.List() {
+			result.Resources = append(result.Resources, api_server_types.ExplainResourceEntry{
+				Type:   proto.MessageName(resource.Resource),
+				Name:   resource.Name,
+				Origin: resource.Origin,
+			})
+		}
+		for _, step := range trace.Steps {
+			result.Steps = append(result.Steps, api_server_types.ExplainStepEntry{
+				Name:     step.Name,
+				Duration: step.Duration.String(),
+			})
+		}
+		if err := response.WriteAsJson(result); err != nil {
+			rest_errors.HandleError(response, err, "Could not write response")
+			return
+		}
+	}
+}
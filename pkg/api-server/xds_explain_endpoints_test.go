@@ -0,0 +1,104 @@
+package api_server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/api-server"
+	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("XDS Explain WS", func() {
+	var resourceStore store.ResourceStore
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		rm := manager.NewResourceManager(resourceStore)
+		for _, resource := range []core_model.Resource{
+			newMesh("mesh-1"),
+			newDataplane().
+				meta("backend-1", "mesh-1").
+				admin(3301).
+				inbound80to81("backend", "192.168.0.1").
+				outbound8080("redis", "192.168.0.2").
+				build(),
+		} {
+			err := rm.Create(context.Background(), resource,
+				store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	explain := func(apiServer *api_server.ApiServer) *http.Response {
+		resp, err := http.Get(fmt.Sprintf("http://%s/xds/explain/mesh-1/backend-1", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+		return resp
+	}
+
+	It("should explain generation of XDS resources", func() {
+		// given
+		apiServer, stop := StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore).WithZone("local"))
+		defer stop()
+
+		// when
+		resp := explain(apiServer)
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		result := api_server_types.DataplaneExplainResponse{}
+		Expect(json.NewDecoder(resp.Body).Decode(&result)).To(Succeed())
+
+		By("returning matched policies")
+		Expect(result.Policies.DataplaneInspectResponseKind).To(BeAssignableToTypeOf(&api_server_types.DataplaneInspectEntryList{}))
+
+		By("returning the origin of every resource")
+		Expect(result.Resources).To(ContainElements(
+			api_server_types.ExplainResourceEntry{
+				Type:   "envoy.config.listener.v3.Listener",
+				Name:   "inbound:192.168.0.1:80",
+				Origin: "inbound",
+			},
+			api_server_types.ExplainResourceEntry{
+				Type:   "envoy.config.listener.v3.Listener",
+				Name:   "outbound:192.168.0.2:8080",
+				Origin: "outbound",
+			},
+		))
+
+		By("returning steps in the order of execution")
+		var names []string
+		for _, step := range result.Steps {
+			_, err := time.ParseDuration(step.Duration)
+			Expect(err).ToNot(HaveOccurred())
+			names = append(names, step.Name)
+		}
+		Expect(names[:2]).To(Equal([]string{"mesh context", "policy matching"}))
+		Expect(names).To(ContainElements("generator.InboundProxyGenerator", "generator.OutboundProxyGenerator", "modifications"))
+	})
+
+	It("should not explain dataplane on global", func() {
+		// given
+		apiServer, stop := StartApiServer(NewTestApiServerConfigurer().WithStore(resourceStore).WithGlobal())
+		defer stop()
+
+		// when
+		resp := explain(apiServer)
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		bytes, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(bytes)).To(ContainSubstring("XDS configuration is generated only by the zone control plane"))
+	})
+})
@@ -41,6 +41,7 @@ var _ = Describe("Zone Overview Endpoints", func() {
  "name": "zone-1",
  "creationTime": "2018-07-17T16:05:36.995Z",
  "modificationTime": "2018-07-17T16:05:36.995Z",
+ "revision": "1",
  "zone": {
  },
  "zoneInsight": {
@@ -63,6 +64,7 @@ var _ = Describe("Zone Overview Endpoints", func() {
  "name": "zone-2",
  "creationTime": "2018-07-17T16:05:36.995Z",
  "modificationTime": "2018-07-17T16:05:36.995Z",
+ "revision": "1",
  "zone": {
  },
  "zoneInsight": {
@@ -85,6 +87,7 @@ var _ = Describe("Zone Overview Endpoints", func() {
  "name": "zone-3",
  "creationTime": "2018-07-17T16:05:36.995Z",
  "modificationTime": "2018-07-17T16:05:36.995Z",
+ "revision": "1",
  "zone": {
  },
  "zoneInsight": {
@@ -43,7 +43,7 @@ func (r *zoneEgressOverviewEndpoints) inspectZoneEgress(
 
 	if err := r.resourceAccess.ValidateGet(
 		model.ResourceKey{Name: name},
-		mesh.NewZoneEgressOverviewResource().Descriptor(),
+		mesh.ZoneEgressResourceTypeDescriptor,
 		user.FromCtx(request.Request.Context()),
 	); err != nil {
 		rest_errors.HandleError(response, err, "Access Denied")
@@ -91,7 +91,8 @@ func (r *zoneEgressOverviewEndpoints) inspectZoneEgresses(
 	response *restful.Response,
 ) {
 	if err := r.resourceAccess.ValidateList(
-		mesh.NewZoneEgressOverviewResource().Descriptor(),
+		"",
+		mesh.ZoneEgressResourceTypeDescriptor,
 		user.FromCtx(request.Request.Context()),
 	); err != nil {
 		rest_errors.HandleError(response, err, "Access Denied")
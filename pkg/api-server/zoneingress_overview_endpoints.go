@@ -40,7 +40,7 @@ func (r *zoneIngressOverviewEndpoints) inspectZoneIngress(request *restful.Reque
 
 	if err := r.resourceAccess.ValidateGet(
 		model.ResourceKey{Name: name},
-		mesh.NewZoneIngressOverviewResource().Descriptor(),
+		mesh.ZoneIngressResourceTypeDescriptor,
 		user.FromCtx(request.Request.Context()),
 	); err != nil {
 		rest_errors.HandleError(response, err, "Access Denied")
@@ -82,7 +82,8 @@ func (r *zoneIngressOverviewEndpoints) fetchOverview(ctx context.Context, name s
 
 func (r *zoneIngressOverviewEndpoints) inspectZoneIngresses(request *restful.Request, response *restful.Response) {
 	if err := r.resourceAccess.ValidateList(
-		mesh.NewZoneIngressOverviewResource().Descriptor(),
+		"",
+		mesh.ZoneIngressResourceTypeDescriptor,
 		user.FromCtx(request.Request.Context()),
 	); err != nil {
 		rest_errors.HandleError(response, err, "Access Denied")
@@ -6,7 +6,10 @@ import (
 	"github.com/kumahq/kuma/pkg/config"
 )
 
-const StaticType = "static"
+const (
+	StaticType = "static"
+	RBACType   = "rbac"
+)
 
 func DefaultAccessConfig() AccessConfig {
 	return AccessConfig{
@@ -40,16 +43,40 @@ func DefaultAccessConfig() AccessConfig {
 				Users:  []string{},
 				Groups: []string{"mesh-system:unauthenticated", "mesh-system:authenticated"},
 			},
+			DrainDataplane: DrainDataplaneStaticAccessConfig{
+				Users:  []string{"mesh-system:admin"},
+				Groups: []string{"mesh-system:admin"},
+			},
+			ViewUnredactedConfigDump: ViewUnredactedConfigDumpStaticAccessConfig{
+				Users:  []string{"mesh-system:admin"},
+				Groups: []string{"mesh-system:admin"},
+			},
+			ProxyAdmin: ProxyAdminStaticAccessConfig{
+				Users:  []string{"mesh-system:admin"},
+				Groups: []string{"mesh-system:admin"},
+			},
+			ViewAuditLog: ViewAuditLogStaticAccessConfig{
+				Users:  []string{"mesh-system:admin"},
+				Groups: []string{"mesh-system:admin"},
+			},
+		},
+		RBAC: RBACAccessConfig{
+			Admin: RBACAdminAccessConfig{
+				Users:  []string{"mesh-system:admin"},
+				Groups: []string{"mesh-system:admin"},
+			},
 		},
 	}
 }
 
 // AccessConfig defines a configuration for acccess control
 type AccessConfig struct {
-	// Type of the access strategy (available values: "static")
+	// Type of the access strategy (available values: "static", "rbac")
 	Type string `yaml:"type" envconfig:"KUMA_ACCESS_TYPE"`
 	// Configuration of static access strategy
 	Static StaticAccessConfig `yaml:"static"`
+	// Configuration of rbac access strategy
+	RBAC RBACAccessConfig `yaml:"rbac"`
 }
 
 func (r AccessConfig) Sanitize() {
@@ -66,7 +93,7 @@ var _ config.Config = &AccessConfig{}
 
 // StaticAccessConfig a static access strategy configuration
 type StaticAccessConfig struct {
-	// AdminResources defines an access to admin resources (Secret/GlobalSecret)
+	// AdminResources defines an access to admin resources (Secret/GlobalSecret/AccessRole/AccessRoleBinding)
 	AdminResources AdminResourcesStaticAccessConfig `yaml:"adminResources"`
 	// GenerateDPToken defines an access to generating dataplane token
 	GenerateDPToken GenerateDPTokenStaticAccessConfig `yaml:"generateDpToken"`
@@ -80,6 +107,14 @@ type StaticAccessConfig struct {
 	ViewStats ViewStatsStaticAccessConfig `yaml:"viewStats"`
 	// ViewClusters defines an access to getting envoy clusters
 	ViewClusters ViewClustersStaticAccessConfig `yaml:"viewClusters"`
+	// DrainDataplane defines an access to draining listeners of envoy
+	DrainDataplane DrainDataplaneStaticAccessConfig `yaml:"drainDataplane"`
+	// ViewUnredactedConfigDump defines an access to getting envoy config dump without redacted credentials
+	ViewUnredactedConfigDump ViewUnredactedConfigDumpStaticAccessConfig `yaml:"viewUnredactedConfigDump"`
+	// ProxyAdmin defines an access to any endpoint of envoy admin API through the control plane
+	ProxyAdmin ProxyAdminStaticAccessConfig `yaml:"proxyAdmin"`
+	// ViewAuditLog defines an access to getting the audit log of resource mutations
+	ViewAuditLog ViewAuditLogStaticAccessConfig `yaml:"viewAuditLog"`
 }
 
 type AdminResourcesStaticAccessConfig struct {
@@ -130,3 +165,46 @@ type ViewClustersStaticAccessConfig struct {
 	// List of groups that are allowed to get envoy config clusters
 	Groups []string `yaml:"groups" envconfig:"KUMA_ACCESS_STATIC_VIEW_CLUSTERS_GROUPS"`
 }
+
+type DrainDataplaneStaticAccessConfig struct {
+	// List of users that are allowed to drain listeners of envoy
+	Users []string `yaml:"users" envconfig:"KUMA_ACCESS_STATIC_DRAIN_DATAPLANE_USERS"`
+	// List of groups that are allowed to drain listeners of envoy
+	Groups []string `yaml:"groups" envconfig:"KUMA_ACCESS_STATIC_DRAIN_DATAPLANE_GROUPS"`
+}
+
+type ViewUnredactedConfigDumpStaticAccessConfig struct {
+	// List of users that are allowed to get envoy config dump without redacted credentials
+	Users []string `yaml:"users" envconfig:"KUMA_ACCESS_STATIC_VIEW_UNREDACTED_CONFIG_DUMP_USERS"`
+	// List of groups that are allowed to get envoy config dump without redacted credentials
+	Groups []string `yaml:"groups" envconfig:"KUMA_ACCESS_STATIC_VIEW_UNREDACTED_CONFIG_DUMP_GROUPS"`
+}
+
+type ProxyAdminStaticAccessConfig struct {
+	// List of users that are allowed to access any endpoint of envoy admin API
+	Users []string `yaml:"users" envconfig:"KUMA_ACCESS_STATIC_PROXY_ADMIN_USERS"`
+	// List of groups that are allowed to access any endpoint of envoy admin API
+	Groups []string `yaml:"groups" envconfig:"KUMA_ACCESS_STATIC_PROXY_ADMIN_GROUPS"`
+}
+
+type ViewAuditLogStaticAccessConfig struct {
+	// List of users that are allowed to get the audit log
+	Users []string `yaml:"users" envconfig:"KUMA_ACCESS_STATIC_VIEW_AUDIT_LOG_USERS"`
+	// List of groups that are allowed to get the audit log
+	Groups []string `yaml:"groups" envconfig:"KUMA_ACCESS_STATIC_VIEW_AUDIT_LOG_GROUPS"`
+}
+
+// RBACAccessConfig a role based access strategy configuration.
+// Access to resources and generating dataplane tokens is granted with AccessRole and AccessRoleBinding resources.
+// The rest of the actions are controlled in the same way as in the static access strategy.
+type RBACAccessConfig struct {
+	// Admin defines users and groups that have access to all resources regardless of AccessRoleBindings
+	Admin RBACAdminAccessConfig `yaml:"admin"`
+}
+
+type RBACAdminAccessConfig struct {
+	// List of users that have access to all resources
+	Users []string `yaml:"users" envconfig:"KUMA_ACCESS_RBAC_ADMIN_USERS"`
+	// List of groups that have access to all resources
+	Groups []string `yaml:"groups" envconfig:"KUMA_ACCESS_RBAC_ADMIN_GROUPS"`
+}
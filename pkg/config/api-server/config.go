@@ -1,6 +1,9 @@
 package api_server
 
 import (
+	net_url "net/url"
+	"strings"
+
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/config"
@@ -22,6 +25,8 @@ type ApiServerConfig struct {
 	Auth ApiServerAuth `yaml:"auth"`
 	// Authentication configuration for API Server
 	Authn ApiServerAuthn `yaml:"authn"`
+	// Limits of the requests that protect the control plane from clients sending too many or too big requests
+	Limits ApiServerLimits `yaml:"limits"`
 }
 
 // API Server HTTP configuration
@@ -79,12 +84,23 @@ type ApiServerAuth struct {
 
 // Api Server Authentication configuration
 type ApiServerAuthn struct {
-	// Type of authentication mechanism (available values: "clientCerts")
+	// Type of authentication mechanism (available values: "adminClientCerts", "tokens", "oidc")
 	Type string `yaml:"type" envconfig:"kuma_api_server_authn_type"`
 	// Localhost is authenticated as a user admin of group admin
 	LocalhostIsAdmin bool `yaml:"localhostIsAdmin" envconfig:"kuma_api_server_authn_localhost_is_admin"`
 	// Configuration for tokens authentication
 	Tokens ApiServerAuthnTokens `yaml:"tokens"`
+	// Configuration for OpenID Connect authentication
+	OIDC ApiServerAuthnOIDC `yaml:"oidc"`
+}
+
+func (a *ApiServerAuthn) Validate() error {
+	if a.Type == "oidc" {
+		if err := a.OIDC.Validate(); err != nil {
+			return errors.Wrap(err, ".OIDC not valid")
+		}
+	}
+	return nil
 }
 
 type ApiServerAuthnTokens struct {
@@ -92,6 +108,111 @@ type ApiServerAuthnTokens struct {
 	BootstrapAdminToken bool `yaml:"bootstrapAdminToken" envconfig:"kuma_api_server_authn_tokens_bootstrap_admin_token"`
 }
 
+// API Server OpenID Connect authentication configuration
+type ApiServerAuthnOIDC struct {
+	// URL of the OpenID Provider. Its configuration is discovered from {issuer}/.well-known/openid-configuration
+	Issuer string `yaml:"issuer" envconfig:"kuma_api_server_authn_oidc_issuer"`
+	// ID of the client registered in the OpenID Provider that is used by kumactl login. The aud claim of ID tokens has to contain it
+	ClientID string `yaml:"clientId" envconfig:"kuma_api_server_authn_oidc_client_id"`
+	// Scopes requested by kumactl login
+	Scopes []string `yaml:"scopes" envconfig:"kuma_api_server_authn_oidc_scopes"`
+	// Claim of the ID token used as the name of the user. If the claim is "email", the email has to be verified
+	UsernameClaim string `yaml:"usernameClaim" envconfig:"kuma_api_server_authn_oidc_username_claim"`
+	// Claim of the ID token with the groups of the user
+	GroupsClaim string `yaml:"groupsClaim" envconfig:"kuma_api_server_authn_oidc_groups_claim"`
+	// Mappings of groups of the OpenID Provider to groups used in the access configuration
+	// in the format provider-group=kuma-group (for example "platform-team=mesh-system:admin").
+	// Groups without a mapping are used as they are
+	GroupMappings GroupMappings `yaml:"groupMappings" envconfig:"kuma_api_server_authn_oidc_group_mappings"`
+	// Claim of the ID token with the tenant of the user. Users with a tenant can only access meshes of the tenant.
+	// If empty, users are not assigned to any tenant
+	TenantClaim string `yaml:"tenantClaim" envconfig:"kuma_api_server_authn_oidc_tenant_claim"`
+}
+
+func (a *ApiServerAuthnOIDC) Validate() error {
+	if a.Issuer == "" {
+		return errors.New("Issuer cannot be empty")
+	}
+	if url, err := net_url.ParseRequestURI(a.Issuer); err != nil || (url.Scheme != "http" && url.Scheme != "https") {
+		return errors.New("Issuer has to be a valid HTTP(S) URL")
+	}
+	if a.ClientID == "" {
+		return errors.New("ClientID cannot be empty")
+	}
+	if a.UsernameClaim == "" {
+		return errors.New("UsernameClaim cannot be empty")
+	}
+	return nil
+}
+
+// API Server limits of the requests
+type ApiServerLimits struct {
+	// Maximum size of the body of the request in bytes. 0 means no limit
+	MaxRequestBodySize int64 `yaml:"maxRequestBodySize" envconfig:"kuma_api_server_limits_max_request_body_size"`
+	// Rate limit of the requests from a single client IP
+	PerClientIP ApiServerClientIPRateLimit `yaml:"perClientIp"`
+	// Rate limit of the requests with the same token in the Authorization header
+	PerToken ApiServerTokenRateLimit `yaml:"perToken"`
+}
+
+func (a *ApiServerLimits) Validate() error {
+	if a.MaxRequestBodySize < 0 {
+		return errors.New("MaxRequestBodySize cannot be negative")
+	}
+	if err := validateRateLimit(a.PerClientIP.RequestsPerSecond, a.PerClientIP.Burst); err != nil {
+		return errors.Wrap(err, ".PerClientIP not valid")
+	}
+	if err := validateRateLimit(a.PerToken.RequestsPerSecond, a.PerToken.Burst); err != nil {
+		return errors.Wrap(err, ".PerToken not valid")
+	}
+	return nil
+}
+
+type ApiServerClientIPRateLimit struct {
+	// Number of requests per second. 0 means no limit
+	RequestsPerSecond float64 `yaml:"requestsPerSecond" envconfig:"kuma_api_server_limits_per_client_ip_requests_per_second"`
+	// Number of requests that can be sent at once above the rate
+	Burst int `yaml:"burst" envconfig:"kuma_api_server_limits_per_client_ip_burst"`
+}
+
+type ApiServerTokenRateLimit struct {
+	// Number of requests per second. 0 means no limit
+	RequestsPerSecond float64 `yaml:"requestsPerSecond" envconfig:"kuma_api_server_limits_per_token_requests_per_second"`
+	// Number of requests that can be sent at once above the rate
+	Burst int `yaml:"burst" envconfig:"kuma_api_server_limits_per_token_burst"`
+}
+
+func validateRateLimit(requestsPerSecond float64, burst int) error {
+	if requestsPerSecond < 0 {
+		return errors.New("RequestsPerSecond cannot be negative")
+	}
+	if requestsPerSecond > 0 && burst < 1 {
+		return errors.New("Burst has to be at least 1 when the rate limit is enabled")
+	}
+	return nil
+}
+
+// GroupMappings maps groups of the OpenID Provider to groups used in the access configuration.
+type GroupMappings map[string]string
+
+// Decode decodes mappings from the environment variable in the format group-a=group-b,group-c=group-d.
+// Colons are allowed in names of groups, so envconfig's default format of maps cannot be used.
+func (g *GroupMappings) Decode(value string) error {
+	mappings := GroupMappings{}
+	for _, mapping := range strings.Split(value, ",") {
+		if mapping == "" {
+			continue
+		}
+		from, to, ok := strings.Cut(mapping, "=")
+		if !ok || from == "" || to == "" {
+			return errors.Errorf("invalid group mapping %q, expected format provider-group=kuma-group", mapping)
+		}
+		mappings[from] = to
+	}
+	*g = mappings
+	return nil
+}
+
 func (a *ApiServerConfig) Sanitize() {
 }
 
@@ -102,6 +223,12 @@ func (a *ApiServerConfig) Validate() error {
 	if err := a.HTTPS.Validate(); err != nil {
 		return errors.Wrap(err, ".HTTP not valid")
 	}
+	if err := a.Authn.Validate(); err != nil {
+		return errors.Wrap(err, ".Authn not valid")
+	}
+	if err := a.Limits.Validate(); err != nil {
+		return errors.Wrap(err, ".Limits not valid")
+	}
 	return nil
 }
 
@@ -130,6 +257,21 @@ func DefaultApiServerConfig() *ApiServerConfig {
 			Tokens: ApiServerAuthnTokens{
 				BootstrapAdminToken: true,
 			},
+			OIDC: ApiServerAuthnOIDC{
+				Scopes:        []string{"openid", "profile", "email"},
+				UsernameClaim: "sub",
+				GroupsClaim:   "groups",
+				GroupMappings: GroupMappings{},
+			},
+		},
+		Limits: ApiServerLimits{
+			MaxRequestBodySize: 10 * 1024 * 1024,
+			PerClientIP: ApiServerClientIPRateLimit{
+				Burst: 100,
+			},
+			PerToken: ApiServerTokenRateLimit{
+				Burst: 100,
+			},
 		},
 	}
 }
@@ -8,12 +8,15 @@ import (
 	"github.com/kumahq/kuma/pkg/config"
 	"github.com/kumahq/kuma/pkg/config/access"
 	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/config/audit"
 	"github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
 	"github.com/kumahq/kuma/pkg/config/diagnostics"
 	dns_server "github.com/kumahq/kuma/pkg/config/dns-server"
 	dp_server "github.com/kumahq/kuma/pkg/config/dp-server"
+	envoy_admin_client "github.com/kumahq/kuma/pkg/config/envoy-admin-client"
 	gui_server "github.com/kumahq/kuma/pkg/config/gui-server"
+	key_provider "github.com/kumahq/kuma/pkg/config/key-provider"
 	"github.com/kumahq/kuma/pkg/config/mads"
 	"github.com/kumahq/kuma/pkg/config/multizone"
 	"github.com/kumahq/kuma/pkg/config/plugins/runtime"
@@ -53,8 +56,11 @@ func (m *Metrics) Validate() error {
 }
 
 type DataplaneMetrics struct {
-	SubscriptionLimit int           `yaml:"subscriptionLimit" envconfig:"kuma_metrics_dataplane_subscription_limit"`
-	IdleTimeout       time.Duration `yaml:"idleTimeout" envconfig:"kuma_metrics_dataplane_idle_timeout"`
+	SubscriptionLimit   int           `yaml:"subscriptionLimit" envconfig:"kuma_metrics_dataplane_subscription_limit"`
+	IdleTimeout         time.Duration `yaml:"idleTimeout" envconfig:"kuma_metrics_dataplane_idle_timeout"`
+	MemoryStatsInterval time.Duration `yaml:"memoryStatsInterval" envconfig:"kuma_metrics_dataplane_memory_stats_interval"`
+	// ConnectionStatsInterval defines how often inbound connections of Dataplanes in meshes with PERMISSIVE mTLS are fetched.
+	ConnectionStatsInterval time.Duration `yaml:"connectionStatsInterval" envconfig:"kuma_metrics_dataplane_connection_stats_interval"`
 }
 
 func (d *DataplaneMetrics) Sanitize() {
@@ -64,6 +70,12 @@ func (d *DataplaneMetrics) Validate() error {
 	if d.SubscriptionLimit < 0 {
 		return errors.New("SubscriptionLimit should be positive or equal 0")
 	}
+	if d.MemoryStatsInterval < 0 {
+		return errors.New("MemoryStatsInterval should be positive or equal 0")
+	}
+	if d.ConnectionStatsInterval < 0 {
+		return errors.New("ConnectionStatsInterval should be positive or equal 0")
+	}
 	return nil
 }
 
@@ -139,8 +151,14 @@ type Config struct {
 	Diagnostics *diagnostics.DiagnosticsConfig `yaml:"diagnostics,omitempty"`
 	// Dataplane Server configuration
 	DpServer *dp_server.DpServerConfig `yaml:"dpServer"`
+	// Envoy Admin Client configuration
+	EnvoyAdminClient *envoy_admin_client.EnvoyAdminClientConfig `yaml:"envoyAdminClient"`
 	// Access Control configuration
 	Access access.AccessConfig `yaml:"access"`
+	// Audit log configuration
+	Audit *audit.AuditConfig `yaml:"audit"`
+	// Key Provider configuration
+	KeyProvider *key_provider.KeyProviderConfig `yaml:"keyProvider"`
 	// Configuration of experimental features
 	Experimental ExperimentalConfig `yaml:"experimental"`
 }
@@ -159,6 +177,9 @@ func (c *Config) Sanitize() {
 	c.DNSServer.Sanitize()
 	c.Multizone.Sanitize()
 	c.Diagnostics.Sanitize()
+	c.EnvoyAdminClient.Sanitize()
+	c.Audit.Sanitize()
+	c.KeyProvider.Sanitize()
 }
 
 var DefaultConfig = func() Config {
@@ -176,8 +197,9 @@ var DefaultConfig = func() Config {
 		},
 		Metrics: &Metrics{
 			Dataplane: &DataplaneMetrics{
-				SubscriptionLimit: 2,
-				IdleTimeout:       5 * time.Minute,
+				SubscriptionLimit:       2,
+				IdleTimeout:             5 * time.Minute,
+				ConnectionStatsInterval: 1 * time.Minute,
 			},
 			Zone: &ZoneMetrics{
 				SubscriptionLimit: 10,
@@ -191,16 +213,20 @@ var DefaultConfig = func() Config {
 		Reports: &Reports{
 			Enabled: false,
 		},
-		General:     DefaultGeneralConfig(),
-		GuiServer:   gui_server.DefaultGuiServerConfig(),
-		DNSServer:   dns_server.DefaultDNSServerConfig(),
-		Multizone:   multizone.DefaultMultizoneConfig(),
-		Diagnostics: diagnostics.DefaultDiagnosticsConfig(),
-		DpServer:    dp_server.DefaultDpServerConfig(),
-		Access:      access.DefaultAccessConfig(),
+		General:          DefaultGeneralConfig(),
+		GuiServer:        gui_server.DefaultGuiServerConfig(),
+		DNSServer:        dns_server.DefaultDNSServerConfig(),
+		Multizone:        multizone.DefaultMultizoneConfig(),
+		Diagnostics:      diagnostics.DefaultDiagnosticsConfig(),
+		DpServer:         dp_server.DefaultDpServerConfig(),
+		EnvoyAdminClient: envoy_admin_client.DefaultEnvoyAdminClientConfig(),
+		Access:           access.DefaultAccessConfig(),
+		Audit:            audit.DefaultAuditConfig(),
+		KeyProvider:      key_provider.DefaultKeyProviderConfig(),
 		Experimental: ExperimentalConfig{
 			GatewayAPI:          false,
 			KubeOutboundsAsVIPs: false,
+			EnvoyTap:            false,
 		},
 	}
 }
@@ -277,6 +303,15 @@ func (c *Config) Validate() error {
 	if err := c.Diagnostics.Validate(); err != nil {
 		return errors.Wrap(err, "Diagnostics validation failed")
 	}
+	if err := c.EnvoyAdminClient.Validate(); err != nil {
+		return errors.Wrap(err, "EnvoyAdminClient validation failed")
+	}
+	if err := c.Audit.Validate(); err != nil {
+		return errors.Wrap(err, "Audit validation failed")
+	}
+	if err := c.KeyProvider.Validate(); err != nil {
+		return errors.Wrap(err, "KeyProvider validation failed")
+	}
 	if err := c.Experimental.Validate(); err != nil {
 		return errors.Wrap(err, "Experimental validation failed")
 	}
@@ -322,6 +357,9 @@ type ExperimentalConfig struct {
 	// If true, instead of embedding kubernetes outbounds into Dataplane object, they are persisted next to VIPs in ConfigMap
 	// This can improve performance, but it should be enabled only after all instances are migrated to version that supports this config
 	KubeOutboundsAsVIPs bool `yaml:"kubeOutboundsAsVIPs" envconfig:"KUMA_EXPERIMENTAL_KUBE_OUTBOUNDS_AS_VIPS"`
+	// If true, HTTP inbound listeners of dataplanes have the tap filter, so their traffic can be captured on demand
+	// with "kumactl tap dataplane". Captured requests and responses may contain sensitive data.
+	EnvoyTap bool `yaml:"envoyTap" envconfig:"KUMA_EXPERIMENTAL_ENVOY_TAP"`
 }
 
 func (e ExperimentalConfig) Validate() error {
@@ -143,6 +143,30 @@ type DataplaneRuntime struct {
 	// Available values are: [trace][debug][info][warning|warn][error][critical][off]
 	// By default it inherits Kuma DP logging level.
 	EnvoyLogLevel string `yaml:"envoyLogLevel,omitempty" envconfig:"kuma_dataplane_runtime_envoy_log_level"`
+	// EnvoyAdminTunnel if true then kuma-dp opens a tunnel to the Control Plane over which the Control Plane
+	// executes requests to the Envoy Admin API instead of connecting to the Envoy Admin API directly.
+	EnvoyAdminTunnel bool `yaml:"envoyAdminTunnel,omitempty" envconfig:"kuma_dataplane_runtime_envoy_admin_tunnel"`
+	// EnvoyAdminSocket if true then Envoy Admin API listens on a unix domain socket instead of the loopback interface.
+	// The Control Plane reaches the Admin API only through the admin port exposed by Kuma DP that accepts only connections secured with mTLS or the tunnel.
+	EnvoyAdminSocket bool `yaml:"envoyAdminSocket,omitempty" envconfig:"kuma_dataplane_runtime_envoy_admin_socket"`
+	// EnvoyRuntime are Envoy runtime key/values put into a runtime layer on top of the defaults set by the Control Plane.
+	EnvoyRuntime map[string]string `yaml:"envoyRuntime,omitempty" envconfig:"kuma_dataplane_runtime_envoy_runtime"`
+	// EnvoyStatsFlushInterval is an interval between flushes of Envoy stats to the sinks. If not set, Envoy flushes stats every 5s.
+	EnvoyStatsFlushInterval time.Duration `yaml:"envoyStatsFlushInterval,omitempty" envconfig:"kuma_dataplane_runtime_envoy_stats_flush_interval"`
+	// EnvoyOverloadManager defines when Envoy protects itself from running out of memory.
+	EnvoyOverloadManager EnvoyOverloadManager `yaml:"envoyOverloadManager,omitempty"`
+}
+
+// EnvoyOverloadManager defines thresholds of the Envoy heap usage on which Envoy starts to protect itself.
+type EnvoyOverloadManager struct {
+	// MaxHeapSizeBytes is a heap size from which the thresholds are computed. Empty value disables the overload manager.
+	MaxHeapSizeBytes uint64 `yaml:"maxHeapSizeBytes,omitempty" envconfig:"kuma_dataplane_runtime_envoy_overload_manager_max_heap_size_bytes"`
+	// ShrinkHeapThreshold is a fraction of MaxHeapSizeBytes on which Envoy starts to release free memory to the system.
+	// If not set, the Control Plane defaults it to 0.95.
+	ShrinkHeapThreshold float64 `yaml:"shrinkHeapThreshold,omitempty" envconfig:"kuma_dataplane_runtime_envoy_overload_manager_shrink_heap_threshold"`
+	// StopAcceptingRequestsThreshold is a fraction of MaxHeapSizeBytes on which Envoy stops accepting new requests.
+	// If not set, the Control Plane defaults it to 0.98.
+	StopAcceptingRequestsThreshold float64 `yaml:"stopAcceptingRequestsThreshold,omitempty" envconfig:"kuma_dataplane_runtime_envoy_overload_manager_stop_accepting_requests_threshold"`
 }
 
 var _ config.Config = &Config{}
@@ -237,6 +261,27 @@ func (d *DataplaneRuntime) Validate() (errs error) {
 	if d.BinaryPath == "" {
 		errs = multierr.Append(errs, errors.Errorf(".BinaryPath must be non-empty"))
 	}
+	for key := range d.EnvoyRuntime {
+		if key == "" {
+			errs = multierr.Append(errs, errors.Errorf(".EnvoyRuntime keys must be non-empty"))
+		}
+	}
+	if d.EnvoyStatsFlushInterval < 0 {
+		errs = multierr.Append(errs, errors.Errorf(".EnvoyStatsFlushInterval must not be negative"))
+	}
+	if err := d.EnvoyOverloadManager.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrap(err, ".EnvoyOverloadManager is not valid"))
+	}
+	return
+}
+
+func (o *EnvoyOverloadManager) Validate() (errs error) {
+	if o.ShrinkHeapThreshold < 0 || o.ShrinkHeapThreshold > 1 {
+		errs = multierr.Append(errs, errors.Errorf(".ShrinkHeapThreshold must be in [0, 1] range"))
+	}
+	if o.StopAcceptingRequestsThreshold < 0 || o.StopAcceptingRequestsThreshold > 1 {
+		errs = multierr.Append(errs, errors.Errorf(".StopAcceptingRequestsThreshold must be in [0, 1] range"))
+	}
 	return
 }
 
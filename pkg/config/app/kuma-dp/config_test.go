@@ -51,28 +51,35 @@ var _ = Describe("Config", func() {
 		It("should be loadable from environment variables", func() {
 			// setup
 			env := map[string]string{
-				"KUMA_CONTROL_PLANE_URL":                                 "https://kuma-control-plane.internal:5682",
-				"KUMA_CONTROL_PLANE_RETRY_BACKOFF":                       "1s",
-				"KUMA_CONTROL_PLANE_RETRY_MAX_DURATION":                  "10s",
-				"KUMA_CONTROL_PLANE_BOOTSTRAP_SERVER_RETRY_BACKOFF":      "2s",
-				"KUMA_CONTROL_PLANE_BOOTSTRAP_SERVER_RETRY_MAX_DURATION": "11s",
-				"KUMA_DATAPLANE_MESH":                                    "demo",
-				"KUMA_DATAPLANE_NAME":                                    "example",
-				"KUMA_DATAPLANE_ADMIN_PORT":                              "2345",
-				"KUMA_DATAPLANE_DRAIN_TIME":                              "60s",
-				"KUMA_DATAPLANE_PROXY_TYPE":                              "ingress",
-				"KUMA_DATAPLANE_RUNTIME_BINARY_PATH":                     "envoy.sh",
-				"KUMA_DATAPLANE_RUNTIME_CONFIG_DIR":                      "/var/run/envoy",
-				"KUMA_DATAPLANE_RUNTIME_TOKEN_PATH":                      "/tmp/token",
-				"KUMA_DATAPLANE_RUNTIME_ENVOY_LOG_LEVEL":                 "trace",
-				"KUMA_DNS_ENABLED":                                       "true",
-				"KUMA_DNS_CORE_DNS_PORT":                                 "5300",
-				"KUMA_DNS_CORE_DNS_EMPTY_PORT":                           "5301",
-				"KUMA_DNS_ENVOY_DNS_PORT":                                "5302",
-				"KUMA_DNS_CORE_DNS_BINARY_PATH":                          "/tmp/coredns",
-				"KUMA_DNS_CORE_DNS_CONFIG_TEMPLATE_PATH":                 "/tmp/Corefile",
-				"KUMA_DNS_CONFIG_DIR":                                    "/var/run/dnsserver",
-				"KUMA_DNS_PROMETHEUS_PORT":                               "6001",
+				"KUMA_CONTROL_PLANE_URL":                                                          "https://kuma-control-plane.internal:5682",
+				"KUMA_CONTROL_PLANE_RETRY_BACKOFF":                                                "1s",
+				"KUMA_CONTROL_PLANE_RETRY_MAX_DURATION":                                           "10s",
+				"KUMA_CONTROL_PLANE_BOOTSTRAP_SERVER_RETRY_BACKOFF":                               "2s",
+				"KUMA_CONTROL_PLANE_BOOTSTRAP_SERVER_RETRY_MAX_DURATION":                          "11s",
+				"KUMA_DATAPLANE_MESH":                                                             "demo",
+				"KUMA_DATAPLANE_NAME":                                                             "example",
+				"KUMA_DATAPLANE_ADMIN_PORT":                                                       "2345",
+				"KUMA_DATAPLANE_DRAIN_TIME":                                                       "60s",
+				"KUMA_DATAPLANE_PROXY_TYPE":                                                       "ingress",
+				"KUMA_DATAPLANE_RUNTIME_BINARY_PATH":                                              "envoy.sh",
+				"KUMA_DATAPLANE_RUNTIME_CONFIG_DIR":                                               "/var/run/envoy",
+				"KUMA_DATAPLANE_RUNTIME_TOKEN_PATH":                                               "/tmp/token",
+				"KUMA_DATAPLANE_RUNTIME_ENVOY_LOG_LEVEL":                                          "trace",
+				"KUMA_DATAPLANE_RUNTIME_ENVOY_ADMIN_TUNNEL":                                       "true",
+				"KUMA_DATAPLANE_RUNTIME_ENVOY_ADMIN_SOCKET":                                       "true",
+				"KUMA_DATAPLANE_RUNTIME_ENVOY_RUNTIME":                                            "re2.max_program_size.error_level:200,overload.global_downstream_max_connections:1000",
+				"KUMA_DATAPLANE_RUNTIME_ENVOY_STATS_FLUSH_INTERVAL":                               "10s",
+				"KUMA_DATAPLANE_RUNTIME_ENVOY_OVERLOAD_MANAGER_MAX_HEAP_SIZE_BYTES":               "1073741824",
+				"KUMA_DATAPLANE_RUNTIME_ENVOY_OVERLOAD_MANAGER_SHRINK_HEAP_THRESHOLD":             "0.9",
+				"KUMA_DATAPLANE_RUNTIME_ENVOY_OVERLOAD_MANAGER_STOP_ACCEPTING_REQUESTS_THRESHOLD": "0.97",
+				"KUMA_DNS_ENABLED":                                                                "true",
+				"KUMA_DNS_CORE_DNS_PORT":                                                          "5300",
+				"KUMA_DNS_CORE_DNS_EMPTY_PORT":                                                    "5301",
+				"KUMA_DNS_ENVOY_DNS_PORT":                                                         "5302",
+				"KUMA_DNS_CORE_DNS_BINARY_PATH":                                                   "/tmp/coredns",
+				"KUMA_DNS_CORE_DNS_CONFIG_TEMPLATE_PATH":                                          "/tmp/Corefile",
+				"KUMA_DNS_CONFIG_DIR":                                                             "/var/run/dnsserver",
+				"KUMA_DNS_PROMETHEUS_PORT":                                                        "6001",
 			}
 			for key, value := range env {
 				os.Setenv(key, value)
@@ -99,6 +106,16 @@ var _ = Describe("Config", func() {
 			Expect(cfg.DataplaneRuntime.ConfigDir).To(Equal("/var/run/envoy"))
 			Expect(cfg.DataplaneRuntime.TokenPath).To(Equal("/tmp/token"))
 			Expect(cfg.DataplaneRuntime.EnvoyLogLevel).To(Equal("trace"))
+			Expect(cfg.DataplaneRuntime.EnvoyAdminTunnel).To(BeTrue())
+			Expect(cfg.DataplaneRuntime.EnvoyAdminSocket).To(BeTrue())
+			Expect(cfg.DataplaneRuntime.EnvoyRuntime).To(Equal(map[string]string{
+				"re2.max_program_size.error_level":           "200",
+				"overload.global_downstream_max_connections": "1000",
+			}))
+			Expect(cfg.DataplaneRuntime.EnvoyStatsFlushInterval).To(Equal(10 * time.Second))
+			Expect(cfg.DataplaneRuntime.EnvoyOverloadManager.MaxHeapSizeBytes).To(Equal(uint64(1073741824)))
+			Expect(cfg.DataplaneRuntime.EnvoyOverloadManager.ShrinkHeapThreshold).To(Equal(0.9))
+			Expect(cfg.DataplaneRuntime.EnvoyOverloadManager.StopAcceptingRequestsThreshold).To(Equal(0.97))
 			Expect(cfg.DNS.Enabled).To(BeTrue())
 			Expect(cfg.DNS.CoreDNSPort).To(Equal(uint32(5300)))
 			Expect(cfg.DNS.CoreDNSEmptyPort).To(Equal(uint32(5301)))
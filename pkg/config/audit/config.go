@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config"
+)
+
+// AuditConfig defines how mutations of resources are recorded.
+type AuditConfig struct {
+	// Enabled turns on recording of create, update and delete operations on resources
+	Enabled bool `yaml:"enabled" envconfig:"kuma_audit_enabled"`
+	// Retention of audit events kept by the Control Plane to serve them with the API
+	Retention RetentionConfig `yaml:"retention"`
+	// Sinks to which audit events are shipped
+	Sinks SinksConfig `yaml:"sinks"`
+}
+
+type RetentionConfig struct {
+	// MaxEntries is a maximum number of audit events kept by the Control Plane. The oldest events are dropped first.
+	MaxEntries int `yaml:"maxEntries" envconfig:"kuma_audit_retention_max_entries"`
+	// MaxAge is a maximum time for which an audit event is kept by the Control Plane
+	MaxAge time.Duration `yaml:"maxAge" envconfig:"kuma_audit_retention_max_age"`
+}
+
+type SinksConfig struct {
+	// File sink appends audit events as JSON lines to the file
+	File FileSinkConfig `yaml:"file"`
+	// Stdout sink writes audit events as JSON lines to the standard output
+	Stdout StdoutSinkConfig `yaml:"stdout"`
+	// Webhook sink sends every audit event as JSON in a POST request
+	Webhook WebhookSinkConfig `yaml:"webhook"`
+}
+
+type FileSinkConfig struct {
+	// Path to the file. If empty, the sink is disabled.
+	Path string `yaml:"path" envconfig:"kuma_audit_sinks_file_path"`
+}
+
+type StdoutSinkConfig struct {
+	// Enabled turns on the sink
+	Enabled bool `yaml:"enabled" envconfig:"kuma_audit_sinks_stdout_enabled"`
+}
+
+type WebhookSinkConfig struct {
+	// URL of the webhook. If empty, the sink is disabled.
+	URL string `yaml:"url" envconfig:"kuma_audit_sinks_webhook_url"`
+	// Timeout of the request to the webhook
+	Timeout time.Duration `yaml:"timeout" envconfig:"kuma_audit_sinks_webhook_timeout"`
+}
+
+var _ config.Config = &AuditConfig{}
+
+func (a *AuditConfig) Sanitize() {
+}
+
+func (a *AuditConfig) Validate() error {
+	if a.Retention.MaxEntries < 0 {
+		return errors.New("Retention.MaxEntries must be greater or equal to 0")
+	}
+	if a.Retention.MaxAge < 0 {
+		return errors.New("Retention.MaxAge must be greater or equal to 0s")
+	}
+	if a.Sinks.Webhook.URL != "" {
+		if _, err := url.ParseRequestURI(a.Sinks.Webhook.URL); err != nil {
+			return errors.Wrap(err, "Sinks.Webhook.URL is not a valid URL")
+		}
+		if a.Sinks.Webhook.Timeout <= 0 {
+			return errors.New("Sinks.Webhook.Timeout must be greater than 0s")
+		}
+	}
+	return nil
+}
+
+func DefaultAuditConfig() *AuditConfig {
+	return &AuditConfig{
+		Enabled: false,
+		Retention: RetentionConfig{
+			MaxEntries: 1000,
+			MaxAge:     24 * time.Hour,
+		},
+		Sinks: SinksConfig{
+			Webhook: WebhookSinkConfig{
+				Timeout: 5 * time.Second,
+			},
+		},
+	}
+}
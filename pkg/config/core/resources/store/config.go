@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/config"
+	"github.com/kumahq/kuma/pkg/config/plugins/resources/etcd"
 	"github.com/kumahq/kuma/pkg/config/plugins/resources/k8s"
 	"github.com/kumahq/kuma/pkg/config/plugins/resources/postgres"
 )
@@ -18,14 +19,17 @@ const (
 	KubernetesStore StoreType = "kubernetes"
 	PostgresStore   StoreType = "postgres"
 	MemoryStore     StoreType = "memory"
+	EtcdStore       StoreType = "etcd"
 )
 
 // Resource Store configuration
 type StoreConfig struct {
-	// Type of Store used in the Control Plane. Can be either "kubernetes", "postgres" or "memory"
+	// Type of Store used in the Control Plane. Can be either "kubernetes", "postgres", "etcd" or "memory"
 	Type StoreType `yaml:"type" envconfig:"kuma_store_type"`
 	// Postgres Store configuration
 	Postgres *postgres.PostgresStoreConfig `yaml:"postgres"`
+	// Etcd Store configuration
+	Etcd *etcd.EtcdStoreConfig `yaml:"etcd"`
 	// Kubernetes Store configuration
 	Kubernetes *k8s.KubernetesStoreConfig `yaml:"kubernetes"`
 	// Cache configuration
@@ -35,12 +39,15 @@ type StoreConfig struct {
 	// UnsafeDelete skips validation of resource delete.
 	// For example you don't have to delete all Dataplane objects before you delete a Mesh
 	UnsafeDelete bool `yaml:"unsafeDelete" envconfig:"kuma_store_unsafe_delete"`
+	// SecretsEncryption configures encryption at rest of secrets in the "postgres", "etcd" and "memory" stores
+	SecretsEncryption SecretsEncryptionConfig `yaml:"secretsEncryption"`
 }
 
 func DefaultStoreConfig() *StoreConfig {
 	return &StoreConfig{
 		Type:       MemoryStore,
 		Postgres:   postgres.DefaultPostgresStoreConfig(),
+		Etcd:       etcd.DefaultEtcdStoreConfig(),
 		Kubernetes: k8s.DefaultKubernetesStoreConfig(),
 		Cache:      DefaultCacheStoreConfig(),
 		Upsert:     DefaultUpsertConfig(),
@@ -50,15 +57,26 @@ func DefaultStoreConfig() *StoreConfig {
 func (s *StoreConfig) Sanitize() {
 	s.Kubernetes.Sanitize()
 	s.Postgres.Sanitize()
+	s.Etcd.Sanitize()
 	s.Cache.Sanitize()
 }
 
 func (s *StoreConfig) Validate() error {
+	if err := s.SecretsEncryption.Validate(); err != nil {
+		return errors.Wrap(err, "SecretsEncryption validation failed")
+	}
+	if s.Type == KubernetesStore && s.SecretsEncryption.Type != "" {
+		return errors.New("SecretsEncryption cannot be used with Kubernetes store, use encryption of Kubernetes Secrets instead")
+	}
 	switch s.Type {
 	case PostgresStore:
 		if err := s.Postgres.Validate(); err != nil {
 			return errors.Wrap(err, "Postgres validation failed")
 		}
+	case EtcdStore:
+		if err := s.Etcd.Validate(); err != nil {
+			return errors.Wrap(err, "Etcd validation failed")
+		}
 	case KubernetesStore:
 		if err := s.Kubernetes.Validate(); err != nil {
 			return errors.Wrap(err, "Kubernetes validation failed")
@@ -67,7 +85,7 @@ func (s *StoreConfig) Validate() error {
 	case MemoryStore:
 		return nil
 	default:
-		return errors.Errorf("Type should be either %s, %s or %s", PostgresStore, KubernetesStore, MemoryStore)
+		return errors.Errorf("Type should be either %s, %s, %s or %s", PostgresStore, EtcdStore, KubernetesStore, MemoryStore)
 	}
 	if err := s.Cache.Validate(); err != nil {
 		return errors.Wrap(err, "Cache validation failed")
@@ -80,19 +98,31 @@ var _ config.Config = &CacheStoreConfig{}
 type CacheStoreConfig struct {
 	Enabled        bool          `yaml:"enabled" envconfig:"kuma_store_cache_enabled"`
 	ExpirationTime time.Duration `yaml:"expirationTime" envconfig:"kuma_store_cache_expiration_time"`
+	// TypeExpirationTimes overrides ExpirationTime for given resource types, e.g. {"Mesh": "5s"}.
+	// Resources of a type with expiration time 0 are not cached.
+	TypeExpirationTimes map[string]time.Duration `yaml:"typeExpirationTimes" envconfig:"kuma_store_cache_type_expiration_times"`
 }
 
 func (c CacheStoreConfig) Sanitize() {
 }
 
 func (c CacheStoreConfig) Validate() error {
+	if c.ExpirationTime < 0 {
+		return errors.New("ExpirationTime cannot be negative")
+	}
+	for resourceType, expirationTime := range c.TypeExpirationTimes {
+		if expirationTime < 0 {
+			return errors.Errorf("TypeExpirationTimes[%s] cannot be negative", resourceType)
+		}
+	}
 	return nil
 }
 
 func DefaultCacheStoreConfig() CacheStoreConfig {
 	return CacheStoreConfig{
-		Enabled:        true,
-		ExpirationTime: time.Second,
+		Enabled:             true,
+		ExpirationTime:      time.Second,
+		TypeExpirationTimes: map[string]time.Duration{},
 	}
 }
 
@@ -121,3 +151,58 @@ func (u *UpsertConfig) Validate() error {
 }
 
 var _ config.Config = &UpsertConfig{}
+
+const (
+	// StaticKeyEncryption encrypts secrets with a key loaded from the file
+	StaticKeyEncryption = "static"
+	// AwsKmsEncryption encrypts secrets with a key of AWS Key Management Service
+	AwsKmsEncryption = "awsKms"
+)
+
+// SecretsEncryptionConfig defines envelope encryption of secrets. Every secret is encrypted with its own data key,
+// which is then encrypted with the key encryption key defined by this config and stored next to the secret.
+type SecretsEncryptionConfig struct {
+	// Type of the key encryption key: "static" or "awsKms". If empty, secrets are stored unencrypted.
+	Type string `yaml:"type" envconfig:"kuma_store_secrets_encryption_type"`
+	// Static configures a key encryption key loaded from the file
+	Static StaticKeyEncryptionConfig `yaml:"static"`
+	// AwsKms configures a key encryption key of AWS Key Management Service
+	AwsKms AwsKmsEncryptionConfig `yaml:"awsKms"`
+}
+
+type StaticKeyEncryptionConfig struct {
+	// KeyFile is a path to the file with base64 encoded 32 bytes AES key, e.g. generated by "openssl rand -base64 32"
+	KeyFile string `yaml:"keyFile" envconfig:"kuma_store_secrets_encryption_static_key_file"`
+}
+
+type AwsKmsEncryptionConfig struct {
+	// KeyId is an id, ARN or alias of the symmetric key in AWS KMS
+	KeyId string `yaml:"keyId" envconfig:"kuma_store_secrets_encryption_aws_kms_key_id"`
+	// Region of AWS KMS. If empty, the region is taken from the environment.
+	Region string `yaml:"region" envconfig:"kuma_store_secrets_encryption_aws_kms_region"`
+	// Endpoint overrides the endpoint of AWS KMS, e.g. to use a VPC endpoint
+	Endpoint string `yaml:"endpoint" envconfig:"kuma_store_secrets_encryption_aws_kms_endpoint"`
+}
+
+var _ config.Config = &SecretsEncryptionConfig{}
+
+func (s *SecretsEncryptionConfig) Sanitize() {
+}
+
+func (s *SecretsEncryptionConfig) Validate() error {
+	switch s.Type {
+	case "":
+		return nil
+	case StaticKeyEncryption:
+		if s.Static.KeyFile == "" {
+			return errors.New("Static.KeyFile has to be defined")
+		}
+	case AwsKmsEncryption:
+		if s.AwsKms.KeyId == "" {
+			return errors.New("AwsKms.KeyId has to be defined")
+		}
+	default:
+		return errors.Errorf("Type has to be either %q or %q", StaticKeyEncryption, AwsKmsEncryption)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package envoy_admin_client
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config"
+)
+
+// EnvoyAdminClientConfig defines how the Control Plane connects to the Envoy Admin API of the proxies.
+type EnvoyAdminClientConfig struct {
+	// ConnectTimeout is a timeout for establishing TCP connection and TLS handshake with the Envoy Admin API.
+	ConnectTimeout time.Duration `yaml:"connectTimeout" envconfig:"kuma_envoy_admin_client_connect_timeout"`
+	// RequestTimeout is a timeout for the whole request to the Envoy Admin API including reading the response.
+	RequestTimeout time.Duration `yaml:"requestTimeout" envconfig:"kuma_envoy_admin_client_request_timeout"`
+	// MaxRetries is a number of retries of a failed read-only request to the Envoy Admin API.
+	MaxRetries uint `yaml:"maxRetries" envconfig:"kuma_envoy_admin_client_max_retries"`
+	// RetryBaseBackoff is a base time for the exponential backoff between retries.
+	RetryBaseBackoff time.Duration `yaml:"retryBaseBackoff" envconfig:"kuma_envoy_admin_client_retry_base_backoff"`
+	// CacheSize is a maximum number of HTTP clients cached by the Control Plane. There is a client for every pair of mesh and service.
+	CacheSize int `yaml:"cacheSize" envconfig:"kuma_envoy_admin_client_cache_size"`
+	// IdleConnTimeout is a time after which idle connections to the Envoy Admin API are closed.
+	IdleConnTimeout time.Duration `yaml:"idleConnTimeout" envconfig:"kuma_envoy_admin_client_idle_conn_timeout"`
+}
+
+var _ config.Config = &EnvoyAdminClientConfig{}
+
+func (e *EnvoyAdminClientConfig) Sanitize() {
+}
+
+func (e *EnvoyAdminClientConfig) Validate() error {
+	if e.ConnectTimeout <= 0 {
+		return errors.New("ConnectTimeout must be greater than 0s")
+	}
+	if e.RequestTimeout <= 0 {
+		return errors.New("RequestTimeout must be greater than 0s")
+	}
+	if e.RetryBaseBackoff <= 0 {
+		return errors.New("RetryBaseBackoff must be greater than 0s")
+	}
+	if e.CacheSize <= 0 {
+		return errors.New("CacheSize must be greater than 0")
+	}
+	if e.IdleConnTimeout < 0 {
+		return errors.New("IdleConnTimeout must be greater or equal to 0s")
+	}
+	return nil
+}
+
+func DefaultEnvoyAdminClientConfig() *EnvoyAdminClientConfig {
+	return &EnvoyAdminClientConfig{
+		ConnectTimeout:   3 * time.Second,
+		RequestTimeout:   5 * time.Second,
+		MaxRetries:       0,
+		RetryBaseBackoff: 500 * time.Millisecond,
+		CacheSize:        1000,
+		IdleConnTimeout:  90 * time.Second,
+	}
+}
@@ -0,0 +1,103 @@
+package key_provider
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config"
+)
+
+const (
+	// AwsKmsType stores keys in AWS Key Management Service
+	AwsKmsType = "awsKms"
+	// Pkcs11Type stores keys in a PKCS#11 token, like HSM
+	Pkcs11Type = "pkcs11"
+)
+
+// KeyProviderConfig defines a provider of the private keys of the Control Plane.
+// Keys of the provider never leave it, the Control Plane only asks the provider to sign data with them.
+type KeyProviderConfig struct {
+	// Type of the key provider: "awsKms" or "pkcs11". If empty, keys are loaded from files and secrets.
+	Type string `yaml:"type" envconfig:"kuma_key_provider_type"`
+	// AwsKms configures AWS Key Management Service
+	AwsKms AwsKmsConfig `yaml:"awsKms"`
+	// Pkcs11 configures a PKCS#11 token
+	Pkcs11 Pkcs11Config `yaml:"pkcs11"`
+	// EnvoyAdminClient is a key of the client certificate used by the Control Plane to connect to the Envoy Admin API
+	EnvoyAdminClient ClientKeyConfig `yaml:"envoyAdminClient"`
+	// UserToken is a key used to sign user tokens
+	UserToken SigningKeyConfig `yaml:"userToken"`
+}
+
+type AwsKmsConfig struct {
+	// Region of AWS KMS. If empty, the region is taken from the environment.
+	Region string `yaml:"region" envconfig:"kuma_key_provider_aws_kms_region"`
+	// Endpoint overrides the endpoint of AWS KMS, e.g. to use a VPC endpoint
+	Endpoint string `yaml:"endpoint" envconfig:"kuma_key_provider_aws_kms_endpoint"`
+}
+
+type Pkcs11Config struct {
+	// Library is a path to the PKCS#11 module
+	Library string `yaml:"library" envconfig:"kuma_key_provider_pkcs11_library"`
+	// TokenLabel is a label of the token with the keys
+	TokenLabel string `yaml:"tokenLabel" envconfig:"kuma_key_provider_pkcs11_token_label"`
+	// Pin of the user of the token
+	Pin string `yaml:"pin" envconfig:"kuma_key_provider_pkcs11_pin"`
+}
+
+type ClientKeyConfig struct {
+	// KeyId is an id or ARN of the key in AWS KMS or a label of the key in the PKCS#11 token.
+	// If empty, the key is loaded from the file.
+	KeyId string `yaml:"keyId" envconfig:"kuma_key_provider_envoy_admin_client_key_id"`
+	// Cert is a PEM encoded certificate of the key.
+	// If empty, the certificate with the label of the key is loaded from the PKCS#11 token.
+	Cert string `yaml:"cert" envconfig:"kuma_key_provider_envoy_admin_client_cert"`
+}
+
+type SigningKeyConfig struct {
+	// KeyId is an id or ARN of the RSA key in AWS KMS or a label of the RSA key in the PKCS#11 token.
+	// If empty, the signing key is stored in the secret.
+	KeyId string `yaml:"keyId" envconfig:"kuma_key_provider_user_token_key_id"`
+	// SerialNumber of the signing key placed in tokens. To rotate the key, use a new key with a higher serial number.
+	SerialNumber int `yaml:"serialNumber" envconfig:"kuma_key_provider_user_token_serial_number"`
+}
+
+var _ config.Config = &KeyProviderConfig{}
+
+func (k *KeyProviderConfig) Sanitize() {
+	k.Pkcs11.Pin = config.SanitizedValue
+}
+
+func (k *KeyProviderConfig) Validate() error {
+	switch k.Type {
+	case "":
+		if k.EnvoyAdminClient.KeyId != "" || k.UserToken.KeyId != "" {
+			return errors.New("Type has to be defined when keys of the key provider are used")
+		}
+		return nil
+	case AwsKmsType:
+		if k.EnvoyAdminClient.KeyId != "" && k.EnvoyAdminClient.Cert == "" {
+			return errors.New("EnvoyAdminClient.Cert has to be defined, because AWS KMS does not store certificates")
+		}
+	case Pkcs11Type:
+		if k.Pkcs11.Library == "" {
+			return errors.New("Pkcs11.Library has to be defined")
+		}
+		if k.Pkcs11.TokenLabel == "" {
+			return errors.New("Pkcs11.TokenLabel has to be defined")
+		}
+	default:
+		return errors.Errorf("Type has to be either %q or %q", AwsKmsType, Pkcs11Type)
+	}
+	if k.UserToken.KeyId != "" && k.UserToken.SerialNumber <= 0 {
+		return errors.New("UserToken.SerialNumber must be greater than 0")
+	}
+	return nil
+}
+
+func DefaultKeyProviderConfig() *KeyProviderConfig {
+	return &KeyProviderConfig{
+		UserToken: SigningKeyConfig{
+			SerialNumber: 1,
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/kumahq/kuma/pkg/config"
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
@@ -104,11 +105,29 @@ var _ = Describe("Config loader", func() {
 
 			Expect(cfg.Store.Kubernetes.SystemNamespace).To(Equal("test-namespace"))
 
+			Expect(cfg.Store.Etcd.Endpoints).To(Equal([]string{"etcd-1:2379", "etcd-2:2379"}))
+			Expect(cfg.Store.Etcd.Username).To(Equal("kuma"))
+			Expect(cfg.Store.Etcd.Password).To(Equal("etcd-pass"))
+			Expect(cfg.Store.Etcd.Prefix).To(Equal("/kuma-test"))
+			Expect(cfg.Store.Etcd.DialTimeout).To(Equal(7 * time.Second))
+			Expect(cfg.Store.Etcd.ListPageSize).To(Equal(int64(100)))
+			Expect(cfg.Store.Etcd.LeaderElectionLeaseTTL).To(Equal(9 * time.Second))
+			Expect(cfg.Store.Etcd.TLS.Enabled).To(BeTrue())
+			Expect(cfg.Store.Etcd.TLS.CertPath).To(Equal("/path/to/etcd/cert"))
+			Expect(cfg.Store.Etcd.TLS.KeyPath).To(Equal("/path/to/etcd/key"))
+			Expect(cfg.Store.Etcd.TLS.CAPath).To(Equal("/path/to/etcd/ca"))
+
 			Expect(cfg.Store.Cache.Enabled).To(BeFalse())
 			Expect(cfg.Store.Cache.ExpirationTime).To(Equal(3 * time.Second))
+			Expect(cfg.Store.Cache.TypeExpirationTimes).To(Equal(map[string]time.Duration{"Mesh": 10 * time.Second, "Secret": 0}))
 
 			Expect(cfg.Store.Upsert.ConflictRetryBaseBackoff).To(Equal(4 * time.Second))
 			Expect(cfg.Store.Upsert.ConflictRetryMaxTimes).To(Equal(uint(10)))
+			Expect(cfg.Store.SecretsEncryption.Type).To(Equal("awsKms"))
+			Expect(cfg.Store.SecretsEncryption.Static.KeyFile).To(Equal("/path/to/kek"))
+			Expect(cfg.Store.SecretsEncryption.AwsKms.KeyId).To(Equal("alias/kuma-secrets"))
+			Expect(cfg.Store.SecretsEncryption.AwsKms.Region).To(Equal("eu-west-1"))
+			Expect(cfg.Store.SecretsEncryption.AwsKms.Endpoint).To(Equal("https://kms.eu-west-1.example.com"))
 
 			Expect(cfg.Store.Postgres.TLS.Mode).To(Equal(postgres.VerifyFull))
 			Expect(cfg.Store.Postgres.TLS.CertPath).To(Equal("/path/to/cert"))
@@ -128,7 +147,19 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.ApiServer.Authn.LocalhostIsAdmin).To(Equal(false))
 			Expect(cfg.ApiServer.Authn.Type).To(Equal("custom-authn"))
 			Expect(cfg.ApiServer.Authn.Tokens.BootstrapAdminToken).To(BeFalse())
+			Expect(cfg.ApiServer.Authn.OIDC.Issuer).To(Equal("https://idp.example.com"))
+			Expect(cfg.ApiServer.Authn.OIDC.ClientID).To(Equal("kumactl"))
+			Expect(cfg.ApiServer.Authn.OIDC.Scopes).To(Equal([]string{"openid", "groups"}))
+			Expect(cfg.ApiServer.Authn.OIDC.UsernameClaim).To(Equal("email"))
+			Expect(cfg.ApiServer.Authn.OIDC.GroupsClaim).To(Equal("roles"))
+			Expect(cfg.ApiServer.Authn.OIDC.GroupMappings).To(Equal(api_server.GroupMappings{"platform-team": "mesh-system:admin", "dev-team": "developers"}))
+			Expect(cfg.ApiServer.Authn.OIDC.TenantClaim).To(Equal("org"))
 			Expect(cfg.ApiServer.CorsAllowedDomains).To(Equal([]string{"https://kuma", "https://someapi"}))
+			Expect(cfg.ApiServer.Limits.MaxRequestBodySize).To(Equal(int64(1024)))
+			Expect(cfg.ApiServer.Limits.PerClientIP.RequestsPerSecond).To(Equal(10.5))
+			Expect(cfg.ApiServer.Limits.PerClientIP.Burst).To(Equal(20))
+			Expect(cfg.ApiServer.Limits.PerToken.RequestsPerSecond).To(Equal(5.0))
+			Expect(cfg.ApiServer.Limits.PerToken.Burst).To(Equal(7))
 
 			// nolint: staticcheck
 			Expect(cfg.MonitoringAssignmentServer.GrpcPort).To(Equal(uint32(3333)))
@@ -199,6 +230,7 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Multizone.Global.KDS.TlsCertFile).To(Equal("/cert"))
 			Expect(cfg.Multizone.Global.KDS.TlsKeyFile).To(Equal("/key"))
 			Expect(cfg.Multizone.Global.KDS.MaxMsgSize).To(Equal(uint32(1)))
+			Expect(cfg.Multizone.Global.KDS.ZoneScopedTypes).To(Equal([]string{"TrafficPermission", "TrafficRoute"}))
 			Expect(cfg.Multizone.Zone.GlobalAddress).To(Equal("grpc://1.1.1.1:5685"))
 			Expect(cfg.Multizone.Zone.Name).To(Equal("zone-1"))
 			Expect(cfg.Multizone.Zone.KDS.RootCAFile).To(Equal("/rootCa"))
@@ -218,6 +250,8 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.XdsServer.DataplaneConfigurationRefreshInterval).To(Equal(21 * time.Second))
 			Expect(cfg.XdsServer.DataplaneDeregistrationDelay).To(Equal(11 * time.Second))
 			Expect(cfg.XdsServer.NACKBackoff).To(Equal(10 * time.Second))
+			Expect(cfg.XdsServer.DeltaXds).To(BeTrue())
+			Expect(cfg.XdsServer.DataplaneConfigurationGenerationConcurrency).To(Equal(4))
 
 			Expect(cfg.Metrics.Zone.SubscriptionLimit).To(Equal(23))
 			Expect(cfg.Metrics.Zone.IdleTimeout).To(Equal(2 * time.Minute))
@@ -225,6 +259,8 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Metrics.Mesh.MaxResyncTimeout).To(Equal(27 * time.Second))
 			Expect(cfg.Metrics.Dataplane.SubscriptionLimit).To(Equal(47))
 			Expect(cfg.Metrics.Dataplane.IdleTimeout).To(Equal(1 * time.Minute))
+			Expect(cfg.Metrics.Dataplane.MemoryStatsInterval).To(Equal(30 * time.Second))
+			Expect(cfg.Metrics.Dataplane.ConnectionStatsInterval).To(Equal(45 * time.Second))
 
 			Expect(cfg.DpServer.TlsCertFile).To(Equal("/test/path"))
 			Expect(cfg.DpServer.TlsKeyFile).To(Equal("/test/path/key"))
@@ -239,6 +275,13 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.DpServer.Hds.CheckDefaults.HealthyThreshold).To(Equal(uint32(8)))
 			Expect(cfg.DpServer.Hds.CheckDefaults.UnhealthyThreshold).To(Equal(uint32(9)))
 
+			Expect(cfg.EnvoyAdminClient.ConnectTimeout).To(Equal(4 * time.Second))
+			Expect(cfg.EnvoyAdminClient.RequestTimeout).To(Equal(30 * time.Second))
+			Expect(cfg.EnvoyAdminClient.MaxRetries).To(Equal(uint(3)))
+			Expect(cfg.EnvoyAdminClient.RetryBaseBackoff).To(Equal(time.Second))
+			Expect(cfg.EnvoyAdminClient.CacheSize).To(Equal(100))
+			Expect(cfg.EnvoyAdminClient.IdleConnTimeout).To(Equal(10 * time.Second))
+
 			Expect(cfg.Access.Type).To(Equal("custom-rbac"))
 			Expect(cfg.Access.Static.AdminResources.Users).To(Equal([]string{"ar-admin1", "ar-admin2"}))
 			Expect(cfg.Access.Static.AdminResources.Groups).To(Equal([]string{"ar-group1", "ar-group2"}))
@@ -254,9 +297,39 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Access.Static.ViewStats.Groups).To(Equal([]string{"zt-group1", "zt-group2"}))
 			Expect(cfg.Access.Static.ViewClusters.Users).To(Equal([]string{"zt-admin1", "zt-admin2"}))
 			Expect(cfg.Access.Static.ViewClusters.Groups).To(Equal([]string{"zt-group1", "zt-group2"}))
+			Expect(cfg.Access.Static.DrainDataplane.Users).To(Equal([]string{"dd-admin1", "dd-admin2"}))
+			Expect(cfg.Access.Static.DrainDataplane.Groups).To(Equal([]string{"dd-group1", "dd-group2"}))
+			Expect(cfg.Access.Static.ViewUnredactedConfigDump.Users).To(Equal([]string{"ucd-admin1", "ucd-admin2"}))
+			Expect(cfg.Access.Static.ViewUnredactedConfigDump.Groups).To(Equal([]string{"ucd-group1", "ucd-group2"}))
+			Expect(cfg.Access.Static.ProxyAdmin.Users).To(Equal([]string{"pa-admin1", "pa-admin2"}))
+			Expect(cfg.Access.Static.ProxyAdmin.Groups).To(Equal([]string{"pa-group1", "pa-group2"}))
+			Expect(cfg.Access.RBAC.Admin.Users).To(Equal([]string{"rbac-admin1", "rbac-admin2"}))
+			Expect(cfg.Access.RBAC.Admin.Groups).To(Equal([]string{"rbac-group1", "rbac-group2"}))
+			Expect(cfg.Access.Static.ViewAuditLog.Users).To(Equal([]string{"al-admin1", "al-admin2"}))
+			Expect(cfg.Access.Static.ViewAuditLog.Groups).To(Equal([]string{"al-group1", "al-group2"}))
+
+			Expect(cfg.Audit.Enabled).To(BeTrue())
+			Expect(cfg.Audit.Retention.MaxEntries).To(Equal(50))
+			Expect(cfg.Audit.Retention.MaxAge).To(Equal(time.Hour))
+			Expect(cfg.Audit.Sinks.File.Path).To(Equal("/tmp/audit.log"))
+			Expect(cfg.Audit.Sinks.Stdout.Enabled).To(BeTrue())
+			Expect(cfg.Audit.Sinks.Webhook.URL).To(Equal("https://audit.example.com/events"))
+			Expect(cfg.Audit.Sinks.Webhook.Timeout).To(Equal(3 * time.Second))
+
+			Expect(cfg.KeyProvider.Type).To(Equal("pkcs11"))
+			Expect(cfg.KeyProvider.AwsKms.Region).To(Equal("us-east-1"))
+			Expect(cfg.KeyProvider.AwsKms.Endpoint).To(Equal("https://kms.example.com"))
+			Expect(cfg.KeyProvider.Pkcs11.Library).To(Equal("/usr/lib/softhsm/libsofthsm2.so"))
+			Expect(cfg.KeyProvider.Pkcs11.TokenLabel).To(Equal("kuma"))
+			Expect(cfg.KeyProvider.Pkcs11.Pin).To(Equal("1234"))
+			Expect(cfg.KeyProvider.EnvoyAdminClient.KeyId).To(Equal("admin-client"))
+			Expect(cfg.KeyProvider.EnvoyAdminClient.Cert).To(Equal("admin-client-cert"))
+			Expect(cfg.KeyProvider.UserToken.KeyId).To(Equal("user-token"))
+			Expect(cfg.KeyProvider.UserToken.SerialNumber).To(Equal(2))
 
 			Expect(cfg.Experimental.GatewayAPI).To(BeTrue())
 			Expect(cfg.Experimental.KubeOutboundsAsVIPs).To(BeTrue())
+			Expect(cfg.Experimental.EnvoyTap).To(BeTrue())
 		},
 		Entry("from config file", testCase{
 			envVars: map[string]string{},
@@ -283,12 +356,38 @@ store:
       caPath: /path/to/rootCert
   kubernetes:
     systemNamespace: test-namespace
+  etcd:
+    endpoints:
+      - etcd-1:2379
+      - etcd-2:2379
+    username: kuma
+    password: etcd-pass
+    prefix: /kuma-test
+    dialTimeout: 7s
+    listPageSize: 100
+    leaderElectionLeaseTTL: 9s
+    tls:
+      enabled: true
+      certPath: /path/to/etcd/cert
+      keyPath: /path/to/etcd/key
+      caPath: /path/to/etcd/ca
   cache:
     enabled: false
     expirationTime: 3s
+    typeExpirationTimes:
+      Mesh: 10s
+      Secret: 0s
   upsert:
     conflictRetryBaseBackoff: 4s
     conflictRetryMaxTimes: 10
+  secretsEncryption:
+    type: awsKms
+    static:
+      keyFile: /path/to/kek
+    awsKms:
+      keyId: alias/kuma-secrets
+      region: eu-west-1
+      endpoint: https://kms.eu-west-1.example.com
 bootstrapServer:
   params:
     adminPort: 1234
@@ -315,6 +414,26 @@ apiServer:
     localhostIsAdmin: false
     tokens:
       bootstrapAdminToken: false
+    oidc:
+      issuer: https://idp.example.com
+      clientId: kumactl
+      scopes:
+        - openid
+        - groups
+      usernameClaim: email
+      groupsClaim: roles
+      groupMappings:
+        platform-team: mesh-system:admin
+        dev-team: developers
+      tenantClaim: org
+  limits:
+    maxRequestBodySize: 1024
+    perClientIp:
+      requestsPerSecond: 10.5
+      burst: 20
+    perToken:
+      requestsPerSecond: 5
+      burst: 7
   readOnly: true
   corsAllowedDomains:
     - https://kuma
@@ -407,6 +526,7 @@ multizone:
       tlsCertFile: /cert
       tlsKeyFile: /key
       maxMsgSize: 1
+      zoneScopedTypes: ["TrafficPermission", "TrafficRoute"]
   zone:
     globalAddress: "grpc://1.1.1.1:5685"
     name: "zone-1"
@@ -428,6 +548,8 @@ xdsServer:
   dataplaneStatusFlushInterval: 7s
   dataplaneDeregistrationDelay: 11s
   nackBackoff: 10s
+  deltaXds: true
+  dataplaneConfigurationGenerationConcurrency: 4
 metrics:
   zone:
     subscriptionLimit: 23
@@ -438,6 +560,8 @@ metrics:
   dataplane:
     subscriptionLimit: 47
     idleTimeout: 1m
+    memoryStatsInterval: 30s
+    connectionStatsInterval: 45s
 dpServer:
   tlsCertFile: /test/path
   tlsKeyFile: /test/path/key
@@ -454,6 +578,13 @@ dpServer:
       noTrafficInterval: 7s
       healthyThreshold: 8
       unhealthyThreshold: 9
+envoyAdminClient:
+  connectTimeout: 4s
+  requestTimeout: 30s
+  maxRetries: 3
+  retryBaseBackoff: 1s
+  cacheSize: 100
+  idleConnTimeout: 10s
 access:
   type: custom-rbac
   static:
@@ -478,9 +609,54 @@ access:
     viewClusters:
       users: ["zt-admin1", "zt-admin2"]
       groups: ["zt-group1", "zt-group2"]
+    drainDataplane:
+      users: ["dd-admin1", "dd-admin2"]
+      groups: ["dd-group1", "dd-group2"]
+    viewUnredactedConfigDump:
+      users: ["ucd-admin1", "ucd-admin2"]
+      groups: ["ucd-group1", "ucd-group2"]
+    proxyAdmin:
+      users: ["pa-admin1", "pa-admin2"]
+      groups: ["pa-group1", "pa-group2"]
+    viewAuditLog:
+      users: ["al-admin1", "al-admin2"]
+      groups: ["al-group1", "al-group2"]
+  rbac:
+    admin:
+      users: ["rbac-admin1", "rbac-admin2"]
+      groups: ["rbac-group1", "rbac-group2"]
+audit:
+  enabled: true
+  retention:
+    maxEntries: 50
+    maxAge: 1h
+  sinks:
+    file:
+      path: /tmp/audit.log
+    stdout:
+      enabled: true
+    webhook:
+      url: https://audit.example.com/events
+      timeout: 3s
+keyProvider:
+  type: pkcs11
+  awsKms:
+    region: us-east-1
+    endpoint: https://kms.example.com
+  pkcs11:
+    library: /usr/lib/softhsm/libsofthsm2.so
+    tokenLabel: kuma
+    pin: "1234"
+  envoyAdminClient:
+    keyId: admin-client
+    cert: admin-client-cert
+  userToken:
+    keyId: user-token
+    serialNumber: 2
 experimental:
   gatewayAPI: true
   kubeOutboundsAsVIPs: true
+  envoyTap: true
 `,
 		}),
 		Entry("from env variables", testCase{
@@ -509,10 +685,27 @@ experimental:
 				"KUMA_STORE_POSTGRES_MIN_RECONNECT_INTERVAL":                                               "44s",
 				"KUMA_STORE_POSTGRES_MAX_RECONNECT_INTERVAL":                                               "55s",
 				"KUMA_STORE_KUBERNETES_SYSTEM_NAMESPACE":                                                   "test-namespace",
+				"KUMA_STORE_ETCD_ENDPOINTS":                                                                "etcd-1:2379,etcd-2:2379",
+				"KUMA_STORE_ETCD_USERNAME":                                                                 "kuma",
+				"KUMA_STORE_ETCD_PASSWORD":                                                                 "etcd-pass",
+				"KUMA_STORE_ETCD_PREFIX":                                                                   "/kuma-test",
+				"KUMA_STORE_ETCD_DIAL_TIMEOUT":                                                             "7s",
+				"KUMA_STORE_ETCD_LIST_PAGE_SIZE":                                                           "100",
+				"KUMA_STORE_ETCD_LEADER_ELECTION_LEASE_TTL":                                                "9s",
+				"KUMA_STORE_ETCD_TLS_ENABLED":                                                              "true",
+				"KUMA_STORE_ETCD_TLS_CERT_PATH":                                                            "/path/to/etcd/cert",
+				"KUMA_STORE_ETCD_TLS_KEY_PATH":                                                             "/path/to/etcd/key",
+				"KUMA_STORE_ETCD_TLS_CA_PATH":                                                              "/path/to/etcd/ca",
 				"KUMA_STORE_CACHE_ENABLED":                                                                 "false",
 				"KUMA_STORE_CACHE_EXPIRATION_TIME":                                                         "3s",
+				"KUMA_STORE_CACHE_TYPE_EXPIRATION_TIMES":                                                   "Mesh:10s,Secret:0s",
 				"KUMA_STORE_UPSERT_CONFLICT_RETRY_BASE_BACKOFF":                                            "4s",
 				"KUMA_STORE_UPSERT_CONFLICT_RETRY_MAX_TIMES":                                               "10",
+				"KUMA_STORE_SECRETS_ENCRYPTION_TYPE":                                                       "awsKms",
+				"KUMA_STORE_SECRETS_ENCRYPTION_STATIC_KEY_FILE":                                            "/path/to/kek",
+				"KUMA_STORE_SECRETS_ENCRYPTION_AWS_KMS_KEY_ID":                                             "alias/kuma-secrets",
+				"KUMA_STORE_SECRETS_ENCRYPTION_AWS_KMS_REGION":                                             "eu-west-1",
+				"KUMA_STORE_SECRETS_ENCRYPTION_AWS_KMS_ENDPOINT":                                           "https://kms.eu-west-1.example.com",
 				"KUMA_API_SERVER_READ_ONLY":                                                                "true",
 				"KUMA_API_SERVER_HTTP_PORT":                                                                "15681",
 				"KUMA_API_SERVER_HTTP_INTERFACE":                                                           "192.168.0.1",
@@ -526,6 +719,18 @@ experimental:
 				"KUMA_API_SERVER_AUTHN_TYPE":                                                               "custom-authn",
 				"KUMA_API_SERVER_AUTHN_LOCALHOST_IS_ADMIN":                                                 "false",
 				"KUMA_API_SERVER_AUTHN_TOKENS_BOOTSTRAP_ADMIN_TOKEN":                                       "false",
+				"KUMA_API_SERVER_AUTHN_OIDC_ISSUER":                                                        "https://idp.example.com",
+				"KUMA_API_SERVER_AUTHN_OIDC_CLIENT_ID":                                                     "kumactl",
+				"KUMA_API_SERVER_AUTHN_OIDC_SCOPES":                                                        "openid,groups",
+				"KUMA_API_SERVER_AUTHN_OIDC_USERNAME_CLAIM":                                                "email",
+				"KUMA_API_SERVER_AUTHN_OIDC_GROUPS_CLAIM":                                                  "roles",
+				"KUMA_API_SERVER_AUTHN_OIDC_GROUP_MAPPINGS":                                                "platform-team=mesh-system:admin,dev-team=developers",
+				"KUMA_API_SERVER_AUTHN_OIDC_TENANT_CLAIM":                                                  "org",
+				"KUMA_API_SERVER_LIMITS_MAX_REQUEST_BODY_SIZE":                                             "1024",
+				"KUMA_API_SERVER_LIMITS_PER_CLIENT_IP_REQUESTS_PER_SECOND":                                 "10.5",
+				"KUMA_API_SERVER_LIMITS_PER_CLIENT_IP_BURST":                                               "20",
+				"KUMA_API_SERVER_LIMITS_PER_TOKEN_REQUESTS_PER_SECOND":                                     "5",
+				"KUMA_API_SERVER_LIMITS_PER_TOKEN_BURST":                                                   "7",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_GRPC_PORT":                                              "3333",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_PORT":                                                   "2222",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_DEFAULT_FETCH_TIMEOUT":                                  "45s",
@@ -587,6 +792,7 @@ experimental:
 				"KUMA_MULTIZONE_GLOBAL_KDS_TLS_CERT_FILE":                                                  "/cert",
 				"KUMA_MULTIZONE_GLOBAL_KDS_TLS_KEY_FILE":                                                   "/key",
 				"KUMA_MULTIZONE_GLOBAL_KDS_MAX_MSG_SIZE":                                                   "1",
+				"KUMA_MULTIZONE_GLOBAL_KDS_ZONE_SCOPED_TYPES":                                              "TrafficPermission,TrafficRoute",
 				"KUMA_MULTIZONE_ZONE_GLOBAL_ADDRESS":                                                       "grpc://1.1.1.1:5685",
 				"KUMA_MULTIZONE_ZONE_NAME":                                                                 "zone-1",
 				"KUMA_MULTIZONE_ZONE_KDS_ROOT_CA_FILE":                                                     "/rootCa",
@@ -600,12 +806,16 @@ experimental:
 				"KUMA_XDS_SERVER_DATAPLANE_CONFIGURATION_REFRESH_INTERVAL":                                 "21s",
 				"KUMA_XDS_DATAPLANE_DEREGISTRATION_DELAY":                                                  "11s",
 				"KUMA_XDS_SERVER_NACK_BACKOFF":                                                             "10s",
+				"KUMA_XDS_SERVER_DELTA_XDS":                                                                "true",
+				"KUMA_XDS_SERVER_DATAPLANE_CONFIGURATION_GENERATION_CONCURRENCY":                           "4",
 				"KUMA_METRICS_ZONE_SUBSCRIPTION_LIMIT":                                                     "23",
 				"KUMA_METRICS_ZONE_IDLE_TIMEOUT":                                                           "2m",
 				"KUMA_METRICS_MESH_MAX_RESYNC_TIMEOUT":                                                     "27s",
 				"KUMA_METRICS_MESH_MIN_RESYNC_TIMEOUT":                                                     "35s",
 				"KUMA_METRICS_DATAPLANE_SUBSCRIPTION_LIMIT":                                                "47",
 				"KUMA_METRICS_DATAPLANE_IDLE_TIMEOUT":                                                      "1m",
+				"KUMA_METRICS_DATAPLANE_MEMORY_STATS_INTERVAL":                                             "30s",
+				"KUMA_METRICS_DATAPLANE_CONNECTION_STATS_INTERVAL":                                         "45s",
 				"KUMA_DP_SERVER_TLS_CERT_FILE":                                                             "/test/path",
 				"KUMA_DP_SERVER_TLS_KEY_FILE":                                                              "/test/path/key",
 				"KUMA_DP_SERVER_AUTH_TYPE":                                                                 "dpToken",
@@ -619,6 +829,12 @@ experimental:
 				"KUMA_DP_SERVER_HDS_CHECK_NO_TRAFFIC_INTERVAL":                                             "7s",
 				"KUMA_DP_SERVER_HDS_CHECK_HEALTHY_THRESHOLD":                                               "8",
 				"KUMA_DP_SERVER_HDS_CHECK_UNHEALTHY_THRESHOLD":                                             "9",
+				"KUMA_ENVOY_ADMIN_CLIENT_CONNECT_TIMEOUT":                                                  "4s",
+				"KUMA_ENVOY_ADMIN_CLIENT_REQUEST_TIMEOUT":                                                  "30s",
+				"KUMA_ENVOY_ADMIN_CLIENT_MAX_RETRIES":                                                      "3",
+				"KUMA_ENVOY_ADMIN_CLIENT_RETRY_BASE_BACKOFF":                                               "1s",
+				"KUMA_ENVOY_ADMIN_CLIENT_CACHE_SIZE":                                                       "100",
+				"KUMA_ENVOY_ADMIN_CLIENT_IDLE_CONN_TIMEOUT":                                                "10s",
 				"KUMA_ACCESS_TYPE":                                                                         "custom-rbac",
 				"KUMA_ACCESS_STATIC_ADMIN_RESOURCES_USERS":                                                 "ar-admin1,ar-admin2",
 				"KUMA_ACCESS_STATIC_ADMIN_RESOURCES_GROUPS":                                                "ar-group1,ar-group2",
@@ -634,8 +850,36 @@ experimental:
 				"KUMA_ACCESS_STATIC_VIEW_STATS_GROUPS":                                                     "zt-group1,zt-group2",
 				"KUMA_ACCESS_STATIC_VIEW_CLUSTERS_USERS":                                                   "zt-admin1,zt-admin2",
 				"KUMA_ACCESS_STATIC_VIEW_CLUSTERS_GROUPS":                                                  "zt-group1,zt-group2",
+				"KUMA_ACCESS_STATIC_DRAIN_DATAPLANE_USERS":                                                 "dd-admin1,dd-admin2",
+				"KUMA_ACCESS_STATIC_DRAIN_DATAPLANE_GROUPS":                                                "dd-group1,dd-group2",
+				"KUMA_ACCESS_STATIC_VIEW_UNREDACTED_CONFIG_DUMP_USERS":                                     "ucd-admin1,ucd-admin2",
+				"KUMA_ACCESS_STATIC_VIEW_UNREDACTED_CONFIG_DUMP_GROUPS":                                    "ucd-group1,ucd-group2",
+				"KUMA_ACCESS_STATIC_PROXY_ADMIN_USERS":                                                     "pa-admin1,pa-admin2",
+				"KUMA_ACCESS_STATIC_PROXY_ADMIN_GROUPS":                                                    "pa-group1,pa-group2",
+				"KUMA_ACCESS_RBAC_ADMIN_USERS":                                                             "rbac-admin1,rbac-admin2",
+				"KUMA_ACCESS_RBAC_ADMIN_GROUPS":                                                            "rbac-group1,rbac-group2",
+				"KUMA_ACCESS_STATIC_VIEW_AUDIT_LOG_USERS":                                                  "al-admin1,al-admin2",
+				"KUMA_ACCESS_STATIC_VIEW_AUDIT_LOG_GROUPS":                                                 "al-group1,al-group2",
+				"KUMA_AUDIT_ENABLED":                                                                       "true",
+				"KUMA_AUDIT_RETENTION_MAX_ENTRIES":                                                         "50",
+				"KUMA_AUDIT_RETENTION_MAX_AGE":                                                             "1h",
+				"KUMA_AUDIT_SINKS_FILE_PATH":                                                               "/tmp/audit.log",
+				"KUMA_AUDIT_SINKS_STDOUT_ENABLED":                                                          "true",
+				"KUMA_AUDIT_SINKS_WEBHOOK_URL":                                                             "https://audit.example.com/events",
+				"KUMA_AUDIT_SINKS_WEBHOOK_TIMEOUT":                                                         "3s",
+				"KUMA_KEY_PROVIDER_TYPE":                                                                   "pkcs11",
+				"KUMA_KEY_PROVIDER_AWS_KMS_REGION":                                                         "us-east-1",
+				"KUMA_KEY_PROVIDER_AWS_KMS_ENDPOINT":                                                       "https://kms.example.com",
+				"KUMA_KEY_PROVIDER_PKCS11_LIBRARY":                                                         "/usr/lib/softhsm/libsofthsm2.so",
+				"KUMA_KEY_PROVIDER_PKCS11_TOKEN_LABEL":                                                     "kuma",
+				"KUMA_KEY_PROVIDER_PKCS11_PIN":                                                             "1234",
+				"KUMA_KEY_PROVIDER_ENVOY_ADMIN_CLIENT_KEY_ID":                                              "admin-client",
+				"KUMA_KEY_PROVIDER_ENVOY_ADMIN_CLIENT_CERT":                                                "admin-client-cert",
+				"KUMA_KEY_PROVIDER_USER_TOKEN_KEY_ID":                                                      "user-token",
+				"KUMA_KEY_PROVIDER_USER_TOKEN_SERIAL_NUMBER":                                               "2",
 				"KUMA_EXPERIMENTAL_GATEWAY_API":                                                            "true",
 				"KUMA_EXPERIMENTAL_KUBE_OUTBOUNDS_AS_VIPS":                                                 "true",
+				"KUMA_EXPERIMENTAL_ENVOY_TAP":                                                              "true",
 			},
 			yamlFileConfig: "",
 		}),
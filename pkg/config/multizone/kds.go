@@ -23,6 +23,10 @@ type KdsServerConfig struct {
 	// MaxMsgSize defines a maximum size of the message that is exchanged using KDS.
 	// In practice this means a limit on full list of one resource type.
 	MaxMsgSize uint32 `yaml:"maxMsgSize" envconfig:"kuma_multizone_global_kds_max_msg_size"`
+	// ZoneScopedTypes defines types of policies that are synced only to zones with dataplanes selected by the policies,
+	// instead of all zones. It reduces the state held by Zone CPs of small zones.
+	// Policies are synced to the zone only after the dataplanes they select are synced from the zone to Global.
+	ZoneScopedTypes []string `yaml:"zoneScopedTypes" envconfig:"kuma_multizone_global_kds_zone_scoped_types"`
 }
 
 var _ config.Config = &KdsServerConfig{}
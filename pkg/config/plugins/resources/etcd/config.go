@@ -0,0 +1,102 @@
+package etcd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config"
+)
+
+var _ config.Config = &EtcdStoreConfig{}
+
+// Etcd store configuration
+type EtcdStoreConfig struct {
+	// Endpoints of the etcd cluster
+	Endpoints []string `yaml:"endpoints" envconfig:"kuma_store_etcd_endpoints"`
+	// Username used to authenticate to etcd. If empty, authentication is disabled.
+	Username string `yaml:"username" envconfig:"kuma_store_etcd_username"`
+	// Password used to authenticate to etcd
+	Password string `yaml:"password" envconfig:"kuma_store_etcd_password"`
+	// Prefix of all keys written by the Control Plane. It allows to share the etcd cluster with other applications.
+	Prefix string `yaml:"prefix" envconfig:"kuma_store_etcd_prefix"`
+	// DialTimeout is a timeout of establishing the connection to etcd
+	DialTimeout time.Duration `yaml:"dialTimeout" envconfig:"kuma_store_etcd_dial_timeout"`
+	// ListPageSize is a maximum number of keys read from etcd in a single request when resources are listed.
+	// Large lists are read in several requests from the same revision, so they are consistent.
+	ListPageSize int64 `yaml:"listPageSize" envconfig:"kuma_store_etcd_list_page_size"`
+	// LeaderElectionLeaseTTL is a TTL of the lease of the leader. When the leader dies,
+	// other instance of the Control Plane becomes the leader after this time.
+	LeaderElectionLeaseTTL time.Duration `yaml:"leaderElectionLeaseTTL" envconfig:"kuma_store_etcd_leader_election_lease_ttl"`
+	// TLS settings
+	TLS TLSEtcdStoreConfig `yaml:"tls"`
+}
+
+type TLSEtcdStoreConfig struct {
+	// If true then TLS is used to connect to etcd
+	Enabled bool `yaml:"enabled" envconfig:"kuma_store_etcd_tls_enabled"`
+	// Path to TLS Certificate of the client
+	CertPath string `yaml:"certPath" envconfig:"kuma_store_etcd_tls_cert_path"`
+	// Path to TLS Key of the client
+	KeyPath string `yaml:"keyPath" envconfig:"kuma_store_etcd_tls_key_path"`
+	// Path to the root certificate used to verify etcd. If empty, system roots are used.
+	CAPath string `yaml:"caPath" envconfig:"kuma_store_etcd_tls_ca_path"`
+}
+
+func (s TLSEtcdStoreConfig) Sanitize() {
+}
+
+func (s TLSEtcdStoreConfig) Validate() error {
+	if s.KeyPath == "" && s.CertPath != "" {
+		return errors.New("KeyPath cannot be empty when CertPath is provided")
+	}
+	if s.CertPath == "" && s.KeyPath != "" {
+		return errors.New("CertPath cannot be empty when KeyPath is provided")
+	}
+	if !s.Enabled && (s.CertPath != "" || s.CAPath != "") {
+		return errors.New("Enabled has to be true when CertPath or CAPath is provided")
+	}
+	return nil
+}
+
+func (e *EtcdStoreConfig) Sanitize() {
+	e.Password = config.SanitizedValue
+}
+
+func (e *EtcdStoreConfig) Validate() error {
+	if len(e.Endpoints) == 0 {
+		return errors.New("Endpoints should not be empty")
+	}
+	if e.Username == "" && e.Password != "" {
+		return errors.New("Username cannot be empty when Password is provided")
+	}
+	if !strings.HasPrefix(e.Prefix, "/") || strings.HasSuffix(e.Prefix, "/") {
+		return errors.New("Prefix has to start with / and cannot end with /")
+	}
+	if e.DialTimeout <= 0 {
+		return errors.New("DialTimeout has to be greater than 0")
+	}
+	if e.ListPageSize <= 0 {
+		return errors.New("ListPageSize has to be greater than 0")
+	}
+	if e.LeaderElectionLeaseTTL < time.Second {
+		return errors.New("LeaderElectionLeaseTTL cannot be lower than 1s")
+	}
+	if err := e.TLS.Validate(); err != nil {
+		return errors.Wrap(err, "TLS validation failed")
+	}
+	return nil
+}
+
+func DefaultEtcdStoreConfig() *EtcdStoreConfig {
+	return &EtcdStoreConfig{
+		Endpoints:              []string{"127.0.0.1:2379"},
+		Prefix:                 "/kuma",
+		DialTimeout:            5 * time.Second,
+		ListPageSize:           500,
+		LeaderElectionLeaseTTL: 5 * time.Second,
+	}
+}
+
+var _ config.Config = &TLSEtcdStoreConfig{}
@@ -0,0 +1,50 @@
+package etcd_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/config/plugins/resources/etcd"
+)
+
+var _ = Describe("EtcdStoreConfig", func() {
+	It("should validate default config", func() {
+		Expect(etcd.DefaultEtcdStoreConfig().Validate()).To(Succeed())
+	})
+
+	DescribeTable("should validate invalid config",
+		func(modify func(*etcd.EtcdStoreConfig), expected string) {
+			// given
+			cfg := etcd.DefaultEtcdStoreConfig()
+			modify(cfg)
+
+			// when
+			err := cfg.Validate()
+
+			// then
+			Expect(err).To(MatchError(expected))
+		},
+		Entry("no endpoints", func(cfg *etcd.EtcdStoreConfig) {
+			cfg.Endpoints = nil
+		}, "Endpoints should not be empty"),
+		Entry("password without username", func(cfg *etcd.EtcdStoreConfig) {
+			cfg.Password = "kuma"
+		}, "Username cannot be empty when Password is provided"),
+		Entry("prefix ending with slash", func(cfg *etcd.EtcdStoreConfig) {
+			cfg.Prefix = "/kuma/"
+		}, "Prefix has to start with / and cannot end with /"),
+		Entry("zero list page size", func(cfg *etcd.EtcdStoreConfig) {
+			cfg.ListPageSize = 0
+		}, "ListPageSize has to be greater than 0"),
+		Entry("short lease", func(cfg *etcd.EtcdStoreConfig) {
+			cfg.LeaderElectionLeaseTTL = 0
+		}, "LeaderElectionLeaseTTL cannot be lower than 1s"),
+		Entry("CertPath without KeyPath", func(cfg *etcd.EtcdStoreConfig) {
+			cfg.TLS.Enabled = true
+			cfg.TLS.CertPath = "/path"
+		}, "TLS validation failed: KeyPath cannot be empty when CertPath is provided"),
+		Entry("CAPath without TLS enabled", func(cfg *etcd.EtcdStoreConfig) {
+			cfg.TLS.CAPath = "/path"
+		}, "TLS validation failed: Enabled has to be true when CertPath or CAPath is provided"),
+	)
+})
@@ -0,0 +1,11 @@
+package etcd_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestEtcdConfig(t *testing.T) {
+	test.RunSpecs(t, "Etcd Config Suite")
+}
@@ -24,6 +24,12 @@ type XdsServerConfig struct {
 	DataplaneDeregistrationDelay time.Duration `yaml:"dataplaneDeregistrationDelay" envconfig:"kuma_xds_dataplane_deregistration_delay"`
 	// Backoff that is executed when Control Plane is sending the response that was previously rejected by Dataplane
 	NACKBackoff time.Duration `yaml:"nackBackoff" envconfig:"kuma_xds_server_nack_backoff"`
+	// DeltaXds enables incremental xDS. Dataplanes are bootstrapped to use Delta ADS,
+	// so only resources that changed are sent to Envoy instead of all resources of the type.
+	DeltaXds bool `yaml:"deltaXds" envconfig:"kuma_xds_server_delta_xds"`
+	// DataplaneConfigurationGenerationConcurrency is the maximum number of proxies whose configuration is generated in parallel.
+	// 0 means the number of CPUs available to the Control Plane.
+	DataplaneConfigurationGenerationConcurrency int `yaml:"dataplaneConfigurationGenerationConcurrency" envconfig:"kuma_xds_server_dataplane_configuration_generation_concurrency"`
 }
 
 func (x *XdsServerConfig) Sanitize() {
@@ -36,6 +42,9 @@ func (x *XdsServerConfig) Validate() error {
 	if x.DataplaneStatusFlushInterval <= 0 {
 		return errors.New("DataplaneStatusFlushInterval must be positive")
 	}
+	if x.DataplaneConfigurationGenerationConcurrency < 0 {
+		return errors.New("DataplaneConfigurationGenerationConcurrency must not be negative")
+	}
 	return nil
 }
 
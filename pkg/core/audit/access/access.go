@@ -0,0 +1,9 @@
+package access
+
+import (
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+type AuditLogAccess interface {
+	ValidateViewAuditLog(user user.User) error
+}
@@ -0,0 +1,41 @@
+package access
+
+import (
+	config_access "github.com/kumahq/kuma/pkg/config/access"
+	"github.com/kumahq/kuma/pkg/core/access"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+type staticAuditLogAccess struct {
+	usernames map[string]bool
+	groups    map[string]bool
+}
+
+var _ AuditLogAccess = &staticAuditLogAccess{}
+
+func NewStaticAuditLogAccess(cfg config_access.ViewAuditLogStaticAccessConfig) AuditLogAccess {
+	s := &staticAuditLogAccess{
+		usernames: map[string]bool{},
+		groups:    map[string]bool{},
+	}
+	for _, user := range cfg.Users {
+		s.usernames[user] = true
+	}
+	for _, group := range cfg.Groups {
+		s.groups[group] = true
+	}
+	return s
+}
+
+func (s *staticAuditLogAccess) ValidateViewAuditLog(user user.User) error {
+	allowed := s.usernames[user.Name]
+	for _, group := range user.Groups {
+		if s.groups[group] {
+			allowed = true
+		}
+	}
+	if !allowed {
+		return &access.AccessDeniedError{Reason: "action not allowed"}
+	}
+	return nil
+}
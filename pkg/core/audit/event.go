@@ -0,0 +1,38 @@
+package audit
+
+import (
+	"time"
+)
+
+type Operation string
+
+const (
+	CreateOperation Operation = "CREATE"
+	UpdateOperation Operation = "UPDATE"
+	DeleteOperation Operation = "DELETE"
+)
+
+// Subject is the user on behalf of which the operation was executed.
+type Subject struct {
+	Name   string   `json:"name"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ControlPlaneSubject is the subject of operations that are not executed on behalf of any user,
+// ex. resources synced by KDS or converted from Kubernetes objects.
+var ControlPlaneSubject = Subject{
+	Name: "mesh-system:control-plane",
+}
+
+// Event is a record of a single mutation of a resource.
+type Event struct {
+	Time      time.Time `json:"time"`
+	Subject   Subject   `json:"subject"`
+	Operation Operation `json:"operation"`
+	Type      string    `json:"type"`
+	Mesh      string    `json:"mesh,omitempty"`
+	Name      string    `json:"name"`
+	// Diff is a unified diff between YAML of the spec before and after the operation.
+	// It is empty for resources with sensitive data like secrets.
+	Diff string `json:"diff,omitempty"`
+}
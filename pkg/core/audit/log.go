@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+// Log keeps recent audit events, so they can be served by the API server.
+type Log interface {
+	Append(Event)
+	// Events returns events from the oldest to the newest.
+	Events() []Event
+}
+
+type memoryLog struct {
+	sync.Mutex
+	maxEntries int
+	maxAge     time.Duration
+	events     []Event
+}
+
+var _ Log = &memoryLog{}
+
+// NewMemoryLog returns a Log that keeps at most maxEntries events that are not older than maxAge.
+// Zero value of any of the limits means no limit.
+func NewMemoryLog(maxEntries int, maxAge time.Duration) Log {
+	return &memoryLog{
+		maxEntries: maxEntries,
+		maxAge:     maxAge,
+	}
+}
+
+func (m *memoryLog) Append(event Event) {
+	m.Lock()
+	defer m.Unlock()
+	m.events = append(m.events, event)
+	if m.maxEntries > 0 && len(m.events) > m.maxEntries {
+		m.events = append([]Event{}, m.events[len(m.events)-m.maxEntries:]...)
+	}
+	m.dropExpired()
+}
+
+func (m *memoryLog) Events() []Event {
+	m.Lock()
+	defer m.Unlock()
+	m.dropExpired()
+	return append([]Event{}, m.events...)
+}
+
+func (m *memoryLog) dropExpired() {
+	if m.maxAge <= 0 {
+		return
+	}
+	oldest := core.Now().Add(-m.maxAge)
+	expired := 0
+	for expired < len(m.events) && m.events[expired].Time.Before(oldest) {
+		expired++
+	}
+	m.events = m.events[expired:]
+}
@@ -0,0 +1,73 @@
+package audit_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+)
+
+var _ = Describe("Memory Log", func() {
+	now := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		core.Now = func() time.Time {
+			return now
+		}
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	event := func(name string, age time.Duration) audit.Event {
+		return audit.Event{
+			Time:      now.Add(-age),
+			Operation: audit.CreateOperation,
+			Type:      "Mesh",
+			Name:      name,
+		}
+	}
+
+	names := func(events []audit.Event) []string {
+		var result []string
+		for _, e := range events {
+			result = append(result, e.Name)
+		}
+		return result
+	}
+
+	It("should drop the oldest events above the max entries", func() {
+		// given
+		log := audit.NewMemoryLog(2, 0)
+
+		// when
+		log.Append(event("mesh-1", 0))
+		log.Append(event("mesh-2", 0))
+		log.Append(event("mesh-3", 0))
+
+		// then
+		Expect(names(log.Events())).To(Equal([]string{"mesh-2", "mesh-3"}))
+	})
+
+	It("should drop events older than the max age", func() {
+		// given
+		log := audit.NewMemoryLog(0, time.Hour)
+
+		// when
+		log.Append(event("mesh-1", 2*time.Hour))
+		log.Append(event("mesh-2", 30*time.Minute))
+
+		// then
+		Expect(names(log.Events())).To(Equal([]string{"mesh-2"}))
+
+		// when time passes
+		now = now.Add(time.Hour)
+
+		// then
+		Expect(log.Events()).To(BeEmpty())
+	})
+})
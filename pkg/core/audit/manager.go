@@ -0,0 +1,173 @@
+package audit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// redactedTypes are types of resources with sensitive data in the spec, so the diff is not recorded.
+var redactedTypes = map[model.ResourceType]bool{
+	system.SecretType:       true,
+	system.GlobalSecretType: true,
+}
+
+type auditResourceManager struct {
+	manager.CustomizableResourceManager
+	recorder *Recorder
+}
+
+var _ manager.CustomizableResourceManager = &auditResourceManager{}
+
+// NewAuditResourceManager returns a manager that records every successful mutation of resources with the Recorder.
+// Mutations of types that are managed only by the Control Plane (ReadOnly types) and dry runs are not recorded.
+// Managers of specific types returned by ResourceManager(type) are not audited.
+func NewAuditResourceManager(delegate manager.CustomizableResourceManager, recorder *Recorder) manager.CustomizableResourceManager {
+	return &auditResourceManager{
+		CustomizableResourceManager: delegate,
+		recorder:                    recorder,
+	}
+}
+
+func (m *auditResourceManager) Create(ctx context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+	if err := m.CustomizableResourceManager.Create(ctx, resource, fs...); err != nil {
+		return err
+	}
+	opts := store.NewCreateOptions(fs...)
+	if !opts.DryRun {
+		m.record(ctx, CreateOperation, resource.Descriptor(), model.ResourceKey{Mesh: opts.Mesh, Name: opts.Name}, nil, resource.GetSpec())
+	}
+	return nil
+}
+
+func (m *auditResourceManager) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+	key := model.MetaToResourceKey(resource.GetMeta())
+	old := m.current(ctx, resource.Descriptor(), key)
+	if err := m.CustomizableResourceManager.Update(ctx, resource, fs...); err != nil {
+		return err
+	}
+	if !store.NewUpdateOptions(fs...).DryRun {
+		m.record(ctx, UpdateOperation, resource.Descriptor(), key, old, resource.GetSpec())
+	}
+	return nil
+}
+
+func (m *auditResourceManager) Delete(ctx context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+	opts := store.NewDeleteOptions(fs...)
+	key := model.ResourceKey{Mesh: opts.Mesh, Name: opts.Name}
+	old := m.current(ctx, resource.Descriptor(), key)
+	if err := m.CustomizableResourceManager.Delete(ctx, resource, fs...); err != nil {
+		return err
+	}
+	m.record(ctx, DeleteOperation, resource.Descriptor(), key, old, nil)
+	return nil
+}
+
+// DeleteAll deletes resources one by one, so every deletion is recorded.
+func (m *auditResourceManager) DeleteAll(ctx context.Context, list model.ResourceList, fs ...store.DeleteAllOptionsFunc) error {
+	if list.NewItem().Descriptor().ReadOnly {
+		return m.CustomizableResourceManager.DeleteAll(ctx, list, fs...)
+	}
+	return manager.DeleteAllResources(m, ctx, list, fs...)
+}
+
+// current returns the spec of the resource before the mutation. The mutation fails anyway when the resource does not exist.
+func (m *auditResourceManager) current(ctx context.Context, descriptor model.ResourceTypeDescriptor, key model.ResourceKey) model.ResourceSpec {
+	if descriptor.ReadOnly {
+		return nil
+	}
+	res := descriptor.NewObject()
+	if err := m.CustomizableResourceManager.Get(ctx, res, store.GetBy(key)); err != nil {
+		return nil
+	}
+	return res.GetSpec()
+}
+
+func (m *auditResourceManager) record(
+	ctx context.Context,
+	operation Operation,
+	descriptor model.ResourceTypeDescriptor,
+	key model.ResourceKey,
+	oldSpec model.ResourceSpec,
+	newSpec model.ResourceSpec,
+) {
+	if descriptor.ReadOnly {
+		return
+	}
+	event := Event{
+		Time:      core.Now(),
+		Subject:   subjectOf(ctx),
+		Operation: operation,
+		Type:      string(descriptor.Name),
+		Mesh:      key.Mesh,
+		Name:      key.Name,
+	}
+	if !redactedTypes[descriptor.Name] {
+		diff, err := specDiff(oldSpec, newSpec)
+		if err != nil {
+			log.Error(err, "could not compute the diff of the spec", "type", event.Type, "mesh", event.Mesh, "name", event.Name)
+		}
+		event.Diff = diff
+	}
+	m.recorder.Record(event)
+}
+
+func subjectOf(ctx context.Context) Subject {
+	u, ok := user.Lookup(ctx)
+	if !ok {
+		return ControlPlaneSubject
+	}
+	return Subject{
+		Name:   u.Name,
+		Groups: u.Groups,
+	}
+}
+
+func specDiff(oldSpec model.ResourceSpec, newSpec model.ResourceSpec) (string, error) {
+	oldYAML, err := specYAML(oldSpec)
+	if err != nil {
+		return "", err
+	}
+	newYAML, err := specYAML(newSpec)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        lines(oldYAML),
+		B:        lines(newYAML),
+		FromFile: "old",
+		ToFile:   "new",
+		Context:  3,
+	})
+}
+
+func lines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	result := strings.SplitAfter(text, "\n")
+	if result[len(result)-1] == "" {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+func specYAML(spec model.ResourceSpec) (string, error) {
+	if spec == nil {
+		return "", nil
+	}
+	bytes, err := util_proto.ToYAML(spec)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
@@ -0,0 +1,174 @@
+package audit_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+type memorySink struct {
+	events []audit.Event
+}
+
+func (m *memorySink) Send(event audit.Event) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+var _ = Describe("Audit Resource Manager", func() {
+	var resManager manager.ResourceManager
+	var auditLog audit.Log
+	var sink *memorySink
+
+	now := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	john := user.User{Name: "john.doe", Groups: []string{"team-a"}}
+
+	trafficPermission := func(source string) *core_mesh.TrafficPermissionResource {
+		tp := core_mesh.NewTrafficPermissionResource()
+		tp.Spec = &mesh_proto.TrafficPermission{
+			Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchService(source)}},
+			Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("backend")}},
+		}
+		return tp
+	}
+
+	BeforeEach(func() {
+		core.Now = func() time.Time {
+			return now
+		}
+		auditLog = audit.NewMemoryLog(0, 0)
+		sink = &memorySink{}
+		resManager = audit.NewAuditResourceManager(
+			manager.NewCustomizableResourceManager(manager.NewResourceManager(memory.NewStore()), nil),
+			audit.NewRecorder(auditLog, sink),
+		)
+		Expect(resManager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", model.NoMesh))).To(Succeed())
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	It("should record create, update and delete with the user and the diff", func() {
+		// given
+		ctx := user.Ctx(context.Background(), john)
+		tp := trafficPermission("web")
+
+		// when
+		Expect(resManager.Create(ctx, tp, store.CreateByKey("tp-1", "default"))).To(Succeed())
+		tp.Spec.Sources[0].Match = mesh_proto.MatchService("admin")
+		Expect(resManager.Update(ctx, tp)).To(Succeed())
+		Expect(resManager.Delete(ctx, core_mesh.NewTrafficPermissionResource(), store.DeleteByKey("tp-1", "default"))).To(Succeed())
+
+		// then
+		events := auditLog.Events()
+		Expect(events).To(HaveLen(4))
+		Expect(events[0]).To(Equal(audit.Event{
+			Time:      now,
+			Subject:   audit.ControlPlaneSubject,
+			Operation: audit.CreateOperation,
+			Type:      "Mesh",
+			Name:      "default",
+			Diff:      "--- old\n+++ new\n@@ -0,0 +1 @@\n+{}\n",
+		}))
+		Expect(events[1]).To(Equal(audit.Event{
+			Time:      now,
+			Subject:   audit.Subject{Name: "john.doe", Groups: []string{"team-a"}},
+			Operation: audit.CreateOperation,
+			Type:      "TrafficPermission",
+			Mesh:      "default",
+			Name:      "tp-1",
+			Diff: `--- old
++++ new
+@@ -0,0 +1,6 @@
++destinations:
++- match:
++    kuma.io/service: backend
++sources:
++- match:
++    kuma.io/service: web
+`,
+		}))
+		Expect(events[2].Operation).To(Equal(audit.UpdateOperation))
+		Expect(events[2].Diff).To(Equal(`--- old
++++ new
+@@ -3,4 +3,4 @@
+     kuma.io/service: backend
+ sources:
+ - match:
+-    kuma.io/service: web
++    kuma.io/service: admin
+`))
+		Expect(events[3].Operation).To(Equal(audit.DeleteOperation))
+		Expect(events[3].Name).To(Equal("tp-1"))
+		Expect(events[3].Diff).To(HavePrefix("--- old\n+++ new\n@@ -1,6 +0,0 @@\n-destinations:\n"))
+		// and
+		Expect(sink.events).To(Equal(events))
+	})
+
+	It("should not record failed operations and dry runs", func() {
+		// when
+		err := resManager.Create(context.Background(), trafficPermission("web"), store.CreateByKey("tp-1", "non-existing"))
+		Expect(err).To(HaveOccurred())
+		err = resManager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("dry-run", model.NoMesh), store.CreateDryRun())
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Delete(context.Background(), core_mesh.NewMeshResource(), store.DeleteByKey("non-existing", model.NoMesh))
+		Expect(err).To(HaveOccurred())
+
+		// then
+		Expect(auditLog.Events()).To(HaveLen(1))
+	})
+
+	It("should not record resources managed by the control plane", func() {
+		// when
+		insight := core_mesh.NewDataplaneInsightResource()
+		Expect(resManager.Create(context.Background(), insight, store.CreateByKey("dp-1", "default"))).To(Succeed())
+
+		// then
+		Expect(auditLog.Events()).To(HaveLen(1))
+	})
+
+	It("should not record the diff of secrets", func() {
+		// when
+		secret := system.NewSecretResource()
+		secret.Spec = &system_proto.Secret{Data: &wrapperspb.BytesValue{Value: []byte("secret")}}
+		Expect(resManager.Create(context.Background(), secret, store.CreateByKey("secret-1", "default"))).To(Succeed())
+
+		// then
+		events := auditLog.Events()
+		Expect(events).To(HaveLen(2))
+		Expect(events[1].Type).To(Equal("Secret"))
+		Expect(events[1].Diff).To(BeEmpty())
+	})
+
+	It("should record every resource deleted with DeleteAll", func() {
+		// given
+		for _, name := range []string{"tp-1", "tp-2"} {
+			Expect(resManager.Create(context.Background(), trafficPermission("web"), store.CreateByKey(name, "default"))).To(Succeed())
+		}
+
+		// when
+		Expect(resManager.DeleteAll(context.Background(), &core_mesh.TrafficPermissionResourceList{}, store.DeleteAllByMesh("default"))).To(Succeed())
+
+		// then
+		events := auditLog.Events()
+		Expect(events).To(HaveLen(5))
+		Expect(events[3].Operation).To(Equal(audit.DeleteOperation))
+		Expect(events[4].Operation).To(Equal(audit.DeleteOperation))
+	})
+})
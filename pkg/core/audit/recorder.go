@@ -0,0 +1,56 @@
+package audit
+
+import (
+	"os"
+
+	config_audit "github.com/kumahq/kuma/pkg/config/audit"
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+var log = core.Log.WithName("audit")
+
+// Recorder keeps audit events in the Log and ships them to sinks.
+type Recorder struct {
+	log   Log
+	sinks []Sink
+}
+
+func NewRecorder(log Log, sinks ...Sink) *Recorder {
+	return &Recorder{
+		log:   log,
+		sinks: sinks,
+	}
+}
+
+// NewRecorderFromConfig returns a Recorder with the retention and sinks defined in the config.
+func NewRecorderFromConfig(cfg config_audit.AuditConfig) (*Recorder, error) {
+	var sinks []Sink
+	if cfg.Sinks.File.Path != "" {
+		sink, err := NewFileSink(cfg.Sinks.File.Path)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.Sinks.Stdout.Enabled {
+		sinks = append(sinks, NewWriterSink(os.Stdout))
+	}
+	if cfg.Sinks.Webhook.URL != "" {
+		sinks = append(sinks, NewWebhookSink(cfg.Sinks.Webhook.URL, cfg.Sinks.Webhook.Timeout))
+	}
+	return NewRecorder(NewMemoryLog(cfg.Retention.MaxEntries, cfg.Retention.MaxAge), sinks...), nil
+}
+
+// Record stores the event and ships it to all sinks. A failure of a sink does not prevent shipping to other sinks.
+func (r *Recorder) Record(event Event) {
+	r.log.Append(event)
+	for _, sink := range r.sinks {
+		if err := sink.Send(event); err != nil {
+			log.Error(err, "could not ship audit event", "type", event.Type, "mesh", event.Mesh, "name", event.Name)
+		}
+	}
+}
+
+func (r *Recorder) Log() Log {
+	return r.log
+}
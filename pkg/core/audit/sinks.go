@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Sink ships audit events outside the Control Plane.
+type Sink interface {
+	Send(Event) error
+}
+
+type writerSink struct {
+	sync.Mutex
+	writer io.Writer
+}
+
+// NewWriterSink returns a Sink that writes every event as a single line of JSON.
+func NewWriterSink(writer io.Writer) Sink {
+	return &writerSink{
+		writer: writer,
+	}
+}
+
+// NewFileSink returns a Sink that appends events as JSON lines to the file.
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open audit log file %s", path)
+	}
+	return NewWriterSink(file), nil
+}
+
+func (w *writerSink) Send(event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	w.Lock()
+	defer w.Unlock()
+	_, err = w.writer.Write(append(line, '\n'))
+	return err
+}
+
+// webhookQueueSize is a number of events waiting to be sent to the webhook.
+// When the webhook is slower than the rate of the events, new events are dropped.
+const webhookQueueSize = 1000
+
+type webhookSink struct {
+	url    string
+	client *http.Client
+	queue  chan Event
+}
+
+// NewWebhookSink returns a Sink that sends every event as JSON in a POST request to the URL.
+// Events are sent in the background in the order of the operations, so the operations are not slowed down by the webhook.
+func NewWebhookSink(url string, timeout time.Duration) Sink {
+	w := &webhookSink{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		queue: make(chan Event, webhookQueueSize),
+	}
+	go w.run()
+	return w
+}
+
+func (w *webhookSink) Send(event Event) error {
+	select {
+	case w.queue <- event:
+		return nil
+	default:
+		return errors.Errorf("webhook queue is full, event dropped")
+	}
+}
+
+func (w *webhookSink) run() {
+	for event := range w.queue {
+		if err := w.post(event); err != nil {
+			log.Error(err, "could not send audit event to the webhook", "url", w.url)
+		}
+	}
+}
+
+func (w *webhookSink) post(event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
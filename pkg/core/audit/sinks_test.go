@@ -0,0 +1,79 @@
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/audit"
+)
+
+var _ = Describe("Sinks", func() {
+	event := audit.Event{
+		Time:      time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC),
+		Subject:   audit.Subject{Name: "john.doe", Groups: []string{"team-a"}},
+		Operation: audit.UpdateOperation,
+		Type:      "TrafficRoute",
+		Mesh:      "default",
+		Name:      "route-all",
+		Diff:      "--- old\n+++ new\n",
+	}
+	eventJSON := `{"time":"2022-06-01T10:00:00Z","subject":{"name":"john.doe","groups":["team-a"]},"operation":"UPDATE","type":"TrafficRoute","mesh":"default","name":"route-all","diff":"--- old\n+++ new\n"}`
+
+	It("should write events as JSON lines", func() {
+		// given
+		buf := &bytes.Buffer{}
+		sink := audit.NewWriterSink(buf)
+
+		// when
+		Expect(sink.Send(event)).To(Succeed())
+		Expect(sink.Send(event)).To(Succeed())
+
+		// then
+		Expect(buf.String()).To(Equal(eventJSON + "\n" + eventJSON + "\n"))
+	})
+
+	It("should append events to the file", func() {
+		// given
+		path := filepath.Join(GinkgoT().TempDir(), "audit.log")
+		Expect(os.WriteFile(path, []byte("existing\n"), 0o600)).To(Succeed())
+		sink, err := audit.NewFileSink(path)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		Expect(sink.Send(event)).To(Succeed())
+
+		// then
+		content, err := os.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("existing\n" + eventJSON + "\n"))
+	})
+
+	It("should send events to the webhook", func() {
+		// given
+		received := make(chan audit.Event, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			defer GinkgoRecover()
+			Expect(request.Method).To(Equal(http.MethodPost))
+			Expect(request.Header.Get("Content-Type")).To(Equal("application/json"))
+			e := audit.Event{}
+			Expect(json.NewDecoder(request.Body).Decode(&e)).To(Succeed())
+			received <- e
+		}))
+		defer server.Close()
+		sink := audit.NewWebhookSink(server.URL, time.Second)
+
+		// when
+		Expect(sink.Send(event)).To(Succeed())
+
+		// then
+		Eventually(received).Should(Receive(Equal(event)))
+	})
+})
@@ -2,15 +2,20 @@ package bootstrap
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/api-server/customization"
+	config_access "github.com/kumahq/kuma/pkg/config/access"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	audit_access "github.com/kumahq/kuma/pkg/core/audit/access"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	"github.com/kumahq/kuma/pkg/core/datasource"
 	"github.com/kumahq/kuma/pkg/core/dns/lookup"
@@ -39,12 +44,16 @@ import (
 	"github.com/kumahq/kuma/pkg/dp-server/server"
 	"github.com/kumahq/kuma/pkg/envoy/admin"
 	"github.com/kumahq/kuma/pkg/envoy/admin/access"
+	"github.com/kumahq/kuma/pkg/envoy/admin/tunnel"
 	"github.com/kumahq/kuma/pkg/events"
 	kds_context "github.com/kumahq/kuma/pkg/kds/context"
+	"github.com/kumahq/kuma/pkg/keys"
 	"github.com/kumahq/kuma/pkg/metrics"
 	metrics_store "github.com/kumahq/kuma/pkg/metrics/store"
 	tokens_access "github.com/kumahq/kuma/pkg/tokens/builtin/access"
 	zone_access "github.com/kumahq/kuma/pkg/tokens/builtin/zone/access"
+	"github.com/kumahq/kuma/pkg/xds/cache/cla"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
 	"github.com/kumahq/kuma/pkg/xds/secrets"
 )
@@ -94,6 +103,12 @@ func buildRuntime(appCtx context.Context, cfg kuma_cp.Config) (core_runtime.Runt
 
 	builder.WithDataSourceLoader(datasource.NewDataSourceLoader(builder.ReadOnlyResourceManager()))
 
+	keyProvider, err := keys.NewProvider(*cfg.KeyProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create key provider")
+	}
+	builder.WithKeyProvider(keyProvider)
+
 	if err := initializeCaManagers(builder); err != nil {
 		return nil, err
 	}
@@ -104,25 +119,34 @@ func buildRuntime(appCtx context.Context, cfg kuma_cp.Config) (core_runtime.Runt
 	builder.WithLookupIP(lookup.CachedLookupIP(net.LookupIP, cfg.General.DNSCacheTTL))
 	builder.WithAPIManager(customization.NewAPIList())
 	builder.WithXDSHooks(&xds_hooks.Hooks{})
-	caProvider, err := secrets.NewCaProvider(builder.CaManagers(), builder.Metrics())
+	caProvider, err := secrets.NewCaProvider(builder.CaManagers(), builder.DataSourceLoader(), builder.Metrics())
 	if err != nil {
 		return nil, err
 	}
 	builder.WithCAProvider(caProvider)
+	if err := initializeXDSControlPlaneContext(builder); err != nil {
+		return nil, err
+	}
 	builder.WithDpServer(server.NewDpServer(*cfg.DpServer, builder.Metrics()))
-	builder.WithKDSContext(kds_context.DefaultContext(builder.ResourceManager(), cfg.Multizone.Zone.Name))
+	builder.WithKDSContext(kds_context.DefaultContext(builder.ReadOnlyResourceManager(), cfg.Multizone.Zone.Name))
+	builder.WithEnvoyAdminTunnels(tunnel.NewTunnels())
 
 	if cfg.Mode == config_core.Global {
 		builder.WithEnvoyAdminClient(admin.NewKDSEnvoyAdminClient(
 			builder.KDSContext().EnvoyAdminRPCs,
 			cfg.Store.Type == store.KubernetesStore))
 	} else {
+		clientCert, err := envoyAdminClientCert(appCtx, builder)
+		if err != nil {
+			return nil, err
+		}
 		envoyAdminClient, err := admin.NewEnvoyAdminClient(
-			builder.ResourceManager(),
+			builder.ReadOnlyResourceManager(),
 			builder.CaManagers(),
-			builder.Config().DpServer.TlsCertFile,
-			builder.Config().DpServer.TlsKeyFile,
+			clientCert,
 			builder.Config().GetEnvoyAdminPort(),
+			*builder.Config().EnvoyAdminClient,
+			builder.EnvoyAdminTunnels(),
 		)
 		if err != nil {
 			return nil, err
@@ -130,15 +154,26 @@ func buildRuntime(appCtx context.Context, cfg kuma_cp.Config) (core_runtime.Runt
 		builder.WithEnvoyAdminClient(envoyAdminClient)
 	}
 
+	resourceAccess := resources_access.NewAdminResourceAccess(builder.Config().Access.Static.AdminResources)
+	dataplaneTokenAccess := tokens_access.NewStaticGenerateDataplaneTokenAccess(builder.Config().Access.Static.GenerateDPToken)
+	if builder.Config().Access.Type == config_access.RBACType {
+		rbac := resources_access.NewRBAC(builder.Config().Access.RBAC, builder.ReadOnlyResourceManager())
+		resourceAccess = resources_access.NewRBACResourceAccess(rbac)
+		dataplaneTokenAccess = tokens_access.NewRBACGenerateDataplaneTokenAccess(rbac)
+	}
 	builder.WithAccess(core_runtime.Access{
-		ResourceAccess:       resources_access.NewAdminResourceAccess(builder.Config().Access.Static.AdminResources),
-		DataplaneTokenAccess: tokens_access.NewStaticGenerateDataplaneTokenAccess(builder.Config().Access.Static.GenerateDPToken),
+		ResourceAccess:       resourceAccess,
+		DataplaneTokenAccess: dataplaneTokenAccess,
 		ZoneTokenAccess:      zone_access.NewStaticZoneTokenAccess(builder.Config().Access.Static.GenerateZoneToken),
 		EnvoyAdminAccess: access.NewStaticEnvoyAdminAccess(
 			builder.Config().Access.Static.ViewConfigDump,
 			builder.Config().Access.Static.ViewStats,
 			builder.Config().Access.Static.ViewClusters,
+			builder.Config().Access.Static.DrainDataplane,
+			builder.Config().Access.Static.ViewUnredactedConfigDump,
+			builder.Config().Access.Static.ProxyAdmin,
 		),
+		AuditLogAccess: audit_access.NewStaticAuditLogAccess(builder.Config().Access.Static.ViewAuditLog),
 	})
 
 	if err := initializeAPIServerAuthenticator(builder); err != nil {
@@ -251,6 +286,9 @@ func initializeResourceStore(cfg kuma_cp.Config, builder *core_runtime.Builder)
 	case store.PostgresStore:
 		pluginName = core_plugins.Postgres
 		pluginConfig = cfg.Store.Postgres
+	case store.EtcdStore:
+		pluginName = core_plugins.Etcd
+		pluginConfig = cfg.Store.Etcd
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
 	}
@@ -264,6 +302,9 @@ func initializeResourceStore(cfg kuma_cp.Config, builder *core_runtime.Builder)
 		return err
 	}
 	builder.WithResourceStore(rs)
+	if txs, ok := rs.(core_store.Transactions); ok {
+		builder.WithTransactions(txs)
+	}
 	eventBus := events.NewEventBus()
 	if err := plugin.EventListener(builder, eventBus); err != nil {
 		return err
@@ -286,7 +327,7 @@ func initializeSecretStore(cfg kuma_cp.Config, builder *core_runtime.Builder) er
 	switch cfg.Store.Type {
 	case store.KubernetesStore:
 		pluginName = core_plugins.Kubernetes
-	case store.MemoryStore, store.PostgresStore:
+	case store.MemoryStore, store.PostgresStore, store.EtcdStore:
 		pluginName = core_plugins.Universal
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
@@ -309,7 +350,7 @@ func initializeConfigStore(cfg kuma_cp.Config, builder *core_runtime.Builder) er
 	switch cfg.Store.Type {
 	case store.KubernetesStore:
 		pluginName = core_plugins.Kubernetes
-	case store.MemoryStore, store.PostgresStore:
+	case store.MemoryStore, store.PostgresStore, store.EtcdStore:
 		pluginName = core_plugins.Universal
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
@@ -337,6 +378,43 @@ func initializeCaManagers(builder *core_runtime.Builder) error {
 	return nil
 }
 
+// envoyAdminClientCert returns the client certificate of the Envoy Admin Client.
+// The key is taken from the key provider when it's configured, otherwise the certificate of the Dataplane Server is used.
+func envoyAdminClientCert(ctx context.Context, builder *core_runtime.Builder) (tls.Certificate, error) {
+	keyCfg := builder.Config().KeyProvider.EnvoyAdminClient
+	if keyCfg.KeyId == "" {
+		return tls.LoadX509KeyPair(builder.Config().DpServer.TlsCertFile, builder.Config().DpServer.TlsKeyFile)
+	}
+	cert, err := keys.ClientCertificate(ctx, builder.KeyProvider(), keyCfg.KeyId, []byte(keyCfg.Cert))
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "could not load the client certificate of the Envoy Admin Client from the key provider")
+	}
+	return cert, nil
+}
+
+// initializeXDSControlPlaneContext builds dependencies shared by all components that generate XDS config.
+// We want to have the same metrics (we cannot register one metric twice) and the same caches for all of them.
+func initializeXDSControlPlaneContext(builder *core_runtime.Builder) error {
+	claCache, err := cla.NewCache(builder.Config().Store.Cache.ExpirationTime, builder.Metrics())
+	if err != nil {
+		return err
+	}
+	idProvider, err := secrets.NewIdentityProvider(builder.CaManagers(), builder.Metrics())
+	if err != nil {
+		return err
+	}
+	xdsSecrets, err := secrets.NewSecrets(builder.CAProvider(), idProvider, builder.Metrics())
+	if err != nil {
+		return err
+	}
+	cpCtx, err := xds_context.BuildControlPlaneContext(claCache, xdsSecrets, builder.Config().Multizone.Zone.Name, builder.Config().Experimental.EnvoyTap)
+	if err != nil {
+		return err
+	}
+	builder.WithXDSControlPlaneContext(cpCtx)
+	return nil
+}
+
 func initializeAPIServerAuthenticator(builder *core_runtime.Builder) error {
 	authnType := builder.Config().ApiServer.Authn.Type
 	plugin, ok := core_plugins.Plugins().AuthnAPIServer()[core_plugins.PluginName(authnType)]
@@ -417,8 +495,12 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 	switch cfg.Store.Type {
 	case store.KubernetesStore:
 		cipher = secret_cipher.None() // deliberately turn encryption off on Kubernetes
-	case store.MemoryStore, store.PostgresStore:
-		cipher = secret_cipher.TODO() // get back to encryption in universal case
+	case store.MemoryStore, store.PostgresStore, store.EtcdStore:
+		c, err := secret_cipher.NewFromConfig(cfg.Store.SecretsEncryption)
+		if err != nil {
+			return errors.Wrap(err, "could not create secrets encryption")
+		}
+		cipher = c
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
 	}
@@ -440,13 +522,31 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 		secret_manager.NewGlobalSecretManager(builder.SecretStore(), cipher),
 	)
 
-	builder.WithResourceManager(customizableManager)
+	if cfg.Audit.Enabled {
+		recorder, err := audit.NewRecorderFromConfig(*cfg.Audit)
+		if err != nil {
+			return errors.Wrap(err, "could not create audit recorder")
+		}
+		builder.WithResourceManager(audit.NewAuditResourceManager(customizableManager, recorder))
+		builder.WithAuditLog(recorder.Log())
+	} else {
+		builder.WithResourceManager(customizableManager)
+		builder.WithAuditLog(audit.NewMemoryLog(0, 0))
+	}
 
 	if builder.Config().Store.Cache.Enabled {
-		cachedManager, err := core_manager.NewCachedManager(customizableManager, builder.Config().Store.Cache.ExpirationTime, builder.Metrics())
+		cacheCfg := builder.Config().Store.Cache
+		typeExpirationTimes := map[core_model.ResourceType]time.Duration{}
+		for resourceType, expirationTime := range cacheCfg.TypeExpirationTimes {
+			typeExpirationTimes[core_model.ResourceType(resourceType)] = expirationTime
+		}
+		cachedManager, err := core_manager.NewCachedManager(customizableManager, cacheCfg.ExpirationTime, typeExpirationTimes, builder.Metrics())
 		if err != nil {
 			return err
 		}
+		if err := builder.ComponentManager().Add(core_manager.NewCacheInvalidator(cachedManager, builder.EventReaderFactory())); err != nil {
+			return err
+		}
 		builder.WithReadOnlyResourceManager(cachedManager)
 	} else {
 		builder.WithReadOnlyResourceManager(customizableManager)
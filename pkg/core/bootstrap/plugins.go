@@ -3,14 +3,19 @@ package bootstrap
 import (
 	// force plugins to get initialized and registered
 	_ "github.com/kumahq/kuma/pkg/plugins/authn/api-server/certs"
+	_ "github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc"
 	_ "github.com/kumahq/kuma/pkg/plugins/authn/api-server/tokens"
 	_ "github.com/kumahq/kuma/pkg/plugins/bootstrap/k8s"
 	_ "github.com/kumahq/kuma/pkg/plugins/bootstrap/universal"
+	_ "github.com/kumahq/kuma/pkg/plugins/ca/acmpca"
 	_ "github.com/kumahq/kuma/pkg/plugins/ca/builtin"
+	_ "github.com/kumahq/kuma/pkg/plugins/ca/gcpcas"
 	_ "github.com/kumahq/kuma/pkg/plugins/ca/provided"
+	_ "github.com/kumahq/kuma/pkg/plugins/ca/vault"
 	_ "github.com/kumahq/kuma/pkg/plugins/config/k8s"
 	_ "github.com/kumahq/kuma/pkg/plugins/config/universal"
 	_ "github.com/kumahq/kuma/pkg/plugins/policies"
+	_ "github.com/kumahq/kuma/pkg/plugins/resources/etcd"
 	_ "github.com/kumahq/kuma/pkg/plugins/resources/k8s"
 	_ "github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	_ "github.com/kumahq/kuma/pkg/plugins/resources/postgres"
@@ -5,6 +5,8 @@ import (
 	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
 	"math/big"
 	"net/url"
@@ -54,7 +56,30 @@ func NewWorkloadCert(ca util_tls.KeyPair, mesh string, tags mesh_proto.MultiValu
 	return util_tls.ToKeyPair(workloadKey, workloadCert)
 }
 
-func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, publicKey crypto.PublicKey, certOpts ...CertOptsFn) (*x509.Certificate, error) {
+// NewWorkloadCSR generates a private key and a certificate request for the Workload Identity cert signed by an external CA.
+// Both are PEM encoded.
+func NewWorkloadCSR(mesh string, tags mesh_proto.MultiValueTagSet) (csrPEM []byte, keyPEM []byte, err error) {
+	key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate a private key")
+	}
+	uris, err := WorkloadURIs(mesh, tags)
+	if err != nil {
+		return nil, nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{URIs: uris}, key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate a certificate request")
+	}
+	keyPEM, err = util_rsa.FromPrivateKeyToPEMBytes(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), keyPEM, nil
+}
+
+// WorkloadURIs returns URI SANs of the Workload Identity cert: SPIFFE IDs of the services and Kuma URIs of the tags.
+func WorkloadURIs(trustDomain string, tags mesh_proto.MultiValueTagSet) ([]*url.URL, error) {
 	var uris []*url.URL
 	for _, service := range tags.Values(mesh_proto.ServiceTag) {
 		uri, err := spiffe.ParseID(fmt.Sprintf("spiffe://%s/%s", trustDomain, service), spiffe.AllowTrustDomainWorkload(trustDomain))
@@ -73,6 +98,14 @@ func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, p
 			uris = append(uris, u)
 		}
 	}
+	return uris, nil
+}
+
+func newWorkloadTemplate(trustDomain string, tags mesh_proto.MultiValueTagSet, publicKey crypto.PublicKey, certOpts ...CertOptsFn) (*x509.Certificate, error) {
+	uris, err := WorkloadURIs(trustDomain, tags)
+	if err != nil {
+		return nil, err
+	}
 
 	now := time.Now()
 	serialNumber, err := newSerialNumber()
@@ -122,6 +155,39 @@ func newSerialNumber() (*big.Int, error) {
 	return res.Add(res, one), nil
 }
 
+// NewRevocationList generates a PEM encoded CRL signed by the CA that revokes certificates with the given serial numbers.
+func NewRevocationList(ca util_tls.KeyPair, serialNumbers []*big.Int, thisUpdate time.Time, nextUpdate time.Time) ([]byte, error) {
+	caPrivateKey, caCert, err := loadKeyPair(ca)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load CA key pair")
+	}
+	signer, ok := caPrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA private key is not a signer")
+	}
+	crlNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	var revoked []pkix.RevokedCertificate
+	for _, serialNumber := range serialNumbers {
+		revoked = append(revoked, pkix.RevokedCertificate{
+			SerialNumber:   serialNumber,
+			RevocationTime: thisUpdate,
+		})
+	}
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		RevokedCertificates: revoked,
+		Number:              crlNumber,
+		ThisUpdate:          thisUpdate,
+		NextUpdate:          nextUpdate,
+	}, caCert, signer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate certificate revocation list")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl}), nil
+}
+
 func loadKeyPair(pair util_tls.KeyPair) (crypto.PrivateKey, *x509.Certificate, error) {
 	root, err := tls.X509KeyPair(pair.CertPEM, pair.KeyPEM)
 	if err != nil {
@@ -2,6 +2,8 @@ package ca
 
 import (
 	"context"
+	"math/big"
+	"time"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/tls"
@@ -26,5 +28,18 @@ type Manager interface {
 	GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (KeyPair, error)
 }
 
+// RevocationList is a list of revoked certificates issued by the CA
+type RevocationList struct {
+	SerialNumbers []*big.Int
+	ThisUpdate    time.Time
+	NextUpdate    time.Time
+}
+
+// RevocationListSigner is implemented by managers that are able to sign a certificate revocation list with the key of the CA
+type RevocationListSigner interface {
+	// SignRevocationList returns PEM encoded CRL of the CA
+	SignRevocationList(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, list RevocationList) ([]byte, error)
+}
+
 // Managers hold Manager instance for each type of backend available (by default: builtin, provided)
 type Managers = map[string]Manager
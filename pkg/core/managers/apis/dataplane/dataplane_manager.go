@@ -55,6 +55,9 @@ func (m *dataplaneManager) Create(ctx context.Context, resource core_model.Resou
 	if err := m.validator.ValidateCreate(ctx, key, dp, owner); err != nil {
 		return err
 	}
+	if opts.DryRun {
+		return nil
+	}
 
 	return m.store.Create(ctx, resource, append(fs, core_store.CreatedAt(core.Now()))...)
 }
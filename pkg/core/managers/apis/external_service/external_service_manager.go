@@ -55,6 +55,9 @@ func (m *externalServiceManager) Create(ctx context.Context, resource core_model
 	if err := m.externalServiceValidator.ValidateCreate(ctx, opts.Mesh, externalService); err != nil {
 		return err
 	}
+	if opts.DryRun {
+		return nil
+	}
 
 	if err := m.store.Create(ctx, externalService, append(fs, core_store.CreatedAt(time.Now()))...); err != nil {
 		return err
@@ -101,6 +104,9 @@ func (m *externalServiceManager) Update(ctx context.Context, resource core_model
 	if err := m.externalServiceValidator.ValidateUpdate(ctx, currentExternalService, externalService); err != nil {
 		return err
 	}
+	if core_store.NewUpdateOptions(fs...).DryRun {
+		return nil
+	}
 
 	return m.store.Update(ctx, externalService, append(fs, core_store.ModifiedAt(time.Now()))...)
 }
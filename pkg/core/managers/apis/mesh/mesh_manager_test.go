@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	"github.com/kumahq/kuma/pkg/core/datasource"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/mesh"
@@ -44,6 +45,8 @@ var _ = Describe("Mesh Manager", func() {
 		caManagers := core_ca.Managers{
 			"builtin":  builtinCaManager,
 			"provided": providedCaManager,
+			// hides the revocation support of the builtin CA
+			"external": struct{ core_ca.Manager }{builtinCaManager},
 		}
 
 		manager := manager.NewResourceManager(resStore)
@@ -84,6 +87,45 @@ var _ = Describe("Mesh Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should not persist anything in dry run", func() {
+			// given
+			meshName := "mesh-1"
+			resKey := model.ResourceKey{
+				Name: meshName,
+			}
+
+			// when
+			mesh := core_mesh.MeshResource{
+				Spec: &mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: "builtin-1",
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{
+								Name: "builtin-1",
+								Type: "builtin",
+							},
+						},
+					},
+				},
+			}
+			err := resManager.Create(context.Background(), &mesh, store.CreateBy(resKey), store.CreateDryRun())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and mesh is not created
+			err = resStore.Get(context.Background(), core_mesh.NewMeshResource(), store.GetBy(resKey))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+
+			// and CA is not created
+			_, err = builtinCaManager.GetRootCert(context.Background(), meshName, mesh.Spec.Mtls.Backends[0])
+			Expect(err).To(HaveOccurred())
+
+			// and default resources are not created
+			err = resStore.Get(context.Background(), core_mesh.NewTrafficPermissionResource(), store.GetByKey("allow-all-mesh-1", meshName))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
 		It("should create default resources", func() {
 			// given
 			meshName := "mesh-1"
@@ -204,6 +246,78 @@ var _ = Describe("Mesh Manager", func() {
 			// then
 			Expect(err).To(MatchError("mtls.backends[0].conf.cert: has to be defined; mtls.backends[0].conf.key: has to be defined"))
 		})
+
+		It("should not allow to revoke certificates of CA that does not support revocation", func() {
+			// given
+			resKey := model.ResourceKey{
+				Name: "mesh-1",
+			}
+
+			// when
+			mesh := core_mesh.MeshResource{
+				Spec: &mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: "ca-1",
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{
+								Name: "ca-1",
+								Type: "external",
+							},
+						},
+						Revocations: []*mesh_proto.Mesh_Mtls_Revocation{
+							{
+								SerialNumber: "2a",
+							},
+						},
+					},
+				},
+			}
+			err := resManager.Create(context.Background(), &mesh, store.CreateBy(resKey))
+
+			// then
+			Expect(err).To(MatchError(`mtls.revocations: backend "ca-1" of type "external" does not support revocation of certificates`))
+		})
+
+		It("should not allow to revoke certificates when trust domains are federated", func() {
+			// given
+			resKey := model.ResourceKey{
+				Name: "mesh-1",
+			}
+
+			// when
+			mesh := core_mesh.MeshResource{
+				Spec: &mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: "ca-1",
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{
+								Name: "ca-1",
+								Type: "builtin",
+							},
+						},
+						Revocations: []*mesh_proto.Mesh_Mtls_Revocation{
+							{
+								SerialNumber: "2a",
+							},
+						},
+						FederatedTrustDomains: []*mesh_proto.Mesh_Mtls_FederatedTrustDomain{
+							{
+								Name: "example.org",
+								Bundle: &system_proto.DataSource{
+									Type: &system_proto.DataSource_InlineString{
+										InlineString: "bundle",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			err := resManager.Create(context.Background(), &mesh, store.CreateBy(resKey))
+
+			// then
+			Expect(err).To(MatchError(`mtls.revocations: revocation of certificates cannot be combined with federated trust domains`))
+		})
 	})
 
 	Describe("Delete()", func() {
@@ -371,6 +485,57 @@ var _ = Describe("Mesh Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should allow to switch CA when the rotation is in progress", func() {
+			// given
+			resKey := model.ResourceKey{
+				Name: "mesh-1",
+			}
+			mesh := core_mesh.MeshResource{
+				Spec: &mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: "builtin-1",
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{
+								Name: "builtin-1",
+								Type: "builtin",
+							},
+						},
+					},
+				},
+			}
+			err := resManager.Create(context.Background(), &mesh, store.CreateBy(resKey))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when the CA is switched together with starting the rotation
+			mesh.Spec.Mtls.Backends = append(mesh.Spec.Mtls.Backends, &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-2",
+				Type: "builtin",
+			})
+			mesh.Spec.Mtls.Rotation = &mesh_proto.Mesh_Mtls_Rotation{
+				From: "builtin-1",
+				To:   "builtin-2",
+			}
+			mesh.Spec.Mtls.EnabledBackend = "builtin-2"
+			err = resManager.Update(context.Background(), &mesh)
+
+			// then dataplanes did not have a chance to trust the new CA
+			Expect(err).To(HaveOccurred())
+
+			// when the rotation is started
+			mesh.Spec.Mtls.EnabledBackend = "builtin-1"
+			err = resManager.Update(context.Background(), &mesh)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when the CA is switched
+			mesh.Spec.Mtls.EnabledBackend = "builtin-2"
+			err = resManager.Update(context.Background(), &mesh)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		Describe("should set default values for Prometheus settings", func() {
 
 			type testCase struct {
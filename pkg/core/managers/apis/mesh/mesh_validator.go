@@ -2,9 +2,12 @@ package mesh
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
@@ -54,6 +57,17 @@ func ValidateMTLSBackends(ctx context.Context, caManagers core_ca.Managers, name
 			}
 		}
 	}
+	if len(resource.RevokedSerialNumbers(core.Now())) > 0 {
+		for _, backend := range resource.Spec.GetMtls().GetBackends() {
+			if _, ok := caManagers[backend.Type].(core_ca.RevocationListSigner); !ok {
+				verr.AddViolation("mtls.revocations", fmt.Sprintf("backend %q of type %q does not support revocation of certificates", backend.Name, backend.Type))
+			}
+		}
+		// dataplanes require revocation lists of all trusted CAs once any list is set, and lists of the federated trust domains are not available
+		if len(resource.Spec.GetMtls().GetFederatedTrustDomains()) > 0 {
+			verr.AddViolation("mtls.revocations", "revocation of certificates cannot be combined with federated trust domains")
+		}
+	}
 	return verr.OrNil()
 }
 
@@ -89,8 +103,20 @@ func ValidateNoActiveDP(ctx context.Context, name string, store core_store.Resou
 
 func (m *meshValidator) validateMTLSBackendChange(previousMesh *core_mesh.MeshResource, newMesh *core_mesh.MeshResource) error {
 	verr := validators.ValidationError{}
-	if previousMesh.MTLSEnabled() && newMesh.MTLSEnabled() && previousMesh.Spec.GetMtls().GetEnabledBackend() != newMesh.Spec.GetMtls().GetEnabledBackend() {
+	if previousMesh.MTLSEnabled() && newMesh.MTLSEnabled() && previousMesh.Spec.GetMtls().GetEnabledBackend() != newMesh.Spec.GetMtls().GetEnabledBackend() &&
+		!isRotationSwitch(previousMesh, newMesh) {
 		verr.AddViolation("mtls.enabledBackend", "Changing CA when mTLS is enabled is forbidden. Disable mTLS first and then change the CA")
 	}
 	return verr.OrNil()
 }
+
+// isRotationSwitch returns true if the enabled backend is switched between backends of the rotation
+// that was already in progress, so dataplanes trust CAs of both backends.
+func isRotationSwitch(previousMesh *core_mesh.MeshResource, newMesh *core_mesh.MeshResource) bool {
+	previous := previousMesh.Spec.GetMtls().GetRotation()
+	rotation := newMesh.Spec.GetMtls().GetRotation()
+	if previous == nil || rotation == nil || !proto.Equal(previous, rotation) {
+		return false
+	}
+	return previousMesh.Spec.GetMtls().GetEnabledBackend() == rotation.GetFrom() && newMesh.Spec.GetMtls().GetEnabledBackend() == rotation.GetTo()
+}
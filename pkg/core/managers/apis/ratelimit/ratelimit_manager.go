@@ -55,6 +55,9 @@ func (m *rateLimitManager) Create(ctx context.Context, resource core_model.Resou
 	if err := m.rateLimitValidator.ValidateCreate(ctx, opts.Mesh, rateLimit); err != nil {
 		return err
 	}
+	if opts.DryRun {
+		return nil
+	}
 
 	if err := m.store.Create(ctx, rateLimit, append(fs, core_store.CreatedAt(time.Now()))...); err != nil {
 		return err
@@ -101,6 +104,9 @@ func (m *rateLimitManager) Update(ctx context.Context, resource core_model.Resou
 	if err := m.rateLimitValidator.ValidateUpdate(ctx, currentRateLimit, rateLimit); err != nil {
 		return err
 	}
+	if core_store.NewUpdateOptions(fs...).DryRun {
+		return nil
+	}
 
 	return m.store.Update(ctx, rateLimit, append(fs, core_store.ModifiedAt(time.Now()))...)
 }
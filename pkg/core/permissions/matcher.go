@@ -37,10 +37,7 @@ func BuildTrafficPermissionMap(
 	inbounds []*mesh_proto.Dataplane_Networking_Inbound,
 	trafficPermissions []*core_mesh.TrafficPermissionResource,
 ) core_xds.TrafficPermissionMap {
-	policies := make([]policy.ConnectionPolicy, len(trafficPermissions))
-	for i, permission := range trafficPermissions {
-		policies[i] = permission
-	}
+	policies := connectionPolicies(trafficPermissions, mesh_proto.TrafficPermission_ALLOW)
 	policyMap := policy.SelectInboundConnectionPolicies(dataplane, inbounds, policies)
 
 	result := core_xds.TrafficPermissionMap{}
@@ -50,6 +47,43 @@ func BuildTrafficPermissionMap(
 	return result
 }
 
+// BuildTrafficDenyMap picks all TrafficPermissions with DENY or SHADOW_DENY action for each inbound.
+// Unlike allow rules, deny rules are additive, so the more specific policy does not override the less specific one.
+func BuildTrafficDenyMap(
+	dataplane *core_mesh.DataplaneResource,
+	inbounds []*mesh_proto.Dataplane_Networking_Inbound,
+	trafficPermissions []*core_mesh.TrafficPermissionResource,
+) core_xds.TrafficDenyMap {
+	policies := connectionPolicies(trafficPermissions, mesh_proto.TrafficPermission_DENY, mesh_proto.TrafficPermission_SHADOW_DENY)
+	policyMap := policy.SelectInboundConnectionMatchingPolicies(dataplane, inbounds, policies)
+
+	result := core_xds.TrafficDenyMap{}
+	for inbound, connectionPolicies := range policyMap {
+		seen := map[string]bool{}
+		for _, connectionPolicy := range connectionPolicies {
+			// a policy that matches the inbound with many destination selectors is returned many times
+			if name := connectionPolicy.GetMeta().GetName(); !seen[name] {
+				seen[name] = true
+				result[inbound] = append(result[inbound], connectionPolicy.(*core_mesh.TrafficPermissionResource))
+			}
+		}
+	}
+	return result
+}
+
+// connectionPolicies returns TrafficPermissions with one of the given actions.
+func connectionPolicies(trafficPermissions []*core_mesh.TrafficPermissionResource, actions ...mesh_proto.TrafficPermission_Action) []policy.ConnectionPolicy {
+	var policies []policy.ConnectionPolicy
+	for _, permission := range trafficPermissions {
+		for _, action := range actions {
+			if permission.Spec.GetAction() == action {
+				policies = append(policies, permission)
+			}
+		}
+	}
+	return policies
+}
+
 func MatchExternalServicesTrafficPermissions(
 	dataplane *core_mesh.DataplaneResource,
 	externalServices *core_mesh.ExternalServiceResourceList,
@@ -79,10 +113,7 @@ func MatchExternalServicesTrafficPermissions(
 type ExternalServicePermissions map[string]*core_mesh.TrafficPermissionResource
 
 func BuildExternalServicesPermissionsMap(externalServices *core_mesh.ExternalServiceResourceList, trafficPermissions []*core_mesh.TrafficPermissionResource) ExternalServicePermissions {
-	policies := make([]policy.ConnectionPolicy, len(trafficPermissions))
-	for i, permission := range trafficPermissions {
-		policies[i] = permission
-	}
+	policies := connectionPolicies(trafficPermissions, mesh_proto.TrafficPermission_ALLOW)
 
 	result := ExternalServicePermissions{}
 	for _, externalService := range externalServices.Items {
@@ -103,10 +134,7 @@ func BuildExternalServicesPermissionsMapForZoneEgress(
 	externalServices []*core_mesh.ExternalServiceResource,
 	trafficPermissions []*core_mesh.TrafficPermissionResource,
 ) core_xds.ExternalServicePermissionMap {
-	policies := make([]policy.ConnectionPolicy, len(trafficPermissions))
-	for i, permission := range trafficPermissions {
-		policies[i] = permission
-	}
+	policies := connectionPolicies(trafficPermissions, mesh_proto.TrafficPermission_ALLOW)
 
 	result := core_xds.ExternalServicePermissionMap{}
 	for _, externalService := range externalServices {
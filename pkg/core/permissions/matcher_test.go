@@ -390,4 +390,76 @@ var _ = Describe("Match", func() {
 			}),
 		)
 	})
+
+	Context("BuildTrafficDenyMap", func() {
+		permission := func(name string, action mesh_proto.TrafficPermission_Action, destinations ...map[string]string) *core_mesh.TrafficPermissionResource {
+			tp := &core_mesh.TrafficPermissionResource{
+				Meta: &model.ResourceMeta{
+					Mesh: "default",
+					Name: name,
+				},
+				Spec: &mesh_proto.TrafficPermission{
+					Sources: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("kong")}},
+					Action:  action,
+				},
+			}
+			for _, destination := range destinations {
+				tp.Spec.Destinations = append(tp.Spec.Destinations, &mesh_proto.Selector{Match: destination})
+			}
+			return tp
+		}
+
+		It("should select all deny policies and only the best allow policy", func() {
+			// given
+			dataplane := &core_mesh.DataplaneResource{
+				Meta: &model.ResourceMeta{
+					Mesh: "default",
+					Name: "dp1",
+				},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Port:        8080,
+								ServicePort: 8081,
+								Tags: map[string]string{
+									"kuma.io/service": "web",
+									"version":         "0.1",
+								},
+							},
+						},
+					},
+				},
+			}
+			inbounds := dataplane.Spec.GetNetworking().GetInbound()
+			policies := []*core_mesh.TrafficPermissionResource{
+				permission("allow-all", mesh_proto.TrafficPermission_ALLOW, mesh_proto.MatchAnyService()),
+				permission("allow-web", mesh_proto.TrafficPermission_ALLOW, mesh_proto.MatchService("web")),
+				permission("deny-all", mesh_proto.TrafficPermission_DENY, mesh_proto.MatchAnyService()),
+				permission("deny-web", mesh_proto.TrafficPermission_DENY,
+					mesh_proto.MatchService("web"),
+					map[string]string{"kuma.io/service": "web", "version": "0.1"},
+				),
+				permission("audit-web", mesh_proto.TrafficPermission_SHADOW_DENY, mesh_proto.MatchService("web")),
+				permission("deny-backend", mesh_proto.TrafficPermission_DENY, mesh_proto.MatchService("backend")),
+			}
+			iface := dataplane.Spec.GetNetworking().ToInboundInterface(inbounds[0])
+
+			// when
+			allows := permissions.BuildTrafficPermissionMap(dataplane, inbounds, policies)
+			denies := permissions.BuildTrafficDenyMap(dataplane, inbounds, policies)
+
+			// then
+			Expect(allows).To(HaveLen(1))
+			Expect(allows[iface].GetMeta().GetName()).To(Equal("allow-web"))
+			// and
+			Expect(denies).To(HaveLen(1))
+			var names []string
+			for _, deny := range denies[iface] {
+				names = append(names, deny.GetMeta().GetName())
+			}
+			Expect(names).To(ConsistOf("audit-web", "deny-all", "deny-web"))
+		})
+	})
 })
@@ -25,9 +25,13 @@ const (
 	Universal  PluginName = "universal"
 	Memory     PluginName = "memory"
 	Postgres   PluginName = "postgres"
+	Etcd       PluginName = "etcd"
 
 	CaBuiltin  PluginName = "builtin"
 	CaProvided PluginName = "provided"
+	CaVault    PluginName = "vault"
+	CaAcmPca   PluginName = "acmpca"
+	CaGcpCas   PluginName = "gcpcas"
 )
 
 type Registry interface {
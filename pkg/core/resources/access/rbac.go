@@ -0,0 +1,167 @@
+package access
+
+import (
+	"context"
+	"fmt"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	config_access "github.com/kumahq/kuma/pkg/config/access"
+	"github.com/kumahq/kuma/pkg/core/access"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+// RBAC checks access of users to resources with AccessRoles bound to them by AccessRoleBindings.
+type RBAC struct {
+	adminUsernames map[string]bool
+	adminGroups    map[string]bool
+	resManager     manager.ReadOnlyResourceManager
+}
+
+func NewRBAC(cfg config_access.RBACAccessConfig, resManager manager.ReadOnlyResourceManager) *RBAC {
+	r := &RBAC{
+		adminUsernames: map[string]bool{},
+		adminGroups:    map[string]bool{},
+		resManager:     resManager,
+	}
+	for _, user := range cfg.Admin.Users {
+		r.adminUsernames[user] = true
+	}
+	for _, group := range cfg.Admin.Groups {
+		r.adminGroups[group] = true
+	}
+	return r
+}
+
+// Validate returns AccessDeniedError if none of the roles of the user grants the access to resources of the type in the mesh.
+// Empty mesh means all meshes. For the Mesh type, the mesh is the name of the Mesh resource.
+func (r *RBAC) Validate(ctx context.Context, u user.User, acc system_proto.AccessRole_Access, descriptor model.ResourceTypeDescriptor, mesh string) error {
+	if r.isAdmin(u) {
+		return nil
+	}
+	rules, err := r.rules(ctx, u)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if ruleMatches(rule, acc, descriptor, mesh) {
+			return nil
+		}
+	}
+	reason := fmt.Sprintf("user %q does not have %s access to resources of type %q", u.String(), acc, descriptor.Name)
+	if mesh != "" {
+		reason += fmt.Sprintf(" in mesh %q", mesh)
+	}
+	return &access.AccessDeniedError{Reason: reason}
+}
+
+func (r *RBAC) isAdmin(u user.User) bool {
+	if r.adminUsernames[u.Name] {
+		return true
+	}
+	for _, group := range u.Groups {
+		if r.adminGroups[group] {
+			return true
+		}
+	}
+	return false
+}
+
+// rules returns rules of all AccessRoles bound to the user or to any of its groups.
+// Roles that are referenced by bindings but do not exist are ignored.
+func (r *RBAC) rules(ctx context.Context, u user.User) ([]*system_proto.AccessRole_Rule, error) {
+	bindings := &system.AccessRoleBindingResourceList{}
+	if err := r.resManager.List(ctx, bindings); err != nil {
+		return nil, err
+	}
+	boundRoles := map[string]bool{}
+	for _, binding := range bindings.Items {
+		if bindingMatches(binding.Spec, u) {
+			for _, role := range binding.Spec.GetRoles() {
+				boundRoles[role] = true
+			}
+		}
+	}
+	if len(boundRoles) == 0 {
+		return nil, nil
+	}
+	roles := &system.AccessRoleResourceList{}
+	if err := r.resManager.List(ctx, roles); err != nil {
+		return nil, err
+	}
+	var rules []*system_proto.AccessRole_Rule
+	for _, role := range roles.Items {
+		if boundRoles[role.GetMeta().GetName()] {
+			rules = append(rules, role.Spec.GetRules()...)
+		}
+	}
+	return rules, nil
+}
+
+func bindingMatches(binding *system_proto.AccessRoleBinding, u user.User) bool {
+	for _, subject := range binding.GetSubjects() {
+		switch subject.GetType() {
+		case system.UserSubjectType:
+			if subject.GetName() == u.Name {
+				return true
+			}
+		case system.GroupSubjectType:
+			for _, group := range u.Groups {
+				if subject.GetName() == group {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func ruleMatches(rule *system_proto.AccessRole_Rule, acc system_proto.AccessRole_Access, descriptor model.ResourceTypeDescriptor, mesh string) bool {
+	return accessMatches(rule.GetAccess(), acc) &&
+		typeMatches(rule.GetTypes(), descriptor) &&
+		meshMatches(rule.GetMeshes(), descriptor, mesh)
+}
+
+// accessMatches checks whether the access is granted. WRITE access also grants READ access,
+// so resources can be applied without listing both.
+func accessMatches(granted []system_proto.AccessRole_Access, acc system_proto.AccessRole_Access) bool {
+	for _, g := range granted {
+		if g == acc || (g == system_proto.AccessRole_WRITE && acc == system_proto.AccessRole_READ) {
+			return true
+		}
+	}
+	return false
+}
+
+// typeMatches checks whether the rule applies to the type. Admin types have to be listed explicitly.
+func typeMatches(types []string, descriptor model.ResourceTypeDescriptor) bool {
+	if len(types) == 0 {
+		return !descriptor.AdminOnly
+	}
+	for _, typ := range types {
+		if model.ResourceType(typ) == descriptor.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// meshMatches checks whether the rule applies to the mesh. A rule with meshes applies only to
+// resources of these meshes and Mesh resources of these names, never to other resources of global scope.
+func meshMatches(meshes []string, descriptor model.ResourceTypeDescriptor, mesh string) bool {
+	if len(meshes) == 0 {
+		return true
+	}
+	if mesh == "" || (descriptor.Scope == model.ScopeGlobal && descriptor.Name != core_mesh.MeshType) {
+		return false
+	}
+	for _, m := range meshes {
+		if m == mesh {
+			return true
+		}
+	}
+	return false
+}
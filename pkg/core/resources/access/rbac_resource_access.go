@@ -0,0 +1,50 @@
+package access
+
+import (
+	"context"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+type rbacResourceAccess struct {
+	rbac *RBAC
+}
+
+func NewRBACResourceAccess(rbac *RBAC) ResourceAccess {
+	return &rbacResourceAccess{
+		rbac: rbac,
+	}
+}
+
+var _ ResourceAccess = &rbacResourceAccess{}
+
+func (r *rbacResourceAccess) ValidateCreate(key model.ResourceKey, _ model.ResourceSpec, descriptor model.ResourceTypeDescriptor, user user.User) error {
+	return r.rbac.Validate(context.Background(), user, system_proto.AccessRole_WRITE, descriptor, meshOf(key, descriptor))
+}
+
+func (r *rbacResourceAccess) ValidateUpdate(key model.ResourceKey, _ model.ResourceSpec, _ model.ResourceSpec, descriptor model.ResourceTypeDescriptor, user user.User) error {
+	return r.rbac.Validate(context.Background(), user, system_proto.AccessRole_WRITE, descriptor, meshOf(key, descriptor))
+}
+
+func (r *rbacResourceAccess) ValidateDelete(key model.ResourceKey, _ model.ResourceSpec, descriptor model.ResourceTypeDescriptor, user user.User) error {
+	return r.rbac.Validate(context.Background(), user, system_proto.AccessRole_WRITE, descriptor, meshOf(key, descriptor))
+}
+
+func (r *rbacResourceAccess) ValidateList(mesh string, descriptor model.ResourceTypeDescriptor, user user.User) error {
+	return r.rbac.Validate(context.Background(), user, system_proto.AccessRole_READ, descriptor, mesh)
+}
+
+func (r *rbacResourceAccess) ValidateGet(key model.ResourceKey, descriptor model.ResourceTypeDescriptor, user user.User) error {
+	return r.rbac.Validate(context.Background(), user, system_proto.AccessRole_READ, descriptor, meshOf(key, descriptor))
+}
+
+// meshOf returns the mesh that the resource belongs to. Mesh resource belongs to itself.
+func meshOf(key model.ResourceKey, descriptor model.ResourceTypeDescriptor) string {
+	if descriptor.Name == core_mesh.MeshType {
+		return key.Name
+	}
+	return key.Mesh
+}
@@ -0,0 +1,166 @@
+package access_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	config_access "github.com/kumahq/kuma/pkg/config/access"
+	"github.com/kumahq/kuma/pkg/core/access"
+	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("RBAC Resource Access", func() {
+	var resManager manager.ResourceManager
+	var resourceAccess resources_access.ResourceAccess
+
+	teamA := user.User{Name: "john.doe", Groups: []string{"team-a"}}
+
+	createRole := func(name string, rules ...*system_proto.AccessRole_Rule) {
+		role := system.NewAccessRoleResource()
+		role.Spec.Rules = rules
+		Expect(resManager.Create(context.Background(), role, store.CreateByKey(name, model.NoMesh))).To(Succeed())
+	}
+
+	bind := func(name string, subject *system_proto.AccessRoleBinding_Subject, roles ...string) {
+		binding := system.NewAccessRoleBindingResource()
+		binding.Spec.Subjects = []*system_proto.AccessRoleBinding_Subject{subject}
+		binding.Spec.Roles = roles
+		Expect(resManager.Create(context.Background(), binding, store.CreateByKey(name, model.NoMesh))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		resourceAccess = resources_access.NewRBACResourceAccess(resources_access.NewRBAC(
+			config_access.DefaultAccessConfig().RBAC,
+			resManager,
+		))
+
+		createRole("team-a-policies", &system_proto.AccessRole_Rule{
+			Types:  []string{string(mesh.TrafficPermissionType), string(mesh.TrafficRouteType)},
+			Meshes: []string{"team-a"},
+			Access: []system_proto.AccessRole_Access{system_proto.AccessRole_WRITE},
+		})
+		createRole("viewer", &system_proto.AccessRole_Rule{
+			Access: []system_proto.AccessRole_Access{system_proto.AccessRole_READ},
+		})
+		bind("team-a", &system_proto.AccessRoleBinding_Subject{Type: system.GroupSubjectType, Name: "team-a"}, "team-a-policies", "non-existing")
+		bind("viewers", &system_proto.AccessRoleBinding_Subject{Type: system.UserSubjectType, Name: "jane.doe"}, "viewer")
+	})
+
+	It("should allow to write resources of the types in the meshes of the role", func() {
+		// when
+		err := resourceAccess.ValidateCreate(
+			model.ResourceKey{Mesh: "team-a", Name: "tp-1"},
+			mesh.NewTrafficPermissionResource().Spec,
+			mesh.TrafficPermissionResourceTypeDescriptor,
+			teamA,
+		)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should allow to read resources with the write access", func() {
+		// when
+		err := resourceAccess.ValidateGet(
+			model.ResourceKey{Mesh: "team-a", Name: "tr-1"},
+			mesh.TrafficRouteResourceTypeDescriptor,
+			teamA,
+		)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should deny to write resources in other meshes", func() {
+		// when
+		err := resourceAccess.ValidateUpdate(
+			model.ResourceKey{Mesh: "team-b", Name: "tp-1"},
+			nil,
+			nil,
+			mesh.TrafficPermissionResourceTypeDescriptor,
+			teamA,
+		)
+
+		// then
+		Expect(err).To(MatchError(`access denied: user "john.doe/team-a" does not have WRITE access to resources of type "TrafficPermission" in mesh "team-b"`))
+		Expect(err).To(BeAssignableToTypeOf(&access.AccessDeniedError{}))
+	})
+
+	It("should deny to write resources of other types", func() {
+		// when
+		err := resourceAccess.ValidateDelete(
+			model.ResourceKey{Mesh: "team-a", Name: "dp-1"},
+			nil,
+			mesh.DataplaneResourceTypeDescriptor,
+			teamA,
+		)
+
+		// then
+		Expect(err).To(MatchError(`access denied: user "john.doe/team-a" does not have WRITE access to resources of type "Dataplane" in mesh "team-a"`))
+	})
+
+	It("should deny to list resources of all meshes when the role is limited to meshes", func() {
+		// when
+		err := resourceAccess.ValidateList("", mesh.TrafficPermissionResourceTypeDescriptor, teamA)
+
+		// then
+		Expect(err).To(MatchError(`access denied: user "john.doe/team-a" does not have READ access to resources of type "TrafficPermission"`))
+
+		// when
+		err = resourceAccess.ValidateList("team-a", mesh.TrafficPermissionResourceTypeDescriptor, teamA)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should match Mesh resources by name", func() {
+		// given
+		createRole("team-a-mesh", &system_proto.AccessRole_Rule{
+			Types:  []string{string(mesh.MeshType)},
+			Meshes: []string{"team-a"},
+			Access: []system_proto.AccessRole_Access{system_proto.AccessRole_READ},
+		})
+		bind("team-a-mesh", &system_proto.AccessRoleBinding_Subject{Type: system.GroupSubjectType, Name: "team-a"}, "team-a-mesh")
+
+		// expect
+		Expect(resourceAccess.ValidateGet(model.ResourceKey{Name: "team-a"}, mesh.MeshResourceTypeDescriptor, teamA)).To(Succeed())
+		Expect(resourceAccess.ValidateGet(model.ResourceKey{Name: "team-b"}, mesh.MeshResourceTypeDescriptor, teamA)).ToNot(Succeed())
+	})
+
+	It("should allow to read all types except admin types with the rule without types", func() {
+		// given
+		jane := user.User{Name: "jane.doe", Groups: []string{user.AuthenticatedGroup}}
+
+		// expect
+		Expect(resourceAccess.ValidateList("", mesh.DataplaneResourceTypeDescriptor, jane)).To(Succeed())
+		Expect(resourceAccess.ValidateGet(model.ResourceKey{Name: "zone-1"}, system.ZoneResourceTypeDescriptor, jane)).To(Succeed())
+		Expect(resourceAccess.ValidateList("", system.SecretResourceTypeDescriptor, jane)).ToNot(Succeed())
+		Expect(resourceAccess.ValidateList("", system.AccessRoleBindingResourceTypeDescriptor, jane)).ToNot(Succeed())
+		Expect(resourceAccess.ValidateCreate(model.ResourceKey{Mesh: "default", Name: "dp-1"}, nil, mesh.DataplaneResourceTypeDescriptor, jane)).ToNot(Succeed())
+	})
+
+	It("should deny users without bindings", func() {
+		// when
+		err := resourceAccess.ValidateList("default", mesh.DataplaneResourceTypeDescriptor, user.Anonymous)
+
+		// then
+		Expect(err).To(MatchError(`access denied: user "mesh-system:anonymous/mesh-system:unauthenticated" does not have READ access to resources of type "Dataplane" in mesh "default"`))
+	})
+
+	It("should allow admin to access all resources", func() {
+		// expect
+		Expect(resourceAccess.ValidateCreate(model.ResourceKey{Name: "team-b"}, nil, system.AccessRoleBindingResourceTypeDescriptor, user.Admin)).To(Succeed())
+		Expect(resourceAccess.ValidateList("", system.SecretResourceTypeDescriptor, user.Admin)).To(Succeed())
+	})
+})
@@ -5,10 +5,12 @@ import (
 	"github.com/kumahq/kuma/pkg/core/user"
 )
 
+// ResourceAccess validates whether the user can perform an action on the resource.
+// The mesh passed to ValidateList is empty when resources of all meshes are listed.
 type ResourceAccess interface {
 	ValidateCreate(key model.ResourceKey, spec model.ResourceSpec, desc model.ResourceTypeDescriptor, user user.User) error
 	ValidateUpdate(key model.ResourceKey, currentSpec model.ResourceSpec, newSpec model.ResourceSpec, desc model.ResourceTypeDescriptor, user user.User) error
 	ValidateDelete(key model.ResourceKey, spec model.ResourceSpec, desc model.ResourceTypeDescriptor, user user.User) error
-	ValidateList(desc model.ResourceTypeDescriptor, user user.User) error
+	ValidateList(mesh string, desc model.ResourceTypeDescriptor, user user.User) error
 	ValidateGet(key model.ResourceKey, desc model.ResourceTypeDescriptor, user user.User) error
 }
@@ -0,0 +1,21 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// GetInboundLimit returns the bandwidth limit of inbound listeners.
+func (b *MeshBandwidthLimitResource) GetInboundLimit() *mesh_proto.MeshBandwidthLimit_Conf_Limit {
+	if b == nil {
+		return nil
+	}
+	return b.Spec.GetConf().GetInbound()
+}
+
+// GetOutboundLimit returns the bandwidth limit of outbound listeners.
+func (b *MeshBandwidthLimitResource) GetOutboundLimit() *mesh_proto.MeshBandwidthLimit_Conf_Limit {
+	if b == nil {
+		return nil
+	}
+	return b.Spec.GetConf().GetOutbound()
+}
@@ -0,0 +1,64 @@
+package mesh
+
+import (
+	"time"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+const (
+	minBandwidthLimitFillInterval = 20 * time.Millisecond
+	maxBandwidthLimitFillInterval = time.Second
+)
+
+func (d *MeshBandwidthLimitResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(d.validateSelectors())
+	err.Add(d.validateConf())
+	return err.OrNil()
+}
+
+func (d *MeshBandwidthLimitResource) validateSelectors() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("selectors"), d.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (d *MeshBandwidthLimitResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	conf := d.Spec.GetConf()
+	if conf == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	if conf.GetInbound() == nil && conf.GetOutbound() == nil {
+		err.AddViolationAt(root, "either inbound or outbound has to be defined")
+	}
+	if conf.GetInbound() != nil {
+		err.Add(validateBandwidthLimit(root.Field("inbound"), conf.GetInbound()))
+	}
+	if conf.GetOutbound() != nil {
+		err.Add(validateBandwidthLimit(root.Field("outbound"), conf.GetOutbound()))
+	}
+	return
+}
+
+func validateBandwidthLimit(path validators.PathBuilder, limit *mesh_proto.MeshBandwidthLimit_Conf_Limit) (err validators.ValidationError) {
+	if limit.GetBytesPerSecond() == 0 {
+		err.AddViolationAt(path.Field("bytesPerSecond"), "must be greater than 0")
+	} else if limit.GetBytesPerSecond()%1024 != 0 {
+		err.AddViolationAt(path.Field("bytesPerSecond"), "has to be a multiple of 1024")
+	}
+	if fillInterval := limit.GetFillInterval(); fillInterval != nil {
+		verr := ValidateDuration(path.Field("fillInterval"), fillInterval)
+		err.Add(verr)
+		if !verr.HasViolations() && (fillInterval.AsDuration() < minBandwidthLimitFillInterval || fillInterval.AsDuration() > maxBandwidthLimitFillInterval) {
+			err.AddViolationAt(path.Field("fillInterval"), "has to be between 20ms and 1s")
+		}
+	}
+	return
+}
@@ -0,0 +1,125 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshBandwidthLimit", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(bandwidthLimitYAML string) {
+				// setup
+				bandwidthLimit := NewMeshBandwidthLimitResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(bandwidthLimitYAML), bandwidthLimit.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := bandwidthLimit.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("inbound and outbound limits", `
+                selectors:
+                - match:
+                    kuma.io/service: batch
+                conf:
+                  inbound:
+                    bytesPerSecond: 1048576
+                    fillInterval: 100ms
+                  outbound:
+                    bytesPerSecond: 10240`,
+			),
+			Entry("only outbound limit", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  outbound:
+                    bytesPerSecond: 1024
+                    fillInterval: 1s`,
+			),
+		)
+
+		type testCase struct {
+			bandwidthLimit string
+			expected       string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				bandwidthLimit := NewMeshBandwidthLimitResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.bandwidthLimit), bandwidthLimit.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := bandwidthLimit.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				bandwidthLimit: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("empty conf", testCase{
+				bandwidthLimit: `
+                selectors:
+                - match:
+                    kuma.io/service: batch
+                conf: {}
+`,
+				expected: `
+                violations:
+                - field: conf
+                  message: either inbound or outbound has to be defined
+`,
+			}),
+			Entry("invalid limits", testCase{
+				bandwidthLimit: `
+                selectors:
+                - match:
+                    kuma.io/service: batch
+                conf:
+                  inbound:
+                    bytesPerSecond: 1000
+                    fillInterval: 10ms
+                  outbound:
+                    fillInterval: 0s
+`,
+				expected: `
+                violations:
+                - field: conf.inbound.bytesPerSecond
+                  message: has to be a multiple of 1024
+                - field: conf.inbound.fillInterval
+                  message: has to be between 20ms and 1s
+                - field: conf.outbound.bytesPerSecond
+                  message: must be greater than 0
+                - field: conf.outbound.fillInterval
+                  message: must have a positive value
+`,
+			}),
+		)
+	})
+})
@@ -1,6 +1,9 @@
 package mesh
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/kumahq/kuma/pkg/core/validators"
@@ -11,7 +14,8 @@ func (c *CircuitBreakerResource) HasDetectors() bool {
 		c.Spec.Conf.GetDetectors().GetGatewayErrors() != nil ||
 		c.Spec.Conf.GetDetectors().GetLocalErrors() != nil ||
 		c.Spec.Conf.GetDetectors().GetStandardDeviation() != nil ||
-		c.Spec.Conf.GetDetectors().GetFailure() != nil
+		c.Spec.Conf.GetDetectors().GetFailure() != nil ||
+		c.Spec.Conf.GetDetectors().GetGrpcErrors() != nil
 }
 
 func (c *CircuitBreakerResource) HasThresholds() bool {
@@ -21,6 +25,15 @@ func (c *CircuitBreakerResource) HasThresholds() bool {
 		c.Spec.Conf.GetThresholds().GetMaxRequests() != nil
 }
 
+func (c *CircuitBreakerResource) HasConnectionPool() bool {
+	pool := c.Spec.Conf.GetConnectionPool()
+	return pool.GetTcpKeepalive() != nil ||
+		pool.GetMaxConnections() != nil ||
+		pool.GetMaxPendingRequests() != nil ||
+		pool.GetMaxRetries() != nil ||
+		pool.GetPerConnectionBufferLimitBytes() != nil
+}
+
 func (c *CircuitBreakerResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(c.validateSources())
@@ -45,8 +58,8 @@ func (c *CircuitBreakerResource) validateDestinations() validators.ValidationErr
 
 func (c *CircuitBreakerResource) validateConf() (err validators.ValidationError) {
 	root := validators.RootedAt("conf")
-	if !c.HasDetectors() && !c.HasThresholds() {
-		err.AddViolationAt(root, "must have at least one of the detector or threshold configured")
+	if !c.HasDetectors() && !c.HasThresholds() && !c.HasConnectionPool() {
+		err.AddViolationAt(root, "must have at least one of the detector, threshold or connection pool configured")
 		return
 	}
 
@@ -62,6 +75,23 @@ func (c *CircuitBreakerResource) validateConf() (err validators.ValidationError)
 	if c.Spec.Conf.GetThresholds() != nil && !c.HasThresholds() {
 		err.AddViolationAt(root.Field("thresholds"), "can't be empty")
 	}
+
+	if c.Spec.Conf.GetConnectionPool() != nil && !c.HasConnectionPool() {
+		err.AddViolationAt(root.Field("connectionPool"), "can't be empty")
+	}
+	keepalivePath := root.Field("connectionPool").Field("tcpKeepalive")
+	if keepalive := c.Spec.Conf.GetConnectionPool().GetTcpKeepalive(); keepalive != nil {
+		err.Add(c.validateKeepaliveDuration(keepalivePath.Field("time"), keepalive.GetTime()))
+		err.Add(c.validateKeepaliveDuration(keepalivePath.Field("interval"), keepalive.GetInterval()))
+	}
+	return
+}
+
+// validateKeepaliveDuration makes sure that the duration is not lost when it's rounded down to seconds.
+func (c *CircuitBreakerResource) validateKeepaliveDuration(path validators.PathBuilder, value *durationpb.Duration) (err validators.ValidationError) {
+	if value != nil && value.AsDuration() < time.Second {
+		err.AddViolationAt(path, "must be at least 1s")
+	}
 	return
 }
 
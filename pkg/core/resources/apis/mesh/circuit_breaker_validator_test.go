@@ -46,6 +46,8 @@ var _ = Describe("CircuitBreaker", func() {
                       consecutive: 10
                     localErrors: 
                       consecutive: 7
+                    grpcErrors:
+                      consecutive: 5
                     standardDeviation:
                       requestVolume: 10
                       minimumHosts: 5
@@ -59,6 +61,15 @@ var _ = Describe("CircuitBreaker", func() {
                     maxPendingRequests: 2
                     maxRetries: 2
                     maxRequests: 2
+                  connectionPool:
+                    tcpKeepalive:
+                      probes: 3
+                      time: 10s
+                      interval: 5s
+                    maxConnections: 4
+                    maxPendingRequests: 4
+                    maxRetries: 4
+                    perConnectionBufferLimitBytes: 65536
 `),
 			Entry("one detector with default values", `
                 sources:
@@ -83,6 +94,18 @@ var _ = Describe("CircuitBreaker", func() {
                 conf:
                     thresholds:
                       maxConnections: 2`),
+			Entry("only connection pool", `
+                sources:
+                - match:
+                    kuma.io/service: frontend
+                    region: us
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                    connectionPool:
+                      tcpKeepalive:
+                        time: 30s`),
 		)
 
 		type testCase struct {
@@ -118,7 +141,7 @@ var _ = Describe("CircuitBreaker", func() {
                - field: destinations
                  message: must have at least one element
                - field: conf
-                 message: must have at least one of the detector or threshold configured`}),
+                 message: must have at least one of the detector, threshold or connection pool configured`}),
 			Entry("wrong format", testCase{
 				circuitBreaker: `
                 sources:
@@ -194,7 +217,46 @@ var _ = Describe("CircuitBreaker", func() {
 				expected: `
                violations:
                - field: conf
-                 message: must have at least one of the detector or threshold configured`}),
+                 message: must have at least one of the detector, threshold or connection pool configured`}),
+			Entry("invalid connection pool", testCase{
+				circuitBreaker: `
+                sources:
+                - match:
+                    kuma.io/service: frontend
+                    region: us
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  thresholds:
+                    maxConnections: 2
+                  connectionPool:
+                    tcpKeepalive:
+                      time: 500ms
+                      interval: 0s`,
+				expected: `
+               violations:
+               - field: conf.connectionPool.tcpKeepalive.time
+                 message: must be at least 1s
+               - field: conf.connectionPool.tcpKeepalive.interval
+                 message: must be at least 1s`}),
+			Entry("empty connection pool section", testCase{
+				circuitBreaker: `
+                sources:
+                - match:
+                    kuma.io/service: frontend
+                    region: us
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  thresholds:
+                    maxConnections: 2
+                  connectionPool: {}`,
+				expected: `
+               violations:
+               - field: conf.connectionPool
+                 message: can't be empty`}),
 		)
 	})
 })
@@ -0,0 +1,13 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// GetConf returns configuration of the compression.
+func (c *MeshCompressionResource) GetConf() *mesh_proto.MeshCompression_Conf {
+	if c == nil {
+		return nil
+	}
+	return c.Spec.GetConf()
+}
@@ -0,0 +1,47 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (d *MeshCompressionResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(d.validateSelectors())
+	err.Add(d.validateConf())
+	return err.OrNil()
+}
+
+func (d *MeshCompressionResource) validateSelectors() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("selectors"), d.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (d *MeshCompressionResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	conf := d.Spec.GetConf()
+	if conf == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	if len(conf.GetLibraries()) == 0 {
+		err.AddViolationAt(root.Field("libraries"), "must have at least one element")
+	}
+	seen := map[mesh_proto.MeshCompression_Conf_Library]bool{}
+	for i, library := range conf.GetLibraries() {
+		if seen[library] {
+			err.AddViolationAt(root.Field("libraries").Index(i), "cannot be duplicated")
+		}
+		seen[library] = true
+	}
+	for i, contentType := range conf.GetContentTypes() {
+		if contentType == "" {
+			err.AddViolationAt(root.Field("contentTypes").Index(i), "cannot be empty")
+		}
+	}
+	return
+}
@@ -0,0 +1,124 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshCompression", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(compressionYAML string) {
+				// setup
+				compression := NewMeshCompressionResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(compressionYAML), compression.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := compression.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full example", `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  libraries:
+                  - BROTLI
+                  - GZIP
+                  minContentLength: 1024
+                  contentTypes:
+                  - application/json
+                  disableOnEtagHeader: true`,
+			),
+			Entry("gzip only", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  libraries:
+                  - GZIP`,
+			),
+		)
+
+		type testCase struct {
+			compression string
+			expected    string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				compression := NewMeshCompressionResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.compression), compression.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := compression.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				compression: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("empty conf", testCase{
+				compression: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf: {}
+`,
+				expected: `
+                violations:
+                - field: conf.libraries
+                  message: must have at least one element
+`,
+			}),
+			Entry("invalid conf", testCase{
+				compression: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  libraries:
+                  - GZIP
+                  - BROTLI
+                  - GZIP
+                  contentTypes:
+                  - application/json
+                  - ''
+`,
+				expected: `
+                violations:
+                - field: conf.libraries[2]
+                  message: cannot be duplicated
+                - field: conf.contentTypes[1]
+                  message: cannot be empty
+`,
+			}),
+		)
+	})
+})
@@ -0,0 +1,77 @@
+package mesh
+
+import (
+	"regexp"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (d *MeshCORSResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(d.validateSelectors())
+	err.Add(d.validateConf())
+	return err.OrNil()
+}
+
+func (d *MeshCORSResource) validateSelectors() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("selectors"), d.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (d *MeshCORSResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	conf := d.Spec.GetConf()
+	if conf == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	if len(conf.GetAllowOrigins()) == 0 {
+		err.AddViolationAt(root.Field("allowOrigins"), "must have at least one element")
+	}
+	for i, origin := range conf.GetAllowOrigins() {
+		err.Add(validateCORSOrigin(root.Field("allowOrigins").Index(i), origin))
+	}
+	err.Add(validateNotEmptyValues(root.Field("allowMethods"), conf.GetAllowMethods()))
+	err.Add(validateNotEmptyValues(root.Field("allowHeaders"), conf.GetAllowHeaders()))
+	err.Add(validateNotEmptyValues(root.Field("exposeHeaders"), conf.GetExposeHeaders()))
+	if conf.GetMaxAge() != nil {
+		err.Add(ValidateDuration(root.Field("maxAge"), conf.GetMaxAge()))
+	}
+	return
+}
+
+func validateCORSOrigin(path validators.PathBuilder, origin *mesh_proto.MeshCORS_Conf_Origin) (err validators.ValidationError) {
+	switch match := origin.GetMatch().(type) {
+	case *mesh_proto.MeshCORS_Conf_Origin_Exact:
+		if match.Exact == "" {
+			err.AddViolationAt(path.Field("exact"), "cannot be empty")
+		}
+	case *mesh_proto.MeshCORS_Conf_Origin_Prefix:
+		if match.Prefix == "" {
+			err.AddViolationAt(path.Field("prefix"), "cannot be empty")
+		}
+	case *mesh_proto.MeshCORS_Conf_Origin_Regex:
+		if match.Regex == "" {
+			err.AddViolationAt(path.Field("regex"), "cannot be empty")
+		} else if _, e := regexp.Compile(match.Regex); e != nil {
+			err.AddViolationAt(path.Field("regex"), "has to be a valid regular expression")
+		}
+	default:
+		err.AddViolationAt(path, "either exact, prefix or regex has to be defined")
+	}
+	return
+}
+
+func validateNotEmptyValues(path validators.PathBuilder, values []string) (err validators.ValidationError) {
+	for i, value := range values {
+		if value == "" {
+			err.AddViolationAt(path.Index(i), "cannot be empty")
+		}
+	}
+	return
+}
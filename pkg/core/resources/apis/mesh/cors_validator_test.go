@@ -0,0 +1,145 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshCORS", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(corsYAML string) {
+				// setup
+				cors := NewMeshCORSResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(corsYAML), cors.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := cors.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full example", `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  allowOrigins:
+                  - exact: https://example.com
+                  - prefix: https://app.
+                  - regex: https://.*\.example\.com
+                  allowMethods:
+                  - GET
+                  - POST
+                  allowHeaders:
+                  - content-type
+                  exposeHeaders:
+                  - x-request-id
+                  maxAge: 1h
+                  allowCredentials: true`,
+			),
+			Entry("origins only", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  allowOrigins:
+                  - exact: https://example.com`,
+			),
+		)
+
+		type testCase struct {
+			cors     string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				cors := NewMeshCORSResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.cors), cors.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := cors.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				cors: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("empty conf", testCase{
+				cors: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf: {}
+`,
+				expected: `
+                violations:
+                - field: conf.allowOrigins
+                  message: must have at least one element
+`,
+			}),
+			Entry("invalid conf", testCase{
+				cors: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  allowOrigins:
+                  - {}
+                  - exact: ''
+                  - regex: '(https://'
+                  allowMethods:
+                  - ''
+                  allowHeaders:
+                  - content-type
+                  - ''
+                  exposeHeaders:
+                  - ''
+                  maxAge: 0s
+`,
+				expected: `
+                violations:
+                - field: conf.allowOrigins[0]
+                  message: either exact, prefix or regex has to be defined
+                - field: conf.allowOrigins[1].exact
+                  message: cannot be empty
+                - field: conf.allowOrigins[2].regex
+                  message: has to be a valid regular expression
+                - field: conf.allowMethods[0]
+                  message: cannot be empty
+                - field: conf.allowHeaders[1]
+                  message: cannot be empty
+                - field: conf.exposeHeaders[0]
+                  message: cannot be empty
+                - field: conf.maxAge
+                  message: must have a positive value
+`,
+			}),
+		)
+	})
+})
@@ -130,6 +130,22 @@ func (d *DataplaneResource) GetPrometheusConfig(mesh *MeshResource) (*mesh_proto
 	return &cfg, nil
 }
 
+// GetEnvoyRuntime returns runtime settings of Envoy of the dataplane, settings
+// defined in the Dataplane resource override defaults defined in the Mesh.
+func (d *DataplaneResource) GetEnvoyRuntime(mesh *MeshResource) *mesh_proto.EnvoyRuntime {
+	cfg := &mesh_proto.EnvoyRuntime{}
+	if d == nil {
+		return cfg
+	}
+	if mesh != nil && mesh.Meta.GetName() == d.Meta.GetMesh() && mesh.Spec.GetEnvoyRuntime() != nil {
+		proto.Merge(cfg, mesh.Spec.GetEnvoyRuntime())
+	}
+	if d.Spec.GetEnvoyRuntime() != nil {
+		proto.Merge(cfg, d.Spec.GetEnvoyRuntime())
+	}
+	return cfg
+}
+
 // After proto.Merge called two lists are merged and we cannot be sure
 // of order of the elements and if the element is from the Mesh or from
 // the Dataplane resource.
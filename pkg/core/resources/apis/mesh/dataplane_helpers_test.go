@@ -426,6 +426,90 @@ var _ = Describe("Dataplane", func() {
 		)
 	})
 
+	Describe("GetEnvoyRuntime()", func() {
+
+		type testCase struct {
+			dataplaneMesh string
+			dataplaneSpec string
+			meshName      string
+			meshSpec      string
+			expected      *mesh_proto.EnvoyRuntime
+		}
+
+		DescribeTable("should correctly determine effective Envoy runtime settings for given Dataplane and Mesh",
+			func(given testCase) {
+				// given
+				dataplane := &DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: given.dataplaneMesh,
+					},
+					Spec: &mesh_proto.Dataplane{},
+				}
+				Expect(util_proto.FromYAML([]byte(given.dataplaneSpec), dataplane.Spec)).To(Succeed())
+
+				// given
+				var mesh *MeshResource
+				if given.meshName != "" {
+					mesh = &MeshResource{
+						Meta: &test_model.ResourceMeta{
+							Name: given.meshName,
+						},
+						Spec: &mesh_proto.Mesh{},
+					}
+					Expect(util_proto.FromYAML([]byte(given.meshSpec), mesh.Spec)).To(Succeed())
+				}
+
+				// then
+				Expect(dataplane.GetEnvoyRuntime(mesh)).To(MatchProto(given.expected))
+			},
+			Entry("neither dataplane nor mesh define envoy runtime", testCase{
+				dataplaneMesh: "demo",
+				meshName:      "demo",
+				expected:      &mesh_proto.EnvoyRuntime{},
+			}),
+			Entry("dataplane.mesh != mesh", testCase{
+				dataplaneMesh: "default",
+				meshName:      "demo",
+				meshSpec: `
+                envoyRuntime:
+                  concurrency: 2
+`,
+				expected: &mesh_proto.EnvoyRuntime{},
+			}),
+			Entry("only mesh defines envoy runtime", testCase{
+				dataplaneMesh: "demo",
+				meshName:      "demo",
+				meshSpec: `
+                envoyRuntime:
+                  concurrency: 2
+                  perConnectionBufferLimitBytes: 32768
+`,
+				expected: &mesh_proto.EnvoyRuntime{
+					Concurrency:                   util_proto.UInt32(2),
+					PerConnectionBufferLimitBytes: util_proto.UInt32(32768),
+				},
+			}),
+			Entry("dataplane overrides mesh defaults", testCase{
+				dataplaneMesh: "demo",
+				dataplaneSpec: `
+                envoyRuntime:
+                  concurrency: 1
+`,
+				meshName: "demo",
+				meshSpec: `
+                envoyRuntime:
+                  concurrency: 2
+                  perConnectionBufferLimitBytes: 32768
+`,
+				expected: &mesh_proto.EnvoyRuntime{
+					Concurrency:                   util_proto.UInt32(1),
+					PerConnectionBufferLimitBytes: util_proto.UInt32(32768),
+				},
+			}),
+		)
+	})
+
 	Describe("GetIP()", func() {
 
 		type testCase struct {
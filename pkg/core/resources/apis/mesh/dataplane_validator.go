@@ -70,6 +70,8 @@ func (d *DataplaneResource) Validate() error {
 		}
 	}
 
+	err.AddError("envoyRuntime", validateEnvoyRuntime(d.Spec.GetEnvoyRuntime()))
+
 	return err.OrNil()
 }
 
@@ -0,0 +1,13 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// GetConf returns configuration of the authorization service.
+func (e *MeshExternalAuthzResource) GetConf() *mesh_proto.MeshExternalAuthz_Conf {
+	if e == nil {
+		return nil
+	}
+	return e.Spec.GetConf()
+}
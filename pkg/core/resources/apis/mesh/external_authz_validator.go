@@ -0,0 +1,70 @@
+package mesh
+
+import (
+	"net/url"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (d *MeshExternalAuthzResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(d.validateSelectors())
+	err.Add(d.validateConf())
+	return err.OrNil()
+}
+
+func (d *MeshExternalAuthzResource) validateSelectors() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("selectors"), d.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (d *MeshExternalAuthzResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	conf := d.Spec.GetConf()
+	if conf == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	switch conf.GetService().(type) {
+	case *mesh_proto.MeshExternalAuthz_Conf_Grpc:
+		err.Add(validateExternalServiceAddress(root.Field("grpc"), conf.GetGrpc().GetAddress()))
+		if conf.GetGrpc().GetTimeout() != nil {
+			err.Add(ValidateDuration(root.Field("grpc").Field("timeout"), conf.GetGrpc().GetTimeout()))
+		}
+	case *mesh_proto.MeshExternalAuthz_Conf_Http:
+		err.Add(validateExternalAuthzHttpService(root.Field("http"), conf.GetHttp()))
+	default:
+		err.AddViolationAt(root, "either grpc or http has to be defined")
+	}
+	if conf.GetRequestBody() != nil && conf.GetRequestBody().GetMaxRequestBytes() == 0 {
+		err.AddViolationAt(root.Field("requestBody").Field("maxRequestBytes"), "must be greater than 0")
+	}
+	return
+}
+
+func validateExternalAuthzHttpService(path validators.PathBuilder, service *mesh_proto.MeshExternalAuthz_Conf_HttpService) (err validators.ValidationError) {
+	if service.GetUrl() == "" {
+		err.AddViolationAt(path.Field("url"), "cannot be empty")
+	} else if uri, parseErr := url.ParseRequestURI(service.GetUrl()); parseErr != nil || (uri.Scheme != "http" && uri.Scheme != "https") {
+		err.AddViolationAt(path.Field("url"), "has to be a valid http or https URL")
+	}
+	if service.GetTimeout() != nil {
+		err.Add(ValidateDuration(path.Field("timeout"), service.GetTimeout()))
+	}
+	for i, header := range service.GetAllowedHeaders() {
+		if header == "" {
+			err.AddViolationAt(path.Field("allowedHeaders").Index(i), "cannot be empty")
+		}
+	}
+	for i, header := range service.GetAllowedUpstreamHeaders() {
+		if header == "" {
+			err.AddViolationAt(path.Field("allowedUpstreamHeaders").Index(i), "cannot be empty")
+		}
+	}
+	return
+}
@@ -0,0 +1,158 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshExternalAuthz", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(externalAuthzYAML string) {
+				// setup
+				externalAuthz := NewMeshExternalAuthzResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(externalAuthzYAML), externalAuthz.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := externalAuthz.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("grpc service", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  grpc:
+                    address: opa.authz.svc:9191
+                    timeout: 1s
+                  failureModeAllow: true
+                  requestBody:
+                    maxRequestBytes: 8192
+                    allowPartialMessage: true`,
+			),
+			Entry("http service", `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http:
+                    url: https://authz.example.com/check
+                    timeout: 500ms
+                    allowedHeaders:
+                    - x-user-id
+                    allowedUpstreamHeaders:
+                    - x-auth-user`,
+			),
+		)
+
+		type testCase struct {
+			externalAuthz string
+			expected      string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				externalAuthz := NewMeshExternalAuthzResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.externalAuthz), externalAuthz.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := externalAuthz.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				externalAuthz: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("empty conf", testCase{
+				externalAuthz: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  requestBody: {}
+`,
+				expected: `
+                violations:
+                - field: conf
+                  message: either grpc or http has to be defined
+                - field: conf.requestBody.maxRequestBytes
+                  message: must be greater than 0
+`,
+			}),
+			Entry("invalid grpc service", testCase{
+				externalAuthz: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  grpc:
+                    address: opa.authz.svc
+                    timeout: 0s
+`,
+				expected: `
+                violations:
+                - field: conf.grpc.address
+                  message: unable to parse address
+                - field: conf.grpc.address
+                  message: address has to be a valid IP address or a domain name
+                - field: conf.grpc.address
+                  message: unable to parse port in address
+                - field: conf.grpc.address
+                  message: port must be in the range [1, 65535]
+                - field: conf.grpc.timeout
+                  message: must have a positive value
+`,
+			}),
+			Entry("invalid http service", testCase{
+				externalAuthz: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http:
+                    url: grpc://authz.example.com
+                    allowedHeaders:
+                    - ''
+                    allowedUpstreamHeaders:
+                    - x-auth-user
+                    - ''
+`,
+				expected: `
+                violations:
+                - field: conf.http.url
+                  message: has to be a valid http or https URL
+                - field: conf.http.allowedHeaders[0]
+                  message: cannot be empty
+                - field: conf.http.allowedUpstreamHeaders[1]
+                  message: cannot be empty
+`,
+			}),
+		)
+	})
+})
@@ -1,8 +1,11 @@
 package mesh
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"net"
+	"regexp"
 	"strconv"
 
 	"github.com/asaskevich/govalidator"
@@ -48,6 +51,55 @@ func validateExternalServiceNetworking(networking *mesh_proto.ExternalService_Ne
 	err.Add(system.ValidateDataSource(path.Field("tls").Field("caCert"), networking.GetTls().GetCaCert()))
 	err.Add(system.ValidateDataSource(path.Field("tls").Field("clientCert"), networking.GetTls().GetClientCert()))
 	err.Add(system.ValidateDataSource(path.Field("tls").Field("clientKey"), networking.GetTls().GetClientKey()))
+	if networking.GetTls().GetClientCert() != nil && networking.GetTls().GetClientKey() == nil {
+		err.AddViolationAt(path.Field("tls").Field("clientKey"), "has to be defined when clientCert is defined")
+	}
+	if networking.GetTls().GetClientKey() != nil && networking.GetTls().GetClientCert() == nil {
+		err.AddViolationAt(path.Field("tls").Field("clientCert"), "has to be defined when clientKey is defined")
+	}
+	for i, san := range networking.GetTls().GetSubjectAltNames() {
+		err.Add(validateExternalServiceSubjectAltName(path.Field("tls").Field("subjectAltNames").Index(i), san))
+	}
+	for i, pin := range networking.GetTls().GetSpkiPins() {
+		if hash, e := base64.StdEncoding.DecodeString(pin); e != nil || len(hash) != sha256.Size {
+			err.AddViolationAt(path.Field("tls").Field("spkiPins").Index(i), "has to be a base64 encoded SHA-256 hash")
+		}
+	}
+	err.Add(validateExternalServiceDNS(path.Field("dns"), networking.GetDns()))
+	return err
+}
+
+func validateExternalServiceDNS(path validators.PathBuilder, dns *mesh_proto.ExternalService_Networking_DNS) validators.ValidationError {
+	var err validators.ValidationError
+	if dns.GetRefreshRate() != nil && dns.GetRefreshRate().AsDuration() <= 0 {
+		err.AddViolationAt(path.Field("refreshRate"), "must be greater than 0")
+	}
+	for i, resolver := range dns.GetResolvers() {
+		host := resolver
+		if h, port, e := net.SplitHostPort(resolver); e == nil {
+			if _, e := strconv.ParseUint(port, 10, 16); e != nil {
+				err.AddViolationAt(path.Field("resolvers").Index(i), "has an invalid port")
+			}
+			host = h
+		}
+		if !govalidator.IsIP(host) {
+			err.AddViolationAt(path.Field("resolvers").Index(i), "has to be a valid IP address optionally followed by a port")
+		}
+	}
+	return err
+}
+
+func validateExternalServiceSubjectAltName(path validators.PathBuilder, san *mesh_proto.ExternalService_Networking_TLS_SubjectAltName) validators.ValidationError {
+	var err validators.ValidationError
+	if san.GetValue() == "" {
+		err.AddViolationAt(path.Field("value"), "cannot be empty")
+		return err
+	}
+	if san.GetMatch() == mesh_proto.ExternalService_Networking_TLS_SubjectAltName_REGEX {
+		if _, e := regexp.Compile(san.GetValue()); e != nil {
+			err.AddViolationAt(path.Field("value"), "has to be a valid regular expression")
+		}
+	}
 	return err
 }
 
@@ -68,6 +68,48 @@ var _ = Describe("ExternalService", func() {
               kuma.io/service: backend
               version: "1"`,
 		),
+		Entry("external service with TLS verification", `
+            type: ExternalService
+            name: es-1
+            mesh: default
+            networking:
+              address: api.example.com:443
+              tls:
+                enabled: true
+                serverName: example.com
+                clientCert:
+                  secret: client-cert
+                clientKey:
+                  secret: client-key
+                subjectAltNames:
+                - value: api.example.com
+                - match: SUFFIX
+                  value: .example.com
+                - match: REGEX
+                  value: '^api-[0-9]+\.example\.com$'
+                spkiPins:
+                - NvqYIYSbgK2vCJpQhObf77vv+bQWtc5ek5RIOwPiC9A=
+            tags:
+              kuma.io/service: backend`,
+		),
+		Entry("external service with DNS configuration", `
+            type: ExternalService
+            name: es-1
+            mesh: default
+            networking:
+              address: api.example.com:443
+              dns:
+                type: LOGICAL
+                lookupFamily: V4_PREFERRED
+                refreshRate: 10s
+                respectDnsTtl: true
+                resolvers:
+                - 10.0.0.2
+                - 10.0.0.3:5353
+                - "[fd00::2]:53"
+            tags:
+              kuma.io/service: backend`,
+		),
 	)
 
 	type testCase struct {
@@ -330,6 +372,76 @@ var _ = Describe("ExternalService", func() {
                 - field: networking.tls.clientKey
                   message: data source cannot be empty`,
 		}),
+		Entry("tls: client cert without client key", testCase{
+			dataplane: `
+                type: ExternalService
+                name: es-1
+                mesh: default
+                tags:
+                  kuma.io/service: backend
+                networking:
+                  address: 192.168.0.1:8080
+                  tls:
+                    enabled: true
+                    clientCert:
+                      secret: client-cert`,
+			expected: `
+                violations:
+                - field: networking.tls.clientKey
+                  message: has to be defined when clientCert is defined`,
+		}),
+		Entry("tls: invalid subject alt names and SPKI pins", testCase{
+			dataplane: `
+                type: ExternalService
+                name: es-1
+                mesh: default
+                tags:
+                  kuma.io/service: backend
+                networking:
+                  address: 192.168.0.1:8080
+                  tls:
+                    enabled: true
+                    subjectAltNames:
+                    - match: PREFIX
+                    - match: REGEX
+                      value: '(api'
+                    spkiPins:
+                    - not-base64
+                    - YWJj`,
+			expected: `
+                violations:
+                - field: networking.tls.subjectAltNames[0].value
+                  message: cannot be empty
+                - field: networking.tls.subjectAltNames[1].value
+                  message: has to be a valid regular expression
+                - field: networking.tls.spkiPins[0]
+                  message: has to be a base64 encoded SHA-256 hash
+                - field: networking.tls.spkiPins[1]
+                  message: has to be a base64 encoded SHA-256 hash`,
+		}),
+		Entry("dns: invalid refresh rate and resolvers", testCase{
+			dataplane: `
+                type: ExternalService
+                name: es-1
+                mesh: default
+                tags:
+                  kuma.io/service: backend
+                networking:
+                  address: api.example.com:443
+                  dns:
+                    refreshRate: 0s
+                    resolvers:
+                    - dns.example.com
+                    - 10.0.0.2:99999`,
+			expected: `
+                violations:
+                - field: networking.dns.refreshRate
+                  message: must be greater than 0
+                - field: networking.dns.resolvers[0]
+                  message: has to be a valid IP address optionally followed by a port
+                - field: networking.dns.resolvers[1]
+                  message: has an invalid port`,
+		}),
 	)
 
 })
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"regexp"
 
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -82,7 +83,16 @@ func validateDelay(path validators.PathBuilder, delay *v1alpha1.FaultInjection_C
 
 func validateAbort(path validators.PathBuilder, abort *v1alpha1.FaultInjection_Conf_Abort) (err validators.ValidationError) {
 	err.Add(validatePercentage(path, abort.GetPercentage()))
-	err.Add(validateHttpStatus(path, abort.GetHttpStatus()))
+	switch {
+	case abort.GetHttpStatus() == nil && abort.GetGrpcStatus() == nil:
+		err.AddViolationAt(path.Field("httpStatus"), "cannot be empty when grpcStatus is not specified")
+	case abort.GetHttpStatus() != nil && abort.GetGrpcStatus() != nil:
+		err.AddViolationAt(path.Field("grpcStatus"), "cannot be specified together with httpStatus")
+	case abort.GetHttpStatus() != nil:
+		err.Add(validateHttpStatus(path, abort.GetHttpStatus()))
+	default:
+		err.Add(validateGrpcStatus(path, abort.GetGrpcStatus()))
+	}
 	return
 }
 
@@ -127,3 +137,11 @@ func validateHttpStatus(path validators.PathBuilder, httpStatus *wrapperspb.UInt
 	}
 	return
 }
+
+func validateGrpcStatus(path validators.PathBuilder, grpcStatus *wrapperspb.UInt32Value) (err validators.ValidationError) {
+	// OK is not a fault
+	if grpcStatus.GetValue() == uint32(codes.OK) || grpcStatus.GetValue() > uint32(codes.Unauthenticated) {
+		err.AddViolationAt(path.Field("grpcStatus"), "grpc status code is incorrect")
+	}
+	return
+}
@@ -76,6 +76,21 @@ var _ = Describe("FaultInjection", func() {
                   abort:
                     percentage: 40
                     httpStatus: 500
+                  responseBandwidth:
+                    percentage: 40
+                    limit: 50kbps`),
+			Entry("grpc", `
+                sources:
+                - match:
+                    service: frontend
+                destinations:
+                - match:
+                    service: backend
+                    kuma.io/protocol: grpc
+                conf:
+                  abort:
+                    percentage: 40
+                    grpcStatus: 14
                   responseBandwidth:
                     percentage: 40
                     limit: 50kbps`),
@@ -183,7 +198,7 @@ var _ = Describe("FaultInjection", func() {
                - field: conf.delay.value
                  message: cannot be empty
                - field: conf.abort.httpStatus
-                 message: cannot be empty
+                 message: cannot be empty when grpcStatus is not specified
                - field: conf.responseBandwidth.limit
                  message: cannot be empty`}),
 			Entry("conf.abort: wrong format", testCase{
@@ -205,6 +220,43 @@ var _ = Describe("FaultInjection", func() {
                violations:
                - field: conf.abort.httpStatus
                  message: http status code is incorrect`}),
+			Entry("conf.abort: wrong grpc status", testCase{
+				faultInjection: `
+                sources:
+                - match:
+                   service: frontend
+                   kuma.io/protocol: grpc
+                destinations:
+                - match:
+                   service: backend
+                   kuma.io/protocol: grpc
+                conf:
+                  abort:
+                    grpcStatus: 17
+                    percentage: 100`,
+				expected: `
+               violations:
+               - field: conf.abort.grpcStatus
+                 message: grpc status code is incorrect`}),
+			Entry("conf.abort: both http and grpc status", testCase{
+				faultInjection: `
+                sources:
+                - match:
+                   service: frontend
+                   kuma.io/protocol: grpc
+                destinations:
+                - match:
+                   service: backend
+                   kuma.io/protocol: grpc
+                conf:
+                  abort:
+                    httpStatus: 503
+                    grpcStatus: 14
+                    percentage: 100`,
+				expected: `
+               violations:
+               - field: conf.abort.grpcStatus
+                 message: cannot be specified together with httpStatus`}),
 			Entry("conf.responseBandwidth: wrong format", testCase{
 				faultInjection: `
                 sources:
@@ -101,6 +101,7 @@ func validateMeshGatewayRouteHTTPRule(
 	conf *mesh_proto.MeshGatewayRoute_HttpRoute_Rule,
 ) validators.ValidationError {
 	var hasRedirect bool
+	var hasDirectResponse bool
 	var hasPrefixMatch bool
 
 	if len(conf.GetMatches()) < 1 {
@@ -122,6 +123,10 @@ func validateMeshGatewayRouteHTTPRule(
 			hasRedirect = true
 		}
 
+		if f.GetDirectResponse() != nil {
+			hasDirectResponse = true
+		}
+
 		err.Add(validateMeshGatewayRouteHTTPFilter(path.Field("filters").Index(i), f, hasPrefixMatch))
 	}
 
@@ -133,16 +138,26 @@ func validateMeshGatewayRouteHTTPRule(
 		return err
 	}
 
+	// Neither does it make sense to respond directly and apply other filters.
+	if hasDirectResponse && len(conf.GetFilters()) != 1 {
+		err.AddViolationAt(path.Field("filters"), "direct responses cannot be used with other filters")
+
+		return err
+	}
+
 	switch len(conf.GetBackends()) {
 	case 0:
-		// Redirection doesn't forward, so there must not be any backend.
-		if !hasRedirect {
+		// Redirection and direct responses don't forward, so there must not be any backend.
+		if !hasRedirect && !hasDirectResponse {
 			err.AddViolationAt(path.Field("backends"), "cannot be empty")
 		}
 	default:
 		if hasRedirect {
 			err.AddViolationAt(path.Field("backends"), "must be empty when using redirect filters")
 		}
+		if hasDirectResponse {
+			err.AddViolationAt(path.Field("backends"), "must be empty when using direct response filters")
+		}
 	}
 
 	for i, b := range conf.GetBackends() {
@@ -261,6 +276,22 @@ func validateMeshGatewayRouteHTTPFilter(
 		if r.GetStatusCode() < 300 || r.GetStatusCode() > 308 {
 			err.AddViolationAt(path.Field("status_code"), "must be in the range [300, 308]")
 		}
+
+		if p, ok := r.GetPath().GetPath().(*mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull); ok && !strings.HasPrefix(p.ReplaceFull, "/") {
+			err.AddViolationAt(path.Field("path").Field("replaceFull"), "must be an absolute path")
+		}
+
+		if _, ok := r.GetPath().GetPath().(*mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch); ok && !hasPrefixMatch {
+			err.AddViolationAt(path.Field("path").Field("replacePrefixMatch"), "cannot be used without a match on path prefix")
+		}
+	}
+
+	if d := conf.GetDirectResponse(); d != nil {
+		path := path.Field("direct_response")
+
+		if d.GetStatusCode() < 200 || d.GetStatusCode() > 599 {
+			err.AddViolationAt(path.Field("status_code"), "must be in the range [200, 599]")
+		}
 	}
 
 	if m := conf.GetMirror(); m != nil {
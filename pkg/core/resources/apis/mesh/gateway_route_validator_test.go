@@ -112,6 +112,47 @@ conf:
          hostname: foo.example.com
          port: 80
          status_code: 307
+`),
+		Entry("HTTP redirect with path rewrite", `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /api
+      filters:
+      - redirect:
+          scheme: https
+          hostname: foo.example.com
+          status_code: 301
+          path:
+            replacePrefixMatch: /v2/api
+`),
+		Entry("HTTP direct response", `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      filters:
+      - direct_response:
+          status_code: 503
+          body: "under maintenance"
 `),
 	)
 
@@ -649,6 +690,97 @@ conf:
         - weight: 5
           destination:
             kuma.io/service: target-2
+`),
+		ErrorCase("redirect filter with relative path", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].redirect.path.replaceFull",
+			Message: "must be an absolute path",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - redirect:
+          scheme: https
+          hostname: example.com
+          status_code: 302
+          path:
+            replaceFull: maintenance
+`),
+		ErrorCase("direct response filter with invalid status", validators.Violation{
+			Field:   "conf.http.rules[0].filters[0].direct_response.status_code",
+			Message: "must be in the range [200, 599]",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - direct_response:
+          status_code: 100
+`),
+		ErrorCase("direct response filter with backend routes", validators.Violation{
+			Field:   "conf.http.rules[0].backends",
+			Message: "must be empty when using direct response filters",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - direct_response:
+          status_code: 200
+      backends:
+      - weight: 5
+        destination:
+          kuma.io/service: target-2
+`),
+		ErrorCase("direct response prevents other filters", validators.Violation{
+			Field:   "conf.http.rules[0].filters",
+			Message: "direct responses cannot be used with other filters",
+		}, `
+type: MeshGatewayRoute
+name: route
+mesh: default
+selectors:
+- match:
+    kuma.io/service: gateway
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          value: /
+      filters:
+      - direct_response:
+          status_code: 200
+      - request_header:
+          remove:
+          - x-foo
 `),
 		ErrorCase("prefix match replacement without prefix match filter", validators.Violation{
 			Field:   "conf.http.rules[0].filters[0].rewrite.replacePrefixMatch",
@@ -123,6 +123,19 @@ func validateMeshGatewayConf(path validators.PathBuilder, conf *mesh_proto.MeshG
 			}
 		}
 
+		// HTTP/3 needs the TLS session to be terminated at the gateway.
+		if l.GetHttp3() {
+			if l.GetProtocol() != mesh_proto.MeshGateway_Listener_HTTPS {
+				err.AddViolationAt(
+					path.Index(i).Field("http3"),
+					"can only be enabled with HTTPS protocol")
+			} else if l.GetTls().GetMode() != mesh_proto.MeshGateway_TLS_TERMINATE {
+				err.AddViolationAt(
+					path.Index(i).Field("http3"),
+					"can only be enabled in TLS termination mode")
+			}
+		}
+
 		// Listener tags are optional, but if given, must not contain
 		// various tags that are well-known properties of Dataplanes.
 		err.Add(ValidateSelector(
@@ -86,6 +86,28 @@ conf:
     tags:
       name: http`,
 		),
+		Entry("HTTPS listener with HTTP/3", `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+tags:
+  product: edge
+conf:
+  listeners:
+  - hostname: www-1.example.com
+    port: 443
+    protocol: HTTPS
+    http3: true
+    tls:
+      mode: TERMINATE
+      certificates:
+      - secret: server-certificate
+    tags:
+      name: https`,
+		),
 	)
 
 	DescribeErrorCases(
@@ -327,6 +349,54 @@ conf:
       name: https
 `),
 
+		ErrorCase("HTTP/3 and HTTP",
+			validators.Violation{
+				Field:   "conf.listeners[0].http3",
+				Message: "can only be enabled with HTTPS protocol",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+tags:
+  product: edge
+conf:
+  listeners:
+  - hostname: "foo.example.com"
+    protocol: HTTP
+    port: 80
+    http3: true
+    tags:
+      name: http
+`),
+
+		ErrorCase("HTTP/3 and TLS passthrough",
+			validators.Violation{
+				Field:   "conf.listeners[0].http3",
+				Message: "can only be enabled in TLS termination mode",
+			}, `
+type: MeshGateway
+name: gateway
+mesh: default
+selectors:
+  - match:
+      kuma.io/service: gateway
+tags:
+  product: edge
+conf:
+  listeners:
+  - hostname: "foo.example.com"
+    protocol: HTTPS
+    port: 443
+    http3: true
+    tls:
+      mode: PASSTHROUGH
+    tags:
+      name: https
+`),
+
 		ErrorCase("crossMesh and multiple services",
 			validators.Violation{
 				Field:   "selectors[1]",
@@ -0,0 +1,21 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// GetInboundModifications returns header modifications of the traffic received by the inbound listeners.
+func (h *MeshHeaderModifierResource) GetInboundModifications() *mesh_proto.MeshHeaderModifier_Conf_Modifications {
+	if h == nil {
+		return nil
+	}
+	return h.Spec.GetConf().GetInbound()
+}
+
+// GetOutboundModifications returns header modifications of the traffic sent through the outbound listeners.
+func (h *MeshHeaderModifierResource) GetOutboundModifications() *mesh_proto.MeshHeaderModifier_Conf_Modifications {
+	if h == nil {
+		return nil
+	}
+	return h.Spec.GetConf().GetOutbound()
+}
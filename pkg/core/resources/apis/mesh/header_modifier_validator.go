@@ -0,0 +1,79 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (d *MeshHeaderModifierResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(d.validateSelectors())
+	err.Add(d.validateConf())
+	return err.OrNil()
+}
+
+func (d *MeshHeaderModifierResource) validateSelectors() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("selectors"), d.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (d *MeshHeaderModifierResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	if d.Spec.GetConf() == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	inbound := d.Spec.GetConf().GetInbound()
+	outbound := d.Spec.GetConf().GetOutbound()
+	if inbound == nil && outbound == nil {
+		err.AddViolationAt(root, "either inbound or outbound has to be defined")
+	}
+	if inbound != nil {
+		err.Add(validateHeaderModifications(root.Field("inbound"), inbound))
+	}
+	if outbound != nil {
+		err.Add(validateHeaderModifications(root.Field("outbound"), outbound))
+	}
+	return
+}
+
+func validateHeaderModifications(path validators.PathBuilder, modifications *mesh_proto.MeshHeaderModifier_Conf_Modifications) (err validators.ValidationError) {
+	if modifications.GetRequestHeaders() == nil && modifications.GetResponseHeaders() == nil {
+		err.AddViolationAt(path, "either requestHeaders or responseHeaders has to be defined")
+	}
+	if headers := modifications.GetRequestHeaders(); headers != nil {
+		err.Add(validateHeaderOperations(path.Field("requestHeaders"), headers))
+	}
+	if headers := modifications.GetResponseHeaders(); headers != nil {
+		err.Add(validateHeaderOperations(path.Field("responseHeaders"), headers))
+	}
+	return
+}
+
+func validateHeaderOperations(path validators.PathBuilder, headers *mesh_proto.MeshHeaderModifier_Conf_Headers) (err validators.ValidationError) {
+	if len(headers.GetAdd()) == 0 && len(headers.GetSet()) == 0 && len(headers.GetRemove()) == 0 {
+		err.AddViolationAt(path, "cannot be empty")
+	}
+	for i, header := range headers.GetAdd() {
+		err.Add(validateHeader(path.Field("add").Index(i), header))
+	}
+	for i, header := range headers.GetSet() {
+		err.Add(validateHeader(path.Field("set").Index(i), header))
+	}
+	for i, name := range headers.GetRemove() {
+		err.Add(validateHeaderName(path.Field("remove").Index(i), name))
+	}
+	return
+}
+
+func validateHeader(path validators.PathBuilder, header *mesh_proto.MeshHeaderModifier_Conf_Headers_Header) (err validators.ValidationError) {
+	err.Add(validateHeaderName(path.Field("name"), header.GetName()))
+	if header.GetValue() == "" {
+		err.AddViolationAt(path.Field("value"), "cannot be empty")
+	}
+	return
+}
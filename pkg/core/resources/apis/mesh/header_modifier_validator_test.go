@@ -0,0 +1,151 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshHeaderModifier", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(headerModifierYAML string) {
+				// setup
+				headerModifier := NewMeshHeaderModifierResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(headerModifierYAML), headerModifier.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := headerModifier.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full example", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  inbound:
+                    requestHeaders:
+                      add:
+                      - name: x-tenant
+                        value: acme
+                      set:
+                      - name: x-client-address
+                        value: '%DOWNSTREAM_REMOTE_ADDRESS%'
+                      remove:
+                      - x-internal
+                    responseHeaders:
+                      remove:
+                      - server
+                  outbound:
+                    requestHeaders:
+                      set:
+                      - name: x-tenant
+                        value: acme`,
+			),
+			Entry("only outbound response headers", `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  outbound:
+                    responseHeaders:
+                      add:
+                      - name: x-served-by
+                        value: backend`,
+			),
+		)
+
+		type testCase struct {
+			headerModifier string
+			expected       string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				headerModifier := NewMeshHeaderModifierResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.headerModifier), headerModifier.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := headerModifier.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				headerModifier: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("empty conf", testCase{
+				headerModifier: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf: {}
+`,
+				expected: `
+                violations:
+                - field: conf
+                  message: either inbound or outbound has to be defined
+`,
+			}),
+			Entry("invalid headers", testCase{
+				headerModifier: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  inbound: {}
+                  outbound:
+                    requestHeaders:
+                      add:
+                      - name: ''
+                        value: acme
+                      set:
+                      - name: ':authority'
+                        value: ''
+                      remove:
+                      - host
+                    responseHeaders: {}
+`,
+				expected: `
+                violations:
+                - field: conf.inbound
+                  message: either requestHeaders or responseHeaders has to be defined
+                - field: conf.outbound.requestHeaders.add[0].name
+                  message: cannot be empty
+                - field: conf.outbound.requestHeaders.set[0].name
+                  message: host header and HTTP/2 pseudo-headers are not allowed to be modified
+                - field: conf.outbound.requestHeaders.set[0].value
+                  message: cannot be empty
+                - field: conf.outbound.requestHeaders.remove[0]
+                  message: host header and HTTP/2 pseudo-headers are not allowed to be modified
+                - field: conf.outbound.responseHeaders
+                  message: cannot be empty
+`,
+			}),
+		)
+	})
+})
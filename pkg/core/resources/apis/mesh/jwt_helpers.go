@@ -0,0 +1,13 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// GetConf returns configuration of the token validation.
+func (j *MeshJWTResource) GetConf() *mesh_proto.MeshJWT_Conf {
+	if j == nil {
+		return nil
+	}
+	return j.Spec.GetConf()
+}
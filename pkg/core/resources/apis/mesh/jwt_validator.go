@@ -0,0 +1,102 @@
+package mesh
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (d *MeshJWTResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(d.validateSelectors())
+	err.Add(d.validateConf())
+	return err.OrNil()
+}
+
+func (d *MeshJWTResource) validateSelectors() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("selectors"), d.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (d *MeshJWTResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	conf := d.Spec.GetConf()
+	if conf == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	if len(conf.GetProviders()) == 0 {
+		err.AddViolationAt(root.Field("providers"), "must have at least one element")
+	}
+	providers := map[string]bool{}
+	for i, provider := range conf.GetProviders() {
+		providerPath := root.Field("providers").Index(i)
+		if provider.GetName() == "" {
+			err.AddViolationAt(providerPath.Field("name"), "cannot be empty")
+		} else if providers[provider.GetName()] {
+			err.AddViolationAt(providerPath.Field("name"), fmt.Sprintf("%q name is already used for another provider", provider.GetName()))
+		}
+		providers[provider.GetName()] = true
+		err.Add(validateJWTProvider(providerPath, provider))
+	}
+	for i, rule := range conf.GetRules() {
+		rulePath := root.Field("rules").Index(i)
+		if !strings.HasPrefix(rule.GetPathPrefix(), "/") {
+			err.AddViolationAt(rulePath.Field("pathPrefix"), "has to start with /")
+		}
+		for j, provider := range rule.GetProviders() {
+			if !providers[provider] {
+				err.AddViolationAt(rulePath.Field("providers").Index(j), fmt.Sprintf("provider %q is not defined", provider))
+			}
+		}
+	}
+	return
+}
+
+func validateJWTProvider(path validators.PathBuilder, provider *mesh_proto.MeshJWT_Conf_Provider) (err validators.ValidationError) {
+	for i, audience := range provider.GetAudiences() {
+		if audience == "" {
+			err.AddViolationAt(path.Field("audiences").Index(i), "cannot be empty")
+		}
+	}
+	switch provider.GetJwks().(type) {
+	case *mesh_proto.MeshJWT_Conf_Provider_LocalJwks:
+		err.Add(system.ValidateDataSource(path.Field("localJwks"), provider.GetLocalJwks()))
+	case *mesh_proto.MeshJWT_Conf_Provider_RemoteJwks_:
+		remotePath := path.Field("remoteJwks")
+		remote := provider.GetRemoteJwks()
+		if remote.GetUrl() == "" {
+			err.AddViolationAt(remotePath.Field("url"), "cannot be empty")
+		} else if uri, parseErr := url.ParseRequestURI(remote.GetUrl()); parseErr != nil || (uri.Scheme != "http" && uri.Scheme != "https") {
+			err.AddViolationAt(remotePath.Field("url"), "has to be a valid http or https URL")
+		}
+		if remote.GetService() == mesh_proto.MatchAllTag {
+			err.AddViolationAt(remotePath.Field("service"), "has to be a name of a service")
+		}
+		if remote.GetTimeout() != nil {
+			err.Add(ValidateDuration(remotePath.Field("timeout"), remote.GetTimeout()))
+		}
+		if remote.GetCacheDuration() != nil {
+			err.Add(ValidateDuration(remotePath.Field("cacheDuration"), remote.GetCacheDuration()))
+		}
+	default:
+		err.AddViolationAt(path, "either remoteJwks or localJwks has to be defined")
+	}
+	for i, claimToHeader := range provider.GetClaimToHeaders() {
+		if claimToHeader.GetClaim() == "" {
+			err.AddViolationAt(path.Field("claimToHeaders").Index(i).Field("claim"), "cannot be empty")
+		}
+		if claimToHeader.GetHeader() == "" {
+			err.AddViolationAt(path.Field("claimToHeaders").Index(i).Field("header"), "cannot be empty")
+		}
+	}
+	return
+}
@@ -0,0 +1,189 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshJWT", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(jwtYAML string) {
+				// setup
+				jwt := NewMeshJWTResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(jwtYAML), jwt.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := jwt.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("remote key set", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  providers:
+                  - name: auth0
+                    issuer: https://example.auth0.com/
+                    audiences:
+                    - backend
+                    remoteJwks:
+                      url: https://example.auth0.com/.well-known/jwks.json
+                      timeout: 1s
+                      cacheDuration: 10m
+                    claimToHeaders:
+                    - claim: sub
+                      header: x-user-id
+                    forward: true`,
+			),
+			Entry("key set fetched through the mesh and key set from a secret", `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  providers:
+                  - name: keycloak
+                    remoteJwks:
+                      url: http://keycloak/realms/kuma/protocol/openid-connect/certs
+                      service: keycloak_kuma-system_svc_8080
+                  - name: internal
+                    localJwks:
+                      secret: internal-jwks
+                  rules:
+                  - pathPrefix: /health
+                  - pathPrefix: /
+                    providers:
+                    - keycloak
+                    - internal`,
+			),
+		)
+
+		type testCase struct {
+			jwt      string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				jwt := NewMeshJWTResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.jwt), jwt.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := jwt.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				jwt: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("empty conf", testCase{
+				jwt: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf: {}
+`,
+				expected: `
+                violations:
+                - field: conf.providers
+                  message: must have at least one element
+`,
+			}),
+			Entry("invalid providers", testCase{
+				jwt: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  providers:
+                  - name: auth0
+                    audiences:
+                    - ''
+                    remoteJwks:
+                      url: grpc://example.auth0.com
+                      service: '*'
+                      timeout: 0s
+                      cacheDuration: 0s
+                    claimToHeaders:
+                    - claim: sub
+                  - name: auth0
+                    localJwks: {}
+                  - {}
+`,
+				expected: `
+                violations:
+                - field: conf.providers[0].audiences[0]
+                  message: cannot be empty
+                - field: conf.providers[0].remoteJwks.url
+                  message: has to be a valid http or https URL
+                - field: conf.providers[0].remoteJwks.service
+                  message: has to be a name of a service
+                - field: conf.providers[0].remoteJwks.timeout
+                  message: must have a positive value
+                - field: conf.providers[0].remoteJwks.cacheDuration
+                  message: must have a positive value
+                - field: conf.providers[0].claimToHeaders[0].header
+                  message: cannot be empty
+                - field: conf.providers[1].name
+                  message: '"auth0" name is already used for another provider'
+                - field: conf.providers[1].localJwks
+                  message: data source cannot be empty
+                - field: conf.providers[2].name
+                  message: cannot be empty
+                - field: conf.providers[2]
+                  message: either remoteJwks or localJwks has to be defined
+`,
+			}),
+			Entry("invalid rules", testCase{
+				jwt: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  providers:
+                  - name: internal
+                    localJwks:
+                      secret: internal-jwks
+                  rules:
+                  - pathPrefix: api
+                    providers:
+                    - internal
+                    - external
+`,
+				expected: `
+                violations:
+                - field: conf.rules[0].pathPrefix
+                  message: has to start with /
+                - field: conf.rules[0].providers[1]
+                  message: provider "external" is not defined
+`,
+			}),
+		)
+	})
+})
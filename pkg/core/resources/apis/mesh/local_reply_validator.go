@@ -0,0 +1,67 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (d *LocalReplyResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(d.validateSelectors())
+	err.Add(d.validateConf())
+	return err.OrNil()
+}
+
+func (d *LocalReplyResource) validateSelectors() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("selectors"), d.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (d *LocalReplyResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	if d.Spec.GetConf() == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	for i, mapper := range d.Spec.GetConf().GetMappers() {
+		err.Add(validateLocalReplyMapper(root.Field("mappers").Index(i), mapper))
+	}
+	if bodyFormat := d.Spec.GetConf().GetBodyFormat(); bodyFormat != nil {
+		err.Add(validateLocalReplyBodyFormat(root.Field("bodyFormat"), bodyFormat))
+	}
+	return
+}
+
+func validateLocalReplyMapper(path validators.PathBuilder, mapper *mesh_proto.LocalReply_Conf_Mapper) (err validators.ValidationError) {
+	if len(mapper.GetStatusCodes()) == 0 {
+		err.AddViolationAt(path.Field("statusCodes"), "must have at least one element")
+	}
+	for i, statusCode := range mapper.GetStatusCodes() {
+		if statusCode < 100 || statusCode > 599 {
+			err.AddViolationAt(path.Field("statusCodes").Index(i), "must be in inclusive range [100, 599]")
+		}
+	}
+	if mapper.GetStatusCode() != nil {
+		if statusCode := mapper.GetStatusCode().GetValue(); statusCode < 200 || statusCode > 599 {
+			err.AddViolationAt(path.Field("statusCode"), "must be in inclusive range [200, 599]")
+		}
+	}
+	if bodyFormat := mapper.GetBodyFormat(); bodyFormat != nil {
+		err.Add(validateLocalReplyBodyFormat(path.Field("bodyFormat"), bodyFormat))
+	}
+	return
+}
+
+func validateLocalReplyBodyFormat(path validators.PathBuilder, bodyFormat *mesh_proto.LocalReply_Conf_BodyFormat) (err validators.ValidationError) {
+	if bodyFormat.GetText() == "" && bodyFormat.GetJson() == nil {
+		err.AddViolationAt(path, "either text or json has to be defined")
+	}
+	if bodyFormat.GetText() != "" && bodyFormat.GetJson() != nil {
+		err.AddViolationAt(path, "text and json cannot be defined at the same time")
+	}
+	return
+}
@@ -0,0 +1,136 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("LocalReply", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(localReplyYAML string) {
+				// setup
+				localReply := NewLocalReplyResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(localReplyYAML), localReply.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := localReply.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full example", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  mappers:
+                  - statusCodes: [503, 504]
+                    statusCode: 502
+                    body: upstream unavailable
+                    bodyFormat:
+                      text: '%LOCAL_REPLY_BODY%'
+                  bodyFormat:
+                    json:
+                      code: '%RESPONSE_CODE%'
+                      message: '%LOCAL_REPLY_BODY%'`,
+			),
+			Entry("only body format", `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  bodyFormat:
+                    text: 'error: %LOCAL_REPLY_BODY%'
+                    contentType: text/html`,
+			),
+		)
+
+		type testCase struct {
+			localReply string
+			expected   string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				localReply := NewLocalReplyResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.localReply), localReply.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := localReply.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				localReply: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("invalid mappers", testCase{
+				localReply: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  mappers:
+                  - statusCode: 100
+                  - statusCodes: [503, 99, 600]
+                    bodyFormat:
+                      contentType: text/html
+`,
+				expected: `
+                violations:
+                - field: conf.mappers[0].statusCodes
+                  message: must have at least one element
+                - field: conf.mappers[0].statusCode
+                  message: must be in inclusive range [200, 599]
+                - field: conf.mappers[1].statusCodes[1]
+                  message: must be in inclusive range [100, 599]
+                - field: conf.mappers[1].statusCodes[2]
+                  message: must be in inclusive range [100, 599]
+                - field: conf.mappers[1].bodyFormat
+                  message: either text or json has to be defined
+`,
+			}),
+			Entry("text and json body format", testCase{
+				localReply: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  bodyFormat:
+                    text: '%LOCAL_REPLY_BODY%'
+                    json:
+                      message: '%LOCAL_REPLY_BODY%'
+`,
+				expected: `
+                violations:
+                - field: conf.bodyFormat
+                  message: text and json cannot be defined at the same time
+`,
+			}),
+		)
+	})
+})
@@ -2,6 +2,7 @@ package mesh
 
 import (
 	"fmt"
+	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
@@ -99,6 +100,72 @@ func (m *MeshResource) GetCertificateAuthorityBackend(name string) *mesh_proto.C
 	return nil
 }
 
+// DefaultCertRotationThreshold is the percentage of the lifetime of the Dataplane certificate after which it's rotated.
+const DefaultCertRotationThreshold = 80
+
+// CertRotationThreshold returns the percentage of the lifetime of the Dataplane certificate after which it's rotated.
+func CertRotationThreshold(rotation *mesh_proto.CertificateAuthorityBackend_DpCert_Rotation) uint32 {
+	if rotation.GetThreshold() != nil {
+		return rotation.GetThreshold().GetValue()
+	}
+	return DefaultCertRotationThreshold
+}
+
+// FederatedTrustDomainNames returns names of the external SPIFFE trust domains federated with the Mesh.
+func (m *MeshResource) FederatedTrustDomainNames() []string {
+	var names []string
+	for _, trustDomain := range m.Spec.GetMtls().GetFederatedTrustDomains() {
+		names = append(names, trustDomain.GetName())
+	}
+	return names
+}
+
+// RevokedSerialNumbers returns serial numbers of the certificates revoked in the Mesh that are still enforced at the given time.
+func (m *MeshResource) RevokedSerialNumbers(now time.Time) []*big.Int {
+	var serials []*big.Int
+	for _, revocation := range m.activeRevocations(now) {
+		if serial, err := ParseSerialNumber(revocation.GetSerialNumber()); err == nil {
+			serials = append(serials, serial)
+		}
+	}
+	return serials
+}
+
+// IsSpiffeIDRevoked returns true if the identity is revoked in the Mesh at the given time.
+func (m *MeshResource) IsSpiffeIDRevoked(spiffeID string, now time.Time) bool {
+	for _, revocation := range m.activeRevocations(now) {
+		if revocation.GetSpiffeId() == spiffeID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MeshResource) activeRevocations(now time.Time) []*mesh_proto.Mesh_Mtls_Revocation {
+	var revocations []*mesh_proto.Mesh_Mtls_Revocation
+	for _, revocation := range m.Spec.GetMtls().GetRevocations() {
+		if revocation.GetExpiration() != nil && !now.Before(revocation.GetExpiration().AsTime()) {
+			continue
+		}
+		revocations = append(revocations, revocation)
+	}
+	return revocations
+}
+
+// ParseSerialNumber parses a serial number of the certificate in hex format. Bytes may be separated with colons.
+func ParseSerialNumber(serial string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(strings.ReplaceAll(serial, ":", ""), 16)
+	if !ok || n.Sign() <= 0 {
+		return nil, fmt.Errorf("not a valid serial number: %q", serial)
+	}
+	return n, nil
+}
+
+// FormatSerialNumber formats a serial number of the certificate in hex format.
+func FormatSerialNumber(serial *big.Int) string {
+	return serial.Text(16)
+}
+
 var durationRE = regexp.MustCompile("^([0-9]+)(y|w|d|h|m|s|ms)$")
 
 // ParseDuration parses a string into a time.Duration
@@ -5,10 +5,13 @@ import (
 	"net"
 	"net/url"
 	"regexp"
+	"sort"
 
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/validators"
 	accesslog "github.com/kumahq/kuma/pkg/envoy/accesslog/v3"
 	"github.com/kumahq/kuma/pkg/util/proto"
@@ -16,6 +19,8 @@ import (
 
 var AllowedMTLSBackends = 1
 
+var trustDomainRegexp = regexp.MustCompile(`^[a-z0-9._-]+$`)
+
 func (m *MeshResource) Validate() error {
 	var verr validators.ValidationError
 	verr.AddError("mtls", validateMtls(m.Spec.Mtls))
@@ -23,7 +28,11 @@ func (m *MeshResource) Validate() error {
 	verr.AddError("tracing", validateTracing(m.Spec.Tracing))
 	verr.AddError("metrics", validateMetrics(m.Spec.Metrics))
 	verr.AddError("constraints", validateConstraints(m.Spec.Constraints))
+	verr.AddError("envoyRuntime", validateEnvoyRuntime(m.Spec.EnvoyRuntime))
 	verr.AddError("", validateZoneEgress(m.Spec.Routing, m.Spec.Mtls))
+	verr.AddError("routing", validateRouting(m.Spec.Routing))
+	verr.AddError("networking", validateMeshNetworking(m.Spec.Networking))
+	verr.AddError("sidecarResources", validateSidecarResources(m.Spec.SidecarResources))
 	return verr.OrNil()
 }
 
@@ -61,13 +70,31 @@ func validateDppConstraints(constraints *mesh_proto.Mesh_DataplaneProxyConstrain
 	return verr
 }
 
+func validateEnvoyRuntime(runtime *mesh_proto.EnvoyRuntime) validators.ValidationError {
+	var verr validators.ValidationError
+	if runtime == nil {
+		return verr
+	}
+	if runtime.GetConcurrency() != nil && runtime.GetConcurrency().GetValue() == 0 {
+		verr.AddViolation("concurrency", "must be greater than 0")
+	}
+	if runtime.GetPerConnectionBufferLimitBytes() != nil && runtime.GetPerConnectionBufferLimitBytes().GetValue() == 0 {
+		verr.AddViolation("perConnectionBufferLimitBytes", "must be greater than 0")
+	}
+	return verr
+}
+
 func validateMtls(mtls *mesh_proto.Mesh_Mtls) validators.ValidationError {
 	var verr validators.ValidationError
 	if mtls == nil {
 		return verr
 	}
-	if len(mtls.GetBackends()) > AllowedMTLSBackends {
-		verr.AddViolationAt(validators.RootedAt("backends"), fmt.Sprintf("cannot have more than %d backends", AllowedMTLSBackends))
+	allowedBackends := AllowedMTLSBackends
+	if mtls.GetRotation() != nil {
+		allowedBackends++ // the backend the mesh rotates to
+	}
+	if len(mtls.GetBackends()) > allowedBackends {
+		verr.AddViolationAt(validators.RootedAt("backends"), fmt.Sprintf("cannot have more than %d backends", allowedBackends))
 	}
 
 	usedNames := map[string]bool{}
@@ -80,14 +107,66 @@ func validateMtls(mtls *mesh_proto.Mesh_Mtls) validators.ValidationError {
 	if mtls.GetEnabledBackend() != "" && !usedNames[mtls.GetEnabledBackend()] {
 		verr.AddViolation("enabledBackend", "has to be set to one of the backends in the mesh")
 	}
+	if rotation := mtls.GetRotation(); rotation != nil {
+		path := validators.RootedAt("rotation")
+		if !usedNames[rotation.GetFrom()] {
+			verr.AddViolationAt(path.Field("from"), "has to be set to one of the backends in the mesh")
+		}
+		if !usedNames[rotation.GetTo()] {
+			verr.AddViolationAt(path.Field("to"), "has to be set to one of the backends in the mesh")
+		} else if rotation.GetTo() == rotation.GetFrom() {
+			verr.AddViolationAt(path.Field("to"), "has to be different than from")
+		}
+		if mtls.GetEnabledBackend() != rotation.GetFrom() && mtls.GetEnabledBackend() != rotation.GetTo() {
+			verr.AddViolation("enabledBackend", "has to be set to one of the backends of the rotation")
+		}
+	}
 	for _, backend := range mtls.Backends {
 		if backend.GetDpCert() != nil {
 			_, err := ParseDuration(backend.GetDpCert().GetRotation().GetExpiration())
 			if err != nil {
 				verr.AddViolation("dpcert.rotation.expiration", "has to be a valid format")
 			}
+			threshold := backend.GetDpCert().GetRotation().GetThreshold()
+			if threshold != nil && (threshold.GetValue() < 1 || threshold.GetValue() > 99) {
+				verr.AddViolation("dpcert.rotation.threshold", "has to be in [1 - 99] range")
+			}
+			if jitter := backend.GetDpCert().GetRotation().GetJitter(); jitter != nil && jitter.GetValue() >= CertRotationThreshold(backend.GetDpCert().GetRotation()) {
+				verr.AddViolation("dpcert.rotation.jitter", "has to be lower than threshold")
+			}
 		}
 	}
+	for i, revocation := range mtls.GetRevocations() {
+		path := validators.RootedAt("revocations").Index(i)
+		switch {
+		case (revocation.GetSerialNumber() == "") == (revocation.GetSpiffeId() == ""):
+			verr.AddViolationAt(path, "has to define either serialNumber or spiffeId")
+		case revocation.GetSerialNumber() != "":
+			if _, err := ParseSerialNumber(revocation.GetSerialNumber()); err != nil {
+				verr.AddViolationAt(path.Field("serialNumber"), "has to be a valid serial number in hex format")
+			}
+		default:
+			if u, err := url.Parse(revocation.GetSpiffeId()); err != nil || u.Scheme != "spiffe" || u.Host == "" {
+				verr.AddViolationAt(path.Field("spiffeId"), "has to be a valid SPIFFE ID")
+			}
+		}
+	}
+	trustDomains := map[string]bool{}
+	for i, trustDomain := range mtls.GetFederatedTrustDomains() {
+		path := validators.RootedAt("federatedTrustDomains").Index(i)
+		if !trustDomainRegexp.MatchString(trustDomain.GetName()) {
+			verr.AddViolationAt(path.Field("name"), "has to be a valid SPIFFE trust domain")
+		} else if trustDomains[trustDomain.GetName()] {
+			verr.AddViolationAt(path.Field("name"), fmt.Sprintf("%q trust domain is already federated", trustDomain.GetName()))
+		}
+		trustDomains[trustDomain.GetName()] = true
+		if trustDomain.GetBundle() == nil {
+			verr.AddViolationAt(path.Field("bundle"), "has to be defined")
+		} else {
+			verr.Add(system.ValidateDataSource(path.Field("bundle"), trustDomain.GetBundle()))
+		}
+		verr.Add(ValidateTags(path.Field("tags"), trustDomain.GetTags(), ValidateTagsOpts{}))
+	}
 	return verr
 }
 
@@ -255,6 +334,38 @@ func validateMetrics(metrics *mesh_proto.Metrics) validators.ValidationError {
 	if metrics.GetEnabledBackend() != "" && !usedNames[metrics.GetEnabledBackend()] {
 		verr.AddViolation("enabledBackend", "has to be set to one of the backends in the mesh")
 	}
+	verr.AddErrorAt(validators.RootedAt("stats"), validateStats(metrics.GetStats()))
+	return verr
+}
+
+func validateStats(stats *mesh_proto.Metrics_Stats) validators.ValidationError {
+	var verr validators.ValidationError
+	usedNames := map[string]bool{}
+	for i, tag := range stats.GetTags() {
+		path := validators.RootedAt("tags").Index(i)
+		if tag.GetName() == "" {
+			verr.AddViolationAt(path.Field("name"), "cannot be empty")
+		} else if usedNames[tag.GetName()] {
+			verr.AddViolationAt(path.Field("name"), fmt.Sprintf("%q name is already used for another tag", tag.GetName()))
+		}
+		usedNames[tag.GetName()] = true
+		if tag.GetRegex() != "" && tag.GetFixedValue() != "" {
+			verr.AddViolationAt(path, "regex and fixedValue cannot be both specified")
+		}
+		if _, err := regexp.Compile(tag.GetRegex()); err != nil {
+			verr.AddViolationAt(path.Field("regex"), fmt.Sprintf("provided regexp isn't correct: %s", err.Error()))
+		}
+	}
+	var clusters []string
+	for cluster := range stats.GetClusterStatNames() {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+	for _, cluster := range clusters {
+		if stats.GetClusterStatNames()[cluster] == "" {
+			verr.AddViolationAt(validators.RootedAt("clusterStatNames").Key(cluster), "cannot be empty")
+		}
+	}
 	return verr
 }
 
@@ -280,6 +391,96 @@ func validatePrometheusConfig(cfgStr *structpb.Struct) validators.ValidationErro
 	return verr
 }
 
+func validateRouting(routing *mesh_proto.Routing) validators.ValidationError {
+	var verr validators.ValidationError
+	if factor := routing.GetLocalityAwareLoadBalancingOptions().GetOverprovisioningFactor(); factor != nil && factor.GetValue() == 0 {
+		verr.AddViolation("localityAwareLoadBalancingOptions.overprovisioningFactor", "must be greater than 0")
+	}
+	if limit := routing.GetZoneIngress().GetConnectionRateLimit(); limit != nil {
+		if limit.GetConnections() == 0 {
+			verr.AddViolation("zoneIngress.connectionRateLimit.connections", "must be greater than 0")
+		}
+		if limit.GetInterval().AsDuration() <= 0 {
+			verr.AddViolation("zoneIngress.connectionRateLimit.interval", "must be greater than 0")
+		}
+	}
+	if healthCheck := routing.GetZoneIngressHealthCheck(); healthCheck != nil {
+		if healthCheck.GetInterval() != nil && healthCheck.GetInterval().AsDuration() <= 0 {
+			verr.AddViolation("zoneIngressHealthCheck.interval", "must be greater than 0")
+		}
+		if healthCheck.GetTimeout() != nil && healthCheck.GetTimeout().AsDuration() <= 0 {
+			verr.AddViolation("zoneIngressHealthCheck.timeout", "must be greater than 0")
+		}
+		if healthCheck.GetUnhealthyThreshold() != nil && healthCheck.GetUnhealthyThreshold().GetValue() == 0 {
+			verr.AddViolation("zoneIngressHealthCheck.unhealthyThreshold", "must be greater than 0")
+		}
+		if healthCheck.GetHealthyThreshold() != nil && healthCheck.GetHealthyThreshold().GetValue() == 0 {
+			verr.AddViolation("zoneIngressHealthCheck.healthyThreshold", "must be greater than 0")
+		}
+	}
+	return verr
+}
+
+func validateMeshNetworking(networking *mesh_proto.Networking) validators.ValidationError {
+	var verr validators.ValidationError
+	for i, portRange := range networking.GetOutbound().GetPassthroughPorts() {
+		path := validators.RootedAt("outbound").Field("passthroughPorts").Index(i)
+		verr.Add(ValidatePort(path.Field("from"), portRange.GetFrom()))
+		if portRange.GetTo() != 0 {
+			verr.Add(ValidatePort(path.Field("to"), portRange.GetTo()))
+			if portRange.GetTo() < portRange.GetFrom() {
+				verr.AddViolationAt(path.Field("to"), "must be greater than or equal to from")
+			}
+		}
+	}
+	proxy := networking.GetOutbound().GetDynamicForwardProxy()
+	if proxy == nil {
+		return verr
+	}
+	path := validators.RootedAt("outbound").Field("dynamicForwardProxy")
+	if len(proxy.GetDomains()) == 0 {
+		verr.AddViolationAt(path.Field("domains"), "must have at least one element")
+	}
+	for i, domain := range proxy.GetDomains() {
+		if domain == "*" {
+			verr.AddViolationAt(path.Field("domains").Index(i), `cannot be "*", enable passthrough instead`)
+			continue
+		}
+		verr.Add(ValidateHostname(path.Field("domains").Index(i), domain))
+	}
+	if proxy.GetPort() != 0 {
+		verr.Add(ValidatePort(path.Field("port"), proxy.GetPort()))
+	}
+	if proxy.GetMaxHosts() != nil && proxy.GetMaxHosts().GetValue() == 0 {
+		verr.AddViolationAt(path.Field("maxHosts"), "must be greater than 0")
+	}
+	return verr
+}
+
+func validateSidecarResources(resources *mesh_proto.SidecarResources) validators.ValidationError {
+	var verr validators.ValidationError
+	if resources == nil {
+		return verr
+	}
+	if resources.GetMaxHeapSizeBytes() == 0 {
+		verr.AddViolation("maxHeapSizeBytes", "must be greater than 0")
+	}
+	thresholds := []struct {
+		field     string
+		threshold *wrapperspb.DoubleValue
+	}{
+		{"shrinkHeapThreshold", resources.GetShrinkHeapThreshold()},
+		{"disableHttpKeepaliveThreshold", resources.GetDisableHttpKeepaliveThreshold()},
+		{"stopAcceptingConnectionsThreshold", resources.GetStopAcceptingConnectionsThreshold()},
+	}
+	for _, t := range thresholds {
+		if t.threshold != nil && (t.threshold.GetValue() <= 0 || t.threshold.GetValue() > 1) {
+			verr.AddViolation(t.field, "must be in (0, 1] range")
+		}
+	}
+	return verr
+}
+
 func validateZoneEgress(routing *mesh_proto.Routing, mtls *mesh_proto.Mesh_Mtls) validators.ValidationError {
 	var verr validators.ValidationError
 	if routing == nil {
@@ -79,6 +79,16 @@ var _ = Describe("Mesh", func() {
                   - name: sidecar
                     port: 12345
                     path: "/stats/sidecar"
+              stats:
+                tags:
+                - name: envoy_cluster_name
+                - name: tenant
+                  regex: '^cluster\.tenant-((.+?)-)'
+                - name: zone
+                  fixedValue: zone-1
+                useAllDefaultTags: false
+                clusterStatNames:
+                  backend-_0_: backend
             constraints:
               dataplaneProxy:
                 requirements:
@@ -91,6 +101,37 @@ var _ = Describe("Mesh", func() {
                     kuma.io/zone: west
             routing:
               zoneEgress: true
+              localityAwareLoadBalancing: true
+              localityAwareLoadBalancingOptions:
+                overprovisioningFactor: 200
+              zoneIngress:
+                connectionRateLimit:
+                  connections: 100
+                  interval: 1s
+              zoneIngressHealthCheck:
+                interval: 3s
+                timeout: 500ms
+                unhealthyThreshold: 3
+                healthyThreshold: 2
+            networking:
+              outbound:
+                passthrough: false
+                passthroughPorts:
+                - from: 443
+                - from: 8000
+                  to: 8999
+                dynamicForwardProxy:
+                  domains:
+                  - "*.s3.amazonaws.com"
+                  - api.github.com
+                  port: 8443
+                  hostTtl: 1m
+                  maxHosts: 100
+            sidecarResources:
+              maxHeapSizeBytes: 536870912
+              shrinkHeapThreshold: 0.9
+              disableHttpKeepaliveThreshold: 0.95
+              stopAcceptingConnectionsThreshold: 1.0
 `
 			mesh := NewMeshResource()
 
@@ -141,6 +182,32 @@ var _ = Describe("Mesh", func() {
                   message: cannot have more than 1 backends
                 - field: mtls.backends[1].name
                   message: '"backend-1" name is already used for another backend'`,
+			}),
+			Entry("invalid stats", testCase{
+				mesh: `
+                metrics:
+                  stats:
+                    tags:
+                    - regex: '^x\.((.+)\.)'
+                    - name: tenant
+                      regex: '^x\.(('
+                    - name: tenant
+                      regex: '^x\.((.+)\.)'
+                      fixedValue: a
+                    clusterStatNames:
+                      backend-_0_: ""`,
+				expected: `
+                violations:
+                - field: metrics.stats.tags[0].name
+                  message: cannot be empty
+                - field: metrics.stats.tags[1].regex
+                  message: 'provided regexp isn''t correct: error parsing regexp: missing closing ): ` + "`^x\\.((`" + `'
+                - field: metrics.stats.tags[2].name
+                  message: '"tenant" name is already used for another tag'
+                - field: metrics.stats.tags[2]
+                  message: regex and fixedValue cannot be both specified
+                - field: metrics.stats.clusterStatNames["backend-_0_"]
+                  message: cannot be empty`,
 			}),
 			Entry("enabledBackend of unknown name", testCase{
 				mesh: `
@@ -153,6 +220,40 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: mtls.enabledBackend
                   message: has to be set to one of the backends in the mesh`,
+			}),
+			Entry("rotation of unknown backends", testCase{
+				mesh: `
+                mtls:
+                  enabledBackend: backend-1
+                  backends:
+                  - name: backend-1
+                    type: builtin
+                  rotation:
+                    from: backend-2
+                    to: backend-3`,
+				expected: `
+                violations:
+                - field: mtls.rotation.from
+                  message: has to be set to one of the backends in the mesh
+                - field: mtls.rotation.to
+                  message: has to be set to one of the backends in the mesh
+                - field: mtls.enabledBackend
+                  message: has to be set to one of the backends of the rotation`,
+			}),
+			Entry("rotation to the same backend", testCase{
+				mesh: `
+                mtls:
+                  enabledBackend: backend-1
+                  backends:
+                  - name: backend-1
+                    type: builtin
+                  rotation:
+                    from: backend-1
+                    to: backend-1`,
+				expected: `
+                violations:
+                - field: mtls.rotation.to
+                  message: has to be different than from`,
 			}),
 			Entry("dpCert rotation invalid expiration time", testCase{
 				mesh: `
@@ -168,6 +269,97 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: mtls.dpcert.rotation.expiration
                   message: has to be a valid format`,
+			}),
+			Entry("dpCert rotation threshold out of range", testCase{
+				mesh: `
+                mtls:
+                  enabledBackend: backend-3
+                  backends:
+                  - name: backend-3
+                    type: builtin
+                    dpCert:
+                      rotation:
+                        expiration: 1d
+                        threshold: 100`,
+				expected: `
+                violations:
+                - field: mtls.dpcert.rotation.threshold
+                  message: has to be in [1 - 99] range`,
+			}),
+			Entry("dpCert rotation jitter not lower than threshold", testCase{
+				mesh: `
+                mtls:
+                  enabledBackend: backend-3
+                  backends:
+                  - name: backend-3
+                    type: builtin
+                    dpCert:
+                      rotation:
+                        expiration: 1d
+                        threshold: 50
+                        jitter: 50`,
+				expected: `
+                violations:
+                - field: mtls.dpcert.rotation.jitter
+                  message: has to be lower than threshold`,
+			}),
+			Entry("invalid revocations", testCase{
+				mesh: `
+                mtls:
+                  enabledBackend: backend-1
+                  backends:
+                  - name: backend-1
+                    type: builtin
+                  revocations:
+                  - expiration: "2022-01-01T00:00:00Z"
+                  - serialNumber: 2a
+                    spiffeId: spiffe://default/web
+                  - serialNumber: xyz
+                  - spiffeId: default/web`,
+				expected: `
+                violations:
+                - field: mtls.revocations[0]
+                  message: has to define either serialNumber or spiffeId
+                - field: mtls.revocations[1]
+                  message: has to define either serialNumber or spiffeId
+                - field: mtls.revocations[2].serialNumber
+                  message: has to be a valid serial number in hex format
+                - field: mtls.revocations[3].spiffeId
+                  message: has to be a valid SPIFFE ID`,
+			}),
+			Entry("invalid federated trust domains", testCase{
+				mesh: `
+                mtls:
+                  enabledBackend: backend-1
+                  backends:
+                  - name: backend-1
+                    type: builtin
+                  federatedTrustDomains:
+                  - name: spiffe://example.org
+                    bundle:
+                      inline: dGVzdA==
+                  - name: example.org
+                    bundle:
+                      inline: dGVzdA==
+                    tags:
+                      kuma.io/service: spire
+                  - name: example.org
+                  - name: other.org
+                    bundle: {}
+                    tags:
+                      kuma.io/service: "*"`,
+				expected: `
+                violations:
+                - field: mtls.federatedTrustDomains[0].name
+                  message: has to be a valid SPIFFE trust domain
+                - field: mtls.federatedTrustDomains[2].name
+                  message: '"example.org" trust domain is already federated'
+                - field: mtls.federatedTrustDomains[2].bundle
+                  message: has to be defined
+                - field: mtls.federatedTrustDomains[3].bundle
+                  message: data source cannot be empty
+                - field: mtls.federatedTrustDomains[3].tags["kuma.io/service"]
+                  message: tag value must consist of alphanumeric characters, dots, dashes and underscores`,
 			}),
 			Entry("logging backend with empty name", testCase{
 				mesh: `
@@ -474,6 +666,19 @@ var _ = Describe("Mesh", func() {
                 - field: constraints.dataplaneProxy.restrictions[2].tags["!@#$"]
                   message: tag value must consist of alphanumeric characters, dots, dashes, slashes
                     and underscores or be "*"`,
+			}),
+			Entry("envoy runtime with zero values", testCase{
+				mesh: `
+                envoyRuntime:
+                  concurrency: 0
+                  perConnectionBufferLimitBytes: 0
+`,
+				expected: `
+                violations:
+                - field: envoyRuntime.concurrency
+                  message: must be greater than 0
+                - field: envoyRuntime.perConnectionBufferLimitBytes
+                  message: must be greater than 0`,
 			}),
 			Entry("multiple errors", testCase{
 				mesh: `
@@ -548,6 +753,121 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: mtls
                   message: has to be set when zoneEgress enabled`,
+			}),
+			Entry("locality aware load balancing with zero overprovisioning factor", testCase{
+				mesh: `
+                routing:
+                  localityAwareLoadBalancing: true
+                  localityAwareLoadBalancingOptions:
+                    overprovisioningFactor: 0`,
+				expected: `
+                violations:
+                - field: routing.localityAwareLoadBalancingOptions.overprovisioningFactor
+                  message: must be greater than 0`,
+			}),
+			Entry("zone ingress connection rate limit with zero values", testCase{
+				mesh: `
+                routing:
+                  zoneIngress:
+                    connectionRateLimit:
+                      connections: 0
+                      interval: 0s`,
+				expected: `
+                violations:
+                - field: routing.zoneIngress.connectionRateLimit.connections
+                  message: must be greater than 0
+                - field: routing.zoneIngress.connectionRateLimit.interval
+                  message: must be greater than 0`,
+			}),
+			Entry("zone ingress health check with zero values", testCase{
+				mesh: `
+                routing:
+                  zoneIngressHealthCheck:
+                    interval: 0s
+                    timeout: 0s
+                    unhealthyThreshold: 0
+                    healthyThreshold: 0`,
+				expected: `
+                violations:
+                - field: routing.zoneIngressHealthCheck.interval
+                  message: must be greater than 0
+                - field: routing.zoneIngressHealthCheck.timeout
+                  message: must be greater than 0
+                - field: routing.zoneIngressHealthCheck.unhealthyThreshold
+                  message: must be greater than 0
+                - field: routing.zoneIngressHealthCheck.healthyThreshold
+                  message: must be greater than 0`,
+			}),
+			Entry("dynamic forward proxy with invalid values", testCase{
+				mesh: `
+                networking:
+                  outbound:
+                    dynamicForwardProxy:
+                      domains:
+                      - "*"
+                      - "*.*.amazonaws.com"
+                      - "api_github.com"
+                      port: 65536
+                      maxHosts: 0`,
+				expected: `
+                violations:
+                - field: networking.outbound.dynamicForwardProxy.domains[0]
+                  message: cannot be "*", enable passthrough instead
+                - field: networking.outbound.dynamicForwardProxy.domains[1]
+                  message: invalid wildcard domain
+                - field: networking.outbound.dynamicForwardProxy.domains[2]
+                  message: invalid hostname
+                - field: networking.outbound.dynamicForwardProxy.port
+                  message: port must be in the range [1, 65535]
+                - field: networking.outbound.dynamicForwardProxy.maxHosts
+                  message: must be greater than 0`,
+			}),
+			Entry("passthrough ports with invalid ranges", testCase{
+				mesh: `
+                networking:
+                  outbound:
+                    passthroughPorts:
+                    - from: 0
+                    - from: 8080
+                      to: 70000
+                    - from: 9000
+                      to: 8000`,
+				expected: `
+                violations:
+                - field: networking.outbound.passthroughPorts[0].from
+                  message: port must be in the range [1, 65535]
+                - field: networking.outbound.passthroughPorts[1].to
+                  message: port must be in the range [1, 65535]
+                - field: networking.outbound.passthroughPorts[2].to
+                  message: must be greater than or equal to from`,
+			}),
+			Entry("sidecar resources with invalid values", testCase{
+				mesh: `
+                sidecarResources:
+                  shrinkHeapThreshold: 0
+                  disableHttpKeepaliveThreshold: 1.5
+                  stopAcceptingConnectionsThreshold: -0.5`,
+				expected: `
+                violations:
+                - field: sidecarResources.maxHeapSizeBytes
+                  message: must be greater than 0
+                - field: sidecarResources.shrinkHeapThreshold
+                  message: must be in (0, 1] range
+                - field: sidecarResources.disableHttpKeepaliveThreshold
+                  message: must be in (0, 1] range
+                - field: sidecarResources.stopAcceptingConnectionsThreshold
+                  message: must be in (0, 1] range`,
+			}),
+			Entry("dynamic forward proxy without domains", testCase{
+				mesh: `
+                networking:
+                  outbound:
+                    dynamicForwardProxy:
+                      port: 443`,
+				expected: `
+                violations:
+                - field: networking.outbound.dynamicForwardProxy.domains
+                  message: must have at least one element`,
 			}),
 			Entry("metrics aggregate configuration contains duplicate entries", testCase{
 				mesh: `
@@ -0,0 +1,13 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// GetConf returns configuration of the protocol options policy.
+func (p *MeshProtocolOptionsResource) GetConf() *mesh_proto.MeshProtocolOptions_Conf {
+	if p == nil {
+		return nil
+	}
+	return p.Spec.GetConf()
+}
@@ -0,0 +1,75 @@
+package mesh
+
+import (
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+const (
+	minHttp2WindowSize = 65535
+	maxHttp2WindowSize = 2147483647
+)
+
+func (p *MeshProtocolOptionsResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(p.validateSources())
+	err.Add(p.validateDestinations())
+	err.Add(p.validateConf())
+	return err.OrNil()
+}
+
+func (p *MeshProtocolOptionsResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("sources"), p.Spec.Sources, ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+			RequireService:       true,
+		},
+	})
+}
+
+func (p *MeshProtocolOptionsResource) validateDestinations() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("destinations"), p.Spec.Destinations, OnlyServiceTagAllowed)
+}
+
+func (p *MeshProtocolOptionsResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	conf := p.Spec.GetConf()
+	if conf == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	if conf.GetUpstreamProtocol() == mesh_proto.MeshProtocolOptions_Conf_DEFAULT &&
+		conf.GetIdleTimeout() == nil && conf.GetMaxRequestsPerConnection() == nil &&
+		conf.GetHttp1() == nil && conf.GetHttp2() == nil {
+		err.AddViolationAt(root, "must have at least one of the upstreamProtocol, idleTimeout, maxRequestsPerConnection, http1 or http2 configured")
+		return
+	}
+	if conf.GetMaxRequestsPerConnection() != nil && conf.GetMaxRequestsPerConnection().GetValue() == 0 {
+		err.AddViolationAt(root.Field("maxRequestsPerConnection"), "must be greater than 0")
+	}
+	if conf.GetHttp1().GetDisableKeepAlive() && conf.GetMaxRequestsPerConnection() != nil {
+		err.AddViolationAt(root.Field("http1").Field("disableKeepAlive"), "cannot be used together with maxRequestsPerConnection")
+	}
+	if http2 := conf.GetHttp2(); http2 != nil {
+		path := root.Field("http2")
+		if http2.GetMaxConcurrentStreams() != nil && http2.GetMaxConcurrentStreams().GetValue() == 0 {
+			err.AddViolationAt(path.Field("maxConcurrentStreams"), "must be greater than 0")
+		}
+		err.Add(validateHttp2WindowSize(path.Field("initialStreamWindowSize"), http2.GetInitialStreamWindowSize()))
+		err.Add(validateHttp2WindowSize(path.Field("initialConnectionWindowSize"), http2.GetInitialConnectionWindowSize()))
+	}
+	return
+}
+
+func validateHttp2WindowSize(path validators.PathBuilder, size *wrapperspb.UInt32Value) (err validators.ValidationError) {
+	if size == nil {
+		return
+	}
+	if size.GetValue() < minHttp2WindowSize || size.GetValue() > maxHttp2WindowSize {
+		err.AddViolationAt(path, "must be in inclusive range [65535, 2147483647]")
+	}
+	return
+}
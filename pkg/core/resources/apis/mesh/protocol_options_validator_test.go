@@ -0,0 +1,147 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshProtocolOptions", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(optionsYAML string) {
+				// setup
+				options := NewMeshProtocolOptionsResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(optionsYAML), options.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := options.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full example", `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  upstreamProtocol: HTTP2
+                  idleTimeout: 30s
+                  maxRequestsPerConnection: 100
+                  http1:
+                    properCaseHeaders: true
+                    enableTrailers: true
+                  http2:
+                    maxConcurrentStreams: 128
+                    initialStreamWindowSize: 65535
+                    initialConnectionWindowSize: 1048576`,
+			),
+			Entry("keep-alive disabled", `
+                sources:
+                - match:
+                    kuma.io/service: '*'
+                destinations:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  http1:
+                    disableKeepAlive: true`,
+			),
+		)
+
+		type testCase struct {
+			options  string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				options := NewMeshProtocolOptionsResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.options), options.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := options.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				options: ``,
+				expected: `
+                violations:
+                - field: sources
+                  message: must have at least one element
+                - field: destinations
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("empty conf", testCase{
+				options: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf: {}
+`,
+				expected: `
+                violations:
+                - field: conf
+                  message: must have at least one of the upstreamProtocol, idleTimeout, maxRequestsPerConnection, http1 or http2 configured
+`,
+			}),
+			Entry("invalid conf", testCase{
+				options: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  maxRequestsPerConnection: 0
+                  http1:
+                    disableKeepAlive: true
+                  http2:
+                    maxConcurrentStreams: 0
+                    initialStreamWindowSize: 1024
+                    initialConnectionWindowSize: 4294967295
+`,
+				expected: `
+                violations:
+                - field: conf.maxRequestsPerConnection
+                  message: must be greater than 0
+                - field: conf.http1.disableKeepAlive
+                  message: cannot be used together with maxRequestsPerConnection
+                - field: conf.http2.maxConcurrentStreams
+                  message: must be greater than 0
+                - field: conf.http2.initialStreamWindowSize
+                  message: must be in inclusive range [65535, 2147483647]
+                - field: conf.http2.initialConnectionWindowSize
+                  message: must be in inclusive range [65535, 2147483647]
+`,
+			}),
+		)
+	})
+})
@@ -13,8 +13,12 @@ import (
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
 	"github.com/kumahq/kuma/pkg/util/envoy"
+	"github.com/kumahq/kuma/pkg/util/lua"
 )
 
+// maxLuaScriptSize is a limit of a Lua script, bigger scripts should be built as WASM plugins.
+const maxLuaScriptSize = 64 * 1024
+
 var AvailableProfiles map[string]struct{}
 
 func init() {
@@ -55,6 +59,51 @@ func validateModification(modification *mesh_proto.ProxyTemplate_Modifications)
 		verr.AddError("httpFilter", validateHTTPFilterModification(modification.GetHttpFilter()))
 	case *mesh_proto.ProxyTemplate_Modifications_VirtualHost_:
 		verr.AddError("virtualHost", validateVirtualHostModification(modification.GetVirtualHost()))
+	case *mesh_proto.ProxyTemplate_Modifications_Lua_:
+		verr.AddError("lua", validateLuaModification(modification.GetLua()))
+	}
+	return verr
+}
+
+func validateLuaModification(luaMod *mesh_proto.ProxyTemplate_Modifications_Lua) validators.ValidationError {
+	verr := validators.ValidationError{}
+	if luaMod.GetName() == "" {
+		verr.AddViolation("name", "cannot be empty")
+	}
+	verr.Add(validateLuaScript(validators.RootedAt("script"), luaMod.GetScript()))
+	for i, override := range luaMod.GetRouteOverrides() {
+		path := validators.RootedAt("routeOverrides").Index(i)
+		if override.GetMatch().GetVirtualHostName() == "" {
+			verr.AddViolationAt(path.Field("match").Field("virtualHostName"), "cannot be empty")
+		}
+		switch {
+		case override.GetDisabled() && override.GetScript() != "":
+			verr.AddViolationAt(path.Field("script"), "cannot be defined when the filter is disabled")
+		case override.GetScript() != "":
+			verr.Add(validateLuaScript(path.Field("script"), override.GetScript()))
+		case !override.GetDisabled():
+			verr.AddViolationAt(path, "either disabled or script has to be defined")
+		}
+	}
+	return verr
+}
+
+func validateLuaScript(path validators.PathBuilder, script string) validators.ValidationError {
+	verr := validators.ValidationError{}
+	if script == "" {
+		verr.AddViolationAt(path, "cannot be empty")
+		return verr
+	}
+	if len(script) > maxLuaScriptSize {
+		verr.AddViolationAt(path, fmt.Sprintf("cannot be bigger than %d bytes", maxLuaScriptSize))
+		return verr
+	}
+	if err := lua.ValidateSyntax(script); err != nil {
+		verr.AddViolationAt(path, fmt.Sprintf("has to be a valid Lua script: %s", err.Error()))
+		return verr
+	}
+	if !lua.DefinesFunction(script, "envoy_on_request") && !lua.DefinesFunction(script, "envoy_on_response") {
+		verr.AddViolationAt(path, "has to define envoy_on_request or envoy_on_response function")
 	}
 	return verr
 }
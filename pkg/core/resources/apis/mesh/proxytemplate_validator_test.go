@@ -160,6 +160,7 @@ var _ = Describe("ProxyTemplate", func() {
                         typedConfig:
                           '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
                           cluster: backend
+                          statPrefix: backend
                   - networkFilter:
                       operation: addLast
                       value: |
@@ -167,6 +168,7 @@ var _ = Describe("ProxyTemplate", func() {
                         typedConfig:
                           '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
                           cluster: backend
+                          statPrefix: backend
                   - networkFilter:
                       operation: addBefore
                       match:
@@ -176,6 +178,7 @@ var _ = Describe("ProxyTemplate", func() {
                         typedConfig:
                           '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
                           cluster: backend
+                          statPrefix: backend
                   - networkFilter:
                       operation: addAfter
                       match:
@@ -186,6 +189,7 @@ var _ = Describe("ProxyTemplate", func() {
                         typedConfig:
                           '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
                           cluster: backend
+                          statPrefix: backend
                   - networkFilter:
                       operation: patch
                       match:
@@ -301,6 +305,36 @@ var _ = Describe("ProxyTemplate", func() {
                       operation: remove
                       match:
                         origin: inbound
+                  `,
+			),
+			Entry("lua modifications", `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  modifications:
+                  - lua:
+                      name: add-header
+                      match:
+                        origin: inbound
+                        listenerTags:
+                          kuma.io/service: backend
+                      script: |
+                        function envoy_on_request(request_handle)
+                          request_handle:headers():add("x-lua", "true")
+                        end
+                      routeOverrides:
+                      - match:
+                          virtualHostName: backend
+                          prefix: /health
+                        disabled: true
+                      - match:
+                          routeConfigurationName: inbound:backend
+                          virtualHostName: backend
+                        script: |
+                          function envoy_on_response(response_handle)
+                            response_handle:headers():add("x-lua", "override")
+                          end
                   `,
 			),
 		)
@@ -555,6 +589,49 @@ var _ = Describe("ProxyTemplate", func() {
                   message: cannot be empty
                 - field: conf.modifications[3].networkFilter.value
                   message: 'native Envoy resource is not valid: unexpected EOF'`,
+			}),
+			Entry("invalid typed configs", testCase{
+				proxyTemplate: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  modifications:
+                  - networkFilter:
+                      operation: addFirst
+                      value: |
+                        name: envoy.filters.network.tcp_proxy
+                        typedConfig:
+                          '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                          cluster: backend
+                  - listener:
+                      operation: add
+                      value: |
+                        name: inbound:192.168.0.1:8080
+                        address:
+                          socketAddress:
+                            address: 192.168.0.1
+                            portValue: 8080
+                        filterChains:
+                        - filters:
+                          - name: envoy.filters.network.http_connection_manager
+                            typedConfig:
+                              '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                              statPrefix: backend
+                              routeConfig:
+                                name: backend
+                              httpFilters:
+                              - name: envoy.filters.http.lua
+                                typedConfig:
+                                  '@type': type.googleapis.com/envoy.extensions.filters.http.lua.v3.Lua
+                                  inlineCode: ""
+`,
+				expected: `
+                violations:
+                - field: conf.modifications[0].networkFilter.value
+                  message: 'native Envoy resource is not valid: typedConfig: invalid TcpProxy.StatPrefix: value length must be at least 1 runes'
+                - field: conf.modifications[1].listener.value
+                  message: 'native Envoy resource is not valid: filterChains[0].filters[0].typedConfig.httpFilters[0].typedConfig: invalid Lua.InlineCode: value length must be at least 1 runes'`,
 			}),
 			Entry("invalid http filter operation", testCase{
 				proxyTemplate: `
@@ -622,6 +699,52 @@ var _ = Describe("ProxyTemplate", func() {
                 - field: conf.modifications[2].virtualHost.value
                   message: 'native Envoy resource is not valid: unexpected EOF'`,
 			}),
+			Entry("invalid lua modifications", testCase{
+				proxyTemplate: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  modifications:
+                  - lua:
+                      script: |
+                        function envoy_on_request(request_handle)
+                          if true then
+                        end
+                  - lua:
+                      name: no-handler
+                      script: |
+                        function on_request(request_handle)
+                        end
+                      routeOverrides:
+                      - match:
+                          prefix: /
+                        disabled: true
+                        script: |
+                          function envoy_on_request(request_handle)
+                          end
+                      - match:
+                          virtualHostName: backend
+                  - lua:
+                      name: empty
+`,
+				expected: `
+                violations:
+                - field: conf.modifications[0].lua.name
+                  message: cannot be empty
+                - field: conf.modifications[0].lua.script
+                  message: 'has to be a valid Lua script: ''end'' expected (to close ''function'' at line 1) near <eof>'
+                - field: conf.modifications[1].lua.script
+                  message: has to define envoy_on_request or envoy_on_response function
+                - field: conf.modifications[1].lua.routeOverrides[0].match.virtualHostName
+                  message: cannot be empty
+                - field: conf.modifications[1].lua.routeOverrides[0].script
+                  message: cannot be defined when the filter is disabled
+                - field: conf.modifications[1].lua.routeOverrides[1]
+                  message: either disabled or script has to be defined
+                - field: conf.modifications[2].lua.script
+                  message: cannot be empty`,
+			}),
 		)
 	})
 })
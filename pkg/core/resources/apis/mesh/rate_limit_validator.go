@@ -43,6 +43,10 @@ func (d *RateLimitResource) validateConf() (err validators.ValidationError) {
 		err.Add(d.validateHttp(root.Field("http"), d.Spec.GetConf().GetHttp()))
 	}
 
+	if d.Spec.GetConf().GetGlobal() != nil {
+		err.Add(d.validateGlobal(root.Field("global"), d.Spec.GetConf().GetGlobal()))
+	}
+
 	return
 }
 
@@ -73,3 +77,44 @@ func (d *RateLimitResource) validateOnRateLimit(path validators.PathBuilder, onR
 	}
 	return
 }
+
+func (d *RateLimitResource) validateGlobal(path validators.PathBuilder, global *v1alpha1.RateLimit_Conf_Global) (err validators.ValidationError) {
+	err.Add(validateExternalServiceAddress(path, global.GetAddress()))
+
+	if global.GetDomain() == "" {
+		err.AddViolationAt(path.Field("domain"), "domain must be set")
+	}
+
+	if global.GetTimeout() != nil {
+		err.Add(ValidateDuration(path.Field("timeout"), global.GetTimeout()))
+	}
+
+	if len(global.GetDescriptors()) == 0 {
+		err.AddViolationAt(path.Field("descriptors"), "at least one descriptor must be set")
+	}
+	for i, descriptor := range global.GetDescriptors() {
+		descriptorPath := path.Field("descriptors").Index(i)
+		if len(descriptor.GetEntries()) == 0 {
+			err.AddViolationAt(descriptorPath.Field("entries"), "at least one entry must be set")
+		}
+		for j, entry := range descriptor.GetEntries() {
+			entryPath := descriptorPath.Field("entries").Index(j)
+			if entry.GetKey() == "" {
+				err.AddViolationAt(entryPath.Field("key"), "key must be set")
+			}
+			switch entry.GetSource().(type) {
+			case *v1alpha1.RateLimit_Conf_Global_Descriptor_Entry_Value:
+				if entry.GetValue() == "" {
+					err.AddViolationAt(entryPath.Field("value"), "value must be set")
+				}
+			case *v1alpha1.RateLimit_Conf_Global_Descriptor_Entry_Header:
+				if entry.GetHeader() == "" {
+					err.AddViolationAt(entryPath.Field("header"), "header must be set")
+				}
+			default:
+				err.AddViolationAt(entryPath, "either value or header must be set")
+			}
+		}
+	}
+	return
+}
@@ -70,6 +70,25 @@ var _ = Describe("RateLimit", func() {
                         - key: "x-kuma-rate-limit"
                           value: "true"
                           append: true`),
+			Entry("global", `
+                sources:
+                - match:
+                    kuma.io/service: '*'
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  global:
+                    address: ratelimit.mesh:8081
+                    domain: kuma
+                    timeout: 50ms
+                    failureModeDeny: true
+                    descriptors:
+                    - entries:
+                      - key: destination
+                        value: backend
+                      - key: user
+                        header: x-user-id`),
 		)
 
 		type testCase struct {
@@ -212,6 +231,50 @@ var _ = Describe("RateLimit", func() {
                   message: key must be set
                 - field: conf.http.onRateLimit.header["0"]
                   message: value must be set
+`,
+			}),
+			Entry("global", testCase{
+				ratelimit: `
+                sources:
+                - match:
+                    kuma.io/service: '*'
+                destinations:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  global:
+                    address: ratelimit.mesh
+                    timeout: 0s
+                    descriptors:
+                    - entries: []
+                    - entries:
+                      - value: backend
+                      - key: user
+                      - key: user
+                        header: ""
+`,
+				expected: `
+                violations:
+                - field: conf.global.address
+                  message: unable to parse address
+                - field: conf.global.address
+                  message: address has to be a valid IP address or a domain name
+                - field: conf.global.address
+                  message: unable to parse port in address
+                - field: conf.global.address
+                  message: port must be in the range [1, 65535]
+                - field: conf.global.domain
+                  message: domain must be set
+                - field: conf.global.timeout
+                  message: must have a positive value
+                - field: conf.global.descriptors[0].entries
+                  message: at least one entry must be set
+                - field: conf.global.descriptors[1].entries[0].key
+                  message: key must be set
+                - field: conf.global.descriptors[1].entries[1]
+                  message: either value or header must be set
+                - field: conf.global.descriptors[1].entries[2].header
+                  message: header must be set
 `,
 			}),
 		)
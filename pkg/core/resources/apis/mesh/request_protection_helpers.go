@@ -0,0 +1,13 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// GetConf returns configuration of the request protection policy.
+func (r *MeshRequestProtectionResource) GetConf() *mesh_proto.MeshRequestProtection_Conf {
+	if r == nil {
+		return nil
+	}
+	return r.Spec.GetConf()
+}
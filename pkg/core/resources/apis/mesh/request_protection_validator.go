@@ -0,0 +1,66 @@
+package mesh
+
+import (
+	"regexp"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (d *MeshRequestProtectionResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(d.validateSelectors())
+	err.Add(d.validateConf())
+	return err.OrNil()
+}
+
+func (d *MeshRequestProtectionResource) validateSelectors() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("selectors"), d.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (d *MeshRequestProtectionResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	conf := d.Spec.GetConf()
+	if conf == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	if conf.GetCsrf() == nil && conf.GetMaxRequestBytes() == nil {
+		err.AddViolationAt(root, "either csrf or maxRequestBytes has to be defined")
+		return
+	}
+	for i, origin := range conf.GetCsrf().GetAdditionalOrigins() {
+		err.Add(validateCsrfOrigin(root.Field("csrf").Field("additionalOrigins").Index(i), origin))
+	}
+	if conf.GetMaxRequestBytes() != nil && conf.GetMaxRequestBytes().GetValue() == 0 {
+		err.AddViolationAt(root.Field("maxRequestBytes"), "must be greater than 0")
+	}
+	return
+}
+
+func validateCsrfOrigin(path validators.PathBuilder, origin *mesh_proto.MeshRequestProtection_Conf_Csrf_Origin) (err validators.ValidationError) {
+	switch match := origin.GetMatch().(type) {
+	case *mesh_proto.MeshRequestProtection_Conf_Csrf_Origin_Exact:
+		if match.Exact == "" {
+			err.AddViolationAt(path.Field("exact"), "cannot be empty")
+		}
+	case *mesh_proto.MeshRequestProtection_Conf_Csrf_Origin_Prefix:
+		if match.Prefix == "" {
+			err.AddViolationAt(path.Field("prefix"), "cannot be empty")
+		}
+	case *mesh_proto.MeshRequestProtection_Conf_Csrf_Origin_Regex:
+		if match.Regex == "" {
+			err.AddViolationAt(path.Field("regex"), "cannot be empty")
+		} else if _, e := regexp.Compile(match.Regex); e != nil {
+			err.AddViolationAt(path.Field("regex"), "has to be a valid regular expression")
+		}
+	default:
+		err.AddViolationAt(path, "either exact, prefix or regex has to be defined")
+	}
+	return
+}
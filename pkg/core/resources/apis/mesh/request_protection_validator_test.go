@@ -0,0 +1,133 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshRequestProtection", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(protectionYAML string) {
+				// setup
+				protection := NewMeshRequestProtectionResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(protectionYAML), protection.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := protection.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full example", `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  csrf:
+                    additionalOrigins:
+                    - exact: https://example.com
+                    - prefix: https://app.
+                    - regex: https://.*\.example\.com
+                    shadow: true
+                  maxRequestBytes: 1048576`,
+			),
+			Entry("csrf only", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  csrf: {}`,
+			),
+			Entry("max request bytes only", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  maxRequestBytes: 1024`,
+			),
+		)
+
+		type testCase struct {
+			protection string
+			expected   string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				protection := NewMeshRequestProtectionResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.protection), protection.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := protection.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				protection: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("empty conf", testCase{
+				protection: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf: {}
+`,
+				expected: `
+                violations:
+                - field: conf
+                  message: either csrf or maxRequestBytes has to be defined
+`,
+			}),
+			Entry("invalid conf", testCase{
+				protection: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  csrf:
+                    additionalOrigins:
+                    - {}
+                    - exact: ''
+                    - regex: '(https://'
+                  maxRequestBytes: 0
+`,
+				expected: `
+                violations:
+                - field: conf.csrf.additionalOrigins[0]
+                  message: either exact, prefix or regex has to be defined
+                - field: conf.csrf.additionalOrigins[1].exact
+                  message: cannot be empty
+                - field: conf.csrf.additionalOrigins[2].regex
+                  message: has to be a valid regular expression
+                - field: conf.maxRequestBytes
+                  message: must be greater than 0
+`,
+			}),
+		)
+	})
+})
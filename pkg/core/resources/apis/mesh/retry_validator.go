@@ -264,6 +264,24 @@ func validateConfTcp(
 	return
 }
 
+func validateConfRetryBudget(
+	path validators.PathBuilder,
+	conf *mesh_proto.Retry_Conf_RetryBudget,
+) (err validators.ValidationError) {
+	if conf == nil {
+		return
+	}
+
+	if percent := conf.BudgetPercent; percent != nil && (percent.Value < 0.0 || percent.Value > 100.0) {
+		err.AddViolationAt(
+			path.Field("budgetPercent"),
+			"has to be in [0.0 - 100.0] range",
+		)
+	}
+
+	return
+}
+
 func (r *RetryResource) validateConf() (err validators.ValidationError) {
 	path := validators.RootedAt("conf")
 	conf := r.Spec.GetConf()
@@ -284,6 +302,7 @@ func (r *RetryResource) validateConf() (err validators.ValidationError) {
 	err.Add(validateConfHttp(path.Field("http"), conf.GetHttp()))
 	err.Add(validateConfGrpc(path.Field("grpc"), conf.GetGrpc()))
 	err.Add(validateConfTcp(path.Field("tcp"), conf.GetTcp()))
+	err.Add(validateConfRetryBudget(path.Field("retryBudget"), conf.GetRetryBudget()))
 
 	return
 }
@@ -163,6 +163,27 @@ var _ = Describe("Retry", func() {
                   message: has to be defined
                 - field: conf.http.retriableMethods[0]
                   message: field cannot be empty
+`,
+			}),
+			Entry("invalid conf.retryBudget", testCase{
+				retry: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                    region: eu
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                    http:
+                        numRetries: 3
+                    retryBudget:
+                        budgetPercent: 120
+`,
+				expected: `
+                violations:
+                - field: conf.retryBudget.budgetPercent
+                  message: has to be in [0.0 - 100.0] range
 `,
 			}),
 			Entry("empty conf.grpc", testCase{
@@ -328,6 +349,9 @@ var _ = Describe("Retry", func() {
                         - unavailable
                     tcp:
                         maxConnectAttempts: 5
+                    retryBudget:
+                        budgetPercent: 25.5
+                        minRetryConcurrency: 5
 `,
 			}),
 		)
@@ -1,6 +1,8 @@
 package mesh
 
 import (
+	"math/big"
+
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
 )
@@ -46,6 +48,19 @@ func (d *TrafficRouteResource) validateHTTP(pathBuilder validators.PathBuilder,
 	err.Add(d.validateHTTPMatch(pathBuilder.Field("match"), http.GetMatch()))
 	err.Add(d.validateHTTPModify(pathBuilder.Field("modify"), http.GetModify(), http.GetMatch()))
 	err.Add(d.validateSplitAndDestination(pathBuilder, http.GetSplit(), http.GetDestination()))
+	if http.GetMirror() != nil {
+		err.Add(d.validateHTTPMirror(pathBuilder.Field("mirror"), http.GetMirror()))
+	}
+	return
+}
+
+func (d *TrafficRouteResource) validateHTTPMirror(pathBuilder validators.PathBuilder, mirror *mesh_proto.TrafficRoute_Http_Mirror) (err validators.ValidationError) {
+	err.Add(d.validateDestination(pathBuilder.Field("destination"), mirror.GetDestination()))
+	if percentage := mirror.GetPercentage(); percentage != nil {
+		if percentage.GetValue() < 0.0 || percentage.GetValue() > 100.0 {
+			err.AddViolationAt(pathBuilder.Field("percentage"), "has to be in [0.0 - 100.0] range")
+		}
+	}
 	return
 }
 
@@ -248,6 +263,33 @@ func (d *TrafficRouteResource) validateLb() (err validators.ValidationError) {
 			root := validators.RootedAt("conf.loadBalancer.ringHash.hashFunction")
 			err.AddViolationAt(root, "must have a valid hash function")
 		}
+
+	case *mesh_proto.TrafficRoute_LoadBalancer_Maglev_:
+		if tableSize := lb.GetMaglev().GetTableSize(); tableSize > 0 && !big.NewInt(0).SetUint64(tableSize).ProbablyPrime(0) {
+			root := validators.RootedAt("conf.loadBalancer.maglev.tableSize")
+			err.AddViolationAt(root, "must be a prime number")
+		}
+	}
+
+	root := validators.RootedAt("conf.loadBalancer.hashPolicies")
+	if len(lb.GetHashPolicies()) > 0 && lb.GetRingHash() == nil && lb.GetMaglev() == nil {
+		err.AddViolationAt(root, "can be used only with ringHash or maglev load balancer")
+	}
+	for i, policy := range lb.GetHashPolicies() {
+		path := root.Index(i)
+		switch policy.GetPolicySpecifier().(type) {
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Header_:
+			if policy.GetHeader().GetName() == "" {
+				err.AddViolationAt(path.Field("header").Field("name"), "cannot be empty")
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Cookie_:
+			if policy.GetCookie().GetName() == "" {
+				err.AddViolationAt(path.Field("cookie").Field("name"), "cannot be empty")
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp_:
+		default:
+			err.AddViolationAt(path, "has to have one of header, cookie or sourceIp defined")
+		}
 	}
 	return
 }
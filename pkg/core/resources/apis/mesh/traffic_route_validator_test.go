@@ -66,6 +66,15 @@ var _ = Describe("TrafficRoute", func() {
                   loadBalancer:
                     ringHash:
                       hashFunction: XX_HASH
+                    hashPolicies:
+                    - header:
+                        name: x-user-id
+                    - cookie:
+                        name: session
+                        ttl: 1h
+                        path: /
+                      terminal: true
+                    - sourceIp: {}
                   http:
                   - match:
                       path:
@@ -129,6 +138,28 @@ var _ = Describe("TrafficRoute", func() {
                       destination:
                         kuma.io/service: backend`,
 			),
+			Entry("example with http mirror", `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http:
+                  - match:
+                      path:
+                        prefix: "/offers"
+                    mirror:
+                      destination:
+                        kuma.io/service: offers
+                        version: v2
+                      percentage: 12.5
+                    destination:
+                      kuma.io/service: offers
+                  destination:
+                    kuma.io/service: backend`,
+			),
 		)
 
 		type testCase struct {
@@ -299,6 +330,60 @@ var _ = Describe("TrafficRoute", func() {
                 violations:
                 - field: conf.loadBalancer.ringHash.hashFunction
                   message: must have a valid hash function
+`,
+			}),
+			Entry("hash policies with non consistent hashing load balancer", testCase{
+				route: `
+                sources:
+                - match:
+                    kuma.io/service: '*'
+                destinations:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  destination:
+                    kuma.io/service: 'backend'
+                  loadBalancer:
+                    roundRobin: {}
+                    hashPolicies:
+                    - sourceIp: {}
+`,
+				expected: `
+                violations:
+                - field: conf.loadBalancer.hashPolicies
+                  message: can be used only with ringHash or maglev load balancer
+`,
+			}),
+			Entry("invalid hash policies and maglev table size", testCase{
+				route: `
+                sources:
+                - match:
+                    kuma.io/service: '*'
+                destinations:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  destination:
+                    kuma.io/service: 'backend'
+                  loadBalancer:
+                    maglev:
+                      tableSize: 100
+                    hashPolicies:
+                    - header: {}
+                    - cookie:
+                        ttl: 1h
+                    - terminal: true
+`,
+				expected: `
+                violations:
+                - field: conf.loadBalancer.maglev.tableSize
+                  message: must be a prime number
+                - field: conf.loadBalancer.hashPolicies[0].header.name
+                  message: cannot be empty
+                - field: conf.loadBalancer.hashPolicies[1].cookie.name
+                  message: cannot be empty
+                - field: conf.loadBalancer.hashPolicies[2]
+                  message: has to have one of header, cookie or sourceIp defined
 `,
 			}),
 			Entry("cannot define both split and destination", testCase{
@@ -645,6 +730,44 @@ var _ = Describe("TrafficRoute", func() {
                 - field: conf.http[0].modify.responseHeaders.remove[0].name
                   message: host header and HTTP/2 pseudo-headers are not allowed to be modified`,
 			}),
+			Entry("http - invalid mirror", testCase{
+				route: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  http:
+                  - match:
+                      path:
+                        prefix: "/offers"
+                    mirror:
+                      destination:
+                        version: v2
+                      percentage: 150
+                    destination:
+                      kuma.io/service: offers
+                  - match:
+                      path:
+                        prefix: "/users"
+                    mirror: {}
+                    destination:
+                      kuma.io/service: users
+                  destination:
+                    kuma.io/service: backend`,
+				expected: `
+                violations:
+                - field: conf.http[0].mirror.destination
+                  message: mandatory tag "kuma.io/service" is missing
+                - field: conf.http[0].mirror.percentage
+                  message: has to be in [0.0 - 100.0] range
+                - field: conf.http[1].mirror.destination
+                  message: must have at least one tag
+                - field: conf.http[1].mirror.destination
+                  message: mandatory tag "kuma.io/service" is missing`,
+			}),
 		)
 	})
 })
@@ -14,6 +14,7 @@ import (
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/validators"
+	util_envoy "github.com/kumahq/kuma/pkg/util/envoy"
 )
 
 type TagsValidatorFunc func(path validators.PathBuilder, selector map[string]string) validators.ValidationError
@@ -274,7 +275,7 @@ func ValidateResourceYAML(msg proto.Message, resYAML string) error {
 			return err
 		}
 	}
-	return nil
+	return util_envoy.ValidateTypedConfigs(proto.MessageV2(msg))
 }
 
 func ValidateResourceYAMLPatch(msg proto.Message, resYAML string) error {
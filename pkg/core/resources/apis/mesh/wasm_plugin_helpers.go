@@ -0,0 +1,21 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// GetInboundPlugins returns plugins inserted into the inbound listeners.
+func (w *MeshWasmPluginResource) GetInboundPlugins() []*mesh_proto.MeshWasmPlugin_Conf_Plugin {
+	if w == nil {
+		return nil
+	}
+	return w.Spec.GetConf().GetInbound()
+}
+
+// GetOutboundPlugins returns plugins inserted into the outbound listeners.
+func (w *MeshWasmPluginResource) GetOutboundPlugins() []*mesh_proto.MeshWasmPlugin_Conf_Plugin {
+	if w == nil {
+		return nil
+	}
+	return w.Spec.GetConf().GetOutbound()
+}
@@ -0,0 +1,81 @@
+package mesh
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (d *MeshWasmPluginResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(d.validateSelectors())
+	err.Add(d.validateConf())
+	return err.OrNil()
+}
+
+func (d *MeshWasmPluginResource) validateSelectors() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("selectors"), d.Spec.GetSelectors(), ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+		},
+	})
+}
+
+func (d *MeshWasmPluginResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	if d.Spec.GetConf() == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	inbound := d.Spec.GetConf().GetInbound()
+	outbound := d.Spec.GetConf().GetOutbound()
+	if len(inbound) == 0 && len(outbound) == 0 {
+		err.AddViolationAt(root, "either inbound or outbound has to be defined")
+	}
+	err.Add(validateWasmPlugins(root.Field("inbound"), inbound))
+	err.Add(validateWasmPlugins(root.Field("outbound"), outbound))
+	return
+}
+
+func validateWasmPlugins(path validators.PathBuilder, plugins []*mesh_proto.MeshWasmPlugin_Conf_Plugin) (err validators.ValidationError) {
+	usedNames := map[string]bool{}
+	for i, plugin := range plugins {
+		pluginPath := path.Index(i)
+		if plugin.GetName() == "" {
+			err.AddViolationAt(pluginPath.Field("name"), "cannot be empty")
+		} else if usedNames[plugin.GetName()] {
+			err.AddViolationAt(pluginPath.Field("name"), fmt.Sprintf("%q name is already used for another plugin", plugin.GetName()))
+		}
+		usedNames[plugin.GetName()] = true
+		err.Add(validateWasmModule(pluginPath.Field("module"), plugin.GetModule()))
+	}
+	return
+}
+
+func validateWasmModule(path validators.PathBuilder, module *mesh_proto.MeshWasmPlugin_Conf_Plugin_Module) (err validators.ValidationError) {
+	switch module.GetSource().(type) {
+	case *mesh_proto.MeshWasmPlugin_Conf_Plugin_Module_Local:
+		err.Add(system.ValidateDataSource(path.Field("local"), module.GetLocal()))
+	case *mesh_proto.MeshWasmPlugin_Conf_Plugin_Module_Remote_:
+		remote := module.GetRemote()
+		if remote.GetUrl() == "" {
+			err.AddViolationAt(path.Field("remote").Field("url"), "cannot be empty")
+		} else if uri, parseErr := url.ParseRequestURI(remote.GetUrl()); parseErr != nil || (uri.Scheme != "http" && uri.Scheme != "https") {
+			err.AddViolationAt(path.Field("remote").Field("url"), "has to be a valid http or https URL")
+		}
+		if sha, decodeErr := hex.DecodeString(remote.GetSha256()); decodeErr != nil || len(sha) != 32 {
+			err.AddViolationAt(path.Field("remote").Field("sha256"), "has to be a hex encoded SHA256 checksum")
+		}
+		if remote.GetTimeout() != nil {
+			err.Add(ValidateDuration(path.Field("remote").Field("timeout"), remote.GetTimeout()))
+		}
+	default:
+		err.AddViolationAt(path, "either local or remote has to be defined")
+	}
+	return
+}
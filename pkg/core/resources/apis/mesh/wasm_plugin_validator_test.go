@@ -0,0 +1,151 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshWasmPlugin", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(wasmPluginYAML string) {
+				// setup
+				wasmPlugin := NewMeshWasmPluginResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(wasmPluginYAML), wasmPlugin.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := wasmPlugin.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full example", `
+                selectors:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  inbound:
+                  - name: auth
+                    rootId: auth_root
+                    module:
+                      local:
+                        secret: auth-wasm
+                    configuration: '{"realm": "acme"}'
+                    failOpen: true
+                  - name: stats
+                    position: AFTER_KUMA
+                    module:
+                      remote:
+                        url: https://registry.example.com/v2/plugins/stats/blobs/sha256:5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b
+                        sha256: 5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b
+                        timeout: 30s
+                  outbound:
+                  - name: auth
+                    module:
+                      local:
+                        secret: auth-wasm`,
+			),
+		)
+
+		type testCase struct {
+			wasmPlugin string
+			expected   string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				wasmPlugin := NewMeshWasmPluginResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.wasmPlugin), wasmPlugin.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := wasmPlugin.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				wasmPlugin: ``,
+				expected: `
+                violations:
+                - field: selectors
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("empty conf", testCase{
+				wasmPlugin: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf: {}
+`,
+				expected: `
+                violations:
+                - field: conf
+                  message: either inbound or outbound has to be defined
+`,
+			}),
+			Entry("invalid plugins", testCase{
+				wasmPlugin: `
+                selectors:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  inbound:
+                  - name: ''
+                  - name: auth
+                    module:
+                      local: {}
+                  - name: auth
+                    module:
+                      remote:
+                        url: ftp://registry.example.com/auth.wasm
+                        sha256: xyz
+                        timeout: 0s
+                  outbound:
+                  - name: stats
+                    module:
+                      remote: {}
+`,
+				expected: `
+                violations:
+                - field: conf.inbound[0].name
+                  message: cannot be empty
+                - field: conf.inbound[0].module
+                  message: either local or remote has to be defined
+                - field: conf.inbound[1].module.local
+                  message: data source cannot be empty
+                - field: conf.inbound[2].name
+                  message: '"auth" name is already used for another plugin'
+                - field: conf.inbound[2].module.remote.url
+                  message: has to be a valid http or https URL
+                - field: conf.inbound[2].module.remote.sha256
+                  message: has to be a hex encoded SHA256 checksum
+                - field: conf.inbound[2].module.remote.timeout
+                  message: must have a positive value
+                - field: conf.outbound[0].module.remote.url
+                  message: cannot be empty
+                - field: conf.outbound[0].module.remote.sha256
+                  message: has to be a hex encoded SHA256 checksum
+`,
+			}),
+		)
+	})
+})
@@ -0,0 +1,13 @@
+package mesh
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+// GetConf returns configuration of the zone traffic route policy.
+func (r *MeshZoneTrafficRouteResource) GetConf() *mesh_proto.MeshZoneTrafficRoute_Conf {
+	if r == nil {
+		return nil
+	}
+	return r.Spec.GetConf()
+}
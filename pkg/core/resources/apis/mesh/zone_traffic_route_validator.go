@@ -0,0 +1,55 @@
+package mesh
+
+import (
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (r *MeshZoneTrafficRouteResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(r.validateSources())
+	err.Add(r.validateDestinations())
+	err.Add(r.validateConf())
+	return err.OrNil()
+}
+
+func (r *MeshZoneTrafficRouteResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("sources"), r.Spec.Sources, ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateTagsOpts: ValidateTagsOpts{
+			RequireAtLeastOneTag: true,
+			RequireService:       true,
+		},
+	})
+}
+
+func (r *MeshZoneTrafficRouteResource) validateDestinations() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("destinations"), r.Spec.Destinations, OnlyServiceTagAllowed)
+}
+
+func (r *MeshZoneTrafficRouteResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	conf := r.Spec.GetConf()
+	if conf == nil {
+		err.AddViolationAt(root, "must have conf")
+		return
+	}
+	if len(conf.GetZones()) == 0 {
+		err.AddViolationAt(root.Field("zones"), "must have at least one element")
+		return
+	}
+	names := map[string]bool{}
+	for i, zone := range conf.GetZones() {
+		path := root.Field("zones").Index(i)
+		switch {
+		case zone.GetName() == "":
+			err.AddViolationAt(path.Field("name"), "cannot be empty")
+		case names[zone.GetName()]:
+			err.AddViolationAt(path.Field("name"), "must be unique")
+		}
+		names[zone.GetName()] = true
+		if zone.GetWeight() != nil && zone.GetWeight().GetValue() == 0 {
+			err.AddViolationAt(path.Field("weight"), "must be greater than 0")
+		}
+	}
+	return
+}
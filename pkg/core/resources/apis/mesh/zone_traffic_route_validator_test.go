@@ -0,0 +1,141 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("MeshZoneTrafficRoute", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(routeYAML string) {
+				// setup
+				route := NewMeshZoneTrafficRouteResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(routeYAML), route.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := route.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("full example", `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  zones:
+                  - name: zone-1
+                    priority: 0
+                    weight: 80
+                  - name: zone-2
+                    priority: 0
+                    weight: 20
+                  - name: '*'
+                    priority: 1`,
+			),
+			Entry("only priorities", `
+                sources:
+                - match:
+                    kuma.io/service: '*'
+                destinations:
+                - match:
+                    kuma.io/service: '*'
+                conf:
+                  zones:
+                  - name: zone-2
+                    priority: 1`,
+			),
+		)
+
+		type testCase struct {
+			route    string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				route := NewMeshZoneTrafficRouteResource()
+
+				// when
+				err := util_proto.FromYAML([]byte(given.route), route.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := route.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				route: ``,
+				expected: `
+                violations:
+                - field: sources
+                  message: must have at least one element
+                - field: destinations
+                  message: must have at least one element
+                - field: conf
+                  message: must have conf
+`,
+			}),
+			Entry("empty zones", testCase{
+				route: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf: {}
+`,
+				expected: `
+                violations:
+                - field: conf.zones
+                  message: must have at least one element
+`,
+			}),
+			Entry("invalid zones", testCase{
+				route: `
+                sources:
+                - match:
+                    kuma.io/service: web
+                destinations:
+                - match:
+                    kuma.io/service: backend
+                conf:
+                  zones:
+                  - name: ''
+                  - name: zone-1
+                    weight: 0
+                  - name: zone-1
+`,
+				expected: `
+                violations:
+                - field: conf.zones[0].name
+                  message: cannot be empty
+                - field: conf.zones[1].weight
+                  message: must be greater than 0
+                - field: conf.zones[2].name
+                  message: must be unique
+`,
+			}),
+		)
+	})
+})
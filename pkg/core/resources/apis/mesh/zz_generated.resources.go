@@ -746,6 +746,111 @@ func init() {
 	registry.RegisterType(HealthCheckResourceTypeDescriptor)
 }
 
+const (
+	LocalReplyType model.ResourceType = "LocalReply"
+)
+
+var _ model.Resource = &LocalReplyResource{}
+
+type LocalReplyResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.LocalReply
+}
+
+func NewLocalReplyResource() *LocalReplyResource {
+	return &LocalReplyResource{
+		Spec: &mesh_proto.LocalReply{},
+	}
+}
+
+func (t *LocalReplyResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *LocalReplyResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *LocalReplyResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *LocalReplyResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.GetSelectors()
+}
+
+func (t *LocalReplyResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.LocalReply)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.LocalReply{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *LocalReplyResource) Descriptor() model.ResourceTypeDescriptor {
+	return LocalReplyResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &LocalReplyResourceList{}
+
+type LocalReplyResourceList struct {
+	Items      []*LocalReplyResource
+	Pagination model.Pagination
+}
+
+func (l *LocalReplyResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *LocalReplyResourceList) GetItemType() model.ResourceType {
+	return LocalReplyType
+}
+
+func (l *LocalReplyResourceList) NewItem() model.Resource {
+	return NewLocalReplyResource()
+}
+
+func (l *LocalReplyResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*LocalReplyResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*LocalReplyResource)(nil), r)
+	}
+}
+
+func (l *LocalReplyResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var LocalReplyResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           LocalReplyType,
+	Resource:       NewLocalReplyResource(),
+	ResourceList:   &LocalReplyResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "local-replies",
+	KumactlArg:     "local-reply",
+	KumactlListArg: "local-replies",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(LocalReplyResourceTypeDescriptor)
+}
+
 const (
 	MeshType model.ResourceType = "Mesh"
 )
@@ -848,45 +953,45 @@ func init() {
 }
 
 const (
-	MeshGatewayType model.ResourceType = "MeshGateway"
+	MeshBandwidthLimitType model.ResourceType = "MeshBandwidthLimit"
 )
 
-var _ model.Resource = &MeshGatewayResource{}
+var _ model.Resource = &MeshBandwidthLimitResource{}
 
-type MeshGatewayResource struct {
+type MeshBandwidthLimitResource struct {
 	Meta model.ResourceMeta
-	Spec *mesh_proto.MeshGateway
+	Spec *mesh_proto.MeshBandwidthLimit
 }
 
-func NewMeshGatewayResource() *MeshGatewayResource {
-	return &MeshGatewayResource{
-		Spec: &mesh_proto.MeshGateway{},
+func NewMeshBandwidthLimitResource() *MeshBandwidthLimitResource {
+	return &MeshBandwidthLimitResource{
+		Spec: &mesh_proto.MeshBandwidthLimit{},
 	}
 }
 
-func (t *MeshGatewayResource) GetMeta() model.ResourceMeta {
+func (t *MeshBandwidthLimitResource) GetMeta() model.ResourceMeta {
 	return t.Meta
 }
 
-func (t *MeshGatewayResource) SetMeta(m model.ResourceMeta) {
+func (t *MeshBandwidthLimitResource) SetMeta(m model.ResourceMeta) {
 	t.Meta = m
 }
 
-func (t *MeshGatewayResource) GetSpec() model.ResourceSpec {
+func (t *MeshBandwidthLimitResource) GetSpec() model.ResourceSpec {
 	return t.Spec
 }
 
-func (t *MeshGatewayResource) Selectors() []*mesh_proto.Selector {
+func (t *MeshBandwidthLimitResource) Selectors() []*mesh_proto.Selector {
 	return t.Spec.GetSelectors()
 }
 
-func (t *MeshGatewayResource) SetSpec(spec model.ResourceSpec) error {
-	protoType, ok := spec.(*mesh_proto.MeshGateway)
+func (t *MeshBandwidthLimitResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshBandwidthLimit)
 	if !ok {
 		return fmt.Errorf("invalid type %T for Spec", spec)
 	} else {
 		if protoType == nil {
-			t.Spec = &mesh_proto.MeshGateway{}
+			t.Spec = &mesh_proto.MeshBandwidthLimit{}
 		} else {
 			t.Spec = protoType
 		}
@@ -894,18 +999,18 @@ func (t *MeshGatewayResource) SetSpec(spec model.ResourceSpec) error {
 	}
 }
 
-func (t *MeshGatewayResource) Descriptor() model.ResourceTypeDescriptor {
-	return MeshGatewayResourceTypeDescriptor
+func (t *MeshBandwidthLimitResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshBandwidthLimitResourceTypeDescriptor
 }
 
-var _ model.ResourceList = &MeshGatewayResourceList{}
+var _ model.ResourceList = &MeshBandwidthLimitResourceList{}
 
-type MeshGatewayResourceList struct {
-	Items      []*MeshGatewayResource
+type MeshBandwidthLimitResourceList struct {
+	Items      []*MeshBandwidthLimitResource
 	Pagination model.Pagination
 }
 
-func (l *MeshGatewayResourceList) GetItems() []model.Resource {
+func (l *MeshBandwidthLimitResourceList) GetItems() []model.Resource {
 	res := make([]model.Resource, len(l.Items))
 	for i, elem := range l.Items {
 		res[i] = elem
@@ -913,85 +1018,85 @@ func (l *MeshGatewayResourceList) GetItems() []model.Resource {
 	return res
 }
 
-func (l *MeshGatewayResourceList) GetItemType() model.ResourceType {
-	return MeshGatewayType
+func (l *MeshBandwidthLimitResourceList) GetItemType() model.ResourceType {
+	return MeshBandwidthLimitType
 }
 
-func (l *MeshGatewayResourceList) NewItem() model.Resource {
-	return NewMeshGatewayResource()
+func (l *MeshBandwidthLimitResourceList) NewItem() model.Resource {
+	return NewMeshBandwidthLimitResource()
 }
 
-func (l *MeshGatewayResourceList) AddItem(r model.Resource) error {
-	if trr, ok := r.(*MeshGatewayResource); ok {
+func (l *MeshBandwidthLimitResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshBandwidthLimitResource); ok {
 		l.Items = append(l.Items, trr)
 		return nil
 	} else {
-		return model.ErrorInvalidItemType((*MeshGatewayResource)(nil), r)
+		return model.ErrorInvalidItemType((*MeshBandwidthLimitResource)(nil), r)
 	}
 }
 
-func (l *MeshGatewayResourceList) GetPagination() *model.Pagination {
+func (l *MeshBandwidthLimitResourceList) GetPagination() *model.Pagination {
 	return &l.Pagination
 }
 
-var MeshGatewayResourceTypeDescriptor = model.ResourceTypeDescriptor{
-	Name:           MeshGatewayType,
-	Resource:       NewMeshGatewayResource(),
-	ResourceList:   &MeshGatewayResourceList{},
+var MeshBandwidthLimitResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshBandwidthLimitType,
+	Resource:       NewMeshBandwidthLimitResource(),
+	ResourceList:   &MeshBandwidthLimitResourceList{},
 	ReadOnly:       false,
 	AdminOnly:      false,
 	Scope:          model.ScopeMesh,
 	KDSFlags:       model.FromGlobalToZone,
-	WsPath:         "meshgateways",
-	KumactlArg:     "meshgateway",
-	KumactlListArg: "meshgateways",
-	AllowToInspect: false,
+	WsPath:         "meshbandwidthlimits",
+	KumactlArg:     "meshbandwidthlimit",
+	KumactlListArg: "meshbandwidthlimits",
+	AllowToInspect: true,
 }
 
 func init() {
-	registry.RegisterType(MeshGatewayResourceTypeDescriptor)
+	registry.RegisterType(MeshBandwidthLimitResourceTypeDescriptor)
 }
 
 const (
-	MeshGatewayRouteType model.ResourceType = "MeshGatewayRoute"
+	MeshCORSType model.ResourceType = "MeshCORS"
 )
 
-var _ model.Resource = &MeshGatewayRouteResource{}
+var _ model.Resource = &MeshCORSResource{}
 
-type MeshGatewayRouteResource struct {
+type MeshCORSResource struct {
 	Meta model.ResourceMeta
-	Spec *mesh_proto.MeshGatewayRoute
+	Spec *mesh_proto.MeshCORS
 }
 
-func NewMeshGatewayRouteResource() *MeshGatewayRouteResource {
-	return &MeshGatewayRouteResource{
-		Spec: &mesh_proto.MeshGatewayRoute{},
+func NewMeshCORSResource() *MeshCORSResource {
+	return &MeshCORSResource{
+		Spec: &mesh_proto.MeshCORS{},
 	}
 }
 
-func (t *MeshGatewayRouteResource) GetMeta() model.ResourceMeta {
+func (t *MeshCORSResource) GetMeta() model.ResourceMeta {
 	return t.Meta
 }
 
-func (t *MeshGatewayRouteResource) SetMeta(m model.ResourceMeta) {
+func (t *MeshCORSResource) SetMeta(m model.ResourceMeta) {
 	t.Meta = m
 }
 
-func (t *MeshGatewayRouteResource) GetSpec() model.ResourceSpec {
+func (t *MeshCORSResource) GetSpec() model.ResourceSpec {
 	return t.Spec
 }
 
-func (t *MeshGatewayRouteResource) Selectors() []*mesh_proto.Selector {
+func (t *MeshCORSResource) Selectors() []*mesh_proto.Selector {
 	return t.Spec.GetSelectors()
 }
 
-func (t *MeshGatewayRouteResource) SetSpec(spec model.ResourceSpec) error {
-	protoType, ok := spec.(*mesh_proto.MeshGatewayRoute)
+func (t *MeshCORSResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshCORS)
 	if !ok {
 		return fmt.Errorf("invalid type %T for Spec", spec)
 	} else {
 		if protoType == nil {
-			t.Spec = &mesh_proto.MeshGatewayRoute{}
+			t.Spec = &mesh_proto.MeshCORS{}
 		} else {
 			t.Spec = protoType
 		}
@@ -999,18 +1104,18 @@ func (t *MeshGatewayRouteResource) SetSpec(spec model.ResourceSpec) error {
 	}
 }
 
-func (t *MeshGatewayRouteResource) Descriptor() model.ResourceTypeDescriptor {
-	return MeshGatewayRouteResourceTypeDescriptor
+func (t *MeshCORSResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshCORSResourceTypeDescriptor
 }
 
-var _ model.ResourceList = &MeshGatewayRouteResourceList{}
+var _ model.ResourceList = &MeshCORSResourceList{}
 
-type MeshGatewayRouteResourceList struct {
-	Items      []*MeshGatewayRouteResource
+type MeshCORSResourceList struct {
+	Items      []*MeshCORSResource
 	Pagination model.Pagination
 }
 
-func (l *MeshGatewayRouteResourceList) GetItems() []model.Resource {
+func (l *MeshCORSResourceList) GetItems() []model.Resource {
 	res := make([]model.Resource, len(l.Items))
 	for i, elem := range l.Items {
 		res[i] = elem
@@ -1018,85 +1123,85 @@ func (l *MeshGatewayRouteResourceList) GetItems() []model.Resource {
 	return res
 }
 
-func (l *MeshGatewayRouteResourceList) GetItemType() model.ResourceType {
-	return MeshGatewayRouteType
+func (l *MeshCORSResourceList) GetItemType() model.ResourceType {
+	return MeshCORSType
 }
 
-func (l *MeshGatewayRouteResourceList) NewItem() model.Resource {
-	return NewMeshGatewayRouteResource()
+func (l *MeshCORSResourceList) NewItem() model.Resource {
+	return NewMeshCORSResource()
 }
 
-func (l *MeshGatewayRouteResourceList) AddItem(r model.Resource) error {
-	if trr, ok := r.(*MeshGatewayRouteResource); ok {
+func (l *MeshCORSResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshCORSResource); ok {
 		l.Items = append(l.Items, trr)
 		return nil
 	} else {
-		return model.ErrorInvalidItemType((*MeshGatewayRouteResource)(nil), r)
+		return model.ErrorInvalidItemType((*MeshCORSResource)(nil), r)
 	}
 }
 
-func (l *MeshGatewayRouteResourceList) GetPagination() *model.Pagination {
+func (l *MeshCORSResourceList) GetPagination() *model.Pagination {
 	return &l.Pagination
 }
 
-var MeshGatewayRouteResourceTypeDescriptor = model.ResourceTypeDescriptor{
-	Name:           MeshGatewayRouteType,
-	Resource:       NewMeshGatewayRouteResource(),
-	ResourceList:   &MeshGatewayRouteResourceList{},
+var MeshCORSResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshCORSType,
+	Resource:       NewMeshCORSResource(),
+	ResourceList:   &MeshCORSResourceList{},
 	ReadOnly:       false,
 	AdminOnly:      false,
 	Scope:          model.ScopeMesh,
 	KDSFlags:       model.FromGlobalToZone,
-	WsPath:         "meshgatewayroutes",
-	KumactlArg:     "meshgatewayroute",
-	KumactlListArg: "meshgatewayroutes",
-	AllowToInspect: false,
+	WsPath:         "meshcorses",
+	KumactlArg:     "meshcors",
+	KumactlListArg: "meshcorses",
+	AllowToInspect: true,
 }
 
 func init() {
-	registry.RegisterType(MeshGatewayRouteResourceTypeDescriptor)
+	registry.RegisterType(MeshCORSResourceTypeDescriptor)
 }
 
 const (
-	MeshInsightType model.ResourceType = "MeshInsight"
+	MeshCompressionType model.ResourceType = "MeshCompression"
 )
 
-var _ model.Resource = &MeshInsightResource{}
+var _ model.Resource = &MeshCompressionResource{}
 
-type MeshInsightResource struct {
+type MeshCompressionResource struct {
 	Meta model.ResourceMeta
-	Spec *mesh_proto.MeshInsight
+	Spec *mesh_proto.MeshCompression
 }
 
-func NewMeshInsightResource() *MeshInsightResource {
-	return &MeshInsightResource{
-		Spec: &mesh_proto.MeshInsight{},
+func NewMeshCompressionResource() *MeshCompressionResource {
+	return &MeshCompressionResource{
+		Spec: &mesh_proto.MeshCompression{},
 	}
 }
 
-func (t *MeshInsightResource) GetMeta() model.ResourceMeta {
+func (t *MeshCompressionResource) GetMeta() model.ResourceMeta {
 	return t.Meta
 }
 
-func (t *MeshInsightResource) SetMeta(m model.ResourceMeta) {
+func (t *MeshCompressionResource) SetMeta(m model.ResourceMeta) {
 	t.Meta = m
 }
 
-func (t *MeshInsightResource) GetSpec() model.ResourceSpec {
+func (t *MeshCompressionResource) GetSpec() model.ResourceSpec {
 	return t.Spec
 }
 
-func (t *MeshInsightResource) Validate() error {
-	return nil
+func (t *MeshCompressionResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.GetSelectors()
 }
 
-func (t *MeshInsightResource) SetSpec(spec model.ResourceSpec) error {
-	protoType, ok := spec.(*mesh_proto.MeshInsight)
+func (t *MeshCompressionResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshCompression)
 	if !ok {
 		return fmt.Errorf("invalid type %T for Spec", spec)
 	} else {
 		if protoType == nil {
-			t.Spec = &mesh_proto.MeshInsight{}
+			t.Spec = &mesh_proto.MeshCompression{}
 		} else {
 			t.Spec = protoType
 		}
@@ -1104,18 +1209,18 @@ func (t *MeshInsightResource) SetSpec(spec model.ResourceSpec) error {
 	}
 }
 
-func (t *MeshInsightResource) Descriptor() model.ResourceTypeDescriptor {
-	return MeshInsightResourceTypeDescriptor
+func (t *MeshCompressionResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshCompressionResourceTypeDescriptor
 }
 
-var _ model.ResourceList = &MeshInsightResourceList{}
+var _ model.ResourceList = &MeshCompressionResourceList{}
 
-type MeshInsightResourceList struct {
-	Items      []*MeshInsightResource
+type MeshCompressionResourceList struct {
+	Items      []*MeshCompressionResource
 	Pagination model.Pagination
 }
 
-func (l *MeshInsightResourceList) GetItems() []model.Resource {
+func (l *MeshCompressionResourceList) GetItems() []model.Resource {
 	res := make([]model.Resource, len(l.Items))
 	for i, elem := range l.Items {
 		res[i] = elem
@@ -1123,42 +1228,1100 @@ func (l *MeshInsightResourceList) GetItems() []model.Resource {
 	return res
 }
 
-func (l *MeshInsightResourceList) GetItemType() model.ResourceType {
-	return MeshInsightType
+func (l *MeshCompressionResourceList) GetItemType() model.ResourceType {
+	return MeshCompressionType
 }
 
-func (l *MeshInsightResourceList) NewItem() model.Resource {
-	return NewMeshInsightResource()
+func (l *MeshCompressionResourceList) NewItem() model.Resource {
+	return NewMeshCompressionResource()
 }
 
-func (l *MeshInsightResourceList) AddItem(r model.Resource) error {
-	if trr, ok := r.(*MeshInsightResource); ok {
+func (l *MeshCompressionResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshCompressionResource); ok {
 		l.Items = append(l.Items, trr)
 		return nil
 	} else {
-		return model.ErrorInvalidItemType((*MeshInsightResource)(nil), r)
+		return model.ErrorInvalidItemType((*MeshCompressionResource)(nil), r)
 	}
 }
 
-func (l *MeshInsightResourceList) GetPagination() *model.Pagination {
+func (l *MeshCompressionResourceList) GetPagination() *model.Pagination {
 	return &l.Pagination
 }
 
-var MeshInsightResourceTypeDescriptor = model.ResourceTypeDescriptor{
-	Name:           MeshInsightType,
-	Resource:       NewMeshInsightResource(),
-	ResourceList:   &MeshInsightResourceList{},
-	ReadOnly:       true,
+var MeshCompressionResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshCompressionType,
+	Resource:       NewMeshCompressionResource(),
+	ResourceList:   &MeshCompressionResourceList{},
+	ReadOnly:       false,
 	AdminOnly:      false,
-	Scope:          model.ScopeGlobal,
-	WsPath:         "mesh-insights",
-	KumactlArg:     "",
-	KumactlListArg: "",
-	AllowToInspect: false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "meshcompressions",
+	KumactlArg:     "meshcompression",
+	KumactlListArg: "meshcompressions",
+	AllowToInspect: true,
 }
 
 func init() {
-	registry.RegisterType(MeshInsightResourceTypeDescriptor)
+	registry.RegisterType(MeshCompressionResourceTypeDescriptor)
+}
+
+const (
+	MeshExternalAuthzType model.ResourceType = "MeshExternalAuthz"
+)
+
+var _ model.Resource = &MeshExternalAuthzResource{}
+
+type MeshExternalAuthzResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.MeshExternalAuthz
+}
+
+func NewMeshExternalAuthzResource() *MeshExternalAuthzResource {
+	return &MeshExternalAuthzResource{
+		Spec: &mesh_proto.MeshExternalAuthz{},
+	}
+}
+
+func (t *MeshExternalAuthzResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *MeshExternalAuthzResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *MeshExternalAuthzResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *MeshExternalAuthzResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.GetSelectors()
+}
+
+func (t *MeshExternalAuthzResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshExternalAuthz)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.MeshExternalAuthz{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *MeshExternalAuthzResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshExternalAuthzResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &MeshExternalAuthzResourceList{}
+
+type MeshExternalAuthzResourceList struct {
+	Items      []*MeshExternalAuthzResource
+	Pagination model.Pagination
+}
+
+func (l *MeshExternalAuthzResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshExternalAuthzResourceList) GetItemType() model.ResourceType {
+	return MeshExternalAuthzType
+}
+
+func (l *MeshExternalAuthzResourceList) NewItem() model.Resource {
+	return NewMeshExternalAuthzResource()
+}
+
+func (l *MeshExternalAuthzResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshExternalAuthzResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshExternalAuthzResource)(nil), r)
+	}
+}
+
+func (l *MeshExternalAuthzResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var MeshExternalAuthzResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshExternalAuthzType,
+	Resource:       NewMeshExternalAuthzResource(),
+	ResourceList:   &MeshExternalAuthzResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "meshexternalauthzs",
+	KumactlArg:     "meshexternalauthz",
+	KumactlListArg: "meshexternalauthzs",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(MeshExternalAuthzResourceTypeDescriptor)
+}
+
+const (
+	MeshGatewayType model.ResourceType = "MeshGateway"
+)
+
+var _ model.Resource = &MeshGatewayResource{}
+
+type MeshGatewayResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.MeshGateway
+}
+
+func NewMeshGatewayResource() *MeshGatewayResource {
+	return &MeshGatewayResource{
+		Spec: &mesh_proto.MeshGateway{},
+	}
+}
+
+func (t *MeshGatewayResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *MeshGatewayResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *MeshGatewayResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *MeshGatewayResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.GetSelectors()
+}
+
+func (t *MeshGatewayResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshGateway)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.MeshGateway{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *MeshGatewayResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshGatewayResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &MeshGatewayResourceList{}
+
+type MeshGatewayResourceList struct {
+	Items      []*MeshGatewayResource
+	Pagination model.Pagination
+}
+
+func (l *MeshGatewayResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshGatewayResourceList) GetItemType() model.ResourceType {
+	return MeshGatewayType
+}
+
+func (l *MeshGatewayResourceList) NewItem() model.Resource {
+	return NewMeshGatewayResource()
+}
+
+func (l *MeshGatewayResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshGatewayResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshGatewayResource)(nil), r)
+	}
+}
+
+func (l *MeshGatewayResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var MeshGatewayResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshGatewayType,
+	Resource:       NewMeshGatewayResource(),
+	ResourceList:   &MeshGatewayResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "meshgateways",
+	KumactlArg:     "meshgateway",
+	KumactlListArg: "meshgateways",
+	AllowToInspect: false,
+}
+
+func init() {
+	registry.RegisterType(MeshGatewayResourceTypeDescriptor)
+}
+
+const (
+	MeshGatewayRouteType model.ResourceType = "MeshGatewayRoute"
+)
+
+var _ model.Resource = &MeshGatewayRouteResource{}
+
+type MeshGatewayRouteResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.MeshGatewayRoute
+}
+
+func NewMeshGatewayRouteResource() *MeshGatewayRouteResource {
+	return &MeshGatewayRouteResource{
+		Spec: &mesh_proto.MeshGatewayRoute{},
+	}
+}
+
+func (t *MeshGatewayRouteResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *MeshGatewayRouteResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *MeshGatewayRouteResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *MeshGatewayRouteResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.GetSelectors()
+}
+
+func (t *MeshGatewayRouteResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshGatewayRoute)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.MeshGatewayRoute{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *MeshGatewayRouteResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshGatewayRouteResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &MeshGatewayRouteResourceList{}
+
+type MeshGatewayRouteResourceList struct {
+	Items      []*MeshGatewayRouteResource
+	Pagination model.Pagination
+}
+
+func (l *MeshGatewayRouteResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshGatewayRouteResourceList) GetItemType() model.ResourceType {
+	return MeshGatewayRouteType
+}
+
+func (l *MeshGatewayRouteResourceList) NewItem() model.Resource {
+	return NewMeshGatewayRouteResource()
+}
+
+func (l *MeshGatewayRouteResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshGatewayRouteResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshGatewayRouteResource)(nil), r)
+	}
+}
+
+func (l *MeshGatewayRouteResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var MeshGatewayRouteResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshGatewayRouteType,
+	Resource:       NewMeshGatewayRouteResource(),
+	ResourceList:   &MeshGatewayRouteResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "meshgatewayroutes",
+	KumactlArg:     "meshgatewayroute",
+	KumactlListArg: "meshgatewayroutes",
+	AllowToInspect: false,
+}
+
+func init() {
+	registry.RegisterType(MeshGatewayRouteResourceTypeDescriptor)
+}
+
+const (
+	MeshHeaderModifierType model.ResourceType = "MeshHeaderModifier"
+)
+
+var _ model.Resource = &MeshHeaderModifierResource{}
+
+type MeshHeaderModifierResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.MeshHeaderModifier
+}
+
+func NewMeshHeaderModifierResource() *MeshHeaderModifierResource {
+	return &MeshHeaderModifierResource{
+		Spec: &mesh_proto.MeshHeaderModifier{},
+	}
+}
+
+func (t *MeshHeaderModifierResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *MeshHeaderModifierResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *MeshHeaderModifierResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *MeshHeaderModifierResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.GetSelectors()
+}
+
+func (t *MeshHeaderModifierResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshHeaderModifier)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.MeshHeaderModifier{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *MeshHeaderModifierResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshHeaderModifierResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &MeshHeaderModifierResourceList{}
+
+type MeshHeaderModifierResourceList struct {
+	Items      []*MeshHeaderModifierResource
+	Pagination model.Pagination
+}
+
+func (l *MeshHeaderModifierResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshHeaderModifierResourceList) GetItemType() model.ResourceType {
+	return MeshHeaderModifierType
+}
+
+func (l *MeshHeaderModifierResourceList) NewItem() model.Resource {
+	return NewMeshHeaderModifierResource()
+}
+
+func (l *MeshHeaderModifierResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshHeaderModifierResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshHeaderModifierResource)(nil), r)
+	}
+}
+
+func (l *MeshHeaderModifierResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var MeshHeaderModifierResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshHeaderModifierType,
+	Resource:       NewMeshHeaderModifierResource(),
+	ResourceList:   &MeshHeaderModifierResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "meshheadermodifiers",
+	KumactlArg:     "meshheadermodifier",
+	KumactlListArg: "meshheadermodifiers",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(MeshHeaderModifierResourceTypeDescriptor)
+}
+
+const (
+	MeshInsightType model.ResourceType = "MeshInsight"
+)
+
+var _ model.Resource = &MeshInsightResource{}
+
+type MeshInsightResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.MeshInsight
+}
+
+func NewMeshInsightResource() *MeshInsightResource {
+	return &MeshInsightResource{
+		Spec: &mesh_proto.MeshInsight{},
+	}
+}
+
+func (t *MeshInsightResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *MeshInsightResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *MeshInsightResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *MeshInsightResource) Validate() error {
+	return nil
+}
+
+func (t *MeshInsightResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshInsight)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.MeshInsight{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *MeshInsightResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshInsightResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &MeshInsightResourceList{}
+
+type MeshInsightResourceList struct {
+	Items      []*MeshInsightResource
+	Pagination model.Pagination
+}
+
+func (l *MeshInsightResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshInsightResourceList) GetItemType() model.ResourceType {
+	return MeshInsightType
+}
+
+func (l *MeshInsightResourceList) NewItem() model.Resource {
+	return NewMeshInsightResource()
+}
+
+func (l *MeshInsightResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshInsightResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshInsightResource)(nil), r)
+	}
+}
+
+func (l *MeshInsightResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var MeshInsightResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshInsightType,
+	Resource:       NewMeshInsightResource(),
+	ResourceList:   &MeshInsightResourceList{},
+	ReadOnly:       true,
+	AdminOnly:      false,
+	Scope:          model.ScopeGlobal,
+	WsPath:         "mesh-insights",
+	KumactlArg:     "",
+	KumactlListArg: "",
+	AllowToInspect: false,
+}
+
+func init() {
+	registry.RegisterType(MeshInsightResourceTypeDescriptor)
+}
+
+const (
+	MeshJWTType model.ResourceType = "MeshJWT"
+)
+
+var _ model.Resource = &MeshJWTResource{}
+
+type MeshJWTResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.MeshJWT
+}
+
+func NewMeshJWTResource() *MeshJWTResource {
+	return &MeshJWTResource{
+		Spec: &mesh_proto.MeshJWT{},
+	}
+}
+
+func (t *MeshJWTResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *MeshJWTResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *MeshJWTResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *MeshJWTResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.GetSelectors()
+}
+
+func (t *MeshJWTResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshJWT)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.MeshJWT{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *MeshJWTResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshJWTResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &MeshJWTResourceList{}
+
+type MeshJWTResourceList struct {
+	Items      []*MeshJWTResource
+	Pagination model.Pagination
+}
+
+func (l *MeshJWTResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshJWTResourceList) GetItemType() model.ResourceType {
+	return MeshJWTType
+}
+
+func (l *MeshJWTResourceList) NewItem() model.Resource {
+	return NewMeshJWTResource()
+}
+
+func (l *MeshJWTResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshJWTResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshJWTResource)(nil), r)
+	}
+}
+
+func (l *MeshJWTResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var MeshJWTResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshJWTType,
+	Resource:       NewMeshJWTResource(),
+	ResourceList:   &MeshJWTResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "meshjwts",
+	KumactlArg:     "meshjwt",
+	KumactlListArg: "meshjwts",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(MeshJWTResourceTypeDescriptor)
+}
+
+const (
+	MeshProtocolOptionsType model.ResourceType = "MeshProtocolOptions"
+)
+
+var _ model.Resource = &MeshProtocolOptionsResource{}
+
+type MeshProtocolOptionsResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.MeshProtocolOptions
+}
+
+func NewMeshProtocolOptionsResource() *MeshProtocolOptionsResource {
+	return &MeshProtocolOptionsResource{
+		Spec: &mesh_proto.MeshProtocolOptions{},
+	}
+}
+
+func (t *MeshProtocolOptionsResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *MeshProtocolOptionsResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *MeshProtocolOptionsResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *MeshProtocolOptionsResource) Sources() []*mesh_proto.Selector {
+	return t.Spec.GetSources()
+}
+
+func (t *MeshProtocolOptionsResource) Destinations() []*mesh_proto.Selector {
+	return t.Spec.GetDestinations()
+}
+
+func (t *MeshProtocolOptionsResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshProtocolOptions)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.MeshProtocolOptions{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *MeshProtocolOptionsResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshProtocolOptionsResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &MeshProtocolOptionsResourceList{}
+
+type MeshProtocolOptionsResourceList struct {
+	Items      []*MeshProtocolOptionsResource
+	Pagination model.Pagination
+}
+
+func (l *MeshProtocolOptionsResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshProtocolOptionsResourceList) GetItemType() model.ResourceType {
+	return MeshProtocolOptionsType
+}
+
+func (l *MeshProtocolOptionsResourceList) NewItem() model.Resource {
+	return NewMeshProtocolOptionsResource()
+}
+
+func (l *MeshProtocolOptionsResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshProtocolOptionsResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshProtocolOptionsResource)(nil), r)
+	}
+}
+
+func (l *MeshProtocolOptionsResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var MeshProtocolOptionsResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshProtocolOptionsType,
+	Resource:       NewMeshProtocolOptionsResource(),
+	ResourceList:   &MeshProtocolOptionsResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "meshprotocoloptions",
+	KumactlArg:     "meshprotocoloption",
+	KumactlListArg: "meshprotocoloptions",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(MeshProtocolOptionsResourceTypeDescriptor)
+}
+
+const (
+	MeshRequestProtectionType model.ResourceType = "MeshRequestProtection"
+)
+
+var _ model.Resource = &MeshRequestProtectionResource{}
+
+type MeshRequestProtectionResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.MeshRequestProtection
+}
+
+func NewMeshRequestProtectionResource() *MeshRequestProtectionResource {
+	return &MeshRequestProtectionResource{
+		Spec: &mesh_proto.MeshRequestProtection{},
+	}
+}
+
+func (t *MeshRequestProtectionResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *MeshRequestProtectionResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *MeshRequestProtectionResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *MeshRequestProtectionResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.GetSelectors()
+}
+
+func (t *MeshRequestProtectionResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshRequestProtection)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.MeshRequestProtection{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *MeshRequestProtectionResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshRequestProtectionResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &MeshRequestProtectionResourceList{}
+
+type MeshRequestProtectionResourceList struct {
+	Items      []*MeshRequestProtectionResource
+	Pagination model.Pagination
+}
+
+func (l *MeshRequestProtectionResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshRequestProtectionResourceList) GetItemType() model.ResourceType {
+	return MeshRequestProtectionType
+}
+
+func (l *MeshRequestProtectionResourceList) NewItem() model.Resource {
+	return NewMeshRequestProtectionResource()
+}
+
+func (l *MeshRequestProtectionResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshRequestProtectionResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshRequestProtectionResource)(nil), r)
+	}
+}
+
+func (l *MeshRequestProtectionResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var MeshRequestProtectionResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshRequestProtectionType,
+	Resource:       NewMeshRequestProtectionResource(),
+	ResourceList:   &MeshRequestProtectionResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "meshrequestprotections",
+	KumactlArg:     "meshrequestprotection",
+	KumactlListArg: "meshrequestprotections",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(MeshRequestProtectionResourceTypeDescriptor)
+}
+
+const (
+	MeshWasmPluginType model.ResourceType = "MeshWasmPlugin"
+)
+
+var _ model.Resource = &MeshWasmPluginResource{}
+
+type MeshWasmPluginResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.MeshWasmPlugin
+}
+
+func NewMeshWasmPluginResource() *MeshWasmPluginResource {
+	return &MeshWasmPluginResource{
+		Spec: &mesh_proto.MeshWasmPlugin{},
+	}
+}
+
+func (t *MeshWasmPluginResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *MeshWasmPluginResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *MeshWasmPluginResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *MeshWasmPluginResource) Selectors() []*mesh_proto.Selector {
+	return t.Spec.GetSelectors()
+}
+
+func (t *MeshWasmPluginResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshWasmPlugin)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.MeshWasmPlugin{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *MeshWasmPluginResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshWasmPluginResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &MeshWasmPluginResourceList{}
+
+type MeshWasmPluginResourceList struct {
+	Items      []*MeshWasmPluginResource
+	Pagination model.Pagination
+}
+
+func (l *MeshWasmPluginResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshWasmPluginResourceList) GetItemType() model.ResourceType {
+	return MeshWasmPluginType
+}
+
+func (l *MeshWasmPluginResourceList) NewItem() model.Resource {
+	return NewMeshWasmPluginResource()
+}
+
+func (l *MeshWasmPluginResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshWasmPluginResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshWasmPluginResource)(nil), r)
+	}
+}
+
+func (l *MeshWasmPluginResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var MeshWasmPluginResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshWasmPluginType,
+	Resource:       NewMeshWasmPluginResource(),
+	ResourceList:   &MeshWasmPluginResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "meshwasmplugins",
+	KumactlArg:     "meshwasmplugin",
+	KumactlListArg: "meshwasmplugins",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(MeshWasmPluginResourceTypeDescriptor)
+}
+
+const (
+	MeshZoneTrafficRouteType model.ResourceType = "MeshZoneTrafficRoute"
+)
+
+var _ model.Resource = &MeshZoneTrafficRouteResource{}
+
+type MeshZoneTrafficRouteResource struct {
+	Meta model.ResourceMeta
+	Spec *mesh_proto.MeshZoneTrafficRoute
+}
+
+func NewMeshZoneTrafficRouteResource() *MeshZoneTrafficRouteResource {
+	return &MeshZoneTrafficRouteResource{
+		Spec: &mesh_proto.MeshZoneTrafficRoute{},
+	}
+}
+
+func (t *MeshZoneTrafficRouteResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *MeshZoneTrafficRouteResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *MeshZoneTrafficRouteResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *MeshZoneTrafficRouteResource) Sources() []*mesh_proto.Selector {
+	return t.Spec.GetSources()
+}
+
+func (t *MeshZoneTrafficRouteResource) Destinations() []*mesh_proto.Selector {
+	return t.Spec.GetDestinations()
+}
+
+func (t *MeshZoneTrafficRouteResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*mesh_proto.MeshZoneTrafficRoute)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &mesh_proto.MeshZoneTrafficRoute{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *MeshZoneTrafficRouteResource) Descriptor() model.ResourceTypeDescriptor {
+	return MeshZoneTrafficRouteResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &MeshZoneTrafficRouteResourceList{}
+
+type MeshZoneTrafficRouteResourceList struct {
+	Items      []*MeshZoneTrafficRouteResource
+	Pagination model.Pagination
+}
+
+func (l *MeshZoneTrafficRouteResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *MeshZoneTrafficRouteResourceList) GetItemType() model.ResourceType {
+	return MeshZoneTrafficRouteType
+}
+
+func (l *MeshZoneTrafficRouteResourceList) NewItem() model.Resource {
+	return NewMeshZoneTrafficRouteResource()
+}
+
+func (l *MeshZoneTrafficRouteResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*MeshZoneTrafficRouteResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*MeshZoneTrafficRouteResource)(nil), r)
+	}
+}
+
+func (l *MeshZoneTrafficRouteResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var MeshZoneTrafficRouteResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           MeshZoneTrafficRouteType,
+	Resource:       NewMeshZoneTrafficRouteResource(),
+	ResourceList:   &MeshZoneTrafficRouteResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      false,
+	Scope:          model.ScopeMesh,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "meshzonetrafficroutes",
+	KumactlArg:     "meshzonetrafficroute",
+	KumactlListArg: "meshzonetrafficroutes",
+	AllowToInspect: true,
+}
+
+func init() {
+	registry.RegisterType(MeshZoneTrafficRouteResourceTypeDescriptor)
 }
 
 const (
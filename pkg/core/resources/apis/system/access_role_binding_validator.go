@@ -0,0 +1,44 @@
+package system
+
+import (
+	"fmt"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+const (
+	UserSubjectType  = "User"
+	GroupSubjectType = "Group"
+)
+
+func (r *AccessRoleBindingResource) Validate() error {
+	var err validators.ValidationError
+	if len(r.Spec.GetSubjects()) == 0 {
+		err.AddViolation("subjects", "must have at least one element")
+	}
+	for i, subject := range r.Spec.GetSubjects() {
+		err.Add(validateSubject(validators.RootedAt("subjects").Index(i), subject))
+	}
+	if len(r.Spec.GetRoles()) == 0 {
+		err.AddViolation("roles", "must have at least one element")
+	}
+	for i, role := range r.Spec.GetRoles() {
+		if role == "" {
+			err.AddViolationAt(validators.RootedAt("roles").Index(i), "cannot be empty")
+		}
+	}
+	return err.OrNil()
+}
+
+func validateSubject(path validators.PathBuilder, subject *system_proto.AccessRoleBinding_Subject) (err validators.ValidationError) {
+	switch subject.GetType() {
+	case UserSubjectType, GroupSubjectType:
+	default:
+		err.AddViolationAt(path.Field("type"), fmt.Sprintf("has to be either %q or %q", UserSubjectType, GroupSubjectType))
+	}
+	if subject.GetName() == "" {
+		err.AddViolationAt(path.Field("name"), "cannot be empty")
+	}
+	return
+}
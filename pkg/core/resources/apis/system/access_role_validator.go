@@ -0,0 +1,38 @@
+package system
+
+import (
+	"fmt"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+func (r *AccessRoleResource) Validate() error {
+	var err validators.ValidationError
+	if len(r.Spec.GetRules()) == 0 {
+		err.AddViolation("rules", "must have at least one element")
+	}
+	for i, rule := range r.Spec.GetRules() {
+		err.Add(validateAccessRoleRule(validators.RootedAt("rules").Index(i), rule))
+	}
+	return err.OrNil()
+}
+
+func validateAccessRoleRule(path validators.PathBuilder, rule *system_proto.AccessRole_Rule) (err validators.ValidationError) {
+	for i, typ := range rule.GetTypes() {
+		if _, e := registry.Global().DescriptorFor(model.ResourceType(typ)); e != nil {
+			err.AddViolationAt(path.Field("types").Index(i), fmt.Sprintf("unknown resource type %q", typ))
+		}
+	}
+	for i, mesh := range rule.GetMeshes() {
+		if mesh == "" {
+			err.AddViolationAt(path.Field("meshes").Index(i), "cannot be empty")
+		}
+	}
+	if len(rule.GetAccess()) == 0 {
+		err.AddViolationAt(path.Field("access"), "must have at least one element")
+	}
+	return
+}
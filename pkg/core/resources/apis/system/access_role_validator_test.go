@@ -0,0 +1,116 @@
+package system_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	_ "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("AccessRole and AccessRoleBinding", func() {
+	type testCase struct {
+		resource model.Resource
+		spec     string
+		expected string
+	}
+
+	DescribeTable("should pass validation",
+		func(given testCase) {
+			// when
+			Expect(util_proto.FromYAML([]byte(given.spec), given.resource.GetSpec())).To(Succeed())
+
+			// then
+			Expect(given.resource.Validate()).To(Succeed())
+		},
+		Entry("role", testCase{
+			resource: system.NewAccessRoleResource(),
+			spec: `
+                rules:
+                - types: ["TrafficPermission", "TrafficRoute"]
+                  meshes: ["team-a"]
+                  access: ["READ", "WRITE"]
+                - access: ["GENERATE_DATAPLANE_TOKEN"]`,
+		}),
+		Entry("binding", testCase{
+			resource: system.NewAccessRoleBindingResource(),
+			spec: `
+                subjects:
+                - type: User
+                  name: john.doe@example.com
+                - type: Group
+                  name: team-a
+                roles: ["team-a-policies"]`,
+		}),
+	)
+
+	DescribeTable("should validate all fields and return as much individual errors as possible",
+		func(given testCase) {
+			// given
+			Expect(util_proto.FromYAML([]byte(given.spec), given.resource.GetSpec())).To(Succeed())
+
+			// when
+			verr := given.resource.Validate()
+			// and
+			actual, err := yaml.Marshal(verr)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("empty role", testCase{
+			resource: system.NewAccessRoleResource(),
+			spec:     ``,
+			expected: `
+                violations:
+                - field: rules
+                  message: must have at least one element`,
+		}),
+		Entry("invalid rules", testCase{
+			resource: system.NewAccessRoleResource(),
+			spec: `
+                rules:
+                - types: ["TrafficPermission", "TrafficPermissions"]
+                  meshes: [""]`,
+			expected: `
+                violations:
+                - field: rules[0].types[1]
+                  message: unknown resource type "TrafficPermissions"
+                - field: rules[0].meshes[0]
+                  message: cannot be empty
+                - field: rules[0].access
+                  message: must have at least one element`,
+		}),
+		Entry("empty binding", testCase{
+			resource: system.NewAccessRoleBindingResource(),
+			spec:     ``,
+			expected: `
+                violations:
+                - field: subjects
+                  message: must have at least one element
+                - field: roles
+                  message: must have at least one element`,
+		}),
+		Entry("invalid subjects and roles", testCase{
+			resource: system.NewAccessRoleBindingResource(),
+			spec: `
+                subjects:
+                - type: ServiceAccount
+                  name: default
+                - type: Group
+                roles: [""]`,
+			expected: `
+                violations:
+                - field: subjects[0].type
+                  message: has to be either "User" or "Group"
+                - field: subjects[1].name
+                  message: cannot be empty
+                - field: roles[0]
+                  message: cannot be empty`,
+		}),
+	)
+})
@@ -0,0 +1,11 @@
+package system_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestSystem(t *testing.T) {
+	test.RunSpecs(t, "System Suite")
+}
@@ -12,6 +12,208 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 )
 
+const (
+	AccessRoleType model.ResourceType = "AccessRole"
+)
+
+var _ model.Resource = &AccessRoleResource{}
+
+type AccessRoleResource struct {
+	Meta model.ResourceMeta
+	Spec *system_proto.AccessRole
+}
+
+func NewAccessRoleResource() *AccessRoleResource {
+	return &AccessRoleResource{
+		Spec: &system_proto.AccessRole{},
+	}
+}
+
+func (t *AccessRoleResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *AccessRoleResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *AccessRoleResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *AccessRoleResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*system_proto.AccessRole)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &system_proto.AccessRole{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *AccessRoleResource) Descriptor() model.ResourceTypeDescriptor {
+	return AccessRoleResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &AccessRoleResourceList{}
+
+type AccessRoleResourceList struct {
+	Items      []*AccessRoleResource
+	Pagination model.Pagination
+}
+
+func (l *AccessRoleResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *AccessRoleResourceList) GetItemType() model.ResourceType {
+	return AccessRoleType
+}
+
+func (l *AccessRoleResourceList) NewItem() model.Resource {
+	return NewAccessRoleResource()
+}
+
+func (l *AccessRoleResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*AccessRoleResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*AccessRoleResource)(nil), r)
+	}
+}
+
+func (l *AccessRoleResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var AccessRoleResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           AccessRoleType,
+	Resource:       NewAccessRoleResource(),
+	ResourceList:   &AccessRoleResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      true,
+	Scope:          model.ScopeGlobal,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "access-roles",
+	KumactlArg:     "access-role",
+	KumactlListArg: "access-roles",
+	AllowToInspect: false,
+}
+
+func init() {
+	registry.RegisterType(AccessRoleResourceTypeDescriptor)
+}
+
+const (
+	AccessRoleBindingType model.ResourceType = "AccessRoleBinding"
+)
+
+var _ model.Resource = &AccessRoleBindingResource{}
+
+type AccessRoleBindingResource struct {
+	Meta model.ResourceMeta
+	Spec *system_proto.AccessRoleBinding
+}
+
+func NewAccessRoleBindingResource() *AccessRoleBindingResource {
+	return &AccessRoleBindingResource{
+		Spec: &system_proto.AccessRoleBinding{},
+	}
+}
+
+func (t *AccessRoleBindingResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+
+func (t *AccessRoleBindingResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+
+func (t *AccessRoleBindingResource) GetSpec() model.ResourceSpec {
+	return t.Spec
+}
+
+func (t *AccessRoleBindingResource) SetSpec(spec model.ResourceSpec) error {
+	protoType, ok := spec.(*system_proto.AccessRoleBinding)
+	if !ok {
+		return fmt.Errorf("invalid type %T for Spec", spec)
+	} else {
+		if protoType == nil {
+			t.Spec = &system_proto.AccessRoleBinding{}
+		} else {
+			t.Spec = protoType
+		}
+		return nil
+	}
+}
+
+func (t *AccessRoleBindingResource) Descriptor() model.ResourceTypeDescriptor {
+	return AccessRoleBindingResourceTypeDescriptor
+}
+
+var _ model.ResourceList = &AccessRoleBindingResourceList{}
+
+type AccessRoleBindingResourceList struct {
+	Items      []*AccessRoleBindingResource
+	Pagination model.Pagination
+}
+
+func (l *AccessRoleBindingResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *AccessRoleBindingResourceList) GetItemType() model.ResourceType {
+	return AccessRoleBindingType
+}
+
+func (l *AccessRoleBindingResourceList) NewItem() model.Resource {
+	return NewAccessRoleBindingResource()
+}
+
+func (l *AccessRoleBindingResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*AccessRoleBindingResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*AccessRoleBindingResource)(nil), r)
+	}
+}
+
+func (l *AccessRoleBindingResourceList) GetPagination() *model.Pagination {
+	return &l.Pagination
+}
+
+var AccessRoleBindingResourceTypeDescriptor = model.ResourceTypeDescriptor{
+	Name:           AccessRoleBindingType,
+	Resource:       NewAccessRoleBindingResource(),
+	ResourceList:   &AccessRoleBindingResourceList{},
+	ReadOnly:       false,
+	AdminOnly:      true,
+	Scope:          model.ScopeGlobal,
+	KDSFlags:       model.FromGlobalToZone,
+	WsPath:         "access-role-bindings",
+	KumactlArg:     "access-role-binding",
+	KumactlListArg: "access-role-bindings",
+	AllowToInspect: false,
+}
+
+func init() {
+	registry.RegisterType(AccessRoleBindingResourceTypeDescriptor)
+}
+
 const (
 	ConfigType model.ResourceType = "Config"
 )
@@ -0,0 +1,279 @@
+package fieldmanager
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Path is the path to the field of the spec in the JSON format of the resource, for example ["conf", "destination", "kuma.io/service"].
+type Path []string
+
+func (p Path) String() string {
+	var sb strings.Builder
+	for i, segment := range p {
+		if strings.ContainsAny(segment, "./[]") {
+			fmt.Fprintf(&sb, "[%q]", segment)
+			continue
+		}
+		if i > 0 {
+			sb.WriteString(".")
+		}
+		sb.WriteString(segment)
+	}
+	return sb.String()
+}
+
+// overlaps returns whether one of the paths is the prefix of the other, so changing one of the fields changes the other.
+func (p Path) overlaps(other Path) bool {
+	n := len(p)
+	if len(other) < n {
+		n = len(other)
+	}
+	for i := 0; i < n; i++ {
+		if p[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (p Path) equal(other Path) bool {
+	return len(p) == len(other) && p.overlaps(other)
+}
+
+// ManagedFields are the fields of the spec owned by every field manager. A field is owned by the manager that applied it last.
+// Many managers own the field when they applied the same value.
+type ManagedFields map[string][]Path
+
+// Conflict is the field that the manager tried to change, but that is owned by other manager with a different value.
+type Conflict struct {
+	Path    Path
+	Manager string
+}
+
+type ConflictError struct {
+	Conflicts []Conflict
+}
+
+func (c *ConflictError) Error() string {
+	var msgs []string
+	for _, conflict := range c.Conflicts {
+		msgs = append(msgs, fmt.Sprintf("%s is owned by %q", conflict.Path, conflict.Manager))
+	}
+	return "conflicts with other field managers: " + strings.Join(msgs, ", ")
+}
+
+func (c *ConflictError) Is(err error) bool {
+	_, ok := err.(*ConflictError)
+	return ok
+}
+
+// Apply merges the fields applied by the manager into the current spec, both in the JSON format of the resource.
+// Only the fields that are in the applied configuration are changed. Fields that the manager applied before,
+// but are not in the applied configuration anymore, are removed unless other manager owns them.
+// Maps are merged key by key, lists and other values are replaced as a whole.
+// When the manager changes the field owned by other manager, ConflictError is returned unless the change is forced,
+// in which case the manager takes the ownership of the field.
+func Apply(current, applied map[string]interface{}, managed ManagedFields, manager string, force bool) (map[string]interface{}, ManagedFields, error) {
+	appliedPaths := leaves(applied, nil)
+
+	var conflicts []Conflict
+	for _, path := range appliedPaths {
+		appliedValue, _ := get(applied, path)
+		currentValue, found := get(current, path)
+		if equal(currentValue, found, appliedValue) {
+			continue
+		}
+		for _, other := range sortedManagers(managed) {
+			if other == manager {
+				continue
+			}
+			for _, owned := range managed[other] {
+				if owned.overlaps(path) {
+					conflicts = append(conflicts, Conflict{Path: path, Manager: other})
+					break
+				}
+			}
+		}
+	}
+	if len(conflicts) > 0 && !force {
+		return nil, nil, &ConflictError{Conflicts: conflicts}
+	}
+
+	merged := deepCopy(current).(map[string]interface{})
+	for _, owned := range managed[manager] {
+		if containsOverlapping(appliedPaths, owned) || ownedByOthers(managed, manager, owned) {
+			continue
+		}
+		remove(merged, owned)
+	}
+	for _, path := range appliedPaths {
+		value, _ := get(applied, path)
+		set(merged, path, deepCopy(value))
+	}
+
+	result := ManagedFields{}
+	for other, paths := range managed {
+		if other == manager {
+			continue
+		}
+		var kept []Path
+		for _, owned := range paths {
+			if !takenOver(conflicts, other, owned) {
+				kept = append(kept, owned)
+			}
+		}
+		if len(kept) > 0 {
+			result[other] = kept
+		}
+	}
+	if len(appliedPaths) > 0 {
+		result[manager] = appliedPaths
+	}
+	return merged, result, nil
+}
+
+func takenOver(conflicts []Conflict, manager string, path Path) bool {
+	for _, conflict := range conflicts {
+		if conflict.Manager == manager && conflict.Path.overlaps(path) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsOverlapping(paths []Path, path Path) bool {
+	for _, p := range paths {
+		if p.overlaps(path) {
+			return true
+		}
+	}
+	return false
+}
+
+func ownedByOthers(managed ManagedFields, manager string, path Path) bool {
+	for other, paths := range managed {
+		if other != manager && containsOverlapping(paths, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedManagers(managed ManagedFields) []string {
+	var managers []string
+	for manager := range managed {
+		managers = append(managers, manager)
+	}
+	sort.Strings(managers)
+	return managers
+}
+
+// leaves returns the paths to the values that are not maps and to the empty maps, sorted.
+func leaves(obj map[string]interface{}, prefix Path) []Path {
+	var keys []string
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var paths []Path
+	for _, key := range keys {
+		path := append(append(Path{}, prefix...), key)
+		if nested, ok := obj[key].(map[string]interface{}); ok && len(nested) > 0 {
+			paths = append(paths, leaves(nested, path)...)
+			continue
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+func get(obj map[string]interface{}, path Path) (interface{}, bool) {
+	var value interface{} = obj
+	for _, segment := range path {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[segment]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func set(obj map[string]interface{}, path Path, value interface{}) {
+	for _, segment := range path[:len(path)-1] {
+		nested, ok := obj[segment].(map[string]interface{})
+		if !ok {
+			nested = map[string]interface{}{}
+			obj[segment] = nested
+		}
+		obj = nested
+	}
+	obj[path[len(path)-1]] = value
+}
+
+// remove deletes the field and the maps that become empty because of it.
+func remove(obj map[string]interface{}, path Path) {
+	if len(path) == 1 {
+		delete(obj, path[0])
+		return
+	}
+	nested, ok := obj[path[0]].(map[string]interface{})
+	if !ok {
+		return
+	}
+	remove(nested, path[1:])
+	if len(nested) == 0 {
+		delete(obj, path[0])
+	}
+}
+
+// equal compares the values in the JSON format. Fields with default values are omitted in the JSON format of the resource,
+// so a missing field is equal to the default value.
+func equal(current interface{}, found bool, applied interface{}) bool {
+	if !found {
+		return isDefault(applied)
+	}
+	return reflect.DeepEqual(current, applied)
+}
+
+func isDefault(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		c := make(map[string]interface{}, len(v))
+		for key, nested := range v {
+			c[key] = deepCopy(nested)
+		}
+		return c
+	case []interface{}:
+		c := make([]interface{}, len(v))
+		for i, nested := range v {
+			c[i] = deepCopy(nested)
+		}
+		return c
+	default:
+		return v
+	}
+}
@@ -0,0 +1,11 @@
+package fieldmanager_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestFieldManager(t *testing.T) {
+	test.RunSpecs(t, "Field Manager Suite")
+}
@@ -0,0 +1,171 @@
+package fieldmanager_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/resources/fieldmanager"
+)
+
+var _ = Describe("Apply", func() {
+	obj := func(s string) map[string]interface{} {
+		m := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(s), &m)).To(Succeed())
+		return m
+	}
+
+	toJSON := func(m map[string]interface{}) string {
+		b, err := json.Marshal(m)
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	It("should take the ownership of the applied fields", func() {
+		// when
+		merged, managed, err := fieldmanager.Apply(
+			obj(`{}`),
+			obj(`{"routing": {"zoneEgress": true}, "tags": ["a"]}`),
+			fieldmanager.ManagedFields{},
+			"kumactl",
+			false,
+		)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(toJSON(merged)).To(MatchJSON(`{"routing": {"zoneEgress": true}, "tags": ["a"]}`))
+		Expect(managed).To(Equal(fieldmanager.ManagedFields{
+			"kumactl": {{"routing", "zoneEgress"}, {"tags"}},
+		}))
+	})
+
+	It("should merge the fields of different managers", func() {
+		// when
+		merged, managed, err := fieldmanager.Apply(
+			obj(`{"routing": {"zoneEgress": true}}`),
+			obj(`{"routing": {"localityAwareLoadBalancing": true}}`),
+			fieldmanager.ManagedFields{"kumactl": {{"routing", "zoneEgress"}}},
+			"gui",
+			false,
+		)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(toJSON(merged)).To(MatchJSON(`{"routing": {"zoneEgress": true, "localityAwareLoadBalancing": true}}`))
+		Expect(managed).To(Equal(fieldmanager.ManagedFields{
+			"kumactl": {{"routing", "zoneEgress"}},
+			"gui":     {{"routing", "localityAwareLoadBalancing"}},
+		}))
+	})
+
+	It("should share the ownership of the fields applied with the same value", func() {
+		// when
+		_, managed, err := fieldmanager.Apply(
+			obj(`{"routing": {"zoneEgress": true}}`),
+			obj(`{"routing": {"zoneEgress": true}}`),
+			fieldmanager.ManagedFields{"kumactl": {{"routing", "zoneEgress"}}},
+			"gui",
+			false,
+		)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managed).To(Equal(fieldmanager.ManagedFields{
+			"kumactl": {{"routing", "zoneEgress"}},
+			"gui":     {{"routing", "zoneEgress"}},
+		}))
+	})
+
+	It("should treat missing fields as the default values", func() {
+		// when
+		_, _, err := fieldmanager.Apply(
+			obj(`{}`),
+			obj(`{"routing": {"zoneEgress": false}}`),
+			fieldmanager.ManagedFields{"kumactl": {{"routing", "zoneEgress"}}},
+			"gui",
+			false,
+		)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should fail when the field owned by other manager is changed", func() {
+		// when
+		_, _, err := fieldmanager.Apply(
+			obj(`{"routing": {"zoneEgress": true}, "mtls": {"enabledBackend": "ca-1"}}`),
+			obj(`{"routing": {"zoneEgress": false}, "mtls": {"enabledBackend": "ca-2"}}`),
+			fieldmanager.ManagedFields{
+				"kumactl": {{"routing", "zoneEgress"}},
+				"gitops":  {{"mtls"}},
+			},
+			"gui",
+			false,
+		)
+
+		// then
+		Expect(err).To(MatchError(`conflicts with other field managers: mtls.enabledBackend is owned by "gitops", routing.zoneEgress is owned by "kumactl"`))
+	})
+
+	It("should take over the ownership when the change is forced", func() {
+		// when
+		merged, managed, err := fieldmanager.Apply(
+			obj(`{"routing": {"zoneEgress": true, "localityAwareLoadBalancing": true}}`),
+			obj(`{"routing": {"zoneEgress": false}}`),
+			fieldmanager.ManagedFields{"kumactl": {{"routing", "zoneEgress"}, {"routing", "localityAwareLoadBalancing"}}},
+			"gui",
+			true,
+		)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(toJSON(merged)).To(MatchJSON(`{"routing": {"zoneEgress": false, "localityAwareLoadBalancing": true}}`))
+		Expect(managed).To(Equal(fieldmanager.ManagedFields{
+			"kumactl": {{"routing", "localityAwareLoadBalancing"}},
+			"gui":     {{"routing", "zoneEgress"}},
+		}))
+	})
+
+	It("should remove the fields that the manager does not apply anymore", func() {
+		// when
+		merged, managed, err := fieldmanager.Apply(
+			obj(`{"routing": {"zoneEgress": true}, "tags": {"a": "1", "b": "2"}}`),
+			obj(`{"tags": {"a": "1"}}`),
+			fieldmanager.ManagedFields{"kumactl": {{"routing", "zoneEgress"}, {"tags", "a"}, {"tags", "b"}}},
+			"kumactl",
+			false,
+		)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(toJSON(merged)).To(MatchJSON(`{"tags": {"a": "1"}}`))
+		Expect(managed).To(Equal(fieldmanager.ManagedFields{
+			"kumactl": {{"tags", "a"}},
+		}))
+	})
+
+	It("should not remove the fields owned also by other managers", func() {
+		// when
+		merged, _, err := fieldmanager.Apply(
+			obj(`{"routing": {"zoneEgress": true}}`),
+			obj(`{}`),
+			fieldmanager.ManagedFields{
+				"kumactl": {{"routing", "zoneEgress"}},
+				"gui":     {{"routing", "zoneEgress"}},
+			},
+			"kumactl",
+			false,
+		)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(toJSON(merged)).To(MatchJSON(`{"routing": {"zoneEgress": true}}`))
+	})
+})
+
+var _ = Describe("Path", func() {
+	It("should quote the segments with dots", func() {
+		Expect(fieldmanager.Path{"conf", "destination", "kuma.io/service"}.String()).To(Equal(`conf.destination["kuma.io/service"]`))
+	})
+})
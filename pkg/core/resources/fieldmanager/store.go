@@ -0,0 +1,71 @@
+package fieldmanager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// Store persists the managed fields of the resources in Config resources. The Config is owned by the resource,
+// so it is deleted together with the resource.
+type Store struct {
+	resManager manager.ResourceManager
+}
+
+func NewStore(resManager manager.ResourceManager) *Store {
+	return &Store{
+		resManager: resManager,
+	}
+}
+
+// Get returns the managed fields of the resource, empty when no manager applied the resource yet.
+func (s *Store) Get(ctx context.Context, res model.Resource) (ManagedFields, error) {
+	config := system.NewConfigResource()
+	if err := s.resManager.Get(ctx, config, store.GetByKey(configName(res), model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return ManagedFields{}, nil
+		}
+		return nil, err
+	}
+	managed := ManagedFields{}
+	if err := json.Unmarshal([]byte(config.Spec.GetConfig()), &managed); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal managed fields")
+	}
+	return managed, nil
+}
+
+// Set persists the managed fields of the resource. The resource has to exist.
+func (s *Store) Set(ctx context.Context, res model.Resource, managed ManagedFields) error {
+	bytes, err := json.Marshal(managed)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal managed fields")
+	}
+	config := system.NewConfigResource()
+	key := model.ResourceKey{Name: configName(res), Mesh: model.NoMesh}
+	if err := s.resManager.Get(ctx, config, store.GetBy(key)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return err
+		}
+		config.Spec.Config = string(bytes)
+		return s.resManager.Create(ctx, config, store.CreateBy(key), store.CreateWithOwner(res))
+	}
+	if config.Spec.Config == string(bytes) {
+		return nil
+	}
+	config.Spec.Config = string(bytes)
+	return s.resManager.Update(ctx, config)
+}
+
+// configName is derived from the key of the resource, which can be too long to be a part of the name.
+func configName(res model.Resource) string {
+	hash := sha256.Sum256([]byte(string(res.Descriptor().Name) + "/" + res.GetMeta().GetMesh() + "/" + res.GetMeta().GetName()))
+	return "kuma-managed-fields-" + hex.EncodeToString(hash[:16])
+}
@@ -3,34 +3,53 @@ package manager
 import (
 	"context"
 	"fmt"
-	"sync"
+	"strings"
 	"time"
 
 	"github.com/patrickmn/go-cache"
 	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/events"
 	"github.com/kumahq/kuma/pkg/metrics"
 )
 
+// CachedManager is a ReadOnlyResourceManager which cached resources can be invalidated before they expire.
+type CachedManager interface {
+	ReadOnlyResourceManager
+	// Invalidate removes all cached Gets and Lists of the resource type.
+	Invalidate(model.ResourceType)
+}
+
 // Cached version of the ReadOnlyResourceManager designed to be used only for use cases of eventual consistency.
 // This cache is NOT consistent across instances of the control plane.
 //
 // When retrieving elements from cache, they point to the same instances of the resources.
 // We cannot do deep copies because it would consume lots of memory, therefore you need to be extra careful to NOT modify the resources.
 type cachedManager struct {
-	delegate ReadOnlyResourceManager
-	cache    *cache.Cache
-	metrics  *prometheus.CounterVec
+	delegate            ReadOnlyResourceManager
+	cache               *cache.Cache
+	expirationTime      time.Duration
+	typeExpirationTimes map[model.ResourceType]time.Duration
+	metrics             *prometheus.CounterVec
 
-	mutexes  map[string]*sync.Mutex
-	mapMutex sync.Mutex // guards "mutexes" field
+	// group deduplicates concurrent queries of the same key, so only one of them reaches the delegate
+	group singleflight.Group
 }
 
-var _ ReadOnlyResourceManager = &cachedManager{}
+var _ CachedManager = &cachedManager{}
 
-func NewCachedManager(delegate ReadOnlyResourceManager, expirationTime time.Duration, metrics metrics.Metrics) (ReadOnlyResourceManager, error) {
+// NewCachedManager builds a cache of Get and List queries.
+// The expiration time of resources of a type can be overridden with typeExpirationTimes. Types with expiration time 0 are not cached.
+func NewCachedManager(
+	delegate ReadOnlyResourceManager,
+	expirationTime time.Duration,
+	typeExpirationTimes map[model.ResourceType]time.Duration,
+	metrics metrics.Metrics,
+) (CachedManager, error) {
 	metric := prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "store_cache",
 		Help: "Summary of Store Cache",
@@ -39,101 +58,160 @@ func NewCachedManager(delegate ReadOnlyResourceManager, expirationTime time.Dura
 		return nil, err
 	}
 	return &cachedManager{
-		delegate: delegate,
-		cache:    cache.New(expirationTime, time.Duration(int64(float64(expirationTime)*0.9))),
-		metrics:  metric,
-		mutexes:  map[string]*sync.Mutex{},
+		delegate:            delegate,
+		cache:               cache.New(expirationTime, time.Duration(int64(float64(expirationTime)*0.9))),
+		expirationTime:      expirationTime,
+		typeExpirationTimes: typeExpirationTimes,
+		metrics:             metric,
 	}, nil
 }
 
 func (c *cachedManager) Get(ctx context.Context, res model.Resource, fs ...store.GetOptionsFunc) error {
+	resourceType := res.Descriptor().Name
+	expirationTime := c.expirationTimeFor(resourceType)
+	if expirationTime == 0 {
+		c.metrics.WithLabelValues("get", string(resourceType), "disabled").Inc()
+		return c.delegate.Get(ctx, res, fs...)
+	}
+
 	opts := store.NewGetOptions(fs...)
-	cacheKey := fmt.Sprintf("GET:%s:%s", res.Descriptor().Name, opts.HashCode())
+	cacheKey := fmt.Sprintf("GET:%s:%s", resourceType, opts.HashCode())
 	obj, found := c.cache.Get(cacheKey)
 	if !found {
 		// There might be a situation when cache just expired and there are many concurrent goroutines here.
 		// We should only let one fill the cache and let the rest of them wait for it. Otherwise we will be repeating expensive work.
-		mutex := c.mutexFor(cacheKey)
-		mutex.Lock()
-		obj, found = c.cache.Get(cacheKey)
-		if !found {
-			// After many goroutines are unlocked one by one, only one should execute this branch, the rest should retrieve object from the cache
-			c.metrics.WithLabelValues("get", string(res.Descriptor().Name), "miss").Inc()
+		// fetched is set only by the goroutine which executed the query
+		fetched := false
+		result, err, _ := c.group.Do(cacheKey, func() (interface{}, error) {
+			// Query that just finished could have filled the cache in the meantime
+			if obj, found := c.cache.Get(cacheKey); found {
+				return obj, nil
+			}
 			if err := c.delegate.Get(ctx, res, fs...); err != nil {
-				mutex.Unlock()
-				return err
+				return nil, err
 			}
-			c.cache.SetDefault(cacheKey, res)
-		} else {
-			c.metrics.WithLabelValues("get", string(res.Descriptor().Name), "hit-wait").Inc()
+			c.cache.Set(cacheKey, res, expirationTime)
+			fetched = true
+			return res, nil
+		})
+		if err != nil {
+			return err
 		}
-		mutex.Unlock()
-		c.cleanMutexFor(cacheKey) // We need to cleanup mutexes from the map, otherwise we can see the memory leak.
+		if fetched {
+			c.metrics.WithLabelValues("get", string(resourceType), "miss").Inc()
+			return nil
+		}
+		c.metrics.WithLabelValues("get", string(resourceType), "hit-wait").Inc()
+		obj = result
 	} else {
-		c.metrics.WithLabelValues("get", string(res.Descriptor().Name), "hit").Inc()
+		c.metrics.WithLabelValues("get", string(resourceType), "hit").Inc()
 	}
 
-	if found {
-		cached := obj.(model.Resource)
-		if err := res.SetSpec(cached.GetSpec()); err != nil {
-			return err
-		}
-		res.SetMeta(cached.GetMeta())
+	cached := obj.(model.Resource)
+	if err := res.SetSpec(cached.GetSpec()); err != nil {
+		return err
 	}
+	res.SetMeta(cached.GetMeta())
 	return nil
 }
 
 func (c *cachedManager) List(ctx context.Context, list model.ResourceList, fs ...store.ListOptionsFunc) error {
+	resourceType := list.GetItemType()
+	expirationTime := c.expirationTimeFor(resourceType)
+	if expirationTime == 0 {
+		c.metrics.WithLabelValues("list", string(resourceType), "disabled").Inc()
+		return c.delegate.List(ctx, list, fs...)
+	}
+
 	opts := store.NewListOptions(fs...)
-	cacheKey := fmt.Sprintf("LIST:%s:%s", list.GetItemType(), opts.HashCode())
+	cacheKey := fmt.Sprintf("LIST:%s:%s", resourceType, opts.HashCode())
 	obj, found := c.cache.Get(cacheKey)
 	if !found {
 		// There might be a situation when cache just expired and there are many concurrent goroutines here.
 		// We should only let one fill the cache and let the rest of them wait for it. Otherwise we will be repeating expensive work.
-		mutex := c.mutexFor(cacheKey)
-		mutex.Lock()
-		obj, found = c.cache.Get(cacheKey)
-		if !found {
-			// After many goroutines are unlocked one by one, only one should execute this branch, the rest should retrieve object from the cache
-			c.metrics.WithLabelValues("list", string(list.GetItemType()), "miss").Inc()
+		// fetched is set only by the goroutine which executed the query
+		fetched := false
+		result, err, _ := c.group.Do(cacheKey, func() (interface{}, error) {
+			// Query that just finished could have filled the cache in the meantime
+			if obj, found := c.cache.Get(cacheKey); found {
+				return obj, nil
+			}
 			if err := c.delegate.List(ctx, list, fs...); err != nil {
-				mutex.Unlock()
-				return err
+				return nil, err
 			}
-			c.cache.SetDefault(cacheKey, list.GetItems())
-		} else {
-			c.metrics.WithLabelValues("list", string(list.GetItemType()), "hit-wait").Inc()
+			c.cache.Set(cacheKey, list.GetItems(), expirationTime)
+			fetched = true
+			return list.GetItems(), nil
+		})
+		if err != nil {
+			return err
+		}
+		if fetched {
+			c.metrics.WithLabelValues("list", string(resourceType), "miss").Inc()
+			return nil
 		}
-		mutex.Unlock()
-		c.cleanMutexFor(cacheKey) // We need to cleanup mutexes from the map, otherwise we can see the memory leak.
+		c.metrics.WithLabelValues("list", string(resourceType), "hit-wait").Inc()
+		obj = result
 	} else {
-		c.metrics.WithLabelValues("list", string(list.GetItemType()), "hit").Inc()
+		c.metrics.WithLabelValues("list", string(resourceType), "hit").Inc()
 	}
 
-	if found {
-		resources := obj.([]model.Resource)
-		for _, res := range resources {
-			if err := list.AddItem(res); err != nil {
-				return err
-			}
+	for _, res := range obj.([]model.Resource) {
+		if err := list.AddItem(res); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func (c *cachedManager) mutexFor(key string) *sync.Mutex {
-	c.mapMutex.Lock()
-	defer c.mapMutex.Unlock()
-	mutex, exist := c.mutexes[key]
-	if !exist {
-		mutex = &sync.Mutex{}
-		c.mutexes[key] = mutex
+func (c *cachedManager) Invalidate(resourceType model.ResourceType) {
+	getPrefix := fmt.Sprintf("GET:%s:", resourceType)
+	listPrefix := fmt.Sprintf("LIST:%s:", resourceType)
+	for key := range c.cache.Items() {
+		if strings.HasPrefix(key, getPrefix) || strings.HasPrefix(key, listPrefix) {
+			c.cache.Delete(key)
+		}
+	}
+}
+
+func (c *cachedManager) expirationTimeFor(resourceType model.ResourceType) time.Duration {
+	if expirationTime, ok := c.typeExpirationTimes[resourceType]; ok {
+		return expirationTime
+	}
+	return c.expirationTime
+}
+
+type cacheInvalidator struct {
+	cache        CachedManager
+	eventFactory events.ListenerFactory
+}
+
+// NewCacheInvalidator builds a component that invalidates the cache as soon as the resources are changed.
+// Without it, the changes are visible only after the cache expires.
+func NewCacheInvalidator(cache CachedManager, eventFactory events.ListenerFactory) component.Component {
+	return &cacheInvalidator{
+		cache:        cache,
+		eventFactory: eventFactory,
+	}
+}
+
+func (c *cacheInvalidator) Start(stop <-chan struct{}) error {
+	listener := c.eventFactory.New()
+	defer listener.Close()
+	for {
+		event, err := listener.Recv(stop)
+		if err == events.ListenerStoppedErr {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if resourceChanged, ok := event.(events.ResourceChangedEvent); ok {
+			c.cache.Invalidate(resourceChanged.Type)
+		}
 	}
-	return mutex
 }
 
-func (c *cachedManager) cleanMutexFor(key string) {
-	c.mapMutex.Lock()
-	delete(c.mutexes, key)
-	c.mapMutex.Unlock()
+func (c *cacheInvalidator) NeedLeaderElection() bool {
+	return false
 }
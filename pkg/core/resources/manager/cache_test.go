@@ -45,7 +45,7 @@ var _ core_manager.ReadOnlyResourceManager = &countingResourcesManager{}
 var _ = Describe("Cached Resource Manager", func() {
 
 	var store core_store.ResourceStore
-	var cachedManager core_manager.ReadOnlyResourceManager
+	var cachedManager core_manager.CachedManager
 	var countingManager *countingResourcesManager
 	var res *core_mesh.DataplaneResource
 	var metrics core_metrics.Metrics
@@ -60,7 +60,10 @@ var _ = Describe("Cached Resource Manager", func() {
 		m, err := core_metrics.NewMetrics("Standalone")
 		metrics = m
 		Expect(err).ToNot(HaveOccurred())
-		cachedManager, err = core_manager.NewCachedManager(countingManager, expiration, metrics)
+		cachedManager, err = core_manager.NewCachedManager(countingManager, expiration, map[core_model.ResourceType]time.Duration{
+			core_mesh.MeshType:       0,
+			core_mesh.TrafficLogType: time.Minute,
+		}, metrics)
 		Expect(err).ToNot(HaveOccurred())
 
 		// and created resources
@@ -138,8 +141,15 @@ var _ = Describe("Cached Resource Manager", func() {
 		}
 		wg.Wait()
 
-		// then real manager should be called every time
-		Expect(countingManager.getQueries).To(Equal(100))
+		// then concurrent queries are deduplicated
+		queries := countingManager.getQueries
+		Expect(queries).To(BeNumerically("<=", 100))
+
+		// when fetched again
+		fetch()
+
+		// then real manager is called again because not found is not cached
+		Expect(countingManager.getQueries).To(Equal(queries + 1))
 	})
 
 	It("should cache List() queries", func() {
@@ -187,6 +197,47 @@ var _ = Describe("Cached Resource Manager", func() {
 		Expect(hits + hitWaits).To(Equal(100.0))
 	})
 
+	It("should invalidate cached queries of the changed type", func() {
+		// given cached Get() and List() of Dataplanes and List() of Meshes
+		Expect(cachedManager.Get(context.Background(), core_mesh.NewDataplaneResource(), core_store.GetByKey("dp-1", "default"))).To(Succeed())
+		Expect(cachedManager.List(context.Background(), &core_mesh.DataplaneResourceList{}, core_store.ListByMesh("default"))).To(Succeed())
+		Expect(cachedManager.List(context.Background(), &core_mesh.TrafficLogResourceList{}, core_store.ListByMesh("default"))).To(Succeed())
+
+		// when
+		cachedManager.Invalidate(core_mesh.DataplaneType)
+
+		// then queries of Dataplanes are executed again
+		Expect(cachedManager.Get(context.Background(), core_mesh.NewDataplaneResource(), core_store.GetByKey("dp-1", "default"))).To(Succeed())
+		Expect(cachedManager.List(context.Background(), &core_mesh.DataplaneResourceList{}, core_store.ListByMesh("default"))).To(Succeed())
+		Expect(countingManager.getQueries).To(Equal(2))
+
+		// and queries of other types are still cached
+		Expect(cachedManager.List(context.Background(), &core_mesh.TrafficLogResourceList{}, core_store.ListByMesh("default"))).To(Succeed())
+		Expect(countingManager.listQueries).To(Equal(3))
+	})
+
+	It("should use expiration time of the type", func() {
+		// given cached List() of TrafficLogs
+		Expect(cachedManager.List(context.Background(), &core_mesh.TrafficLogResourceList{}, core_store.ListByMesh("default"))).To(Succeed())
+
+		// when default expiration time passes
+		time.Sleep(expiration)
+
+		// then TrafficLogs are still cached
+		Expect(cachedManager.List(context.Background(), &core_mesh.TrafficLogResourceList{}, core_store.ListByMesh("default"))).To(Succeed())
+		Expect(countingManager.listQueries).To(Equal(1))
+	})
+
+	It("should not cache types with expiration time 0", func() {
+		// when
+		Expect(cachedManager.List(context.Background(), &core_mesh.MeshResourceList{})).To(Succeed())
+		Expect(cachedManager.List(context.Background(), &core_mesh.MeshResourceList{})).To(Succeed())
+
+		// then
+		Expect(countingManager.listQueries).To(Equal(2))
+		Expect(test_metrics.FindMetric(metrics, "store_cache", "operation", "list", "resource_type", "Mesh", "result", "disabled").Counter.GetValue()).To(Equal(2.0))
+	})
+
 	It("should let concurrent List() queries for different types and meshes", test.Within(5*time.Second, func() {
 		// given ongoing TrafficLog from mesh slow that takes a lot of time to complete
 		go func() {
@@ -66,6 +66,9 @@ func (r *resourcesManager) Create(ctx context.Context, resource model.Resource,
 		}
 	}
 
+	if opts.DryRun {
+		return nil
+	}
 	return r.Store.Create(ctx, resource, append(fs, store.CreatedAt(core.Now()), store.CreateWithOwner(owner))...)
 }
 
@@ -94,6 +97,9 @@ func (r *resourcesManager) Update(ctx context.Context, resource model.Resource,
 	if err := resource.Validate(); err != nil {
 		return err
 	}
+	if store.NewUpdateOptions(fs...).DryRun {
+		return nil
+	}
 	return r.Store.Update(ctx, resource, append(fs, store.ModifiedAt(time.Now()))...)
 }
 
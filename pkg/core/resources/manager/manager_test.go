@@ -65,6 +65,40 @@ var _ = Describe("Resource Manager", func() {
 			// then
 			Expect(err.Error()).To(Equal("mesh of name mesh-1 is not found"))
 		})
+
+		It("should not persist a resource in dry run", func() {
+			// given
+			err := createSampleMesh("mesh-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			trRes := sample.TrafficRouteResource{
+				Spec: &v1alpha1.TrafficRoute{
+					Path: "/some",
+				},
+			}
+			err = resManager.Create(context.Background(), &trRes, store.CreateByKey("tr-1", "mesh-1"), store.CreateDryRun())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			err = resStore.Get(context.Background(), sample.NewTrafficRouteResource(), store.GetByKey("tr-1", "mesh-1"))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should validate a resource in dry run", func() {
+			// given no mesh for resource
+
+			// when
+			trRes := sample.TrafficRouteResource{
+				Spec: &v1alpha1.TrafficRoute{
+					Path: "/some",
+				},
+			}
+			err := resManager.Create(context.Background(), &trRes, store.CreateByKey("tr-1", "mesh-1"), store.CreateDryRun())
+
+			// then
+			Expect(err.Error()).To(Equal("mesh of name mesh-1 is not found"))
+		})
 	})
 
 	Describe("DeleteAll()", func() {
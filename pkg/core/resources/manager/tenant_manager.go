@@ -0,0 +1,331 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	core_access "github.com/kumahq/kuma/pkg/core/access"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+// NewTenantResourceManager returns the manager that isolates tenants from each other.
+// Operations executed on behalf of a user of a tenant can only access meshes of the tenant, resources of these meshes,
+// zones of the tenant and zone ingresses and zone egresses of these zones. These global resources are read-only for such users,
+// other global resources, like global secrets, are not accessible at all.
+// Operations executed on behalf of users without a tenant and operations of the Control Plane itself are not restricted.
+func NewTenantResourceManager(delegate ResourceManager) ResourceManager {
+	return &tenantResourceManager{
+		delegate: delegate,
+	}
+}
+
+var _ ResourceManager = &tenantResourceManager{}
+
+type tenantResourceManager struct {
+	delegate ResourceManager
+}
+
+func tenantFromCtx(ctx context.Context) (string, bool) {
+	u, ok := user.Lookup(ctx)
+	if !ok || u.Tenant == "" {
+		return "", false
+	}
+	return u.Tenant, true
+}
+
+func (t *tenantResourceManager) Get(ctx context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
+	tenant, ok := tenantFromCtx(ctx)
+	if !ok {
+		return t.delegate.Get(ctx, resource, fs...)
+	}
+	if err := t.delegate.Get(ctx, resource, fs...); err != nil {
+		return err
+	}
+	visible, err := t.visible(ctx, tenant, resource)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		// resources of other tenants are reported as not existing, so the user cannot learn about them
+		opts := store.NewGetOptions(fs...)
+		resource.SetMeta(nil)
+		resource.GetSpec().Reset()
+		return store.ErrorResourceNotFound(resource.Descriptor().Name, opts.Name, opts.Mesh)
+	}
+	return nil
+}
+
+func (t *tenantResourceManager) List(ctx context.Context, list model.ResourceList, fs ...store.ListOptionsFunc) error {
+	tenant, ok := tenantFromCtx(ctx)
+	if !ok {
+		return t.delegate.List(ctx, list, fs...)
+	}
+	meshes, err := t.meshesOfTenant(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	zones, err := t.zonesOfTenant(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	// insights of zone ingresses and zone egresses belong to the zone of the proxy with the same name
+	var proxyZones map[string]string
+	switch list.GetItemType() {
+	case core_mesh.ZoneIngressInsightType:
+		if proxyZones, err = t.zonesOfProxies(ctx, &core_mesh.ZoneIngressResourceList{}); err != nil {
+			return err
+		}
+	case core_mesh.ZoneEgressInsightType:
+		if proxyZones, err = t.zonesOfProxies(ctx, &core_mesh.ZoneEgressResourceList{}); err != nil {
+			return err
+		}
+	}
+	// filtering is done by the store before the pagination, so pages of the tenant are complete
+	filter := store.NewListOptions(fs...).FilterFunc
+	return t.delegate.List(ctx, list, append(fs, store.ListByFilterFunc(func(rs model.Resource) bool {
+		if filter != nil && !filter(rs) {
+			return false
+		}
+		switch rs.Descriptor().Name {
+		case core_mesh.MeshType, core_mesh.MeshInsightType:
+			return meshes[rs.GetMeta().GetName()]
+		case system.ZoneType, system.ZoneInsightType:
+			return zones[rs.GetMeta().GetName()]
+		case core_mesh.ZoneIngressType, core_mesh.ZoneEgressType:
+			return zones[zoneOfProxy(rs)]
+		case core_mesh.ZoneIngressInsightType, core_mesh.ZoneEgressInsightType:
+			return zones[proxyZones[rs.GetMeta().GetName()]]
+		}
+		if rs.Descriptor().Scope == model.ScopeMesh {
+			return meshes[rs.GetMeta().GetMesh()]
+		}
+		return false
+	}))...)
+}
+
+func (t *tenantResourceManager) Create(ctx context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+	tenant, ok := tenantFromCtx(ctx)
+	if !ok {
+		return t.delegate.Create(ctx, resource, fs...)
+	}
+	opts := store.NewCreateOptions(fs...)
+	switch {
+	case resource.Descriptor().Name == core_mesh.MeshType:
+		if err := assignTenant(resource.(*core_mesh.MeshResource), tenant); err != nil {
+			return err
+		}
+	case resource.Descriptor().Scope == model.ScopeMesh:
+		if err := t.ensureMeshOfTenant(ctx, tenant, opts.Mesh); err != nil {
+			return err
+		}
+	default:
+		return readOnlyForTenant(resource.Descriptor().Name)
+	}
+	return t.delegate.Create(ctx, resource, fs...)
+}
+
+func (t *tenantResourceManager) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+	tenant, ok := tenantFromCtx(ctx)
+	if !ok {
+		return t.delegate.Update(ctx, resource, fs...)
+	}
+	switch {
+	case resource.Descriptor().Name == core_mesh.MeshType:
+		if err := t.ensureMeshOfTenant(ctx, tenant, resource.GetMeta().GetName()); err != nil {
+			return notFoundIfMeshNotFound(err, core_mesh.MeshType, resource.GetMeta().GetName(), model.NoMesh)
+		}
+		if err := assignTenant(resource.(*core_mesh.MeshResource), tenant); err != nil {
+			return err
+		}
+	case resource.Descriptor().Scope == model.ScopeMesh:
+		if err := t.ensureMeshOfTenant(ctx, tenant, resource.GetMeta().GetMesh()); err != nil {
+			return err
+		}
+	default:
+		return readOnlyForTenant(resource.Descriptor().Name)
+	}
+	return t.delegate.Update(ctx, resource, fs...)
+}
+
+func (t *tenantResourceManager) Delete(ctx context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+	tenant, ok := tenantFromCtx(ctx)
+	if !ok {
+		return t.delegate.Delete(ctx, resource, fs...)
+	}
+	opts := store.NewDeleteOptions(fs...)
+	switch {
+	case resource.Descriptor().Name == core_mesh.MeshType:
+		if err := t.ensureMeshOfTenant(ctx, tenant, opts.Name); err != nil {
+			return notFoundIfMeshNotFound(err, core_mesh.MeshType, opts.Name, model.NoMesh)
+		}
+	case resource.Descriptor().Scope == model.ScopeMesh:
+		if err := t.ensureMeshOfTenant(ctx, tenant, opts.Mesh); err != nil {
+			return notFoundIfMeshNotFound(err, resource.Descriptor().Name, opts.Name, opts.Mesh)
+		}
+	default:
+		return readOnlyForTenant(resource.Descriptor().Name)
+	}
+	return t.delegate.Delete(ctx, resource, fs...)
+}
+
+func (t *tenantResourceManager) DeleteAll(ctx context.Context, list model.ResourceList, fs ...store.DeleteAllOptionsFunc) error {
+	if _, ok := tenantFromCtx(ctx); !ok {
+		return t.delegate.DeleteAll(ctx, list, fs...)
+	}
+	return DeleteAllResources(t, ctx, list, fs...)
+}
+
+// visible returns whether the resource can be accessed by the user of the tenant.
+func (t *tenantResourceManager) visible(ctx context.Context, tenant string, resource model.Resource) (bool, error) {
+	switch resource.Descriptor().Name {
+	case core_mesh.MeshType:
+		return resource.(*core_mesh.MeshResource).Spec.GetTenant() == tenant, nil
+	case core_mesh.MeshInsightType:
+		return t.meshOfTenant(ctx, tenant, resource.GetMeta().GetName())
+	case system.ZoneType:
+		return resource.(*system.ZoneResource).Spec.GetTenant() == tenant, nil
+	case system.ZoneInsightType:
+		return t.zoneOfTenant(ctx, tenant, resource.GetMeta().GetName())
+	case core_mesh.ZoneIngressType, core_mesh.ZoneEgressType:
+		return t.zoneOfTenant(ctx, tenant, zoneOfProxy(resource))
+	case core_mesh.ZoneIngressInsightType:
+		return t.proxyOfTenant(ctx, tenant, core_mesh.NewZoneIngressResource(), resource.GetMeta().GetName())
+	case core_mesh.ZoneEgressInsightType:
+		return t.proxyOfTenant(ctx, tenant, core_mesh.NewZoneEgressResource(), resource.GetMeta().GetName())
+	}
+	if resource.Descriptor().Scope == model.ScopeMesh {
+		return t.meshOfTenant(ctx, tenant, resource.GetMeta().GetMesh())
+	}
+	return false, nil
+}
+
+func (t *tenantResourceManager) zoneOfTenant(ctx context.Context, tenant string, name string) (bool, error) {
+	if name == "" {
+		return false, nil // zone ingresses and zone egresses without a zone are not owned by any tenant
+	}
+	zone := system.NewZoneResource()
+	if err := t.delegate.Get(ctx, zone, store.GetByKey(name, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return zone.Spec.GetTenant() == tenant, nil
+}
+
+// proxyOfTenant returns whether the zone ingress or the zone egress of the given name is in a zone of the tenant.
+func (t *tenantResourceManager) proxyOfTenant(ctx context.Context, tenant string, proxy model.Resource, name string) (bool, error) {
+	if err := t.delegate.Get(ctx, proxy, store.GetByKey(name, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return t.zoneOfTenant(ctx, tenant, zoneOfProxy(proxy))
+}
+
+// zoneOfProxy returns the zone of the zone ingress or the zone egress.
+func zoneOfProxy(proxy model.Resource) string {
+	switch p := proxy.(type) {
+	case *core_mesh.ZoneIngressResource:
+		return p.Spec.GetZone()
+	case *core_mesh.ZoneEgressResource:
+		return p.Spec.GetZone()
+	}
+	return ""
+}
+
+func (t *tenantResourceManager) meshOfTenant(ctx context.Context, tenant string, mesh string) (bool, error) {
+	meshRes := core_mesh.NewMeshResource()
+	if err := t.delegate.Get(ctx, meshRes, store.GetByKey(mesh, model.NoMesh)); err != nil {
+		if store.IsResourceNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return meshRes.Spec.GetTenant() == tenant, nil
+}
+
+// ensureMeshOfTenant returns MeshNotFoundError when the mesh is not owned by the tenant, so the user cannot learn
+// about meshes of other tenants.
+func (t *tenantResourceManager) ensureMeshOfTenant(ctx context.Context, tenant string, mesh string) error {
+	ownedByTenant, err := t.meshOfTenant(ctx, tenant, mesh)
+	if err != nil {
+		return err
+	}
+	if !ownedByTenant {
+		return MeshNotFound(mesh)
+	}
+	return nil
+}
+
+func (t *tenantResourceManager) meshesOfTenant(ctx context.Context, tenant string) (map[string]bool, error) {
+	meshes := core_mesh.MeshResourceList{}
+	if err := t.delegate.List(ctx, &meshes); err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for _, mesh := range meshes.Items {
+		if mesh.Spec.GetTenant() == tenant {
+			names[mesh.GetMeta().GetName()] = true
+		}
+	}
+	return names, nil
+}
+
+func (t *tenantResourceManager) zonesOfTenant(ctx context.Context, tenant string) (map[string]bool, error) {
+	zones := system.ZoneResourceList{}
+	if err := t.delegate.List(ctx, &zones); err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for _, zone := range zones.Items {
+		if zone.Spec.GetTenant() == tenant {
+			names[zone.GetMeta().GetName()] = true
+		}
+	}
+	return names, nil
+}
+
+// zonesOfProxies returns zones of zone ingresses or zone egresses by their names.
+func (t *tenantResourceManager) zonesOfProxies(ctx context.Context, proxies model.ResourceList) (map[string]string, error) {
+	if err := t.delegate.List(ctx, proxies); err != nil {
+		return nil, err
+	}
+	zones := map[string]string{}
+	for _, proxy := range proxies.GetItems() {
+		zones[proxy.GetMeta().GetName()] = zoneOfProxy(proxy)
+	}
+	return zones, nil
+}
+
+func notFoundIfMeshNotFound(err error, resourceType model.ResourceType, name string, mesh string) error {
+	if IsMeshNotFound(err) {
+		return store.ErrorResourceNotFound(resourceType, name, mesh)
+	}
+	return err
+}
+
+// assignTenant places the mesh in the tenant of the user. The user cannot place the mesh in other tenant.
+func assignTenant(mesh *core_mesh.MeshResource, tenant string) error {
+	switch mesh.Spec.GetTenant() {
+	case "":
+		mesh.Spec.Tenant = tenant
+	case tenant:
+	default:
+		return &core_access.AccessDeniedError{
+			Reason: fmt.Sprintf("mesh can only be placed in the tenant %q of the user", tenant),
+		}
+	}
+	return nil
+}
+
+func readOnlyForTenant(resourceType model.ResourceType) error {
+	return &core_access.AccessDeniedError{
+		Reason: fmt.Sprintf("%s can only be changed by users without a tenant", resourceType),
+	}
+}
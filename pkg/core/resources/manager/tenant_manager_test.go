@@ -0,0 +1,200 @@
+package manager_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	core_access "github.com/kumahq/kuma/pkg/core/access"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/apis/sample/v1alpha1"
+	"github.com/kumahq/kuma/pkg/test/resources/apis/sample"
+)
+
+var _ = Describe("Tenant Resource Manager", func() {
+
+	var resManager manager.ResourceManager
+	var tenantManager manager.ResourceManager
+	var tenantCtx context.Context
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(store.NewPaginationStore(memory.NewStore()))
+		tenantManager = manager.NewTenantResourceManager(resManager)
+		tenantCtx = user.Ctx(context.Background(), user.User{Name: "john", Tenant: "team-a"})
+
+		for name, tenant := range map[string]string{"mesh-a": "team-a", "mesh-b": "team-b", "shared": ""} {
+			meshRes := mesh.NewMeshResource()
+			meshRes.Spec.Tenant = tenant
+			Expect(resManager.Create(context.Background(), meshRes, store.CreateByKey(name, model.NoMesh))).To(Succeed())
+			Expect(resManager.Create(context.Background(), &sample.TrafficRouteResource{
+				Spec: &v1alpha1.TrafficRoute{Path: "/" + name},
+			}, store.CreateByKey("tr-1", name))).To(Succeed())
+		}
+		for name, tenant := range map[string]string{"zone-a": "team-a", "zone-b": "team-b"} {
+			zone := system.NewZoneResource()
+			zone.Spec = &system_proto.Zone{Tenant: tenant}
+			Expect(resManager.Create(context.Background(), zone, store.CreateByKey(name, model.NoMesh))).To(Succeed())
+
+			zoneIngress := mesh.NewZoneIngressResource()
+			zoneIngress.Spec = &mesh_proto.ZoneIngress{
+				Zone: name,
+				Networking: &mesh_proto.ZoneIngress_Networking{
+					Address: "192.168.0.1",
+					Port:    10001,
+				},
+			}
+			Expect(resManager.Create(context.Background(), zoneIngress, store.CreateByKey("ingress-"+name, model.NoMesh))).To(Succeed())
+			Expect(resManager.Create(context.Background(), mesh.NewZoneIngressInsightResource(), store.CreateByKey("ingress-"+name, model.NoMesh))).To(Succeed())
+		}
+		Expect(resManager.Create(context.Background(), system.NewGlobalSecretResource(), store.CreateByKey("secret-1", model.NoMesh))).To(Succeed())
+	})
+
+	It("should list only resources of the tenant", func() {
+		// when
+		meshes := mesh.MeshResourceList{}
+		Expect(tenantManager.List(tenantCtx, &meshes)).To(Succeed())
+		routes := sample.TrafficRouteResourceList{}
+		Expect(tenantManager.List(tenantCtx, &routes, store.ListByPage(10, ""))).To(Succeed())
+		zones := system.ZoneResourceList{}
+		Expect(tenantManager.List(tenantCtx, &zones)).To(Succeed())
+
+		// then
+		Expect(meshes.Items).To(HaveLen(1))
+		Expect(meshes.Items[0].GetMeta().GetName()).To(Equal("mesh-a"))
+		Expect(routes.Items).To(HaveLen(1))
+		Expect(routes.Items[0].GetMeta().GetMesh()).To(Equal("mesh-a"))
+		Expect(routes.GetPagination().Total).To(Equal(uint32(1)))
+		Expect(zones.Items).To(HaveLen(1))
+		Expect(zones.Items[0].GetMeta().GetName()).To(Equal("zone-a"))
+	})
+
+	It("should list only zone ingresses and their insights of zones of the tenant", func() {
+		// when
+		zoneIngresses := mesh.ZoneIngressResourceList{}
+		Expect(tenantManager.List(tenantCtx, &zoneIngresses)).To(Succeed())
+		insights := mesh.ZoneIngressInsightResourceList{}
+		Expect(tenantManager.List(tenantCtx, &insights)).To(Succeed())
+
+		// then
+		Expect(zoneIngresses.Items).To(HaveLen(1))
+		Expect(zoneIngresses.Items[0].GetMeta().GetName()).To(Equal("ingress-zone-a"))
+		Expect(insights.Items).To(HaveLen(1))
+		Expect(insights.Items[0].GetMeta().GetName()).To(Equal("ingress-zone-a"))
+
+		// when
+		err := tenantManager.Get(tenantCtx, mesh.NewZoneIngressInsightResource(), store.GetByKey("ingress-zone-b", model.NoMesh))
+
+		// then
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should not expose other global resources", func() {
+		// when
+		secrets := system.GlobalSecretResourceList{}
+		Expect(tenantManager.List(tenantCtx, &secrets)).To(Succeed())
+		err := tenantManager.Get(tenantCtx, system.NewGlobalSecretResource(), store.GetByKey("secret-1", model.NoMesh))
+
+		// then
+		Expect(secrets.Items).To(BeEmpty())
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+	})
+
+	It("should not list resources of other tenants in a mesh", func() {
+		// when
+		routes := sample.TrafficRouteResourceList{}
+		err := tenantManager.List(tenantCtx, &routes, store.ListByMesh("mesh-b"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(routes.Items).To(BeEmpty())
+	})
+
+	It("should report resources of other tenants as not found", func() {
+		// when
+		err := tenantManager.Get(tenantCtx, mesh.NewMeshResource(), store.GetByKey("mesh-b", model.NoMesh))
+
+		// then
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+
+		// when
+		err = tenantManager.Get(tenantCtx, sample.NewTrafficRouteResource(), store.GetByKey("tr-1", "mesh-b"))
+
+		// then
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+
+		// when
+		err = tenantManager.Delete(tenantCtx, sample.NewTrafficRouteResource(), store.DeleteByKey("tr-1", "mesh-b"))
+
+		// then
+		Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		Expect(resManager.Get(context.Background(), sample.NewTrafficRouteResource(), store.GetByKey("tr-1", "mesh-b"))).To(Succeed())
+	})
+
+	It("should place created meshes in the tenant of the user", func() {
+		// when
+		err := tenantManager.Create(tenantCtx, mesh.NewMeshResource(), store.CreateByKey("new-mesh", model.NoMesh))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		meshRes := mesh.NewMeshResource()
+		Expect(resManager.Get(context.Background(), meshRes, store.GetByKey("new-mesh", model.NoMesh))).To(Succeed())
+		Expect(meshRes.Spec.Tenant).To(Equal("team-a"))
+	})
+
+	It("should not let move a mesh to other tenant", func() {
+		// given
+		meshRes := mesh.NewMeshResource()
+		Expect(tenantManager.Get(tenantCtx, meshRes, store.GetByKey("mesh-a", model.NoMesh))).To(Succeed())
+
+		// when
+		meshRes.Spec.Tenant = "team-b"
+		err := tenantManager.Update(tenantCtx, meshRes)
+
+		// then
+		Expect(err).To(MatchError(&core_access.AccessDeniedError{Reason: `mesh can only be placed in the tenant "team-a" of the user`}))
+	})
+
+	It("should not let create resources in meshes of other tenants", func() {
+		// when
+		err := tenantManager.Create(tenantCtx, &sample.TrafficRouteResource{
+			Spec: &v1alpha1.TrafficRoute{Path: "/"},
+		}, store.CreateByKey("tr-2", "mesh-b"))
+
+		// then
+		Expect(manager.IsMeshNotFound(err)).To(BeTrue())
+	})
+
+	It("should not let change global resources", func() {
+		// given
+		zone := system.NewZoneResource()
+		Expect(tenantManager.Get(tenantCtx, zone, store.GetByKey("zone-a", model.NoMesh))).To(Succeed())
+
+		// when
+		zone.Spec.Enabled = nil
+		err := tenantManager.Update(tenantCtx, zone)
+
+		// then
+		Expect(err).To(MatchError(&core_access.AccessDeniedError{Reason: "Zone can only be changed by users without a tenant"}))
+	})
+
+	It("should not restrict users without a tenant", func() {
+		// given
+		ctx := user.Ctx(context.Background(), user.Admin)
+
+		// when
+		meshes := mesh.MeshResourceList{}
+		Expect(tenantManager.List(ctx, &meshes)).To(Succeed())
+
+		// then
+		Expect(meshes.Items).To(HaveLen(3))
+	})
+})
@@ -21,6 +21,7 @@ func (c *from) Resource(r model.Resource) *Resource {
 			Name:             r.GetMeta().GetName(),
 			CreationTime:     r.GetMeta().GetCreationTime(),
 			ModificationTime: r.GetMeta().GetModificationTime(),
+			Revision:         r.GetMeta().GetVersion(),
 		},
 		Spec: r.GetSpec(),
 	}
@@ -0,0 +1,186 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// specFields is the name that selects all fields of the spec, so the resources can be listed only with their meta.
+const specFields = "spec"
+
+// identityFields are always returned, so the resources with selected fields can still be identified.
+var identityFields = []string{"type", "mesh", "name"}
+
+var metaFields = map[string]bool{
+	"type":             true,
+	"mesh":             true,
+	"name":             true,
+	"creationTime":     true,
+	"modificationTime": true,
+	"revision":         true,
+}
+
+// FieldSelector selects the fields of the resource that are returned, so clients listing many resources
+// don't have to transfer whole specs. Fields are paths in JSON separated by dots, for example "networking.address".
+// A path that goes through a list selects the field of every item of the list.
+type FieldSelector struct {
+	include [][]string
+	exclude [][]string
+}
+
+// ParseFieldSelector parses comma separated lists of fields to include and to exclude. Nil is returned when both are empty.
+// When fields to include are given, only these fields and the type, the mesh and the name of the resource are returned.
+// Fields to exclude are removed afterwards. "spec" selects all fields that are not part of the meta of the resource.
+func ParseFieldSelector(include string, exclude string) (*FieldSelector, error) {
+	verr := validators.ValidationError{}
+	selector := &FieldSelector{
+		include: parseFields(validators.RootedAt("fields"), include, &verr),
+		exclude: parseFields(validators.RootedAt("exclude"), exclude, &verr),
+	}
+	if err := verr.OrNil(); err != nil {
+		return nil, err
+	}
+	if len(selector.include) == 0 && len(selector.exclude) == 0 {
+		return nil, nil
+	}
+	return selector, nil
+}
+
+func parseFields(path validators.PathBuilder, fields string, verr *validators.ValidationError) [][]string {
+	if fields == "" {
+		return nil
+	}
+	var paths [][]string
+	for _, field := range strings.Split(fields, ",") {
+		segments := strings.Split(strings.TrimSpace(field), ".")
+		for _, segment := range segments {
+			if segment == "" {
+				verr.AddViolationAt(path, fmt.Sprintf("invalid field %q", field))
+				return nil
+			}
+		}
+		paths = append(paths, segments)
+	}
+	return paths
+}
+
+// Select returns the JSON object of the resource with only the selected fields.
+func (s *FieldSelector) Select(resource []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(resource))
+	// numbers are kept as they are, so they are not rounded as float64
+	decoder.UseNumber()
+	obj := map[string]interface{}{}
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if len(s.include) > 0 {
+		selected := map[string]interface{}{}
+		for _, field := range identityFields {
+			if value, ok := obj[field]; ok {
+				selected[field] = value
+			}
+		}
+		for _, path := range s.include {
+			if len(path) == 1 && path[0] == specFields {
+				for field, value := range obj {
+					if !metaFields[field] {
+						selected[field] = value
+					}
+				}
+				continue
+			}
+			if value, ok := project(obj, path); ok {
+				merge(selected, value)
+			}
+		}
+		obj = selected
+	}
+	for _, path := range s.exclude {
+		if len(path) == 1 && path[0] == specFields {
+			for field := range obj {
+				if !metaFields[field] {
+					delete(obj, field)
+				}
+			}
+			continue
+		}
+		remove(obj, path)
+	}
+	return json.Marshal(obj)
+}
+
+// project returns the value with only the field under the path. The path that goes through a list is applied to every item.
+func project(value interface{}, path []string) (interface{}, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		field, ok := v[path[0]]
+		if !ok {
+			return nil, false
+		}
+		if len(path) > 1 {
+			if field, ok = project(field, path[1:]); !ok {
+				return nil, false
+			}
+		}
+		return map[string]interface{}{path[0]: field}, true
+	case []interface{}:
+		// items without the field are kept empty, so the items selected by other paths can be merged by index
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			projected, ok := project(item, path)
+			if !ok {
+				projected = map[string]interface{}{}
+			}
+			items[i] = projected
+		}
+		return items, true
+	default:
+		return nil, false
+	}
+}
+
+// merge merges the value selected by one path into the value selected by other paths.
+func merge(dst interface{}, src interface{}) interface{} {
+	switch d := dst.(type) {
+	case map[string]interface{}:
+		if s, ok := src.(map[string]interface{}); ok {
+			for k, v := range s {
+				if existing, ok := d[k]; ok {
+					d[k] = merge(existing, v)
+				} else {
+					d[k] = v
+				}
+			}
+			return d
+		}
+	case []interface{}:
+		if s, ok := src.([]interface{}); ok && len(s) == len(d) {
+			for i := range d {
+				d[i] = merge(d[i], s[i])
+			}
+			return d
+		}
+	}
+	return src
+}
+
+func remove(value interface{}, path []string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			delete(v, path[0])
+			return
+		}
+		if field, ok := v[path[0]]; ok {
+			remove(field, path[1:])
+		}
+	case []interface{}:
+		for _, item := range v {
+			remove(item, path)
+		}
+	}
+}
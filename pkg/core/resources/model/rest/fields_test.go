@@ -0,0 +1,161 @@
+package rest_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+var _ = Describe("FieldSelector", func() {
+
+	dataplane := func() *rest.Resource {
+		return &rest.Resource{
+			Meta: rest.ResourceMeta{
+				Type:     "Dataplane",
+				Mesh:     "default",
+				Name:     "web-1",
+				Revision: "3",
+			},
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port: 8080,
+							Tags: map[string]string{"kuma.io/service": "web"},
+						},
+						{
+							Port:        8081,
+							ServicePort: 9091,
+							Tags:        map[string]string{"kuma.io/service": "web-admin"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	type testCase struct {
+		fields   string
+		exclude  string
+		expected string
+	}
+
+	DescribeTable("should marshal only selected fields",
+		func(given testCase) {
+			// given
+			selector, err := rest.ParseFieldSelector(given.fields, given.exclude)
+			Expect(err).ToNot(HaveOccurred())
+			res := dataplane()
+			res.Fields = selector
+
+			// when
+			bytes, err := json.Marshal(res)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(MatchJSON(given.expected))
+		},
+		Entry("nested field", testCase{
+			fields: "revision,networking.address",
+			expected: `
+			{
+				"type": "Dataplane",
+				"mesh": "default",
+				"name": "web-1",
+				"revision": "3",
+				"networking": {"address": "192.168.0.1"}
+			}`,
+		}),
+		Entry("fields of the items of the list", testCase{
+			fields: "networking.inbound.port,networking.inbound.servicePort",
+			expected: `
+			{
+				"type": "Dataplane",
+				"mesh": "default",
+				"name": "web-1",
+				"networking": {
+					"inbound": [
+						{"port": 8080},
+						{"port": 8081, "servicePort": 9091}
+					]
+				}
+			}`,
+		}),
+		Entry("missing field", testCase{
+			fields: "probes.port",
+			expected: `
+			{
+				"type": "Dataplane",
+				"mesh": "default",
+				"name": "web-1"
+			}`,
+		}),
+		Entry("whole spec", testCase{
+			fields:  "spec",
+			exclude: "networking.inbound",
+			expected: `
+			{
+				"type": "Dataplane",
+				"mesh": "default",
+				"name": "web-1",
+				"networking": {"address": "192.168.0.1"}
+			}`,
+		}),
+		Entry("exclude spec", testCase{
+			exclude: "spec",
+			expected: `
+			{
+				"type": "Dataplane",
+				"mesh": "default",
+				"name": "web-1",
+				"revision": "3",
+				"creationTime": "0001-01-01T00:00:00Z",
+				"modificationTime": "0001-01-01T00:00:00Z"
+			}`,
+		}),
+		Entry("exclude fields of the items of the list", testCase{
+			exclude: "creationTime,modificationTime,networking.inbound.tags",
+			expected: `
+			{
+				"type": "Dataplane",
+				"mesh": "default",
+				"name": "web-1",
+				"revision": "3",
+				"networking": {
+					"address": "192.168.0.1",
+					"inbound": [
+						{"port": 8080},
+						{"port": 8081, "servicePort": 9091}
+					]
+				}
+			}`,
+		}),
+	)
+
+	It("should not select fields when nothing is given", func() {
+		// when
+		selector, err := rest.ParseFieldSelector("", "")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selector).To(BeNil())
+	})
+
+	It("should reject invalid fields", func() {
+		// when
+		_, err := rest.ParseFieldSelector("networking..address", "spec,")
+
+		// then
+		Expect(validators.IsValidationError(err)).To(BeTrue())
+		Expect(err.(*validators.ValidationError).Violations).To(Equal([]validators.Violation{
+			{Field: "fields", Message: `invalid field "networking..address"`},
+			{Field: "exclude", Message: `invalid field ""`},
+		}))
+	})
+})
@@ -18,6 +18,9 @@ type ResourceMeta struct {
 	Name             string    `json:"name"`
 	CreationTime     time.Time `json:"creationTime"`
 	ModificationTime time.Time `json:"modificationTime"`
+	// Revision is the version of the resource in the store. It changes on every modification of the resource.
+	// When it is set on update, the resource is updated only if it was not modified since this revision.
+	Revision string `json:"revision,omitempty"`
 }
 
 func (r *ResourceMeta) GetName() string {
@@ -29,7 +32,7 @@ func (r *ResourceMeta) GetNameExtensions() model.ResourceNameExtensions {
 }
 
 func (r *ResourceMeta) GetVersion() string {
-	return ""
+	return r.Revision
 }
 
 func (r *ResourceMeta) GetMesh() string {
@@ -49,6 +52,8 @@ var _ model.ResourceMeta = &ResourceMeta{}
 type Resource struct {
 	Meta ResourceMeta
 	Spec model.ResourceSpec
+	// Fields selects the fields that are marshaled. All fields are marshaled when it is nil.
+	Fields *FieldSelector
 }
 
 // NewFromModel create a REST Resource from the given model Resource.
@@ -65,6 +70,7 @@ func NewFromModel(m model.Resource) *Resource {
 			Name:             meta.GetName(),
 			CreationTime:     meta.GetCreationTime(),
 			ModificationTime: meta.GetModificationTime(),
+			Revision:         meta.GetVersion(),
 		},
 		Spec: m.GetSpec(),
 	}
@@ -76,10 +82,25 @@ type ResourceList struct {
 	Next  *string     `json:"next"`
 }
 
+// SelectFields selects the fields of all items of the list that are marshaled.
+func (l *ResourceList) SelectFields(fields *FieldSelector) {
+	for _, item := range l.Items {
+		item.Fields = fields
+	}
+}
+
 var _ json.Marshaler = &Resource{}
 var _ json.Unmarshaler = &Resource{}
 
 func (r *Resource) MarshalJSON() ([]byte, error) {
+	b, err := r.marshalJSON()
+	if err != nil || r.Fields == nil {
+		return b, err
+	}
+	return r.Fields.Select(b)
+}
+
+func (r *Resource) marshalJSON() ([]byte, error) {
 	var specBytes []byte
 	if r.Spec != nil {
 		var buf bytes.Buffer
@@ -0,0 +1,253 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+// Document is the subset of OpenAPI v3 document that is needed to describe the resources endpoints of the API Server.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+}
+
+type Operation struct {
+	Summary     string              `json:"summary"`
+	OperationID string              `json:"operationId"`
+	Tags        []string            `json:"tags"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Parameter struct {
+	In          string  `json:"in"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// NewDocument generates the document of the resources endpoints of the API Server.
+// Schemas of the resources are generated from their protobuf specs and are named after the types of the resources.
+func NewDocument(descriptors []model.ResourceTypeDescriptor, version string) *Document {
+	doc := &Document{
+		OpenAPI: "3.0.1",
+		Info: Info{
+			Title:       "Kuma API",
+			Description: "Kuma API",
+			Version:     version,
+		},
+		Paths: map[string]*PathItem{},
+		Components: Components{
+			Schemas: map[string]*Schema{},
+		},
+	}
+	s := schemas(doc.Components.Schemas)
+	for _, desc := range descriptors {
+		typ := string(desc.Name)
+		s[typ] = s.resource(desc)
+		doc.addPaths(desc)
+	}
+	return doc
+}
+
+func (d *Document) addPaths(desc model.ResourceTypeDescriptor) {
+	typ := string(desc.Name)
+	nameParam := Parameter{
+		In:          "path",
+		Name:        "name",
+		Description: fmt.Sprintf("Name of the %s", typ),
+		Required:    true,
+		Schema:      &Schema{Type: "string"},
+	}
+	var params []Parameter
+	prefix := ""
+	if desc.Scope == model.ScopeMesh {
+		params = append(params, Parameter{
+			In:          "path",
+			Name:        "mesh",
+			Description: "Name of the Mesh",
+			Required:    true,
+			Schema:      &Schema{Type: "string"},
+		})
+		prefix = "/meshes/{mesh}"
+	}
+	itemParams := append(append([]Parameter{}, params...), nameParam)
+	fieldsParams := []Parameter{
+		{
+			In:          "query",
+			Name:        "fields",
+			Description: "Comma separated fields to return, for example name,networking.address",
+			Schema:      &Schema{Type: "string"},
+		},
+		{
+			In:          "query",
+			Name:        "exclude",
+			Description: "Comma separated fields not to return, spec excludes all fields except the meta",
+			Schema:      &Schema{Type: "string"},
+		},
+	}
+	tags := []string{typ}
+	list := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"total": {Type: "integer"},
+			"items": {Type: "array", Items: ref(typ)},
+			"next":  {Type: "string", Description: "URL to the next page"},
+		},
+	}
+
+	item := &PathItem{
+		Get: &Operation{
+			Summary:     fmt.Sprintf("Returns %s", typ),
+			OperationID: "get" + typ,
+			Tags:        tags,
+			Parameters:  append(append([]Parameter{}, itemParams...), fieldsParams...),
+			Responses: map[string]Response{
+				"200": jsonResponse("Successful response", ref(typ)),
+				"404": {Description: "Not found"},
+			},
+		},
+	}
+	if !desc.ReadOnly {
+		item.Put = &Operation{
+			Summary:     fmt.Sprintf("Creates or updates %s", typ),
+			OperationID: "put" + typ,
+			Tags:        tags,
+			Parameters:  itemParams,
+			RequestBody: &RequestBody{
+				Required: true,
+				Content:  map[string]MediaType{"application/json": {Schema: ref(typ)}},
+			},
+			Responses: map[string]Response{
+				"200": {Description: "Updated"},
+				"201": {Description: "Created"},
+				"400": {Description: "Resource is not valid"},
+				"409": {Description: "Resource was modified since the revision in the request"},
+			},
+		}
+		item.Patch = &Operation{
+			Summary:     fmt.Sprintf("Creates %s or merges the fields of the field manager into it", typ),
+			OperationID: "apply" + typ,
+			Tags:        tags,
+			Parameters: append(append([]Parameter{}, itemParams...),
+				Parameter{
+					In:          "query",
+					Name:        "fieldManager",
+					Description: "Name of the manager that owns the applied fields, for example kumactl",
+					Required:    true,
+					Schema:      &Schema{Type: "string"},
+				},
+				Parameter{
+					In:          "query",
+					Name:        "force",
+					Description: "Take the ownership of the fields owned by other managers instead of failing with a conflict",
+					Schema:      &Schema{Type: "boolean"},
+				},
+			),
+			RequestBody: &RequestBody{
+				Required: true,
+				Content:  map[string]MediaType{"application/apply-patch+yaml": {Schema: ref(typ)}},
+			},
+			Responses: map[string]Response{
+				"200": jsonResponse("Updated", ref(typ)),
+				"201": jsonResponse("Created", ref(typ)),
+				"400": {Description: "Resource is not valid"},
+				"409": {Description: "Fields are owned by other field managers or the resource was modified since the revision in the request"},
+			},
+		}
+		item.Delete = &Operation{
+			Summary:     fmt.Sprintf("Deletes %s", typ),
+			OperationID: "delete" + typ,
+			Tags:        tags,
+			Parameters:  itemParams,
+			Responses: map[string]Response{
+				"200": {Description: "Successful response"},
+				"404": {Description: "Not found"},
+			},
+		}
+	}
+	d.Paths[fmt.Sprintf("%s/%s/{name}", prefix, desc.WsPath)] = item
+
+	d.Paths[fmt.Sprintf("%s/%s", prefix, desc.WsPath)] = &PathItem{
+		Get: &Operation{
+			Summary:     fmt.Sprintf("Returns a list of %s", typ),
+			OperationID: "list" + typ,
+			Tags:        tags,
+			Parameters:  append(append([]Parameter{}, params...), fieldsParams...),
+			Responses: map[string]Response{
+				"200": jsonResponse("Successful response", list),
+			},
+		},
+	}
+	if desc.Scope == model.ScopeMesh {
+		d.Paths["/"+desc.WsPath] = &PathItem{
+			Get: &Operation{
+				Summary:     fmt.Sprintf("Returns a list of %s from all meshes", typ),
+				OperationID: "listAll" + typ,
+				Tags:        tags,
+				Parameters:  fieldsParams,
+				Responses: map[string]Response{
+					"200": jsonResponse("Successful response", list),
+				},
+			},
+		}
+	}
+}
+
+func jsonResponse(description string, schema *Schema) Response {
+	return Response{
+		Description: description,
+		Content:     map[string]MediaType{"application/json": {Schema: schema}},
+	}
+}
+
+func (d *Document) JSON() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+func (d *Document) YAML() ([]byte, error) {
+	b, err := d.JSON()
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(b)
+}
@@ -0,0 +1,67 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/openapi"
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	sample_model "github.com/kumahq/kuma/pkg/test/resources/apis/sample"
+)
+
+var _ = Describe("Document", func() {
+
+	It("should generate paths and schemas of the resources", func() {
+		// given
+		mesh := core_mesh.MeshResourceTypeDescriptor
+		mesh.ReadOnly = true
+
+		// when
+		bytes, err := openapi.NewDocument([]model.ResourceTypeDescriptor{
+			mesh,
+			sample_model.TrafficRouteResourceTypeDescriptor,
+		}, "1.0.0").YAML()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bytes).To(matchers.MatchGoldenYAML(filepath.Join("testdata", "document.golden.yaml")))
+	})
+
+	It("should generate schemas of the nested and recursive messages", func() {
+		// when
+		doc := openapi.NewDocument([]model.ResourceTypeDescriptor{
+			core_mesh.DataplaneResourceTypeDescriptor,
+		}, "1.0.0")
+
+		// then
+		Expect(doc.Components.Schemas).To(HaveKey("Dataplane"))
+		Expect(doc.Components.Schemas).To(HaveKey("kuma.mesh.v1alpha1.Dataplane.Networking"))
+
+		// and meta of the resource is a part of the schema
+		bytes, err := json.Marshal(doc.Components.Schemas["Dataplane"])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bytes).To(MatchJSON(`
+		{
+			"type": "object",
+			"properties": {
+				"type": {"type": "string", "enum": ["Dataplane"], "description": "Type of the resource"},
+				"name": {"type": "string", "description": "Name of the resource"},
+				"mesh": {"type": "string", "description": "Mesh of the resource"},
+				"creationTime": {"type": "string", "format": "date-time", "readOnly": true},
+				"modificationTime": {"type": "string", "format": "date-time", "readOnly": true},
+				"revision": {"type": "string", "description": "Revision of the resource. When it is set on update, the resource is updated only if it was not modified since this revision"},
+				"networking": {"$ref": "#/components/schemas/kuma.mesh.v1alpha1.Dataplane.Networking"},
+				"metrics": {"$ref": "#/components/schemas/kuma.mesh.v1alpha1.MetricsBackend"},
+				"probes": {"$ref": "#/components/schemas/kuma.mesh.v1alpha1.Dataplane.Probes"},
+				"envoyRuntime": {"$ref": "#/components/schemas/kuma.mesh.v1alpha1.EnvoyRuntime"}
+			},
+			"additionalProperties": false,
+			"required": ["mesh", "name", "type"]
+		}`))
+	})
+})
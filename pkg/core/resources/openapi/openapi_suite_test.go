@@ -0,0 +1,11 @@
+package openapi_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestOpenApi(t *testing.T) {
+	test.RunSpecs(t, "OpenAPI")
+}
@@ -0,0 +1,184 @@
+package openapi
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+)
+
+// Schema is the subset of OpenAPI v3 schema object that is needed to describe resources.
+type Schema struct {
+	Ref         string   `json:"$ref,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Format      string   `json:"format,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+	ReadOnly    bool     `json:"readOnly,omitempty"`
+
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	// AdditionalProperties is either a *Schema of the values of a map or false when no other properties are allowed
+	AdditionalProperties interface{} `json:"additionalProperties,omitempty"`
+	Required             []string    `json:"required,omitempty"`
+	Items                *Schema     `json:"items,omitempty"`
+	AnyOf                []*Schema   `json:"anyOf,omitempty"`
+}
+
+func ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// wellKnownTypes are the types with a special JSON mapping in https://developers.google.com/protocol-buffers/docs/proto3#json
+var wellKnownTypes = map[protoreflect.FullName]func() *Schema{
+	"google.protobuf.Duration": func() *Schema {
+		return &Schema{Type: "string", Format: "duration", Description: `Duration in seconds with up to nine fractional digits, ending with "s", for example "1.5s"`}
+	},
+	"google.protobuf.Timestamp":   func() *Schema { return &Schema{Type: "string", Format: "date-time"} },
+	"google.protobuf.FieldMask":   func() *Schema { return &Schema{Type: "string"} },
+	"google.protobuf.Empty":       func() *Schema { return &Schema{Type: "object"} },
+	"google.protobuf.Struct":      func() *Schema { return &Schema{Type: "object"} },
+	"google.protobuf.Value":       func() *Schema { return &Schema{} },
+	"google.protobuf.ListValue":   func() *Schema { return &Schema{Type: "array", Items: &Schema{}} },
+	"google.protobuf.Any":         func() *Schema { return &Schema{Type: "object", Required: []string{"@type"}} },
+	"google.protobuf.BoolValue":   func() *Schema { return &Schema{Type: "boolean"} },
+	"google.protobuf.StringValue": func() *Schema { return &Schema{Type: "string"} },
+	"google.protobuf.BytesValue":  func() *Schema { return &Schema{Type: "string", Format: "byte"} },
+	"google.protobuf.DoubleValue": func() *Schema { return &Schema{Type: "number", Format: "double"} },
+	"google.protobuf.FloatValue":  func() *Schema { return &Schema{Type: "number", Format: "float"} },
+	"google.protobuf.Int32Value":  func() *Schema { return &Schema{Type: "integer", Format: "int32"} },
+	"google.protobuf.UInt32Value": func() *Schema { return &Schema{Type: "integer", Format: "uint32"} },
+	"google.protobuf.Int64Value":  int64Schema("int64"),
+	"google.protobuf.UInt64Value": int64Schema("uint64"),
+}
+
+// int64Schema accepts both numbers and strings, because 64-bit integers are encoded as strings in JSON.
+func int64Schema(format string) func() *Schema {
+	return func() *Schema {
+		return &Schema{AnyOf: []*Schema{
+			{Type: "integer", Format: format},
+			{Type: "string", Format: format},
+		}}
+	}
+}
+
+// schemas generates schemas of protobuf messages with the JSON mapping used by the API Server.
+// Every message is a separate schema referenced by its full name, so recursive messages can be described.
+type schemas map[string]*Schema
+
+func (s schemas) message(md protoreflect.MessageDescriptor) *Schema {
+	if wkt, ok := wellKnownTypes[md.FullName()]; ok {
+		return wkt()
+	}
+	name := string(md.FullName())
+	if _, ok := s[name]; !ok {
+		s[name] = nil // placeholder for the recursive messages
+		s[name] = s.object(md)
+	}
+	return ref(name)
+}
+
+func (s schemas) object(md protoreflect.MessageDescriptor) *Schema {
+	schema := &Schema{
+		Type:                 "object",
+		Properties:           map[string]*Schema{},
+		AdditionalProperties: false,
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		schema.Properties[fd.JSONName()] = s.field(fd)
+	}
+	return schema
+}
+
+func (s schemas) field(fd protoreflect.FieldDescriptor) *Schema {
+	switch {
+	case fd.IsMap():
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: s.singular(fd.MapValue()),
+		}
+	case fd.IsList():
+		return &Schema{
+			Type:  "array",
+			Items: s.singular(fd),
+		}
+	default:
+		return s.singular(fd)
+	}
+}
+
+func (s schemas) singular(fd protoreflect.FieldDescriptor) *Schema {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return &Schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &Schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &Schema{Type: "integer", Format: "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return int64Schema("int64")()
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return int64Schema("uint64")()
+	case protoreflect.FloatKind:
+		return &Schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &Schema{Type: "number", Format: "double"}
+	case protoreflect.StringKind:
+		return &Schema{Type: "string"}
+	case protoreflect.BytesKind:
+		return &Schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		schema := &Schema{Type: "string"}
+		for i := 0; i < values.Len(); i++ {
+			schema.Enum = append(schema.Enum, string(values.Get(i).Name()))
+		}
+		return schema
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return s.message(fd.Message())
+	default:
+		return &Schema{}
+	}
+}
+
+// resource generates the schema of the resource in the format of the API Server, which is the spec with the meta fields.
+func (s schemas) resource(desc model.ResourceTypeDescriptor) *Schema {
+	md := proto.MessageReflect(desc.NewObject().GetSpec()).Descriptor()
+	schema := s.object(md)
+	schema.Properties["type"] = &Schema{
+		Type:        "string",
+		Enum:        []string{string(desc.Name)},
+		Description: "Type of the resource",
+	}
+	schema.Properties["name"] = &Schema{
+		Type:        "string",
+		Description: "Name of the resource",
+	}
+	schema.Required = []string{"type", "name"}
+	if desc.Scope == model.ScopeMesh {
+		schema.Properties["mesh"] = &Schema{
+			Type:        "string",
+			Description: "Mesh of the resource",
+		}
+		schema.Required = append(schema.Required, "mesh")
+	}
+	schema.Properties["creationTime"] = &Schema{
+		Type:     "string",
+		Format:   "date-time",
+		ReadOnly: true,
+	}
+	schema.Properties["modificationTime"] = &Schema{
+		Type:     "string",
+		Format:   "date-time",
+		ReadOnly: true,
+	}
+	schema.Properties["revision"] = &Schema{
+		Type:        "string",
+		Description: "Revision of the resource. When it is set on update, the resource is updated only if it was not modified since this revision",
+	}
+	sort.Strings(schema.Required)
+	return schema
+}
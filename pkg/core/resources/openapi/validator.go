@@ -0,0 +1,77 @@
+package openapi
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+// Validator validates resources in the format of the API Server against the schemas of the document.
+type Validator struct {
+	doc *Document
+
+	sync.Mutex
+	compiled map[model.ResourceType]*gojsonschema.Schema
+}
+
+func NewValidator(doc *Document) *Validator {
+	return &Validator{
+		doc:      doc,
+		compiled: map[model.ResourceType]*gojsonschema.Schema{},
+	}
+}
+
+// Validate validates the resource in JSON. Resources of types without the schema in the document are not validated.
+func (v *Validator) Validate(typ model.ResourceType, resource []byte) error {
+	schema, err := v.schema(typ)
+	if err != nil || schema == nil {
+		return err
+	}
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(resource))
+	if err != nil {
+		return err
+	}
+	verr := validators.ValidationError{}
+	for _, resultErr := range result.Errors() {
+		// errors of the subschemas of "anyOf" are reported separately
+		if resultErr.Type() == "number_any_of" {
+			continue
+		}
+		field := resultErr.Field()
+		if field == gojsonschema.STRING_ROOT_SCHEMA_PROPERTY {
+			field = ""
+		}
+		// the properties that are not allowed are reported on the object that has them
+		if property, ok := resultErr.Details()["property"].(string); ok && resultErr.Type() == "additional_property_not_allowed" {
+			field = strings.TrimPrefix(field+"."+property, ".")
+			verr.AddViolation(field, "unknown field")
+			continue
+		}
+		verr.AddViolation(field, resultErr.Description())
+	}
+	return verr.OrNil()
+}
+
+func (v *Validator) schema(typ model.ResourceType) (*gojsonschema.Schema, error) {
+	v.Lock()
+	defer v.Unlock()
+	if schema, ok := v.compiled[typ]; ok {
+		return schema, nil
+	}
+	if _, ok := v.doc.Components.Schemas[string(typ)]; !ok {
+		return nil, nil
+	}
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(map[string]interface{}{
+		"$ref":       ref(string(typ)).Ref,
+		"components": v.doc.Components,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	v.compiled[typ] = schema
+	return schema, nil
+}
@@ -0,0 +1,147 @@
+package openapi_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/openapi"
+	"github.com/kumahq/kuma/pkg/core/validators"
+)
+
+var _ = Describe("Validator", func() {
+
+	var validator *openapi.Validator
+
+	BeforeEach(func() {
+		validator = openapi.NewValidator(openapi.NewDocument([]model.ResourceTypeDescriptor{
+			core_mesh.MeshResourceTypeDescriptor,
+			core_mesh.DataplaneResourceTypeDescriptor,
+		}, "1.0.0"))
+	})
+
+	It("should accept a valid resource", func() {
+		// when
+		err := validator.Validate(core_mesh.DataplaneType, []byte(`
+		{
+			"type": "Dataplane",
+			"mesh": "default",
+			"name": "dp-1",
+			"networking": {
+				"address": "192.168.0.1",
+				"inbound": [
+					{
+						"port": 8080,
+						"tags": {"kuma.io/service": "backend"},
+						"health": {"ready": true}
+					}
+				]
+			},
+			"probes": {
+				"port": 9000
+			}
+		}`))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should accept a resource with durations and 64-bit integers", func() {
+		// when
+		err := validator.Validate(core_mesh.MeshType, []byte(`
+		{
+			"type": "Mesh",
+			"name": "default",
+			"mtls": {
+				"enabledBackend": "ca-1",
+				"backends": [
+					{
+						"name": "ca-1",
+						"type": "builtin",
+						"dpCert": {"rotation": {"expiration": "1d"}},
+						"conf": {"caCert": {"RSAbits": 2048}}
+					}
+				]
+			}
+		}`))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	type testCase struct {
+		resource string
+		expected []validators.Violation
+	}
+
+	DescribeTable("should report violations",
+		func(given testCase) {
+			// when
+			err := validator.Validate(core_mesh.DataplaneType, []byte(given.resource))
+
+			// then
+			Expect(validators.IsValidationError(err)).To(BeTrue())
+			Expect(err.(*validators.ValidationError).Violations).To(ConsistOf(given.expected))
+		},
+		Entry("unknown field", testCase{
+			resource: `
+			{
+				"type": "Dataplane",
+				"mesh": "default",
+				"name": "dp-1",
+				"networking": {
+					"address": "192.168.0.1",
+					"inbounds": []
+				}
+			}`,
+			expected: []validators.Violation{
+				{Field: "networking.inbounds", Message: "unknown field"},
+			},
+		}),
+		Entry("wrong type", testCase{
+			resource: `
+			{
+				"type": "Dataplane",
+				"mesh": "default",
+				"name": "dp-1",
+				"networking": {
+					"address": "192.168.0.1",
+					"inbound": [{"port": "8080"}]
+				}
+			}`,
+			expected: []validators.Violation{
+				{Field: "networking.inbound.0.port", Message: "Invalid type. Expected: integer, given: string"},
+			},
+		}),
+		Entry("missing meta", testCase{
+			resource: `
+			{
+				"type": "Dataplane",
+				"networking": {
+					"address": "192.168.0.1"
+				}
+			}`,
+			expected: []validators.Violation{
+				{Field: "", Message: "name is required"},
+				{Field: "", Message: "mesh is required"},
+			},
+		}),
+		Entry("wrong type of the resource", testCase{
+			resource: `
+			{
+				"type": "Mesh",
+				"mesh": "default",
+				"name": "dp-1"
+			}`,
+			expected: []validators.Violation{
+				{Field: "type", Message: `type must be one of the following: "Dataplane"`},
+			},
+		}),
+	)
+
+	It("should not validate types without schemas", func() {
+		// expect
+		Expect(validator.Validate(core_mesh.TrafficRouteType, []byte(`{"unknown": true}`))).To(Succeed())
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/events"
 )
 
 func NewCustomizableResourceStore(defaultStore ResourceStore, customStores map[model.ResourceType]ResourceStore) ResourceStore {
@@ -38,6 +39,11 @@ func (m *customizableResourceStore) Update(ctx context.Context, resource model.R
 	return m.ResourceStore(resource.Descriptor().Name).Update(ctx, resource, fs...)
 }
 
+// Watch watches the store of the type. When the type is not specified, only the default store is watched.
+func (m *customizableResourceStore) Watch(ctx context.Context, fs ...WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return m.ResourceStore(NewWatchOptions(fs...).Type).Watch(ctx, fs...)
+}
+
 func (m *customizableResourceStore) ResourceStore(typ model.ResourceType) ResourceStore {
 	if customManager, ok := m.customStores[typ]; ok {
 		return customManager
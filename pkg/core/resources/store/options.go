@@ -2,8 +2,11 @@ package store
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 )
 
@@ -12,6 +15,7 @@ type CreateOptions struct {
 	Mesh         string
 	CreationTime time.Time
 	Owner        core_model.Resource
+	DryRun       bool
 }
 
 type CreateOptionsFunc func(*CreateOptions)
@@ -47,8 +51,23 @@ func CreateWithOwner(owner core_model.Resource) CreateOptionsFunc {
 	}
 }
 
+// CreateDryRun runs validation and defaulting of the resource without persisting it.
+func CreateDryRun() CreateOptionsFunc {
+	return func(opts *CreateOptions) {
+		opts.DryRun = true
+	}
+}
+
 type UpdateOptions struct {
 	ModificationTime time.Time
+	DryRun           bool
+}
+
+// UpdateDryRun runs validation and defaulting of the resource without persisting it.
+func UpdateDryRun() UpdateOptionsFunc {
+	return func(opts *UpdateOptions) {
+		opts.DryRun = true
+	}
 }
 
 func ModifiedAt(modificationTime time.Time) UpdateOptionsFunc {
@@ -152,11 +171,17 @@ func (g *GetOptions) HashCode() string {
 
 type ListFilterFunc func(rs core_model.Resource) bool
 
+// TagsMatcher is implemented by specs of the resources that can be listed by tags.
+type TagsMatcher interface {
+	MatchTags(selector mesh_proto.TagSelector) bool
+}
+
 type ListOptions struct {
 	Mesh       string
 	PageSize   int
 	PageOffset string
 	FilterFunc ListFilterFunc
+	Tags       map[string]string
 }
 
 type ListOptionsFunc func(*ListOptions)
@@ -171,6 +196,13 @@ func NewListOptions(fs ...ListOptionsFunc) *ListOptions {
 
 // Filter returns true if the item passes the filtering criteria
 func (l *ListOptions) Filter(rs core_model.Resource) bool {
+	if len(l.Tags) > 0 {
+		matcher, ok := rs.GetSpec().(TagsMatcher)
+		if !ok || !matcher.MatchTags(l.Tags) {
+			return false
+		}
+	}
+
 	if l.FilterFunc == nil {
 		return true
 	}
@@ -197,6 +229,22 @@ func ListByFilterFunc(filterFunc ListFilterFunc) ListOptionsFunc {
 	}
 }
 
+// ListByTags lists only resources that match all given tags.
+// Resources which spec does not implement TagsMatcher never match.
+func ListByTags(tags map[string]string) ListOptionsFunc {
+	return func(opts *ListOptions) {
+		opts.Tags = tags
+	}
+}
+
 func (l *ListOptions) HashCode() string {
-	return l.Mesh
+	if len(l.Tags) == 0 {
+		return l.Mesh
+	}
+	var tags []string
+	for key, value := range l.Tags {
+		tags = append(tags, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(tags)
+	return fmt.Sprintf("%s:%s", l.Mesh, strings.Join(tags, ","))
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/events"
 )
 
 // The Pagination Store is handling only the pagination functionality in the List.
@@ -46,7 +47,7 @@ func (p *paginationStore) List(ctx context.Context, list model.ResourceList, opt
 	opts := NewListOptions(optionsFunc...)
 
 	// Performance optimization
-	if opts.FilterFunc == nil && opts.PageSize == 0 && opts.PageOffset == "" {
+	if opts.FilterFunc == nil && len(opts.Tags) == 0 && opts.PageSize == 0 && opts.PageOffset == "" {
 		return p.delegate.List(ctx, list, optionsFunc...)
 	}
 
@@ -106,4 +107,8 @@ func (p *paginationStore) List(ctx context.Context, list model.ResourceList, opt
 	return nil
 }
 
+func (p *paginationStore) Watch(ctx context.Context, optionsFunc ...WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return p.delegate.Watch(ctx, optionsFunc...)
+}
+
 var _ ResourceStore = &paginationStore{}
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/events"
 )
 
 type ResourceStore interface {
@@ -17,6 +18,10 @@ type ResourceStore interface {
 	Delete(context.Context, model.Resource, ...DeleteOptionsFunc) error
 	Get(context.Context, model.Resource, ...GetOptionsFunc) error
 	List(context.Context, model.ResourceList, ...ListOptionsFunc) error
+	// Watch streams the changes of the resources until the context is done.
+	// The channel is also closed by the store when the changes could be missed, e.g. after the store was disconnected.
+	// Stores that cannot stream the changes return ErrorWatchNotSupported.
+	Watch(context.Context, ...WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error)
 }
 
 type ClosableResourceStore interface {
@@ -108,6 +113,10 @@ func (s *strictResourceStore) List(ctx context.Context, rs model.ResourceList, f
 	return s.delegate.List(ctx, rs, fs...)
 }
 
+func (s *strictResourceStore) Watch(ctx context.Context, fs ...WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return s.delegate.Watch(ctx, fs...)
+}
+
 func (s *strictResourceStore) Close() error {
 	closable, ok := s.delegate.(io.Closer)
 	if ok {
@@ -124,11 +133,32 @@ func ErrorResourceAlreadyExists(rt model.ResourceType, name, mesh string) error
 	return fmt.Errorf("Resource already exists: type=%q name=%q mesh=%q", rt, name, mesh)
 }
 
+func IsResourceAlreadyExists(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "Resource already exists")
+}
+
+// ResourceConflictError is returned when the resource was modified in the meantime,
+// so the version of the updated resource does not match the version of the stored resource.
+type ResourceConflictError struct {
+	Type model.ResourceType
+	Name string
+	Mesh string
+}
+
+func (e *ResourceConflictError) Error() string {
+	return fmt.Sprintf("Resource conflict: type=%q name=%q mesh=%q", e.Type, e.Name, e.Mesh)
+}
+
 func ErrorResourceConflict(rt model.ResourceType, name, mesh string) error {
-	return fmt.Errorf("Resource conflict: type=%q name=%q mesh=%q", rt, name, mesh)
+	return &ResourceConflictError{Type: rt, Name: name, Mesh: mesh}
 }
 
 func IsResourceConflict(err error) bool {
+	var conflictErr *ResourceConflictError
+	if errors.As(err, &conflictErr) {
+		return true
+	}
+	// the error could be rebuilt from the message, e.g. when it was received from the remote control plane
 	return err != nil && strings.HasPrefix(err.Error(), "Resource conflict")
 }
 
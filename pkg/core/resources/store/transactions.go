@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+var ErrorTransactionsNotSupported = errors.New("transactions are not supported by the store")
+
+func IsTransactionsNotSupported(err error) bool {
+	return errors.Is(err, ErrorTransactionsNotSupported)
+}
+
+// Transaction is a unit of changes in the store that are either all persisted or none of them is.
+type Transaction interface {
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+// Transactions is implemented by the stores that can apply many changes atomically.
+// The transaction is passed to the store in the context, so every call of the store, also the one made by
+// resource managers, with the context returned by CtxWithTx is a part of the transaction.
+type Transactions interface {
+	Begin(ctx context.Context) (Transaction, error)
+}
+
+type txCtx struct{}
+
+func CtxWithTx(ctx context.Context, tx Transaction) context.Context {
+	return context.WithValue(ctx, txCtx{}, tx)
+}
+
+func TxFromCtx(ctx context.Context) (Transaction, bool) {
+	tx, ok := ctx.Value(txCtx{}).(Transaction)
+	return tx, ok
+}
+
+// InTx calls fn in a transaction. The transaction is committed when fn succeeds and rolled back otherwise.
+func InTx(ctx context.Context, transactions Transactions, fn func(ctx context.Context) error) error {
+	tx, err := transactions.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(CtxWithTx(ctx, tx)); err != nil {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			return multierr.Append(err, errors.Wrap(rollbackErr, "could not rollback the transaction"))
+		}
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// NoTransactions is used for the stores that don't support transactions.
+type NoTransactions struct{}
+
+var _ Transactions = NoTransactions{}
+
+func (NoTransactions) Begin(context.Context) (Transaction, error) {
+	return nil, ErrorTransactionsNotSupported
+}
@@ -0,0 +1,165 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/events"
+)
+
+var ErrorWatchNotSupported = errors.New("watch is not supported by the store")
+
+func IsWatchNotSupported(err error) bool {
+	return errors.Is(err, ErrorWatchNotSupported)
+}
+
+type WatchOptions struct {
+	Type model.ResourceType
+	Mesh string
+}
+
+type WatchOptionsFunc func(*WatchOptions)
+
+func NewWatchOptions(fs ...WatchOptionsFunc) *WatchOptions {
+	opts := &WatchOptions{}
+	for _, f := range fs {
+		f(opts)
+	}
+	return opts
+}
+
+// WatchByType watches only resources of the given type. By default, resources of all types are watched.
+func WatchByType(resourceType model.ResourceType) WatchOptionsFunc {
+	return func(opts *WatchOptions) {
+		opts.Type = resourceType
+	}
+}
+
+// WatchByMesh watches only resources of the given mesh. By default, resources of all meshes are watched.
+func WatchByMesh(mesh string) WatchOptionsFunc {
+	return func(opts *WatchOptions) {
+		opts.Mesh = mesh
+	}
+}
+
+// Matches returns true if the change passes the watch criteria
+func (w *WatchOptions) Matches(event events.ResourceChangedEvent) bool {
+	return (w.Type == "" || w.Type == event.Type) && (w.Mesh == "" || w.Mesh == event.Key.Mesh)
+}
+
+// watchBufferSize is the number of changes buffered for a single watch.
+// When the watcher is slower than the changes, the watch is closed instead of blocking the store.
+const watchBufferSize = 100
+
+// Watchers fans out the changes to the watches. It's used by the stores that receive all changes from a single source.
+type Watchers struct {
+	mu      sync.Mutex
+	watches map[chan events.ResourceChangedEvent]*WatchOptions
+}
+
+// Add registers a watch that lasts until the context is done or until the watchers are closed.
+func (w *Watchers) Add(ctx context.Context, fs ...WatchOptionsFunc) <-chan events.ResourceChangedEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.watches == nil {
+		w.watches = map[chan events.ResourceChangedEvent]*WatchOptions{}
+	}
+	ch := make(chan events.ResourceChangedEvent, watchBufferSize)
+	w.watches[ch] = NewWatchOptions(fs...)
+	go func() {
+		<-ctx.Done()
+		w.remove(ch)
+	}()
+	return ch
+}
+
+func (w *Watchers) Send(event events.ResourceChangedEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for ch, opts := range w.watches {
+		if !opts.Matches(event) {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			close(ch)
+			delete(w.watches, ch)
+		}
+	}
+}
+
+// CloseAll closes all watches, e.g. when the source of the changes was disconnected and the changes could be missed.
+func (w *Watchers) CloseAll() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for ch := range w.watches {
+		close(ch)
+		delete(w.watches, ch)
+	}
+}
+
+func (w *Watchers) remove(ch chan events.ResourceChangedEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.watches[ch]; ok {
+		close(ch)
+		delete(w.watches, ch)
+	}
+}
+
+var watchLog = core.Log.WithName("store-watch")
+
+// watchRetryBackoff is the time between watches when the previous watch was closed by the store
+const watchRetryBackoff = time.Second
+
+type watchEmitter struct {
+	store ResourceStore
+	out   events.Emitter
+}
+
+// NewWatchEmitter builds a component that watches all changes of the store and emits them.
+// When the watch is closed by the store, it's started again.
+func NewWatchEmitter(store ResourceStore, out events.Emitter) component.Component {
+	return &watchEmitter{
+		store: store,
+		out:   out,
+	}
+}
+
+func (w *watchEmitter) Start(stop <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	watchLog.Info("start watching")
+	for {
+		changes, err := w.store.Watch(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not watch the store")
+		}
+		for change := range changes {
+			w.out.Send(change)
+		}
+		select {
+		case <-stop:
+			watchLog.Info("stop")
+			return nil
+		case <-time.After(watchRetryBackoff):
+			watchLog.Info("watch was closed by the store, changes could be missed, restarting the watch")
+		}
+	}
+}
+
+func (w *watchEmitter) NeedLeaderElection() bool {
+	return false
+}
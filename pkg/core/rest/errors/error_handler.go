@@ -9,6 +9,7 @@ import (
 	api_server_types "github.com/kumahq/kuma/pkg/api-server/types"
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/access"
+	"github.com/kumahq/kuma/pkg/core/resources/fieldmanager"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
@@ -22,6 +23,16 @@ func HandleError(response *restful.Response, err error, title string) {
 		handleNotFound(title, response)
 	case store.IsResourcePreconditionFailed(err):
 		handlePreconditionFailed(title, response)
+	case store.IsResourceAlreadyExists(err):
+		handleConflict(title, response)
+	case store.IsResourceConflict(err):
+		handleResourceConflict(title, response)
+	case errors.Is(err, &fieldmanager.ConflictError{}):
+		var conflictErr *fieldmanager.ConflictError
+		errors.As(err, &conflictErr)
+		handleFieldManagerConflict(title, conflictErr, response)
+	case store.IsTransactionsNotSupported(err):
+		handleTransactionsNotSupported(title, response)
 	case err == store.ErrorInvalidOffset:
 		handleInvalidOffset(title, response)
 	case manager.IsMeshNotFound(err):
@@ -32,6 +43,12 @@ func HandleError(response *restful.Response, err error, title string) {
 		handleMaxPageSizeExceeded(title, err, response)
 	case err == api_server_types.InvalidPageSize:
 		handleInvalidPageSize(title, response)
+	case err == api_server_types.InvalidResumeToken:
+		handleInvalidResumeToken(title, response)
+	case err == api_server_types.ResumeTokenExpired:
+		handleResumeTokenExpired(title, response)
+	case api_server_types.IsRequestBodyTooLarge(err):
+		handleRequestBodyTooLarge(title, response)
 	case tokens.IsSigningKeyNotFound(err):
 		handleSigningKeyNotFound(err, response)
 	case errors.Is(err, &access.AccessDeniedError{}):
@@ -77,6 +94,36 @@ func handlePreconditionFailed(title string, response *restful.Response) {
 	WriteError(response, 412, kumaErr)
 }
 
+func handleConflict(title string, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Conflict",
+	}
+	WriteError(response, 409, kumaErr)
+}
+
+func handleResourceConflict(title string, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Conflict",
+		Causes: []types.Cause{
+			{
+				Field:   "revision",
+				Message: "resource was modified in the meantime, fetch the newest revision of the resource and apply the changes again",
+			},
+		},
+	}
+	WriteError(response, 409, kumaErr)
+}
+
+func handleTransactionsNotSupported(title string, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Transactions are not supported by the store of the control plane",
+	}
+	WriteError(response, 501, kumaErr)
+}
+
 func handleMeshNotFound(title string, err *manager.MeshNotFoundError, response *restful.Response) {
 	kumaErr := types.Error{
 		Title:   title,
@@ -133,6 +180,50 @@ func handleMaxPageSizeExceeded(title string, err error, response *restful.Respon
 	WriteError(response, 400, kumaErr)
 }
 
+func handleInvalidResumeToken(title string, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Invalid resume token",
+		Causes: []types.Cause{
+			{
+				Field:   "resumeToken",
+				Message: "Invalid format",
+			},
+		},
+	}
+	WriteError(response, 400, kumaErr)
+}
+
+func handleResumeTokenExpired(title string, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Resume token expired, list the resources again and watch for changes since then",
+	}
+	WriteError(response, 410, kumaErr)
+}
+
+func handleFieldManagerConflict(title string, err *fieldmanager.ConflictError, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Fields are owned by other field managers. Apply the resource with ?force=true to take the ownership of them",
+	}
+	for _, conflict := range err.Conflicts {
+		kumaErr.Causes = append(kumaErr.Causes, types.Cause{
+			Field:   conflict.Path.String(),
+			Message: fmt.Sprintf("owned by %q", conflict.Manager),
+		})
+	}
+	WriteError(response, 409, kumaErr)
+}
+
+func handleRequestBodyTooLarge(title string, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Request body is bigger than the limit",
+	}
+	WriteError(response, 413, kumaErr)
+}
+
 func handleUnknownError(err error, title string, response *restful.Response) {
 	core.Log.Error(err, title)
 	kumaErr := types.Error{
@@ -6,6 +6,9 @@ type Error struct {
 	Title   string  `json:"title"`
 	Details string  `json:"details"`
 	Causes  []Cause `json:"causes,omitempty"`
+	// Status is the HTTP status code of the response with the error. It is not a part of the response body,
+	// it is set by clients of the API, so the errors can be told apart without parsing their details.
+	Status int `json:"-"`
 }
 
 func (e *Error) Error() string {
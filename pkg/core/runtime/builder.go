@@ -12,6 +12,7 @@ import (
 	api_server "github.com/kumahq/kuma/pkg/api-server/customization"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/audit"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	"github.com/kumahq/kuma/pkg/core/datasource"
@@ -22,9 +23,12 @@ import (
 	"github.com/kumahq/kuma/pkg/core/secrets/store"
 	dp_server "github.com/kumahq/kuma/pkg/dp-server/server"
 	"github.com/kumahq/kuma/pkg/envoy/admin"
+	"github.com/kumahq/kuma/pkg/envoy/admin/tunnel"
 	"github.com/kumahq/kuma/pkg/events"
 	kds_context "github.com/kumahq/kuma/pkg/kds/context"
+	"github.com/kumahq/kuma/pkg/keys"
 	"github.com/kumahq/kuma/pkg/metrics"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
 	"github.com/kumahq/kuma/pkg/xds/secrets"
 )
@@ -33,11 +37,13 @@ import (
 type BuilderContext interface {
 	ComponentManager() component.Manager
 	ResourceStore() core_store.ResourceStore
+	Transactions() core_store.Transactions
 	SecretStore() store.SecretStore
 	ConfigStore() core_store.ResourceStore
 	ResourceManager() core_manager.CustomizableResourceManager
 	Config() kuma_cp.Config
 	DataSourceLoader() datasource.Loader
+	KeyProvider() keys.Provider
 	Extensions() context.Context
 	ConfigManager() config_manager.ConfigManager
 	LeaderInfo() component.LeaderInfo
@@ -45,6 +51,7 @@ type BuilderContext interface {
 	EventReaderFactory() events.ListenerFactory
 	APIManager() api_server.APIManager
 	XDSHooks() *xds_hooks.Hooks
+	XDSControlPlaneContext() *xds_context.ControlPlaneContext
 	CAProvider() secrets.CaProvider
 	DpServer() *dp_server.DpServer
 	ResourceValidators() ResourceValidators
@@ -60,27 +67,32 @@ type Builder struct {
 	cfg            kuma_cp.Config
 	cm             component.Manager
 	rs             core_store.ResourceStore
+	txs            core_store.Transactions
 	ss             store.SecretStore
 	cs             core_store.ResourceStore
 	rm             core_manager.CustomizableResourceManager
 	rom            core_manager.ReadOnlyResourceManager
 	cam            core_ca.Managers
 	dsl            datasource.Loader
+	kp             keys.Provider
 	ext            context.Context
 	configm        config_manager.ConfigManager
 	leadInfo       component.LeaderInfo
 	lif            lookup.LookupIPFunc
 	eac            admin.EnvoyAdminClient
+	eat            tunnel.Tunnels
 	metrics        metrics.Metrics
 	erf            events.ListenerFactory
 	apim           api_server.APIManager
 	xdsh           *xds_hooks.Hooks
+	xdscp          *xds_context.ControlPlaneContext
 	cap            secrets.CaProvider
 	dps            *dp_server.DpServer
 	kdsctx         *kds_context.Context
 	rv             ResourceValidators
 	au             authn.Authenticator
 	acc            Access
+	auditLog       audit.Log
 	appCtx         context.Context
 	extraReportsFn ExtraReportsFn
 	*runtimeInfo
@@ -96,6 +108,7 @@ func BuilderFor(appCtx context.Context, cfg kuma_cp.Config) (*Builder, error) {
 		cfg: cfg,
 		ext: context.Background(),
 		cam: core_ca.Managers{},
+		txs: core_store.NoTransactions{},
 		runtimeInfo: &runtimeInfo{
 			instanceId: fmt.Sprintf("%s-%s", hostname, suffix),
 			startTime:  time.Now(),
@@ -114,6 +127,11 @@ func (b *Builder) WithResourceStore(rs core_store.ResourceStore) *Builder {
 	return b
 }
 
+func (b *Builder) WithTransactions(txs core_store.Transactions) *Builder {
+	b.txs = txs
+	return b
+}
+
 func (b *Builder) WithSecretStore(ss store.SecretStore) *Builder {
 	b.ss = ss
 	return b
@@ -149,6 +167,11 @@ func (b *Builder) WithDataSourceLoader(loader datasource.Loader) *Builder {
 	return b
 }
 
+func (b *Builder) WithKeyProvider(kp keys.Provider) *Builder {
+	b.kp = kp
+	return b
+}
+
 func (b *Builder) WithExtensions(ext context.Context) *Builder {
 	b.ext = ext
 	return b
@@ -179,6 +202,11 @@ func (b *Builder) WithEnvoyAdminClient(eac admin.EnvoyAdminClient) *Builder {
 	return b
 }
 
+func (b *Builder) WithEnvoyAdminTunnels(eat tunnel.Tunnels) *Builder {
+	b.eat = eat
+	return b
+}
+
 func (b *Builder) WithMetrics(metrics metrics.Metrics) *Builder {
 	b.metrics = metrics
 	return b
@@ -199,6 +227,11 @@ func (b *Builder) WithXDSHooks(xdsh *xds_hooks.Hooks) *Builder {
 	return b
 }
 
+func (b *Builder) WithXDSControlPlaneContext(xdscp *xds_context.ControlPlaneContext) *Builder {
+	b.xdscp = xdscp
+	return b
+}
+
 func (b *Builder) WithCAProvider(cap secrets.CaProvider) *Builder {
 	b.cap = cap
 	return b
@@ -229,6 +262,11 @@ func (b *Builder) WithAccess(acc Access) *Builder {
 	return b
 }
 
+func (b *Builder) WithAuditLog(auditLog audit.Log) *Builder {
+	b.auditLog = auditLog
+	return b
+}
+
 func (b *Builder) WithExtraReportsFn(fn ExtraReportsFn) *Builder {
 	b.extraReportsFn = fn
 	return b
@@ -250,6 +288,9 @@ func (b *Builder) Build() (Runtime, error) {
 	if b.dsl == nil {
 		return nil, errors.Errorf("DataSourceLoader has not been configured")
 	}
+	if b.kp == nil {
+		return nil, errors.Errorf("KeyProvider has not been configured")
+	}
 	if b.ext == nil {
 		return nil, errors.Errorf("Extensions have been misconfigured")
 	}
@@ -262,6 +303,9 @@ func (b *Builder) Build() (Runtime, error) {
 	if b.eac == nil {
 		return nil, errors.Errorf("EnvoyAdminClient has not been configured")
 	}
+	if b.eat == nil {
+		return nil, errors.Errorf("EnvoyAdminTunnels has not been configured")
+	}
 	if b.metrics == nil {
 		return nil, errors.Errorf("Metrics has not been configured")
 	}
@@ -274,6 +318,9 @@ func (b *Builder) Build() (Runtime, error) {
 	if b.xdsh == nil {
 		return nil, errors.Errorf("XDSHooks has not been configured")
 	}
+	if b.xdscp == nil {
+		return nil, errors.Errorf("XDSControlPlaneContext has not been configured")
+	}
 	if b.cap == nil {
 		return nil, errors.Errorf("CAProvider has not been configured")
 	}
@@ -292,6 +339,9 @@ func (b *Builder) Build() (Runtime, error) {
 	if b.acc == (Access{}) {
 		return nil, errors.Errorf("Access has not been configured")
 	}
+	if b.auditLog == nil {
+		return nil, errors.Errorf("AuditLog has not been configured")
+	}
 	return &runtime{
 		RuntimeInfo: b.runtimeInfo,
 		RuntimeContext: &runtimeContext{
@@ -299,24 +349,29 @@ func (b *Builder) Build() (Runtime, error) {
 			rm:             b.rm,
 			rom:            b.rom,
 			rs:             b.rs,
+			txs:            b.txs,
 			ss:             b.ss,
 			cam:            b.cam,
 			dsl:            b.dsl,
+			kp:             b.kp,
 			ext:            b.ext,
 			configm:        b.configm,
 			leadInfo:       b.leadInfo,
 			lif:            b.lif,
 			eac:            b.eac,
+			eat:            b.eat,
 			metrics:        b.metrics,
 			erf:            b.erf,
 			apim:           b.apim,
 			xdsh:           b.xdsh,
+			xdscp:          b.xdscp,
 			cap:            b.cap,
 			dps:            b.dps,
 			kdsctx:         b.kdsctx,
 			rv:             b.rv,
 			au:             b.au,
 			acc:            b.acc,
+			auditLog:       b.auditLog,
 			appCtx:         b.appCtx,
 			extraReportsFn: b.extraReportsFn,
 		},
@@ -330,6 +385,9 @@ func (b *Builder) ComponentManager() component.Manager {
 func (b *Builder) ResourceStore() core_store.ResourceStore {
 	return b.rs
 }
+func (b *Builder) Transactions() core_store.Transactions {
+	return b.txs
+}
 func (b *Builder) SecretStore() store.SecretStore {
 	return b.ss
 }
@@ -351,6 +409,9 @@ func (b *Builder) Config() kuma_cp.Config {
 func (b *Builder) DataSourceLoader() datasource.Loader {
 	return b.dsl
 }
+func (b *Builder) KeyProvider() keys.Provider {
+	return b.kp
+}
 func (b *Builder) Extensions() context.Context {
 	return b.ext
 }
@@ -363,6 +424,9 @@ func (b *Builder) LeaderInfo() component.LeaderInfo {
 func (b *Builder) LookupIP() lookup.LookupIPFunc {
 	return b.lif
 }
+func (b *Builder) EnvoyAdminTunnels() tunnel.Tunnels {
+	return b.eat
+}
 func (b *Builder) Metrics() metrics.Metrics {
 	return b.metrics
 }
@@ -375,6 +439,9 @@ func (b *Builder) APIManager() api_server.APIManager {
 func (b *Builder) XDSHooks() *xds_hooks.Hooks {
 	return b.xdsh
 }
+func (b *Builder) XDSControlPlaneContext() *xds_context.ControlPlaneContext {
+	return b.xdscp
+}
 func (b *Builder) CAProvider() secrets.CaProvider {
 	return b.cap
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/kumahq/kuma/pkg/api-server/authn"
 	api_server "github.com/kumahq/kuma/pkg/api-server/customization"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	audit_access "github.com/kumahq/kuma/pkg/core/audit/access"
 	"github.com/kumahq/kuma/pkg/core/ca"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	"github.com/kumahq/kuma/pkg/core/datasource"
@@ -22,11 +24,14 @@ import (
 	dp_server "github.com/kumahq/kuma/pkg/dp-server/server"
 	"github.com/kumahq/kuma/pkg/envoy/admin"
 	"github.com/kumahq/kuma/pkg/envoy/admin/access"
+	"github.com/kumahq/kuma/pkg/envoy/admin/tunnel"
 	"github.com/kumahq/kuma/pkg/events"
 	kds_context "github.com/kumahq/kuma/pkg/kds/context"
+	"github.com/kumahq/kuma/pkg/keys"
 	"github.com/kumahq/kuma/pkg/metrics"
 	tokens_access "github.com/kumahq/kuma/pkg/tokens/builtin/access"
 	zone_access "github.com/kumahq/kuma/pkg/tokens/builtin/zone/access"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
 	"github.com/kumahq/kuma/pkg/xds/secrets"
 )
@@ -48,8 +53,10 @@ type RuntimeInfo interface {
 type RuntimeContext interface {
 	Config() kuma_cp.Config
 	DataSourceLoader() datasource.Loader
+	KeyProvider() keys.Provider
 	ResourceManager() core_manager.ResourceManager
 	ResourceStore() core_store.ResourceStore
+	Transactions() core_store.Transactions
 	ReadOnlyResourceManager() core_manager.ReadOnlyResourceManager
 	SecretStore() store.SecretStore
 	ConfigStore() core_store.ResourceStore
@@ -59,16 +66,20 @@ type RuntimeContext interface {
 	LeaderInfo() component.LeaderInfo
 	LookupIP() lookup.LookupIPFunc
 	EnvoyAdminClient() admin.EnvoyAdminClient
+	EnvoyAdminTunnels() tunnel.Tunnels
 	Metrics() metrics.Metrics
 	EventReaderFactory() events.ListenerFactory
 	APIInstaller() api_server.APIInstaller
 	XDSHooks() *xds_hooks.Hooks
+	XDSControlPlaneContext() *xds_context.ControlPlaneContext
 	CAProvider() secrets.CaProvider
 	DpServer() *dp_server.DpServer
 	KDSContext() *kds_context.Context
 	APIServerAuthenticator() authn.Authenticator
 	ResourceValidators() ResourceValidators
 	Access() Access
+	// AuditLog returns recent mutations of resources executed by this instance of the Control Plane.
+	AuditLog() audit.Log
 	// AppContext returns a context.Context which tracks the lifetime of the apps, it gets cancelled when the app is starting to shutdown.
 	AppContext() context.Context
 	ExtraReportsFn() ExtraReportsFn
@@ -79,6 +90,7 @@ type Access struct {
 	DataplaneTokenAccess tokens_access.DataplaneTokenAccess
 	ZoneTokenAccess      zone_access.ZoneTokenAccess
 	EnvoyAdminAccess     access.EnvoyAdminAccess
+	AuditLogAccess       audit_access.AuditLogAccess
 }
 
 type ResourceValidators struct {
@@ -132,26 +144,31 @@ type runtimeContext struct {
 	cfg            kuma_cp.Config
 	rm             core_manager.ResourceManager
 	rs             core_store.ResourceStore
+	txs            core_store.Transactions
 	ss             store.SecretStore
 	cs             core_store.ResourceStore
 	rom            core_manager.ReadOnlyResourceManager
 	cam            ca.Managers
 	dsl            datasource.Loader
+	kp             keys.Provider
 	ext            context.Context
 	configm        config_manager.ConfigManager
 	leadInfo       component.LeaderInfo
 	lif            lookup.LookupIPFunc
 	eac            admin.EnvoyAdminClient
+	eat            tunnel.Tunnels
 	metrics        metrics.Metrics
 	erf            events.ListenerFactory
 	apim           api_server.APIInstaller
 	xdsh           *xds_hooks.Hooks
+	xdscp          *xds_context.ControlPlaneContext
 	cap            secrets.CaProvider
 	dps            *dp_server.DpServer
 	kdsctx         *kds_context.Context
 	rv             ResourceValidators
 	au             authn.Authenticator
 	acc            Access
+	auditLog       audit.Log
 	appCtx         context.Context
 	extraReportsFn ExtraReportsFn
 }
@@ -176,6 +193,10 @@ func (rc *runtimeContext) DataSourceLoader() datasource.Loader {
 	return rc.dsl
 }
 
+func (rc *runtimeContext) KeyProvider() keys.Provider {
+	return rc.kp
+}
+
 func (rc *runtimeContext) ResourceManager() core_manager.ResourceManager {
 	return rc.rm
 }
@@ -184,6 +205,10 @@ func (rc *runtimeContext) ResourceStore() core_store.ResourceStore {
 	return rc.rs
 }
 
+func (rc *runtimeContext) Transactions() core_store.Transactions {
+	return rc.txs
+}
+
 func (rc *runtimeContext) SecretStore() store.SecretStore {
 	return rc.ss
 }
@@ -216,6 +241,10 @@ func (rc *runtimeContext) EnvoyAdminClient() admin.EnvoyAdminClient {
 	return rc.eac
 }
 
+func (rc *runtimeContext) EnvoyAdminTunnels() tunnel.Tunnels {
+	return rc.eat
+}
+
 func (rc *runtimeContext) APIInstaller() api_server.APIInstaller {
 	return rc.apim
 }
@@ -231,6 +260,10 @@ func (rc *runtimeContext) XDSHooks() *xds_hooks.Hooks {
 	return rc.xdsh
 }
 
+func (rc *runtimeContext) XDSControlPlaneContext() *xds_context.ControlPlaneContext {
+	return rc.xdscp
+}
+
 func (rc *runtimeContext) KDSContext() *kds_context.Context {
 	return rc.kdsctx
 }
@@ -243,6 +276,10 @@ func (rc *runtimeContext) APIServerAuthenticator() authn.Authenticator {
 	return rc.au
 }
 
+func (rc *runtimeContext) AuditLog() audit.Log {
+	return rc.auditLog
+}
+
 func (rc *runtimeContext) Access() Access {
 	return rc.acc
 }
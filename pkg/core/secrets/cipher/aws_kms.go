@@ -0,0 +1,70 @@
+package cipher
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config/core/resources/store"
+)
+
+// awsKmsTimeout is a timeout of a single request, Cipher does not pass the context.
+const awsKmsTimeout = 10 * time.Second
+
+type awsKmsKey struct {
+	client kmsiface.KMSAPI
+	keyID  string
+}
+
+var _ KeyEncryptionKey = &awsKmsKey{}
+
+// NewAwsKmsKey builds the key encryption key backed by the symmetric key of AWS KMS.
+// Credentials are taken from the environment.
+func NewAwsKmsKey(cfg store.AwsKmsEncryptionConfig) (KeyEncryptionKey, error) {
+	awsCfg := aws.NewConfig()
+	if cfg.Region != "" {
+		awsCfg = awsCfg.WithRegion(cfg.Region)
+	}
+	if cfg.Endpoint != "" {
+		awsCfg = awsCfg.WithEndpoint(cfg.Endpoint)
+	}
+	sess, err := session.NewSession(awsCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create AWS session")
+	}
+	return &awsKmsKey{
+		client: kms.New(sess),
+		keyID:  cfg.KeyId,
+	}, nil
+}
+
+func (a *awsKmsKey) EncryptKey(dataKey []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), awsKmsTimeout)
+	defer cancel()
+	out, err := a.client.EncryptWithContext(ctx, &kms.EncryptInput{
+		KeyId:     aws.String(a.keyID),
+		Plaintext: dataKey,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt with AWS KMS")
+	}
+	return out.CiphertextBlob, nil
+}
+
+func (a *awsKmsKey) DecryptKey(encryptedKey []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), awsKmsTimeout)
+	defer cancel()
+	out, err := a.client.DecryptWithContext(ctx, &kms.DecryptInput{
+		KeyId:          aws.String(a.keyID),
+		CiphertextBlob: encryptedKey,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt with AWS KMS")
+	}
+	return out.Plaintext, nil
+}
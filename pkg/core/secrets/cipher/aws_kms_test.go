@@ -0,0 +1,111 @@
+package cipher_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
+)
+
+const kmsKeyID = "alias/kuma-secrets"
+
+// fakeKms implements operations of AWS KMS (JSON protocol) used by the key encryption key.
+// Instead of encrypting, it stores the data keys and returns their indexes as ciphertexts.
+type fakeKms struct {
+	keys     [][]byte
+	decrypts int
+}
+
+func (f *fakeKms) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		KeyId          string
+		Plaintext      []byte
+		CiphertextBlob []byte
+	}
+	Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+	respond := func(status int, resp interface{}) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(status)
+		Expect(json.NewEncoder(w).Encode(resp)).To(Succeed())
+	}
+	if req.KeyId != kmsKeyID {
+		respond(http.StatusBadRequest, map[string]string{"__type": "NotFoundException", "message": "key not found"})
+		return
+	}
+
+	switch r.Header.Get("X-Amz-Target") {
+	case "TrentService.Encrypt":
+		f.keys = append(f.keys, req.Plaintext)
+		respond(http.StatusOK, map[string]interface{}{"KeyId": kmsKeyID, "CiphertextBlob": []byte{byte(len(f.keys) - 1)}})
+	case "TrentService.Decrypt":
+		f.decrypts++
+		respond(http.StatusOK, map[string]interface{}{"KeyId": kmsKeyID, "Plaintext": f.keys[req.CiphertextBlob[0]]})
+	default:
+		respond(http.StatusBadRequest, map[string]string{"__type": "InvalidAction", "message": "unknown action"})
+	}
+}
+
+var _ = Describe("AWS KMS key encryption key", func() {
+	var kms *fakeKms
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		Expect(os.Setenv("AWS_ACCESS_KEY_ID", "AKIAEXAMPLE")).To(Succeed())
+		Expect(os.Setenv("AWS_SECRET_ACCESS_KEY", "secret")).To(Succeed())
+		kms = &fakeKms{}
+		server = httptest.NewServer(kms)
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.Unsetenv("AWS_ACCESS_KEY_ID")).To(Succeed())
+		Expect(os.Unsetenv("AWS_SECRET_ACCESS_KEY")).To(Succeed())
+	})
+
+	newCipher := func(keyID string) cipher.Cipher {
+		c, err := cipher.NewFromConfig(store.SecretsEncryptionConfig{
+			Type: store.AwsKmsEncryption,
+			AwsKms: store.AwsKmsEncryptionConfig{
+				KeyId:    keyID,
+				Region:   "us-east-1",
+				Endpoint: server.URL,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	It("should encrypt data keys with KMS and cache decrypted data keys", func() {
+		// given
+		c := newCipher(kmsKeyID)
+
+		// when
+		encrypted, err := c.Encrypt([]byte("data"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kms.keys).To(HaveLen(1))
+
+		// when
+		for i := 0; i < 3; i++ {
+			Expect(c.Decrypt(encrypted)).To(Equal([]byte("data")))
+		}
+
+		// then
+		Expect(kms.decrypts).To(Equal(1))
+	})
+
+	It("should fail when the key does not exist", func() {
+		// when
+		_, err := newCipher("alias/other").Encrypt([]byte("data"))
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not encrypt with AWS KMS: NotFoundException: key not found")))
+	})
+})
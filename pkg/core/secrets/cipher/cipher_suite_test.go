@@ -0,0 +1,11 @@
+package cipher_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestCipher(t *testing.T) {
+	test.RunSpecs(t, "Cipher Suite")
+}
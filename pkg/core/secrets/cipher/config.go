@@ -0,0 +1,27 @@
+package cipher
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/config/core/resources/store"
+)
+
+// NewFromConfig builds Cipher of secrets. Secrets are not encrypted when the encryption is not configured.
+func NewFromConfig(cfg store.SecretsEncryptionConfig) (Cipher, error) {
+	var kek KeyEncryptionKey
+	var err error
+	switch cfg.Type {
+	case "":
+		return None(), nil
+	case store.StaticKeyEncryption:
+		kek, err = LoadStaticKey(cfg.Static.KeyFile)
+	case store.AwsKmsEncryption:
+		kek, err = NewAwsKmsKey(cfg.AwsKms)
+	default:
+		return nil, errors.Errorf("unknown type of secrets encryption %q", cfg.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewEnvelope(kek), nil
+}
@@ -0,0 +1,138 @@
+package cipher
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+)
+
+// envelopePrefix marks data encrypted by the envelope cipher.
+// Data without the prefix was stored before the encryption was enabled and is returned as is.
+var envelopePrefix = []byte("kuma-envelope-v1:")
+
+const (
+	dataKeySize = 32
+	// dataKeysCacheExpiration is how long decrypted data keys are kept in memory, so the key encryption key
+	// (which may be remote, like AWS KMS) is not called on every read of the secret.
+	dataKeysCacheExpiration = 10 * time.Minute
+)
+
+// KeyEncryptionKey encrypts data keys of the envelope encryption.
+type KeyEncryptionKey interface {
+	EncryptKey(dataKey []byte) ([]byte, error)
+	DecryptKey(encryptedKey []byte) ([]byte, error)
+}
+
+type envelope struct {
+	Key   []byte `json:"key"`
+	Nonce []byte `json:"nonce"`
+	Data  []byte `json:"data"`
+}
+
+type envelopeCipher struct {
+	kek      KeyEncryptionKey
+	dataKeys *cache.Cache
+}
+
+var _ Cipher = &envelopeCipher{}
+
+// NewEnvelope builds Cipher that encrypts every value with a new AES-256-GCM data key.
+// The data key is encrypted with the key encryption key and stored next to the encrypted value.
+func NewEnvelope(kek KeyEncryptionKey) Cipher {
+	return &envelopeCipher{
+		kek:      kek,
+		dataKeys: cache.New(dataKeysCacheExpiration, time.Minute),
+	}
+}
+
+func (e *envelopeCipher) Encrypt(data []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, errors.Wrap(err, "could not generate a data key")
+	}
+	encryptedKey, err := e.kek.EncryptKey(dataKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt the data key")
+	}
+	nonce, encrypted, err := aesGcmSeal(dataKey, data)
+	if err != nil {
+		return nil, err
+	}
+	marshaled, err := json.Marshal(envelope{
+		Key:   encryptedKey,
+		Nonce: nonce,
+		Data:  encrypted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, envelopePrefix...), marshaled...), nil
+}
+
+func (e *envelopeCipher) Decrypt(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, envelopePrefix) {
+		return data, nil
+	}
+	env := envelope{}
+	if err := json.Unmarshal(data[len(envelopePrefix):], &env); err != nil {
+		return nil, errors.Wrap(err, "could not parse the envelope of encrypted data")
+	}
+	dataKey, err := e.dataKey(env.Key)
+	if err != nil {
+		return nil, err
+	}
+	return aesGcmOpen(dataKey, env.Nonce, env.Data)
+}
+
+func (e *envelopeCipher) dataKey(encryptedKey []byte) ([]byte, error) {
+	if dataKey, ok := e.dataKeys.Get(string(encryptedKey)); ok {
+		return dataKey.([]byte), nil
+	}
+	dataKey, err := e.kek.DecryptKey(encryptedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt the data key")
+	}
+	e.dataKeys.SetDefault(string(encryptedKey), dataKey)
+	return dataKey, nil
+}
+
+func aesGcmSeal(key []byte, data []byte) ([]byte, []byte, error) {
+	gcm, err := newAesGcm(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, errors.Wrap(err, "could not generate a nonce")
+	}
+	return nonce, gcm.Seal(nil, nonce, data, nil), nil
+}
+
+func aesGcmOpen(key []byte, nonce []byte, data []byte) ([]byte, error) {
+	gcm, err := newAesGcm(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, errors.New("invalid size of the nonce")
+	}
+	decrypted, err := gcm.Open(nil, nonce, data, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt the data")
+	}
+	return decrypted, nil
+}
+
+func newAesGcm(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid AES key")
+	}
+	return cipher.NewGCM(block)
+}
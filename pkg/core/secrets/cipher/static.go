@@ -0,0 +1,57 @@
+package cipher
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+type staticKey struct {
+	key []byte
+}
+
+var _ KeyEncryptionKey = &staticKey{}
+
+// LoadStaticKey loads the key encryption key from the file with base64 encoded 32 bytes AES key.
+func LoadStaticKey(path string) (KeyEncryptionKey, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read the key file %q", path)
+	}
+	key, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(content)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "the key file %q has to contain base64 encoded key", path)
+	}
+	return NewStaticKey(key)
+}
+
+// NewStaticKey builds the key encryption key from 32 bytes AES key.
+func NewStaticKey(key []byte) (KeyEncryptionKey, error) {
+	if len(key) != dataKeySize {
+		return nil, errors.Errorf("the key has to have %d bytes, it has %d", dataKeySize, len(key))
+	}
+	return &staticKey{
+		key: key,
+	}, nil
+}
+
+func (s *staticKey) EncryptKey(dataKey []byte) ([]byte, error) {
+	nonce, encrypted, err := aesGcmSeal(s.key, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return append(nonce, encrypted...), nil
+}
+
+func (s *staticKey) DecryptKey(encryptedKey []byte) ([]byte, error) {
+	gcm, err := newAesGcm(s.key)
+	if err != nil {
+		return nil, err
+	}
+	if len(encryptedKey) < gcm.NonceSize() {
+		return nil, errors.New("the encrypted key is too short")
+	}
+	return aesGcmOpen(s.key, encryptedKey[:gcm.NonceSize()], encryptedKey[gcm.NonceSize():])
+}
@@ -58,6 +58,9 @@ func (s *globalSecretManager) Create(ctx context.Context, resource model.Resourc
 	if !ok {
 		return newInvalidTypeError()
 	}
+	if core_store.NewCreateOptions(fs...).DryRun {
+		return nil
+	}
 	if err := s.encrypt(secret); err != nil {
 		return err
 	}
@@ -72,6 +75,9 @@ func (s *globalSecretManager) Update(ctx context.Context, resource model.Resourc
 	if !ok {
 		return newInvalidTypeError()
 	}
+	if core_store.NewUpdateOptions(fs...).DryRun {
+		return nil
+	}
 	if err := s.encrypt(secret); err != nil {
 		return err
 	}
@@ -64,6 +64,9 @@ func (s *secretManager) Create(ctx context.Context, resource model.Resource, fs
 	if !ok {
 		return newInvalidTypeError()
 	}
+	if core_store.NewCreateOptions(fs...).DryRun {
+		return nil
+	}
 	if err := s.encrypt(secret); err != nil {
 		return err
 	}
@@ -78,6 +81,9 @@ func (s *secretManager) Update(ctx context.Context, resource model.Resource, fs
 	if !ok {
 		return newInvalidTypeError()
 	}
+	if core_store.NewUpdateOptions(fs...).DryRun {
+		return nil
+	}
 	if err := s.encrypt(secret); err != nil {
 		return err
 	}
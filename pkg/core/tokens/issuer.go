@@ -2,6 +2,10 @@ package tokens
 
 import (
 	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
 	"strconv"
 	"time"
 
@@ -46,7 +50,7 @@ func (j *jwtTokenIssuer) Generate(ctx context.Context, claims Claims, validFor t
 		ExpiresAt: jwt.NewNumericDate(now.Add(validFor)),
 	})
 
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token := jwt.NewWithClaims(signerSigningMethodRS256, claims)
 	token.Header[KeyIDHeader] = strconv.Itoa(serialNumber)
 	tokenString, err := token.SignedString(signingKey)
 	if err != nil {
@@ -54,3 +58,33 @@ func (j *jwtTokenIssuer) Generate(ctx context.Context, claims Claims, validFor t
 	}
 	return tokenString, nil
 }
+
+// signerSigningMethodRS256 signs tokens with RS256 using crypto.Signer,
+// so signing keys of the key provider that are not available in memory can be used.
+var signerSigningMethodRS256 jwt.SigningMethod = &signerSigningMethod{}
+
+type signerSigningMethod struct{}
+
+func (s *signerSigningMethod) Alg() string {
+	return jwt.SigningMethodRS256.Alg()
+}
+
+func (s *signerSigningMethod) Verify(signingString, signature string, key interface{}) error {
+	return jwt.SigningMethodRS256.Verify(signingString, signature, key)
+}
+
+func (s *signerSigningMethod) Sign(signingString string, key interface{}) (string, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return "", jwt.ErrInvalidKeyType
+	}
+	if _, ok := signer.Public().(*rsa.PublicKey); !ok {
+		return "", jwt.ErrInvalidKeyType
+	}
+	digest := sha256.Sum256([]byte(signingString))
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+	return jwt.EncodeSegment(signature), nil
+}
@@ -2,6 +2,9 @@ package tokens_test
 
 import (
 	"context"
+	"crypto"
+	"crypto/rsa"
+	"io"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -22,6 +25,7 @@ import (
 	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	"github.com/kumahq/kuma/pkg/core/tokens"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
 )
 
 type TestClaims struct {
@@ -224,4 +228,68 @@ var _ = Describe("Token issuer", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("Static signing key", func() {
+		It("should issue tokens valid for the control plane", func() {
+			// given signing key stored in the control plane
+			store = memory.NewStore()
+			secretManager := manager.NewResourceManager(store)
+			Expect(secretManager.Create(ctx, mesh.NewMeshResource(), core_store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))).To(Succeed())
+			Expect(tokens.NewMeshedSigningKeyManager(secretManager, TestTokenSigningKeyPrefix, core_model.DefaultMesh).CreateDefaultSigningKey(ctx)).To(Succeed())
+			validator = tokens.NewValidator(
+				tokens.NewMeshedSigningKeyAccessor(secretManager, TestTokenSigningKeyPrefix, core_model.DefaultMesh),
+				tokens.NewRevocations(secretManager, TokenRevocationsSecretKey(core_model.DefaultMesh)),
+				store_config.MemoryStore,
+			)
+
+			// and the same signing key provided outside of the control plane
+			secret := system.NewSecretResource()
+			Expect(store.Get(ctx, secret, core_store.GetBy(tokens.SigningKeyResourceKey(TestTokenSigningKeyPrefix, tokens.DefaultSerialNumber, core_model.DefaultMesh)))).To(Succeed())
+			key, err := tokens.ParseSigningKey(secret.Spec.GetData().GetValue())
+			Expect(err).ToNot(HaveOccurred())
+			issuer = tokens.NewTokenIssuer(tokens.NewStaticSigningKeyManager(key, tokens.DefaultSerialNumber))
+
+			// when
+			id := &TestClaims{}
+			token, err := issuer.Generate(ctx, id, time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(validator.ParseWithValidation(ctx, token, id)).To(Succeed())
+		})
+
+		It("should issue tokens with the signing key of the key provider", func() {
+			// given the signing key that is available only as a signer
+			key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+			Expect(err).ToNot(HaveOccurred())
+			signer := opaqueSigner{key: key}
+			issuer = tokens.NewTokenIssuer(tokens.NewStaticSigningKeyManager(signer, tokens.DefaultSerialNumber))
+			validator = tokens.NewValidator(
+				tokens.NewStaticSigningKeyAccessor(&key.PublicKey, tokens.DefaultSerialNumber),
+				tokens.NewRevocations(manager.NewResourceManager(memory.NewStore()), TokenRevocationsGlobalSecretKey),
+				store_config.MemoryStore,
+			)
+
+			// when
+			id := &TestClaims{}
+			token, err := issuer.Generate(ctx, id, time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(validator.ParseWithValidation(ctx, token, id)).To(Succeed())
+		})
+	})
 })
+
+// opaqueSigner hides the private key like signers of HSM or KMS do
+type opaqueSigner struct {
+	key *rsa.PrivateKey
+}
+
+func (o opaqueSigner) Public() crypto.PublicKey {
+	return o.key.Public()
+}
+
+func (o opaqueSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return o.key.Sign(rand, digest, opts)
+}
@@ -2,7 +2,7 @@ package tokens
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
@@ -32,7 +32,7 @@ type meshedSigningKeyManager struct {
 
 var _ SigningKeyManager = &meshedSigningKeyManager{}
 
-func (s *meshedSigningKeyManager) GetLatestSigningKey(ctx context.Context) (*rsa.PrivateKey, int, error) {
+func (s *meshedSigningKeyManager) GetLatestSigningKey(ctx context.Context) (crypto.Signer, int, error) {
 	resources := system.SecretResourceList{}
 	if err := s.manager.List(ctx, &resources, store.ListByMesh(s.mesh)); err != nil {
 		return nil, 0, errors.Wrap(err, "could not retrieve signing key from secret manager")
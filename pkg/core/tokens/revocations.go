@@ -36,17 +36,33 @@ func (s *secretRevocations) IsRevoked(ctx context.Context, id string) (bool, err
 	if err != nil {
 		return false, err
 	}
-	if len(data) == 0 {
-		return false, nil
+	for _, revokedId := range RevokedIDs(data) {
+		if revokedId == id {
+			return true, nil
+		}
 	}
+	return false, nil
+}
+
+// RevokedIDs returns IDs of tokens stored in the data of the revocation list.
+func RevokedIDs(data []byte) []string {
 	rawIds := strings.TrimSuffix(string(data), "\n")
-	ids := strings.Split(rawIds, ",")
+	if rawIds == "" {
+		return nil
+	}
+	return strings.Split(rawIds, ",")
+}
+
+// WithRevokedID returns the data of the revocation list with the ID of the token added.
+// The second value is false when the token is already revoked.
+func WithRevokedID(data []byte, id string) ([]byte, bool) {
+	ids := RevokedIDs(data)
 	for _, revokedId := range ids {
 		if revokedId == id {
-			return true, nil
+			return data, false
 		}
 	}
-	return false, nil
+	return []byte(strings.Join(append(ids, id), ",")), true
 }
 
 func (s *secretRevocations) getSecretData(ctx context.Context) ([]byte, error) {
@@ -56,6 +56,11 @@ func IsSigningKeyNotFound(err error) bool {
 	return errors.As(err, &target)
 }
 
+// ParseSigningKey parses the signing key in the format in which it is stored in a Secret (PEM or legacy PKCS1).
+func ParseSigningKey(keyBytes []byte) (*rsa.PrivateKey, error) {
+	return keyBytesToRsaPrivateKey(keyBytes)
+}
+
 func keyBytesToRsaPrivateKey(keyBytes []byte) (*rsa.PrivateKey, error) {
 	if util_rsa.IsPrivateKeyPEMBytes(keyBytes) {
 		key, err := util_rsa.FromPEMBytesToPrivateKey(keyBytes)
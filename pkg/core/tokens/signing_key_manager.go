@@ -2,6 +2,7 @@ package tokens
 
 import (
 	"context"
+	"crypto"
 	"crypto/rsa"
 	"strings"
 
@@ -25,7 +26,7 @@ const (
 // "user-token-signing-key" has a serial number of 0
 // The latest key is  a key with a higher serial number (number at the end of the name)
 type SigningKeyManager interface {
-	GetLatestSigningKey(context.Context) (*rsa.PrivateKey, int, error)
+	GetLatestSigningKey(context.Context) (crypto.Signer, int, error)
 	CreateDefaultSigningKey(context.Context) error
 	CreateSigningKey(ctx context.Context, serialNumber int) error
 }
@@ -44,7 +45,7 @@ type signingKeyManager struct {
 
 var _ SigningKeyManager = &signingKeyManager{}
 
-func (s *signingKeyManager) GetLatestSigningKey(ctx context.Context) (*rsa.PrivateKey, int, error) {
+func (s *signingKeyManager) GetLatestSigningKey(ctx context.Context) (crypto.Signer, int, error) {
 	resources := system.GlobalSecretResourceList{}
 	if err := s.manager.List(ctx, &resources); err != nil {
 		return nil, 0, errors.Wrap(err, "could not retrieve signing key from secret manager")
@@ -0,0 +1,36 @@
+package tokens
+
+import (
+	"context"
+	"crypto/rsa"
+
+	"github.com/pkg/errors"
+)
+
+// staticSigningKeyAccessor is an accessor of the public key of the signing key that is not stored in secrets,
+// like the signing key of the key provider.
+type staticSigningKeyAccessor struct {
+	publicKey    *rsa.PublicKey
+	serialNumber int
+}
+
+var _ SigningKeyAccessor = &staticSigningKeyAccessor{}
+
+func NewStaticSigningKeyAccessor(publicKey *rsa.PublicKey, serialNumber int) SigningKeyAccessor {
+	return &staticSigningKeyAccessor{
+		publicKey:    publicKey,
+		serialNumber: serialNumber,
+	}
+}
+
+func (s *staticSigningKeyAccessor) GetPublicKey(_ context.Context, serialNumber int) (*rsa.PublicKey, error) {
+	if serialNumber != s.serialNumber {
+		return nil, errors.Errorf("there is no signing key with serial number %d. If signing key was rotated, regenerate the token", serialNumber)
+	}
+	return s.publicKey, nil
+}
+
+// GetLegacyKey is not supported for this accessor, because the private part of the signing key is not available.
+func (s *staticSigningKeyAccessor) GetLegacyKey(_ context.Context, _ int) ([]byte, error) {
+	return nil, errors.New("legacy key are not supported")
+}
@@ -0,0 +1,37 @@
+package tokens
+
+import (
+	"context"
+	"crypto"
+
+	"github.com/pkg/errors"
+)
+
+// NewStaticSigningKeyManager builds SigningKeyManager that always returns the provided signing key.
+// It is used to sign tokens outside of the control plane (for example by kumactl in offline mode)
+// or with a key of the key provider, therefore signing keys cannot be created or rotated with it.
+func NewStaticSigningKeyManager(key crypto.Signer, serialNumber int) SigningKeyManager {
+	return &staticSigningKeyManager{
+		key:          key,
+		serialNumber: serialNumber,
+	}
+}
+
+type staticSigningKeyManager struct {
+	key          crypto.Signer
+	serialNumber int
+}
+
+var _ SigningKeyManager = &staticSigningKeyManager{}
+
+func (s *staticSigningKeyManager) GetLatestSigningKey(context.Context) (crypto.Signer, int, error) {
+	return s.key, s.serialNumber, nil
+}
+
+func (s *staticSigningKeyManager) CreateDefaultSigningKey(context.Context) error {
+	return errors.New("static signing key manager cannot create signing keys")
+}
+
+func (s *staticSigningKeyManager) CreateSigningKey(context.Context, int) error {
+	return errors.New("static signing key manager cannot create signing keys")
+}
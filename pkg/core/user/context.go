@@ -9,8 +9,15 @@ func Ctx(ctx context.Context, user User) context.Context {
 }
 
 func FromCtx(ctx context.Context) User {
-	if value, ok := ctx.Value(userCtx{}).(User); ok {
+	if value, ok := Lookup(ctx); ok {
 		return value
 	}
 	return Anonymous
 }
+
+// Lookup returns the user of the context and whether there is any. Operations executed by the Control Plane itself
+// are not executed on behalf of any user.
+func Lookup(ctx context.Context) (User, bool) {
+	value, ok := ctx.Value(userCtx{}).(User)
+	return value, ok
+}
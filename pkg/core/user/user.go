@@ -7,6 +7,8 @@ const AuthenticatedGroup = "mesh-system:authenticated"
 type User struct {
 	Name   string
 	Groups []string
+	// Tenant of the user. The user can only access meshes of the tenant. Users without a tenant can access all meshes.
+	Tenant string `json:",omitempty"`
 }
 
 func (u User) String() string {
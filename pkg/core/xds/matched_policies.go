@@ -12,15 +12,18 @@ import (
 type MatchedPolicies struct {
 	// Inbound(Listener) -> Policy
 	TrafficPermissions    TrafficPermissionMap
+	TrafficDenies         TrafficDenyMap
 	FaultInjections       FaultInjectionMap
 	RateLimitsInbound     InboundRateLimitsMap
 	CustomInboundPolicies []map[mesh_proto.InboundInterface]core_model.Resource
 
 	// Service(Cluster) -> Policy
-	TrafficLogs     TrafficLogMap
-	HealthChecks    HealthCheckMap
-	CircuitBreakers CircuitBreakerMap
-	Retries         RetryMap
+	TrafficLogs       TrafficLogMap
+	HealthChecks      HealthCheckMap
+	CircuitBreakers   CircuitBreakerMap
+	Retries           RetryMap
+	ProtocolOptions   ProtocolOptionsMap
+	ZoneTrafficRoutes ZoneTrafficRouteMap
 
 	// Outbound(Listener) -> Policy
 	Timeouts           TimeoutMap
@@ -29,7 +32,16 @@ type MatchedPolicies struct {
 	TrafficRoutes RouteMap
 
 	// Dataplane -> Policy
-	TrafficTrace *core_mesh.TrafficTraceResource
+	TrafficTrace      *core_mesh.TrafficTraceResource
+	LocalReply        *core_mesh.LocalReplyResource
+	HeaderModifier    *core_mesh.MeshHeaderModifierResource
+	WasmPlugin        *core_mesh.MeshWasmPluginResource
+	ExternalAuthz     *core_mesh.MeshExternalAuthzResource
+	JWT               *core_mesh.MeshJWTResource
+	BandwidthLimit    *core_mesh.MeshBandwidthLimitResource
+	Compression       *core_mesh.MeshCompressionResource
+	CORS              *core_mesh.MeshCORSResource
+	RequestProtection *core_mesh.MeshRequestProtectionResource
 	// Actual Envoy Configuration is generated without taking this ProxyTemplate into account
 	ProxyTemplate *core_mesh.ProxyTemplateResource
 }
@@ -175,6 +187,11 @@ func getInboundMatchedPolicies(matchedPolicies *MatchedPolicies) map[mesh_proto.
 	for inbound, tp := range matchedPolicies.TrafficPermissions {
 		result[inbound] = append(result[inbound], tp)
 	}
+	for inbound, tdList := range matchedPolicies.TrafficDenies {
+		for _, td := range tdList {
+			result[inbound] = append(result[inbound], td)
+		}
+	}
 	for inbound, fiList := range matchedPolicies.FaultInjections {
 		for _, fi := range fiList {
 			result[inbound] = append(result[inbound], fi)
@@ -225,6 +242,12 @@ func getServiceMatchedPolicies(matchedPolicies *MatchedPolicies) map[ServiceName
 	for service, retry := range matchedPolicies.Retries {
 		result[service] = append(result[service], retry)
 	}
+	for service, po := range matchedPolicies.ProtocolOptions {
+		result[service] = append(result[service], po)
+	}
+	for service, route := range matchedPolicies.ZoneTrafficRoutes {
+		result[service] = append(result[service], route)
+	}
 
 	return result
 }
@@ -234,6 +257,33 @@ func getDataplaneMatchedPolicies(matchedPolicies *MatchedPolicies) []core_model.
 	if matchedPolicies.TrafficTrace != nil {
 		resources = append(resources, matchedPolicies.TrafficTrace)
 	}
+	if matchedPolicies.LocalReply != nil {
+		resources = append(resources, matchedPolicies.LocalReply)
+	}
+	if matchedPolicies.HeaderModifier != nil {
+		resources = append(resources, matchedPolicies.HeaderModifier)
+	}
+	if matchedPolicies.WasmPlugin != nil {
+		resources = append(resources, matchedPolicies.WasmPlugin)
+	}
+	if matchedPolicies.ExternalAuthz != nil {
+		resources = append(resources, matchedPolicies.ExternalAuthz)
+	}
+	if matchedPolicies.JWT != nil {
+		resources = append(resources, matchedPolicies.JWT)
+	}
+	if matchedPolicies.BandwidthLimit != nil {
+		resources = append(resources, matchedPolicies.BandwidthLimit)
+	}
+	if matchedPolicies.Compression != nil {
+		resources = append(resources, matchedPolicies.Compression)
+	}
+	if matchedPolicies.CORS != nil {
+		resources = append(resources, matchedPolicies.CORS)
+	}
+	if matchedPolicies.RequestProtection != nil {
+		resources = append(resources, matchedPolicies.RequestProtection)
+	}
 	if matchedPolicies.ProxyTemplate != nil {
 		resources = append(resources, matchedPolicies.ProxyTemplate)
 	}
@@ -20,6 +20,7 @@ var metadataLog = core.Log.WithName("xds-server").WithName("metadata-tracker")
 const (
 	// Supported Envoy node metadata fields.
 	fieldDataplaneAdminPort         = "dataplane.admin.port"
+	fieldDataplaneAdminSocket       = "dataplane.admin.socket"
 	fieldDataplaneDNSPort           = "dataplane.dns.port"
 	fieldDataplaneDNSEmptyPort      = "dataplane.dns.empty.port"
 	fieldDataplaneDataplaneResource = "dataplane.resource"
@@ -47,6 +48,7 @@ const (
 type DataplaneMetadata struct {
 	Resource        model.Resource
 	AdminPort       uint32
+	AdminSocketPath string
 	DNSPort         uint32
 	EmptyDNSPort    uint32
 	DynamicMetadata map[string]string
@@ -105,6 +107,16 @@ func (m *DataplaneMetadata) GetAdminPort() uint32 {
 	return m.AdminPort
 }
 
+// GetAdminSocketPath returns a path of the unix domain socket Envoy Admin API listens on.
+// Empty value means that Envoy Admin API listens on the loopback interface.
+// The path is set by kuma-dp, so the Control Plane must not use it to configure Envoy.
+func (m *DataplaneMetadata) GetAdminSocketPath() string {
+	if m == nil {
+		return ""
+	}
+	return m.AdminSocketPath
+}
+
 func (m *DataplaneMetadata) GetDNSPort() uint32 {
 	if m == nil {
 		return 0
@@ -145,6 +157,9 @@ func DataplaneMetadataFromXdsMetadata(xdsMetadata *structpb.Struct) *DataplaneMe
 		metadata.ProxyType = mesh_proto.ProxyType(field.GetStringValue())
 	}
 	metadata.AdminPort = uint32Metadata(xdsMetadata, fieldDataplaneAdminPort)
+	if value := xdsMetadata.Fields[fieldDataplaneAdminSocket]; value != nil {
+		metadata.AdminSocketPath = value.GetStringValue()
+	}
 	metadata.DNSPort = uint32Metadata(xdsMetadata, fieldDataplaneDNSPort)
 	metadata.EmptyDNSPort = uint32Metadata(xdsMetadata, fieldDataplaneDNSEmptyPort)
 	if value := xdsMetadata.Fields[fieldDataplaneDataplaneResource]; value != nil {
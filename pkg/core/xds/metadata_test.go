@@ -37,6 +37,11 @@ var _ = Describe("DataplaneMetadataFromXdsMetadata", func() {
 							StringValue: "1234",
 						},
 					},
+					"dataplane.admin.socket": {
+						Kind: &structpb.Value_StringValue{
+							StringValue: "/tmp/kuma-ea-backend-default.sock",
+						},
+					},
 					"dataplane.dns.port": {
 						Kind: &structpb.Value_StringValue{
 							StringValue: "8000",
@@ -50,9 +55,10 @@ var _ = Describe("DataplaneMetadataFromXdsMetadata", func() {
 				},
 			},
 			expected: xds.DataplaneMetadata{
-				AdminPort:    1234,
-				DNSPort:      8000,
-				EmptyDNSPort: 8001,
+				AdminPort:       1234,
+				AdminSocketPath: "/tmp/kuma-ea-backend-default.sock",
+				DNSPort:         8000,
+				EmptyDNSPort:    8001,
 			},
 		}),
 		Entry("should ignore dependencies version provided through metadata if version is not set at all", testCase{
@@ -61,6 +61,10 @@ type ExternalService struct {
 	ClientKey          []byte
 	AllowRenegotiation bool
 	ServerName         string
+	SubjectAltNames    []*mesh_proto.ExternalService_Networking_TLS_SubjectAltName
+	SpkiPins           []string
+	ProxyProtocol      *mesh_proto.ExternalService_Networking_ProxyProtocol
+	DNS                *mesh_proto.ExternalService_Networking_DNS
 }
 
 type Locality struct {
@@ -97,12 +101,21 @@ type CircuitBreakerMap map[ServiceName]*core_mesh.CircuitBreakerResource
 // RetryMap holds the most specific Retry for each reachable service.
 type RetryMap map[ServiceName]*core_mesh.RetryResource
 
+// ProtocolOptionsMap holds the most specific MeshProtocolOptions for each reachable service.
+type ProtocolOptionsMap map[ServiceName]*core_mesh.MeshProtocolOptionsResource
+
+// ZoneTrafficRouteMap holds the most specific MeshZoneTrafficRoute for each reachable service.
+type ZoneTrafficRouteMap map[ServiceName]*core_mesh.MeshZoneTrafficRouteResource
+
 // FaultInjectionMap holds all matched FaultInjectionResources for each InboundInterface
 type FaultInjectionMap map[mesh_proto.InboundInterface][]*core_mesh.FaultInjectionResource
 
 // TrafficPermissionMap holds the most specific TrafficPermissionResource for each InboundInterface
 type TrafficPermissionMap map[mesh_proto.InboundInterface]*core_mesh.TrafficPermissionResource
 
+// TrafficDenyMap holds all TrafficPermissionResources with DENY or SHADOW_DENY action for each InboundInterface
+type TrafficDenyMap map[mesh_proto.InboundInterface][]*core_mesh.TrafficPermissionResource
+
 // InboundRateLimitsMap holds all RateLimitResources for each InboundInterface
 type InboundRateLimitsMap map[mesh_proto.InboundInterface][]*core_mesh.RateLimitResource
 
@@ -121,7 +134,7 @@ type ExternalServiceFaultInjectionMap map[ServiceName][]*core_mesh.FaultInjectio
 type ExternalServiceRateLimitMap map[ServiceName][]*core_mesh.RateLimitResource
 
 type CLACache interface {
-	GetCLA(ctx context.Context, meshName, meshHash string, cluster envoy_common.Cluster, apiVersion envoy_common.APIVersion, endpointMap EndpointMap) (proto.Message, error)
+	GetCLA(ctx context.Context, mesh *core_mesh.MeshResource, meshHash string, cluster envoy_common.Cluster, apiVersion envoy_common.APIVersion, endpointMap EndpointMap) (proto.Message, error)
 }
 
 // SocketAddressProtocol is the L4 protocol the listener should bind to
@@ -274,6 +287,7 @@ type ZoneIngressProxy struct {
 	TrafficRouteList *core_mesh.TrafficRouteResourceList
 	GatewayRoutes    *core_mesh.MeshGatewayRouteResourceList
 	MeshGateways     *core_mesh.MeshGatewayResourceList
+	MeshResourceList *core_mesh.MeshResourceList
 }
 
 type VIPDomains struct {
@@ -288,6 +302,8 @@ type Routing struct {
 
 type CaSecret struct {
 	PemCerts [][]byte
+	// PemCrls are revocation lists of the CAs. Certificates are not checked for revocation if it's empty.
+	PemCrls [][]byte
 }
 
 type IdentitySecret struct {
@@ -6,4 +6,7 @@ type EnvoyAdminAccess interface {
 	ValidateViewConfigDump(user user.User) error
 	ValidateViewStats(user user.User) error
 	ValidateViewClusters(user user.User) error
+	ValidateDrainDataplane(user user.User) error
+	ValidateViewUnredactedConfigDump(user user.User) error
+	ValidateProxyAdmin(user user.User) error
 }
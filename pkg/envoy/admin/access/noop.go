@@ -18,3 +18,15 @@ func (n NoopEnvoyAdminAccess) ValidateViewStats(user user.User) error {
 func (n NoopEnvoyAdminAccess) ValidateViewClusters(user user.User) error {
 	return nil
 }
+
+func (n NoopEnvoyAdminAccess) ValidateDrainDataplane(user user.User) error {
+	return nil
+}
+
+func (n NoopEnvoyAdminAccess) ValidateViewUnredactedConfigDump(user user.User) error {
+	return nil
+}
+
+func (n NoopEnvoyAdminAccess) ValidateProxyAdmin(user user.User) error {
+	return nil
+}
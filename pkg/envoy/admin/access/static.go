@@ -10,6 +10,11 @@ type staticEnvoyAdminAccess struct {
 	configDump accessMaps
 	stats      accessMaps
 	clusters   accessMaps
+	drain      accessMaps
+	// unredactedConfigDump is required on top of configDump to get a config dump without redacted credentials
+	unredactedConfigDump accessMaps
+	// proxyAdmin grants access to any endpoint of the admin API, so it should be granted only to trusted users
+	proxyAdmin accessMaps
 }
 
 type accessMaps struct {
@@ -23,11 +28,17 @@ func NewStaticEnvoyAdminAccess(
 	configDumpCfg config_access.ViewConfigDumpStaticAccessConfig,
 	statsCfg config_access.ViewStatsStaticAccessConfig,
 	clustersCfg config_access.ViewClustersStaticAccessConfig,
+	drainCfg config_access.DrainDataplaneStaticAccessConfig,
+	unredactedConfigDumpCfg config_access.ViewUnredactedConfigDumpStaticAccessConfig,
+	proxyAdminCfg config_access.ProxyAdminStaticAccessConfig,
 ) EnvoyAdminAccess {
 	return &staticEnvoyAdminAccess{
-		configDump: mapAccess(configDumpCfg.Users, configDumpCfg.Groups),
-		stats:      mapAccess(statsCfg.Users, statsCfg.Groups),
-		clusters:   mapAccess(clustersCfg.Users, clustersCfg.Groups),
+		configDump:           mapAccess(configDumpCfg.Users, configDumpCfg.Groups),
+		stats:                mapAccess(statsCfg.Users, statsCfg.Groups),
+		clusters:             mapAccess(clustersCfg.Users, clustersCfg.Groups),
+		drain:                mapAccess(drainCfg.Users, drainCfg.Groups),
+		unredactedConfigDump: mapAccess(unredactedConfigDumpCfg.Users, unredactedConfigDumpCfg.Groups),
+		proxyAdmin:           mapAccess(proxyAdminCfg.Users, proxyAdminCfg.Groups),
 	}
 }
 
@@ -57,6 +68,18 @@ func (s *staticEnvoyAdminAccess) ValidateViewClusters(user user.User) error {
 	return validateAccess(s.clusters, user)
 }
 
+func (s *staticEnvoyAdminAccess) ValidateDrainDataplane(user user.User) error {
+	return validateAccess(s.drain, user)
+}
+
+func (s *staticEnvoyAdminAccess) ValidateViewUnredactedConfigDump(user user.User) error {
+	return validateAccess(s.unredactedConfigDump, user)
+}
+
+func (s *staticEnvoyAdminAccess) ValidateProxyAdmin(user user.User) error {
+	return validateAccess(s.proxyAdmin, user)
+}
+
 func validateAccess(maps accessMaps, user user.User) error {
 	allowed := maps.usernames[user.Name]
 	for _, group := range user.Groups {
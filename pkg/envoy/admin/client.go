@@ -1,25 +1,36 @@
 package admin
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	"github.com/golang/groupcache/lru"
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
+	"github.com/sethvargo/go-retry"
 
+	envoy_admin_client "github.com/kumahq/kuma/pkg/config/envoy-admin-client"
 	"github.com/kumahq/kuma/pkg/core/ca"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/envoy/admin/tunnel"
 	util_tls "github.com/kumahq/kuma/pkg/tls"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	xds_tls "github.com/kumahq/kuma/pkg/xds/envoy/tls"
@@ -27,51 +38,187 @@ import (
 
 type EnvoyAdminClient interface {
 	PostQuit(ctx context.Context, dataplane *core_mesh.DataplaneResource) error
+	Drain(ctx context.Context, dataplane *core_mesh.DataplaneResource, graceful bool) error
 
-	Stats(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error)
-	Clusters(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error)
-	ConfigDump(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error)
+	Stats(ctx context.Context, proxy core_model.ResourceWithAddress, opts StatsOpts) ([]byte, error)
+	Clusters(ctx context.Context, proxy core_model.ResourceWithAddress, opts ClustersOpts) ([]byte, error)
+	ConfigDump(ctx context.Context, proxy core_model.ResourceWithAddress, opts ConfigDumpOpts) ([]byte, error)
+	Listeners(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Listeners, error)
+	Certs(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Certificates, error)
+	Runtime(ctx context.Context, proxy core_model.ResourceWithAddress) (*Runtime, error)
+	ServerInfo(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.ServerInfo, error)
+	MemoryStats(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Memory, error)
+	TailLogs(ctx context.Context, proxy core_model.ResourceWithAddress, opts TailLogsOpts) (io.ReadCloser, error)
+	Forward(ctx context.Context, proxy core_model.ResourceWithAddress, method, path string, query url.Values, body io.Reader) (*http.Response, error)
 }
 
 type envoyAdminClient struct {
-	rm               manager.ResourceManager
+	rm               manager.ReadOnlyResourceManager
 	caManagers       ca.Managers
 	clientCert       tls.Certificate
 	defaultAdminPort uint32
+	config           envoy_admin_client.EnvoyAdminClientConfig
+	// tunnels are preferred over direct connections to the Envoy Admin API, so the Admin API does not have to be reachable from the Control Plane.
+	tunnels tunnel.Tunnels
+
+	plainHTTPClient *http.Client
+
+	sync.Mutex
+	// clients is an LRU cache of HTTP clients keyed by clientKey and holding *cachedClient.
+	// Reusing clients lets us reuse TLS connections and avoid fetching root certs of the Mesh CA on every request.
+	clients *lru.Cache
 }
 
-func NewEnvoyAdminClient(rm manager.ResourceManager, caManagers ca.Managers, clientCertPath, clientKeyPath string, adminPort uint32) (EnvoyAdminClient, error) {
-	cert, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
-	if err != nil {
-		return nil, err
-	}
+type clientKey struct {
+	mesh               string
+	identifyingService string
+}
+
+type cachedClient struct {
+	// meshVersion is a version of the Mesh resource for which the client was built.
+	// Every change of the Mesh, including the change of CA, results in building a new client.
+	meshVersion string
+	httpClient  *http.Client
+	// invalid is set when the certificate of the proxy could not be verified against the root certs of the client.
+	// The root certs can be rotated without the change of the Mesh, so the client is built again with the current root certs.
+	invalid int32
+}
 
+func (c *cachedClient) invalidate() {
+	atomic.StoreInt32(&c.invalid, 1)
+}
+
+func (c *cachedClient) valid(meshVersion string) bool {
+	return c.meshVersion == meshVersion && atomic.LoadInt32(&c.invalid) == 0
+}
+
+func NewEnvoyAdminClient(
+	rm manager.ReadOnlyResourceManager,
+	caManagers ca.Managers,
+	clientCert tls.Certificate,
+	adminPort uint32,
+	config envoy_admin_client.EnvoyAdminClientConfig,
+	tunnels tunnel.Tunnels,
+) (EnvoyAdminClient, error) {
 	client := &envoyAdminClient{
 		rm:               rm,
 		caManagers:       caManagers,
-		clientCert:       cert,
+		clientCert:       clientCert,
 		defaultAdminPort: adminPort,
+		config:           config,
+		tunnels:          tunnels,
+		plainHTTPClient: &http.Client{
+			Transport: &http.Transport{
+				Dial: (&net.Dialer{
+					Timeout: config.ConnectTimeout,
+				}).Dial,
+				IdleConnTimeout: config.IdleConnTimeout,
+			},
+		},
+		clients: lru.New(config.CacheSize),
+	}
+	client.clients.OnEvicted = func(_ lru.Key, value interface{}) {
+		value.(*cachedClient).httpClient.CloseIdleConnections()
 	}
 	return client, nil
 }
 
+type requestTimeoutKey struct{}
+
+type maxRetriesKey struct{}
+
+// WithRequestTimeout overrides the configured timeout of the requests to the Envoy Admin API executed with the returned context.
+// It is useful for requests that are known to take longer, like config dumps of big proxies.
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
+// WithMaxRetries overrides the configured number of retries of the read-only requests to the Envoy Admin API executed with the returned context.
+func WithMaxRetries(ctx context.Context, maxRetries uint) context.Context {
+	return context.WithValue(ctx, maxRetriesKey{}, maxRetries)
+}
+
+func (a *envoyAdminClient) requestTimeout(ctx context.Context) time.Duration {
+	if timeout, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); ok {
+		return timeout
+	}
+	return a.config.RequestTimeout
+}
+
+func (a *envoyAdminClient) maxRetries(ctx context.Context) uint {
+	if maxRetries, ok := ctx.Value(maxRetriesKey{}).(uint); ok {
+		return maxRetries
+	}
+	return a.config.MaxRetries
+}
+
 // Envoy admin API endpoint is secured in two possible ways
 // 1) When mTLS on the mesh is disabled, we pass autogenerated self signed cert just to have TLS in place.
 // 2) When mTLS on the mesh is enabled, we are protecting the endpoint with enabled mTLS backend.
 //
 // Regardless of which CA is used to protect Admin API endpoint, Envoy will always require certs from CP which are the same certs as DP server.
-func (a *envoyAdminClient) buildHTTPClient(mesh, identifyingService string) (*http.Client, error) {
-	caCertPool, err := a.caCertPoolOfMeshMTLS(mesh)
+func (a *envoyAdminClient) httpClient(ctx context.Context, mesh, identifyingService string) (*http.Client, error) {
+	var meshRes *core_mesh.MeshResource
+	meshVersion := ""
+	if mesh != "" {
+		meshRes = core_mesh.NewMeshResource()
+		if err := a.rm.Get(ctx, meshRes, core_store.GetByKey(mesh, core_model.NoMesh)); err != nil {
+			return nil, err
+		}
+		meshVersion = meshRes.Meta.GetVersion()
+	}
+
+	key := clientKey{mesh: mesh, identifyingService: identifyingService}
+	a.Lock()
+	if value, ok := a.clients.Get(key); ok && value.(*cachedClient).valid(meshVersion) {
+		a.Unlock()
+		return value.(*cachedClient).httpClient, nil
+	}
+	a.Unlock()
+
+	cached := &cachedClient{
+		meshVersion: meshVersion,
+	}
+	httpClient, err := a.buildHTTPClient(ctx, meshRes, identifyingService, cached.invalidate)
 	if err != nil {
 		return nil, err
 	}
+	cached.httpClient = httpClient
+
+	a.Lock()
+	defer a.Unlock()
+	if value, ok := a.clients.Get(key); ok {
+		// Add replaces the value without calling OnEvicted
+		value.(*cachedClient).httpClient.CloseIdleConnections()
+	}
+	a.clients.Add(key, cached)
+	return httpClient, nil
+}
+
+// buildHTTPClient builds the client that verifies the proxy against the root certs of the Mesh CA.
+// onCAVerificationFailure is called when the certificate of the proxy is not signed by any of the root certs.
+func (a *envoyAdminClient) buildHTTPClient(
+	ctx context.Context,
+	meshRes *core_mesh.MeshResource,
+	identifyingService string,
+	onCAVerificationFailure func(),
+) (*http.Client, error) {
+	caCertPool, err := a.caCertPoolOfMeshMTLS(ctx, meshRes)
+	if err != nil {
+		return nil, err
+	}
+	mesh := ""
+	if meshRes != nil {
+		mesh = meshRes.GetMeta().GetName()
+	}
 
 	c := &http.Client{
 		Transport: &http.Transport{
 			Dial: (&net.Dialer{
-				Timeout: 3 * time.Second,
+				Timeout: a.config.ConnectTimeout,
 			}).Dial,
-			TLSHandshakeTimeout: 3 * time.Second,
+			TLSHandshakeTimeout: a.config.ConnectTimeout,
+			IdleConnTimeout:     a.config.IdleConnTimeout,
 			TLSClientConfig: &tls.Config{
 				VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
 					if caCertPool == nil {
@@ -80,6 +227,7 @@ func (a *envoyAdminClient) buildHTTPClient(mesh, identifyingService string) (*ht
 					}
 					// verify CA against the Mesh CA
 					if err := util_tls.VerifyOnlyCA(caCertPool)(rawCerts, verifiedChains); err != nil {
+						onCAVerificationFailure()
 						return err
 					}
 
@@ -102,20 +250,14 @@ func (a *envoyAdminClient) buildHTTPClient(mesh, identifyingService string) (*ht
 				Certificates:       []tls.Certificate{a.clientCert},
 			},
 		},
-		Timeout: 5 * time.Second,
 	}
 	return c, err
 }
 
-func (a *envoyAdminClient) caCertPoolOfMeshMTLS(mesh string) (*x509.CertPool, error) {
-	if mesh == "" {
+func (a *envoyAdminClient) caCertPoolOfMeshMTLS(ctx context.Context, meshRes *core_mesh.MeshResource) (*x509.CertPool, error) {
+	if meshRes == nil {
 		return nil, nil
 	}
-	meshRes := core_mesh.NewMeshResource()
-	err := a.rm.Get(context.Background(), meshRes, core_store.GetByKey(mesh, core_model.NoMesh))
-	if err != nil {
-		return nil, err
-	}
 	backend := meshRes.GetEnabledCertificateAuthorityBackend()
 	if backend == nil {
 		return nil, nil
@@ -124,7 +266,7 @@ func (a *envoyAdminClient) caCertPoolOfMeshMTLS(mesh string) (*x509.CertPool, er
 	if !ok {
 		return nil, errors.Errorf("cannot find CA Manager for type %s", backend.Type)
 	}
-	rootCerts, err := caManager.GetRootCert(context.Background(), mesh, backend)
+	rootCerts, err := caManager.GetRootCert(ctx, meshRes.GetMeta().GetName(), backend)
 	if err != nil {
 		return nil, err
 	}
@@ -145,12 +287,19 @@ const (
 )
 
 func (a *envoyAdminClient) PostQuit(ctx context.Context, dataplane *core_mesh.DataplaneResource) error {
-	httpClient, err := a.buildHTTPClient(dataplane.Meta.GetMesh(), dataplane.Spec.GetIdentifyingService())
-	if err != nil {
-		return err
+	url := fmt.Sprintf("https://%s/%s", dataplane.AdminAddress(a.defaultAdminPort), quitquitquit)
+	httpClient, ok := a.tunnelHTTPClient(dataplane)
+	if !ok {
+		var err error
+		httpClient, err = a.httpClient(ctx, dataplane.Meta.GetMesh(), dataplane.Spec.GetIdentifyingService())
+		if err != nil {
+			return err
+		}
 	}
 
-	url := fmt.Sprintf("https://%s/%s", dataplane.AdminAddress(a.defaultAdminPort), quitquitquit)
+	ctx, cancel := context.WithTimeout(ctx, a.requestTimeout(ctx))
+	defer cancel()
+
 	request, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 	if err != nil {
 		return err
@@ -173,16 +322,277 @@ func (a *envoyAdminClient) PostQuit(ctx context.Context, dataplane *core_mesh.Da
 	return nil
 }
 
-func (a *envoyAdminClient) Stats(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
-	return a.executeRequest(ctx, proxy, "stats")
+const (
+	drainListeners = "drain_listeners"
+)
+
+// Drain drains all listeners of the proxy, so it stops accepting new connections and closes existing ones.
+// When graceful is true, Envoy keeps existing connections for the drain time of the proxy before closing them.
+// Contrary to PostQuit, the proxy keeps running after it is drained.
+func (a *envoyAdminClient) Drain(ctx context.Context, dataplane *core_mesh.DataplaneResource, graceful bool) error {
+	httpClient, u, err := a.adminHTTPClient(ctx, dataplane)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.requestTimeout(ctx))
+	defer cancel()
+
+	u.Path = drainListeners
+	if graceful {
+		u.RawQuery = "graceful"
+	}
+	request, err := http.NewRequestWithContext(ctx, "POST", u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "unable to send POST to %s", drainListeners)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		return errors.Errorf("envoy response [%d %s] [%s]", response.StatusCode, response.Status, body)
+	}
+	return nil
+}
+
+type StatsFormat string
+
+const (
+	StatsFormatText       StatsFormat = ""
+	StatsFormatJSON       StatsFormat = "json"
+	StatsFormatPrometheus StatsFormat = "prometheus"
+)
+
+func (f StatsFormat) Validate() error {
+	switch f {
+	case StatsFormatText, StatsFormatJSON, StatsFormatPrometheus:
+		return nil
+	default:
+		return errors.Errorf("unsupported stats format %q, supported formats are: %q, %q", f, StatsFormatJSON, StatsFormatPrometheus)
+	}
+}
+
+// StatsOpts are passed down to the Envoy /stats endpoint, so the proxy only returns the stats the caller is interested in.
+type StatsOpts struct {
+	// Filter is a regular expression, only stats with matching names are returned.
+	Filter string
+	// UsedOnly returns only stats that were updated by Envoy at least once.
+	UsedOnly bool
+	// Format of the stats. Text format is used by default.
+	Format StatsFormat
+}
+
+func (o StatsOpts) query() url.Values {
+	query := url.Values{}
+	if o.Filter != "" {
+		query.Set("filter", o.Filter)
+	}
+	if o.UsedOnly {
+		query.Set("usedonly", "")
+	}
+	if o.Format != StatsFormatText {
+		query.Set("format", string(o.Format))
+	}
+	return query
 }
 
-func (a *envoyAdminClient) Clusters(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
-	return a.executeRequest(ctx, proxy, "clusters")
+func (a *envoyAdminClient) Stats(ctx context.Context, proxy core_model.ResourceWithAddress, opts StatsOpts) ([]byte, error) {
+	if err := opts.Format.Validate(); err != nil {
+		return nil, err
+	}
+	return a.executeRequest(ctx, proxy, "stats", opts.query())
 }
 
-func (a *envoyAdminClient) ConfigDump(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
-	configDump, err := a.executeRequest(ctx, proxy, "config_dump")
+type ClustersFormat string
+
+const (
+	ClustersFormatText ClustersFormat = ""
+	ClustersFormatJSON ClustersFormat = "json"
+)
+
+func (f ClustersFormat) Validate() error {
+	switch f {
+	case ClustersFormatText, ClustersFormatJSON:
+		return nil
+	default:
+		return errors.Errorf("unsupported clusters format %q, supported formats are: %q", f, ClustersFormatJSON)
+	}
+}
+
+// ClustersOpts are passed down to the Envoy /clusters endpoint.
+type ClustersOpts struct {
+	// Format of the clusters. Text format is used by default.
+	Format ClustersFormat
+}
+
+func (o ClustersOpts) query() url.Values {
+	query := url.Values{}
+	if o.Format != ClustersFormatText {
+		query.Set("format", string(o.Format))
+	}
+	return query
+}
+
+func (a *envoyAdminClient) Clusters(ctx context.Context, proxy core_model.ResourceWithAddress, opts ClustersOpts) ([]byte, error) {
+	if err := opts.Format.Validate(); err != nil {
+		return nil, err
+	}
+	return a.executeRequest(ctx, proxy, "clusters", opts.query())
+}
+
+// Listeners returns listeners of the proxy. They are requested in JSON format, which contrary to the default text format
+// includes local and additional addresses of every listener.
+func (a *envoyAdminClient) Listeners(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Listeners, error) {
+	listeners := &envoy_admin_v3.Listeners{}
+	if err := a.executeProtoRequest(ctx, proxy, "listeners", url.Values{"format": []string{"json"}}, listeners); err != nil {
+		return nil, err
+	}
+	return listeners, nil
+}
+
+// Certs returns the certificates loaded by Envoy. It only contains metadata
+// like SANs, serial numbers and expiration time, private keys are never exposed by Envoy.
+func (a *envoyAdminClient) Certs(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Certificates, error) {
+	certs := &envoy_admin_v3.Certificates{}
+	if err := a.executeProtoRequest(ctx, proxy, "certs", nil, certs); err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+// Runtime is the response of the /runtime endpoint. Contrary to other endpoints, Envoy does not define it as a proto message.
+type Runtime struct {
+	// Layers are names of the runtime layers ordered from the lowest to the highest priority.
+	Layers []string `json:"layers"`
+	// Entries are runtime keys with their values.
+	Entries map[string]RuntimeEntry `json:"entries"`
+}
+
+type RuntimeEntry struct {
+	// FinalValue is the value of the highest priority layer that sets the key.
+	FinalValue string `json:"final_value"`
+	// LayerValues are values of the key in every layer, in the order of Runtime.Layers. Empty when the layer does not set the key.
+	LayerValues []string `json:"layer_values"`
+}
+
+func (a *envoyAdminClient) Runtime(ctx context.Context, proxy core_model.ResourceWithAddress) (*Runtime, error) {
+	resp, err := a.executeRequest(ctx, proxy, "runtime", nil)
+	if err != nil {
+		return nil, err
+	}
+	runtime := &Runtime{}
+	if err := json.Unmarshal(resp, runtime); err != nil {
+		return nil, err
+	}
+	return runtime, nil
+}
+
+func (a *envoyAdminClient) ServerInfo(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.ServerInfo, error) {
+	serverInfo := &envoy_admin_v3.ServerInfo{}
+	if err := a.executeProtoRequest(ctx, proxy, "server_info", nil, serverInfo); err != nil {
+		return nil, err
+	}
+	return serverInfo, nil
+}
+
+// ProxyInfoEndpoint is an endpoint of the Envoy Admin API that returns read-only information about the proxy.
+type ProxyInfoEndpoint string
+
+const (
+	ProxyInfoListeners  ProxyInfoEndpoint = "listeners"
+	ProxyInfoCerts      ProxyInfoEndpoint = "certs"
+	ProxyInfoRuntime    ProxyInfoEndpoint = "runtime"
+	ProxyInfoServerInfo ProxyInfoEndpoint = "server_info"
+)
+
+func (e ProxyInfoEndpoint) Validate() error {
+	switch e {
+	case ProxyInfoListeners, ProxyInfoCerts, ProxyInfoRuntime, ProxyInfoServerInfo:
+		return nil
+	default:
+		return errors.Errorf("unsupported proxy info endpoint %q, supported endpoints are: %q, %q, %q, %q", e, ProxyInfoListeners, ProxyInfoCerts, ProxyInfoRuntime, ProxyInfoServerInfo)
+	}
+}
+
+// ProxyInfo returns the information about the proxy from the endpoint in JSON format using the corresponding method of the client.
+// It lets the callers that only pass the information through, like the API Server and KDS, handle all endpoints the same way.
+func ProxyInfo(ctx context.Context, client EnvoyAdminClient, proxy core_model.ResourceWithAddress, endpoint ProxyInfoEndpoint) ([]byte, error) {
+	switch endpoint {
+	case ProxyInfoListeners:
+		return protoToJSON(client.Listeners(ctx, proxy))
+	case ProxyInfoCerts:
+		return protoToJSON(client.Certs(ctx, proxy))
+	case ProxyInfoServerInfo:
+		return protoToJSON(client.ServerInfo(ctx, proxy))
+	case ProxyInfoRuntime:
+		runtime, err := client.Runtime(ctx, proxy)
+		if err != nil {
+			return nil, err
+		}
+		return json.MarshalIndent(runtime, "", " ")
+	default:
+		return nil, endpoint.Validate()
+	}
+}
+
+func protoToJSON(msg proto.Message, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	return util_proto.ToJSONIndent(msg, " ")
+}
+
+// MemoryStats returns memory usage of the proxy reported by the heap allocator of Envoy.
+func (a *envoyAdminClient) MemoryStats(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Memory, error) {
+	memory := &envoy_admin_v3.Memory{}
+	if err := a.executeProtoRequest(ctx, proxy, "memory", nil, memory); err != nil {
+		return nil, err
+	}
+	return memory, nil
+}
+
+// executeProtoRequest executes the request on the endpoint that returns the message in JSON format.
+func (a *envoyAdminClient) executeProtoRequest(ctx context.Context, proxy core_model.ResourceWithAddress, path string, query url.Values, out proto.Message) error {
+	resp, err := a.executeRequest(ctx, proxy, path, query)
+	if err != nil {
+		return err
+	}
+	return util_proto.FromJSON(resp, out)
+}
+
+// ConfigDumpOpts configures how the config dump is processed before it's returned.
+type ConfigDumpOpts struct {
+	// Redaction defines which parts of the config dump are redacted. DefaultRedactionPolicy is used when empty.
+	Redaction RedactionPolicy
+	// IncludeEDS adds endpoints of the clusters to the config dump. They are not included by Envoy by default.
+	IncludeEDS bool
+}
+
+func (o ConfigDumpOpts) query() url.Values {
+	query := url.Values{}
+	if o.IncludeEDS {
+		query.Set("include_eds", "")
+	}
+	return query
+}
+
+func (o ConfigDumpOpts) RedactionPolicy() RedactionPolicy {
+	if o.Redaction == "" {
+		return DefaultRedactionPolicy
+	}
+	return o.Redaction
+}
+
+func (a *envoyAdminClient) ConfigDump(ctx context.Context, proxy core_model.ResourceWithAddress, opts ConfigDumpOpts) ([]byte, error) {
+	if err := opts.RedactionPolicy().Validate(); err != nil {
+		return nil, err
+	}
+	configDump, err := a.executeRequest(ctx, proxy, "config_dump", opts.query())
 	if err != nil {
 		return nil, err
 	}
@@ -192,62 +602,288 @@ func (a *envoyAdminClient) ConfigDump(ctx context.Context, proxy core_model.Reso
 		return nil, err
 	}
 
-	if err := Sanitize(cd); err != nil {
+	if err := Sanitize(cd, opts.RedactionPolicy()); err != nil {
 		return nil, err
 	}
 
 	return util_proto.ToJSONIndent(cd, " ")
 }
 
-func (a *envoyAdminClient) executeRequest(ctx context.Context, proxy core_model.ResourceWithAddress, path string) ([]byte, error) {
+// LogLevel is the level of the logs of Envoy that is set with the /logging endpoint.
+type LogLevel string
+
+const (
+	LogLevelUnchanged LogLevel = ""
+	LogLevelTrace     LogLevel = "trace"
+	LogLevelDebug     LogLevel = "debug"
+	LogLevelInfo      LogLevel = "info"
+	LogLevelWarning   LogLevel = "warning"
+	LogLevelError     LogLevel = "error"
+	LogLevelCritical  LogLevel = "critical"
+	LogLevelOff       LogLevel = "off"
+)
+
+func (l LogLevel) Validate() error {
+	switch l {
+	case LogLevelUnchanged, LogLevelTrace, LogLevelDebug, LogLevelInfo, LogLevelWarning, LogLevelError, LogLevelCritical, LogLevelOff:
+		return nil
+	default:
+		return errors.Errorf("unsupported log level %q, supported levels are: %q, %q, %q, %q, %q, %q, %q",
+			l, LogLevelTrace, LogLevelDebug, LogLevelInfo, LogLevelWarning, LogLevelError, LogLevelCritical, LogLevelOff)
+	}
+}
+
+// TailLogsOpts configures the stream of traffic entries returned by TailLogs.
+type TailLogsOpts struct {
+	// ConfigID is the identifier of the tap filter on the proxy which should be used to capture traffic.
+	ConfigID string
+	// MaxBufferedBytes limits the number of request and response body bytes captured in a single entry.
+	// 0 means that Envoy default (1KiB) is used.
+	MaxBufferedBytes uint32
+	// RequestHeaders limits the captured traffic to requests with headers equal to the values.
+	// Pseudo-headers like :method or :path can be matched as well. Empty means that all traffic is captured.
+	RequestHeaders map[string]string
+	// LogLevel is set on all loggers of Envoy before the traffic is tailed, so the logs of the proxy show
+	// the details of the captured traffic. The level stays after the stream is closed. Empty means that the level is not changed.
+	LogLevel LogLevel
+}
+
+// TailLogs opens a stream of traffic entries going through the proxy using the admin /tap endpoint.
+// Every entry is a JSON-encoded envoy.data.tap.v3.TraceWrapper, entries are written one after another.
+// The stream is open until ctx is cancelled or the proxy closes the connection.
+// It is the responsibility of the caller to close the returned reader.
+func (a *envoyAdminClient) TailLogs(ctx context.Context, proxy core_model.ResourceWithAddress, opts TailLogsOpts) (io.ReadCloser, error) {
+	if opts.ConfigID == "" {
+		return nil, errors.New("tap config id cannot be empty")
+	}
+	if err := opts.LogLevel.Validate(); err != nil {
+		return nil, err
+	}
+	// the tunnel streams the response as Envoy writes it, so the stream can use it as well as a direct connection
+	httpClient, u, err := a.adminHTTPClient(ctx, proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.LogLevel != LogLevelUnchanged {
+		if err := a.setLogLevel(ctx, httpClient, *u, opts.LogLevel); err != nil {
+			return nil, err
+		}
+	}
+
+	// the request timeout is not applied, we rely on ctx to close the stream instead
+	u.Path = tap
+	request, err := http.NewRequestWithContext(ctx, "POST", u.String(), bytes.NewReader(tapRequestBody(opts)))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to send POST to %s", tap)
+	}
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+		body, _ := io.ReadAll(response.Body)
+		return nil, errors.Errorf("envoy response [%d %s] [%s]", response.StatusCode, response.Status, body)
+	}
+	return response.Body, nil
+}
+
+func (a *envoyAdminClient) setLogLevel(ctx context.Context, httpClient *http.Client, u url.URL, level LogLevel) error {
+	ctx, cancel := context.WithTimeout(ctx, a.requestTimeout(ctx))
+	defer cancel()
+
+	u.Path = logging
+	u.RawQuery = url.Values{"level": []string{string(level)}}.Encode()
+	request, err := http.NewRequestWithContext(ctx, "POST", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "unable to send POST to %s", logging)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		return errors.Errorf("envoy response [%d %s] [%s]", response.StatusCode, response.Status, body)
+	}
+	return nil
+}
+
+const (
+	tap     = "tap"
+	logging = "logging"
+)
+
+// Forward executes an arbitrary request to the Envoy Admin API of the proxy and returns the response as it is,
+// including non 200 responses, so the caller can expose the whole Admin API, e.g. for port-forwarding.
+// The request timeout is not applied, because some endpoints stream responses, we rely on ctx instead.
+// It is the responsibility of the caller to close the body of the returned response.
+func (a *envoyAdminClient) Forward(ctx context.Context, proxy core_model.ResourceWithAddress, method, path string, query url.Values, body io.Reader) (*http.Response, error) {
+	httpClient, u, err := a.adminHTTPClient(ctx, proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	u.Path = "/" + strings.TrimPrefix(path, "/")
+	u.RawQuery = query.Encode()
+	request, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to send %s to %s", method, path)
+	}
+	return response, nil
+}
+
+func tapRequestBody(opts TailLogsOpts) []byte {
+	sink := map[string]interface{}{
+		"streaming_admin": map[string]interface{}{},
+	}
+	outputConfig := map[string]interface{}{
+		"sinks": []interface{}{sink},
+	}
+	if opts.MaxBufferedBytes > 0 {
+		outputConfig["max_buffered_rx_bytes"] = opts.MaxBufferedBytes
+		outputConfig["max_buffered_tx_bytes"] = opts.MaxBufferedBytes
+	}
+	match := map[string]interface{}{
+		"any_match": true,
+	}
+	if len(opts.RequestHeaders) > 0 {
+		var names []string
+		for name := range opts.RequestHeaders {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		var headers []interface{}
+		for _, name := range names {
+			headers = append(headers, map[string]interface{}{
+				"name": name,
+				"string_match": map[string]interface{}{
+					"exact": opts.RequestHeaders[name],
+				},
+			})
+		}
+		match = map[string]interface{}{
+			"http_request_headers_match": map[string]interface{}{
+				"headers": headers,
+			},
+		}
+	}
+	body := map[string]interface{}{
+		"config_id": opts.ConfigID,
+		"tap_config": map[string]interface{}{
+			"match":         match,
+			"output_config": outputConfig,
+		},
+	}
+	b, _ := json.Marshal(body) // marshalling of maps with basic types cannot fail
+	return b
+}
+
+// adminHTTPClient returns a client that executes requests over the tunnel opened by kuma-dp.
+// If the proxy did not open the tunnel, it falls back to a direct connection to the Envoy Admin API.
+func (a *envoyAdminClient) adminHTTPClient(ctx context.Context, proxy core_model.ResourceWithAddress) (*http.Client, *url.URL, error) {
+	if httpClient, ok := a.tunnelHTTPClient(proxy); ok {
+		u := &url.URL{
+			Scheme: "http",
+			Host:   proxy.AdminAddress(a.defaultAdminPort),
+		}
+		return httpClient, u, nil
+	}
+	return a.directAdminHTTPClient(ctx, proxy)
+}
+
+func (a *envoyAdminClient) tunnelHTTPClient(proxy core_model.ResourceWithAddress) (*http.Client, bool) {
+	if a.tunnels == nil {
+		return nil, false
+	}
+	return a.tunnels.HTTPClient(proxy)
+}
+
+func (a *envoyAdminClient) directAdminHTTPClient(ctx context.Context, proxy core_model.ResourceWithAddress) (*http.Client, *url.URL, error) {
 	var httpClient *http.Client
 	var err error
 	u := &url.URL{}
 
 	switch p := proxy.(type) {
 	case *core_mesh.DataplaneResource:
-		httpClient, err = a.buildHTTPClient(p.Meta.GetMesh(), p.Spec.GetIdentifyingService())
+		httpClient, err = a.httpClient(ctx, p.Meta.GetMesh(), p.Spec.GetIdentifyingService())
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		u.Scheme = "https"
 	case *core_mesh.ZoneIngressResource, *core_mesh.ZoneEgressResource:
-		httpClient, err = a.buildHTTPClient(core_model.NoMesh, "")
+		httpClient, err = a.httpClient(ctx, core_model.NoMesh, "")
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		u.Scheme = "https"
 	default:
-		return nil, errors.New("unsupported proxy type")
+		return nil, nil, errors.New("unsupported proxy type")
 	}
 
 	if host, _, err := net.SplitHostPort(proxy.AdminAddress(a.defaultAdminPort)); err == nil && host == "127.0.0.1" {
-		httpClient = &http.Client{
-			Timeout: 5 * time.Second,
-		}
+		httpClient = a.plainHTTPClient
 		u.Scheme = "http"
 	}
 
 	u.Host = proxy.AdminAddress(a.defaultAdminPort)
+	return httpClient, u, nil
+}
+
+func (a *envoyAdminClient) executeRequest(ctx context.Context, proxy core_model.ResourceWithAddress, path string, query url.Values) ([]byte, error) {
+	httpClient, u, err := a.adminHTTPClient(ctx, proxy)
+	if err != nil {
+		return nil, err
+	}
+
 	u.Path = path
-	request, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	u.RawQuery = query.Encode()
+
+	var resp []byte
+	backoff := retry.WithMaxRetries(uint64(a.maxRetries(ctx)), retry.NewExponential(a.config.RetryBaseBackoff))
+	err = retry.Do(ctx, backoff, func(ctx context.Context) error {
+		resp, err = a.get(ctx, httpClient, u.String(), path)
+		return err
+	})
+	return resp, err
+}
+
+// get executes a single GET request within the request timeout.
+// Connection errors and 5xx responses are retryable, because they are usually caused by the proxy being temporarily busy.
+func (a *envoyAdminClient) get(ctx context.Context, httpClient *http.Client, url string, path string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.requestTimeout(ctx))
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	response, err := httpClient.Do(request)
 	if err != nil {
-		return nil, errors.Wrapf(err, "unable to send GET to %s", "config_dump")
+		return nil, retry.RetryableError(errors.Wrapf(err, "unable to send GET to %s", path))
 	}
 	defer response.Body.Close()
 
 	if response.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("envoy response [%d %s] [%s]", response.StatusCode, response.Status, response.Body)
+		err := errors.Errorf("envoy response [%d %s] [%s]", response.StatusCode, response.Status, response.Body)
+		if response.StatusCode >= http.StatusInternalServerError {
+			return nil, retry.RetryableError(err)
+		}
+		return nil, err
 	}
 
 	resp, err := io.ReadAll(response.Body)
 	if err != nil {
-		return nil, err
+		return nil, retry.RetryableError(err)
 	}
 	return resp, nil
 }
@@ -0,0 +1,750 @@
+package admin_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	envoy_admin_client "github.com/kumahq/kuma/pkg/config/envoy-admin-client"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+	"github.com/kumahq/kuma/pkg/envoy/admin/tunnel"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+var _ = Describe("Envoy Admin client", func() {
+
+	var server *httptest.Server
+	var requests []*http.Request
+	var client admin.EnvoyAdminClient
+	var dataplane *core_mesh.DataplaneResource
+	var rm manager.ResourceManager
+	var tunnels tunnel.Tunnels
+
+	BeforeEach(func() {
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			_, _ = writer.Write([]byte(req.URL.Path))
+		}))
+
+		rm = manager.NewResourceManager(memory.NewStore())
+		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		cfg := envoy_admin_client.DefaultEnvoyAdminClientConfig()
+		cfg.RetryBaseBackoff = time.Millisecond
+		tunnels = tunnel.NewTunnels()
+		clientCert, err := tls.LoadX509KeyPair(
+			filepath.Join("..", "..", "..", "test", "certs", "client-cert.pem"),
+			filepath.Join("..", "..", "..", "test", "certs", "client-key.pem"),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		client, err = admin.NewEnvoyAdminClient(
+			rm,
+			core_ca.Managers{},
+			clientCert,
+			9901,
+			*cfg,
+			tunnels,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		port, err := strconv.ParseUint(portStr, 10, 32)
+		Expect(err).ToNot(HaveOccurred())
+
+		dataplane = core_mesh.NewDataplaneResource()
+		dataplane.SetMeta(&test_model.ResourceMeta{
+			Mesh: core_model.DefaultMesh,
+			Name: "dp-1",
+		})
+		dataplane.Spec = &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: host,
+				Admin: &mesh_proto.EnvoyAdmin{
+					Port: uint32(port),
+				},
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+					{
+						Port: 8080,
+						Tags: map[string]string{
+							mesh_proto.ServiceTag: "backend",
+						},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	type testCase struct {
+		fn            func(admin.EnvoyAdminClient) func(context.Context, core_model.ResourceWithAddress) ([]byte, error)
+		expectedPath  string
+		expectedQuery string
+	}
+
+	DescribeTable("should execute request on the admin endpoint",
+		func(given testCase) {
+			// when
+			resp, err := given.fn(client)(context.Background(), dataplane)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(resp)).To(Equal(given.expectedPath))
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Method).To(Equal(http.MethodGet))
+			Expect(requests[0].URL.Path).To(Equal(given.expectedPath))
+			Expect(requests[0].URL.RawQuery).To(Equal(given.expectedQuery))
+		},
+		Entry("stats", testCase{
+			fn: func(c admin.EnvoyAdminClient) func(context.Context, core_model.ResourceWithAddress) ([]byte, error) {
+				return func(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+					return c.Stats(ctx, proxy, admin.StatsOpts{})
+				}
+			},
+			expectedPath: "/stats",
+		}),
+		Entry("stats with options", testCase{
+			fn: func(c admin.EnvoyAdminClient) func(context.Context, core_model.ResourceWithAddress) ([]byte, error) {
+				return func(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+					return c.Stats(ctx, proxy, admin.StatsOpts{
+						Filter:   "^cluster\\.",
+						UsedOnly: true,
+						Format:   admin.StatsFormatPrometheus,
+					})
+				}
+			},
+			expectedPath:  "/stats",
+			expectedQuery: "filter=%5Ecluster%5C.&format=prometheus&usedonly=",
+		}),
+		Entry("clusters", testCase{
+			fn: func(c admin.EnvoyAdminClient) func(context.Context, core_model.ResourceWithAddress) ([]byte, error) {
+				return func(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+					return c.Clusters(ctx, proxy, admin.ClustersOpts{})
+				}
+			},
+			expectedPath: "/clusters",
+		}),
+		Entry("clusters with options", testCase{
+			fn: func(c admin.EnvoyAdminClient) func(context.Context, core_model.ResourceWithAddress) ([]byte, error) {
+				return func(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+					return c.Clusters(ctx, proxy, admin.ClustersOpts{
+						Format: admin.ClustersFormatJSON,
+					})
+				}
+			},
+			expectedPath:  "/clusters",
+			expectedQuery: "format=json",
+		}),
+	)
+
+	It("should stream traffic entries from the tap endpoint", func() {
+		// given
+		var body []byte
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			body, _ = io.ReadAll(req.Body)
+			_, _ = writer.Write([]byte(`{"http_buffered_trace": {}}`))
+			writer.(http.Flusher).Flush()
+			_, _ = writer.Write([]byte(`{"http_buffered_trace": {}}`))
+		})
+
+		// when
+		stream, err := client.TailLogs(context.Background(), dataplane, admin.TailLogsOpts{
+			ConfigID:         "kuma-tap",
+			MaxBufferedBytes: 2048,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer stream.Close()
+		entries, err := io.ReadAll(stream)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(entries)).To(Equal(`{"http_buffered_trace": {}}{"http_buffered_trace": {}}`))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal(http.MethodPost))
+		Expect(requests[0].URL.Path).To(Equal("/tap"))
+		Expect(body).To(MatchJSON(`{
+			"config_id": "kuma-tap",
+			"tap_config": {
+				"match": {"any_match": true},
+				"output_config": {
+					"sinks": [{"streaming_admin": {}}],
+					"max_buffered_rx_bytes": 2048,
+					"max_buffered_tx_bytes": 2048
+				}
+			}
+		}`))
+	})
+
+	DescribeTable("should drain listeners",
+		func(graceful bool, expectedQuery string) {
+			// when
+			err := client.Drain(context.Background(), dataplane, graceful)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Method).To(Equal(http.MethodPost))
+			Expect(requests[0].URL.Path).To(Equal("/drain_listeners"))
+			Expect(requests[0].URL.RawQuery).To(Equal(expectedQuery))
+		},
+		Entry("immediately", false, ""),
+		Entry("gracefully", true, "graceful"),
+	)
+
+	It("should forward request and return the response as it is", func() {
+		// given
+		var body []byte
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			body, _ = io.ReadAll(req.Body)
+			writer.Header().Set("Content-Type", "text/plain")
+			writer.WriteHeader(http.StatusNotFound)
+			_, _ = writer.Write([]byte("invalid path"))
+		})
+
+		// when
+		resp, err := client.Forward(context.Background(), dataplane, http.MethodPost, "runtime_modify", url.Values{"key": []string{"value"}}, strings.NewReader("body"))
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("text/plain"))
+		Expect(string(respBody)).To(Equal("invalid path"))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal(http.MethodPost))
+		Expect(requests[0].URL.Path).To(Equal("/runtime_modify"))
+		Expect(requests[0].URL.RawQuery).To(Equal("key=value"))
+		Expect(string(body)).To(Equal("body"))
+	})
+
+	It("should parse memory stats", func() {
+		// given
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			_, _ = writer.Write([]byte(`{
+				"allocated": "7191256",
+				"heap_size": "13631488",
+				"pageheap_unmapped": "1024",
+				"pageheap_free": "0",
+				"total_thread_cache": "1677344",
+				"total_physical_bytes": "15865856"
+			}`))
+		})
+
+		// when
+		memory, err := client.MemoryStats(context.Background(), dataplane)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Path).To(Equal("/memory"))
+		Expect(memory.Allocated).To(Equal(uint64(7191256)))
+		Expect(memory.HeapSize).To(Equal(uint64(13631488)))
+		Expect(memory.PageheapUnmapped).To(Equal(uint64(1024)))
+	})
+
+	It("should parse listeners", func() {
+		// given
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			_, _ = writer.Write([]byte(`{
+				"listener_statuses": [{
+					"name": "inbound:127.0.0.1:8080",
+					"local_address": {"socket_address": {"address": "127.0.0.1", "port_value": 8080}}
+				}]
+			}`))
+		})
+
+		// when
+		listeners, err := client.Listeners(context.Background(), dataplane)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Path).To(Equal("/listeners"))
+		Expect(requests[0].URL.RawQuery).To(Equal("format=json"))
+		Expect(listeners.ListenerStatuses).To(HaveLen(1))
+		Expect(listeners.ListenerStatuses[0].Name).To(Equal("inbound:127.0.0.1:8080"))
+		Expect(listeners.ListenerStatuses[0].LocalAddress.GetSocketAddress().GetPortValue()).To(Equal(uint32(8080)))
+	})
+
+	It("should parse certs", func() {
+		// given
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			_, _ = writer.Write([]byte(`{
+				"certificates": [{
+					"cert_chain": [{
+						"path": "<inline>",
+						"serial_number": "1",
+						"days_until_expiration": "29",
+						"subject_alt_names": [{"uri": "spiffe://default/backend"}]
+					}]
+				}]
+			}`))
+		})
+
+		// when
+		certs, err := client.Certs(context.Background(), dataplane)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Path).To(Equal("/certs"))
+		Expect(certs.Certificates).To(HaveLen(1))
+		Expect(certs.Certificates[0].CertChain[0].DaysUntilExpiration).To(Equal(uint64(29)))
+		Expect(certs.Certificates[0].CertChain[0].SubjectAltNames[0].GetUri()).To(Equal("spiffe://default/backend"))
+	})
+
+	It("should parse runtime", func() {
+		// given
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			_, _ = writer.Write([]byte(`{
+				"layers": ["static", "admin"],
+				"entries": {
+					"re2.max_program_size.error_level": {"final_value": "1000", "layer_values": ["1000", ""]}
+				}
+			}`))
+		})
+
+		// when
+		runtime, err := client.Runtime(context.Background(), dataplane)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Path).To(Equal("/runtime"))
+		Expect(runtime.Layers).To(Equal([]string{"static", "admin"}))
+		Expect(runtime.Entries).To(HaveKeyWithValue("re2.max_program_size.error_level", admin.RuntimeEntry{
+			FinalValue:  "1000",
+			LayerValues: []string{"1000", ""},
+		}))
+	})
+
+	It("should parse server info", func() {
+		// given
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			_, _ = writer.Write([]byte(`{
+				"version": "1.22.0",
+				"state": "LIVE",
+				"uptime_current_epoch": "60s"
+			}`))
+		})
+
+		// when
+		info, err := client.ServerInfo(context.Background(), dataplane)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Path).To(Equal("/server_info"))
+		Expect(info.Version).To(Equal("1.22.0"))
+		Expect(info.State.String()).To(Equal("LIVE"))
+		Expect(info.UptimeCurrentEpoch.AsDuration()).To(Equal(time.Minute))
+	})
+
+	It("should not execute stats request with unsupported format", func() {
+		// when
+		_, err := client.Stats(context.Background(), dataplane, admin.StatsOpts{Format: "xml"})
+
+		// then
+		Expect(err).To(MatchError(`unsupported stats format "xml", supported formats are: "json", "prometheus"`))
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should not execute clusters request with unsupported format", func() {
+		// when
+		_, err := client.Clusters(context.Background(), dataplane, admin.ClustersOpts{Format: "prometheus"})
+
+		// then
+		Expect(err).To(MatchError(`unsupported clusters format "prometheus", supported formats are: "json"`))
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should capture only traffic matching request headers", func() {
+		// given
+		var body []byte
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			body, _ = io.ReadAll(req.Body)
+		})
+
+		// when
+		stream, err := client.TailLogs(context.Background(), dataplane, admin.TailLogsOpts{
+			ConfigID: "kuma-tap",
+			RequestHeaders: map[string]string{
+				":path":   "/api",
+				":method": "POST",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer stream.Close()
+		_, err = io.ReadAll(stream)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`{
+			"config_id": "kuma-tap",
+			"tap_config": {
+				"match": {
+					"http_request_headers_match": {
+						"headers": [
+							{"name": ":method", "string_match": {"exact": "POST"}},
+							{"name": ":path", "string_match": {"exact": "/api"}}
+						]
+					}
+				},
+				"output_config": {
+					"sinks": [{"streaming_admin": {}}]
+				}
+			}
+		}`))
+	})
+
+	It("should set the log level before the traffic is tailed", func() {
+		// when
+		stream, err := client.TailLogs(context.Background(), dataplane, admin.TailLogsOpts{
+			ConfigID: "kuma-tap",
+			LogLevel: admin.LogLevelDebug,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer stream.Close()
+		_, err = io.ReadAll(stream)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].Method).To(Equal(http.MethodPost))
+		Expect(requests[0].URL.Path).To(Equal("/logging"))
+		Expect(requests[0].URL.RawQuery).To(Equal("level=debug"))
+		Expect(requests[1].URL.Path).To(Equal("/tap"))
+	})
+
+	It("should not open a stream with unsupported log level", func() {
+		// when
+		_, err := client.TailLogs(context.Background(), dataplane, admin.TailLogsOpts{
+			ConfigID: "kuma-tap",
+			LogLevel: "verbose",
+		})
+
+		// then
+		Expect(err).To(MatchError(`unsupported log level "verbose", supported levels are: "trace", "debug", "info", "warning", "error", "critical", "off"`))
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should not open a stream without tap config id", func() {
+		// when
+		_, err := client.TailLogs(context.Background(), dataplane, admin.TailLogsOpts{})
+
+		// then
+		Expect(err).To(MatchError("tap config id cannot be empty"))
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should retry request when Envoy is unavailable", func() {
+		// given
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			if len(requests) < 3 {
+				writer.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = writer.Write([]byte(req.URL.Path))
+		})
+
+		// when
+		resp, err := client.Clusters(admin.WithMaxRetries(context.Background(), 2), dataplane, admin.ClustersOpts{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(resp)).To(Equal("/clusters"))
+		Expect(requests).To(HaveLen(3))
+	})
+
+	It("should not retry request by default", func() {
+		// given
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		// when
+		_, err := client.Clusters(context.Background(), dataplane, admin.ClustersOpts{})
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("envoy response [503 503 Service Unavailable]"))
+		Expect(requests).To(HaveLen(1))
+	})
+
+	It("should not retry request rejected by Envoy", func() {
+		// given
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			writer.WriteHeader(http.StatusNotFound)
+		})
+
+		// when
+		_, err := client.Clusters(admin.WithMaxRetries(context.Background(), 2), dataplane, admin.ClustersOpts{})
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(requests).To(HaveLen(1))
+	})
+
+	It("should fail request exceeding the timeout from the context", func() {
+		// given
+		server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			<-req.Context().Done()
+		})
+
+		// when
+		_, err := client.Clusters(admin.WithRequestTimeout(context.Background(), 10*time.Millisecond), dataplane, admin.ClustersOpts{})
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(requests).To(HaveLen(1))
+	})
+
+	Context("tunnel", func() {
+		var tunnelRequests []*mesh_proto.EnvoyAdminTunnelRequest
+		var stream *kumaDpStream
+
+		BeforeEach(func() {
+			tunnelRequests = nil
+			stream = &kumaDpStream{
+				handle: func(req *mesh_proto.EnvoyAdminTunnelRequest) {
+					tunnelRequests = append(tunnelRequests, req)
+					go func() {
+						defer GinkgoRecover()
+						Expect(tunnels.ResponseReceived(dataplane, &mesh_proto.EnvoyAdminTunnelResponse{
+							RequestId:  req.RequestId,
+							StatusCode: http.StatusOK,
+							Body:       []byte("tunneled " + req.Path),
+						})).To(Succeed())
+					}()
+				},
+			}
+			tunnels.TunnelOpened(dataplane, stream)
+		})
+
+		It("should prefer the tunnel over the direct connection", func() {
+			// when
+			resp, err := client.Stats(context.Background(), dataplane, admin.StatsOpts{Format: admin.StatsFormatJSON})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(resp)).To(Equal("tunneled /stats"))
+			Expect(requests).To(BeEmpty())
+			Expect(tunnelRequests).To(HaveLen(1))
+			Expect(tunnelRequests[0].Method).To(Equal(http.MethodGet))
+			Expect(tunnelRequests[0].Query).To(Equal("format=json"))
+		})
+
+		It("should fall back to the direct connection when the tunnel is closed", func() {
+			// given
+			tunnels.TunnelClosed(dataplane, stream)
+
+			// when
+			resp, err := client.Clusters(context.Background(), dataplane, admin.ClustersOpts{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(resp)).To(Equal("/clusters"))
+			Expect(requests).To(HaveLen(1))
+			Expect(tunnelRequests).To(BeEmpty())
+		})
+
+		It("should stream traffic entries over the tunnel", func() {
+			// given
+			stream.handle = func(req *mesh_proto.EnvoyAdminTunnelRequest) {
+				tunnelRequests = append(tunnelRequests, req)
+				go func() {
+					defer GinkgoRecover()
+					Expect(tunnels.ResponseReceived(dataplane, &mesh_proto.EnvoyAdminTunnelResponse{
+						RequestId:  req.RequestId,
+						StatusCode: http.StatusOK,
+						Body:       []byte(`{"http_buffered_trace": {}}`),
+						More:       true,
+					})).To(Succeed())
+					Expect(tunnels.ResponseReceived(dataplane, &mesh_proto.EnvoyAdminTunnelResponse{
+						RequestId: req.RequestId,
+						Body:      []byte(`{"http_buffered_trace": {}}`),
+					})).To(Succeed())
+				}()
+			}
+
+			// when
+			stream, err := client.TailLogs(context.Background(), dataplane, admin.TailLogsOpts{
+				ConfigID: "kuma-tap",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			defer stream.Close()
+			entries, err := io.ReadAll(stream)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(entries)).To(Equal(`{"http_buffered_trace": {}}{"http_buffered_trace": {}}`))
+			Expect(requests).To(BeEmpty())
+			Expect(tunnelRequests).To(HaveLen(1))
+			Expect(tunnelRequests[0].Path).To(Equal("/tap"))
+			Expect(tunnelRequests[0].Stream).To(BeTrue())
+		})
+	})
+
+	Context("TLS", func() {
+		var tlsServer *httptest.Server
+		var connections int
+
+		BeforeEach(func() {
+			connections = 0
+			tlsServer = httptest.NewUnstartedServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+				_, _ = writer.Write([]byte(req.URL.Path))
+			}))
+			// 127.0.0.1 is reached over plain HTTP, so we listen on other loopback address
+			l, err := net.Listen("tcp", "127.0.0.2:0")
+			Expect(err).ToNot(HaveOccurred())
+			tlsServer.Listener = l
+			tlsServer.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					connections++
+				}
+			}
+			tlsServer.StartTLS()
+
+			_, portStr, err := net.SplitHostPort(l.Addr().String())
+			Expect(err).ToNot(HaveOccurred())
+			port, err := strconv.ParseUint(portStr, 10, 32)
+			Expect(err).ToNot(HaveOccurred())
+			dataplane.Spec.Networking.Address = "127.0.0.2"
+			dataplane.Spec.Networking.Admin.Port = uint32(port)
+		})
+
+		AfterEach(func() {
+			tlsServer.Close()
+		})
+
+		It("should reuse connections between requests to the same service", func() {
+			// when
+			_, err := client.Clusters(context.Background(), dataplane, admin.ClustersOpts{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = client.Stats(context.Background(), dataplane, admin.StatsOpts{})
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(connections).To(Equal(1))
+		})
+
+		It("should build a new client when the mesh changes", func() {
+			// given
+			_, err := client.Clusters(context.Background(), dataplane, admin.ClustersOpts{})
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			mesh := core_mesh.NewMeshResource()
+			Expect(rm.Get(context.Background(), mesh, core_store.GetByKey(core_model.DefaultMesh, core_model.NoMesh))).To(Succeed())
+			mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{}
+			Expect(rm.Update(context.Background(), mesh)).To(Succeed())
+			_, err = client.Clusters(context.Background(), dataplane, admin.ClustersOpts{})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(connections).To(Equal(2))
+		})
+
+		It("should build a new client when the proxy cannot be verified against the root certs of the CA", func() {
+			// given mesh CA with the root cert that did not sign the cert of the proxy
+			rootCert, err := os.ReadFile(filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"))
+			Expect(err).ToNot(HaveOccurred())
+			caManager := &rootCertsCAManager{rootCerts: []core_ca.Cert{rootCert}}
+			clientCert, err := tls.LoadX509KeyPair(
+				filepath.Join("..", "..", "..", "test", "certs", "client-cert.pem"),
+				filepath.Join("..", "..", "..", "test", "certs", "client-key.pem"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			client, err = admin.NewEnvoyAdminClient(
+				rm,
+				core_ca.Managers{"fake": caManager},
+				clientCert,
+				9901,
+				*envoy_admin_client.DefaultEnvoyAdminClientConfig(),
+				nil,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			mesh := core_mesh.NewMeshResource()
+			Expect(rm.Get(context.Background(), mesh, core_store.GetByKey(core_model.DefaultMesh, core_model.NoMesh))).To(Succeed())
+			mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+				EnabledBackend: "ca-1",
+				Backends: []*mesh_proto.CertificateAuthorityBackend{
+					{Name: "ca-1", Type: "fake"},
+				},
+			}
+			Expect(rm.Update(context.Background(), mesh)).To(Succeed())
+			_, err = client.Clusters(context.Background(), dataplane, admin.ClustersOpts{})
+			Expect(err).To(HaveOccurred())
+
+			// when
+			_, err = client.Clusters(context.Background(), dataplane, admin.ClustersOpts{})
+
+			// then the root certs are fetched again, because they could have been rotated without the change of the mesh
+			Expect(err).To(HaveOccurred())
+			Expect(caManager.calls).To(Equal(2))
+		})
+	})
+})
+
+// rootCertsCAManager is a CA Manager that only provides root certs.
+type rootCertsCAManager struct {
+	core_ca.Manager
+	rootCerts []core_ca.Cert
+	calls     int
+}
+
+func (m *rootCertsCAManager) GetRootCert(context.Context, string, *mesh_proto.CertificateAuthorityBackend) ([]core_ca.Cert, error) {
+	m.calls++
+	return m.rootCerts, nil
+}
+
+// kumaDpStream simulates kuma-dp on the other side of the Envoy Admin tunnel.
+type kumaDpStream struct {
+	grpc.ServerStream
+	handle func(*mesh_proto.EnvoyAdminTunnelRequest)
+}
+
+func (s *kumaDpStream) SendMsg(m interface{}) error {
+	s.handle(m.(*mesh_proto.EnvoyAdminTunnelRequest))
+	return nil
+}
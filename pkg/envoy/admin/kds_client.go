@@ -2,8 +2,13 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
 	"strings"
 
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
 	"github.com/pkg/errors"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -12,6 +17,7 @@ import (
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/kds/service"
 	util_grpc "github.com/kumahq/kuma/pkg/util/grpc"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 type kdsEnvoyAdminClient struct {
@@ -32,7 +38,10 @@ func (k *kdsEnvoyAdminClient) PostQuit(context.Context, *core_mesh.DataplaneReso
 	panic("not implemented")
 }
 
-func (k *kdsEnvoyAdminClient) ConfigDump(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+func (k *kdsEnvoyAdminClient) ConfigDump(ctx context.Context, proxy core_model.ResourceWithAddress, opts ConfigDumpOpts) ([]byte, error) {
+	if err := opts.RedactionPolicy().Validate(); err != nil {
+		return nil, err
+	}
 	zone, nameInZone, err := resNameInZone(proxy.GetMeta().GetName(), k.k8sStore)
 	if err != nil {
 		return nil, err
@@ -43,6 +52,8 @@ func (k *kdsEnvoyAdminClient) ConfigDump(ctx context.Context, proxy core_model.R
 		ResourceType: string(proxy.Descriptor().Name),
 		ResourceName: nameInZone,                // send the name which without the added prefix
 		ResourceMesh: proxy.GetMeta().GetMesh(), // should be empty for ZoneIngress/ZoneEgress
+		Redaction:    string(opts.Redaction),
+		IncludeEds:   opts.IncludeEDS,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not send XDSConfigRequest")
@@ -69,7 +80,10 @@ func (k *kdsEnvoyAdminClient) ConfigDump(ctx context.Context, proxy core_model.R
 	}
 }
 
-func (k *kdsEnvoyAdminClient) Stats(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+func (k *kdsEnvoyAdminClient) Stats(ctx context.Context, proxy core_model.ResourceWithAddress, opts StatsOpts) ([]byte, error) {
+	if err := opts.Format.Validate(); err != nil {
+		return nil, err
+	}
 	zone, nameInZone, err := resNameInZone(proxy.GetMeta().GetName(), k.k8sStore)
 	if err != nil {
 		return nil, err
@@ -80,6 +94,9 @@ func (k *kdsEnvoyAdminClient) Stats(ctx context.Context, proxy core_model.Resour
 		ResourceType: string(proxy.Descriptor().Name),
 		ResourceName: nameInZone,                // send the name which without the added prefix
 		ResourceMesh: proxy.GetMeta().GetMesh(), // should be empty for ZoneIngress/ZoneEgress
+		Filter:       opts.Filter,
+		UsedOnly:     opts.UsedOnly,
+		Format:       string(opts.Format),
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not send StatsRequest")
@@ -106,7 +123,10 @@ func (k *kdsEnvoyAdminClient) Stats(ctx context.Context, proxy core_model.Resour
 	}
 }
 
-func (k *kdsEnvoyAdminClient) Clusters(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+func (k *kdsEnvoyAdminClient) Clusters(ctx context.Context, proxy core_model.ResourceWithAddress, opts ClustersOpts) ([]byte, error) {
+	if err := opts.Format.Validate(); err != nil {
+		return nil, err
+	}
 	zone, nameInZone, err := resNameInZone(proxy.GetMeta().GetName(), k.k8sStore)
 	if err != nil {
 		return nil, err
@@ -117,6 +137,7 @@ func (k *kdsEnvoyAdminClient) Clusters(ctx context.Context, proxy core_model.Res
 		ResourceType: string(proxy.Descriptor().Name),
 		ResourceName: nameInZone,                // send the name which without the added prefix
 		ResourceMesh: proxy.GetMeta().GetMesh(), // should be empty for ZoneIngress/ZoneEgress
+		Format:       string(opts.Format),
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not send ClustersRequest")
@@ -143,6 +164,178 @@ func (k *kdsEnvoyAdminClient) Clusters(ctx context.Context, proxy core_model.Res
 	}
 }
 
+// TailLogs opens the stream of traffic of the proxy on the Zone CP. The Zone CP sends an empty response once the stream is opened,
+// then it streams the entries in many responses until the returned reader is closed or ctx is cancelled.
+func (k *kdsEnvoyAdminClient) TailLogs(ctx context.Context, proxy core_model.ResourceWithAddress, opts TailLogsOpts) (io.ReadCloser, error) {
+	if err := opts.LogLevel.Validate(); err != nil {
+		return nil, err
+	}
+	zone, nameInZone, err := resNameInZone(proxy.GetMeta().GetName(), k.k8sStore)
+	if err != nil {
+		return nil, err
+	}
+	reqId := core.NewUUID()
+	ch := make(chan util_grpc.ReverseUnaryMessage)
+	if err := k.rpcs.TailLogs.WatchResponse(zone, reqId, ch); err != nil {
+		return nil, errors.Wrapf(err, "could not watch the response")
+	}
+	deleteWatch := func() {
+		k.rpcs.TailLogs.DeleteWatch(zone, reqId)
+	}
+	cancel := func() {
+		_ = k.rpcs.TailLogs.Send(zone, &mesh_proto.TailLogsRequest{
+			RequestId: reqId,
+			Cancel:    true,
+		})
+	}
+
+	err = k.rpcs.TailLogs.Send(zone, &mesh_proto.TailLogsRequest{
+		RequestId:        reqId,
+		ResourceType:     string(proxy.Descriptor().Name),
+		ResourceName:     nameInZone,                // send the name which without the added prefix
+		ResourceMesh:     proxy.GetMeta().GetMesh(), // should be empty for ZoneIngress/ZoneEgress
+		RequestHeaders:   opts.RequestHeaders,
+		MaxBufferedBytes: opts.MaxBufferedBytes,
+		LogLevel:         string(opts.LogLevel),
+	})
+	if err != nil {
+		deleteWatch()
+		return nil, errors.Wrapf(err, "could not send TailLogsRequest")
+	}
+
+	select {
+	case <-ctx.Done():
+		// the stream may have already started, read it till the end in the background
+		util_grpc.NewReverseStreamReader(ctx, ch, tailLogsChunk, cancel, deleteWatch)
+		return nil, ctx.Err()
+	case resp := <-ch:
+		_, more, err := tailLogsChunk(resp)
+		if err != nil {
+			deleteWatch()
+			return nil, err
+		}
+		if !more {
+			deleteWatch()
+			return io.NopCloser(strings.NewReader("")), nil
+		}
+		return util_grpc.NewReverseStreamReader(ctx, ch, tailLogsChunk, cancel, deleteWatch), nil
+	}
+}
+
+func tailLogsChunk(resp util_grpc.ReverseUnaryMessage) ([]byte, bool, error) {
+	tailLogsResp, ok := resp.(*mesh_proto.TailLogsResponse)
+	if !ok {
+		return nil, false, errors.New("invalid request type")
+	}
+	if tailLogsResp.GetError() != "" {
+		return nil, false, errors.Errorf("error response from Zone CP: %s", tailLogsResp.GetError())
+	}
+	return tailLogsResp.GetEntries(), tailLogsResp.GetMore(), nil
+}
+
+func (k *kdsEnvoyAdminClient) Listeners(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Listeners, error) {
+	info, err := k.proxyInfo(ctx, proxy, ProxyInfoListeners)
+	if err != nil {
+		return nil, err
+	}
+	listeners := &envoy_admin_v3.Listeners{}
+	if err := util_proto.FromJSON(info, listeners); err != nil {
+		return nil, err
+	}
+	return listeners, nil
+}
+
+func (k *kdsEnvoyAdminClient) Certs(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Certificates, error) {
+	info, err := k.proxyInfo(ctx, proxy, ProxyInfoCerts)
+	if err != nil {
+		return nil, err
+	}
+	certs := &envoy_admin_v3.Certificates{}
+	if err := util_proto.FromJSON(info, certs); err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+func (k *kdsEnvoyAdminClient) Runtime(ctx context.Context, proxy core_model.ResourceWithAddress) (*Runtime, error) {
+	info, err := k.proxyInfo(ctx, proxy, ProxyInfoRuntime)
+	if err != nil {
+		return nil, err
+	}
+	runtime := &Runtime{}
+	if err := json.Unmarshal(info, runtime); err != nil {
+		return nil, err
+	}
+	return runtime, nil
+}
+
+func (k *kdsEnvoyAdminClient) ServerInfo(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.ServerInfo, error) {
+	info, err := k.proxyInfo(ctx, proxy, ProxyInfoServerInfo)
+	if err != nil {
+		return nil, err
+	}
+	serverInfo := &envoy_admin_v3.ServerInfo{}
+	if err := util_proto.FromJSON(info, serverInfo); err != nil {
+		return nil, err
+	}
+	return serverInfo, nil
+}
+
+// proxyInfo executes the request on the Zone CP of the proxy, which returns the information in JSON format.
+func (k *kdsEnvoyAdminClient) proxyInfo(ctx context.Context, proxy core_model.ResourceWithAddress, endpoint ProxyInfoEndpoint) ([]byte, error) {
+	zone, nameInZone, err := resNameInZone(proxy.GetMeta().GetName(), k.k8sStore)
+	if err != nil {
+		return nil, err
+	}
+	reqId := core.NewUUID()
+	err = k.rpcs.ProxyInfo.Send(zone, &mesh_proto.ProxyInfoRequest{
+		RequestId:    reqId,
+		ResourceType: string(proxy.Descriptor().Name),
+		ResourceName: nameInZone,                // send the name which without the added prefix
+		ResourceMesh: proxy.GetMeta().GetMesh(), // should be empty for ZoneIngress/ZoneEgress
+		Endpoint:     string(endpoint),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not send ProxyInfoRequest")
+	}
+
+	defer k.rpcs.ProxyInfo.DeleteWatch(zone, reqId)
+	ch := make(chan util_grpc.ReverseUnaryMessage)
+	if err := k.rpcs.ProxyInfo.WatchResponse(zone, reqId, ch); err != nil {
+		return nil, errors.Wrapf(err, "could not watch the response")
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case resp := <-ch:
+		proxyInfoResp, ok := resp.(*mesh_proto.ProxyInfoResponse)
+		if !ok {
+			return nil, errors.New("invalid request type")
+		}
+		if proxyInfoResp.GetError() != "" {
+			return nil, errors.Errorf("error response from Zone CP: %s", proxyInfoResp.GetError())
+		}
+		return proxyInfoResp.GetInfo(), nil
+	}
+}
+
+func (k *kdsEnvoyAdminClient) Drain(context.Context, *core_mesh.DataplaneResource, bool) error {
+	return notSupportedOverKDS(drainListeners)
+}
+
+func (k *kdsEnvoyAdminClient) MemoryStats(context.Context, core_model.ResourceWithAddress) (*envoy_admin_v3.Memory, error) {
+	return nil, notSupportedOverKDS("memory")
+}
+
+func (k *kdsEnvoyAdminClient) Forward(context.Context, core_model.ResourceWithAddress, string, string, url.Values, io.Reader) (*http.Response, error) {
+	return nil, notSupportedOverKDS("forward")
+}
+
+func notSupportedOverKDS(path string) error {
+	return errors.Errorf("%s request is not supported on Global CP, execute it on the Zone CP instead", path)
+}
+
 func resNameInZone(nameInGlobal string, k8sStore bool) (string, string, error) {
 	parts := strings.Split(nameInGlobal, ".")
 	if len(parts) < 2 {
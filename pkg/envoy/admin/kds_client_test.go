@@ -2,8 +2,10 @@ package admin_test
 
 import (
 	"context"
+	"io"
 	"time"
 
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
@@ -45,7 +47,7 @@ var _ = Describe("KDS client", func() {
 			respCh := make(chan []byte)
 			go func() {
 				defer GinkgoRecover()
-				resp, err := client.ConfigDump(context.Background(), dpRes)
+				resp, err := client.ConfigDump(context.Background(), dpRes, admin.ConfigDumpOpts{Redaction: admin.RedactionPolicyFull, IncludeEDS: true})
 				Expect(err).To(Succeed())
 				respCh <- resp
 			}()
@@ -53,6 +55,8 @@ var _ = Describe("KDS client", func() {
 			// and
 			request := <-stream.receivedRequests
 			Expect(request.ResourceName).To(Equal("dp-1"))
+			Expect(request.Redaction).To(Equal("full"))
+			Expect(request.IncludeEds).To(BeTrue())
 
 			Eventually(func() error {
 				return rpcs.XDSConfigDump.ResponseReceived(zoneName, &mesh_proto.XDSConfigResponse{
@@ -76,7 +80,7 @@ var _ = Describe("KDS client", func() {
 			})
 
 			// when
-			_, err := client.ConfigDump(context.Background(), dpRes)
+			_, err := client.ConfigDump(context.Background(), dpRes, admin.ConfigDumpOpts{})
 
 			// then
 			Expect(err).To(MatchError("could not send XDSConfigRequest: client not-connected is not connected"))
@@ -94,7 +98,7 @@ var _ = Describe("KDS client", func() {
 			defer cancel()
 
 			// when
-			_, err := client.ConfigDump(ctx, dpRes)
+			_, err := client.ConfigDump(ctx, dpRes, admin.ConfigDumpOpts{})
 
 			// then
 			Expect(err).To(MatchError(context.DeadlineExceeded))
@@ -112,7 +116,7 @@ var _ = Describe("KDS client", func() {
 			errCh := make(chan error)
 			go func() {
 				defer GinkgoRecover()
-				_, err := client.ConfigDump(context.Background(), dpRes)
+				_, err := client.ConfigDump(context.Background(), dpRes, admin.ConfigDumpOpts{})
 				errCh <- err
 			}()
 
@@ -134,6 +138,214 @@ var _ = Describe("KDS client", func() {
 		})
 	})
 
+	Context("tail logs", func() {
+
+		rpcs := service.NewEnvoyAdminRPCs()
+		client := admin.NewKDSEnvoyAdminClient(rpcs, false)
+
+		zoneName := "zone-1"
+		var stream *tailLogsMockStream
+		var dpRes *core_mesh.DataplaneResource
+
+		BeforeEach(func() {
+			stream = &tailLogsMockStream{
+				receivedRequests: make(chan *mesh_proto.TailLogsRequest, 2),
+			}
+			rpcs.TailLogs.ClientConnected(zoneName, stream)
+			dpRes = core_mesh.NewDataplaneResource()
+			dpRes.SetMeta(&test_model.ResourceMeta{
+				Mesh: "default",
+				Name: "zone-1.dp-1",
+			})
+		})
+
+		respond := func(resp *mesh_proto.TailLogsResponse) {
+			Eventually(func() error {
+				return rpcs.TailLogs.ResponseReceived(zoneName, resp)
+			}, "10s", "100ms").Should(Succeed())
+		}
+
+		It("should stream entries sent in many responses", func() {
+			// when
+			streamCh := make(chan io.ReadCloser)
+			go func() {
+				defer GinkgoRecover()
+				logs, err := client.TailLogs(context.Background(), dpRes, admin.TailLogsOpts{
+					MaxBufferedBytes: 2048,
+					LogLevel:         admin.LogLevelDebug,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				streamCh <- logs
+			}()
+
+			// and
+			request := <-stream.receivedRequests
+			Expect(request.ResourceName).To(Equal("dp-1"))
+			Expect(request.MaxBufferedBytes).To(Equal(uint32(2048)))
+			Expect(request.LogLevel).To(Equal("debug"))
+			respond(&mesh_proto.TailLogsResponse{RequestId: request.RequestId, More: true})
+
+			var logs io.ReadCloser
+			Eventually(streamCh).Should(Receive(&logs))
+			defer logs.Close()
+			go func() {
+				defer GinkgoRecover()
+				respond(&mesh_proto.TailLogsResponse{RequestId: request.RequestId, Entries: []byte("entry-1"), More: true})
+				respond(&mesh_proto.TailLogsResponse{RequestId: request.RequestId, Entries: []byte("entry-2")})
+			}()
+			entries, err := io.ReadAll(logs)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(entries)).To(Equal("entry-1entry-2"))
+		})
+
+		It("should rethrow error of opening the stream from zone CP", func() {
+			// when
+			errCh := make(chan error)
+			go func() {
+				defer GinkgoRecover()
+				_, err := client.TailLogs(context.Background(), dpRes, admin.TailLogsOpts{})
+				errCh <- err
+			}()
+
+			// and
+			request := <-stream.receivedRequests
+			respond(&mesh_proto.TailLogsResponse{RequestId: request.RequestId, Error: "failed"})
+
+			// then
+			Eventually(errCh).Should(Receive(MatchError("error response from Zone CP: failed")))
+		})
+
+		It("should cancel the stream on zone CP when it is closed", func() {
+			// given
+			streamCh := make(chan io.ReadCloser)
+			go func() {
+				defer GinkgoRecover()
+				logs, err := client.TailLogs(context.Background(), dpRes, admin.TailLogsOpts{})
+				Expect(err).ToNot(HaveOccurred())
+				streamCh <- logs
+			}()
+			request := <-stream.receivedRequests
+			respond(&mesh_proto.TailLogsResponse{RequestId: request.RequestId, More: true})
+			var logs io.ReadCloser
+			Eventually(streamCh).Should(Receive(&logs))
+
+			// when
+			Expect(logs.Close()).To(Succeed())
+
+			// then
+			cancelRequest := <-stream.receivedRequests
+			Expect(cancelRequest.RequestId).To(Equal(request.RequestId))
+			Expect(cancelRequest.Cancel).To(BeTrue())
+			respond(&mesh_proto.TailLogsResponse{RequestId: request.RequestId})
+		})
+	})
+
+	Context("proxy info", func() {
+
+		rpcs := service.NewEnvoyAdminRPCs()
+		client := admin.NewKDSEnvoyAdminClient(rpcs, false)
+
+		zoneName := "zone-1"
+		var stream *proxyInfoMockStream
+		var dpRes *core_mesh.DataplaneResource
+
+		BeforeEach(func() {
+			stream = &proxyInfoMockStream{
+				receivedRequests: make(chan *mesh_proto.ProxyInfoRequest, 1),
+			}
+			rpcs.ProxyInfo.ClientConnected(zoneName, stream)
+			dpRes = core_mesh.NewDataplaneResource()
+			dpRes.SetMeta(&test_model.ResourceMeta{
+				Mesh: "default",
+				Name: "zone-1.dp-1",
+			})
+		})
+
+		respond := func(resp *mesh_proto.ProxyInfoResponse) {
+			Eventually(func() error {
+				return rpcs.ProxyInfo.ResponseReceived(zoneName, resp)
+			}, "10s", "100ms").Should(Succeed())
+		}
+
+		It("should parse certs sent by zone CP", func() {
+			// when
+			certsCh := make(chan *envoy_admin_v3.Certificates)
+			go func() {
+				defer GinkgoRecover()
+				certs, err := client.Certs(context.Background(), dpRes)
+				Expect(err).ToNot(HaveOccurred())
+				certsCh <- certs
+			}()
+
+			// and
+			request := <-stream.receivedRequests
+			Expect(request.ResourceName).To(Equal("dp-1"))
+			Expect(request.Endpoint).To(Equal("certs"))
+			respond(&mesh_proto.ProxyInfoResponse{
+				RequestId: request.RequestId,
+				Result: &mesh_proto.ProxyInfoResponse_Info{
+					Info: []byte(`{"certificates": [{"cert_chain": [{"serial_number": "1", "days_until_expiration": "29"}]}]}`),
+				},
+			})
+
+			// then
+			var certs *envoy_admin_v3.Certificates
+			Eventually(certsCh).Should(Receive(&certs))
+			Expect(certs.Certificates[0].CertChain[0].DaysUntilExpiration).To(Equal(uint64(29)))
+		})
+
+		It("should parse runtime sent by zone CP", func() {
+			// when
+			runtimeCh := make(chan *admin.Runtime)
+			go func() {
+				defer GinkgoRecover()
+				runtime, err := client.Runtime(context.Background(), dpRes)
+				Expect(err).ToNot(HaveOccurred())
+				runtimeCh <- runtime
+			}()
+
+			// and
+			request := <-stream.receivedRequests
+			Expect(request.Endpoint).To(Equal("runtime"))
+			respond(&mesh_proto.ProxyInfoResponse{
+				RequestId: request.RequestId,
+				Result: &mesh_proto.ProxyInfoResponse_Info{
+					Info: []byte(`{"layers": ["static"], "entries": {"key": {"final_value": "value"}}}`),
+				},
+			})
+
+			// then
+			var runtime *admin.Runtime
+			Eventually(runtimeCh).Should(Receive(&runtime))
+			Expect(runtime.Entries["key"].FinalValue).To(Equal("value"))
+		})
+
+		It("should rethrow error from zone CP", func() {
+			// when
+			errCh := make(chan error)
+			go func() {
+				defer GinkgoRecover()
+				_, err := client.ServerInfo(context.Background(), dpRes)
+				errCh <- err
+			}()
+
+			// and
+			request := <-stream.receivedRequests
+			Expect(request.Endpoint).To(Equal("server_info"))
+			respond(&mesh_proto.ProxyInfoResponse{
+				RequestId: request.RequestId,
+				Result: &mesh_proto.ProxyInfoResponse_Error{
+					Error: "failed",
+				},
+			})
+
+			// then
+			Eventually(errCh).Should(Receive(MatchError("error response from Zone CP: failed")))
+		})
+	})
+
 	Context("Kubernetes", func() {
 
 		streams := service.NewEnvoyAdminRPCs()
@@ -162,7 +374,7 @@ var _ = Describe("KDS client", func() {
 			respCh := make(chan []byte)
 			go func() {
 				defer GinkgoRecover()
-				resp, err := client.ConfigDump(context.Background(), dpRes)
+				resp, err := client.ConfigDump(context.Background(), dpRes, admin.ConfigDumpOpts{})
 				Expect(err).To(Succeed())
 				respCh <- resp
 			}()
@@ -206,3 +418,45 @@ func (m *mockStream) Recv() (*mesh_proto.XDSConfigResponse, error) {
 }
 
 var _ mesh_proto.GlobalKDSService_StreamXDSConfigsServer = &mockStream{}
+
+type tailLogsMockStream struct {
+	receivedRequests  chan *mesh_proto.TailLogsRequest
+	grpc.ServerStream // nil to implement methods
+}
+
+func (m *tailLogsMockStream) Send(request *mesh_proto.TailLogsRequest) error {
+	m.receivedRequests <- request
+	return nil
+}
+
+func (m *tailLogsMockStream) SendMsg(request interface{}) error {
+	m.receivedRequests <- request.(*mesh_proto.TailLogsRequest)
+	return nil
+}
+
+func (m *tailLogsMockStream) Recv() (*mesh_proto.TailLogsResponse, error) {
+	return nil, nil
+}
+
+var _ mesh_proto.GlobalKDSService_StreamTailLogsServer = &tailLogsMockStream{}
+
+type proxyInfoMockStream struct {
+	receivedRequests  chan *mesh_proto.ProxyInfoRequest
+	grpc.ServerStream // nil to implement methods
+}
+
+func (m *proxyInfoMockStream) Send(request *mesh_proto.ProxyInfoRequest) error {
+	m.receivedRequests <- request
+	return nil
+}
+
+func (m *proxyInfoMockStream) SendMsg(request interface{}) error {
+	m.receivedRequests <- request.(*mesh_proto.ProxyInfoRequest)
+	return nil
+}
+
+func (m *proxyInfoMockStream) Recv() (*mesh_proto.ProxyInfoResponse, error) {
+	return nil, nil
+}
+
+var _ mesh_proto.GlobalKDSService_StreamProxyInfoServer = &proxyInfoMockStream{}
@@ -0,0 +1,157 @@
+package admin
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+const (
+	// MeshConfigDumpErrorsFile is the name of the file in the archive that contains errors of proxies that could not be dumped.
+	MeshConfigDumpErrorsFile = "errors.json"
+
+	DefaultMeshConfigDumpConcurrency = 10
+)
+
+// MeshConfigDumper fetches config dumps of all Dataplanes in a Mesh.
+type MeshConfigDumper struct {
+	rm     manager.ReadOnlyResourceManager
+	client EnvoyAdminClient
+}
+
+func NewMeshConfigDumper(rm manager.ReadOnlyResourceManager, client EnvoyAdminClient) *MeshConfigDumper {
+	return &MeshConfigDumper{
+		rm:     rm,
+		client: client,
+	}
+}
+
+type configDumpResult struct {
+	name       string
+	configDump []byte
+	err        error
+}
+
+// ConfigDumpForMesh writes a gzipped tar archive with config dumps of all Dataplanes in the mesh to the writer.
+// Every config dump is stored in a "<dataplane>.json" file. At most concurrency config dumps are fetched at the same time.
+// A failure of a single proxy does not stop the dump, errors of all failed proxies are stored in MeshConfigDumpErrorsFile.
+// Every config dump is fetched with the same opts.
+func (d *MeshConfigDumper) ConfigDumpForMesh(ctx context.Context, mesh string, concurrency int, opts ConfigDumpOpts, writer io.Writer) error {
+	if concurrency <= 0 {
+		return errors.New("concurrency has to be greater than 0")
+	}
+	if err := opts.RedactionPolicy().Validate(); err != nil {
+		return err
+	}
+
+	dataplanes := core_mesh.DataplaneResourceList{}
+	if err := d.rm.List(ctx, &dataplanes, store.ListByMesh(mesh)); err != nil {
+		return errors.Wrap(err, "could not list dataplanes")
+	}
+
+	gzipWriter := gzip.NewWriter(writer)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var writeErr error
+	failures := map[string]string{}
+	// we have to consume all results, otherwise fetching goroutines would leak
+	for result := range d.fetchConfigDumps(ctx, dataplanes.Items, concurrency, opts) {
+		switch {
+		case writeErr != nil:
+		case result.err != nil:
+			failures[result.name] = result.err.Error()
+		default:
+			if writeErr = writeTarFile(tarWriter, result.name+".json", result.configDump); writeErr != nil {
+				cancel()
+			}
+		}
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+
+	if len(failures) > 0 {
+		content, err := json.MarshalIndent(failures, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := writeTarFile(tarWriter, MeshConfigDumpErrorsFile, content); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+func (d *MeshConfigDumper) fetchConfigDumps(ctx context.Context, dataplanes []*core_mesh.DataplaneResource, concurrency int, opts ConfigDumpOpts) <-chan configDumpResult {
+	// we sort dataplanes so the order of dumps in the archive is stable when concurrency is 1
+	sort.Slice(dataplanes, func(i, j int) bool {
+		return dataplanes[i].GetMeta().GetName() < dataplanes[j].GetMeta().GetName()
+	})
+
+	queue := make(chan *core_mesh.DataplaneResource)
+	results := make(chan configDumpResult)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for dataplane := range queue {
+				configDump, err := d.client.ConfigDump(ctx, dataplane, opts)
+				results <- configDumpResult{
+					name:       dataplane.GetMeta().GetName(),
+					configDump: configDump,
+					err:        err,
+				}
+			}
+		}()
+	}
+	go func() {
+		defer close(queue)
+		for _, dataplane := range dataplanes {
+			select {
+			case queue <- dataplane:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
+
+func writeTarFile(writer *tar.Writer, name string, content []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(content)),
+		ModTime: core.Now(),
+	}
+	if err := writer.WriteHeader(header); err != nil {
+		return errors.Wrapf(err, "could not write header of %s", name)
+	}
+	if _, err := writer.Write(content); err != nil {
+		return errors.Wrapf(err, "could not write %s", name)
+	}
+	return nil
+}
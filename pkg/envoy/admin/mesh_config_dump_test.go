@@ -0,0 +1,129 @@
+package admin_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+)
+
+type configDumpClient struct {
+	admin.EnvoyAdminClient
+}
+
+func (c *configDumpClient) ConfigDump(_ context.Context, proxy core_model.ResourceWithAddress, _ admin.ConfigDumpOpts) ([]byte, error) {
+	name := proxy.GetMeta().GetName()
+	if name == "broken" {
+		return nil, errors.New("connection refused")
+	}
+	return []byte(`{"name": "` + name + `"}`), nil
+}
+
+func readArchive(content []byte) map[string]string {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(content))
+	Expect(err).ToNot(HaveOccurred())
+	tarReader := tar.NewReader(gzipReader)
+	files := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).ToNot(HaveOccurred())
+		fileContent, err := io.ReadAll(tarReader)
+		Expect(err).ToNot(HaveOccurred())
+		files[header.Name] = string(fileContent)
+	}
+	return files
+}
+
+var _ = Describe("MeshConfigDumper", func() {
+
+	var dumper *admin.MeshConfigDumper
+
+	BeforeEach(func() {
+		rm := manager.NewResourceManager(memory.NewStore())
+		for _, mesh := range []string{"default", "other"} {
+			err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey(mesh, core_model.NoMesh))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		dataplanes := []core_model.ResourceKey{
+			core_model.WithMesh("default", "backend"),
+			core_model.WithMesh("default", "web"),
+			core_model.WithMesh("default", "broken"),
+			core_model.WithMesh("other", "redis"),
+		}
+		for _, key := range dataplanes {
+			dp := &core_mesh.DataplaneResource{
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+							Port: 80,
+							Tags: map[string]string{mesh_proto.ServiceTag: key.Name},
+						}},
+					},
+				},
+			}
+			Expect(rm.Create(context.Background(), dp, store.CreateBy(key))).To(Succeed())
+		}
+		dumper = admin.NewMeshConfigDumper(rm, &configDumpClient{})
+	})
+
+	DescribeTable("should dump config of all dataplanes in the mesh",
+		func(concurrency int) {
+			// given
+			buf := &bytes.Buffer{}
+
+			// when
+			err := dumper.ConfigDumpForMesh(context.Background(), "default", concurrency, admin.ConfigDumpOpts{}, buf)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readArchive(buf.Bytes())).To(Equal(map[string]string{
+				"backend.json": `{"name": "backend"}`,
+				"web.json":     `{"name": "web"}`,
+				admin.MeshConfigDumpErrorsFile: `{
+  "broken": "connection refused"
+}`,
+			}))
+		},
+		Entry("sequentially", 1),
+		Entry("concurrently", 10),
+	)
+
+	It("should not write errors file when all dumps succeeded", func() {
+		// given
+		buf := &bytes.Buffer{}
+
+		// when
+		err := dumper.ConfigDumpForMesh(context.Background(), "other", 2, admin.ConfigDumpOpts{}, buf)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readArchive(buf.Bytes())).To(Equal(map[string]string{
+			"redis.json": `{"name": "redis"}`,
+		}))
+	})
+
+	It("should reject invalid concurrency", func() {
+		// when
+		err := dumper.ConfigDumpForMesh(context.Background(), "default", 0, admin.ConfigDumpOpts{}, &bytes.Buffer{})
+
+		// then
+		Expect(err).To(MatchError("concurrency has to be greater than 0"))
+	})
+})
@@ -1,8 +1,40 @@
 package admin
 
-import envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+import (
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	"github.com/pkg/errors"
+)
 
-func Sanitize(configDump *envoy_admin_v3.ConfigDump) error {
+// RedactionPolicy defines which parts of the config dump are redacted before it's returned to the user.
+type RedactionPolicy string
+
+const (
+	// RedactionPolicyNone returns the config dump exactly as it was returned by Envoy.
+	RedactionPolicyNone RedactionPolicy = "none"
+	// RedactionPolicySecretsOnly redacts credentials that the proxy uses to authenticate to the Control Plane.
+	RedactionPolicySecretsOnly RedactionPolicy = "secrets-only"
+	// RedactionPolicyFull redacts credentials and drops the content of all SDS secrets, only their names and versions are kept.
+	RedactionPolicyFull RedactionPolicy = "full"
+
+	DefaultRedactionPolicy = RedactionPolicySecretsOnly
+)
+
+func (p RedactionPolicy) Validate() error {
+	switch p {
+	case RedactionPolicyNone, RedactionPolicySecretsOnly, RedactionPolicyFull:
+		return nil
+	default:
+		return errors.Errorf("unsupported redaction policy %q, supported policies are: %q, %q, %q", p, RedactionPolicyNone, RedactionPolicySecretsOnly, RedactionPolicyFull)
+	}
+}
+
+func Sanitize(configDump *envoy_admin_v3.ConfigDump, policy RedactionPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	if policy == RedactionPolicyNone {
+		return nil
+	}
 	for _, config := range configDump.Configs {
 		if config.MessageIs(&envoy_admin_v3.BootstrapConfigDump{}) {
 			bootstrapConfigDump := &envoy_admin_v3.BootstrapConfigDump{}
@@ -30,6 +62,27 @@ func Sanitize(configDump *envoy_admin_v3.ConfigDump) error {
 				return err
 			}
 		}
+
+		if policy == RedactionPolicyFull && config.MessageIs(&envoy_admin_v3.SecretsConfigDump{}) {
+			secretsConfigDump := &envoy_admin_v3.SecretsConfigDump{}
+			if err := config.UnmarshalTo(secretsConfigDump); err != nil {
+				return err
+			}
+
+			for _, secret := range secretsConfigDump.StaticSecrets {
+				secret.Secret = nil
+			}
+			for _, secret := range secretsConfigDump.DynamicActiveSecrets {
+				secret.Secret = nil
+			}
+			for _, secret := range secretsConfigDump.DynamicWarmingSecrets {
+				secret.Secret = nil
+			}
+
+			if err := config.MarshalFrom(secretsConfigDump); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/kumahq/kuma/pkg/envoy/admin"
 	"github.com/kumahq/kuma/pkg/test/matchers"
@@ -18,6 +19,7 @@ var _ = Describe("Sanitize ConfigDump", func() {
 
 	type testCase struct {
 		configFile string
+		policy     admin.RedactionPolicy
 		goldenFile string
 	}
 
@@ -31,7 +33,7 @@ var _ = Describe("Sanitize ConfigDump", func() {
 			Expect(util_proto.FromJSON(rawConfigDump, configDump)).To(Succeed())
 
 			// when
-			Expect(admin.Sanitize(configDump)).To(Succeed())
+			Expect(admin.Sanitize(configDump, given.policy)).To(Succeed())
 			// and when
 			sanitized, err := util_proto.ToJSONIndent(configDump, "  ")
 			Expect(err).ToNot(HaveOccurred())
@@ -41,11 +43,42 @@ var _ = Describe("Sanitize ConfigDump", func() {
 		},
 		Entry("full config", testCase{
 			configFile: "full_config.json",
+			policy:     admin.RedactionPolicySecretsOnly,
 			goldenFile: "golden.full_config.json",
 		}),
 		Entry("no hds", testCase{
 			configFile: "no_hds.json",
+			policy:     admin.RedactionPolicySecretsOnly,
 			goldenFile: "golden.no_hds.json",
 		}),
+		Entry("full config with full redaction", testCase{
+			configFile: "full_config.json",
+			policy:     admin.RedactionPolicyFull,
+			goldenFile: "golden.full_config.full_redaction.json",
+		}),
 	)
+
+	It("should not redact anything when redaction is disabled", func() {
+		// given
+		rawConfigDump, err := os.ReadFile(filepath.Join("testdata", "full_config.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		configDump := &envoy_admin_v3.ConfigDump{}
+		Expect(util_proto.FromJSON(rawConfigDump, configDump)).To(Succeed())
+		expected := proto.Clone(configDump)
+
+		// when
+		Expect(admin.Sanitize(configDump, admin.RedactionPolicyNone)).To(Succeed())
+
+		// then
+		Expect(configDump).To(matchers.MatchProto(expected))
+	})
+
+	It("should reject unknown redaction policy", func() {
+		// when
+		err := admin.Sanitize(&envoy_admin_v3.ConfigDump{}, "partial")
+
+		// then
+		Expect(err).To(MatchError(`unsupported redaction policy "partial", supported policies are: "none", "secrets-only", "full"`))
+	})
 })
@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+// UnixSocketTransport returns a transport that connects to the Envoy Admin API listening on the unix domain socket.
+// The host of the request URL is ignored, so the callers can build URLs the same way as for the Admin API listening on TCP.
+func UnixSocketTransport(socketPath string) *http.Transport {
+	dialer := &net.Dialer{}
+	return &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socketPath)
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package admin_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+)
+
+var _ = Describe("UnixSocketTransport", func() {
+
+	var socketPath string
+	var server *http.Server
+
+	BeforeEach(func() {
+		socketPath = filepath.Join(GinkgoT().TempDir(), "admin.sock")
+		listener, err := net.Listen("unix", socketPath)
+		Expect(err).ToNot(HaveOccurred())
+		server = &http.Server{
+			Handler: http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+				_, _ = writer.Write([]byte(req.URL.Path))
+			}),
+		}
+		go func() {
+			_ = server.Serve(listener)
+		}()
+	})
+
+	AfterEach(func() {
+		Expect(server.Close()).To(Succeed())
+	})
+
+	It("should send requests to the unix domain socket regardless of the host", func() {
+		// given
+		client := &http.Client{Transport: admin.UnixSocketTransport(socketPath)}
+
+		// when
+		resp, err := client.Get("http://127.0.0.1:9901/ready")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal("/ready"))
+	})
+})
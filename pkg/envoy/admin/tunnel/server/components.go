@@ -0,0 +1,22 @@
+package server
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	config_core "github.com/kumahq/kuma/pkg/config/core"
+	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
+	"github.com/kumahq/kuma/pkg/xds/auth/components"
+)
+
+func Setup(rt core_runtime.Runtime) error {
+	if rt.Config().Mode == config_core.Global {
+		return nil
+	}
+	authenticator, err := components.DefaultAuthenticator(rt)
+	if err != nil {
+		return err
+	}
+	srv := NewServer(rt.ReadOnlyResourceManager(), authenticator, rt.EnvoyAdminTunnels())
+	log.Info("registering Envoy Admin Tunnel Service in Dataplane Server")
+	mesh_proto.RegisterEnvoyAdminTunnelServiceServer(rt.DpServer().GrpcServer(), srv)
+	return nil
+}
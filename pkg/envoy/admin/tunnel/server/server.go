@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/envoy/admin/tunnel"
+	"github.com/kumahq/kuma/pkg/xds/auth"
+)
+
+var log = core.Log.WithName("envoy-admin-tunnel-server")
+
+type server struct {
+	resManager    core_manager.ReadOnlyResourceManager
+	authenticator auth.Authenticator
+	tunnels       tunnel.Tunnels
+	log           logr.Logger
+}
+
+var _ mesh_proto.EnvoyAdminTunnelServiceServer = &server{}
+
+func NewServer(resManager core_manager.ReadOnlyResourceManager, authenticator auth.Authenticator, tunnels tunnel.Tunnels) mesh_proto.EnvoyAdminTunnelServiceServer {
+	return &server{
+		resManager:    resManager,
+		authenticator: authenticator,
+		tunnels:       tunnels,
+		log:           log,
+	}
+}
+
+func (s *server) StreamEnvoyAdminRequests(stream mesh_proto.EnvoyAdminTunnelService_StreamEnvoyAdminRequestsServer) error {
+	proxy, err := s.authenticate(stream.Context())
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	logger := s.log.WithValues("type", proxy.Descriptor().Name, "mesh", proxy.GetMeta().GetMesh(), "name", proxy.GetMeta().GetName())
+	logger.Info("Envoy Admin tunnel opened")
+	s.tunnels.TunnelOpened(proxy, stream)
+	defer func() {
+		s.tunnels.TunnelClosed(proxy, stream)
+		logger.Info("Envoy Admin tunnel closed")
+	}()
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.tunnels.ResponseReceived(proxy, resp); err != nil {
+			// the request might have been already cancelled, it should not close the whole tunnel
+			logger.Error(err, "could not deliver the response", "requestId", resp.GetRequestId())
+		}
+	}
+}
+
+func (s *server) authenticate(ctx context.Context) (core_model.Resource, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errors.New("request has no metadata")
+	}
+
+	var resource core_model.Resource
+	switch proxyType := mesh_proto.ProxyType(metadataValue(md, tunnel.ProxyTypeMetadataKey)); proxyType {
+	case mesh_proto.DataplaneProxyType:
+		resource = core_mesh.NewDataplaneResource()
+	case mesh_proto.IngressProxyType:
+		resource = core_mesh.NewZoneIngressResource()
+	case mesh_proto.EgressProxyType:
+		resource = core_mesh.NewZoneEgressResource()
+	default:
+		return nil, errors.Errorf("unsupported proxy type %q", proxyType)
+	}
+
+	key := core_model.ResourceKey{
+		Mesh: metadataValue(md, tunnel.ProxyMeshMetadataKey),
+		Name: metadataValue(md, tunnel.ProxyNameMetadataKey),
+	}
+	if resource.Descriptor().Scope == core_model.ScopeGlobal {
+		key.Mesh = ""
+	}
+	if err := s.resManager.Get(ctx, resource, core_store.GetBy(key)); err != nil {
+		if core_store.IsResourceNotFound(err) {
+			return nil, errors.Errorf("%s %q not found", resource.Descriptor().Name, key.Name)
+		}
+		return nil, err
+	}
+
+	credential, err := auth.ExtractCredential(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not extract credential")
+	}
+	if err := s.authenticator.Authenticate(ctx, resource, credential); err != nil {
+		return nil, errors.Wrap(err, "authentication failed")
+	}
+	return resource, nil
+}
+
+func metadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) != 1 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,11 @@
+package tunnel_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestTunnel(t *testing.T) {
+	test.RunSpecs(t, "Envoy Admin Tunnel Suite")
+}
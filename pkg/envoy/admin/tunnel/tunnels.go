@@ -0,0 +1,192 @@
+package tunnel
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	util_grpc "github.com/kumahq/kuma/pkg/util/grpc"
+)
+
+// Metadata of the stream that kuma-dp uses to identify the proxy. The proxy is authenticated with the "authorization" metadata,
+// the same way as the xDS stream of the proxy.
+const (
+	ProxyTypeMetadataKey = "kuma-proxy-type"
+	ProxyMeshMetadataKey = "kuma-proxy-mesh"
+	ProxyNameMetadataKey = "kuma-proxy-name"
+)
+
+// Tunnels keeps tunnels to the Envoy Admin API opened by kuma-dp over the gRPC connection to the Dataplane Server.
+type Tunnels interface {
+	// HTTPClient returns an HTTP client that executes requests to the Envoy Admin API over the tunnel of the proxy.
+	// It returns false if the proxy did not open a tunnel.
+	HTTPClient(proxy core_model.Resource) (*http.Client, bool)
+
+	TunnelOpened(proxy core_model.Resource, stream grpc.ServerStream)
+	// TunnelClosed removes the tunnel of the proxy unless it was already replaced by the tunnel opened on another stream.
+	TunnelClosed(proxy core_model.Resource, stream grpc.ServerStream)
+	ResponseReceived(proxy core_model.Resource, resp *mesh_proto.EnvoyAdminTunnelResponse) error
+}
+
+type tunnels struct {
+	rpcs util_grpc.ReverseUnaryRPCs
+	// sendLock protects streams from concurrent sends which are not allowed by gRPC.
+	sendLock sync.Mutex
+}
+
+var _ Tunnels = &tunnels{}
+
+func NewTunnels() Tunnels {
+	return &tunnels{
+		rpcs: util_grpc.NewReverseUnaryRPCs(),
+	}
+}
+
+func (t *tunnels) HTTPClient(proxy core_model.Resource) (*http.Client, bool) {
+	client := tunnelClient(proxy)
+	if !t.rpcs.IsConnected(client) {
+		return nil, false
+	}
+	return &http.Client{
+		Transport: &roundTripper{
+			tunnels: t,
+			client:  client,
+		},
+	}, true
+}
+
+func (t *tunnels) TunnelOpened(proxy core_model.Resource, stream grpc.ServerStream) {
+	t.rpcs.ClientConnected(tunnelClient(proxy), stream)
+}
+
+func (t *tunnels) TunnelClosed(proxy core_model.Resource, stream grpc.ServerStream) {
+	t.rpcs.ClientDisconnected(tunnelClient(proxy), stream)
+}
+
+func (t *tunnels) ResponseReceived(proxy core_model.Resource, resp *mesh_proto.EnvoyAdminTunnelResponse) error {
+	return t.rpcs.ResponseReceived(tunnelClient(proxy), resp)
+}
+
+func (t *tunnels) send(client string, req *mesh_proto.EnvoyAdminTunnelRequest) error {
+	t.sendLock.Lock()
+	defer t.sendLock.Unlock()
+	return t.rpcs.Send(client, req)
+}
+
+// tunnelClient identifies the tunnel. Type of the proxy is included, because ZoneIngress and ZoneEgress are not scoped to a Mesh
+// and their names could collide with the names of Dataplanes.
+func tunnelClient(proxy core_model.Resource) string {
+	return fmt.Sprintf("%s:%s", proxy.Descriptor().Name, core_model.MetaToResourceKey(proxy.GetMeta()))
+}
+
+// roundTripper executes HTTP requests over the tunnel. kuma-dp streams the body of the response as Envoy writes it,
+// so it can be used for endpoints that stream the response. Closing the body stops the stream on the side of kuma-dp.
+type roundTripper struct {
+	tunnels *tunnels
+	client  string
+}
+
+func (r *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read the body of the request")
+		}
+		body = b
+	}
+
+	reqID := core.NewUUID()
+	// the channel is buffered, so receiving the response does not block the stream when the request was already cancelled
+	ch := make(chan util_grpc.ReverseUnaryMessage, 1)
+	if err := r.tunnels.rpcs.WatchResponse(r.client, reqID, ch); err != nil {
+		return nil, errors.Wrap(err, "could not watch the response")
+	}
+	deleteWatch := func() {
+		r.tunnels.rpcs.DeleteWatch(r.client, reqID)
+	}
+	cancel := func() {
+		_ = r.tunnels.send(r.client, &mesh_proto.EnvoyAdminTunnelRequest{
+			RequestId: reqID,
+			Cancel:    true,
+		})
+	}
+
+	err := r.tunnels.send(r.client, &mesh_proto.EnvoyAdminTunnelRequest{
+		RequestId: reqID,
+		Method:    req.Method,
+		Path:      req.URL.Path,
+		Query:     req.URL.RawQuery,
+		Body:      body,
+		Stream:    true,
+	})
+	if err != nil {
+		deleteWatch()
+		return nil, errors.Wrap(err, "could not send EnvoyAdminTunnelRequest")
+	}
+
+	select {
+	case <-req.Context().Done():
+		// the stream may have already started, read it till the end in the background
+		util_grpc.NewReverseStreamReader(req.Context(), ch, tunnelChunk, cancel, deleteWatch)
+		return nil, req.Context().Err()
+	case msg := <-ch:
+		resp, ok := msg.(*mesh_proto.EnvoyAdminTunnelResponse)
+		if !ok {
+			deleteWatch()
+			return nil, errors.New("invalid response type")
+		}
+		if resp.GetError() != "" {
+			deleteWatch()
+			return nil, errors.Errorf("error response from kuma-dp: %s", resp.GetError())
+		}
+		statusCode := int(resp.GetStatusCode())
+		httpResp := &http.Response{
+			Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+			StatusCode:    statusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{},
+			Body:          io.NopCloser(bytes.NewReader(resp.GetBody())),
+			ContentLength: int64(len(resp.GetBody())),
+			Request:       req,
+		}
+		if !resp.GetMore() {
+			deleteWatch()
+			return httpResp, nil
+		}
+		rest := util_grpc.NewReverseStreamReader(req.Context(), ch, tunnelChunk, cancel, deleteWatch)
+		httpResp.Body = &streamedBody{
+			Reader: io.MultiReader(bytes.NewReader(resp.GetBody()), rest),
+			Closer: rest,
+		}
+		httpResp.ContentLength = -1
+		return httpResp, nil
+	}
+}
+
+func tunnelChunk(msg util_grpc.ReverseUnaryMessage) ([]byte, bool, error) {
+	resp, ok := msg.(*mesh_proto.EnvoyAdminTunnelResponse)
+	if !ok {
+		return nil, false, errors.New("invalid response type")
+	}
+	if resp.GetError() != "" {
+		return nil, false, errors.Errorf("error response from kuma-dp: %s", resp.GetError())
+	}
+	return resp.GetBody(), resp.GetMore(), nil
+}
+
+type streamedBody struct {
+	io.Reader
+	io.Closer
+}
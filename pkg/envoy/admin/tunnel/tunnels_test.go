@@ -0,0 +1,258 @@
+package tunnel_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/envoy/admin/tunnel"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+// kumaDpStream simulates kuma-dp on the other side of the tunnel by answering every request with respond.
+type kumaDpStream struct {
+	grpc.ServerStream
+	requests []*mesh_proto.EnvoyAdminTunnelRequest
+	respond  func(*mesh_proto.EnvoyAdminTunnelRequest) *mesh_proto.EnvoyAdminTunnelResponse
+	deliver  func(*mesh_proto.EnvoyAdminTunnelResponse)
+}
+
+func (s *kumaDpStream) SendMsg(m interface{}) error {
+	req := m.(*mesh_proto.EnvoyAdminTunnelRequest)
+	s.requests = append(s.requests, req)
+	if resp := s.respond(req); resp != nil {
+		go s.deliver(resp)
+	}
+	return nil
+}
+
+var _ = Describe("Tunnels", func() {
+	var tunnels tunnel.Tunnels
+	var dataplane *core_mesh.DataplaneResource
+	var stream *kumaDpStream
+
+	BeforeEach(func() {
+		tunnels = tunnel.NewTunnels()
+		dataplane = core_mesh.NewDataplaneResource()
+		dataplane.SetMeta(&test_model.ResourceMeta{
+			Mesh: core_model.DefaultMesh,
+			Name: "dp-1",
+		})
+		stream = &kumaDpStream{
+			respond: func(req *mesh_proto.EnvoyAdminTunnelRequest) *mesh_proto.EnvoyAdminTunnelResponse {
+				return &mesh_proto.EnvoyAdminTunnelResponse{
+					RequestId:  req.RequestId,
+					StatusCode: http.StatusOK,
+					Body:       []byte(req.Path + "?" + req.Query),
+				}
+			},
+			deliver: func(resp *mesh_proto.EnvoyAdminTunnelResponse) {
+				defer GinkgoRecover()
+				Expect(tunnels.ResponseReceived(dataplane, resp)).To(Succeed())
+			},
+		}
+	})
+
+	It("should execute request over the tunnel", func() {
+		// given
+		tunnels.TunnelOpened(dataplane, stream)
+		client, ok := tunnels.HTTPClient(dataplane)
+		Expect(ok).To(BeTrue())
+
+		// when
+		resp, err := client.Get("http://127.0.0.1:9901/stats?format=json")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal("/stats?format=json"))
+		Expect(stream.requests).To(HaveLen(1))
+		Expect(stream.requests[0].Method).To(Equal(http.MethodGet))
+	})
+
+	It("should not return a client when the tunnel is not opened", func() {
+		// when
+		_, ok := tunnels.HTTPClient(dataplane)
+
+		// then
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should not return a client when the tunnel is closed", func() {
+		// given
+		tunnels.TunnelOpened(dataplane, stream)
+
+		// when
+		tunnels.TunnelClosed(dataplane, stream)
+		_, ok := tunnels.HTTPClient(dataplane)
+
+		// then
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should forward the body of the request", func() {
+		// given
+		tunnels.TunnelOpened(dataplane, stream)
+		client, _ := tunnels.HTTPClient(dataplane)
+
+		// when
+		resp, err := client.Post("http://127.0.0.1:9901/tap", "application/json", strings.NewReader(`{"config_id":"tap-1"}`))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+		Expect(stream.requests).To(HaveLen(1))
+		Expect(stream.requests[0].Method).To(Equal(http.MethodPost))
+		Expect(string(stream.requests[0].Body)).To(Equal(`{"config_id":"tap-1"}`))
+	})
+
+	It("should keep the tunnel when the stream that was already replaced is closed", func() {
+		// given
+		oldStream := &kumaDpStream{respond: stream.respond, deliver: stream.deliver}
+		tunnels.TunnelOpened(dataplane, oldStream)
+		tunnels.TunnelOpened(dataplane, stream)
+
+		// when
+		tunnels.TunnelClosed(dataplane, oldStream)
+		client, ok := tunnels.HTTPClient(dataplane)
+
+		// then
+		Expect(ok).To(BeTrue())
+		resp, err := client.Get("http://127.0.0.1:9901/ready")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+		Expect(stream.requests).To(HaveLen(1))
+		Expect(oldStream.requests).To(BeEmpty())
+	})
+
+	It("should not mix tunnels of different proxy types with the same name", func() {
+		// given
+		zoneIngress := core_mesh.NewZoneIngressResource()
+		zoneIngress.SetMeta(&test_model.ResourceMeta{
+			Name: "dp-1",
+		})
+		dataplane.SetMeta(&test_model.ResourceMeta{
+			Name: "dp-1",
+		})
+
+		// when
+		tunnels.TunnelOpened(zoneIngress, stream)
+		_, ok := tunnels.HTTPClient(dataplane)
+
+		// then
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should return error sent by kuma-dp", func() {
+		// given
+		stream.respond = func(req *mesh_proto.EnvoyAdminTunnelRequest) *mesh_proto.EnvoyAdminTunnelResponse {
+			return &mesh_proto.EnvoyAdminTunnelResponse{
+				RequestId: req.RequestId,
+				Error:     "connection refused",
+			}
+		}
+		tunnels.TunnelOpened(dataplane, stream)
+		client, _ := tunnels.HTTPClient(dataplane)
+
+		// when
+		_, err := client.Get("http://127.0.0.1:9901/stats")
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("error response from kuma-dp: connection refused")))
+	})
+
+	It("should stream the body sent by kuma-dp in many responses", func() {
+		// given
+		stream.respond = func(req *mesh_proto.EnvoyAdminTunnelRequest) *mesh_proto.EnvoyAdminTunnelResponse {
+			go func() {
+				defer GinkgoRecover()
+				for i, chunk := range []string{"entry-1", "entry-2", "entry-3"} {
+					Expect(tunnels.ResponseReceived(dataplane, &mesh_proto.EnvoyAdminTunnelResponse{
+						RequestId:  req.RequestId,
+						StatusCode: http.StatusOK,
+						Body:       []byte(chunk),
+						More:       i < 2,
+					})).To(Succeed())
+				}
+			}()
+			return nil
+		}
+		tunnels.TunnelOpened(dataplane, stream)
+		client, _ := tunnels.HTTPClient(dataplane)
+
+		// when
+		resp, err := client.Post("http://127.0.0.1:9901/tap", "application/json", strings.NewReader(`{}`))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal("entry-1entry-2entry-3"))
+		Expect(stream.requests[0].Stream).To(BeTrue())
+	})
+
+	It("should cancel the stream on kuma-dp when the body is closed", func() {
+		// given
+		var requestID string
+		cancelled := make(chan *mesh_proto.EnvoyAdminTunnelRequest, 1)
+		stream.respond = func(req *mesh_proto.EnvoyAdminTunnelRequest) *mesh_proto.EnvoyAdminTunnelResponse {
+			if req.Cancel {
+				cancelled <- req
+				return nil
+			}
+			requestID = req.RequestId
+			return &mesh_proto.EnvoyAdminTunnelResponse{
+				RequestId:  req.RequestId,
+				StatusCode: http.StatusOK,
+				More:       true,
+			}
+		}
+		tunnels.TunnelOpened(dataplane, stream)
+		client, _ := tunnels.HTTPClient(dataplane)
+		resp, err := client.Post("http://127.0.0.1:9901/tap", "application/json", strings.NewReader(`{}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		Expect(resp.Body.Close()).To(Succeed())
+
+		// then
+		var cancelReq *mesh_proto.EnvoyAdminTunnelRequest
+		Eventually(cancelled).Should(Receive(&cancelReq))
+		Expect(cancelReq.RequestId).To(Equal(requestID))
+		// the last response is still received after the body is closed
+		Expect(tunnels.ResponseReceived(dataplane, &mesh_proto.EnvoyAdminTunnelResponse{
+			RequestId: requestID,
+		})).To(Succeed())
+	})
+
+	It("should stop waiting for the response when the context is cancelled", func() {
+		// given
+		stream.respond = func(*mesh_proto.EnvoyAdminTunnelRequest) *mesh_proto.EnvoyAdminTunnelResponse {
+			return nil
+		}
+		tunnels.TunnelOpened(dataplane, stream)
+		client, _ := tunnels.HTTPClient(dataplane)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:9901/stats", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = client.Do(req)
+
+		// then
+		Expect(err).To(MatchError(context.Canceled))
+	})
+})
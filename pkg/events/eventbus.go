@@ -22,10 +22,24 @@ func (b *EventBus) New() Listener {
 	events := make(chan Event, 10)
 	b.subscribers = append(b.subscribers, events)
 	return &reader{
+		bus:    b,
 		events: events,
 	}
 }
 
+func (b *EventBus) unsubscribe(events chan Event) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for i, s := range b.subscribers {
+		if s == events {
+			b.subscribers = append(b.subscribers[:i], b.subscribers[i+1:]...)
+			close(events)
+			return
+		}
+	}
+}
+
 func (b *EventBus) Send(event Event) {
 	b.mtx.RLock()
 	defer b.mtx.RUnlock()
@@ -43,7 +57,9 @@ func (b *EventBus) Send(event Event) {
 }
 
 type reader struct {
+	bus    *EventBus
 	events chan Event
+	once   sync.Once
 }
 
 func (k *reader) Recv(stop <-chan struct{}) (Event, error) {
@@ -57,3 +73,15 @@ func (k *reader) Recv(stop <-chan struct{}) (Event, error) {
 		return nil, ListenerStoppedErr
 	}
 }
+
+// Close unsubscribes the reader. Events that are sent while the reader is being closed are drained,
+// otherwise Send would block on the full channel and the reader could never be unsubscribed.
+func (k *reader) Close() {
+	k.once.Do(func() {
+		go func() {
+			for range k.events {
+			}
+		}()
+		k.bus.unsubscribe(k.events)
+	})
+}
@@ -26,6 +26,8 @@ var ListenerStoppedErr = errors.New("listener closed")
 
 type Listener interface {
 	Recv(stop <-chan struct{}) (Event, error)
+	// Close unsubscribes the listener from events. Recv returns an error once the listener is closed.
+	Close()
 }
 
 type Emitter interface {
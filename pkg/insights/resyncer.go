@@ -350,6 +350,25 @@ func (r *resyncer) createOrUpdateMeshInsight(mesh string) error {
 
 	insight.Dataplanes.Total = uint32(len(dataplanes.GetItems()))
 
+	meshRes := core_mesh.NewMeshResource()
+	if err := r.rm.Get(context.Background(), meshRes, store.GetByKey(mesh, model.NoMesh)); err != nil && !store.IsResourceNotFound(err) {
+		return err
+	}
+	if rotation := meshRes.Spec.GetMtls().GetRotation(); rotation != nil {
+		insight.MTLS.CaRotation = &mesh_proto.MeshInsight_MTLS_CaRotation{
+			From:  rotation.GetFrom(),
+			To:    rotation.GetTo(),
+			Phase: mesh_proto.MeshInsight_MTLS_CaRotation_TRUSTING,
+			Total: insight.Dataplanes.Total,
+		}
+		if meshRes.Spec.GetMtls().GetEnabledBackend() == rotation.GetTo() {
+			insight.MTLS.CaRotation.Phase = mesh_proto.MeshInsight_MTLS_CaRotation_REISSUING
+		}
+	}
+	if meshRes.MTLSEnabled() && meshRes.GetEnabledCertificateAuthorityBackend().GetMode() == mesh_proto.CertificateAuthorityBackend_PERMISSIVE {
+		insight.MTLS.PermissiveConnections = map[string]*mesh_proto.MeshInsight_MTLS_PermissiveConnections{}
+	}
+
 	dpInsights := &core_mesh.DataplaneInsightResourceList{}
 	if err := r.rm.List(context.Background(), dpInsights, store.ListByMesh(mesh)); err != nil {
 		return err
@@ -399,6 +418,8 @@ func (r *resyncer) createOrUpdateMeshInsight(mesh string) error {
 		updateTotal(kumaDpVersion, insight.DpVersions.KumaDp)
 		updateTotal(envoyVersion, insight.DpVersions.Envoy)
 		updateMTLS(dpInsight.GetMTLS(), status, insight.MTLS)
+		updateCaRotation(dpInsight.GetMTLS(), insight.MTLS.CaRotation)
+		updatePermissiveConnections(networking, dpInsight.GetMTLS(), insight.MTLS.PermissiveConnections)
 
 		if svc := networking.GetGateway().GetTags()[mesh_proto.ServiceTag]; svc != "" {
 			internalServices[svc] = struct{}{}
@@ -504,6 +525,45 @@ func updateMTLS(mtlsInsight *mesh_proto.DataplaneInsight_MTLS, status core_mesh.
 	}
 }
 
+func updateCaRotation(mtlsInsight *mesh_proto.DataplaneInsight_MTLS, rotation *mesh_proto.MeshInsight_MTLS_CaRotation) {
+	if mtlsInsight == nil || rotation == nil {
+		return
+	}
+	supported := map[string]bool{}
+	for _, backend := range mtlsInsight.GetSupportedBackends() {
+		supported[backend] = true
+	}
+	if supported[rotation.From] && supported[rotation.To] {
+		rotation.Trusted++
+	}
+	if mtlsInsight.GetIssuedBackend() == rotation.To {
+		rotation.Reissued++
+	}
+}
+
+func updatePermissiveConnections(networking *mesh_proto.Dataplane_Networking, mtlsInsight *mesh_proto.DataplaneInsight_MTLS, connections map[string]*mesh_proto.MeshInsight_MTLS_PermissiveConnections) {
+	if connections == nil {
+		return
+	}
+	services := map[string]bool{}
+	for _, inbound := range networking.GetInbound() {
+		services[inbound.GetService()] = true
+	}
+	for service := range services {
+		stat := connections[service]
+		if stat == nil {
+			stat = &mesh_proto.MeshInsight_MTLS_PermissiveConnections{}
+			connections[service] = stat
+		}
+		stat.Total++
+		if reported, ok := mtlsInsight.GetInboundConnections().GetServices()[service]; ok {
+			stat.Reported++
+			stat.Mtls += reported.GetMtls()
+			stat.Plaintext += reported.GetPlaintext()
+		}
+	}
+}
+
 func updateTotal(version string, dpStats map[string]*mesh_proto.MeshInsight_DataplaneStat) {
 	dpStats[version].Total = dpStats[version].Online + dpStats[version].Offline
 }
@@ -22,6 +22,7 @@ import (
 	test_insights "github.com/kumahq/kuma/pkg/insights/test"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	"github.com/kumahq/kuma/pkg/test/kds/samples"
+	"github.com/kumahq/kuma/pkg/test/matchers"
 )
 
 var _ = Describe("Insight Persistence", func() {
@@ -315,6 +316,119 @@ var _ = Describe("Insight Persistence", func() {
 		Expect(meshInsight.Spec.MTLS.SupportedBackends["ca-2"].Online).To(Equal(uint32(1)))
 	})
 
+	It("should track progress of the CA rotation", func() {
+		// given mesh that rotates from ca-1 to ca-2
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+			EnabledBackend: "ca-1",
+			Backends: []*mesh_proto.CertificateAuthorityBackend{
+				{Name: "ca-1", Type: "builtin"},
+				{Name: "ca-2", Type: "builtin"},
+			},
+			Rotation: &mesh_proto.Mesh_Mtls_Rotation{
+				From: "ca-1",
+				To:   "ca-2",
+			},
+		}
+		err := rm.Create(context.Background(), mesh, store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and dp1 that trusts both CAs
+		err = rm.Create(context.Background(), &core_mesh.DataplaneResource{Spec: samples.Dataplane}, store.CreateByKey("dp1", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+		dp1 := core_mesh.NewDataplaneInsightResource()
+		dp1.Spec.MTLS = &mesh_proto.DataplaneInsight_MTLS{
+			IssuedBackend:     "ca-1",
+			SupportedBackends: []string{"ca-1", "ca-2"},
+		}
+		err = rm.Create(context.Background(), dp1, store.CreateByKey("dp1", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and dp2 that trusts only the previous CA
+		err = rm.Create(context.Background(), &core_mesh.DataplaneResource{Spec: samples.Dataplane}, store.CreateByKey("dp2", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+		dp2 := core_mesh.NewDataplaneInsightResource()
+		dp2.Spec.MTLS = &mesh_proto.DataplaneInsight_MTLS{
+			IssuedBackend:     "ca-1",
+			SupportedBackends: []string{"ca-1"},
+		}
+		err = rm.Create(context.Background(), dp2, store.CreateByKey("dp2", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when resyncer generates insight
+		nowMtx.Lock()
+		now = now.Add(60 * time.Second)
+		nowMtx.Unlock()
+		tickCh <- now
+
+		meshInsight := core_mesh.NewMeshInsightResource()
+		Eventually(func() error {
+			return rm.Get(context.Background(), meshInsight, store.GetByKey("mesh-1", model.NoMesh))
+		}, "10s", "100ms").Should(BeNil())
+
+		// then
+		Expect(meshInsight.Spec.MTLS.CaRotation).To(matchers.MatchProto(&mesh_proto.MeshInsight_MTLS_CaRotation{
+			From:     "ca-1",
+			To:       "ca-2",
+			Phase:    mesh_proto.MeshInsight_MTLS_CaRotation_TRUSTING,
+			Total:    2,
+			Trusted:  1,
+			Reissued: 0,
+		}))
+	})
+
+	It("should count inbound connections of services in PERMISSIVE mode", func() {
+		// given mesh with PERMISSIVE mTLS
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+			EnabledBackend: "ca-1",
+			Backends: []*mesh_proto.CertificateAuthorityBackend{
+				{Name: "ca-1", Type: "builtin", Mode: mesh_proto.CertificateAuthorityBackend_PERMISSIVE},
+			},
+		}
+		err := rm.Create(context.Background(), mesh, store.CreateByKey("mesh-1", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and dp1 that reported connections
+		err = rm.Create(context.Background(), &core_mesh.DataplaneResource{Spec: samples.Dataplane}, store.CreateByKey("dp1", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+		dp1 := core_mesh.NewDataplaneInsightResource()
+		dp1.Spec.MTLS = &mesh_proto.DataplaneInsight_MTLS{
+			IssuedBackend: "ca-1",
+			InboundConnections: &mesh_proto.DataplaneInsight_MTLS_InboundConnections{
+				Services: map[string]*mesh_proto.DataplaneInsight_MTLS_InboundConnections_Connections{
+					"backend": {Mtls: 10, Plaintext: 2},
+				},
+			},
+		}
+		err = rm.Create(context.Background(), dp1, store.CreateByKey("dp1", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and dp2 that did not report connections yet
+		err = rm.Create(context.Background(), &core_mesh.DataplaneResource{Spec: samples.Dataplane}, store.CreateByKey("dp2", "mesh-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when resyncer generates insight
+		nowMtx.Lock()
+		now = now.Add(60 * time.Second)
+		nowMtx.Unlock()
+		tickCh <- now
+
+		meshInsight := core_mesh.NewMeshInsightResource()
+		Eventually(func() error {
+			return rm.Get(context.Background(), meshInsight, store.GetByKey("mesh-1", model.NoMesh))
+		}, "10s", "100ms").Should(BeNil())
+
+		// then
+		Expect(meshInsight.Spec.MTLS.PermissiveConnections).To(HaveLen(1))
+		Expect(meshInsight.Spec.MTLS.PermissiveConnections["backend"]).To(matchers.MatchProto(&mesh_proto.MeshInsight_MTLS_PermissiveConnections{
+			Mtls:      10,
+			Plaintext: 2,
+			Reported:  1,
+			Total:     2,
+		}))
+	})
+
 	It("should not count dataplane as a policy", func() {
 		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
 		Expect(err).ToNot(HaveOccurred())
@@ -10,6 +10,9 @@ func (t *TestEventReader) Recv(stop <-chan struct{}) (events.Event, error) {
 	return <-t.Ch, nil
 }
 
+func (t *TestEventReader) Close() {
+}
+
 type TestEventReaderFactory struct {
 	Reader *TestEventReader
 }
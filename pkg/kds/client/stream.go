@@ -2,6 +2,8 @@ package client
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_sd "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
@@ -10,6 +12,7 @@ import (
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/kds"
 	"github.com/kumahq/kuma/pkg/kds/util"
@@ -26,21 +29,62 @@ type KDSStream interface {
 
 var _ KDSStream = &stream{}
 
+// ResumeVersions are the versions of the resources that were received and applied by the client, by type.
+// They outlive a single stream, so when the client reconnects it sends the versions it already has
+// in the initial DiscoveryRequests and the server sends only the types of resources that changed in the meantime.
+// It is only safe to use them when nothing but the client changes the applied resources between the streams.
+type ResumeVersions struct {
+	sync.RWMutex
+	versions map[string]string
+}
+
+func NewResumeVersions() *ResumeVersions {
+	return &ResumeVersions{
+		versions: map[string]string{},
+	}
+}
+
+// Get returns the version of the resources of the type. It returns an empty version when the resources
+// were not applied yet or when ResumeVersions are nil, which means that all the resources should be sent.
+func (r *ResumeVersions) Get(typ string) string {
+	if r == nil {
+		return ""
+	}
+	r.RLock()
+	defer r.RUnlock()
+	return r.versions[typ]
+}
+
+func (r *ResumeVersions) Set(typ string, version string) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.versions[typ] = version
+}
+
 type stream struct {
 	streamClient   mesh_proto.KumaDiscoveryService_StreamKumaResourcesClient
 	latestACKed    map[string]*envoy_sd.DiscoveryResponse
 	latestReceived map[string]*envoy_sd.DiscoveryResponse
+	resumeVersions *ResumeVersions
 	clientId       string
 	cpConfig       string
+	degradation    *system_proto.KDSDegradation
 }
 
-func NewKDSStream(s mesh_proto.KumaDiscoveryService_StreamKumaResourcesClient, clientId string, cpConfig string) KDSStream {
+// NewKDSStream creates the stream. ResumeVersions can be nil, then all the resources are requested on every stream.
+// Degradation is the degraded operation of the client that preceded the stream, it is reported to the server when it is not nil.
+func NewKDSStream(s mesh_proto.KumaDiscoveryService_StreamKumaResourcesClient, clientId string, cpConfig string, resumeVersions *ResumeVersions, degradation *system_proto.KDSDegradation) KDSStream {
 	return &stream{
 		streamClient:   s,
 		latestACKed:    make(map[string]*envoy_sd.DiscoveryResponse),
 		latestReceived: make(map[string]*envoy_sd.DiscoveryResponse),
+		resumeVersions: resumeVersions,
 		clientId:       clientId,
 		cpConfig:       cpConfig,
+		degradation:    degradation,
 	}
 }
 
@@ -56,22 +100,32 @@ func (s *stream) DiscoveryRequest(resourceType model.ResourceType) error {
 	if err != nil {
 		return err
 	}
+	metadata := &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			kds.MetadataFieldVersion: {Kind: &structpb.Value_StructValue{StructValue: cpVersion}},
+			kds.MetadataFieldConfig:  {Kind: &structpb.Value_StringValue{StringValue: s.cpConfig}},
+			kds.MetadataFieldTime:    timeValue(),
+			kds.MetadataFeatures: {Kind: &structpb.Value_ListValue{ListValue: &structpb.ListValue{
+				Values: []*structpb.Value{
+					{Kind: &structpb.Value_StringValue{StringValue: kds.FeatureZoneToken}},
+				},
+			}}},
+		},
+	}
+	if s.degradation != nil {
+		degradation, err := util_proto.ToStruct(s.degradation)
+		if err != nil {
+			return err
+		}
+		metadata.Fields[kds.MetadataFieldDegradation] = &structpb.Value{Kind: &structpb.Value_StructValue{StructValue: degradation}}
+	}
 	return s.streamClient.Send(&envoy_sd.DiscoveryRequest{
-		VersionInfo:   "",
+		// the server does not respond until the resources of the type differ from the version the client already has
+		VersionInfo:   s.resumeVersions.Get(string(resourceType)),
 		ResponseNonce: "",
 		Node: &envoy_core.Node{
-			Id: s.clientId,
-			Metadata: &structpb.Struct{
-				Fields: map[string]*structpb.Value{
-					kds.MetadataFieldVersion: {Kind: &structpb.Value_StructValue{StructValue: cpVersion}},
-					kds.MetadataFieldConfig:  {Kind: &structpb.Value_StringValue{StringValue: s.cpConfig}},
-					kds.MetadataFeatures: {Kind: &structpb.Value_ListValue{ListValue: &structpb.ListValue{
-						Values: []*structpb.Value{
-							{Kind: &structpb.Value_StringValue{StringValue: kds.FeatureZoneToken}},
-						},
-					}}},
-				},
-			},
+			Id:       s.clientId,
+			Metadata: metadata,
 		},
 		ResourceNames: []string{},
 		TypeUrl:       string(resourceType),
@@ -101,12 +155,14 @@ func (s *stream) ACK(typ string) error {
 		ResponseNonce: latestReceived.Nonce,
 		ResourceNames: []string{},
 		Node: &envoy_core.Node{
-			Id: s.clientId,
+			Id:       s.clientId,
+			Metadata: timeMetadata(),
 		},
 		TypeUrl: typ,
 	})
 	if err == nil {
 		s.latestACKed[typ] = latestReceived
+		s.resumeVersions.Set(typ, latestReceived.VersionInfo)
 	}
 	return err
 }
@@ -123,10 +179,23 @@ func (s *stream) NACK(typ string, err error) error {
 		ResourceNames: []string{},
 		TypeUrl:       typ,
 		Node: &envoy_core.Node{
-			Id: s.clientId,
+			Id:       s.clientId,
+			Metadata: timeMetadata(),
 		},
 		ErrorDetail: &status.Status{
 			Message: fmt.Sprintf("%s", err),
 		},
 	})
 }
+
+func timeMetadata() *structpb.Struct {
+	return &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			kds.MetadataFieldTime: timeValue(),
+		},
+	}
+}
+
+func timeValue() *structpb.Value {
+	return &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: core.Now().Format(time.RFC3339Nano)}}
+}
@@ -0,0 +1,78 @@
+package client_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/kds/client"
+	test_grpc "github.com/kumahq/kuma/pkg/test/grpc"
+	"github.com/kumahq/kuma/pkg/test/kds/samples"
+	kds_verifier "github.com/kumahq/kuma/pkg/test/kds/verifier"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+var _ = Describe("KDS Stream", func() {
+
+	var resumeVersions *client.ResumeVersions
+
+	BeforeEach(func() {
+		resumeVersions = client.NewResumeVersions()
+	})
+
+	receive := func(mockClientStream *test_grpc.MockClientStream, kdsStream client.KDSStream, nonce, version string) {
+		err := kds_verifier.DiscoveryResponse(&mesh.MeshResourceList{Items: []*mesh.MeshResource{
+			{Meta: &test_model.ResourceMeta{Name: "mesh1"}, Spec: samples.Mesh1},
+		}}, nonce, version)(&kds_verifier.TestContextImpl{MockClientStream: mockClientStream})
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = kdsStream.Receive()
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("should resume from the version ACKed on the previous stream", func() {
+		// given
+		previous := test_grpc.MakeMockClientStream()
+		previousKdsStream := client.NewKDSStream(previous, "zone-1", "", resumeVersions, nil)
+		Expect(previousKdsStream.DiscoveryRequest(mesh.MeshType)).To(Succeed())
+		Expect((<-previous.SentCh).VersionInfo).To(BeEmpty())
+		receive(previous, previousKdsStream, "1", "v1")
+		Expect(previousKdsStream.ACK(string(mesh.MeshType))).To(Succeed())
+		<-previous.SentCh
+
+		// and the rejected version is not resumed
+		receive(previous, previousKdsStream, "2", "v2")
+		Expect(previousKdsStream.NACK(string(mesh.MeshType), errors.New("invalid"))).To(Succeed())
+		<-previous.SentCh
+
+		// when
+		next := test_grpc.MakeMockClientStream()
+		Expect(client.NewKDSStream(next, "zone-1", "", resumeVersions, nil).DiscoveryRequest(mesh.MeshType)).To(Succeed())
+		Expect(client.NewKDSStream(next, "zone-1", "", resumeVersions, nil).DiscoveryRequest(mesh.DataplaneType)).To(Succeed())
+
+		// then
+		req := <-next.SentCh
+		Expect(req.TypeUrl).To(Equal(string(mesh.MeshType)))
+		Expect(req.VersionInfo).To(Equal("v1"))
+		Expect(req.ResponseNonce).To(BeEmpty())
+		req = <-next.SentCh
+		Expect(req.TypeUrl).To(Equal(string(mesh.DataplaneType)))
+		Expect(req.VersionInfo).To(BeEmpty())
+	})
+
+	It("should request all the resources without resume versions", func() {
+		// given
+		mockClientStream := test_grpc.MakeMockClientStream()
+		kdsStream := client.NewKDSStream(mockClientStream, "zone-1", "", nil, nil)
+		receive(mockClientStream, kdsStream, "1", "v1")
+		Expect(kdsStream.ACK(string(mesh.MeshType))).To(Succeed())
+		<-mockClientStream.SentCh
+
+		// when
+		Expect(client.NewKDSStream(mockClientStream, "zone-1", "", nil, nil).DiscoveryRequest(mesh.MeshType)).To(Succeed())
+
+		// then
+		Expect((<-mockClientStream.SentCh).VersionInfo).To(BeEmpty())
+	})
+})
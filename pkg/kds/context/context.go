@@ -45,7 +45,9 @@ type Context struct {
 	EnvoyAdminRPCs service.EnvoyAdminRPCs
 }
 
-func DefaultContext(manager manager.ResourceManager, zone string) *Context {
+// DefaultContext builds the context of KDS. The resource manager is used by the filters on every reconciliation,
+// so it should be the cached read-only manager.
+func DefaultContext(manager manager.ReadOnlyResourceManager, zone string) *Context {
 	configs := map[string]bool{
 		config_manager.ClusterIdConfigKey: true,
 	}
@@ -111,7 +113,7 @@ func MapInsightResourcesZeroGeneration(r model.Resource) (model.Resource, error)
 
 func MapZoneTokenSigningKeyGlobalToPublicKey(
 	_ context.Context,
-	_ manager.ResourceManager,
+	_ manager.ReadOnlyResourceManager,
 ) reconcile.ResourceMapper {
 	return func(r model.Resource) (model.Resource, error) {
 		resType := r.Descriptor().Name
@@ -147,11 +149,15 @@ func MapZoneTokenSigningKeyGlobalToPublicKey(
 }
 
 // GlobalProvidedFilter returns ResourceFilter which filters Resources provided by Global, specifically
-// excludes Dataplanes, Ingresses and Egresses from 'clusterID' cluster
-func GlobalProvidedFilter(rm manager.ResourceManager, configs map[string]bool) reconcile.ResourceFilter {
-	return func(clusterID string, features kds.Features, r model.Resource) bool {
+// excludes Dataplanes, Ingresses and Egresses from 'clusterID' cluster and meshes of other tenants than the tenant of the zone
+func GlobalProvidedFilter(rm manager.ReadOnlyResourceManager, configs map[string]bool) reconcile.ResourceFilter {
+	return func(ctx context.Context, clusterID string, features kds.Features, r model.Resource) bool {
 		resName := r.GetMeta().GetName()
 
+		if !inTenantOfZone(ctx, rm, clusterID, r) {
+			return false
+		}
+
 		switch r.Descriptor().Name {
 		case mesh.DataplaneType:
 			return false
@@ -175,7 +181,7 @@ func GlobalProvidedFilter(rm manager.ResourceManager, configs map[string]bool) r
 			}
 
 			zone := system.NewZoneResource()
-			if err := rm.Get(context.Background(), zone, store.GetByKey(zoneTag, model.NoMesh)); err != nil {
+			if err := rm.Get(ctx, zone, store.GetByKey(zoneTag, model.NoMesh)); err != nil {
 				log.Error(err, "failed to get zone", "zone", zoneTag)
 				// since there is no explicit 'enabled: false' then we don't
 				// make any strong decisions which might affect connectivity
@@ -189,10 +195,49 @@ func GlobalProvidedFilter(rm manager.ResourceManager, configs map[string]bool) r
 	}
 }
 
+// inTenantOfZone returns whether the resource can be synced to the zone. Zones with a tenant receive only meshes
+// of the tenant and resources of these meshes. Zones without a tenant receive all meshes.
+// Meshes are not synced to the zone that does not exist, because the tenant of the zone is unknown.
+func inTenantOfZone(ctx context.Context, rm manager.ReadOnlyResourceManager, clusterID string, r model.Resource) bool {
+	var meshName string
+	switch {
+	case r.Descriptor().Name == mesh.MeshType:
+		meshName = r.GetMeta().GetName()
+	case r.Descriptor().Scope == model.ScopeMesh:
+		meshName = r.GetMeta().GetMesh()
+	default:
+		return true
+	}
+
+	zone := system.NewZoneResource()
+	if err := rm.Get(ctx, zone, store.GetByKey(clusterID, model.NoMesh)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			log.Error(err, "failed to get zone", "zone", clusterID)
+		}
+		// the isolation of tenants cannot be guaranteed without the tenant of the zone
+		return false
+	}
+	if zone.Spec.GetTenant() == "" {
+		return true
+	}
+
+	meshRes, ok := r.(*mesh.MeshResource)
+	if !ok {
+		meshRes = mesh.NewMeshResource()
+		if err := rm.Get(ctx, meshRes, store.GetByKey(meshName, model.NoMesh)); err != nil {
+			if !store.IsResourceNotFound(err) {
+				log.Error(err, "failed to get mesh", "mesh", meshName)
+			}
+			return false
+		}
+	}
+	return meshRes.Spec.GetTenant() == zone.Spec.GetTenant()
+}
+
 // ZoneProvidedFilter filter Resources provided by Zone, specifically Ingresses
 // that belongs to another zones
 func ZoneProvidedFilter(clusterName string) reconcile.ResourceFilter {
-	return func(_ string, _ kds.Features, r model.Resource) bool {
+	return func(_ context.Context, _ string, _ kds.Features, r model.Resource) bool {
 		switch r.Descriptor().Name {
 		case mesh.DataplaneType:
 			return clusterName == util.ZoneTag(r)
@@ -249,7 +249,7 @@ var _ = Describe("Context", func() {
 			}
 
 			// when
-			ok := predicate(clusterID, kds.Features{}, dp)
+			ok := predicate(stdcontext.Background(), clusterID, kds.Features{}, dp)
 
 			// then
 			Expect(ok).To(BeFalse())
@@ -269,13 +269,13 @@ var _ = Describe("Context", func() {
 			}
 
 			// when
-			ok := predicate(clusterID, kds.Features{}, config1)
+			ok := predicate(stdcontext.Background(), clusterID, kds.Features{}, config1)
 
 			// then
 			Expect(ok).To(BeTrue())
 
 			// when
-			ok = predicate(clusterID, kds.Features{}, config2)
+			ok = predicate(stdcontext.Background(), clusterID, kds.Features{}, config2)
 
 			// then
 			Expect(ok).To(BeFalse())
@@ -284,7 +284,7 @@ var _ = Describe("Context", func() {
 		DescribeTable("global secrets",
 			func(given testCase) {
 				// when
-				ok := predicate(clusterID, kds.Features{
+				ok := predicate(stdcontext.Background(), clusterID, kds.Features{
 					kds.FeatureZoneToken: true,
 				}, given.resource)
 
@@ -329,7 +329,7 @@ var _ = Describe("Context", func() {
 				}
 
 				// when
-				ok := predicate(clusterID, kds.Features{}, given.resource)
+				ok := predicate(stdcontext.Background(), clusterID, kds.Features{}, given.resource)
 
 				// then
 				Expect(ok).To(Equal(given.expect))
@@ -388,6 +388,10 @@ var _ = Describe("Context", func() {
 		)
 
 		Context("global provided resources", func() {
+			BeforeEach(func() {
+				Expect(rm.Create(stdcontext.Background(), core_system.NewZoneResource(), core_store.CreateByKey(clusterID, model.NoMesh))).To(Succeed())
+			})
+
 			// we are ignoring this types, as we should already test them in
 			// earlier tests
 			ignoreTypes := map[model.ResourceType]struct{}{
@@ -419,7 +423,7 @@ var _ = Describe("Context", func() {
 			DescribeTable("returned predicate function",
 				func(given testCase) {
 					// when
-					ok := predicate(clusterID, kds.Features{}, given.resource)
+					ok := predicate(stdcontext.Background(), clusterID, kds.Features{}, given.resource)
 
 					// then
 					Expect(ok).To(BeTrue())
@@ -427,5 +431,53 @@ var _ = Describe("Context", func() {
 				entries,
 			)
 		})
+
+		Context("zone of a tenant", func() {
+			BeforeEach(func() {
+				Expect(rm.Create(stdcontext.Background(), &core_system.ZoneResource{
+					Spec: &system_proto.Zone{Tenant: "team-a"},
+				}, core_store.CreateByKey(clusterID, model.NoMesh))).To(Succeed())
+				for name, tenant := range map[string]string{"mesh-a": "team-a", "mesh-b": "team-b"} {
+					Expect(rm.Create(stdcontext.Background(), &core_mesh.MeshResource{
+						Spec: &mesh_proto.Mesh{Tenant: tenant},
+					}, core_store.CreateByKey(name, model.NoMesh))).To(Succeed())
+				}
+			})
+
+			meshResource := func(name string, tenant string) model.Resource {
+				return &core_mesh.MeshResource{
+					Meta: &test_model.ResourceMeta{Name: name},
+					Spec: &mesh_proto.Mesh{Tenant: tenant},
+				}
+			}
+
+			trafficRoute := func(mesh string) model.Resource {
+				return &core_mesh.TrafficRouteResource{
+					Meta: &test_model.ResourceMeta{Name: "tr-1", Mesh: mesh},
+					Spec: &mesh_proto.TrafficRoute{},
+				}
+			}
+
+			It("should sync only meshes of the tenant and their resources", func() {
+				Expect(predicate(stdcontext.Background(), clusterID, kds.Features{}, meshResource("mesh-a", "team-a"))).To(BeTrue())
+				Expect(predicate(stdcontext.Background(), clusterID, kds.Features{}, trafficRoute("mesh-a"))).To(BeTrue())
+				Expect(predicate(stdcontext.Background(), clusterID, kds.Features{}, meshResource("mesh-b", "team-b"))).To(BeFalse())
+				Expect(predicate(stdcontext.Background(), clusterID, kds.Features{}, trafficRoute("mesh-b"))).To(BeFalse())
+			})
+
+			It("should sync meshes of all tenants to zones without a tenant", func() {
+				// given
+				Expect(rm.Create(stdcontext.Background(), core_system.NewZoneResource(), core_store.CreateByKey("other-zone", model.NoMesh))).To(Succeed())
+
+				// then
+				Expect(predicate(stdcontext.Background(), "other-zone", kds.Features{}, meshResource("mesh-b", "team-b"))).To(BeTrue())
+				Expect(predicate(stdcontext.Background(), "other-zone", kds.Features{}, trafficRoute("mesh-b"))).To(BeTrue())
+			})
+
+			It("should not sync meshes to zones that do not exist", func() {
+				Expect(predicate(stdcontext.Background(), "unknown-zone", kds.Features{}, meshResource("mesh-a", "team-a"))).To(BeFalse())
+				Expect(predicate(stdcontext.Background(), "unknown-zone", kds.Features{}, trafficRoute("mesh-a"))).To(BeFalse())
+			})
+		})
 	})
 })
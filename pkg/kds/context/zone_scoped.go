@@ -0,0 +1,107 @@
+package context
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/kds"
+	"github.com/kumahq/kuma/pkg/kds/reconcile"
+)
+
+type connectionPolicy interface {
+	Sources() []*mesh_proto.Selector
+	Destinations() []*mesh_proto.Selector
+}
+
+type dataplanePolicy interface {
+	Selectors() []*mesh_proto.Selector
+}
+
+// CompositeResourceFilter combines the given ResourceFilters into a single ResourceFilter
+// which syncs the resource only when all of them do.
+func CompositeResourceFilter(filters ...reconcile.ResourceFilter) reconcile.ResourceFilter {
+	return func(ctx context.Context, clusterID string, features kds.Features, r model.Resource) bool {
+		for _, filter := range filters {
+			if !filter(ctx, clusterID, features, r) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// ZoneScopedFilter returns ResourceFilter which syncs policies of the given types only to the zones with dataplanes
+// selected by the policies, either as sources or as destinations. Resources of other types are not filtered.
+// Policies are synced to the zone only after the dataplanes they select are synced from the zone to Global,
+// so a new service of the zone runs without its policies until then.
+func ZoneScopedFilter(rm manager.ReadOnlyResourceManager, types []model.ResourceType) (reconcile.ResourceFilter, error) {
+	scoped := map[model.ResourceType]bool{}
+	for _, typ := range types {
+		desc, err := registry.Global().DescriptorFor(typ)
+		if err != nil {
+			return nil, err
+		}
+		if !desc.KDSFlags.Has(model.FromGlobalToZone) {
+			return nil, errors.Errorf("%s is not synced from Global to zones", typ)
+		}
+		if selectorsOf(desc.Resource) == nil {
+			return nil, errors.Errorf("%s cannot be zone scoped, only policies that select dataplanes can", typ)
+		}
+		scoped[typ] = true
+	}
+	return func(ctx context.Context, clusterID string, _ kds.Features, r model.Resource) bool {
+		if !scoped[r.Descriptor().Name] {
+			return true
+		}
+		tagSets, err := dataplaneTagSetsOfZone(ctx, rm, r.GetMeta().GetMesh(), clusterID)
+		if err != nil {
+			log.Error(err, "failed to get dataplanes of the zone", "zone", clusterID, "mesh", r.GetMeta().GetMesh())
+			// the policy is synced so the traffic in the zone is not affected by the error
+			return true
+		}
+		for _, selector := range selectorsOf(r) {
+			for _, tags := range tagSets {
+				if mesh_proto.TagSelector(selector.GetMatch()).Matches(tags) {
+					return true
+				}
+			}
+		}
+		return false
+	}, nil
+}
+
+// selectorsOf returns selectors of the policy or nil when the resource is not a policy that selects dataplanes.
+func selectorsOf(r model.Resource) []*mesh_proto.Selector {
+	switch policy := r.(type) {
+	case connectionPolicy:
+		return append(append([]*mesh_proto.Selector{}, policy.Sources()...), policy.Destinations()...)
+	case dataplanePolicy:
+		return append([]*mesh_proto.Selector{}, policy.Selectors()...)
+	default:
+		return nil
+	}
+}
+
+// dataplaneTagSetsOfZone returns tags of inbounds and gateways of dataplanes of the mesh that were synced from the zone.
+func dataplaneTagSetsOfZone(ctx context.Context, rm manager.ReadOnlyResourceManager, meshName string, zone string) ([]mesh_proto.SingleValueTagSet, error) {
+	dataplanes := mesh.DataplaneResourceList{}
+	if err := rm.List(ctx, &dataplanes, store.ListByMesh(meshName)); err != nil {
+		return nil, err
+	}
+	var tagSets []mesh_proto.SingleValueTagSet
+	for _, dataplane := range dataplanes.Items {
+		for _, tags := range dataplane.Spec.SingleValueTagSets() {
+			if tags[mesh_proto.ZoneTag] == zone {
+				tagSets = append(tagSets, tags)
+			}
+		}
+	}
+	return tagSets, nil
+}
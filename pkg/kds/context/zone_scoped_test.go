@@ -0,0 +1,144 @@
+package context_test
+
+import (
+	stdcontext "context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/kds"
+	"github.com/kumahq/kuma/pkg/kds/context"
+	"github.com/kumahq/kuma/pkg/kds/reconcile"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+)
+
+var _ = Describe("ZoneScopedFilter", func() {
+	var predicate reconcile.ResourceFilter
+
+	BeforeEach(func() {
+		rm := manager.NewResourceManager(memory.NewStore())
+		Expect(rm.Create(stdcontext.Background(), core_mesh.NewMeshResource(), core_store.CreateByKey("default", model.NoMesh))).To(Succeed())
+		for name, tags := range map[string]map[string]string{
+			"web-1":     {mesh_proto.ServiceTag: "web", mesh_proto.ZoneTag: "zone-1"},
+			"backend-1": {mesh_proto.ServiceTag: "backend", mesh_proto.ZoneTag: "zone-1", "version": "v1"},
+			"backend-2": {mesh_proto.ServiceTag: "backend", mesh_proto.ZoneTag: "zone-2", "version": "v2"},
+		} {
+			Expect(rm.Create(stdcontext.Background(), &core_mesh.DataplaneResource{
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{Port: 8080, Tags: tags}},
+					},
+				},
+			}, core_store.CreateByKey(name, "default"))).To(Succeed())
+		}
+
+		var err error
+		predicate, err = context.ZoneScopedFilter(rm, []model.ResourceType{core_mesh.TrafficPermissionType, core_mesh.ProxyTemplateType})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	selector := func(tags ...string) *mesh_proto.Selector {
+		match := map[string]string{}
+		for i := 0; i < len(tags); i += 2 {
+			match[tags[i]] = tags[i+1]
+		}
+		return &mesh_proto.Selector{Match: match}
+	}
+
+	trafficPermission := func(source, destination *mesh_proto.Selector) model.Resource {
+		return &core_mesh.TrafficPermissionResource{
+			Meta: &test_model.ResourceMeta{Name: "tp-1", Mesh: "default"},
+			Spec: &mesh_proto.TrafficPermission{
+				Sources:      []*mesh_proto.Selector{source},
+				Destinations: []*mesh_proto.Selector{destination},
+			},
+		}
+	}
+
+	type testCase struct {
+		resource model.Resource
+		zone     string
+		expected bool
+	}
+
+	DescribeTable("should sync policies only to zones with selected dataplanes",
+		func(given testCase) {
+			Expect(predicate(stdcontext.Background(), given.zone, kds.Features{}, given.resource)).To(Equal(given.expected))
+		},
+		Entry("source in the zone", testCase{
+			resource: trafficPermission(selector(mesh_proto.ServiceTag, "web"), selector(mesh_proto.ServiceTag, "other")),
+			zone:     "zone-1",
+			expected: true,
+		}),
+		Entry("destination in the zone", testCase{
+			resource: trafficPermission(selector(mesh_proto.ServiceTag, "other"), selector(mesh_proto.ServiceTag, "backend")),
+			zone:     "zone-2",
+			expected: true,
+		}),
+		Entry("neither source nor destination in the zone", testCase{
+			resource: trafficPermission(selector(mesh_proto.ServiceTag, "web"), selector(mesh_proto.ServiceTag, "web")),
+			zone:     "zone-2",
+			expected: false,
+		}),
+		Entry("selector of the zone tag", testCase{
+			resource: trafficPermission(selector(mesh_proto.ServiceTag, "*", mesh_proto.ZoneTag, "zone-1"), selector(mesh_proto.ServiceTag, "web")),
+			zone:     "zone-2",
+			expected: false,
+		}),
+		Entry("selector of other tags", testCase{
+			resource: trafficPermission(selector(mesh_proto.ServiceTag, "backend", "version", "v2"), selector(mesh_proto.ServiceTag, "other")),
+			zone:     "zone-1",
+			expected: false,
+		}),
+		Entry("wildcard selector", testCase{
+			resource: trafficPermission(selector(mesh_proto.ServiceTag, "*"), selector(mesh_proto.ServiceTag, "other")),
+			zone:     "zone-2",
+			expected: true,
+		}),
+		Entry("zone without dataplanes", testCase{
+			resource: trafficPermission(selector(mesh_proto.ServiceTag, "*"), selector(mesh_proto.ServiceTag, "*")),
+			zone:     "zone-3",
+			expected: false,
+		}),
+		Entry("dataplane policy", testCase{
+			resource: &core_mesh.ProxyTemplateResource{
+				Meta: &test_model.ResourceMeta{Name: "pt-1", Mesh: "default"},
+				Spec: &mesh_proto.ProxyTemplate{Selectors: []*mesh_proto.Selector{selector(mesh_proto.ServiceTag, "web")}},
+			},
+			zone:     "zone-2",
+			expected: false,
+		}),
+		Entry("type that is not zone scoped", testCase{
+			resource: &core_mesh.TrafficRouteResource{
+				Meta: &test_model.ResourceMeta{Name: "tr-1", Mesh: "default"},
+				Spec: &mesh_proto.TrafficRoute{
+					Sources:      []*mesh_proto.Selector{selector(mesh_proto.ServiceTag, "web")},
+					Destinations: []*mesh_proto.Selector{selector(mesh_proto.ServiceTag, "web")},
+				},
+			},
+			zone:     "zone-2",
+			expected: true,
+		}),
+	)
+
+	It("should reject types that cannot be zone scoped", func() {
+		// when
+		_, err := context.ZoneScopedFilter(manager.NewResourceManager(memory.NewStore()), []model.ResourceType{core_mesh.MeshType})
+
+		// then
+		Expect(err).To(MatchError("Mesh cannot be zone scoped, only policies that select dataplanes can"))
+
+		// when
+		_, err = context.ZoneScopedFilter(manager.NewResourceManager(memory.NewStore()), []model.ResourceType{core_mesh.DataplaneType})
+
+		// then
+		Expect(err).To(MatchError("Dataplane is not synced from Global to zones"))
+	})
+})
@@ -19,6 +19,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/kds/client"
+	kds_context "github.com/kumahq/kuma/pkg/kds/context"
 	"github.com/kumahq/kuma/pkg/kds/mux"
 	kds_server "github.com/kumahq/kuma/pkg/kds/server"
 	"github.com/kumahq/kuma/pkg/kds/service"
@@ -39,9 +40,17 @@ func Setup(rt runtime.Runtime) (err error) {
 		return nil
 	}
 	reg := registry.Global()
+	var zoneScopedTypes []model.ResourceType
+	for _, typ := range rt.Config().Multizone.Global.KDS.ZoneScopedTypes {
+		zoneScopedTypes = append(zoneScopedTypes, model.ResourceType(typ))
+	}
+	zoneScopedFilter, err := kds_context.ZoneScopedFilter(rt.ReadOnlyResourceManager(), zoneScopedTypes)
+	if err != nil {
+		return errors.Wrap(err, "invalid zone scoped types")
+	}
 	kdsServer, err := kds_server.New(kdsGlobalLog, rt, reg.ObjectTypes(model.HasKDSFlag(model.ProvidedByGlobal)),
 		"global", rt.Config().Multizone.Global.KDS.RefreshInterval,
-		rt.KDSContext().GlobalProvidedFilter, rt.KDSContext().GlobalResourceMapper, true)
+		kds_context.CompositeResourceFilter(rt.KDSContext().GlobalProvidedFilter, zoneScopedFilter), rt.KDSContext().GlobalResourceMapper, true)
 	if err != nil {
 		return err
 	}
@@ -50,6 +59,11 @@ func Setup(rt runtime.Runtime) (err error) {
 	onSessionStarted := mux.OnSessionStartedFunc(func(session mux.Session) error {
 		log := kdsGlobalLog.WithValues("peer-id", session.PeerID())
 		log.Info("new session created")
+		// the zone is created before resources are streamed to it, because only meshes of the tenant of the zone are synced
+		if err := createZoneIfAbsent(session.PeerID(), rt.ResourceManager()); err != nil {
+			log.Error(err, "Global CP could not create a zone")
+			return errors.New("Global CP could not create a zone") // send back message without details. Zone CP will retry
+		}
 		go func() {
 			if err := kdsServer.StreamKumaResources(session.ServerStream()); err != nil {
 				log.Error(err, "StreamKumaResources finished with an error")
@@ -57,11 +71,8 @@ func Setup(rt runtime.Runtime) (err error) {
 				log.V(1).Info("StreamKumaResources finished gracefully")
 			}
 		}()
-		kdsStream := client.NewKDSStream(session.ClientStream(), session.PeerID(), "") // we only care about Zone CP config. Zone CP should not receive Global CP config.
-		if err := createZoneIfAbsent(session.PeerID(), rt.ResourceManager()); err != nil {
-			log.Error(err, "Global CP could not create a zone")
-			return errors.New("Global CP could not create a zone") // send back message without details. Zone CP will retry
-		}
+		// resources of the zone can be changed in Global without the stream, for example when the zone is deleted, so they are not resumed
+		kdsStream := client.NewKDSStream(session.ClientStream(), session.PeerID(), "", nil, nil) // we only care about Zone CP config. Zone CP should not receive Global CP config.
 		sink := client.NewKDSSink(log, reg.ObjectTypes(model.HasKDSFlag(model.ConsumedByGlobal)), kdsStream, Callbacks(resourceSyncer, rt.Config().Store.Type == store_config.KubernetesStore, kubeFactory))
 		go func() {
 			if err := sink.Receive(); err != nil {
@@ -178,12 +178,14 @@ var _ = Describe("Global Sync", func() {
 			return !excludeTypes[descriptor.Name]
 		}))
 
-		// plus 4 global-scope types
+		// plus 6 global-scope types
 		extraTypes := []model.ResourceType{
 			mesh.MeshType,
 			mesh.ZoneIngressType,
 			system.ConfigType,
 			system.GlobalSecretType,
+			system.AccessRoleType,
+			system.AccessRoleBindingType,
 		}
 
 		actualProvidedTypes = append(actualProvidedTypes, extraTypes...)
@@ -108,6 +108,8 @@ func (c *client) Start(stop <-chan struct{}) (errs error) {
 	go c.startXDSConfigs(withKDSCtx, log, conn, stop, errorCh)
 	go c.startStats(withKDSCtx, log, conn, stop, errorCh)
 	go c.startClusters(withKDSCtx, log, conn, stop, errorCh)
+	go c.startTailLogs(withKDSCtx, log, conn, stop, errorCh)
+	go c.startProxyInfo(withKDSCtx, log, conn, stop, errorCh)
 
 	select {
 	case <-stop:
@@ -213,6 +215,48 @@ func (c *client) startClusters(
 	c.handleProcessingErrors(stream, log, stop, processingErrorsCh, errorCh)
 }
 
+func (c *client) startTailLogs(
+	ctx context.Context,
+	log logr.Logger,
+	conn *grpc.ClientConn,
+	stop <-chan struct{},
+	errorCh chan error,
+) {
+	client := mesh_proto.NewGlobalKDSServiceClient(conn)
+	log = log.WithValues("rpc", "tail-logs")
+	log.Info("initializing rpc stream for tailing traffic of data plane proxies")
+	stream, err := client.StreamTailLogs(ctx)
+	if err != nil {
+		errorCh <- err
+		return
+	}
+
+	processingErrorsCh := make(chan error)
+	go c.envoyAdminProcessor.StartProcessingTailLogs(stream, processingErrorsCh)
+	c.handleProcessingErrors(stream, log, stop, processingErrorsCh, errorCh)
+}
+
+func (c *client) startProxyInfo(
+	ctx context.Context,
+	log logr.Logger,
+	conn *grpc.ClientConn,
+	stop <-chan struct{},
+	errorCh chan error,
+) {
+	client := mesh_proto.NewGlobalKDSServiceClient(conn)
+	log = log.WithValues("rpc", "proxy-info")
+	log.Info("initializing rpc stream for reading information about data plane proxies")
+	stream, err := client.StreamProxyInfo(ctx)
+	if err != nil {
+		errorCh <- err
+		return
+	}
+
+	processingErrorsCh := make(chan error)
+	go c.envoyAdminProcessor.StartProcessingProxyInfo(stream, processingErrorsCh)
+	c.handleProcessingErrors(stream, log, stop, processingErrorsCh, errorCh)
+}
+
 func (c *client) handleProcessingErrors(
 	stream grpc.ClientStream,
 	log logr.Logger,
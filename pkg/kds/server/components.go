@@ -23,7 +23,8 @@ import (
 func New(log logr.Logger, rt core_runtime.Runtime, providedTypes []model.ResourceType, serverID string, refresh time.Duration, filter reconcile.ResourceFilter, mapper reconcile.ResourceMapper, insight bool) (Server, error) {
 	hasher, cache := newKDSContext(log)
 	generator := reconcile.NewSnapshotGenerator(rt.ReadOnlyResourceManager(), providedTypes, filter, mapper)
-	versioner := util_xds_v3.SnapshotAutoVersioner{UUID: core.NewUUID}
+	// versions are stable across the streams and the instances of the Control Plane, so reconnected clients can resume the sync
+	versioner := util_xds_v3.SnapshotHashVersioner{UUID: core.NewUUID}
 	statsCallbacks, err := util_xds.NewStatsCallbacks(rt.Metrics(), "kds")
 	if err != nil {
 		return nil, err
@@ -59,6 +59,8 @@ var _ = Describe("KDS Server", func() {
 		// Do not forget to update this test after updating protobuf `KumaKdsOptions`.
 		Expect(registry.Global().ObjectTypes(model.HasKdsEnabled())).
 			To(HaveLen(len([]proto.Message{
+				kds_samples.AccessRole,
+				kds_samples.AccessRoleBinding,
 				kds_samples.CircuitBreaker,
 				kds_samples.Dataplane,
 				kds_samples.DataplaneInsight,
@@ -67,7 +69,18 @@ var _ = Describe("KDS Server", func() {
 				kds_samples.FaultInjection,
 				kds_samples.GlobalSecret,
 				kds_samples.HealthCheck,
+				kds_samples.LocalReply,
 				kds_samples.Mesh1,
+				kds_samples.MeshBandwidthLimit,
+				kds_samples.MeshCORS,
+				kds_samples.MeshCompression,
+				kds_samples.MeshExternalAuthz,
+				kds_samples.MeshHeaderModifier,
+				kds_samples.MeshJWT,
+				kds_samples.MeshProtocolOptions,
+				kds_samples.MeshRequestProtection,
+				kds_samples.MeshWasmPlugin,
+				kds_samples.MeshZoneTrafficRoute,
 				kds_samples.ProxyTemplate,
 				kds_samples.RateLimit,
 				kds_samples.Retry,
@@ -100,7 +113,18 @@ var _ = Describe("KDS Server", func() {
 			Exec(kds_verifier.Create(ctx, &mesh.ExternalServiceResource{Spec: kds_samples.ExternalService}, store.CreateByKey("es-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.FaultInjectionResource{Spec: kds_samples.FaultInjection}, store.CreateByKey("fi-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.HealthCheckResource{Spec: kds_samples.HealthCheck}, store.CreateByKey("hc-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.LocalReplyResource{Spec: kds_samples.LocalReply}, store.CreateByKey("lr-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.MeshResource{Spec: kds_samples.Mesh1}, store.CreateByKey("mesh-1", model.NoMesh))).
+			Exec(kds_verifier.Create(ctx, &mesh.MeshBandwidthLimitResource{Spec: kds_samples.MeshBandwidthLimit}, store.CreateByKey("bl-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.MeshCORSResource{Spec: kds_samples.MeshCORS}, store.CreateByKey("cors-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.MeshCompressionResource{Spec: kds_samples.MeshCompression}, store.CreateByKey("mc-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.MeshExternalAuthzResource{Spec: kds_samples.MeshExternalAuthz}, store.CreateByKey("ea-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.MeshHeaderModifierResource{Spec: kds_samples.MeshHeaderModifier}, store.CreateByKey("hm-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.MeshJWTResource{Spec: kds_samples.MeshJWT}, store.CreateByKey("jwt-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.MeshProtocolOptionsResource{Spec: kds_samples.MeshProtocolOptions}, store.CreateByKey("po-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.MeshRequestProtectionResource{Spec: kds_samples.MeshRequestProtection}, store.CreateByKey("rp-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.MeshWasmPluginResource{Spec: kds_samples.MeshWasmPlugin}, store.CreateByKey("wp-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.MeshZoneTrafficRouteResource{Spec: kds_samples.MeshZoneTrafficRoute}, store.CreateByKey("ztr-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.ProxyTemplateResource{Spec: kds_samples.ProxyTemplate}, store.CreateByKey("pt-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.RateLimitResource{Spec: kds_samples.RateLimit}, store.CreateByKey("rl-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.RetryResource{Spec: kds_samples.Retry}, store.CreateByKey("retry-1", "mesh-1"))).
@@ -110,6 +134,8 @@ var _ = Describe("KDS Server", func() {
 			Exec(kds_verifier.Create(ctx, &mesh.TrafficRouteResource{Spec: kds_samples.TrafficRoute}, store.CreateByKey("tr-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &mesh.TrafficTraceResource{Spec: kds_samples.TrafficTrace}, store.CreateByKey("tt-1", "mesh-1"))).
 			Exec(kds_verifier.Create(ctx, &system.SecretResource{Spec: kds_samples.Secret}, store.CreateByKey("s-1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &system.AccessRoleResource{Spec: kds_samples.AccessRole}, store.CreateByKey("ar-1", model.NoMesh))).
+			Exec(kds_verifier.Create(ctx, &system.AccessRoleBindingResource{Spec: kds_samples.AccessRoleBinding}, store.CreateByKey("arb-1", model.NoMesh))).
 			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
 				Expect(rs).To(HaveLen(1))
@@ -145,6 +171,61 @@ var _ = Describe("KDS Server", func() {
 				Expect(rs).To(HaveLen(1))
 				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.HealthCheck))
 			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.LocalReplyType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.LocalReply))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshHeaderModifierType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.MeshHeaderModifier))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshBandwidthLimitType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.MeshBandwidthLimit))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshCORSType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.MeshCORS))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshCompressionType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.MeshCompression))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshExternalAuthzType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.MeshExternalAuthz))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshJWTType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.MeshJWT))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshProtocolOptionsType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.MeshProtocolOptions))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshRequestProtectionType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.MeshRequestProtection))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshWasmPluginType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.MeshWasmPlugin))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshZoneTrafficRouteType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.MeshZoneTrafficRoute))
+			})).
 			Exec(kds_verifier.DiscoveryRequest(node, mesh.TrafficLogType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
 				Expect(rs).To(HaveLen(1))
@@ -175,6 +256,16 @@ var _ = Describe("KDS Server", func() {
 				Expect(rs).To(HaveLen(1))
 				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.Secret))
 			})).
+			Exec(kds_verifier.DiscoveryRequest(node, system.AccessRoleType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.AccessRole))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, system.AccessRoleBindingType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+				Expect(rs[0].GetSpec()).To(MatchProto(kds_samples.AccessRoleBinding))
+			})).
 			Exec(kds_verifier.DiscoveryRequest(node, mesh.RetryType)).
 			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
 				Expect(rs).To(HaveLen(1))
@@ -337,4 +428,52 @@ var _ = Describe("KDS Server", func() {
 
 		tc.WaitGroup().Wait()
 	})
+
+	It("should send only changed resources to the client that resumes the stream after the restart of the server", func() {
+		ctx := context.Background()
+
+		// given the client received the meshes from the previous instance of the server
+		vrf := kds_verifier.New().
+			Exec(kds_verifier.Create(ctx, &mesh.MeshResource{Spec: kds_samples.Mesh1}, store.CreateByKey("mesh1", "mesh-1"))).
+			Exec(kds_verifier.Create(ctx, &mesh.TrafficRouteResource{Spec: kds_samples.TrafficRoute}, store.CreateByKey("tr-1", "mesh-1"))).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.MeshType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+			})).
+			Exec(kds_verifier.DiscoveryRequest(node, mesh.TrafficRouteType)).
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(1))
+			})).
+			Exec(kds_verifier.CloseStream())
+		Expect(vrf.Verify(tc)).To(Succeed())
+		tc.WaitGroup().Wait()
+		meshVersion := tc.LastResponse(string(mesh.MeshType)).VersionInfo
+		routeVersion := tc.LastResponse(string(mesh.TrafficRouteType)).VersionInfo
+
+		// and the server is restarted
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		restarted := &kds_verifier.TestContextImpl{
+			ResourceStore:      tc.Store(),
+			MockStream:         kds_setup.StartServer(tc.Store(), wg, "test-cluster", registry.Global().ObjectTypes(model.HasKdsEnabled()), reconcile.Any, reconcile.NoopResourceMapper),
+			Wg:                 wg,
+			Responses:          map[string]*envoy_sd.DiscoveryResponse{},
+			LastACKedResponses: map[string]*envoy_sd.DiscoveryResponse{},
+		}
+
+		// when the client resumes the stream and the route is changed in the meantime
+		vrf = kds_verifier.New().
+			Exec(kds_verifier.Create(ctx, &mesh.TrafficRouteResource{Spec: kds_samples.TrafficRoute}, store.CreateByKey("tr-2", "mesh-1"))).
+			Exec(kds_verifier.ResumeDiscoveryRequest(node, mesh.MeshType, meshVersion)).
+			Exec(kds_verifier.ResumeDiscoveryRequest(node, mesh.TrafficRouteType, routeVersion)).
+			// then only the routes are sent
+			Exec(kds_verifier.WaitResponse(defaultTimeout, func(rs []model.Resource) {
+				Expect(rs).To(HaveLen(2))
+				Expect(rs[0].Descriptor().Name).To(Equal(mesh.TrafficRouteType))
+			})).
+			Exec(kds_verifier.ExpectNoResponseDuring(500 * time.Millisecond)).
+			Exec(kds_verifier.CloseStream())
+		Expect(vrf.Verify(restarted)).To(Succeed())
+		restarted.WaitGroup().Wait()
+	})
 })
@@ -3,11 +3,13 @@ package server
 import (
 	"context"
 	"sync"
+	"time"
 
 	envoy_sd "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	"github.com/go-logr/logr"
 	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
@@ -124,21 +126,32 @@ func (c *statusTracker) OnStreamRequest(streamID int64, req *envoy_sd.DiscoveryR
 		if err := readVersion(req.Node.GetMetadata(), state.subscription.Version); err != nil {
 			c.log.Error(err, "failed to extract version out of the Envoy metadata", "streamid", streamID, "metadata", req.Node.GetMetadata())
 		}
+		if err := readDegradation(req.Node.GetMetadata(), state.subscription); err != nil {
+			c.log.Error(err, "failed to extract degradation out of the Envoy metadata", "streamid", streamID, "metadata", req.Node.GetMetadata())
+		}
 		go c.createStatusSink(state, c.log).Start(state.stop)
 	}
 
 	// update Dataplane status
 	subscription := state.subscription
+	now := core.Now()
 	if req.ResponseNonce != "" {
-		subscription.Status.LastUpdateTime = util_proto.MustTimestampProto(core.Now())
+		subscription.Status.LastUpdateTime = util_proto.MustTimestampProto(now)
 		if req.ErrorDetail != nil {
 			subscription.Status.Total.ResponsesRejected++
 			util.StatsOf(subscription.Status, model.ResourceType(req.TypeUrl)).ResponsesRejected++
 		} else {
+			stat := util.StatsOf(subscription.Status, model.ResourceType(req.TypeUrl))
 			subscription.Status.Total.ResponsesAcknowledged++
-			util.StatsOf(subscription.Status, model.ResourceType(req.TypeUrl)).ResponsesAcknowledged++
+			subscription.Status.Total.LastAcknowledgedTime = util_proto.MustTimestampProto(now)
+			stat.ResponsesAcknowledged++
+			stat.LastAcknowledgedTime = util_proto.MustTimestampProto(now)
+			stat.LastAcknowledgedVersion = req.VersionInfo
 		}
 	}
+	if clockSkew, ok := readClockSkew(req.Node.GetMetadata(), now); ok {
+		subscription.ClockSkew = durationpb.New(clockSkew)
+	}
 	if subscription.Config == "" && req.Node.Metadata != nil && req.Node.Metadata.Fields[kds.MetadataFieldConfig] != nil {
 		subscription.Config = req.Node.Metadata.Fields[kds.MetadataFieldConfig].GetStringValue()
 	}
@@ -159,9 +172,15 @@ func (c *statusTracker) OnStreamResponse(_ context.Context, streamID int64, req
 
 	// update Dataplane status
 	subscription := state.subscription
-	subscription.Status.LastUpdateTime = util_proto.MustTimestampProto(core.Now())
+	now := util_proto.MustTimestampProto(core.Now())
+	subscription.Status.LastUpdateTime = now
 	subscription.Status.Total.ResponsesSent++
-	util.StatsOf(subscription.Status, model.ResourceType(req.TypeUrl)).ResponsesSent++
+	subscription.Status.Total.LastSentTime = now
+	stat := util.StatsOf(subscription.Status, model.ResourceType(req.TypeUrl))
+	stat.ResponsesSent++
+	stat.LastSentTime = now
+	stat.LastSentVersion = resp.GetVersionInfo()
+	stat.Resources = uint64(len(resp.GetResources()))
 
 	c.log.V(1).Info("OnStreamResponse", "streamid", streamID, "request", req, "response", resp, "subscription", subscription)
 }
@@ -183,6 +202,33 @@ func (s *streamState) Close() {
 	close(s.stop)
 }
 
+// readClockSkew estimates how much the clock of the zone is ahead of the given time
+// out of the time when the request was sent by the zone.
+func readClockSkew(metadata *structpb.Struct, now time.Time) (time.Duration, bool) {
+	rawTime := metadata.GetFields()[kds.MetadataFieldTime].GetStringValue()
+	if rawTime == "" {
+		return 0, false
+	}
+	sent, err := time.Parse(time.RFC3339Nano, rawTime)
+	if err != nil {
+		return 0, false
+	}
+	return sent.Sub(now), true
+}
+
+func readDegradation(metadata *structpb.Struct, subscription *system_proto.KDSSubscription) error {
+	rawDegradation := metadata.GetFields()[kds.MetadataFieldDegradation].GetStructValue()
+	if rawDegradation == nil {
+		return nil
+	}
+	degradation := &system_proto.KDSDegradation{}
+	if err := util_proto.ToTyped(rawDegradation, degradation); err != nil {
+		return err
+	}
+	subscription.Degradation = degradation
+	return nil
+}
+
 func readVersion(metadata *structpb.Struct, version *system_proto.Version) error {
 	if metadata == nil {
 		return nil
@@ -0,0 +1,165 @@
+package server_test
+
+import (
+	"context"
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_sd "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/kds"
+	"github.com/kumahq/kuma/pkg/kds/server"
+	test_runtime "github.com/kumahq/kuma/pkg/test/runtime"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type noopZoneInsightSink struct{}
+
+func (noopZoneInsightSink) Start(<-chan struct{}) {}
+
+var _ = Describe("KDS Status Tracker", func() {
+
+	var tracker server.StatusTracker
+	var now time.Time
+	streamID := int64(1)
+	typ := string(mesh.TrafficRouteType)
+
+	BeforeEach(func() {
+		now = time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+		core.Now = func() time.Time {
+			return now
+		}
+		tracker = server.NewStatusTracker(&test_runtime.TestRuntimeInfo{InstanceId: "global-1"}, func(server.StatusAccessor, logr.Logger) server.ZoneInsightSink {
+			return noopZoneInsightSink{}
+		}, logr.Discard())
+		Expect(tracker.OnStreamOpen(context.Background(), streamID, "")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		core.Now = time.Now
+	})
+
+	node := func(zoneTime time.Time) *envoy_core.Node {
+		return &envoy_core.Node{
+			Id: "zone-1",
+			Metadata: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					kds.MetadataFieldTime: structpb.NewStringValue(zoneTime.Format(time.RFC3339Nano)),
+				},
+			},
+		}
+	}
+
+	It("should track sync status per resource type", func() {
+		// given
+		req := &envoy_sd.DiscoveryRequest{Node: node(now), TypeUrl: typ}
+		Expect(tracker.OnStreamRequest(streamID, req)).To(Succeed())
+
+		// when
+		now = now.Add(time.Second)
+		tracker.OnStreamResponse(context.Background(), streamID, req, &envoy_sd.DiscoveryResponse{
+			VersionInfo: "v1",
+			Resources:   []*anypb.Any{{}, {}},
+			Nonce:       "1",
+			TypeUrl:     typ,
+		})
+
+		// then
+		accessor, _ := tracker.GetStatusAccessor(streamID)
+		zone, subscription := accessor.GetStatus()
+		Expect(zone).To(Equal("zone-1"))
+		stat := subscription.Status.Stat[typ]
+		Expect(stat.LastSentVersion).To(Equal("v1"))
+		Expect(stat.LastSentTime.AsTime()).To(Equal(now))
+		Expect(stat.Resources).To(Equal(uint64(2)))
+		Expect(stat.LastAcknowledgedTime).To(BeNil())
+		Expect(stat.PendingChanges()).To(Equal(uint64(1)))
+
+		// when
+		now = now.Add(time.Second)
+		Expect(tracker.OnStreamRequest(streamID, &envoy_sd.DiscoveryRequest{
+			Node:          node(now),
+			VersionInfo:   "v1",
+			ResponseNonce: "1",
+			TypeUrl:       typ,
+		})).To(Succeed())
+
+		// then
+		_, subscription = accessor.GetStatus()
+		stat = subscription.Status.Stat[typ]
+		Expect(stat.LastAcknowledgedVersion).To(Equal("v1"))
+		Expect(stat.LastAcknowledgedTime.AsTime()).To(Equal(now))
+		Expect(stat.PendingChanges()).To(BeZero())
+		Expect(subscription.Status.Total.LastAcknowledgedTime.AsTime()).To(Equal(now))
+	})
+
+	It("should not update acknowledged version on NACK", func() {
+		// given
+		req := &envoy_sd.DiscoveryRequest{Node: node(now), TypeUrl: typ}
+		Expect(tracker.OnStreamRequest(streamID, req)).To(Succeed())
+		tracker.OnStreamResponse(context.Background(), streamID, req, &envoy_sd.DiscoveryResponse{
+			VersionInfo: "v1",
+			Nonce:       "1",
+			TypeUrl:     typ,
+		})
+
+		// when
+		Expect(tracker.OnStreamRequest(streamID, &envoy_sd.DiscoveryRequest{
+			Node:          node(now),
+			ResponseNonce: "1",
+			TypeUrl:       typ,
+			ErrorDetail:   &status.Status{Message: "invalid resource"},
+		})).To(Succeed())
+
+		// then
+		accessor, _ := tracker.GetStatusAccessor(streamID)
+		_, subscription := accessor.GetStatus()
+		stat := subscription.Status.Stat[typ]
+		Expect(stat.LastAcknowledgedVersion).To(BeEmpty())
+		Expect(stat.ResponsesRejected).To(Equal(uint64(1)))
+		Expect(stat.PendingChanges()).To(BeZero())
+	})
+
+	It("should estimate clock skew of the zone", func() {
+		// when
+		Expect(tracker.OnStreamRequest(streamID, &envoy_sd.DiscoveryRequest{
+			Node:    node(now.Add(3 * time.Second)),
+			TypeUrl: typ,
+		})).To(Succeed())
+
+		// then
+		accessor, _ := tracker.GetStatusAccessor(streamID)
+		_, subscription := accessor.GetStatus()
+		Expect(subscription.ClockSkew.AsDuration()).To(Equal(3 * time.Second))
+	})
+
+	It("should read degradation reported by the zone", func() {
+		// given
+		req := &envoy_sd.DiscoveryRequest{Node: node(now), TypeUrl: typ}
+		degradation, err := util_proto.ToStruct(&system_proto.KDSDegradation{
+			StartTime:      util_proto.MustTimestampProto(now.Add(-time.Hour)),
+			EndTime:        util_proto.MustTimestampProto(now),
+			PendingChanges: 7,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		req.Node.Metadata.Fields[kds.MetadataFieldDegradation] = structpb.NewStructValue(degradation)
+
+		// when
+		Expect(tracker.OnStreamRequest(streamID, req)).To(Succeed())
+
+		// then
+		accessor, _ := tracker.GetStatusAccessor(streamID)
+		_, subscription := accessor.GetStatus()
+		Expect(subscription.Degradation.StartTime.AsTime()).To(Equal(now.Add(-time.Hour)))
+		Expect(subscription.Degradation.PendingChanges).To(Equal(uint64(7)))
+	})
+})
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"io"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -17,31 +19,55 @@ type EnvoyAdminProcessor interface {
 	StartProcessingXDSConfigs(stream mesh_proto.GlobalKDSService_StreamXDSConfigsClient, errorCh chan error)
 	StartProcessingStats(stream mesh_proto.GlobalKDSService_StreamStatsClient, errorCh chan error)
 	StartProcessingClusters(stream mesh_proto.GlobalKDSService_StreamClustersClient, errorCh chan error)
+	StartProcessingTailLogs(stream mesh_proto.GlobalKDSService_StreamTailLogsClient, errorCh chan error)
+	StartProcessingProxyInfo(stream mesh_proto.GlobalKDSService_StreamProxyInfoClient, errorCh chan error)
 }
 
 type EnvoyAdminFn = func(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error)
 
+// EnvoyAdminConfigDumpFn executes config dump request with the options passed by Global CP.
+type EnvoyAdminConfigDumpFn = func(ctx context.Context, proxy core_model.ResourceWithAddress, req *mesh_proto.XDSConfigRequest) ([]byte, error)
+
+// EnvoyAdminStatsFn executes stats request with the options passed by Global CP.
+type EnvoyAdminStatsFn = func(ctx context.Context, proxy core_model.ResourceWithAddress, req *mesh_proto.StatsRequest) ([]byte, error)
+
+// EnvoyAdminClustersFn executes clusters request with the options passed by Global CP.
+type EnvoyAdminClustersFn = func(ctx context.Context, proxy core_model.ResourceWithAddress, req *mesh_proto.ClustersRequest) ([]byte, error)
+
+// EnvoyAdminTailLogsFn opens the stream of traffic of the proxy with the options passed by Global CP.
+// The stream is open until ctx is cancelled.
+type EnvoyAdminTailLogsFn = func(ctx context.Context, proxy core_model.ResourceWithAddress, req *mesh_proto.TailLogsRequest) (io.ReadCloser, error)
+
+// EnvoyAdminProxyInfoFn returns the information about the proxy from the endpoint requested by Global CP.
+type EnvoyAdminProxyInfoFn = func(ctx context.Context, proxy core_model.ResourceWithAddress, req *mesh_proto.ProxyInfoRequest) ([]byte, error)
+
 type envoyAdminProcessor struct {
 	resManager core_manager.ReadOnlyResourceManager
 
-	configDumpFn EnvoyAdminFn
-	statsFn      EnvoyAdminFn
-	clustersFn   EnvoyAdminFn
+	configDumpFn EnvoyAdminConfigDumpFn
+	statsFn      EnvoyAdminStatsFn
+	clustersFn   EnvoyAdminClustersFn
+	tailLogsFn   EnvoyAdminTailLogsFn
+	proxyInfoFn  EnvoyAdminProxyInfoFn
 }
 
 var _ EnvoyAdminProcessor = &envoyAdminProcessor{}
 
 func NewEnvoyAdminProcessor(
 	resManager core_manager.ReadOnlyResourceManager,
-	configDumpFn EnvoyAdminFn,
-	statsFn EnvoyAdminFn,
-	clustersFn EnvoyAdminFn,
+	configDumpFn EnvoyAdminConfigDumpFn,
+	statsFn EnvoyAdminStatsFn,
+	clustersFn EnvoyAdminClustersFn,
+	tailLogsFn EnvoyAdminTailLogsFn,
+	proxyInfoFn EnvoyAdminProxyInfoFn,
 ) EnvoyAdminProcessor {
 	return &envoyAdminProcessor{
 		resManager:   resManager,
 		configDumpFn: configDumpFn,
 		statsFn:      statsFn,
 		clustersFn:   clustersFn,
+		tailLogsFn:   tailLogsFn,
+		proxyInfoFn:  proxyInfoFn,
 	}
 }
 
@@ -56,7 +82,9 @@ func (s *envoyAdminProcessor) StartProcessingXDSConfigs(
 			return
 		}
 		go func() { // schedule in the background to be able to quickly process more requests
-			config, err := s.executeAdminFn(stream.Context(), req.ResourceType, req.ResourceName, req.ResourceMesh, s.configDumpFn)
+			config, err := s.executeAdminFn(stream.Context(), req.ResourceType, req.ResourceName, req.ResourceMesh, func(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+				return s.configDumpFn(ctx, proxy, req)
+			})
 
 			resp := &mesh_proto.XDSConfigResponse{
 				RequestId: req.RequestId,
@@ -90,7 +118,9 @@ func (s *envoyAdminProcessor) StartProcessingStats(
 			return
 		}
 		go func() { // schedule in the background to be able to quickly process more requests
-			stats, err := s.executeAdminFn(stream.Context(), req.ResourceType, req.ResourceName, req.ResourceMesh, s.statsFn)
+			stats, err := s.executeAdminFn(stream.Context(), req.ResourceType, req.ResourceName, req.ResourceMesh, func(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+				return s.statsFn(ctx, proxy, req)
+			})
 
 			resp := &mesh_proto.StatsResponse{
 				RequestId: req.RequestId,
@@ -124,7 +154,9 @@ func (s *envoyAdminProcessor) StartProcessingClusters(
 			return
 		}
 		go func() { // schedule in the background to be able to quickly process more requests
-			clusters, err := s.executeAdminFn(stream.Context(), req.ResourceType, req.ResourceName, req.ResourceMesh, s.clustersFn)
+			clusters, err := s.executeAdminFn(stream.Context(), req.ResourceType, req.ResourceName, req.ResourceMesh, func(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+				return s.clustersFn(ctx, proxy, req)
+			})
 
 			resp := &mesh_proto.ClustersResponse{
 				RequestId: req.RequestId,
@@ -147,6 +179,135 @@ func (s *envoyAdminProcessor) StartProcessingClusters(
 	}
 }
 
+func (s *envoyAdminProcessor) StartProcessingProxyInfo(
+	stream mesh_proto.GlobalKDSService_StreamProxyInfoClient,
+	errorCh chan error,
+) {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			errorCh <- err
+			return
+		}
+		go func() { // schedule in the background to be able to quickly process more requests
+			info, err := s.executeAdminFn(stream.Context(), req.ResourceType, req.ResourceName, req.ResourceMesh, func(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+				return s.proxyInfoFn(ctx, proxy, req)
+			})
+
+			resp := &mesh_proto.ProxyInfoResponse{
+				RequestId: req.RequestId,
+			}
+			if len(info) > 0 {
+				resp.Result = &mesh_proto.ProxyInfoResponse_Info{
+					Info: info,
+				}
+			}
+			if err != nil { // send the error to the client instead of terminating stream.
+				resp.Result = &mesh_proto.ProxyInfoResponse_Error{
+					Error: err.Error(),
+				}
+			}
+			if err := stream.Send(resp); err != nil {
+				errorCh <- err
+				return
+			}
+		}()
+	}
+}
+
+// StartProcessingTailLogs streams the traffic of the proxy in many responses for a single request
+// until the stream of the traffic ends or Global CP cancels the request.
+func (s *envoyAdminProcessor) StartProcessingTailLogs(
+	stream mesh_proto.GlobalKDSService_StreamTailLogsClient,
+	errorCh chan error,
+) {
+	sendLock := sync.Mutex{} // concurrent sends are not allowed by gRPC
+	send := func(resp *mesh_proto.TailLogsResponse) error {
+		sendLock.Lock()
+		defer sendLock.Unlock()
+		return stream.Send(resp)
+	}
+	cancels := map[string]context.CancelFunc{}
+	cancelsLock := sync.Mutex{}
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			errorCh <- err
+			return
+		}
+		if req.Cancel {
+			cancelsLock.Lock()
+			if cancel, ok := cancels[req.RequestId]; ok {
+				cancel()
+			}
+			cancelsLock.Unlock()
+			continue
+		}
+		ctx, cancel := context.WithCancel(stream.Context())
+		cancelsLock.Lock()
+		cancels[req.RequestId] = cancel
+		cancelsLock.Unlock()
+		go func() {
+			defer func() {
+				cancel()
+				cancelsLock.Lock()
+				delete(cancels, req.RequestId)
+				cancelsLock.Unlock()
+			}()
+			if err := s.tailLogs(ctx, req, send); err != nil {
+				errorCh <- err
+			}
+		}()
+	}
+}
+
+// tailLogs sends an empty response as soon as the stream of traffic is opened, so Global CP can report errors of opening it,
+// then it sends chunks of the stream. Only errors of sending the responses are returned.
+func (s *envoyAdminProcessor) tailLogs(ctx context.Context, req *mesh_proto.TailLogsRequest, send func(*mesh_proto.TailLogsResponse) error) error {
+	var logs io.ReadCloser
+	_, err := s.executeAdminFn(ctx, req.ResourceType, req.ResourceName, req.ResourceMesh, func(_ context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+		// the timeout of the admin fn applies only to opening of the stream
+		var err error
+		logs, err = s.tailLogsFn(ctx, proxy, req)
+		return nil, err
+	})
+	if err != nil { // send the error to the client instead of terminating stream.
+		return send(&mesh_proto.TailLogsResponse{
+			RequestId: req.RequestId,
+			Error:     err.Error(),
+		})
+	}
+	defer logs.Close()
+	if err := send(&mesh_proto.TailLogsResponse{
+		RequestId: req.RequestId,
+		More:      true,
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := logs.Read(buf)
+		resp := &mesh_proto.TailLogsResponse{
+			RequestId: req.RequestId,
+			Entries:   append([]byte{}, buf[:n]...),
+			More:      err == nil,
+		}
+		if err != nil && err != io.EOF && ctx.Err() == nil {
+			resp.Error = err.Error()
+		}
+		if n == 0 && resp.More {
+			continue
+		}
+		if err := send(resp); err != nil {
+			return err
+		}
+		if !resp.More {
+			return nil
+		}
+	}
+}
+
 func (s *envoyAdminProcessor) executeAdminFn(
 	ctx context.Context,
 	resType string,
@@ -8,6 +8,9 @@ type EnvoyAdminRPCs struct {
 	XDSConfigDump util_grpc.ReverseUnaryRPCs
 	Stats         util_grpc.ReverseUnaryRPCs
 	Clusters      util_grpc.ReverseUnaryRPCs
+	// TailLogs streams many responses for a single request, see util_grpc.NewReverseStreamReader.
+	TailLogs  util_grpc.ReverseUnaryRPCs
+	ProxyInfo util_grpc.ReverseUnaryRPCs
 }
 
 func NewEnvoyAdminRPCs() EnvoyAdminRPCs {
@@ -15,5 +18,7 @@ func NewEnvoyAdminRPCs() EnvoyAdminRPCs {
 		XDSConfigDump: util_grpc.NewReverseUnaryRPCs(),
 		Stats:         util_grpc.NewReverseUnaryRPCs(),
 		Clusters:      util_grpc.NewReverseUnaryRPCs(),
+		TailLogs:      util_grpc.NewReverseUnaryRPCs(),
+		ProxyInfo:     util_grpc.NewReverseUnaryRPCs(),
 	}
 }
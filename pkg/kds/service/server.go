@@ -39,6 +39,18 @@ func (g *GlobalKDSServiceServer) StreamClusters(stream mesh_proto.GlobalKDSServi
 	})
 }
 
+func (g *GlobalKDSServiceServer) StreamTailLogs(stream mesh_proto.GlobalKDSService_StreamTailLogsServer) error {
+	return g.streamEnvoyAdminRPC("Tail Logs", g.envoyAdminRPCs.TailLogs, stream, func() (util_grpc.ReverseUnaryMessage, error) {
+		return stream.Recv()
+	})
+}
+
+func (g *GlobalKDSServiceServer) StreamProxyInfo(stream mesh_proto.GlobalKDSService_StreamProxyInfoServer) error {
+	return g.streamEnvoyAdminRPC("Proxy Info", g.envoyAdminRPCs.ProxyInfo, stream, func() (util_grpc.ReverseUnaryMessage, error) {
+		return stream.Recv()
+	})
+}
+
 func (g *GlobalKDSServiceServer) streamEnvoyAdminRPC(
 	rpcName string,
 	rpc util_grpc.ReverseUnaryRPCs,
@@ -51,7 +63,7 @@ func (g *GlobalKDSServiceServer) streamEnvoyAdminRPC(
 	}
 	core.Log.Info("Envoy Admin RPC stream started", "rpc", rpcName, "zone", zone)
 	rpc.ClientConnected(zone, stream)
-	defer rpc.ClientDisconnected(zone)
+	defer rpc.ClientDisconnected(zone, stream)
 	for {
 		resp, err := recv()
 		if err != nil {
@@ -59,7 +71,8 @@ func (g *GlobalKDSServiceServer) streamEnvoyAdminRPC(
 		}
 		core.Log.V(1).Info("Envoy Admin RPC response received", "rpc", rpc, "zone", zone, "requestId", resp.GetRequestId())
 		if err := rpc.ResponseReceived(zone, resp); err != nil {
-			return err
+			// the request might have been already cancelled, it should not close the whole stream
+			core.Log.Error(err, "could not deliver the response", "rpc", rpcName, "zone", zone, "requestId", resp.GetRequestId())
 		}
 	}
 }
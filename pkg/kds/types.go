@@ -9,4 +9,9 @@ const (
 	MetadataFieldConfig  = "config"
 	MetadataFieldVersion = "version"
 	MetadataFeatures     = "features"
+	// MetadataFieldTime is the time when the request was sent by the client, formatted as RFC3339Nano.
+	// It lets the server estimate the skew between the clocks of the control planes.
+	MetadataFieldTime = "time"
+	// MetadataFieldDegradation is the degraded operation of the Zone CP that preceded the stream.
+	MetadataFieldDegradation = "degradation"
 )
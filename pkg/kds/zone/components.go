@@ -1,8 +1,12 @@
 package zone
 
 import (
+	"context"
+	"io"
+
 	"github.com/pkg/errors"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/config"
 	config_core "github.com/kumahq/kuma/pkg/config/core"
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
@@ -13,6 +17,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
 	kds_client "github.com/kumahq/kuma/pkg/kds/client"
 	"github.com/kumahq/kuma/pkg/kds/mux"
 	kds_server "github.com/kumahq/kuma/pkg/kds/server"
@@ -23,6 +28,7 @@ import (
 	k8s_model "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
 	zone_tokens "github.com/kumahq/kuma/pkg/tokens/builtin/zone"
 	"github.com/kumahq/kuma/pkg/tokens/builtin/zoneingress"
+	envoy_listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
 )
 
 var (
@@ -54,21 +60,33 @@ func Setup(rt core_runtime.Runtime) error {
 	if err != nil {
 		return errors.Wrap(err, "could not marshall config to json")
 	}
+	degradation, err := NewDegradationTracker(reg.ObjectTypes(model.HasKDSFlag(model.ProvidedByZone)), rt.EventReaderFactory(), rt.Metrics())
+	if err != nil {
+		return err
+	}
+	if err := rt.Add(degradation); err != nil {
+		return err
+	}
+	// resources from Global are changed only by the sink, so after the reconnection only the types that changed in the meantime are synced
+	resumeVersions := kds_client.NewResumeVersions()
 	onSessionStarted := mux.OnSessionStartedFunc(func(session mux.Session) error {
 		log := kdsZoneLog.WithValues("peer-id", session.PeerID())
 		log.Info("new session created")
+		degradationSession := degradation.OnConnected()
 		go func() {
 			if err := kdsServer.StreamKumaResources(session.ServerStream()); err != nil {
 				log.Error(err, "StreamKumaResources finished with an error")
 			}
 		}()
-		sink := kds_client.NewKDSSink(log, reg.ObjectTypes(model.HasKDSFlag(model.ConsumedByZone)), kds_client.NewKDSStream(session.ClientStream(), zone, string(cfgJson)),
+		sink := kds_client.NewKDSSink(log, reg.ObjectTypes(model.HasKDSFlag(model.ConsumedByZone)), kds_client.NewKDSStream(session.ClientStream(), zone, string(cfgJson), resumeVersions, degradation.LastDegradation()),
 			Callbacks(rt.KDSContext().Configs, resourceSyncer, rt.Config().Store.Type == store.KubernetesStore, zone, kubeFactory),
 		)
 		go func() {
 			if err := sink.Receive(); err != nil {
 				log.Error(err, "KDSSink finished with an error")
 			}
+			// the sink finishes when the session is closed, the Zone keeps serving xDS from the resources synced so far
+			degradation.OnDisconnected(degradationSession)
 		}()
 		return nil
 	})
@@ -81,9 +99,35 @@ func Setup(rt core_runtime.Runtime) error {
 		rt.Metrics(),
 		service.NewEnvoyAdminProcessor(
 			rt.ReadOnlyResourceManager(),
-			rt.EnvoyAdminClient().ConfigDump,
-			rt.EnvoyAdminClient().Stats,
-			rt.EnvoyAdminClient().Clusters,
+			func(ctx context.Context, proxy model.ResourceWithAddress, req *mesh_proto.XDSConfigRequest) ([]byte, error) {
+				return rt.EnvoyAdminClient().ConfigDump(ctx, proxy, admin.ConfigDumpOpts{
+					Redaction:  admin.RedactionPolicy(req.GetRedaction()),
+					IncludeEDS: req.GetIncludeEds(),
+				})
+			},
+			func(ctx context.Context, proxy model.ResourceWithAddress, req *mesh_proto.StatsRequest) ([]byte, error) {
+				return rt.EnvoyAdminClient().Stats(ctx, proxy, admin.StatsOpts{
+					Filter:   req.GetFilter(),
+					UsedOnly: req.GetUsedOnly(),
+					Format:   admin.StatsFormat(req.GetFormat()),
+				})
+			},
+			func(ctx context.Context, proxy model.ResourceWithAddress, req *mesh_proto.ClustersRequest) ([]byte, error) {
+				return rt.EnvoyAdminClient().Clusters(ctx, proxy, admin.ClustersOpts{
+					Format: admin.ClustersFormat(req.GetFormat()),
+				})
+			},
+			func(ctx context.Context, proxy model.ResourceWithAddress, req *mesh_proto.TailLogsRequest) (io.ReadCloser, error) {
+				return rt.EnvoyAdminClient().TailLogs(ctx, proxy, admin.TailLogsOpts{
+					ConfigID:         envoy_listeners_v3.TapConfigID,
+					MaxBufferedBytes: req.GetMaxBufferedBytes(),
+					RequestHeaders:   req.GetRequestHeaders(),
+					LogLevel:         admin.LogLevel(req.GetLogLevel()),
+				})
+			},
+			func(ctx context.Context, proxy model.ResourceWithAddress, req *mesh_proto.ProxyInfoRequest) ([]byte, error) {
+				return admin.ProxyInfo(ctx, rt.EnvoyAdminClient(), proxy, admin.ProxyInfoEndpoint(req.GetEndpoint()))
+			},
 		),
 	)
 	return rt.Add(component.NewResilientComponent(kdsZoneLog.WithName("kds-mux-client"), muxClient))
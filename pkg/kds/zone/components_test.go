@@ -34,7 +34,7 @@ var _ = Describe("Zone Sync", func() {
 	zoneName := "zone-1"
 
 	newPolicySink := func(zoneName string, resourceSyncer sync_store.ResourceSyncer, cs *grpc.MockClientStream, configs map[string]bool) kds_client.KDSSink {
-		return kds_client.NewKDSSink(core.Log.WithName("kds-sink"), registry.Global().ObjectTypes(model.HasKDSFlag(model.ConsumedByZone)), kds_client.NewKDSStream(cs, zoneName, ""), zone.Callbacks(configs, resourceSyncer, false, zoneName, nil))
+		return kds_client.NewKDSSink(core.Log.WithName("kds-sink"), registry.Global().ObjectTypes(model.HasKDSFlag(model.ConsumedByZone)), kds_client.NewKDSStream(cs, zoneName, "", nil, nil), zone.Callbacks(configs, resourceSyncer, false, zoneName, nil))
 	}
 	ingressFunc := func(zone string) *mesh_proto.ZoneIngress {
 		return &mesh_proto.ZoneIngress{
@@ -61,6 +61,8 @@ var _ = Describe("Zone Sync", func() {
 
 	BeforeEach(func() {
 		globalStore = memory.NewStore()
+		// Global CP creates the Zone when the zone connects
+		Expect(globalStore.Create(context.Background(), system.NewZoneResource(), store.CreateByKey(zoneName, model.NoMesh))).To(Succeed())
 		wg := &sync.WaitGroup{}
 
 		kdsCtx := kds_context.DefaultContext(manager.NewResourceManager(globalStore), "global")
@@ -142,12 +144,14 @@ var _ = Describe("Zone Sync", func() {
 			return !excludeTypes[descriptor.Name]
 		}))
 
-		// plus 4 global-scope types
+		// plus 6 global-scope types
 		extraTypes := []model.ResourceType{
 			mesh.MeshType,
 			mesh.ZoneIngressType,
 			system.ConfigType,
 			system.GlobalSecretType,
+			system.AccessRoleType,
+			system.AccessRoleBindingType,
 		}
 
 		actualConsumedTypes = append(actualConsumedTypes, extraTypes...)
@@ -0,0 +1,175 @@
+package zone
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/pkg/events"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// DegradationTracker tracks the degraded operation of the Zone CP, which is when the Zone CP is not connected to the Global CP.
+//
+// While degraded, the Zone CP keeps serving xDS from the resources that were last synced from the Global CP, they stay in the store,
+// and keeps accepting local changes of the resources it provides, like Dataplanes and their insights. The changes are not queued
+// one by one, the store is the queue: KDS syncs the whole state, so after the reconnection the Global CP receives all the resources
+// provided by the Zone CP and reconciles them. The tracker counts the changes to report how much is waiting for the reconciliation.
+type DegradationTracker struct {
+	types           map[model.ResourceType]bool
+	listenerFactory events.ListenerFactory
+	degradations    prometheus.Counter
+
+	mu              sync.Mutex // protects access to the fields below
+	running         bool
+	connected       bool
+	session         uint64 // generation of the most recent session, it tells apart disconnects of stale sessions
+	lostConnection  bool
+	degradedSince   time.Time
+	pendingChanges  uint64
+	lastDegradation *system_proto.KDSDegradation
+}
+
+var _ component.Component = &DegradationTracker{}
+
+// NewDegradationTracker creates the tracker that counts the changes of the resources of the given types.
+func NewDegradationTracker(types []model.ResourceType, listenerFactory events.ListenerFactory, metrics core_metrics.Metrics) (*DegradationTracker, error) {
+	t := &DegradationTracker{
+		types:           map[model.ResourceType]bool{},
+		listenerFactory: listenerFactory,
+		degradations: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "kds_zone_degradations",
+			Help: "Number of times the Zone CP lost the connection to the Global CP",
+		}),
+	}
+	for _, typ := range types {
+		t.types[typ] = true
+	}
+	degraded := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "kds_zone_degraded",
+		Help: "Whether the Zone CP operates without the connection to the Global CP",
+	}, func() float64 {
+		if t.Degraded() {
+			return 1
+		}
+		return 0
+	})
+	pendingChanges := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "kds_zone_degraded_pending_changes",
+		Help: "Number of changes of the resources provided by the Zone CP that were made without the connection to the Global CP",
+	}, func() float64 {
+		return float64(t.PendingChanges())
+	})
+	for _, collector := range []prometheus.Collector{t.degradations, degraded, pendingChanges} {
+		if err := metrics.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// Start counts the changes while the Zone CP is degraded. The Zone CP is degraded from the start until the first connection,
+// unless it is already connected.
+func (t *DegradationTracker) Start(stop <-chan struct{}) error {
+	listener := t.listenerFactory.New()
+	defer listener.Close()
+
+	t.mu.Lock()
+	t.running = true
+	if !t.connected {
+		t.degradedSince = core.Now()
+	}
+	t.mu.Unlock()
+	defer func() {
+		// only the leader is connected to the Global CP, other instances are not degraded
+		t.mu.Lock()
+		t.running = false
+		t.degradedSince = time.Time{}
+		t.lostConnection = false
+		t.pendingChanges = 0
+		t.mu.Unlock()
+	}()
+
+	for {
+		event, err := listener.Recv(stop)
+		if err == events.ListenerStoppedErr {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if changed, ok := event.(events.ResourceChangedEvent); ok && t.types[changed.Type] {
+			t.mu.Lock()
+			if !t.degradedSince.IsZero() {
+				t.pendingChanges++
+			}
+			t.mu.Unlock()
+		}
+	}
+}
+
+func (t *DegradationTracker) NeedLeaderElection() bool {
+	return true
+}
+
+// OnConnected ends the degradation. The degradation is reported by LastDegradation when it began with the loss of the connection.
+// It returns the generation of the session that has to be passed to OnDisconnected when the session ends.
+func (t *DegradationTracker) OnConnected() uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.session++
+	t.lastDegradation = nil
+	if t.lostConnection {
+		t.lastDegradation = &system_proto.KDSDegradation{
+			StartTime:      util_proto.MustTimestampProto(t.degradedSince),
+			EndTime:        util_proto.MustTimestampProto(core.Now()),
+			PendingChanges: t.pendingChanges,
+		}
+	}
+	t.connected = true
+	t.lostConnection = false
+	t.degradedSince = time.Time{}
+	t.pendingChanges = 0
+	return t.session
+}
+
+// OnDisconnected begins the degradation unless the session of the given generation was already replaced by a newer one.
+func (t *DegradationTracker) OnDisconnected(session uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.connected || session != t.session {
+		return
+	}
+	t.connected = false
+	if !t.running {
+		return
+	}
+	t.lostConnection = true
+	t.degradedSince = core.Now()
+	t.degradations.Inc()
+}
+
+func (t *DegradationTracker) Degraded() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return !t.degradedSince.IsZero()
+}
+
+func (t *DegradationTracker) PendingChanges() uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.pendingChanges
+}
+
+// LastDegradation returns the degradation that preceded the current connection or nil if there was none.
+func (t *DegradationTracker) LastDegradation() *system_proto.KDSDegradation {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lastDegradation
+}
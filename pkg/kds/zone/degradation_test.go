@@ -0,0 +1,117 @@
+package zone_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/events"
+	"github.com/kumahq/kuma/pkg/kds/zone"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	test_metrics "github.com/kumahq/kuma/pkg/test/metrics"
+)
+
+var _ = Describe("Degradation Tracker", func() {
+
+	var tracker *zone.DegradationTracker
+	var eventBus *events.EventBus
+	var metrics core_metrics.Metrics
+	var now time.Time
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		now = time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+		core.Now = func() time.Time {
+			return now
+		}
+		m, err := core_metrics.NewMetrics("zone-1")
+		Expect(err).ToNot(HaveOccurred())
+		metrics = m
+		eventBus = events.NewEventBus()
+		tracker, err = zone.NewDegradationTracker([]model.ResourceType{mesh.DataplaneType}, eventBus, metrics)
+		Expect(err).ToNot(HaveOccurred())
+
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(tracker.Start(stop)).To(Succeed())
+		}()
+		Eventually(tracker.Degraded).Should(BeTrue())
+	})
+
+	AfterEach(func() {
+		close(stop)
+		core.Now = time.Now
+	})
+
+	changeDataplane := func() {
+		eventBus.Send(events.ResourceChangedEvent{
+			Operation: events.Update,
+			Type:      mesh.DataplaneType,
+			Key:       model.ResourceKey{Mesh: "default", Name: "dp-1"},
+		})
+	}
+
+	It("should be degraded until the first connection without reporting the degradation", func() {
+		// when
+		tracker.OnConnected()
+
+		// then
+		Expect(tracker.Degraded()).To(BeFalse())
+		Expect(tracker.LastDegradation()).To(BeNil())
+		Expect(test_metrics.FindMetric(metrics, "kds_zone_degraded").GetGauge().GetValue()).To(Equal(0.0))
+	})
+
+	It("should count the changes while the connection is lost and report the degradation", func() {
+		// given
+		session := tracker.OnConnected()
+
+		// when
+		tracker.OnDisconnected(session)
+		changeDataplane()
+		changeDataplane()
+		eventBus.Send(events.ResourceChangedEvent{
+			Operation: events.Update,
+			Type:      mesh.TrafficRouteType,
+			Key:       model.ResourceKey{Mesh: "default", Name: "route-1"},
+		})
+
+		// then
+		Expect(tracker.Degraded()).To(BeTrue())
+		Eventually(func() float64 {
+			return test_metrics.FindMetric(metrics, "kds_zone_degraded_pending_changes").GetGauge().GetValue()
+		}).Should(Equal(2.0))
+		Expect(test_metrics.FindMetric(metrics, "kds_zone_degraded").GetGauge().GetValue()).To(Equal(1.0))
+		Expect(test_metrics.FindMetric(metrics, "kds_zone_degradations").GetCounter().GetValue()).To(Equal(1.0))
+
+		// when
+		disconnected := now
+		now = now.Add(time.Minute)
+		tracker.OnConnected()
+
+		// then
+		Expect(tracker.Degraded()).To(BeFalse())
+		Expect(tracker.PendingChanges()).To(BeZero())
+		degradation := tracker.LastDegradation()
+		Expect(degradation.StartTime.AsTime()).To(Equal(disconnected))
+		Expect(degradation.EndTime.AsTime()).To(Equal(now))
+		Expect(degradation.PendingChanges).To(Equal(uint64(2)))
+	})
+
+	It("should ignore disconnect of the session that was already replaced", func() {
+		// given
+		oldSession := tracker.OnConnected()
+		tracker.OnConnected()
+
+		// when
+		tracker.OnDisconnected(oldSession)
+
+		// then
+		Expect(tracker.Degraded()).To(BeFalse())
+		Expect(test_metrics.FindMetric(metrics, "kds_zone_degradations").GetCounter().GetValue()).To(Equal(0.0))
+	})
+})
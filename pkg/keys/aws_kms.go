@@ -0,0 +1,132 @@
+package keys
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/pkg/errors"
+
+	key_provider "github.com/kumahq/kuma/pkg/config/key-provider"
+)
+
+// awsKmsSignTimeout is a timeout of a single sign request, crypto.Signer does not pass the context.
+const awsKmsSignTimeout = 10 * time.Second
+
+type awsKmsProvider struct {
+	client kmsiface.KMSAPI
+}
+
+var _ Provider = &awsKmsProvider{}
+
+// NewAwsKmsProvider builds Provider of keys of AWS KMS. Keys are identified by their ids, ARNs or aliases.
+// Credentials are taken from the environment.
+func NewAwsKmsProvider(cfg key_provider.AwsKmsConfig) (Provider, error) {
+	awsCfg := aws.NewConfig()
+	if cfg.Region != "" {
+		awsCfg = awsCfg.WithRegion(cfg.Region)
+	}
+	if cfg.Endpoint != "" {
+		awsCfg = awsCfg.WithEndpoint(cfg.Endpoint)
+	}
+	sess, err := session.NewSession(awsCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create AWS session")
+	}
+	return &awsKmsProvider{
+		client: kms.New(sess),
+	}, nil
+}
+
+func (a *awsKmsProvider) Signer(ctx context.Context, keyID string) (crypto.Signer, error) {
+	out, err := a.client.GetPublicKeyWithContext(ctx, &kms.GetPublicKeyInput{
+		KeyId: aws.String(keyID),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get the public key from AWS KMS")
+	}
+	if aws.StringValue(out.KeyUsage) != kms.KeyUsageTypeSignVerify {
+		return nil, errors.Errorf("the key has to be used to sign and verify, it's used to %s", aws.StringValue(out.KeyUsage))
+	}
+	public, err := x509.ParsePKIXPublicKey(out.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse the public key")
+	}
+	return &awsKmsSigner{
+		client: a.client,
+		keyID:  keyID,
+		public: public,
+	}, nil
+}
+
+type awsKmsSigner struct {
+	client kmsiface.KMSAPI
+	keyID  string
+	public crypto.PublicKey
+}
+
+var _ crypto.Signer = &awsKmsSigner{}
+
+func (a *awsKmsSigner) Public() crypto.PublicKey {
+	return a.public
+}
+
+func (a *awsKmsSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	algorithm, err := awsKmsSigningAlgorithm(a.public, opts)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), awsKmsSignTimeout)
+	defer cancel()
+	out, err := a.client.SignWithContext(ctx, &kms.SignInput{
+		KeyId:            aws.String(a.keyID),
+		Message:          digest,
+		MessageType:      aws.String(kms.MessageTypeDigest),
+		SigningAlgorithm: aws.String(algorithm),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign with AWS KMS")
+	}
+	return out.Signature, nil
+}
+
+func awsKmsSigningAlgorithm(public crypto.PublicKey, opts crypto.SignerOpts) (string, error) {
+	_, pss := opts.(*rsa.PSSOptions)
+	switch public.(type) {
+	case *rsa.PublicKey:
+		switch {
+		case pss && opts.HashFunc() == crypto.SHA256:
+			return kms.SigningAlgorithmSpecRsassaPssSha256, nil
+		case pss && opts.HashFunc() == crypto.SHA384:
+			return kms.SigningAlgorithmSpecRsassaPssSha384, nil
+		case pss && opts.HashFunc() == crypto.SHA512:
+			return kms.SigningAlgorithmSpecRsassaPssSha512, nil
+		case opts.HashFunc() == crypto.SHA256:
+			return kms.SigningAlgorithmSpecRsassaPkcs1V15Sha256, nil
+		case opts.HashFunc() == crypto.SHA384:
+			return kms.SigningAlgorithmSpecRsassaPkcs1V15Sha384, nil
+		case opts.HashFunc() == crypto.SHA512:
+			return kms.SigningAlgorithmSpecRsassaPkcs1V15Sha512, nil
+		}
+	case *ecdsa.PublicKey:
+		switch opts.HashFunc() {
+		case crypto.SHA256:
+			return kms.SigningAlgorithmSpecEcdsaSha256, nil
+		case crypto.SHA384:
+			return kms.SigningAlgorithmSpecEcdsaSha384, nil
+		case crypto.SHA512:
+			return kms.SigningAlgorithmSpecEcdsaSha512, nil
+		}
+	default:
+		return "", errors.Errorf("unsupported type of the key %T", public)
+	}
+	return "", errors.Errorf("unsupported hash function %s", opts.HashFunc())
+}
@@ -0,0 +1,167 @@
+package keys_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	key_provider "github.com/kumahq/kuma/pkg/config/key-provider"
+	"github.com/kumahq/kuma/pkg/keys"
+	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
+)
+
+const keyID = "alias/kuma-cp"
+
+// fakeKms implements operations of AWS KMS (JSON protocol) used by the key provider
+type fakeKms struct {
+	key *rsa.PrivateKey
+}
+
+func (f *fakeKms) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		KeyId            string
+		Message          []byte
+		MessageType      string
+		SigningAlgorithm string
+	}
+	Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+	respond := func(status int, resp interface{}) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(status)
+		Expect(json.NewEncoder(w).Encode(resp)).To(Succeed())
+	}
+	if req.KeyId != keyID {
+		respond(http.StatusBadRequest, map[string]string{"__type": "NotFoundException", "message": "key not found"})
+		return
+	}
+
+	switch r.Header.Get("X-Amz-Target") {
+	case "TrentService.GetPublicKey":
+		der, err := x509.MarshalPKIXPublicKey(f.key.Public())
+		Expect(err).ToNot(HaveOccurred())
+		respond(http.StatusOK, map[string]interface{}{"KeyId": keyID, "KeyUsage": "SIGN_VERIFY", "PublicKey": der})
+	case "TrentService.Sign":
+		Expect(req.MessageType).To(Equal("DIGEST"))
+		Expect(req.SigningAlgorithm).To(Equal("RSASSA_PKCS1_V1_5_SHA_256"))
+		signature, err := rsa.SignPKCS1v15(rand.Reader, f.key, crypto.SHA256, req.Message)
+		Expect(err).ToNot(HaveOccurred())
+		respond(http.StatusOK, map[string]interface{}{"KeyId": keyID, "Signature": signature})
+	default:
+		respond(http.StatusBadRequest, map[string]string{"__type": "InvalidAction", "message": "unknown action"})
+	}
+}
+
+func selfSignedCert(key crypto.Signer) []byte {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "kuma-cp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	Expect(err).ToNot(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+var _ = Describe("AWS KMS key provider", func() {
+	var kms *fakeKms
+	var server *httptest.Server
+	var provider keys.Provider
+
+	BeforeEach(func() {
+		Expect(os.Setenv("AWS_ACCESS_KEY_ID", "AKIAEXAMPLE")).To(Succeed())
+		Expect(os.Setenv("AWS_SECRET_ACCESS_KEY", "secret")).To(Succeed())
+
+		key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+		Expect(err).ToNot(HaveOccurred())
+		kms = &fakeKms{key: key}
+		server = httptest.NewServer(kms)
+
+		provider, err = keys.NewProvider(key_provider.KeyProviderConfig{
+			Type: key_provider.AwsKmsType,
+			AwsKms: key_provider.AwsKmsConfig{
+				Region:   "us-east-1",
+				Endpoint: server.URL,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.Unsetenv("AWS_ACCESS_KEY_ID")).To(Succeed())
+		Expect(os.Unsetenv("AWS_SECRET_ACCESS_KEY")).To(Succeed())
+	})
+
+	It("should sign with the key of KMS", func() {
+		// when
+		signer, err := provider.Signer(context.Background(), keyID)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signer.Public()).To(Equal(kms.key.Public()))
+
+		// when
+		digest := sha256.Sum256([]byte("message"))
+		signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rsa.VerifyPKCS1v15(&kms.key.PublicKey, crypto.SHA256, digest[:], signature)).To(Succeed())
+	})
+
+	It("should fail when the key does not exist", func() {
+		// when
+		_, err := provider.Signer(context.Background(), "alias/other")
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not get the public key from AWS KMS: NotFoundException: key not found")))
+	})
+
+	It("should build client certificate of the key", func() {
+		// when
+		cert, err := keys.ClientCertificate(context.Background(), provider, keyID, selfSignedCert(kms.key))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.Certificate).To(HaveLen(1))
+		Expect(cert.Leaf.Subject.CommonName).To(Equal("kuma-cp"))
+		Expect(cert.PrivateKey.(crypto.Signer).Public()).To(Equal(kms.key.Public()))
+	})
+
+	It("should fail when the certificate does not match the key", func() {
+		// given
+		other, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = keys.ClientCertificate(context.Background(), provider, keyID, selfSignedCert(other))
+
+		// then
+		Expect(err).To(MatchError(`the certificate does not match the key "alias/kuma-cp"`))
+	})
+
+	It("should require the certificate, because KMS does not store certificates", func() {
+		// when
+		_, err := keys.ClientCertificate(context.Background(), provider, keyID, nil)
+
+		// then
+		Expect(err).To(MatchError("the certificate has to be provided, because the key provider does not store certificates"))
+	})
+})
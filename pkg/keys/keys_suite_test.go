@@ -0,0 +1,11 @@
+package keys_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestKeys(t *testing.T) {
+	test.RunSpecs(t, "Keys Suite")
+}
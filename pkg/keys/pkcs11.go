@@ -0,0 +1,171 @@
+//go:build pkcs11
+// +build pkcs11
+
+package keys
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+	"github.com/pkg/errors"
+
+	key_provider "github.com/kumahq/kuma/pkg/config/key-provider"
+)
+
+// pkcs1DigestInfoPrefixes are DER encoded DigestInfo prefixes of the digests signed with CKM_RSA_PKCS.
+var pkcs1DigestInfoPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+type pkcs11Provider struct {
+	// session is shared by all the signers, PKCS#11 sessions cannot be used concurrently
+	sync.Mutex
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+}
+
+var _ Provider = &pkcs11Provider{}
+var _ CertificateProvider = &pkcs11Provider{}
+
+// NewPkcs11Provider builds Provider of RSA keys of the PKCS#11 token. Keys and certificates are identified by their labels.
+func NewPkcs11Provider(cfg key_provider.Pkcs11Config) (Provider, error) {
+	ctx := pkcs11.New(cfg.Library)
+	if ctx == nil {
+		return nil, errors.Errorf("could not load PKCS#11 module %q", cfg.Library)
+	}
+	if err := ctx.Initialize(); err != nil {
+		return nil, errors.Wrap(err, "could not initialize PKCS#11 module")
+	}
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list slots of PKCS#11 module")
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil || info.Label != cfg.TokenLabel {
+			continue
+		}
+		session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not open session of PKCS#11 token")
+		}
+		if err := ctx.Login(session, pkcs11.CKU_USER, cfg.Pin); err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+			return nil, errors.Wrap(err, "could not log in to PKCS#11 token")
+		}
+		return &pkcs11Provider{
+			ctx:     ctx,
+			session: session,
+		}, nil
+	}
+	return nil, errors.Errorf("could not find PKCS#11 token %q", cfg.TokenLabel)
+}
+
+func (p *pkcs11Provider) Signer(_ context.Context, keyID string) (crypto.Signer, error) {
+	p.Lock()
+	defer p.Unlock()
+	privateKey, err := p.findObject(pkcs11.CKO_PRIVATE_KEY, keyID)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := p.findObject(pkcs11.CKO_PUBLIC_KEY, keyID)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := p.ctx.GetAttributeValue(p.session, publicKey, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get the public key from PKCS#11 token")
+	}
+	// CKA_KEY_TYPE is CK_ULONG in the native byte order and CKK_RSA is 0
+	if len(bytes.Trim(attrs[0].Value, "\x00")) != 0 {
+		return nil, errors.Errorf("the key %q has to be an RSA key", keyID)
+	}
+	return &pkcs11Signer{
+		provider: p,
+		key:      privateKey,
+		public: &rsa.PublicKey{
+			N: new(big.Int).SetBytes(attrs[1].Value),
+			E: int(new(big.Int).SetBytes(attrs[2].Value).Int64()),
+		},
+	}, nil
+}
+
+func (p *pkcs11Provider) Certificate(_ context.Context, keyID string) ([]byte, error) {
+	p.Lock()
+	defer p.Unlock()
+	cert, err := p.findObject(pkcs11.CKO_CERTIFICATE, keyID)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := p.ctx.GetAttributeValue(p.session, cert, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get the certificate from PKCS#11 token")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: attrs[0].Value}), nil
+}
+
+func (p *pkcs11Provider) findObject(class uint, label string) (pkcs11.ObjectHandle, error) {
+	if err := p.ctx.FindObjectsInit(p.session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}); err != nil {
+		return 0, errors.Wrap(err, "could not find objects of PKCS#11 token")
+	}
+	defer func() {
+		_ = p.ctx.FindObjectsFinal(p.session)
+	}()
+	objects, _, err := p.ctx.FindObjects(p.session, 1)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not find objects of PKCS#11 token")
+	}
+	if len(objects) == 0 {
+		return 0, errors.Errorf("could not find object %q of PKCS#11 token", label)
+	}
+	return objects[0], nil
+}
+
+type pkcs11Signer struct {
+	provider *pkcs11Provider
+	key      pkcs11.ObjectHandle
+	public   *rsa.PublicKey
+}
+
+var _ crypto.Signer = &pkcs11Signer{}
+
+func (p *pkcs11Signer) Public() crypto.PublicKey {
+	return p.public
+}
+
+func (p *pkcs11Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if _, ok := opts.(*rsa.PSSOptions); ok {
+		return nil, errors.New("RSA-PSS signatures are not supported by PKCS#11 key provider")
+	}
+	prefix, ok := pkcs1DigestInfoPrefixes[opts.HashFunc()]
+	if !ok {
+		return nil, errors.Errorf("unsupported hash function %s", opts.HashFunc())
+	}
+	p.provider.Lock()
+	defer p.provider.Unlock()
+	if err := p.provider.ctx.SignInit(p.provider.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)}, p.key); err != nil {
+		return nil, errors.Wrap(err, "could not sign with PKCS#11 token")
+	}
+	signature, err := p.provider.ctx.Sign(p.provider.session, append(append([]byte{}, prefix...), digest...))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign with PKCS#11 token")
+	}
+	return signature, nil
+}
@@ -0,0 +1,15 @@
+//go:build !pkcs11
+// +build !pkcs11
+
+package keys
+
+import (
+	"github.com/pkg/errors"
+
+	key_provider "github.com/kumahq/kuma/pkg/config/key-provider"
+)
+
+// NewPkcs11Provider is available only when built with the "pkcs11" build tag, because it requires cgo.
+func NewPkcs11Provider(key_provider.Pkcs11Config) (Provider, error) {
+	return nil, errors.New(`"pkcs11" key provider is not supported, the Control Plane has to be built with the "pkcs11" build tag`)
+}
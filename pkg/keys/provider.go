@@ -0,0 +1,88 @@
+package keys
+
+import (
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+
+	key_provider "github.com/kumahq/kuma/pkg/config/key-provider"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+)
+
+// Provider provides private keys of the Control Plane that never leave the provider, like keys of HSM or KMS.
+type Provider interface {
+	// Signer returns the signer of the key. The key is identified by the id specific to the provider.
+	Signer(ctx context.Context, keyID string) (crypto.Signer, error)
+}
+
+// CertificateProvider is implemented by providers that store certificates next to the keys.
+type CertificateProvider interface {
+	// Certificate returns PEM encoded certificate of the key.
+	Certificate(ctx context.Context, keyID string) ([]byte, error)
+}
+
+func NewProvider(cfg key_provider.KeyProviderConfig) (Provider, error) {
+	switch cfg.Type {
+	case "":
+		return &noneProvider{}, nil
+	case key_provider.AwsKmsType:
+		return NewAwsKmsProvider(cfg.AwsKms)
+	case key_provider.Pkcs11Type:
+		return NewPkcs11Provider(cfg.Pkcs11)
+	default:
+		return nil, errors.Errorf("unknown key provider type %q", cfg.Type)
+	}
+}
+
+type noneProvider struct{}
+
+func (n *noneProvider) Signer(context.Context, string) (crypto.Signer, error) {
+	return nil, errors.New("key provider is not configured")
+}
+
+// ClientCertificate builds TLS certificate of the key of the provider.
+// If the certificate is empty, it's loaded from the provider.
+func ClientCertificate(ctx context.Context, provider Provider, keyID string, certPEM []byte) (tls.Certificate, error) {
+	signer, err := provider.Signer(ctx, keyID)
+	if err != nil {
+		return tls.Certificate{}, errors.Wrapf(err, "could not get the signer of the key %q", keyID)
+	}
+	if len(certPEM) == 0 {
+		certProvider, ok := provider.(CertificateProvider)
+		if !ok {
+			return tls.Certificate{}, errors.New("the certificate has to be provided, because the key provider does not store certificates")
+		}
+		if certPEM, err = certProvider.Certificate(ctx, keyID); err != nil {
+			return tls.Certificate{}, errors.Wrapf(err, "could not get the certificate of the key %q", keyID)
+		}
+	}
+
+	cert := tls.Certificate{
+		PrivateKey: signer,
+	}
+	for _, certBlock := range util_tls.SplitPEMCerts(certPEM) {
+		block, _ := pem.Decode(certBlock)
+		cert.Certificate = append(cert.Certificate, block.Bytes)
+	}
+	if len(cert.Certificate) == 0 {
+		return tls.Certificate{}, errors.New("the certificate does not contain PEM encoded certificates")
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "could not parse the certificate")
+	}
+	if !publicKeysEqual(leaf.PublicKey, signer.Public()) {
+		return tls.Certificate{}, errors.Errorf("the certificate does not match the key %q", keyID)
+	}
+	cert.Leaf = leaf
+	return cert, nil
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	key, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && key.Equal(b)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
 	core_metrics "github.com/kumahq/kuma/pkg/metrics"
 )
 
@@ -70,4 +71,8 @@ func (m *MeteredStore) List(ctx context.Context, list model.ResourceList, option
 	return m.delegate.List(ctx, list, optionsFunc...)
 }
 
+func (m *MeteredStore) Watch(ctx context.Context, optionsFunc ...store.WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return m.delegate.Watch(ctx, optionsFunc...)
+}
+
 var _ store.ResourceStore = &MeteredStore{}
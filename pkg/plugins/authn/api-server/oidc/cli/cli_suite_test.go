@@ -0,0 +1,11 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestOIDCCli(t *testing.T) {
+	test.RunSpecs(t, "OIDC CLI Suite")
+}
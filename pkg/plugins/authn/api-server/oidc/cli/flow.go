@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	net_url "net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/provider"
+)
+
+const (
+	deviceCodeGrantType   = "urn:ietf:params:oauth:grant-type:device_code"
+	refreshTokenGrantType = "refresh_token"
+	// defaultPollInterval is the interval of polling the token endpoint if the OpenID Provider does not define it.
+	defaultPollInterval = 5 * time.Second
+)
+
+// Tokens are the tokens issued by the OpenID Provider.
+type Tokens struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// DeviceAuthorization is the response of the device authorization endpoint.
+// https://www.rfc-editor.org/rfc/rfc8628#section-3.2
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// tokenError is the error response of the OpenID Provider.
+// https://www.rfc-editor.org/rfc/rfc6749#section-5.2
+type tokenError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (t *tokenError) Error() string {
+	if t.Description != "" {
+		return t.Code + ": " + t.Description
+	}
+	return t.Code
+}
+
+// DeviceFlow logs in the user with the OAuth 2.0 Device Authorization Grant, so no redirect URL has to be exposed by kumactl.
+// The prompt is called with the authorization that the user has to approve in the browser.
+func DeviceFlow(
+	ctx context.Context,
+	client *http.Client,
+	metadata *provider.Metadata,
+	clientID string,
+	scopes []string,
+	prompt func(DeviceAuthorization),
+) (*Tokens, error) {
+	if metadata.DeviceAuthorizationEndpoint == "" {
+		return nil, errors.Errorf("OpenID Provider %q does not support the device authorization grant", metadata.Issuer)
+	}
+	authorization := DeviceAuthorization{}
+	if err := postForm(ctx, client, metadata.DeviceAuthorizationEndpoint, net_url.Values{
+		"client_id": {clientID},
+		"scope":     {strings.Join(scopes, " ")},
+	}, &authorization); err != nil {
+		return nil, errors.Wrap(err, "could not start the device authorization")
+	}
+	prompt(authorization)
+
+	interval := defaultPollInterval
+	if authorization.Interval > 0 {
+		interval = time.Duration(authorization.Interval) * time.Second
+	}
+	if authorization.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(authorization.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.New("the device authorization has expired")
+		case <-time.After(interval):
+		}
+		tokens := &Tokens{}
+		err := postForm(ctx, client, metadata.TokenEndpoint, net_url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {authorization.DeviceCode},
+			"client_id":   {clientID},
+		}, tokens)
+		var tokenErr *tokenError
+		switch {
+		case errors.As(err, &tokenErr) && tokenErr.Code == "authorization_pending":
+			continue
+		case errors.As(err, &tokenErr) && tokenErr.Code == "slow_down":
+			interval += defaultPollInterval
+			continue
+		case err != nil:
+			return nil, errors.Wrap(err, "could not obtain tokens")
+		}
+		if tokens.IDToken == "" {
+			return nil, errors.New("OpenID Provider did not issue an ID token, check that the openid scope is requested")
+		}
+		return tokens, nil
+	}
+}
+
+// Refresh obtains a new ID token with the refresh token. The refresh token is kept if the OpenID Provider does not rotate it.
+func Refresh(ctx context.Context, client *http.Client, metadata *provider.Metadata, clientID string, refreshToken string) (*Tokens, error) {
+	tokens := &Tokens{}
+	if err := postForm(ctx, client, metadata.TokenEndpoint, net_url.Values{
+		"grant_type":    {refreshTokenGrantType},
+		"refresh_token": {refreshToken},
+		"client_id":     {clientID},
+	}, tokens); err != nil {
+		return nil, errors.Wrap(err, "could not refresh tokens")
+	}
+	if tokens.IDToken == "" {
+		return nil, errors.New("OpenID Provider did not issue an ID token")
+	}
+	if tokens.RefreshToken == "" {
+		tokens.RefreshToken = refreshToken
+	}
+	return tokens, nil
+}
+
+func postForm(ctx context.Context, client *http.Client, url string, form net_url.Values, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.Wrap(err, "could not construct the request")
+	}
+	req.Header.Set("content-type", "application/x-www-form-urlencoded")
+	req.Header.Set("accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not execute the request")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "could not read a body of the response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		tokenErr := &tokenError{}
+		if err := json.Unmarshal(body, tokenErr); err == nil && tokenErr.Code != "" {
+			return tokenErr
+		}
+		return errors.Errorf("(%d): %s", resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return errors.Wrap(err, "could not unmarshal the response")
+	}
+	return nil
+}
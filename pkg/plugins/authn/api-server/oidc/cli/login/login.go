@@ -0,0 +1,115 @@
+package login
+
+import (
+	"os/exec"
+	"runtime"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	oidc_cli "github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/cli"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/provider"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/ws/client"
+)
+
+var NewHTTPOIDCConfigClient = client.NewHTTPOIDCConfigClient
+
+// OpenBrowser opens the URL in the default browser of the system.
+var OpenBrowser = openBrowser
+
+type loginArgs struct {
+	noBrowser bool
+}
+
+func NewLoginCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	var args loginArgs
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Log in to the Control Plane with OpenID Connect",
+		Long: `Log in to the active Control Plane with OpenID Connect.
+
+kumactl fetches the OpenID Provider configured in the Control Plane, opens the verification page of the provider
+in the browser and waits until the login is approved. ID and refresh tokens are stored in the configuration
+of the active Control Plane and used to authenticate requests to the API Server. The ID token is refreshed
+automatically when it expires. The Control Plane has to run with KUMA_API_SERVER_AUTHN_TYPE set to oidc.`,
+		Example: `
+Log in to the active Control Plane
+$ kumactl login
+
+Log in on a machine without a browser
+$ kumactl login --no-browser
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			controlPlane, err := pctx.CurrentControlPlane()
+			if err != nil {
+				return err
+			}
+			// the client is not authenticated, so expired credentials do not prevent logging in again
+			apiClient, err := pctx.Runtime.NewBaseAPIServerClient(controlPlane.Coordinates.ApiServer, pctx.Args.ApiTimeout)
+			if err != nil {
+				return errors.Wrapf(err, "failed to create a client for Control Plane %q", controlPlane.Name)
+			}
+			oidcConfig, err := NewHTTPOIDCConfigClient(apiClient).Config()
+			if err != nil {
+				return errors.Wrap(err, "failed to get the OpenID Connect configuration of the Control Plane")
+			}
+
+			metadata, err := provider.Discover(cmd.Context(), oidc_cli.ProviderClient, oidcConfig.Issuer)
+			if err != nil {
+				return err
+			}
+			tokens, err := oidc_cli.DeviceFlow(cmd.Context(), oidc_cli.ProviderClient, metadata, oidcConfig.ClientID, oidcConfig.Scopes, func(authorization oidc_cli.DeviceAuthorization) {
+				url := authorization.VerificationURIComplete
+				if url == "" {
+					url = authorization.VerificationURI
+				}
+				cmd.Printf("To log in, open %s and confirm the code %s\n", url, authorization.UserCode)
+				if !args.noBrowser {
+					if err := OpenBrowser(url); err != nil {
+						cmd.Printf("could not open the browser: %s\n", err)
+					}
+				}
+				cmd.Println("waiting for the login to be approved...")
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to log in")
+			}
+
+			_, configured := pctx.Config().GetControlPlane(controlPlane.Name)
+			if configured.Coordinates.GetApiServer() == nil {
+				configured.Coordinates = controlPlane.Coordinates
+			}
+			configured.Coordinates.ApiServer.AuthType = oidc_cli.AuthType
+			configured.Coordinates.ApiServer.AuthConf = map[string]string{
+				oidc_cli.IssuerKey:   oidcConfig.Issuer,
+				oidc_cli.ClientIDKey: oidcConfig.ClientID,
+				oidc_cli.IDTokenKey:  tokens.IDToken,
+			}
+			if tokens.RefreshToken != "" {
+				configured.Coordinates.ApiServer.AuthConf[oidc_cli.RefreshTokenKey] = tokens.RefreshToken
+			}
+			if err := pctx.SaveConfig(); err != nil {
+				return err
+			}
+			cmd.Printf("logged in to Control Plane %q\n", controlPlane.Name)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&args.noBrowser, "no-browser", false, "print the verification URL without opening the browser")
+	return cmd
+}
+
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
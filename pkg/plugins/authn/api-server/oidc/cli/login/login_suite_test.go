@@ -0,0 +1,11 @@
+package login_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestLoginCmd(t *testing.T) {
+	test.RunSpecs(t, "Login Cmd Suite")
+}
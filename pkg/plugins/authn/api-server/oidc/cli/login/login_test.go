@@ -0,0 +1,125 @@
+package login_test
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/emicklei/go-restful"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/config"
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	oidc_cli "github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/cli"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/cli/login"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/ws/server"
+	test_oidc "github.com/kumahq/kuma/pkg/test/oidc"
+)
+
+var _ = Describe("kumactl login", func() {
+	var provider *test_oidc.Provider
+	var controlPlane *httptest.Server
+	var configFile string
+	var openedURLs []string
+
+	BeforeEach(func() {
+		p, err := test_oidc.NewProvider()
+		Expect(err).ToNot(HaveOccurred())
+		provider = p
+		provider.PendingPolls = 1
+
+		container := restful.NewContainer()
+		container.Add(server.NewWebService(api_server.ApiServerAuthnOIDC{
+			Issuer:   provider.Issuer(),
+			ClientID: test_oidc.ClientID,
+			Scopes:   []string{"openid", "email"},
+		}))
+		controlPlane = httptest.NewServer(container)
+
+		configFile = filepath.Join(GinkgoT().TempDir(), "config")
+		cfg := config.DefaultConfiguration()
+		cfg.ControlPlanes[0].Coordinates.ApiServer.Url = controlPlane.URL
+		Expect(config.Save(configFile, &cfg)).To(Succeed())
+
+		openedURLs = nil
+		login.OpenBrowser = func(url string) error {
+			openedURLs = append(openedURLs, url)
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		controlPlane.Close()
+		provider.Close()
+	})
+
+	It("should log in with the device authorization and store tokens", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(kumactl_cmd.DefaultRootContext())
+		buf := &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+		rootCmd.SetArgs([]string{"--config-file", configFile, "login"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		verificationURL := provider.Issuer() + "/activate?user_code=" + test_oidc.UserCode
+		Expect(buf.String()).To(Equal("To log in, open " + verificationURL + " and confirm the code " + test_oidc.UserCode + "\n" +
+			"waiting for the login to be approved...\n" +
+			"logged in to Control Plane \"local\"\n"))
+		Expect(openedURLs).To(Equal([]string{verificationURL}))
+
+		// and
+		cfg := config_proto.Configuration{}
+		Expect(config.Load(configFile, &cfg)).To(Succeed())
+		apiServer := cfg.ControlPlanes[0].Coordinates.ApiServer
+		Expect(apiServer.AuthType).To(Equal(oidc_cli.AuthType))
+		Expect(apiServer.AuthConf).To(HaveKeyWithValue(oidc_cli.IssuerKey, provider.Issuer()))
+		Expect(apiServer.AuthConf).To(HaveKeyWithValue(oidc_cli.ClientIDKey, test_oidc.ClientID))
+		Expect(apiServer.AuthConf).To(HaveKeyWithValue(oidc_cli.RefreshTokenKey, test_oidc.RefreshToken))
+		Expect(apiServer.AuthConf).To(HaveKey(oidc_cli.IDTokenKey))
+	})
+
+	It("should not open the browser with --no-browser", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(kumactl_cmd.DefaultRootContext())
+		rootCmd.SetOut(&bytes.Buffer{})
+		rootCmd.SetArgs([]string{"--config-file", configFile, "login", "--no-browser"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(openedURLs).To(BeEmpty())
+	})
+
+	It("should fail when OpenID Connect is not enabled on the Control Plane", func() {
+		// given
+		controlPlane.Close()
+		controlPlane = httptest.NewServer(restful.NewContainer())
+		cfg := config.DefaultConfiguration()
+		cfg.ControlPlanes[0].Coordinates.ApiServer.Url = controlPlane.URL
+		Expect(config.Save(configFile, &cfg)).To(Succeed())
+
+		rootCmd := cmd.NewRootCmd(kumactl_cmd.DefaultRootContext())
+		rootCmd.SetOut(&bytes.Buffer{})
+		rootCmd.SetErr(&bytes.Buffer{})
+		rootCmd.SetArgs([]string{"--config-file", configFile, "login"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("failed to get the OpenID Connect configuration of the Control Plane: OpenID Connect authentication is not enabled on the Control Plane. Set KUMA_API_SERVER_AUTHN_TYPE to oidc"))
+		_, err = os.Stat(configFile)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
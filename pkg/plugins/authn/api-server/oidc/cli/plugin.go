@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/app/kumactl/pkg/plugins"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/provider"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+const (
+	AuthType        = "oidc"
+	IssuerKey       = "issuer"
+	ClientIDKey     = "clientId"
+	IDTokenKey      = "idToken"
+	RefreshTokenKey = "refreshToken"
+)
+
+// refreshBefore is how long before the expiration the ID token is refreshed, so it does not expire in the middle of the command.
+const refreshBefore = 30 * time.Second
+
+// ProviderClient is used to communicate with the OpenID Provider.
+var ProviderClient = &http.Client{Timeout: 30 * time.Second}
+
+type OIDCAuthnPlugin struct {
+}
+
+var _ plugins.AuthnPlugin = &OIDCAuthnPlugin{}
+var _ plugins.AuthnRefresher = &OIDCAuthnPlugin{}
+
+func (o *OIDCAuthnPlugin) Validate(authConf map[string]string) error {
+	if authConf[IssuerKey] == "" || authConf[ClientIDKey] == "" || authConf[IDTokenKey] == "" {
+		return errors.New("provide issuer=ISSUER, clientId=CLIENT_ID and idToken=ID_TOKEN or use kumactl login")
+	}
+	return nil
+}
+
+func (o *OIDCAuthnPlugin) DecorateClient(delegate util_http.Client, authConf map[string]string) (util_http.Client, error) {
+	return util_http.ClientFunc(func(req *http.Request) (*http.Response, error) {
+		req.Header.Set("authorization", "Bearer "+authConf[IDTokenKey])
+		return delegate.Do(req)
+	}), nil
+}
+
+func (o *OIDCAuthnPlugin) Refresh(authConf map[string]string) (map[string]string, error) {
+	claims := jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(authConf[IDTokenKey], &claims); err != nil {
+		return nil, errors.Wrap(err, "could not parse the ID token")
+	}
+	if claims.ExpiresAt == nil || time.Until(claims.ExpiresAt.Time) > refreshBefore {
+		return nil, nil
+	}
+	if authConf[RefreshTokenKey] == "" {
+		return nil, errors.New("ID token has expired. Use kumactl login to log in again")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ProviderClient.Timeout)
+	defer cancel()
+	metadata, err := provider.Discover(ctx, ProviderClient, authConf[IssuerKey])
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := Refresh(ctx, ProviderClient, metadata, authConf[ClientIDKey], authConf[RefreshTokenKey])
+	if err != nil {
+		return nil, errors.Wrap(err, "ID token has expired and could not be refreshed. Use kumactl login to log in again")
+	}
+	refreshed := map[string]string{}
+	for k, v := range authConf {
+		refreshed[k] = v
+	}
+	refreshed[IDTokenKey] = tokens.IDToken
+	refreshed[RefreshTokenKey] = tokens.RefreshToken
+	return refreshed, nil
+}
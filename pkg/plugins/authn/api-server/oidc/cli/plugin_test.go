@@ -0,0 +1,113 @@
+package cli_test
+
+import (
+	"net/http"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kumactl_cmd "github.com/kumahq/kuma/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/app/kumactl/pkg/config"
+	config_proto "github.com/kumahq/kuma/pkg/config/app/kumactl/v1alpha1"
+	oidc_cli "github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/cli"
+	test_oidc "github.com/kumahq/kuma/pkg/test/oidc"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+var _ = Describe("OIDC Authn Plugin", func() {
+	var provider *test_oidc.Provider
+	var configFile string
+	var authorization string
+	var rootCtx *kumactl_cmd.RootContext
+
+	BeforeEach(func() {
+		p, err := test_oidc.NewProvider()
+		Expect(err).ToNot(HaveOccurred())
+		provider = p
+
+		configFile = filepath.Join(GinkgoT().TempDir(), "config")
+		rootCtx = kumactl_cmd.DefaultRootContext()
+		rootCtx.Args.ConfigFile = configFile
+		rootCtx.Runtime.NewBaseAPIServerClient = func(*config_proto.ControlPlaneCoordinates_ApiServer, time.Duration) (util_http.Client, error) {
+			return util_http.ClientFunc(func(req *http.Request) (*http.Response, error) {
+				authorization = req.Header.Get("authorization")
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			}), nil
+		}
+	})
+
+	AfterEach(func() {
+		provider.Close()
+	})
+
+	login := func(idToken, refreshToken string) {
+		cfg := config.DefaultConfiguration()
+		cfg.ControlPlanes[0].Coordinates.ApiServer.AuthType = oidc_cli.AuthType
+		cfg.ControlPlanes[0].Coordinates.ApiServer.AuthConf = map[string]string{
+			oidc_cli.IssuerKey:       provider.Issuer(),
+			oidc_cli.ClientIDKey:     test_oidc.ClientID,
+			oidc_cli.IDTokenKey:      idToken,
+			oidc_cli.RefreshTokenKey: refreshToken,
+		}
+		Expect(config.Save(configFile, &cfg)).To(Succeed())
+		Expect(rootCtx.LoadConfig()).To(Succeed())
+	}
+
+	send := func() error {
+		client, err := rootCtx.BaseAPIServerClient()
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequest("GET", "/", nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.Do(req)
+		return err
+	}
+
+	savedAuthConf := func() map[string]string {
+		cfg := config_proto.Configuration{}
+		Expect(config.Load(configFile, &cfg)).To(Succeed())
+		return cfg.ControlPlanes[0].Coordinates.ApiServer.AuthConf
+	}
+
+	It("should authenticate with the ID token", func() {
+		// given
+		idToken := provider.IDToken("john.doe", time.Hour)
+		login(idToken, test_oidc.RefreshToken)
+
+		// when
+		Expect(send()).To(Succeed())
+
+		// then
+		Expect(authorization).To(Equal("Bearer " + idToken))
+		Expect(savedAuthConf()).To(HaveKeyWithValue(oidc_cli.IDTokenKey, idToken))
+	})
+
+	It("should refresh and save the expired ID token", func() {
+		// given
+		expired := provider.IDToken("john.doe", -time.Minute)
+		login(expired, test_oidc.RefreshToken)
+
+		// when
+		Expect(send()).To(Succeed())
+
+		// then
+		refreshed := savedAuthConf()[oidc_cli.IDTokenKey]
+		Expect(refreshed).ToNot(Equal(expired))
+		Expect(authorization).To(Equal("Bearer " + refreshed))
+		Expect(savedAuthConf()).To(HaveKeyWithValue(oidc_cli.RefreshTokenKey, test_oidc.RefreshToken))
+	})
+
+	It("should ask to log in again when the refresh token is not valid", func() {
+		// given
+		login(provider.IDToken("john.doe", -time.Minute), "invalid")
+
+		// when
+		err := send()
+
+		// then
+		Expect(err).To(MatchError(`failed to refresh credentials of authentication type "oidc": ID token has expired and could not be refreshed. Use kumactl login to log in again: could not refresh tokens: invalid_grant: refresh token is not valid`))
+	})
+})
@@ -0,0 +1,54 @@
+package oidc
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kumahq/kuma/pkg/api-server/authn"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/plugins"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/ws/server"
+)
+
+const PluginName = "oidc"
+
+// providerTimeout is a timeout of requests to the OpenID Provider.
+const providerTimeout = 10 * time.Second
+
+var log = core.Log.WithName("plugins").WithName("authn").WithName("api-server").WithName("oidc")
+
+type plugin struct {
+}
+
+var _ plugins.AuthnAPIServerPlugin = plugin{}
+var _ plugins.BootstrapPlugin = plugin{}
+
+func init() {
+	plugins.Register(PluginName, &plugin{})
+}
+
+func (c plugin) NewAuthenticator(context plugins.PluginContext) (authn.Authenticator, error) {
+	validator := NewIDTokenValidator(context.Config().ApiServer.Authn.OIDC, &http.Client{Timeout: providerTimeout})
+	return IDTokenAuthenticator(validator), nil
+}
+
+func (c plugin) BeforeBootstrap(*plugins.MutablePluginContext, plugins.PluginConfig) error {
+	return nil
+}
+
+// AfterBootstrap exposes the configuration of the OpenID Provider, so kumactl login only needs the address of the control plane.
+func (c plugin) AfterBootstrap(context *plugins.MutablePluginContext, _ plugins.PluginConfig) error {
+	if context.Config().ApiServer.Authn.Type != PluginName {
+		return nil
+	}
+	context.APIManager().Add(server.NewWebService(context.Config().ApiServer.Authn.OIDC))
+	return nil
+}
+
+func (c plugin) Name() plugins.PluginName {
+	return PluginName
+}
+
+func (c plugin) Order() int {
+	return plugins.EnvironmentPreparedOrder
+}
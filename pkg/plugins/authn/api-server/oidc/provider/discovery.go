@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const discoveryPath = "/.well-known/openid-configuration"
+
+// Metadata is a subset of the OpenID Provider metadata used by the control plane and kumactl.
+// https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type Metadata struct {
+	Issuer                      string `json:"issuer"`
+	JwksURI                     string `json:"jwks_uri"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+// Discover fetches the metadata of the OpenID Provider of the issuer.
+func Discover(ctx context.Context, client *http.Client, issuer string) (*Metadata, error) {
+	url := strings.TrimSuffix(issuer, "/") + discoveryPath
+	metadata := &Metadata{}
+	if err := getJSON(ctx, client, url, metadata); err != nil {
+		return nil, errors.Wrapf(err, "could not discover the configuration of OpenID Provider %q", issuer)
+	}
+	if strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return nil, errors.Errorf("issuer %q of the OpenID Provider configuration does not match the expected issuer %q", metadata.Issuer, issuer)
+	}
+	return metadata, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not construct the request")
+	}
+	req.Header.Set("accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not execute the request")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "could not read a body of the response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("(%d): %s", resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return errors.Wrap(err, "could not unmarshal the response")
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/provider"
+)
+
+// reservedPrefix is a prefix of the users and groups defined by Kuma. The OpenID Provider cannot issue them,
+// groups with this prefix can be only assigned with group mappings.
+const reservedPrefix = "mesh-system:"
+
+var validMethods = []string{
+	jwt.SigningMethodRS256.Name, jwt.SigningMethodRS384.Name, jwt.SigningMethodRS512.Name,
+	jwt.SigningMethodPS256.Name, jwt.SigningMethodPS384.Name, jwt.SigningMethodPS512.Name,
+	jwt.SigningMethodES256.Name, jwt.SigningMethodES384.Name, jwt.SigningMethodES512.Name,
+}
+
+// IDTokenValidator validates ID tokens issued by the OpenID Provider and maps their claims to the user.
+type IDTokenValidator interface {
+	Validate(ctx context.Context, rawToken string) (user.User, error)
+}
+
+type idTokenValidator struct {
+	config api_server.ApiServerAuthnOIDC
+	client *http.Client
+
+	sync.Mutex
+	issuer string
+	keySet *provider.KeySet
+}
+
+var _ IDTokenValidator = &idTokenValidator{}
+
+func NewIDTokenValidator(config api_server.ApiServerAuthnOIDC, client *http.Client) IDTokenValidator {
+	return &idTokenValidator{
+		config: config,
+		client: client,
+	}
+}
+
+func (v *idTokenValidator) Validate(ctx context.Context, rawToken string) (user.User, error) {
+	issuer, keySet, err := v.discover(ctx)
+	if err != nil {
+		return user.User{}, err
+	}
+	claims := jwt.MapClaims{}
+	_, err = jwt.NewParser(jwt.WithValidMethods(validMethods)).ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return keySet.Key(ctx, kid)
+	})
+	if err != nil {
+		return user.User{}, errors.Wrap(err, "could not parse token")
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return user.User{}, errors.New("token has no expiration time")
+	}
+	if !claims.VerifyIssuer(issuer, true) {
+		return user.User{}, errors.Errorf("token is not issued by %q", issuer)
+	}
+	if !claims.VerifyAudience(v.config.ClientID, true) {
+		return user.User{}, errors.Errorf("token is not issued for client %q", v.config.ClientID)
+	}
+
+	name, _ := claims[v.config.UsernameClaim].(string)
+	if name == "" {
+		return user.User{}, errors.Errorf("token has no %q claim", v.config.UsernameClaim)
+	}
+	if strings.HasPrefix(name, reservedPrefix) {
+		return user.User{}, errors.Errorf("user %q is reserved", name)
+	}
+	if v.config.UsernameClaim == "email" && !isTrue(claims["email_verified"]) {
+		return user.User{}, errors.Errorf("email %q is not verified", name)
+	}
+	u := user.User{
+		Name:   name,
+		Groups: v.groups(claims),
+	}
+	if v.config.TenantClaim != "" {
+		tenant, _ := claims[v.config.TenantClaim].(string)
+		if tenant == "" {
+			// without the tenant the user would have access to all meshes
+			return user.User{}, errors.Errorf("token has no %q claim", v.config.TenantClaim)
+		}
+		u.Tenant = tenant
+	}
+	return u, nil
+}
+
+// discover fetches the configuration of the OpenID Provider on the first validated token,
+// so the control plane starts even if the OpenID Provider is not available.
+func (v *idTokenValidator) discover(ctx context.Context) (string, *provider.KeySet, error) {
+	v.Lock()
+	defer v.Unlock()
+	if v.keySet == nil {
+		metadata, err := provider.Discover(ctx, v.client, v.config.Issuer)
+		if err != nil {
+			return "", nil, err
+		}
+		v.issuer = metadata.Issuer
+		v.keySet = provider.NewKeySet(v.client, metadata.JwksURI)
+	}
+	return v.issuer, v.keySet, nil
+}
+
+// groups returns the groups of the claim of groups with the group mappings applied.
+func (v *idTokenValidator) groups(claims jwt.MapClaims) []string {
+	var groups []string
+	switch value := claims[v.config.GroupsClaim].(type) {
+	case string:
+		groups = []string{value}
+	case []interface{}:
+		for _, group := range value {
+			if group, ok := group.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+	}
+	var mapped []string
+	for _, group := range groups {
+		if mapping, ok := v.config.GroupMappings[group]; ok {
+			mapped = append(mapped, mapping)
+		} else if !strings.HasPrefix(group, reservedPrefix) {
+			mapped = append(mapped, group)
+		}
+	}
+	return mapped
+}
+
+// isTrue returns true for the true boolean, some OpenID Providers send booleans as strings.
+func isTrue(value interface{}) bool {
+	switch value := value.(type) {
+	case bool:
+		return value
+	case string:
+		return value == "true"
+	default:
+		return false
+	}
+}
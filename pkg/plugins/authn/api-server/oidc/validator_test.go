@@ -0,0 +1,195 @@
+package oidc_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core/user"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc"
+	test_oidc "github.com/kumahq/kuma/pkg/test/oidc"
+)
+
+var _ = Describe("ID Token Validator", func() {
+	var provider *test_oidc.Provider
+	var config api_server.ApiServerAuthnOIDC
+
+	BeforeEach(func() {
+		p, err := test_oidc.NewProvider()
+		Expect(err).ToNot(HaveOccurred())
+		provider = p
+		config = api_server.ApiServerAuthnOIDC{
+			Issuer:        provider.Issuer(),
+			ClientID:      test_oidc.ClientID,
+			UsernameClaim: "sub",
+			GroupsClaim:   "groups",
+			GroupMappings: api_server.GroupMappings{
+				"platform-team": "mesh-system:admin",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		provider.Close()
+	})
+
+	validate := func(token string) (user.User, error) {
+		return oidc.NewIDTokenValidator(config, http.DefaultClient).Validate(context.Background(), token)
+	}
+
+	claims := func(overrides jwt.MapClaims) jwt.MapClaims {
+		claims := jwt.MapClaims{
+			"iss": provider.Issuer(),
+			"aud": test_oidc.ClientID,
+			"sub": "john.doe",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(claims, k)
+			} else {
+				claims[k] = v
+			}
+		}
+		return claims
+	}
+
+	It("should map claims to the user", func() {
+		// given
+		token := provider.Sign(claims(jwt.MapClaims{
+			"groups": []string{"platform-team", "developers", "mesh-system:admin-impersonation"},
+		}))
+
+		// when
+		u, err := validate(token)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u).To(Equal(user.User{
+			Name:   "john.doe",
+			Groups: []string{"mesh-system:admin", "developers"},
+		}))
+	})
+
+	It("should map the tenant claim to the tenant of the user", func() {
+		// given
+		config.TenantClaim = "org"
+		token := provider.Sign(claims(jwt.MapClaims{
+			"org": "team-a",
+		}))
+
+		// when
+		u, err := validate(token)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Tenant).To(Equal("team-a"))
+	})
+
+	It("should reject token without the tenant claim when the claim is configured", func() {
+		// given
+		config.TenantClaim = "org"
+		token := provider.Sign(claims(nil))
+
+		// when
+		_, err := validate(token)
+
+		// then
+		Expect(err).To(MatchError(`token has no "org" claim`))
+	})
+
+	It("should fetch the key set once", func() {
+		// given
+		validator := oidc.NewIDTokenValidator(config, http.DefaultClient)
+
+		// when
+		for i := 0; i < 3; i++ {
+			_, err := validator.Validate(context.Background(), provider.Sign(claims(nil)))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// then
+		Expect(provider.JwksRequests).To(Equal(1))
+	})
+
+	It("should require verified email when email is the username claim", func() {
+		// given
+		config.UsernameClaim = "email"
+
+		// when
+		u, err := validate(provider.Sign(claims(jwt.MapClaims{
+			"email":          "john.doe@example.com",
+			"email_verified": true,
+		})))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Name).To(Equal("john.doe@example.com"))
+
+		// when
+		_, err = validate(provider.Sign(claims(jwt.MapClaims{
+			"email": "john.doe@example.com",
+		})))
+
+		// then
+		Expect(err).To(MatchError(`email "john.doe@example.com" is not verified`))
+	})
+
+	type testCase struct {
+		claims jwt.MapClaims
+		err    string
+	}
+
+	DescribeTable("should reject invalid tokens",
+		func(given testCase) {
+			// when
+			_, err := validate(provider.Sign(claims(given.claims)))
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(given.err))
+		},
+		Entry("expired token", testCase{
+			claims: jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()},
+			err:    "Token is expired",
+		}),
+		Entry("token without expiration", testCase{
+			claims: jwt.MapClaims{"exp": nil},
+			err:    "token has no expiration time",
+		}),
+		Entry("token of another issuer", testCase{
+			claims: jwt.MapClaims{"iss": "https://idp.example.com"},
+			err:    "token is not issued by",
+		}),
+		Entry("token of another client", testCase{
+			claims: jwt.MapClaims{"aud": "another-client"},
+			err:    `token is not issued for client "kumactl"`,
+		}),
+		Entry("token without username", testCase{
+			claims: jwt.MapClaims{"sub": ""},
+			err:    `token has no "sub" claim`,
+		}),
+		Entry("reserved user", testCase{
+			claims: jwt.MapClaims{"sub": "mesh-system:admin"},
+			err:    `user "mesh-system:admin" is reserved`,
+		}),
+	)
+
+	It("should reject tokens signed with unknown key", func() {
+		// given
+		another, err := test_oidc.NewProvider()
+		Expect(err).ToNot(HaveOccurred())
+		defer another.Close()
+
+		// when
+		_, err = validate(another.Sign(claims(nil)))
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("crypto/rsa: verification error")))
+	})
+})
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/ws"
+	util_http "github.com/kumahq/kuma/pkg/util/http"
+)
+
+type OIDCConfigClient interface {
+	Config() (*ws.OIDCConfig, error)
+}
+
+var _ OIDCConfigClient = &httpOIDCConfigClient{}
+
+func NewHTTPOIDCConfigClient(client util_http.Client) OIDCConfigClient {
+	return &httpOIDCConfigClient{
+		client: client,
+	}
+}
+
+type httpOIDCConfigClient struct {
+	client util_http.Client
+}
+
+func (h *httpOIDCConfigClient) Config() (*ws.OIDCConfig, error) {
+	req, err := http.NewRequest("GET", "/oidc", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not construct the request")
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not execute the request")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read a body of the request")
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.New("OpenID Connect authentication is not enabled on the Control Plane. Set KUMA_API_SERVER_AUTHN_TYPE to oidc")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("(%d): %s", resp.StatusCode, body)
+	}
+	config := &ws.OIDCConfig{}
+	if err := json.Unmarshal(body, config); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal the response")
+	}
+	return config, nil
+}
@@ -0,0 +1,8 @@
+package ws
+
+// OIDCConfig is the configuration of the OpenID Provider used by kumactl login.
+type OIDCConfig struct {
+	Issuer   string   `json:"issuer"`
+	ClientID string   `json:"clientId"`
+	Scopes   []string `json:"scopes"`
+}
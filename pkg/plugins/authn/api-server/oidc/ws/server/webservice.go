@@ -0,0 +1,41 @@
+package server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	api_server "github.com/kumahq/kuma/pkg/config/api-server"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/oidc/ws"
+)
+
+var log = core.Log.WithName("oidc-ws")
+
+type oidcWebService struct {
+	config ws.OIDCConfig
+}
+
+func NewWebService(config api_server.ApiServerAuthnOIDC) *restful.WebService {
+	webservice := oidcWebService{
+		config: ws.OIDCConfig{
+			Issuer:   config.Issuer,
+			ClientID: config.ClientID,
+			Scopes:   config.Scopes,
+		},
+	}
+	return webservice.createWs()
+}
+
+func (o *oidcWebService) createWs() *restful.WebService {
+	webservice := new(restful.WebService).
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	webservice.Path("/oidc").
+		Route(webservice.GET("").To(o.handleConfigRequest))
+	return webservice
+}
+
+func (o *oidcWebService) handleConfigRequest(_ *restful.Request, response *restful.Response) {
+	if err := response.WriteAsJson(o.config); err != nil {
+		log.Error(err, "Could not write the response")
+	}
+}
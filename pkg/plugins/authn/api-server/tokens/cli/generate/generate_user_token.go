@@ -15,6 +15,7 @@ var NewHTTPUserTokenClient = client.NewHTTPUserTokenClient
 type generateUserTokenCmd struct {
 	name     string
 	groups   []string
+	tenant   string
 	validFor time.Duration
 }
 
@@ -27,6 +28,9 @@ func NewGenerateUserTokenCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 		Example: `
 Generate token
 $ kumactl generate user-token --name john.doe@example.com --group users --valid-for 24h
+
+Generate token of a user of the tenant
+$ kumactl generate user-token --name john.doe@example.com --group users --tenant team-a --valid-for 24h
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			client, err := pctx.BaseAPIServerClient()
@@ -35,7 +39,7 @@ $ kumactl generate user-token --name john.doe@example.com --group users --valid-
 			}
 
 			tokenClient := NewHTTPUserTokenClient(client)
-			token, err := tokenClient.Generate(args.name, args.groups, args.validFor)
+			token, err := tokenClient.Generate(args.name, args.groups, args.tenant, args.validFor)
 			if err != nil {
 				return errors.Wrap(err, "failed to generate a user token")
 			}
@@ -46,6 +50,7 @@ $ kumactl generate user-token --name john.doe@example.com --group users --valid-
 	cmd.Flags().StringVar(&args.name, "name", "", "name of the user")
 	_ = cmd.MarkFlagRequired("name")
 	cmd.Flags().StringSliceVar(&args.groups, "group", nil, "group of the user")
+	cmd.Flags().StringVar(&args.tenant, "tenant", "", "tenant of the user. The user can only access meshes of the tenant")
 	cmd.Flags().DurationVar(&args.validFor, "valid-for", 0, `how long the token will be valid (for example "24h")`)
 	_ = cmd.MarkFlagRequired("valid-for")
 	return cmd
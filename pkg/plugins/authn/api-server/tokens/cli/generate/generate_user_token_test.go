@@ -18,8 +18,8 @@ import (
 type fakeUserTokenClient struct {
 }
 
-func (f *fakeUserTokenClient) Generate(name string, groups []string, validFor time.Duration) (string, error) {
-	return "token-" + name + "-" + strings.Join(groups, ",") + "-" + validFor.String(), nil
+func (f *fakeUserTokenClient) Generate(name string, groups []string, tenant string, validFor time.Duration) (string, error) {
+	return "token-" + name + "-" + strings.Join(groups, ",") + "-" + tenant + "-" + validFor.String(), nil
 }
 
 var _ client.UserTokenClient = &fakeUserTokenClient{}
@@ -40,6 +40,7 @@ var _ = Describe("Generate User Token", func() {
 			"--name", "john",
 			"--group", "team-a",
 			"--group", "team-b",
+			"--tenant", "org-a",
 			"--valid-for", "30s",
 		})
 
@@ -48,7 +49,7 @@ var _ = Describe("Generate User Token", func() {
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
-		Expect(buf.String()).To(Equal("token-john-team-a,team-b-30s"))
+		Expect(buf.String()).To(Equal("token-john-team-a,team-b-org-a-30s"))
 	})
 
 	It("should throw an error when name is not specified", func() {
@@ -1,6 +1,10 @@
 package tokens
 
 import (
+	stdcontext "context"
+	"crypto"
+	"crypto/rsa"
+
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/api-server/authn"
@@ -25,6 +29,10 @@ var AccessStrategies = map[string]func(*plugins.MutablePluginContext) access.Gen
 	config_access.StaticType: func(context *plugins.MutablePluginContext) access.GenerateUserTokenAccess {
 		return access.NewStaticGenerateUserTokenAccess(context.Config().Access.Static.GenerateUserToken)
 	},
+	// generating user tokens is not controlled by AccessRoles
+	config_access.RBACType: func(context *plugins.MutablePluginContext) access.GenerateUserTokenAccess {
+		return access.NewStaticGenerateUserTokenAccess(context.Config().Access.Static.GenerateUserToken)
+	},
 }
 
 func init() {
@@ -32,9 +40,17 @@ func init() {
 }
 
 func (c plugin) NewAuthenticator(context plugins.PluginContext) (authn.Authenticator, error) {
+	signingKeyAccessor := core_tokens.NewSigningKeyAccessor(context.ResourceManager(), issuer.UserTokenSigningKeyPrefix)
+	if keyCfg := context.Config().KeyProvider.UserToken; keyCfg.KeyId != "" {
+		signer, err := keyProviderSigningKey(context)
+		if err != nil {
+			return nil, err
+		}
+		signingKeyAccessor = core_tokens.NewStaticSigningKeyAccessor(signer.Public().(*rsa.PublicKey), keyCfg.SerialNumber)
+	}
 	validator := issuer.NewUserTokenValidator(
 		core_tokens.NewValidator(
-			core_tokens.NewSigningKeyAccessor(context.ResourceManager(), issuer.UserTokenSigningKeyPrefix),
+			signingKeyAccessor,
 			core_tokens.NewRevocations(context.ResourceManager(), issuer.UserTokenRevocationsGlobalSecretKey),
 			context.Config().Store.Type,
 		),
@@ -42,15 +58,36 @@ func (c plugin) NewAuthenticator(context plugins.PluginContext) (authn.Authentic
 	return UserTokenAuthenticator(validator), nil
 }
 
+// keyProviderSigningKey returns the signing key of user tokens stored in the key provider.
+func keyProviderSigningKey(context plugins.PluginContext) (crypto.Signer, error) {
+	signer, err := context.KeyProvider().Signer(stdcontext.Background(), context.Config().KeyProvider.UserToken.KeyId)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get the signing key of user tokens from the key provider")
+	}
+	if _, ok := signer.Public().(*rsa.PublicKey); !ok {
+		return nil, errors.New("the signing key of user tokens has to be an RSA key")
+	}
+	return signer, nil
+}
+
 func (c plugin) BeforeBootstrap(*plugins.MutablePluginContext, plugins.PluginConfig) error {
 	return nil
 }
 
 func (c plugin) AfterBootstrap(context *plugins.MutablePluginContext, config plugins.PluginConfig) error {
-	signingKeyManager := core_tokens.NewSigningKeyManager(context.ResourceManager(), issuer.UserTokenSigningKeyPrefix)
-	component := core_tokens.NewDefaultSigningKeyComponent(signingKeyManager, log)
-	if err := context.ComponentManager().Add(component); err != nil {
-		return err
+	var signingKeyManager core_tokens.SigningKeyManager
+	if keyCfg := context.Config().KeyProvider.UserToken; keyCfg.KeyId != "" {
+		signer, err := keyProviderSigningKey(context)
+		if err != nil {
+			return err
+		}
+		signingKeyManager = core_tokens.NewStaticSigningKeyManager(signer, keyCfg.SerialNumber)
+	} else {
+		signingKeyManager = core_tokens.NewSigningKeyManager(context.ResourceManager(), issuer.UserTokenSigningKeyPrefix)
+		component := core_tokens.NewDefaultSigningKeyComponent(signingKeyManager, log)
+		if err := context.ComponentManager().Add(component); err != nil {
+			return err
+		}
 	}
 	accessFn, ok := AccessStrategies[context.Config().Access.Type]
 	if !ok {
@@ -3,5 +3,6 @@ package ws
 type UserTokenRequest struct {
 	Name     string   `json:"name"`
 	Groups   []string `json:"groups"`
+	Tenant   string   `json:"tenant,omitempty"`
 	ValidFor string   `json:"validFor"`
 }
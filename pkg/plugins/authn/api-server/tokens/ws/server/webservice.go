@@ -1,12 +1,14 @@
 package server
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/emicklei/go-restful"
 
 	"github.com/kumahq/kuma/pkg/core"
+	core_access "github.com/kumahq/kuma/pkg/core/access"
 	"github.com/kumahq/kuma/pkg/core/rest/errors"
 	"github.com/kumahq/kuma/pkg/core/user"
 	"github.com/kumahq/kuma/pkg/core/validators"
@@ -40,7 +42,8 @@ func (d *userTokenWebService) createWs() *restful.WebService {
 }
 
 func (d *userTokenWebService) handleIdentityRequest(request *restful.Request, response *restful.Response) {
-	if err := d.access.ValidateGenerate(user.FromCtx(request.Request.Context())); err != nil {
+	requester := user.FromCtx(request.Request.Context())
+	if err := d.access.ValidateGenerate(requester); err != nil {
 		errors.HandleError(response, err, "Could not issue a token")
 		return
 	}
@@ -73,9 +76,18 @@ func (d *userTokenWebService) handleIdentityRequest(request *restful.Request, re
 		return
 	}
 
+	// users of a tenant can only issue tokens for their tenant, so they cannot escape the isolation
+	if requester.Tenant != "" && idReq.Tenant != requester.Tenant {
+		errors.HandleError(response, &core_access.AccessDeniedError{
+			Reason: fmt.Sprintf("user of the tenant %q can only generate tokens for the tenant %q", requester.Tenant, requester.Tenant),
+		}, "Could not issue a token")
+		return
+	}
+
 	token, err := d.issuer.Generate(request.Request.Context(), user.User{
 		Name:   idReq.Name,
 		Groups: idReq.Groups,
+		Tenant: idReq.Tenant,
 	}, validFor)
 	if err != nil {
 		errors.HandleError(response, err, "Could not issue a token")
@@ -38,8 +38,10 @@ var _ = Describe("Auth Tokens WS", func() {
 	var userTokenClient client.UserTokenClient
 	var userTokenValidator issuer.UserTokenValidator
 	var httpClient util_http.Client
+	var requester user.User
 
 	BeforeEach(func() {
+		requester = user.Admin
 		resManager := manager.NewResourceManager(memory.NewStore())
 		signingKeyManager := core_tokens.NewSigningKeyManager(resManager, issuer.UserTokenSigningKeyPrefix)
 		tokenIssuer := issuer.NewUserTokenIssuer(core_tokens.NewTokenIssuer(signingKeyManager))
@@ -55,6 +57,10 @@ var _ = Describe("Auth Tokens WS", func() {
 		ws := server.NewWebService(tokenIssuer, &noopGenerateUserTokenAccess{})
 
 		container := restful.NewContainer()
+		container.Filter(func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+			request.Request = request.Request.WithContext(user.Ctx(request.Request.Context(), requester))
+			chain.ProcessFilter(request, response)
+		})
 		container.Add(ws)
 		srv := httptest.NewServer(container)
 
@@ -65,14 +71,14 @@ var _ = Describe("Auth Tokens WS", func() {
 
 		// wait for the server
 		Eventually(func() error {
-			_, err := userTokenClient.Generate("john.doe@example.com", []string{"team-a"}, 0)
+			_, err := userTokenClient.Generate("john.doe@example.com", []string{"team-a"}, "", 0)
 			return err
 		}).ShouldNot(HaveOccurred())
 	})
 
 	It("should generate token", func() {
 		// when
-		token, err := userTokenClient.Generate("john.doe@example.com", []string{"team-a"}, 1*time.Hour)
+		token, err := userTokenClient.Generate("john.doe@example.com", []string{"team-a"}, "", 1*time.Hour)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -82,9 +88,31 @@ var _ = Describe("Auth Tokens WS", func() {
 		Expect(u.Groups).To(Equal([]string{"team-a"}))
 	})
 
+	It("should generate token of a user of the tenant", func() {
+		// when
+		token, err := userTokenClient.Generate("john.doe@example.com", nil, "org-a", 1*time.Hour)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		u, err := userTokenValidator.Validate(context.Background(), token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(u.Tenant).To(Equal("org-a"))
+	})
+
+	It("should not let a user of the tenant generate token of other tenant", func() {
+		// given
+		requester = user.User{Name: "jane.doe@example.com", Tenant: "org-a"}
+
+		// when
+		_, err := userTokenClient.Generate("john.doe@example.com", nil, "org-b", 1*time.Hour)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`user of the tenant "org-a" can only generate tokens for the tenant "org-a"`)))
+	})
+
 	It("should throw an error when zone is not passed", func() {
 		// when
-		_, err := userTokenClient.Generate("", nil, 1*time.Hour)
+		_, err := userTokenClient.Generate("", nil, "", 1*time.Hour)
 
 		// then
 		Expect(err).To(Equal(&error_types.Error{
@@ -34,7 +34,9 @@ func (h ApiServerBypass) Modify(resources *core_xds.ResourceSet, ctx xds_context
 	if proxy.Dataplane == nil {
 		return nil
 	}
-	if ctx.Mesh.Resource.Spec.IsPassthrough() {
+	// the API server is reachable through the passthrough unless its port may be restricted
+	if ctx.Mesh.Resource.Spec.IsPassthroughFor(proxy.Dataplane.Spec) &&
+		len(ctx.Mesh.Resource.Spec.GetNetworking().GetOutbound().GetPassthroughPorts()) == 0 {
 		return nil
 	}
 
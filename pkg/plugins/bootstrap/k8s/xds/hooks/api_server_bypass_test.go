@@ -53,4 +53,64 @@ var _ = Describe("ApiServerBypass", func() {
 
 		Expect(actual).To(MatchGoldenYAML(filepath.Join("testdata", "api-server-bypass.yaml")))
 	})
+
+	It("should generate configuration for API Server bypass when dataplane disables passthrough", func() {
+		// given
+		hook := hooks.NewApiServerBypass("1.1.1.1", 9090)
+		rs := xds.NewResourceSet()
+		ctx := xds_context.Context{
+			Mesh: xds_context.MeshContext{
+				Resource: &mesh.MeshResource{
+					Spec: &mesh_proto.Mesh{},
+				},
+			},
+		}
+		proxy := &xds.Proxy{
+			APIVersion: envoy.APIV3,
+			Dataplane: &mesh.DataplaneResource{
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						TransparentProxying: &mesh_proto.Dataplane_Networking_TransparentProxying{
+							DisablePassthrough: true,
+						},
+					},
+				},
+			},
+		}
+
+		// when
+		Expect(hook.Modify(rs, ctx, proxy)).To(Succeed())
+
+		// then
+		resp, err := rs.List().ToDeltaDiscoveryResponse()
+		Expect(err).ToNot(HaveOccurred())
+
+		actual, err := util_proto.ToYAML(resp)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(actual).To(MatchGoldenYAML(filepath.Join("testdata", "api-server-bypass.yaml")))
+	})
+
+	It("should not generate configuration for API Server bypass when passthrough is not restricted", func() {
+		// given
+		hook := hooks.NewApiServerBypass("1.1.1.1", 9090)
+		rs := xds.NewResourceSet()
+		ctx := xds_context.Context{
+			Mesh: xds_context.MeshContext{
+				Resource: &mesh.MeshResource{
+					Spec: &mesh_proto.Mesh{},
+				},
+			},
+		}
+		proxy := &xds.Proxy{
+			APIVersion: envoy.APIV3,
+			Dataplane:  &mesh.DataplaneResource{},
+		}
+
+		// when
+		Expect(hook.Modify(rs, ctx, proxy)).To(Succeed())
+
+		// then
+		Expect(rs.List()).To(BeEmpty())
+	})
 })
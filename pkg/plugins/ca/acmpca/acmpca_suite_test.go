@@ -0,0 +1,11 @@
+package acmpca_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestCaAcmPca(t *testing.T) {
+	test.RunSpecs(t, "CA ACM PCA Suite")
+}
@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: pkg/plugins/ca/acmpca/config/acmpca_ca_config.proto
+
+package config
+
+import (
+	v1alpha1 "github.com/kumahq/kuma/api/system/v1alpha1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AcmPcaCertificateAuthorityConfig defines configuration for AWS Certificate
+// Manager Private CA plugin
+type AcmPcaCertificateAuthorityConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ARN of the private CA
+	Arn string `protobuf:"bytes,1,opt,name=arn,proto3" json:"arn,omitempty"`
+	// Region of the private CA. Default: the region from the ARN
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	// Custom endpoint of ACM Private CA, e.g. VPC endpoint
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Algorithm used to sign certificates. Default: SHA256WITHRSA
+	SigningAlgorithm string `protobuf:"bytes,4,opt,name=signingAlgorithm,proto3" json:"signingAlgorithm,omitempty"`
+	// ARN of the template used to issue certificates. Default:
+	// EndEntityCertificate/V1
+	TemplateArn string `protobuf:"bytes,5,opt,name=templateArn,proto3" json:"templateArn,omitempty"`
+	// Authentication to AWS
+	Auth *AcmPcaCertificateAuthorityConfig_Auth `protobuf:"bytes,6,opt,name=auth,proto3" json:"auth,omitempty"`
+	// Maximum number of certificates issued per second. Default: 25
+	IssuanceRate *wrapperspb.UInt32Value `protobuf:"bytes,7,opt,name=issuanceRate,proto3" json:"issuanceRate,omitempty"`
+	// Maximum number of certificates issued at the same time. Default: 10
+	MaxConcurrentIssuance *wrapperspb.UInt32Value `protobuf:"bytes,8,opt,name=maxConcurrentIssuance,proto3" json:"maxConcurrentIssuance,omitempty"`
+}
+
+func (x *AcmPcaCertificateAuthorityConfig) Reset() {
+	*x = AcmPcaCertificateAuthorityConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcmPcaCertificateAuthorityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcmPcaCertificateAuthorityConfig) ProtoMessage() {}
+
+func (x *AcmPcaCertificateAuthorityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcmPcaCertificateAuthorityConfig.ProtoReflect.Descriptor instead.
+func (*AcmPcaCertificateAuthorityConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcmPcaCertificateAuthorityConfig) GetArn() string {
+	if x != nil {
+		return x.Arn
+	}
+	return ""
+}
+
+func (x *AcmPcaCertificateAuthorityConfig) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AcmPcaCertificateAuthorityConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *AcmPcaCertificateAuthorityConfig) GetSigningAlgorithm() string {
+	if x != nil {
+		return x.SigningAlgorithm
+	}
+	return ""
+}
+
+func (x *AcmPcaCertificateAuthorityConfig) GetTemplateArn() string {
+	if x != nil {
+		return x.TemplateArn
+	}
+	return ""
+}
+
+func (x *AcmPcaCertificateAuthorityConfig) GetAuth() *AcmPcaCertificateAuthorityConfig_Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *AcmPcaCertificateAuthorityConfig) GetIssuanceRate() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.IssuanceRate
+	}
+	return nil
+}
+
+func (x *AcmPcaCertificateAuthorityConfig) GetMaxConcurrentIssuance() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxConcurrentIssuance
+	}
+	return nil
+}
+
+// Auth defines the method of authentication to AWS.
+type AcmPcaCertificateAuthorityConfig_Auth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Static credentials of AWS. If not defined, the default credential chain
+	// of AWS SDK is used (environment variables, shared credentials file, IAM
+	// role of the instance or the service account).
+	AwsCredentials *AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials `protobuf:"bytes,1,opt,name=awsCredentials,proto3" json:"awsCredentials,omitempty"`
+}
+
+func (x *AcmPcaCertificateAuthorityConfig_Auth) Reset() {
+	*x = AcmPcaCertificateAuthorityConfig_Auth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcmPcaCertificateAuthorityConfig_Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcmPcaCertificateAuthorityConfig_Auth) ProtoMessage() {}
+
+func (x *AcmPcaCertificateAuthorityConfig_Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcmPcaCertificateAuthorityConfig_Auth.ProtoReflect.Descriptor instead.
+func (*AcmPcaCertificateAuthorityConfig_Auth) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AcmPcaCertificateAuthorityConfig_Auth) GetAwsCredentials() *AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials {
+	if x != nil {
+		return x.AwsCredentials
+	}
+	return nil
+}
+
+// AwsCredentials defines static credentials of AWS.
+type AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data source for the access key ID
+	AccessKey *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	// Data source for the secret access key
+	AccessKeySecret *v1alpha1.DataSource `protobuf:"bytes,2,opt,name=accessKeySecret,proto3" json:"accessKeySecret,omitempty"`
+}
+
+func (x *AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials) Reset() {
+	*x = AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials) ProtoMessage() {}
+
+func (x *AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials.ProtoReflect.Descriptor instead.
+func (*AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials) GetAccessKey() *v1alpha1.DataSource {
+	if x != nil {
+		return x.AccessKey
+	}
+	return nil
+}
+
+func (x *AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials) GetAccessKeySecret() *v1alpha1.DataSource {
+	if x != nil {
+		return x.AccessKeySecret
+	}
+	return nil
+}
+
+var File_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto protoreflect.FileDescriptor
+
+var file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDesc = []byte{
+	0x0a, 0x33, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61,
+	0x2f, 0x61, 0x63, 0x6d, 0x70, 0x63, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x61,
+	0x63, 0x6d, 0x70, 0x63, 0x61, 0x5f, 0x63, 0x61, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x05, 0x0a, 0x20, 0x41, 0x63, 0x6d,
+	0x50, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x72, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x72, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x72,
+	0x6e, 0x12, 0x4a, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63,
+	0x61, 0x2e, 0x41, 0x63, 0x6d, 0x50, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x40, 0x0a,
+	0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x52, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x6d, 0x61,
+	0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61,
+	0x6e, 0x63, 0x65, 0x1a, 0x94, 0x02, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x6d, 0x0a, 0x0e,
+	0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x41, 0x63, 0x6d, 0x50, 0x63, 0x61, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x77, 0x73,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0e, 0x61, 0x77, 0x73,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x9c, 0x01, 0x0a, 0x0e,
+	0x41, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x3e,
+	0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x4a,
+	0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f,
+	0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x2f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDescOnce sync.Once
+	file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDescData = file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDesc
+)
+
+func file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDescGZIP() []byte {
+	file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDescOnce.Do(func() {
+		file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDescData)
+	})
+	return file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDescData
+}
+
+var file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_goTypes = []interface{}{
+	(*AcmPcaCertificateAuthorityConfig)(nil),                     // 0: kuma.plugins.ca.AcmPcaCertificateAuthorityConfig
+	(*AcmPcaCertificateAuthorityConfig_Auth)(nil),                // 1: kuma.plugins.ca.AcmPcaCertificateAuthorityConfig.Auth
+	(*AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials)(nil), // 2: kuma.plugins.ca.AcmPcaCertificateAuthorityConfig.Auth.AwsCredentials
+	(*wrapperspb.UInt32Value)(nil),                               // 3: google.protobuf.UInt32Value
+	(*v1alpha1.DataSource)(nil),                                  // 4: kuma.system.v1alpha1.DataSource
+}
+var file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_depIdxs = []int32{
+	1, // 0: kuma.plugins.ca.AcmPcaCertificateAuthorityConfig.auth:type_name -> kuma.plugins.ca.AcmPcaCertificateAuthorityConfig.Auth
+	3, // 1: kuma.plugins.ca.AcmPcaCertificateAuthorityConfig.issuanceRate:type_name -> google.protobuf.UInt32Value
+	3, // 2: kuma.plugins.ca.AcmPcaCertificateAuthorityConfig.maxConcurrentIssuance:type_name -> google.protobuf.UInt32Value
+	2, // 3: kuma.plugins.ca.AcmPcaCertificateAuthorityConfig.Auth.awsCredentials:type_name -> kuma.plugins.ca.AcmPcaCertificateAuthorityConfig.Auth.AwsCredentials
+	4, // 4: kuma.plugins.ca.AcmPcaCertificateAuthorityConfig.Auth.AwsCredentials.accessKey:type_name -> kuma.system.v1alpha1.DataSource
+	4, // 5: kuma.plugins.ca.AcmPcaCertificateAuthorityConfig.Auth.AwsCredentials.accessKeySecret:type_name -> kuma.system.v1alpha1.DataSource
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_init() }
+func file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_init() {
+	if File_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcmPcaCertificateAuthorityConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcmPcaCertificateAuthorityConfig_Auth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcmPcaCertificateAuthorityConfig_Auth_AwsCredentials); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_goTypes,
+		DependencyIndexes: file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_depIdxs,
+		MessageInfos:      file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_msgTypes,
+	}.Build()
+	File_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto = out.File
+	file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_rawDesc = nil
+	file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_goTypes = nil
+	file_pkg_plugins_ca_acmpca_config_acmpca_ca_config_proto_depIdxs = nil
+}
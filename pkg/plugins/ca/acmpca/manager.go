@@ -0,0 +1,314 @@
+package acmpca
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/acmpca/acmpcaiface"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/ca"
+	ca_issuer "github.com/kumahq/kuma/pkg/core/ca/issuer"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/plugins/ca/acmpca/config"
+	"github.com/kumahq/kuma/pkg/plugins/ca/cloud"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var log = core.Log.WithName("plugins").WithName("ca").WithName("acmpca")
+
+const (
+	// default quota of IssueCertificate requests is 25 per second
+	defaultIssuanceRate          = 25
+	defaultMaxConcurrentIssuance = 10
+	rootCertsTTL                 = time.Minute
+	issuancePollInterval         = 500 * time.Millisecond
+	issuanceMaxPolls             = 60
+)
+
+type acmPcaCaManager struct {
+	dataSourceLoader datasource.Loader
+	throttlers       *cloud.Throttlers
+	rootCerts        *cloud.RootCerts
+
+	sync.Mutex
+	clients map[string]acmpcaiface.ACMPCAAPI
+}
+
+var _ ca.Manager = &acmPcaCaManager{}
+
+func NewAcmPcaCaManager(dataSourceLoader datasource.Loader) ca.Manager {
+	return &acmPcaCaManager{
+		dataSourceLoader: dataSourceLoader,
+		throttlers:       cloud.NewThrottlers(request.IsErrorThrottle),
+		rootCerts:        cloud.NewRootCerts(rootCertsTTL),
+		clients:          map[string]acmpcaiface.ACMPCAAPI{},
+	}
+}
+
+func (a *acmPcaCaManager) ValidateBackend(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
+	verr := validators.ValidationError{}
+
+	cfg := &config.AcmPcaCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		verr.AddViolation("", "could not convert backend config: "+err.Error())
+		return verr.OrNil()
+	}
+
+	if cfg.GetArn() == "" {
+		verr.AddViolation("arn", "has to be defined")
+	} else if parsed, err := arn.Parse(cfg.GetArn()); err != nil || parsed.Service != "acm-pca" {
+		verr.AddViolation("arn", "has to be a valid ARN of ACM Private CA")
+	}
+	if cfg.GetTemplateArn() != "" {
+		if _, err := arn.Parse(cfg.GetTemplateArn()); err != nil {
+			verr.AddViolation("templateArn", "has to be a valid ARN")
+		}
+	}
+	if cfg.GetSigningAlgorithm() != "" && !contains(acmpca.SigningAlgorithm_Values(), cfg.GetSigningAlgorithm()) {
+		verr.AddViolation("signingAlgorithm", "has to be one of "+strings.Join(acmpca.SigningAlgorithm_Values(), ", "))
+	}
+	if creds := cfg.GetAuth().GetAwsCredentials(); creds != nil {
+		if creds.GetAccessKey() == nil {
+			verr.AddViolation("auth.awsCredentials.accessKey", "has to be defined")
+		} else {
+			verr.AddError("auth.awsCredentials.accessKey", datasource.Validate(creds.GetAccessKey()))
+		}
+		if creds.GetAccessKeySecret() == nil {
+			verr.AddViolation("auth.awsCredentials.accessKeySecret", "has to be defined")
+		} else {
+			verr.AddError("auth.awsCredentials.accessKeySecret", datasource.Validate(creds.GetAccessKeySecret()))
+		}
+	}
+	if cfg.GetIssuanceRate() != nil && cfg.GetIssuanceRate().GetValue() == 0 {
+		verr.AddViolation("issuanceRate", "has to be greater than 0")
+	}
+	if cfg.GetMaxConcurrentIssuance() != nil && cfg.GetMaxConcurrentIssuance().GetValue() == 0 {
+		verr.AddViolation("maxConcurrentIssuance", "has to be greater than 0")
+	}
+
+	if !verr.HasViolations() {
+		if _, err := a.GetRootCert(ctx, mesh, backend); err != nil {
+			verr.AddViolation("", err.Error())
+		}
+	}
+	return verr.OrNil()
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *acmPcaCaManager) EnsureBackends(ctx context.Context, mesh string, backends []*mesh_proto.CertificateAuthorityBackend) error {
+	return nil // CA is managed by AWS and retrieved from it when it's needed
+}
+
+func (a *acmPcaCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
+	cfg := &config.AcmPcaCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to AcmPcaCertificateAuthorityConfig")
+	}
+	var secrets []string
+	if cfg.GetAuth().GetAwsCredentials().GetAccessKey().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetAuth().GetAwsCredentials().GetAccessKey().GetSecret())
+	}
+	if cfg.GetAuth().GetAwsCredentials().GetAccessKeySecret().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetAuth().GetAwsCredentials().GetAccessKeySecret().GetSecret())
+	}
+	return secrets, nil
+}
+
+func (a *acmPcaCaManager) GetRootCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]ca.Cert, error) {
+	cfg, client, clientKey, err := a.client(ctx, mesh, backend)
+	if err != nil {
+		return nil, err
+	}
+	certs, err := a.rootCerts.Get(clientKey+"/"+cfg.GetArn(), func() ([]ca.Cert, error) {
+		out, err := client.GetCertificateAuthorityCertificateWithContext(ctx, &acmpca.GetCertificateAuthorityCertificateInput{
+			CertificateAuthorityArn: aws.String(cfg.GetArn()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		// the chain is empty for root CAs
+		certs := util_tls.SplitPEMCerts([]byte(aws.StringValue(out.Certificate) + "\n" + aws.StringValue(out.CertificateChain)))
+		if len(certs) == 0 {
+			return nil, errors.New("ACM Private CA did not return CA certificates")
+		}
+		return certs, nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve CA certificates from ACM Private CA for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return certs, nil
+}
+
+func (a *acmPcaCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (ca.KeyPair, error) {
+	cfg, client, _, err := a.client(ctx, mesh, backend)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+	expiration := ca_issuer.DefaultWorkloadCertValidityPeriod
+	if backend.GetDpCert().GetRotation().GetExpiration() != "" {
+		expiration, err = core_mesh.ParseDuration(backend.GetDpCert().GetRotation().Expiration)
+		if err != nil {
+			return ca.KeyPair{}, err
+		}
+	}
+	csr, keyPEM, err := ca_issuer.NewWorkloadCSR(mesh, tags)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+
+	input := &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(cfg.GetArn()),
+		Csr:                     csr,
+		SigningAlgorithm:        aws.String(acmpca.SigningAlgorithmSha256withrsa),
+		Validity: &acmpca.Validity{
+			Type:  aws.String(acmpca.ValidityPeriodTypeAbsolute),
+			Value: aws.Int64(core.Now().Add(expiration).Unix()),
+		},
+	}
+	if cfg.GetSigningAlgorithm() != "" {
+		input.SigningAlgorithm = aws.String(cfg.GetSigningAlgorithm())
+	}
+	if cfg.GetTemplateArn() != "" {
+		input.TemplateArn = aws.String(cfg.GetTemplateArn())
+	}
+
+	var certArn string
+	throttler := a.throttlers.Get(cfg.GetArn(), issuanceRate(cfg), maxConcurrentIssuance(cfg))
+	err = throttler.Do(ctx, func() error {
+		out, err := client.IssueCertificateWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+		certArn = aws.StringValue(out.CertificateArn)
+		return nil
+	})
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "failed to issue a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend.Name)
+	}
+
+	out, err := waitForCertificate(ctx, client, &acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(certArn),
+		CertificateAuthorityArn: aws.String(cfg.GetArn()),
+	})
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "failed to retrieve the issued Workload Identity cert %q for tags %q in Mesh %q using backend %q", certArn, tags.String(), mesh, backend.Name)
+	}
+	// the certificate is followed by the chain of the CA, so it can be verified with the root CA only
+	certPEM := strings.TrimSpace(aws.StringValue(out.Certificate)) + "\n"
+	if chain := strings.TrimSpace(aws.StringValue(out.CertificateChain)); chain != "" {
+		certPEM += chain + "\n"
+	}
+	return ca.KeyPair{
+		CertPEM: []byte(certPEM),
+		KeyPEM:  keyPEM,
+	}, nil
+}
+
+// waitForCertificate retrieves the certificate. Certificates are issued asynchronously,
+// so the certificate may not be available right after the request to issue it.
+func waitForCertificate(ctx context.Context, client acmpcaiface.ACMPCAAPI, input *acmpca.GetCertificateInput) (*acmpca.GetCertificateOutput, error) {
+	for poll := 1; ; poll++ {
+		out, err := client.GetCertificateWithContext(ctx, input)
+		if err == nil {
+			return out, nil
+		}
+		var awsErr awserr.Error
+		if !errors.As(err, &awsErr) || awsErr.Code() != acmpca.ErrCodeRequestInProgressException || poll == issuanceMaxPolls {
+			return nil, err
+		}
+		select {
+		case <-time.After(issuancePollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func issuanceRate(cfg *config.AcmPcaCertificateAuthorityConfig) uint32 {
+	if cfg.GetIssuanceRate() != nil {
+		return cfg.GetIssuanceRate().GetValue()
+	}
+	return defaultIssuanceRate
+}
+
+func maxConcurrentIssuance(cfg *config.AcmPcaCertificateAuthorityConfig) uint32 {
+	if cfg.GetMaxConcurrentIssuance() != nil {
+		return cfg.GetMaxConcurrentIssuance().GetValue()
+	}
+	return defaultMaxConcurrentIssuance
+}
+
+// client returns the client of ACM Private CA for the backend and the key of the client.
+// Clients are cached by their configuration.
+func (a *acmPcaCaManager) client(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) (*config.AcmPcaCertificateAuthorityConfig, acmpcaiface.ACMPCAAPI, string, error) {
+	cfg := &config.AcmPcaCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, nil, "", errors.Wrap(err, "could not convert backend config to AcmPcaCertificateAuthorityConfig")
+	}
+	region := cfg.GetRegion()
+	if region == "" {
+		parsed, err := arn.Parse(cfg.GetArn())
+		if err != nil {
+			return nil, nil, "", errors.Wrap(err, "could not parse ARN of the CA")
+		}
+		region = parsed.Region
+	}
+
+	awsCfg := aws.NewConfig().WithRegion(region)
+	if cfg.GetEndpoint() != "" {
+		awsCfg = awsCfg.WithEndpoint(cfg.GetEndpoint())
+	}
+	key := fmt.Sprintf("%s/%s", region, cfg.GetEndpoint())
+	if creds := cfg.GetAuth().GetAwsCredentials(); creds != nil {
+		accessKey, err := a.dataSourceLoader.Load(ctx, mesh, creds.GetAccessKey())
+		if err != nil {
+			return nil, nil, "", errors.Wrap(err, "could not load the access key")
+		}
+		accessKeySecret, err := a.dataSourceLoader.Load(ctx, mesh, creds.GetAccessKeySecret())
+		if err != nil {
+			return nil, nil, "", errors.Wrap(err, "could not load the access key secret")
+		}
+		id, secret := strings.TrimSpace(string(accessKey)), strings.TrimSpace(string(accessKeySecret))
+		awsCfg = awsCfg.WithCredentials(credentials.NewStaticCredentials(id, secret, ""))
+		key += fmt.Sprintf("/%s/%x", id, sha256.Sum256([]byte(secret)))
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	if client, ok := a.clients[key]; ok {
+		return cfg, client, key, nil
+	}
+	sess, err := session.NewSession(awsCfg)
+	if err != nil {
+		return nil, nil, "", errors.Wrap(err, "could not create AWS session")
+	}
+	client := acmpca.New(sess)
+	a.clients[key] = client
+	log.V(1).Info("created client of ACM Private CA", "region", region, "endpoint", cfg.GetEndpoint())
+	return cfg, client, key, nil
+}
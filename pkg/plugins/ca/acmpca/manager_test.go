@@ -0,0 +1,289 @@
+package acmpca_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	"github.com/kumahq/kuma/pkg/plugins/ca/acmpca"
+	"github.com/kumahq/kuma/pkg/util/proto"
+	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
+)
+
+const caArn = "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/kuma"
+
+// fakeAcmPca implements operations of ACM Private CA (JSON protocol) used by the CA
+type fakeAcmPca struct {
+	sync.Mutex
+	caCert      *x509.Certificate
+	caPEM       string
+	caSigner    interface{}
+	certs       map[string]string
+	polls       map[string]int
+	rootFetches int
+}
+
+func newFakeAcmPca() *fakeAcmPca {
+	key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "acm-pca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+	return &fakeAcmPca{
+		caCert:   cert,
+		caPEM:    string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		caSigner: key,
+		certs:    map[string]string{},
+		polls:    map[string]int{},
+	}
+}
+
+func (f *fakeAcmPca) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	body := map[string]interface{}{}
+	Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+	respond := func(status int, resp interface{}) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(status)
+		Expect(json.NewEncoder(w).Encode(resp)).To(Succeed())
+	}
+	if body["CertificateAuthorityArn"] != caArn {
+		respond(http.StatusBadRequest, map[string]string{"__type": "ResourceNotFoundException", "message": "CA not found"})
+		return
+	}
+
+	switch r.Header.Get("X-Amz-Target") {
+	case "ACMPrivateCA.GetCertificateAuthorityCertificate":
+		f.rootFetches++
+		respond(http.StatusOK, map[string]string{"Certificate": f.caPEM})
+	case "ACMPrivateCA.IssueCertificate":
+		// CSR is a blob, so it's base64 encoded by the SDK and decoded by encoding/json
+		var req struct {
+			Csr      []byte
+			Validity struct {
+				Type  string
+				Value int64
+			}
+		}
+		raw, err := json.Marshal(body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(json.Unmarshal(raw, &req)).To(Succeed())
+		Expect(req.Validity.Type).To(Equal("ABSOLUTE"))
+		block, _ := pem.Decode(req.Csr)
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(int64(len(f.certs) + 2)),
+			URIs:         csr.URIs,
+			NotBefore:    time.Now(),
+			NotAfter:     time.Unix(req.Validity.Value, 0),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, f.caCert, csr.PublicKey, f.caSigner)
+		Expect(err).ToNot(HaveOccurred())
+		certArn := fmt.Sprintf("%s/certificate/%d", caArn, len(f.certs)+1)
+		f.certs[certArn] = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+		respond(http.StatusOK, map[string]string{"CertificateArn": certArn})
+	case "ACMPrivateCA.GetCertificate":
+		certArn := body["CertificateArn"].(string)
+		f.polls[certArn]++
+		// the certificate is issued asynchronously
+		if f.polls[certArn] == 1 {
+			respond(http.StatusBadRequest, map[string]string{"__type": "RequestInProgressException", "message": "the request is in progress"})
+			return
+		}
+		respond(http.StatusOK, map[string]string{"Certificate": f.certs[certArn], "CertificateChain": f.caPEM})
+	default:
+		respond(http.StatusBadRequest, map[string]string{"__type": "InvalidAction", "message": "unknown action"})
+	}
+}
+
+var _ = Describe("ACM Private CA", func() {
+	var caManager core_ca.Manager
+	var acmPca *fakeAcmPca
+	var server *httptest.Server
+
+	backend := func(configYAML string) *mesh_proto.CertificateAuthorityBackend {
+		str := structpb.Struct{}
+		Expect(proto.FromYAML([]byte(strings.ReplaceAll(configYAML, "ENDPOINT", server.URL)), &str)).To(Succeed())
+		return &mesh_proto.CertificateAuthorityBackend{
+			Name: "acmpca-1",
+			Type: "acmpca",
+			Conf: &str,
+			DpCert: &mesh_proto.CertificateAuthorityBackend_DpCert{
+				Rotation: &mesh_proto.CertificateAuthorityBackend_DpCert_Rotation{
+					Expiration: "1h",
+				},
+			},
+		}
+	}
+
+	validConfig := `
+        arn: ` + caArn + `
+        endpoint: ENDPOINT
+        auth:
+          awsCredentials:
+            accessKey:
+              inlineString: AKIAEXAMPLE
+            accessKeySecret:
+              inlineString: secret`
+
+	BeforeEach(func() {
+		acmPca = newFakeAcmPca()
+		server = httptest.NewServer(acmPca)
+		caManager = acmpca.NewAcmPcaCaManager(datasource.NewDataSourceLoader(nil))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("ValidateBackend", func() {
+		type testCase struct {
+			configYAML string
+			expected   string
+		}
+
+		DescribeTable("should validate invalid config",
+			func(given testCase) {
+				// when
+				verr := caManager.ValidateBackend(context.Background(), "default", backend(given.configYAML))
+
+				// then
+				actual, err := yaml.Marshal(verr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty config", testCase{
+				configYAML: ``,
+				expected: `
+            violations:
+            - field: arn
+              message: has to be defined`,
+			}),
+			Entry("invalid values", testCase{
+				configYAML: `
+            arn: arn:aws:s3:::bucket
+            signingAlgorithm: MD5
+            auth:
+              awsCredentials:
+                accessKey:
+                  inlineString: AKIAEXAMPLE
+            issuanceRate: 0`,
+				expected: `
+            violations:
+            - field: arn
+              message: has to be a valid ARN of ACM Private CA
+            - field: signingAlgorithm
+              message: has to be one of SHA256WITHECDSA, SHA384WITHECDSA, SHA512WITHECDSA, SHA256WITHRSA, SHA384WITHRSA, SHA512WITHRSA
+            - field: auth.awsCredentials.accessKeySecret
+              message: has to be defined
+            - field: issuanceRate
+              message: has to be greater than 0`,
+			}),
+			Entry("not existing CA", testCase{
+				configYAML: strings.ReplaceAll(validConfig, "certificate-authority/kuma", "certificate-authority/other"),
+				expected: `
+            violations:
+            - field: ""
+              message: 'failed to retrieve CA certificates from ACM Private CA for Mesh "default" and backend "acmpca-1": ResourceNotFoundException: CA not found'`,
+			}),
+		)
+
+		It("should pass validation of valid config", func() {
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend(validConfig))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	It("should return the CA as the root cert and cache it", func() {
+		// when
+		certs, err := caManager.GetRootCert(context.Background(), "default", backend(validConfig))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = caManager.GetRootCert(context.Background(), "default", backend(validConfig))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(certs).To(HaveLen(1))
+		Expect(string(certs[0])).To(Equal(acmPca.caPEM))
+		Expect(acmPca.rootFetches).To(Equal(1))
+	})
+
+	It("should generate dataplane certs issued by ACM Private CA", func() {
+		// given
+		tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+			"kuma.io/service": {"backend"},
+		})
+
+		// when
+		pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(validConfig), tags)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		_, err = tls.X509KeyPair(pair.CertPEM, pair.KeyPEM)
+		Expect(err).ToNot(HaveOccurred())
+
+		block, rest := pem.Decode(pair.CertPEM)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.URIs[0].String()).To(Equal("spiffe://default/backend"))
+		Expect(cert.NotAfter).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		Expect(strings.TrimSpace(string(rest))).To(Equal(strings.TrimSpace(acmPca.caPEM)))
+
+		// and the certificate was polled until it was issued
+		Expect(acmPca.polls).To(HaveKeyWithValue(caArn+"/certificate/1", 2))
+	})
+
+	It("should return secrets used by the backend", func() {
+		// given
+		b := backend(`
+        arn: ` + caArn + `
+        auth:
+          awsCredentials:
+            accessKey:
+              secret: aws-access-key
+            accessKeySecret:
+              secret: aws-access-key-secret`)
+
+		// when
+		secrets, err := caManager.UsedSecrets("default", b)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(Equal([]string{"aws-access-key", "aws-access-key-secret"}))
+	})
+})
@@ -3,8 +3,10 @@ package builtin
 import (
 	"crypto"
 	"crypto/rand"
+	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/pem"
 	"math/big"
 	"net/url"
 	"time"
@@ -72,6 +74,69 @@ func newCACert(signer crypto.Signer, trustDomain string, certOpts ...certOptsFn)
 	return x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
 }
 
+// newIntermediateCa generates the CA of the mesh signed by the root CA. The certificate of the returned key pair
+// is followed by the certificate of the root CA.
+func newIntermediateCa(mesh string, rsaBits int, root core_ca.KeyPair, certOpts ...certOptsFn) (*core_ca.KeyPair, error) {
+	rootPair, err := tls.X509KeyPair(root.CertPEM, root.KeyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the key pair of the root CA")
+	}
+	rootCert, err := x509.ParseCertificate(rootPair.Certificate[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the certificate of the root CA")
+	}
+	if !rootCert.IsCA {
+		return nil, errors.New("the certificate of the root CA is not a CA certificate")
+	}
+	rootSigner, ok := rootPair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("the key of the root CA can't be used for signing")
+	}
+
+	if rsaBits == 0 {
+		rsaBits = util_rsa.DefaultKeySize
+	}
+	key, err := util_rsa.GenerateKey(rsaBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate a private key")
+	}
+	spiffeID := &url.URL{
+		Scheme: "spiffe",
+		Host:   mesh,
+	}
+	subject := pkix.Name{
+		Organization:       []string{"Kuma"},
+		OrganizationalUnit: []string{"Mesh"},
+		CommonName:         mesh,
+	}
+	now := core.Now()
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate serial number")
+	}
+	template, err := caTemplate(spiffeID.String(), mesh, subject, key.Public(), now.Add(-DefaultAllowedClockSkew), now.Add(DefaultCACertValidityPeriod), serialNumber)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range certOpts {
+		opt(template)
+	}
+	// the intermediate CA can't outlive the root CA
+	if template.NotAfter.After(rootCert.NotAfter) {
+		template.NotAfter = rootCert.NotAfter
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, rootCert, key.Public(), rootSigner)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate X509 certificate")
+	}
+	keyPair, err := util_tls.ToKeyPair(key, cert)
+	if err != nil {
+		return nil, err
+	}
+	keyPair.CertPEM = append(keyPair.CertPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootCert.Raw})...)
+	return keyPair, nil
+}
+
 func caTemplate(spiffeID string, trustDomain string, subject pkix.Name, publicKey crypto.PublicKey, notBefore, notAfter time.Time, serialNumber *big.Int) (*x509.Certificate, error) {
 	uri, err := spiffe.ParseID(spiffeID, spiffe.AllowTrustDomain(trustDomain))
 	if err != nil {
@@ -2,6 +2,8 @@ package builtin
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -10,6 +12,7 @@ import (
 	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	ca_issuer "github.com/kumahq/kuma/pkg/core/ca/issuer"
+	"github.com/kumahq/kuma/pkg/core/datasource"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_system "github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -17,20 +20,24 @@ import (
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	core_validators "github.com/kumahq/kuma/pkg/core/validators"
 	"github.com/kumahq/kuma/pkg/plugins/ca/builtin/config"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 )
 
 type builtinCaManager struct {
-	secretManager manager.ResourceManager
+	secretManager    manager.ResourceManager
+	dataSourceLoader datasource.Loader
 }
 
 func NewBuiltinCaManager(secretManager manager.ResourceManager) core_ca.Manager {
 	return &builtinCaManager{
-		secretManager: secretManager,
+		secretManager:    secretManager,
+		dataSourceLoader: datasource.NewDataSourceLoader(secretManager),
 	}
 }
 
 var _ core_ca.Manager = &builtinCaManager{}
+var _ core_ca.RevocationListSigner = &builtinCaManager{}
 
 func (b *builtinCaManager) EnsureBackends(ctx context.Context, mesh string, backends []*mesh_proto.CertificateAuthorityBackend) error {
 	for _, backend := range backends {
@@ -57,14 +64,61 @@ func (b *builtinCaManager) ValidateBackend(ctx context.Context, mesh string, bac
 		verr.AddViolation("", "could not convert backend config: "+err.Error())
 		return verr.OrNil()
 	}
+	if root := cfg.GetRoot(); root != nil {
+		if root.GetCert() == nil {
+			verr.AddViolation("root.cert", "has to be defined")
+		} else {
+			verr.AddError("root.cert", datasource.Validate(root.GetCert()))
+		}
+		// the key is not loaded, because it can be removed after the intermediate CA is created
+		if root.GetKey() == nil {
+			verr.AddViolation("root.key", "has to be defined")
+		} else {
+			verr.AddError("root.key", datasource.Validate(root.GetKey()))
+		}
+		if !verr.HasViolations() {
+			if err := b.validateRootCert(ctx, mesh, root); err != nil {
+				verr.AddViolation("root.cert", err.Error())
+			}
+		}
+	}
+	return verr.OrNil()
+}
+
+func (b *builtinCaManager) validateRootCert(ctx context.Context, mesh string, root *config.BuiltinCertificateAuthorityConfig_Root) error {
+	certPEM, err := b.dataSourceLoader.Load(ctx, mesh, root.GetCert())
+	if err != nil {
+		return err
+	}
+	certs := util_tls.SplitPEMCerts(certPEM)
+	if len(certs) != 1 {
+		return errors.New("has to contain exactly one certificate")
+	}
+	block, _ := pem.Decode(certs[0])
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return errors.Wrap(err, "could not parse the certificate")
+	}
+	if !cert.IsCA {
+		return errors.New("has to be a CA certificate")
+	}
 	return nil
 }
 
 func (b *builtinCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
-	return []string{
+	secrets := []string{
 		certSecretResKey(mesh, backend.Name).Name,
 		keySecretResKey(mesh, backend.Name).Name,
-	}, nil
+	}
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	// the key of the root CA is not used after the intermediate CA is created, so it's not reported to allow removing it
+	if cfg.GetRoot().GetCert().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetRoot().GetCert().GetSecret())
+	}
+	return secrets, nil
 }
 
 func (b *builtinCaManager) create(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
@@ -81,9 +135,22 @@ func (b *builtinCaManager) create(ctx context.Context, mesh string, backend *mes
 		}
 		opts = append(opts, withExpirationTime(duration))
 	}
-	keyPair, err := newRootCa(mesh, int(cfg.GetCaCert().GetRSAbits().GetValue()), opts...)
-	if err != nil {
-		return errors.Wrapf(err, "failed to generate a Root CA cert for Mesh %q", mesh)
+	var keyPair *core_ca.KeyPair
+	if root := cfg.GetRoot(); root != nil {
+		rootPair, err := b.loadRoot(ctx, mesh, root)
+		if err != nil {
+			return err
+		}
+		keyPair, err = newIntermediateCa(mesh, int(cfg.GetCaCert().GetRSAbits().GetValue()), rootPair, opts...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to generate an Intermediate CA cert for Mesh %q", mesh)
+		}
+	} else {
+		var err error
+		keyPair, err = newRootCa(mesh, int(cfg.GetCaCert().GetRSAbits().GetValue()), opts...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to generate a Root CA cert for Mesh %q", mesh)
+		}
 	}
 
 	certSecret := &core_system.SecretResource{
@@ -106,6 +173,21 @@ func (b *builtinCaManager) create(ctx context.Context, mesh string, backend *mes
 	return nil
 }
 
+func (b *builtinCaManager) loadRoot(ctx context.Context, mesh string, root *config.BuiltinCertificateAuthorityConfig_Root) (core_ca.KeyPair, error) {
+	cert, err := b.dataSourceLoader.Load(ctx, mesh, root.GetCert())
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not load the certificate of the root CA")
+	}
+	key, err := b.dataSourceLoader.Load(ctx, mesh, root.GetKey())
+	if err != nil {
+		return core_ca.KeyPair{}, errors.Wrap(err, "could not load the key of the root CA")
+	}
+	return core_ca.KeyPair{
+		CertPEM: cert,
+		KeyPEM:  key,
+	}, nil
+}
+
 func certSecretResKey(mesh string, backendName string) core_model.ResourceKey {
 	return core_model.ResourceKey{
 		Mesh: mesh,
@@ -125,6 +207,10 @@ func (b *builtinCaManager) GetRootCert(ctx context.Context, mesh string, backend
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
 	}
+	// the intermediate CA is followed by the root CA, which is the only one that is trusted
+	if certs := util_tls.SplitPEMCerts(ca.CertPEM); len(certs) > 1 {
+		return []core_ca.Cert{certs[len(certs)-1]}, nil
+	}
 	return []core_ca.Cert{ca.CertPEM}, nil
 }
 
@@ -146,9 +232,43 @@ func (b *builtinCaManager) GenerateDataplaneCert(ctx context.Context, mesh strin
 	if err != nil {
 		return core_ca.KeyPair{}, errors.Wrapf(err, "failed to generate a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend)
 	}
+	// the certificate is followed by the intermediate CA, so it can be verified with the root CA
+	if certs := util_tls.SplitPEMCerts(ca.CertPEM); len(certs) > 1 {
+		for _, cert := range certs[:len(certs)-1] {
+			keyPair.CertPEM = append(keyPair.CertPEM, cert...)
+		}
+	}
 	return *keyPair, nil
 }
 
+func (b *builtinCaManager) SignRevocationList(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, list core_ca.RevocationList) ([]byte, error) {
+	ca, err := b.getCa(ctx, mesh, backend.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	crl, err := ca_issuer.NewRevocationList(ca, list.SerialNumbers, list.ThisUpdate, list.NextUpdate)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &config.BuiltinCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to BuiltinCertificateAuthorityConfig")
+	}
+	// dataplanes check revocation of every certificate in the chain, so the root CA has to sign the list of the intermediate CA
+	if root := cfg.GetRoot(); root != nil {
+		rootPair, err := b.loadRoot(ctx, mesh, root)
+		if err != nil {
+			return nil, errors.Wrap(err, "the key of the root CA is required to revoke certificates issued by the intermediate CA")
+		}
+		rootCrl, err := ca_issuer.NewRevocationList(rootPair, nil, list.ThisUpdate, list.NextUpdate)
+		if err != nil {
+			return nil, err
+		}
+		crl = append(crl, rootCrl...)
+	}
+	return crl, nil
+}
+
 func (b *builtinCaManager) getCa(ctx context.Context, mesh string, backendName string) (core_ca.KeyPair, error) {
 	certSecret := core_system.NewSecretResource()
 	if err := b.secretManager.Get(ctx, certSecret, core_store.GetBy(certSecretResKey(mesh, backendName))); err != nil {
@@ -2,14 +2,18 @@ package builtin_test
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
+	"math/big"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	"github.com/kumahq/kuma/pkg/core/resources/apis/system"
@@ -22,6 +26,7 @@ import (
 	"github.com/kumahq/kuma/pkg/plugins/ca/builtin/config"
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
 )
 
 var _ = Describe("Builtin CA Manager", func() {
@@ -244,4 +249,199 @@ var _ = Describe("Builtin CA Manager", func() {
 			Expect(err).To(MatchError(`failed to load CA key pair for Mesh "default" and backend "builtin-non-existent": Resource not found: type="Secret" name="default.ca-builtin-cert-builtin-non-existent" mesh="default"`))
 		})
 	})
+
+	Context("SignRevocationList", func() {
+		It("should sign revocation list with the CA", func() {
+			// given
+			backend := &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+			}
+			err := caManager.EnsureBackends(context.Background(), "default", []*mesh_proto.CertificateAuthorityBackend{backend})
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			crlPEM, err := caManager.(core_ca.RevocationListSigner).SignRevocationList(context.Background(), "default", backend, core_ca.RevocationList{
+				SerialNumbers: []*big.Int{big.NewInt(42)},
+				ThisUpdate:    now,
+				NextUpdate:    now.Add(time.Hour),
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			block, rest := pem.Decode(crlPEM)
+			Expect(block.Type).To(Equal("X509 CRL"))
+			Expect(rest).To(BeEmpty())
+			crl, err := x509.ParseCRL(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+			Expect(crl.TBSCertList.RevokedCertificates[0].SerialNumber).To(Equal(big.NewInt(42)))
+
+			// and the list is signed by the CA
+			rootCerts, err := caManager.GetRootCert(context.Background(), "default", backend)
+			Expect(err).ToNot(HaveOccurred())
+			caBlock, _ := pem.Decode(rootCerts[0])
+			ca, err := x509.ParseCertificate(caBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ca.CheckCRLSignature(crl)).To(Succeed())
+		})
+	})
+
+	Context("Intermediate CA", func() {
+		var rootCertPEM []byte
+		var backend *mesh_proto.CertificateAuthorityBackend
+		var secretStore core_store.ResourceStore
+
+		BeforeEach(func() {
+			secretStore = store.NewSecretStore(memory.NewStore())
+			secretManager = secret_manager.NewSecretManager(secretStore, cipher.None(), nil, false)
+			caManager = builtin.NewBuiltinCaManager(secretManager)
+
+			key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+			Expect(err).ToNot(HaveOccurred())
+			template := &x509.Certificate{
+				SerialNumber:          big.NewInt(1),
+				Subject:               pkix.Name{CommonName: "offline-root"},
+				NotBefore:             now.Add(-time.Hour),
+				NotAfter:              now.Add(24 * time.Hour),
+				KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+				BasicConstraintsValid: true,
+				IsCA:                  true,
+			}
+			der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+			Expect(err).ToNot(HaveOccurred())
+			rootCertPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+			rootKeyPEM, err := util_rsa.FromPrivateKeyToPEMBytes(key)
+			Expect(err).ToNot(HaveOccurred())
+
+			for name, data := range map[string][]byte{"root-cert": rootCertPEM, "root-key": rootKeyPEM} {
+				secret := &system.SecretResource{
+					Spec: &system_proto.Secret{
+						Data: util_proto.Bytes(data),
+					},
+				}
+				Expect(secretManager.Create(context.Background(), secret, core_store.CreateByKey(name, "default"))).To(Succeed())
+			}
+
+			backend = &mesh_proto.CertificateAuthorityBackend{
+				Name: "builtin-1",
+				Type: "builtin",
+				Conf: util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+					CaCert: &config.BuiltinCertificateAuthorityConfig_CaCert{
+						Expiration: "48h",
+					},
+					Root: &config.BuiltinCertificateAuthorityConfig_Root{
+						Cert: &system_proto.DataSource{Type: &system_proto.DataSource_Secret{Secret: "root-cert"}},
+						Key:  &system_proto.DataSource{Type: &system_proto.DataSource_Secret{Secret: "root-key"}},
+					},
+				}),
+			}
+		})
+
+		It("should issue dataplane certs with the intermediate CA signed by the offline root", func() {
+			// given
+			Expect(caManager.ValidateBackend(context.Background(), "default", backend)).To(Succeed())
+			err := caManager.EnsureBackends(context.Background(), "default", []*mesh_proto.CertificateAuthorityBackend{backend})
+			Expect(err).ToNot(HaveOccurred())
+
+			// when the key of the root is removed
+			err = secretStore.Delete(context.Background(), system.NewSecretResource(), core_store.DeleteByKey("root-key", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// then the root is the only trusted CA
+			rootCerts, err := caManager.GetRootCert(context.Background(), "default", backend)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rootCerts).To(Equal([]core_ca.Cert{rootCertPEM}))
+
+			// when
+			pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend, mesh_proto.MultiValueTagSetFrom(map[string][]string{
+				"kuma.io/service": {"web"},
+			}))
+
+			// then the cert is followed by the intermediate CA and it's verified with the root
+			Expect(err).ToNot(HaveOccurred())
+			block, rest := pem.Decode(pair.CertPEM)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			intermediateBlock, _ := pem.Decode(rest)
+			intermediate, err := x509.ParseCertificate(intermediateBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(intermediate.URIs[0].String()).To(Equal("spiffe://default"))
+			// and the intermediate CA does not outlive the root
+			Expect(intermediate.NotAfter).To(Equal(now.UTC().Add(24 * time.Hour).Truncate(time.Second)))
+
+			roots := x509.NewCertPool()
+			Expect(roots.AppendCertsFromPEM(rootCertPEM)).To(BeTrue())
+			intermediates := x509.NewCertPool()
+			intermediates.AddCert(intermediate)
+			_, err = cert.Verify(x509.VerifyOptions{
+				Roots:         roots,
+				Intermediates: intermediates,
+				CurrentTime:   now,
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should sign revocation lists of the intermediate CA and the root", func() {
+			// given
+			err := caManager.EnsureBackends(context.Background(), "default", []*mesh_proto.CertificateAuthorityBackend{backend})
+			Expect(err).ToNot(HaveOccurred())
+			list := core_ca.RevocationList{
+				SerialNumbers: []*big.Int{big.NewInt(42)},
+				ThisUpdate:    now,
+				NextUpdate:    now.Add(time.Hour),
+			}
+
+			// when
+			crlPEM, err := caManager.(core_ca.RevocationListSigner).SignRevocationList(context.Background(), "default", backend, list)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			intermediateBlock, rest := pem.Decode(crlPEM)
+			intermediateCrl, err := x509.ParseCRL(intermediateBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(intermediateCrl.TBSCertList.RevokedCertificates).To(HaveLen(1))
+			rootBlock, _ := pem.Decode(rest)
+			rootCrl, err := x509.ParseCRL(rootBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rootCrl.TBSCertList.RevokedCertificates).To(BeEmpty())
+			rootCertBlock, _ := pem.Decode(rootCertPEM)
+			root, err := x509.ParseCertificate(rootCertBlock.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(root.CheckCRLSignature(rootCrl)).To(Succeed())
+
+			// when the key of the root is removed
+			err = secretStore.Delete(context.Background(), system.NewSecretResource(), core_store.DeleteByKey("root-key", "default"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = caManager.(core_ca.RevocationListSigner).SignRevocationList(context.Background(), "default", backend, list)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("the key of the root CA is required to revoke certificates issued by the intermediate CA")))
+		})
+
+		It("should report certificate of the root as used secret", func() {
+			// when
+			secrets, err := caManager.UsedSecrets("default", backend)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets).To(Equal([]string{"default.ca-builtin-cert-builtin-1", "default.ca-builtin-key-builtin-1", "root-cert"}))
+		})
+
+		It("should not validate the root without a CA certificate", func() {
+			// given
+			backend.Conf = util_proto.MustToStruct(&config.BuiltinCertificateAuthorityConfig{
+				Root: &config.BuiltinCertificateAuthorityConfig_Root{
+					Cert: &system_proto.DataSource{Type: &system_proto.DataSource_InlineString{InlineString: "not a cert"}},
+					Key:  &system_proto.DataSource{Type: &system_proto.DataSource_Secret{Secret: "root-key"}},
+				},
+			})
+
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend)
+
+			// then
+			Expect(err).To(MatchError("root.cert: has to contain exactly one certificate"))
+		})
+	})
 })
@@ -0,0 +1,11 @@
+package cloud_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestCloud(t *testing.T) {
+	test.RunSpecs(t, "CA Cloud Suite")
+}
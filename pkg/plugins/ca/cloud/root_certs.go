@@ -0,0 +1,60 @@
+package cloud
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/kumahq/kuma/pkg/core"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+)
+
+type rootCertsEntry struct {
+	certs      []core_ca.Cert
+	expiration time.Time
+}
+
+// RootCerts caches root certificates of CAs, so they are not fetched from the cloud provider for every Dataplane.
+// Concurrent fetches of root certificates of the same CA are coalesced into a single request.
+type RootCerts struct {
+	ttl   time.Duration
+	group singleflight.Group
+
+	sync.Mutex
+	entries map[string]rootCertsEntry
+}
+
+func NewRootCerts(ttl time.Duration) *RootCerts {
+	return &RootCerts{
+		ttl:     ttl,
+		entries: map[string]rootCertsEntry{},
+	}
+}
+
+func (r *RootCerts) Get(ca string, fetch func() ([]core_ca.Cert, error)) ([]core_ca.Cert, error) {
+	r.Lock()
+	entry, ok := r.entries[ca]
+	r.Unlock()
+	if ok && core.Now().Before(entry.expiration) {
+		return entry.certs, nil
+	}
+
+	certs, err, _ := r.group.Do(ca, func() (interface{}, error) {
+		certs, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		r.Lock()
+		r.entries[ca] = rootCertsEntry{
+			certs:      certs,
+			expiration: core.Now().Add(r.ttl),
+		}
+		r.Unlock()
+		return certs, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return certs.([]core_ca.Cert), nil
+}
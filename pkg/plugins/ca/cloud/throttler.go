@@ -0,0 +1,95 @@
+package cloud
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	maxThrottledRetries = 5
+	initialBackoff      = 200 * time.Millisecond
+)
+
+// Throttler limits the rate and the concurrency of requests to the CA, so the quota of the cloud provider is not exceeded
+// when many Dataplanes request certificates at the same time, e.g. after the restart of the control plane.
+// Requests rejected by the provider because of exceeded quota are retried with exponential backoff.
+type Throttler struct {
+	limiter     *rate.Limiter
+	slots       chan struct{}
+	isThrottled func(error) bool
+}
+
+func NewThrottler(requestsPerSecond uint32, maxConcurrent uint32, isThrottled func(error) bool) *Throttler {
+	return &Throttler{
+		limiter:     rate.NewLimiter(rate.Limit(requestsPerSecond), int(requestsPerSecond)),
+		slots:       make(chan struct{}, maxConcurrent),
+		isThrottled: isThrottled,
+	}
+}
+
+func (t *Throttler) Do(ctx context.Context, fn func() error) error {
+	select {
+	case t.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() {
+		<-t.slots
+	}()
+
+	backoff := initialBackoff
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		err := fn()
+		if err == nil || !t.isThrottled(err) || attempt == maxThrottledRetries {
+			return err
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+type throttlerLimits struct {
+	requestsPerSecond uint32
+	maxConcurrent     uint32
+}
+
+// Throttlers holds a Throttler for every CA. Quotas of cloud providers are applied per CA,
+// so backends of different meshes that use the same CA share the Throttler.
+type Throttlers struct {
+	sync.Mutex
+	isThrottled func(error) bool
+	throttlers  map[string]*Throttler
+	limits      map[string]throttlerLimits
+}
+
+func NewThrottlers(isThrottled func(error) bool) *Throttlers {
+	return &Throttlers{
+		isThrottled: isThrottled,
+		throttlers:  map[string]*Throttler{},
+		limits:      map[string]throttlerLimits{},
+	}
+}
+
+// Get returns the Throttler of the CA. The Throttler is recreated when the limits are changed.
+func (t *Throttlers) Get(ca string, requestsPerSecond uint32, maxConcurrent uint32) *Throttler {
+	t.Lock()
+	defer t.Unlock()
+	limits := throttlerLimits{requestsPerSecond: requestsPerSecond, maxConcurrent: maxConcurrent}
+	if throttler, ok := t.throttlers[ca]; ok && t.limits[ca] == limits {
+		return throttler
+	}
+	throttler := NewThrottler(requestsPerSecond, maxConcurrent, t.isThrottled)
+	t.throttlers[ca] = throttler
+	t.limits[ca] = limits
+	return throttler
+}
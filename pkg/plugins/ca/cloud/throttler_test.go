@@ -0,0 +1,132 @@
+package cloud_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/plugins/ca/cloud"
+)
+
+var errThrottled = errors.New("throttled")
+
+func isThrottled(err error) bool {
+	return errors.Is(err, errThrottled)
+}
+
+var _ = Describe("Throttler", func() {
+	It("should limit the number of concurrent requests", func() {
+		// given
+		throttler := cloud.NewThrottler(1000, 2, isThrottled)
+		var inFlight, maxInFlight int32
+
+		// when
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				Expect(throttler.Do(context.Background(), func() error {
+					current := atomic.AddInt32(&inFlight, 1)
+					for {
+						max := atomic.LoadInt32(&maxInFlight)
+						if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+							break
+						}
+					}
+					time.Sleep(10 * time.Millisecond)
+					atomic.AddInt32(&inFlight, -1)
+					return nil
+				})).To(Succeed())
+			}()
+		}
+		wg.Wait()
+
+		// then
+		Expect(maxInFlight).To(Equal(int32(2)))
+	})
+
+	It("should retry throttled requests", func() {
+		// given
+		throttler := cloud.NewThrottler(1000, 1, isThrottled)
+		attempts := 0
+
+		// when
+		err := throttler.Do(context.Background(), func() error {
+			attempts++
+			if attempts < 3 {
+				return errThrottled
+			}
+			return nil
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(attempts).To(Equal(3))
+	})
+
+	It("should not retry other errors", func() {
+		// given
+		throttler := cloud.NewThrottler(1000, 1, isThrottled)
+		attempts := 0
+
+		// when
+		err := throttler.Do(context.Background(), func() error {
+			attempts++
+			return errors.New("invalid request")
+		})
+
+		// then
+		Expect(err).To(MatchError("invalid request"))
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("should share the throttler of the CA until limits change", func() {
+		// given
+		throttlers := cloud.NewThrottlers(isThrottled)
+
+		// expect
+		Expect(throttlers.Get("ca-1", 10, 1)).To(BeIdenticalTo(throttlers.Get("ca-1", 10, 1)))
+		Expect(throttlers.Get("ca-1", 10, 1)).ToNot(BeIdenticalTo(throttlers.Get("ca-2", 10, 1)))
+		Expect(throttlers.Get("ca-1", 10, 1)).ToNot(BeIdenticalTo(throttlers.Get("ca-1", 20, 1)))
+	})
+})
+
+var _ = Describe("RootCerts", func() {
+	It("should cache root certs and coalesce concurrent fetches", func() {
+		// given
+		rootCerts := cloud.NewRootCerts(time.Minute)
+		var fetches int32
+		fetch := func() ([]core_ca.Cert, error) {
+			atomic.AddInt32(&fetches, 1)
+			time.Sleep(10 * time.Millisecond)
+			return []core_ca.Cert{[]byte("root")}, nil
+		}
+
+		// when
+		wg := sync.WaitGroup{}
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				certs, err := rootCerts.Get("ca-1", fetch)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(certs).To(Equal([]core_ca.Cert{[]byte("root")}))
+			}()
+		}
+		wg.Wait()
+		_, err := rootCerts.Get("ca-1", fetch)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetches).To(Equal(int32(1)))
+	})
+})
@@ -0,0 +1,141 @@
+package gcpcas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// client is a minimal client of Certificate Authority Service REST API that covers the endpoints used by the CA.
+type client struct {
+	httpClient *http.Client
+	endpoint   string
+}
+
+// apiError is an error returned by Google Cloud APIs.
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  string `json:"status"`
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("Certificate Authority Service responded with status code %d: %s", e.Code, e.Message)
+}
+
+func isThrottled(err error) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && (apiErr.Code == http.StatusTooManyRequests || apiErr.Status == "RESOURCE_EXHAUSTED")
+}
+
+func isAlreadyExists(err error) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && (apiErr.Code == http.StatusConflict || apiErr.Status == "ALREADY_EXISTS")
+}
+
+type fetchCaCertsResponse struct {
+	CaCerts []struct {
+		Certificates []string `json:"certificates"`
+	} `json:"caCerts"`
+}
+
+// fetchCaCerts returns chains of certificates of all CAs in the pool.
+func (c *client) fetchCaCerts(ctx context.Context, caPool string) ([][]string, error) {
+	resp := fetchCaCertsResponse{}
+	if err := c.do(ctx, caPool+":fetchCaCerts", nil, struct{}{}, &resp); err != nil {
+		return nil, err
+	}
+	var chains [][]string
+	for _, chain := range resp.CaCerts {
+		chains = append(chains, chain.Certificates)
+	}
+	return chains, nil
+}
+
+type createCertificateRequest struct {
+	PemCsr   string `json:"pemCsr"`
+	Lifetime string `json:"lifetime"`
+}
+
+type certificate struct {
+	PemCertificate      string   `json:"pemCertificate"`
+	PemCertificateChain []string `json:"pemCertificateChain"`
+}
+
+// createCertificate creates the certificate in the pool. If certificateAuthority is empty, any CA of the pool issues it.
+func (c *client) createCertificate(ctx context.Context, caPool string, certificateAuthority string, certificateID string, req createCertificateRequest) (certificate, error) {
+	query := url.Values{}
+	query.Set("certificateId", certificateID)
+	if certificateAuthority != "" {
+		query.Set("issuingCertificateAuthorityId", certificateAuthority)
+	}
+	resp := certificate{}
+	if err := c.do(ctx, caPool+"/certificates", query, req, &resp); err != nil {
+		return certificate{}, err
+	}
+	if resp.PemCertificate == "" {
+		return certificate{}, errors.New("Certificate Authority Service did not return a certificate")
+	}
+	return resp, nil
+}
+
+// getCertificate returns the certificate created in the pool.
+func (c *client) getCertificate(ctx context.Context, caPool string, certificateID string) (certificate, error) {
+	resp := certificate{}
+	if err := c.send(ctx, http.MethodGet, caPool+"/certificates/"+certificateID, nil, nil, &resp); err != nil {
+		return certificate{}, err
+	}
+	if resp.PemCertificate == "" {
+		return certificate{}, errors.New("Certificate Authority Service did not return a certificate")
+	}
+	return resp, nil
+}
+
+func (c *client) do(ctx context.Context, path string, query url.Values, reqBody interface{}, respBody interface{}) error {
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return err
+	}
+	return c.send(ctx, http.MethodPost, path, query, bytes.NewReader(body), respBody)
+}
+
+func (c *client) send(ctx context.Context, method string, path string, query url.Values, body io.Reader, respBody interface{}) error {
+	u := fmt.Sprintf("%s/v1/%s", c.endpoint, strings.TrimPrefix(path, "/"))
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp struct {
+			Error *apiError `json:"error"`
+		}
+		if err := json.Unmarshal(respBytes, &errResp); err == nil && errResp.Error != nil {
+			errResp.Error.Code = resp.StatusCode
+			return errResp.Error
+		}
+		return &apiError{Code: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+	}
+	return json.Unmarshal(respBytes, respBody)
+}
@@ -0,0 +1,299 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: pkg/plugins/ca/gcpcas/config/gcpcas_ca_config.proto
+
+package config
+
+import (
+	v1alpha1 "github.com/kumahq/kuma/api/system/v1alpha1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GcpCasCertificateAuthorityConfig defines configuration for Google
+// Certificate Authority Service plugin
+type GcpCasCertificateAuthorityConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resource name of the CA pool, e.g.
+	// projects/my-project/locations/us-east1/caPools/my-pool
+	CaPool string `protobuf:"bytes,1,opt,name=caPool,proto3" json:"caPool,omitempty"`
+	// ID of the CA in the pool that issues certificates. Default: any enabled
+	// CA of the pool
+	CertificateAuthority string `protobuf:"bytes,2,opt,name=certificateAuthority,proto3" json:"certificateAuthority,omitempty"`
+	// Custom endpoint of Certificate Authority Service. Default:
+	// https://privateca.googleapis.com
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Authentication to Google Cloud
+	Auth *GcpCasCertificateAuthorityConfig_Auth `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
+	// Maximum number of certificates issued per second. Default: 25
+	IssuanceRate *wrapperspb.UInt32Value `protobuf:"bytes,5,opt,name=issuanceRate,proto3" json:"issuanceRate,omitempty"`
+	// Maximum number of certificates issued at the same time. Default: 10
+	MaxConcurrentIssuance *wrapperspb.UInt32Value `protobuf:"bytes,6,opt,name=maxConcurrentIssuance,proto3" json:"maxConcurrentIssuance,omitempty"`
+}
+
+func (x *GcpCasCertificateAuthorityConfig) Reset() {
+	*x = GcpCasCertificateAuthorityConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GcpCasCertificateAuthorityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GcpCasCertificateAuthorityConfig) ProtoMessage() {}
+
+func (x *GcpCasCertificateAuthorityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GcpCasCertificateAuthorityConfig.ProtoReflect.Descriptor instead.
+func (*GcpCasCertificateAuthorityConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GcpCasCertificateAuthorityConfig) GetCaPool() string {
+	if x != nil {
+		return x.CaPool
+	}
+	return ""
+}
+
+func (x *GcpCasCertificateAuthorityConfig) GetCertificateAuthority() string {
+	if x != nil {
+		return x.CertificateAuthority
+	}
+	return ""
+}
+
+func (x *GcpCasCertificateAuthorityConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *GcpCasCertificateAuthorityConfig) GetAuth() *GcpCasCertificateAuthorityConfig_Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *GcpCasCertificateAuthorityConfig) GetIssuanceRate() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.IssuanceRate
+	}
+	return nil
+}
+
+func (x *GcpCasCertificateAuthorityConfig) GetMaxConcurrentIssuance() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.MaxConcurrentIssuance
+	}
+	return nil
+}
+
+// Auth defines the method of authentication to Google Cloud.
+type GcpCasCertificateAuthorityConfig_Auth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data source for the JSON key of the service account. If not defined,
+	// Application Default Credentials are used.
+	ServiceAccountKey *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=serviceAccountKey,proto3" json:"serviceAccountKey,omitempty"`
+}
+
+func (x *GcpCasCertificateAuthorityConfig_Auth) Reset() {
+	*x = GcpCasCertificateAuthorityConfig_Auth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GcpCasCertificateAuthorityConfig_Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GcpCasCertificateAuthorityConfig_Auth) ProtoMessage() {}
+
+func (x *GcpCasCertificateAuthorityConfig_Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GcpCasCertificateAuthorityConfig_Auth.ProtoReflect.Descriptor instead.
+func (*GcpCasCertificateAuthorityConfig_Auth) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *GcpCasCertificateAuthorityConfig_Auth) GetServiceAccountKey() *v1alpha1.DataSource {
+	if x != nil {
+		return x.ServiceAccountKey
+	}
+	return nil
+}
+
+var File_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto protoreflect.FileDescriptor
+
+var file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDesc = []byte{
+	0x0a, 0x33, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61,
+	0x2f, 0x67, 0x63, 0x70, 0x63, 0x61, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x67,
+	0x63, 0x70, 0x63, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x03, 0x0a, 0x20, 0x47, 0x63, 0x70,
+	0x43, 0x61, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x61, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x61, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x47, 0x63, 0x70, 0x43, 0x61, 0x73, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x12, 0x40, 0x0a, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x15, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x56, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x4e, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x11, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x42,
+	0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75,
+	0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2f, 0x63, 0x61, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDescOnce sync.Once
+	file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDescData = file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDesc
+)
+
+func file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDescGZIP() []byte {
+	file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDescOnce.Do(func() {
+		file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDescData)
+	})
+	return file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDescData
+}
+
+var file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_goTypes = []interface{}{
+	(*GcpCasCertificateAuthorityConfig)(nil),      // 0: kuma.plugins.ca.GcpCasCertificateAuthorityConfig
+	(*GcpCasCertificateAuthorityConfig_Auth)(nil), // 1: kuma.plugins.ca.GcpCasCertificateAuthorityConfig.Auth
+	(*wrapperspb.UInt32Value)(nil),                // 2: google.protobuf.UInt32Value
+	(*v1alpha1.DataSource)(nil),                   // 3: kuma.system.v1alpha1.DataSource
+}
+var file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_depIdxs = []int32{
+	1, // 0: kuma.plugins.ca.GcpCasCertificateAuthorityConfig.auth:type_name -> kuma.plugins.ca.GcpCasCertificateAuthorityConfig.Auth
+	2, // 1: kuma.plugins.ca.GcpCasCertificateAuthorityConfig.issuanceRate:type_name -> google.protobuf.UInt32Value
+	2, // 2: kuma.plugins.ca.GcpCasCertificateAuthorityConfig.maxConcurrentIssuance:type_name -> google.protobuf.UInt32Value
+	3, // 3: kuma.plugins.ca.GcpCasCertificateAuthorityConfig.Auth.serviceAccountKey:type_name -> kuma.system.v1alpha1.DataSource
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_init() }
+func file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_init() {
+	if File_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GcpCasCertificateAuthorityConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GcpCasCertificateAuthorityConfig_Auth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_goTypes,
+		DependencyIndexes: file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_depIdxs,
+		MessageInfos:      file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_msgTypes,
+	}.Build()
+	File_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto = out.File
+	file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_rawDesc = nil
+	file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_goTypes = nil
+	file_pkg_plugins_ca_gcpcas_config_gcpcas_ca_config_proto_depIdxs = nil
+}
@@ -0,0 +1,11 @@
+package gcpcas_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestCaGcpCas(t *testing.T) {
+	test.RunSpecs(t, "CA GCP CAS Suite")
+}
@@ -0,0 +1,272 @@
+package gcpcas
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/ca"
+	ca_issuer "github.com/kumahq/kuma/pkg/core/ca/issuer"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/plugins/ca/cloud"
+	"github.com/kumahq/kuma/pkg/plugins/ca/gcpcas/config"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var log = core.Log.WithName("plugins").WithName("ca").WithName("gcpcas")
+
+const (
+	defaultEndpoint    = "https://privateca.googleapis.com"
+	cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+	// default quota of CreateCertificate requests is 25 per second
+	defaultIssuanceRate          = 25
+	defaultMaxConcurrentIssuance = 10
+	rootCertsTTL                 = time.Minute
+	requestTimeout               = 10 * time.Second
+)
+
+var caPoolRegexp = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/caPools/[^/]+$`)
+
+type gcpCasCaManager struct {
+	dataSourceLoader datasource.Loader
+	throttlers       *cloud.Throttlers
+	rootCerts        *cloud.RootCerts
+
+	sync.Mutex
+	clients map[string]*client
+}
+
+var _ ca.Manager = &gcpCasCaManager{}
+
+func NewGcpCasCaManager(dataSourceLoader datasource.Loader) ca.Manager {
+	return &gcpCasCaManager{
+		dataSourceLoader: dataSourceLoader,
+		throttlers:       cloud.NewThrottlers(isThrottled),
+		rootCerts:        cloud.NewRootCerts(rootCertsTTL),
+		clients:          map[string]*client{},
+	}
+}
+
+func (g *gcpCasCaManager) ValidateBackend(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
+	verr := validators.ValidationError{}
+
+	cfg := &config.GcpCasCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		verr.AddViolation("", "could not convert backend config: "+err.Error())
+		return verr.OrNil()
+	}
+
+	if cfg.GetCaPool() == "" {
+		verr.AddViolation("caPool", "has to be defined")
+	} else if !caPoolRegexp.MatchString(cfg.GetCaPool()) {
+		verr.AddViolation("caPool", "has to be in format projects/<project>/locations/<location>/caPools/<pool>")
+	}
+	if cfg.GetEndpoint() != "" {
+		if u, err := url.Parse(cfg.GetEndpoint()); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			verr.AddViolation("endpoint", "has to be a valid http or https URL")
+		}
+	}
+	if cfg.GetAuth().GetServiceAccountKey() != nil {
+		verr.AddError("auth.serviceAccountKey", datasource.Validate(cfg.GetAuth().GetServiceAccountKey()))
+	}
+	if cfg.GetIssuanceRate() != nil && cfg.GetIssuanceRate().GetValue() == 0 {
+		verr.AddViolation("issuanceRate", "has to be greater than 0")
+	}
+	if cfg.GetMaxConcurrentIssuance() != nil && cfg.GetMaxConcurrentIssuance().GetValue() == 0 {
+		verr.AddViolation("maxConcurrentIssuance", "has to be greater than 0")
+	}
+
+	if !verr.HasViolations() {
+		if _, err := g.GetRootCert(ctx, mesh, backend); err != nil {
+			verr.AddViolation("", err.Error())
+		}
+	}
+	return verr.OrNil()
+}
+
+func (g *gcpCasCaManager) EnsureBackends(ctx context.Context, mesh string, backends []*mesh_proto.CertificateAuthorityBackend) error {
+	return nil // CA is managed by Google Cloud and retrieved from it when it's needed
+}
+
+func (g *gcpCasCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
+	cfg := &config.GcpCasCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to GcpCasCertificateAuthorityConfig")
+	}
+	var secrets []string
+	if cfg.GetAuth().GetServiceAccountKey().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetAuth().GetServiceAccountKey().GetSecret())
+	}
+	return secrets, nil
+}
+
+func (g *gcpCasCaManager) GetRootCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]ca.Cert, error) {
+	cfg, c, clientKey, err := g.client(ctx, mesh, backend)
+	if err != nil {
+		return nil, err
+	}
+	certs, err := g.rootCerts.Get(clientKey+"/"+cfg.GetCaPool(), func() ([]ca.Cert, error) {
+		chains, err := c.fetchCaCerts(ctx, cfg.GetCaPool())
+		if err != nil {
+			return nil, err
+		}
+		// CAs of the pool usually share the root CA, so we remove duplicates
+		var certs []ca.Cert
+		seen := map[string]bool{}
+		for _, chain := range chains {
+			for _, cert := range util_tls.SplitPEMCerts([]byte(strings.Join(chain, "\n"))) {
+				if !seen[string(cert)] {
+					seen[string(cert)] = true
+					certs = append(certs, cert)
+				}
+			}
+		}
+		if len(certs) == 0 {
+			return nil, errors.New("Certificate Authority Service did not return CA certificates")
+		}
+		return certs, nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve CA certificates from Certificate Authority Service for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return certs, nil
+}
+
+func (g *gcpCasCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (ca.KeyPair, error) {
+	cfg, c, _, err := g.client(ctx, mesh, backend)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+	expiration := ca_issuer.DefaultWorkloadCertValidityPeriod
+	if backend.GetDpCert().GetRotation().GetExpiration() != "" {
+		expiration, err = core_mesh.ParseDuration(backend.GetDpCert().GetRotation().Expiration)
+		if err != nil {
+			return ca.KeyPair{}, err
+		}
+	}
+	csr, keyPEM, err := ca_issuer.NewWorkloadCSR(mesh, tags)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+	// the same ID is used when the request is retried, so we don't create the certificate twice
+	certificateID, err := newCertificateID()
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+	req := createCertificateRequest{
+		PemCsr:   string(csr),
+		Lifetime: fmt.Sprintf("%ds", int64(expiration.Seconds())),
+	}
+
+	var cert certificate
+	throttler := g.throttlers.Get(cfg.GetCaPool(), issuanceRate(cfg), maxConcurrentIssuance(cfg))
+	err = throttler.Do(ctx, func() error {
+		var err error
+		cert, err = c.createCertificate(ctx, cfg.GetCaPool(), cfg.GetCertificateAuthority(), certificateID, req)
+		if isAlreadyExists(err) {
+			// the previous attempt created the certificate, but the response did not reach us
+			cert, err = c.getCertificate(ctx, cfg.GetCaPool(), certificateID)
+		}
+		return err
+	})
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "failed to issue a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend.Name)
+	}
+
+	// the certificate is followed by the chain of the CA, so it can be verified with the root CA only
+	certPEM := []string{strings.TrimSpace(cert.PemCertificate)}
+	for _, chainCert := range cert.PemCertificateChain {
+		certPEM = append(certPEM, strings.TrimSpace(chainCert))
+	}
+	return ca.KeyPair{
+		CertPEM: []byte(strings.Join(certPEM, "\n") + "\n"),
+		KeyPEM:  keyPEM,
+	}, nil
+}
+
+func newCertificateID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate ID of the certificate")
+	}
+	return "kuma-" + hex.EncodeToString(b), nil
+}
+
+func issuanceRate(cfg *config.GcpCasCertificateAuthorityConfig) uint32 {
+	if cfg.GetIssuanceRate() != nil {
+		return cfg.GetIssuanceRate().GetValue()
+	}
+	return defaultIssuanceRate
+}
+
+func maxConcurrentIssuance(cfg *config.GcpCasCertificateAuthorityConfig) uint32 {
+	if cfg.GetMaxConcurrentIssuance() != nil {
+		return cfg.GetMaxConcurrentIssuance().GetValue()
+	}
+	return defaultMaxConcurrentIssuance
+}
+
+// client returns the client of Certificate Authority Service for the backend and the key of the client.
+// Clients are cached by their configuration, so OAuth2 tokens are reused.
+func (g *gcpCasCaManager) client(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) (*config.GcpCasCertificateAuthorityConfig, *client, string, error) {
+	cfg := &config.GcpCasCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, nil, "", errors.Wrap(err, "could not convert backend config to GcpCasCertificateAuthorityConfig")
+	}
+	endpoint := strings.TrimSuffix(cfg.GetEndpoint(), "/")
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+	var serviceAccountKey []byte
+	key := endpoint + "/application-default"
+	if cfg.GetAuth().GetServiceAccountKey() != nil {
+		var err error
+		serviceAccountKey, err = g.dataSourceLoader.Load(ctx, mesh, cfg.GetAuth().GetServiceAccountKey())
+		if err != nil {
+			return nil, nil, "", errors.Wrap(err, "could not load the key of the service account")
+		}
+		key = fmt.Sprintf("%s/%x", endpoint, sha256.Sum256(serviceAccountKey))
+	}
+
+	g.Lock()
+	defer g.Unlock()
+	if c, ok := g.clients[key]; ok {
+		return cfg, c, key, nil
+	}
+	// the client outlives the request, so its token source can't use the context of the request
+	var creds *google.Credentials
+	var err error
+	if serviceAccountKey != nil {
+		creds, err = google.CredentialsFromJSON(context.Background(), serviceAccountKey, cloudPlatformScope)
+	} else {
+		creds, err = google.FindDefaultCredentials(context.Background(), cloudPlatformScope)
+	}
+	if err != nil {
+		return nil, nil, "", errors.Wrap(err, "could not load credentials of Google Cloud")
+	}
+	httpClient := oauth2.NewClient(context.Background(), creds.TokenSource)
+	httpClient.Timeout = requestTimeout
+	c := &client{
+		httpClient: httpClient,
+		endpoint:   endpoint,
+	}
+	g.clients[key] = c
+	log.V(1).Info("created client of Certificate Authority Service", "endpoint", endpoint)
+	return cfg, c, key, nil
+}
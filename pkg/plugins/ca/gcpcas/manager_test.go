@@ -0,0 +1,273 @@
+package gcpcas_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	"github.com/kumahq/kuma/pkg/plugins/ca/gcpcas"
+	"github.com/kumahq/kuma/pkg/util/proto"
+	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
+)
+
+const caPool = "projects/kuma/locations/us-east1/caPools/mesh"
+
+// fakeCas implements endpoints of Certificate Authority Service used by the CA and the token endpoint of Google OAuth2
+type fakeCas struct {
+	sync.Mutex
+	caCert    *x509.Certificate
+	caPEM     string
+	caSigner  interface{}
+	tokens    int
+	throttled int
+	certIDs   []string
+}
+
+func newFakeCas() *fakeCas {
+	key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "cas"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+	return &fakeCas{
+		caCert:   cert,
+		caPEM:    string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		caSigner: key,
+	}
+}
+
+func (f *fakeCas) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	respond := func(status int, resp interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		Expect(json.NewEncoder(w).Encode(resp)).To(Succeed())
+	}
+	if r.URL.Path == "/token" {
+		f.tokens++
+		respond(http.StatusOK, map[string]interface{}{"access_token": "access-token", "token_type": "Bearer", "expires_in": 3600})
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer access-token" {
+		respond(http.StatusUnauthorized, map[string]interface{}{"error": map[string]interface{}{"code": 401, "message": "unauthenticated", "status": "UNAUTHENTICATED"}})
+		return
+	}
+
+	switch r.URL.Path {
+	case "/v1/" + caPool + ":fetchCaCerts":
+		respond(http.StatusOK, map[string]interface{}{"caCerts": []interface{}{
+			map[string]interface{}{"certificates": []string{f.caPEM}},
+			map[string]interface{}{"certificates": []string{f.caPEM}},
+		}})
+	case "/v1/" + caPool + "/certificates":
+		if f.throttled == 0 {
+			f.throttled++
+			respond(http.StatusTooManyRequests, map[string]interface{}{"error": map[string]interface{}{"code": 429, "message": "quota exceeded", "status": "RESOURCE_EXHAUSTED"}})
+			return
+		}
+		f.certIDs = append(f.certIDs, r.URL.Query().Get("certificateId"))
+		req := map[string]string{}
+		Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+		lifetime, err := time.ParseDuration(req["lifetime"])
+		Expect(err).ToNot(HaveOccurred())
+		block, _ := pem.Decode([]byte(req["pemCsr"]))
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			URIs:         csr.URIs,
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(lifetime),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, f.caCert, csr.PublicKey, f.caSigner)
+		Expect(err).ToNot(HaveOccurred())
+		respond(http.StatusOK, map[string]interface{}{
+			"pemCertificate":      string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+			"pemCertificateChain": []string{f.caPEM},
+		})
+	default:
+		respond(http.StatusNotFound, map[string]interface{}{"error": map[string]interface{}{"code": 404, "message": "not found", "status": "NOT_FOUND"}})
+	}
+}
+
+var _ = Describe("GCP Certificate Authority Service", func() {
+	var caManager core_ca.Manager
+	var cas *fakeCas
+	var server *httptest.Server
+	var serviceAccountKey string
+
+	backend := func(configYAML string) *mesh_proto.CertificateAuthorityBackend {
+		configYAML = strings.ReplaceAll(configYAML, "ENDPOINT", server.URL)
+		configYAML = strings.ReplaceAll(configYAML, "SERVICE_ACCOUNT_KEY", serviceAccountKey)
+		str := structpb.Struct{}
+		Expect(proto.FromYAML([]byte(configYAML), &str)).To(Succeed())
+		return &mesh_proto.CertificateAuthorityBackend{
+			Name: "gcpcas-1",
+			Type: "gcpcas",
+			Conf: &str,
+			DpCert: &mesh_proto.CertificateAuthorityBackend_DpCert{
+				Rotation: &mesh_proto.CertificateAuthorityBackend_DpCert_Rotation{
+					Expiration: "1h",
+				},
+			},
+		}
+	}
+
+	validConfig := `
+        caPool: ` + caPool + `
+        endpoint: ENDPOINT
+        auth:
+          serviceAccountKey:
+            inlineString: 'SERVICE_ACCOUNT_KEY'`
+
+	BeforeEach(func() {
+		cas = newFakeCas()
+		server = httptest.NewServer(cas)
+		caManager = gcpcas.NewGcpCasCaManager(datasource.NewDataSourceLoader(nil))
+
+		key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+		Expect(err).ToNot(HaveOccurred())
+		keyPEM, err := util_rsa.FromPrivateKeyToPEMBytes(key)
+		Expect(err).ToNot(HaveOccurred())
+		keyJSON, err := json.Marshal(map[string]string{
+			"type":         "service_account",
+			"client_email": "kuma@kuma.iam.gserviceaccount.com",
+			"private_key":  string(keyPEM),
+			"token_uri":    server.URL + "/token",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		serviceAccountKey = string(keyJSON)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("ValidateBackend", func() {
+		type testCase struct {
+			configYAML string
+			expected   string
+		}
+
+		DescribeTable("should validate invalid config",
+			func(given testCase) {
+				// when
+				verr := caManager.ValidateBackend(context.Background(), "default", backend(given.configYAML))
+
+				// then
+				actual, err := yaml.Marshal(verr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty config", testCase{
+				configYAML: ``,
+				expected: `
+            violations:
+            - field: caPool
+              message: has to be defined`,
+			}),
+			Entry("invalid values", testCase{
+				configYAML: `
+            caPool: mesh
+            endpoint: privateca.googleapis.com
+            maxConcurrentIssuance: 0`,
+				expected: `
+            violations:
+            - field: caPool
+              message: has to be in format projects/<project>/locations/<location>/caPools/<pool>
+            - field: endpoint
+              message: has to be a valid http or https URL
+            - field: maxConcurrentIssuance
+              message: has to be greater than 0`,
+			}),
+			Entry("not existing pool", testCase{
+				configYAML: strings.ReplaceAll(validConfig, "caPools/mesh", "caPools/other"),
+				expected: `
+            violations:
+            - field: ""
+              message: 'failed to retrieve CA certificates from Certificate Authority Service for Mesh "default" and backend "gcpcas-1": Certificate Authority Service responded with status code 404: not found'`,
+			}),
+		)
+
+		It("should pass validation of valid config", func() {
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend(validConfig))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	It("should return distinct CA certs of the pool as root certs", func() {
+		// when
+		certs, err := caManager.GetRootCert(context.Background(), "default", backend(validConfig))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(certs).To(HaveLen(1))
+		Expect(string(certs[0])).To(Equal(cas.caPEM))
+	})
+
+	It("should generate dataplane certs and retry when the quota is exceeded", func() {
+		// given
+		tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+			"kuma.io/service": {"backend"},
+		})
+
+		// when
+		pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(validConfig), tags)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		_, err = tls.X509KeyPair(pair.CertPEM, pair.KeyPEM)
+		Expect(err).ToNot(HaveOccurred())
+
+		block, rest := pem.Decode(pair.CertPEM)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.URIs[0].String()).To(Equal("spiffe://default/backend"))
+		Expect(cert.NotAfter).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		Expect(strings.TrimSpace(string(rest))).To(Equal(strings.TrimSpace(cas.caPEM)))
+		Expect(cas.throttled).To(Equal(1))
+		Expect(cas.certIDs).To(HaveLen(1))
+		Expect(cas.certIDs[0]).To(HavePrefix("kuma-"))
+
+		// when
+		_, err = caManager.GenerateDataplaneCert(context.Background(), "default", backend(validConfig), tags)
+
+		// then the token is reused
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cas.tokens).To(Equal(1))
+	})
+})
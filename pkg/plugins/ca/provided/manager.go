@@ -20,6 +20,7 @@ type providedCaManager struct {
 }
 
 var _ ca.Manager = &providedCaManager{}
+var _ ca.RevocationListSigner = &providedCaManager{}
 
 func NewProvidedCaManager(dataSourceLoader datasource.Loader) ca.Manager {
 	return &providedCaManager{
@@ -126,3 +127,11 @@ func (p *providedCaManager) GenerateDataplaneCert(ctx context.Context, mesh stri
 	}
 	return *keyPair, nil
 }
+
+func (p *providedCaManager) SignRevocationList(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, list ca.RevocationList) ([]byte, error) {
+	meshCa, err := p.getCa(ctx, mesh, backend)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load CA key pair for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return ca_issuer.NewRevocationList(meshCa, list.SerialNumbers, list.ThisUpdate, list.NextUpdate)
+}
@@ -0,0 +1,223 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+const requestTimeout = 10 * time.Second
+
+// client is a minimal client of Vault HTTP API that covers the endpoints used by the CA.
+type client struct {
+	httpClient *http.Client
+	address    string
+	namespace  string
+}
+
+type tlsConfig struct {
+	caCert     []byte
+	skipVerify bool
+	serverName string
+}
+
+func newClient(address string, namespace string, cfg tlsConfig) (*client, error) {
+	tlsCfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.skipVerify, // #nosec G402 -- explicitly configured by the user
+		ServerName:         cfg.serverName,
+	}
+	if len(cfg.caCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.caCert) {
+			return nil, errors.New("could not parse the certificate of CA of Vault")
+		}
+		tlsCfg.RootCAs = pool
+	}
+	return &client{
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: tlsCfg,
+			},
+		},
+		address:   strings.TrimSuffix(address, "/"),
+		namespace: namespace,
+	}, nil
+}
+
+// close releases connections of the client, clients are created for every operation.
+func (c *client) close() {
+	c.httpClient.CloseIdleConnections()
+}
+
+// token is a Vault token with its lease.
+type token struct {
+	value     string
+	renewable bool
+	issued    time.Time
+	// expiration is zero for tokens that do not expire
+	expiration time.Time
+}
+
+// fresh returns true if the token is before the half of its lease.
+func (t *token) fresh(now time.Time) bool {
+	return t.expiration.IsZero() || now.Before(t.issued.Add(t.expiration.Sub(t.issued)/2))
+}
+
+func (t *token) expired(now time.Time) bool {
+	return !t.expiration.IsZero() && !now.Before(t.expiration)
+}
+
+type authResponse struct {
+	Auth struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int64  `json:"lease_duration"`
+		Renewable     bool   `json:"renewable"`
+	} `json:"auth"`
+}
+
+func (a authResponse) token() (*token, error) {
+	if a.Auth.ClientToken == "" {
+		return nil, errors.New("Vault did not return a token")
+	}
+	now := core.Now()
+	t := &token{
+		value:     a.Auth.ClientToken,
+		renewable: a.Auth.Renewable,
+		issued:    now,
+	}
+	if a.Auth.LeaseDuration > 0 {
+		t.expiration = now.Add(time.Duration(a.Auth.LeaseDuration) * time.Second)
+	}
+	return t, nil
+}
+
+// loginAppRole authenticates with AppRole auth method mounted on the path.
+func (c *client) loginAppRole(ctx context.Context, path string, roleID string, secretID string) (*token, error) {
+	req := map[string]string{
+		"role_id":   roleID,
+		"secret_id": secretID,
+	}
+	resp := authResponse{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("auth/%s/login", path), "", req, &resp); err != nil {
+		return nil, errors.Wrap(err, "could not log in with AppRole")
+	}
+	return resp.token()
+}
+
+// renewSelf extends the lease of the token.
+func (c *client) renewSelf(ctx context.Context, t *token) (*token, error) {
+	resp := authResponse{}
+	if err := c.do(ctx, http.MethodPost, "auth/token/renew-self", t.value, struct{}{}, &resp); err != nil {
+		return nil, errors.Wrap(err, "could not renew the token")
+	}
+	return resp.token()
+}
+
+// caChain returns PEM encoded chain of the CA of the PKI secrets engine, starting with the issuing CA.
+func (c *client) caChain(ctx context.Context, pki string, tokenValue string) ([]byte, error) {
+	chain, err := c.raw(ctx, pki+"/ca_chain", tokenValue)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(chain)) != 0 {
+		return chain, nil
+	}
+	// ca_chain of the mount with a root CA can be empty
+	return c.raw(ctx, pki+"/ca/pem", tokenValue)
+}
+
+type signRequest struct {
+	CSR     string `json:"csr"`
+	URISans string `json:"uri_sans,omitempty"`
+	TTL     string `json:"ttl,omitempty"`
+	Format  string `json:"format"`
+}
+
+type signResponse struct {
+	Data struct {
+		Certificate string   `json:"certificate"`
+		IssuingCA   string   `json:"issuing_ca"`
+		CAChain     []string `json:"ca_chain"`
+	} `json:"data"`
+}
+
+// sign signs the CSR by the PKI secrets engine using the role.
+func (c *client) sign(ctx context.Context, pki string, role string, tokenValue string, req signRequest) (signResponse, error) {
+	resp := signResponse{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("%s/sign/%s", pki, role), tokenValue, req, &resp); err != nil {
+		return signResponse{}, errors.Wrap(err, "could not sign the certificate")
+	}
+	if resp.Data.Certificate == "" {
+		return signResponse{}, errors.New("Vault did not return a certificate")
+	}
+	return resp, nil
+}
+
+func (c *client) do(ctx context.Context, method string, path string, tokenValue string, reqBody interface{}, respBody interface{}) error {
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return err
+	}
+	resp, err := c.send(ctx, method, path, tokenValue, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(resp, respBody)
+}
+
+func (c *client) raw(ctx context.Context, path string, tokenValue string) ([]byte, error) {
+	return c.send(ctx, http.MethodGet, path, tokenValue, nil)
+}
+
+func (c *client) send(ctx context.Context, method string, path string, tokenValue string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/v1/%s", c.address, strings.TrimPrefix(path, "/")), body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if tokenValue != "" {
+		req.Header.Set("X-Vault-Token", tokenValue)
+	}
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, errorFromResponse(resp.StatusCode, respBody)
+	}
+	return respBody, nil
+}
+
+// errorFromResponse builds an error from errors returned by Vault in the body.
+func errorFromResponse(status int, body []byte) error {
+	var vaultErr struct {
+		Errors []string `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &vaultErr); err == nil && len(vaultErr.Errors) > 0 {
+		return errors.Errorf("Vault responded with status code %d: %s", status, strings.Join(vaultErr.Errors, ", "))
+	}
+	return errors.Errorf("Vault responded with status code %d", status)
+}
@@ -0,0 +1,546 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.20.0
+// source: pkg/plugins/ca/vault/config/vault_ca_config.proto
+
+package config
+
+import (
+	v1alpha1 "github.com/kumahq/kuma/api/system/v1alpha1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VaultCertificateAuthorityConfig defines configuration for Vault CA plugin
+type VaultCertificateAuthorityConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of Vault, e.g. https://vault.example.com:8200
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Vault Enterprise namespace
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Path on which PKI secrets engine with the CA of the mesh is mounted
+	Pki string `protobuf:"bytes,3,opt,name=pki,proto3" json:"pki,omitempty"`
+	// Role of PKI secrets engine used to sign certificates of dataplanes. The
+	// role has to allow URI SANs of dataplanes (spiffe://<mesh>/* and
+	// kuma://*) and certificates without a common name.
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// Configuration of the connection to Vault
+	Tls *VaultCertificateAuthorityConfig_Tls `protobuf:"bytes,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Authentication to Vault
+	Auth *VaultCertificateAuthorityConfig_Auth `protobuf:"bytes,6,opt,name=auth,proto3" json:"auth,omitempty"`
+}
+
+func (x *VaultCertificateAuthorityConfig) Reset() {
+	*x = VaultCertificateAuthorityConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultCertificateAuthorityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultCertificateAuthorityConfig) ProtoMessage() {}
+
+func (x *VaultCertificateAuthorityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultCertificateAuthorityConfig.ProtoReflect.Descriptor instead.
+func (*VaultCertificateAuthorityConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VaultCertificateAuthorityConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VaultCertificateAuthorityConfig) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *VaultCertificateAuthorityConfig) GetPki() string {
+	if x != nil {
+		return x.Pki
+	}
+	return ""
+}
+
+func (x *VaultCertificateAuthorityConfig) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *VaultCertificateAuthorityConfig) GetTls() *VaultCertificateAuthorityConfig_Tls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *VaultCertificateAuthorityConfig) GetAuth() *VaultCertificateAuthorityConfig_Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+// Tls defines configuration of the connection to Vault.
+type VaultCertificateAuthorityConfig_Tls struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data source for the certificate of CA that signed the certificate of
+	// Vault
+	CaCert *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=caCert,proto3" json:"caCert,omitempty"`
+	// If true, the certificate of Vault is not verified
+	SkipVerify bool `protobuf:"varint,2,opt,name=skipVerify,proto3" json:"skipVerify,omitempty"`
+	// Server name used to verify the certificate of Vault
+	ServerName string `protobuf:"bytes,3,opt,name=serverName,proto3" json:"serverName,omitempty"`
+}
+
+func (x *VaultCertificateAuthorityConfig_Tls) Reset() {
+	*x = VaultCertificateAuthorityConfig_Tls{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultCertificateAuthorityConfig_Tls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultCertificateAuthorityConfig_Tls) ProtoMessage() {}
+
+func (x *VaultCertificateAuthorityConfig_Tls) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultCertificateAuthorityConfig_Tls.ProtoReflect.Descriptor instead.
+func (*VaultCertificateAuthorityConfig_Tls) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *VaultCertificateAuthorityConfig_Tls) GetCaCert() *v1alpha1.DataSource {
+	if x != nil {
+		return x.CaCert
+	}
+	return nil
+}
+
+func (x *VaultCertificateAuthorityConfig_Tls) GetSkipVerify() bool {
+	if x != nil {
+		return x.SkipVerify
+	}
+	return false
+}
+
+func (x *VaultCertificateAuthorityConfig_Tls) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+// Auth defines the method of authentication to Vault.
+type VaultCertificateAuthorityConfig_Auth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Authentication with Vault token
+	Token *VaultCertificateAuthorityConfig_Auth_Token `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Authentication with AppRole auth method
+	AppRole *VaultCertificateAuthorityConfig_Auth_AppRole `protobuf:"bytes,2,opt,name=appRole,proto3" json:"appRole,omitempty"`
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth) Reset() {
+	*x = VaultCertificateAuthorityConfig_Auth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultCertificateAuthorityConfig_Auth) ProtoMessage() {}
+
+func (x *VaultCertificateAuthorityConfig_Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultCertificateAuthorityConfig_Auth.ProtoReflect.Descriptor instead.
+func (*VaultCertificateAuthorityConfig_Auth) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth) GetToken() *VaultCertificateAuthorityConfig_Auth_Token {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth) GetAppRole() *VaultCertificateAuthorityConfig_Auth_AppRole {
+	if x != nil {
+		return x.AppRole
+	}
+	return nil
+}
+
+// Token defines authentication with Vault token.
+type VaultCertificateAuthorityConfig_Auth_Token struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data source for the token
+	Secret *v1alpha1.DataSource `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth_Token) Reset() {
+	*x = VaultCertificateAuthorityConfig_Auth_Token{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth_Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultCertificateAuthorityConfig_Auth_Token) ProtoMessage() {}
+
+func (x *VaultCertificateAuthorityConfig_Auth_Token) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultCertificateAuthorityConfig_Auth_Token.ProtoReflect.Descriptor instead.
+func (*VaultCertificateAuthorityConfig_Auth_Token) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth_Token) GetSecret() *v1alpha1.DataSource {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+// AppRole defines authentication with AppRole auth method.
+type VaultCertificateAuthorityConfig_Auth_AppRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path on which AppRole auth method is mounted. Default: approle
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// RoleId of the AppRole
+	RoleId string `protobuf:"bytes,2,opt,name=roleId,proto3" json:"roleId,omitempty"`
+	// Data source for the SecretId of the AppRole
+	SecretId *v1alpha1.DataSource `protobuf:"bytes,3,opt,name=secretId,proto3" json:"secretId,omitempty"`
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth_AppRole) Reset() {
+	*x = VaultCertificateAuthorityConfig_Auth_AppRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth_AppRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultCertificateAuthorityConfig_Auth_AppRole) ProtoMessage() {}
+
+func (x *VaultCertificateAuthorityConfig_Auth_AppRole) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultCertificateAuthorityConfig_Auth_AppRole.ProtoReflect.Descriptor instead.
+func (*VaultCertificateAuthorityConfig_Auth_AppRole) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescGZIP(), []int{0, 1, 1}
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth_AppRole) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth_AppRole) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *VaultCertificateAuthorityConfig_Auth_AppRole) GetSecretId() *v1alpha1.DataSource {
+	if x != nil {
+		return x.SecretId
+	}
+	return nil
+}
+
+var File_pkg_plugins_ca_vault_config_vault_ca_config_proto protoreflect.FileDescriptor
+
+var file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61,
+	0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x63, 0x61, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2e, 0x63, 0x61, 0x1a, 0x20, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x06, 0x0a, 0x1f, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6b, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x70, 0x6b, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x6c, 0x73, 0x52, 0x03, 0x74, 0x6c, 0x73,
+	0x12, 0x49, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61,
+	0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x7f, 0x0a, 0x03, 0x54,
+	0x6c, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xea, 0x02, 0x0a,
+	0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x51, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x57, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x52,
+	0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6b, 0x75, 0x6d, 0x61,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x63, 0x61, 0x2e, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x2e, 0x41, 0x70, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x07, 0x61, 0x70, 0x70, 0x52, 0x6f, 0x6c,
+	0x65, 0x1a, 0x41, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x75, 0x6d,
+	0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x1a, 0x73, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6b, 0x75, 0x6d, 0x61, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x08, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6d, 0x61, 0x68, 0x71, 0x2f, 0x6b,
+	0x75, 0x6d, 0x61, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescOnce sync.Once
+	file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescData = file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDesc
+)
+
+func file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescGZIP() []byte {
+	file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescOnce.Do(func() {
+		file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescData)
+	})
+	return file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDescData
+}
+
+var file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_plugins_ca_vault_config_vault_ca_config_proto_goTypes = []interface{}{
+	(*VaultCertificateAuthorityConfig)(nil),              // 0: kuma.plugins.ca.VaultCertificateAuthorityConfig
+	(*VaultCertificateAuthorityConfig_Tls)(nil),          // 1: kuma.plugins.ca.VaultCertificateAuthorityConfig.Tls
+	(*VaultCertificateAuthorityConfig_Auth)(nil),         // 2: kuma.plugins.ca.VaultCertificateAuthorityConfig.Auth
+	(*VaultCertificateAuthorityConfig_Auth_Token)(nil),   // 3: kuma.plugins.ca.VaultCertificateAuthorityConfig.Auth.Token
+	(*VaultCertificateAuthorityConfig_Auth_AppRole)(nil), // 4: kuma.plugins.ca.VaultCertificateAuthorityConfig.Auth.AppRole
+	(*v1alpha1.DataSource)(nil),                          // 5: kuma.system.v1alpha1.DataSource
+}
+var file_pkg_plugins_ca_vault_config_vault_ca_config_proto_depIdxs = []int32{
+	1, // 0: kuma.plugins.ca.VaultCertificateAuthorityConfig.tls:type_name -> kuma.plugins.ca.VaultCertificateAuthorityConfig.Tls
+	2, // 1: kuma.plugins.ca.VaultCertificateAuthorityConfig.auth:type_name -> kuma.plugins.ca.VaultCertificateAuthorityConfig.Auth
+	5, // 2: kuma.plugins.ca.VaultCertificateAuthorityConfig.Tls.caCert:type_name -> kuma.system.v1alpha1.DataSource
+	3, // 3: kuma.plugins.ca.VaultCertificateAuthorityConfig.Auth.token:type_name -> kuma.plugins.ca.VaultCertificateAuthorityConfig.Auth.Token
+	4, // 4: kuma.plugins.ca.VaultCertificateAuthorityConfig.Auth.appRole:type_name -> kuma.plugins.ca.VaultCertificateAuthorityConfig.Auth.AppRole
+	5, // 5: kuma.plugins.ca.VaultCertificateAuthorityConfig.Auth.Token.secret:type_name -> kuma.system.v1alpha1.DataSource
+	5, // 6: kuma.plugins.ca.VaultCertificateAuthorityConfig.Auth.AppRole.secretId:type_name -> kuma.system.v1alpha1.DataSource
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_pkg_plugins_ca_vault_config_vault_ca_config_proto_init() }
+func file_pkg_plugins_ca_vault_config_vault_ca_config_proto_init() {
+	if File_pkg_plugins_ca_vault_config_vault_ca_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultCertificateAuthorityConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultCertificateAuthorityConfig_Tls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultCertificateAuthorityConfig_Auth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultCertificateAuthorityConfig_Auth_Token); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultCertificateAuthorityConfig_Auth_AppRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_plugins_ca_vault_config_vault_ca_config_proto_goTypes,
+		DependencyIndexes: file_pkg_plugins_ca_vault_config_vault_ca_config_proto_depIdxs,
+		MessageInfos:      file_pkg_plugins_ca_vault_config_vault_ca_config_proto_msgTypes,
+	}.Build()
+	File_pkg_plugins_ca_vault_config_vault_ca_config_proto = out.File
+	file_pkg_plugins_ca_vault_config_vault_ca_config_proto_rawDesc = nil
+	file_pkg_plugins_ca_vault_config_vault_ca_config_proto_goTypes = nil
+	file_pkg_plugins_ca_vault_config_vault_ca_config_proto_depIdxs = nil
+}
@@ -0,0 +1,276 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/ca"
+	ca_issuer "github.com/kumahq/kuma/pkg/core/ca/issuer"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/validators"
+	"github.com/kumahq/kuma/pkg/plugins/ca/vault/config"
+	util_tls "github.com/kumahq/kuma/pkg/tls"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var log = core.Log.WithName("plugins").WithName("ca").WithName("vault")
+
+const defaultAppRolePath = "approle"
+
+type vaultCaManager struct {
+	dataSourceLoader datasource.Loader
+
+	sync.Mutex
+	// tokens holds tokens obtained with AppRole auth method, so we don't log in for every certificate
+	tokens map[string]*token
+}
+
+var _ ca.Manager = &vaultCaManager{}
+
+func NewVaultCaManager(dataSourceLoader datasource.Loader) ca.Manager {
+	return &vaultCaManager{
+		dataSourceLoader: dataSourceLoader,
+		tokens:           map[string]*token{},
+	}
+}
+
+func (v *vaultCaManager) ValidateBackend(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) error {
+	verr := validators.ValidationError{}
+
+	cfg := &config.VaultCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		verr.AddViolation("", "could not convert backend config: "+err.Error())
+		return verr.OrNil()
+	}
+
+	if cfg.GetAddress() == "" {
+		verr.AddViolation("address", "has to be defined")
+	} else if u, err := url.Parse(cfg.GetAddress()); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		verr.AddViolation("address", "has to be a valid http or https URL")
+	}
+	if cfg.GetPki() == "" {
+		verr.AddViolation("pki", "has to be defined")
+	}
+	if cfg.GetRole() == "" {
+		verr.AddViolation("role", "has to be defined")
+	}
+	if cfg.GetTls().GetCaCert() != nil {
+		verr.AddError("tls.caCert", datasource.Validate(cfg.GetTls().GetCaCert()))
+	}
+	verr.AddError("auth", validateAuth(cfg.GetAuth()))
+
+	if !verr.HasViolations() {
+		if _, err := v.GetRootCert(ctx, mesh, backend); err != nil {
+			verr.AddViolation("", err.Error())
+		}
+	}
+	return verr.OrNil()
+}
+
+func validateAuth(auth *config.VaultCertificateAuthorityConfig_Auth) validators.ValidationError {
+	verr := validators.ValidationError{}
+	switch {
+	case auth.GetToken() == nil && auth.GetAppRole() == nil:
+		verr.AddViolation("", "either token or appRole has to be defined")
+	case auth.GetToken() != nil && auth.GetAppRole() != nil:
+		verr.AddViolation("", "only one of token or appRole can be defined")
+	case auth.GetToken() != nil:
+		if auth.GetToken().GetSecret() == nil {
+			verr.AddViolation("token.secret", "has to be defined")
+		} else {
+			verr.AddError("token.secret", datasource.Validate(auth.GetToken().GetSecret()))
+		}
+	default:
+		if auth.GetAppRole().GetRoleId() == "" {
+			verr.AddViolation("appRole.roleId", "has to be defined")
+		}
+		if auth.GetAppRole().GetSecretId() == nil {
+			verr.AddViolation("appRole.secretId", "has to be defined")
+		} else {
+			verr.AddError("appRole.secretId", datasource.Validate(auth.GetAppRole().GetSecretId()))
+		}
+	}
+	return verr
+}
+
+func (v *vaultCaManager) EnsureBackends(ctx context.Context, mesh string, backends []*mesh_proto.CertificateAuthorityBackend) error {
+	return nil // CA is managed by Vault and retrieved from it when it's needed
+}
+
+func (v *vaultCaManager) UsedSecrets(mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]string, error) {
+	cfg := &config.VaultCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not convert backend config to VaultCertificateAuthorityConfig")
+	}
+	var secrets []string
+	if cfg.GetTls().GetCaCert().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetTls().GetCaCert().GetSecret())
+	}
+	if cfg.GetAuth().GetToken().GetSecret().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetAuth().GetToken().GetSecret().GetSecret())
+	}
+	if cfg.GetAuth().GetAppRole().GetSecretId().GetSecret() != "" {
+		secrets = append(secrets, cfg.GetAuth().GetAppRole().GetSecretId().GetSecret())
+	}
+	return secrets, nil
+}
+
+func (v *vaultCaManager) GetRootCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend) ([]ca.Cert, error) {
+	var chain []byte
+	err := v.withClient(ctx, mesh, backend, func(c *client, cfg *config.VaultCertificateAuthorityConfig, tokenValue string) error {
+		var err error
+		chain, err = c.caChain(ctx, cfg.GetPki(), tokenValue)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve CA certificates from Vault for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	certs := util_tls.SplitPEMCerts(chain)
+	if len(certs) == 0 {
+		return nil, errors.Errorf("Vault did not return CA certificates for Mesh %q and backend %q", mesh, backend.Name)
+	}
+	return certs, nil
+}
+
+func (v *vaultCaManager) GenerateDataplaneCert(ctx context.Context, mesh string, backend *mesh_proto.CertificateAuthorityBackend, tags mesh_proto.MultiValueTagSet) (ca.KeyPair, error) {
+	csr, keyPEM, err := ca_issuer.NewWorkloadCSR(mesh, tags)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+	uris, err := ca_issuer.WorkloadURIs(mesh, tags)
+	if err != nil {
+		return ca.KeyPair{}, err
+	}
+	req := signRequest{
+		CSR:    string(csr),
+		Format: "pem",
+	}
+	var uriSans []string
+	for _, uri := range uris {
+		uriSans = append(uriSans, uri.String())
+	}
+	req.URISans = strings.Join(uriSans, ",")
+	if backend.GetDpCert().GetRotation().GetExpiration() != "" {
+		duration, err := core_mesh.ParseDuration(backend.GetDpCert().GetRotation().Expiration)
+		if err != nil {
+			return ca.KeyPair{}, err
+		}
+		req.TTL = duration.String()
+	}
+
+	var resp signResponse
+	err = v.withClient(ctx, mesh, backend, func(c *client, cfg *config.VaultCertificateAuthorityConfig, tokenValue string) error {
+		var err error
+		resp, err = c.sign(ctx, cfg.GetPki(), cfg.GetRole(), tokenValue, req)
+		return err
+	})
+	if err != nil {
+		return ca.KeyPair{}, errors.Wrapf(err, "failed to generate a Workload Identity cert for tags %q in Mesh %q using backend %q", tags.String(), mesh, backend.Name)
+	}
+
+	// the certificate is followed by the intermediate CAs, so it can be verified with the root CA only
+	chain := resp.Data.CAChain
+	if len(chain) == 0 && resp.Data.IssuingCA != "" {
+		chain = []string{resp.Data.IssuingCA}
+	}
+	certPEM := []string{strings.TrimSpace(resp.Data.Certificate)}
+	for _, cert := range chain {
+		certPEM = append(certPEM, strings.TrimSpace(cert))
+	}
+	return ca.KeyPair{
+		CertPEM: []byte(strings.Join(certPEM, "\n") + "\n"),
+		KeyPEM:  keyPEM,
+	}, nil
+}
+
+// withClient calls fn with the client of Vault and the token of the backend.
+func (v *vaultCaManager) withClient(
+	ctx context.Context,
+	mesh string,
+	backend *mesh_proto.CertificateAuthorityBackend,
+	fn func(c *client, cfg *config.VaultCertificateAuthorityConfig, tokenValue string) error,
+) error {
+	cfg := &config.VaultCertificateAuthorityConfig{}
+	if err := util_proto.ToTyped(backend.Conf, cfg); err != nil {
+		return errors.Wrap(err, "could not convert backend config to VaultCertificateAuthorityConfig")
+	}
+	tlsCfg := tlsConfig{
+		skipVerify: cfg.GetTls().GetSkipVerify(),
+		serverName: cfg.GetTls().GetServerName(),
+	}
+	if cfg.GetTls().GetCaCert() != nil {
+		caCert, err := v.dataSourceLoader.Load(ctx, mesh, cfg.GetTls().GetCaCert())
+		if err != nil {
+			return errors.Wrap(err, "could not load the certificate of CA of Vault")
+		}
+		tlsCfg.caCert = caCert
+	}
+	c, err := newClient(cfg.GetAddress(), cfg.GetNamespace(), tlsCfg)
+	if err != nil {
+		return err
+	}
+	defer c.close()
+
+	tokenValue, err := v.token(ctx, c, mesh, backend.Name, cfg.GetAuth())
+	if err != nil {
+		return err
+	}
+	return fn(c, cfg, tokenValue)
+}
+
+// token returns the token used to authenticate to Vault. Tokens obtained with AppRole auth method are cached.
+// The token is renewed after the half of its lease if it's renewable, otherwise we log in again.
+func (v *vaultCaManager) token(ctx context.Context, c *client, mesh string, backendName string, auth *config.VaultCertificateAuthorityConfig_Auth) (string, error) {
+	if auth.GetToken() != nil {
+		t, err := v.dataSourceLoader.Load(ctx, mesh, auth.GetToken().GetSecret())
+		if err != nil {
+			return "", errors.Wrap(err, "could not load the token")
+		}
+		return strings.TrimSpace(string(t)), nil
+	}
+
+	appRole := auth.GetAppRole()
+	path := appRole.GetPath()
+	if path == "" {
+		path = defaultAppRolePath
+	}
+	key := fmt.Sprintf("%s/%s/%s/%s/%s", mesh, backendName, c.address, path, appRole.GetRoleId())
+
+	v.Lock()
+	defer v.Unlock()
+
+	now := core.Now()
+	if t, ok := v.tokens[key]; ok {
+		if t.fresh(now) {
+			return t.value, nil
+		}
+		if t.renewable && !t.expired(now) {
+			renewed, err := c.renewSelf(ctx, t)
+			if err == nil {
+				v.tokens[key] = renewed
+				return renewed.value, nil
+			}
+			log.Info("could not renew the token, logging in again", "mesh", mesh, "backend", backendName, "err", err)
+		}
+		delete(v.tokens, key)
+	}
+
+	secretID, err := v.dataSourceLoader.Load(ctx, mesh, appRole.GetSecretId())
+	if err != nil {
+		return "", errors.Wrap(err, "could not load the SecretId")
+	}
+	t, err := c.loginAppRole(ctx, path, appRole.GetRoleId(), strings.TrimSpace(string(secretID)))
+	if err != nil {
+		return "", err
+	}
+	v.tokens[key] = t
+	return t.value, nil
+}
@@ -0,0 +1,377 @@
+package vault_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/datasource"
+	"github.com/kumahq/kuma/pkg/plugins/ca/vault"
+	"github.com/kumahq/kuma/pkg/util/proto"
+	util_rsa "github.com/kumahq/kuma/pkg/util/rsa"
+)
+
+// fakeVault implements endpoints of Vault HTTP API used by the CA
+type fakeVault struct {
+	sync.Mutex
+	caCert   *x509.Certificate
+	caPEM    string
+	caSigner interface{}
+	tokens   map[string]bool
+	logins   int
+	renewals int
+}
+
+func newFakeVault() *fakeVault {
+	key, err := util_rsa.GenerateKey(util_rsa.DefaultKeySize)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "vault-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+	return &fakeVault{
+		caCert:   cert,
+		caPEM:    string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		caSigner: key,
+		tokens:   map[string]bool{"root-token": true},
+	}
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	body := map[string]string{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	respond := func(status int, resp interface{}) {
+		w.WriteHeader(status)
+		Expect(json.NewEncoder(w).Encode(resp)).To(Succeed())
+	}
+	authorized := f.tokens[r.Header.Get("X-Vault-Token")]
+
+	switch r.URL.Path {
+	case "/v1/auth/approle/login":
+		if body["role_id"] != "kuma" || body["secret_id"] != "s3cr3t" {
+			respond(http.StatusBadRequest, map[string]interface{}{"errors": []string{"invalid role or secret ID"}})
+			return
+		}
+		f.logins++
+		token := "approle-token-" + big.NewInt(int64(f.logins)).String()
+		f.tokens[token] = true
+		respond(http.StatusOK, map[string]interface{}{"auth": map[string]interface{}{"client_token": token, "lease_duration": 60, "renewable": true}})
+	case "/v1/auth/token/renew-self":
+		if !authorized {
+			respond(http.StatusForbidden, map[string]interface{}{"errors": []string{"permission denied"}})
+			return
+		}
+		f.renewals++
+		respond(http.StatusOK, map[string]interface{}{"auth": map[string]interface{}{"client_token": r.Header.Get("X-Vault-Token"), "lease_duration": 60, "renewable": true}})
+	case "/v1/pki/ca_chain":
+		_, _ = w.Write([]byte(f.caPEM))
+	case "/v1/pki/sign/dataplane":
+		if !authorized {
+			respond(http.StatusForbidden, map[string]interface{}{"errors": []string{"permission denied"}})
+			return
+		}
+		block, _ := pem.Decode([]byte(body["csr"]))
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		ttl, err := time.ParseDuration(body["ttl"])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body["uri_sans"]).To(Equal(uris(csr.URIs)))
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			URIs:         csr.URIs,
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(ttl),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, f.caCert, csr.PublicKey, f.caSigner)
+		Expect(err).ToNot(HaveOccurred())
+		respond(http.StatusOK, map[string]interface{}{"data": map[string]interface{}{
+			"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+			"issuing_ca":  f.caPEM,
+			"ca_chain":    []string{f.caPEM},
+		}})
+	default:
+		respond(http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+	}
+}
+
+func uris(us []*url.URL) string {
+	var values []string
+	for _, u := range us {
+		values = append(values, u.String())
+	}
+	return strings.Join(values, ",")
+}
+
+var _ = Describe("Vault CA", func() {
+	var caManager core_ca.Manager
+	var vaultServer *fakeVault
+	var server *httptest.Server
+
+	now := time.Now()
+
+	backend := func(configYAML string) *mesh_proto.CertificateAuthorityBackend {
+		str := structpb.Struct{}
+		Expect(proto.FromYAML([]byte(strings.ReplaceAll(configYAML, "VAULT_ADDRESS", server.URL)), &str)).To(Succeed())
+		return &mesh_proto.CertificateAuthorityBackend{
+			Name: "vault-1",
+			Type: "vault",
+			Conf: &str,
+			DpCert: &mesh_proto.CertificateAuthorityBackend_DpCert{
+				Rotation: &mesh_proto.CertificateAuthorityBackend_DpCert_Rotation{
+					Expiration: "1h",
+				},
+			},
+		}
+	}
+
+	tokenConfig := `
+        address: VAULT_ADDRESS
+        pki: pki
+        role: dataplane
+        auth:
+          token:
+            secret:
+              inlineString: root-token`
+
+	appRoleConfig := `
+        address: VAULT_ADDRESS
+        pki: pki
+        role: dataplane
+        auth:
+          appRole:
+            roleId: kuma
+            secretId:
+              inlineString: s3cr3t`
+
+	BeforeEach(func() {
+		core.Now = func() time.Time {
+			return now
+		}
+		vaultServer = newFakeVault()
+		server = httptest.NewServer(vaultServer)
+		caManager = vault.NewVaultCaManager(datasource.NewDataSourceLoader(nil))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		core.Now = time.Now
+	})
+
+	Context("ValidateBackend", func() {
+		type testCase struct {
+			configYAML string
+			expected   string
+		}
+
+		DescribeTable("should validate invalid config",
+			func(given testCase) {
+				// when
+				verr := caManager.ValidateBackend(context.Background(), "default", backend(given.configYAML))
+
+				// then
+				actual, err := yaml.Marshal(verr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty config", testCase{
+				configYAML: ``,
+				expected: `
+            violations:
+            - field: address
+              message: has to be defined
+            - field: pki
+              message: has to be defined
+            - field: role
+              message: has to be defined
+            - field: auth
+              message: either token or appRole has to be defined`,
+			}),
+			Entry("invalid address and both auth methods", testCase{
+				configYAML: `
+            address: vault:8200
+            pki: pki
+            role: dataplane
+            auth:
+              token:
+                secret:
+                  inlineString: root-token
+              appRole:
+                roleId: kuma`,
+				expected: `
+            violations:
+            - field: address
+              message: has to be a valid http or https URL
+            - field: auth
+              message: only one of token or appRole can be defined`,
+			}),
+			Entry("incomplete AppRole", testCase{
+				configYAML: `
+            address: VAULT_ADDRESS
+            pki: pki
+            role: dataplane
+            auth:
+              appRole:
+                path: kuma-approle`,
+				expected: `
+            violations:
+            - field: auth.appRole.roleId
+              message: has to be defined
+            - field: auth.appRole.secretId
+              message: has to be defined`,
+			}),
+			Entry("invalid credentials", testCase{
+				configYAML: `
+            address: VAULT_ADDRESS
+            pki: pki
+            role: dataplane
+            auth:
+              appRole:
+                roleId: kuma
+                secretId:
+                  inlineString: wrong`,
+				expected: `
+            violations:
+            - field: ""
+              message: 'failed to retrieve CA certificates from Vault for Mesh "default" and backend "vault-1": could not log in with AppRole: Vault responded with status code 400: invalid role or secret ID'`,
+			}),
+		)
+
+		It("should pass validation of valid config", func() {
+			// when
+			err := caManager.ValidateBackend(context.Background(), "default", backend(tokenConfig))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	It("should return the CA from Vault as the root cert", func() {
+		// when
+		certs, err := caManager.GetRootCert(context.Background(), "default", backend(tokenConfig))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(certs).To(HaveLen(1))
+		Expect(string(certs[0])).To(Equal(vaultServer.caPEM))
+	})
+
+	It("should generate dataplane certs signed by Vault", func() {
+		// given
+		tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+			"kuma.io/service": {"backend"},
+		})
+
+		// when
+		pair, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(tokenConfig), tags)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		_, err = tls.X509KeyPair(pair.CertPEM, pair.KeyPEM)
+		Expect(err).ToNot(HaveOccurred())
+
+		block, rest := pem.Decode(pair.CertPEM)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.URIs).To(HaveLen(2))
+		Expect(cert.URIs[0].String()).To(Equal("spiffe://default/backend"))
+		Expect(cert.URIs[1].String()).To(Equal("kuma://kuma.io/service/backend"))
+		Expect(cert.NotAfter).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+
+		// and the chain of the CA is attached
+		Expect(strings.TrimSpace(string(rest))).To(Equal(strings.TrimSpace(vaultServer.caPEM)))
+		pool := x509.NewCertPool()
+		pool.AddCert(vaultServer.caCert)
+		_, err = cert.Verify(x509.VerifyOptions{Roots: pool, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reuse, renew and recreate the AppRole token", func() {
+		// given
+		tags := mesh_proto.MultiValueTagSetFrom(map[string][]string{
+			"kuma.io/service": {"backend"},
+		})
+		generate := func() {
+			_, err := caManager.GenerateDataplaneCert(context.Background(), "default", backend(appRoleConfig), tags)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// when
+		generate()
+		generate()
+
+		// then
+		Expect(vaultServer.logins).To(Equal(1))
+		Expect(vaultServer.renewals).To(Equal(0))
+
+		// when after the half of the lease
+		now = now.Add(40 * time.Second)
+		generate()
+
+		// then
+		Expect(vaultServer.logins).To(Equal(1))
+		Expect(vaultServer.renewals).To(Equal(1))
+
+		// when after the expiration
+		now = now.Add(2 * time.Minute)
+		generate()
+
+		// then
+		Expect(vaultServer.logins).To(Equal(2))
+		Expect(vaultServer.renewals).To(Equal(1))
+	})
+
+	It("should return secrets used by the backend", func() {
+		// given
+		b := backend(`
+        address: VAULT_ADDRESS
+        pki: pki
+        role: dataplane
+        tls:
+          caCert:
+            secret: vault-ca
+        auth:
+          appRole:
+            roleId: kuma
+            secretId:
+              secret: vault-secret-id`)
+
+		// when
+		secrets, err := caManager.UsedSecrets("default", b)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(Equal([]string{"vault-ca", "vault-secret-id"}))
+	})
+})
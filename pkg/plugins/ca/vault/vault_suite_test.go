@@ -0,0 +1,11 @@
+package vault_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestCaVault(t *testing.T) {
+	test.RunSpecs(t, "CA Vault Suite")
+}
@@ -0,0 +1,67 @@
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	config "github.com/kumahq/kuma/pkg/config/plugins/resources/etcd"
+)
+
+func NewClient(cfg config.EtcdStoreConfig) (*clientv3.Client, error) {
+	clientCfg := clientv3.Config{
+		Endpoints:   cfg.Endpoints,
+		Username:    cfg.Username,
+		Password:    cfg.Password,
+		DialTimeout: cfg.DialTimeout,
+	}
+	if cfg.TLS.Enabled {
+		tlsConfig, err := tlsConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		clientCfg.TLS = tlsConfig
+	}
+	client, err := clientv3.New(clientCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create etcd client")
+	}
+
+	// check the connection, the client connects lazily
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.DialTimeout)
+	defer cancel()
+	if _, err := client.Get(ctx, cfg.Prefix, clientv3.WithCountOnly()); err != nil {
+		_ = client.Close()
+		return nil, errors.Wrap(err, "cannot connect to etcd")
+	}
+	return client, nil
+}
+
+func tlsConfig(cfg config.TLSEtcdStoreConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if cfg.CAPath != "" {
+		caBytes, err := os.ReadFile(cfg.CAPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read CA of etcd")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBytes) {
+			return nil, errors.New("could not add CA of etcd to the pool")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.CertPath != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertPath, cfg.KeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load client certificate of etcd")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
@@ -16,6 +16,7 @@ import (
 	config_model "github.com/kumahq/kuma/pkg/core/resources/apis/system"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
 	common_k8s "github.com/kumahq/kuma/pkg/plugins/common/k8s"
 )
 
@@ -191,3 +192,8 @@ func (m *KubernetesMetaAdapter) GetModificationTime() time.Time {
 func newInvalidTypeError() error {
 	return errors.New("resource has a wrong type")
 }
+
+// Watch is not supported, changes of the config are delivered by the informers of the Kubernetes client.
+func (s *KubernetesStore) Watch(context.Context, ...core_store.WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return nil, core_store.ErrorWatchNotSupported
+}
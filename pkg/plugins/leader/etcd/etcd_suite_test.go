@@ -0,0 +1,14 @@
+package etcd
+
+import (
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestEtcdLeader(t *testing.T) {
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+	test.RunSpecs(t, "Etcd Leader Suite")
+}
@@ -0,0 +1,129 @@
+package etcd
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	util_channels "github.com/kumahq/kuma/pkg/util/channels"
+)
+
+var log = core.Log.WithName("etcd-leader")
+
+const backoffTime = 5 * time.Second
+
+// etcdLeaderElector implements leader election using etcd.
+// The leader holds a key attached to the lease of its session. When the leader dies, the lease expires after TTL
+// and the key is removed, so the next candidate in the order of creation becomes the leader.
+type etcdLeaderElector struct {
+	leader     int32
+	client     *clientv3.Client
+	prefix     string
+	leaseTTL   time.Duration
+	instanceId string
+	callbacks  []component.LeaderCallbacks
+}
+
+var _ component.LeaderElector = &etcdLeaderElector{}
+
+func NewEtcdLeaderElector(client *clientv3.Client, prefix string, leaseTTL time.Duration, instanceId string) component.LeaderElector {
+	return &etcdLeaderElector{
+		client:     client,
+		prefix:     prefix + "/leader",
+		leaseTTL:   leaseTTL,
+		instanceId: instanceId,
+	}
+}
+
+func (e *etcdLeaderElector) Start(stop <-chan struct{}) {
+	log.Info("starting Leader Elector")
+	ctx, cancelFn := context.WithCancel(context.Background())
+	go func() {
+		<-stop
+		log.Info("stopping Leader Elector")
+		cancelFn()
+	}()
+
+	for {
+		// in case of error (ex. connection to etcd is dropped) we want to retry the election with some backoff
+		// returning error here would shut down the CP
+		if err := e.elect(ctx); err != nil {
+			log.Error(err, "error during leader election")
+		}
+
+		if util_channels.IsClosed(stop) {
+			break
+		}
+		time.Sleep(backoffTime)
+	}
+	if err := e.client.Close(); err != nil {
+		log.Error(err, "error closing etcd client")
+	}
+	log.Info("Leader Elector stopped")
+}
+
+func (e *etcdLeaderElector) elect(ctx context.Context) error {
+	session, err := concurrency.NewSession(e.client, concurrency.WithTTL(int(e.leaseTTL.Seconds())), concurrency.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// closing the session revokes the lease, so other instance can become the leader immediately
+		if err := session.Close(); err != nil && ctx.Err() == nil {
+			log.Error(err, "error closing etcd session")
+		}
+	}()
+
+	election := concurrency.NewElection(session, e.prefix)
+	log.Info("waiting for lock")
+	if err := election.Campaign(ctx, e.instanceId); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	e.leaderAcquired()
+	select {
+	case <-ctx.Done():
+	case <-session.Done():
+		log.Info("lease of the leader expired")
+	}
+	e.leaderLost()
+	return nil
+}
+
+func (e *etcdLeaderElector) leaderAcquired() {
+	e.setLeader(true)
+	for _, callback := range e.callbacks {
+		callback.OnStartedLeading()
+	}
+}
+
+func (e *etcdLeaderElector) leaderLost() {
+	e.setLeader(false)
+	for _, callback := range e.callbacks {
+		callback.OnStoppedLeading()
+	}
+}
+
+func (e *etcdLeaderElector) AddCallbacks(callbacks component.LeaderCallbacks) {
+	e.callbacks = append(e.callbacks, callbacks)
+}
+
+func (e *etcdLeaderElector) setLeader(leader bool) {
+	var value int32 = 0
+	if leader {
+		value = 1
+	}
+	atomic.StoreInt32(&e.leader, value)
+}
+
+func (e *etcdLeaderElector) IsLeader() bool {
+	return atomic.LoadInt32(&(e.leader)) == 1
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/kumahq/kuma/pkg/config/core/resources/store"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/core/runtime/component"
+	common_etcd "github.com/kumahq/kuma/pkg/plugins/common/etcd"
 	common_postgres "github.com/kumahq/kuma/pkg/plugins/common/postgres"
+	leader_etcd "github.com/kumahq/kuma/pkg/plugins/leader/etcd"
 	leader_memory "github.com/kumahq/kuma/pkg/plugins/leader/memory"
 	leader_postgres "github.com/kumahq/kuma/pkg/plugins/leader/postgres"
 )
@@ -32,6 +34,13 @@ func NewLeaderElector(b *core_runtime.Builder) (component.LeaderElector, error)
 		}
 		elector := leader_postgres.NewPostgresLeaderElector(client)
 		return elector, nil
+	case store.EtcdStore:
+		cfg := b.Config().Store.Etcd
+		client, err := common_etcd.NewClient(*cfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not connect to etcd")
+		}
+		return leader_etcd.NewEtcdLeaderElector(client, cfg.Prefix, cfg.LeaderElectionLeaseTTL, b.GetInstanceId()), nil
 	case store.MemoryStore:
 		return leader_memory.NewAlwaysLeaderElector(), nil
 	// In case of Kubernetes, Leader Elector is embedded in a Kubernetes ComponentManager
@@ -0,0 +1,34 @@
+package etcd
+
+import (
+	"errors"
+
+	"github.com/kumahq/kuma/pkg/config/plugins/resources/etcd"
+	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
+	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
+)
+
+var _ core_plugins.ResourceStorePlugin = &plugin{}
+
+type plugin struct{}
+
+func init() {
+	core_plugins.Register(core_plugins.Etcd, &plugin{})
+}
+
+func (p *plugin) NewResourceStore(pc core_plugins.PluginContext, config core_plugins.PluginConfig) (core_store.ResourceStore, error) {
+	cfg, ok := config.(*etcd.EtcdStoreConfig)
+	if !ok {
+		return nil, errors.New("invalid type of the config. Passed config should be a EtcdStoreConfig")
+	}
+	return NewStore(*cfg)
+}
+
+func (p *plugin) Migrate(pc core_plugins.PluginContext, config core_plugins.PluginConfig) (core_plugins.DbVersion, error) {
+	return 0, errors.New("migrations are not supported for etcd resource store")
+}
+
+func (p *plugin) EventListener(pc core_plugins.PluginContext, out events.Emitter) error {
+	return pc.ComponentManager().Add(core_store.NewWatchEmitter(pc.ResourceStore(), out))
+}
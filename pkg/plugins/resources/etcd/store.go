@@ -0,0 +1,379 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	config "github.com/kumahq/kuma/pkg/config/plugins/resources/etcd"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	common_etcd "github.com/kumahq/kuma/pkg/plugins/common/etcd"
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// Resources are stored under keys "<prefix>/resources/<type>/<mesh>/<name>".
+// For every owned resource there is a key "<prefix>/owners/<owner type>/<owner mesh>/<owner name>/<type>/<mesh>/<name>",
+// so the children can be found and deleted with the owner.
+// Version of the resource is the revision of etcd in which the resource was modified.
+type etcdResourceStore struct {
+	client   *clientv3.Client
+	prefix   string
+	pageSize int64
+}
+
+type etcdRecord struct {
+	Spec             json.RawMessage `json:"spec"`
+	CreationTime     time.Time       `json:"creationTime"`
+	ModificationTime time.Time       `json:"modificationTime"`
+	Owner            *etcdOwner      `json:"owner,omitempty"`
+}
+
+type etcdOwner struct {
+	Type string `json:"type"`
+	Mesh string `json:"mesh"`
+	Name string `json:"name"`
+}
+
+var _ store.ResourceStore = &etcdResourceStore{}
+
+func NewStore(cfg config.EtcdStoreConfig) (store.ResourceStore, error) {
+	client, err := common_etcd.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newStore(client, cfg), nil
+}
+
+func newStore(client *clientv3.Client, cfg config.EtcdStoreConfig) *etcdResourceStore {
+	return &etcdResourceStore{
+		client:   client,
+		prefix:   cfg.Prefix,
+		pageSize: cfg.ListPageSize,
+	}
+}
+
+func (e *etcdResourceStore) Create(ctx context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+	opts := store.NewCreateOptions(fs...)
+
+	spec, err := proto.ToJSON(resource.GetSpec())
+	if err != nil {
+		return errors.Wrap(err, "failed to convert spec to json")
+	}
+	record := etcdRecord{
+		Spec:             spec,
+		CreationTime:     opts.CreationTime.UTC(),
+		ModificationTime: opts.CreationTime.UTC(),
+	}
+	key := e.resourceKey(resource.Descriptor().Name, opts.Mesh, opts.Name)
+	conditions := []clientv3.Cmp{clientv3.Compare(clientv3.CreateRevision(key), "=", 0)}
+	var ops []clientv3.Op
+
+	if opts.Owner != nil {
+		owner := etcdOwner{
+			Type: string(opts.Owner.Descriptor().Name),
+			Mesh: opts.Owner.GetMeta().GetMesh(),
+			Name: opts.Owner.GetMeta().GetName(),
+		}
+		ownerKey := e.resourceKey(model.ResourceType(owner.Type), owner.Mesh, owner.Name)
+		// the owner has to exist, otherwise the resource would never be deleted
+		conditions = append(conditions, clientv3.Compare(clientv3.CreateRevision(ownerKey), ">", 0))
+		ops = append(ops, clientv3.OpPut(e.childKey(owner, resource.Descriptor().Name, opts.Mesh, opts.Name), ""))
+		record.Owner = &owner
+	}
+
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	ops = append(ops, clientv3.OpPut(key, string(value)))
+
+	resp, err := e.client.Txn(ctx).If(conditions...).Then(ops...).Else(clientv3.OpGet(key, clientv3.WithCountOnly())).Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed to create the resource in etcd")
+	}
+	if !resp.Succeeded {
+		if resp.Responses[0].GetResponseRange().GetCount() > 0 {
+			return store.ErrorResourceAlreadyExists(resource.Descriptor().Name, opts.Name, opts.Mesh)
+		}
+		return store.ErrorResourceNotFound(opts.Owner.Descriptor().Name, opts.Owner.GetMeta().GetName(), opts.Owner.GetMeta().GetMesh())
+	}
+
+	resource.SetMeta(&resourceMetaObject{
+		Name:             opts.Name,
+		Mesh:             opts.Mesh,
+		Version:          strconv.FormatInt(resp.Header.Revision, 10),
+		CreationTime:     opts.CreationTime,
+		ModificationTime: opts.CreationTime,
+	})
+	return nil
+}
+
+func (e *etcdResourceStore) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+	opts := store.NewUpdateOptions(fs...)
+	name := resource.GetMeta().GetName()
+	mesh := resource.GetMeta().GetMesh()
+	conflict := store.ErrorResourceConflict(resource.Descriptor().Name, name, mesh)
+
+	version, err := strconv.ParseInt(resource.GetMeta().GetVersion(), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert meta version to int")
+	}
+
+	key := e.resourceKey(resource.Descriptor().Name, mesh, name)
+	getResp, err := e.client.Get(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the resource from etcd")
+	}
+	if len(getResp.Kvs) == 0 || getResp.Kvs[0].ModRevision != version {
+		return conflict
+	}
+	record := etcdRecord{}
+	if err := json.Unmarshal(getResp.Kvs[0].Value, &record); err != nil {
+		return errors.Wrap(err, "failed to unmarshal the resource")
+	}
+
+	spec, err := proto.ToJSON(resource.GetSpec())
+	if err != nil {
+		return err
+	}
+	record.Spec = spec
+	record.ModificationTime = opts.ModificationTime.UTC()
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	resp, err := e.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", version)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed to update the resource in etcd")
+	}
+	if !resp.Succeeded {
+		return conflict
+	}
+
+	resource.SetMeta(&resourceMetaObject{
+		Name:             name,
+		Mesh:             mesh,
+		Version:          strconv.FormatInt(resp.Header.Revision, 10),
+		CreationTime:     record.CreationTime.Local(),
+		ModificationTime: opts.ModificationTime,
+	})
+	return nil
+}
+
+func (e *etcdResourceStore) Delete(ctx context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+	opts := store.NewDeleteOptions(fs...)
+	return e.delete(ctx, resource.Descriptor().Name, opts.Mesh, opts.Name)
+}
+
+func (e *etcdResourceStore) delete(ctx context.Context, resourceType model.ResourceType, mesh string, name string) error {
+	key := e.resourceKey(resourceType, mesh, name)
+	self := etcdOwner{Type: string(resourceType), Mesh: mesh, Name: name}
+	childrenPrefix := e.childrenPrefix(self)
+
+	resp, err := e.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), ">", 0)).
+		Then(
+			clientv3.OpDelete(key, clientv3.WithPrevKV()),
+			clientv3.OpDelete(childrenPrefix, clientv3.WithPrefix(), clientv3.WithPrevKV()),
+		).
+		Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed to delete the resource from etcd")
+	}
+	if !resp.Succeeded {
+		return store.ErrorResourceNotFound(resourceType, name, mesh)
+	}
+
+	deleted := resp.Responses[0].GetResponseDeleteRange().GetPrevKvs()
+	if len(deleted) == 1 {
+		record := etcdRecord{}
+		if err := json.Unmarshal(deleted[0].Value, &record); err == nil && record.Owner != nil {
+			if _, err := e.client.Delete(ctx, e.childKey(*record.Owner, resourceType, mesh, name)); err != nil {
+				return errors.Wrap(err, "failed to delete the reference of the owner")
+			}
+		}
+	}
+
+	for _, child := range resp.Responses[1].GetResponseDeleteRange().GetPrevKvs() {
+		childType, childMesh, childName, ok := splitKey(strings.TrimPrefix(string(child.Key), childrenPrefix))
+		if !ok {
+			continue
+		}
+		if err := e.delete(ctx, childType, childMesh, childName); err != nil && !store.IsResourceNotFound(err) {
+			return errors.Wrapf(err, "failed to delete the child resource %s %s/%s", childType, childMesh, childName)
+		}
+	}
+	return nil
+}
+
+func (e *etcdResourceStore) Get(ctx context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
+	opts := store.NewGetOptions(fs...)
+
+	resp, err := e.client.Get(ctx, e.resourceKey(resource.Descriptor().Name, opts.Mesh, opts.Name))
+	if err != nil {
+		return errors.Wrap(err, "failed to get the resource from etcd")
+	}
+	if len(resp.Kvs) == 0 {
+		return store.ErrorResourceNotFound(resource.Descriptor().Name, opts.Name, opts.Mesh)
+	}
+	if err := unmarshalResource(resp.Kvs[0].Value, resp.Kvs[0].ModRevision, opts.Mesh, opts.Name, resource); err != nil {
+		return err
+	}
+	if opts.Version != "" && resource.GetMeta().GetVersion() != opts.Version {
+		return store.ErrorResourcePreconditionFailed(resource.Descriptor().Name, opts.Name, opts.Mesh)
+	}
+	return nil
+}
+
+// List reads resources in pages of the configured size, so a single response of etcd does not exceed its limits.
+// All pages are read from the revision of the first page, so the list is consistent.
+func (e *etcdResourceStore) List(ctx context.Context, resources model.ResourceList, fs ...store.ListOptionsFunc) error {
+	opts := store.NewListOptions(fs...)
+
+	prefix := e.typePrefix(resources.GetItemType())
+	if opts.Mesh != "" {
+		prefix += opts.Mesh + "/"
+	}
+	rangeEnd := clientv3.GetPrefixRangeEnd(prefix)
+
+	from := prefix
+	var revision int64
+	total := 0
+	for {
+		options := []clientv3.OpOption{
+			clientv3.WithRange(rangeEnd),
+			clientv3.WithLimit(e.pageSize),
+			clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
+		}
+		if revision != 0 {
+			options = append(options, clientv3.WithRev(revision))
+		}
+		resp, err := e.client.Get(ctx, from, options...)
+		if err != nil {
+			return errors.Wrap(err, "failed to list resources from etcd")
+		}
+		revision = resp.Header.Revision
+
+		for _, kv := range resp.Kvs {
+			_, mesh, name, ok := splitKey(strings.TrimPrefix(string(kv.Key), e.resourcesPrefix()))
+			if !ok {
+				continue
+			}
+			item := resources.NewItem()
+			if err := unmarshalResource(kv.Value, kv.ModRevision, mesh, name, item); err != nil {
+				return err
+			}
+			if err := resources.AddItem(item); err != nil {
+				return err
+			}
+			total++
+		}
+
+		if !resp.More || len(resp.Kvs) == 0 {
+			break
+		}
+		from = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+
+	resources.GetPagination().SetTotal(uint32(total))
+	return nil
+}
+
+func (e *etcdResourceStore) Close() error {
+	return e.client.Close()
+}
+
+func unmarshalResource(value []byte, revision int64, mesh string, name string, resource model.Resource) error {
+	record := etcdRecord{}
+	if err := json.Unmarshal(value, &record); err != nil {
+		return errors.Wrap(err, "failed to unmarshal the resource")
+	}
+	if err := proto.FromJSON(record.Spec, resource.GetSpec()); err != nil {
+		return errors.Wrap(err, "failed to convert json to spec")
+	}
+	resource.SetMeta(&resourceMetaObject{
+		Name:             name,
+		Mesh:             mesh,
+		Version:          strconv.FormatInt(revision, 10),
+		CreationTime:     record.CreationTime.Local(),
+		ModificationTime: record.ModificationTime.Local(),
+	})
+	return nil
+}
+
+func (e *etcdResourceStore) resourcesPrefix() string {
+	return resourcesPrefix(e.prefix)
+}
+
+func (e *etcdResourceStore) typePrefix(resourceType model.ResourceType) string {
+	return e.resourcesPrefix() + string(resourceType) + "/"
+}
+
+func (e *etcdResourceStore) resourceKey(resourceType model.ResourceType, mesh string, name string) string {
+	return e.typePrefix(resourceType) + mesh + "/" + name
+}
+
+func (e *etcdResourceStore) childrenPrefix(owner etcdOwner) string {
+	return e.prefix + "/owners/" + owner.Type + "/" + owner.Mesh + "/" + owner.Name + "/"
+}
+
+func (e *etcdResourceStore) childKey(owner etcdOwner, resourceType model.ResourceType, mesh string, name string) string {
+	return e.childrenPrefix(owner) + string(resourceType) + "/" + mesh + "/" + name
+}
+
+func resourcesPrefix(prefix string) string {
+	return prefix + "/resources/"
+}
+
+// splitKey splits "<type>/<mesh>/<name>" part of the key.
+func splitKey(key string) (model.ResourceType, string, string, bool) {
+	parts := strings.SplitN(key, "/", 3)
+	if len(parts) != 3 {
+		return "", "", "", false
+	}
+	return model.ResourceType(parts[0]), parts[1], parts[2], true
+}
+
+type resourceMetaObject struct {
+	Name             string
+	Version          string
+	Mesh             string
+	CreationTime     time.Time
+	ModificationTime time.Time
+}
+
+var _ model.ResourceMeta = &resourceMetaObject{}
+
+func (r *resourceMetaObject) GetName() string {
+	return r.Name
+}
+
+func (r *resourceMetaObject) GetNameExtensions() model.ResourceNameExtensions {
+	return model.ResourceNameExtensionsUnsupported
+}
+
+func (r *resourceMetaObject) GetVersion() string {
+	return r.Version
+}
+
+func (r *resourceMetaObject) GetMesh() string {
+	return r.Mesh
+}
+
+func (r *resourceMetaObject) GetCreationTime() time.Time {
+	return r.CreationTime
+}
+
+func (r *resourceMetaObject) GetModificationTime() time.Time {
+	return r.ModificationTime
+}
@@ -0,0 +1,25 @@
+package etcd
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/testcontainers/testcontainers-go"
+
+	"github.com/kumahq/kuma/pkg/test"
+	test_etcd "github.com/kumahq/kuma/pkg/test/store/etcd"
+)
+
+var c test_etcd.EtcdContainer
+
+func TestEtcdStore(t *testing.T) {
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+	BeforeSuite(func() {
+		Expect(c.Start()).To(Succeed())
+	})
+	AfterSuite(func() {
+		Expect(c.Stop()).To(Succeed())
+	})
+	test.RunSpecs(t, "Etcd Resource Store Suite")
+}
@@ -0,0 +1,26 @@
+package etcd
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	test_store "github.com/kumahq/kuma/pkg/test/store"
+)
+
+var _ = Describe("EtcdStore template", func() {
+	createStore := func() store.ResourceStore {
+		cfg, err := c.Config()
+		Expect(err).ToNot(HaveOccurred())
+		// small pages, so listing is done in several requests
+		cfg.ListPageSize = 2
+
+		eStore, err := NewStore(*cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		return eStore
+	}
+
+	test_store.ExecuteStoreTests(createStore)
+	test_store.ExecuteOwnerTests(createStore)
+})
@@ -0,0 +1,72 @@
+package etcd
+
+import (
+	"context"
+	"strings"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
+)
+
+var log = core.Log.WithName("etcd-event-listener")
+
+// Watch streams the changes from the native watch of etcd.
+// The channel is closed when the watch fails, e.g. when the revision of the watch was compacted.
+func (e *etcdResourceStore) Watch(ctx context.Context, fs ...store.WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	opts := store.NewWatchOptions(fs...)
+	prefix := e.resourcesPrefix()
+	key := prefix
+	if opts.Type != "" {
+		key = e.typePrefix(opts.Type)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	watch := e.client.Watch(clientv3.WithRequireLeader(ctx), key, clientv3.WithPrefix())
+	out := make(chan events.ResourceChangedEvent)
+	go func() {
+		defer close(out)
+		defer cancel()
+		for resp := range watch {
+			if err := resp.Err(); err != nil {
+				log.Error(err, "error watching etcd")
+				return
+			}
+			for _, event := range resp.Events {
+				change, ok := toChangedEvent(prefix, event)
+				if !ok || !opts.Matches(change) {
+					continue
+				}
+				select {
+				case out <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func toChangedEvent(prefix string, event *clientv3.Event) (events.ResourceChangedEvent, bool) {
+	resourceType, mesh, name, ok := splitKey(strings.TrimPrefix(string(event.Kv.Key), prefix))
+	if !ok {
+		return events.ResourceChangedEvent{}, false
+	}
+	var op events.Op
+	switch {
+	case event.Type == clientv3.EventTypeDelete:
+		op = events.Delete
+	case event.IsCreate():
+		op = events.Create
+	default:
+		op = events.Update
+	}
+	return events.ResourceChangedEvent{
+		Operation: op,
+		Type:      resourceType,
+		Key:       model.ResourceKey{Mesh: mesh, Name: name},
+	}, true
+}
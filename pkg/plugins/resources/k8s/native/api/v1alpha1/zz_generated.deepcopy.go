@@ -28,6 +28,130 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRole) DeepCopyInto(out *AccessRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRole.
+func (in *AccessRole) DeepCopy() *AccessRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRoleBinding) DeepCopyInto(out *AccessRoleBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRoleBinding.
+func (in *AccessRoleBinding) DeepCopy() *AccessRoleBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRoleBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessRoleBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRoleBindingList) DeepCopyInto(out *AccessRoleBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessRoleBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRoleBindingList.
+func (in *AccessRoleBindingList) DeepCopy() *AccessRoleBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRoleBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessRoleBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRoleList) DeepCopyInto(out *AccessRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRoleList.
+func (in *AccessRoleList) DeepCopy() *AccessRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
 	*out = *in
@@ -484,7 +608,7 @@ func (in *HealthCheckList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Mesh) DeepCopyInto(out *Mesh) {
+func (in *LocalReply) DeepCopyInto(out *LocalReply) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -495,18 +619,18 @@ func (in *Mesh) DeepCopyInto(out *Mesh) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mesh.
-func (in *Mesh) DeepCopy() *Mesh {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReply.
+func (in *LocalReply) DeepCopy() *LocalReply {
 	if in == nil {
 		return nil
 	}
-	out := new(Mesh)
+	out := new(LocalReply)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Mesh) DeepCopyObject() runtime.Object {
+func (in *LocalReply) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -514,29 +638,31 @@ func (in *Mesh) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGateway) DeepCopyInto(out *MeshGateway) {
+func (in *LocalReplyList) DeepCopyInto(out *LocalReplyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(apiextensionsv1.JSON)
-		(*in).DeepCopyInto(*out)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LocalReply, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGateway.
-func (in *MeshGateway) DeepCopy() *MeshGateway {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyList.
+func (in *LocalReplyList) DeepCopy() *LocalReplyList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGateway)
+	out := new(LocalReplyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshGateway) DeepCopyObject() runtime.Object {
+func (in *LocalReplyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -544,47 +670,59 @@ func (in *MeshGateway) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayCommonConfig) DeepCopyInto(out *MeshGatewayCommonConfig) {
+func (in *Mesh) DeepCopyInto(out *Mesh) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
-	in.ServiceTemplate.DeepCopyInto(&out.ServiceTemplate)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayCommonConfig.
-func (in *MeshGatewayCommonConfig) DeepCopy() *MeshGatewayCommonConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mesh.
+func (in *Mesh) DeepCopy() *Mesh {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayCommonConfig)
+	out := new(Mesh)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Mesh) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayConfig) DeepCopyInto(out *MeshGatewayConfig) {
+func (in *MeshBandwidthLimit) DeepCopyInto(out *MeshBandwidthLimit) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayConfig.
-func (in *MeshGatewayConfig) DeepCopy() *MeshGatewayConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshBandwidthLimit.
+func (in *MeshBandwidthLimit) DeepCopy() *MeshBandwidthLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayConfig)
+	out := new(MeshBandwidthLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshGatewayConfig) DeepCopyObject() runtime.Object {
+func (in *MeshBandwidthLimit) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -592,31 +730,31 @@ func (in *MeshGatewayConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayConfigList) DeepCopyInto(out *MeshGatewayConfigList) {
+func (in *MeshBandwidthLimitList) DeepCopyInto(out *MeshBandwidthLimitList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MeshGatewayConfig, len(*in))
+		*out = make([]MeshBandwidthLimit, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayConfigList.
-func (in *MeshGatewayConfigList) DeepCopy() *MeshGatewayConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshBandwidthLimitList.
+func (in *MeshBandwidthLimitList) DeepCopy() *MeshBandwidthLimitList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayConfigList)
+	out := new(MeshBandwidthLimitList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshGatewayConfigList) DeepCopyObject() runtime.Object {
+func (in *MeshBandwidthLimitList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -624,64 +762,91 @@ func (in *MeshGatewayConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayConfigSpec) DeepCopyInto(out *MeshGatewayConfigSpec) {
+func (in *MeshCORS) DeepCopyInto(out *MeshCORS) {
 	*out = *in
-	in.MeshGatewayCommonConfig.DeepCopyInto(&out.MeshGatewayCommonConfig)
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayConfigSpec.
-func (in *MeshGatewayConfigSpec) DeepCopy() *MeshGatewayConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshCORS.
+func (in *MeshCORS) DeepCopy() *MeshCORS {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayConfigSpec)
+	out := new(MeshCORS)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshCORS) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayConfigStatus) DeepCopyInto(out *MeshGatewayConfigStatus) {
+func (in *MeshCORSList) DeepCopyInto(out *MeshCORSList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshCORS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayConfigStatus.
-func (in *MeshGatewayConfigStatus) DeepCopy() *MeshGatewayConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshCORSList.
+func (in *MeshCORSList) DeepCopy() *MeshCORSList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayConfigStatus)
+	out := new(MeshCORSList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshCORSList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayInstance) DeepCopyInto(out *MeshGatewayInstance) {
+func (in *MeshCompression) DeepCopyInto(out *MeshCompression) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayInstance.
-func (in *MeshGatewayInstance) DeepCopy() *MeshGatewayInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshCompression.
+func (in *MeshCompression) DeepCopy() *MeshCompression {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayInstance)
+	out := new(MeshCompression)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshGatewayInstance) DeepCopyObject() runtime.Object {
+func (in *MeshCompression) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -689,31 +854,31 @@ func (in *MeshGatewayInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayInstanceList) DeepCopyInto(out *MeshGatewayInstanceList) {
+func (in *MeshCompressionList) DeepCopyInto(out *MeshCompressionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MeshGatewayInstance, len(*in))
+		*out = make([]MeshCompression, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayInstanceList.
-func (in *MeshGatewayInstanceList) DeepCopy() *MeshGatewayInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshCompressionList.
+func (in *MeshCompressionList) DeepCopy() *MeshCompressionList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayInstanceList)
+	out := new(MeshCompressionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshGatewayInstanceList) DeepCopyObject() runtime.Object {
+func (in *MeshCompressionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -721,81 +886,590 @@ func (in *MeshGatewayInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayInstanceSpec) DeepCopyInto(out *MeshGatewayInstanceSpec) {
+func (in *MeshExternalAuthz) DeepCopyInto(out *MeshExternalAuthz) {
 	*out = *in
-	in.MeshGatewayCommonConfig.DeepCopyInto(&out.MeshGatewayCommonConfig)
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayInstanceSpec.
-func (in *MeshGatewayInstanceSpec) DeepCopy() *MeshGatewayInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshExternalAuthz.
+func (in *MeshExternalAuthz) DeepCopy() *MeshExternalAuthz {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayInstanceSpec)
+	out := new(MeshExternalAuthz)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshExternalAuthz) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayInstanceStatus) DeepCopyInto(out *MeshGatewayInstanceStatus) {
+func (in *MeshExternalAuthzList) DeepCopyInto(out *MeshExternalAuthzList) {
 	*out = *in
-	if in.LoadBalancer != nil {
-		in, out := &in.LoadBalancer, &out.LoadBalancer
-		*out = new(v1.LoadBalancerStatus)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshExternalAuthz, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshExternalAuthzList.
+func (in *MeshExternalAuthzList) DeepCopy() *MeshExternalAuthzList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshExternalAuthzList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshExternalAuthzList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGateway) DeepCopyInto(out *MeshGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGateway.
+func (in *MeshGateway) DeepCopy() *MeshGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayCommonConfig) DeepCopyInto(out *MeshGatewayCommonConfig) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ServiceTemplate.DeepCopyInto(&out.ServiceTemplate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayCommonConfig.
+func (in *MeshGatewayCommonConfig) DeepCopy() *MeshGatewayCommonConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayCommonConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayConfig) DeepCopyInto(out *MeshGatewayConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayConfig.
+func (in *MeshGatewayConfig) DeepCopy() *MeshGatewayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshGatewayConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayConfigList) DeepCopyInto(out *MeshGatewayConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshGatewayConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayConfigList.
+func (in *MeshGatewayConfigList) DeepCopy() *MeshGatewayConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshGatewayConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayConfigSpec) DeepCopyInto(out *MeshGatewayConfigSpec) {
+	*out = *in
+	in.MeshGatewayCommonConfig.DeepCopyInto(&out.MeshGatewayCommonConfig)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayConfigSpec.
+func (in *MeshGatewayConfigSpec) DeepCopy() *MeshGatewayConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayConfigStatus) DeepCopyInto(out *MeshGatewayConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayConfigStatus.
+func (in *MeshGatewayConfigStatus) DeepCopy() *MeshGatewayConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayInstance) DeepCopyInto(out *MeshGatewayInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayInstance.
+func (in *MeshGatewayInstance) DeepCopy() *MeshGatewayInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshGatewayInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayInstanceList) DeepCopyInto(out *MeshGatewayInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshGatewayInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayInstanceList.
+func (in *MeshGatewayInstanceList) DeepCopy() *MeshGatewayInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshGatewayInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayInstanceSpec) DeepCopyInto(out *MeshGatewayInstanceSpec) {
+	*out = *in
+	in.MeshGatewayCommonConfig.DeepCopyInto(&out.MeshGatewayCommonConfig)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayInstanceSpec.
+func (in *MeshGatewayInstanceSpec) DeepCopy() *MeshGatewayInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayInstanceStatus) DeepCopyInto(out *MeshGatewayInstanceStatus) {
+	*out = *in
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(v1.LoadBalancerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayInstanceStatus.
+func (in *MeshGatewayInstanceStatus) DeepCopy() *MeshGatewayInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayList) DeepCopyInto(out *MeshGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayList.
+func (in *MeshGatewayList) DeepCopy() *MeshGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayRoute) DeepCopyInto(out *MeshGatewayRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayRoute.
+func (in *MeshGatewayRoute) DeepCopy() *MeshGatewayRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshGatewayRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayRouteList) DeepCopyInto(out *MeshGatewayRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshGatewayRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayRouteList.
+func (in *MeshGatewayRouteList) DeepCopy() *MeshGatewayRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshGatewayRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayServiceMetadata) DeepCopyInto(out *MeshGatewayServiceMetadata) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayServiceMetadata.
+func (in *MeshGatewayServiceMetadata) DeepCopy() *MeshGatewayServiceMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayServiceMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayServiceSpec) DeepCopyInto(out *MeshGatewayServiceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayServiceSpec.
+func (in *MeshGatewayServiceSpec) DeepCopy() *MeshGatewayServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshGatewayServiceTemplate) DeepCopyInto(out *MeshGatewayServiceTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayServiceTemplate.
+func (in *MeshGatewayServiceTemplate) DeepCopy() *MeshGatewayServiceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshGatewayServiceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshHeaderModifier) DeepCopyInto(out *MeshHeaderModifier) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshHeaderModifier.
+func (in *MeshHeaderModifier) DeepCopy() *MeshHeaderModifier {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshHeaderModifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshHeaderModifier) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshHeaderModifierList) DeepCopyInto(out *MeshHeaderModifierList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshHeaderModifier, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayInstanceStatus.
-func (in *MeshGatewayInstanceStatus) DeepCopy() *MeshGatewayInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshHeaderModifierList.
+func (in *MeshHeaderModifierList) DeepCopy() *MeshHeaderModifierList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayInstanceStatus)
+	out := new(MeshHeaderModifierList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshHeaderModifierList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayList) DeepCopyInto(out *MeshGatewayList) {
+func (in *MeshInsight) DeepCopyInto(out *MeshInsight) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshInsight.
+func (in *MeshInsight) DeepCopy() *MeshInsight {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshInsight)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshInsight) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshInsightList) DeepCopyInto(out *MeshInsightList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MeshGateway, len(*in))
+		*out = make([]MeshInsight, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayList.
-func (in *MeshGatewayList) DeepCopy() *MeshGatewayList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshInsightList.
+func (in *MeshInsightList) DeepCopy() *MeshInsightList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayList)
+	out := new(MeshInsightList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshGatewayList) DeepCopyObject() runtime.Object {
+func (in *MeshInsightList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -803,7 +1477,7 @@ func (in *MeshGatewayList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayRoute) DeepCopyInto(out *MeshGatewayRoute) {
+func (in *MeshJWT) DeepCopyInto(out *MeshJWT) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -814,18 +1488,18 @@ func (in *MeshGatewayRoute) DeepCopyInto(out *MeshGatewayRoute) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayRoute.
-func (in *MeshGatewayRoute) DeepCopy() *MeshGatewayRoute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshJWT.
+func (in *MeshJWT) DeepCopy() *MeshJWT {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayRoute)
+	out := new(MeshJWT)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshGatewayRoute) DeepCopyObject() runtime.Object {
+func (in *MeshJWT) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -833,31 +1507,31 @@ func (in *MeshGatewayRoute) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayRouteList) DeepCopyInto(out *MeshGatewayRouteList) {
+func (in *MeshJWTList) DeepCopyInto(out *MeshJWTList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MeshGatewayRoute, len(*in))
+		*out = make([]MeshJWT, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayRouteList.
-func (in *MeshGatewayRouteList) DeepCopy() *MeshGatewayRouteList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshJWTList.
+func (in *MeshJWTList) DeepCopy() *MeshJWTList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayRouteList)
+	out := new(MeshJWTList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshGatewayRouteList) DeepCopyObject() runtime.Object {
+func (in *MeshJWTList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -865,61 +1539,163 @@ func (in *MeshGatewayRouteList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayServiceMetadata) DeepCopyInto(out *MeshGatewayServiceMetadata) {
+func (in *MeshList) DeepCopyInto(out *MeshList) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Mesh, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayServiceMetadata.
-func (in *MeshGatewayServiceMetadata) DeepCopy() *MeshGatewayServiceMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshList.
+func (in *MeshList) DeepCopy() *MeshList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayServiceMetadata)
+	out := new(MeshList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayServiceSpec) DeepCopyInto(out *MeshGatewayServiceSpec) {
+func (in *MeshProtocolOptions) DeepCopyInto(out *MeshProtocolOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshProtocolOptions.
+func (in *MeshProtocolOptions) DeepCopy() *MeshProtocolOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshProtocolOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshProtocolOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshProtocolOptionsList) DeepCopyInto(out *MeshProtocolOptionsList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshProtocolOptions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayServiceSpec.
-func (in *MeshGatewayServiceSpec) DeepCopy() *MeshGatewayServiceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshProtocolOptionsList.
+func (in *MeshProtocolOptionsList) DeepCopy() *MeshProtocolOptionsList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayServiceSpec)
+	out := new(MeshProtocolOptionsList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshProtocolOptionsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshGatewayServiceTemplate) DeepCopyInto(out *MeshGatewayServiceTemplate) {
+func (in *MeshRequestProtection) DeepCopyInto(out *MeshRequestProtection) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	out.Spec = in.Spec
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayServiceTemplate.
-func (in *MeshGatewayServiceTemplate) DeepCopy() *MeshGatewayServiceTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshRequestProtection.
+func (in *MeshRequestProtection) DeepCopy() *MeshRequestProtection {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshGatewayServiceTemplate)
+	out := new(MeshRequestProtection)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshRequestProtection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshInsight) DeepCopyInto(out *MeshInsight) {
+func (in *MeshRequestProtectionList) DeepCopyInto(out *MeshRequestProtectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshRequestProtection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshRequestProtectionList.
+func (in *MeshRequestProtectionList) DeepCopy() *MeshRequestProtectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshRequestProtectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshRequestProtectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshWasmPlugin) DeepCopyInto(out *MeshWasmPlugin) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -930,18 +1706,18 @@ func (in *MeshInsight) DeepCopyInto(out *MeshInsight) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshInsight.
-func (in *MeshInsight) DeepCopy() *MeshInsight {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshWasmPlugin.
+func (in *MeshWasmPlugin) DeepCopy() *MeshWasmPlugin {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshInsight)
+	out := new(MeshWasmPlugin)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshInsight) DeepCopyObject() runtime.Object {
+func (in *MeshWasmPlugin) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -949,31 +1725,31 @@ func (in *MeshInsight) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshInsightList) DeepCopyInto(out *MeshInsightList) {
+func (in *MeshWasmPluginList) DeepCopyInto(out *MeshWasmPluginList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MeshInsight, len(*in))
+		*out = make([]MeshWasmPlugin, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshInsightList.
-func (in *MeshInsightList) DeepCopy() *MeshInsightList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshWasmPluginList.
+func (in *MeshWasmPluginList) DeepCopy() *MeshWasmPluginList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshInsightList)
+	out := new(MeshWasmPluginList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshInsightList) DeepCopyObject() runtime.Object {
+func (in *MeshWasmPluginList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -981,31 +1757,61 @@ func (in *MeshInsightList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MeshList) DeepCopyInto(out *MeshList) {
+func (in *MeshZoneTrafficRoute) DeepCopyInto(out *MeshZoneTrafficRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshZoneTrafficRoute.
+func (in *MeshZoneTrafficRoute) DeepCopy() *MeshZoneTrafficRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshZoneTrafficRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshZoneTrafficRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshZoneTrafficRouteList) DeepCopyInto(out *MeshZoneTrafficRouteList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Mesh, len(*in))
+		*out = make([]MeshZoneTrafficRoute, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshList.
-func (in *MeshList) DeepCopy() *MeshList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshZoneTrafficRouteList.
+func (in *MeshZoneTrafficRouteList) DeepCopy() *MeshZoneTrafficRouteList {
 	if in == nil {
 		return nil
 	}
-	out := new(MeshList)
+	out := new(MeshZoneTrafficRouteList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MeshList) DeepCopyObject() runtime.Object {
+func (in *MeshZoneTrafficRouteList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -595,6 +595,102 @@ func init() {
 	})
 }
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type LocalReply struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma LocalReply resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type LocalReplyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LocalReply `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LocalReply{}, &LocalReplyList{})
+}
+
+func (cb *LocalReply) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *LocalReply) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *LocalReply) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *LocalReply) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *LocalReply) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.LocalReply{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *LocalReply) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.LocalReply); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *LocalReply) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *LocalReplyList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.LocalReply{}, &LocalReply{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "LocalReply",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.LocalReply{}, &LocalReplyList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "LocalReplyList",
+		},
+	})
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:categories=kuma,scope=Cluster
 type Mesh struct {
@@ -693,7 +789,7 @@ func init() {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:categories=kuma,scope=Cluster
-type MeshGateway struct {
+type MeshBandwidthLimit struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
@@ -702,42 +798,42 @@ type MeshGateway struct {
 	//
 	// +kubebuilder:validation:Optional
 	Mesh string `json:"mesh,omitempty"`
-	// Spec is the specification of the Kuma MeshGateway resource.
+	// Spec is the specification of the Kuma MeshBandwidthLimit resource.
 	// +kubebuilder:validation:Optional
 	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced
-type MeshGatewayList struct {
+type MeshBandwidthLimitList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []MeshGateway `json:"items"`
+	Items           []MeshBandwidthLimit `json:"items"`
 }
 
 func init() {
-	SchemeBuilder.Register(&MeshGateway{}, &MeshGatewayList{})
+	SchemeBuilder.Register(&MeshBandwidthLimit{}, &MeshBandwidthLimitList{})
 }
 
-func (cb *MeshGateway) GetObjectMeta() *metav1.ObjectMeta {
+func (cb *MeshBandwidthLimit) GetObjectMeta() *metav1.ObjectMeta {
 	return &cb.ObjectMeta
 }
 
-func (cb *MeshGateway) SetObjectMeta(m *metav1.ObjectMeta) {
+func (cb *MeshBandwidthLimit) SetObjectMeta(m *metav1.ObjectMeta) {
 	cb.ObjectMeta = *m
 }
 
-func (cb *MeshGateway) GetMesh() string {
+func (cb *MeshBandwidthLimit) GetMesh() string {
 	return cb.Mesh
 }
 
-func (cb *MeshGateway) SetMesh(mesh string) {
+func (cb *MeshBandwidthLimit) SetMesh(mesh string) {
 	cb.Mesh = mesh
 }
 
-func (cb *MeshGateway) GetSpec() (proto.Message, error) {
+func (cb *MeshBandwidthLimit) GetSpec() (proto.Message, error) {
 	spec := cb.Spec
-	m := mesh_proto.MeshGateway{}
+	m := mesh_proto.MeshBandwidthLimit{}
 
 	if spec == nil || len(spec.Raw) == 0 {
 		return &m, nil
@@ -747,24 +843,24 @@ func (cb *MeshGateway) GetSpec() (proto.Message, error) {
 	return &m, err
 }
 
-func (cb *MeshGateway) SetSpec(spec proto.Message) {
+func (cb *MeshBandwidthLimit) SetSpec(spec proto.Message) {
 	if spec == nil {
 		cb.Spec = nil
 		return
 	}
 
-	if _, ok := spec.(*mesh_proto.MeshGateway); !ok {
+	if _, ok := spec.(*mesh_proto.MeshBandwidthLimit); !ok {
 		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
 	}
 
 	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
 }
 
-func (cb *MeshGateway) Scope() model.Scope {
+func (cb *MeshBandwidthLimit) Scope() model.Scope {
 	return model.ScopeCluster
 }
 
-func (l *MeshGatewayList) GetItems() []model.KubernetesObject {
+func (l *MeshBandwidthLimitList) GetItems() []model.KubernetesObject {
 	result := make([]model.KubernetesObject, len(l.Items))
 	for i := range l.Items {
 		result[i] = &l.Items[i]
@@ -773,23 +869,23 @@ func (l *MeshGatewayList) GetItems() []model.KubernetesObject {
 }
 
 func init() {
-	registry.RegisterObjectType(&mesh_proto.MeshGateway{}, &MeshGateway{
+	registry.RegisterObjectType(&mesh_proto.MeshBandwidthLimit{}, &MeshBandwidthLimit{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: GroupVersion.String(),
-			Kind:       "MeshGateway",
+			Kind:       "MeshBandwidthLimit",
 		},
 	})
-	registry.RegisterListType(&mesh_proto.MeshGateway{}, &MeshGatewayList{
+	registry.RegisterListType(&mesh_proto.MeshBandwidthLimit{}, &MeshBandwidthLimitList{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: GroupVersion.String(),
-			Kind:       "MeshGatewayList",
+			Kind:       "MeshBandwidthLimitList",
 		},
 	})
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:categories=kuma,scope=Cluster
-type MeshGatewayRoute struct {
+type MeshCORS struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
@@ -798,42 +894,42 @@ type MeshGatewayRoute struct {
 	//
 	// +kubebuilder:validation:Optional
 	Mesh string `json:"mesh,omitempty"`
-	// Spec is the specification of the Kuma MeshGatewayRoute resource.
+	// Spec is the specification of the Kuma MeshCORS resource.
 	// +kubebuilder:validation:Optional
 	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced
-type MeshGatewayRouteList struct {
+type MeshCORSList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []MeshGatewayRoute `json:"items"`
+	Items           []MeshCORS `json:"items"`
 }
 
 func init() {
-	SchemeBuilder.Register(&MeshGatewayRoute{}, &MeshGatewayRouteList{})
+	SchemeBuilder.Register(&MeshCORS{}, &MeshCORSList{})
 }
 
-func (cb *MeshGatewayRoute) GetObjectMeta() *metav1.ObjectMeta {
+func (cb *MeshCORS) GetObjectMeta() *metav1.ObjectMeta {
 	return &cb.ObjectMeta
 }
 
-func (cb *MeshGatewayRoute) SetObjectMeta(m *metav1.ObjectMeta) {
+func (cb *MeshCORS) SetObjectMeta(m *metav1.ObjectMeta) {
 	cb.ObjectMeta = *m
 }
 
-func (cb *MeshGatewayRoute) GetMesh() string {
+func (cb *MeshCORS) GetMesh() string {
 	return cb.Mesh
 }
 
-func (cb *MeshGatewayRoute) SetMesh(mesh string) {
+func (cb *MeshCORS) SetMesh(mesh string) {
 	cb.Mesh = mesh
 }
 
-func (cb *MeshGatewayRoute) GetSpec() (proto.Message, error) {
+func (cb *MeshCORS) GetSpec() (proto.Message, error) {
 	spec := cb.Spec
-	m := mesh_proto.MeshGatewayRoute{}
+	m := mesh_proto.MeshCORS{}
 
 	if spec == nil || len(spec.Raw) == 0 {
 		return &m, nil
@@ -843,24 +939,24 @@ func (cb *MeshGatewayRoute) GetSpec() (proto.Message, error) {
 	return &m, err
 }
 
-func (cb *MeshGatewayRoute) SetSpec(spec proto.Message) {
+func (cb *MeshCORS) SetSpec(spec proto.Message) {
 	if spec == nil {
 		cb.Spec = nil
 		return
 	}
 
-	if _, ok := spec.(*mesh_proto.MeshGatewayRoute); !ok {
+	if _, ok := spec.(*mesh_proto.MeshCORS); !ok {
 		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
 	}
 
 	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
 }
 
-func (cb *MeshGatewayRoute) Scope() model.Scope {
+func (cb *MeshCORS) Scope() model.Scope {
 	return model.ScopeCluster
 }
 
-func (l *MeshGatewayRouteList) GetItems() []model.KubernetesObject {
+func (l *MeshCORSList) GetItems() []model.KubernetesObject {
 	result := make([]model.KubernetesObject, len(l.Items))
 	for i := range l.Items {
 		result[i] = &l.Items[i]
@@ -869,23 +965,23 @@ func (l *MeshGatewayRouteList) GetItems() []model.KubernetesObject {
 }
 
 func init() {
-	registry.RegisterObjectType(&mesh_proto.MeshGatewayRoute{}, &MeshGatewayRoute{
+	registry.RegisterObjectType(&mesh_proto.MeshCORS{}, &MeshCORS{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: GroupVersion.String(),
-			Kind:       "MeshGatewayRoute",
+			Kind:       "MeshCORS",
 		},
 	})
-	registry.RegisterListType(&mesh_proto.MeshGatewayRoute{}, &MeshGatewayRouteList{
+	registry.RegisterListType(&mesh_proto.MeshCORS{}, &MeshCORSList{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: GroupVersion.String(),
-			Kind:       "MeshGatewayRouteList",
+			Kind:       "MeshCORSList",
 		},
 	})
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:categories=kuma,scope=Cluster
-type MeshInsight struct {
+type MeshCompression struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
@@ -894,42 +990,42 @@ type MeshInsight struct {
 	//
 	// +kubebuilder:validation:Optional
 	Mesh string `json:"mesh,omitempty"`
-	// Spec is the specification of the Kuma MeshInsight resource.
+	// Spec is the specification of the Kuma MeshCompression resource.
 	// +kubebuilder:validation:Optional
 	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced
-type MeshInsightList struct {
+type MeshCompressionList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
-	Items           []MeshInsight `json:"items"`
+	Items           []MeshCompression `json:"items"`
 }
 
 func init() {
-	SchemeBuilder.Register(&MeshInsight{}, &MeshInsightList{})
+	SchemeBuilder.Register(&MeshCompression{}, &MeshCompressionList{})
 }
 
-func (cb *MeshInsight) GetObjectMeta() *metav1.ObjectMeta {
+func (cb *MeshCompression) GetObjectMeta() *metav1.ObjectMeta {
 	return &cb.ObjectMeta
 }
 
-func (cb *MeshInsight) SetObjectMeta(m *metav1.ObjectMeta) {
+func (cb *MeshCompression) SetObjectMeta(m *metav1.ObjectMeta) {
 	cb.ObjectMeta = *m
 }
 
-func (cb *MeshInsight) GetMesh() string {
+func (cb *MeshCompression) GetMesh() string {
 	return cb.Mesh
 }
 
-func (cb *MeshInsight) SetMesh(mesh string) {
+func (cb *MeshCompression) SetMesh(mesh string) {
 	cb.Mesh = mesh
 }
 
-func (cb *MeshInsight) GetSpec() (proto.Message, error) {
+func (cb *MeshCompression) GetSpec() (proto.Message, error) {
 	spec := cb.Spec
-	m := mesh_proto.MeshInsight{}
+	m := mesh_proto.MeshCompression{}
 
 	if spec == nil || len(spec.Raw) == 0 {
 		return &m, nil
@@ -939,24 +1035,24 @@ func (cb *MeshInsight) GetSpec() (proto.Message, error) {
 	return &m, err
 }
 
-func (cb *MeshInsight) SetSpec(spec proto.Message) {
+func (cb *MeshCompression) SetSpec(spec proto.Message) {
 	if spec == nil {
 		cb.Spec = nil
 		return
 	}
 
-	if _, ok := spec.(*mesh_proto.MeshInsight); !ok {
+	if _, ok := spec.(*mesh_proto.MeshCompression); !ok {
 		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
 	}
 
 	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
 }
 
-func (cb *MeshInsight) Scope() model.Scope {
+func (cb *MeshCompression) Scope() model.Scope {
 	return model.ScopeCluster
 }
 
-func (l *MeshInsightList) GetItems() []model.KubernetesObject {
+func (l *MeshCompressionList) GetItems() []model.KubernetesObject {
 	result := make([]model.KubernetesObject, len(l.Items))
 	for i := range l.Items {
 		result[i] = &l.Items[i]
@@ -965,16 +1061,976 @@ func (l *MeshInsightList) GetItems() []model.KubernetesObject {
 }
 
 func init() {
-	registry.RegisterObjectType(&mesh_proto.MeshInsight{}, &MeshInsight{
+	registry.RegisterObjectType(&mesh_proto.MeshCompression{}, &MeshCompression{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: GroupVersion.String(),
-			Kind:       "MeshInsight",
+			Kind:       "MeshCompression",
 		},
 	})
-	registry.RegisterListType(&mesh_proto.MeshInsight{}, &MeshInsightList{
+	registry.RegisterListType(&mesh_proto.MeshCompression{}, &MeshCompressionList{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: GroupVersion.String(),
-			Kind:       "MeshInsightList",
+			Kind:       "MeshCompressionList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type MeshExternalAuthz struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma MeshExternalAuthz resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type MeshExternalAuthzList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshExternalAuthz `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshExternalAuthz{}, &MeshExternalAuthzList{})
+}
+
+func (cb *MeshExternalAuthz) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *MeshExternalAuthz) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *MeshExternalAuthz) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *MeshExternalAuthz) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *MeshExternalAuthz) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.MeshExternalAuthz{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *MeshExternalAuthz) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.MeshExternalAuthz); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *MeshExternalAuthz) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshExternalAuthzList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.MeshExternalAuthz{}, &MeshExternalAuthz{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshExternalAuthz",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.MeshExternalAuthz{}, &MeshExternalAuthzList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshExternalAuthzList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type MeshGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma MeshGateway resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type MeshGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshGateway `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshGateway{}, &MeshGatewayList{})
+}
+
+func (cb *MeshGateway) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *MeshGateway) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *MeshGateway) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *MeshGateway) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *MeshGateway) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.MeshGateway{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *MeshGateway) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.MeshGateway); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *MeshGateway) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshGatewayList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.MeshGateway{}, &MeshGateway{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshGateway",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.MeshGateway{}, &MeshGatewayList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshGatewayList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type MeshGatewayRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma MeshGatewayRoute resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type MeshGatewayRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshGatewayRoute `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshGatewayRoute{}, &MeshGatewayRouteList{})
+}
+
+func (cb *MeshGatewayRoute) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *MeshGatewayRoute) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *MeshGatewayRoute) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *MeshGatewayRoute) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *MeshGatewayRoute) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.MeshGatewayRoute{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *MeshGatewayRoute) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.MeshGatewayRoute); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *MeshGatewayRoute) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshGatewayRouteList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.MeshGatewayRoute{}, &MeshGatewayRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshGatewayRoute",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.MeshGatewayRoute{}, &MeshGatewayRouteList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshGatewayRouteList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type MeshHeaderModifier struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma MeshHeaderModifier resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type MeshHeaderModifierList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshHeaderModifier `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshHeaderModifier{}, &MeshHeaderModifierList{})
+}
+
+func (cb *MeshHeaderModifier) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *MeshHeaderModifier) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *MeshHeaderModifier) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *MeshHeaderModifier) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *MeshHeaderModifier) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.MeshHeaderModifier{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *MeshHeaderModifier) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.MeshHeaderModifier); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *MeshHeaderModifier) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshHeaderModifierList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.MeshHeaderModifier{}, &MeshHeaderModifier{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshHeaderModifier",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.MeshHeaderModifier{}, &MeshHeaderModifierList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshHeaderModifierList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type MeshInsight struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma MeshInsight resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type MeshInsightList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshInsight `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshInsight{}, &MeshInsightList{})
+}
+
+func (cb *MeshInsight) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *MeshInsight) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *MeshInsight) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *MeshInsight) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *MeshInsight) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.MeshInsight{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *MeshInsight) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.MeshInsight); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *MeshInsight) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshInsightList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.MeshInsight{}, &MeshInsight{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshInsight",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.MeshInsight{}, &MeshInsightList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshInsightList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type MeshJWT struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma MeshJWT resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type MeshJWTList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshJWT `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshJWT{}, &MeshJWTList{})
+}
+
+func (cb *MeshJWT) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *MeshJWT) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *MeshJWT) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *MeshJWT) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *MeshJWT) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.MeshJWT{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *MeshJWT) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.MeshJWT); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *MeshJWT) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshJWTList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.MeshJWT{}, &MeshJWT{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshJWT",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.MeshJWT{}, &MeshJWTList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshJWTList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type MeshProtocolOptions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma MeshProtocolOptions resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type MeshProtocolOptionsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshProtocolOptions `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshProtocolOptions{}, &MeshProtocolOptionsList{})
+}
+
+func (cb *MeshProtocolOptions) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *MeshProtocolOptions) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *MeshProtocolOptions) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *MeshProtocolOptions) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *MeshProtocolOptions) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.MeshProtocolOptions{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *MeshProtocolOptions) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.MeshProtocolOptions); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *MeshProtocolOptions) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshProtocolOptionsList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.MeshProtocolOptions{}, &MeshProtocolOptions{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshProtocolOptions",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.MeshProtocolOptions{}, &MeshProtocolOptionsList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshProtocolOptionsList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type MeshRequestProtection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma MeshRequestProtection resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type MeshRequestProtectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshRequestProtection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshRequestProtection{}, &MeshRequestProtectionList{})
+}
+
+func (cb *MeshRequestProtection) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *MeshRequestProtection) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *MeshRequestProtection) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *MeshRequestProtection) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *MeshRequestProtection) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.MeshRequestProtection{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *MeshRequestProtection) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.MeshRequestProtection); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *MeshRequestProtection) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshRequestProtectionList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.MeshRequestProtection{}, &MeshRequestProtection{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshRequestProtection",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.MeshRequestProtection{}, &MeshRequestProtectionList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshRequestProtectionList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type MeshWasmPlugin struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma MeshWasmPlugin resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type MeshWasmPluginList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshWasmPlugin `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshWasmPlugin{}, &MeshWasmPluginList{})
+}
+
+func (cb *MeshWasmPlugin) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *MeshWasmPlugin) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *MeshWasmPlugin) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *MeshWasmPlugin) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *MeshWasmPlugin) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.MeshWasmPlugin{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *MeshWasmPlugin) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.MeshWasmPlugin); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *MeshWasmPlugin) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshWasmPluginList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.MeshWasmPlugin{}, &MeshWasmPlugin{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshWasmPlugin",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.MeshWasmPlugin{}, &MeshWasmPluginList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshWasmPluginList",
+		},
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=kuma,scope=Cluster
+type MeshZoneTrafficRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Mesh is the name of the Kuma mesh this resource belongs to.
+	// It may be omitted for cluster-scoped resources.
+	//
+	// +kubebuilder:validation:Optional
+	Mesh string `json:"mesh,omitempty"`
+	// Spec is the specification of the Kuma MeshZoneTrafficRoute resource.
+	// +kubebuilder:validation:Optional
+	Spec *apiextensionsv1.JSON `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+type MeshZoneTrafficRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeshZoneTrafficRoute `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeshZoneTrafficRoute{}, &MeshZoneTrafficRouteList{})
+}
+
+func (cb *MeshZoneTrafficRoute) GetObjectMeta() *metav1.ObjectMeta {
+	return &cb.ObjectMeta
+}
+
+func (cb *MeshZoneTrafficRoute) SetObjectMeta(m *metav1.ObjectMeta) {
+	cb.ObjectMeta = *m
+}
+
+func (cb *MeshZoneTrafficRoute) GetMesh() string {
+	return cb.Mesh
+}
+
+func (cb *MeshZoneTrafficRoute) SetMesh(mesh string) {
+	cb.Mesh = mesh
+}
+
+func (cb *MeshZoneTrafficRoute) GetSpec() (proto.Message, error) {
+	spec := cb.Spec
+	m := mesh_proto.MeshZoneTrafficRoute{}
+
+	if spec == nil || len(spec.Raw) == 0 {
+		return &m, nil
+	}
+
+	err := util_proto.FromJSON(spec.Raw, &m)
+	return &m, err
+}
+
+func (cb *MeshZoneTrafficRoute) SetSpec(spec proto.Message) {
+	if spec == nil {
+		cb.Spec = nil
+		return
+	}
+
+	if _, ok := spec.(*mesh_proto.MeshZoneTrafficRoute); !ok {
+		panic(fmt.Sprintf("unexpected protobuf message type %T", spec))
+	}
+
+	cb.Spec = &apiextensionsv1.JSON{Raw: util_proto.MustMarshalJSON(spec)}
+}
+
+func (cb *MeshZoneTrafficRoute) Scope() model.Scope {
+	return model.ScopeCluster
+}
+
+func (l *MeshZoneTrafficRouteList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&mesh_proto.MeshZoneTrafficRoute{}, &MeshZoneTrafficRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshZoneTrafficRoute",
+		},
+	})
+	registry.RegisterListType(&mesh_proto.MeshZoneTrafficRoute{}, &MeshZoneTrafficRouteList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "MeshZoneTrafficRouteList",
 		},
 	})
 }
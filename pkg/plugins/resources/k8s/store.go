@@ -14,6 +14,7 @@ import (
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
 	k8s_common "github.com/kumahq/kuma/pkg/plugins/common/k8s"
 	k8s_model "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/model"
 	k8s_registry "github.com/kumahq/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
@@ -263,3 +264,8 @@ func (f *SimpleKubeFactory) NewObject(r core_model.Resource) (k8s_model.Kubernet
 func (f *SimpleKubeFactory) NewList(rl core_model.ResourceList) (k8s_model.KubernetesList, error) {
 	return f.KubeTypes.NewList(rl.NewItem().GetSpec())
 }
+
+// Watch is not supported, changes of the resources are delivered by the informers of the Kubernetes client.
+func (s *KubernetesStore) Watch(context.Context, ...store.WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return nil, store.ErrorWatchNotSupported
+}
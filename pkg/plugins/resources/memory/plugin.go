@@ -28,6 +28,5 @@ func (p *plugin) Migrate(pc core_plugins.PluginContext, config core_plugins.Plug
 }
 
 func (p *plugin) EventListener(context core_plugins.PluginContext, writer events.Emitter) error {
-	context.ResourceStore().(*memoryStore).SetEventWriter(writer)
-	return nil
+	return context.ComponentManager().Add(core_store.NewWatchEmitter(context.ResourceStore(), writer))
 }
@@ -81,24 +81,18 @@ func (v memoryVersion) String() string {
 var _ store.ResourceStore = &memoryStore{}
 
 type memoryStore struct {
-	records     memoryStoreRecords
-	mu          sync.RWMutex
-	eventWriter events.Emitter
+	records  memoryStoreRecords
+	mu       sync.RWMutex
+	watchers store.Watchers
 }
 
 func NewStore() store.ResourceStore {
 	return &memoryStore{}
 }
 
-func (c *memoryStore) SetEventWriter(writer events.Emitter) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.eventWriter = writer
-}
-
-func (c *memoryStore) Create(_ context.Context, r model.Resource, fs ...store.CreateOptionsFunc) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+func (c *memoryStore) Create(ctx context.Context, r model.Resource, fs ...store.CreateOptionsFunc) error {
+	unlock := c.lock(ctx)
+	defer unlock()
 
 	opts := store.NewCreateOptions(fs...)
 	// Name must be provided via CreateOptions
@@ -136,21 +130,17 @@ func (c *memoryStore) Create(_ context.Context, r model.Resource, fs ...store.Cr
 
 	// persist
 	c.records = append(c.records, record)
-	if c.eventWriter != nil {
-		go func() {
-			c.eventWriter.Send(events.ResourceChangedEvent{
-				Operation: events.Create,
-				Type:      r.Descriptor().Name,
-				Key:       model.MetaToResourceKey(r.GetMeta()),
-			})
-		}()
-	}
+	c.send(ctx, events.ResourceChangedEvent{
+		Operation: events.Create,
+		Type:      r.Descriptor().Name,
+		Key:       model.MetaToResourceKey(r.GetMeta()),
+	})
 	return nil
 }
 
-func (c *memoryStore) Update(_ context.Context, r model.Resource, fs ...store.UpdateOptionsFunc) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+func (c *memoryStore) Update(ctx context.Context, r model.Resource, fs ...store.UpdateOptionsFunc) error {
+	unlock := c.lock(ctx)
+	defer unlock()
 
 	opts := store.NewUpdateOptions(fs...)
 
@@ -180,25 +170,21 @@ func (c *memoryStore) Update(_ context.Context, r model.Resource, fs ...store.Up
 	c.records[idx] = record
 
 	r.SetMeta(meta)
-	if c.eventWriter != nil {
-		go func() {
-			c.eventWriter.Send(events.ResourceChangedEvent{
-				Operation: events.Update,
-				Type:      r.Descriptor().Name,
-				Key:       model.MetaToResourceKey(r.GetMeta()),
-			})
-		}()
-	}
+	c.send(ctx, events.ResourceChangedEvent{
+		Operation: events.Update,
+		Type:      r.Descriptor().Name,
+		Key:       model.MetaToResourceKey(r.GetMeta()),
+	})
 	return nil
 }
 
 func (c *memoryStore) Delete(ctx context.Context, r model.Resource, fs ...store.DeleteOptionsFunc) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	return c.delete(r, fs...)
+	unlock := c.lock(ctx)
+	defer unlock()
+	return c.delete(ctx, r, fs...)
 }
 
-func (c *memoryStore) delete(r model.Resource, fs ...store.DeleteOptionsFunc) error {
+func (c *memoryStore) delete(ctx context.Context, r model.Resource, fs ...store.DeleteOptionsFunc) error {
 	opts := store.NewDeleteOptions(fs...)
 
 	_, ok := (r.GetMeta()).(memoryMeta)
@@ -223,29 +209,29 @@ func (c *memoryStore) delete(r model.Resource, fs ...store.DeleteOptionsFunc) er
 		if err := c.unmarshalRecord(childRecord, obj); err != nil {
 			return fmt.Errorf("MemoryStore.Delete() couldn't unmarshal child resource")
 		}
-		if err := c.delete(obj, store.DeleteByKey(childRecord.Name, childRecord.Mesh)); err != nil {
+		if err := c.delete(ctx, obj, store.DeleteByKey(childRecord.Name, childRecord.Mesh)); err != nil {
 			return fmt.Errorf("MemoryStore.Delete() couldn't delete linked child resource")
 		}
 	}
 	c.records = append(c.records[:idx], c.records[idx+1:]...)
-	if c.eventWriter != nil {
-		go func() {
-			c.eventWriter.Send(events.ResourceChangedEvent{
-				Operation: events.Delete,
-				Type:      r.Descriptor().Name,
-				Key: model.ResourceKey{
-					Mesh: opts.Mesh,
-					Name: opts.Name,
-				},
-			})
-		}()
-	}
+	c.send(ctx, events.ResourceChangedEvent{
+		Operation: events.Delete,
+		Type:      r.Descriptor().Name,
+		Key: model.ResourceKey{
+			Mesh: opts.Mesh,
+			Name: opts.Name,
+		},
+	})
 	return nil
 }
 
-func (c *memoryStore) Get(_ context.Context, r model.Resource, fs ...store.GetOptionsFunc) error {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+func (c *memoryStore) Watch(ctx context.Context, fs ...store.WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return c.watchers.Add(ctx, fs...), nil
+}
+
+func (c *memoryStore) Get(ctx context.Context, r model.Resource, fs ...store.GetOptionsFunc) error {
+	unlock := c.rlock(ctx)
+	defer unlock()
 
 	opts := store.NewGetOptions(fs...)
 	// Name must be provided via GetOptions
@@ -259,9 +245,9 @@ func (c *memoryStore) Get(_ context.Context, r model.Resource, fs ...store.GetOp
 	return c.unmarshalRecord(record, r)
 }
 
-func (c *memoryStore) List(_ context.Context, rs model.ResourceList, fs ...store.ListOptionsFunc) error {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+func (c *memoryStore) List(ctx context.Context, rs model.ResourceList, fs ...store.ListOptionsFunc) error {
+	unlock := c.rlock(ctx)
+	defer unlock()
 
 	opts := store.NewListOptions(fs...)
 
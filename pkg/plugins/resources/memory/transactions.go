@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
+)
+
+// memoryTransaction holds the lock of the store until it's committed or rolled back,
+// so the changes of the transaction are not visible to others until then.
+type memoryTransaction struct {
+	store *memoryStore
+	// records are the records from the beginning of the transaction, they are restored on rollback
+	records memoryStoreRecords
+	// events are sent to the watchers only when the transaction is committed
+	events []events.ResourceChangedEvent
+}
+
+var _ store.Transaction = &memoryTransaction{}
+
+func (t *memoryTransaction) Commit(context.Context) error {
+	defer t.store.mu.Unlock()
+	for _, event := range t.events {
+		t.store.watchers.Send(event)
+	}
+	return nil
+}
+
+func (t *memoryTransaction) Rollback(context.Context) error {
+	defer t.store.mu.Unlock()
+	t.store.records = t.records
+	return nil
+}
+
+var _ store.Transactions = &memoryStore{}
+
+func (c *memoryStore) Begin(context.Context) (store.Transaction, error) {
+	c.mu.Lock()
+	records := make(memoryStoreRecords, 0, len(c.records))
+	for _, record := range c.records {
+		cpy := *record
+		cpy.Children = append([]*resourceKey(nil), record.Children...)
+		records = append(records, &cpy)
+	}
+	return &memoryTransaction{
+		store:   c,
+		records: records,
+	}, nil
+}
+
+// tx returns the transaction from the context if there is one started by this store.
+func (c *memoryStore) tx(ctx context.Context) *memoryTransaction {
+	if tx, ok := store.TxFromCtx(ctx); ok {
+		if memTx, ok := tx.(*memoryTransaction); ok && memTx.store == c {
+			return memTx
+		}
+	}
+	return nil
+}
+
+// lock locks the store for writing unless the store is already locked by the transaction in the context.
+func (c *memoryStore) lock(ctx context.Context) func() {
+	if c.tx(ctx) != nil {
+		return func() {}
+	}
+	c.mu.Lock()
+	return c.mu.Unlock
+}
+
+// rlock locks the store for reading unless the store is already locked by the transaction in the context.
+func (c *memoryStore) rlock(ctx context.Context) func() {
+	if c.tx(ctx) != nil {
+		return func() {}
+	}
+	c.mu.RLock()
+	return c.mu.RUnlock
+}
+
+func (c *memoryStore) send(ctx context.Context, event events.ResourceChangedEvent) {
+	if tx := c.tx(ctx); tx != nil {
+		tx.events = append(tx.events, event)
+		return
+	}
+	c.watchers.Send(event)
+}
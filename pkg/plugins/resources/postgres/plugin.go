@@ -7,7 +7,6 @@ import (
 	core_plugins "github.com/kumahq/kuma/pkg/core/plugins"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/events"
-	postgres_events "github.com/kumahq/kuma/pkg/plugins/resources/postgres/events"
 )
 
 var _ core_plugins.ResourceStorePlugin = &plugin{}
@@ -42,5 +41,5 @@ func (p *plugin) Migrate(pc core_plugins.PluginContext, config core_plugins.Plug
 }
 
 func (p *plugin) EventListener(pc core_plugins.PluginContext, out events.Emitter) error {
-	return pc.ComponentManager().Add(postgres_events.NewListener(*pc.Config().Store.Postgres, out))
+	return pc.ComponentManager().Add(core_store.NewWatchEmitter(pc.ResourceStore(), out))
 }
@@ -6,9 +6,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -23,7 +24,12 @@ import (
 const duplicateKeyErrorMsg = "duplicate key value violates unique constraint"
 
 type postgresResourceStore struct {
-	db *sql.DB
+	db     *sql.DB
+	config config.PostgresStoreConfig
+
+	watchers   store.Watchers
+	listenerMu sync.Mutex
+	listener   *pq.Listener
 }
 
 var _ store.ResourceStore = &postgresResourceStore{}
@@ -39,11 +45,12 @@ func NewStore(metrics core_metrics.Metrics, config config.PostgresStoreConfig) (
 	}
 
 	return &postgresResourceStore{
-		db: db,
+		db:     db,
+		config: config,
 	}, nil
 }
 
-func (r *postgresResourceStore) Create(_ context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
+func (r *postgresResourceStore) Create(ctx context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
 	opts := store.NewCreateOptions(fs...)
 
 	bytes, err := proto.ToJSON(resource.GetSpec())
@@ -64,7 +71,7 @@ func (r *postgresResourceStore) Create(_ context.Context, resource model.Resourc
 
 	version := 0
 	statement := `INSERT INTO resources VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);`
-	_, err = r.db.Exec(statement, opts.Name, opts.Mesh, resource.Descriptor().Name, version, string(bytes),
+	_, err = r.querier(ctx).Exec(statement, opts.Name, opts.Mesh, resource.Descriptor().Name, version, string(bytes),
 		opts.CreationTime.UTC(), opts.CreationTime.UTC(), ownerName, ownerMesh, ownerType)
 	if err != nil {
 		if strings.Contains(err.Error(), duplicateKeyErrorMsg) {
@@ -83,7 +90,7 @@ func (r *postgresResourceStore) Create(_ context.Context, resource model.Resourc
 	return nil
 }
 
-func (r *postgresResourceStore) Update(_ context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+func (r *postgresResourceStore) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
 	bytes, err := proto.ToJSON(resource.GetSpec())
 	if err != nil {
 		return err
@@ -97,7 +104,7 @@ func (r *postgresResourceStore) Update(_ context.Context, resource model.Resourc
 		return errors.Wrap(err, "failed to convert meta version to int")
 	}
 	statement := `UPDATE resources SET spec=$1, version=$2, modification_time=$3 WHERE name=$4 AND mesh=$5 AND type=$6 AND version=$7;`
-	result, err := r.db.Exec(
+	result, err := r.querier(ctx).Exec(
 		statement,
 		string(bytes),
 		newVersion,
@@ -125,11 +132,11 @@ func (r *postgresResourceStore) Update(_ context.Context, resource model.Resourc
 	return nil
 }
 
-func (r *postgresResourceStore) Delete(_ context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
+func (r *postgresResourceStore) Delete(ctx context.Context, resource model.Resource, fs ...store.DeleteOptionsFunc) error {
 	opts := store.NewDeleteOptions(fs...)
 
 	statement := `DELETE FROM resources WHERE name=$1 AND type=$2 AND mesh=$3`
-	result, err := r.db.Exec(statement, opts.Name, resource.Descriptor().Name, opts.Mesh)
+	result, err := r.querier(ctx).Exec(statement, opts.Name, resource.Descriptor().Name, opts.Mesh)
 	if err != nil {
 		return errors.Wrapf(err, "failed to execute query: %s", statement)
 	}
@@ -140,11 +147,11 @@ func (r *postgresResourceStore) Delete(_ context.Context, resource model.Resourc
 	return nil
 }
 
-func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
+func (r *postgresResourceStore) Get(ctx context.Context, resource model.Resource, fs ...store.GetOptionsFunc) error {
 	opts := store.NewGetOptions(fs...)
 
 	statement := `SELECT spec, version, creation_time, modification_time FROM resources WHERE name=$1 AND mesh=$2 AND type=$3;`
-	row := r.db.QueryRow(statement, opts.Name, opts.Mesh, resource.Descriptor().Name)
+	row := r.querier(ctx).QueryRow(statement, opts.Name, opts.Mesh, resource.Descriptor().Name)
 
 	var spec string
 	var version int
@@ -176,7 +183,7 @@ func (r *postgresResourceStore) Get(_ context.Context, resource model.Resource,
 	return nil
 }
 
-func (r *postgresResourceStore) List(_ context.Context, resources model.ResourceList, args ...store.ListOptionsFunc) error {
+func (r *postgresResourceStore) List(ctx context.Context, resources model.ResourceList, args ...store.ListOptionsFunc) error {
 	opts := store.NewListOptions(args...)
 
 	statement := `SELECT name, mesh, spec, version, creation_time, modification_time FROM resources WHERE type=$1`
@@ -190,7 +197,7 @@ func (r *postgresResourceStore) List(_ context.Context, resources model.Resource
 	}
 	statement += " ORDER BY name, mesh"
 
-	rows, err := r.db.Query(statement, statementArgs...)
+	rows, err := r.querier(ctx).Query(statement, statementArgs...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to execute query: %s", statement)
 	}
@@ -238,6 +245,9 @@ func rowToItem(resources model.ResourceList, rows *sql.Rows) (model.Resource, er
 }
 
 func (r *postgresResourceStore) Close() error {
+	if err := r.closeListener(); err != nil {
+		return err
+	}
 	return r.db.Close()
 }
 
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+)
+
+// querier is implemented by both the DB and the transaction, so the statements can be executed either way.
+type querier interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+type postgresTransaction struct {
+	tx *sql.Tx
+	db *sql.DB
+}
+
+var _ store.Transaction = &postgresTransaction{}
+
+func (p *postgresTransaction) Commit(context.Context) error {
+	return p.tx.Commit()
+}
+
+func (p *postgresTransaction) Rollback(context.Context) error {
+	return p.tx.Rollback()
+}
+
+var _ store.Transactions = &postgresResourceStore{}
+
+func (r *postgresResourceStore) Begin(ctx context.Context) (store.Transaction, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not begin the transaction")
+	}
+	return &postgresTransaction{tx: tx, db: r.db}, nil
+}
+
+// querier returns the transaction from the context if there is one started by this store.
+func (r *postgresResourceStore) querier(ctx context.Context) querier {
+	if tx, ok := store.TxFromCtx(ctx); ok {
+		if pgTx, ok := tx.(*postgresTransaction); ok && pgTx.db == r.db {
+			return pgTx.tx
+		}
+	}
+	return r.db
+}
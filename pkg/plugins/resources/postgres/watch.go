@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/pkg/core"
+	"github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
+	common_postgres "github.com/kumahq/kuma/pkg/plugins/common/postgres"
+)
+
+var log = core.Log.WithName("postgres-event-listener")
+
+// Watch streams the changes delivered by the triggers of the "resources" table with NOTIFY.
+// All watches share a single LISTEN connection which is opened with the first watch.
+func (r *postgresResourceStore) Watch(ctx context.Context, fs ...store.WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	if err := r.startListener(); err != nil {
+		return nil, err
+	}
+	return r.watchers.Add(ctx, fs...), nil
+}
+
+func (r *postgresResourceStore) startListener() error {
+	r.listenerMu.Lock()
+	defer r.listenerMu.Unlock()
+	if r.listener != nil {
+		return nil
+	}
+	listener, err := common_postgres.NewListener(r.config, log)
+	if err != nil {
+		return errors.Wrap(err, "could not listen to the changes of resources")
+	}
+	r.listener = listener
+	log.Info("start monitoring")
+	go r.notify(listener)
+	return nil
+}
+
+func (r *postgresResourceStore) notify(listener *pq.Listener) {
+	// Notify is closed when the listener is closed
+	for n := range listener.Notify {
+		if n == nil {
+			// nil is sent after the connection was reestablished, notifications sent in the meantime are lost
+			r.watchers.CloseAll()
+			continue
+		}
+		event, err := parseNotification(n.Extra)
+		if err != nil {
+			log.Error(err, "unable to parse event from PostgreSQL", "event", n.Extra)
+			continue
+		}
+		r.watchers.Send(event)
+	}
+	r.watchers.CloseAll()
+}
+
+func (r *postgresResourceStore) closeListener() error {
+	r.listenerMu.Lock()
+	defer r.listenerMu.Unlock()
+	if r.listener == nil {
+		return nil
+	}
+	log.Info("stop monitoring")
+	return r.listener.Close()
+}
+
+func parseNotification(payload string) (events.ResourceChangedEvent, error) {
+	obj := &struct {
+		Action string `json:"action"`
+		Data   struct {
+			Name string `json:"name"`
+			Mesh string `json:"mesh"`
+			Type string `json:"type"`
+		}
+	}{}
+	if err := json.Unmarshal([]byte(payload), obj); err != nil {
+		return events.ResourceChangedEvent{}, err
+	}
+	var op events.Op
+	switch obj.Action {
+	case "INSERT":
+		op = events.Create
+	case "UPDATE":
+		op = events.Update
+	case "DELETE":
+		op = events.Delete
+	default:
+		return events.ResourceChangedEvent{}, errors.Errorf("unknown action %q", obj.Action)
+	}
+	return events.ResourceChangedEvent{
+		Operation: op,
+		Type:      model.ResourceType(obj.Data.Type),
+		Key:       model.ResourceKey{Mesh: obj.Data.Mesh, Name: obj.Data.Name},
+	}, nil
+}
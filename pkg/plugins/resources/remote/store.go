@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/model/rest"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/rest/errors/types"
+	"github.com/kumahq/kuma/pkg/events"
 	util_http "github.com/kumahq/kuma/pkg/util/http"
 )
 
@@ -38,25 +40,28 @@ func (s *remoteStore) Create(ctx context.Context, res model.Resource, fs ...stor
 		Name: opts.Name,
 		Mesh: opts.Mesh,
 	}
-	if err := s.upsert(ctx, res, meta); err != nil {
+	if err := s.upsert(ctx, res, meta, opts.DryRun); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (s *remoteStore) Update(ctx context.Context, res model.Resource, fs ...store.UpdateOptionsFunc) error {
+	opts := store.NewUpdateOptions(fs...)
 	meta := rest.ResourceMeta{
-		Type: string(res.Descriptor().Name),
-		Name: res.GetMeta().GetName(),
-		Mesh: res.GetMeta().GetMesh(),
+		Type:     string(res.Descriptor().Name),
+		Name:     res.GetMeta().GetName(),
+		Mesh:     res.GetMeta().GetMesh(),
+		Revision: res.GetMeta().GetVersion(),
 	}
-	if err := s.upsert(ctx, res, meta); err != nil {
+	if err := s.upsert(ctx, res, meta, opts.DryRun); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *remoteStore) upsert(ctx context.Context, res model.Resource, meta rest.ResourceMeta) error {
+// upsert creates or updates the resource. In case of dry run, the resource is replaced with the one returned by the server.
+func (s *remoteStore) upsert(ctx context.Context, res model.Resource, meta rest.ResourceMeta, dryRun bool) error {
 	resourceApi, err := s.api.GetResourceApi(res.Descriptor().Name)
 	if err != nil {
 		return errors.Wrapf(err, "failed to construct URI to update a %q", res.Descriptor().Name)
@@ -74,7 +79,16 @@ func (s *remoteStore) upsert(ctx context.Context, res model.Resource, meta rest.
 		return err
 	}
 	req.Header.Set("content-type", "application/json")
+	if dryRun {
+		query := req.URL.Query()
+		query.Add("dryRun", "true")
+		req.URL.RawQuery = query.Encode()
+	}
 	statusCode, b, err := s.doRequest(ctx, req)
+	if statusCode == http.StatusConflict && meta.Revision != "" {
+		// the resource was modified since the revision
+		return store.ErrorResourceConflict(res.Descriptor().Name, meta.Name, meta.Mesh)
+	}
 	if err != nil {
 		return err
 	}
@@ -85,6 +99,10 @@ func (s *remoteStore) upsert(ctx context.Context, res model.Resource, meta rest.
 			return errors.Errorf("(%d): %s", statusCode, string(b))
 		}
 	}
+	if dryRun {
+		res.GetSpec().Reset()
+		return Unmarshal(b, res)
+	}
 	res.SetMeta(remoteMeta{
 		Name:    meta.Name,
 		Mesh:    meta.Mesh,
@@ -160,6 +178,9 @@ func (s *remoteStore) List(ctx context.Context, rs model.ResourceList, fs ...sto
 	if opts.PageSize != 0 {
 		query.Add("size", strconv.Itoa(opts.PageSize))
 	}
+	for tag, value := range opts.Tags {
+		query.Add("tag", fmt.Sprintf("%s:%s", tag, value))
+	}
 	req.URL.RawQuery = query.Encode()
 
 	statusCode, b, err := s.doRequest(ctx, req)
@@ -188,9 +209,14 @@ func (s *remoteStore) doRequest(ctx context.Context, req *http.Request) (int, []
 		kumaErr := types.Error{}
 		if err := json.Unmarshal(b, &kumaErr); err == nil {
 			if kumaErr.Title != "" && kumaErr.Details != "" {
+				kumaErr.Status = resp.StatusCode
 				return resp.StatusCode, b, &kumaErr
 			}
 		}
 	}
 	return resp.StatusCode, b, nil
 }
+
+func (s *remoteStore) Watch(context.Context, ...store.WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return nil, store.ErrorWatchNotSupported
+}
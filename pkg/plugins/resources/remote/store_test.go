@@ -132,6 +132,7 @@ var _ = Describe("RemoteStore", func() {
 			Expect(err).To(Equal(&errors_types.Error{
 				Title:   "Could not get resource",
 				Details: "Internal Server Error",
+				Status:  400,
 			}))
 		})
 
@@ -176,6 +177,28 @@ var _ = Describe("RemoteStore", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should replace the resource with the result of dry run", func() {
+			// setup
+			store := setupStore("get.json", func(req *http.Request) {
+				Expect(req.URL.Path).To(Equal("/meshes/default/traffic-routes/res-1"))
+				Expect(req.URL.Query().Get("dryRun")).To(Equal("true"))
+			})
+
+			// when
+			resource := sample_core.TrafficRouteResource{
+				Spec: &sample_api.TrafficRoute{
+					Path: "/some-path",
+				},
+			}
+			err := store.Create(context.Background(), &resource, core_store.CreateByKey("res-1", "default"), core_store.CreateDryRun())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.Spec.Path).To(Equal("/example"))
+			Expect(resource.Meta.GetName()).To(Equal("res-1"))
+			Expect(resource.Meta.GetCreationTime()).To(Equal(creationTime))
+		})
+
 		It("should send proper mesh json", func() {
 			// setup
 			meshName := "someMesh"
@@ -225,6 +248,7 @@ var _ = Describe("RemoteStore", func() {
 						Message: "cannot be empty",
 					},
 				},
+				Status: 400,
 			}))
 		})
 	})
@@ -256,6 +280,31 @@ var _ = Describe("RemoteStore", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should replace the resource with the result of dry run", func() {
+			// setup
+			store := setupStore("get.json", func(req *http.Request) {
+				Expect(req.URL.Path).To(Equal("/meshes/default/traffic-routes/res-1"))
+				Expect(req.URL.Query().Get("dryRun")).To(Equal("true"))
+			})
+
+			// when
+			resource := sample_core.TrafficRouteResource{
+				Spec: &sample_api.TrafficRoute{
+					Path: "/some-path",
+				},
+				Meta: &model.ResourceMeta{
+					Mesh: "default",
+					Name: "res-1",
+				},
+			}
+			err := store.Update(context.Background(), &resource, core_store.UpdateDryRun())
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.Spec.Path).To(Equal("/example"))
+			Expect(resource.Meta.GetModificationTime()).To(Equal(modificationTime))
+		})
+
 		It("should send proper mesh json", func() {
 			// setup
 			meshName := "someMesh"
@@ -350,8 +399,40 @@ var _ = Describe("RemoteStore", func() {
 						Message: "cannot be empty",
 					},
 				},
+				Status: 400,
 			}))
 		})
+
+		It("should map 409 error to ResourceConflict when the revision is sent", func() {
+			// given
+			json := `
+			{
+				"title": "Could not update a resource",
+				"details": "Conflict",
+				"causes": [
+					{
+						"field": "revision",
+						"message": "resource was modified in the meantime, fetch the newest revision of the resource and apply the changes again"
+					}
+				]
+			}
+		`
+			store := setupErrorStore(409, json)
+
+			// when
+			resource := sample_core.TrafficRouteResource{
+				Spec: &sample_api.TrafficRoute{},
+				Meta: &model.ResourceMeta{
+					Mesh:    "default",
+					Name:    "res-1",
+					Version: "1",
+				},
+			}
+			err := store.Update(context.Background(), &resource)
+
+			// then
+			Expect(core_store.IsResourceConflict(err)).To(BeTrue())
+		})
 	})
 
 	Describe("List()", func() {
@@ -408,6 +489,25 @@ var _ = Describe("RemoteStore", func() {
 			Expect(rs.Items[0].Meta.GetModificationTime()).Should(Equal(modificationTime))
 		})
 
+		It("should list known resources by tags", func() {
+			// given
+			store := setupStore("list.json", func(req *http.Request) {
+				Expect(req.URL.Path).To(Equal("/meshes/demo/traffic-routes"))
+				Expect(req.URL.Query()["tag"]).To(ConsistOf("kuma.io/service:backend", "version:v1"))
+			})
+
+			// when
+			rs := sample_core.TrafficRouteResourceList{}
+			err := store.List(context.Background(), &rs, core_store.ListByMesh("demo"), core_store.ListByTags(map[string]string{
+				"kuma.io/service": "backend",
+				"version":         "v1",
+			}))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs.Items).To(HaveLen(2))
+		})
+
 		It("should list meshes", func() {
 			// given
 			store := setupStore("list-meshes.json", func(req *http.Request) {
@@ -464,6 +564,7 @@ var _ = Describe("RemoteStore", func() {
 			Expect(err).To(Equal(&errors_types.Error{
 				Title:   "Could not list resource",
 				Details: "Internal Server Error",
+				Status:  400,
 			}))
 		})
 	})
@@ -547,6 +648,7 @@ var _ = Describe("RemoteStore", func() {
 			Expect(err).To(Equal(&errors_types.Error{
 				Title:   "Could not delete resource",
 				Details: "Internal Server Error",
+				Status:  400,
 			}))
 		})
 	})
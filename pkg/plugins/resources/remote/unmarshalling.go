@@ -53,7 +53,7 @@ func Unmarshal(b []byte, res model.Resource) error {
 	res.SetMeta(remoteMeta{
 		Name:             restResource.Meta.Name,
 		Mesh:             restResource.Meta.Mesh,
-		Version:          "",
+		Version:          restResource.Meta.Revision,
 		CreationTime:     restResource.Meta.CreationTime,
 		ModificationTime: restResource.Meta.ModificationTime,
 	})
@@ -75,7 +75,7 @@ func UnmarshalList(b []byte, rs model.ResourceList) error {
 		r.SetMeta(&remoteMeta{
 			Name:             ri.Meta.Name,
 			Mesh:             ri.Meta.Mesh,
-			Version:          "",
+			Version:          ri.Meta.Revision,
 			CreationTime:     ri.Meta.CreationTime,
 			ModificationTime: ri.Meta.ModificationTime,
 		})
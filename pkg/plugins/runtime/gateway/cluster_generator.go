@@ -94,7 +94,7 @@ func (c *ClusterGenerator) GenerateClusters(ctx xds_context.Context, info Gatewa
 
 		loadAssignment, err := ctx.ControlPlane.CLACache.GetCLA(
 			context.Background(),
-			ctx.Mesh.Resource.GetMeta().GetName(),
+			ctx.Mesh.Resource,
 			ctx.Mesh.Hash,
 			cluster,
 			info.Proxy.APIVersion,
@@ -161,6 +161,7 @@ func (c *ClusterGenerator) generateExternalCluster(
 		newClusterBuilder(info.Proxy.APIVersion, protocol, dest).Configure(
 			clusters.ProvidedEndpointCluster(dest.Destination[mesh_proto.ServiceTag], info.Proxy.Dataplane.IsIPv6(), endpoints...),
 			clusters.ClientSideTLS(endpoints),
+			clusters.UpstreamProxyProtocol(endpoints),
 		),
 		identifyingTags,
 	)
@@ -179,7 +180,9 @@ func newClusterBuilder(
 	builder := clusters.NewClusterBuilder(version).Configure(
 		clusters.Timeout(timeout, protocol),
 		clusters.CircuitBreaker(circuitBreakerPolicyFor(dest)),
-		clusters.OutlierDetection(circuitBreakerPolicyFor(dest)),
+		clusters.ConnectionPool(circuitBreakerPolicyFor(dest)),
+		clusters.RetryBudget(retryPolicyFor(dest)),
+		clusters.OutlierDetection(circuitBreakerPolicyFor(dest), protocol),
 		clusters.HealthCheck(protocol, healthCheckPolicyFor(dest)),
 	)
 
@@ -273,6 +276,14 @@ func circuitBreakerPolicyFor(dest *route.Destination) *core_mesh.CircuitBreakerR
 	return nil // TODO(jpeach) default circuit breaker policy
 }
 
+func retryPolicyFor(dest *route.Destination) *core_mesh.RetryResource {
+	if policy, ok := dest.Policies[core_mesh.RetryType]; ok {
+		return policy.(*core_mesh.RetryResource)
+	}
+
+	return nil
+}
+
 func healthCheckPolicyFor(dest *route.Destination) *core_mesh.HealthCheckResource {
 	if policy, ok := dest.Policies[core_mesh.HealthCheckType]; ok {
 		return policy.(*core_mesh.HealthCheckResource)
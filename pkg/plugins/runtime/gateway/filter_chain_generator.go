@@ -13,6 +13,7 @@ import (
 	envoy_config_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_transport_sockets_quic_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
 	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/pkg/errors"
 
@@ -82,6 +83,32 @@ func (g *HTTPSFilterChainGenerator) Generate(
 	ctx xds_context.Context, info GatewayListenerInfo, hosts []GatewayHost,
 ) (
 	*core_xds.ResourceSet, []*envoy_listeners.FilterChainBuilder, error,
+) {
+	return generateHTTPSFilterChains(ctx, info, hosts, false)
+}
+
+// HTTP3FilterChainGenerator generates a filter chain for the QUIC listener
+// of an HTTPS listener with HTTP/3 enabled.
+type HTTP3FilterChainGenerator struct {
+}
+
+func (g *HTTP3FilterChainGenerator) Generate(
+	ctx xds_context.Context, info GatewayListenerInfo, hosts []GatewayHost,
+) (
+	*core_xds.ResourceSet, []*envoy_listeners.FilterChainBuilder, error,
+) {
+	log.V(1).Info("generating filter chain", "protocol", "HTTP3")
+
+	return generateHTTPSFilterChains(ctx, info, hosts, true)
+}
+
+// generateHTTPSFilterChains generates a filter chain for each host. If quic
+// is set, the filter chains terminate QUIC and serve HTTP/3, otherwise they
+// terminate TLS.
+func generateHTTPSFilterChains(
+	ctx xds_context.Context, info GatewayListenerInfo, hosts []GatewayHost, quic bool,
+) (
+	*core_xds.ResourceSet, []*envoy_listeners.FilterChainBuilder, error,
 ) {
 	resources := core_xds.NewResourceSet()
 
@@ -95,9 +122,22 @@ func (g *HTTPSFilterChainGenerator) Generate(
 
 		builder := newFilterChain(ctx.Mesh, info)
 
-		builder.Configure(
-			envoy_listeners.MatchTransportProtocol("tls"),
-		)
+		if quic {
+			builder.Configure(
+				envoy_listeners.MatchTransportProtocol("quic"),
+				envoy_listeners.AddFilterChainConfigurer(
+					envoy_listeners_v3.HttpConnectionManagerMustConfigureFunc(func(hcm *envoy_hcm.HttpConnectionManager) {
+						hcm.CodecType = envoy_hcm.HttpConnectionManager_HTTP3
+						hcm.Http3ProtocolOptions = &envoy_config_core.Http3ProtocolOptions{}
+						hcm.Http2ProtocolOptions = nil
+					}),
+				),
+			)
+		} else {
+			builder.Configure(
+				envoy_listeners.MatchTransportProtocol("tls"),
+			)
+		}
 
 		mode := mesh_proto.MeshGateway_TLS_NONE
 		if host.TLS != nil {
@@ -114,7 +154,7 @@ func (g *HTTPSFilterChainGenerator) Generate(
 
 			// Note that Envoy 1.184 and earlier will only accept 1 SDS reference.
 			for _, cert := range host.TLS.GetCertificates() {
-				secret, err := g.generateCertificateSecret(ctx.Mesh, host, cert)
+				secret, err := generateCertificateSecret(ctx.Mesh, host, cert)
 				if err != nil {
 					return nil, nil, errors.Wrap(err, "failed to generate TLS certificate")
 				}
@@ -141,7 +181,7 @@ func (g *HTTPSFilterChainGenerator) Generate(
 			return nil, nil, errors.Errorf("unsupported TLS mode %q", host.TLS.GetMode())
 
 		case mesh_proto.MeshGateway_TLS_NONE:
-			if !info.Listener.CrossMesh {
+			if !info.Listener.CrossMesh || quic {
 				return nil, nil, errors.Errorf("unsupported TLS mode %q", mode)
 			}
 
@@ -156,7 +196,7 @@ func (g *HTTPSFilterChainGenerator) Generate(
 			var err error
 			// We generate a downstream context using the envoy helpers
 			// and we don't want to match a SAN because it can be any mesh
-			downstream, err = envoy_tls_v3.CreateDownstreamTlsContext(info.Proxy.SecretsTracker.RequestAllInOneCa(), info.Proxy.SecretsTracker.RequestIdentityCert())
+			downstream, err = envoy_tls_v3.CreateDownstreamTlsContext(info.Proxy.SecretsTracker.RequestAllInOneCa(), info.Proxy.SecretsTracker.RequestIdentityCert(), nil)
 			if err != nil {
 				return nil, nil, errors.Wrap(err, "couldn't generate downstream tls context for gateway")
 			}
@@ -169,7 +209,15 @@ func (g *HTTPSFilterChainGenerator) Generate(
 			return nil, nil, errors.Errorf("unsupported TLS mode %q", host.TLS.GetMode())
 		}
 
+		transportSocketName := "envoy.transport_sockets.tls"
 		any, err := util_proto.MarshalAnyDeterministic(downstream)
+		if quic {
+			downstream.CommonTlsContext.AlpnProtocols = []string{"h3"}
+			transportSocketName = "envoy.transport_sockets.quic"
+			any, err = util_proto.MarshalAnyDeterministic(&envoy_extensions_transport_sockets_quic_v3.QuicDownstreamTransport{
+				DownstreamTlsContext: downstream,
+			})
+		}
 		if err != nil {
 			return nil, nil, err
 		}
@@ -178,7 +226,7 @@ func (g *HTTPSFilterChainGenerator) Generate(
 			envoy_listeners.AddFilterChainConfigurer(envoy_listeners_v3.FilterChainMustConfigureFunc(
 				func(chain *envoy_listener.FilterChain) {
 					chain.TransportSocket = &envoy_config_core.TransportSocket{
-						Name: "envoy.transport_sockets.tls",
+						Name: transportSocketName,
 						ConfigType: &envoy_config_core.TransportSocket_TypedConfig{
 							TypedConfig: any,
 						},
@@ -195,7 +243,7 @@ func (g *HTTPSFilterChainGenerator) Generate(
 	return resources, filterChainBuilders, nil
 }
 
-func (g *HTTPSFilterChainGenerator) generateCertificateSecret(
+func generateCertificateSecret(
 	ctx xds_context.MeshContext,
 	host GatewayHost,
 	secret *system_proto.DataSource,
@@ -299,7 +347,8 @@ func newFilterChain(ctx xds_context.MeshContext, info GatewayListenerInfo) *envo
 		// Force the ratelimit filter to always be present. This
 		// is a no-op unless we later add a per-route configuration.
 		envoy_listeners.RateLimit([]*core_mesh.RateLimitResource{nil}),
-		envoy_listeners.DefaultCompressorFilter(),
+		compressorFilter(info.Proxy.Policies.Compression.GetConf()),
+		corsFilter(info.HostInfos),
 		envoy_listeners.Tracing(ctx.GetTracingBackend(info.Proxy.Policies.TrafficTrace), service),
 		// In mesh proxies, the access log is configured on the outbound
 		// listener, which is why we index the Logs slice by destination
@@ -440,3 +489,23 @@ func (g *TCPFilterChainGenerator) Generate(
 
 	return nil, []*envoy_listeners.FilterChainBuilder{builder}, nil
 }
+
+// compressorFilter configures the compression of responses with a matching
+// MeshCompression policy, falling back to the default gzip compressor.
+func compressorFilter(conf *mesh_proto.MeshCompression_Conf) envoy_listeners.FilterChainBuilderOpt {
+	if conf == nil {
+		return envoy_listeners.DefaultCompressorFilter()
+	}
+	return envoy_listeners.Compression(conf)
+}
+
+// corsFilter adds the CORS filter when any host of the listener matches a
+// MeshCORS policy. The policies themselves are set on the virtual hosts.
+func corsFilter(hostInfos []GatewayHostInfo) envoy_listeners.FilterChainBuilderOpt {
+	for _, hostInfo := range hostInfos {
+		if conf := hostInfo.Host.CORS.GetConf(); conf != nil {
+			return envoy_listeners.CORS(conf)
+		}
+	}
+	return envoy_listeners.FilterChainBuilderOptFunc(nil)
+}
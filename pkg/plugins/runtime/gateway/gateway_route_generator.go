@@ -168,6 +168,18 @@ func makeHttpRouteEntry(name string, rule *mesh_proto.MeshGatewayRoute_HttpRoute
 				Port:       r.GetPort(),
 				StripQuery: true,
 			}
+
+			switch p := r.GetPath().GetPath().(type) {
+			case *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplaceFull:
+				entry.Action.Redirect.Path = p.ReplaceFull
+			case *mesh_proto.MeshGatewayRoute_HttpRoute_Filter_Rewrite_ReplacePrefixMatch:
+				entry.Action.Redirect.Prefix = p.ReplacePrefixMatch
+			}
+		} else if d := f.GetDirectResponse(); d != nil {
+			entry.Action.Respond = &route.Response{
+				Status: d.GetStatusCode(),
+				Body:   d.GetBody(),
+			}
 		} else if m := f.GetMirror(); m != nil {
 			entry.Mirror = &route.Mirror{
 				Percentage: m.GetPercentage().GetValue(),
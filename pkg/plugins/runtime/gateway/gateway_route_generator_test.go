@@ -283,6 +283,64 @@ conf:
 `,
 		),
 
+		Entry("should create a redirect route with a path rewrite",
+			"redirect-with-path.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /old
+      filters:
+      - redirect:
+          scheme: https
+          hostname: example.com
+          status_code: 301
+          path:
+            replacePrefixMatch: /new
+    - matches:
+      - path:
+          match: EXACT
+          value: /moved
+      filters:
+      - redirect:
+          scheme: https
+          hostname: example.com
+          status_code: 308
+          path:
+            replaceFull: /elsewhere
+`,
+		),
+
+		Entry("should create a direct response route",
+			"direct-response.yaml", `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      filters:
+      - direct_response:
+          status_code: 503
+          body: under maintenance
+`,
+		),
+
 		Entry("should create a request header rewrite route",
 			"06-gateway-route.yaml", `
 type: MeshGatewayRoute
@@ -1461,6 +1519,59 @@ conf:
     idle_timeout: 115s
     stream_idle_timeout: 116s
     max_stream_duration: 117s
+`,
+		),
+
+		Entry("match CORS policy by listener tags",
+			"27-gateway-route.yaml", `
+type: MeshGateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 8080
+    protocol: HTTP
+    tags:
+      port: http/8080
+  - port: 9080
+    protocol: HTTP
+    tags:
+      port: http/9080
+`, `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`, `
+type: MeshCORS
+mesh: default
+name: echo-cors
+selectors:
+- match:
+    kuma.io/service: gateway-default
+    port: http/8080
+conf:
+  allowOrigins:
+  - regex: https://.*\.example\.com
+  allowMethods:
+  - GET
+  - POST
+  maxAge: 1h
 `,
 		),
 	}
@@ -1611,6 +1722,48 @@ conf:
 		),
 	}
 
+	http3Entries := []TableEntry{
+		Entry("advertises HTTP/3 listener",
+			"http3-gateway-route.yaml", `
+type: MeshGateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - hostname: echo.example.com
+    port: 8443
+    protocol: HTTPS
+    http3: true
+    tls:
+      mode: TERMINATE
+      certificates:
+      - secret: echo-example-com-server-cert
+    tags:
+      port: https/8443
+`, `
+type: MeshGatewayRoute
+mesh: default
+name: echo-service
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  http:
+    rules:
+    - matches:
+      - path:
+          match: PREFIX
+          value: /
+      backends:
+      - destination:
+          kuma.io/service: echo-service
+`,
+		),
+	}
+
 	handleArg := func(arg interface{}) {
 		switch val := arg.(type) {
 		case WithoutResource:
@@ -1676,6 +1829,30 @@ conf:
 		)
 	})
 
+	Context("with a HTTP/3 gateway", func() {
+		JustBeforeEach(func() {
+			Expect(StoreNamedFixture(rt, "secret-https-default.yaml")).To(Succeed())
+		})
+		DescribeTable("generating xDS resources",
+			func(goldenFileName string, fixtureResources ...string) {
+				// given
+				for _, resource := range fixtureResources {
+					Expect(StoreInlineFixture(rt, []byte(resource))).To(Succeed())
+				}
+
+				// when
+				snap, err := Do()
+				Expect(err).To(Succeed())
+
+				// then
+				Expect(yaml.Marshal(MakeProtoSnapshot(snap))).
+					To(matchers.MatchGoldenYAML(path.Join("testdata", "https", goldenFileName)))
+
+			},
+			http3Entries,
+		)
+	})
+
 	Context("with a TCP gateway", func() {
 		DescribeTable("generating xDS resources",
 			func(goldenFileName string, fixtureResources ...string) {
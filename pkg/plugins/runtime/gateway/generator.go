@@ -53,6 +53,8 @@ type GatewayHost struct {
 	TLS      *mesh_proto.MeshGateway_TLS_Conf
 	// Contains MeshGateway, Listener and Dataplane object tags
 	Tags mesh_proto.TagSelector
+	// CORS is the MeshCORS policy matching the listener tags.
+	CORS *core_mesh.MeshCORSResource
 }
 
 type GatewayListener struct {
@@ -62,6 +64,9 @@ type GatewayListener struct {
 	// CrossMesh is important because for generation we need to treat such a
 	// listener as if we have HTTPS with the Mesh cert for this Dataplane
 	CrossMesh bool
+	// HTTP3 means that besides the TCP listener there is a QUIC listener
+	// on the same port serving HTTP/3.
+	HTTP3 bool
 }
 
 // GatewayListenerInfo holds everything needed to generate resources for a
@@ -92,6 +97,9 @@ type Generator struct {
 
 type filterChainGenerators struct {
 	FilterChainGenerators map[mesh_proto.MeshGateway_Listener_Protocol]FilterChainGenerator
+	// HTTP3FilterChainGenerator generates the filter chains of the QUIC
+	// listener of HTTPS listeners with HTTP/3 enabled.
+	HTTP3FilterChainGenerator FilterChainGenerator
 }
 
 func (g *filterChainGenerators) For(ctx xds_context.Context, info GatewayListenerInfo) FilterChainGenerator {
@@ -170,6 +178,11 @@ func GatewayListenerInfoFromProxy(
 					listeners[i].GetProtocol(), listeners[0].GetProtocol(), port,
 				)
 			}
+			if listeners[i].GetHttp3() != listeners[0].GetHttp3() {
+				return nil, errors.Errorf(
+					"cannot collapse listeners with and without HTTP/3 on port %d", port,
+				)
+			}
 		}
 
 		listener, hosts, err := MakeGatewayListener(ctx, gateway, proxy.Dataplane, listeners)
@@ -268,6 +281,39 @@ func (g Generator) generateLDS(ctx xds_context.Context, info GatewayListenerInfo
 	}
 	resources.AddSet(res)
 
+	if info.Listener.HTTP3 {
+		res, err := g.generateHTTP3LDS(ctx, info, gatewayHosts)
+		if err != nil {
+			return nil, err
+		}
+		resources.AddSet(res)
+	}
+
+	return resources, nil
+}
+
+// generateHTTP3LDS generates the QUIC listener serving HTTP/3 next to the
+// TCP listener of the given HTTPS listener.
+func (g Generator) generateHTTP3LDS(ctx xds_context.Context, info GatewayListenerInfo, gatewayHosts []GatewayHost) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+	listenerBuilder := GenerateHTTP3Listener(info)
+
+	res, filterChainBuilders, err := g.FilterChainGenerators.HTTP3FilterChainGenerator.Generate(ctx, info, gatewayHosts)
+	if err != nil {
+		return nil, err
+	}
+	resources.AddSet(res)
+
+	for _, filterChainBuilder := range filterChainBuilders {
+		listenerBuilder.Configure(envoy_listeners.FilterChain(filterChainBuilder))
+	}
+
+	res, err = BuildResourceSet(listenerBuilder)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build HTTP/3 listener resource")
+	}
+	resources.AddSet(res)
+
 	return resources, nil
 }
 
@@ -335,6 +381,7 @@ func MakeGatewayListener(
 			listeners[0].GetPort(),
 		),
 		CrossMesh: listeners[0].CrossMesh,
+		HTTP3:     listeners[0].GetHttp3(),
 	}
 
 	// Hostnames must be unique to a listener to remove ambiguity
@@ -355,6 +402,7 @@ func MakeGatewayListener(
 			Policies: map[model.ResourceType][]match.RankedPolicy{},
 			TLS:      l.GetTls(),
 			Tags:     l.Tags,
+			CORS:     xds_topology.SelectGatewayListenerCORS(l.GetTags(), meshContext.Resources.MeshCORSes().Items),
 		}
 
 		switch listener.Protocol {
@@ -1,6 +1,8 @@
 package gateway
 
 import (
+	"time"
+
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
@@ -16,6 +18,9 @@ import (
 // Buffer defaults.
 const DefaultConnectionBuffer = 32 * 1024
 
+// Alt-Svc defaults.
+const DefaultAltSvcMaxAge = 24 * time.Hour
+
 func SupportsProtocol(p mesh_proto.MeshGateway_Listener_Protocol) bool {
 	switch p {
 	case mesh_proto.MeshGateway_Listener_HTTP,
@@ -63,3 +68,25 @@ func GenerateListener(info GatewayListenerInfo) *envoy_listeners.ListenerBuilder
 			envoy_listeners.TLSInspector(),
 		)
 }
+
+// GenerateHTTP3Listener generates the QUIC listener serving HTTP/3 on the
+// same port as the TCP listener of the given HTTPS listener.
+func GenerateHTTP3Listener(info GatewayListenerInfo) *envoy_listeners.ListenerBuilder {
+	port := info.Listener.Port
+	address := info.Proxy.Dataplane.Spec.GetNetworking().Address
+
+	log.V(1).Info("generating HTTP/3 listener",
+		"address", address,
+		"port", port,
+	)
+
+	return envoy_listeners.NewListenerBuilder(info.Proxy.APIVersion).
+		Configure(
+			envoy_listeners.InboundListener(
+				envoy_names.GetGatewayListenerName(info.Gateway.Meta.GetName(), "HTTP3", port),
+				address, port, core_xds.SocketAddressProtocolUDP),
+			// Limit default buffering for edge connections.
+			envoy_listeners.ConnectionBufferLimit(DefaultConnectionBuffer),
+			envoy_listeners.QUIC(),
+		)
+}
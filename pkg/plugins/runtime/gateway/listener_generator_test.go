@@ -202,6 +202,28 @@ conf:
       name: any-hostname
 `),
 
+		Entry("should generate HTTP/3 listener",
+			"06-gateway-listener.yaml", `
+type: MeshGateway
+mesh: default
+name: default-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 443
+    protocol: HTTPS
+    hostname: foo.example.com
+    http3: true
+    tls:
+      mode: TERMINATE
+      certificates:
+      - secret: server-certificate
+    tags:
+      name: foo.example.com
+`),
+
 		Entry("should generate TCP listener",
 			"tcp-listener.yaml", `
 type: MeshGateway
@@ -227,6 +249,38 @@ conf:
 			Expect(err.Error()).To(ContainSubstring(errMsg))
 		},
 
+		Entry("listeners with and without HTTP/3",
+			"cannot collapse listeners with and without HTTP/3", `
+type: MeshGateway
+mesh: default
+name: edge-gateway
+selectors:
+- match:
+    kuma.io/service: gateway-default
+conf:
+  listeners:
+  - port: 443
+    protocol: HTTPS
+    hostname: foo.example.com
+    http3: true
+    tls:
+      mode: TERMINATE
+      certificates:
+      - secret: server-certificate
+    tags:
+      name: foo.example.com
+  - port: 443
+    protocol: HTTPS
+    hostname: bar.example.com
+    tls:
+      mode: TERMINATE
+      certificates:
+      - secret: server-certificate
+    tags:
+      name: bar.example.com
+`,
+		),
+
 		Entry("incompatible listeners",
 			"cannot collapse listener protocols", `
 type: MeshGateway
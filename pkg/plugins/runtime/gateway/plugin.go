@@ -81,7 +81,9 @@ func NewProxyProfile(zone string) generator_core.ResourceGenerator {
 					mesh_proto.MeshGateway_Listener_HTTP:  &HTTPFilterChainGenerator{},
 					mesh_proto.MeshGateway_Listener_HTTPS: &HTTPSFilterChainGenerator{},
 					mesh_proto.MeshGateway_Listener_TCP:   &TCPFilterChainGenerator{},
-				}},
+				},
+				HTTP3FilterChainGenerator: &HTTP3FilterChainGenerator{},
+			},
 			ClusterGenerator: ClusterGenerator{
 				Zone: zone,
 			},
@@ -304,6 +304,16 @@ func RouteActionRedirect(redirect *Redirection) RouteConfigurer {
 			},
 		}
 
+		if redirect.Path != "" {
+			r.GetRedirect().PathRewriteSpecifier = &envoy_config_route.RedirectAction_PathRedirect{
+				PathRedirect: redirect.Path,
+			}
+		} else if redirect.Prefix != "" {
+			r.GetRedirect().PathRewriteSpecifier = &envoy_config_route.RedirectAction_PrefixRewrite{
+				PrefixRewrite: redirect.Prefix,
+			}
+		}
+
 		switch redirect.Status {
 		case 301:
 			r.GetRedirect().ResponseCode = envoy_config_route.RedirectAction_MOVED_PERMANENTLY
@@ -323,6 +333,35 @@ func RouteActionRedirect(redirect *Redirection) RouteConfigurer {
 	})
 }
 
+// RouteActionDirectResponse configures the route to automatically
+// respond with a fixed HTTP response. This replaces any previous action
+// specification.
+func RouteActionDirectResponse(response *Response) RouteConfigurer {
+	if response == nil {
+		return RouteConfigureFunc(nil)
+	}
+
+	return RouteConfigureFunc(func(r *envoy_config_route.Route) error {
+		action := &envoy_config_route.DirectResponseAction{
+			Status: response.Status,
+		}
+
+		if response.Body != "" {
+			action.Body = &envoy_config_core.DataSource{
+				Specifier: &envoy_config_core.DataSource_InlineString{
+					InlineString: response.Body,
+				},
+			}
+		}
+
+		r.Action = &envoy_config_route.Route_DirectResponse{
+			DirectResponse: action,
+		}
+
+		return nil
+	})
+}
+
 func RouteRewrite(rewrite *Rewrite) RouteConfigurer {
 	if rewrite == nil {
 		return RouteConfigureFunc(nil)
@@ -75,7 +75,7 @@ type Match struct {
 type Action struct {
 	Forward  []Destination
 	Redirect *Redirection
-	Respond  struct{} // TODO(jpeach) add DirectResponseAction support
+	Respond  *Response
 }
 
 // Redirection is an action that responds to a HTTP request with a HTTP
@@ -86,10 +86,18 @@ type Redirection struct {
 	Host   string // URL host (optional).
 	Port   uint32 // URL port (optional).
 	Path   string // URL path (optional).
+	Prefix string // URL path prefix replacement (optional).
 
 	StripQuery bool // Whether to strip the query string.
 }
 
+// Response is an action that responds to a HTTP request with a fixed
+// HTTP response.
+type Response struct {
+	Status uint32 // HTTP status code.
+	Body   string // Response body (optional).
+}
+
 // Destination is a forwarding target (aka Cluster).
 type Destination struct {
 	Destination   envoy.Tags
@@ -1,6 +1,7 @@
 package gateway
 
 import (
+	"fmt"
 	"sort"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -36,6 +37,18 @@ func GenerateVirtualHost(
 		)
 	}
 
+	// Advertise the HTTP/3 listener on the same port.
+	if info.Listener.HTTP3 {
+		vh.Configure(
+			envoy_routes.SetResponseHeader(
+				"Alt-Svc",
+				fmt.Sprintf(`h3=":%d"; ma=%d`, info.Listener.Port, int(DefaultAltSvcMaxAge.Seconds())),
+			),
+		)
+	}
+
+	vh.Configure(envoy_routes.CORS(host.CORS.GetConf()))
+
 	// TODO(jpeach) match the FaultInjection policy for this virtual host.
 
 	// TODO(jpeach) apply additional virtual host configuration.
@@ -53,6 +66,7 @@ func GenerateVirtualHost(
 			route.RouteMatchExactHeader(":method", e.Match.Method),
 
 			route.RouteActionRedirect(e.Action.Redirect),
+			route.RouteActionDirectResponse(e.Action.Respond),
 			route.RouteActionForward(ctx.Mesh.Resource, info.OutboundEndpoints, info.Proxy.Dataplane.Spec.TagSet(), e.Action.Forward),
 		)
 
@@ -31,10 +31,8 @@ import (
 	"github.com/kumahq/kuma/pkg/test"
 	test_runtime "github.com/kumahq/kuma/pkg/test/runtime"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
-	"github.com/kumahq/kuma/pkg/xds/cache/cla"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	"github.com/kumahq/kuma/pkg/xds/envoy"
-	"github.com/kumahq/kuma/pkg/xds/secrets"
 	"github.com/kumahq/kuma/pkg/xds/server"
 	"github.com/kumahq/kuma/pkg/xds/sync"
 )
@@ -106,22 +104,6 @@ func MakeGeneratorContext(rt runtime.Runtime, key core_model.ResourceKey) (*xds_
 		APIVersion:      envoy.APIV3,
 	}
 
-	cache, err := cla.NewCache(rt.Config().Store.Cache.ExpirationTime, rt.Metrics())
-	Expect(err).To(Succeed())
-
-	idProvider, err := secrets.NewIdentityProvider(rt.CaManagers(), rt.Metrics())
-	Expect(err).To(Succeed())
-
-	secrets, err := secrets.NewSecrets(
-		rt.CAProvider(),
-		idProvider,
-		rt.Metrics(),
-	)
-	Expect(err).To(Succeed())
-
-	control, err := xds_context.BuildControlPlaneContext(cache, secrets, rt.Config().Multizone.Zone.Name)
-	Expect(err).To(Succeed())
-
 	meshCtxBuilder := xds_context.NewMeshContextBuilder(
 		rt.ReadOnlyResourceManager(),
 		server.MeshResourceTypes(server.HashMeshExcludedResources),
@@ -135,7 +117,7 @@ func MakeGeneratorContext(rt runtime.Runtime, key core_model.ResourceKey) (*xds_
 	Expect(err).To(Succeed())
 
 	ctx := xds_context.Context{
-		ControlPlane: control,
+		ControlPlane: rt.XDSControlPlaneContext(),
 		Mesh:         meshCtx,
 	}
 
@@ -3,6 +3,7 @@ package containers
 import (
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	kube_core "k8s.io/api/core/v1"
@@ -240,6 +241,35 @@ func (i *DataplaneProxyFactory) sidecarEnvVars(mesh string, podAnnotations map[s
 			Value: logLevel,
 		}
 	}
+	envoyRuntime, err := metadata.Annotations(podAnnotations).GetMap(metadata.KumaEnvoyRuntime)
+	if err != nil {
+		return nil, err
+	}
+	if len(envoyRuntime) != 0 {
+		var pairs []string
+		for key, value := range envoyRuntime {
+			pairs = append(pairs, key+":"+value)
+		}
+		sort.Strings(pairs)
+		envVars["KUMA_DATAPLANE_RUNTIME_ENVOY_RUNTIME"] = kube_core.EnvVar{
+			Name:  "KUMA_DATAPLANE_RUNTIME_ENVOY_RUNTIME",
+			Value: strings.Join(pairs, ","),
+		}
+	}
+	// values are validated by kuma-dp when it loads the configuration
+	for annotation, envName := range map[string]string{
+		metadata.KumaEnvoyStatsFlushInterval:                     "KUMA_DATAPLANE_RUNTIME_ENVOY_STATS_FLUSH_INTERVAL",
+		metadata.KumaEnvoyOverloadMaxHeapSizeBytes:               "KUMA_DATAPLANE_RUNTIME_ENVOY_OVERLOAD_MANAGER_MAX_HEAP_SIZE_BYTES",
+		metadata.KumaEnvoyOverloadShrinkHeapThreshold:            "KUMA_DATAPLANE_RUNTIME_ENVOY_OVERLOAD_MANAGER_SHRINK_HEAP_THRESHOLD",
+		metadata.KumaEnvoyOverloadStopAcceptingRequestsThreshold: "KUMA_DATAPLANE_RUNTIME_ENVOY_OVERLOAD_MANAGER_STOP_ACCEPTING_REQUESTS_THRESHOLD",
+	} {
+		if value, exist := metadata.Annotations(podAnnotations).GetString(annotation); exist {
+			envVars[envName] = kube_core.EnvVar{
+				Name:  envName,
+				Value: value,
+			}
+		}
+	}
 
 	// override defaults with cfg env vars
 	for envName, envVal := range i.ContainerConfig.EnvVars {
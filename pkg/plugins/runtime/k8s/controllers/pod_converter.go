@@ -119,6 +119,13 @@ func (p *PodConverter) dataplaneFor(
 			reachableServices = strings.Split(reachableServicesRaw, ",")
 			dataplane.Networking.TransparentProxying.ReachableServices = reachableServices
 		}
+		passthrough, exist, err := annotations.GetEnabled(metadata.KumaTransparentProxyingPassthroughAnnotation)
+		if err != nil {
+			return nil, err
+		}
+		if exist && !passthrough {
+			dataplane.Networking.TransparentProxying.DisablePassthrough = true
+		}
 	}
 
 	dataplane.Networking.Address = pod.Status.PodIP
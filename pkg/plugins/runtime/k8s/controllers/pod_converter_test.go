@@ -251,6 +251,11 @@ var _ = Describe("PodToDataplane(..)", func() {
 			servicesForPod: "19.services-for-pod.yaml",
 			dataplane:      "19.dataplane.yaml",
 		}),
+		Entry("20. Pod with transparent proxy and passthrough disabled", testCase{
+			pod:            "20.pod.yaml",
+			servicesForPod: "20.services-for-pod.yaml",
+			dataplane:      "20.dataplane.yaml",
+		}),
 	)
 
 	DescribeTable("should convert Ingress Pod into an Ingress Dataplane YAML version",
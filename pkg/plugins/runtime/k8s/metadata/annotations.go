@@ -92,6 +92,18 @@ const (
 	// Available values are: [trace][debug][info][warning|warn][error][critical][off]
 	KumaEnvoyLogLevel = "kuma.io/envoy-log-level"
 
+	// KumaEnvoyRuntime is a ; separated list of Envoy runtime key/values put into a runtime layer on top of the defaults.
+	// Example value: overload.global_downstream_max_connections=1000;re2.max_program_size.error_level=200
+	KumaEnvoyRuntime = "kuma.io/envoy-runtime"
+	// KumaEnvoyStatsFlushInterval allows to specify an interval between flushes of Envoy stats to the sinks.
+	KumaEnvoyStatsFlushInterval = "kuma.io/envoy-stats-flush-interval"
+	// KumaEnvoyOverloadMaxHeapSizeBytes enables Envoy overload manager with the given heap size.
+	KumaEnvoyOverloadMaxHeapSizeBytes = "kuma.io/envoy-overload-max-heap-size-bytes"
+	// KumaEnvoyOverloadShrinkHeapThreshold allows to specify a fraction of the max heap size on which Envoy releases free memory.
+	KumaEnvoyOverloadShrinkHeapThreshold = "kuma.io/envoy-overload-shrink-heap-threshold"
+	// KumaEnvoyOverloadStopAcceptingRequestsThreshold allows to specify a fraction of the max heap size on which Envoy stops accepting requests.
+	KumaEnvoyOverloadStopAcceptingRequestsThreshold = "kuma.io/envoy-overload-stop-accepting-requests-threshold"
+
 	// KumaMetricsPrometheusAggregatePath allows to specify which path for specific app should request for metrics
 	KumaMetricsPrometheusAggregatePath = "prometheus.metrics.kuma.io/aggregate-%s-path"
 	// KumaMetricsPrometheusAggregatePort allows to specify which port for specific app should request for metrics
@@ -113,6 +125,7 @@ const (
 	KumaTransparentProxyingInboundPortAnnotationV6     = "kuma.io/transparent-proxying-inbound-v6-port"
 	KumaTransparentProxyingOutboundPortAnnotation      = "kuma.io/transparent-proxying-outbound-port"
 	KumaTransparentProxyingReachableServicesAnnotation = "kuma.io/transparent-proxying-reachable-services"
+	KumaTransparentProxyingPassthroughAnnotation       = "kuma.io/transparent-proxying-passthrough"
 	CNCFNetworkAnnotation                              = "k8s.v1.cni.cncf.io/networks"
 	KumaCNI                                            = "kuma-cni"
 )
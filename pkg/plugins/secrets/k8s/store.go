@@ -16,6 +16,7 @@ import (
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
 	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
+	"github.com/kumahq/kuma/pkg/events"
 	common_k8s "github.com/kumahq/kuma/pkg/plugins/common/k8s"
 	"github.com/kumahq/kuma/pkg/plugins/runtime/k8s/metadata"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
@@ -292,3 +293,8 @@ func (c *SimpleConverter) ToCoreList(in *kube_core.SecretList, out core_model.Re
 	}
 	return nil
 }
+
+// Watch is not supported, changes of the secrets are delivered by the informers of the Kubernetes client.
+func (s *KubernetesStore) Watch(context.Context, ...core_store.WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return nil, core_store.ErrorWatchNotSupported
+}
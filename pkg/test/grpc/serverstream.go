@@ -39,14 +39,32 @@ func (stream *MockServerStream) ClientStream(stopCh chan struct{}) *MockClientSt
 	sentCh := make(chan *envoy_sd.DiscoveryRequest)
 	recvCh := make(chan *envoy_sd.DiscoveryResponse)
 	go func() {
+		// requests are queued without a limit, so the client never blocks on Send. Otherwise, when the client sends
+		// more requests than fit the buffers before it receives responses, the server blocks on sending the responses
+		// and stops receiving the requests, which deadlocks both sides.
+		var queue []*envoy_sd.DiscoveryRequest
+		in := sentCh
 		for {
-			r, more := <-sentCh
-			if more {
-				stream.RecvCh <- r
-			} else {
+			if in == nil && len(queue) == 0 {
 				close(stream.RecvCh)
 				return
 			}
+			var out chan *envoy_sd.DiscoveryRequest
+			var next *envoy_sd.DiscoveryRequest
+			if len(queue) > 0 {
+				out = stream.RecvCh
+				next = queue[0]
+			}
+			select {
+			case r, more := <-in:
+				if more {
+					queue = append(queue, r)
+				} else {
+					in = nil
+				}
+			case out <- next:
+				queue = queue[1:]
+			}
 		}
 	}()
 	go func() {
@@ -236,6 +236,134 @@ var (
 			Backend: "tracing-backend",
 		},
 	}
+	LocalReply = &mesh_proto.LocalReply{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.LocalReply_Conf{
+			Mappers: []*mesh_proto.LocalReply_Conf_Mapper{{
+				StatusCodes: []uint32{503},
+				Body:        "service unavailable",
+			}},
+		},
+	}
+	MeshHeaderModifier = &mesh_proto.MeshHeaderModifier{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.MeshHeaderModifier_Conf{
+			Inbound: &mesh_proto.MeshHeaderModifier_Conf_Modifications{
+				RequestHeaders: &mesh_proto.MeshHeaderModifier_Conf_Headers{
+					Remove: []string{"x-internal"},
+				},
+			},
+		},
+	}
+	MeshBandwidthLimit = &mesh_proto.MeshBandwidthLimit{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.MeshBandwidthLimit_Conf{
+			Inbound: &mesh_proto.MeshBandwidthLimit_Conf_Limit{
+				BytesPerSecond: 1048576,
+			},
+		},
+	}
+	MeshCORS = &mesh_proto.MeshCORS{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.MeshCORS_Conf{
+			AllowOrigins: []*mesh_proto.MeshCORS_Conf_Origin{{
+				Match: &mesh_proto.MeshCORS_Conf_Origin_Exact{Exact: "https://example.com"},
+			}},
+		},
+	}
+	MeshCompression = &mesh_proto.MeshCompression{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.MeshCompression_Conf{
+			Libraries: []mesh_proto.MeshCompression_Conf_Library{mesh_proto.MeshCompression_Conf_GZIP},
+		},
+	}
+	MeshExternalAuthz = &mesh_proto.MeshExternalAuthz{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.MeshExternalAuthz_Conf{
+			Service: &mesh_proto.MeshExternalAuthz_Conf_Grpc{
+				Grpc: &mesh_proto.MeshExternalAuthz_Conf_GrpcService{
+					Address: "opa.authz.svc:9191",
+				},
+			},
+		},
+	}
+	MeshJWT = &mesh_proto.MeshJWT{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.MeshJWT_Conf{
+			Providers: []*mesh_proto.MeshJWT_Conf_Provider{{
+				Name: "auth0",
+				Jwks: &mesh_proto.MeshJWT_Conf_Provider_RemoteJwks_{
+					RemoteJwks: &mesh_proto.MeshJWT_Conf_Provider_RemoteJwks{
+						Url: "https://example.auth0.com/.well-known/jwks.json",
+					},
+				},
+			}},
+		},
+	}
+	MeshProtocolOptions = &mesh_proto.MeshProtocolOptions{
+		Sources: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Destinations: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.MeshProtocolOptions_Conf{
+			MaxRequestsPerConnection: util_proto.UInt32(100),
+		},
+	}
+	MeshRequestProtection = &mesh_proto.MeshRequestProtection{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.MeshRequestProtection_Conf{
+			MaxRequestBytes: util_proto.UInt32(1024),
+		},
+	}
+	MeshWasmPlugin = &mesh_proto.MeshWasmPlugin{
+		Selectors: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.MeshWasmPlugin_Conf{
+			Inbound: []*mesh_proto.MeshWasmPlugin_Conf_Plugin{{
+				Name: "auth",
+				Module: &mesh_proto.MeshWasmPlugin_Conf_Plugin_Module{
+					Source: &mesh_proto.MeshWasmPlugin_Conf_Plugin_Module_Local{
+						Local: &system_proto.DataSource{
+							Type: &system_proto.DataSource_Secret{Secret: "auth-wasm"},
+						},
+					},
+				},
+			}},
+		},
+	}
+	MeshZoneTrafficRoute = &mesh_proto.MeshZoneTrafficRoute{
+		Sources: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Destinations: []*mesh_proto.Selector{{
+			Match: map[string]string{mesh_proto.ServiceTag: "*"},
+		}},
+		Conf: &mesh_proto.MeshZoneTrafficRoute_Conf{
+			Zones: []*mesh_proto.MeshZoneTrafficRoute_Conf_Zone{{
+				Name:     "zone-1",
+				Priority: 1,
+			}},
+		},
+	}
 	ProxyTemplate = &mesh_proto.ProxyTemplate{
 		Selectors: []*mesh_proto.Selector{{
 			Match: map[string]string{mesh_proto.ServiceTag: "*"},
@@ -302,6 +430,20 @@ var (
 	Config = &system_proto.Config{
 		Config: "sample config",
 	}
+	AccessRole = &system_proto.AccessRole{
+		Rules: []*system_proto.AccessRole_Rule{{
+			Types:  []string{"TrafficPermission"},
+			Meshes: []string{"mesh-1"},
+			Access: []system_proto.AccessRole_Access{system_proto.AccessRole_READ},
+		}},
+	}
+	AccessRoleBinding = &system_proto.AccessRoleBinding{
+		Subjects: []*system_proto.AccessRoleBinding_Subject{{
+			Type: "Group",
+			Name: "team-a",
+		}},
+		Roles: []string{"ar-1"},
+	}
 	RateLimit = &mesh_proto.RateLimit{
 		Sources: []*mesh_proto.Selector{{
 			Match: map[string]string{
@@ -31,6 +31,18 @@ func DiscoveryRequest(node *envoy_core.Node, resourceType model.ResourceType) Ex
 	}
 }
 
+// ResumeDiscoveryRequest is the initial DiscoveryRequest of the client that already has the resources of the version.
+func ResumeDiscoveryRequest(node *envoy_core.Node, resourceType model.ResourceType, version string) Executable {
+	return func(tc TestContext) error {
+		tc.ServerStream().RecvCh <- &envoy_sd.DiscoveryRequest{
+			Node:        node,
+			TypeUrl:     string(resourceType),
+			VersionInfo: version,
+		}
+		return nil
+	}
+}
+
 func ACK(node *envoy_core.Node, resourceType model.ResourceType) Executable {
 	return func(tc TestContext) error {
 		tc.ServerStream().RecvCh <- &envoy_sd.DiscoveryRequest{
@@ -0,0 +1,168 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	KeyID        = "test-key"
+	ClientID     = "kumactl"
+	DeviceCode   = "device-code"
+	UserCode     = "ABCD-EFGH"
+	RefreshToken = "refresh-token"
+)
+
+// Provider is an OpenID Provider for tests. It issues ID tokens signed with a generated RSA key
+// with the device authorization grant and the refresh token grant.
+type Provider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	sync.Mutex
+	// Claims are the claims of the issued ID tokens.
+	Claims jwt.MapClaims
+	// PendingPolls is how many times the device authorization is pending before the tokens are issued.
+	PendingPolls int
+	// JwksRequests is the number of requests to the key set.
+	JwksRequests int
+}
+
+func NewProvider() (*Provider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	p := &Provider{
+		key:    key,
+		Claims: jwt.MapClaims{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("/jwks", p.handleJwks)
+	mux.HandleFunc("/device", p.handleDevice)
+	mux.HandleFunc("/token", p.handleToken)
+	p.server = httptest.NewServer(mux)
+	return p, nil
+}
+
+func (p *Provider) Issuer() string {
+	return p.server.URL
+}
+
+func (p *Provider) Close() {
+	p.server.Close()
+}
+
+// Sign returns the ID token with the claims signed by the key of the provider.
+func (p *Provider) Sign(claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = KeyID
+	signed, err := token.SignedString(p.key)
+	if err != nil {
+		panic(err)
+	}
+	return signed
+}
+
+// IDToken returns a valid ID token with the subject and the claims of the provider.
+func (p *Provider) IDToken(subject string, validFor time.Duration) string {
+	p.Lock()
+	defer p.Unlock()
+	claims := jwt.MapClaims{
+		"iss": p.Issuer(),
+		"aud": ClientID,
+		"sub": subject,
+		"exp": time.Now().Add(validFor).Unix(),
+	}
+	for k, v := range p.Claims {
+		claims[k] = v
+	}
+	return p.Sign(claims)
+}
+
+func (p *Provider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{
+		"issuer":                        p.Issuer(),
+		"jwks_uri":                      p.Issuer() + "/jwks",
+		"token_endpoint":                p.Issuer() + "/token",
+		"device_authorization_endpoint": p.Issuer() + "/device",
+	})
+}
+
+func (p *Provider) handleJwks(w http.ResponseWriter, _ *http.Request) {
+	p.Lock()
+	p.JwksRequests++
+	p.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": KeyID,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (p *Provider) handleDevice(w http.ResponseWriter, r *http.Request) {
+	if r.PostFormValue("client_id") != ClientID {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_client"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"device_code":               DeviceCode,
+		"user_code":                 UserCode,
+		"verification_uri":          p.Issuer() + "/activate",
+		"verification_uri_complete": p.Issuer() + "/activate?user_code=" + UserCode,
+		"expires_in":                60,
+		"interval":                  1,
+	})
+}
+
+func (p *Provider) handleToken(w http.ResponseWriter, r *http.Request) {
+	switch r.PostFormValue("grant_type") {
+	case "urn:ietf:params:oauth:grant-type:device_code":
+		if r.PostFormValue("device_code") != DeviceCode {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		p.Lock()
+		pending := p.PendingPolls > 0
+		if pending {
+			p.PendingPolls--
+		}
+		p.Unlock()
+		if pending {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "authorization_pending"})
+			return
+		}
+	case "refresh_token":
+		if r.PostFormValue("refresh_token") != RefreshToken {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "refresh token is not valid"})
+			return
+		}
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{
+		"id_token":      p.IDToken("john.doe", time.Hour),
+		"refresh_token": RefreshToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
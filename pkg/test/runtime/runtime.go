@@ -3,13 +3,22 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
 	"time"
 
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/pkg/api-server/customization"
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
+	"github.com/kumahq/kuma/pkg/core/audit"
+	audit_access "github.com/kumahq/kuma/pkg/core/audit/access"
 	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
 	"github.com/kumahq/kuma/pkg/core/datasource"
 	"github.com/kumahq/kuma/pkg/core/managers/apis/dataplane"
@@ -26,14 +35,19 @@ import (
 	secret_manager "github.com/kumahq/kuma/pkg/core/secrets/manager"
 	secret_store "github.com/kumahq/kuma/pkg/core/secrets/store"
 	"github.com/kumahq/kuma/pkg/dp-server/server"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+	"github.com/kumahq/kuma/pkg/envoy/admin/tunnel"
 	"github.com/kumahq/kuma/pkg/events"
 	kds_context "github.com/kumahq/kuma/pkg/kds/context"
+	"github.com/kumahq/kuma/pkg/keys"
 	"github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/plugins/authn/api-server/certs"
 	"github.com/kumahq/kuma/pkg/plugins/ca/builtin"
 	leader_memory "github.com/kumahq/kuma/pkg/plugins/leader/memory"
 	resources_memory "github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	tokens_access "github.com/kumahq/kuma/pkg/tokens/builtin/access"
+	"github.com/kumahq/kuma/pkg/xds/cache/cla"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
 	"github.com/kumahq/kuma/pkg/xds/secrets"
 )
@@ -85,27 +99,52 @@ func BuilderFor(appCtx context.Context, cfg kuma_cp.Config) (*core_runtime.Build
 	builder.WithMetrics(metrics)
 
 	builder.WithDataSourceLoader(datasource.NewDataSourceLoader(builder.ResourceManager()))
+	keyProvider, err := keys.NewProvider(*cfg.KeyProvider)
+	if err != nil {
+		return nil, err
+	}
+	builder.WithKeyProvider(keyProvider)
 	builder.WithCaManager("builtin", builtin.NewBuiltinCaManager(builder.ResourceManager()))
 	builder.WithLeaderInfo(&component.LeaderInfoComponent{})
 	builder.WithLookupIP(func(s string) ([]net.IP, error) {
 		return nil, errors.New("LookupIP not set, set one in your test to resolve things")
 	})
 	builder.WithEnvoyAdminClient(&DummyEnvoyAdminClient{})
+	builder.WithEnvoyAdminTunnels(tunnel.NewTunnels())
 	builder.WithEventReaderFactory(events.NewEventBus())
 	builder.WithAPIManager(customization.NewAPIList())
 	builder.WithXDSHooks(&xds_hooks.Hooks{})
 	builder.WithDpServer(server.NewDpServer(*cfg.DpServer, metrics))
-	builder.WithKDSContext(kds_context.DefaultContext(builder.ResourceManager(), cfg.Multizone.Zone.Name))
-	caProvider, err := secrets.NewCaProvider(builder.CaManagers(), metrics)
+	builder.WithKDSContext(kds_context.DefaultContext(builder.ReadOnlyResourceManager(), cfg.Multizone.Zone.Name))
+	caProvider, err := secrets.NewCaProvider(builder.CaManagers(), builder.DataSourceLoader(), metrics)
 	if err != nil {
 		return nil, err
 	}
 	builder.WithCAProvider(caProvider)
+	claCache, err := cla.NewCache(cfg.Store.Cache.ExpirationTime, metrics)
+	if err != nil {
+		return nil, err
+	}
+	idProvider, err := secrets.NewIdentityProvider(builder.CaManagers(), metrics)
+	if err != nil {
+		return nil, err
+	}
+	xdsSecrets, err := secrets.NewSecrets(caProvider, idProvider, metrics)
+	if err != nil {
+		return nil, err
+	}
+	cpCtx, err := xds_context.BuildControlPlaneContext(claCache, xdsSecrets, cfg.Multizone.Zone.Name, cfg.Experimental.EnvoyTap)
+	if err != nil {
+		return nil, err
+	}
+	builder.WithXDSControlPlaneContext(cpCtx)
 	builder.WithAPIServerAuthenticator(certs.ClientCertAuthenticator)
 	builder.WithAccess(core_runtime.Access{
 		ResourceAccess:       resources_access.NewAdminResourceAccess(builder.Config().Access.Static.AdminResources),
 		DataplaneTokenAccess: tokens_access.NewStaticGenerateDataplaneTokenAccess(builder.Config().Access.Static.GenerateDPToken),
+		AuditLogAccess:       audit_access.NewStaticAuditLogAccess(builder.Config().Access.Static.ViewAuditLog),
 	})
+	builder.WithAuditLog(audit.NewMemoryLog(0, 0))
 
 	initializeConfigManager(builder)
 
@@ -138,13 +177,20 @@ func newResourceManager(builder *core_runtime.Builder) core_manager.Customizable
 
 type DummyEnvoyAdminClient struct {
 	PostQuitCalled *int
+	DrainCalled    *int
 }
 
-func (d *DummyEnvoyAdminClient) Stats(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+func (d *DummyEnvoyAdminClient) Stats(ctx context.Context, proxy core_model.ResourceWithAddress, opts admin.StatsOpts) ([]byte, error) {
+	if opts.Format == admin.StatsFormatJSON {
+		return []byte(`{"stats": [{"name": "server.live", "value": 1}]}`), nil
+	}
 	return []byte("server.live: 1\n"), nil
 }
 
-func (d *DummyEnvoyAdminClient) Clusters(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+func (d *DummyEnvoyAdminClient) Clusters(ctx context.Context, proxy core_model.ResourceWithAddress, opts admin.ClustersOpts) ([]byte, error) {
+	if opts.Format == admin.ClustersFormatJSON {
+		return []byte(`{"cluster_statuses": [{"name": "kuma:envoy:admin"}]}`), nil
+	}
 	return []byte("kuma:envoy:admin\n"), nil
 }
 
@@ -160,6 +206,91 @@ func (d *DummyEnvoyAdminClient) PostQuit(ctx context.Context, dataplane *core_me
 	return nil
 }
 
-func (d *DummyEnvoyAdminClient) ConfigDump(ctx context.Context, proxy core_model.ResourceWithAddress) ([]byte, error) {
+func (d *DummyEnvoyAdminClient) ConfigDump(ctx context.Context, proxy core_model.ResourceWithAddress, opts admin.ConfigDumpOpts) ([]byte, error) {
 	return []byte(fmt.Sprintf(`{"envoyAdminAddress": "%s"}`, proxy.AdminAddress(9901))), nil
 }
+
+func (d *DummyEnvoyAdminClient) Listeners(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Listeners, error) {
+	return &envoy_admin_v3.Listeners{
+		ListenerStatuses: []*envoy_admin_v3.ListenerStatus{{
+			Name: "inbound:127.0.0.1:8080",
+			LocalAddress: &envoy_config_core_v3.Address{
+				Address: &envoy_config_core_v3.Address_SocketAddress{
+					SocketAddress: &envoy_config_core_v3.SocketAddress{
+						Address:       "127.0.0.1",
+						PortSpecifier: &envoy_config_core_v3.SocketAddress_PortValue{PortValue: 8080},
+					},
+				},
+			},
+		}},
+	}, nil
+}
+
+func (d *DummyEnvoyAdminClient) Certs(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Certificates, error) {
+	return &envoy_admin_v3.Certificates{
+		Certificates: []*envoy_admin_v3.Certificate{{
+			CertChain: []*envoy_admin_v3.CertificateDetails{{
+				Path:                "<inline>",
+				SerialNumber:        "1",
+				DaysUntilExpiration: 1,
+				SubjectAltNames: []*envoy_admin_v3.SubjectAlternateName{{
+					Name: &envoy_admin_v3.SubjectAlternateName_Uri{Uri: "spiffe://default/backend"},
+				}},
+			}},
+		}},
+	}, nil
+}
+
+func (d *DummyEnvoyAdminClient) Runtime(ctx context.Context, proxy core_model.ResourceWithAddress) (*admin.Runtime, error) {
+	return &admin.Runtime{
+		Layers: []string{"static"},
+		Entries: map[string]admin.RuntimeEntry{
+			"re2.max_program_size.error_level": {FinalValue: "1000", LayerValues: []string{"1000"}},
+		},
+	}, nil
+}
+
+func (d *DummyEnvoyAdminClient) ServerInfo(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.ServerInfo, error) {
+	return &envoy_admin_v3.ServerInfo{
+		Version: "1.22.0",
+		State:   envoy_admin_v3.ServerInfo_LIVE,
+	}, nil
+}
+
+func (d *DummyEnvoyAdminClient) Drain(ctx context.Context, dataplane *core_mesh.DataplaneResource, graceful bool) error {
+	if d.DrainCalled != nil {
+		*d.DrainCalled++
+	}
+
+	return nil
+}
+
+func (d *DummyEnvoyAdminClient) MemoryStats(ctx context.Context, proxy core_model.ResourceWithAddress) (*envoy_admin_v3.Memory, error) {
+	return &envoy_admin_v3.Memory{
+		Allocated:        1024,
+		HeapSize:         2048,
+		PageheapUnmapped: 512,
+	}, nil
+}
+
+func (d *DummyEnvoyAdminClient) TailLogs(ctx context.Context, proxy core_model.ResourceWithAddress, opts admin.TailLogsOpts) (io.ReadCloser, error) {
+	// captured requests echo the matched headers, so tests can verify the options
+	var names []string
+	for name := range opts.RequestHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var headers []string
+	for _, name := range names {
+		headers = append(headers, fmt.Sprintf(`{"key": %q, "value": %q}`, name, opts.RequestHeaders[name]))
+	}
+	return io.NopCloser(strings.NewReader(fmt.Sprintf(`{"http_buffered_trace": {"request": {"headers": [%s]}}}`, strings.Join(headers, ", ")))), nil
+}
+
+func (d *DummyEnvoyAdminClient) Forward(ctx context.Context, proxy core_model.ResourceWithAddress, method, path string, query url.Values, body io.Reader) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/plain"}},
+		Body:       io.NopCloser(strings.NewReader(fmt.Sprintf("%s %s?%s\n", method, path, query.Encode()))),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	etcd_config "github.com/kumahq/kuma/pkg/config/plugins/resources/etcd"
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+type EtcdContainer struct {
+	container testcontainers.Container
+}
+
+func (v *EtcdContainer) Start() error {
+	req := testcontainers.ContainerRequest{
+		Image: "quay.io/coreos/etcd:v3.5.4",
+		Cmd: []string{
+			"etcd",
+			"--listen-client-urls", "http://0.0.0.0:2379",
+			"--advertise-client-urls", "http://0.0.0.0:2379",
+		},
+		ExposedPorts: []string{"2379/tcp"},
+		WaitingFor:   wait.ForListeningPort("2379"),
+	}
+	c, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return err
+	}
+	v.container = c
+	return nil
+}
+
+func (v *EtcdContainer) Stop() error {
+	if v.container != nil {
+		return v.container.Terminate(context.Background())
+	}
+	return nil
+}
+
+// Config returns the config of the store with a random prefix, so every store is isolated.
+func (v *EtcdContainer) Config() (*etcd_config.EtcdStoreConfig, error) {
+	cfg := etcd_config.DefaultEtcdStoreConfig()
+	ip, err := v.container.Host(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	port, err := v.container.MappedPort(context.Background(), "2379")
+	if err != nil {
+		return nil, err
+	}
+	cfg.Endpoints = []string{fmt.Sprintf("%s:%d", ip, port.Int())}
+	cfg.Prefix = fmt.Sprintf("/kuma-%s", strings.ReplaceAll(core.NewUUID(), "-", ""))
+	return cfg, nil
+}
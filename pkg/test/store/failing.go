@@ -5,6 +5,7 @@ import (
 
 	"github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
 )
 
 type FailingStore struct {
@@ -32,3 +33,7 @@ func (f *FailingStore) Get(context.Context, model.Resource, ...core_store.GetOpt
 func (f *FailingStore) List(context.Context, model.ResourceList, ...core_store.ListOptionsFunc) error {
 	return f.Err
 }
+
+func (f *FailingStore) Watch(context.Context, ...core_store.WatchOptionsFunc) (<-chan events.ResourceChangedEvent, error) {
+	return nil, f.Err
+}
@@ -9,7 +9,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/events"
 	resources_k8s "github.com/kumahq/kuma/pkg/plugins/resources/k8s"
 	sample_proto "github.com/kumahq/kuma/pkg/test/apis/sample/v1alpha1"
 	. "github.com/kumahq/kuma/pkg/test/matchers"
@@ -21,9 +23,12 @@ func ExecuteStoreTests(
 ) {
 	const mesh = "default-mesh"
 	var s store.ClosableResourceStore
+	var txs store.Transactions
 
 	BeforeEach(func() {
-		s = store.NewStrictResourceStore(store.NewPaginationStore(createStore()))
+		rs := createStore()
+		s = store.NewStrictResourceStore(store.NewPaginationStore(rs))
+		txs, _ = rs.(store.Transactions)
 	})
 
 	AfterEach(func() {
@@ -433,4 +438,118 @@ func ExecuteStoreTests(
 			})
 		})
 	})
+
+	Describe("Watch()", func() {
+		It("should stream changes of resources", func() {
+			// given
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			changes, err := s.Watch(ctx, store.WatchByType(sample_model.TrafficRouteType))
+			if store.IsWatchNotSupported(err) {
+				Skip("watch is not supported by the store")
+			}
+			Expect(err).ToNot(HaveOccurred())
+			key := model.ResourceKey{Mesh: mesh, Name: "watched.demo"}
+
+			// when
+			resource := createResource(key.Name)
+			resource.Spec.Path = "new-path"
+			Expect(s.Update(context.Background(), resource)).To(Succeed())
+			Expect(s.Delete(context.Background(), resource, store.DeleteByKey(key.Name, key.Mesh))).To(Succeed())
+
+			// then
+			for _, op := range []events.Op{events.Create, events.Update, events.Delete} {
+				Eventually(changes, "5s").Should(Receive(Equal(events.ResourceChangedEvent{
+					Operation: op,
+					Type:      sample_model.TrafficRouteType,
+					Key:       key,
+				})))
+			}
+		})
+
+		It("should close the watch when the context is done", func() {
+			// given
+			ctx, cancel := context.WithCancel(context.Background())
+			changes, err := s.Watch(ctx)
+			if store.IsWatchNotSupported(err) {
+				Skip("watch is not supported by the store")
+			}
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			cancel()
+
+			// then
+			Eventually(changes, "5s").Should(BeClosed())
+		})
+	})
+
+	Describe("Transactions", func() {
+		BeforeEach(func() {
+			if txs == nil {
+				Skip("transactions are not supported by the store")
+			}
+		})
+
+		It("should persist all changes when the transaction is committed", func() {
+			// given
+			existing := createResource("existing.demo")
+
+			// when
+			err := store.InTx(context.Background(), txs, func(ctx context.Context) error {
+				res := sample_model.NewTrafficRouteResource()
+				res.Spec.Path = "created"
+				if err := s.Create(ctx, res, store.CreateByKey("created.demo", mesh), store.CreatedAt(time.Now())); err != nil {
+					return err
+				}
+				existing.Spec.Path = "updated"
+				return s.Update(ctx, existing)
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			created := sample_model.NewTrafficRouteResource()
+			Expect(s.Get(context.Background(), created, store.GetByKey("created.demo", mesh))).To(Succeed())
+			Expect(created.Spec.Path).To(Equal("created"))
+			updated := sample_model.NewTrafficRouteResource()
+			Expect(s.Get(context.Background(), updated, store.GetByKey("existing.demo", mesh))).To(Succeed())
+			Expect(updated.Spec.Path).To(Equal("updated"))
+		})
+
+		It("should not persist any change when the transaction is rolled back", func() {
+			// given
+			existing := createResource("existing.demo")
+			createResource("deleted.demo")
+
+			// when
+			err := store.InTx(context.Background(), txs, func(ctx context.Context) error {
+				res := sample_model.NewTrafficRouteResource()
+				if err := s.Create(ctx, res, store.CreateByKey("created.demo", mesh), store.CreatedAt(time.Now())); err != nil {
+					return err
+				}
+				existing.Spec.Path = "updated"
+				if err := s.Update(ctx, existing); err != nil {
+					return err
+				}
+				if err := s.Delete(ctx, sample_model.NewTrafficRouteResource(), store.DeleteByKey("deleted.demo", mesh)); err != nil {
+					return err
+				}
+				// the changes are visible within the transaction
+				list := sample_model.TrafficRouteResourceList{}
+				if err := s.List(ctx, &list, store.ListByMesh(mesh)); err != nil {
+					return err
+				}
+				Expect(list.Items).To(HaveLen(2))
+				return s.Create(ctx, sample_model.NewTrafficRouteResource(), store.CreateByKey("existing.demo", mesh), store.CreatedAt(time.Now()))
+			})
+
+			// then
+			Expect(store.IsResourceAlreadyExists(err)).To(BeTrue())
+			Expect(s.Get(context.Background(), sample_model.NewTrafficRouteResource(), store.GetByKey("created.demo", mesh))).To(MatchError(store.ErrorResourceNotFound(sample_model.TrafficRouteType, "created.demo", mesh)))
+			Expect(s.Get(context.Background(), sample_model.NewTrafficRouteResource(), store.GetByKey("deleted.demo", mesh))).To(Succeed())
+			notUpdated := sample_model.NewTrafficRouteResource()
+			Expect(s.Get(context.Background(), notUpdated, store.GetByKey("existing.demo", mesh))).To(Succeed())
+			Expect(notUpdated.Spec.Path).To(Equal("demo"))
+		})
+	})
 }
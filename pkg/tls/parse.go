@@ -6,6 +6,7 @@ import (
 	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/pem"
 	"errors"
 )
 
@@ -29,3 +30,16 @@ func ParsePrivateKey(data []byte) (crypto.PrivateKey, error) {
 
 	return nil, errors.New("failed to parse private key")
 }
+
+// SplitPEMCerts splits a bundle of PEM encoded certificates to separate PEM encoded certificates.
+func SplitPEMCerts(data []byte) [][]byte {
+	var certs [][]byte
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+		certs = append(certs, pem.EncodeToMemory(block))
+	}
+}
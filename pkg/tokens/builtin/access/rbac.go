@@ -0,0 +1,30 @@
+package access
+
+import (
+	"context"
+
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	resources_access "github.com/kumahq/kuma/pkg/core/resources/access"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/user"
+)
+
+type rbacGenerateDataplaneTokenAccess struct {
+	rbac *resources_access.RBAC
+}
+
+var _ DataplaneTokenAccess = &rbacGenerateDataplaneTokenAccess{}
+
+func NewRBACGenerateDataplaneTokenAccess(rbac *resources_access.RBAC) DataplaneTokenAccess {
+	return &rbacGenerateDataplaneTokenAccess{
+		rbac: rbac,
+	}
+}
+
+func (r *rbacGenerateDataplaneTokenAccess) ValidateGenerateDataplaneToken(_ string, mesh string, _ map[string][]string, user user.User) error {
+	return r.rbac.Validate(context.Background(), user, system_proto.AccessRole_GENERATE_DATAPLANE_TOKEN, core_mesh.DataplaneResourceTypeDescriptor, mesh)
+}
+
+func (r *rbacGenerateDataplaneTokenAccess) ValidateGenerateZoneIngressToken(_ string, user user.User) error {
+	return r.rbac.Validate(context.Background(), user, system_proto.AccessRole_GENERATE_DATAPLANE_TOKEN, core_mesh.ZoneIngressResourceTypeDescriptor, "")
+}
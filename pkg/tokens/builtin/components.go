@@ -1,6 +1,8 @@
 package builtin
 
 import (
+	"github.com/pkg/errors"
+
 	"github.com/kumahq/kuma/pkg/config/core"
 	store_config "github.com/kumahq/kuma/pkg/config/core/resources/store"
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
@@ -18,6 +20,19 @@ func NewDataplaneTokenIssuer(resManager manager.ResourceManager) issuer.Dataplan
 	})
 }
 
+// NewOfflineDataplaneTokenIssuer builds DataplaneTokenIssuer that signs tokens with the provided signing key
+// without access to the control plane. The signing key has to be the one stored in the mesh
+// under the given serial number, otherwise the control plane rejects the token.
+func NewOfflineDataplaneTokenIssuer(signingKey []byte, serialNumber int) (issuer.DataplaneTokenIssuer, error) {
+	key, err := tokens.ParseSigningKey(signingKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse signing key")
+	}
+	return issuer.NewDataplaneTokenIssuer(func(string) tokens.Issuer {
+		return tokens.NewTokenIssuer(tokens.NewStaticSigningKeyManager(key, serialNumber))
+	}), nil
+}
+
 func NewZoneIngressTokenIssuer(resManager manager.ResourceManager) zoneingress.TokenIssuer {
 	return zoneingress.NewTokenIssuer(
 		tokens.NewTokenIssuer(
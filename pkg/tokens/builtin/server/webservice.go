@@ -2,6 +2,7 @@ package server
 
 import (
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/emicklei/go-restful"
@@ -81,6 +82,8 @@ func (d *tokenWebService) handleIdentityRequest(request *restful.Request, respon
 		}
 	}
 
+	verr.Add(validateTags(idReq.Tags))
+
 	if verr.HasViolations() {
 		errors.HandleError(response, verr.OrNil(), "Invalid request")
 		return
@@ -113,6 +116,31 @@ func (d *tokenWebService) handleIdentityRequest(request *restful.Request, respon
 	}
 }
 
+// validateTags validates tags the token is bound to. Every tag has to define at least one allowed value.
+func validateTags(tags map[string][]string) validators.ValidationError {
+	var verr validators.ValidationError
+	var names []string
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := validators.RootedAt("tags").Key(name)
+		if name == "" {
+			verr.AddViolationAt(path, "tag name cannot be empty")
+		}
+		if len(tags[name]) == 0 {
+			verr.AddViolationAt(path, "has to have at least one value")
+		}
+		for i, value := range tags[name] {
+			if value == "" {
+				verr.AddViolationAt(path.Index(i), "tag value cannot be empty")
+			}
+		}
+	}
+	return verr
+}
+
 func validateValidFor(validForRequest string) (verr validators.ValidationError, validFor time.Duration) {
 	if validForRequest == "" {
 		// https://github.com/kumahq/kuma/issues/4001
@@ -106,6 +106,39 @@ var _ = Describe("Dataplane Token Webservice", func() {
 		Expect(string(respBody)).To(Equal(credentials))
 	})
 
+	It("should reject tags without values", func() {
+		// given
+		idReq := types.DataplaneTokenRequest{
+			Mesh: "default",
+			Tags: map[string][]string{
+				"kuma.io/service": {"web", ""},
+				"version":         {},
+			},
+		}
+		reqBytes, err := json.Marshal(idReq)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		req, err := http.NewRequest("POST", fmt.Sprintf("%s/tokens/dataplane", url), bytes.NewReader(reqBytes))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Add("content-type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(400))
+
+		// when
+		respBody, err := io.ReadAll(resp.Body)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(respBody)).To(ContainSubstring(`tags[\"kuma.io/service\"][1]`))
+		Expect(string(respBody)).To(ContainSubstring("tag value cannot be empty"))
+		Expect(string(respBody)).To(ContainSubstring(`tags[\"version\"]`))
+		Expect(string(respBody)).To(ContainSubstring("has to have at least one value"))
+	})
+
 	DescribeTable("should return bad request on invalid json",
 		func(json string) {
 			// given
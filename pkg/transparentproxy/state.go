@@ -0,0 +1,91 @@
+package transparentproxy
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultStateFile is where kumactl install transparent-proxy records the changes applied to the host.
+const DefaultStateFile = "/var/lib/kuma/transparent-proxy.json"
+
+type Engine string
+
+const (
+	IstioEngine        Engine = "istio"
+	ExperimentalEngine Engine = "experimental"
+	EbpfEngine         Engine = "ebpf"
+)
+
+// State is the record of the changes applied to the host by the transparent proxy installation.
+// Uninstallation reverts the changes one by one and removes them from the state, so when it fails
+// in the middle, running it again resumes with the changes that were not reverted yet.
+type State struct {
+	Engine Engine `json:"engine"`
+	// IPTables is true when the iptables rules were applied by the engine.
+	IPTables bool `json:"iptables,omitempty"`
+	// Firewalld is true when the iptables rules were stored with firewalld.
+	Firewalld bool `json:"firewalld,omitempty"`
+	// ResolvConfBackup is the path to the copy of /etc/resolv.conf from before it was modified.
+	ResolvConfBackup string `json:"resolvConfBackup,omitempty"`
+}
+
+// Empty returns true when there are no changes left to revert.
+func (s *State) Empty() bool {
+	return !s.IPTables && !s.Firewalld && s.ResolvConfBackup == ""
+}
+
+// LoadState reads the state from the file. It returns nil without an error when the file does not exist.
+func LoadState(path string) (*State, error) {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read transparent proxy state from %s", path)
+	}
+	state := &State{}
+	if err := json.Unmarshal(content, state); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse transparent proxy state from %s", path)
+	}
+	return state, nil
+}
+
+// SaveState writes the state to the file, creating its directory if needed.
+func SaveState(path string, state *State) error {
+	content, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "unable to create directory for transparent proxy state %s", path)
+	}
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		return errors.Wrapf(err, "unable to write transparent proxy state to %s", path)
+	}
+	return nil
+}
+
+// RemoveState removes the state file. It is not an error when the file does not exist.
+func RemoveState(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "unable to remove transparent proxy state %s", path)
+	}
+	return nil
+}
+
+// NewTransparentProxy returns the implementation of the engine recorded in the state.
+func NewTransparentProxy(engine Engine) (TransparentProxy, error) {
+	switch engine {
+	case IstioEngine, "":
+		return DefaultTransparentProxy(), nil
+	case ExperimentalEngine:
+		return &ExperimentalTransparentProxy{}, nil
+	case EbpfEngine:
+		return nil, errors.New("ebpf engine is not supported by this version of kumactl, use the version of kumactl that installed the transparent proxy")
+	default:
+		return nil, errors.Errorf("unknown transparent proxy engine %q", engine)
+	}
+}
@@ -0,0 +1,44 @@
+package transparentproxy
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("State", func() {
+	It("should save, load and remove the state", func() {
+		// given
+		path := filepath.Join(GinkgoT().TempDir(), "state", "transparent-proxy.json")
+		state := &State{
+			Engine:           ExperimentalEngine,
+			IPTables:         true,
+			ResolvConfBackup: "/etc/resolv.conf.kuma-backup",
+		}
+
+		// when
+		Expect(SaveState(path, state)).To(Succeed())
+		loaded, err := LoadState(path)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(loaded).To(Equal(state))
+
+		// when
+		Expect(RemoveState(path)).To(Succeed())
+		loaded, err = LoadState(path)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(loaded).To(BeNil())
+	})
+
+	It("should not create the implementation of the ebpf engine", func() {
+		// when
+		_, err := NewTransparentProxy(EbpfEngine)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("ebpf engine is not supported")))
+	})
+})
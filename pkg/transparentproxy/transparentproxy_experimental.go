@@ -17,6 +17,9 @@ import (
 
 var _ TransparentProxy = &ExperimentalTransparentProxy{}
 
+// experimentalChainPrefix is the prefix of the iptables chains created by the experimental engine
+const experimentalChainPrefix = "KUMA_"
+
 type ExperimentalTransparentProxy struct{}
 
 func splitPorts(ports string) ([]uint16, error) {
@@ -134,7 +137,7 @@ func (tp *ExperimentalTransparentProxy) Setup(tpConfig *config.TransparentProxyC
 			UID: tpConfig.UID,
 		},
 		Redirect: kumanet_config.Redirect{
-			NamePrefix: "KUMA_",
+			NamePrefix: experimentalChainPrefix,
 			Inbound: kumanet_config.TrafficFlow{
 				Port:         uint16(redirectInboundPort),
 				PortIPv6:     uint16(redirectInboundPortIPv6),
@@ -161,7 +164,92 @@ func (tp *ExperimentalTransparentProxy) Setup(tpConfig *config.TransparentProxyC
 	return builder.RestoreIPTables(cfg)
 }
 
+// Cleanup removes the chains created by the engine, the rules jumping to them and the DNS conntrack
+// zone split rules. Other rules on the host are left untouched.
 func (tp *ExperimentalTransparentProxy) Cleanup(dryRun, verbose bool) (string, error) {
-	// TODO implement me
-	panic("implement me")
+	output, err := cleanupIPTables(constants.IPTABLES, dryRun)
+	if err != nil {
+		return "", err
+	}
+
+	ipv6, err := ShouldEnableIPv6()
+	if err != nil {
+		return "", errors.Wrap(err, "cannot verify if IPv6 should be enabled")
+	}
+
+	if ipv6 {
+		ipv6Output, err := cleanupIPTables(constants.IP6TABLES, dryRun)
+		if err != nil {
+			return "", err
+		}
+		output += ipv6Output
+	}
+
+	return output, nil
+}
+
+func cleanupIPTables(iptables string, dryRun bool) (string, error) {
+	saved, err := exec.Command(iptables + "-save").Output()
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to save current %s rules", iptables)
+	}
+
+	rules := removeKumaRules(string(saved))
+	if dryRun {
+		return rules, nil
+	}
+
+	cmd := exec.Command(iptables + "-restore")
+	cmd.Stdin = strings.NewReader(rules)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", errors.Wrapf(err, "unable to restore %s rules (with output: %q)", iptables, output)
+	}
+
+	return rules, nil
+}
+
+// removeKumaRules removes from the iptables-save output the chains created by the engine
+// and the rules that refer to them, so the result can be restored with iptables-restore.
+func removeKumaRules(saved string) string {
+	var lines []string
+	table := ""
+
+	for _, line := range strings.Split(saved, "\n") {
+		switch {
+		case strings.HasPrefix(line, "*"):
+			table = strings.TrimPrefix(line, "*")
+		case strings.HasPrefix(line, ":"+experimentalChainPrefix):
+			continue
+		case strings.HasPrefix(line, "-A "):
+			if isKumaRule(table, strings.Fields(line)) {
+				continue
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func isKumaRule(table string, fields []string) bool {
+	if len(fields) > 1 && strings.HasPrefix(fields[1], experimentalChainPrefix) {
+		return true
+	}
+
+	for i := 0; i < len(fields)-1; i++ {
+		switch fields[i] {
+		case "-j", "-g":
+			if strings.HasPrefix(fields[i+1], experimentalChainPrefix) {
+				return true
+			}
+			// DNS conntrack zones split, see kuma-net/iptables/builder/builder_raw.go
+			if table == constants.RAW && fields[i+1] == "CT" && i+3 < len(fields) &&
+				fields[i+2] == "--zone" && (fields[i+3] == "1" || fields[i+3] == "2") {
+				return true
+			}
+		}
+	}
+
+	return false
 }
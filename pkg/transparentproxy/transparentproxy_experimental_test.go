@@ -0,0 +1,50 @@
+package transparentproxy
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ExperimentalTransparentProxy", func() {
+	It("should remove only the rules created by the engine", func() {
+		// given
+		saved := `*raw
+:PREROUTING ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+-A PREROUTING -p udp -m udp --sport 53 -j CT --zone 1
+-A OUTPUT -p udp -m udp --dport 53 -m owner --uid-owner 5678 -j CT --zone 1
+-A OUTPUT -p udp -m udp --dport 53 -j CT --zone 2
+-A OUTPUT -p udp -m udp --dport 5353 -j CT --zone 7
+COMMIT
+*nat
+:PREROUTING ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+:DOCKER - [0:0]
+:KUMA_INBOUND - [0:0]
+:KUMA_REDIRECT - [0:0]
+-A PREROUTING -m addrtype --dst-type LOCAL -j DOCKER
+-A PREROUTING -p tcp -j KUMA_INBOUND
+-A OUTPUT -p tcp -j KUMA_REDIRECT
+-A KUMA_INBOUND -p tcp -j KUMA_REDIRECT
+-A KUMA_REDIRECT -p tcp -j REDIRECT --to-ports 15001
+COMMIT
+`
+
+		// when
+		rules := removeKumaRules(saved)
+
+		// then
+		Expect(rules).To(Equal(`*raw
+:PREROUTING ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+-A OUTPUT -p udp -m udp --dport 5353 -j CT --zone 7
+COMMIT
+*nat
+:PREROUTING ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+:DOCKER - [0:0]
+-A PREROUTING -m addrtype --dst-type LOCAL -j DOCKER
+COMMIT
+`))
+	})
+})
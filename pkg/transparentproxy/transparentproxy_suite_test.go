@@ -0,0 +1,11 @@
+package transparentproxy
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestTransparentProxy(t *testing.T) {
+	test.RunSpecs(t, "Transparent Proxy Suite")
+}
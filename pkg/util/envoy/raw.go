@@ -34,5 +34,8 @@ func ResourceFromYaml(resYaml string) (proto.Message, error) {
 			return nil, err
 		}
 	}
+	if err := ValidateTypedConfigs(msg); err != nil {
+		return nil, err
+	}
 	return p, nil
 }
@@ -0,0 +1,78 @@
+package envoy
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ValidateTypedConfigs validates messages packed in google.protobuf.Any fields of the message,
+// like typed configs of filters, which are not covered by the Validate method of the message.
+// The error is prefixed with the path of the invalid field, e.g. filterChains[0].filters[0].typedConfig.
+func ValidateTypedConfigs(msg protoreflect.ProtoMessage) error {
+	return validateTypedConfigs(msg.ProtoReflect(), "")
+}
+
+func validateTypedConfigs(msg protoreflect.Message, path string) error {
+	if typed, ok := msg.Interface().(*anypb.Any); ok {
+		inner, err := typed.UnmarshalNew()
+		if err != nil {
+			return withPath(path, err)
+		}
+		if v, ok := inner.(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return withPath(path, err)
+			}
+		}
+		return validateTypedConfigs(inner.ProtoReflect(), path)
+	}
+
+	var err error
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		fieldPath := fd.JSONName()
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		switch {
+		case fd.IsList():
+			if fd.Message() == nil {
+				return true
+			}
+			list := value.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = validateTypedConfigs(list.Get(i).Message(), fmt.Sprintf("%s[%d]", fieldPath, i))
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			entries := value.Map()
+			var keys []protoreflect.MapKey
+			entries.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, key)
+				return true
+			})
+			sort.Slice(keys, func(i, j int) bool {
+				return keys[i].String() < keys[j].String()
+			})
+			for _, key := range keys {
+				if err = validateTypedConfigs(entries.Get(key).Message(), fmt.Sprintf("%s[%q]", fieldPath, key.String())); err != nil {
+					break
+				}
+			}
+		case fd.Message() != nil:
+			err = validateTypedConfigs(value.Message(), fieldPath)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func withPath(path string, err error) error {
+	if path == "" {
+		return err
+	}
+	return fmt.Errorf("%s: %w", path, err)
+}
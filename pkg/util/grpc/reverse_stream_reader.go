@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// ReverseStreamChunkFn reads the chunk of the body from the response that is streamed by a client of reverse rpcs in many responses.
+// more is false for the last response, the error reported by the client ends the stream.
+type ReverseStreamChunkFn = func(resp ReverseUnaryMessage) (chunk []byte, more bool, err error)
+
+// reverseStreamDrainTimeout is how long the reader waits for the last response once the stream was cancelled.
+const reverseStreamDrainTimeout = 10 * time.Second
+
+// NewReverseStreamReader returns the body that is streamed by the client in many responses received on ch.
+// When the reader is closed or ctx is done before the last response, cancel is called to let the client stop streaming
+// and the responses are received until the last one, so sending of the responses to ch does not block the stream of the client.
+// done is called when no more responses are received from ch, i.e. to delete the watch of the responses.
+func NewReverseStreamReader(
+	ctx context.Context,
+	ch <-chan ReverseUnaryMessage,
+	chunkFn ReverseStreamChunkFn,
+	cancel func(),
+	done func(),
+) io.ReadCloser {
+	pr, pw := io.Pipe()
+	reader := &reverseStreamReader{
+		PipeReader: pr,
+		closed:     make(chan struct{}),
+	}
+	go func() {
+		defer done()
+		for {
+			select {
+			case <-ctx.Done():
+				_ = pw.CloseWithError(ctx.Err())
+				cancelAndDrain(ch, chunkFn, cancel)
+				return
+			case <-reader.closed:
+				cancelAndDrain(ch, chunkFn, cancel)
+				return
+			case resp := <-ch:
+				chunk, more, err := chunkFn(resp)
+				if err != nil {
+					_ = pw.CloseWithError(err)
+					return
+				}
+				if len(chunk) > 0 {
+					if _, err := pw.Write(chunk); err != nil { // the reader was closed
+						if more {
+							cancelAndDrain(ch, chunkFn, cancel)
+						}
+						return
+					}
+				}
+				if !more {
+					_ = pw.Close()
+					return
+				}
+			}
+		}
+	}()
+	return reader
+}
+
+func cancelAndDrain(ch <-chan ReverseUnaryMessage, chunkFn ReverseStreamChunkFn, cancel func()) {
+	cancel()
+	timeout := time.After(reverseStreamDrainTimeout)
+	for {
+		select {
+		case resp := <-ch:
+			if _, more, err := chunkFn(resp); err != nil || !more {
+				return
+			}
+		case <-timeout:
+			return
+		}
+	}
+}
+
+type reverseStreamReader struct {
+	*io.PipeReader
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (r *reverseStreamReader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.closed)
+	})
+	return r.PipeReader.Close()
+}
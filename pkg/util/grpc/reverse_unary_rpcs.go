@@ -20,7 +20,9 @@ type ReverseUnaryRPCs interface {
 	DeleteWatch(client string, reqID string)
 
 	ClientConnected(client string, stream grpc.ServerStream)
-	ClientDisconnected(client string)
+	// ClientDisconnected removes the stream of the client unless the client already reconnected on another stream.
+	ClientDisconnected(client string, stream grpc.ServerStream)
+	IsConnected(client string) bool
 	ResponseReceived(client string, resp ReverseUnaryMessage) error
 }
 
@@ -69,10 +71,19 @@ func (x *clientStreams) clientStream(client string) (*clientStream, error) {
 	return stream, nil
 }
 
-func (x *clientStreams) ClientDisconnected(client string) {
+func (x *clientStreams) ClientDisconnected(client string, stream grpc.ServerStream) {
 	x.Lock()
 	defer x.Unlock()
-	delete(x.streamForClient, client)
+	if current, ok := x.streamForClient[client]; ok && current.stream == stream {
+		delete(x.streamForClient, client)
+	}
+}
+
+func (x *clientStreams) IsConnected(client string) bool {
+	x.Lock()
+	defer x.Unlock()
+	_, ok := x.streamForClient[client]
+	return ok
 }
 
 type clientStream struct {
@@ -0,0 +1,11 @@
+package lua_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/pkg/test"
+)
+
+func TestLua(t *testing.T) {
+	test.RunSpecs(t, "Lua Suite")
+}
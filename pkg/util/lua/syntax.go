@@ -0,0 +1,262 @@
+package lua
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type tokenKind int
+
+const (
+	tokenName tokenKind = iota
+	tokenKeyword
+	tokenSymbol
+	tokenString
+	tokenNumber
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	line  int
+}
+
+var keywords = map[string]bool{
+	"and": true, "break": true, "do": true, "else": true, "elseif": true, "end": true,
+	"false": true, "for": true, "function": true, "goto": true, "if": true, "in": true,
+	"local": true, "nil": true, "not": true, "or": true, "repeat": true, "return": true,
+	"then": true, "true": true, "until": true, "while": true,
+}
+
+var symbols = []string{
+	"...", "==", "~=", "<=", ">=", "//", "::", "<<", ">>", "..",
+	"+", "-", "*", "/", "%", "^", "#", "&", "~", "|", "<", ">", "=",
+	"(", ")", "{", "}", "[", "]", ";", ":", ",", ".",
+}
+
+// ValidateSyntax checks that the script consists of valid Lua tokens and that its blocks
+// (function, if, for, while, do, repeat) and brackets are properly closed.
+// It does not verify the complete Lua grammar, the script can still fail when it is loaded by Envoy.
+func ValidateSyntax(script string) error {
+	tokens, err := tokenize(script)
+	if err != nil {
+		return err
+	}
+	return validateBlocks(tokens)
+}
+
+// DefinesFunction returns true if the script defines a function of the given name.
+func DefinesFunction(script string, name string) bool {
+	tokens, err := tokenize(script)
+	if err != nil {
+		return false
+	}
+	for i := 0; i+2 < len(tokens); i++ {
+		if tokens[i].kind == tokenKeyword && tokens[i].value == "function" &&
+			tokens[i+1].kind == tokenName && tokens[i+1].value == name &&
+			tokens[i+2].kind == tokenSymbol && tokens[i+2].value == "(" {
+			return true
+		}
+	}
+	return false
+}
+
+type block struct {
+	opener string
+	closer string
+	line   int
+}
+
+func validateBlocks(tokens []token) error {
+	var stack []*block
+	push := func(t token, closer string) {
+		stack = append(stack, &block{opener: t.value, closer: closer, line: t.line})
+	}
+	pop := func(t token) error {
+		if len(stack) == 0 {
+			return errors.Errorf("line %d: unexpected '%s'", t.line, t.value)
+		}
+		top := stack[len(stack)-1]
+		if top.closer != t.value {
+			return errors.Errorf("line %d: '%s' expected (to close '%s' at line %d) near '%s'", t.line, top.closer, top.opener, top.line, t.value)
+		}
+		stack = stack[:len(stack)-1]
+		return nil
+	}
+
+	for _, t := range tokens {
+		switch t.kind {
+		case tokenKeyword:
+			switch t.value {
+			case "function", "if":
+				push(t, "end")
+			case "for", "while":
+				push(t, "do")
+			case "do":
+				if len(stack) > 0 && stack[len(stack)-1].closer == "do" {
+					stack[len(stack)-1].closer = "end"
+				} else {
+					push(t, "end")
+				}
+			case "repeat":
+				push(t, "until")
+			case "end", "until":
+				if err := pop(t); err != nil {
+					return err
+				}
+			case "then", "elseif", "else":
+				if len(stack) == 0 || stack[len(stack)-1].opener != "if" {
+					return errors.Errorf("line %d: unexpected '%s'", t.line, t.value)
+				}
+			}
+		case tokenSymbol:
+			switch t.value {
+			case "(":
+				push(t, ")")
+			case "{":
+				push(t, "}")
+			case "[":
+				push(t, "]")
+			case ")", "}", "]":
+				if err := pop(t); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(stack) > 0 {
+		top := stack[len(stack)-1]
+		return errors.Errorf("'%s' expected (to close '%s' at line %d) near <eof>", top.closer, top.opener, top.line)
+	}
+	return nil
+}
+
+func tokenize(script string) ([]token, error) {
+	var tokens []token
+	line := 1
+	for i := 0; i < len(script); {
+		c := script[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case strings.HasPrefix(script[i:], "--"):
+			start := line
+			i += 2
+			if level := longBracketLevel(script, i); level >= 0 {
+				end, lines := readLongBracket(script, i, level)
+				if end < 0 {
+					return nil, errors.Errorf("line %d: unfinished long comment", start)
+				}
+				i, line = end, line+lines
+			} else {
+				for i < len(script) && script[i] != '\n' {
+					i++
+				}
+			}
+		case c == '[' && longBracketLevel(script, i) >= 0:
+			start := line
+			end, lines := readLongBracket(script, i, longBracketLevel(script, i))
+			if end < 0 {
+				return nil, errors.Errorf("line %d: unfinished long string", start)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: script[i:end], line: start})
+			i, line = end, line+lines
+		case c == '"' || c == '\'':
+			start := line
+			j := i + 1
+			for ; j < len(script) && script[j] != c; j++ {
+				if script[j] == '\n' {
+					return nil, errors.Errorf("line %d: unfinished string", start)
+				}
+				if script[j] == '\\' && j+1 < len(script) {
+					j++
+					if script[j] == '\n' {
+						line++
+					}
+				}
+			}
+			if j >= len(script) {
+				return nil, errors.Errorf("line %d: unfinished string", start)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: script[i : j+1], line: start})
+			i = j + 1
+		case isDigit(c) || (c == '.' && i+1 < len(script) && isDigit(script[i+1])):
+			j := i + 1
+			for j < len(script) && (isAlphanumeric(script[j]) || script[j] == '.' ||
+				((script[j] == '+' || script[j] == '-') && strings.ContainsRune("eEpP", rune(script[j-1])))) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: script[i:j], line: line})
+			i = j
+		case isAlphanumeric(c):
+			j := i + 1
+			for j < len(script) && isAlphanumeric(script[j]) {
+				j++
+			}
+			kind := tokenName
+			if keywords[script[i:j]] {
+				kind = tokenKeyword
+			}
+			tokens = append(tokens, token{kind: kind, value: script[i:j], line: line})
+			i = j
+		default:
+			symbol := ""
+			for _, s := range symbols {
+				if strings.HasPrefix(script[i:], s) {
+					symbol = s
+					break
+				}
+			}
+			if symbol == "" {
+				return nil, errors.Errorf("line %d: unexpected symbol near '%c'", line, c)
+			}
+			tokens = append(tokens, token{kind: tokenSymbol, value: symbol, line: line})
+			i += len(symbol)
+		}
+	}
+	return tokens, nil
+}
+
+// longBracketLevel returns the level of the long bracket ([[, [=[, [==[ etc.) opened at the index or -1.
+func longBracketLevel(script string, i int) int {
+	if i >= len(script) || script[i] != '[' {
+		return -1
+	}
+	level := 0
+	for j := i + 1; j < len(script); j++ {
+		switch script[j] {
+		case '=':
+			level++
+		case '[':
+			return level
+		default:
+			return -1
+		}
+	}
+	return -1
+}
+
+// readLongBracket returns the index right after the long bracket opened at the index together with
+// the number of lines it spans. The index is -1 if the bracket is not closed.
+func readLongBracket(script string, i int, level int) (int, int) {
+	closing := "]" + strings.Repeat("=", level) + "]"
+	start := i + level + 2
+	end := strings.Index(script[start:], closing)
+	if end < 0 {
+		return -1, 0
+	}
+	end += start + len(closing)
+	return end, strings.Count(script[i:end], "\n")
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlphanumeric(c byte) bool {
+	return c == '_' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,79 @@
+package lua_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/util/lua"
+)
+
+var _ = Describe("ValidateSyntax", func() {
+	It("should accept valid script", func() {
+		// given
+		script := `
+-- adds a header to every request
+local header = "x-custom" --[[ name of
+the header ]]
+function envoy_on_request(request_handle)
+  for i = 1, 3 do
+    if i == 2 then
+      request_handle:headers():add(header, [==[value ]] ]==])
+    elseif i > 2 then
+      request_handle:logInfo('escaped \' quote')
+    else
+      local t = { a = {1, 2}, ["b"] = 0x1F, c = 1e-3 }
+    end
+  end
+  repeat
+    while false do end
+  until true
+end
+`
+
+		// when
+		err := lua.ValidateSyntax(script)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("should reject invalid script",
+		func(script string, expectedErr string) {
+			// when
+			err := lua.ValidateSyntax(script)
+
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("unclosed function", "function envoy_on_request(h)\n  h:logInfo('x')\n",
+			"'end' expected (to close 'function' at line 1) near <eof>"),
+		Entry("unexpected end", "local a = 1\nend",
+			"line 2: unexpected 'end'"),
+		Entry("mismatched bracket", "local t = { (1 }",
+			"line 1: ')' expected (to close '(' at line 1) near '}'"),
+		Entry("then outside of if", "while true then end",
+			"line 1: unexpected 'then'"),
+		Entry("unfinished string", "local a = \"abc\nlocal b = 1",
+			"line 1: unfinished string"),
+		Entry("unfinished long comment", "--[[ comment\nlocal a = 1",
+			"line 1: unfinished long comment"),
+		Entry("unexpected symbol", "local a = 1 $ 2",
+			"line 1: unexpected symbol near '$'"),
+	)
+})
+
+var _ = Describe("DefinesFunction", func() {
+	It("should find defined function", func() {
+		// given
+		script := `
+-- function envoy_on_response(response_handle)
+local helper = "function envoy_on_response("
+function envoy_on_request(request_handle)
+end
+`
+
+		// expect
+		Expect(lua.DefinesFunction(script, "envoy_on_request")).To(BeTrue())
+		Expect(lua.DefinesFunction(script, "envoy_on_response")).To(BeFalse())
+	})
+})
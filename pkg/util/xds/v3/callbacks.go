@@ -2,9 +2,12 @@ package v3
 
 import (
 	"context"
+	"sync"
 
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_sd "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/kumahq/kuma/pkg/util/xds"
@@ -14,13 +17,15 @@ import (
 
 type adapterCallbacks struct {
 	NoopCallbacks
-	callbacks xds.Callbacks
+	callbacks    xds.Callbacks
+	deltaStreams *deltaStreams
 }
 
 // AdaptCallbacks translate Kuma callbacks to real go-control-plane Callbacks
 func AdaptCallbacks(callbacks xds.Callbacks) envoy_xds.Callbacks {
 	return &adapterCallbacks{
-		callbacks: callbacks,
+		callbacks:    callbacks,
+		deltaStreams: newDeltaStreams(),
 	}
 }
 
@@ -42,6 +47,24 @@ func (a *adapterCallbacks) OnStreamResponse(ctx context.Context, streamID int64,
 	a.callbacks.OnStreamResponse(streamID, &discoveryRequest{request}, &discoveryResponse{response})
 }
 
+func (a *adapterCallbacks) OnDeltaStreamOpen(ctx context.Context, streamID int64, typeURL string) error {
+	a.deltaStreams.open(streamID)
+	return a.callbacks.OnStreamOpen(ctx, deltaStreamID(streamID), typeURL)
+}
+
+func (a *adapterCallbacks) OnDeltaStreamClosed(streamID int64) {
+	a.callbacks.OnStreamClosed(deltaStreamID(streamID))
+	a.deltaStreams.close(streamID)
+}
+
+func (a *adapterCallbacks) OnStreamDeltaRequest(streamID int64, request *envoy_sd.DeltaDiscoveryRequest) error {
+	return a.callbacks.OnStreamRequest(deltaStreamID(streamID), a.deltaStreams.request(streamID, request))
+}
+
+func (a *adapterCallbacks) OnStreamDeltaResponse(streamID int64, request *envoy_sd.DeltaDiscoveryRequest, response *envoy_sd.DeltaDiscoveryResponse) {
+	a.callbacks.OnStreamResponse(deltaStreamID(streamID), a.deltaStreams.request(streamID, request), a.deltaStreams.response(streamID, response))
+}
+
 // rest callbacks
 
 type adapterRestCallbacks struct {
@@ -68,13 +91,15 @@ func (a *adapterRestCallbacks) OnFetchResponse(request *envoy_sd.DiscoveryReques
 
 type adapterMultiCallbacks struct {
 	NoopCallbacks
-	callbacks xds.MultiCallbacks
+	callbacks    xds.MultiCallbacks
+	deltaStreams *deltaStreams
 }
 
 // AdaptMultiCallbacks translate Kuma callbacks to real go-control-plane Callbacks
 func AdaptMultiCallbacks(callbacks xds.MultiCallbacks) envoy_xds.Callbacks {
 	return &adapterMultiCallbacks{
-		callbacks: callbacks,
+		callbacks:    callbacks,
+		deltaStreams: newDeltaStreams(),
 	}
 }
 
@@ -102,6 +127,101 @@ func (a *adapterMultiCallbacks) OnStreamResponse(ctx context.Context, streamID i
 	a.callbacks.OnStreamResponse(streamID, &discoveryRequest{request}, &discoveryResponse{response})
 }
 
+func (a *adapterMultiCallbacks) OnDeltaStreamOpen(ctx context.Context, streamID int64, typeURL string) error {
+	a.deltaStreams.open(streamID)
+	return a.callbacks.OnStreamOpen(ctx, deltaStreamID(streamID), typeURL)
+}
+
+func (a *adapterMultiCallbacks) OnDeltaStreamClosed(streamID int64) {
+	a.callbacks.OnStreamClosed(deltaStreamID(streamID))
+	a.deltaStreams.close(streamID)
+}
+
+func (a *adapterMultiCallbacks) OnStreamDeltaRequest(streamID int64, request *envoy_sd.DeltaDiscoveryRequest) error {
+	return a.callbacks.OnStreamRequest(deltaStreamID(streamID), a.deltaStreams.request(streamID, request))
+}
+
+func (a *adapterMultiCallbacks) OnStreamDeltaResponse(streamID int64, request *envoy_sd.DeltaDiscoveryRequest, response *envoy_sd.DeltaDiscoveryResponse) {
+	a.callbacks.OnStreamResponse(deltaStreamID(streamID), a.deltaStreams.request(streamID, request), a.deltaStreams.response(streamID, response))
+}
+
+// delta streams
+
+// deltaStreamID maps ID of the delta stream to the ID passed to Kuma callbacks.
+// go-control-plane counts SOTW and delta streams separately, so both kinds of streams can have the same ID.
+// Kuma callbacks track streams by IDs, therefore IDs of delta streams are negated to not collide with SOTW streams.
+func deltaStreamID(streamID int64) int64 {
+	return -streamID
+}
+
+type sentDeltaResponse struct {
+	nonce   string
+	version string
+}
+
+// deltaStreams keeps the state of delta streams that is needed to present
+// DeltaDiscoveryRequest and DeltaDiscoveryResponse to Kuma callbacks as DiscoveryRequest and DiscoveryResponse.
+type deltaStreams struct {
+	sync.Mutex
+	// nodes of the streams, Node is set only in the first request of the stream when Envoy uses set_node_on_first_message_only
+	nodes map[int64]*envoy_core.Node
+	// lastResponses by type URL. Unlike DiscoveryRequest, DeltaDiscoveryRequest does not carry the version it acknowledges,
+	// so the version is resolved from the response of the nonce.
+	lastResponses map[int64]map[string]sentDeltaResponse
+}
+
+func newDeltaStreams() *deltaStreams {
+	return &deltaStreams{
+		nodes:         map[int64]*envoy_core.Node{},
+		lastResponses: map[int64]map[string]sentDeltaResponse{},
+	}
+}
+
+func (d *deltaStreams) open(streamID int64) {
+	d.Lock()
+	defer d.Unlock()
+	d.lastResponses[streamID] = map[string]sentDeltaResponse{}
+}
+
+func (d *deltaStreams) close(streamID int64) {
+	d.Lock()
+	defer d.Unlock()
+	delete(d.nodes, streamID)
+	delete(d.lastResponses, streamID)
+}
+
+func (d *deltaStreams) request(streamID int64, request *envoy_sd.DeltaDiscoveryRequest) *deltaDiscoveryRequest {
+	d.Lock()
+	defer d.Unlock()
+	node := request.GetNode()
+	if node != nil {
+		d.nodes[streamID] = node
+	} else {
+		node = d.nodes[streamID]
+	}
+	version := ""
+	if last, ok := d.lastResponses[streamID][request.GetTypeUrl()]; ok && request.GetResponseNonce() != "" && last.nonce == request.GetResponseNonce() {
+		version = last.version
+	}
+	return &deltaDiscoveryRequest{
+		DeltaDiscoveryRequest: request,
+		node:                  node,
+		version:               version,
+	}
+}
+
+func (d *deltaStreams) response(streamID int64, response *envoy_sd.DeltaDiscoveryResponse) *deltaDiscoveryResponse {
+	d.Lock()
+	defer d.Unlock()
+	if responses, ok := d.lastResponses[streamID]; ok {
+		responses[response.GetTypeUrl()] = sentDeltaResponse{
+			nonce:   response.GetNonce(),
+			version: response.GetSystemVersionInfo(),
+		}
+	}
+	return &deltaDiscoveryResponse{response}
+}
+
 // DiscoveryRequest facade
 
 type discoveryRequest struct {
@@ -145,3 +265,62 @@ type discoveryResponse struct {
 func (d *discoveryResponse) VersionInfo() string {
 	return d.GetVersionInfo()
 }
+
+// DeltaDiscoveryRequest facade
+
+type deltaDiscoveryRequest struct {
+	*envoy_sd.DeltaDiscoveryRequest
+	node    *envoy_core.Node
+	version string
+}
+
+func (d *deltaDiscoveryRequest) Metadata() *structpb.Struct {
+	return d.node.GetMetadata()
+}
+
+// VersionInfo returns the system version of the response acknowledged by the request
+func (d *deltaDiscoveryRequest) VersionInfo() string {
+	return d.version
+}
+
+func (d *deltaDiscoveryRequest) NodeId() string {
+	return d.node.GetId()
+}
+
+func (d *deltaDiscoveryRequest) Node() interface{} {
+	return d.node
+}
+
+func (d *deltaDiscoveryRequest) HasErrors() bool {
+	return d.ErrorDetail != nil
+}
+
+func (d *deltaDiscoveryRequest) ErrorMsg() string {
+	return d.GetErrorDetail().GetMessage()
+}
+
+// GetResourceNames returns names of the resources that the request subscribes to
+func (d *deltaDiscoveryRequest) GetResourceNames() []string {
+	return d.GetResourceNamesSubscribe()
+}
+
+var _ xds.DiscoveryRequest = &deltaDiscoveryRequest{}
+
+type deltaDiscoveryResponse struct {
+	*envoy_sd.DeltaDiscoveryResponse
+}
+
+func (d *deltaDiscoveryResponse) VersionInfo() string {
+	return d.GetSystemVersionInfo()
+}
+
+// GetResources returns the resources that were added or changed, removed resources are not included
+func (d *deltaDiscoveryResponse) GetResources() []*anypb.Any {
+	var resources []*anypb.Any
+	for _, resource := range d.DeltaDiscoveryResponse.GetResources() {
+		resources = append(resources, resource.GetResource())
+	}
+	return resources
+}
+
+var _ xds.DiscoveryResponse = &deltaDiscoveryResponse{}
@@ -0,0 +1,130 @@
+package v3_test
+
+import (
+	"context"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_sd "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+	util_xds_v3 "github.com/kumahq/kuma/pkg/util/xds/v3"
+)
+
+type recordedRequest struct {
+	streamID   int64
+	nodeID     string
+	version    string
+	nonce      string
+	resources  []string
+	hasErrors  bool
+	responseTo string
+}
+
+type recordingCallbacks struct {
+	util_xds.NoopCallbacks
+	opened    []int64
+	closed    []int64
+	requests  []recordedRequest
+	responses []util_xds.DiscoveryResponse
+}
+
+func (r *recordingCallbacks) OnStreamOpen(_ context.Context, streamID int64, _ string) error {
+	r.opened = append(r.opened, streamID)
+	return nil
+}
+
+func (r *recordingCallbacks) OnStreamClosed(streamID int64) {
+	r.closed = append(r.closed, streamID)
+}
+
+func (r *recordingCallbacks) OnStreamRequest(streamID int64, request util_xds.DiscoveryRequest) error {
+	r.requests = append(r.requests, recordedRequest{
+		streamID:  streamID,
+		nodeID:    request.NodeId(),
+		version:   request.VersionInfo(),
+		nonce:     request.GetResponseNonce(),
+		resources: request.GetResourceNames(),
+		hasErrors: request.HasErrors(),
+	})
+	return nil
+}
+
+func (r *recordingCallbacks) OnStreamResponse(streamID int64, request util_xds.DiscoveryRequest, response util_xds.DiscoveryResponse) {
+	r.requests = append(r.requests, recordedRequest{
+		streamID:   streamID,
+		nodeID:     request.NodeId(),
+		responseTo: response.GetNonce(),
+	})
+	r.responses = append(r.responses, response)
+}
+
+var _ = Describe("AdaptCallbacks", func() {
+
+	var recorder *recordingCallbacks
+	var callbacks interface {
+		OnDeltaStreamOpen(context.Context, int64, string) error
+		OnDeltaStreamClosed(int64)
+		OnStreamDeltaRequest(int64, *envoy_sd.DeltaDiscoveryRequest) error
+		OnStreamDeltaResponse(int64, *envoy_sd.DeltaDiscoveryRequest, *envoy_sd.DeltaDiscoveryResponse)
+	}
+
+	BeforeEach(func() {
+		recorder = &recordingCallbacks{}
+		callbacks = util_xds_v3.AdaptCallbacks(recorder)
+	})
+
+	It("should adapt delta xDS stream", func() {
+		// given
+		node := &envoy_core.Node{Id: "default.backend-01"}
+		initial := &envoy_sd.DeltaDiscoveryRequest{
+			Node:                   node,
+			TypeUrl:                envoy_resource.ClusterType,
+			ResourceNamesSubscribe: []string{"backend"},
+		}
+		response := &envoy_sd.DeltaDiscoveryResponse{
+			TypeUrl:           envoy_resource.ClusterType,
+			SystemVersionInfo: "v1",
+			Nonce:             "1",
+			Resources: []*envoy_sd.Resource{
+				{Name: "backend", Version: "hash", Resource: &anypb.Any{TypeUrl: envoy_resource.ClusterType}},
+			},
+		}
+		ack := &envoy_sd.DeltaDiscoveryRequest{
+			TypeUrl:       envoy_resource.ClusterType,
+			ResponseNonce: "1",
+		}
+		nack := &envoy_sd.DeltaDiscoveryRequest{
+			TypeUrl:       envoy_resource.ClusterType,
+			ResponseNonce: "1",
+			ErrorDetail:   &status.Status{Message: "rejected"},
+		}
+
+		// when
+		Expect(callbacks.OnDeltaStreamOpen(context.Background(), 1, envoy_resource.AnyType)).To(Succeed())
+		Expect(callbacks.OnStreamDeltaRequest(1, initial)).To(Succeed())
+		callbacks.OnStreamDeltaResponse(1, initial, response)
+		Expect(callbacks.OnStreamDeltaRequest(1, ack)).To(Succeed())
+		Expect(callbacks.OnStreamDeltaRequest(1, nack)).To(Succeed())
+		callbacks.OnDeltaStreamClosed(1)
+
+		// then delta streams do not collide with SOTW streams of the same ID
+		Expect(recorder.opened).To(Equal([]int64{-1}))
+		Expect(recorder.closed).To(Equal([]int64{-1}))
+		// and the node of the first request is used for the rest of the stream and version is resolved by the nonce
+		Expect(recorder.requests).To(Equal([]recordedRequest{
+			{streamID: -1, nodeID: "default.backend-01", resources: []string{"backend"}},
+			{streamID: -1, nodeID: "default.backend-01", responseTo: "1"},
+			{streamID: -1, nodeID: "default.backend-01", version: "v1", nonce: "1"},
+			{streamID: -1, nodeID: "default.backend-01", version: "v1", nonce: "1", hasErrors: true},
+		}))
+		// and
+		Expect(recorder.responses).To(HaveLen(1))
+		Expect(recorder.responses[0].VersionInfo()).To(Equal("v1"))
+		Expect(recorder.responses[0].GetResources()).To(HaveLen(1))
+	})
+})
@@ -1,8 +1,14 @@
 package v3
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+
 	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	"github.com/golang/protobuf/proto"
+	proto_v2 "google.golang.org/protobuf/proto"
 )
 
 // SnapshotVersioner assigns versions to xDS resources in a new Snapshot.
@@ -49,3 +55,54 @@ func (_ SnapshotAutoVersioner) equal(new, old map[string]envoy_types.Resource) b
 	}
 	return true
 }
+
+// SnapshotHashVersioner assigns versions to xDS resources in a new Snapshot
+// by hashing the resources of every type.
+// Unlike SnapshotAutoVersioner, the same resources always get the same version,
+// also in other instances of the Control Plane and after its restart.
+// This way the client can resume the stream by sending the version of the resources it already has,
+// and it receives only the types of resources that changed in the meantime.
+// UUID is used when the resources cannot be hashed.
+type SnapshotHashVersioner struct {
+	UUID func() string
+}
+
+func (v SnapshotHashVersioner) Version(new, _ Snapshot) Snapshot {
+	if new == nil {
+		return nil
+	}
+	for _, typ := range new.GetSupportedTypes() {
+		if new.GetVersion(typ) != "" {
+			// favor a version assigned by resource generator
+			continue
+		}
+		version, err := v.hash(new.GetResources(typ))
+		if err != nil {
+			version = v.UUID()
+		}
+		new = new.WithVersion(typ, version)
+	}
+	return new
+}
+
+func (_ SnapshotHashVersioner) hash(resources map[string]envoy_types.Resource) (string, error) {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		bytes, err := proto_v2.MarshalOptions{Deterministic: true}.Marshal(proto.MessageV2(resources[name]))
+		if err != nil {
+			return "", err
+		}
+		// length prefixes keep the boundaries of the names and the resources unambiguous
+		_ = binary.Write(hash, binary.BigEndian, uint64(len(name)))
+		hash.Write([]byte(name))
+		_ = binary.Write(hash, binary.BigEndian, uint64(len(bytes)))
+		hash.Write(bytes)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
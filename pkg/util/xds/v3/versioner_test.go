@@ -0,0 +1,57 @@
+package v3_test
+
+import (
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/kds/cache"
+	util_xds_v3 "github.com/kumahq/kuma/pkg/util/xds/v3"
+)
+
+var _ = Describe("SnapshotHashVersioner", func() {
+
+	versioner := util_xds_v3.SnapshotHashVersioner{UUID: func() string {
+		return "uuid"
+	}}
+
+	snapshot := func(meshes ...string) util_xds_v3.Snapshot {
+		var resources []envoy_types.Resource
+		for _, mesh := range meshes {
+			spec, err := anypb.New(&mesh_proto.Mesh{})
+			Expect(err).ToNot(HaveOccurred())
+			resources = append(resources, &mesh_proto.KumaResource{
+				Meta: &mesh_proto.KumaResource_Meta{Name: mesh, Mesh: mesh},
+				Spec: spec,
+			})
+		}
+		return cache.NewSnapshotBuilder().
+			With(string(core_mesh.MeshType), resources).
+			Build("")
+	}
+
+	It("should assign the same version to the same resources regardless of the previous snapshot", func() {
+		// when
+		first := versioner.Version(snapshot("mesh-1", "mesh-2"), nil)
+		second := versioner.Version(snapshot("mesh-2", "mesh-1"), snapshot("mesh-3"))
+
+		// then
+		Expect(first.GetVersion(string(core_mesh.MeshType))).ToNot(BeEmpty())
+		Expect(first.GetVersion(string(core_mesh.MeshType))).To(Equal(second.GetVersion(string(core_mesh.MeshType))))
+	})
+
+	It("should assign a new version when resources change", func() {
+		// when
+		first := versioner.Version(snapshot("mesh-1"), nil)
+		second := versioner.Version(snapshot("mesh-1", "mesh-2"), first)
+		empty := versioner.Version(snapshot(), second)
+
+		// then
+		Expect(second.GetVersion(string(core_mesh.MeshType))).ToNot(Equal(first.GetVersion(string(core_mesh.MeshType))))
+		Expect(empty.GetVersion(string(core_mesh.MeshType))).ToNot(BeEmpty())
+		Expect(empty.GetVersion(string(core_mesh.MeshType))).ToNot(Equal(second.GetVersion(string(core_mesh.MeshType))))
+	})
+})
@@ -13,6 +13,7 @@ func RegisterBootstrap(rt core_runtime.Runtime) error {
 		rt.Config().DpServer.Auth.Type != dp_server.DpServerAuthNone,
 		rt.Config().DpServer.Auth.UseTokenPath,
 		rt.Config().DpServer.Hds.Enabled,
+		rt.Config().XdsServer.DeltaXds,
 		rt.Config().GetEnvoyAdminPort(),
 	)
 	if err != nil {
@@ -8,9 +8,11 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	bootstrap_config "github.com/kumahq/kuma/pkg/config/xds/bootstrap"
@@ -37,6 +39,7 @@ func NewDefaultBootstrapGenerator(
 	dpAuthEnabled bool,
 	dpUseTokenPath bool,
 	hdsEnabled bool,
+	deltaXds bool,
 	defaultAdminPort uint32,
 ) (BootstrapGenerator, error) {
 	hostsAndIps, err := hostsAndIPsFromCertFile(dpServerCertFile)
@@ -54,6 +57,7 @@ func NewDefaultBootstrapGenerator(
 		dpUseTokenPath:   dpUseTokenPath,
 		hostsAndIps:      hostsAndIps,
 		hdsEnabled:       hdsEnabled,
+		deltaXds:         deltaXds,
 		defaultAdminPort: defaultAdminPort,
 	}, nil
 }
@@ -66,6 +70,7 @@ type bootstrapGenerator struct {
 	xdsCertFile      string
 	hostsAndIps      SANSet
 	hdsEnabled       bool
+	deltaXds         bool
 	defaultAdminPort uint32
 }
 
@@ -99,10 +104,17 @@ func (b *bootstrapGenerator) Generate(ctx context.Context, request types.Bootstr
 		EmptyDNSPort:          request.EmptyDNSPort,
 		ProxyType:             request.ProxyType,
 		Features:              request.Features,
+		DeltaXds:              b.deltaXds,
+		EnvoyRuntime:          request.EnvoyRuntime,
+		StatsFlushInterval:    request.StatsFlushInterval,
+		OverloadManager:       overloadManagerFor(request.OverloadManager),
 	}
 	if params.ProxyType == "" {
 		params.ProxyType = string(mesh_proto.DataplaneProxyType)
 	}
+	if request.AdminSocket {
+		params.AdminSocketPath = envoy_common.EnvoyAdminSocketName(request.Name, request.Mesh)
+	}
 
 	setAdminPort := func(adminPortFromResource uint32) {
 		if adminPortFromResource != 0 {
@@ -147,10 +159,21 @@ func (b *bootstrapGenerator) Generate(ctx context.Context, request types.Bootstr
 		params.Service = dataplane.Spec.GetIdentifyingService()
 		setAdminPort(dataplane.Spec.GetNetworking().GetAdmin().GetPort())
 
-		err = b.getMetricsConfig(ctx, dataplane, &kumaDpBootstrap)
+		meshResource := core_mesh.NewMeshResource()
+		if err := b.resManager.Get(ctx, meshResource, core_store.GetByKey(dataplane.Meta.GetMesh(), core_model.NoMesh)); err != nil {
+			return nil, kumaDpBootstrap, err
+		}
+
+		err = b.getMetricsConfig(dataplane, meshResource, &kumaDpBootstrap)
 		if err != nil {
 			return nil, kumaDpBootstrap, err
 		}
+		kumaDpBootstrap.EnvoyConcurrency = dataplane.GetEnvoyRuntime(meshResource).GetConcurrency().GetValue()
+		params.Stats = meshResource.Spec.GetMetrics().GetStats()
+		// overload manager configured by kuma-dp takes precedence over the one defined in Mesh
+		if params.OverloadManager == nil {
+			params.OverloadManager = sidecarOverloadManagerFor(meshResource.Spec.GetSidecarResources())
+		}
 
 	default:
 		return nil, kumaDpBootstrap, errors.Errorf("unknown proxy type %v", params.ProxyType)
@@ -191,15 +214,10 @@ func ISSANMismatchErr(err error) bool {
 }
 
 func (b *bootstrapGenerator) getMetricsConfig(
-	ctx context.Context,
 	dataplane *core_mesh.DataplaneResource,
+	meshResource *core_mesh.MeshResource,
 	kumaDpBootstrap *KumaDpBootstrap,
 ) error {
-	meshResource := core_mesh.NewMeshResource()
-	err := b.resManager.Get(ctx, meshResource, core_store.GetByKey(dataplane.Meta.GetMesh(), core_model.NoMesh))
-	if err != nil {
-		return err
-	}
 	config, err := dataplane.GetPrometheusConfig(meshResource)
 	if err != nil {
 		return err
@@ -231,7 +249,75 @@ func (b *bootstrapGenerator) validateRequest(request types.BootstrapRequest) err
 			return SANMismatchErr(request.Host, b.hostsAndIps.slice())
 		}
 	}
-	return nil
+	return validateEnvoyOverrides(request)
+}
+
+// validateEnvoyOverrides validates the parts of the bootstrap provided by a user,
+// so a misconfigured data plane proxy is rejected by the bootstrap server instead of Envoy.
+func validateEnvoyOverrides(request types.BootstrapRequest) error {
+	var verr validators.ValidationError
+	if _, ok := request.EnvoyRuntime[""]; ok {
+		verr.AddViolation("envoyRuntime", "keys cannot be empty")
+	}
+	if request.StatsFlushInterval != 0 && (request.StatsFlushInterval < time.Millisecond || request.StatsFlushInterval >= 5*time.Minute) {
+		verr.AddViolation("statsFlushInterval", "must be greater than or equal to 1ms and less than 5m")
+	}
+	if overloadManager := request.OverloadManager; overloadManager != nil {
+		path := validators.RootedAt("overloadManager")
+		if overloadManager.MaxHeapSizeBytes == 0 {
+			verr.AddViolationAt(path.Field("maxHeapSizeBytes"), "must be greater than 0")
+		}
+		if overloadManager.ShrinkHeapThreshold < 0 || overloadManager.ShrinkHeapThreshold > 1 {
+			verr.AddViolationAt(path.Field("shrinkHeapThreshold"), "must be in [0, 1] range")
+		}
+		if overloadManager.StopAcceptingRequestsThreshold < 0 || overloadManager.StopAcceptingRequestsThreshold > 1 {
+			verr.AddViolationAt(path.Field("stopAcceptingRequestsThreshold"), "must be in [0, 1] range")
+		}
+	}
+	return verr.OrNil()
+}
+
+const (
+	defaultShrinkHeapThreshold               = 0.95
+	defaultDisableHttpKeepaliveThreshold     = 0.95
+	defaultStopAcceptingRequestsThreshold    = 0.98
+	defaultStopAcceptingConnectionsThreshold = 0.98
+)
+
+func overloadManagerFor(overloadManager *types.OverloadManager) *overloadManagerParameters {
+	if overloadManager == nil {
+		return nil
+	}
+	params := &overloadManagerParameters{
+		MaxHeapSizeBytes:               overloadManager.MaxHeapSizeBytes,
+		ShrinkHeapThreshold:            overloadManager.ShrinkHeapThreshold,
+		StopAcceptingRequestsThreshold: overloadManager.StopAcceptingRequestsThreshold,
+	}
+	if params.ShrinkHeapThreshold == 0 {
+		params.ShrinkHeapThreshold = defaultShrinkHeapThreshold
+	}
+	if params.StopAcceptingRequestsThreshold == 0 {
+		params.StopAcceptingRequestsThreshold = defaultStopAcceptingRequestsThreshold
+	}
+	return params
+}
+
+func sidecarOverloadManagerFor(resources *mesh_proto.SidecarResources) *overloadManagerParameters {
+	if resources == nil {
+		return nil
+	}
+	thresholdOrDefault := func(threshold *wrapperspb.DoubleValue, def float64) float64 {
+		if threshold == nil {
+			return def
+		}
+		return threshold.GetValue()
+	}
+	return &overloadManagerParameters{
+		MaxHeapSizeBytes:                  resources.GetMaxHeapSizeBytes(),
+		ShrinkHeapThreshold:               thresholdOrDefault(resources.GetShrinkHeapThreshold(), defaultShrinkHeapThreshold),
+		DisableHttpKeepaliveThreshold:     thresholdOrDefault(resources.GetDisableHttpKeepaliveThreshold(), defaultDisableHttpKeepaliveThreshold),
+		StopAcceptingConnectionsThreshold: thresholdOrDefault(resources.GetStopAcceptingConnectionsThreshold(), defaultStopAcceptingConnectionsThreshold),
+	}
 }
 
 // dataplaneFor returns dataplane for two flows
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"time"
 
+	envoy_bootstrap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -100,6 +101,7 @@ var _ = Describe("bootstrapGenerator", func() {
 		request            types.BootstrapRequest
 		expectedConfigFile string
 		hdsEnabled         bool
+		deltaXds           bool
 	}
 	DescribeTable("should generate bootstrap configuration",
 		func(given testCase) {
@@ -107,7 +109,7 @@ var _ = Describe("bootstrapGenerator", func() {
 			err := resManager.Create(context.Background(), given.dataplane(), store.CreateByKey("name.namespace", "mesh"))
 			Expect(err).ToNot(HaveOccurred())
 
-			generator, err := NewDefaultBootstrapGenerator(resManager, given.config(), filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), given.dpAuthEnabled, given.useTokenPath, given.hdsEnabled, 0)
+			generator, err := NewDefaultBootstrapGenerator(resManager, given.config(), filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), given.dpAuthEnabled, given.useTokenPath, given.hdsEnabled, given.deltaXds, 0)
 			Expect(err).ToNot(HaveOccurred())
 
 			// when
@@ -138,6 +140,24 @@ var _ = Describe("bootstrapGenerator", func() {
 			expectedConfigFile: "generator.default-config-minimal-request.golden.yaml",
 			hdsEnabled:         true,
 		}),
+		Entry("default config with delta xDS", testCase{
+			dpAuthEnabled: false,
+			config: func() *bootstrap_config.BootstrapServerConfig {
+				cfg := bootstrap_config.DefaultBootstrapServerConfig()
+				cfg.Params.XdsHost = "localhost"
+				cfg.Params.XdsPort = 5678
+				return cfg
+			},
+			dataplane: defaultDataplane,
+			request: types.BootstrapRequest{
+				Mesh:    "mesh",
+				Name:    "name.namespace",
+				Version: defaultVersion,
+			},
+			expectedConfigFile: "generator.default-config-delta-xds.golden.yaml",
+			hdsEnabled:         true,
+			deltaXds:           true,
+		}),
 		Entry("default config", testCase{
 			dpAuthEnabled: true,
 			config: func() *bootstrap_config.BootstrapServerConfig {
@@ -364,6 +384,67 @@ var _ = Describe("bootstrapGenerator", func() {
 			expectedConfigFile: "generator.default-config.golden.yaml",
 			hdsEnabled:         true,
 		}),
+		Entry("default config with admin socket", testCase{
+			dpAuthEnabled: true,
+			config: func() *bootstrap_config.BootstrapServerConfig {
+				cfg := bootstrap_config.DefaultBootstrapServerConfig()
+				cfg.Params.XdsHost = "localhost"
+				cfg.Params.XdsPort = 5678
+				return cfg
+			},
+			dataplane: func() *core_mesh.DataplaneResource {
+				dp := defaultDataplane()
+				dp.Spec.Networking.Admin.Port = 1234
+				return dp
+			},
+			request: types.BootstrapRequest{
+				Mesh:           "mesh",
+				Name:           "name.namespace",
+				AdminSocket:    true,
+				DataplaneToken: "token",
+				Version:        defaultVersion,
+				DNSPort:        53001,
+				EmptyDNSPort:   53002,
+			},
+			expectedConfigFile: "generator.admin-socket.golden.yaml",
+			hdsEnabled:         true,
+		}),
+		Entry("default config with Envoy overrides", testCase{
+			dpAuthEnabled: true,
+			config: func() *bootstrap_config.BootstrapServerConfig {
+				cfg := bootstrap_config.DefaultBootstrapServerConfig()
+				cfg.Params.XdsHost = "localhost"
+				cfg.Params.XdsPort = 5678
+				return cfg
+			},
+			dataplane: func() *core_mesh.DataplaneResource {
+				dp := defaultDataplane()
+				dp.Spec.Networking.Admin.Port = 1234
+				return dp
+			},
+			request: types.BootstrapRequest{
+				Mesh:           "mesh",
+				Name:           "name.namespace",
+				DataplaneToken: "token",
+				Version:        defaultVersion,
+				DNSPort:        53001,
+				EmptyDNSPort:   53002,
+				EnvoyRuntime: map[string]string{
+					"re2.max_program_size.error_level":            "200",
+					"overload.global_downstream_max_connections":  "1000",
+					"envoy.reloadable_features.header_validation": "false",
+					"upstream.healthy_panic_threshold":            "25.5",
+					"custom.value":                                "text",
+				},
+				StatsFlushInterval: 10 * time.Second,
+				OverloadManager: &types.OverloadManager{
+					MaxHeapSizeBytes:    1073741824,
+					ShrinkHeapThreshold: 0.9,
+				},
+			},
+			expectedConfigFile: "generator.envoy-overrides.golden.yaml",
+			hdsEnabled:         true,
+		}),
 		Entry("default config with useTokenPath", testCase{
 			dpAuthEnabled: true,
 			config: func() *bootstrap_config.BootstrapServerConfig {
@@ -404,7 +485,7 @@ var _ = Describe("bootstrapGenerator", func() {
 
 			cfg := bootstrap_config.DefaultBootstrapServerConfig()
 
-			generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, false, true, 9901)
+			generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), false, false, true, false, 9901)
 			Expect(err).ToNot(HaveOccurred())
 
 			// when
@@ -426,6 +507,19 @@ var _ = Describe("bootstrapGenerator", func() {
 2) Set KUMA_GENERAL_TLS_CERT_FILE and KUMA_GENERAL_TLS_KEY_FILE or the equivalent in Kuma CP config file to the new certificate.
 3) Restart the control plane to read the new certificate and start kuma-dp.`,
 		}),
+		Entry("due to invalid Envoy overrides", errTestCase{
+			request: types.BootstrapRequest{
+				Host:               "localhost",
+				Mesh:               "mesh",
+				Name:               "name.namespace",
+				EnvoyRuntime:       map[string]string{"": "1"},
+				StatsFlushInterval: 10 * time.Minute,
+				OverloadManager: &types.OverloadManager{
+					StopAcceptingRequestsThreshold: 1.5,
+				},
+			},
+			expected: "envoyRuntime: keys cannot be empty; statsFlushInterval: must be greater than or equal to 1ms and less than 5m; overloadManager.maxHeapSizeBytes: must be greater than 0; overloadManager.stopAcceptingRequestsThreshold: must be in [0, 1] range",
+		}),
 		Entry("when CaCert is not a CA and EnvoyGRPC is used", errTestCase{
 			request: types.BootstrapRequest{
 				Host:           "localhost",
@@ -537,7 +631,7 @@ Provide CA that was used to sign a certificate used in the control plane by usin
 		err = resManager.Create(context.Background(), dataplane, store.CreateByKey("name.namespace", "metrics"))
 		Expect(err).ToNot(HaveOccurred())
 
-		generator, err := NewDefaultBootstrapGenerator(resManager, config(), filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), true, false, false, 0)
+		generator, err := NewDefaultBootstrapGenerator(resManager, config(), filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), true, false, false, false, 0)
 		Expect(err).ToNot(HaveOccurred())
 
 		// when
@@ -628,7 +722,7 @@ Provide CA that was used to sign a certificate used in the control plane by usin
 		err = resManager.Create(context.Background(), dataplane, store.CreateByKey("name.namespace", "metrics"))
 		Expect(err).ToNot(HaveOccurred())
 
-		generator, err := NewDefaultBootstrapGenerator(resManager, config(), filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), true, false, false, 0)
+		generator, err := NewDefaultBootstrapGenerator(resManager, config(), filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), true, false, false, false, 0)
 		Expect(err).ToNot(HaveOccurred())
 
 		// when
@@ -658,4 +752,231 @@ Provide CA that was used to sign a certificate used in the control plane by usin
 			},
 		}))
 	})
+
+	It("should take Envoy concurrency from Dataplane over Mesh", func() {
+		// given
+		err := resManager.Create(context.Background(), &core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				EnvoyRuntime: &mesh_proto.EnvoyRuntime{
+					Concurrency: util_proto.UInt32(4),
+				},
+			},
+		}, store.CreateByKey("runtime", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		dataplane := &core_mesh.DataplaneResource{
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "8.8.8.8",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port:        443,
+							ServicePort: 8443,
+							Tags: map[string]string{
+								"kuma.io/service": "backend",
+							},
+						},
+					},
+					Admin: &mesh_proto.EnvoyAdmin{},
+				},
+				EnvoyRuntime: &mesh_proto.EnvoyRuntime{
+					Concurrency: util_proto.UInt32(2),
+				},
+			},
+		}
+		err = resManager.Create(context.Background(), dataplane, store.CreateByKey("name.namespace", "runtime"))
+		Expect(err).ToNot(HaveOccurred())
+
+		cfg := bootstrap_config.DefaultBootstrapServerConfig()
+		cfg.Params.XdsHost = "localhost"
+		cfg.Params.XdsPort = 5678
+
+		generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), true, false, false, false, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, configParam, err := generator.Generate(context.Background(), types.BootstrapRequest{
+			Mesh:           "runtime",
+			Name:           "name.namespace",
+			DataplaneToken: "token",
+			Version:        defaultVersion,
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configParam.EnvoyConcurrency).To(Equal(uint32(2)))
+	})
+
+	It("should take stats tags from Mesh", func() {
+		// given
+		err := resManager.Create(context.Background(), &core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				Metrics: &mesh_proto.Metrics{
+					Stats: &mesh_proto.Metrics_Stats{
+						Tags: []*mesh_proto.Metrics_Stats_Tag{
+							{Name: "envoy_cluster_name"},
+							{Name: "listener", Regex: "^listener\\.((.+?)\\.)"},
+							{Name: "zone", FixedValue: "zone-1"},
+						},
+						UseAllDefaultTags: util_proto.Bool(false),
+					},
+				},
+			},
+		}, store.CreateByKey("stats", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		dataplane := &core_mesh.DataplaneResource{
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "8.8.8.8",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port:        443,
+							ServicePort: 8443,
+							Tags: map[string]string{
+								"kuma.io/service": "backend",
+							},
+						},
+					},
+				},
+			},
+		}
+		err = resManager.Create(context.Background(), dataplane, store.CreateByKey("name.namespace", "stats"))
+		Expect(err).ToNot(HaveOccurred())
+
+		cfg := bootstrap_config.DefaultBootstrapServerConfig()
+		cfg.Params.XdsHost = "localhost"
+		cfg.Params.XdsPort = 5678
+
+		generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), true, false, false, false, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		bootstrapConfig, _, err := generator.Generate(context.Background(), types.BootstrapRequest{
+			Mesh:           "stats",
+			Name:           "name.namespace",
+			DataplaneToken: "token",
+			Version:        defaultVersion,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		actual, err := util_proto.ToYAML(bootstrapConfig.(*envoy_bootstrap_v3.Bootstrap).GetStatsConfig())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+            statsTags:
+            - regex: ^grpc\.((.+)\.)
+              tagName: name
+            - regex: ^grpc.*streams_closed(_([0-9]+))
+              tagName: status
+            - regex: ^kafka(\.(\S*[0-9]))\.
+              tagName: kafka_name
+            - regex: ^kafka\..*\.(.*)
+              tagName: kafka_type
+            - regex: (worker_([0-9]+)\.)
+              tagName: worker
+            - tagName: envoy_cluster_name
+            - regex: ^listener\.((.+?)\.)
+              tagName: listener
+            - fixedValue: zone-1
+              tagName: zone
+            useAllDefaultTags: false
+`))
+	})
+
+	It("should take overload manager from Mesh sidecar resources", func() {
+		// given
+		err := resManager.Create(context.Background(), &core_mesh.MeshResource{
+			Spec: &mesh_proto.Mesh{
+				SidecarResources: &mesh_proto.SidecarResources{
+					MaxHeapSizeBytes:    536870912,
+					ShrinkHeapThreshold: util_proto.Double(0.9),
+				},
+			},
+		}, store.CreateByKey("resources", model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		dataplane := &core_mesh.DataplaneResource{
+			Spec: &mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "8.8.8.8",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port:        443,
+							ServicePort: 8443,
+							Tags: map[string]string{
+								"kuma.io/service": "backend",
+							},
+						},
+					},
+				},
+			},
+		}
+		err = resManager.Create(context.Background(), dataplane, store.CreateByKey("name.namespace", "resources"))
+		Expect(err).ToNot(HaveOccurred())
+
+		cfg := bootstrap_config.DefaultBootstrapServerConfig()
+		cfg.Params.XdsHost = "localhost"
+		cfg.Params.XdsPort = 5678
+
+		generator, err := NewDefaultBootstrapGenerator(resManager, cfg, filepath.Join("..", "..", "..", "test", "certs", "server-cert.pem"), true, false, false, false, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		bootstrapConfig, _, err := generator.Generate(context.Background(), types.BootstrapRequest{
+			Mesh:           "resources",
+			Name:           "name.namespace",
+			DataplaneToken: "token",
+			Version:        defaultVersion,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		actual, err := util_proto.ToYAML(bootstrapConfig.(*envoy_bootstrap_v3.Bootstrap).GetOverloadManager())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+            actions:
+            - name: envoy.overload_actions.shrink_heap
+              triggers:
+              - name: envoy.resource_monitors.fixed_heap
+                threshold:
+                  value: 0.9
+            - name: envoy.overload_actions.disable_http_keepalive
+              triggers:
+              - name: envoy.resource_monitors.fixed_heap
+                threshold:
+                  value: 0.95
+            - name: envoy.overload_actions.stop_accepting_connections
+              triggers:
+              - name: envoy.resource_monitors.fixed_heap
+                threshold:
+                  value: 0.98
+            refreshInterval: 0.250s
+            resourceMonitors:
+            - name: envoy.resource_monitors.fixed_heap
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.resource_monitors.fixed_heap.v3.FixedHeapConfig
+                maxHeapSizeBytes: "536870912"
+`))
+
+		// when overload manager is configured by kuma-dp
+		bootstrapConfig, _, err = generator.Generate(context.Background(), types.BootstrapRequest{
+			Mesh:           "resources",
+			Name:           "name.namespace",
+			DataplaneToken: "token",
+			Version:        defaultVersion,
+			OverloadManager: &types.OverloadManager{
+				MaxHeapSizeBytes: 1073741824,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// then it takes precedence over the one defined in Mesh
+		overloadManager := bootstrapConfig.(*envoy_bootstrap_v3.Bootstrap).GetOverloadManager()
+		Expect(overloadManager.GetActions()).To(HaveLen(2))
+		Expect(overloadManager.GetActions()[1].GetName()).To(Equal("envoy.overload_actions.stop_accepting_requests"))
+	})
 })
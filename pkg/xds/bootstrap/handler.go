@@ -126,6 +126,9 @@ func createBootstrapResponse(bootstrap []byte, config *KumaDpBootstrap) *types.B
 		Metrics: types.MetricsConfiguration{
 			Aggregate: aggregate,
 		},
+		Envoy: types.EnvoyConfiguration{
+			Concurrency: config.EnvoyConcurrency,
+		},
 	}
 	return &bootstrapConfig
 }
@@ -1,9 +1,14 @@
 package bootstrap
 
-import "time"
+import (
+	"time"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
 
 type KumaDpBootstrap struct {
 	AggregateMetricsConfig []AggregateMetricsConfig
+	EnvoyConcurrency       uint32
 }
 
 type AggregateMetricsConfig struct {
@@ -17,6 +22,7 @@ type configParameters struct {
 	Service               string
 	AdminAddress          string
 	AdminPort             uint32
+	AdminSocketPath       string
 	AdminAccessLogPath    string
 	XdsHost               string
 	XdsPort               uint32
@@ -39,4 +45,19 @@ type configParameters struct {
 	EmptyDNSPort          uint32
 	ProxyType             string
 	Features              []string
+	DeltaXds              bool
+	Stats                 *mesh_proto.Metrics_Stats
+	EnvoyRuntime          map[string]string
+	StatsFlushInterval    time.Duration
+	OverloadManager       *overloadManagerParameters
+}
+
+// overloadManagerParameters holds the heap thresholds of the overload actions.
+// The action is not configured when its threshold is 0.
+type overloadManagerParameters struct {
+	MaxHeapSizeBytes                  uint64
+	ShrinkHeapThreshold               float64
+	DisableHttpKeepaliveThreshold     float64
+	StopAcceptingRequestsThreshold    float64
+	StopAcceptingConnectionsThreshold float64
 }
@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"net"
 	"strconv"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	envoy_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
@@ -12,11 +13,14 @@ import (
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_grpc_credentials_v3 "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v3"
 	envoy_metrics_v3 "github.com/envoyproxy/go-control-plane/envoy/config/metrics/v3"
+	envoy_overload_v3 "github.com/envoyproxy/go-control-plane/envoy/config/overload/v3"
 	access_loggers_file "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoy_fixed_heap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/resource_monitors/fixed_heap/v3"
 	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/pkg/errors"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	clusters_v3 "github.com/kumahq/kuma/pkg/xds/envoy/clusters/v3"
 	"github.com/kumahq/kuma/pkg/xds/envoy/tls"
@@ -32,6 +36,34 @@ func RegisterBootstrapCluster(c string) string {
 var adsClusterName = RegisterBootstrapCluster("ads_cluster")
 var accessLogSinkClusterName = RegisterBootstrapCluster("access_log_sink")
 
+// kumaStatsTags are the tags extracted from the names of the stats of Kuma in addition to the default tags of Envoy
+var kumaStatsTags = []*envoy_metrics_v3.TagSpecifier{
+	{
+		TagName:  "name",
+		TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "^grpc\\.((.+)\\.)"},
+	},
+	{
+		TagName:  "status",
+		TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "^grpc.*streams_closed(_([0-9]+))"},
+	},
+	{
+		TagName:  "kafka_name",
+		TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "^kafka(\\.(\\S*[0-9]))\\."},
+	},
+	{
+		TagName:  "kafka_type",
+		TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "^kafka\\..*\\.(.*)"},
+	},
+	{
+		TagName:  "worker",
+		TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "(worker_([0-9]+)\\.)"},
+	},
+	{
+		TagName:  "listener",
+		TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "((.+?)\\.)rbac\\."},
+	},
+}
+
 func genConfig(parameters configParameters, useTokenPath bool) (*envoy_bootstrap_v3.Bootstrap, error) {
 	staticClusters, err := buildStaticClusters(parameters, useTokenPath)
 	if err != nil {
@@ -80,32 +112,8 @@ func genConfig(parameters configParameters, useTokenPath bool) (*envoy_bootstrap
 			},
 		},
 		StatsConfig: &envoy_metrics_v3.StatsConfig{
-			StatsTags: []*envoy_metrics_v3.TagSpecifier{
-				{
-					TagName:  "name",
-					TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "^grpc\\.((.+)\\.)"},
-				},
-				{
-					TagName:  "status",
-					TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "^grpc.*streams_closed(_([0-9]+))"},
-				},
-				{
-					TagName:  "kafka_name",
-					TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "^kafka(\\.(\\S*[0-9]))\\."},
-				},
-				{
-					TagName:  "kafka_type",
-					TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "^kafka\\..*\\.(.*)"},
-				},
-				{
-					TagName:  "worker",
-					TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "(worker_([0-9]+)\\.)"},
-				},
-				{
-					TagName:  "listener",
-					TagValue: &envoy_metrics_v3.TagSpecifier_Regex{Regex: "((.+?)\\.)rbac\\."},
-				},
-			},
+			StatsTags:         buildStatsTags(parameters.Stats.GetTags()),
+			UseAllDefaultTags: parameters.Stats.GetUseAllDefaultTags(),
 		},
 		DynamicResources: &envoy_bootstrap_v3.Bootstrap_DynamicResources{
 			LdsConfig: &envoy_core_v3.ConfigSource{
@@ -117,7 +125,7 @@ func genConfig(parameters configParameters, useTokenPath bool) (*envoy_bootstrap
 				ResourceApiVersion:    envoy_core_v3.ApiVersion_V3,
 			},
 			AdsConfig: &envoy_core_v3.ApiConfigSource{
-				ApiType:                   envoy_core_v3.ApiConfigSource_GRPC,
+				ApiType:                   adsApiType(parameters),
 				TransportApiVersion:       envoy_core_v3.ApiVersion_V3,
 				SetNodeOnFirstMessageOnly: true,
 				GrpcServices: []*envoy_core_v3.GrpcService{
@@ -175,6 +183,25 @@ func genConfig(parameters configParameters, useTokenPath bool) (*envoy_bootstrap
 			}
 		}
 	}
+	if len(parameters.EnvoyRuntime) != 0 {
+		// the layer of the user goes after the layer of Kuma, so the user can override the defaults
+		res.LayeredRuntime.Layers = append(res.LayeredRuntime.Layers, &envoy_bootstrap_v3.RuntimeLayer{
+			Name: "custom",
+			LayerSpecifier: &envoy_bootstrap_v3.RuntimeLayer_StaticLayer{
+				StaticLayer: util_proto.MustStruct(runtimeValues(parameters.EnvoyRuntime)),
+			},
+		})
+	}
+	if parameters.StatsFlushInterval != 0 {
+		res.StatsFlushInterval = util_proto.Duration(parameters.StatsFlushInterval)
+	}
+	if parameters.OverloadManager != nil {
+		overloadManager, err := buildOverloadManager(parameters.OverloadManager)
+		if err != nil {
+			return nil, err
+		}
+		res.OverloadManager = overloadManager
+	}
 	if parameters.HdsEnabled {
 		res.HdsConfig = &envoy_core_v3.ApiConfigSource{
 			ApiType:                   envoy_core_v3.ApiConfigSource_GRPC,
@@ -219,6 +246,19 @@ func genConfig(parameters configParameters, useTokenPath bool) (*envoy_bootstrap
 				},
 			},
 		}
+		if parameters.AdminSocketPath != "" {
+			// Admin API is reachable only by the processes of the same user,
+			// the Control Plane reaches it through the admin port exposed by kuma-dp that accepts only connections secured with mTLS.
+			res.Node.Metadata.Fields["dataplane.admin.socket"] = util_proto.MustNewValueForStruct(parameters.AdminSocketPath)
+			res.Admin.Address = &envoy_core_v3.Address{
+				Address: &envoy_core_v3.Address_Pipe{
+					Pipe: &envoy_core_v3.Pipe{
+						Path: parameters.AdminSocketPath,
+						Mode: 0o600,
+					},
+				},
+			}
+		}
 		if parameters.AdminAccessLogPath != "" {
 			fileAccessLog := &access_loggers_file.FileAccessLog{
 				Path: parameters.AdminAccessLogPath,
@@ -424,3 +464,94 @@ func buildStaticClusters(parameters configParameters, useTokenPath bool) ([]*env
 	}
 	return clusters, nil
 }
+
+func adsApiType(parameters configParameters) envoy_core_v3.ApiConfigSource_ApiType {
+	if parameters.DeltaXds {
+		return envoy_core_v3.ApiConfigSource_DELTA_GRPC
+	}
+	return envoy_core_v3.ApiConfigSource_GRPC
+}
+
+// buildStatsTags returns the tags of Kuma with the tags of the mesh. A tag of the mesh replaces the tag of Kuma
+// with the same name, because Envoy rejects the tags that are specified twice.
+func buildStatsTags(meshTags []*mesh_proto.Metrics_Stats_Tag) []*envoy_metrics_v3.TagSpecifier {
+	meshTagNames := map[string]bool{}
+	for _, tag := range meshTags {
+		meshTagNames[tag.GetName()] = true
+	}
+	var tags []*envoy_metrics_v3.TagSpecifier
+	for _, tag := range kumaStatsTags {
+		if !meshTagNames[tag.GetTagName()] {
+			tags = append(tags, tag)
+		}
+	}
+	for _, tag := range meshTags {
+		specifier := &envoy_metrics_v3.TagSpecifier{
+			TagName: tag.GetName(),
+		}
+		switch {
+		case tag.GetRegex() != "":
+			specifier.TagValue = &envoy_metrics_v3.TagSpecifier_Regex{Regex: tag.GetRegex()}
+		case tag.GetFixedValue() != "":
+			specifier.TagValue = &envoy_metrics_v3.TagSpecifier_FixedValue{FixedValue: tag.GetFixedValue()}
+		}
+		tags = append(tags, specifier)
+	}
+	return tags
+}
+
+// runtimeValues converts the values to booleans and numbers when possible, because Envoy does not parse
+// string values of runtime keys that are feature flags or numbers.
+func runtimeValues(runtime map[string]string) map[string]interface{} {
+	values := map[string]interface{}{}
+	for key, value := range runtime {
+		if b, err := strconv.ParseBool(value); err == nil {
+			values[key] = b
+		} else if f, err := strconv.ParseFloat(value, 64); err == nil {
+			values[key] = f
+		} else {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+const fixedHeapResourceMonitor = "envoy.resource_monitors.fixed_heap"
+
+func buildOverloadManager(params *overloadManagerParameters) (*envoy_overload_v3.OverloadManager, error) {
+	fixedHeap, err := util_proto.MarshalAnyDeterministic(&envoy_fixed_heap_v3.FixedHeapConfig{
+		MaxHeapSizeBytes: params.MaxHeapSizeBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var actions []*envoy_overload_v3.OverloadAction
+	addThresholdAction := func(name string, threshold float64) {
+		if threshold == 0 {
+			return
+		}
+		actions = append(actions, &envoy_overload_v3.OverloadAction{
+			Name: name,
+			Triggers: []*envoy_overload_v3.Trigger{{
+				Name: fixedHeapResourceMonitor,
+				TriggerOneof: &envoy_overload_v3.Trigger_Threshold{
+					Threshold: &envoy_overload_v3.ThresholdTrigger{Value: threshold},
+				},
+			}},
+		})
+	}
+	addThresholdAction("envoy.overload_actions.shrink_heap", params.ShrinkHeapThreshold)
+	addThresholdAction("envoy.overload_actions.disable_http_keepalive", params.DisableHttpKeepaliveThreshold)
+	addThresholdAction("envoy.overload_actions.stop_accepting_requests", params.StopAcceptingRequestsThreshold)
+	addThresholdAction("envoy.overload_actions.stop_accepting_connections", params.StopAcceptingConnectionsThreshold)
+	return &envoy_overload_v3.OverloadManager{
+		RefreshInterval: util_proto.Duration(250 * time.Millisecond),
+		ResourceMonitors: []*envoy_overload_v3.ResourceMonitor{{
+			Name: fixedHeapResourceMonitor,
+			ConfigType: &envoy_overload_v3.ResourceMonitor_TypedConfig{
+				TypedConfig: fixedHeap,
+			},
+		}},
+		Actions: actions,
+	}, nil
+}
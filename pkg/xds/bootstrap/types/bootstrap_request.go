@@ -1,10 +1,13 @@
 package types
 
+import "time"
+
 type BootstrapRequest struct {
 	Mesh               string  `json:"mesh"`
 	Name               string  `json:"name"`
 	ProxyType          string  `json:"proxyType"`
 	AdminPort          uint32  `json:"adminPort,omitempty"`
+	AdminSocket        bool    `json:"adminSocket,omitempty"`
 	DataplaneToken     string  `json:"dataplaneToken,omitempty"`
 	DataplaneTokenPath string  `json:"dataplaneTokenPath,omitempty"`
 	DataplaneResource  string  `json:"dataplaneResource,omitempty"`
@@ -17,6 +20,18 @@ type BootstrapRequest struct {
 	EmptyDNSPort    uint32            `json:"emptyDnsPort,omitempty"`
 	OperatingSystem string            `json:"operatingSystem"`
 	Features        []string          `json:"features"`
+	// EnvoyRuntime are runtime key/values of Envoy put into a runtime layer on top of the defaults
+	EnvoyRuntime map[string]string `json:"envoyRuntime,omitempty"`
+	// StatsFlushInterval is an interval between flushes of Envoy stats to the sinks
+	StatsFlushInterval time.Duration `json:"statsFlushInterval,omitempty"`
+	// OverloadManager defines when Envoy protects itself from running out of memory
+	OverloadManager *OverloadManager `json:"overloadManager,omitempty"`
+}
+
+type OverloadManager struct {
+	MaxHeapSizeBytes               uint64  `json:"maxHeapSizeBytes"`
+	ShrinkHeapThreshold            float64 `json:"shrinkHeapThreshold,omitempty"`
+	StopAcceptingRequestsThreshold float64 `json:"stopAcceptingRequestsThreshold,omitempty"`
 }
 
 type Version struct {
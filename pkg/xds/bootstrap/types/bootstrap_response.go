@@ -18,6 +18,12 @@ type BootstrapResponse struct {
 
 type KumaSidecarConfiguration struct {
 	Metrics MetricsConfiguration `json:"metrics"`
+	Envoy   EnvoyConfiguration   `json:"envoy"`
+}
+
+type EnvoyConfiguration struct {
+	// Concurrency is a number of Envoy worker threads, 0 means that it's not specified by the control plane.
+	Concurrency uint32 `json:"concurrency,omitempty"`
 }
 
 type MetricsConfiguration struct {
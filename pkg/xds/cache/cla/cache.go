@@ -8,6 +8,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/xds"
 	"github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/xds/cache/once"
@@ -36,8 +37,8 @@ func NewCache(
 	}, nil
 }
 
-func (c *Cache) GetCLA(ctx context.Context, meshName, meshHash string, cluster envoy_common.Cluster, apiVersion envoy_common.APIVersion, endpointMap xds.EndpointMap) (proto.Message, error) {
-	key := sha256.Hash(fmt.Sprintf("%s:%s:%s:%s", apiVersion, meshName, cluster.Hash(), meshHash))
+func (c *Cache) GetCLA(ctx context.Context, mesh *core_mesh.MeshResource, meshHash string, cluster envoy_common.Cluster, apiVersion envoy_common.APIVersion, endpointMap xds.EndpointMap) (proto.Message, error) {
+	key := sha256.Hash(fmt.Sprintf("%s:%s:%s:%s", apiVersion, mesh.GetMeta().GetName(), cluster.Hash(), meshHash))
 
 	elt, err := c.cache.GetOrRetrieve(ctx, key, once.RetrieverFunc(func(ctx context.Context, key string) (interface{}, error) {
 		matchTags := map[string]string{}
@@ -57,7 +58,7 @@ func (c *Cache) GetCLA(ctx context.Context, meshName, meshHash string, cluster e
 				}
 			}
 		}
-		return envoy_endpoints.CreateClusterLoadAssignment(cluster.Name(), endpoints, apiVersion)
+		return envoy_endpoints.CreateLocalityAwareClusterLoadAssignment(cluster.Name(), endpoints, mesh.Spec.GetRouting(), apiVersion)
 	}))
 	if err != nil {
 		return nil, err
@@ -7,9 +7,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/xds"
 	core_metrics "github.com/kumahq/kuma/pkg/metrics"
 	"github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	"github.com/kumahq/kuma/pkg/xds/cache/cla"
 	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
 	envoy_endpoints "github.com/kumahq/kuma/pkg/xds/envoy/endpoints/v3"
@@ -18,6 +22,7 @@ import (
 var _ = Describe("ClusterLoadAssignment Cache", func() {
 	var claCache *cla.Cache
 	var metrics core_metrics.Metrics
+	var mesh *core_mesh.MeshResource
 
 	expiration := 2 * time.Second
 
@@ -28,6 +33,11 @@ var _ = Describe("ClusterLoadAssignment Cache", func() {
 
 		claCache, err = cla.NewCache(expiration, metrics)
 		Expect(err).ToNot(HaveOccurred())
+
+		mesh = &core_mesh.MeshResource{
+			Meta: &test_model.ResourceMeta{Name: "mesh-0"},
+			Spec: &mesh_proto.Mesh{},
+		}
 	})
 
 	It("should cache ClusterLoadAssignment", func() {
@@ -40,10 +50,10 @@ var _ = Describe("ClusterLoadAssignment Cache", func() {
 				},
 			},
 		}
-		cla1, err := claCache.GetCLA(context.Background(), "mesh-0", "", envoy_common.NewCluster(envoy_common.WithService("backend")), envoy_common.APIV3, endpointMap)
+		cla1, err := claCache.GetCLA(context.Background(), mesh, "", envoy_common.NewCluster(envoy_common.WithService("backend")), envoy_common.APIV3, endpointMap)
 		Expect(err).ToNot(HaveOccurred())
 
-		cla2, err := claCache.GetCLA(context.Background(), "mesh-0", "", envoy_common.NewCluster(envoy_common.WithService("backend")), envoy_common.APIV3, endpointMap)
+		cla2, err := claCache.GetCLA(context.Background(), mesh, "", envoy_common.NewCluster(envoy_common.WithService("backend")), envoy_common.APIV3, endpointMap)
 		Expect(err).ToNot(HaveOccurred())
 
 		Expect(cla1).To(BeIdenticalTo(cla2))
@@ -68,7 +78,7 @@ var _ = Describe("ClusterLoadAssignment Cache", func() {
 
 		// when
 		clusterBackend := envoy_common.NewCluster(envoy_common.WithService("backend"))
-		claBackend, err := claCache.GetCLA(context.Background(), "mesh-0", "", clusterBackend, envoy_common.APIV3, endpointMap)
+		claBackend, err := claCache.GetCLA(context.Background(), mesh, "", clusterBackend, envoy_common.APIV3, endpointMap)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -77,7 +87,7 @@ var _ = Describe("ClusterLoadAssignment Cache", func() {
 
 		// when
 		clusterWeb := envoy_common.NewCluster(envoy_common.WithService("web"))
-		claWeb, err := claCache.GetCLA(context.Background(), "mesh-0", "", clusterWeb, envoy_common.APIV3, endpointMap)
+		claWeb, err := claCache.GetCLA(context.Background(), mesh, "", clusterWeb, envoy_common.APIV3, endpointMap)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -111,7 +121,7 @@ var _ = Describe("ClusterLoadAssignment Cache", func() {
 			envoy_common.WithService("backend"),
 			envoy_common.WithTags(envoy_common.Tags{}.WithTags("version", "v1")),
 		)
-		claV1, err := claCache.GetCLA(context.Background(), "mesh-0", "", clusterV1, envoy_common.APIV3, endpointMap)
+		claV1, err := claCache.GetCLA(context.Background(), mesh, "", clusterV1, envoy_common.APIV3, endpointMap)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -123,7 +133,7 @@ var _ = Describe("ClusterLoadAssignment Cache", func() {
 			envoy_common.WithService("backend"),
 			envoy_common.WithTags(envoy_common.Tags{}.WithTags("version", "v2")),
 		)
-		claV2, err := claCache.GetCLA(context.Background(), "mesh-0", "", clusterV2, envoy_common.APIV3, endpointMap)
+		claV2, err := claCache.GetCLA(context.Background(), mesh, "", clusterV2, envoy_common.APIV3, endpointMap)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -131,4 +141,33 @@ var _ = Describe("ClusterLoadAssignment Cache", func() {
 		Expect(claV2).To(matchers.MatchProto(expectedCla))
 	})
 
+	It("should set overprovisioning factor when locality aware load balancing is enabled", func() {
+		// given
+		endpointMap := xds.EndpointMap{
+			"backend": []xds.Endpoint{
+				{
+					Target: "192.168.0.1",
+					Port:   uint32(1000),
+					Locality: &xds.Locality{
+						Zone: "zone-1",
+					},
+				},
+			},
+		}
+		mesh.Spec.Routing = &mesh_proto.Routing{
+			LocalityAwareLoadBalancing: true,
+			LocalityAwareLoadBalancingOptions: &mesh_proto.Routing_LocalityAwareLoadBalancingOptions{
+				OverprovisioningFactor: util_proto.UInt32(200),
+			},
+		}
+
+		// when
+		claBackend, err := claCache.GetCLA(context.Background(), mesh, "", envoy_common.NewCluster(envoy_common.WithService("backend")), envoy_common.APIV3, endpointMap)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		expectedCla := envoy_endpoints.CreateClusterLoadAssignment("backend", endpointMap["backend"])
+		envoy_endpoints.OverprovisioningFactor(expectedCla, 200)
+		Expect(claBackend).To(matchers.MatchProto(expectedCla))
+	})
 })
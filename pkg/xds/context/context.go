@@ -1,10 +1,13 @@
 package context
 
 import (
+	"time"
+
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	"github.com/kumahq/kuma/pkg/core/datasource"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/xds"
 	"github.com/kumahq/kuma/pkg/tls"
 	"github.com/kumahq/kuma/pkg/xds/secrets"
@@ -13,6 +16,30 @@ import (
 type Context struct {
 	ControlPlane *ControlPlaneContext
 	Mesh         MeshContext
+	// Trace records the steps of the generation. It's set only when the generation is explained.
+	Trace *GenerationTrace
+}
+
+// GenerationTrace records how long the steps of XDS generation took, in the order they were executed.
+type GenerationTrace struct {
+	Steps []GenerationStep
+}
+
+type GenerationStep struct {
+	Name     string
+	Duration time.Duration
+}
+
+// Record adds the step that started at the given time. It is a no-op on nil trace,
+// so generation that is not explained is not affected.
+func (t *GenerationTrace) Record(name string, start time.Time) {
+	if t == nil {
+		return
+	}
+	t.Steps = append(t.Steps, GenerationStep{
+		Name:     name,
+		Duration: time.Since(start),
+	})
 }
 
 type ConnectionInfo struct {
@@ -27,6 +54,8 @@ type ControlPlaneContext struct {
 	CLACache          xds.CLACache
 	Secrets           secrets.Secrets
 	Zone              string
+	// EnvoyTap adds the tap filter to HTTP inbound listeners, so their traffic can be captured through the Envoy Admin API.
+	EnvoyTap bool
 }
 
 // MeshContext contains shared data within one mesh that is required for generating XDS config.
@@ -44,6 +73,9 @@ type MeshContext struct {
 	VIPOutbounds        []*mesh_proto.Dataplane_Networking_Outbound
 	ServiceTLSReadiness map[string]bool
 	DataSourceLoader    datasource.Loader
+	// ResourceHashes are hashes of the resources taken into account in Hash by type of the resource.
+	// The Mesh, other Meshes and cross mesh resources are hashed together under MeshType.
+	ResourceHashes map[core_model.ResourceType]string
 }
 
 func (mc *MeshContext) GetTracingBackend(tt *core_mesh.TrafficTraceResource) *mesh_proto.TracingBackend {
@@ -80,6 +112,7 @@ func BuildControlPlaneContext(
 	claCache xds.CLACache,
 	secrets secrets.Secrets,
 	zone string,
+	envoyTap bool,
 ) (*ControlPlaneContext, error) {
 	adminKeyPair, err := tls.NewSelfSignedCert("admin", tls.ServerCertType, tls.DefaultKeyType, "localhost")
 	if err != nil {
@@ -91,5 +124,6 @@ func BuildControlPlaneContext(
 		CLACache:          claCache,
 		Secrets:           secrets,
 		Zone:              zone,
+		EnvoyTap:          envoyTap,
 	}, nil
 }
@@ -84,7 +84,7 @@ func (m *meshContextBuilder) BuildIfChanged(ctx context.Context, meshName string
 	}
 	m.resolveAddresses(resources)
 
-	newHash := m.hash(mesh, resources)
+	newHash, resourceHashes := m.hash(mesh, resources)
 	if newHash == hash {
 		return nil, nil
 	}
@@ -133,6 +133,7 @@ func (m *meshContextBuilder) BuildIfChanged(ctx context.Context, meshName string
 		VIPOutbounds:        outbounds,
 		ServiceTLSReadiness: m.resolveTLSReadiness(mesh, resources.ServiceInsights()),
 		DataSourceLoader:    datasource.NewStaticLoader(resources.Secrets().Items),
+		ResourceHashes:      resourceHashes,
 	}, nil
 }
 
@@ -313,28 +314,38 @@ func (m *meshContextBuilder) resolveAddresses(resources Resources) {
 	resources.Dataplanes().Items = dataplanes
 }
 
-func (m *meshContextBuilder) hash(mesh *core_mesh.MeshResource, resources Resources) string {
-	allResources := []core_model.Resource{
-		mesh,
+// hash returns the hash of all resources and the hashes of resources by type.
+// The Mesh, other Meshes and cross mesh resources are hashed together under MeshType.
+func (m *meshContextBuilder) hash(mesh *core_mesh.MeshResource, resources Resources) (string, map[core_model.ResourceType]string) {
+	var all []string
+	byType := map[core_model.ResourceType][]string{}
+	add := func(typ core_model.ResourceType, rs ...core_model.Resource) {
+		for _, r := range rs {
+			hash := m.hashResource(r)
+			all = append(all, hash)
+			byType[typ] = append(byType[typ], hash)
+		}
 	}
-	for _, rl := range resources.MeshLocalResources {
-		allResources = append(allResources, rl.GetItems()...)
+	add(core_mesh.MeshType, mesh)
+	for typ, rl := range resources.MeshLocalResources {
+		add(typ, rl.GetItems()...)
 	}
 	for _, ml := range resources.CrossMeshResources {
 		for _, rl := range ml {
-			allResources = append(allResources, rl.GetItems()...)
+			add(core_mesh.MeshType, rl.GetItems()...)
 		}
 	}
-	return sha256.Hash(m.hashResources(allResources...))
-}
 
-func (m *meshContextBuilder) hashResources(rs ...core_model.Resource) string {
-	hashes := []string{}
-	for _, r := range rs {
-		hashes = append(hashes, m.hashResource(r))
+	resourceHashes := map[core_model.ResourceType]string{}
+	for typ, hashes := range byType {
+		resourceHashes[typ] = joinHashes(hashes)
 	}
+	return joinHashes(all), resourceHashes
+}
+
+func joinHashes(hashes []string) string {
 	sort.Strings(hashes)
-	return strings.Join(hashes, ",")
+	return sha256.Hash(strings.Join(hashes, ","))
 }
 
 func (m *meshContextBuilder) hashResource(r core_model.Resource) string {
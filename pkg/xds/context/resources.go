@@ -50,6 +50,50 @@ func (r Resources) TrafficTraces() *core_mesh.TrafficTraceResourceList {
 	return r.ListOrEmpty(core_mesh.TrafficTraceType).(*core_mesh.TrafficTraceResourceList)
 }
 
+func (r Resources) LocalReplies() *core_mesh.LocalReplyResourceList {
+	return r.ListOrEmpty(core_mesh.LocalReplyType).(*core_mesh.LocalReplyResourceList)
+}
+
+func (r Resources) MeshBandwidthLimits() *core_mesh.MeshBandwidthLimitResourceList {
+	return r.ListOrEmpty(core_mesh.MeshBandwidthLimitType).(*core_mesh.MeshBandwidthLimitResourceList)
+}
+
+func (r Resources) MeshCORSes() *core_mesh.MeshCORSResourceList {
+	return r.ListOrEmpty(core_mesh.MeshCORSType).(*core_mesh.MeshCORSResourceList)
+}
+
+func (r Resources) MeshCompressions() *core_mesh.MeshCompressionResourceList {
+	return r.ListOrEmpty(core_mesh.MeshCompressionType).(*core_mesh.MeshCompressionResourceList)
+}
+
+func (r Resources) MeshExternalAuthzs() *core_mesh.MeshExternalAuthzResourceList {
+	return r.ListOrEmpty(core_mesh.MeshExternalAuthzType).(*core_mesh.MeshExternalAuthzResourceList)
+}
+
+func (r Resources) MeshJWTs() *core_mesh.MeshJWTResourceList {
+	return r.ListOrEmpty(core_mesh.MeshJWTType).(*core_mesh.MeshJWTResourceList)
+}
+
+func (r Resources) MeshHeaderModifiers() *core_mesh.MeshHeaderModifierResourceList {
+	return r.ListOrEmpty(core_mesh.MeshHeaderModifierType).(*core_mesh.MeshHeaderModifierResourceList)
+}
+
+func (r Resources) MeshRequestProtections() *core_mesh.MeshRequestProtectionResourceList {
+	return r.ListOrEmpty(core_mesh.MeshRequestProtectionType).(*core_mesh.MeshRequestProtectionResourceList)
+}
+
+func (r Resources) MeshProtocolOptions() *core_mesh.MeshProtocolOptionsResourceList {
+	return r.ListOrEmpty(core_mesh.MeshProtocolOptionsType).(*core_mesh.MeshProtocolOptionsResourceList)
+}
+
+func (r Resources) MeshZoneTrafficRoutes() *core_mesh.MeshZoneTrafficRouteResourceList {
+	return r.ListOrEmpty(core_mesh.MeshZoneTrafficRouteType).(*core_mesh.MeshZoneTrafficRouteResourceList)
+}
+
+func (r Resources) MeshWasmPlugins() *core_mesh.MeshWasmPluginResourceList {
+	return r.ListOrEmpty(core_mesh.MeshWasmPluginType).(*core_mesh.MeshWasmPluginResourceList)
+}
+
 func (r Resources) TrafficRoutes() *core_mesh.TrafficRouteResourceList {
 	return r.ListOrEmpty(core_mesh.TrafficRouteType).(*core_mesh.TrafficRouteResourceList)
 }
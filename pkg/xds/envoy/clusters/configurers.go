@@ -8,9 +8,9 @@ import (
 	v3 "github.com/kumahq/kuma/pkg/xds/envoy/clusters/v3"
 )
 
-func OutlierDetection(circuitBreaker *core_mesh.CircuitBreakerResource) ClusterBuilderOpt {
+func OutlierDetection(circuitBreaker *core_mesh.CircuitBreakerResource, protocol core_mesh.Protocol) ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
-		config.AddV3(&v3.OutlierDetectionConfigurer{CircuitBreaker: circuitBreaker})
+		config.AddV3(&v3.OutlierDetectionConfigurer{CircuitBreaker: circuitBreaker, Protocol: protocol})
 	})
 }
 
@@ -20,6 +20,20 @@ func CircuitBreaker(circuitBreaker *core_mesh.CircuitBreakerResource) ClusterBui
 	})
 }
 
+// ConnectionPool has to be configured after CircuitBreaker, because its limits override thresholds of the circuit breaker.
+func ConnectionPool(circuitBreaker *core_mesh.CircuitBreakerResource) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.ConnectionPoolConfigurer{CircuitBreaker: circuitBreaker})
+	})
+}
+
+// RetryBudget has to be configured after CircuitBreaker and ConnectionPool, because it extends their thresholds.
+func RetryBudget(retry *core_mesh.RetryResource) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.RetryBudgetConfigurer{Retry: retry})
+	})
+}
+
 func ClientSideMTLS(tracker core_xds.SecretsTracker, mesh *core_mesh.MeshResource, upstreamService string, upstreamTLSReady bool, tags []envoy.Tags) ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
 		config.AddV3(&v3.ClientSideMTLSConfigurer{
@@ -68,6 +82,15 @@ func ClientSideTLS(endpoints []core_xds.Endpoint) ClusterBuilderOpt {
 	})
 }
 
+// UpstreamProxyProtocol has to be configured after ClientSideTLS, because it wraps transport sockets configured by it.
+func UpstreamProxyProtocol(endpoints []core_xds.Endpoint) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.UpstreamProxyProtocolConfigurer{
+			Endpoints: endpoints,
+		})
+	})
+}
+
 func EdsCluster(name string) ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
 		config.AddV3(&v3.EdsClusterConfigurer{
@@ -147,6 +170,33 @@ func DefaultTimeout() ClusterBuilderOpt {
 	})
 }
 
+// ProtocolOptions applies MeshProtocolOptions to a cluster. The HTTP version is selected
+// only when selectUpstreamProtocol is true, i.e. for clusters of external services.
+func ProtocolOptions(conf *mesh_proto.MeshProtocolOptions_Conf, protocol core_mesh.Protocol, selectUpstreamProtocol bool) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.ProtocolOptionsConfigurer{
+			Protocol:               protocol,
+			Conf:                   conf,
+			SelectUpstreamProtocol: selectUpstreamProtocol,
+		})
+	})
+}
+
+// ZoneTrafficRoute has to be configured after HealthCheck, because weights of the zones cannot be used together
+// with 'fail_traffic_on_panic'.
+func ZoneTrafficRoute(conf *mesh_proto.MeshZoneTrafficRoute_Conf) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.ZoneTrafficRouteConfigurer{Conf: conf})
+	})
+}
+
+// ZoneIngressHealthCheck has to be configured after HealthCheck, because the HealthCheck policy takes precedence.
+func ZoneIngressHealthCheck(healthCheck *mesh_proto.Routing_ZoneIngressHealthCheck) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.ZoneIngressHealthCheckConfigurer{HealthCheck: healthCheck})
+	})
+}
+
 func PassThroughCluster(name string) ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
 		config.AddV3(&v3.PassThroughClusterConfigurer{
@@ -156,6 +206,18 @@ func PassThroughCluster(name string) ClusterBuilderOpt {
 	})
 }
 
+// DynamicForwardProxyCluster configures a cluster connecting to the hosts resolved by
+// the dynamic forward proxy filter that shares the DNS cache with the cluster.
+func DynamicForwardProxyCluster(name string, hasIPv6 bool, conf *mesh_proto.Networking_Outbound_DynamicForwardProxy) ClusterBuilderOpt {
+	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
+		config.AddV3(&v3.DynamicForwardProxyClusterConfigurer{
+			Name:     name,
+			DNSCache: envoy.DynamicForwardProxyDNSCache(name, hasIPv6, conf),
+		})
+		config.AddV3(&v3.AltStatNameConfigurer{})
+	})
+}
+
 func UpstreamBindConfig(address string, port uint32) ClusterBuilderOpt {
 	return ClusterBuilderOptFunc(func(config *ClusterBuilderConfig) {
 		config.AddV3(&v3.UpstreamBindConfigConfigurer{
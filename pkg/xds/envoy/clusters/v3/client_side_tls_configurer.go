@@ -29,14 +29,7 @@ func (c *ClientSideTLSConfigurer) Configure(cluster *envoy_cluster.Cluster) erro
 				sni = ep.Target
 			}
 
-			tlsContext, err := envoy_tls.UpstreamTlsContextOutsideMesh(
-				ep.ExternalService.CaCert,
-				ep.ExternalService.ClientCert,
-				ep.ExternalService.ClientKey,
-				ep.ExternalService.AllowRenegotiation,
-				ep.Target,
-				sni,
-			)
+			tlsContext, err := envoy_tls.UpstreamTlsContextOutsideMesh(ep.ExternalService, ep.Target, sni)
 			if err != nil {
 				return err
 			}
@@ -4,6 +4,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/core/xds"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
@@ -143,6 +144,100 @@ var _ = Describe("ClientSideTLSConfigurer", func() {
                         inlineBytes: Y2FjZXJ0
                   sni: custom
             type: EDS
+`}),
+		Entry("cluster with TLS and SAN and SPKI verification", testCase{
+			clusterName: "testCluster",
+			endpoints: []xds.Endpoint{
+				{
+					Target: "httpbin.org",
+					Port:   3000,
+					Tags:   nil,
+					Weight: 100,
+					ExternalService: &xds.ExternalService{
+						TLSEnabled: true,
+						CaCert:     []byte("cacert"),
+						SubjectAltNames: []*mesh_proto.ExternalService_Networking_TLS_SubjectAltName{
+							{
+								Value: "httpbin.org",
+							},
+							{
+								Match: mesh_proto.ExternalService_Networking_TLS_SubjectAltName_SUFFIX,
+								Value: ".httpbin.org",
+							},
+							{
+								Match: mesh_proto.ExternalService_Networking_TLS_SubjectAltName_REGEX,
+								Value: "^eu-[0-9]+\\.httpbin\\.org$",
+							},
+						},
+						SpkiPins: []string{"NvqYIYSbgK2vCJpQhObf77vv+bQWtc5ek5RIOwPiC9A="},
+					},
+				},
+			},
+
+			expected: `
+            connectTimeout: 5s
+            edsClusterConfig:
+              edsConfig:
+                ads: {}
+                resourceApiVersion: V3
+            name: testCluster
+            transportSocketMatches:
+            - match: {}
+              name: httpbin.org
+              transportSocket:
+                name: envoy.transport_sockets.tls
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext
+                  commonTlsContext:
+                    validationContext:
+                      matchSubjectAltNames:
+                      - exact: httpbin.org
+                      - suffix: .httpbin.org
+                      - safeRegex:
+                          googleRe2: {}
+                          regex: ^eu-[0-9]+\.httpbin\.org$
+                      trustedCa:
+                        inlineBytes: Y2FjZXJ0
+                      verifyCertificateSpki:
+                      - NvqYIYSbgK2vCJpQhObf77vv+bQWtc5ek5RIOwPiC9A=
+                  sni: httpbin.org
+            type: EDS
+`}),
+		Entry("cluster with TLS and SPKI pins without CA", testCase{
+			clusterName: "testCluster",
+			endpoints: []xds.Endpoint{
+				{
+					Target: "httpbin.org",
+					Port:   3000,
+					Tags:   nil,
+					Weight: 100,
+					ExternalService: &xds.ExternalService{
+						TLSEnabled: true,
+						SpkiPins:   []string{"NvqYIYSbgK2vCJpQhObf77vv+bQWtc5ek5RIOwPiC9A="},
+					},
+				},
+			},
+
+			expected: `
+            connectTimeout: 5s
+            edsClusterConfig:
+              edsConfig:
+                ads: {}
+                resourceApiVersion: V3
+            name: testCluster
+            transportSocketMatches:
+            - match: {}
+              name: httpbin.org
+              transportSocket:
+                name: envoy.transport_sockets.tls
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext
+                  commonTlsContext:
+                    validationContext:
+                      verifyCertificateSpki:
+                      - NvqYIYSbgK2vCJpQhObf77vv+bQWtc5ek5RIOwPiC9A=
+                  sni: httpbin.org
+            type: EDS
 `}),
 	)
 })
@@ -0,0 +1,78 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+type ConnectionPoolConfigurer struct {
+	CircuitBreaker *core_mesh.CircuitBreakerResource
+}
+
+var _ ClusterConfigurer = &ConnectionPoolConfigurer{}
+
+func (c *ConnectionPoolConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if c.CircuitBreaker == nil {
+		return nil
+	}
+	pool := c.CircuitBreaker.Spec.GetConf().GetConnectionPool()
+	if pool == nil {
+		return nil
+	}
+
+	if keepalive := pool.GetTcpKeepalive(); keepalive != nil {
+		cluster.UpstreamConnectionOptions = &envoy_cluster.UpstreamConnectionOptions{
+			TcpKeepalive: &envoy_config_core_v3.TcpKeepalive{
+				KeepaliveProbes:   keepalive.GetProbes(),
+				KeepaliveTime:     seconds(keepalive.GetTime()),
+				KeepaliveInterval: seconds(keepalive.GetInterval()),
+			},
+		}
+	}
+
+	cluster.PerConnectionBufferLimitBytes = pool.GetPerConnectionBufferLimitBytes()
+
+	if pool.GetMaxConnections() == nil && pool.GetMaxPendingRequests() == nil && pool.GetMaxRetries() == nil {
+		return nil
+	}
+	// limits of the connection pool take precedence over thresholds set by CircuitBreakerConfigurer
+	thresholds := defaultThresholds(cluster)
+	if pool.GetMaxConnections() != nil {
+		thresholds.MaxConnections = pool.GetMaxConnections()
+	}
+	if pool.GetMaxPendingRequests() != nil {
+		thresholds.MaxPendingRequests = pool.GetMaxPendingRequests()
+	}
+	if pool.GetMaxRetries() != nil {
+		thresholds.MaxRetries = pool.GetMaxRetries()
+	}
+	return nil
+}
+
+func defaultThresholds(cluster *envoy_cluster.Cluster) *envoy_cluster.CircuitBreakers_Thresholds {
+	if cluster.CircuitBreakers == nil {
+		cluster.CircuitBreakers = &envoy_cluster.CircuitBreakers{}
+	}
+	for _, thresholds := range cluster.CircuitBreakers.Thresholds {
+		if thresholds.Priority == envoy_config_core_v3.RoutingPriority_DEFAULT {
+			return thresholds
+		}
+	}
+	thresholds := &envoy_cluster.CircuitBreakers_Thresholds{
+		Priority: envoy_config_core_v3.RoutingPriority_DEFAULT,
+	}
+	cluster.CircuitBreakers.Thresholds = append(cluster.CircuitBreakers.Thresholds, thresholds)
+	return thresholds
+}
+
+func seconds(duration *durationpb.Duration) *wrapperspb.UInt32Value {
+	if duration == nil {
+		return nil
+	}
+	return util_proto.UInt32(uint32(duration.AsDuration().Seconds()))
+}
@@ -0,0 +1,129 @@
+package clusters_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+)
+
+var _ = Describe("ConnectionPoolConfigurer", func() {
+
+	type testCase struct {
+		circuitBreaker *core_mesh.CircuitBreakerResource
+		expected       string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+				Configure(clusters.EdsCluster("backend")).
+				Configure(clusters.CircuitBreaker(given.circuitBreaker)).
+				Configure(clusters.ConnectionPool(given.circuitBreaker)).
+				Configure(clusters.Timeout(DefaultTimeout(), core_mesh.ProtocolTCP)).
+				Build()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			actual, err := util_proto.ToYAML(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("connection pool", testCase{
+			circuitBreaker: &core_mesh.CircuitBreakerResource{
+				Spec: &mesh_proto.CircuitBreaker{
+					Conf: &mesh_proto.CircuitBreaker_Conf{
+						ConnectionPool: &mesh_proto.CircuitBreaker_Conf_ConnectionPool{
+							TcpKeepalive: &mesh_proto.CircuitBreaker_Conf_ConnectionPool_TcpKeepalive{
+								Probes:   util_proto.UInt32(3),
+								Time:     util_proto.Duration(90 * time.Second),
+								Interval: util_proto.Duration(10500 * time.Millisecond),
+							},
+							MaxConnections:                util_proto.UInt32(2048),
+							MaxPendingRequests:            util_proto.UInt32(4096),
+							MaxRetries:                    util_proto.UInt32(10),
+							PerConnectionBufferLimitBytes: util_proto.UInt32(65536),
+						},
+					},
+				},
+			},
+			expected: `
+        circuitBreakers:
+          thresholds:
+          - maxConnections: 2048
+            maxPendingRequests: 4096
+            maxRetries: 10
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        perConnectionBufferLimitBytes: 65536
+        type: EDS
+        upstreamConnectionOptions:
+          tcpKeepalive:
+            keepaliveInterval: 10
+            keepaliveProbes: 3
+            keepaliveTime: 90`,
+		}),
+		Entry("connection pool overriding thresholds", testCase{
+			circuitBreaker: &core_mesh.CircuitBreakerResource{
+				Spec: &mesh_proto.CircuitBreaker{
+					Conf: &mesh_proto.CircuitBreaker_Conf{
+						Thresholds: &mesh_proto.CircuitBreaker_Conf_Thresholds{
+							MaxConnections:     util_proto.UInt32(2),
+							MaxPendingRequests: util_proto.UInt32(3),
+							MaxRequests:        util_proto.UInt32(4),
+							MaxRetries:         util_proto.UInt32(5),
+						},
+						ConnectionPool: &mesh_proto.CircuitBreaker_Conf_ConnectionPool{
+							MaxConnections: util_proto.UInt32(2048),
+						},
+					},
+				},
+			},
+			expected: `
+        circuitBreakers:
+          thresholds:
+          - maxConnections: 2048
+            maxPendingRequests: 3
+            maxRequests: 4
+            maxRetries: 5
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS`,
+		}),
+		Entry("no connection pool", testCase{
+			circuitBreaker: &core_mesh.CircuitBreakerResource{
+				Spec: &mesh_proto.CircuitBreaker{
+					Conf: &mesh_proto.CircuitBreaker_Conf{
+						Detectors: &mesh_proto.CircuitBreaker_Conf_Detectors{
+							TotalErrors: &mesh_proto.CircuitBreaker_Conf_Detectors_Errors{},
+						},
+					},
+				},
+			},
+			expected: `
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS`,
+		}),
+	)
+})
@@ -0,0 +1,36 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_dynamic_forward_proxy_cluster "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/dynamic_forward_proxy/v3"
+	envoy_dynamic_forward_proxy "github.com/envoyproxy/go-control-plane/envoy/extensions/common/dynamic_forward_proxy/v3"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// DynamicForwardProxyClusterConfigurer configures a cluster that connects to the hosts
+// resolved by the dynamic forward proxy filter using the given DNS cache.
+type DynamicForwardProxyClusterConfigurer struct {
+	Name     string
+	DNSCache *envoy_dynamic_forward_proxy.DnsCacheConfig
+}
+
+var _ ClusterConfigurer = &DynamicForwardProxyClusterConfigurer{}
+
+func (d *DynamicForwardProxyClusterConfigurer) Configure(c *envoy_cluster.Cluster) error {
+	config, err := util_proto.MarshalAnyDeterministic(&envoy_dynamic_forward_proxy_cluster.ClusterConfig{
+		DnsCacheConfig: d.DNSCache,
+	})
+	if err != nil {
+		return err
+	}
+	c.Name = d.Name
+	c.ClusterDiscoveryType = &envoy_cluster.Cluster_ClusterType{
+		ClusterType: &envoy_cluster.Cluster_CustomClusterType{
+			Name:        "envoy.clusters.dynamic_forward_proxy",
+			TypedConfig: config,
+		},
+	}
+	c.LbPolicy = envoy_cluster.Cluster_CLUSTER_PROVIDED
+	return nil
+}
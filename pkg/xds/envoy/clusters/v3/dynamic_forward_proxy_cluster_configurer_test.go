@@ -0,0 +1,50 @@
+package clusters_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+)
+
+var _ = Describe("DynamicForwardProxyClusterConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// given
+		conf := &mesh_proto.Networking_Outbound_DynamicForwardProxy{
+			Domains:  []string{"*.s3.amazonaws.com"},
+			HostTtl:  util_proto.Duration(time.Minute),
+			MaxHosts: util_proto.UInt32(100),
+		}
+		expected := `
+        altStatName: outbound_dynamic_forward_proxy
+        clusterType:
+          name: envoy.clusters.dynamic_forward_proxy
+          typedConfig:
+            '@type': type.googleapis.com/envoy.extensions.clusters.dynamic_forward_proxy.v3.ClusterConfig
+            dnsCacheConfig:
+              dnsLookupFamily: V4_ONLY
+              hostTtl: 60s
+              maxHosts: 100
+              name: outbound:dynamic_forward_proxy
+        lbPolicy: CLUSTER_PROVIDED
+        name: outbound:dynamic_forward_proxy`
+
+		// when
+		cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+			Configure(clusters.DynamicForwardProxyCluster("outbound:dynamic_forward_proxy", false, conf)).
+			Build()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		actual, err := util_proto.ToYAML(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+})
@@ -2,14 +2,21 @@ package clusters
 
 import (
 	"net"
+	"strconv"
 
 	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_cares "github.com/envoyproxy/go-control-plane/envoy/extensions/network/dns_resolver/cares/v3"
 	"github.com/pkg/errors"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	envoy_endpoints "github.com/kumahq/kuma/pkg/xds/envoy/endpoints/v3"
 )
 
+const defaultDNSResolverPort = 53
+
 type ProvidedEndpointClusterConfigurer struct {
 	Name      string
 	Endpoints []xds.Endpoint
@@ -45,9 +52,84 @@ func (e *ProvidedEndpointClusterConfigurer) Configure(c *envoy_cluster.Cluster)
 		} else {
 			c.DnsLookupFamily = envoy_cluster.Cluster_V4_ONLY
 		}
+		// DNS settings are defined per cluster, so they are taken from the first external service
+		if es := nonIpEndpoints[0].ExternalService; es != nil && es.DNS != nil {
+			if err := configureDNS(c, es.DNS, len(nonIpEndpoints)); err != nil {
+				return err
+			}
+		}
 	} else {
 		c.ClusterDiscoveryType = &envoy_cluster.Cluster_Type{Type: envoy_cluster.Cluster_STATIC}
 	}
 	c.LoadAssignment = envoy_endpoints.CreateClusterLoadAssignment(e.Name, e.Endpoints)
 	return nil
 }
+
+func configureDNS(c *envoy_cluster.Cluster, dns *mesh_proto.ExternalService_Networking_DNS, endpoints int) error {
+	if dns.GetType() == mesh_proto.ExternalService_Networking_DNS_LOGICAL {
+		if endpoints > 1 {
+			return errors.New("LOGICAL DNS discovery type can be used only with a single endpoint")
+		}
+		c.ClusterDiscoveryType = &envoy_cluster.Cluster_Type{Type: envoy_cluster.Cluster_LOGICAL_DNS}
+	}
+	switch dns.GetLookupFamily() {
+	case mesh_proto.ExternalService_Networking_DNS_AUTO:
+		c.DnsLookupFamily = envoy_cluster.Cluster_AUTO
+	case mesh_proto.ExternalService_Networking_DNS_V4_ONLY:
+		c.DnsLookupFamily = envoy_cluster.Cluster_V4_ONLY
+	case mesh_proto.ExternalService_Networking_DNS_V6_ONLY:
+		c.DnsLookupFamily = envoy_cluster.Cluster_V6_ONLY
+	case mesh_proto.ExternalService_Networking_DNS_V4_PREFERRED:
+		c.DnsLookupFamily = envoy_cluster.Cluster_V4_PREFERRED
+	}
+	c.DnsRefreshRate = dns.GetRefreshRate()
+	c.RespectDnsTtl = dns.GetRespectDnsTtl()
+
+	if len(dns.GetResolvers()) == 0 {
+		return nil
+	}
+	var resolvers []*envoy_core.Address
+	for _, resolver := range dns.GetResolvers() {
+		address, err := dnsResolverAddress(resolver)
+		if err != nil {
+			return err
+		}
+		resolvers = append(resolvers, address)
+	}
+	pbst, err := util_proto.MarshalAnyDeterministic(&envoy_cares.CaresDnsResolverConfig{
+		Resolvers: resolvers,
+	})
+	if err != nil {
+		return err
+	}
+	c.TypedDnsResolverConfig = &envoy_core.TypedExtensionConfig{
+		Name:        "envoy.network.dns_resolver.cares",
+		TypedConfig: pbst,
+	}
+	return nil
+}
+
+func dnsResolverAddress(resolver string) (*envoy_core.Address, error) {
+	host, port := resolver, uint64(defaultDNSResolverPort)
+	if h, p, err := net.SplitHostPort(resolver); err == nil {
+		port, err = strconv.ParseUint(p, 10, 16)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid port of DNS resolver %q", resolver)
+		}
+		host = h
+	}
+	if net.ParseIP(host) == nil {
+		return nil, errors.Errorf("DNS resolver %q has to be an IP address", resolver)
+	}
+	return &envoy_core.Address{
+		Address: &envoy_core.Address_SocketAddress{
+			SocketAddress: &envoy_core.SocketAddress{
+				Protocol: envoy_core.SocketAddress_UDP,
+				Address:  host,
+				PortSpecifier: &envoy_core.SocketAddress_PortValue{
+					PortValue: uint32(port),
+				},
+			},
+		},
+	}, nil
+}
@@ -1,9 +1,13 @@
 package clusters_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
@@ -104,6 +108,90 @@ var _ = Describe("ProvidedEndpointClusterConfigurer", func() {
 		Expect(actual).To(MatchYAML(expected))
 	})
 
+	It("should generate proper Envoy config with DNS configuration of external service", func() {
+		// given
+		clusterName := "test:cluster"
+		expected := `
+        altStatName: test_cluster
+        connectTimeout: 5s
+        dnsLookupFamily: V4_PREFERRED
+        dnsRefreshRate: 10s
+        loadAssignment:
+          clusterName: test:cluster
+          endpoints:
+          - lbEndpoints:
+            - endpoint:
+                address:
+                  socketAddress:
+                    address: google.com
+                    portValue: 80
+              loadBalancingWeight: 100
+        name: test:cluster
+        respectDnsTtl: true
+        type: LOGICAL_DNS
+        typedDnsResolverConfig:
+          name: envoy.network.dns_resolver.cares
+          typedConfig:
+            '@type': type.googleapis.com/envoy.extensions.network.dns_resolver.cares.v3.CaresDnsResolverConfig
+            resolvers:
+            - socketAddress:
+                address: 10.0.0.2
+                portValue: 53
+                protocol: UDP
+            - socketAddress:
+                address: fd00::2
+                portValue: 5353
+                protocol: UDP`
+
+		// when
+		cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+			Configure(clusters.ProvidedEndpointCluster(clusterName, false,
+				core_xds.Endpoint{
+					Target: "google.com",
+					Port:   80,
+					Weight: 100,
+					ExternalService: &core_xds.ExternalService{
+						DNS: &mesh_proto.ExternalService_Networking_DNS{
+							Type:          mesh_proto.ExternalService_Networking_DNS_LOGICAL,
+							LookupFamily:  mesh_proto.ExternalService_Networking_DNS_V4_PREFERRED,
+							RefreshRate:   durationpb.New(10 * time.Second),
+							RespectDnsTtl: true,
+							Resolvers:     []string{"10.0.0.2", "[fd00::2]:5353"},
+						},
+					},
+				},
+			)).
+			Configure(clusters.Timeout(DefaultTimeout(), core_mesh.ProtocolTCP)).
+			Build()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		actual, err := util_proto.ToYAML(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should not allow LOGICAL DNS discovery type with multiple endpoints", func() {
+		// given
+		externalService := &core_xds.ExternalService{
+			DNS: &mesh_proto.ExternalService_Networking_DNS{
+				Type: mesh_proto.ExternalService_Networking_DNS_LOGICAL,
+			},
+		}
+
+		// when
+		_, err := clusters.NewClusterBuilder(envoy.APIV3).
+			Configure(clusters.ProvidedEndpointCluster("test:cluster", false,
+				core_xds.Endpoint{Target: "a.example.com", Port: 80, ExternalService: externalService},
+				core_xds.Endpoint{Target: "b.example.com", Port: 80, ExternalService: externalService},
+			)).
+			Build()
+
+		// then
+		Expect(err).To(MatchError("LOGICAL DNS discovery type can be used only with a single endpoint"))
+	})
+
 	It("should generate proper Envoy config for static cluster with socket", func() {
 		// given
 		clusterName := "test:cluster"
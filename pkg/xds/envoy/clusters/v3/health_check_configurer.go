@@ -2,6 +2,7 @@ package clusters
 
 import (
 	"encoding/hex"
+	"strings"
 
 	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -104,6 +105,18 @@ func httpHealthCheck(
 	}
 }
 
+// grpcHealthCheck translates HTTP health check to the gRPC health checking protocol,
+// path without the leading slash is used as a name of the checked service
+func grpcHealthCheck(
+	httpConf *mesh_proto.HealthCheck_Conf_Http,
+) *envoy_core.HealthCheck_GrpcHealthCheck_ {
+	return &envoy_core.HealthCheck_GrpcHealthCheck_{
+		GrpcHealthCheck: &envoy_core.HealthCheck_GrpcHealthCheck{
+			ServiceName: strings.TrimPrefix(httpConf.Path, "/"),
+		},
+	}
+}
+
 func healthPanicThreshold(cluster *envoy_cluster.Cluster, value *wrapperspb.FloatValue) {
 	if value == nil {
 		return
@@ -122,8 +135,8 @@ func failTrafficOnPanic(cluster *envoy_cluster.Cluster, value *wrapperspb.BoolVa
 		cluster.CommonLbConfig = &envoy_cluster.Cluster_CommonLbConfig{}
 	}
 	if cluster.CommonLbConfig.GetLocalityWeightedLbConfig() != nil {
-		// used load balancing type doesn't support 'fail_traffic_on_panic', right now we don't use
-		// 'locality_weighted_lb_config' in Kuma, locality aware load balancing is implemented based on priority levels
+		// used load balancing type doesn't support 'fail_traffic_on_panic', 'locality_weighted_lb_config' is used
+		// only for weights of the zones of MeshZoneTrafficRoute, locality aware load balancing is based on priority levels
 		core.Log.WithName("health-check-configurer").Error(
 			errors.New("unable to set 'fail_traffic_on_panic' for 'locality_weighted_lb_config' load balancer"),
 			"unable to configure 'fail_traffic_on_panic', parameter is ignored")
@@ -163,6 +176,8 @@ func addHealthChecker(healthCheck *envoy_core.HealthCheck, healthChecker interfa
 		healthCheck.HealthChecker = httpHc
 	} else if tcpHc, ok := healthChecker.(*envoy_core.HealthCheck_TcpHealthCheck_); ok {
 		healthCheck.HealthChecker = tcpHc
+	} else if grpcHc, ok := healthChecker.(*envoy_core.HealthCheck_GrpcHealthCheck_); ok {
+		healthCheck.HealthChecker = grpcHc
 	}
 
 	return healthCheck
@@ -197,7 +212,12 @@ func (e *HealthCheckConfigurer) Configure(cluster *envoy_cluster.Cluster) error
 
 	if http != nil {
 		defaultHealthCheck := buildHealthCheck(activeChecks)
-		healthChecker := httpHealthCheck(e.Protocol, http)
+		var healthChecker interface{}
+		if e.Protocol == core_mesh.ProtocolGRPC {
+			healthChecker = grpcHealthCheck(http)
+		} else {
+			healthChecker = httpHealthCheck(e.Protocol, http)
+		}
 		healthCheck := addHealthChecker(defaultHealthCheck, healthChecker)
 
 		cluster.HealthChecks = append(cluster.HealthChecks, healthCheck)
@@ -18,16 +18,23 @@ var _ = Describe("HealthCheckConfigurer", func() {
 
 	type testCase struct {
 		clusterName string
+		protocol    core_mesh.Protocol
 		healthCheck *core_mesh.HealthCheckResource
 		expected    string
 	}
 
 	DescribeTable("should generate proper Envoy config",
 		func(given testCase) {
+			// given
+			protocol := given.protocol
+			if protocol == "" {
+				protocol = core_mesh.ProtocolHTTP
+			}
+
 			// when
 			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
 				Configure(clusters.EdsCluster(given.clusterName)).
-				Configure(clusters.HealthCheck(core_mesh.ProtocolHTTP, given.healthCheck)).
+				Configure(clusters.HealthCheck(protocol, given.healthCheck)).
 				Configure(clusters.Timeout(DefaultTimeout(), core_mesh.ProtocolTCP)).
 				Build()
 
@@ -463,6 +470,44 @@ var _ = Describe("HealthCheckConfigurer", func() {
               timeout: 4s
               unhealthyThreshold: 3
             name: testCluster
+            type: EDS`,
+		}),
+		Entry("HealthCheck with provided HTTP configuration for gRPC service", testCase{
+			clusterName: "testCluster",
+			protocol:    core_mesh.ProtocolGRPC,
+			healthCheck: &core_mesh.HealthCheckResource{
+				Spec: &mesh_proto.HealthCheck{
+					Sources: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"kuma.io/service": "backend"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"kuma.io/service": "grpc-backend"}},
+					},
+					Conf: &mesh_proto.HealthCheck_Conf{
+						Interval:           util_proto.Duration(5 * time.Second),
+						Timeout:            util_proto.Duration(4 * time.Second),
+						UnhealthyThreshold: 3,
+						HealthyThreshold:   2,
+						Http: &mesh_proto.HealthCheck_Conf_Http{
+							Path: "/greeter.Greeter",
+						},
+					},
+				},
+			},
+			expected: `
+            connectTimeout: 5s
+            edsClusterConfig:
+              edsConfig:
+                ads: {}
+                resourceApiVersion: V3
+            healthChecks:
+            - grpcHealthCheck:
+                serviceName: greeter.Greeter
+              healthyThreshold: 2
+              interval: 5s
+              timeout: 4s
+              unhealthyThreshold: 3
+            name: testCluster
             type: EDS`,
 		}),
 	)
@@ -61,6 +61,14 @@ func (e *LbConfigurer) Configure(c *envoy_cluster.Cluster) error {
 
 	case *mesh_proto.TrafficRoute_LoadBalancer_Maglev_:
 		c.LbPolicy = envoy_cluster.Cluster_MAGLEV
+
+		if tableSize := e.Lb.GetMaglev().GetTableSize(); tableSize > 0 {
+			c.LbConfig = &envoy_cluster.Cluster_MaglevLbConfig_{
+				MaglevLbConfig: &envoy_cluster.Cluster_MaglevLbConfig{
+					TableSize: util_proto.UInt64(tableSize),
+				},
+			}
+		}
 	}
 
 	return nil
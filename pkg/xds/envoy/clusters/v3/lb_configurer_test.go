@@ -123,6 +123,27 @@ var _ = Describe("Lb", func() {
                 resourceApiVersion: V3
             lbPolicy: MAGLEV
             name: backend
+            type: EDS`,
+		}),
+		Entry("maglev with table size", testCase{
+			clusterName: "backend",
+			lb: &mesh_proto.TrafficRoute_LoadBalancer{
+				LbType: &mesh_proto.TrafficRoute_LoadBalancer_Maglev_{
+					Maglev: &mesh_proto.TrafficRoute_LoadBalancer_Maglev{
+						TableSize: 131,
+					},
+				},
+			},
+			expected: `
+            connectTimeout: 5s
+            edsClusterConfig:
+              edsConfig:
+                ads: {}
+                resourceApiVersion: V3
+            lbPolicy: MAGLEV
+            maglevLbConfig:
+              tableSize: "131"
+            name: backend
             type: EDS`,
 		}),
 	)
@@ -9,6 +9,7 @@ import (
 
 type OutlierDetectionConfigurer struct {
 	CircuitBreaker *core_mesh.CircuitBreakerResource
+	Protocol       core_mesh.Protocol
 }
 
 var _ ClusterConfigurer = &OutlierDetectionConfigurer{}
@@ -33,7 +34,12 @@ func (c *OutlierDetectionConfigurer) Configure(cluster *envoy_cluster.Cluster) e
 }
 
 func (c *OutlierDetectionConfigurer) configureTotalErrorDetector(outlierDetection *envoy_cluster.OutlierDetection) {
-	if total := c.CircuitBreaker.Spec.GetConf().GetDetectors().GetTotalErrors(); total != nil {
+	total := c.CircuitBreaker.Spec.GetConf().GetDetectors().GetTotalErrors()
+	if grpc := c.CircuitBreaker.Spec.GetConf().GetDetectors().GetGrpcErrors(); grpc != nil && c.Protocol == core_mesh.ProtocolGRPC {
+		// Envoy maps gRPC status codes to HTTP status codes, so gRPC errors are counted by the 5xx detector
+		total = grpc
+	}
+	if total != nil {
 		outlierDetection.Consecutive_5Xx = total.GetConsecutive()
 		outlierDetection.EnforcingConsecutive_5Xx = util_proto.UInt32(100)
 	} else {
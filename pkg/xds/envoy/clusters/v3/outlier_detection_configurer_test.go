@@ -16,6 +16,7 @@ var _ = Describe("OutlierDetectionConfigurer", func() {
 	type testCase struct {
 		clusterName    string
 		circuitBreaker *core_mesh.CircuitBreakerResource
+		protocol       core_mesh.Protocol
 		expected       string
 	}
 
@@ -24,7 +25,7 @@ var _ = Describe("OutlierDetectionConfigurer", func() {
 			// when
 			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
 				Configure(clusters.EdsCluster(given.clusterName)).
-				Configure(clusters.OutlierDetection(given.circuitBreaker)).
+				Configure(clusters.OutlierDetection(given.circuitBreaker, given.protocol)).
 				Configure(clusters.Timeout(DefaultTimeout(), core_mesh.ProtocolTCP)).
 				Build()
 
@@ -134,6 +135,64 @@ var _ = Describe("OutlierDetectionConfigurer", func() {
               enforcingConsecutiveLocalOriginFailure: 100
               enforcingFailurePercentage: 0
               enforcingSuccessRate: 0
+            type: EDS`,
+		}),
+		Entry("CircuitBreaker with gRPC error detector, gRPC protocol", testCase{
+			circuitBreaker: &core_mesh.CircuitBreakerResource{
+				Spec: &mesh_proto.CircuitBreaker{
+					Conf: &mesh_proto.CircuitBreaker_Conf{
+						SplitExternalAndLocalErrors: true,
+						Detectors: &mesh_proto.CircuitBreaker_Conf_Detectors{
+							TotalErrors: &mesh_proto.CircuitBreaker_Conf_Detectors_Errors{Consecutive: util_proto.UInt32(21)},
+							LocalErrors: &mesh_proto.CircuitBreaker_Conf_Detectors_Errors{Consecutive: util_proto.UInt32(6)},
+							GrpcErrors:  &mesh_proto.CircuitBreaker_Conf_Detectors_Errors{Consecutive: util_proto.UInt32(3)},
+						},
+					},
+				},
+			},
+			protocol: core_mesh.ProtocolGRPC,
+			expected: `
+            connectTimeout: 5s
+            edsClusterConfig:
+              edsConfig:
+                ads: {}
+                resourceApiVersion: V3
+            outlierDetection:
+              consecutive5xx: 3
+              consecutiveLocalOriginFailure: 6
+              enforcingConsecutive5xx: 100
+              enforcingConsecutiveGatewayFailure: 0
+              enforcingConsecutiveLocalOriginFailure: 100
+              enforcingFailurePercentage: 0
+              enforcingSuccessRate: 0
+              splitExternalLocalOriginErrors: true
+            type: EDS`,
+		}),
+		Entry("CircuitBreaker with gRPC error detector, HTTP protocol", testCase{
+			circuitBreaker: &core_mesh.CircuitBreakerResource{
+				Spec: &mesh_proto.CircuitBreaker{
+					Conf: &mesh_proto.CircuitBreaker_Conf{
+						Detectors: &mesh_proto.CircuitBreaker_Conf_Detectors{
+							TotalErrors: &mesh_proto.CircuitBreaker_Conf_Detectors_Errors{Consecutive: util_proto.UInt32(21)},
+							GrpcErrors:  &mesh_proto.CircuitBreaker_Conf_Detectors_Errors{Consecutive: util_proto.UInt32(3)},
+						},
+					},
+				},
+			},
+			protocol: core_mesh.ProtocolHTTP,
+			expected: `
+            connectTimeout: 5s
+            edsClusterConfig:
+              edsConfig:
+                ads: {}
+                resourceApiVersion: V3
+            outlierDetection:
+              consecutive5xx: 21
+              enforcingConsecutive5xx: 100
+              enforcingConsecutiveGatewayFailure: 0
+              enforcingConsecutiveLocalOriginFailure: 0
+              enforcingFailurePercentage: 0
+              enforcingSuccessRate: 0
             type: EDS`,
 		}),
 		Entry("CircuitBreaker with StandardDeviation detector, default values", testCase{
@@ -0,0 +1,165 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_upstream_http "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// ProtocolOptionsConfigurer applies MeshProtocolOptions to the HTTP protocol options of a cluster.
+// It has to be configured after the transport socket of the cluster, because the HTTP version
+// negotiated with ALPN requires TLS.
+type ProtocolOptionsConfigurer struct {
+	Protocol core_mesh.Protocol
+	Conf     *mesh_proto.MeshProtocolOptions_Conf
+	// SelectUpstreamProtocol is false for clusters of the mesh services,
+	// because dataplanes always talk to each other over HTTP/2.
+	SelectUpstreamProtocol bool
+}
+
+var _ ClusterConfigurer = &ProtocolOptionsConfigurer{}
+
+func (p *ProtocolOptionsConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if p.Conf == nil {
+		return nil
+	}
+	switch p.Protocol {
+	case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2, core_mesh.ProtocolGRPC:
+	default:
+		return nil
+	}
+	return UpdateCommonHttpProtocolOptions(cluster, func(options *envoy_upstream_http.HttpProtocolOptions) {
+		if p.SelectUpstreamProtocol {
+			p.setUpstreamProtocol(options, cluster.TransportSocket != nil || len(cluster.TransportSocketMatches) > 0)
+		}
+		p.setCommonOptions(options)
+		if http1 := http1ProtocolOptions(options); http1 != nil {
+			p.setHttp1Options(http1)
+		}
+		if http2 := http2ProtocolOptions(options); http2 != nil {
+			p.setHttp2Options(http2)
+		}
+	})
+}
+
+func (p *ProtocolOptionsConfigurer) setUpstreamProtocol(options *envoy_upstream_http.HttpProtocolOptions, tls bool) {
+	switch p.Conf.GetUpstreamProtocol() {
+	case mesh_proto.MeshProtocolOptions_Conf_HTTP1:
+		// gRPC requires HTTP/2
+		if p.Protocol != core_mesh.ProtocolGRPC {
+			options.UpstreamProtocolOptions = explicitHttp1()
+		}
+	case mesh_proto.MeshProtocolOptions_Conf_HTTP2:
+		options.UpstreamProtocolOptions = explicitHttp2()
+	case mesh_proto.MeshProtocolOptions_Conf_AUTO:
+		if tls {
+			options.UpstreamProtocolOptions = &envoy_upstream_http.HttpProtocolOptions_AutoConfig{
+				AutoConfig: &envoy_upstream_http.HttpProtocolOptions_AutoHttpConfig{
+					HttpProtocolOptions:  &envoy_core.Http1ProtocolOptions{},
+					Http2ProtocolOptions: &envoy_core.Http2ProtocolOptions{},
+				},
+			}
+		}
+	}
+	if options.UpstreamProtocolOptions != nil {
+		return
+	}
+	// the version is inferred from the kuma.io/protocol tag of the service
+	if p.Protocol == core_mesh.ProtocolHTTP {
+		options.UpstreamProtocolOptions = explicitHttp1()
+	} else {
+		options.UpstreamProtocolOptions = explicitHttp2()
+	}
+}
+
+func (p *ProtocolOptionsConfigurer) setCommonOptions(options *envoy_upstream_http.HttpProtocolOptions) {
+	// keep-alive is disabled by allowing a single request per HTTP/1.1 connection
+	disableKeepAlive := p.Conf.GetHttp1().GetDisableKeepAlive() &&
+		http1ProtocolOptions(options) != nil && http2ProtocolOptions(options) == nil
+	if p.Conf.GetIdleTimeout() == nil && p.Conf.GetMaxRequestsPerConnection() == nil && !disableKeepAlive {
+		return
+	}
+	if options.CommonHttpProtocolOptions == nil {
+		options.CommonHttpProtocolOptions = &envoy_core.HttpProtocolOptions{}
+	}
+	if idleTimeout := p.Conf.GetIdleTimeout(); idleTimeout != nil {
+		options.CommonHttpProtocolOptions.IdleTimeout = idleTimeout
+	}
+	if maxRequests := p.Conf.GetMaxRequestsPerConnection(); maxRequests != nil {
+		options.CommonHttpProtocolOptions.MaxRequestsPerConnection = maxRequests
+	}
+	if disableKeepAlive {
+		options.CommonHttpProtocolOptions.MaxRequestsPerConnection = util_proto.UInt32(1)
+	}
+}
+
+func (p *ProtocolOptionsConfigurer) setHttp1Options(http1 *envoy_core.Http1ProtocolOptions) {
+	conf := p.Conf.GetHttp1()
+	if conf.GetProperCaseHeaders() {
+		http1.HeaderKeyFormat = &envoy_core.Http1ProtocolOptions_HeaderKeyFormat{
+			HeaderFormat: &envoy_core.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords_{
+				ProperCaseWords: &envoy_core.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords{},
+			},
+		}
+	}
+	if conf.GetEnableTrailers() {
+		http1.EnableTrailers = true
+	}
+}
+
+func (p *ProtocolOptionsConfigurer) setHttp2Options(http2 *envoy_core.Http2ProtocolOptions) {
+	conf := p.Conf.GetHttp2()
+	if conf.GetMaxConcurrentStreams() != nil {
+		http2.MaxConcurrentStreams = conf.GetMaxConcurrentStreams()
+	}
+	if conf.GetInitialStreamWindowSize() != nil {
+		http2.InitialStreamWindowSize = conf.GetInitialStreamWindowSize()
+	}
+	if conf.GetInitialConnectionWindowSize() != nil {
+		http2.InitialConnectionWindowSize = conf.GetInitialConnectionWindowSize()
+	}
+}
+
+func explicitHttp1() *envoy_upstream_http.HttpProtocolOptions_ExplicitHttpConfig_ {
+	return &envoy_upstream_http.HttpProtocolOptions_ExplicitHttpConfig_{
+		ExplicitHttpConfig: &envoy_upstream_http.HttpProtocolOptions_ExplicitHttpConfig{
+			ProtocolConfig: &envoy_upstream_http.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{
+				HttpProtocolOptions: &envoy_core.Http1ProtocolOptions{},
+			},
+		},
+	}
+}
+
+func explicitHttp2() *envoy_upstream_http.HttpProtocolOptions_ExplicitHttpConfig_ {
+	return &envoy_upstream_http.HttpProtocolOptions_ExplicitHttpConfig_{
+		ExplicitHttpConfig: &envoy_upstream_http.HttpProtocolOptions_ExplicitHttpConfig{
+			ProtocolConfig: &envoy_upstream_http.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+				Http2ProtocolOptions: &envoy_core.Http2ProtocolOptions{},
+			},
+		},
+	}
+}
+
+func http1ProtocolOptions(options *envoy_upstream_http.HttpProtocolOptions) *envoy_core.Http1ProtocolOptions {
+	switch upstream := options.GetUpstreamProtocolOptions().(type) {
+	case *envoy_upstream_http.HttpProtocolOptions_ExplicitHttpConfig_:
+		return upstream.ExplicitHttpConfig.GetHttpProtocolOptions()
+	case *envoy_upstream_http.HttpProtocolOptions_AutoConfig:
+		return upstream.AutoConfig.GetHttpProtocolOptions()
+	}
+	return nil
+}
+
+func http2ProtocolOptions(options *envoy_upstream_http.HttpProtocolOptions) *envoy_core.Http2ProtocolOptions {
+	switch upstream := options.GetUpstreamProtocolOptions().(type) {
+	case *envoy_upstream_http.HttpProtocolOptions_ExplicitHttpConfig_:
+		return upstream.ExplicitHttpConfig.GetHttp2ProtocolOptions()
+	case *envoy_upstream_http.HttpProtocolOptions_AutoConfig:
+		return upstream.AutoConfig.GetHttp2ProtocolOptions()
+	}
+	return nil
+}
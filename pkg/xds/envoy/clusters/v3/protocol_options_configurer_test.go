@@ -0,0 +1,227 @@
+package clusters_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+)
+
+var _ = Describe("ProtocolOptionsConfigurer", func() {
+
+	type testCase struct {
+		opts     []clusters.ClusterBuilderOpt
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+				Configure(clusters.EdsCluster("backend")).
+				Configure(clusters.Timeout(nil, core_mesh.ProtocolTCP)).
+				Configure(given.opts...).
+				Build()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			actual, err := util_proto.ToYAML(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("mesh service keeps HTTP/2", testCase{
+			opts: []clusters.ClusterBuilderOpt{
+				clusters.Http2(),
+				clusters.ProtocolOptions(&mesh_proto.MeshProtocolOptions_Conf{
+					UpstreamProtocol:         mesh_proto.MeshProtocolOptions_Conf_HTTP1,
+					IdleTimeout:              util_proto.Duration(30 * time.Second),
+					MaxRequestsPerConnection: util_proto.UInt32(100),
+					Http2: &mesh_proto.MeshProtocolOptions_Conf_Http2{
+						MaxConcurrentStreams:        util_proto.UInt32(128),
+						InitialStreamWindowSize:     util_proto.UInt32(65535),
+						InitialConnectionWindowSize: util_proto.UInt32(1048576),
+					},
+				}, core_mesh.ProtocolHTTP, false),
+			},
+			expected: `
+        connectTimeout: 10s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS
+        typedExtensionProtocolOptions:
+          envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+            '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+            commonHttpProtocolOptions:
+              idleTimeout: 30s
+              maxRequestsPerConnection: 100
+            explicitHttpConfig:
+              http2ProtocolOptions:
+                initialConnectionWindowSize: 1048576
+                initialStreamWindowSize: 65535
+                maxConcurrentStreams: 128`,
+		}),
+		Entry("external service forced to HTTP/2 with prior knowledge", testCase{
+			opts: []clusters.ClusterBuilderOpt{
+				clusters.Http(),
+				clusters.ProtocolOptions(&mesh_proto.MeshProtocolOptions_Conf{
+					UpstreamProtocol: mesh_proto.MeshProtocolOptions_Conf_HTTP2,
+					Http1: &mesh_proto.MeshProtocolOptions_Conf_Http1{
+						DisableKeepAlive: true,
+					},
+					Http2: &mesh_proto.MeshProtocolOptions_Conf_Http2{
+						MaxConcurrentStreams: util_proto.UInt32(10),
+					},
+				}, core_mesh.ProtocolHTTP, true),
+			},
+			expected: `
+        connectTimeout: 10s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS
+        typedExtensionProtocolOptions:
+          envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+            '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+            explicitHttpConfig:
+              http2ProtocolOptions:
+                maxConcurrentStreams: 10`,
+		}),
+		Entry("external service over HTTP/1.1 without keep-alive", testCase{
+			opts: []clusters.ClusterBuilderOpt{
+				clusters.Http(),
+				clusters.ProtocolOptions(&mesh_proto.MeshProtocolOptions_Conf{
+					Http1: &mesh_proto.MeshProtocolOptions_Conf_Http1{
+						DisableKeepAlive:  true,
+						ProperCaseHeaders: true,
+						EnableTrailers:    true,
+					},
+				}, core_mesh.ProtocolHTTP, true),
+			},
+			expected: `
+        connectTimeout: 10s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS
+        typedExtensionProtocolOptions:
+          envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+            '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+            commonHttpProtocolOptions:
+              maxRequestsPerConnection: 1
+            explicitHttpConfig:
+              httpProtocolOptions:
+                enableTrailers: true
+                headerKeyFormat:
+                  properCaseWords: {}`,
+		}),
+		Entry("gRPC external service ignores HTTP/1.1", testCase{
+			opts: []clusters.ClusterBuilderOpt{
+				clusters.Http2(),
+				clusters.ProtocolOptions(&mesh_proto.MeshProtocolOptions_Conf{
+					UpstreamProtocol: mesh_proto.MeshProtocolOptions_Conf_HTTP1,
+				}, core_mesh.ProtocolGRPC, true),
+			},
+			expected: `
+        connectTimeout: 10s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS
+        typedExtensionProtocolOptions:
+          envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+            '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+            explicitHttpConfig:
+              http2ProtocolOptions: {}`,
+		}),
+		Entry("external service negotiating the version with ALPN", testCase{
+			opts: []clusters.ClusterBuilderOpt{
+				clusters.ClientSideTLS([]xds.Endpoint{{
+					Target: "httpbin.org",
+					Port:   443,
+					ExternalService: &xds.ExternalService{
+						TLSEnabled: true,
+					},
+				}}),
+				clusters.Http(),
+				clusters.ProtocolOptions(&mesh_proto.MeshProtocolOptions_Conf{
+					UpstreamProtocol: mesh_proto.MeshProtocolOptions_Conf_AUTO,
+				}, core_mesh.ProtocolHTTP, true),
+			},
+			expected: `
+        connectTimeout: 10s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        transportSocketMatches:
+        - match: {}
+          name: httpbin.org
+          transportSocket:
+            name: envoy.transport_sockets.tls
+            typedConfig:
+              '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext
+              sni: httpbin.org
+        type: EDS
+        typedExtensionProtocolOptions:
+          envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+            '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+            autoConfig:
+              http2ProtocolOptions: {}
+              httpProtocolOptions: {}`,
+		}),
+		Entry("external service without TLS falls back to the inferred version", testCase{
+			opts: []clusters.ClusterBuilderOpt{
+				clusters.Http(),
+				clusters.ProtocolOptions(&mesh_proto.MeshProtocolOptions_Conf{
+					UpstreamProtocol: mesh_proto.MeshProtocolOptions_Conf_AUTO,
+				}, core_mesh.ProtocolHTTP, true),
+			},
+			expected: `
+        connectTimeout: 10s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS
+        typedExtensionProtocolOptions:
+          envoy.extensions.upstreams.http.v3.HttpProtocolOptions:
+            '@type': type.googleapis.com/envoy.extensions.upstreams.http.v3.HttpProtocolOptions
+            explicitHttpConfig:
+              httpProtocolOptions: {}`,
+		}),
+		Entry("TCP service is not changed", testCase{
+			opts: []clusters.ClusterBuilderOpt{
+				clusters.ProtocolOptions(&mesh_proto.MeshProtocolOptions_Conf{
+					IdleTimeout: util_proto.Duration(30 * time.Second),
+				}, core_mesh.ProtocolTCP, true),
+			},
+			expected: `
+        connectTimeout: 10s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS`,
+		}),
+	)
+})
@@ -0,0 +1,35 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+// RetryBudgetConfigurer limits concurrent retries of the cluster with the retry budget of the Retry policy.
+// When the retry budget is set, Envoy ignores max_retries of the thresholds.
+type RetryBudgetConfigurer struct {
+	Retry *core_mesh.RetryResource
+}
+
+var _ ClusterConfigurer = &RetryBudgetConfigurer{}
+
+func (c *RetryBudgetConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if c.Retry == nil {
+		return nil
+	}
+	budget := c.Retry.Spec.GetConf().GetRetryBudget()
+	if budget == nil {
+		return nil
+	}
+
+	retryBudget := &envoy_cluster.CircuitBreakers_Thresholds_RetryBudget{
+		MinRetryConcurrency: budget.GetMinRetryConcurrency(),
+	}
+	if percent := budget.GetBudgetPercent(); percent != nil {
+		retryBudget.BudgetPercent = &envoy_type.Percent{Value: percent.GetValue()}
+	}
+	defaultThresholds(cluster).RetryBudget = retryBudget
+	return nil
+}
@@ -0,0 +1,109 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_proxy_protocol "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
+	envoy_raw_buffer "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/raw_buffer/v3"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	envoy_metadata "github.com/kumahq/kuma/pkg/xds/envoy/metadata/v3"
+)
+
+// UpstreamProxyProtocolConfigurer wraps transport sockets of endpoints of external services
+// that require PROXY protocol, so the header is sent before any other data.
+type UpstreamProxyProtocolConfigurer struct {
+	Endpoints []xds.Endpoint
+}
+
+var _ ClusterConfigurer = &UpstreamProxyProtocolConfigurer{}
+
+func (c *UpstreamProxyProtocolConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	for _, ep := range c.Endpoints {
+		if ep.ExternalService == nil || ep.ExternalService.ProxyProtocol == nil {
+			continue
+		}
+		version := proxyProtocolVersion(ep.ExternalService.ProxyProtocol.GetVersion())
+
+		// TLS endpoints already have a transport socket match configured by ClientSideTLSConfigurer
+		if match := transportSocketMatch(cluster, ep.Target); match != nil {
+			socket, err := upstreamProxyProtocolSocket(version, match.TransportSocket)
+			if err != nil {
+				return err
+			}
+			match.TransportSocket = socket
+			continue
+		}
+
+		rawBuffer, err := rawBufferSocket()
+		if err != nil {
+			return err
+		}
+		socket, err := upstreamProxyProtocolSocket(version, rawBuffer)
+		if err != nil {
+			return err
+		}
+		cluster.TransportSocketMatches = append(cluster.TransportSocketMatches, &envoy_cluster.Cluster_TransportSocketMatch{
+			Name: ep.Target,
+			Match: &structpb.Struct{
+				Fields: envoy_metadata.MetadataFields(envoy_common.Tags(ep.Tags).WithoutTags(mesh_proto.ServiceTag)),
+			},
+			TransportSocket: socket,
+		})
+	}
+
+	return nil
+}
+
+func transportSocketMatch(cluster *envoy_cluster.Cluster, name string) *envoy_cluster.Cluster_TransportSocketMatch {
+	for _, match := range cluster.TransportSocketMatches {
+		if match.Name == name {
+			return match
+		}
+	}
+	return nil
+}
+
+func proxyProtocolVersion(version mesh_proto.ExternalService_Networking_ProxyProtocol_Version) envoy_core.ProxyProtocolConfig_Version {
+	switch version {
+	case mesh_proto.ExternalService_Networking_ProxyProtocol_V2:
+		return envoy_core.ProxyProtocolConfig_V2
+	default:
+		return envoy_core.ProxyProtocolConfig_V1
+	}
+}
+
+func rawBufferSocket() (*envoy_core.TransportSocket, error) {
+	pbst, err := proto.MarshalAnyDeterministic(&envoy_raw_buffer.RawBuffer{})
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_core.TransportSocket{
+		Name: "envoy.transport_sockets.raw_buffer",
+		ConfigType: &envoy_core.TransportSocket_TypedConfig{
+			TypedConfig: pbst,
+		},
+	}, nil
+}
+
+func upstreamProxyProtocolSocket(version envoy_core.ProxyProtocolConfig_Version, inner *envoy_core.TransportSocket) (*envoy_core.TransportSocket, error) {
+	pbst, err := proto.MarshalAnyDeterministic(&envoy_proxy_protocol.ProxyProtocolUpstreamTransport{
+		Config: &envoy_core.ProxyProtocolConfig{
+			Version: version,
+		},
+		TransportSocket: inner,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_core.TransportSocket{
+		Name: "envoy.transport_sockets.upstream_proxy_protocol",
+		ConfigType: &envoy_core.TransportSocket_TypedConfig{
+			TypedConfig: pbst,
+		},
+	}, nil
+}
@@ -0,0 +1,148 @@
+package clusters_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+)
+
+var _ = Describe("UpstreamProxyProtocolConfigurer", func() {
+
+	type testCase struct {
+		endpoints []xds.Endpoint
+		expected  string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+				Configure(clusters.ProvidedEndpointCluster("es", false, given.endpoints...)).
+				Configure(clusters.ClientSideTLS(given.endpoints)).
+				Configure(clusters.UpstreamProxyProtocol(given.endpoints)).
+				Build()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			actual, err := util_proto.ToYAML(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("external service without proxy protocol", testCase{
+			endpoints: []xds.Endpoint{
+				{
+					Target:          "httpbin.org",
+					Port:            80,
+					Weight:          1,
+					ExternalService: &xds.ExternalService{},
+				},
+			},
+			expected: `
+            dnsLookupFamily: V4_ONLY
+            loadAssignment:
+              clusterName: es
+              endpoints:
+              - lbEndpoints:
+                - endpoint:
+                    address:
+                      socketAddress:
+                        address: httpbin.org
+                        portValue: 80
+                  loadBalancingWeight: 1
+            name: es
+            type: STRICT_DNS
+`,
+		}),
+		Entry("external service with proxy protocol v2", testCase{
+			endpoints: []xds.Endpoint{
+				{
+					Target: "httpbin.org",
+					Port:   80,
+					Weight: 1,
+					ExternalService: &xds.ExternalService{
+						ProxyProtocol: &mesh_proto.ExternalService_Networking_ProxyProtocol{
+							Version: mesh_proto.ExternalService_Networking_ProxyProtocol_V2,
+						},
+					},
+				},
+			},
+			expected: `
+            dnsLookupFamily: V4_ONLY
+            loadAssignment:
+              clusterName: es
+              endpoints:
+              - lbEndpoints:
+                - endpoint:
+                    address:
+                      socketAddress:
+                        address: httpbin.org
+                        portValue: 80
+                  loadBalancingWeight: 1
+            name: es
+            transportSocketMatches:
+            - match: {}
+              name: httpbin.org
+              transportSocket:
+                name: envoy.transport_sockets.upstream_proxy_protocol
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.transport_sockets.proxy_protocol.v3.ProxyProtocolUpstreamTransport
+                  config:
+                    version: V2
+                  transportSocket:
+                    name: envoy.transport_sockets.raw_buffer
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.transport_sockets.raw_buffer.v3.RawBuffer
+            type: STRICT_DNS
+`,
+		}),
+		Entry("external service with TLS and proxy protocol v1", testCase{
+			endpoints: []xds.Endpoint{
+				{
+					Target: "httpbin.org",
+					Port:   443,
+					Weight: 1,
+					ExternalService: &xds.ExternalService{
+						TLSEnabled: true,
+						ProxyProtocol: &mesh_proto.ExternalService_Networking_ProxyProtocol{
+							Version: mesh_proto.ExternalService_Networking_ProxyProtocol_V1,
+						},
+					},
+				},
+			},
+			expected: `
+            dnsLookupFamily: V4_ONLY
+            loadAssignment:
+              clusterName: es
+              endpoints:
+              - lbEndpoints:
+                - endpoint:
+                    address:
+                      socketAddress:
+                        address: httpbin.org
+                        portValue: 443
+                  loadBalancingWeight: 1
+            name: es
+            transportSocketMatches:
+            - match: {}
+              name: httpbin.org
+              transportSocket:
+                name: envoy.transport_sockets.upstream_proxy_protocol
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.transport_sockets.proxy_protocol.v3.ProxyProtocolUpstreamTransport
+                  config: {}
+                  transportSocket:
+                    name: envoy.transport_sockets.tls
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext
+                      sni: httpbin.org
+            type: STRICT_DNS
+`,
+		}),
+	)
+})
@@ -0,0 +1,54 @@
+package clusters
+
+import (
+	"time"
+
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+const (
+	defaultZoneIngressHealthCheckInterval           = 5 * time.Second
+	defaultZoneIngressHealthCheckTimeout            = 1 * time.Second
+	defaultZoneIngressHealthCheckUnhealthyThreshold = 2
+	defaultZoneIngressHealthCheckHealthyThreshold   = 1
+)
+
+// ZoneIngressHealthCheckConfigurer health checks the zone ingresses of other zones with TCP health checks.
+// Health checks of the HealthCheck policy take precedence, they are configured on the cluster already.
+type ZoneIngressHealthCheckConfigurer struct {
+	HealthCheck *mesh_proto.Routing_ZoneIngressHealthCheck
+}
+
+var _ ClusterConfigurer = &ZoneIngressHealthCheckConfigurer{}
+
+func (c *ZoneIngressHealthCheckConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if c.HealthCheck == nil || len(cluster.HealthChecks) > 0 {
+		return nil
+	}
+	conf := &mesh_proto.HealthCheck_Conf{
+		Interval:           util_proto.Duration(defaultZoneIngressHealthCheckInterval),
+		Timeout:            util_proto.Duration(defaultZoneIngressHealthCheckTimeout),
+		UnhealthyThreshold: defaultZoneIngressHealthCheckUnhealthyThreshold,
+		HealthyThreshold:   defaultZoneIngressHealthCheckHealthyThreshold,
+	}
+	if c.HealthCheck.GetInterval() != nil {
+		conf.Interval = c.HealthCheck.GetInterval()
+	}
+	if c.HealthCheck.GetTimeout() != nil {
+		conf.Timeout = c.HealthCheck.GetTimeout()
+	}
+	if c.HealthCheck.GetUnhealthyThreshold() != nil {
+		conf.UnhealthyThreshold = c.HealthCheck.GetUnhealthyThreshold().GetValue()
+	}
+	if c.HealthCheck.GetHealthyThreshold() != nil {
+		conf.HealthyThreshold = c.HealthCheck.GetHealthyThreshold().GetValue()
+	}
+	// zone ingresses are checked at the same interval when there is no traffic, otherwise Envoy would check them
+	// only once a minute and the first requests after the idle period could go to the zone ingress that is down
+	conf.NoTrafficInterval = conf.Interval
+	cluster.HealthChecks = append(cluster.HealthChecks, buildHealthCheck(conf))
+	return nil
+}
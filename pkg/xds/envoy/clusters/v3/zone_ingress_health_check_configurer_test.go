@@ -0,0 +1,110 @@
+package clusters_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+)
+
+var _ = Describe("ZoneIngressHealthCheckConfigurer", func() {
+
+	type testCase struct {
+		healthCheck            *core_mesh.HealthCheckResource
+		zoneIngressHealthCheck *mesh_proto.Routing_ZoneIngressHealthCheck
+		expected               string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+				Configure(clusters.EdsCluster("backend")).
+				Configure(clusters.HealthCheck(core_mesh.ProtocolTCP, given.healthCheck)).
+				Configure(clusters.ZoneIngressHealthCheck(given.zoneIngressHealthCheck)).
+				Configure(clusters.Timeout(DefaultTimeout(), core_mesh.ProtocolTCP)).
+				Build()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			actual, err := util_proto.ToYAML(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("default health check", testCase{
+			zoneIngressHealthCheck: &mesh_proto.Routing_ZoneIngressHealthCheck{},
+			expected: `
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        healthChecks:
+        - healthyThreshold: 1
+          interval: 5s
+          noTrafficInterval: 5s
+          tcpHealthCheck: {}
+          timeout: 1s
+          unhealthyThreshold: 2
+        name: backend
+        type: EDS`,
+		}),
+		Entry("custom health check", testCase{
+			zoneIngressHealthCheck: &mesh_proto.Routing_ZoneIngressHealthCheck{
+				Interval:           util_proto.Duration(2 * time.Second),
+				Timeout:            util_proto.Duration(500 * time.Millisecond),
+				UnhealthyThreshold: util_proto.UInt32(3),
+				HealthyThreshold:   util_proto.UInt32(2),
+			},
+			expected: `
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        healthChecks:
+        - healthyThreshold: 2
+          interval: 2s
+          noTrafficInterval: 2s
+          tcpHealthCheck: {}
+          timeout: 0.500s
+          unhealthyThreshold: 3
+        name: backend
+        type: EDS`,
+		}),
+		Entry("HealthCheck policy takes precedence", testCase{
+			healthCheck: &core_mesh.HealthCheckResource{
+				Spec: &mesh_proto.HealthCheck{
+					Conf: &mesh_proto.HealthCheck_Conf{
+						Interval:           util_proto.Duration(10 * time.Second),
+						Timeout:            util_proto.Duration(4 * time.Second),
+						UnhealthyThreshold: 3,
+						HealthyThreshold:   2,
+					},
+				},
+			},
+			zoneIngressHealthCheck: &mesh_proto.Routing_ZoneIngressHealthCheck{},
+			expected: `
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        healthChecks:
+        - healthyThreshold: 2
+          interval: 10s
+          tcpHealthCheck: {}
+          timeout: 4s
+          unhealthyThreshold: 3
+        name: backend
+        type: EDS`,
+		}),
+	)
+})
@@ -0,0 +1,46 @@
+package clusters
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
+)
+
+// ZoneTrafficRouteConfigurer enables the locality weighted load balancing of the cluster when MeshZoneTrafficRoute
+// sets weights of the zones. The weights themselves are set on the localities of the ClusterLoadAssignment.
+type ZoneTrafficRouteConfigurer struct {
+	Conf *mesh_proto.MeshZoneTrafficRoute_Conf
+}
+
+var _ ClusterConfigurer = &ZoneTrafficRouteConfigurer{}
+
+func (c *ZoneTrafficRouteConfigurer) Configure(cluster *envoy_cluster.Cluster) error {
+	if !c.weighted() {
+		return nil
+	}
+	if cluster.CommonLbConfig == nil {
+		cluster.CommonLbConfig = &envoy_cluster.Cluster_CommonLbConfig{}
+	}
+	if cluster.CommonLbConfig.GetZoneAwareLbConfig() != nil {
+		// 'fail_traffic_on_panic' of HealthCheck is only supported by the zone aware load balancing
+		core.Log.WithName("zone-traffic-route-configurer").Error(
+			errors.New("unable to set 'locality_weighted_lb_config' together with 'fail_traffic_on_panic'"),
+			"unable to configure weights of the zones, they are ignored", "cluster", cluster.Name)
+		return nil
+	}
+	cluster.CommonLbConfig.LocalityConfigSpecifier = &envoy_cluster.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
+		LocalityWeightedLbConfig: &envoy_cluster.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
+	}
+	return nil
+}
+
+func (c *ZoneTrafficRouteConfigurer) weighted() bool {
+	for _, zone := range c.Conf.GetZones() {
+		if zone.GetWeight() != nil {
+			return true
+		}
+	}
+	return false
+}
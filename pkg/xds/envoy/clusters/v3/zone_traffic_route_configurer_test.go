@@ -0,0 +1,110 @@
+package clusters_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+)
+
+var _ = Describe("ZoneTrafficRouteConfigurer", func() {
+
+	type testCase struct {
+		healthCheck *core_mesh.HealthCheckResource
+		conf        *mesh_proto.MeshZoneTrafficRoute_Conf
+		expected    string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			cluster, err := clusters.NewClusterBuilder(envoy.APIV3).
+				Configure(clusters.EdsCluster("backend")).
+				Configure(clusters.HealthCheck(core_mesh.ProtocolTCP, given.healthCheck)).
+				Configure(clusters.ZoneTrafficRoute(given.conf)).
+				Configure(clusters.Timeout(DefaultTimeout(), core_mesh.ProtocolTCP)).
+				Build()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			actual, err := util_proto.ToYAML(cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("zones with weights", testCase{
+			conf: &mesh_proto.MeshZoneTrafficRoute_Conf{
+				Zones: []*mesh_proto.MeshZoneTrafficRoute_Conf_Zone{
+					{Name: "zone-1", Weight: util_proto.UInt32(80)},
+					{Name: "zone-2", Weight: util_proto.UInt32(20)},
+				},
+			},
+			expected: `
+        commonLbConfig:
+          localityWeightedLbConfig: {}
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS`,
+		}),
+		Entry("zones with only priorities", testCase{
+			conf: &mesh_proto.MeshZoneTrafficRoute_Conf{
+				Zones: []*mesh_proto.MeshZoneTrafficRoute_Conf_Zone{
+					{Name: "zone-1", Priority: 1},
+				},
+			},
+			expected: `
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS`,
+		}),
+		Entry("weights ignored when traffic fails on panic", testCase{
+			healthCheck: &core_mesh.HealthCheckResource{
+				Spec: &mesh_proto.HealthCheck{
+					Conf: &mesh_proto.HealthCheck_Conf{
+						Interval:           util_proto.Duration(5 * time.Second),
+						Timeout:            util_proto.Duration(4 * time.Second),
+						UnhealthyThreshold: 3,
+						HealthyThreshold:   2,
+						FailTrafficOnPanic: util_proto.Bool(true),
+					},
+				},
+			},
+			conf: &mesh_proto.MeshZoneTrafficRoute_Conf{
+				Zones: []*mesh_proto.MeshZoneTrafficRoute_Conf_Zone{
+					{Name: "zone-1", Weight: util_proto.UInt32(80)},
+				},
+			},
+			expected: `
+        commonLbConfig:
+          zoneAwareLbConfig:
+            failTrafficOnPanic: true
+        connectTimeout: 5s
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        name: backend
+        type: EDS
+        healthChecks:
+        - healthyThreshold: 2
+          interval: 5s
+          tcpHealthCheck: {}
+          timeout: 4s
+          unhealthyThreshold: 3`,
+		}),
+	)
+})
@@ -0,0 +1,33 @@
+package envoy
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_dynamic_forward_proxy "github.com/envoyproxy/go-control-plane/envoy/extensions/common/dynamic_forward_proxy/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+)
+
+const DefaultDynamicForwardProxyPort = 443
+
+// DynamicForwardProxyDNSCache returns the DNS cache of the dynamic forward proxy.
+// The filter and the cluster that share the cache have to be configured with the same
+// DNS cache, otherwise Envoy rejects the configuration.
+func DynamicForwardProxyDNSCache(name string, hasIPv6 bool, conf *mesh_proto.Networking_Outbound_DynamicForwardProxy) *envoy_dynamic_forward_proxy.DnsCacheConfig {
+	lookupFamily := envoy_cluster.Cluster_V4_ONLY
+	if hasIPv6 {
+		lookupFamily = envoy_cluster.Cluster_AUTO
+	}
+	return &envoy_dynamic_forward_proxy.DnsCacheConfig{
+		Name:            name,
+		DnsLookupFamily: lookupFamily,
+		HostTtl:         conf.GetHostTtl(),
+		MaxHosts:        conf.GetMaxHosts(),
+	}
+}
+
+func DynamicForwardProxyPort(conf *mesh_proto.Networking_Outbound_DynamicForwardProxy) uint32 {
+	if conf.GetPort() == 0 {
+		return DefaultDynamicForwardProxyPort
+	}
+	return conf.GetPort()
+}
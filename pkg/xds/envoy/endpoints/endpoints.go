@@ -3,8 +3,10 @@ package endpoints
 import (
 	"errors"
 
+	envoy_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	"github.com/golang/protobuf/proto"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
 	endpoints_v3 "github.com/kumahq/kuma/pkg/xds/envoy/endpoints/v3"
@@ -18,3 +20,32 @@ func CreateClusterLoadAssignment(clusterName string, endpoints []core_xds.Endpoi
 		return nil, errors.New("unknown API")
 	}
 }
+
+// CreateLocalityAwareClusterLoadAssignment creates ClusterLoadAssignment with the overprovisioning
+// factor configured in the Locality Aware Load Balancing options of the mesh.
+func CreateLocalityAwareClusterLoadAssignment(clusterName string, endpoints []core_xds.Endpoint, routing *mesh_proto.Routing, apiVersion envoy_common.APIVersion) (proto.Message, error) {
+	switch apiVersion {
+	case envoy_common.APIV3:
+		cla := endpoints_v3.CreateClusterLoadAssignment(clusterName, endpoints)
+		if factor := routing.GetLocalityAwareLoadBalancingOptions().GetOverprovisioningFactor(); factor != nil && routing.GetLocalityAwareLoadBalancing() {
+			endpoints_v3.OverprovisioningFactor(cla, factor.GetValue())
+		}
+		return cla, nil
+	default:
+		return nil, errors.New("unknown API")
+	}
+}
+
+// ZoneTrafficRoute returns the copy of the ClusterLoadAssignment with the priorities and the weights of the zones
+// configured by MeshZoneTrafficRoute. The ClusterLoadAssignment itself is not modified, because it is cached and
+// shared by many proxies.
+func ZoneTrafficRoute(cla proto.Message, conf *mesh_proto.MeshZoneTrafficRoute_Conf, apiVersion envoy_common.APIVersion) (proto.Message, error) {
+	switch apiVersion {
+	case envoy_common.APIV3:
+		clone := proto.Clone(cla).(*envoy_endpoint.ClusterLoadAssignment)
+		endpoints_v3.ZoneTrafficRoute(clone, conf)
+		return clone, nil
+	default:
+		return nil, errors.New("unknown API")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	envoy_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	proto_wrappers "google.golang.org/protobuf/types/known/wrapperspb"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	envoy "github.com/kumahq/kuma/pkg/xds/envoy/metadata/v3"
 )
@@ -104,6 +105,92 @@ func (l LocalityLbEndpointsMap) asSlice() []*envoy_endpoint.LocalityLbEndpoints
 	return slice
 }
 
+// OverprovisioningFactor sets the overprovisioning factor of the priority levels, which determines
+// when the traffic overflows to lower priorities (endpoints in other zones).
+func OverprovisioningFactor(cla *envoy_endpoint.ClusterLoadAssignment, factor uint32) {
+	if cla.Policy == nil {
+		cla.Policy = &envoy_endpoint.ClusterLoadAssignment_Policy{}
+	}
+	cla.Policy.OverprovisioningFactor = &proto_wrappers.UInt32Value{Value: factor}
+}
+
+// ZoneTrafficRoute sets the priorities and the weights of the localities according to the zones of MeshZoneTrafficRoute.
+// The zones that are not listed get the priority of the '*' zone or, when it is not listed, the priority lower than
+// the priorities of all the listed zones. When any zone has a weight, every locality gets one, because the locality
+// weighted load balancing of Envoy does not route to the localities without a weight. The localities of the zones
+// without a weight get the sum of the weights of their endpoints.
+func ZoneTrafficRoute(cla *envoy_endpoint.ClusterLoadAssignment, conf *mesh_proto.MeshZoneTrafficRoute_Conf) {
+	zones := map[string]*mesh_proto.MeshZoneTrafficRoute_Conf_Zone{}
+	lowestPriority := uint32(0)
+	weighted := false
+	for _, zone := range conf.GetZones() {
+		zones[zone.GetName()] = zone
+		if zone.GetPriority() > lowestPriority {
+			lowestPriority = zone.GetPriority()
+		}
+		if zone.GetWeight() != nil {
+			weighted = true
+		}
+	}
+	unlisted, ok := zones[mesh_proto.MatchAllTag]
+	if !ok {
+		unlisted = &mesh_proto.MeshZoneTrafficRoute_Conf_Zone{Priority: lowestPriority + 1}
+	}
+
+	for _, lle := range cla.Endpoints {
+		zone := unlisted
+		if listed, ok := zones[lle.GetLocality().GetZone()]; ok {
+			zone = listed
+		}
+		// endpoints without the locality are not in any zone, they keep their priority
+		if lle.Locality != nil {
+			lle.Priority = zone.GetPriority()
+		}
+		if weighted {
+			if lle.Locality != nil && zone.GetWeight() != nil {
+				lle.LoadBalancingWeight = &proto_wrappers.UInt32Value{Value: zone.GetWeight().GetValue()}
+			} else {
+				lle.LoadBalancingWeight = &proto_wrappers.UInt32Value{Value: endpointsWeight(lle.LbEndpoints)}
+			}
+		}
+	}
+	compactPriorities(cla.Endpoints)
+}
+
+// compactPriorities renumbers the priorities so they start from 0 and have no gaps, as required by Envoy.
+func compactPriorities(endpoints []*envoy_endpoint.LocalityLbEndpoints) {
+	var priorities []uint32
+	seen := map[uint32]bool{}
+	for _, lle := range endpoints {
+		if !seen[lle.Priority] {
+			seen[lle.Priority] = true
+			priorities = append(priorities, lle.Priority)
+		}
+	}
+	sort.Slice(priorities, func(i, j int) bool {
+		return priorities[i] < priorities[j]
+	})
+	compacted := map[uint32]uint32{}
+	for i, priority := range priorities {
+		compacted[priority] = uint32(i)
+	}
+	for _, lle := range endpoints {
+		lle.Priority = compacted[lle.Priority]
+	}
+}
+
+func endpointsWeight(lbEndpoints []*envoy_endpoint.LbEndpoint) uint32 {
+	weight := uint32(0)
+	for _, lbEndpoint := range lbEndpoints {
+		if lbEndpoint.GetLoadBalancingWeight() != nil {
+			weight += lbEndpoint.GetLoadBalancingWeight().GetValue()
+		} else {
+			weight++
+		}
+	}
+	return weight
+}
+
 func sortLbEndpoints(lbEndpoints []*envoy_endpoint.LbEndpoint) {
 	sort.Slice(lbEndpoints, func(i, j int) bool {
 		left, right := lbEndpoints[i], lbEndpoints[j]
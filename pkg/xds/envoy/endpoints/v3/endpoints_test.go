@@ -1,9 +1,12 @@
 package endpoints_test
 
 import (
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	. "github.com/kumahq/kuma/pkg/xds/envoy/endpoints/v3"
@@ -190,4 +193,76 @@ var _ = Describe("Endpoints", func() {
 			}),
 		)
 	})
+
+	Describe("ZoneTrafficRoute()", func() {
+		endpoints := []core_xds.Endpoint{
+			{Target: "192.168.0.1", Port: 8080, Weight: 1, Locality: &core_xds.Locality{Zone: "zone-1", Priority: 0}},
+			{Target: "192.168.0.2", Port: 8080, Weight: 1, Locality: &core_xds.Locality{Zone: "zone-2", Priority: 1}},
+			{Target: "192.168.0.3", Port: 8080, Weight: 2, Locality: &core_xds.Locality{Zone: "zone-2", Priority: 1}},
+			{Target: "192.168.0.4", Port: 8080, Weight: 1, Locality: &core_xds.Locality{Zone: "zone-3", Priority: 1}},
+		}
+
+		type testCase struct {
+			conf     string
+			expected map[string]string
+		}
+		DescribeTable("should set priorities and weights of the zones",
+			func(given testCase) {
+				// given
+				cla := CreateClusterLoadAssignment("backend", endpoints)
+				conf := &mesh_proto.MeshZoneTrafficRoute_Conf{}
+				Expect(util_proto.FromYAML([]byte(given.conf), conf)).To(Succeed())
+
+				// when
+				ZoneTrafficRoute(cla, conf)
+
+				// then
+				actual := map[string]string{}
+				for _, lle := range cla.Endpoints {
+					actual[lle.GetLocality().GetZone()] = fmt.Sprintf("priority=%d weight=%v", lle.GetPriority(), lle.GetLoadBalancingWeight().GetValue())
+				}
+				Expect(actual).To(Equal(given.expected))
+			},
+			Entry("unlisted zones get the lowest priority", testCase{
+				conf: `
+                zones:
+                - name: zone-2
+                  priority: 0
+                - name: zone-1
+                  priority: 3`,
+				expected: map[string]string{
+					"zone-1": "priority=1 weight=0",
+					"zone-2": "priority=0 weight=0",
+					"zone-3": "priority=2 weight=0",
+				},
+			}),
+			Entry("unlisted zones get the priority of '*'", testCase{
+				conf: `
+                zones:
+                - name: zone-3
+                  priority: 1
+                - name: '*'
+                  priority: 0`,
+				expected: map[string]string{
+					"zone-1": "priority=0 weight=0",
+					"zone-2": "priority=0 weight=0",
+					"zone-3": "priority=1 weight=0",
+				},
+			}),
+			Entry("zones without a weight get the weight of their endpoints", testCase{
+				conf: `
+                zones:
+                - name: zone-1
+                  weight: 10
+                - name: zone-3
+                  priority: 1
+                  weight: 5`,
+				expected: map[string]string{
+					"zone-1": "priority=0 weight=10",
+					"zone-2": "priority=2 weight=3",
+					"zone-3": "priority=1 weight=5",
+				},
+			}),
+		)
+	})
 })
@@ -20,6 +20,74 @@ func GrpcStats() FilterChainBuilderOpt {
 	return AddFilterChainConfigurer(&v3.GrpcStatsConfigurer{})
 }
 
+// GrpcWeb has to be configured before GrpcStats, so the stats of translated gRPC-Web requests are collected.
+func GrpcWeb() FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.GrpcWebConfigurer{})
+}
+
+func Tap(enabled bool) FilterChainBuilderOpt {
+	if !enabled {
+		return FilterChainBuilderOptFunc(nil)
+	}
+
+	return AddFilterChainConfigurer(&v3.TapConfigurer{})
+}
+
+func LocalReply(localReply *core_mesh.LocalReplyResource) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.LocalReplyConfigurer{
+		LocalReply: localReply,
+	})
+}
+
+func HeaderModifier(modifications *mesh_proto.MeshHeaderModifier_Conf_Modifications) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.HeaderModifierConfigurer{
+		Modifications: modifications,
+	})
+}
+
+func ExternalAuthz(conf *mesh_proto.MeshExternalAuthz_Conf) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.ExternalAuthzConfigurer{
+		Conf: conf,
+	})
+}
+
+func JWT(providers []v3.JWTProvider, rules []*mesh_proto.MeshJWT_Conf_Rule) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.JWTConfigurer{
+		Providers: providers,
+		Rules:     rules,
+	})
+}
+
+func BandwidthLimit(limit *mesh_proto.MeshBandwidthLimit_Conf_Limit) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.BandwidthLimitConfigurer{
+		Limit: limit,
+	})
+}
+
+func CORS(conf *mesh_proto.MeshCORS_Conf) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.CorsConfigurer{
+		Conf: conf,
+	})
+}
+
+func Compression(conf *mesh_proto.MeshCompression_Conf) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.CompressionConfigurer{
+		Conf: conf,
+	})
+}
+
+func RequestProtection(conf *mesh_proto.MeshRequestProtection_Conf) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.RequestProtectionConfigurer{
+		Conf: conf,
+	})
+}
+
+func Wasm(plugins []v3.WasmPlugin) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.WasmConfigurer{
+		Plugins: plugins,
+	})
+}
+
 func Kafka(statsName string) FilterChainBuilderOpt {
 	return AddFilterChainConfigurer(&v3.KafkaConfigurer{
 		StatsName: statsName,
@@ -61,14 +129,16 @@ func HttpConnectionManager(statsName string, forwardClientCertDetails bool) Filt
 	})
 }
 
-func NetworkRBAC(statsName string, rbacEnabled bool, permission *core_mesh.TrafficPermissionResource) FilterChainBuilderOpt {
+func NetworkRBAC(statsName string, rbacEnabled bool, permission *core_mesh.TrafficPermissionResource, denies []*core_mesh.TrafficPermissionResource, federatedTrustDomains []*mesh_proto.Mesh_Mtls_FederatedTrustDomain) FilterChainBuilderOpt {
 	if !rbacEnabled {
 		return FilterChainBuilderOptFunc(nil)
 	}
 
 	return AddFilterChainConfigurer(&v3.NetworkRBACConfigurer{
-		StatsName:  statsName,
-		Permission: permission,
+		StatsName:             statsName,
+		Permission:            permission,
+		Denies:                denies,
+		FederatedTrustDomains: federatedTrustDomains,
 	})
 }
 
@@ -88,6 +158,29 @@ func TcpProxyWithMetadata(statsName string, clusters ...envoy_common.Cluster) Fi
 	})
 }
 
+// SNIDynamicForwardProxy has to share the DNS cache with the dynamic forward proxy cluster
+// the connections are forwarded to.
+func SNIDynamicForwardProxy(cacheName string, hasIPv6 bool, conf *mesh_proto.Networking_Outbound_DynamicForwardProxy) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.SNIDynamicForwardProxyConfigurer{
+		DNSCache: envoy_common.DynamicForwardProxyDNSCache(cacheName, hasIPv6, conf),
+		Port:     envoy_common.DynamicForwardProxyPort(conf),
+	})
+}
+
+func ConnectionRateLimit(statsName string, limit *mesh_proto.Routing_ZoneIngressOptions_ConnectionRateLimit) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.ConnectionRateLimitConfigurer{
+		StatsName: statsName,
+		Limit:     limit,
+	})
+}
+
+func DestinationPortsRBAC(statsName string, portRanges []*mesh_proto.Networking_Outbound_PortRange) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(&v3.DestinationPortsRBACConfigurer{
+		StatsName:  statsName,
+		PortRanges: portRanges,
+	})
+}
+
 func FaultInjection(faultInjections ...*core_mesh.FaultInjectionResource) FilterChainBuilderOpt {
 	return AddFilterChainConfigurer(&v3.FaultInjectionConfigurer{
 		FaultInjections: faultInjections,
@@ -55,6 +55,19 @@ func MatchApplicationProtocols(alpn ...string) FilterChainBuilderOpt {
 	)
 }
 
+// MatchDestinationPort sets the original destination port match for the filter chain.
+func MatchDestinationPort(port uint32) FilterChainBuilderOpt {
+	return AddFilterChainConfigurer(
+		v3.FilterChainMustConfigureFunc(func(chain *envoy_listener.FilterChain) {
+			if chain.FilterChainMatch == nil {
+				chain.FilterChainMatch = &envoy_listener.FilterChainMatch{}
+			}
+
+			chain.FilterChainMatch.DestinationPort = util_proto.UInt32(port)
+		}),
+	)
+}
+
 // MatchSourceAddress appends an exact filter chain match for the given source IP address.
 func MatchSourceAddress(address string) FilterChainBuilderOpt {
 	return AddFilterChainConfigurer(
@@ -1,6 +1,7 @@
 package listeners
 
 import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -13,6 +14,11 @@ func TLSInspector() ListenerBuilderOpt {
 	return AddListenerConfigurer(&v3.TLSInspectorConfigurer{})
 }
 
+// ProxyProtocol has to be configured before TLSInspector.
+func ProxyProtocol() ListenerBuilderOpt {
+	return AddListenerConfigurer(&v3.ProxyProtocolConfigurer{})
+}
+
 func OriginalDstForwarder() ListenerBuilderOpt {
 	return AddListenerConfigurer(&v3.OriginalDstForwarderConfigurer{})
 }
@@ -35,6 +41,14 @@ func OutboundListener(listenerName string, address string, port uint32, protocol
 	})
 }
 
+// PipeListener binds the listener to the unix domain socket of the given path that only the user running Envoy can access.
+func PipeListener(listenerName string, path string) ListenerBuilderOpt {
+	return AddListenerConfigurer(&v3.PipeListenerConfigurer{
+		ListenerName: listenerName,
+		Path:         path,
+	})
+}
+
 func TransparentProxying(transparentProxying *mesh_proto.Dataplane_Networking_TransparentProxying) ListenerBuilderOpt {
 	virtual := transparentProxying.GetRedirectPortOutbound() != 0 && transparentProxying.GetRedirectPortInbound() != 0
 	if virtual {
@@ -90,6 +104,20 @@ func EnableFreebind(enable bool) ListenerBuilderOpt {
 		}))
 }
 
+// QUIC makes the listener accept QUIC connections. The listener has to use
+// the UDP protocol.
+func QUIC() ListenerBuilderOpt {
+	return AddListenerConfigurer(
+		v3.ListenerMustConfigureFunc(func(l *envoy_listener.Listener) {
+			l.UdpListenerConfig = &envoy_listener.UdpListenerConfig{
+				QuicOptions: &envoy_listener.QuicProtocolOptions{},
+				DownstreamSocketConfig: &envoy_core.UdpSocketConfig{
+					PreferGro: wrapperspb.Bool(true),
+				},
+			}
+		}))
+}
+
 func TagsMetadata(tags map[string]string) ListenerBuilderOpt {
 	return AddListenerConfigurer(&v3.TagsMetadataConfigurer{
 		Tags: tags,
@@ -0,0 +1,44 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_bandwidth_limit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/bandwidth_limit/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// BandwidthLimitConfigurer limits the bandwidth of both requests and responses going through the filter chain.
+type BandwidthLimitConfigurer struct {
+	Limit *mesh_proto.MeshBandwidthLimit_Conf_Limit
+}
+
+var _ FilterChainConfigurer = &BandwidthLimitConfigurer{}
+
+func (b *BandwidthLimitConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if b.Limit == nil {
+		return nil
+	}
+
+	config := &envoy_bandwidth_limit.BandwidthLimit{
+		StatPrefix:   "bandwidth_limit",
+		EnableMode:   envoy_bandwidth_limit.BandwidthLimit_REQUEST_AND_RESPONSE,
+		LimitKbps:    util_proto.UInt64(b.Limit.GetBytesPerSecond() / 1024),
+		FillInterval: b.Limit.GetFillInterval(),
+	}
+	typedConfig, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return err
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append(manager.HttpFilters, &envoy_hcm.HttpFilter{
+			Name: "envoy.filters.http.bandwidth_limit",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: typedConfig,
+			},
+		})
+		return nil
+	})
+}
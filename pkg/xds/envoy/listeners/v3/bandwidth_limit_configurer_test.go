@@ -0,0 +1,77 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("BandwidthLimitConfigurer", func() {
+	type testCase struct {
+		limit    string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			var limit *mesh_proto.MeshBandwidthLimit_Conf_Limit
+			if given.limit != "" {
+				limit = &mesh_proto.MeshBandwidthLimit_Conf_Limit{}
+				Expect(util_proto.FromYAML([]byte(given.limit), limit)).To(Succeed())
+			}
+
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("localhost:8080", false)).
+				Configure(BandwidthLimit(limit)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("with fill interval", testCase{
+			limit: `
+            bytesPerSecond: 1048576
+            fillInterval: 100ms`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.bandwidth_limit
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.bandwidth_limit.v3.BandwidthLimit
+                    enableMode: REQUEST_AND_RESPONSE
+                    fillInterval: 0.100s
+                    limitKbps: '1024'
+                    statPrefix: bandwidth_limit
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080`,
+		}),
+		Entry("without limit", testCase{
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080`,
+		}),
+	)
+})
@@ -0,0 +1,87 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_brotli "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoy_gzip "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoy_compressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// CompressionConfigurer adds a compressor filter for every configured library.
+// Envoy picks the compressor based on the Accept-Encoding header of the request,
+// so the order of libraries defines the preference when the client accepts many of them.
+type CompressionConfigurer struct {
+	Conf *mesh_proto.MeshCompression_Conf
+}
+
+var _ FilterChainConfigurer = &CompressionConfigurer{}
+
+func (c *CompressionConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.Conf == nil {
+		return nil
+	}
+
+	var filters []*envoy_hcm.HttpFilter
+	for _, library := range c.Conf.GetLibraries() {
+		filter, err := c.compressorFilter(library)
+		if err != nil {
+			return err
+		}
+		filters = append(filters, filter)
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append(manager.HttpFilters, filters...)
+		return nil
+	})
+}
+
+func (c *CompressionConfigurer) compressorFilter(library mesh_proto.MeshCompression_Conf_Library) (*envoy_hcm.HttpFilter, error) {
+	var name string
+	var libraryConfig proto.Message
+	switch library {
+	case mesh_proto.MeshCompression_Conf_GZIP:
+		name = "gzip"
+		libraryConfig = &envoy_gzip.Gzip{}
+	case mesh_proto.MeshCompression_Conf_BROTLI:
+		name = "brotli"
+		libraryConfig = &envoy_brotli.Brotli{}
+	default:
+		return nil, errors.Errorf("unsupported compression library %s", library)
+	}
+	libraryTypedConfig, err := util_proto.MarshalAnyDeterministic(libraryConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &envoy_compressor.Compressor{
+		CompressorLibrary: &envoy_core.TypedExtensionConfig{
+			Name:        name,
+			TypedConfig: libraryTypedConfig,
+		},
+		ResponseDirectionConfig: &envoy_compressor.Compressor_ResponseDirectionConfig{
+			CommonConfig: &envoy_compressor.Compressor_CommonDirectionConfig{
+				MinContentLength: c.Conf.GetMinContentLength(),
+				ContentType:      c.Conf.GetContentTypes(),
+			},
+			DisableOnEtagHeader: c.Conf.GetDisableOnEtagHeader(),
+		},
+	}
+	typedConfig, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_hcm.HttpFilter{
+		Name: name + "-compress",
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}, nil
+}
@@ -0,0 +1,125 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("CompressionConfigurer", func() {
+	type testCase struct {
+		conf     string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			var conf *mesh_proto.MeshCompression_Conf
+			if given.conf != "" {
+				conf = &mesh_proto.MeshCompression_Conf{}
+				Expect(util_proto.FromYAML([]byte(given.conf), conf)).To(Succeed())
+			}
+
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("localhost:8080", false)).
+				Configure(Compression(conf)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("with all libraries", testCase{
+			conf: `
+            libraries:
+            - BROTLI
+            - GZIP
+            minContentLength: 100
+            contentTypes:
+            - application/json
+            disableOnEtagHeader: true`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: brotli-compress
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.compressor.v3.Compressor
+                    compressorLibrary:
+                      name: brotli
+                      typedConfig:
+                        '@type': type.googleapis.com/envoy.extensions.compression.brotli.compressor.v3.Brotli
+                    responseDirectionConfig:
+                      commonConfig:
+                        contentType:
+                        - application/json
+                        minContentLength: 100
+                      disableOnEtagHeader: true
+                - name: gzip-compress
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.compressor.v3.Compressor
+                    compressorLibrary:
+                      name: gzip
+                      typedConfig:
+                        '@type': type.googleapis.com/envoy.extensions.compression.gzip.compressor.v3.Gzip
+                    responseDirectionConfig:
+                      commonConfig:
+                        contentType:
+                        - application/json
+                        minContentLength: 100
+                      disableOnEtagHeader: true
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080`,
+		}),
+		Entry("with defaults", testCase{
+			conf: `
+            libraries:
+            - GZIP`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: gzip-compress
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.compressor.v3.Compressor
+                    compressorLibrary:
+                      name: gzip
+                      typedConfig:
+                        '@type': type.googleapis.com/envoy.extensions.compression.gzip.compressor.v3.Gzip
+                    responseDirectionConfig:
+                      commonConfig: {}
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080`,
+		}),
+		Entry("without conf", testCase{
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080`,
+		}),
+	)
+})
@@ -0,0 +1,48 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_local_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+)
+
+// ConnectionRateLimitConfigurer limits new connections of the filter chain with
+// a token bucket that is not shared with other filter chains of the listener.
+type ConnectionRateLimitConfigurer struct {
+	StatsName string
+	Limit     *mesh_proto.Routing_ZoneIngressOptions_ConnectionRateLimit
+}
+
+var _ FilterChainConfigurer = &ConnectionRateLimitConfigurer{}
+
+func (c *ConnectionRateLimitConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.Limit == nil {
+		return nil
+	}
+
+	config, err := util_proto.MarshalAnyDeterministic(&envoy_local_ratelimit.LocalRateLimit{
+		StatPrefix: util_xds.SanitizeMetric(c.StatsName),
+		TokenBucket: &envoy_type.TokenBucket{
+			MaxTokens:     c.Limit.GetConnections(),
+			TokensPerFill: util_proto.UInt32(c.Limit.GetConnections()),
+			FillInterval:  c.Limit.GetInterval(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	filter := &envoy_listener.Filter{
+		Name: "envoy.filters.network.local_ratelimit",
+		ConfigType: &envoy_listener.Filter_TypedConfig{
+			TypedConfig: config,
+		},
+	}
+
+	// connections have to be limited before they are proxied
+	filterChain.Filters = append([]*envoy_listener.Filter{filter}, filterChain.Filters...)
+	return nil
+}
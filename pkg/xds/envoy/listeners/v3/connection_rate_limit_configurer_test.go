@@ -0,0 +1,90 @@
+package v3_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("ConnectionRateLimitConfigurer", func() {
+
+	type testCase struct {
+		limit    *mesh_proto.Routing_ZoneIngressOptions_ConnectionRateLimit
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			listener, err := NewListenerBuilder(envoy_common.APIV3).
+				Configure(InboundListener("inbound:10.0.0.1:10001", "10.0.0.1", 10001, xds.SocketAddressProtocolTCP)).
+				Configure(FilterChain(NewFilterChainBuilder(envoy_common.APIV3).
+					Configure(TcpProxy("backend", envoy_common.NewCluster(envoy_common.WithService("backend")))).
+					Configure(ConnectionRateLimit("backend{mesh=default}", given.limit)))).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(listener)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without limit", testCase{
+			expected: `
+            name: inbound:10.0.0.1:10001
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 10.0.0.1
+                portValue: 10001
+            enableReusePort: false
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  cluster: backend
+                  statPrefix: backend
+`,
+		}),
+		Entry("with limit", testCase{
+			limit: &mesh_proto.Routing_ZoneIngressOptions_ConnectionRateLimit{
+				Connections: 10,
+				Interval:    util_proto.Duration(time.Minute),
+			},
+			expected: `
+            name: inbound:10.0.0.1:10001
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 10.0.0.1
+                portValue: 10001
+            enableReusePort: false
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.local_ratelimit
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.local_ratelimit.v3.LocalRateLimit
+                  statPrefix: backend_mesh_default_
+                  tokenBucket:
+                    fillInterval: 60s
+                    maxTokens: 10
+                    tokensPerFill: 10
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  cluster: backend
+                  statPrefix: backend
+`,
+		}),
+	)
+})
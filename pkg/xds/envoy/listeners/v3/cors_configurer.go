@@ -0,0 +1,50 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_cors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
+)
+
+// CorsConfigurer inserts the CORS filter as the first HTTP filter, so preflight requests are answered
+// before they are authorized or rate limited. If the routes are specified as a static route configuration,
+// the CORS policy is also set on all of its virtual hosts, so it has to be applied after the routes.
+// Virtual hosts of a dynamic route configuration have to be configured separately.
+type CorsConfigurer struct {
+	Conf *mesh_proto.MeshCORS_Conf
+}
+
+var _ FilterChainConfigurer = &CorsConfigurer{}
+
+func (c *CorsConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.Conf == nil {
+		return nil
+	}
+
+	typedConfig, err := util_proto.MarshalAnyDeterministic(&envoy_cors.Cors{})
+	if err != nil {
+		return err
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		filter := &envoy_hcm.HttpFilter{
+			Name: "envoy.filters.http.cors",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: typedConfig,
+			},
+		}
+		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{filter}, manager.HttpFilters...)
+
+		configurer := &envoy_routes.CorsConfigurer{Conf: c.Conf}
+		for _, virtualHost := range manager.GetRouteConfig().GetVirtualHosts() {
+			if err := configurer.Configure(virtualHost); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
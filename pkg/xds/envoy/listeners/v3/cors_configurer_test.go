@@ -0,0 +1,134 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("CorsConfigurer", func() {
+	conf := &mesh_proto.MeshCORS_Conf{
+		AllowOrigins: []*mesh_proto.MeshCORS_Conf_Origin{{
+			Match: &mesh_proto.MeshCORS_Conf_Origin_Exact{
+				Exact: "https://example.com",
+			},
+		}},
+		AllowMethods: []string{"GET", "POST"},
+	}
+
+	It("should add the CORS filter and set the policy on static virtual hosts", func() {
+		// given
+		routes := envoy_common.Routes{
+			envoy_common.NewRouteFromCluster(envoy_common.NewCluster(envoy_common.WithService("localhost:8080"))),
+		}
+
+		// when
+		filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+			Configure(HttpConnectionManager("localhost:8080", false)).
+			Configure(HttpInboundRoutes("backend", routes)).
+			Configure(CORS(conf)).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(filterChain)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.cors
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.cors.v3.Cors
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                routeConfig:
+                  name: inbound:backend
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  validateClusters: false
+                  virtualHosts:
+                  - cors:
+                      allowMethods: GET,POST
+                      allowOriginStringMatch:
+                      - exact: https://example.com
+                    domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                        timeout: 0s
+                statPrefix: localhost_8080`))
+	})
+
+	It("should add only the CORS filter with dynamic routes", func() {
+		// when
+		filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+			Configure(HttpConnectionManager("localhost:8080", false)).
+			Configure(HttpDynamicRoute("gateway:listener")).
+			Configure(CORS(conf)).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(filterChain)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.cors
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.cors.v3.Cors
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                rds:
+                  configSource:
+                    ads: {}
+                    resourceApiVersion: V3
+                  routeConfigName: gateway:listener
+                statPrefix: localhost_8080`))
+	})
+
+	It("should not change the filter chain without conf", func() {
+		// when
+		filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+			Configure(HttpConnectionManager("localhost:8080", false)).
+			Configure(CORS(nil)).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(filterChain)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080`))
+	})
+})
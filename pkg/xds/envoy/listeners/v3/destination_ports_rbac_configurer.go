@@ -0,0 +1,71 @@
+package v3
+
+import (
+	"fmt"
+
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	rbac_config "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	util_xds "github.com/kumahq/kuma/pkg/util/xds"
+)
+
+// DestinationPortsRBACConfigurer allows only the connections to the original
+// destination ports within the given ranges. No ranges deny all the connections.
+type DestinationPortsRBACConfigurer struct {
+	StatsName  string
+	PortRanges []*mesh_proto.Networking_Outbound_PortRange
+}
+
+var _ FilterChainConfigurer = &DestinationPortsRBACConfigurer{}
+
+func (c *DestinationPortsRBACConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	policies := map[string]*rbac_config.Policy{}
+	if len(c.PortRanges) > 0 {
+		var permissions []*rbac_config.Permission
+		for _, portRange := range c.PortRanges {
+			permissions = append(permissions, &rbac_config.Permission{
+				Rule: &rbac_config.Permission_DestinationPortRange{
+					DestinationPortRange: &envoy_type.Int32Range{
+						Start: int32(portRange.GetFrom()),
+						End:   int32(portRange.LastPort()) + 1, // the end of the range is exclusive
+					},
+				},
+			})
+		}
+		policies["destination-ports"] = &rbac_config.Policy{
+			Permissions: permissions,
+			Principals: []*rbac_config.Principal{
+				{
+					Identifier: &rbac_config.Principal_Any{
+						Any: true,
+					},
+				},
+			},
+		}
+	}
+
+	config, err := util_proto.MarshalAnyDeterministic(&rbac.RBAC{
+		Rules: &rbac_config.RBAC{
+			Action:   rbac_config.RBAC_ALLOW,
+			Policies: policies,
+		},
+		StatPrefix: fmt.Sprintf("%s.", util_xds.SanitizeMetric(c.StatsName)),
+	})
+	if err != nil {
+		return err
+	}
+	filter := &envoy_listener.Filter{
+		Name: "envoy.filters.network.rbac",
+		ConfigType: &envoy_listener.Filter_TypedConfig{
+			TypedConfig: config,
+		},
+	}
+
+	// RBAC filter should be the first in the chain
+	filterChain.Filters = append([]*envoy_listener.Filter{filter}, filterChain.Filters...)
+	return nil
+}
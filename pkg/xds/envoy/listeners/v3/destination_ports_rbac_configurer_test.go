@@ -0,0 +1,99 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("DestinationPortsRBACConfigurer", func() {
+
+	type testCase struct {
+		portRanges []*mesh_proto.Networking_Outbound_PortRange
+		expected   string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			listener, err := NewListenerBuilder(envoy_common.APIV3).
+				Configure(OutboundListener("outbound:passthrough:ipv4", "0.0.0.0", 15001, xds.SocketAddressProtocolTCP)).
+				Configure(FilterChain(NewFilterChainBuilder(envoy_common.APIV3).
+					Configure(TcpProxy("outbound:passthrough:ipv4", envoy_common.NewCluster(envoy_common.WithService("outbound:passthrough:ipv4")))).
+					Configure(DestinationPortsRBAC("outbound:passthrough:ipv4", given.portRanges)))).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(listener)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without port ranges", testCase{
+			expected: `
+            name: outbound:passthrough:ipv4
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 0.0.0.0
+                portValue: 15001
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.rbac
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.rbac.v3.RBAC
+                  rules: {}
+                  statPrefix: outbound_passthrough_ipv4.
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  cluster: outbound:passthrough:ipv4
+                  statPrefix: outbound_passthrough_ipv4
+`,
+		}),
+		Entry("with port ranges", testCase{
+			portRanges: []*mesh_proto.Networking_Outbound_PortRange{
+				{From: 443},
+				{From: 8000, To: 8999},
+			},
+			expected: `
+            name: outbound:passthrough:ipv4
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 0.0.0.0
+                portValue: 15001
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.rbac
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.rbac.v3.RBAC
+                  rules:
+                    policies:
+                      destination-ports:
+                        permissions:
+                        - destinationPortRange:
+                            end: 444
+                            start: 443
+                        - destinationPortRange:
+                            end: 9000
+                            start: 8000
+                        principals:
+                        - any: true
+                  statPrefix: outbound_passthrough_ipv4.
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  cluster: outbound:passthrough:ipv4
+                  statPrefix: outbound_passthrough_ipv4
+`,
+		}),
+	)
+})
@@ -0,0 +1,136 @@
+package v3
+
+import (
+	net_url "net/url"
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_ext_authz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+)
+
+const defaultExternalAuthzTimeout = 200 * time.Millisecond
+
+// ExternalAuthzConfigurer inserts the external authorization filter as the first HTTP filter,
+// so requests are authorized before any other HTTP filter generated by Kuma is applied.
+type ExternalAuthzConfigurer struct {
+	Conf *mesh_proto.MeshExternalAuthz_Conf
+}
+
+var _ FilterChainConfigurer = &ExternalAuthzConfigurer{}
+
+func (e *ExternalAuthzConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if e.Conf == nil {
+		return nil
+	}
+
+	config := &envoy_ext_authz.ExtAuthz{
+		TransportApiVersion: envoy_core.ApiVersion_V3,
+		FailureModeAllow:    e.Conf.GetFailureModeAllow(),
+	}
+	switch e.Conf.GetService().(type) {
+	case *mesh_proto.MeshExternalAuthz_Conf_Grpc:
+		config.Services = &envoy_ext_authz.ExtAuthz_GrpcService{
+			GrpcService: e.grpcService(e.Conf.GetGrpc()),
+		}
+	case *mesh_proto.MeshExternalAuthz_Conf_Http:
+		httpService, err := e.httpService(e.Conf.GetHttp())
+		if err != nil {
+			return err
+		}
+		config.Services = &envoy_ext_authz.ExtAuthz_HttpService{
+			HttpService: httpService,
+		}
+	default:
+		return errors.Errorf("unsupported authorization service %T", e.Conf.GetService())
+	}
+	if body := e.Conf.GetRequestBody(); body != nil {
+		config.WithRequestBody = &envoy_ext_authz.BufferSettings{
+			MaxRequestBytes:     body.GetMaxRequestBytes(),
+			AllowPartialMessage: body.GetAllowPartialMessage(),
+		}
+	}
+
+	typedConfig, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return err
+	}
+	filter := &envoy_hcm.HttpFilter{
+		Name: "envoy.filters.http.ext_authz",
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{filter}, manager.HttpFilters...)
+		return nil
+	})
+}
+
+func (e *ExternalAuthzConfigurer) grpcService(service *mesh_proto.MeshExternalAuthz_Conf_GrpcService) *envoy_core.GrpcService {
+	return &envoy_core.GrpcService{
+		TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
+			EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
+				ClusterName: names.GetExternalAuthzClusterName(service.GetAddress()),
+			},
+		},
+		Timeout: e.timeout(service.GetTimeout()),
+	}
+}
+
+func (e *ExternalAuthzConfigurer) httpService(service *mesh_proto.MeshExternalAuthz_Conf_HttpService) (*envoy_ext_authz.HttpService, error) {
+	url, err := net_url.ParseRequestURI(service.GetUrl())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid URL of the authorization service")
+	}
+	httpService := &envoy_ext_authz.HttpService{
+		ServerUri: &envoy_core.HttpUri{
+			Uri: service.GetUrl(),
+			HttpUpstreamType: &envoy_core.HttpUri_Cluster{
+				Cluster: names.GetExternalAuthzClusterName(url.Host),
+			},
+			Timeout: e.timeout(service.GetTimeout()),
+		},
+		PathPrefix: url.Path,
+	}
+	if headers := service.GetAllowedHeaders(); len(headers) > 0 {
+		httpService.AuthorizationRequest = &envoy_ext_authz.AuthorizationRequest{
+			AllowedHeaders: exactHeaderMatchers(headers),
+		}
+	}
+	if headers := service.GetAllowedUpstreamHeaders(); len(headers) > 0 {
+		httpService.AuthorizationResponse = &envoy_ext_authz.AuthorizationResponse{
+			AllowedUpstreamHeaders: exactHeaderMatchers(headers),
+		}
+	}
+	return httpService, nil
+}
+
+func (e *ExternalAuthzConfigurer) timeout(timeout *durationpb.Duration) *durationpb.Duration {
+	if timeout == nil {
+		return util_proto.Duration(defaultExternalAuthzTimeout)
+	}
+	return timeout
+}
+
+func exactHeaderMatchers(headers []string) *envoy_type_matcher.ListStringMatcher {
+	matchers := &envoy_type_matcher.ListStringMatcher{}
+	for _, header := range headers {
+		matchers.Patterns = append(matchers.Patterns, &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+				Exact: header,
+			},
+			IgnoreCase: true,
+		})
+	}
+	return matchers
+}
@@ -0,0 +1,124 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("ExternalAuthzConfigurer", func() {
+	type testCase struct {
+		conf     string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			conf := &mesh_proto.MeshExternalAuthz_Conf{}
+			Expect(util_proto.FromYAML([]byte(given.conf), conf)).To(Succeed())
+
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("localhost:8080", false)).
+				Configure(GrpcStats()).
+				Configure(ExternalAuthz(conf)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("grpc service", testCase{
+			conf: `
+            grpc:
+              address: opa.authz.svc:9191
+            failureModeAllow: true
+            requestBody:
+              maxRequestBytes: 8192
+              allowPartialMessage: true`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.ext_authz
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz
+                    failureModeAllow: true
+                    grpcService:
+                      envoyGrpc:
+                        clusterName: ext-authz:opa.authz.svc:9191
+                      timeout: 0.200s
+                    transportApiVersion: V3
+                    withRequestBody:
+                      allowPartialMessage: true
+                      maxRequestBytes: 8192
+                - name: envoy.filters.http.grpc_stats
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.grpc_stats.v3.FilterConfig
+                    emitFilterState: true
+                    statsForAllMethods: true
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080
+`,
+		}),
+		Entry("http service", testCase{
+			conf: `
+            http:
+              url: https://authz.example.com/check
+              timeout: 1s
+              allowedHeaders:
+              - x-user-id
+              allowedUpstreamHeaders:
+              - x-auth-user`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.ext_authz
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz
+                    httpService:
+                      authorizationRequest:
+                        allowedHeaders:
+                          patterns:
+                          - exact: x-user-id
+                            ignoreCase: true
+                      authorizationResponse:
+                        allowedUpstreamHeaders:
+                          patterns:
+                          - exact: x-auth-user
+                            ignoreCase: true
+                      pathPrefix: /check
+                      serverUri:
+                        cluster: ext-authz:authz.example.com
+                        timeout: 1s
+                        uri: https://authz.example.com/check
+                    transportApiVersion: V3
+                - name: envoy.filters.http.grpc_stats
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.grpc_stats.v3.FilterConfig
+                    emitFilterState: true
+                    statsForAllMethods: true
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080
+`,
+		}),
+	)
+})
@@ -96,10 +96,14 @@ func convertAbort(abort *mesh_proto.FaultInjection_Conf_Abort) *envoy_http_fault
 	if abort == nil {
 		return nil
 	}
-	return &envoy_http_fault.FaultAbort{
+	faultAbort := &envoy_http_fault.FaultAbort{
 		ErrorType:  &envoy_http_fault.FaultAbort_HttpStatus{HttpStatus: abort.HttpStatus.GetValue()},
 		Percentage: ConvertPercentage(abort.GetPercentage()),
 	}
+	if abort.GetGrpcStatus() != nil {
+		faultAbort.ErrorType = &envoy_http_fault.FaultAbort_GrpcStatus{GrpcStatus: abort.GetGrpcStatus().GetValue()}
+	}
+	return faultAbort
 }
 
 func convertResponseRateLimit(responseBandwidth *mesh_proto.FaultInjection_Conf_ResponseBandwidth) (*envoy_filter_fault.FaultRateLimit, error) {
@@ -76,6 +76,57 @@ var _ = Describe("FaultInjectionConfigurer", func() {
                     '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
                 statPrefix: stats`,
 		}),
+		Entry("gRPC abort and response bandwidth", testCase{
+			input: []*core_mesh.FaultInjectionResource{{
+				Spec: &mesh_proto.FaultInjection{
+					Sources: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"tag1": "value1",
+							},
+						},
+					},
+					Conf: &mesh_proto.FaultInjection_Conf{
+						Abort: &mesh_proto.FaultInjection_Conf_Abort{
+							Percentage: util_proto.Double(40),
+							GrpcStatus: util_proto.UInt32(14),
+						},
+						ResponseBandwidth: &mesh_proto.FaultInjection_Conf_ResponseBandwidth{
+							Percentage: util_proto.Double(50),
+							Limit:      util_proto.String("50kbps"),
+						},
+					},
+				},
+			}},
+
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.fault
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.fault.v3.HTTPFault
+                    abort:
+                      grpcStatus: 14
+                      percentage:
+                        numerator: 40
+                    headers:
+                    - name: x-kuma-tags
+                      safeRegexMatch:
+                        googleRe2: {}
+                        regex: '.*&tag1=[^&]*value1[,&].*'
+                    responseRateLimit:
+                      fixedLimit:
+                        limitKbps: "50"
+                      percentage:
+                        numerator: 50
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: stats`,
+		}),
 		Entry("2 policy selectors", testCase{
 			input: []*core_mesh.FaultInjectionResource{{
 				Spec: &mesh_proto.FaultInjection{
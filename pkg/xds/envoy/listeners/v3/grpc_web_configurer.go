@@ -0,0 +1,33 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_grpc_web "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_web/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// GrpcWebConfigurer enables gRPC-Web clients (e.g. browsers) to call gRPC services
+// by translating gRPC-Web requests to gRPC.
+type GrpcWebConfigurer struct {
+}
+
+var _ FilterChainConfigurer = &GrpcWebConfigurer{}
+
+func (g *GrpcWebConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	pbst, err := util_proto.MarshalAnyDeterministic(&envoy_grpc_web.GrpcWeb{})
+	if err != nil {
+		return err
+	}
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append(manager.HttpFilters,
+			&envoy_hcm.HttpFilter{
+				Name: "envoy.filters.http.grpc_web",
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: pbst,
+				},
+			})
+		return nil
+	})
+}
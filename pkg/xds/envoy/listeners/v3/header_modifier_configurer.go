@@ -0,0 +1,64 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// HeaderModifierConfigurer adds, sets and removes headers of requests and responses in the route configuration
+// of the HTTP connection manager according to the MeshHeaderModifier policy. It has to be applied after the routes.
+type HeaderModifierConfigurer struct {
+	Modifications *mesh_proto.MeshHeaderModifier_Conf_Modifications
+}
+
+var _ FilterChainConfigurer = &HeaderModifierConfigurer{}
+
+func (h *HeaderModifierConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if h.Modifications == nil {
+		return nil
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		routeConfig := manager.GetRouteConfig()
+		if routeConfig == nil {
+			return errors.New("cannot modify headers without a static route configuration")
+		}
+
+		request := h.Modifications.GetRequestHeaders()
+		routeConfig.RequestHeadersToAdd = append(routeConfig.RequestHeadersToAdd, headerValueOptions(request)...)
+		routeConfig.RequestHeadersToRemove = append(routeConfig.RequestHeadersToRemove, request.GetRemove()...)
+
+		response := h.Modifications.GetResponseHeaders()
+		routeConfig.ResponseHeadersToAdd = append(routeConfig.ResponseHeadersToAdd, headerValueOptions(response)...)
+		routeConfig.ResponseHeadersToRemove = append(routeConfig.ResponseHeadersToRemove, response.GetRemove()...)
+		return nil
+	})
+}
+
+func headerValueOptions(headers *mesh_proto.MeshHeaderModifier_Conf_Headers) []*envoy_core.HeaderValueOption {
+	var options []*envoy_core.HeaderValueOption
+	for _, header := range headers.GetAdd() {
+		options = append(options, &envoy_core.HeaderValueOption{
+			Header: &envoy_core.HeaderValue{
+				Key:   header.GetName(),
+				Value: header.GetValue(),
+			},
+			Append: util_proto.Bool(true),
+		})
+	}
+	for _, header := range headers.GetSet() {
+		options = append(options, &envoy_core.HeaderValueOption{
+			Header: &envoy_core.HeaderValue{
+				Key:   header.GetName(),
+				Value: header.GetValue(),
+			},
+			Append: util_proto.Bool(false),
+		})
+	}
+	return options
+}
@@ -0,0 +1,122 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("HeaderModifierConfigurer", func() {
+	type testCase struct {
+		modifications string
+		expected      string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			modifications := &mesh_proto.MeshHeaderModifier_Conf_Modifications{}
+			Expect(util_proto.FromYAML([]byte(given.modifications), modifications)).To(Succeed())
+			routes := envoy_common.Routes{
+				envoy_common.NewRouteFromCluster(envoy_common.NewCluster(envoy_common.WithService("localhost:8080"))),
+			}
+
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("localhost:8080", false)).
+				Configure(HttpInboundRoutes("backend", routes)).
+				Configure(HeaderModifier(modifications)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("request and response headers", testCase{
+			modifications: `
+            requestHeaders:
+              add:
+              - name: x-tenant
+                value: acme
+              set:
+              - name: x-client-address
+                value: '%DOWNSTREAM_REMOTE_ADDRESS%'
+              remove:
+              - x-internal
+            responseHeaders:
+              set:
+              - name: x-served-by
+                value: backend
+              remove:
+              - server`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                routeConfig:
+                  name: inbound:backend
+                  validateClusters: false
+                  requestHeadersToAdd:
+                  - append: true
+                    header:
+                      key: x-tenant
+                      value: acme
+                  - append: false
+                    header:
+                      key: x-client-address
+                      value: '%DOWNSTREAM_REMOTE_ADDRESS%'
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  - x-internal
+                  responseHeadersToAdd:
+                  - append: false
+                    header:
+                      key: x-served-by
+                      value: backend
+                  responseHeadersToRemove:
+                  - server
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                        timeout: 0s
+                statPrefix: localhost_8080`,
+		}),
+	)
+
+	It("should fail without a static route configuration", func() {
+		// given
+		modifications := &mesh_proto.MeshHeaderModifier_Conf_Modifications{
+			RequestHeaders: &mesh_proto.MeshHeaderModifier_Conf_Headers{
+				Remove: []string{"x-internal"},
+			},
+		}
+
+		// when
+		_, err := NewFilterChainBuilder(envoy_common.APIV3).
+			Configure(HttpConnectionManager("localhost:8080", false)).
+			Configure(HeaderModifier(modifications)).
+			Build()
+
+		// then
+		Expect(err).To(MatchError("cannot modify headers without a static route configuration"))
+	})
+})
@@ -0,0 +1,200 @@
+package v3
+
+import (
+	"fmt"
+	net_url "net/url"
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_jwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+)
+
+const (
+	jwtAuthnFilterName      = "envoy.filters.http.jwt_authn"
+	defaultJwksFetchTimeout = 5 * time.Second
+)
+
+// JWTProvider is a provider of the MeshJWT policy together with its JSON Web Key Set.
+type JWTProvider struct {
+	Provider *mesh_proto.MeshJWT_Conf_Provider
+	// Jwks loaded by the control plane, empty when the key set is fetched by Envoy.
+	Jwks []byte
+}
+
+// JWTConfigurer inserts the JWT authentication filter as the first HTTP filter, so requests are authenticated
+// before they are authorized by any other filter. Claims are copied to the headers in the route configuration,
+// so it has to be applied after the routes.
+type JWTConfigurer struct {
+	Providers []JWTProvider
+	Rules     []*mesh_proto.MeshJWT_Conf_Rule
+}
+
+var _ FilterChainConfigurer = &JWTConfigurer{}
+
+func (j *JWTConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if len(j.Providers) == 0 {
+		return nil
+	}
+
+	config := &envoy_jwt.JwtAuthentication{
+		Providers: map[string]*envoy_jwt.JwtProvider{},
+	}
+	var providerNames []string
+	var headersToAdd []*envoy_core.HeaderValueOption
+	var headersToRemove []string
+	for _, provider := range j.Providers {
+		name := provider.Provider.GetName()
+		jwtProvider, err := j.jwtProvider(provider)
+		if err != nil {
+			return errors.Wrapf(err, "could not generate JWT provider %q", name)
+		}
+		config.Providers[name] = jwtProvider
+		providerNames = append(providerNames, name)
+
+		for _, claimToHeader := range provider.Provider.GetClaimToHeaders() {
+			headersToAdd = append(headersToAdd, &envoy_core.HeaderValueOption{
+				Header: &envoy_core.HeaderValue{
+					Key:   claimToHeader.GetHeader(),
+					Value: fmt.Sprintf("%%DYNAMIC_METADATA(%s:%s:%s)%%", jwtAuthnFilterName, name, claimToHeader.GetClaim()),
+				},
+				Append: util_proto.Bool(false),
+			})
+			// the header sent by the client is removed, so it's not passed when the token doesn't have the claim
+			headersToRemove = append(headersToRemove, claimToHeader.GetHeader())
+		}
+	}
+
+	if len(j.Rules) == 0 {
+		config.Rules = []*envoy_jwt.RequirementRule{requirementRule("/", providerNames)}
+	}
+	for _, rule := range j.Rules {
+		config.Rules = append(config.Rules, requirementRule(rule.GetPathPrefix(), rule.GetProviders()))
+	}
+
+	typedConfig, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return err
+	}
+	filter := &envoy_hcm.HttpFilter{
+		Name: jwtAuthnFilterName,
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{filter}, manager.HttpFilters...)
+		if len(headersToAdd) == 0 {
+			return nil
+		}
+		routeConfig := manager.GetRouteConfig()
+		if routeConfig == nil {
+			return errors.New("cannot copy claims to headers without a static route configuration")
+		}
+		routeConfig.RequestHeadersToAdd = append(routeConfig.RequestHeadersToAdd, headersToAdd...)
+		routeConfig.RequestHeadersToRemove = append(routeConfig.RequestHeadersToRemove, headersToRemove...)
+		return nil
+	})
+}
+
+func (j *JWTConfigurer) jwtProvider(provider JWTProvider) (*envoy_jwt.JwtProvider, error) {
+	jwtProvider := &envoy_jwt.JwtProvider{
+		Issuer:    provider.Provider.GetIssuer(),
+		Audiences: provider.Provider.GetAudiences(),
+		Forward:   provider.Provider.GetForward(),
+	}
+	if len(provider.Provider.GetClaimToHeaders()) > 0 {
+		jwtProvider.PayloadInMetadata = provider.Provider.GetName()
+	}
+
+	switch provider.Provider.GetJwks().(type) {
+	case *mesh_proto.MeshJWT_Conf_Provider_LocalJwks:
+		jwtProvider.JwksSourceSpecifier = &envoy_jwt.JwtProvider_LocalJwks{
+			LocalJwks: &envoy_core.DataSource{
+				Specifier: &envoy_core.DataSource_InlineString{
+					InlineString: string(provider.Jwks),
+				},
+			},
+		}
+	case *mesh_proto.MeshJWT_Conf_Provider_RemoteJwks_:
+		remote := provider.Provider.GetRemoteJwks()
+		url, err := net_url.ParseRequestURI(remote.GetUrl())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid URL of the key set")
+		}
+		// the key set is fetched through the outbound cluster of the service
+		cluster := remote.GetService()
+		if cluster == "" {
+			cluster = names.GetJWKSClusterName(url.Host)
+		}
+		timeout := remote.GetTimeout()
+		if timeout == nil {
+			timeout = util_proto.Duration(defaultJwksFetchTimeout)
+		}
+		jwtProvider.JwksSourceSpecifier = &envoy_jwt.JwtProvider_RemoteJwks{
+			RemoteJwks: &envoy_jwt.RemoteJwks{
+				HttpUri: &envoy_core.HttpUri{
+					Uri: remote.GetUrl(),
+					HttpUpstreamType: &envoy_core.HttpUri_Cluster{
+						Cluster: cluster,
+					},
+					Timeout: timeout,
+				},
+				CacheDuration: remote.GetCacheDuration(),
+			},
+		}
+	default:
+		return nil, errors.Errorf("unsupported key set %T", provider.Provider.GetJwks())
+	}
+	return jwtProvider, nil
+}
+
+// requirementRule requires a valid token of any of the providers for requests with the path prefix.
+func requirementRule(prefix string, providers []string) *envoy_jwt.RequirementRule {
+	rule := &envoy_jwt.RequirementRule{
+		Match: &envoy_route.RouteMatch{
+			PathSpecifier: &envoy_route.RouteMatch_Prefix{
+				Prefix: prefix,
+			},
+		},
+	}
+	switch len(providers) {
+	case 0:
+		// a rule without requirement skips the validation
+	case 1:
+		rule.RequirementType = &envoy_jwt.RequirementRule_Requires{
+			Requires: providerRequirement(providers[0]),
+		}
+	default:
+		var requirements []*envoy_jwt.JwtRequirement
+		for _, provider := range providers {
+			requirements = append(requirements, providerRequirement(provider))
+		}
+		rule.RequirementType = &envoy_jwt.RequirementRule_Requires{
+			Requires: &envoy_jwt.JwtRequirement{
+				RequiresType: &envoy_jwt.JwtRequirement_RequiresAny{
+					RequiresAny: &envoy_jwt.JwtRequirementOrList{
+						Requirements: requirements,
+					},
+				},
+			},
+		}
+	}
+	return rule
+}
+
+func providerRequirement(provider string) *envoy_jwt.JwtRequirement {
+	return &envoy_jwt.JwtRequirement{
+		RequiresType: &envoy_jwt.JwtRequirement_ProviderName{
+			ProviderName: provider,
+		},
+	}
+}
@@ -0,0 +1,198 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+)
+
+var _ = Describe("JWTConfigurer", func() {
+	type testCase struct {
+		conf     string
+		jwks     map[string]string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			conf := &mesh_proto.MeshJWT_Conf{}
+			Expect(util_proto.FromYAML([]byte(given.conf), conf)).To(Succeed())
+			var providers []listeners_v3.JWTProvider
+			for _, provider := range conf.GetProviders() {
+				providers = append(providers, listeners_v3.JWTProvider{
+					Provider: provider,
+					Jwks:     []byte(given.jwks[provider.GetName()]),
+				})
+			}
+			routes := envoy_common.Routes{
+				envoy_common.NewRouteFromCluster(envoy_common.NewCluster(envoy_common.WithService("localhost:8080"))),
+			}
+
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("localhost:8080", false)).
+				Configure(HttpInboundRoutes("backend", routes)).
+				Configure(JWT(providers, conf.GetRules())).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("remote key set", testCase{
+			conf: `
+            providers:
+            - name: auth0
+              issuer: https://example.auth0.com/
+              audiences:
+              - backend
+              remoteJwks:
+                url: https://example.auth0.com/.well-known/jwks.json
+                cacheDuration: 10m
+              claimToHeaders:
+              - claim: sub
+                header: x-user-id
+              forward: true`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.jwt_authn
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication
+                    providers:
+                      auth0:
+                        audiences:
+                        - backend
+                        forward: true
+                        issuer: https://example.auth0.com/
+                        payloadInMetadata: auth0
+                        remoteJwks:
+                          cacheDuration: 600s
+                          httpUri:
+                            cluster: jwks:example.auth0.com
+                            timeout: 5s
+                            uri: https://example.auth0.com/.well-known/jwks.json
+                    rules:
+                    - match:
+                        prefix: /
+                      requires:
+                        providerName: auth0
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                routeConfig:
+                  name: inbound:backend
+                  requestHeadersToAdd:
+                  - append: false
+                    header:
+                      key: x-user-id
+                      value: '%DYNAMIC_METADATA(envoy.filters.http.jwt_authn:auth0:sub)%'
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  - x-user-id
+                  validateClusters: false
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                        timeout: 0s
+                statPrefix: localhost_8080
+`,
+		}),
+		Entry("key set fetched through the mesh, local key set and rules", testCase{
+			conf: `
+            providers:
+            - name: keycloak
+              remoteJwks:
+                url: http://keycloak/realms/kuma/protocol/openid-connect/certs
+                service: keycloak
+                timeout: 1s
+            - name: internal
+              localJwks:
+                secret: internal-jwks
+            rules:
+            - pathPrefix: /health
+            - pathPrefix: /admin
+              providers:
+              - internal
+            - pathPrefix: /
+              providers:
+              - keycloak
+              - internal`,
+			jwks: map[string]string{
+				"internal": `{"keys":[]}`,
+			},
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.jwt_authn
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.jwt_authn.v3.JwtAuthentication
+                    providers:
+                      internal:
+                        localJwks:
+                          inlineString: '{"keys":[]}'
+                      keycloak:
+                        remoteJwks:
+                          httpUri:
+                            cluster: keycloak
+                            timeout: 1s
+                            uri: http://keycloak/realms/kuma/protocol/openid-connect/certs
+                    rules:
+                    - match:
+                        prefix: /health
+                    - match:
+                        prefix: /admin
+                      requires:
+                        providerName: internal
+                    - match:
+                        prefix: /
+                      requires:
+                        requiresAny:
+                          requirements:
+                          - providerName: keycloak
+                          - providerName: internal
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                routeConfig:
+                  name: inbound:backend
+                  requestHeadersToRemove:
+                  - x-kuma-tags
+                  validateClusters: false
+                  virtualHosts:
+                  - domains:
+                    - '*'
+                    name: backend
+                    routes:
+                    - match:
+                        prefix: /
+                      route:
+                        cluster: localhost:8080
+                        timeout: 0s
+                statPrefix: localhost_8080
+`,
+		}),
+	)
+})
@@ -0,0 +1,105 @@
+package v3
+
+import (
+	"fmt"
+
+	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+// LocalReplyConfigurer rewrites responses generated by Envoy itself (i.e. 503 when there is no healthy upstream)
+// according to the LocalReply policy.
+type LocalReplyConfigurer struct {
+	LocalReply *core_mesh.LocalReplyResource
+}
+
+var _ FilterChainConfigurer = &LocalReplyConfigurer{}
+
+func (l *LocalReplyConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if l.LocalReply == nil {
+		return nil
+	}
+	conf := l.LocalReply.Spec.GetConf()
+
+	localReplyConfig := &envoy_hcm.LocalReplyConfig{
+		BodyFormat: substitutionFormatString(conf.GetBodyFormat()),
+	}
+	for _, mapper := range conf.GetMappers() {
+		responseMapper := &envoy_hcm.ResponseMapper{
+			Filter:             statusCodesFilter(mapper.GetStatusCodes()),
+			StatusCode:         mapper.GetStatusCode(),
+			BodyFormatOverride: substitutionFormatString(mapper.GetBodyFormat()),
+		}
+		if mapper.GetBody() != "" {
+			responseMapper.Body = &envoy_core.DataSource{
+				Specifier: &envoy_core.DataSource_InlineString{
+					InlineString: mapper.GetBody(),
+				},
+			}
+		}
+		localReplyConfig.Mappers = append(localReplyConfig.Mappers, responseMapper)
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.LocalReplyConfig = localReplyConfig
+		return nil
+	})
+}
+
+func statusCodesFilter(statusCodes []uint32) *envoy_accesslog.AccessLogFilter {
+	var filters []*envoy_accesslog.AccessLogFilter
+	for _, statusCode := range statusCodes {
+		filters = append(filters, &envoy_accesslog.AccessLogFilter{
+			FilterSpecifier: &envoy_accesslog.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &envoy_accesslog.StatusCodeFilter{
+					Comparison: &envoy_accesslog.ComparisonFilter{
+						Op: envoy_accesslog.ComparisonFilter_EQ,
+						Value: &envoy_core.RuntimeUInt32{
+							DefaultValue: statusCode,
+							RuntimeKey:   fmt.Sprintf("local_reply_status_code_%d", statusCode),
+						},
+					},
+				},
+			},
+		})
+	}
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	// OrFilter requires at least two filters
+	return &envoy_accesslog.AccessLogFilter{
+		FilterSpecifier: &envoy_accesslog.AccessLogFilter_OrFilter{
+			OrFilter: &envoy_accesslog.OrFilter{
+				Filters: filters,
+			},
+		},
+	}
+}
+
+func substitutionFormatString(bodyFormat *mesh_proto.LocalReply_Conf_BodyFormat) *envoy_core.SubstitutionFormatString {
+	if bodyFormat == nil {
+		return nil
+	}
+	format := &envoy_core.SubstitutionFormatString{
+		ContentType: bodyFormat.GetContentType(),
+	}
+	if bodyFormat.GetJson() != nil {
+		format.Format = &envoy_core.SubstitutionFormatString_JsonFormat{
+			JsonFormat: bodyFormat.GetJson(),
+		}
+	} else {
+		format.Format = &envoy_core.SubstitutionFormatString_TextFormatSource{
+			TextFormatSource: &envoy_core.DataSource{
+				Specifier: &envoy_core.DataSource_InlineString{
+					InlineString: bodyFormat.GetText(),
+				},
+			},
+		}
+	}
+	return format
+}
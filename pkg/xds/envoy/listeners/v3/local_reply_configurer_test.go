@@ -0,0 +1,141 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("LocalReplyConfigurer", func() {
+	type testCase struct {
+		localReply string
+		expected   string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			localReply := core_mesh.NewLocalReplyResource()
+			Expect(util_proto.FromYAML([]byte(given.localReply), localReply.Spec)).To(Succeed())
+
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy.APIV3).
+				Configure(HttpConnectionManager("stats", false)).
+				Configure(LocalReply(localReply)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("json body format", testCase{
+			localReply: `
+            selectors:
+            - match:
+                kuma.io/service: '*'
+            conf:
+              bodyFormat:
+                json:
+                  code: '%RESPONSE_CODE%'
+                  message: '%LOCAL_REPLY_BODY%'`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                localReplyConfig:
+                  bodyFormat:
+                    jsonFormat:
+                      code: '%RESPONSE_CODE%'
+                      message: '%LOCAL_REPLY_BODY%'
+                statPrefix: stats`,
+		}),
+		Entry("mappers", testCase{
+			localReply: `
+            selectors:
+            - match:
+                kuma.io/service: '*'
+            conf:
+              mappers:
+              - statusCodes: [503]
+                statusCode: 502
+                body: upstream unavailable
+              - statusCodes: [500, 504]
+                bodyFormat:
+                  text: 'error: %LOCAL_REPLY_BODY%'
+                  contentType: text/html`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                localReplyConfig:
+                  mappers:
+                  - body:
+                      inlineString: upstream unavailable
+                    filter:
+                      statusCodeFilter:
+                        comparison:
+                          value:
+                            defaultValue: 503
+                            runtimeKey: local_reply_status_code_503
+                    statusCode: 502
+                  - bodyFormatOverride:
+                      contentType: text/html
+                      textFormatSource:
+                        inlineString: 'error: %LOCAL_REPLY_BODY%'
+                    filter:
+                      orFilter:
+                        filters:
+                        - statusCodeFilter:
+                            comparison:
+                              value:
+                                defaultValue: 500
+                                runtimeKey: local_reply_status_code_500
+                        - statusCodeFilter:
+                            comparison:
+                              value:
+                                defaultValue: 504
+                                runtimeKey: local_reply_status_code_504
+                statPrefix: stats`,
+		}),
+	)
+
+	It("should not configure local replies without the policy", func() {
+		// when
+		filterChain, err := NewFilterChainBuilder(envoy.APIV3).
+			Configure(HttpConnectionManager("stats", false)).
+			Configure(LocalReply(nil)).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(filterChain)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: stats`))
+	})
+})
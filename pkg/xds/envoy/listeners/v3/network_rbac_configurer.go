@@ -17,21 +17,34 @@ import (
 type NetworkRBACConfigurer struct {
 	StatsName  string
 	Permission *core_mesh.TrafficPermissionResource
+	// Denies are TrafficPermissions with DENY or SHADOW_DENY action
+	Denies []*core_mesh.TrafficPermissionResource
+	// FederatedTrustDomains are external SPIFFE trust domains which workloads are matched by sources by their tags
+	FederatedTrustDomains []*mesh_proto.Mesh_Mtls_FederatedTrustDomain
 }
 
 func (c *NetworkRBACConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
-	filter, err := createRbacFilter(c.StatsName, c.Permission)
+	filter, err := createRbacFilter(createRbacRule(c.StatsName, c.Permission, c.FederatedTrustDomains))
 	if err != nil {
 		return err
 	}
+	filters := []*envoy_listener.Filter{filter}
 
-	// RBAC filter should be the first in the chain
-	filterChain.Filters = append([]*envoy_listener.Filter{filter}, filterChain.Filters...)
+	// deny rules take precedence over allow rules, so the deny filter goes before the allow filter
+	if len(c.Denies) > 0 {
+		denyFilter, err := createRbacFilter(createRbacDenyRule(c.StatsName, c.Denies, c.FederatedTrustDomains))
+		if err != nil {
+			return err
+		}
+		filters = append([]*envoy_listener.Filter{denyFilter}, filters...)
+	}
+
+	// RBAC filters should be the first in the chain
+	filterChain.Filters = append(filters, filterChain.Filters...)
 	return nil
 }
 
-func createRbacFilter(statsName string, permission *core_mesh.TrafficPermissionResource) (*envoy_listener.Filter, error) {
-	rbacRule := createRbacRule(statsName, permission)
+func createRbacFilter(rbacRule *rbac.RBAC) (*envoy_listener.Filter, error) {
 	rbacMarshalled, err := proto.MarshalAnyDeterministic(rbacRule)
 	if err != nil {
 		return nil, err
@@ -44,12 +57,12 @@ func createRbacFilter(statsName string, permission *core_mesh.TrafficPermissionR
 	}, nil
 }
 
-func createRbacRule(statsName string, permission *core_mesh.TrafficPermissionResource) *rbac.RBAC {
+func createRbacRule(statsName string, permission *core_mesh.TrafficPermissionResource, trustDomains []*mesh_proto.Mesh_Mtls_FederatedTrustDomain) *rbac.RBAC {
 	policies := make(map[string]*rbac_config.Policy)
 	// We only create policy if Traffic Permission is selected. Otherwise, we still need to build RBAC filter
 	// to restrict all the traffic coming to the dataplane.
 	if permission != nil {
-		policies[permission.GetMeta().GetName()] = createPolicy(permission)
+		policies[permission.GetMeta().GetName()] = createPolicy(permission, trustDomains)
 	}
 
 	return &rbac.RBAC{
@@ -61,12 +74,46 @@ func createRbacRule(statsName string, permission *core_mesh.TrafficPermissionRes
 	}
 }
 
-func createPolicy(permission *core_mesh.TrafficPermissionResource) *rbac_config.Policy {
+// createRbacDenyRule builds RBAC with DENY action. Policies with DENY action are enforced,
+// policies with SHADOW_DENY action are only reported in "<stats>.deny.rbac.shadow_denied" stat.
+func createRbacDenyRule(statsName string, denies []*core_mesh.TrafficPermissionResource, trustDomains []*mesh_proto.Mesh_Mtls_FederatedTrustDomain) *rbac.RBAC {
+	rules := &rbac_config.RBAC{
+		Action:   rbac_config.RBAC_DENY,
+		Policies: map[string]*rbac_config.Policy{},
+	}
+	shadowRules := &rbac_config.RBAC{
+		Action:   rbac_config.RBAC_DENY,
+		Policies: map[string]*rbac_config.Policy{},
+	}
+	for _, deny := range denies {
+		switch deny.Spec.GetAction() {
+		case mesh_proto.TrafficPermission_DENY:
+			rules.Policies[deny.GetMeta().GetName()] = createPolicy(deny, trustDomains)
+		case mesh_proto.TrafficPermission_SHADOW_DENY:
+			shadowRules.Policies[deny.GetMeta().GetName()] = createPolicy(deny, trustDomains)
+		}
+	}
+
+	rbacRule := &rbac.RBAC{
+		StatPrefix: fmt.Sprintf("%s.deny.", util_xds.SanitizeMetric(statsName)),
+	}
+	// without rules the filter allows all the traffic, so it's left to the allow filter
+	if len(rules.Policies) > 0 {
+		rbacRule.Rules = rules
+	}
+	if len(shadowRules.Policies) > 0 {
+		rbacRule.ShadowRules = shadowRules
+	}
+	return rbacRule
+}
+
+func createPolicy(permission *core_mesh.TrafficPermissionResource, trustDomains []*mesh_proto.Mesh_Mtls_FederatedTrustDomain) *rbac_config.Policy {
 	principals := []*rbac_config.Principal{}
 
 	// build principals list: one per sources/destinations rule
 	for _, selector := range permission.Spec.Sources {
 		principals = append(principals, principalFromSelector(selector, permission.GetMeta().GetMesh()))
+		principals = append(principals, federatedPrincipals(selector, trustDomains)...)
 	}
 
 	return &rbac_config.Policy{
@@ -116,6 +163,28 @@ func principalFromSelector(selector *mesh_proto.Selector, mesh string) *rbac_con
 	}
 }
 
+// federatedPrincipals match workloads of the federated trust domains which tags are matched by the selector.
+// Certificates of such workloads are not issued by Kuma, so they are matched by the trust domain of the SPIFFE ID.
+func federatedPrincipals(selector *mesh_proto.Selector, trustDomains []*mesh_proto.Mesh_Mtls_FederatedTrustDomain) []*rbac_config.Principal {
+	var principals []*rbac_config.Principal
+	if len(kumaPrincipals(selector)) == 0 && (selector.Match[mesh_proto.ServiceTag] == "" || selector.Match[mesh_proto.ServiceTag] == mesh_proto.MatchAllTag) {
+		return nil // the selector already matches any principal
+	}
+	for _, trustDomain := range trustDomains {
+		if !mesh_proto.TagSelector(selector.Match).Matches(trustDomain.GetTags()) {
+			continue
+		}
+		principals = append(principals, &rbac_config.Principal{
+			Identifier: &rbac_config.Principal_Authenticated_{
+				Authenticated: &rbac_config.Principal_Authenticated{
+					PrincipalName: tls.MeshSpiffeIDPrefixMatcher(trustDomain.GetName()),
+				},
+			},
+		})
+	}
+	return principals
+}
+
 // kumaPrincipals can match any other tag than kuma.io/service tag
 func kumaPrincipals(selector *mesh_proto.Selector) []*rbac_config.Principal {
 	principals := []*rbac_config.Principal{}
@@ -24,6 +24,8 @@ var _ = Describe("NetworkRbacConfigurer", func() {
 		clusters         []envoy_common.Cluster
 		rbacEnabled      bool
 		permission       *core_mesh.TrafficPermissionResource
+		denies           []*core_mesh.TrafficPermissionResource
+		trustDomains     []*mesh_proto.Mesh_Mtls_FederatedTrustDomain
 		expected         string
 	}
 
@@ -34,7 +36,7 @@ var _ = Describe("NetworkRbacConfigurer", func() {
 				Configure(InboundListener(given.listenerName, given.listenerAddress, given.listenerPort, given.listenerProtocol)).
 				Configure(FilterChain(NewFilterChainBuilder(envoy_common.APIV3).
 					Configure(TcpProxy(given.statsName, given.clusters...)).
-					Configure(NetworkRBAC(given.listenerName, given.rbacEnabled, given.permission)))).
+					Configure(NetworkRBAC(given.listenerName, given.rbacEnabled, given.permission, given.denies, given.trustDomains)))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -112,6 +114,165 @@ var _ = Describe("NetworkRbacConfigurer", func() {
                   statPrefix: localhost_8080
             name: inbound:192.168.0.1:8080
             trafficDirection: INBOUND
+`,
+		}),
+		Entry("basic tcp_proxy with network RBAC and deny rules", testCase{
+			listenerName:    "inbound:192.168.0.1:8080",
+			listenerAddress: "192.168.0.1",
+			listenerPort:    8080,
+			statsName:       "localhost:8080",
+			clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+				envoy_common.WithService("localhost:8080"),
+				envoy_common.WithWeight(200),
+			)},
+			rbacEnabled: true,
+			permission: &core_mesh.TrafficPermissionResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "allow-all",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.TrafficPermission{
+					Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+					Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+				},
+			},
+			denies: []*core_mesh.TrafficPermissionResource{
+				{
+					Meta: &test_model.ResourceMeta{
+						Name: "deny-web1",
+						Mesh: "default",
+					},
+					Spec: &mesh_proto.TrafficPermission{
+						Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchService("web1")}},
+						Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("backend1")}},
+						Action:       mesh_proto.TrafficPermission_DENY,
+					},
+				},
+				{
+					Meta: &test_model.ResourceMeta{
+						Name: "audit-web2",
+						Mesh: "default",
+					},
+					Spec: &mesh_proto.TrafficPermission{
+						Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchService("web2")}},
+						Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("backend1")}},
+						Action:       mesh_proto.TrafficPermission_SHADOW_DENY,
+					},
+				},
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            enableReusePort: false
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.rbac
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.rbac.v3.RBAC
+                  rules:
+                    action: DENY
+                    policies:
+                      deny-web1:
+                        permissions:
+                        - any: true
+                        principals:
+                        - authenticated:
+                            principalName:
+                              exact: spiffe://default/web1
+                  shadowRules:
+                    action: DENY
+                    policies:
+                      audit-web2:
+                        permissions:
+                        - any: true
+                        principals:
+                        - authenticated:
+                            principalName:
+                              exact: spiffe://default/web2
+                  statPrefix: inbound_192_168_0_1_8080.deny.
+              - name: envoy.filters.network.rbac
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.rbac.v3.RBAC
+                  rules:
+                    policies:
+                      allow-all:
+                        permissions:
+                        - any: true
+                        principals:
+                        - any: true
+                  statPrefix: inbound_192_168_0_1_8080.
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  cluster: localhost:8080
+                  statPrefix: localhost_8080
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
+`,
+		}),
+		Entry("basic tcp_proxy with network RBAC and federated trust domains", testCase{
+			listenerName:    "inbound:192.168.0.1:8080",
+			listenerAddress: "192.168.0.1",
+			listenerPort:    8080,
+			statsName:       "localhost:8080",
+			clusters: []envoy_common.Cluster{envoy_common.NewCluster(
+				envoy_common.WithService("localhost:8080"),
+				envoy_common.WithWeight(200),
+			)},
+			rbacEnabled: true,
+			permission: &core_mesh.TrafficPermissionResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "tp-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.TrafficPermission{
+					Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchService("web1")}},
+					Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("backend1")}},
+				},
+			},
+			trustDomains: []*mesh_proto.Mesh_Mtls_FederatedTrustDomain{
+				{
+					Name: "example.org",
+					Tags: mesh_proto.MatchService("web1"),
+				},
+				{
+					Name: "other.org",
+					Tags: mesh_proto.MatchService("web2"),
+				},
+			},
+			expected: `
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            enableReusePort: false
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.rbac
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.rbac.v3.RBAC
+                  rules:
+                    policies:
+                      tp-1:
+                        permissions:
+                        - any: true
+                        principals:
+                        - authenticated:
+                            principalName:
+                              exact: spiffe://default/web1
+                        - authenticated:
+                            principalName:
+                              prefix: spiffe://example.org/
+                  statPrefix: inbound_192_168_0_1_8080.
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  cluster: localhost:8080
+                  statPrefix: localhost_8080
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
 `,
 		}),
 		Entry("basic tcp_proxy with network RBAC disabled", testCase{
@@ -0,0 +1,27 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+)
+
+type PipeListenerConfigurer struct {
+	ListenerName string
+	Path         string
+}
+
+func (c *PipeListenerConfigurer) Configure(l *envoy_listener.Listener) error {
+	l.Name = c.ListenerName
+	l.TrafficDirection = envoy_core.TrafficDirection_INBOUND
+	l.Address = &envoy_core.Address{
+		Address: &envoy_core.Address_Pipe{
+			Pipe: &envoy_core.Pipe{
+				Path: c.Path,
+				Mode: 0o600,
+			},
+		},
+	}
+	// notice that filter chain configuration is left up to other configurers
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("PipeListenerConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		listener, err := NewListenerBuilder(envoy.APIV3).
+			Configure(PipeListener("kuma:envoy:admin", "/tmp/kuma-eal-web-1-default.sock")).
+			Build()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+            name: kuma:envoy:admin
+            trafficDirection: INBOUND
+            address:
+              pipe:
+                path: /tmp/kuma-eal-web-1-default.sock
+                mode: 384
+`))
+	})
+})
@@ -0,0 +1,29 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_extensions_filters_listener_proxy_protocol_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
+
+	"github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// ProxyProtocolConfigurer makes the listener accept PROXY protocol (v1 and v2) headers.
+// It has to be configured before TLSInspectorConfigurer, because the PROXY protocol header precedes the TLS handshake.
+type ProxyProtocolConfigurer struct {
+}
+
+var _ ListenerConfigurer = &ProxyProtocolConfigurer{}
+
+func (c *ProxyProtocolConfigurer) Configure(l *envoy_listener.Listener) error {
+	any, err := proto.MarshalAnyDeterministic(&envoy_extensions_filters_listener_proxy_protocol_v3.ProxyProtocol{})
+	if err != nil {
+		return err
+	}
+	l.ListenerFilters = append(l.ListenerFilters, &envoy_listener.ListenerFilter{
+		Name: "envoy.filters.listener.proxy_protocol",
+		ConfigType: &envoy_listener.ListenerFilter_TypedConfig{
+			TypedConfig: any,
+		},
+	})
+	return nil
+}
@@ -0,0 +1,46 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("ProxyProtocolConfigurer", func() {
+
+	It("should add proxy protocol listener filter before tls inspector", func() {
+		// when
+		listener, err := NewListenerBuilder(envoy_common.APIV3).
+			Configure(InboundListener("inbound:192.168.0.1:8080", "192.168.0.1", 8080, core_xds.SocketAddressProtocolTCP)).
+			Configure(ProxyProtocol()).
+			Configure(TLSInspector()).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            enableReusePort: false
+            listenerFilters:
+            - name: envoy.filters.listener.proxy_protocol
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.listener.proxy_protocol.v3.ProxyProtocol
+            - name: envoy.filters.listener.tls_inspector
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.listener.tls_inspector.v3.TlsInspector
+`))
+	})
+})
@@ -1,12 +1,18 @@
 package v3
 
 import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_ratelimit "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
 	envoy_extensions_filters_http_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_extensions_filters_http_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
 )
 
 type RateLimitConfigurer struct {
@@ -27,18 +33,73 @@ func (r *RateLimitConfigurer) Configure(filterChain *envoy_listener.FilterChain)
 		return err
 	}
 
+	filters := []*envoy_hcm.HttpFilter{
+		{
+			Name: "envoy.filters.http.local_ratelimit",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		},
+	}
+
+	globalFilters, err := r.globalRateLimitFilters()
+	if err != nil {
+		return err
+	}
+	filters = append(filters, globalFilters...)
+
 	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
-		manager.HttpFilters = append(manager.HttpFilters,
-			&envoy_hcm.HttpFilter{
-				Name: "envoy.filters.http.local_ratelimit",
-				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
-					TypedConfig: pbst,
-				},
-			})
+		manager.HttpFilters = append(manager.HttpFilters, filters...)
 		return nil
 	})
 }
 
+// globalRateLimitFilters generates a rate limit filter for every distinct rate limit service.
+// Each filter handles only the actions of the routes with the same stage.
+func (r *RateLimitConfigurer) globalRateLimitFilters() ([]*envoy_hcm.HttpFilter, error) {
+	var rateLimits []*mesh_proto.RateLimit
+	for _, rateLimit := range r.RateLimits {
+		if rateLimit != nil {
+			rateLimits = append(rateLimits, rateLimit.Spec)
+		}
+	}
+	stages, err := envoy_routes.GlobalRateLimitStages(rateLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]*envoy_hcm.HttpFilter, len(stages))
+	for service, stage := range stages {
+		config := &envoy_extensions_filters_http_ratelimit_v3.RateLimit{
+			Domain:          service.Domain,
+			Stage:           stage,
+			Timeout:         proto.Duration(service.Timeout),
+			FailureModeDeny: service.FailureModeDeny,
+			RateLimitService: &envoy_ratelimit.RateLimitServiceConfig{
+				GrpcService: &envoy_core.GrpcService{
+					TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
+							ClusterName: names.GetRateLimitServiceClusterName(service.Address),
+						},
+					},
+				},
+				TransportApiVersion: envoy_core.ApiVersion_V3,
+			},
+		}
+		typedConfig, err := proto.MarshalAnyDeterministic(config)
+		if err != nil {
+			return nil, err
+		}
+		filters[stage] = &envoy_hcm.HttpFilter{
+			Name: "envoy.filters.http.ratelimit",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: typedConfig,
+			},
+		}
+	}
+	return filters, nil
+}
+
 func (r *RateLimitConfigurer) hasHttpRateLimit() bool {
 	return len(r.RateLimits) > 0
 }
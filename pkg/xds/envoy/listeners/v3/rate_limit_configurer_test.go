@@ -1,6 +1,8 @@
 package v3_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -67,5 +69,81 @@ var _ = Describe("RateLimitConfigurer", func() {
                     '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
                 statPrefix: stats`,
 		}),
+		Entry("global rate limits", testCase{
+			input: []*core_mesh.RateLimitResource{
+				{
+					Spec: &mesh_proto.RateLimit{
+						Conf: &mesh_proto.RateLimit_Conf{
+							Global: &mesh_proto.RateLimit_Conf_Global{
+								Address: "ratelimit.mesh:8081",
+								Domain:  "kuma",
+							},
+						},
+					},
+				},
+				{
+					Spec: &mesh_proto.RateLimit{
+						Conf: &mesh_proto.RateLimit_Conf{
+							Global: &mesh_proto.RateLimit_Conf_Global{
+								Address:         "10.0.0.1:8081",
+								Domain:          "kuma",
+								Timeout:         util_proto.Duration(100 * time.Millisecond),
+								FailureModeDeny: true,
+							},
+							Http: &mesh_proto.RateLimit_Conf_Http{
+								Requests: 100,
+							},
+						},
+					},
+				},
+				{
+					Spec: &mesh_proto.RateLimit{
+						Conf: &mesh_proto.RateLimit_Conf{
+							Global: &mesh_proto.RateLimit_Conf_Global{
+								Address: "ratelimit.mesh:8081",
+								Domain:  "kuma",
+							},
+						},
+					},
+				},
+			},
+
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.local_ratelimit
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.local_ratelimit.v3.LocalRateLimit
+                    statPrefix: rate_limit
+                - name: envoy.filters.http.ratelimit
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.ratelimit.v3.RateLimit
+                    domain: kuma
+                    failureModeDeny: true
+                    rateLimitService:
+                      grpcService:
+                        envoyGrpc:
+                          clusterName: rate-limit:10.0.0.1:8081
+                      transportApiVersion: V3
+                    timeout: 0.100s
+                - name: envoy.filters.http.ratelimit
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.ratelimit.v3.RateLimit
+                    domain: kuma
+                    rateLimitService:
+                      grpcService:
+                        envoyGrpc:
+                          clusterName: rate-limit:ratelimit.mesh:8081
+                      transportApiVersion: V3
+                    stage: 1
+                    timeout: 0.020s
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: stats`,
+		}),
 	)
 })
@@ -0,0 +1,118 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_buffer "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	envoy_csrf "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/proto"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// RequestProtectionConfigurer adds the CSRF filter and the buffer filter limiting the size of requests.
+// CSRF filter goes first, so forged requests are rejected before their body is buffered.
+type RequestProtectionConfigurer struct {
+	Conf *mesh_proto.MeshRequestProtection_Conf
+}
+
+var _ FilterChainConfigurer = &RequestProtectionConfigurer{}
+
+func (r *RequestProtectionConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if r.Conf == nil {
+		return nil
+	}
+
+	var filters []*envoy_hcm.HttpFilter
+	if csrf := r.Conf.GetCsrf(); csrf != nil {
+		filter, err := r.httpFilter("envoy.filters.http.csrf", r.csrfPolicy(csrf))
+		if err != nil {
+			return err
+		}
+		filters = append(filters, filter)
+	}
+	if maxRequestBytes := r.Conf.GetMaxRequestBytes(); maxRequestBytes != nil {
+		filter, err := r.httpFilter("envoy.filters.http.buffer", &envoy_buffer.Buffer{
+			MaxRequestBytes: maxRequestBytes,
+		})
+		if err != nil {
+			return err
+		}
+		filters = append(filters, filter)
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append(manager.HttpFilters, filters...)
+		return nil
+	})
+}
+
+func (r *RequestProtectionConfigurer) csrfPolicy(csrf *mesh_proto.MeshRequestProtection_Conf_Csrf) *envoy_csrf.CsrfPolicy {
+	// in the shadow mode requests are only evaluated, so the filter itself has to be disabled
+	filterEnabled := uint32(100)
+	policy := &envoy_csrf.CsrfPolicy{}
+	if csrf.GetShadow() {
+		filterEnabled = 0
+		policy.ShadowEnabled = runtimePercent("csrf_shadow_enabled", 100)
+	}
+	policy.FilterEnabled = runtimePercent("csrf_filter_enabled", filterEnabled)
+	for _, origin := range csrf.GetAdditionalOrigins() {
+		policy.AdditionalOrigins = append(policy.AdditionalOrigins, csrfOriginMatcher(origin))
+	}
+	return policy
+}
+
+func (r *RequestProtectionConfigurer) httpFilter(name string, config proto.Message) (*envoy_hcm.HttpFilter, error) {
+	typedConfig, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_hcm.HttpFilter{
+		Name: name,
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}, nil
+}
+
+func runtimePercent(runtimeKey string, numerator uint32) *envoy_core.RuntimeFractionalPercent {
+	return &envoy_core.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type.FractionalPercent{
+			Numerator:   numerator,
+			Denominator: envoy_type.FractionalPercent_HUNDRED,
+		},
+		RuntimeKey: runtimeKey,
+	}
+}
+
+func csrfOriginMatcher(origin *mesh_proto.MeshRequestProtection_Conf_Csrf_Origin) *envoy_type_matcher.StringMatcher {
+	switch match := origin.GetMatch().(type) {
+	case *mesh_proto.MeshRequestProtection_Conf_Csrf_Origin_Prefix:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_Prefix{
+				Prefix: match.Prefix,
+			},
+		}
+	case *mesh_proto.MeshRequestProtection_Conf_Csrf_Origin_Regex:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_SafeRegex{
+				SafeRegex: &envoy_type_matcher.RegexMatcher{
+					EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+						GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{},
+					},
+					Regex: match.Regex,
+				},
+			},
+		}
+	default:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+				Exact: origin.GetExact(),
+			},
+		}
+	}
+}
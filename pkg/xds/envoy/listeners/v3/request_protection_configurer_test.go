@@ -0,0 +1,134 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("RequestProtectionConfigurer", func() {
+	type testCase struct {
+		conf     string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			var conf *mesh_proto.MeshRequestProtection_Conf
+			if given.conf != "" {
+				conf = &mesh_proto.MeshRequestProtection_Conf{}
+				Expect(util_proto.FromYAML([]byte(given.conf), conf)).To(Succeed())
+			}
+
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("localhost:8080", false)).
+				Configure(RequestProtection(conf)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("with csrf and max request bytes", testCase{
+			conf: `
+            csrf:
+              additionalOrigins:
+              - exact: https://example.com
+              - prefix: https://app.
+              - regex: https://.*\.example\.com
+            maxRequestBytes: 1024`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.csrf
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.csrf.v3.CsrfPolicy
+                    additionalOrigins:
+                    - exact: https://example.com
+                    - prefix: https://app.
+                    - safeRegex:
+                        googleRe2: {}
+                        regex: https://.*\.example\.com
+                    filterEnabled:
+                      defaultValue:
+                        numerator: 100
+                      runtimeKey: csrf_filter_enabled
+                - name: envoy.filters.http.buffer
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.buffer.v3.Buffer
+                    maxRequestBytes: 1024
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080`,
+		}),
+		Entry("with csrf in shadow mode", testCase{
+			conf: `
+            csrf:
+              shadow: true`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.csrf
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.csrf.v3.CsrfPolicy
+                    filterEnabled:
+                      defaultValue: {}
+                      runtimeKey: csrf_filter_enabled
+                    shadowEnabled:
+                      defaultValue:
+                        numerator: 100
+                      runtimeKey: csrf_shadow_enabled
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080`,
+		}),
+		Entry("with max request bytes only", testCase{
+			conf: `
+            maxRequestBytes: 1048576`,
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.buffer
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.buffer.v3.Buffer
+                    maxRequestBytes: 1048576
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080`,
+		}),
+		Entry("without conf", testCase{
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080`,
+		}),
+	)
+})
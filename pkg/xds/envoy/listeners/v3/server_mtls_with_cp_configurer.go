@@ -26,7 +26,7 @@ func (c *ServerSideMTLSWithCPConfigurer) Configure(filterChain *envoy_listener.F
 		var err error
 		meshCa := c.SecretsTracker.RequestCa(c.Ctx.Mesh.Resource.GetMeta().GetName())
 		identity := c.SecretsTracker.RequestIdentityCert()
-		tlsContext, err = tls.CreateDownstreamTlsContext(meshCa, identity)
+		tlsContext, err = tls.CreateDownstreamTlsContext(meshCa, identity, nil)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,40 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_dynamic_forward_proxy "github.com/envoyproxy/go-control-plane/envoy/extensions/common/dynamic_forward_proxy/v3"
+	envoy_sni_dynamic_forward_proxy "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/sni_dynamic_forward_proxy/v3"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// SNIDynamicForwardProxyConfigurer resolves the host from the SNI of the connection, so
+// the TCP proxy can forward the connection to the dynamic forward proxy cluster.
+type SNIDynamicForwardProxyConfigurer struct {
+	DNSCache *envoy_dynamic_forward_proxy.DnsCacheConfig
+	Port     uint32
+}
+
+var _ FilterChainConfigurer = &SNIDynamicForwardProxyConfigurer{}
+
+func (s *SNIDynamicForwardProxyConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	config, err := util_proto.MarshalAnyDeterministic(&envoy_sni_dynamic_forward_proxy.FilterConfig{
+		DnsCacheConfig: s.DNSCache,
+		PortSpecifier: &envoy_sni_dynamic_forward_proxy.FilterConfig_PortValue{
+			PortValue: s.Port,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	filter := &envoy_listener.Filter{
+		Name: "envoy.filters.network.sni_dynamic_forward_proxy",
+		ConfigType: &envoy_listener.Filter_TypedConfig{
+			TypedConfig: config,
+		},
+	}
+
+	// the host has to be resolved before the TCP proxy connects to the upstream
+	filterChain.Filters = append([]*envoy_listener.Filter{filter}, filterChain.Filters...)
+	return nil
+}
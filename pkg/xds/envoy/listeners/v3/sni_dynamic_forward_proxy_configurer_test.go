@@ -0,0 +1,100 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("SNIDynamicForwardProxyConfigurer", func() {
+
+	type testCase struct {
+		conf     *mesh_proto.Networking_Outbound_DynamicForwardProxy
+		hasIPv6  bool
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			listener, err := NewListenerBuilder(envoy_common.APIV3).
+				Configure(OutboundListener("outbound:passthrough:ipv4", "0.0.0.0", 15001, xds.SocketAddressProtocolTCP)).
+				Configure(FilterChain(NewFilterChainBuilder(envoy_common.APIV3).
+					Configure(TcpProxy("outbound:dynamic_forward_proxy", envoy_common.NewCluster(envoy_common.WithService("outbound:dynamic_forward_proxy")))).
+					Configure(SNIDynamicForwardProxy("outbound:dynamic_forward_proxy", given.hasIPv6, given.conf)))).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(listener)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("default port and DNS cache", testCase{
+			conf: &mesh_proto.Networking_Outbound_DynamicForwardProxy{
+				Domains: []string{"*.s3.amazonaws.com"},
+			},
+			expected: `
+            name: outbound:passthrough:ipv4
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 0.0.0.0
+                portValue: 15001
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.sni_dynamic_forward_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.sni_dynamic_forward_proxy.v3.FilterConfig
+                  dnsCacheConfig:
+                    dnsLookupFamily: V4_ONLY
+                    name: outbound:dynamic_forward_proxy
+                  portValue: 443
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  cluster: outbound:dynamic_forward_proxy
+                  statPrefix: outbound_dynamic_forward_proxy
+`,
+		}),
+		Entry("custom port and DNS cache with IPv6", testCase{
+			conf: &mesh_proto.Networking_Outbound_DynamicForwardProxy{
+				Domains:  []string{"*.s3.amazonaws.com"},
+				Port:     8443,
+				HostTtl:  util_proto.Duration(0),
+				MaxHosts: util_proto.UInt32(10),
+			},
+			hasIPv6: true,
+			expected: `
+            name: outbound:passthrough:ipv4
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 0.0.0.0
+                portValue: 15001
+            filterChains:
+            - filters:
+              - name: envoy.filters.network.sni_dynamic_forward_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.sni_dynamic_forward_proxy.v3.FilterConfig
+                  dnsCacheConfig:
+                    hostTtl: 0s
+                    maxHosts: 10
+                    name: outbound:dynamic_forward_proxy
+                  portValue: 8443
+              - name: envoy.filters.network.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+                  cluster: outbound:dynamic_forward_proxy
+                  statPrefix: outbound_dynamic_forward_proxy
+`,
+		}),
+	)
+})
@@ -0,0 +1,47 @@
+package v3
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_common_tap "github.com/envoyproxy/go-control-plane/envoy/extensions/common/tap/v3"
+	envoy_tap "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// TapConfigID is the identifier of the tap filters generated by Kuma. Traffic is captured by all of them
+// when a tap session with the identifier is opened on the /tap endpoint of the Envoy Admin API.
+const TapConfigID = "kuma-tap"
+
+// TapConfigurer adds the tap filter controlled through the Envoy Admin API. The filter is added right before the router,
+// so it captures requests as they are forwarded to the application and responses returned by the application.
+// Until a tap session is opened, the filter does not capture anything.
+type TapConfigurer struct{}
+
+var _ FilterChainConfigurer = &TapConfigurer{}
+
+func (t *TapConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	typedConfig, err := util_proto.MarshalAnyDeterministic(&envoy_tap.Tap{
+		CommonConfig: &envoy_common_tap.CommonExtensionConfig{
+			ConfigType: &envoy_common_tap.CommonExtensionConfig_AdminConfig{
+				AdminConfig: &envoy_common_tap.AdminConfig{
+					ConfigId: TapConfigID,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	filter := &envoy_hcm.HttpFilter{
+		Name: "envoy.filters.http.tap",
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		manager.HttpFilters = append(manager.HttpFilters, filter)
+		return nil
+	})
+}
@@ -0,0 +1,136 @@
+package v3
+
+import (
+	net_url "net/url"
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_http_wasm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_wasm "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+)
+
+const defaultWasmFetchTimeout = 10 * time.Second
+
+// WasmPlugin is a plugin of the MeshWasmPlugin policy together with the code of its module.
+type WasmPlugin struct {
+	Plugin *mesh_proto.MeshWasmPlugin_Conf_Plugin
+	// Code of the module loaded by the control plane, empty when the module is fetched by Envoy.
+	Code []byte
+}
+
+// WasmConfigurer inserts WASM HTTP filters before or after the HTTP filters generated by Kuma.
+// It has to be applied after all other configurers that add HTTP filters. The router is appended
+// when the filter chain is built, so it always stays the last filter.
+type WasmConfigurer struct {
+	Plugins []WasmPlugin
+}
+
+var _ FilterChainConfigurer = &WasmConfigurer{}
+
+func (w *WasmConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if len(w.Plugins) == 0 {
+		return nil
+	}
+
+	var before, after []*envoy_hcm.HttpFilter
+	for _, plugin := range w.Plugins {
+		filter, err := wasmHttpFilter(plugin)
+		if err != nil {
+			return errors.Wrapf(err, "could not generate WASM filter %q", plugin.Plugin.GetName())
+		}
+		switch plugin.Plugin.GetPosition() {
+		case mesh_proto.MeshWasmPlugin_Conf_Plugin_AFTER_KUMA:
+			after = append(after, filter)
+		default:
+			before = append(before, filter)
+		}
+	}
+
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		httpFilters := append(before, manager.HttpFilters...)
+		manager.HttpFilters = append(httpFilters, after...)
+		return nil
+	})
+}
+
+func wasmHttpFilter(plugin WasmPlugin) (*envoy_hcm.HttpFilter, error) {
+	code, err := wasmCode(plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &envoy_wasm.PluginConfig{
+		Name:   plugin.Plugin.GetName(),
+		RootId: plugin.Plugin.GetRootId(),
+		Vm: &envoy_wasm.PluginConfig_VmConfig{
+			VmConfig: &envoy_wasm.VmConfig{
+				Runtime: "envoy.wasm.runtime.v8",
+				Code:    code,
+			},
+		},
+		FailOpen: plugin.Plugin.GetFailOpen(),
+	}
+	if configuration := plugin.Plugin.GetConfiguration(); configuration != "" {
+		config.Configuration, err = util_proto.MarshalAnyDeterministic(wrapperspb.String(configuration))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	typedConfig, err := util_proto.MarshalAnyDeterministic(&envoy_http_wasm.Wasm{Config: config})
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_hcm.HttpFilter{
+		Name: plugin.Plugin.GetName(),
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}, nil
+}
+
+func wasmCode(plugin WasmPlugin) (*envoy_core.AsyncDataSource, error) {
+	remote := plugin.Plugin.GetModule().GetRemote()
+	if remote == nil {
+		return &envoy_core.AsyncDataSource{
+			Specifier: &envoy_core.AsyncDataSource_Local{
+				Local: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: plugin.Code,
+					},
+				},
+			},
+		}, nil
+	}
+
+	url, err := net_url.ParseRequestURI(remote.GetUrl())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid URL of the module")
+	}
+	timeout := remote.GetTimeout()
+	if timeout == nil {
+		timeout = util_proto.Duration(defaultWasmFetchTimeout)
+	}
+	return &envoy_core.AsyncDataSource{
+		Specifier: &envoy_core.AsyncDataSource_Remote{
+			Remote: &envoy_core.RemoteDataSource{
+				HttpUri: &envoy_core.HttpUri{
+					Uri: remote.GetUrl(),
+					HttpUpstreamType: &envoy_core.HttpUri_Cluster{
+						Cluster: names.GetWasmClusterName(url.Host),
+					},
+					Timeout: timeout,
+				},
+				Sha256: remote.GetSha256(),
+			},
+		},
+	}, nil
+}
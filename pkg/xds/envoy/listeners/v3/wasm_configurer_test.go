@@ -0,0 +1,114 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+)
+
+var _ = Describe("WasmConfigurer", func() {
+	type testCase struct {
+		plugins  string
+		code     []byte
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			conf := &mesh_proto.MeshWasmPlugin_Conf{}
+			Expect(util_proto.FromYAML([]byte(given.plugins), conf)).To(Succeed())
+			var plugins []listeners_v3.WasmPlugin
+			for _, plugin := range conf.GetInbound() {
+				wasmPlugin := listeners_v3.WasmPlugin{Plugin: plugin}
+				if plugin.GetModule().GetLocal() != nil {
+					wasmPlugin.Code = given.code
+				}
+				plugins = append(plugins, wasmPlugin)
+			}
+
+			// when
+			filterChain, err := NewFilterChainBuilder(envoy_common.APIV3).
+				Configure(HttpConnectionManager("localhost:8080", false)).
+				Configure(GrpcStats()).
+				Configure(Wasm(plugins)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("plugins before and after Kuma filters", testCase{
+			plugins: `
+            inbound:
+            - name: stats
+              position: AFTER_KUMA
+              module:
+                remote:
+                  url: https://registry.example.com/v2/plugins/stats/blobs/sha256:5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b
+                  sha256: 5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b
+            - name: auth
+              rootId: auth_root
+              configuration: '{"realm": "acme"}'
+              failOpen: true
+              module:
+                local:
+                  secret: auth-wasm`,
+			code: []byte("\x00asm"),
+			expected: `
+            filters:
+            - name: envoy.filters.network.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                httpFilters:
+                - name: auth
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.wasm.v3.Wasm
+                    config:
+                      configuration:
+                        '@type': type.googleapis.com/google.protobuf.StringValue
+                        value: '{"realm": "acme"}'
+                      failOpen: true
+                      name: auth
+                      rootId: auth_root
+                      vmConfig:
+                        code:
+                          local:
+                            inlineBytes: AGFzbQ==
+                        runtime: envoy.wasm.runtime.v8
+                - name: envoy.filters.http.grpc_stats
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.grpc_stats.v3.FilterConfig
+                    emitFilterState: true
+                    statsForAllMethods: true
+                - name: stats
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.wasm.v3.Wasm
+                    config:
+                      name: stats
+                      vmConfig:
+                        code:
+                          remote:
+                            httpUri:
+                              cluster: wasm:registry.example.com
+                              timeout: 10s
+                              uri: https://registry.example.com/v2/plugins/stats/blobs/sha256:5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b
+                            sha256: 5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b
+                        runtime: envoy.wasm.runtime.v8
+                - name: envoy.filters.http.router
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
+                statPrefix: localhost_8080
+`,
+		}),
+	)
+})
@@ -79,6 +79,22 @@ func GetTracingClusterName(backendName string) string {
 	return Join("tracing", backendName)
 }
 
+func GetWasmClusterName(host string) string {
+	return Join("wasm", host)
+}
+
+func GetExternalAuthzClusterName(address string) string {
+	return Join("ext-authz", address)
+}
+
+func GetJWKSClusterName(host string) string {
+	return Join("jwks", host)
+}
+
+func GetRateLimitServiceClusterName(address string) string {
+	return Join("rate-limit", address)
+}
+
 func GetDNSListenerName() string {
 	return Join("kuma", "dns")
 }
@@ -9,6 +9,13 @@ type Route struct {
 	Modify    *mesh_proto.TrafficRoute_Http_Modify
 	RateLimit *mesh_proto.RateLimit
 	Clusters  []Cluster
+	Mirror    *Mirror
+}
+
+// Mirror defines a cluster that a percentage of the requests is mirrored to.
+type Mirror struct {
+	Cluster    Cluster
+	Percentage float64
 }
 
 func NewRouteFromCluster(cluster Cluster) Route {
@@ -28,6 +35,16 @@ func (r Routes) Clusters() (clusters []Cluster) {
 	return
 }
 
+// MirrorClusters returns clusters that the requests are mirrored to.
+func (r Routes) MirrorClusters() (clusters []Cluster) {
+	for _, route := range r {
+		if route.Mirror != nil {
+			clusters = append(clusters, route.Mirror.Cluster)
+		}
+	}
+	return
+}
+
 type NewRouteOpt interface {
 	apply(route *Route)
 }
@@ -74,6 +74,17 @@ func SetResponseHeader(name string, value string) VirtualHostBuilderOpt {
 	)
 }
 
+// CORS sets the CORS policy of the virtual host.
+func CORS(conf *mesh_proto.MeshCORS_Conf) VirtualHostBuilderOpt {
+	if conf == nil {
+		return VirtualHostBuilderOptFunc(nil)
+	}
+
+	return AddVirtualHostConfigurer(&v3.CorsConfigurer{
+		Conf: conf,
+	})
+}
+
 // ResetTagsHeader adds x-kuma-tags header to the RequestHeadersToRemove list. x-kuma-tags header is planned to be used
 // internally, so we don't want to expose it to the destination application.
 func ResetTagsHeader() RouteConfigurationBuilderOpt {
@@ -0,0 +1,72 @@
+package v3
+
+import (
+	"strconv"
+	"strings"
+
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+// CorsConfigurer sets the CORS policy of the virtual host. The policy is enforced
+// only when the envoy.filters.http.cors filter is present in the HTTP connection manager.
+type CorsConfigurer struct {
+	Conf *mesh_proto.MeshCORS_Conf
+}
+
+var _ VirtualHostConfigurer = &CorsConfigurer{}
+
+func (c *CorsConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	if c.Conf == nil {
+		return nil
+	}
+
+	policy := &envoy_route.CorsPolicy{
+		AllowMethods:  strings.Join(c.Conf.GetAllowMethods(), ","),
+		AllowHeaders:  strings.Join(c.Conf.GetAllowHeaders(), ","),
+		ExposeHeaders: strings.Join(c.Conf.GetExposeHeaders(), ","),
+	}
+	for _, origin := range c.Conf.GetAllowOrigins() {
+		policy.AllowOriginStringMatch = append(policy.AllowOriginStringMatch, originMatcher(origin))
+	}
+	if maxAge := c.Conf.GetMaxAge(); maxAge != nil {
+		policy.MaxAge = strconv.FormatInt(int64(maxAge.AsDuration().Seconds()), 10)
+	}
+	if c.Conf.GetAllowCredentials() {
+		policy.AllowCredentials = util_proto.Bool(true)
+	}
+
+	virtualHost.Cors = policy
+	return nil
+}
+
+func originMatcher(origin *mesh_proto.MeshCORS_Conf_Origin) *envoy_type_matcher.StringMatcher {
+	switch match := origin.GetMatch().(type) {
+	case *mesh_proto.MeshCORS_Conf_Origin_Prefix:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_Prefix{
+				Prefix: match.Prefix,
+			},
+		}
+	case *mesh_proto.MeshCORS_Conf_Origin_Regex:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_SafeRegex{
+				SafeRegex: &envoy_type_matcher.RegexMatcher{
+					EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+						GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{},
+					},
+					Regex: match.Regex,
+				},
+			},
+		}
+	default:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+				Exact: origin.GetExact(),
+			},
+		}
+	}
+}
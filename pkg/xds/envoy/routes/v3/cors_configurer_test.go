@@ -0,0 +1,89 @@
+package v3_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/envoy"
+	. "github.com/kumahq/kuma/pkg/xds/envoy/routes"
+)
+
+var _ = Describe("CorsConfigurer", func() {
+
+	type testCase struct {
+		conf     string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// given
+			conf := &mesh_proto.MeshCORS_Conf{}
+			Expect(util_proto.FromYAML([]byte(given.conf), conf)).To(Succeed())
+
+			// when
+			virtualHost, err := NewVirtualHostBuilder(envoy.APIV3).
+				Configure(CommonVirtualHost("backend")).
+				Configure(CORS(conf)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(virtualHost)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("full CORS policy", testCase{
+			conf: `
+            allowOrigins:
+            - exact: https://example.com
+            - prefix: https://app.
+            - regex: https://.*\.example\.com
+            allowMethods:
+            - GET
+            - POST
+            allowHeaders:
+            - content-type
+            - authorization
+            exposeHeaders:
+            - x-request-id
+            maxAge: 1h
+            allowCredentials: true`,
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            cors:
+              allowCredentials: true
+              allowHeaders: content-type,authorization
+              allowMethods: GET,POST
+              allowOriginStringMatch:
+              - exact: https://example.com
+              - prefix: https://app.
+              - safeRegex:
+                  googleRe2: {}
+                  regex: https://.*\.example\.com
+              exposeHeaders: x-request-id
+              maxAge: "3600"
+`,
+		}),
+		Entry("origins only", testCase{
+			conf: `
+            allowOrigins:
+            - exact: https://example.com`,
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            cors:
+              allowOriginStringMatch:
+              - exact: https://example.com
+`,
+		}),
+	)
+})
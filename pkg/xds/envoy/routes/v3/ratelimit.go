@@ -1,10 +1,15 @@
 package v3
 
 import (
+	"sort"
+	"time"
+
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_extensions_filters_http_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
 
 	"github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/util/proto"
@@ -56,3 +61,102 @@ func NewRateLimitConfiguration(rlHttp *v1alpha1.RateLimit_Conf_Http) (*any.Any,
 
 	return proto.MarshalAnyDeterministic(config)
 }
+
+const (
+	// DefaultGlobalRateLimitTimeout is the timeout of the request to the rate limit service used when the policy does not define one.
+	DefaultGlobalRateLimitTimeout = 20 * time.Millisecond
+	// maxGlobalRateLimitStage is the highest stage supported by the rate limit filter of Envoy.
+	maxGlobalRateLimitStage = 10
+)
+
+// GlobalRateLimitService identifies the rate limit filter which sends the descriptors of the given domain to the rate limit service.
+type GlobalRateLimitService struct {
+	Address         string
+	Domain          string
+	Timeout         time.Duration
+	FailureModeDeny bool
+}
+
+func NewGlobalRateLimitService(global *v1alpha1.RateLimit_Conf_Global) GlobalRateLimitService {
+	timeout := DefaultGlobalRateLimitTimeout
+	if global.GetTimeout() != nil {
+		timeout = global.GetTimeout().AsDuration()
+	}
+	return GlobalRateLimitService{
+		Address:         global.GetAddress(),
+		Domain:          global.GetDomain(),
+		Timeout:         timeout,
+		FailureModeDeny: global.GetFailureModeDeny(),
+	}
+}
+
+// GlobalRateLimitStages assigns a stage of the rate limit filter to every distinct rate limit service of the given policies.
+// Services are sorted before the stages are assigned, so the filters of the listener and the actions of the routes
+// generated from the same policies always agree on the stages.
+func GlobalRateLimitStages(rateLimits []*v1alpha1.RateLimit) (map[GlobalRateLimitService]uint32, error) {
+	var services []GlobalRateLimitService
+	seen := map[GlobalRateLimitService]bool{}
+	for _, rateLimit := range rateLimits {
+		if rateLimit.GetConf().GetGlobal() == nil {
+			continue
+		}
+		service := NewGlobalRateLimitService(rateLimit.GetConf().GetGlobal())
+		if !seen[service] {
+			seen[service] = true
+			services = append(services, service)
+		}
+	}
+	if len(services) > maxGlobalRateLimitStage+1 {
+		return nil, errors.Errorf("at most %d distinct global rate limit configurations can be applied, got %d", maxGlobalRateLimitStage+1, len(services))
+	}
+	sort.Slice(services, func(i, j int) bool {
+		a, b := services[i], services[j]
+		if a.Address != b.Address {
+			return a.Address < b.Address
+		}
+		if a.Domain != b.Domain {
+			return a.Domain < b.Domain
+		}
+		if a.Timeout != b.Timeout {
+			return a.Timeout < b.Timeout
+		}
+		return !a.FailureModeDeny && b.FailureModeDeny
+	})
+	stages := map[GlobalRateLimitService]uint32{}
+	for i, service := range services {
+		stages[service] = uint32(i)
+	}
+	return stages, nil
+}
+
+// NewGlobalRateLimitActions converts descriptors of the policy to the rate limit actions of a route.
+func NewGlobalRateLimitActions(global *v1alpha1.RateLimit_Conf_Global, stage uint32) []*envoy_config_route_v3.RateLimit {
+	var rateLimits []*envoy_config_route_v3.RateLimit
+	for _, descriptor := range global.GetDescriptors() {
+		rateLimit := &envoy_config_route_v3.RateLimit{
+			Stage: proto.UInt32(stage),
+		}
+		for _, entry := range descriptor.GetEntries() {
+			action := &envoy_config_route_v3.RateLimit_Action{}
+			switch entry.GetSource().(type) {
+			case *v1alpha1.RateLimit_Conf_Global_Descriptor_Entry_Header:
+				action.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_RequestHeaders_{
+					RequestHeaders: &envoy_config_route_v3.RateLimit_Action_RequestHeaders{
+						HeaderName:    entry.GetHeader(),
+						DescriptorKey: entry.GetKey(),
+					},
+				}
+			default:
+				action.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_GenericKey_{
+					GenericKey: &envoy_config_route_v3.RateLimit_Action_GenericKey{
+						DescriptorKey:   entry.GetKey(),
+						DescriptorValue: entry.GetValue(),
+					},
+				}
+			}
+			rateLimit.Actions = append(rateLimit.Actions, action)
+		}
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	return rateLimits
+}
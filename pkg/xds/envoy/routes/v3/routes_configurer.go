@@ -1,11 +1,13 @@
 package v3
 
 import (
+	"math"
 	"sort"
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/any"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
@@ -18,11 +20,24 @@ type RoutesConfigurer struct {
 }
 
 func (c RoutesConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	var rateLimits []*mesh_proto.RateLimit
 	for _, route := range c.Routes {
+		rateLimits = append(rateLimits, route.RateLimit)
+	}
+	stages, err := GlobalRateLimitStages(rateLimits)
+	if err != nil {
+		return err
+	}
+
+	for _, route := range c.Routes {
+		routeAction := c.routeAction(route.Clusters, route.Modify, route.Mirror)
+		if global := route.RateLimit.GetConf().GetGlobal(); global != nil {
+			routeAction.RateLimits = NewGlobalRateLimitActions(global, stages[NewGlobalRateLimitService(global)])
+		}
 		envoyRoute := &envoy_route.Route{
 			Match: c.routeMatch(route.Match),
 			Action: &envoy_route.Route_Route{
-				Route: c.routeAction(route.Clusters, route.Modify),
+				Route: routeAction,
 			},
 		}
 
@@ -155,7 +170,7 @@ func (c RoutesConfigurer) hasExternal(clusters []envoy_common.Cluster) bool {
 	return false
 }
 
-func (c RoutesConfigurer) routeAction(clusters []envoy_common.Cluster, modify *mesh_proto.TrafficRoute_Http_Modify) *envoy_route.RouteAction {
+func (c RoutesConfigurer) routeAction(clusters []envoy_common.Cluster, modify *mesh_proto.TrafficRoute_Http_Modify, mirror *envoy_common.Mirror) *envoy_route.RouteAction {
 	routeAction := &envoy_route.RouteAction{}
 	if len(clusters) != 0 {
 		routeAction.Timeout = util_proto.Duration(clusters[0].Timeout().GetHttp().GetRequestTimeout().AsDuration())
@@ -187,9 +202,72 @@ func (c RoutesConfigurer) routeAction(clusters []envoy_common.Cluster, modify *m
 		}
 	}
 	c.setModifications(routeAction, modify)
+	c.setMirror(routeAction, mirror)
+	c.setHashPolicies(routeAction, clusters)
 	return routeAction
 }
 
+// setHashPolicies sets hash policies of the consistent hashing load balancers. All clusters of the route
+// are generated from the same TrafficRoute, so they share the load balancer configuration.
+func (c RoutesConfigurer) setHashPolicies(routeAction *envoy_route.RouteAction, clusters []envoy_common.Cluster) {
+	if len(clusters) == 0 {
+		return
+	}
+	lb := clusters[0].LB()
+	switch lb.GetLbType().(type) {
+	case *mesh_proto.TrafficRoute_LoadBalancer_RingHash_, *mesh_proto.TrafficRoute_LoadBalancer_Maglev_:
+	default:
+		return
+	}
+
+	for _, policy := range lb.GetHashPolicies() {
+		hashPolicy := &envoy_route.RouteAction_HashPolicy{
+			Terminal: policy.GetTerminal(),
+		}
+		switch policy.GetPolicySpecifier().(type) {
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Header_:
+			hashPolicy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_Header_{
+				Header: &envoy_route.RouteAction_HashPolicy_Header{
+					HeaderName: policy.GetHeader().GetName(),
+				},
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Cookie_:
+			hashPolicy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_Cookie_{
+				Cookie: &envoy_route.RouteAction_HashPolicy_Cookie{
+					Name: policy.GetCookie().GetName(),
+					Ttl:  policy.GetCookie().GetTtl(),
+					Path: policy.GetCookie().GetPath(),
+				},
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp_:
+			hashPolicy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &envoy_route.RouteAction_HashPolicy_ConnectionProperties{
+					SourceIp: true,
+				},
+			}
+		default:
+			continue
+		}
+		routeAction.HashPolicy = append(routeAction.HashPolicy, hashPolicy)
+	}
+}
+
+func (c RoutesConfigurer) setMirror(routeAction *envoy_route.RouteAction, mirror *envoy_common.Mirror) {
+	if mirror == nil || mirror.Percentage <= 0 {
+		return
+	}
+
+	routeAction.RequestMirrorPolicies = append(routeAction.RequestMirrorPolicies, &envoy_route.RouteAction_RequestMirrorPolicy{
+		Cluster: mirror.Cluster.Name(),
+		RuntimeFraction: &envoy_config_core_v3.RuntimeFractionalPercent{
+			DefaultValue: &envoy_type.FractionalPercent{
+				Numerator:   uint32(math.Round(mirror.Percentage * 10000)),
+				Denominator: envoy_type.FractionalPercent_MILLION,
+			},
+		},
+	})
+}
+
 func (c RoutesConfigurer) setModifications(routeAction *envoy_route.RouteAction, modify *mesh_proto.TrafficRoute_Http_Modify) {
 	if modify.GetPath() != nil {
 		switch modify.GetPath().Type.(type) {
@@ -233,7 +311,7 @@ func (c RoutesConfigurer) setModifications(routeAction *envoy_route.RouteAction,
 func (c *RoutesConfigurer) typedPerFilterConfig(route *envoy_common.Route) (map[string]*any.Any, error) {
 	typedPerFilterConfig := map[string]*any.Any{}
 
-	if route.RateLimit != nil {
+	if route.RateLimit.GetConf().GetHttp() != nil {
 		rateLimit, err := NewRateLimitConfiguration(route.RateLimit.GetConf().GetHttp())
 		if err != nil {
 			return nil, err
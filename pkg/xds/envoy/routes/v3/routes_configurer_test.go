@@ -1,10 +1,13 @@
 package v3_test
 
 import (
+	"time"
+
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
 	envoy_routes "github.com/kumahq/kuma/pkg/xds/envoy/routes/v3"
@@ -37,6 +40,184 @@ var _ = Describe("RoutesConfigurer", func() {
 				envoy_common.NewRouteFromCluster(envoy_common.NewCluster(envoy_common.WithName("backend"))),
 			},
 			expected: `
+routes:
+  - match:
+      prefix: "/"
+    route:
+      timeout: "0s"
+      cluster: backend`,
+		}),
+		Entry("routes with global rate limit", testCase{
+			routes: []envoy_common.Route{
+				{
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(envoy_common.WithName("backend"))},
+					RateLimit: &mesh_proto.RateLimit{
+						Conf: &mesh_proto.RateLimit_Conf{
+							Global: &mesh_proto.RateLimit_Conf_Global{
+								Address: "ratelimit.mesh:8081",
+								Domain:  "kuma",
+								Descriptors: []*mesh_proto.RateLimit_Conf_Global_Descriptor{
+									{
+										Entries: []*mesh_proto.RateLimit_Conf_Global_Descriptor_Entry{
+											{
+												Key:    "destination",
+												Source: &mesh_proto.RateLimit_Conf_Global_Descriptor_Entry_Value{Value: "backend"},
+											},
+											{
+												Key:    "user",
+												Source: &mesh_proto.RateLimit_Conf_Global_Descriptor_Entry_Header{Header: "x-user-id"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(envoy_common.WithName("backend"))},
+					RateLimit: &mesh_proto.RateLimit{
+						Conf: &mesh_proto.RateLimit_Conf{
+							Global: &mesh_proto.RateLimit_Conf_Global{
+								Address: "10.0.0.1:8081",
+								Domain:  "kuma",
+								Descriptors: []*mesh_proto.RateLimit_Conf_Global_Descriptor{
+									{
+										Entries: []*mesh_proto.RateLimit_Conf_Global_Descriptor_Entry{
+											{
+												Key:    "destination",
+												Source: &mesh_proto.RateLimit_Conf_Global_Descriptor_Entry_Value{Value: "backend"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: `
+routes:
+  - match:
+      prefix: "/"
+    route:
+      timeout: "0s"
+      cluster: backend
+      rateLimits:
+      - stage: 1
+        actions:
+        - genericKey:
+            descriptorKey: destination
+            descriptorValue: backend
+        - requestHeaders:
+            descriptorKey: user
+            headerName: x-user-id
+  - match:
+      prefix: "/"
+    route:
+      timeout: "0s"
+      cluster: backend
+      rateLimits:
+      - stage: 0
+        actions:
+        - genericKey:
+            descriptorKey: destination
+            descriptorValue: backend`,
+		}),
+		Entry("routes with mirror", testCase{
+			routes: []envoy_common.Route{
+				{
+					Clusters: []envoy_common.Cluster{envoy_common.NewCluster(envoy_common.WithName("backend"))},
+					Mirror: &envoy_common.Mirror{
+						Cluster:    envoy_common.NewCluster(envoy_common.WithName("backend-canary")),
+						Percentage: 12.5,
+					},
+				},
+			},
+			expected: `
+routes:
+  - match:
+      prefix: "/"
+    route:
+      timeout: "0s"
+      cluster: backend
+      requestMirrorPolicies:
+      - cluster: backend-canary
+        runtimeFraction:
+          defaultValue:
+            numerator: 125000
+            denominator: MILLION`,
+		}),
+		Entry("routes with hash policies", testCase{
+			routes: []envoy_common.Route{
+				envoy_common.NewRouteFromCluster(envoy_common.NewCluster(
+					envoy_common.WithName("backend"),
+					envoy_common.WithLB(&mesh_proto.TrafficRoute_LoadBalancer{
+						LbType: &mesh_proto.TrafficRoute_LoadBalancer_RingHash_{
+							RingHash: &mesh_proto.TrafficRoute_LoadBalancer_RingHash{},
+						},
+						HashPolicies: []*mesh_proto.TrafficRoute_LoadBalancer_HashPolicy{
+							{
+								PolicySpecifier: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Header_{
+									Header: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Header{
+										Name: "x-user-id",
+									},
+								},
+							},
+							{
+								PolicySpecifier: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Cookie_{
+									Cookie: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Cookie{
+										Name: "session",
+										Ttl:  util_proto.Duration(time.Hour),
+										Path: "/",
+									},
+								},
+								Terminal: true,
+							},
+							{
+								PolicySpecifier: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp_{
+									SourceIp: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp{},
+								},
+							},
+						},
+					}),
+				)),
+			},
+			expected: `
+routes:
+  - match:
+      prefix: "/"
+    route:
+      timeout: "0s"
+      cluster: backend
+      hashPolicy:
+      - header:
+          headerName: x-user-id
+      - cookie:
+          name: session
+          ttl: 3600s
+          path: /
+        terminal: true
+      - connectionProperties:
+          sourceIp: true`,
+		}),
+		Entry("hash policies are ignored without consistent hashing load balancer", testCase{
+			routes: []envoy_common.Route{
+				envoy_common.NewRouteFromCluster(envoy_common.NewCluster(
+					envoy_common.WithName("backend"),
+					envoy_common.WithLB(&mesh_proto.TrafficRoute_LoadBalancer{
+						LbType: &mesh_proto.TrafficRoute_LoadBalancer_RoundRobin_{},
+						HashPolicies: []*mesh_proto.TrafficRoute_LoadBalancer_HashPolicy{
+							{
+								PolicySpecifier: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp_{
+									SourceIp: &mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp{},
+								},
+							},
+						},
+					}),
+				)),
+			},
+			expected: `
 routes:
   - match:
       prefix: "/"
@@ -17,6 +17,16 @@ func MetricsHijackerSocketName(name, mesh string) string {
 	return socketName(fmt.Sprintf("%s%skuma-mh-%s-%s", core.TempDir(), string(os.PathSeparator), name, mesh))
 }
 
+// EnvoyAdminSocketName generates a socket path that will fit the Unix socket path limitation of 104 chars
+func EnvoyAdminSocketName(name, mesh string) string {
+	return socketName(fmt.Sprintf("%s%skuma-ea-%s-%s", core.TempDir(), string(os.PathSeparator), name, mesh))
+}
+
+// EnvoyAdminListenerSocketName generates a socket path that will fit the Unix socket path limitation of 104 chars
+func EnvoyAdminListenerSocketName(name, mesh string) string {
+	return socketName(fmt.Sprintf("%s%skuma-eal-%s-%s", core.TempDir(), string(os.PathSeparator), name, mesh))
+}
+
 func socketName(s string) string {
 	trimLen := len(s)
 	if trimLen > 98 {
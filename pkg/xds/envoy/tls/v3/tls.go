@@ -5,6 +5,7 @@ import (
 	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	"github.com/kumahq/kuma/pkg/tls"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
@@ -14,12 +15,16 @@ import (
 // CreateDownstreamTlsContext creates DownstreamTlsContext for incoming connections
 // It verifies that incoming connection has TLS certificate signed by Mesh CA with URI SAN of prefix spiffe://{mesh_name}/
 // It secures inbound listener with certificate of "identity_cert" that will be received from the SDS (it contains URI SANs of all inbounds).
-func CreateDownstreamTlsContext(downstreamMesh core_xds.CaRequest, mesh core_xds.IdentityCertRequest) (*envoy_tls.DownstreamTlsContext, error) {
+// Connections with URI SAN of prefix spiffe://{trust_domain}/ of the federated trust domains are accepted as well.
+func CreateDownstreamTlsContext(downstreamMesh core_xds.CaRequest, mesh core_xds.IdentityCertRequest, federatedTrustDomains []string) (*envoy_tls.DownstreamTlsContext, error) {
 	var validationSANMatchers []*envoy_type_matcher.StringMatcher
 	meshNames := downstreamMesh.MeshName()
 	for _, meshName := range meshNames {
 		validationSANMatchers = append(validationSANMatchers, MeshSpiffeIDPrefixMatcher(meshName))
 	}
+	for _, trustDomain := range federatedTrustDomains {
+		validationSANMatchers = append(validationSANMatchers, MeshSpiffeIDPrefixMatcher(trustDomain))
+	}
 
 	commonTlsContext := createCommonTlsContext(mesh, downstreamMesh, validationSANMatchers)
 	return &envoy_tls.DownstreamTlsContext{
@@ -82,40 +87,89 @@ func NewSecretConfigSource(secretName string) *envoy_tls.SdsSecretConfig {
 	}
 }
 
-func UpstreamTlsContextOutsideMesh(ca, cert, key []byte, allowRenegotiation bool, hostname string, sni string) (*envoy_tls.UpstreamTlsContext, error) {
+// UpstreamTlsContextOutsideMesh creates UpstreamTlsContext for connections to the external service.
+// The certificate of the external service is verified when CA, subject alt names or SPKI pins are defined.
+// Without explicit subject alt names, the certificate signed by the CA has to be issued for the hostname.
+func UpstreamTlsContextOutsideMesh(externalService *core_xds.ExternalService, hostname string, sni string) (*envoy_tls.UpstreamTlsContext, error) {
 	tlsContext := &envoy_tls.UpstreamTlsContext{
-		AllowRenegotiation: allowRenegotiation,
+		AllowRenegotiation: externalService.AllowRenegotiation,
 		Sni:                sni,
 	}
-	if cert != nil && key != nil {
+	if externalService.ClientCert != nil && externalService.ClientKey != nil {
 		tlsContext.CommonTlsContext = &envoy_tls.CommonTlsContext{
 			TlsCertificates: []*envoy_tls.TlsCertificate{
 				{
-					CertificateChain: dataSourceFromBytes(cert),
-					PrivateKey:       dataSourceFromBytes(key),
+					CertificateChain: dataSourceFromBytes(externalService.ClientCert),
+					PrivateKey:       dataSourceFromBytes(externalService.ClientKey),
 				},
 			},
 		}
 	}
 
-	if ca != nil {
-		if tlsContext.CommonTlsContext == nil {
-			tlsContext.CommonTlsContext = &envoy_tls.CommonTlsContext{}
+	if externalService.CaCert == nil && len(externalService.SubjectAltNames) == 0 && len(externalService.SpkiPins) == 0 {
+		return tlsContext, nil
+	}
+
+	validationContext := &envoy_tls.CertificateValidationContext{
+		VerifyCertificateSpki: externalService.SpkiPins,
+	}
+	if externalService.CaCert != nil {
+		validationContext.TrustedCa = dataSourceFromBytes(externalService.CaCert)
+	}
+	for _, san := range externalService.SubjectAltNames {
+		validationContext.MatchSubjectAltNames = append(validationContext.MatchSubjectAltNames, subjectAltNameMatcher(san))
+	}
+	if len(validationContext.MatchSubjectAltNames) == 0 && externalService.CaCert != nil {
+		validationContext.MatchSubjectAltNames = []*envoy_type_matcher.StringMatcher{
+			{
+				MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+					Exact: hostname,
+				},
+			},
 		}
-		tlsContext.CommonTlsContext.ValidationContextType = &envoy_tls.CommonTlsContext_ValidationContext{
-			ValidationContext: &envoy_tls.CertificateValidationContext{
-				TrustedCa: dataSourceFromBytes(ca),
-				MatchSubjectAltNames: []*envoy_type_matcher.StringMatcher{
-					{
-						MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
-							Exact: hostname,
-						},
+	}
+
+	if tlsContext.CommonTlsContext == nil {
+		tlsContext.CommonTlsContext = &envoy_tls.CommonTlsContext{}
+	}
+	tlsContext.CommonTlsContext.ValidationContextType = &envoy_tls.CommonTlsContext_ValidationContext{
+		ValidationContext: validationContext,
+	}
+	return tlsContext, nil
+}
+
+func subjectAltNameMatcher(san *mesh_proto.ExternalService_Networking_TLS_SubjectAltName) *envoy_type_matcher.StringMatcher {
+	switch san.GetMatch() {
+	case mesh_proto.ExternalService_Networking_TLS_SubjectAltName_PREFIX:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_Prefix{
+				Prefix: san.GetValue(),
+			},
+		}
+	case mesh_proto.ExternalService_Networking_TLS_SubjectAltName_SUFFIX:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_Suffix{
+				Suffix: san.GetValue(),
+			},
+		}
+	case mesh_proto.ExternalService_Networking_TLS_SubjectAltName_REGEX:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_SafeRegex{
+				SafeRegex: &envoy_type_matcher.RegexMatcher{
+					EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+						GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{},
 					},
+					Regex: san.GetValue(),
 				},
 			},
 		}
+	default:
+		return &envoy_type_matcher.StringMatcher{
+			MatchPattern: &envoy_type_matcher.StringMatcher_Exact{
+				Exact: san.GetValue(),
+			},
+		}
 	}
-	return tlsContext, nil
 }
 
 func dataSourceFromBytes(bytes []byte) *envoy_core.DataSource {
@@ -35,7 +35,8 @@ var _ = Describe("CreateDownstreamTlsContext()", func() {
 	Context("when mTLS is enabled on a given Mesh", func() {
 
 		type testCase struct {
-			expected string
+			federatedTrustDomains []string
+			expected              string
 		}
 
 		DescribeTable("should generate proper Envoy config",
@@ -62,6 +63,7 @@ var _ = Describe("CreateDownstreamTlsContext()", func() {
 				snippet, err := v3.CreateDownstreamTlsContext(
 					&caRequest{mesh: mesh.GetMeta().GetName()},
 					&identityRequest{mesh: mesh.GetMeta().GetName()},
+					given.federatedTrustDomains,
 				)
 				// then
 				Expect(err).ToNot(HaveOccurred())
@@ -89,6 +91,28 @@ var _ = Describe("CreateDownstreamTlsContext()", func() {
                     sdsConfig:
                       ads: {}
                       resourceApiVersion: V3
+                requireClientCertificate: true`,
+			}),
+			Entry("with federated trust domains", testCase{
+				federatedTrustDomains: []string{"example.org", "spire.example.com"},
+				expected: `
+                commonTlsContext:
+                  combinedValidationContext:
+                    defaultValidationContext:
+                      matchSubjectAltNames:
+                      - prefix: spiffe://default/
+                      - prefix: spiffe://example.org/
+                      - prefix: spiffe://spire.example.com/
+                    validationContextSdsSecretConfig:
+                      name: mesh_ca:secret:default
+                      sdsConfig:
+                        ads: {}
+                        resourceApiVersion: V3
+                  tlsCertificateSdsSecretConfigs:
+                  - name: identity_cert:secret:default
+                    sdsConfig:
+                      ads: {}
+                      resourceApiVersion: V3
                 requireClientCertificate: true`,
 			}),
 		)
@@ -44,10 +44,17 @@ func (g AdminProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds.P
 	// In contrast to `AdminPort`, we shouldn't trust `AdminAddress` from the Envoy node metadata
 	// since it would allow a malicious user to manipulate that value and use Prometheus endpoint
 	// as a gateway to another host.
-	adminAddress := "127.0.0.1"
+	adminEndpoint := core_xds.Endpoint{Target: "127.0.0.1", Port: adminPort}
+	// The metadata only tells that Admin API is bound to the unix domain socket. Like the address, we don't trust the path
+	// of the socket from the metadata and compute it the same way the bootstrap generator does.
+	adminSocket := proxy.Metadata.GetAdminSocketPath() != ""
+	proxyKey := proxy.Id.ToResourceKey()
+	if adminSocket {
+		adminEndpoint = core_xds.Endpoint{UnixDomainPath: envoy_common.EnvoyAdminSocketName(proxyKey.Name, proxyKey.Mesh)}
+	}
 	envoyAdminClusterName := envoy_names.GetEnvoyAdminClusterName()
 	cluster, err := envoy_clusters.NewClusterBuilder(proxy.APIVersion).
-		Configure(envoy_clusters.ProvidedEndpointCluster(envoyAdminClusterName, false, core_xds.Endpoint{Target: adminAddress, Port: adminPort})).
+		Configure(envoy_clusters.ProvidedEndpointCluster(envoyAdminClusterName, false, adminEndpoint)).
 		Configure(envoy_clusters.DefaultTimeout()).
 		Build()
 	if err != nil {
@@ -59,27 +66,39 @@ func (g AdminProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds.P
 	for _, se := range staticEndpointPaths {
 		se.ClusterName = envoyAdminClusterName
 	}
+	for _, se := range staticTlsEndpointPaths {
+		se.ClusterName = envoyAdminClusterName
+	}
+	mTLSFilterChain := envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
+		Configure(envoy_listeners.MatchTransportProtocol("tls")).
+		Configure(envoy_listeners.StaticEndpoints(envoy_names.GetAdminListenerName(), staticTlsEndpointPaths)).
+		Configure(envoy_listeners.ServerSideMTLSWithCP(ctx, proxy.SecretsTracker)),
+	)
 
-	// We bind admin to 127.0.0.1 by default, creating another listener with same address and port will result in error.
-	if g.getAddress(proxy) != "127.0.0.1" {
-		filterChains := []envoy_listeners.ListenerBuilderOpt{
+	var listenerOpts []envoy_listeners.ListenerBuilderOpt
+	switch {
+	case adminSocket:
+		// The admin port is bound by kuma-dp which passes only TLS connections to the listener bound to the unix domain socket,
+		// so the only way to reach Admin API from outside the host is to be authenticated as the Control Plane.
+		listenerOpts = []envoy_listeners.ListenerBuilderOpt{
+			envoy_listeners.PipeListener(envoy_names.GetAdminListenerName(), envoy_common.EnvoyAdminListenerSocketName(proxyKey.Name, proxyKey.Mesh)),
+			envoy_listeners.TLSInspector(),
+			mTLSFilterChain,
+		}
+	case g.getAddress(proxy) != "127.0.0.1":
+		// We bind admin to 127.0.0.1 by default, creating another listener with same address and port will result in error.
+		listenerOpts = []envoy_listeners.ListenerBuilderOpt{
+			envoy_listeners.InboundListener(envoy_names.GetAdminListenerName(), g.getAddress(proxy), adminPort, core_xds.SocketAddressProtocolTCP),
+			envoy_listeners.TLSInspector(),
 			envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
 				Configure(envoy_listeners.StaticEndpoints(envoy_names.GetAdminListenerName(), staticEndpointPaths)),
 			),
+			mTLSFilterChain,
 		}
-		for _, se := range staticTlsEndpointPaths {
-			se.ClusterName = envoyAdminClusterName
-		}
-		filterChains = append(filterChains, envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
-			Configure(envoy_listeners.MatchTransportProtocol("tls")).
-			Configure(envoy_listeners.StaticEndpoints(envoy_names.GetAdminListenerName(), staticTlsEndpointPaths)).
-			Configure(envoy_listeners.ServerSideMTLSWithCP(ctx, proxy.SecretsTracker)),
-		))
-
+	}
+	if listenerOpts != nil {
 		listener, err := envoy_listeners.NewListenerBuilder(proxy.APIVersion).
-			Configure(envoy_listeners.InboundListener(envoy_names.GetAdminListenerName(), g.getAddress(proxy), adminPort, core_xds.SocketAddressProtocolTCP)).
-			Configure(envoy_listeners.TLSInspector()).
-			Configure(filterChains...).
+			Configure(listenerOpts...).
 			Build()
 		if err != nil {
 			return nil, err
@@ -23,6 +23,7 @@ var _ = Describe("AdminProxyGenerator", func() {
 
 	type testCase struct {
 		dataplaneFile string
+		adminSocket   string
 		expected      string
 	}
 
@@ -54,8 +55,10 @@ var _ = Describe("AdminProxyGenerator", func() {
 
 			proxy := &xds.Proxy{
 				Metadata: &xds.DataplaneMetadata{
-					AdminPort: 9901,
+					AdminPort:       9901,
+					AdminSocketPath: given.adminSocket,
 				},
+				Id:         *xds.BuildProxyId("default", "web-1"),
 				Dataplane:  dataplane,
 				APIVersion: envoy_common.APIV3,
 			}
@@ -78,5 +81,10 @@ var _ = Describe("AdminProxyGenerator", func() {
 			dataplaneFile: "01.dataplane.input.yaml",
 			expected:      "01.envoy-config.golden.yaml",
 		}),
+		Entry("should generate admin resources when admin is bound to the unix domain socket", testCase{
+			dataplaneFile: "02.dataplane.input.yaml",
+			adminSocket:   "/var/run/other.sock", // the path is computed by the Control Plane
+			expected:      "02.envoy-config.golden.yaml",
+		}),
 	)
 })
@@ -0,0 +1,30 @@
+package generator
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+
+	model "github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+)
+
+// applyClusterStatNames overrides the alt_stat_name of the clusters with the names set in the Mesh,
+// so the stats of the clusters are reported under the names chosen by the user.
+func applyClusterStatNames(ctx xds_context.Context, resources *model.ResourceSet) {
+	if ctx.Mesh.Resource == nil {
+		return
+	}
+	statNames := ctx.Mesh.Resource.Spec.GetMetrics().GetStats().GetClusterStatNames()
+	if len(statNames) == 0 {
+		return
+	}
+	for name, resource := range resources.Resources(envoy_resource.ClusterType) {
+		statName, ok := statNames[name]
+		if !ok {
+			continue
+		}
+		if cluster, ok := resource.Resource.(*envoy_cluster.Cluster); ok {
+			cluster.AltStatName = statName
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package core
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/pkg/errors"
 
 	model "github.com/kumahq/kuma/pkg/core/xds"
@@ -16,10 +20,12 @@ type CompositeResourceGenerator []ResourceGenerator
 func (c CompositeResourceGenerator) Generate(ctx xds_context.Context, proxy *model.Proxy) (*model.ResourceSet, error) {
 	resources := model.NewResourceSet()
 	for _, gen := range c {
+		start := time.Now()
 		rs, err := gen.Generate(ctx, proxy)
 		if err != nil {
 			return nil, errors.Wrapf(err, "%T failed", gen)
 		}
+		ctx.Trace.Record(strings.TrimPrefix(fmt.Sprintf("%T", gen), "*"), start)
 		resources.AddSet(rs)
 	}
 	return resources, nil
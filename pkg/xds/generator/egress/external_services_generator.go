@@ -1,6 +1,8 @@
 package egress
 
 import (
+	"google.golang.org/protobuf/proto"
+
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
@@ -86,6 +88,7 @@ func (*ExternalServicesGenerator) generateCDS(
 				endpoints...,
 			)).
 			Configure(envoy_clusters.ClientSideTLS(endpoints)).
+			Configure(envoy_clusters.UpstreamProxyProtocol(endpoints)).
 			Configure(envoy_clusters.DefaultTimeout())
 
 		switch endpoints[0].Tags[mesh_proto.ProtocolTag] {
@@ -183,6 +186,8 @@ func (g *ExternalServicesGenerator) addFilterChains(
 					// meshes with mTLS enabled, so we can safely pass here true
 					true,
 					meshResources.ExternalServicePermissionMap[serviceName],
+					nil,
+					meshResources.Mesh.Spec.GetMtls().GetFederatedTrustDomains(),
 				),
 			)
 
@@ -191,8 +196,9 @@ func (g *ExternalServicesGenerator) addFilterChains(
 			switch protocol {
 			case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2, core_mesh.ProtocolGRPC:
 				routes := envoy_common.Routes{}
+				rateLimits := localRateLimits(meshResources.ExternalServiceRateLimits[serviceName])
 
-				for _, rl := range meshResources.ExternalServiceRateLimits[serviceName] {
+				for _, rl := range rateLimits {
 					if rl.Spec.GetConf().GetHttp() == nil {
 						continue
 					}
@@ -210,7 +216,7 @@ func (g *ExternalServicesGenerator) addFilterChains(
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(serviceName, false)).
 					Configure(envoy_listeners.FaultInjection(meshResources.ExternalServiceFaultInjections[serviceName]...)).
-					Configure(envoy_listeners.RateLimit(meshResources.ExternalServiceRateLimits[serviceName])).
+					Configure(envoy_listeners.RateLimit(rateLimits)).
 					Configure(envoy_listeners.HttpOutboundRoute(serviceName, routes, nil))
 			default:
 				filterChainBuilder.Configure(
@@ -222,3 +228,24 @@ func (g *ExternalServicesGenerator) addFilterChains(
 		}
 	}
 }
+
+// localRateLimits strips the global configuration of rate limits as global rate limiting is not supported on ZoneEgress.
+func localRateLimits(rateLimits []*core_mesh.RateLimitResource) []*core_mesh.RateLimitResource {
+	var local []*core_mesh.RateLimitResource
+	for _, rl := range rateLimits {
+		if rl.Spec.GetConf().GetGlobal() == nil {
+			local = append(local, rl)
+			continue
+		}
+		if rl.Spec.GetConf().GetHttp() == nil {
+			continue
+		}
+		spec := proto.Clone(rl.Spec).(*mesh_proto.RateLimit)
+		spec.Conf.Global = nil
+		local = append(local, &core_mesh.RateLimitResource{
+			Meta: rl.Meta,
+			Spec: spec,
+		})
+	}
+	return local
+}
@@ -195,5 +195,9 @@ var _ = Describe("EgressGenerator", func() {
 			fileWithResourcesName: "06.mixed-services-with-external-in-other-zone.yaml",
 			expected:              "06.mixed-services-with-external-in-other-zone.golden.yaml",
 		}),
+		Entry("07. one service behind zoneingress with zone ingress health check", testCase{
+			fileWithResourcesName: "07.internalservice-zoneingress-health-check.yaml",
+			expected:              "07.internalservice-zoneingress-health-check.golden.yaml",
+		}),
 	)
 })
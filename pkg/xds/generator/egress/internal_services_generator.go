@@ -39,7 +39,8 @@ func (g *InternalServicesGenerator) Generate(
 		services,
 	)
 
-	cds, err := g.generateCDS(meshName, apiVersion, services, destinations)
+	zoneIngressHealthCheck := meshResources.Mesh.Spec.GetRouting().GetZoneIngressHealthCheck()
+	cds, err := g.generateCDS(meshName, apiVersion, services, destinations, zoneIngressHealthCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +90,7 @@ func (*InternalServicesGenerator) generateCDS(
 	apiVersion envoy_common.APIVersion,
 	services map[string]bool,
 	destinationsPerService map[string][]envoy_common.Tags,
+	zoneIngressHealthCheck *mesh_proto.Routing_ZoneIngressHealthCheck,
 ) ([]*core_xds.Resource, error) {
 	var resources []*core_xds.Resource
 
@@ -106,6 +108,8 @@ func (*InternalServicesGenerator) generateCDS(
 			Configure(envoy_clusters.EdsCluster(clusterName)).
 			Configure(envoy_clusters.LbSubset(tagKeySlice)).
 			Configure(envoy_clusters.DefaultTimeout()).
+			// all endpoints of the cluster are zone ingresses of other zones
+			Configure(envoy_clusters.ZoneIngressHealthCheck(zoneIngressHealthCheck)).
 			Build()
 
 		if err != nil {
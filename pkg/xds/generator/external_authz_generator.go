@@ -0,0 +1,69 @@
+package generator
+
+import (
+	net_url "net/url"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+	"github.com/kumahq/kuma/pkg/xds/generator/core"
+)
+
+// OriginExternalAuthz is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginExternalAuthz = "external-authz"
+
+// ExternalAuthzGenerator generates a cluster of the authorization service of the MeshExternalAuthz policy.
+type ExternalAuthzGenerator struct {
+}
+
+var _ core.ResourceGenerator = ExternalAuthzGenerator{}
+
+func (g ExternalAuthzGenerator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+	conf := proxy.Policies.ExternalAuthz.GetConf()
+	if conf == nil {
+		return resources, nil
+	}
+
+	builder := clusters.NewClusterBuilder(proxy.APIVersion)
+	var clusterName string
+	switch conf.GetService().(type) {
+	case *mesh_proto.MeshExternalAuthz_Conf_Grpc:
+		address := conf.GetGrpc().GetAddress()
+		clusterName = names.GetExternalAuthzClusterName(address)
+		endpoint, err := endpointForAddress(address)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid address of the authorization service")
+		}
+		builder.
+			Configure(clusters.ProvidedEndpointCluster(clusterName, proxy.Dataplane.IsIPv6(), *endpoint)).
+			Configure(clusters.Http2())
+	case *mesh_proto.MeshExternalAuthz_Conf_Http:
+		url, err := net_url.ParseRequestURI(conf.GetHttp().GetUrl())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid URL of the authorization service")
+		}
+		clusterName = names.GetExternalAuthzClusterName(url.Host)
+		endpoint, err := endpointForURL(url)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate cluster %s", clusterName)
+		}
+		builder.
+			Configure(clusters.ProvidedEndpointCluster(clusterName, proxy.Dataplane.IsIPv6(), *endpoint)).
+			Configure(clusters.ClientSideTLS([]core_xds.Endpoint{*endpoint}))
+	default:
+		return nil, errors.Errorf("unsupported authorization service %T", conf.GetService())
+	}
+	res, err := builder.
+		Configure(clusters.DefaultTimeout()).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	resources.Add(&core_xds.Resource{Name: clusterName, Origin: OriginExternalAuthz, Resource: res})
+	return resources, nil
+}
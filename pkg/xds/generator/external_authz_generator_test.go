@@ -0,0 +1,84 @@
+package generator_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	. "github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+)
+
+var _ = Describe("ExternalAuthzGenerator", func() {
+
+	proxy := func(externalAuthz *core_mesh.MeshExternalAuthzResource) *core_xds.Proxy {
+		return &core_xds.Proxy{
+			Id: *core_xds.BuildProxyId("", "demo.backend-01"),
+			Dataplane: &core_mesh.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "backend-01",
+					Mesh: "demo",
+				},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+					},
+				},
+			},
+			APIVersion: envoy_common.APIV3,
+			Policies: core_xds.MatchedPolicies{
+				ExternalAuthz: externalAuthz,
+			},
+		}
+	}
+
+	It("should not generate cluster without the policy", func() {
+		// given
+		gen := &generator.ExternalAuthzGenerator{}
+
+		// when
+		rs, err := gen.Generate(xds_context.Context{}, proxy(nil))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rs.Empty()).To(BeTrue())
+	})
+
+	DescribeTable("should generate cluster of the authorization service",
+		func(conf string, goldenFile string) {
+			// given
+			gen := &generator.ExternalAuthzGenerator{}
+			externalAuthz := core_mesh.NewMeshExternalAuthzResource()
+			externalAuthz.Spec.Conf = &mesh_proto.MeshExternalAuthz_Conf{}
+			Expect(util_proto.FromYAML([]byte(conf), externalAuthz.Spec.Conf)).To(Succeed())
+
+			// when
+			rs, err := gen.Generate(xds_context.Context{}, proxy(externalAuthz))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			resp, err := rs.List().ToDeltaDiscoveryResponse()
+			Expect(err).ToNot(HaveOccurred())
+			actual, err := util_proto.ToYAML(resp)
+			Expect(err).ToNot(HaveOccurred())
+
+			// and output matches golden files
+			Expect(actual).To(MatchGoldenYAML(filepath.Join("testdata", "external-authz", goldenFile)))
+		},
+		Entry("grpc service", `
+        grpc:
+          address: opa.authz.svc:9191`, "grpc.golden.yaml"),
+		Entry("http service", `
+        http:
+          url: https://authz.example.com/check`, "http.golden.yaml"),
+	)
+})
@@ -26,6 +26,14 @@ type InboundProxyGenerator struct {
 
 func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
 	resources := core_xds.NewResourceSet()
+	wasmPlugins, err := loadWasmPlugins(ctx, proxy.Policies.WasmPlugin.GetInboundPlugins())
+	if err != nil {
+		return nil, err
+	}
+	jwtProviders, err := loadJWTProviders(ctx, proxy.Policies.JWT.GetConf())
+	if err != nil {
+		return nil, err
+	}
 	for i, endpoint := range proxy.Dataplane.Spec.Networking.GetInboundInterfaces() {
 		// we do not create inbounds for serviceless
 		if endpoint.IsServiceLess() {
@@ -64,7 +72,7 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 		// We do assume that the rateLimits resource is sorted, so the most
 		// specific source matches come first.
 		for _, rl := range proxy.Policies.RateLimitsInbound[endpoint] {
-			if rl.Spec.GetConf().GetHttp() == nil {
+			if rl.Spec.GetConf().GetHttp() == nil && rl.Spec.GetConf().GetGlobal() == nil {
 				continue
 			}
 
@@ -88,18 +96,39 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 			case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2:
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName, true)).
+					Configure(envoy_listeners.ExternalAuthz(proxy.Policies.ExternalAuthz.GetConf())).
+					Configure(envoy_listeners.RequestProtection(proxy.Policies.RequestProtection.GetConf())).
 					Configure(envoy_listeners.FaultInjection(proxy.Policies.FaultInjections[endpoint]...)).
 					Configure(envoy_listeners.RateLimit(proxy.Policies.RateLimitsInbound[endpoint])).
+					Configure(envoy_listeners.BandwidthLimit(proxy.Policies.BandwidthLimit.GetInboundLimit())).
+					Configure(envoy_listeners.Compression(proxy.Policies.Compression.GetConf())).
 					Configure(envoy_listeners.Tracing(ctx.Mesh.GetTracingBackend(proxy.Policies.TrafficTrace), service)).
-					Configure(envoy_listeners.HttpInboundRoutes(service, routes))
+					Configure(envoy_listeners.LocalReply(proxy.Policies.LocalReply)).
+					Configure(envoy_listeners.HttpInboundRoutes(service, routes)).
+					Configure(envoy_listeners.JWT(jwtProviders, proxy.Policies.JWT.GetConf().GetRules())).
+					Configure(envoy_listeners.HeaderModifier(proxy.Policies.HeaderModifier.GetInboundModifications())).
+					Configure(envoy_listeners.CORS(proxy.Policies.CORS.GetConf())).
+					Configure(envoy_listeners.Wasm(wasmPlugins)).
+					Configure(envoy_listeners.Tap(ctx.ControlPlane.EnvoyTap))
 			case core_mesh.ProtocolGRPC:
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName, true)).
+					Configure(envoy_listeners.ExternalAuthz(proxy.Policies.ExternalAuthz.GetConf())).
+					Configure(envoy_listeners.RequestProtection(proxy.Policies.RequestProtection.GetConf())).
+					Configure(envoy_listeners.GrpcWeb()).
 					Configure(envoy_listeners.GrpcStats()).
 					Configure(envoy_listeners.FaultInjection(proxy.Policies.FaultInjections[endpoint]...)).
 					Configure(envoy_listeners.RateLimit(proxy.Policies.RateLimitsInbound[endpoint])).
+					Configure(envoy_listeners.BandwidthLimit(proxy.Policies.BandwidthLimit.GetInboundLimit())).
+					Configure(envoy_listeners.Compression(proxy.Policies.Compression.GetConf())).
 					Configure(envoy_listeners.Tracing(ctx.Mesh.GetTracingBackend(proxy.Policies.TrafficTrace), service)).
-					Configure(envoy_listeners.HttpInboundRoutes(service, routes))
+					Configure(envoy_listeners.LocalReply(proxy.Policies.LocalReply)).
+					Configure(envoy_listeners.HttpInboundRoutes(service, routes)).
+					Configure(envoy_listeners.JWT(jwtProviders, proxy.Policies.JWT.GetConf().GetRules())).
+					Configure(envoy_listeners.HeaderModifier(proxy.Policies.HeaderModifier.GetInboundModifications())).
+					Configure(envoy_listeners.CORS(proxy.Policies.CORS.GetConf())).
+					Configure(envoy_listeners.Wasm(wasmPlugins)).
+					Configure(envoy_listeners.Tap(ctx.ControlPlane.EnvoyTap))
 			case core_mesh.ProtocolKafka:
 				filterChainBuilder.
 					Configure(envoy_listeners.Kafka(localClusterName)).
@@ -117,7 +146,7 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 			return filterChainBuilder.
 				Configure(envoy_listeners.Timeout(defaults_mesh.DefaultInboundTimeout(), protocol)).
 				Configure(envoy_listeners.NetworkRBAC(inboundListenerName, ctx.Mesh.Resource.MTLSEnabled(),
-					proxy.Policies.TrafficPermissions[endpoint]))
+					proxy.Policies.TrafficPermissions[endpoint], proxy.Policies.TrafficDenies[endpoint], ctx.Mesh.Resource.Spec.GetMtls().GetFederatedTrustDomains()))
 		}
 
 		listenerBuilder := envoy_listeners.NewListenerBuilder(proxy.APIVersion).
@@ -125,6 +154,14 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *core_xds
 			Configure(envoy_listeners.TransparentProxying(proxy.Dataplane.Spec.Networking.GetTransparentProxying())).
 			Configure(envoy_listeners.TagsMetadata(iface.GetTags()))
 
+		if limit := proxy.Dataplane.GetEnvoyRuntime(ctx.Mesh.Resource).GetPerConnectionBufferLimitBytes(); limit != nil {
+			listenerBuilder.Configure(envoy_listeners.ConnectionBufferLimit(limit.GetValue()))
+		}
+
+		if iface.GetProxyProtocol() {
+			listenerBuilder.Configure(envoy_listeners.ProxyProtocol())
+		}
+
 		switch ctx.Mesh.Resource.GetEnabledCertificateAuthorityBackend().GetMode() {
 		case mesh_proto.CertificateAuthorityBackend_STRICT:
 			listenerBuilder.
@@ -9,6 +9,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core/datasource"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	model "github.com/kumahq/kuma/pkg/core/xds"
 	. "github.com/kumahq/kuma/pkg/test/matchers"
@@ -23,9 +25,19 @@ import (
 var _ = Describe("InboundProxyGenerator", func() {
 
 	type testCase struct {
-		dataplaneFile string
-		expected      string
-		mode          mesh_proto.CertificateAuthorityBackend_Mode
+		dataplaneFile     string
+		expected          string
+		mode              mesh_proto.CertificateAuthorityBackend_Mode
+		localReply        *core_mesh.LocalReplyResource
+		headerModifier    *core_mesh.MeshHeaderModifierResource
+		wasmPlugin        *core_mesh.MeshWasmPluginResource
+		externalAuthz     *core_mesh.MeshExternalAuthzResource
+		bandwidthLimit    *core_mesh.MeshBandwidthLimitResource
+		compression       *core_mesh.MeshCompressionResource
+		cors              *core_mesh.MeshCORSResource
+		requestProtection *core_mesh.MeshRequestProtectionResource
+		jwt               *core_mesh.MeshJWTResource
+		envoyTap          bool
 	}
 
 	DescribeTable("Generate Envoy xDS resources",
@@ -34,7 +46,8 @@ var _ = Describe("InboundProxyGenerator", func() {
 			gen := &generator.InboundProxyGenerator{}
 			ctx := xds_context.Context{
 				ControlPlane: &xds_context.ControlPlaneContext{
-					Secrets: &xds.TestSecrets{},
+					Secrets:  &xds.TestSecrets{},
+					EnvoyTap: given.envoyTap,
 				},
 				Mesh: xds_context.MeshContext{
 					Resource: &core_mesh.MeshResource{
@@ -54,6 +67,7 @@ var _ = Describe("InboundProxyGenerator", func() {
 							},
 						},
 					},
+					DataSourceLoader: datasource.NewStaticLoader(nil),
 				},
 			}
 
@@ -72,6 +86,15 @@ var _ = Describe("InboundProxyGenerator", func() {
 				SecretsTracker: model.NewSecretsTracker(ctx.Mesh.Resource.Meta.GetName(), []string{ctx.Mesh.Resource.Meta.GetName()}),
 				APIVersion:     envoy_common.APIV3,
 				Policies: model.MatchedPolicies{
+					LocalReply:        given.localReply,
+					HeaderModifier:    given.headerModifier,
+					WasmPlugin:        given.wasmPlugin,
+					ExternalAuthz:     given.externalAuthz,
+					BandwidthLimit:    given.bandwidthLimit,
+					Compression:       given.compression,
+					CORS:              given.cors,
+					RequestProtection: given.requestProtection,
+					JWT:               given.jwt,
 
 					TrafficPermissions: model.TrafficPermissionMap{
 						mesh_proto.InboundInterface{
@@ -251,5 +274,331 @@ var _ = Describe("InboundProxyGenerator", func() {
 			expected:      "6-envoy-config.golden.yaml",
 			mode:          mesh_proto.CertificateAuthorityBackend_PERMISSIVE,
 		}),
+		Entry("07. proxy_protocol=true, mode=permissive", testCase{
+			dataplaneFile: "7-dataplane.input.yaml",
+			expected:      "7-envoy-config.golden.yaml",
+			mode:          mesh_proto.CertificateAuthorityBackend_PERMISSIVE,
+		}),
+		Entry("08. per connection buffer limit defined in dataplane", testCase{
+			dataplaneFile: "8-dataplane.input.yaml",
+			expected:      "8-envoy-config.golden.yaml",
+		}),
+		Entry("09. local reply", testCase{
+			dataplaneFile: "9-dataplane.input.yaml",
+			expected:      "9-envoy-config.golden.yaml",
+			localReply: &core_mesh.LocalReplyResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "lr-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.LocalReply{
+					Selectors: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "*",
+							},
+						},
+					},
+					Conf: &mesh_proto.LocalReply_Conf{
+						Mappers: []*mesh_proto.LocalReply_Conf_Mapper{
+							{
+								StatusCodes: []uint32{503, 504},
+								Body:        "upstream unavailable",
+							},
+						},
+						BodyFormat: &mesh_proto.LocalReply_Conf_BodyFormat{
+							Text:        `{"code": %RESPONSE_CODE%, "message": "%LOCAL_REPLY_BODY%"}`,
+							ContentType: "application/json",
+						},
+					},
+				},
+			},
+		}),
+		Entry("10. header modifier", testCase{
+			dataplaneFile: "10-dataplane.input.yaml",
+			expected:      "10-envoy-config.golden.yaml",
+			headerModifier: &core_mesh.MeshHeaderModifierResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "hm-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.MeshHeaderModifier{
+					Selectors: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "*",
+							},
+						},
+					},
+					Conf: &mesh_proto.MeshHeaderModifier_Conf{
+						Inbound: &mesh_proto.MeshHeaderModifier_Conf_Modifications{
+							RequestHeaders: &mesh_proto.MeshHeaderModifier_Conf_Headers{
+								Set: []*mesh_proto.MeshHeaderModifier_Conf_Headers_Header{
+									{
+										Name:  "x-tenant",
+										Value: "acme",
+									},
+								},
+							},
+							ResponseHeaders: &mesh_proto.MeshHeaderModifier_Conf_Headers{
+								Remove: []string{"server"},
+							},
+						},
+					},
+				},
+			},
+		}),
+		Entry("11. wasm plugin", testCase{
+			dataplaneFile: "10-dataplane.input.yaml",
+			expected:      "11-envoy-config.golden.yaml",
+			wasmPlugin: &core_mesh.MeshWasmPluginResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "wp-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.MeshWasmPlugin{
+					Selectors: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "*",
+							},
+						},
+					},
+					Conf: &mesh_proto.MeshWasmPlugin_Conf{
+						Inbound: []*mesh_proto.MeshWasmPlugin_Conf_Plugin{
+							{
+								Name:     "stats",
+								Position: mesh_proto.MeshWasmPlugin_Conf_Plugin_AFTER_KUMA,
+								Module: &mesh_proto.MeshWasmPlugin_Conf_Plugin_Module{
+									Source: &mesh_proto.MeshWasmPlugin_Conf_Plugin_Module_Remote_{
+										Remote: &mesh_proto.MeshWasmPlugin_Conf_Plugin_Module_Remote{
+											Url:    "https://registry.example.com/stats.wasm",
+											Sha256: "5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b",
+										},
+									},
+								},
+							},
+							{
+								Name:          "auth",
+								Configuration: "acme",
+								Module: &mesh_proto.MeshWasmPlugin_Conf_Plugin_Module{
+									Source: &mesh_proto.MeshWasmPlugin_Conf_Plugin_Module_Local{
+										Local: &system_proto.DataSource{
+											Type: &system_proto.DataSource_Inline{
+												Inline: util_proto.Bytes([]byte("\x00asm")),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}),
+		Entry("12. external authorization", testCase{
+			dataplaneFile: "10-dataplane.input.yaml",
+			expected:      "12-envoy-config.golden.yaml",
+			externalAuthz: &core_mesh.MeshExternalAuthzResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "ea-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.MeshExternalAuthz{
+					Selectors: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "*",
+							},
+						},
+					},
+					Conf: &mesh_proto.MeshExternalAuthz_Conf{
+						Service: &mesh_proto.MeshExternalAuthz_Conf_Grpc{
+							Grpc: &mesh_proto.MeshExternalAuthz_Conf_GrpcService{
+								Address: "opa.authz.svc:9191",
+							},
+						},
+						FailureModeAllow: true,
+					},
+				},
+			},
+		}),
+		Entry("13. bandwidth limit", testCase{
+			dataplaneFile: "10-dataplane.input.yaml",
+			expected:      "13-envoy-config.golden.yaml",
+			bandwidthLimit: &core_mesh.MeshBandwidthLimitResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "bl-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.MeshBandwidthLimit{
+					Selectors: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "*",
+							},
+						},
+					},
+					Conf: &mesh_proto.MeshBandwidthLimit_Conf{
+						Inbound: &mesh_proto.MeshBandwidthLimit_Conf_Limit{
+							BytesPerSecond: 1048576,
+						},
+					},
+				},
+			},
+		}),
+		Entry("14. compression", testCase{
+			dataplaneFile: "10-dataplane.input.yaml",
+			expected:      "14-envoy-config.golden.yaml",
+			compression: &core_mesh.MeshCompressionResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "mc-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.MeshCompression{
+					Selectors: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "*",
+							},
+						},
+					},
+					Conf: &mesh_proto.MeshCompression_Conf{
+						Libraries: []mesh_proto.MeshCompression_Conf_Library{
+							mesh_proto.MeshCompression_Conf_BROTLI,
+							mesh_proto.MeshCompression_Conf_GZIP,
+						},
+						MinContentLength: util_proto.UInt32(1024),
+						ContentTypes:     []string{"application/json"},
+					},
+				},
+			},
+		}),
+		Entry("15. CORS", testCase{
+			dataplaneFile: "10-dataplane.input.yaml",
+			expected:      "15-envoy-config.golden.yaml",
+			cors: &core_mesh.MeshCORSResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "cors-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.MeshCORS{
+					Selectors: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "*",
+							},
+						},
+					},
+					Conf: &mesh_proto.MeshCORS_Conf{
+						AllowOrigins: []*mesh_proto.MeshCORS_Conf_Origin{
+							{
+								Match: &mesh_proto.MeshCORS_Conf_Origin_Regex{
+									Regex: `https://.*\.example\.com`,
+								},
+							},
+						},
+						AllowMethods:     []string{"GET", "POST"},
+						AllowHeaders:     []string{"content-type"},
+						MaxAge:           util_proto.Duration(10 * time.Minute),
+						AllowCredentials: true,
+					},
+				},
+			},
+		}),
+		Entry("16. request protection", testCase{
+			dataplaneFile: "10-dataplane.input.yaml",
+			expected:      "16-envoy-config.golden.yaml",
+			requestProtection: &core_mesh.MeshRequestProtectionResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "request-protection-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.MeshRequestProtection{
+					Selectors: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "*",
+							},
+						},
+					},
+					Conf: &mesh_proto.MeshRequestProtection_Conf{
+						Csrf: &mesh_proto.MeshRequestProtection_Conf_Csrf{
+							AdditionalOrigins: []*mesh_proto.MeshRequestProtection_Conf_Csrf_Origin{
+								{
+									Match: &mesh_proto.MeshRequestProtection_Conf_Csrf_Origin_Exact{
+										Exact: "https://example.com",
+									},
+								},
+							},
+						},
+						MaxRequestBytes: util_proto.UInt32(1048576),
+					},
+				},
+			},
+		}),
+		Entry("17. envoy tap", testCase{
+			dataplaneFile: "10-dataplane.input.yaml",
+			expected:      "17-envoy-config.golden.yaml",
+			envoyTap:      true,
+		}),
+		Entry("18. JWT authentication", testCase{
+			dataplaneFile: "10-dataplane.input.yaml",
+			expected:      "18-envoy-config.golden.yaml",
+			jwt: &core_mesh.MeshJWTResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "jwt-1",
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.MeshJWT{
+					Selectors: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"kuma.io/service": "*",
+							},
+						},
+					},
+					Conf: &mesh_proto.MeshJWT_Conf{
+						Providers: []*mesh_proto.MeshJWT_Conf_Provider{
+							{
+								Name:      "auth0",
+								Issuer:    "https://example.auth0.com/",
+								Audiences: []string{"backend"},
+								Jwks: &mesh_proto.MeshJWT_Conf_Provider_RemoteJwks_{
+									RemoteJwks: &mesh_proto.MeshJWT_Conf_Provider_RemoteJwks{
+										Url: "https://example.auth0.com/.well-known/jwks.json",
+									},
+								},
+								ClaimToHeaders: []*mesh_proto.MeshJWT_Conf_Provider_ClaimToHeader{
+									{
+										Claim:  "sub",
+										Header: "x-user-id",
+									},
+								},
+							},
+							{
+								Name: "internal",
+								Jwks: &mesh_proto.MeshJWT_Conf_Provider_LocalJwks{
+									LocalJwks: &system_proto.DataSource{
+										Type: &system_proto.DataSource_Inline{
+											Inline: util_proto.Bytes([]byte(`{"keys":[]}`)),
+										},
+									},
+								},
+							},
+						},
+						Rules: []*mesh_proto.MeshJWT_Conf_Rule{
+							{
+								PathPrefix: "/health",
+							},
+							{
+								PathPrefix: "/",
+								Providers:  []string{"auth0", "internal"},
+							},
+						},
+					},
+				},
+			},
+		}),
 	)
 })
@@ -62,6 +62,7 @@ func (i IngressGenerator) Generate(ctx xds_context.Context, proxy *core_xds.Prox
 // SNI value has service name and tag values specified with the following format: "backend{cluster=2,version=1}"
 // We take all possible destinations from TrafficRoutes + GatewayRoutes and generate FilterChainsMatcher for each unique destination.
 // This approach has a limitation: additional tags on outbound in Universal mode won't work across different zones.
+// Every SNI has its own filter chain, so new connections can be rate limited per SNI with the zone ingress options of the mesh.
 // Traffic is NOT decrypted here, therefore we don't need certificates and mTLS settings
 func (i IngressGenerator) generateLDS(
 	proxy *core_xds.Proxy,
@@ -79,6 +80,7 @@ func (i IngressGenerator) generateLDS(
 			Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(apiVersion)))
 	}
 
+	zoneIngressOptions := i.zoneIngressOptions(proxy.ZoneIngressProxy)
 	sniUsed := map[string]bool{}
 
 	for _, inbound := range proxy.ZoneIngress.Spec.GetAvailableServices() {
@@ -99,10 +101,12 @@ func (i IngressGenerator) generateLDS(
 				envoy_listeners.NewFilterChainBuilder(apiVersion).Configure(
 					envoy_listeners.MatchTransportProtocol("tls"),
 					envoy_listeners.MatchServerNames(sni),
-					envoy_listeners.TcpProxyWithMetadata(service, envoy_common.NewCluster(
+					// services of different meshes can have the same name, so the stats are prefixed with the mesh
+					envoy_listeners.TcpProxyWithMetadata(envoy_names.GetMeshClusterName(inbound.GetMesh(), service), envoy_common.NewCluster(
 						envoy_common.WithService(service),
 						envoy_common.WithTags(meshDestination.WithoutTags(mesh_proto.ServiceTag)),
 					)),
+					envoy_listeners.ConnectionRateLimit(sni, zoneIngressOptions[inbound.GetMesh()].GetConnectionRateLimit()),
 				),
 			))
 		}
@@ -111,6 +115,18 @@ func (i IngressGenerator) generateLDS(
 	return inboundListenerBuilder.Build()
 }
 
+func (_ IngressGenerator) zoneIngressOptions(
+	ingressProxy *core_xds.ZoneIngressProxy,
+) map[string]*mesh_proto.Routing_ZoneIngressOptions {
+	options := map[string]*mesh_proto.Routing_ZoneIngressOptions{}
+	for _, mesh := range ingressProxy.MeshResourceList.Items {
+		if opts := mesh.Spec.GetRouting().GetZoneIngress(); opts != nil {
+			options[mesh.GetMeta().GetName()] = opts
+		}
+	}
+	return options
+}
+
 func (_ IngressGenerator) destinations(
 	ingressProxy *core_xds.ZoneIngressProxy,
 ) map[string][]envoy_common.Tags {
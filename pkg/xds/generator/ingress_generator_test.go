@@ -2,6 +2,7 @@ package generator_test
 
 import (
 	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -24,6 +25,7 @@ var _ = Describe("IngressGenerator", func() {
 		outboundTargets core_xds.EndpointMap
 		trafficRoutes   *core_mesh.TrafficRouteResourceList
 		meshGateways    *core_mesh.MeshGatewayResourceList
+		meshes          *core_mesh.MeshResourceList
 	}
 
 	DescribeTable("should generate Envoy xDS resources",
@@ -42,6 +44,9 @@ var _ = Describe("IngressGenerator", func() {
 			if given.meshGateways == nil {
 				given.meshGateways = &core_mesh.MeshGatewayResourceList{}
 			}
+			if given.meshes == nil {
+				given.meshes = &core_mesh.MeshResourceList{}
+			}
 			proxy := &core_xds.Proxy{
 				Id:          *core_xds.BuildProxyId("default", "ingress"),
 				ZoneIngress: zoneIngressRes,
@@ -53,6 +58,7 @@ var _ = Describe("IngressGenerator", func() {
 					TrafficRouteList: given.trafficRoutes,
 					GatewayRoutes:    &core_mesh.MeshGatewayRouteResourceList{},
 					MeshGateways:     given.meshGateways,
+					MeshResourceList: given.meshes,
 				},
 			}
 
@@ -498,6 +504,74 @@ var _ = Describe("IngressGenerator", func() {
 				},
 			},
 		}),
+		Entry("06. connection rate limit per SNI", testCase{
+			ingress: `
+            networking:
+              address: 10.0.0.1
+              port: 10001
+            availableServices:
+              - mesh: mesh1
+                tags:
+                  kuma.io/service: backend
+                  version: v1
+              - mesh: mesh2
+                tags:
+                  kuma.io/service: backend
+                  version: v1
+              - mesh: mesh2
+                tags:
+                  kuma.io/service: backend
+                  version: v2
+`,
+			expected: "06.envoy.golden.yaml",
+			outboundTargets: map[core_xds.ServiceName][]core_xds.Endpoint{
+				"backend": {},
+			},
+			trafficRoutes: &core_mesh.TrafficRouteResourceList{
+				Items: []*core_mesh.TrafficRouteResource{
+					{
+						Spec: &mesh_proto.TrafficRoute{
+							Sources: []*mesh_proto.Selector{{
+								Match: mesh_proto.MatchAnyService(),
+							}},
+							Destinations: []*mesh_proto.Selector{{
+								Match: mesh_proto.MatchAnyService(),
+							}},
+							Conf: &mesh_proto.TrafficRoute_Conf{
+								Split: []*mesh_proto.TrafficRoute_Split{{
+									Weight:      util_proto.UInt32(50),
+									Destination: mesh_proto.TagSelector{mesh_proto.ServiceTag: "backend", "version": "v1"},
+								}, {
+									Weight:      util_proto.UInt32(50),
+									Destination: mesh_proto.TagSelector{mesh_proto.ServiceTag: "backend", "version": "v2"},
+								}},
+							},
+						},
+					},
+				},
+			},
+			meshes: &core_mesh.MeshResourceList{
+				Items: []*core_mesh.MeshResource{
+					{
+						Meta: &test_model.ResourceMeta{Name: "mesh1"},
+						Spec: &mesh_proto.Mesh{},
+					},
+					{
+						Meta: &test_model.ResourceMeta{Name: "mesh2"},
+						Spec: &mesh_proto.Mesh{
+							Routing: &mesh_proto.Routing{
+								ZoneIngress: &mesh_proto.Routing_ZoneIngressOptions{
+									ConnectionRateLimit: &mesh_proto.Routing_ZoneIngressOptions_ConnectionRateLimit{
+										Connections: 100,
+										Interval:    util_proto.Duration(time.Second),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}),
 		Entry("cross-mesh MeshGateway", testCase{
 			ingress: `
             networking:
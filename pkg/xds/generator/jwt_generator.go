@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"context"
+	net_url "net/url"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+	"github.com/kumahq/kuma/pkg/xds/generator/core"
+)
+
+// OriginJWT is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginJWT = "jwt"
+
+// JWTGenerator generates clusters used by Envoy to fetch remote key sets of the MeshJWT policy.
+// Key sets fetched through the mesh use outbound clusters of the service, so no cluster is generated for them.
+type JWTGenerator struct {
+}
+
+var _ core.ResourceGenerator = JWTGenerator{}
+
+func (g JWTGenerator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+	generated := map[string]bool{}
+	for _, provider := range proxy.Policies.JWT.GetConf().GetProviders() {
+		remote := provider.GetRemoteJwks()
+		if remote == nil || remote.GetService() != "" {
+			continue
+		}
+		url, err := net_url.ParseRequestURI(remote.GetUrl())
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid URL of the key set of JWT provider %q", provider.GetName())
+		}
+		clusterName := names.GetJWKSClusterName(url.Host)
+		if generated[clusterName] {
+			continue
+		}
+		endpoint, err := endpointForURL(url)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate cluster %s", clusterName)
+		}
+		res, err := clusters.NewClusterBuilder(proxy.APIVersion).
+			Configure(clusters.ProvidedEndpointCluster(clusterName, proxy.Dataplane.IsIPv6(), *endpoint)).
+			Configure(clusters.ClientSideTLS([]core_xds.Endpoint{*endpoint})).
+			Configure(clusters.DefaultTimeout()).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+		resources.Add(&core_xds.Resource{Name: clusterName, Origin: OriginJWT, Resource: res})
+		generated[clusterName] = true
+	}
+	return resources, nil
+}
+
+// loadJWTProviders loads the key sets provided by the control plane.
+func loadJWTProviders(ctx xds_context.Context, conf *mesh_proto.MeshJWT_Conf) ([]listeners_v3.JWTProvider, error) {
+	var loaded []listeners_v3.JWTProvider
+	for _, provider := range conf.GetProviders() {
+		jwtProvider := listeners_v3.JWTProvider{Provider: provider}
+		if local := provider.GetLocalJwks(); local != nil {
+			jwks, err := ctx.Mesh.DataSourceLoader.Load(context.Background(), ctx.Mesh.Resource.GetMeta().GetName(), local)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not load the key set of JWT provider %q", provider.GetName())
+			}
+			jwtProvider.Jwks = jwks
+		}
+		loaded = append(loaded, jwtProvider)
+	}
+	return loaded, nil
+}
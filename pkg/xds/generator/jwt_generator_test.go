@@ -0,0 +1,93 @@
+package generator_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	. "github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+)
+
+var _ = Describe("JWTGenerator", func() {
+
+	proxy := func(jwt *core_mesh.MeshJWTResource) *core_xds.Proxy {
+		return &core_xds.Proxy{
+			Id: *core_xds.BuildProxyId("", "demo.backend-01"),
+			Dataplane: &core_mesh.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "backend-01",
+					Mesh: "demo",
+				},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+					},
+				},
+			},
+			APIVersion: envoy_common.APIV3,
+			Policies: core_xds.MatchedPolicies{
+				JWT: jwt,
+			},
+		}
+	}
+
+	It("should not generate clusters without the policy", func() {
+		// given
+		gen := &generator.JWTGenerator{}
+
+		// when
+		rs, err := gen.Generate(xds_context.Context{}, proxy(nil))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rs.Empty()).To(BeTrue())
+	})
+
+	It("should generate clusters of remote key sets", func() {
+		// given
+		gen := &generator.JWTGenerator{}
+		jwt := core_mesh.NewMeshJWTResource()
+		jwt.Spec.Conf = &mesh_proto.MeshJWT_Conf{}
+		Expect(util_proto.FromYAML([]byte(`
+        providers:
+        - name: auth0
+          remoteJwks:
+            url: https://example.auth0.com/.well-known/jwks.json
+        - name: auth0-legacy
+          remoteJwks:
+            url: https://example.auth0.com/legacy/jwks.json
+        - name: keycloak
+          remoteJwks:
+            url: http://keycloak.svc:8080/realms/kuma/protocol/openid-connect/certs
+        - name: mesh
+          remoteJwks:
+            url: http://keycloak/realms/kuma/protocol/openid-connect/certs
+            service: keycloak_kuma-system_svc_8080
+        - name: internal
+          localJwks:
+            inline: e30=`), jwt.Spec.Conf)).To(Succeed())
+
+		// when
+		rs, err := gen.Generate(xds_context.Context{}, proxy(jwt))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		resp, err := rs.List().ToDeltaDiscoveryResponse()
+		Expect(err).ToNot(HaveOccurred())
+		actual, err := util_proto.ToYAML(resp)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and output matches golden files
+		Expect(actual).To(MatchGoldenYAML(filepath.Join("testdata", "jwt", "remote-jwks.golden.yaml")))
+	})
+})
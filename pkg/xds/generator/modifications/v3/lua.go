@@ -0,0 +1,162 @@
+package v3
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_lua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/golang/protobuf/ptypes/any"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	envoy_metadata "github.com/kumahq/kuma/pkg/xds/envoy/metadata/v3"
+)
+
+const routerFilterName = "envoy.filters.http.router"
+
+// luaModificator inserts Lua filter right before the router. Like virtualHostModificator,
+// it assumes that the routes are specified as `routeConfig` in Listeners, not through RDS.
+type luaModificator mesh_proto.ProxyTemplate_Modifications_Lua
+
+func (l *luaModificator) apply(resources *core_xds.ResourceSet) error {
+	for _, resource := range resources.Resources(envoy_resource.ListenerType) {
+		if l.listenerMatches(resource) {
+			listener := resource.Resource.(*envoy_listener.Listener)
+			for _, chain := range listener.FilterChains { // apply on all filter chains. We could introduce filter chain matcher as an improvement.
+				for _, networkFilter := range chain.Filters {
+					if networkFilter.Name == "envoy.filters.network.http_connection_manager" {
+						hcm := &envoy_hcm.HttpConnectionManager{}
+						err := util_proto.UnmarshalAnyTo(networkFilter.ConfigType.(*envoy_listener.Filter_TypedConfig).TypedConfig, hcm)
+						if err != nil {
+							return err
+						}
+						if err := l.applyHCMModification(hcm); err != nil {
+							return err
+						}
+						any, err := util_proto.MarshalAnyDeterministic(hcm)
+						if err != nil {
+							return err
+						}
+						networkFilter.ConfigType.(*envoy_listener.Filter_TypedConfig).TypedConfig = any
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (l *luaModificator) applyHCMModification(hcm *envoy_hcm.HttpConnectionManager) error {
+	typedConfig, err := util_proto.MarshalAnyDeterministic(&envoy_lua.Lua{
+		InlineCode: l.Script,
+	})
+	if err != nil {
+		return err
+	}
+	filter := &envoy_hcm.HttpFilter{
+		Name: l.Name,
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}
+	idx := len(hcm.HttpFilters)
+	for i, httpFilter := range hcm.HttpFilters {
+		if httpFilter.Name == routerFilterName {
+			idx = i
+			break
+		}
+	}
+	hcm.HttpFilters = append(hcm.HttpFilters, nil)
+	copy(hcm.HttpFilters[idx+1:], hcm.HttpFilters[idx:])
+	hcm.HttpFilters[idx] = filter
+
+	routeCfg := hcm.GetRouteConfig()
+	if routeCfg == nil {
+		return nil // ignore HCMs without embedded routes
+	}
+	for _, override := range l.RouteOverrides {
+		if err := l.applyRouteOverride(routeCfg, override); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *luaModificator) applyRouteOverride(routeCfg *envoy_route.RouteConfiguration, override *mesh_proto.ProxyTemplate_Modifications_Lua_RouteOverride) error {
+	match := override.GetMatch()
+	if match.GetRouteConfigurationName() != "" && match.GetRouteConfigurationName() != routeCfg.Name {
+		return nil
+	}
+	perRoute := &envoy_lua.LuaPerRoute{}
+	if override.GetDisabled() {
+		perRoute.Override = &envoy_lua.LuaPerRoute_Disabled{
+			Disabled: true,
+		}
+	} else {
+		perRoute.Override = &envoy_lua.LuaPerRoute_SourceCode{
+			SourceCode: &envoy_core.DataSource{
+				Specifier: &envoy_core.DataSource_InlineString{
+					InlineString: override.GetScript(),
+				},
+			},
+		}
+	}
+	perRouteConfig, err := util_proto.MarshalAnyDeterministic(perRoute)
+	if err != nil {
+		return err
+	}
+	for _, vHost := range routeCfg.VirtualHosts {
+		if match.GetVirtualHostName() != vHost.Name {
+			continue
+		}
+		if match.GetRouteName() == "" && match.GetPrefix() == "" {
+			vHost.TypedPerFilterConfig = l.withPerFilterConfig(vHost.TypedPerFilterConfig, perRouteConfig)
+			continue
+		}
+		for _, route := range vHost.Routes {
+			if l.routeMatches(route, match) {
+				route.TypedPerFilterConfig = l.withPerFilterConfig(route.TypedPerFilterConfig, perRouteConfig)
+			}
+		}
+	}
+	return nil
+}
+
+func (l *luaModificator) withPerFilterConfig(configs map[string]*any.Any, config *any.Any) map[string]*any.Any {
+	if configs == nil {
+		configs = map[string]*any.Any{}
+	}
+	configs[l.Name] = config
+	return configs
+}
+
+func (l *luaModificator) routeMatches(route *envoy_route.Route, match *mesh_proto.ProxyTemplate_Modifications_Lua_RouteOverride_Match) bool {
+	if match.GetRouteName() != "" && match.GetRouteName() != route.Name {
+		return false
+	}
+	if match.GetPrefix() != "" && match.GetPrefix() != route.GetMatch().GetPrefix() {
+		return false
+	}
+	return true
+}
+
+func (l *luaModificator) listenerMatches(resource *core_xds.Resource) bool {
+	if l.Match.GetListenerName() != "" && l.Match.GetListenerName() != resource.Name {
+		return false
+	}
+	if l.Match.GetOrigin() != "" && l.Match.GetOrigin() != resource.Origin {
+		return false
+	}
+	if len(l.Match.GetListenerTags()) > 0 {
+		if listenerProto, ok := resource.Resource.(*envoy_listener.Listener); ok {
+			listenerTags := envoy_metadata.ExtractTags(listenerProto.Metadata)
+			if !mesh_proto.TagSelector(l.Match.GetListenerTags()).Matches(listenerTags) {
+				return false
+			}
+		}
+	}
+	return true
+}
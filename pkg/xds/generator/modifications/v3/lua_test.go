@@ -0,0 +1,228 @@
+package v3_test
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+	modifications "github.com/kumahq/kuma/pkg/xds/generator/modifications/v3"
+)
+
+var _ = Describe("Lua modifications", func() {
+
+	type testCase struct {
+		listeners     []string
+		modifications []string
+		expected      string
+	}
+
+	DescribeTable("should apply modifications",
+		func(given testCase) {
+			// given
+			set := core_xds.NewResourceSet()
+			for _, listenerYAML := range given.listeners {
+				listener := &envoy_listener.Listener{}
+				err := util_proto.FromYAML([]byte(listenerYAML), listener)
+				Expect(err).ToNot(HaveOccurred())
+				set.Add(&core_xds.Resource{
+					Name:     listener.Name,
+					Origin:   generator.OriginInbound,
+					Resource: listener,
+				})
+			}
+
+			var mods []*mesh_proto.ProxyTemplate_Modifications
+			for _, modificationYAML := range given.modifications {
+				modification := &mesh_proto.ProxyTemplate_Modifications{}
+				err := util_proto.FromYAML([]byte(modificationYAML), modification)
+				Expect(err).ToNot(HaveOccurred())
+				mods = append(mods, modification)
+			}
+
+			// when
+			err := modifications.Apply(set, mods)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			resp, err := set.List().ToDeltaDiscoveryResponse()
+			Expect(err).ToNot(HaveOccurred())
+			actual, err := util_proto.ToYAML(resp)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("should add filter before the router with route overrides", testCase{
+			listeners: []string{
+				`
+                name: inbound:192.168.0.1:8080
+                filterChains:
+                - filters:
+                  - name: envoy.filters.network.http_connection_manager
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                      httpFilters:
+                      - name: envoy.filters.http.fault
+                      - name: envoy.filters.http.router
+                      routeConfig:
+                        name: inbound:backend
+                        virtualHosts:
+                        - name: backend
+                          domains:
+                          - '*'
+                          routes:
+                          - match:
+                              prefix: /health
+                            route:
+                              cluster: localhost:8080
+                          - match:
+                              prefix: /
+                            route:
+                              cluster: localhost:8080
+                        - name: other
+                          domains:
+                          - other.com
+`,
+			},
+			modifications: []string{`
+                lua:
+                  name: add-header
+                  script: |
+                    function envoy_on_request(request_handle)
+                    end
+                  routeOverrides:
+                  - match:
+                      virtualHostName: backend
+                      prefix: /health
+                    disabled: true
+                  - match:
+                      routeConfigurationName: inbound:backend
+                      virtualHostName: other
+                    script: |
+                      function envoy_on_response(response_handle)
+                      end
+                  - match:
+                      routeConfigurationName: outbound:backend
+                      virtualHostName: backend
+                    disabled: true`,
+			},
+			expected: `
+                resources:
+                - name: inbound:192.168.0.1:8080
+                  resource:
+                    '@type': type.googleapis.com/envoy.config.listener.v3.Listener
+                    filterChains:
+                    - filters:
+                      - name: envoy.filters.network.http_connection_manager
+                        typedConfig:
+                          '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                          httpFilters:
+                          - name: envoy.filters.http.fault
+                          - name: add-header
+                            typedConfig:
+                              '@type': type.googleapis.com/envoy.extensions.filters.http.lua.v3.Lua
+                              inlineCode: |
+                                function envoy_on_request(request_handle)
+                                end
+                          - name: envoy.filters.http.router
+                          routeConfig:
+                            name: inbound:backend
+                            virtualHosts:
+                            - domains:
+                              - '*'
+                              name: backend
+                              routes:
+                              - match:
+                                  prefix: /health
+                                route:
+                                  cluster: localhost:8080
+                                typedPerFilterConfig:
+                                  add-header:
+                                    '@type': type.googleapis.com/envoy.extensions.filters.http.lua.v3.LuaPerRoute
+                                    disabled: true
+                              - match:
+                                  prefix: /
+                                route:
+                                  cluster: localhost:8080
+                            - domains:
+                              - other.com
+                              name: other
+                              typedPerFilterConfig:
+                                add-header:
+                                  '@type': type.googleapis.com/envoy.extensions.filters.http.lua.v3.LuaPerRoute
+                                  sourceCode:
+                                    inlineString: |
+                                      function envoy_on_response(response_handle)
+                                      end
+                    name: inbound:192.168.0.1:8080
+`,
+		}),
+		Entry("should add filter only to matching listeners", testCase{
+			listeners: []string{
+				`
+                name: inbound:192.168.0.1:8080
+                filterChains:
+                - filters:
+                  - name: envoy.filters.network.http_connection_manager
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                      httpFilters:
+                      - name: envoy.filters.http.router
+`,
+				`
+                name: inbound:192.168.0.1:8081
+                filterChains:
+                - filters:
+                  - name: envoy.filters.network.http_connection_manager
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                      httpFilters:
+                      - name: envoy.filters.http.router
+`,
+			},
+			modifications: []string{`
+                lua:
+                  name: add-header
+                  match:
+                    origin: inbound
+                    listenerName: inbound:192.168.0.1:8081
+                  script: |
+                    function envoy_on_request(request_handle)
+                    end`,
+			},
+			expected: `
+                resources:
+                - name: inbound:192.168.0.1:8080
+                  resource:
+                    '@type': type.googleapis.com/envoy.config.listener.v3.Listener
+                    filterChains:
+                    - filters:
+                      - name: envoy.filters.network.http_connection_manager
+                        typedConfig:
+                          '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                          httpFilters:
+                          - name: envoy.filters.http.router
+                    name: inbound:192.168.0.1:8080
+                - name: inbound:192.168.0.1:8081
+                  resource:
+                    '@type': type.googleapis.com/envoy.config.listener.v3.Listener
+                    filterChains:
+                    - filters:
+                      - name: envoy.filters.network.http_connection_manager
+                        typedConfig:
+                          '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                          httpFilters:
+                          - name: add-header
+                            typedConfig:
+                              '@type': type.googleapis.com/envoy.extensions.filters.http.lua.v3.Lua
+                              inlineCode: |-
+                                function envoy_on_request(request_handle)
+                                end
+                          - name: envoy.filters.http.router
+                    name: inbound:192.168.0.1:8081
+`,
+		}),
+	)
+})
@@ -33,6 +33,9 @@ func Apply(resources *core_xds.ResourceSet, modifications []*mesh_proto.ProxyTem
 		case *mesh_proto.ProxyTemplate_Modifications_VirtualHost_:
 			mod := virtualHostModificator(*modification.GetVirtualHost())
 			modificator = &mod
+		case *mesh_proto.ProxyTemplate_Modifications_Lua_:
+			mod := luaModificator(*modification.GetLua())
+			modificator = &mod
 		default:
 			return errors.Errorf("invalid modification type %T", modification.Type)
 		}
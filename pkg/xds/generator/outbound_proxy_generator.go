@@ -13,7 +13,9 @@ import (
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
 	envoy_clusters "github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	envoy_endpoints "github.com/kumahq/kuma/pkg/xds/envoy/endpoints"
 	envoy_listeners "github.com/kumahq/kuma/pkg/xds/envoy/listeners"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
 	envoy_names "github.com/kumahq/kuma/pkg/xds/envoy/names"
 )
 
@@ -53,17 +55,23 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 	clusterCache := map[string]string{}
 	splitCounter := &splitCounter{}
 
+	wasmPlugins, err := loadWasmPlugins(ctx, proxy.Policies.WasmPlugin.GetOutboundPlugins())
+	if err != nil {
+		return nil, err
+	}
+
 	for _, outbound := range outbounds {
 		// Determine the list of destination subsets
 		// For one outbound listener it may contain many subsets (ex. TrafficRoute to many destinations)
 		routes := g.determineRoutes(proxy, outbound, clusterCache, splitCounter, ctx.Mesh.Resource.ZoneEgressEnabled())
 		clusters := routes.Clusters()
 		servicesAcc.Add(clusters...)
+		servicesAcc.Add(routes.MirrorClusters()...)
 
 		protocol := g.inferProtocol(proxy, clusters)
 
 		// Generate listener
-		listener, err := g.generateLDS(ctx, proxy, routes, outbound, protocol)
+		listener, err := g.generateLDS(ctx, proxy, routes, outbound, protocol, wasmPlugins)
 		if err != nil {
 			return nil, err
 		}
@@ -92,7 +100,14 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 	return resources, nil
 }
 
-func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.Proxy, routes envoy_common.Routes, outbound *mesh_proto.Dataplane_Networking_Outbound, protocol core_mesh.Protocol) (envoy_common.NamedResource, error) {
+func (OutboundProxyGenerator) generateLDS(
+	ctx xds_context.Context,
+	proxy *model.Proxy,
+	routes envoy_common.Routes,
+	outbound *mesh_proto.Dataplane_Networking_Outbound,
+	protocol core_mesh.Protocol,
+	wasmPlugins []listeners_v3.WasmPlugin,
+) (envoy_common.NamedResource, error) {
 	oface := proxy.Dataplane.Spec.Networking.ToOutboundInterface(outbound)
 	rateLimits := []*core_mesh.RateLimitResource{}
 	if rateLimit, exists := proxy.Policies.RateLimitsOutbound[oface]; exists {
@@ -117,16 +132,20 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 				Configure(envoy_listeners.HttpAccessLog(meshName, envoy_common.TrafficDirectionOutbound, sourceService, serviceName,
 					ctx.Mesh.GetLoggingBackend(proxy.Policies.TrafficLogs[serviceName]), proxy)).
 				Configure(envoy_listeners.HttpOutboundRoute(serviceName, routes, proxy.Dataplane.Spec.TagSet())).
+				Configure(envoy_listeners.HeaderModifier(proxy.Policies.HeaderModifier.GetOutboundModifications())).
 				// backwards compatibility to support RateLimit for ExternalServices without ZoneEgress
 				ConfigureIf(!ctx.Mesh.Resource.ZoneEgressEnabled(), envoy_listeners.RateLimit(rateLimits)).
+				Configure(envoy_listeners.BandwidthLimit(proxy.Policies.BandwidthLimit.GetOutboundLimit())).
 				Configure(envoy_listeners.Retry(retryPolicy, protocol)).
-				Configure(envoy_listeners.GrpcStats())
+				Configure(envoy_listeners.GrpcStats()).
+				Configure(envoy_listeners.Wasm(wasmPlugins))
 		case core_mesh.ProtocolHTTP, core_mesh.ProtocolHTTP2:
 			filterChainBuilder.
 				Configure(envoy_listeners.HttpConnectionManager(serviceName, false)).
 				Configure(envoy_listeners.Tracing(ctx.Mesh.GetTracingBackend(proxy.Policies.TrafficTrace), sourceService)).
 				// backwards compatibility to support RateLimit for ExternalServices without ZoneEgress
 				ConfigureIf(!ctx.Mesh.Resource.ZoneEgressEnabled(), envoy_listeners.RateLimit(rateLimits)).
+				Configure(envoy_listeners.BandwidthLimit(proxy.Policies.BandwidthLimit.GetOutboundLimit())).
 				Configure(envoy_listeners.HttpAccessLog(
 					meshName,
 					envoy_common.TrafficDirectionOutbound,
@@ -136,7 +155,9 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 					proxy,
 				)).
 				Configure(envoy_listeners.HttpOutboundRoute(serviceName, routes, proxy.Dataplane.Spec.TagSet())).
-				Configure(envoy_listeners.Retry(retryPolicy, protocol))
+				Configure(envoy_listeners.HeaderModifier(proxy.Policies.HeaderModifier.GetOutboundModifications())).
+				Configure(envoy_listeners.Retry(retryPolicy, protocol)).
+				Configure(envoy_listeners.Wasm(wasmPlugins))
 		case core_mesh.ProtocolKafka:
 			filterChainBuilder.
 				Configure(envoy_listeners.Kafka(serviceName)).
@@ -172,12 +193,15 @@ func (OutboundProxyGenerator) generateLDS(ctx xds_context.Context, proxy *model.
 			Configure(envoy_listeners.Timeout(timeoutPolicyConf, protocol))
 		return filterChainBuilder
 	}()
-	listener, err := envoy_listeners.NewListenerBuilder(proxy.APIVersion).
+	listenerBuilder := envoy_listeners.NewListenerBuilder(proxy.APIVersion).
 		Configure(envoy_listeners.OutboundListener(outboundListenerName, oface.DataplaneIP, oface.DataplanePort, model.SocketAddressProtocolTCP)).
 		Configure(envoy_listeners.FilterChain(filterChainBuilder)).
 		Configure(envoy_listeners.TransparentProxying(proxy.Dataplane.Spec.Networking.GetTransparentProxying())).
-		Configure(envoy_listeners.TagsMetadata(envoy_common.Tags(outbound.GetTagsIncludingLegacy()).WithoutTags(mesh_proto.MeshTag))).
-		Build()
+		Configure(envoy_listeners.TagsMetadata(envoy_common.Tags(outbound.GetTagsIncludingLegacy()).WithoutTags(mesh_proto.MeshTag)))
+	if limit := proxy.Dataplane.GetEnvoyRuntime(ctx.Mesh.Resource).GetPerConnectionBufferLimitBytes(); limit != nil {
+		listenerBuilder.Configure(envoy_listeners.ConnectionBufferLimit(limit.GetValue()))
+	}
+	listener, err := listenerBuilder.Build()
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not generate listener %s for service %s", outboundListenerName, serviceName)
 	}
@@ -191,6 +215,9 @@ func (g OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 		service := services[serviceName]
 		healthCheck := proxy.Policies.HealthChecks[serviceName]
 		circuitBreaker := proxy.Policies.CircuitBreakers[serviceName]
+		retry := proxy.Policies.Retries[serviceName]
+		protocolOptions := proxy.Policies.ProtocolOptions[serviceName].GetConf()
+		zoneTrafficRoute := proxy.Policies.ZoneTrafficRoutes[serviceName].GetConf()
 		protocol := g.inferProtocol(proxy, service.Clusters())
 		tlsReady := service.TLSReady()
 
@@ -198,8 +225,11 @@ func (g OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 			edsClusterBuilder := envoy_clusters.NewClusterBuilder(proxy.APIVersion).
 				Configure(envoy_clusters.Timeout(cluster.Timeout(), protocol)).
 				Configure(envoy_clusters.CircuitBreaker(circuitBreaker)).
-				Configure(envoy_clusters.OutlierDetection(circuitBreaker)).
-				Configure(envoy_clusters.HealthCheck(protocol, healthCheck))
+				Configure(envoy_clusters.ConnectionPool(circuitBreaker)).
+				Configure(envoy_clusters.RetryBudget(retry)).
+				Configure(envoy_clusters.OutlierDetection(circuitBreaker, protocol)).
+				Configure(envoy_clusters.HealthCheck(protocol, healthCheck)).
+				Configure(envoy_clusters.ZoneTrafficRoute(zoneTrafficRoute))
 
 			clusterName := cluster.Name()
 			clusterTags := []envoy_common.Tags{cluster.Tags()}
@@ -221,7 +251,8 @@ func (g OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 
 					edsClusterBuilder.
 						Configure(envoy_clusters.ProvidedEndpointCluster(clusterName, isIPv6, endpoints...)).
-						Configure(envoy_clusters.ClientSideTLS(endpoints))
+						Configure(envoy_clusters.ClientSideTLS(endpoints)).
+						Configure(envoy_clusters.UpstreamProxyProtocol(endpoints))
 				}
 
 				switch protocol {
@@ -237,6 +268,13 @@ func (g OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 					Configure(envoy_clusters.LB(cluster.LB())).
 					Configure(envoy_clusters.Http2())
 
+				// with zone egress, the zone ingresses are health checked by the zone egress
+				if !ctx.Mesh.Resource.ZoneEgressEnabled() && reachesZoneIngress(proxy.Routing.OutboundTargets[serviceName], ctx.ControlPlane.Zone) {
+					edsClusterBuilder.Configure(envoy_clusters.ZoneIngressHealthCheck(
+						ctx.Mesh.Resource.Spec.GetRouting().GetZoneIngressHealthCheck(),
+					))
+				}
+
 				if upstreamMeshName := cluster.Mesh(); upstreamMeshName != "" {
 					for _, otherMesh := range append(ctx.Mesh.Resources.OtherMeshes().Items, ctx.Mesh.Resource) {
 						if otherMesh.GetMeta().GetName() == upstreamMeshName {
@@ -255,6 +293,10 @@ func (g OutboundProxyGenerator) generateCDS(ctx xds_context.Context, services en
 				}
 			}
 
+			// the HTTP version is selected only when the cluster connects to the external service directly
+			directExternalService := service.HasExternalService() && !ctx.Mesh.Resource.ZoneEgressEnabled()
+			edsClusterBuilder.Configure(envoy_clusters.ProtocolOptions(protocolOptions, protocol, directExternalService))
+
 			edsCluster, err := edsClusterBuilder.Build()
 			if err != nil {
 				return nil, errors.Wrapf(err, "build CDS for cluster %s failed", clusterName)
@@ -284,6 +326,7 @@ func (OutboundProxyGenerator) generateEDS(
 		// are specified in load assignment in DNS Cluster.
 		// We are not allowed to add endpoints with DNS names through EDS.
 		if !services[serviceName].HasExternalService() || ctx.Mesh.Resource.ZoneEgressEnabled() {
+			zoneTrafficRoute := proxy.Policies.ZoneTrafficRoutes[serviceName].GetConf()
 			for _, cluster := range services[serviceName].Clusters() {
 				var endpoints model.EndpointMap
 				if cluster.Mesh() != "" {
@@ -292,10 +335,16 @@ func (OutboundProxyGenerator) generateEDS(
 					endpoints = ctx.Mesh.EndpointMap
 				}
 
-				loadAssignment, err := ctx.ControlPlane.CLACache.GetCLA(context.Background(), ctx.Mesh.Resource.Meta.GetName(), ctx.Mesh.Hash, cluster, apiVersion, endpoints)
+				loadAssignment, err := ctx.ControlPlane.CLACache.GetCLA(context.Background(), ctx.Mesh.Resource, ctx.Mesh.Hash, cluster, apiVersion, endpoints)
 				if err != nil {
 					return nil, errors.Wrapf(err, "could not get ClusterLoadAssignment for %s", serviceName)
 				}
+				if zoneTrafficRoute != nil {
+					loadAssignment, err = envoy_endpoints.ZoneTrafficRoute(loadAssignment, zoneTrafficRoute, apiVersion)
+					if err != nil {
+						return nil, errors.Wrapf(err, "could not apply MeshZoneTrafficRoute to ClusterLoadAssignment for %s", serviceName)
+					}
+				}
 
 				resources.Add(&model.Resource{
 					Name:     cluster.Name(),
@@ -308,6 +357,16 @@ func (OutboundProxyGenerator) generateEDS(
 	return resources, nil
 }
 
+// reachesZoneIngress returns true when some of the endpoints are zone ingresses of other zones.
+func reachesZoneIngress(endpoints []model.Endpoint, zone string) bool {
+	for _, endpoint := range endpoints {
+		if endpointZone := endpoint.Tags[mesh_proto.ZoneTag]; !endpoint.IsExternalService() && endpointZone != "" && endpointZone != zone {
+			return true
+		}
+	}
+	return false
+}
+
 // inferProtocol infers protocol for the destination listener. It will only return HTTP when all endpoints are tagged with HTTP.
 func (OutboundProxyGenerator) inferProtocol(proxy *model.Proxy, clusters []envoy_common.Cluster) core_mesh.Protocol {
 	var allEndpoints []model.Endpoint
@@ -401,8 +460,26 @@ func (OutboundProxyGenerator) determineRoutes(
 		return clustersInternal, clustersExternal
 	}
 
+	mirrorFromHttp := func(mirror *mesh_proto.TrafficRoute_Http_Mirror) *envoy_common.Mirror {
+		clustersInternal, clustersExternal := clustersFromSplit(mirror.GetSplitWithDestination())
+		clusters := append(clustersInternal, clustersExternal...)
+		if len(clusters) == 0 {
+			return nil
+		}
+
+		percentage := 100.0
+		if mirror.GetPercentage() != nil {
+			percentage = mirror.GetPercentage().GetValue()
+		}
+
+		return &envoy_common.Mirror{
+			Cluster:    clusters[0],
+			Percentage: percentage,
+		}
+	}
+
 	appendRoute := func(routes envoy_common.Routes, match *mesh_proto.TrafficRoute_Http_Match, modify *mesh_proto.TrafficRoute_Http_Modify,
-		clusters []envoy_common.Cluster, rateLimit *core_mesh.RateLimitResource) envoy_common.Routes {
+		mirror *envoy_common.Mirror, clusters []envoy_common.Cluster, rateLimit *core_mesh.RateLimitResource) envoy_common.Routes {
 		if len(clusters) == 0 {
 			return routes
 		}
@@ -413,6 +490,7 @@ func (OutboundProxyGenerator) determineRoutes(
 				Match:    match,
 				Modify:   modify,
 				Clusters: clusters,
+				Mirror:   mirror,
 			})
 		} else {
 			var rlSpec *mesh_proto.RateLimit
@@ -424,20 +502,22 @@ func (OutboundProxyGenerator) determineRoutes(
 				Modify:    modify,
 				RateLimit: rlSpec,
 				Clusters:  clusters,
+				Mirror:    mirror,
 			})
 		}
 	}
 
 	for _, http := range route.Spec.GetConf().GetHttp() {
 		clustersInternal, clustersExternal := clustersFromSplit(http.GetSplitWithDestination())
-		routes = appendRoute(routes, http.Match, http.Modify, clustersInternal, nil)
-		routes = appendRoute(routes, http.Match, http.Modify, clustersExternal, proxy.Policies.RateLimitsOutbound[oface])
+		mirror := mirrorFromHttp(http.GetMirror())
+		routes = appendRoute(routes, http.Match, http.Modify, mirror, clustersInternal, nil)
+		routes = appendRoute(routes, http.Match, http.Modify, mirror, clustersExternal, proxy.Policies.RateLimitsOutbound[oface])
 	}
 
 	if defaultDestination := route.Spec.GetConf().GetSplitWithDestination(); len(defaultDestination) != 0 {
 		clustersInternal, clustersExternal := clustersFromSplit(defaultDestination)
-		routes = appendRoute(routes, nil, nil, clustersInternal, nil)
-		routes = appendRoute(routes, nil, nil, clustersExternal, proxy.Policies.RateLimitsOutbound[oface])
+		routes = appendRoute(routes, nil, nil, nil, clustersInternal, nil)
+		routes = appendRoute(routes, nil, nil, nil, clustersExternal, proxy.Policies.RateLimitsOutbound[oface])
 	}
 
 	return routes
@@ -101,6 +101,35 @@ var _ = Describe("OutboundProxyGenerator", func() {
 		},
 	}
 
+	zoneIngressHealthCheckCtx := xds_context.Context{
+		ControlPlane: &xds_context.ControlPlaneContext{
+			Secrets: &xds.TestSecrets{},
+			Zone:    "zone-1",
+		},
+		Mesh: xds_context.MeshContext{
+			Resource: &core_mesh.MeshResource{
+				Spec: &mesh_proto.Mesh{
+					Mtls: &mesh_proto.Mesh_Mtls{
+						EnabledBackend: "builtin",
+						Backends: []*mesh_proto.CertificateAuthorityBackend{
+							{
+								Name: "builtin",
+								Type: "builtin",
+							},
+						},
+					},
+					Routing: &mesh_proto.Routing{
+						ZoneIngressHealthCheck: &mesh_proto.Routing_ZoneIngressHealthCheck{
+							Interval: util_proto.Duration(2 * time.Second),
+						},
+					},
+					Logging: logging,
+				},
+				Meta: meta,
+			},
+		},
+	}
+
 	crossMeshCtx := xds_context.Context{
 		ControlPlane: &xds_context.ControlPlaneContext{
 			Secrets: &xds.TestSecrets{},
@@ -194,9 +223,11 @@ var _ = Describe("OutboundProxyGenerator", func() {
 	}
 
 	type testCase struct {
-		ctx       xds_context.Context
-		dataplane string
-		expected  string
+		ctx               xds_context.Context
+		dataplane         string
+		protocolOptions   model.ProtocolOptionsMap
+		zoneTrafficRoutes model.ZoneTrafficRouteMap
+		expected          string
 	}
 
 	DescribeTable("Generate Envoy xDS resources",
@@ -265,6 +296,14 @@ var _ = Describe("OutboundProxyGenerator", func() {
 						Weight: 1,
 					},
 				},
+				"api-http-shadow": []model.Endpoint{
+					{
+						Target: "192.168.0.10",
+						Port:   8092,
+						Tags:   map[string]string{"kuma.io/service": "api-http-shadow", "kuma.io/protocol": "http"},
+						Weight: 1,
+					},
+				},
 				"db": []model.Endpoint{
 					{
 						Target: "192.168.0.3",
@@ -279,6 +318,29 @@ var _ = Describe("OutboundProxyGenerator", func() {
 						Weight: 1,
 					},
 				},
+				"api-zones": []model.Endpoint{
+					{
+						Target:   "192.168.0.8",
+						Port:     8090,
+						Tags:     map[string]string{"kuma.io/service": "api-zones", "kuma.io/zone": "zone-1"},
+						Weight:   1,
+						Locality: &model.Locality{Zone: "zone-1", Priority: 0},
+					},
+					{
+						Target:   "10.1.0.1",
+						Port:     10001,
+						Tags:     map[string]string{"kuma.io/service": "api-zones", "kuma.io/zone": "zone-2"},
+						Weight:   2,
+						Locality: &model.Locality{Zone: "zone-2", Priority: 1},
+					},
+					{
+						Target:   "10.2.0.1",
+						Port:     10001,
+						Tags:     map[string]string{"kuma.io/service": "api-zones", "kuma.io/zone": "zone-3"},
+						Weight:   1,
+						Locality: &model.Locality{Zone: "zone-3", Priority: 1},
+					},
+				},
 				"es": []model.Endpoint{
 					{
 						Target:          "10.0.0.1",
@@ -383,6 +445,65 @@ var _ = Describe("OutboundProxyGenerator", func() {
 								},
 							},
 						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "127.0.0.1",
+							DataplanePort: 40005,
+						}: &core_mesh.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Destination: mesh_proto.MatchService("api-http"),
+									Http: []*mesh_proto.TrafficRoute_Http{{
+										Match: &mesh_proto.TrafficRoute_Http_Match{
+											Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+												MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix{
+													Prefix: "/offers",
+												},
+											},
+										},
+										Destination: mesh_proto.TagSelector{"kuma.io/service": "api-http", "region": "us"},
+										Mirror: &mesh_proto.TrafficRoute_Http_Mirror{
+											Destination: mesh_proto.TagSelector{"kuma.io/service": "api-http", "region": "eu"},
+											Percentage:  util_proto.Double(25),
+										},
+									}},
+								},
+							},
+						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "127.0.0.1",
+							DataplanePort: 40007,
+						}: &core_mesh.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Destination: mesh_proto.MatchService("api-http"),
+									Http: []*mesh_proto.TrafficRoute_Http{{
+										Match: &mesh_proto.TrafficRoute_Http_Match{
+											Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+												MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix{
+													Prefix: "/offers",
+												},
+											},
+										},
+										Destination: mesh_proto.MatchService("api-http"),
+										Mirror: &mesh_proto.TrafficRoute_Http_Mirror{
+											// notice that the dataplane has no outbound of the mirrored service
+											Destination: mesh_proto.MatchService("api-http-shadow"),
+											Percentage:  util_proto.Double(10),
+										},
+									}},
+								},
+							},
+						},
+						mesh_proto.OutboundInterface{
+							DataplaneIP:   "127.0.0.1",
+							DataplanePort: 40006,
+						}: &core_mesh.TrafficRouteResource{
+							Spec: &mesh_proto.TrafficRoute{
+								Conf: &mesh_proto.TrafficRoute_Conf{
+									Destination: mesh_proto.MatchService("api-zones"),
+								},
+							},
+						},
 						mesh_proto.OutboundInterface{
 							DataplaneIP:   "127.0.0.1",
 							DataplanePort: 18080,
@@ -534,6 +655,15 @@ var _ = Describe("OutboundProxyGenerator", func() {
 								},
 							},
 						},
+						"api-http-shadow": &core_mesh.CircuitBreakerResource{
+							Spec: &mesh_proto.CircuitBreaker{
+								Conf: &mesh_proto.CircuitBreaker_Conf{
+									Detectors: &mesh_proto.CircuitBreaker_Conf_Detectors{
+										GatewayErrors: &mesh_proto.CircuitBreaker_Conf_Detectors_Errors{},
+									},
+								},
+							},
+						},
 					},
 					Timeouts: map[mesh_proto.OutboundInterface]*core_mesh.TimeoutResource{
 						{DataplaneIP: "127.0.0.1", DataplanePort: 40002}: {Spec: timeout},
@@ -541,6 +671,8 @@ var _ = Describe("OutboundProxyGenerator", func() {
 						{DataplaneIP: "127.0.0.1", DataplanePort: 40004}: {Spec: timeout},
 						{DataplaneIP: "127.0.0.1", DataplanePort: 18082}: {Spec: timeout},
 					},
+					ProtocolOptions:   given.protocolOptions,
+					ZoneTrafficRoutes: given.zoneTrafficRoutes,
 				},
 				Metadata: &model.DataplaneMetadata{
 					Features: model.Features{"feature-tcp-accesslog-via-named-pipe": true},
@@ -556,6 +688,7 @@ var _ = Describe("OutboundProxyGenerator", func() {
 				"api-tcp":   true,
 				"api-http2": true,
 				"api-grpc":  true,
+				"api-zones": true,
 				"backend":   true,
 				"db":        true,
 				"es":        true,
@@ -724,6 +857,36 @@ var _ = Describe("OutboundProxyGenerator", func() {
 `,
 			expected: "08.envoy.golden.yaml",
 		}),
+		Entry("10. outbound with HTTP mirror", testCase{
+			ctx: plainCtx,
+			dataplane: `
+            networking:
+              address: 10.0.0.1
+              inbound:
+              - port: 8080
+                tags:
+                  kuma.io/service: web
+              outbound:
+              - port: 40005
+                service: api-http
+`,
+			expected: "10.envoy.golden.yaml",
+		}),
+		Entry("14. outbound with HTTP mirror to a service without outbound", testCase{
+			ctx: plainCtx,
+			dataplane: `
+            networking:
+              address: 10.0.0.1
+              inbound:
+              - port: 8080
+                tags:
+                  kuma.io/service: web
+              outbound:
+              - port: 40007
+                service: api-http
+`,
+			expected: "14.envoy.golden.yaml",
+		}),
 		Entry("cross-mesh", testCase{
 			ctx: crossMeshCtx,
 			dataplane: `
@@ -741,6 +904,97 @@ var _ = Describe("OutboundProxyGenerator", func() {
 `,
 			expected: "09.envoy.golden.yaml",
 		}),
+		Entry("11. protocol options", testCase{
+			ctx: mtlsCtx,
+			dataplane: `
+            networking:
+              address: 10.0.0.1
+              inbound:
+              - port: 8080
+                tags:
+                  kuma.io/service: web
+              outbound:
+              - port: 40001
+                service: api-http
+              - port: 18081
+                tags:
+                  kuma.io/service: es
+              transparentProxying:
+                redirectPortOutbound: 15001
+                redirectPortInbound: 15006
+`,
+			protocolOptions: model.ProtocolOptionsMap{
+				"api-http": &core_mesh.MeshProtocolOptionsResource{
+					Spec: &mesh_proto.MeshProtocolOptions{
+						Conf: &mesh_proto.MeshProtocolOptions_Conf{
+							IdleTimeout: util_proto.Duration(30 * time.Second),
+							Http2: &mesh_proto.MeshProtocolOptions_Conf_Http2{
+								MaxConcurrentStreams: util_proto.UInt32(128),
+							},
+						},
+					},
+				},
+				"es": &core_mesh.MeshProtocolOptionsResource{
+					Spec: &mesh_proto.MeshProtocolOptions{
+						Conf: &mesh_proto.MeshProtocolOptions_Conf{
+							UpstreamProtocol:         mesh_proto.MeshProtocolOptions_Conf_HTTP2,
+							MaxRequestsPerConnection: util_proto.UInt32(100),
+						},
+					},
+				},
+			},
+			expected: "11.envoy.golden.yaml",
+		}),
+		Entry("12. zone traffic route", testCase{
+			ctx: mtlsCtx,
+			dataplane: `
+            networking:
+              address: 10.0.0.1
+              inbound:
+              - port: 8080
+                tags:
+                  kuma.io/service: web
+              outbound:
+              - port: 40006
+                service: api-zones
+              transparentProxying:
+                redirectPortOutbound: 15001
+                redirectPortInbound: 15006
+`,
+			zoneTrafficRoutes: model.ZoneTrafficRouteMap{
+				"api-zones": &core_mesh.MeshZoneTrafficRouteResource{
+					Spec: &mesh_proto.MeshZoneTrafficRoute{
+						Conf: &mesh_proto.MeshZoneTrafficRoute_Conf{
+							Zones: []*mesh_proto.MeshZoneTrafficRoute_Conf_Zone{
+								{Name: "zone-2", Priority: 0, Weight: util_proto.UInt32(80)},
+								{Name: "zone-1", Priority: 0, Weight: util_proto.UInt32(20)},
+							},
+						},
+					},
+				},
+			},
+			expected: "12.envoy.golden.yaml",
+		}),
+		Entry("13. zone ingress health check", testCase{
+			ctx: zoneIngressHealthCheckCtx,
+			dataplane: `
+            networking:
+              address: 10.0.0.1
+              inbound:
+              - port: 8080
+                tags:
+                  kuma.io/service: web
+              outbound:
+              - port: 40006
+                service: api-zones
+              - port: 18080
+                service: backend
+              transparentProxying:
+                redirectPortOutbound: 15001
+                redirectPortInbound: 15006
+`,
+			expected: "13.envoy.golden.yaml",
+		}),
 	)
 
 	It("Add sanitized alternative cluster name for stats", func() {
@@ -34,6 +34,7 @@ func (g *ProxyTemplateGenerator) Generate(ctx xds_context.Context, proxy *model.
 	} else {
 		resources.AddSet(rs)
 	}
+	applyClusterStatNames(ctx, resources)
 	return resources, nil
 }
 
@@ -82,6 +83,10 @@ func NewDefaultProxyProfile() core.ResourceGenerator {
 		OutboundProxyGenerator{},
 		DirectAccessProxyGenerator{},
 		TracingProxyGenerator{},
+		WasmPluginGenerator{},
+		ExternalAuthzGenerator{},
+		JWTGenerator{},
+		RateLimitServiceGenerator{},
 		ProbeProxyGenerator{},
 		DNSGenerator{},
 		generator_secrets.Generator{},
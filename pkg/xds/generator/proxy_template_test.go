@@ -106,6 +106,7 @@ var _ = Describe("ProxyTemplateGenerator", func() {
 		type testCase struct {
 			dataplane         string
 			proxyTemplateFile string
+			metrics           *mesh_proto.Metrics
 			expected          string
 		}
 
@@ -140,6 +141,7 @@ var _ = Describe("ProxyTemplateGenerator", func() {
 										},
 									},
 								},
+								Metrics: given.metrics,
 							},
 						},
 					},
@@ -209,6 +211,30 @@ var _ = Describe("ProxyTemplateGenerator", func() {
 				proxyTemplateFile: "2-proxy-template.input.yaml",
 				expected:          "2-envoy-config.golden.yaml",
 			}),
+			Entry("should override stat names of clusters from the mesh", testCase{
+				dataplane: `
+                networking:
+                  transparentProxying:
+                    redirectPortOutbound: 15001
+                    redirectPortInbound: 15006
+                  address: 192.168.0.1
+                  inbound:
+                    - port: 80
+                      servicePort: 8080
+                      tags:
+                        kuma.io/service: backend
+`,
+				proxyTemplateFile: "1-proxy-template.input.yaml",
+				metrics: &mesh_proto.Metrics{
+					Stats: &mesh_proto.Metrics_Stats{
+						ClusterStatNames: map[string]string{
+							"localhost:8080":            "backend_local",
+							"outbound:passthrough:ipv4": "passthrough",
+						},
+					},
+				},
+				expected: "3-envoy-config.golden.yaml",
+			}),
 		)
 
 	})
@@ -0,0 +1,73 @@
+package generator
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+	"github.com/kumahq/kuma/pkg/xds/generator/core"
+)
+
+// OriginRateLimitService is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginRateLimitService = "rate-limit-service"
+
+// RateLimitServiceGenerator generates clusters of the rate limit services used by the global RateLimit policies.
+type RateLimitServiceGenerator struct {
+}
+
+var _ core.ResourceGenerator = RateLimitServiceGenerator{}
+
+func (g RateLimitServiceGenerator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+	for _, address := range g.addresses(ctx, proxy) {
+		clusterName := names.GetRateLimitServiceClusterName(address)
+		endpoint, err := endpointForAddress(address)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid address of the rate limit service")
+		}
+		res, err := clusters.NewClusterBuilder(proxy.APIVersion).
+			Configure(clusters.ProvidedEndpointCluster(clusterName, proxy.Dataplane.IsIPv6(), *endpoint)).
+			Configure(clusters.Http2()).
+			Configure(clusters.DefaultTimeout()).
+			Build()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate cluster %s", clusterName)
+		}
+		resources.Add(&core_xds.Resource{Name: clusterName, Origin: OriginRateLimitService, Resource: res})
+	}
+	return resources, nil
+}
+
+// addresses returns sorted addresses of the rate limit services referenced by the rate limits applied on the proxy.
+func (g RateLimitServiceGenerator) addresses(ctx xds_context.Context, proxy *core_xds.Proxy) []string {
+	var rateLimits []*core_mesh.RateLimitResource
+	for _, inboundRateLimits := range proxy.Policies.RateLimitsInbound {
+		rateLimits = append(rateLimits, inboundRateLimits...)
+	}
+	// rate limits of external services are applied on ZoneEgress when it is enabled
+	if !ctx.Mesh.Resource.ZoneEgressEnabled() {
+		for _, outboundRateLimit := range proxy.Policies.RateLimitsOutbound {
+			rateLimits = append(rateLimits, outboundRateLimit)
+		}
+	}
+
+	seen := map[string]bool{}
+	var addresses []string
+	for _, rateLimit := range rateLimits {
+		global := rateLimit.Spec.GetConf().GetGlobal()
+		if global == nil {
+			continue
+		}
+		if !seen[global.GetAddress()] {
+			seen[global.GetAddress()] = true
+			addresses = append(addresses, global.GetAddress())
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
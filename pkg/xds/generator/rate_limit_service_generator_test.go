@@ -0,0 +1,111 @@
+package generator_test
+
+import (
+	"fmt"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	. "github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+)
+
+var _ = Describe("RateLimitServiceGenerator", func() {
+
+	rateLimit := func(conf string) *core_mesh.RateLimitResource {
+		rl := core_mesh.NewRateLimitResource()
+		rl.Spec.Conf = &mesh_proto.RateLimit_Conf{}
+		Expect(util_proto.FromYAML([]byte(conf), rl.Spec.Conf)).To(Succeed())
+		return rl
+	}
+
+	proxy := func(policies core_xds.MatchedPolicies) *core_xds.Proxy {
+		return &core_xds.Proxy{
+			Id: *core_xds.BuildProxyId("", "demo.backend-01"),
+			Dataplane: &core_mesh.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "backend-01",
+					Mesh: "demo",
+				},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+					},
+				},
+			},
+			APIVersion: envoy_common.APIV3,
+			Policies:   policies,
+		}
+	}
+
+	It("should not generate clusters without global rate limits", func() {
+		// given
+		gen := &generator.RateLimitServiceGenerator{}
+		policies := core_xds.MatchedPolicies{
+			RateLimitsInbound: core_xds.InboundRateLimitsMap{
+				mesh_proto.InboundInterface{WorkloadPort: 8080}: {
+					rateLimit(`
+                    http:
+                      requests: 10
+                      interval: 1s`),
+				},
+			},
+		}
+
+		// when
+		rs, err := gen.Generate(xds_context.Context{}, proxy(policies))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rs.Empty()).To(BeTrue())
+	})
+
+	It("should generate clusters of the rate limit services", func() {
+		// given
+		gen := &generator.RateLimitServiceGenerator{}
+		global := `
+        global:
+          address: %s
+          domain: kuma
+          descriptors:
+          - entries:
+            - key: destination
+              value: backend`
+		policies := core_xds.MatchedPolicies{
+			RateLimitsInbound: core_xds.InboundRateLimitsMap{
+				mesh_proto.InboundInterface{WorkloadPort: 8080}: {
+					rateLimit(fmt.Sprintf(global, "ratelimit.mesh:8081")),
+					rateLimit(fmt.Sprintf(global, "10.0.0.1:8081")),
+				},
+				mesh_proto.InboundInterface{WorkloadPort: 8081}: {
+					rateLimit(fmt.Sprintf(global, "ratelimit.mesh:8081")),
+				},
+			},
+			RateLimitsOutbound: core_xds.OutboundRateLimitsMap{
+				mesh_proto.OutboundInterface{DataplanePort: 10001}: rateLimit(fmt.Sprintf(global, "ratelimit.external:8081")),
+			},
+		}
+
+		// when
+		rs, err := gen.Generate(xds_context.Context{}, proxy(policies))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		resp, err := rs.List().ToDeltaDiscoveryResponse()
+		Expect(err).ToNot(HaveOccurred())
+		actual, err := util_proto.ToYAML(resp)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and output matches golden files
+		Expect(actual).To(MatchGoldenYAML(filepath.Join("testdata", "rate-limit-service", "clusters.golden.yaml")))
+	})
+})
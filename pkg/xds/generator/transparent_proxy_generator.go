@@ -1,8 +1,11 @@
 package generator
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	model "github.com/kumahq/kuma/pkg/core/xds"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
@@ -13,15 +16,17 @@ import (
 
 // OriginTransparent is a marker to indicate by which ProxyGenerator resources were generated.
 const (
-	OriginTransparent = "transparent"
-	outboundNameIPv4  = "outbound:passthrough:ipv4"
-	outboundNameIPv6  = "outbound:passthrough:ipv6"
-	inboundNameIPv4   = "inbound:passthrough:ipv4"
-	inboundNameIPv6   = "inbound:passthrough:ipv6"
-	allIPv4           = "0.0.0.0"
-	allIPv6           = "::"
-	inPassThroughIPv4 = "127.0.0.6"
-	inPassThroughIPv6 = "::6"
+	OriginTransparent           = "transparent"
+	outboundNameIPv4            = "outbound:passthrough:ipv4"
+	outboundNameIPv6            = "outbound:passthrough:ipv6"
+	dynamicForwardProxyNameIPv4 = "outbound:dynamic_forward_proxy:ipv4"
+	dynamicForwardProxyNameIPv6 = "outbound:dynamic_forward_proxy:ipv6"
+	inboundNameIPv4             = "inbound:passthrough:ipv4"
+	inboundNameIPv6             = "inbound:passthrough:ipv6"
+	allIPv4                     = "0.0.0.0"
+	allIPv6                     = "::"
+	inPassThroughIPv4           = "127.0.0.6"
+	inPassThroughIPv6           = "::6"
 )
 
 type TransparentProxyGenerator struct {
@@ -35,7 +40,7 @@ func (tpg TransparentProxyGenerator) Generate(ctx xds_context.Context, proxy *mo
 	}
 
 	redirectPortInbound := proxy.Dataplane.Spec.Networking.GetTransparentProxying().GetRedirectPortInbound()
-	resourcesIPv4, err := tpg.generate(ctx, proxy, outboundNameIPv4, inboundNameIPv4, allIPv4, inPassThroughIPv4, redirectPortOutbound, redirectPortInbound)
+	resourcesIPv4, err := tpg.generate(ctx, proxy, outboundNameIPv4, inboundNameIPv4, dynamicForwardProxyNameIPv4, allIPv4, inPassThroughIPv4, redirectPortOutbound, redirectPortInbound)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +48,7 @@ func (tpg TransparentProxyGenerator) Generate(ctx xds_context.Context, proxy *mo
 
 	redirectPortInboundV6 := proxy.Dataplane.Spec.Networking.GetTransparentProxying().GetRedirectPortInboundV6()
 	if redirectPortInboundV6 != 0 {
-		resourcesIPv6, err := tpg.generate(ctx, proxy, outboundNameIPv6, inboundNameIPv6, allIPv6, inPassThroughIPv6, redirectPortOutbound, redirectPortInboundV6)
+		resourcesIPv6, err := tpg.generate(ctx, proxy, outboundNameIPv6, inboundNameIPv6, dynamicForwardProxyNameIPv6, allIPv6, inPassThroughIPv6, redirectPortOutbound, redirectPortInboundV6)
 		if err != nil {
 			return nil, err
 		}
@@ -54,18 +59,22 @@ func (tpg TransparentProxyGenerator) Generate(ctx xds_context.Context, proxy *mo
 }
 
 func (_ TransparentProxyGenerator) generate(ctx xds_context.Context, proxy *model.Proxy,
-	outboundName, inboundName, allIP, inPassThroughIP string,
+	outboundName, inboundName, dynamicForwardProxyName, allIP, inPassThroughIP string,
 	redirectPortOutbound, redirectPortInbound uint32) (*model.ResourceSet, error) {
 	resources := model.NewResourceSet()
 
 	sourceService := proxy.Dataplane.Spec.GetIdentifyingService()
 	meshName := ctx.Mesh.Resource.GetMeta().GetName()
+	dynamicForwardProxy := ctx.Mesh.Resource.Spec.GetNetworking().GetOutbound().GetDynamicForwardProxy()
+	hasIPv6 := allIP == allIPv6
 
 	var outboundPassThroughCluster envoy_common.NamedResource
+	var dynamicForwardProxyCluster envoy_common.NamedResource
 	var outboundListener envoy_common.NamedResource
 	var err error
 
-	if ctx.Mesh.Resource.Spec.IsPassthrough() {
+	passThrough := ctx.Mesh.Resource.Spec.IsPassthroughFor(proxy.Dataplane.Spec)
+	if passThrough {
 		outboundPassThroughCluster, err = envoy_clusters.NewClusterBuilder(proxy.APIVersion).
 			Configure(envoy_clusters.PassThroughCluster(outboundName)).
 			Configure(envoy_clusters.DefaultTimeout()).
@@ -75,20 +84,60 @@ func (_ TransparentProxyGenerator) generate(ctx xds_context.Context, proxy *mode
 		}
 	}
 
-	outboundListener, err = envoy_listeners.NewListenerBuilder(proxy.APIVersion).
+	if ctx.Mesh.Resource.Spec.IsDynamicForwardProxyEnabled() {
+		dynamicForwardProxyCluster, err = envoy_clusters.NewClusterBuilder(proxy.APIVersion).
+			Configure(envoy_clusters.DynamicForwardProxyCluster(dynamicForwardProxyName, hasIPv6, dynamicForwardProxy)).
+			Configure(envoy_clusters.DefaultTimeout()).
+			Build()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not generate outbound cluster: %s", dynamicForwardProxyName)
+		}
+	}
+
+	accessLog := envoy_listeners.NetworkAccessLog(
+		meshName,
+		envoy_common.TrafficDirectionUnspecified,
+		sourceService,
+		"external",
+		ctx.Mesh.GetLoggingBackend(proxy.Policies.TrafficLogs[core_mesh.PassThroughService]),
+		proxy,
+	)
+	// the traffic to every single allowed port is passed through by its own filter chain to have separate stats,
+	// the default filter chain passes through only the traffic to the allowed port ranges
+	var passThroughPortRanges []*mesh_proto.Networking_Outbound_PortRange
+	var passThroughPorts []uint32
+	if passThrough {
+		passThroughPortRanges, passThroughPorts = splitPassThroughPorts(ctx.Mesh.Resource.Spec.GetNetworking().GetOutbound().GetPassthroughPorts())
+	}
+	restrictPassThroughPorts := len(passThroughPortRanges) > 0 || len(passThroughPorts) > 0
+
+	outboundListenerBuilder := envoy_listeners.NewListenerBuilder(proxy.APIVersion).
 		Configure(envoy_listeners.OutboundListener(outboundName, allIP, redirectPortOutbound, model.SocketAddressProtocolTCP)).
 		Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
 			Configure(envoy_listeners.TcpProxy(outboundName, envoy_common.NewCluster(envoy_common.WithService(outboundName)))).
-			Configure(envoy_listeners.NetworkAccessLog(
-				meshName,
-				envoy_common.TrafficDirectionUnspecified,
-				sourceService,
-				"external",
-				ctx.Mesh.GetLoggingBackend(proxy.Policies.TrafficLogs[core_mesh.PassThroughService]),
-				proxy,
-			)))).
-		Configure(envoy_listeners.OriginalDstForwarder()).
-		Build()
+			Configure(accessLog).
+			ConfigureIf(restrictPassThroughPorts, envoy_listeners.DestinationPortsRBAC(outboundName, passThroughPortRanges)))).
+		Configure(envoy_listeners.OriginalDstForwarder())
+	for _, port := range passThroughPorts {
+		outboundListenerBuilder = outboundListenerBuilder.
+			Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
+				Configure(envoy_listeners.MatchDestinationPort(port)).
+				Configure(envoy_listeners.TcpProxy(fmt.Sprintf("%s:%d", outboundName, port), envoy_common.NewCluster(envoy_common.WithService(outboundName)))).
+				Configure(accessLog)))
+	}
+	if ctx.Mesh.Resource.Spec.IsDynamicForwardProxyEnabled() {
+		// TLS connections to the allowed domains are matched by SNI before they fall back to the passthrough filter chain.
+		// Envoy matches the destination port before SNI, so the filter chains of the allowed ports need their own copy.
+		outboundListenerBuilder = outboundListenerBuilder.
+			Configure(envoy_listeners.TLSInspector()).
+			Configure(envoy_listeners.FilterChain(dynamicForwardProxyFilterChain(proxy, dynamicForwardProxyName, hasIPv6, dynamicForwardProxy, accessLog)))
+		for _, port := range passThroughPorts {
+			outboundListenerBuilder = outboundListenerBuilder.
+				Configure(envoy_listeners.FilterChain(dynamicForwardProxyFilterChain(proxy, dynamicForwardProxyName, hasIPv6, dynamicForwardProxy, accessLog).
+					Configure(envoy_listeners.MatchDestinationPort(port))))
+		}
+	}
+	outboundListener, err = outboundListenerBuilder.Build()
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not generate listener: %s", outboundName)
 	}
@@ -118,13 +167,20 @@ func (_ TransparentProxyGenerator) generate(ctx xds_context.Context, proxy *mode
 		Resource: outboundListener,
 	})
 
-	if ctx.Mesh.Resource.Spec.IsPassthrough() {
+	if passThrough {
 		resources.Add(&model.Resource{
 			Name:     outboundPassThroughCluster.GetName(),
 			Origin:   OriginTransparent,
 			Resource: outboundPassThroughCluster,
 		})
 	}
+	if ctx.Mesh.Resource.Spec.IsDynamicForwardProxyEnabled() {
+		resources.Add(&model.Resource{
+			Name:     dynamicForwardProxyCluster.GetName(),
+			Origin:   OriginTransparent,
+			Resource: dynamicForwardProxyCluster,
+		})
+	}
 	resources.Add(&model.Resource{
 		Name:     inboundListener.GetName(),
 		Origin:   OriginTransparent,
@@ -137,3 +193,31 @@ func (_ TransparentProxyGenerator) generate(ctx xds_context.Context, proxy *mode
 	})
 	return resources, nil
 }
+
+func dynamicForwardProxyFilterChain(proxy *model.Proxy, name string, hasIPv6 bool,
+	conf *mesh_proto.Networking_Outbound_DynamicForwardProxy, accessLog envoy_listeners.FilterChainBuilderOpt) *envoy_listeners.FilterChainBuilder {
+	return envoy_listeners.NewFilterChainBuilder(proxy.APIVersion).
+		Configure(envoy_listeners.MatchTransportProtocol("tls")).
+		Configure(envoy_listeners.MatchServerNames(conf.GetDomains()...)).
+		Configure(envoy_listeners.SNIDynamicForwardProxy(name, hasIPv6, conf)).
+		Configure(envoy_listeners.TcpProxy(name, envoy_common.NewCluster(envoy_common.WithService(name)))).
+		Configure(accessLog)
+}
+
+// splitPassThroughPorts returns the ranges of many ports and the unique single ports.
+func splitPassThroughPorts(portRanges []*mesh_proto.Networking_Outbound_PortRange) ([]*mesh_proto.Networking_Outbound_PortRange, []uint32) {
+	var ranges []*mesh_proto.Networking_Outbound_PortRange
+	var ports []uint32
+	seen := map[uint32]bool{}
+	for _, portRange := range portRanges {
+		if !portRange.IsSinglePort() {
+			ranges = append(ranges, portRange)
+			continue
+		}
+		if !seen[portRange.GetFrom()] {
+			seen[portRange.GetFrom()] = true
+			ports = append(ports, portRange.GetFrom())
+		}
+	}
+	return ranges, ports
+}
@@ -2,6 +2,7 @@ package generator_test
 
 import (
 	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -20,8 +21,9 @@ import (
 var _ = Describe("TransparentProxyGenerator", func() {
 
 	type testCase struct {
-		proxy    *model.Proxy
-		expected string
+		proxy      *model.Proxy
+		networking *mesh_proto.Networking
+		expected   string
 	}
 
 	DescribeTable("Generate Envoy xDS resources",
@@ -46,6 +48,7 @@ var _ = Describe("TransparentProxyGenerator", func() {
 									},
 								},
 							},
+							Networking: given.networking,
 						},
 					},
 				},
@@ -165,5 +168,97 @@ var _ = Describe("TransparentProxyGenerator", func() {
 			},
 			expected: "04.envoy.golden.yaml",
 		}),
+		Entry("transparent_proxying=true with dynamic forward proxy", testCase{
+			proxy: &model.Proxy{
+				Id: *model.BuildProxyId("", "side-car"),
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Version: "v1",
+					},
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							TransparentProxying: &mesh_proto.Dataplane_Networking_TransparentProxying{
+								RedirectPortOutbound:  15001,
+								RedirectPortInbound:   15006,
+								RedirectPortInboundV6: 15010,
+							},
+						},
+					},
+				},
+				APIVersion: envoy_common.APIV3,
+			},
+			networking: &mesh_proto.Networking{
+				Outbound: &mesh_proto.Networking_Outbound{
+					Passthrough: util_proto.Bool(false),
+					DynamicForwardProxy: &mesh_proto.Networking_Outbound_DynamicForwardProxy{
+						Domains:  []string{"*.s3.amazonaws.com", "api.github.com"},
+						HostTtl:  util_proto.Duration(time.Minute),
+						MaxHosts: util_proto.UInt32(100),
+					},
+				},
+			},
+			expected: "05.envoy.golden.yaml",
+		}),
+		Entry("transparent_proxying=true with passthrough ports", testCase{
+			proxy: &model.Proxy{
+				Id: *model.BuildProxyId("", "side-car"),
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Version: "v1",
+					},
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							TransparentProxying: &mesh_proto.Dataplane_Networking_TransparentProxying{
+								RedirectPortOutbound: 15001,
+								RedirectPortInbound:  15006,
+							},
+						},
+					},
+				},
+				APIVersion: envoy_common.APIV3,
+			},
+			networking: &mesh_proto.Networking{
+				Outbound: &mesh_proto.Networking_Outbound{
+					PassthroughPorts: []*mesh_proto.Networking_Outbound_PortRange{
+						{From: 443},
+						{From: 8000, To: 8999},
+						{From: 5432, To: 5432},
+						{From: 443},
+					},
+					DynamicForwardProxy: &mesh_proto.Networking_Outbound_DynamicForwardProxy{
+						Domains: []string{"api.github.com"},
+					},
+				},
+			},
+			expected: "06.envoy.golden.yaml",
+		}),
+		Entry("transparent_proxying=true with passthrough disabled by dataplane", testCase{
+			proxy: &model.Proxy{
+				Id: *model.BuildProxyId("", "side-car"),
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Version: "v1",
+					},
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							TransparentProxying: &mesh_proto.Dataplane_Networking_TransparentProxying{
+								RedirectPortOutbound: 15001,
+								RedirectPortInbound:  15006,
+								DisablePassthrough:   true,
+							},
+						},
+					},
+				},
+				APIVersion: envoy_common.APIV3,
+			},
+			networking: &mesh_proto.Networking{
+				Outbound: &mesh_proto.Networking_Outbound{
+					PassthroughPorts: []*mesh_proto.Networking_Outbound_PortRange{
+						{From: 443},
+					},
+				},
+			},
+			expected: "07.envoy.golden.yaml",
+		}),
 	)
 })
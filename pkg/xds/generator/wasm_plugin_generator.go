@@ -0,0 +1,115 @@
+package generator
+
+import (
+	"context"
+	"net"
+	net_url "net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	"github.com/kumahq/kuma/pkg/xds/envoy/clusters"
+	listeners_v3 "github.com/kumahq/kuma/pkg/xds/envoy/listeners/v3"
+	"github.com/kumahq/kuma/pkg/xds/envoy/names"
+	"github.com/kumahq/kuma/pkg/xds/generator/core"
+)
+
+// OriginWasm is a marker to indicate by which ProxyGenerator resources were generated.
+const OriginWasm = "wasm"
+
+// WasmPluginGenerator generates clusters used by Envoy to fetch remote modules of the MeshWasmPlugin policy.
+type WasmPluginGenerator struct {
+}
+
+var _ core.ResourceGenerator = WasmPluginGenerator{}
+
+func (g WasmPluginGenerator) Generate(ctx xds_context.Context, proxy *core_xds.Proxy) (*core_xds.ResourceSet, error) {
+	resources := core_xds.NewResourceSet()
+	for _, plugins := range [][]*mesh_proto.MeshWasmPlugin_Conf_Plugin{
+		proxy.Policies.WasmPlugin.GetInboundPlugins(),
+		proxy.Policies.WasmPlugin.GetOutboundPlugins(),
+	} {
+		for _, plugin := range plugins {
+			remote := plugin.GetModule().GetRemote()
+			if remote == nil {
+				continue
+			}
+			url, err := net_url.ParseRequestURI(remote.GetUrl())
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid URL of the module of WASM plugin %q", plugin.GetName())
+			}
+			clusterName := names.GetWasmClusterName(url.Host)
+			endpoint, err := endpointForURL(url)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not generate cluster %s", clusterName)
+			}
+			res, err := clusters.NewClusterBuilder(proxy.APIVersion).
+				Configure(clusters.ProvidedEndpointCluster(clusterName, proxy.Dataplane.IsIPv6(), *endpoint)).
+				Configure(clusters.ClientSideTLS([]core_xds.Endpoint{*endpoint})).
+				Configure(clusters.DefaultTimeout()).
+				Build()
+			if err != nil {
+				return nil, err
+			}
+			resources.Add(&core_xds.Resource{Name: clusterName, Origin: OriginWasm, Resource: res})
+		}
+	}
+	return resources, nil
+}
+
+// endpointForURL returns the endpoint of an HTTP(S) server, TLS is enabled for the https scheme.
+func endpointForURL(url *net_url.URL) (*core_xds.Endpoint, error) {
+	port := uint64(80)
+	if url.Scheme == "https" {
+		port = 443
+	}
+	if url.Port() != "" {
+		var err error
+		if port, err = strconv.ParseUint(url.Port(), 10, 16); err != nil {
+			return nil, errors.Wrap(err, "invalid port of the URL")
+		}
+	}
+	return &core_xds.Endpoint{
+		Target: url.Hostname(),
+		Port:   uint32(port),
+		ExternalService: &core_xds.ExternalService{
+			TLSEnabled: url.Scheme == "https",
+		},
+	}, nil
+}
+
+// endpointForAddress returns the endpoint of a server given in the host:port format.
+func endpointForAddress(address string) (*core_xds.Endpoint, error) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid address")
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid port of the address")
+	}
+	return &core_xds.Endpoint{
+		Target: host,
+		Port:   uint32(port),
+	}, nil
+}
+
+// loadWasmPlugins loads the code of the modules provided by the control plane.
+func loadWasmPlugins(ctx xds_context.Context, plugins []*mesh_proto.MeshWasmPlugin_Conf_Plugin) ([]listeners_v3.WasmPlugin, error) {
+	var loaded []listeners_v3.WasmPlugin
+	for _, plugin := range plugins {
+		wasmPlugin := listeners_v3.WasmPlugin{Plugin: plugin}
+		if local := plugin.GetModule().GetLocal(); local != nil {
+			code, err := ctx.Mesh.DataSourceLoader.Load(context.Background(), ctx.Mesh.Resource.GetMeta().GetName(), local)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not load the module of WASM plugin %q", plugin.GetName())
+			}
+			wasmPlugin.Code = code
+		}
+		loaded = append(loaded, wasmPlugin)
+	}
+	return loaded, nil
+}
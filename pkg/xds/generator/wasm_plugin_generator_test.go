@@ -0,0 +1,98 @@
+package generator_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	. "github.com/kumahq/kuma/pkg/test/matchers"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/generator"
+)
+
+var _ = Describe("WasmPluginGenerator", func() {
+
+	proxy := func(conf string) *core_xds.Proxy {
+		wasmPlugin := core_mesh.NewMeshWasmPluginResource()
+		wasmPlugin.Spec.Conf = &mesh_proto.MeshWasmPlugin_Conf{}
+		Expect(util_proto.FromYAML([]byte(conf), wasmPlugin.Spec.Conf)).To(Succeed())
+		return &core_xds.Proxy{
+			Id: *core_xds.BuildProxyId("", "demo.backend-01"),
+			Dataplane: &core_mesh.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "backend-01",
+					Mesh: "demo",
+				},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+					},
+				},
+			},
+			APIVersion: envoy_common.APIV3,
+			Policies: core_xds.MatchedPolicies{
+				WasmPlugin: wasmPlugin,
+			},
+		}
+	}
+
+	It("should not generate clusters for local modules", func() {
+		// given
+		gen := &generator.WasmPluginGenerator{}
+
+		// when
+		rs, err := gen.Generate(xds_context.Context{}, proxy(`
+        inbound:
+        - name: auth
+          module:
+            local:
+              secret: auth-wasm`))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rs.Empty()).To(BeTrue())
+	})
+
+	It("should generate clusters for remote modules", func() {
+		// given
+		gen := &generator.WasmPluginGenerator{}
+
+		// when
+		rs, err := gen.Generate(xds_context.Context{}, proxy(`
+        inbound:
+        - name: stats
+          module:
+            remote:
+              url: https://registry.example.com/v2/plugins/stats/blobs/sha256:5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b
+              sha256: 5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b
+        outbound:
+        - name: stats
+          module:
+            remote:
+              url: https://registry.example.com/v2/plugins/stats/blobs/sha256:5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b
+              sha256: 5e8bd7ae3b2b54e2bf2b632d1a21c0d7a6fba25c8ad8fd9479b8d09f1dd0ef0b
+        - name: audit
+          module:
+            remote:
+              url: http://plugins.internal:8080/audit.wasm
+              sha256: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08`))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		resp, err := rs.List().ToDeltaDiscoveryResponse()
+		Expect(err).ToNot(HaveOccurred())
+		actual, err := util_proto.ToYAML(resp)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and output matches golden files
+		Expect(actual).To(MatchGoldenYAML(filepath.Join("testdata", "wasm", "remote-modules.golden.yaml")))
+	})
+})
@@ -7,18 +7,25 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/datasource"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/tls"
 )
 
+// RevocationListValidity is a period for which revocation lists of CAs distributed to dataplanes are valid.
+const RevocationListValidity = 24 * time.Hour
+
 type CaProvider interface {
 	// Get returns all PEM encoded CAs, a list of CAs that were used to generate a secret and an error.
 	Get(context.Context, *core_mesh.MeshResource) (*core_xds.CaSecret, []string, error)
 }
 
-func NewCaProvider(caManagers core_ca.Managers, metrics core_metrics.Metrics) (CaProvider, error) {
+func NewCaProvider(caManagers core_ca.Managers, dataSourceLoader datasource.Loader, metrics core_metrics.Metrics) (CaProvider, error) {
 	latencyMetrics := prometheus.NewSummaryVec(prometheus.SummaryOpts{
 		Name:       "ca_manager_get_root_cert_chain",
 		Help:       "Summary of CA manager get CA root certificate chain latencies",
@@ -28,14 +35,16 @@ func NewCaProvider(caManagers core_ca.Managers, metrics core_metrics.Metrics) (C
 		return nil, err
 	}
 	return &meshCaProvider{
-		caManagers:     caManagers,
-		latencyMetrics: latencyMetrics,
+		caManagers:       caManagers,
+		dataSourceLoader: dataSourceLoader,
+		latencyMetrics:   latencyMetrics,
 	}, nil
 }
 
 type meshCaProvider struct {
-	caManagers     core_ca.Managers
-	latencyMetrics *prometheus.SummaryVec
+	caManagers       core_ca.Managers
+	dataSourceLoader datasource.Loader
+	latencyMetrics   *prometheus.SummaryVec
 }
 
 func (s *meshCaProvider) Get(ctx context.Context, mesh *core_mesh.MeshResource) (*core_xds.CaSecret, []string, error) {
@@ -43,7 +52,94 @@ func (s *meshCaProvider) Get(ctx context.Context, mesh *core_mesh.MeshResource)
 	if backend == nil {
 		return nil, nil, errors.New("CA backend is nil")
 	}
+	backends := []*mesh_proto.CertificateAuthorityBackend{backend}
+	// during the rotation dataplanes trust CAs of both backends, so certificates issued by any of them are accepted
+	if rotation := mesh.Spec.GetMtls().GetRotation(); rotation != nil {
+		for _, name := range []string{rotation.GetFrom(), rotation.GetTo()} {
+			if other := mesh.GetCertificateAuthorityBackend(name); other != nil && name != backend.Name {
+				backends = append(backends, other)
+			}
+		}
+	}
+
+	var certs [][]byte
+	var names []string
+	for _, backend := range backends {
+		backendCerts, err := s.getRootCerts(ctx, mesh, backend)
+		if err != nil {
+			return nil, nil, err
+		}
+		certs = append(certs, backendCerts...)
+		names = append(names, backend.Name)
+	}
+
+	bundles, err := s.getFederatedBundles(ctx, mesh)
+	if err != nil {
+		return nil, nil, err
+	}
+	certs = append(certs, bundles...)
+
+	crls, err := s.getRevocationLists(ctx, mesh, backends)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &core_xds.CaSecret{
+		PemCerts: certs,
+		PemCrls:  crls,
+	}, names, nil
+}
+
+// getRevocationLists returns CRLs of all backends, because dataplanes reject certificates of CAs without the list once any list is set.
+func (s *meshCaProvider) getRevocationLists(ctx context.Context, mesh *core_mesh.MeshResource, backends []*mesh_proto.CertificateAuthorityBackend) ([][]byte, error) {
+	now := core.Now()
+	serialNumbers := mesh.RevokedSerialNumbers(now)
+	if len(serialNumbers) == 0 {
+		return nil, nil
+	}
+	list := core_ca.RevocationList{
+		SerialNumbers: serialNumbers,
+		ThisUpdate:    now,
+		NextUpdate:    now.Add(RevocationListValidity),
+	}
+
+	var crls [][]byte
+	for _, backend := range backends {
+		caManager, exist := s.caManagers[backend.Type]
+		if !exist {
+			return nil, errors.Errorf("CA manager of type %s not exist", backend.Type)
+		}
+		signer, ok := caManager.(core_ca.RevocationListSigner)
+		if !ok {
+			return nil, errors.Errorf("CA manager of type %s does not support revocation of certificates", backend.Type)
+		}
+		crl, err := signer.SignRevocationList(ctx, mesh.GetMeta().GetName(), backend, list)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not sign revocation list")
+		}
+		crls = append(crls, crl)
+	}
+	return crls, nil
+}
+
+// getFederatedBundles returns CA certificates of the trust domains federated with the mesh.
+func (s *meshCaProvider) getFederatedBundles(ctx context.Context, mesh *core_mesh.MeshResource) ([][]byte, error) {
+	var certs [][]byte
+	for _, trustDomain := range mesh.Spec.GetMtls().GetFederatedTrustDomains() {
+		bundle, err := s.dataSourceLoader.Load(ctx, mesh.GetMeta().GetName(), trustDomain.GetBundle())
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not load the trust bundle of %q trust domain", trustDomain.GetName())
+		}
+		bundleCerts := tls.SplitPEMCerts(bundle)
+		if len(bundleCerts) == 0 {
+			return nil, errors.Errorf("the trust bundle of %q trust domain does not contain PEM encoded certificates", trustDomain.GetName())
+		}
+		certs = append(certs, bundleCerts...)
+	}
+	return certs, nil
+}
 
+func (s *meshCaProvider) getRootCerts(ctx context.Context, mesh *core_mesh.MeshResource, backend *mesh_proto.CertificateAuthorityBackend) ([][]byte, error) {
 	timeout := backend.GetRootChain().GetRequestTimeout()
 	if timeout != nil {
 		var cancel context.CancelFunc
@@ -53,7 +149,7 @@ func (s *meshCaProvider) Get(ctx context.Context, mesh *core_mesh.MeshResource)
 
 	caManager, exist := s.caManagers[backend.Type]
 	if !exist {
-		return nil, nil, errors.Errorf("CA manager of type %s not exist", backend.Type)
+		return nil, errors.Errorf("CA manager of type %s not exist", backend.Type)
 	}
 
 	var certs [][]byte
@@ -66,10 +162,7 @@ func (s *meshCaProvider) Get(ctx context.Context, mesh *core_mesh.MeshResource)
 		certs, err = caManager.GetRootCert(ctx, mesh.GetMeta().GetName(), backend)
 	}()
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "could not get root certs")
+		return nil, errors.Wrap(err, "could not get root certs")
 	}
-
-	return &core_xds.CaSecret{
-		PemCerts: certs,
-	}, []string{backend.Name}, nil
+	return certs, nil
 }
@@ -8,10 +8,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_xds "github.com/kumahq/kuma/pkg/core/xds"
 	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	xds_tls "github.com/kumahq/kuma/pkg/xds/envoy/tls"
 )
 
 type Identity struct {
@@ -51,6 +53,12 @@ func (s *identityCertProvider) Get(ctx context.Context, requestor Identity, mesh
 		return nil, "", errors.Errorf("CA default backend in mesh %q has to be defined", mesh.GetMeta().GetName())
 	}
 
+	for _, service := range requestor.Services.Values(mesh_proto.ServiceTag) {
+		if spiffeID := xds_tls.ServiceSpiffeID(mesh.GetMeta().GetName(), service); mesh.IsSpiffeIDRevoked(spiffeID, core.Now()) {
+			return nil, "", errors.Errorf("identity %q is revoked in mesh %q", spiffeID, mesh.GetMeta().GetName())
+		}
+	}
+
 	timeout := backend.GetDpCert().GetRequestTimeout()
 	if timeout != nil {
 		var cancel context.CancelFunc
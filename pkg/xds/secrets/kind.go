@@ -6,15 +6,17 @@ const (
 	IdentityChange ChangeKind = iota
 	OwnMeshChange
 	OtherMeshChange
+	RevocationChange
 )
 
 type UpdateKinds map[ChangeKind]struct{}
 
 func UpdateEverything() UpdateKinds {
 	return map[ChangeKind]struct{}{
-		IdentityChange:  {},
-		OwnMeshChange:   {},
-		OtherMeshChange: {},
+		IdentityChange:   {},
+		OwnMeshChange:    {},
+		OtherMeshChange:  {},
+		RevocationChange: {},
 	}
 }
 func (kinds UpdateKinds) HasType(kind ChangeKind) bool {
@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"math/rand"
 	"sort"
 	"strings"
 	"sync"
@@ -44,9 +45,17 @@ type MeshInfo struct {
 type Info struct {
 	Expiration time.Time
 	Generation time.Time
+	// Rotation is a time after which the certificate is rotated.
+	// It's computed from the rotation threshold and jitter of the backend that issued the certificate.
+	Rotation time.Time
 
 	Tags mesh_proto.MultiValueTagSet
 
+	// SerialNumber of the certificate in hex format.
+	SerialNumber string
+	// RevocationListRefresh is a time after which revocation lists of the CAs are regenerated before they expire.
+	RevocationListRefresh time.Time
+
 	IssuedBackend     string
 	SupportedBackends []string
 
@@ -62,9 +71,24 @@ func (c *Info) CertLifetime() time.Duration {
 }
 
 func (c *Info) ExpiringSoon() bool {
+	if !c.Rotation.IsZero() {
+		return core.Now().After(c.Rotation)
+	}
 	return core.Now().After(c.Generation.Add(c.CertLifetime() / 5 * 4))
 }
 
+// rotationTime returns a time after which the certificate should be rotated.
+// The jitter brings the rotation forward by a random part of the lifetime,
+// so certificates generated at the same time are not rotated all at once.
+func rotationTime(generation time.Time, expiration time.Time, rotation *mesh_proto.CertificateAuthorityBackend_DpCert_Rotation) time.Time {
+	lifetime := expiration.Sub(generation)
+	rotateAfter := lifetime / 100 * time.Duration(core_mesh.CertRotationThreshold(rotation))
+	if maxJitter := lifetime / 100 * time.Duration(rotation.GetJitter().GetValue()); maxJitter > 0 {
+		rotateAfter -= time.Duration(rand.Int63n(int64(maxJitter)))
+	}
+	return generation.Add(rotateAfter)
+}
+
 func NewSecrets(caProvider CaProvider, identityProvider IdentityProvider, metrics metrics.Metrics) (Secrets, error) {
 	certGenerationsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
 		Help: "Number of generated certificates",
@@ -74,12 +98,53 @@ func NewSecrets(caProvider CaProvider, identityProvider IdentityProvider, metric
 		return nil, err
 	}
 
-	return &secrets{
+	s := &secrets{
 		caProvider:            caProvider,
 		identityProvider:      identityProvider,
 		cachedCerts:           map[model.ResourceKey]*certs{},
 		certGenerationsMetric: certGenerationsMetric,
-	}, nil
+	}
+	if err := metrics.Register(&expiringCertsCollector{secrets: s}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+var expiringCertsDesc = prometheus.NewDesc(
+	"cert_expiring_soon",
+	"Number of certificates that passed their rotation time and are waiting to be regenerated",
+	[]string{"mesh"},
+	nil,
+)
+
+// expiringCertsCollector reports certificates that passed their rotation time.
+// Certificates are regenerated only when the proxy requests configuration, so a growing value
+// means that proxies are disconnected or the CA is not able to issue certificates on time.
+type expiringCertsCollector struct {
+	secrets *secrets
+}
+
+var _ prometheus.Collector = &expiringCertsCollector{}
+
+func (e *expiringCertsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- expiringCertsDesc
+}
+
+func (e *expiringCertsCollector) Collect(ch chan<- prometheus.Metric) {
+	e.secrets.RLock()
+	expiring := map[string]int{}
+	for key, certs := range e.secrets.cachedCerts {
+		count := expiring[key.Mesh]
+		if certs.Info().ExpiringSoon() {
+			count++
+		}
+		expiring[key.Mesh] = count
+	}
+	e.secrets.RUnlock()
+
+	for mesh, count := range expiring {
+		ch <- prometheus.MustNewConstMetric(expiringCertsDesc, prometheus.GaugeValue, float64(count), mesh)
+	}
 }
 
 type secrets struct {
@@ -228,9 +293,15 @@ func (s *secrets) shouldGenerateCerts(info *Info, tags mesh_proto.MultiValueTagS
 	var reason string
 	updates := UpdateKinds{}
 
-	if !proto.Equal(info.OwnMesh.MTLS, ownMesh.Spec.Mtls) {
+	if !proto.Equal(mtlsWithoutRevocations(info.OwnMesh.MTLS), mtlsWithoutRevocations(ownMesh.Spec.Mtls)) {
 		updates.AddKind(OwnMeshChange)
 		reason = "Mesh mTLS settings have changed"
+	} else if !proto.Equal(info.OwnMesh.MTLS, ownMesh.Spec.Mtls) {
+		updates.AddKind(RevocationChange)
+		reason = "Mesh revoked certificates have changed"
+	} else if !info.RevocationListRefresh.IsZero() && core.Now().After(info.RevocationListRefresh) {
+		updates.AddKind(RevocationChange)
+		reason = "the revocation list is expiring soon"
 	}
 
 	if len(info.OtherMeshInfos) != len(otherMeshInfos) || info.failedOtherMeshes {
@@ -259,6 +330,17 @@ func (s *secrets) shouldGenerateCerts(info *Info, tags mesh_proto.MultiValueTagS
 	return updates, reason
 }
 
+// mtlsWithoutRevocations returns mTLS settings without revoked certificates,
+// so revoking a certificate does not regenerate certificates of all dataplanes in the mesh.
+func mtlsWithoutRevocations(mtls *mesh_proto.Mesh_Mtls) *mesh_proto.Mesh_Mtls {
+	if len(mtls.GetRevocations()) == 0 {
+		return mtls
+	}
+	result := proto.Clone(mtls).(*mesh_proto.Mesh_Mtls)
+	result.Revocations = nil
+	return result
+}
+
 func (s *secrets) generateCerts(
 	tags mesh_proto.MultiValueTagSet,
 	mesh *core_mesh.MeshResource,
@@ -302,18 +384,25 @@ func (s *secrets) generateCerts(
 		}
 
 		info.Tags = tags
+		info.SerialNumber = core_mesh.FormatSerialNumber(cert.SerialNumber)
 		info.IssuedBackend = issuedBackend
 		info.Expiration = cert.NotAfter
 		info.Generation = core.Now()
+		info.Rotation = rotationTime(info.Generation, info.Expiration, mesh.GetCertificateAuthorityBackend(issuedBackend).GetDpCert().GetRotation())
 		identity = identitySecret
 	}
 
-	if updateKinds.HasType(OwnMeshChange) {
+	if updateKinds.HasType(OwnMeshChange) || updateKinds.HasType(RevocationChange) {
 		caSecret, supportedBackends, err := s.caProvider.Get(context.Background(), mesh)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get mesh CA cert")
 		}
 
+		info.RevocationListRefresh = time.Time{}
+		if len(caSecret.PemCrls) > 0 {
+			info.RevocationListRefresh = core.Now().Add(RevocationListValidity / 2)
+		}
+
 		ownCa = MeshCa{
 			Mesh:     meshName,
 			CaSecret: caSecret,
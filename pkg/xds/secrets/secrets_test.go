@@ -8,8 +8,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/api/system/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
 	core_ca "github.com/kumahq/kuma/pkg/core/ca"
+	"github.com/kumahq/kuma/pkg/core/datasource"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/secrets/cipher"
@@ -20,6 +22,7 @@ import (
 	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
 	test_metrics "github.com/kumahq/kuma/pkg/test/metrics"
 	"github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	. "github.com/kumahq/kuma/pkg/xds/secrets"
 )
 
@@ -114,7 +117,7 @@ var _ = Describe("Secrets", func() {
 		Expect(err).ToNot(HaveOccurred())
 		metrics = m
 
-		caProvider, err := NewCaProvider(caManagers, metrics)
+		caProvider, err := NewCaProvider(caManagers, datasource.NewDataSourceLoader(secretManager), metrics)
 		Expect(err).ToNot(HaveOccurred())
 		identityProvider, err := NewIdentityProvider(caManagers, metrics)
 		Expect(err).ToNot(HaveOccurred())
@@ -174,6 +177,88 @@ var _ = Describe("Secrets", func() {
 			Expect(test_metrics.FindMetric(metrics, "ca_manager_get_cert", "backend_name", "ca-1").GetSummary().GetSampleCount()).To(Equal(uint64(1)))
 		})
 
+		It("should trust CAs of both backends during the rotation", func() {
+			// given
+			mesh := newMesh()
+			mesh.Spec.Mtls.Rotation = &mesh_proto.Mesh_Mtls_Rotation{
+				From: "ca-1",
+				To:   "ca-2",
+			}
+
+			// when
+			_, cas, err := secrets.GetForDataPlane(newDataplane(), mesh, nil)
+
+			// then the certificate is issued by the enabled backend and CAs of both backends are trusted
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cas["default"].PemCerts).To(HaveLen(2))
+			info := secrets.Info(core_model.MetaToResourceKey(newDataplane().Meta))
+			Expect(info.IssuedBackend).To(Equal("ca-1"))
+			Expect(info.SupportedBackends).To(Equal([]string{"ca-1", "ca-2"}))
+		})
+
+		It("should trust bundles of federated trust domains", func() {
+			// given
+			_, cas, err := secrets.GetForDataPlane(newDataplane(), newMesh(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			bundle := string(cas["default"].PemCerts[0])
+			mesh := newMesh()
+			mesh.Spec.Mtls.FederatedTrustDomains = []*mesh_proto.Mesh_Mtls_FederatedTrustDomain{
+				{
+					Name: "example.org",
+					Bundle: &system_proto.DataSource{
+						Type: &system_proto.DataSource_InlineString{
+							InlineString: bundle + bundle,
+						},
+					},
+				},
+			}
+
+			// when
+			_, cas, err = secrets.GetForDataPlane(newDataplane(), mesh, nil)
+
+			// then every certificate of the bundle is trusted next to the CA of the mesh
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cas["default"].PemCerts).To(HaveLen(3))
+		})
+
+		It("should distribute revocation list without regenerating the certificate", func() {
+			// given
+			identity, _, err := secrets.GetForDataPlane(newDataplane(), newMesh(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			mesh := newMesh()
+			mesh.Spec.Mtls.Revocations = []*mesh_proto.Mesh_Mtls_Revocation{
+				{
+					SerialNumber: "2a",
+				},
+			}
+
+			// when
+			newIdentity, cas, err := secrets.GetForDataPlane(newDataplane(), mesh, nil)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newIdentity).To(Equal(identity))
+			Expect(cas["default"].PemCrls).To(HaveLen(1))
+			Expect(test_metrics.FindMetric(metrics, "cert_generation").GetCounter().GetValue()).To(Equal(1.0))
+			Expect(test_metrics.FindMetric(metrics, "ca_manager_get_root_cert_chain", "backend_name", "ca-1").GetSummary().GetSampleCount()).To(Equal(uint64(2)))
+		})
+
+		It("should not issue certificate for revoked identity", func() {
+			// given
+			mesh := newMesh()
+			mesh.Spec.Mtls.Revocations = []*mesh_proto.Mesh_Mtls_Revocation{
+				{
+					SpiffeId: "spiffe://default/web",
+				},
+			}
+
+			// when
+			_, _, err := secrets.GetForDataPlane(newDataplane(), mesh, nil)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring(`identity "spiffe://default/web" is revoked in mesh "default"`)))
+		})
+
 		Context("should regenerate certificate", func() {
 			BeforeEach(func() {
 				_, _, err := secrets.GetForDataPlane(newDataplane(), newMesh(), nil)
@@ -221,6 +306,23 @@ var _ = Describe("Secrets", func() {
 				Expect(test_metrics.FindMetric(metrics, "ca_manager_get_cert", "backend_name", "ca-1").GetSummary().GetSampleCount()).To(Equal(uint64(2)))
 			})
 
+			It("when cert passed rotation threshold of the backend", func() {
+				// given
+				mesh := newMesh()
+				mesh.Spec.Mtls.Backends[0].DpCert.Rotation.Threshold = util_proto.UInt32(50)
+				secrets.Cleanup(core_model.MetaToResourceKey(newDataplane().Meta))
+				_, _, err := secrets.GetForDataPlane(newDataplane(), mesh, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				now = now.Add(30*time.Minute + 1*time.Millisecond)
+				_, _, err = secrets.GetForDataPlane(newDataplane(), mesh, nil)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(test_metrics.FindMetric(metrics, "cert_generation").GetCounter().GetValue()).To(Equal(3.0))
+			})
+
 			It("when cert was cleaned up", func() {
 				// given
 				secrets.Cleanup(core_model.MetaToResourceKey(newDataplane().Meta))
@@ -235,6 +337,35 @@ var _ = Describe("Secrets", func() {
 			})
 		})
 
+		It("should bring rotation forward by jitter", func() {
+			// given
+			mesh := newMesh()
+			mesh.Spec.Mtls.Backends[0].DpCert.Rotation.Threshold = util_proto.UInt32(50)
+			mesh.Spec.Mtls.Backends[0].DpCert.Rotation.Jitter = util_proto.UInt32(20)
+
+			// when
+			_, _, err := secrets.GetForDataPlane(newDataplane(), mesh, nil)
+
+			// then rotation is between 30% and 50% of the lifetime
+			Expect(err).ToNot(HaveOccurred())
+			info := secrets.Info(core_model.MetaToResourceKey(newDataplane().Meta))
+			Expect(info.Rotation).To(BeTemporally(">", now.Add(18*time.Minute-time.Second)))
+			Expect(info.Rotation).To(BeTemporally("<=", now.Add(30*time.Minute)))
+		})
+
+		It("should emit statistic of certs expiring soon", func() {
+			// given
+			_, _, err := secrets.GetForDataPlane(newDataplane(), newMesh(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(test_metrics.FindMetric(metrics, "cert_expiring_soon", "mesh", "default").GetGauge().GetValue()).To(Equal(0.0))
+
+			// when
+			now = now.Add(48*time.Minute + 1*time.Millisecond)
+
+			// then
+			Expect(test_metrics.FindMetric(metrics, "cert_expiring_soon", "mesh", "default").GetGauge().GetValue()).To(Equal(1.0))
+		})
+
 		It("should cleanup certs", func() {
 			// given
 			_, _, err := secrets.GetForDataPlane(newDataplane(), newMesh(), nil)
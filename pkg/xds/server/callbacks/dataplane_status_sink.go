@@ -1,10 +1,16 @@
 package callbacks
 
 import (
+	"context"
+	"encoding/json"
+	"net"
 	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
@@ -12,6 +18,8 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	"github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	"github.com/kumahq/kuma/pkg/xds/secrets"
 )
 
@@ -25,9 +33,101 @@ type DataplaneInsightStore interface {
 	// Upsert creates or updates the subscription, storing it with
 	// the key dataplaneID. dataplaneType gives the resource type of
 	// the dataplane proxy that has subscribed.
-	Upsert(dataplaneType core_model.ResourceType, dataplaneID core_model.ResourceKey, subscription *mesh_proto.DiscoverySubscription, secretsInfo *secrets.Info) error
+	// memory is stored only if it's not nil.
+	// connections replace inbound connections stored before.
+	Upsert(dataplaneType core_model.ResourceType, dataplaneID core_model.ResourceKey, subscription *mesh_proto.DiscoverySubscription, secretsInfo *secrets.Info, memory *mesh_proto.DataplaneInsight_Memory, connections *mesh_proto.DataplaneInsight_MTLS_InboundConnections) error
 }
 
+// MemoryStatsFetcher returns memory usage of the data plane proxy.
+type MemoryStatsFetcher func(ctx context.Context, dataplaneID core_model.ResourceKey) (*mesh_proto.DataplaneInsight_Memory, error)
+
+// NewMemoryStatsFetcher returns MemoryStatsFetcher that asks Envoy Admin API of the Dataplane for memory usage.
+func NewMemoryStatsFetcher(resManager manager.ReadOnlyResourceManager, envoyAdminClient admin.EnvoyAdminClient) MemoryStatsFetcher {
+	return func(ctx context.Context, dataplaneID core_model.ResourceKey) (*mesh_proto.DataplaneInsight_Memory, error) {
+		dataplane := core_mesh.NewDataplaneResource()
+		if err := resManager.Get(ctx, dataplane, store.GetBy(dataplaneID)); err != nil {
+			return nil, err
+		}
+		memory, err := envoyAdminClient.MemoryStats(ctx, dataplane)
+		if err != nil {
+			return nil, err
+		}
+		return &mesh_proto.DataplaneInsight_Memory{
+			LastUpdateTime:   util_proto.MustTimestampProto(core.Now()),
+			Allocated:        memory.GetAllocated(),
+			HeapSize:         memory.GetHeapSize(),
+			PageheapUnmapped: memory.GetPageheapUnmapped(),
+		}, nil
+	}
+}
+
+// InboundConnectionsFetcher returns inbound connections of the data plane proxy or nil if they are not collected for the proxy.
+type InboundConnectionsFetcher func(ctx context.Context, dataplaneID core_model.ResourceKey) (*mesh_proto.DataplaneInsight_MTLS_InboundConnections, error)
+
+// NewInboundConnectionsFetcher returns InboundConnectionsFetcher that asks Envoy Admin API of the Dataplane for stats of inbound listeners.
+// Connections are collected only when the Mesh runs in PERMISSIVE mode, so operators know when it's safe to switch to STRICT mode.
+// TLS handshakes are done only by the filter chain with mTLS, so other connections of the listener are counted as plaintext.
+func NewInboundConnectionsFetcher(resManager manager.ReadOnlyResourceManager, envoyAdminClient admin.EnvoyAdminClient) InboundConnectionsFetcher {
+	return func(ctx context.Context, dataplaneID core_model.ResourceKey) (*mesh_proto.DataplaneInsight_MTLS_InboundConnections, error) {
+		mesh := core_mesh.NewMeshResource()
+		if err := resManager.Get(ctx, mesh, store.GetByKey(dataplaneID.Mesh, core_model.NoMesh)); err != nil {
+			return nil, err
+		}
+		if !mesh.MTLSEnabled() || mesh.GetEnabledCertificateAuthorityBackend().GetMode() != mesh_proto.CertificateAuthorityBackend_PERMISSIVE {
+			return nil, nil
+		}
+		dataplane := core_mesh.NewDataplaneResource()
+		if err := resManager.Get(ctx, dataplane, store.GetBy(dataplaneID)); err != nil {
+			return nil, err
+		}
+		raw, err := envoyAdminClient.Stats(ctx, dataplane, admin.StatsOpts{
+			Filter: `^listener\..+\.(downstream_cx_total|ssl\.handshake)$`,
+			Format: admin.StatsFormatJSON,
+		})
+		if err != nil {
+			return nil, err
+		}
+		stats := struct {
+			Stats []struct {
+				Name  string `json:"name"`
+				Value uint64 `json:"value"`
+			} `json:"stats"`
+		}{}
+		if err := json.Unmarshal(raw, &stats); err != nil {
+			return nil, errors.Wrap(err, "could not parse stats")
+		}
+		values := map[string]uint64{}
+		for _, stat := range stats.Stats {
+			values[stat.Name] = stat.Value
+		}
+
+		connections := &mesh_proto.DataplaneInsight_MTLS_InboundConnections{
+			LastUpdateTime: util_proto.MustTimestampProto(core.Now()),
+			Services:       map[string]*mesh_proto.DataplaneInsight_MTLS_InboundConnections_Connections{},
+		}
+		for _, inbound := range dataplane.Spec.GetNetworking().GetInbound() {
+			iface := dataplane.Spec.GetNetworking().ToInboundInterface(inbound)
+			// Envoy names stats of the listener after its address with ':' replaced by '_'
+			prefix := "listener." + strings.ReplaceAll(net.JoinHostPort(iface.DataplaneIP, strconv.Itoa(int(iface.DataplanePort))), ":", "_") + "."
+			total, mtls := values[prefix+"downstream_cx_total"], values[prefix+"ssl.handshake"]
+			if mtls > total {
+				mtls = total
+			}
+			service := connections.Services[inbound.GetService()]
+			if service == nil {
+				service = &mesh_proto.DataplaneInsight_MTLS_InboundConnections_Connections{}
+				connections.Services[inbound.GetService()] = service
+			}
+			service.Mtls += mtls
+			service.Plaintext += total - mtls
+		}
+		return connections, nil
+	}
+}
+
+// NewDataplaneInsightSink returns a sink that periodically stores status of the proxy.
+// If memoryStats is not nil, memory usage of the proxy is fetched on every tick of memoryStatsTicker and stored as well.
+// The same applies to connectionStats and connectionStatsTicker.
 func NewDataplaneInsightSink(
 	dataplaneType core_model.ResourceType,
 	accessor SubscriptionStatusAccessor,
@@ -35,15 +135,25 @@ func NewDataplaneInsightSink(
 	newTicker func() *time.Ticker,
 	generationTicker func() *time.Ticker,
 	flushBackoff time.Duration,
-	store DataplaneInsightStore) DataplaneInsightSink {
+	store DataplaneInsightStore,
+	memoryStatsTicker func() *time.Ticker,
+	memoryStats MemoryStatsFetcher,
+	connectionStatsTicker func() *time.Ticker,
+	connectionStats InboundConnectionsFetcher,
+) DataplaneInsightSink {
 	return &dataplaneInsightSink{
-		flushTicker:      newTicker,
-		generationTicker: generationTicker,
-		dataplaneType:    dataplaneType,
-		accessor:         accessor,
-		secrets:          secrets,
-		flushBackoff:     flushBackoff,
-		store:            store,
+		flushTicker:       newTicker,
+		generationTicker:  generationTicker,
+		dataplaneType:     dataplaneType,
+		accessor:          accessor,
+		secrets:           secrets,
+		flushBackoff:      flushBackoff,
+		store:             store,
+		memoryStatsTicker: memoryStatsTicker,
+		memoryStats:       memoryStats,
+
+		connectionStatsTicker: connectionStatsTicker,
+		connectionStats:       connectionStats,
 	}
 }
 
@@ -57,6 +167,12 @@ type dataplaneInsightSink struct {
 	secrets          secrets.Secrets
 	store            DataplaneInsightStore
 	flushBackoff     time.Duration
+
+	memoryStatsTicker func() *time.Ticker
+	memoryStats       MemoryStatsFetcher
+
+	connectionStatsTicker func() *time.Ticker
+	connectionStats       InboundConnectionsFetcher
 }
 
 func (s *dataplaneInsightSink) Start(stop <-chan struct{}) {
@@ -66,8 +182,26 @@ func (s *dataplaneInsightSink) Start(stop <-chan struct{}) {
 	generationTicker := s.generationTicker()
 	defer generationTicker.Stop()
 
+	var memoryStatsTick <-chan time.Time
+	if s.memoryStats != nil {
+		memoryStatsTicker := s.memoryStatsTicker()
+		defer memoryStatsTicker.Stop()
+		memoryStatsTick = memoryStatsTicker.C
+	}
+
+	var connectionStatsTick <-chan time.Time
+	if s.connectionStats != nil {
+		connectionStatsTicker := s.connectionStatsTicker()
+		defer connectionStatsTicker.Stop()
+		connectionStatsTick = connectionStatsTicker.C
+	}
+
 	var lastStoredState *mesh_proto.DiscoverySubscription
 	var lastStoredSecretsInfo *secrets.Info
+	var lastStoredMemory *mesh_proto.DataplaneInsight_Memory
+	var memory *mesh_proto.DataplaneInsight_Memory
+	var lastStoredConnections *mesh_proto.DataplaneInsight_MTLS_InboundConnections
+	var connections *mesh_proto.DataplaneInsight_MTLS_InboundConnections
 	var generation uint32
 
 	flush := func(closing bool) {
@@ -81,11 +215,12 @@ func (s *dataplaneInsightSink) Start(stop <-chan struct{}) {
 		}
 		currentState.Generation = generation
 
-		if proto.Equal(currentState, lastStoredState) && secretsInfo == lastStoredSecretsInfo {
+		if proto.Equal(currentState, lastStoredState) && secretsInfo == lastStoredSecretsInfo && proto.Equal(memory, lastStoredMemory) &&
+			proto.Equal(connections, lastStoredConnections) {
 			return
 		}
 
-		if err := s.store.Upsert(s.dataplaneType, dataplaneID, currentState, secretsInfo); err != nil {
+		if err := s.store.Upsert(s.dataplaneType, dataplaneID, currentState, secretsInfo, memory, connections); err != nil {
 			switch {
 			case closing:
 				// When XDS stream is closed, Dataplane Status Tracker executes OnStreamClose which closes stop channel
@@ -113,6 +248,8 @@ func (s *dataplaneInsightSink) Start(stop <-chan struct{}) {
 			sinkLog.V(1).Info("DataplaneInsight saved", "dataplaneid", dataplaneID, "subscription", currentState)
 			lastStoredState = currentState
 			lastStoredSecretsInfo = secretsInfo
+			lastStoredMemory = memory
+			lastStoredConnections = connections
 		}
 	}
 
@@ -128,6 +265,23 @@ func (s *dataplaneInsightSink) Start(stop <-chan struct{}) {
 			// On Kubernetes, because of the cache subsequent Get, Update requests can fail, because the cache is not strongly consistent.
 			// We handle the Resource Conflict logging on V1, but we can try to avoid the situation with backoff
 			time.Sleep(s.flushBackoff)
+		case <-memoryStatsTick:
+			dataplaneID, _ := s.accessor.GetStatus()
+			m, err := s.memoryStats(context.Background(), dataplaneID)
+			if err != nil {
+				// we keep the last known memory usage, the proxy may not expose the Envoy Admin API to the Control Plane
+				sinkLog.V(1).Info("failed to fetch memory stats", "dataplaneid", dataplaneID, "err", err)
+				continue
+			}
+			memory = m
+		case <-connectionStatsTick:
+			dataplaneID, _ := s.accessor.GetStatus()
+			c, err := s.connectionStats(context.Background(), dataplaneID)
+			if err != nil {
+				sinkLog.V(1).Info("failed to fetch inbound connections", "dataplaneid", dataplaneID, "err", err)
+				continue
+			}
+			connections = c
 		case <-stop:
 			flush(true)
 			return
@@ -147,7 +301,7 @@ type dataplaneInsightStore struct {
 	resManager manager.ResourceManager
 }
 
-func (s *dataplaneInsightStore) Upsert(dataplaneType core_model.ResourceType, dataplaneID core_model.ResourceKey, subscription *mesh_proto.DiscoverySubscription, secretsInfo *secrets.Info) error {
+func (s *dataplaneInsightStore) Upsert(dataplaneType core_model.ResourceType, dataplaneID core_model.ResourceKey, subscription *mesh_proto.DiscoverySubscription, secretsInfo *secrets.Info, memory *mesh_proto.DataplaneInsight_Memory, connections *mesh_proto.DataplaneInsight_MTLS_InboundConnections) error {
 	switch dataplaneType {
 	case core_mesh.ZoneIngressType:
 		return manager.Upsert(s.resManager, dataplaneID, core_mesh.NewZoneIngressInsightResource(), func(resource core_model.Resource) error {
@@ -170,12 +324,20 @@ func (s *dataplaneInsightStore) Upsert(dataplaneType core_model.ResourceType, da
 				insight.Spec.MTLS = nil
 			} else if insight.Spec.MTLS == nil ||
 				insight.Spec.MTLS.CertificateExpirationTime.AsTime() != secretsInfo.Expiration ||
+				insight.Spec.MTLS.CertificateSerialNumber != secretsInfo.SerialNumber ||
 				insight.Spec.MTLS.IssuedBackend != secretsInfo.IssuedBackend ||
 				!reflect.DeepEqual(insight.Spec.MTLS.SupportedBackends, secretsInfo.SupportedBackends) {
-				if err := insight.Spec.UpdateCert(secretsInfo.Generation, secretsInfo.Expiration, secretsInfo.IssuedBackend, secretsInfo.SupportedBackends); err != nil {
+				if err := insight.Spec.UpdateCert(secretsInfo.Generation, secretsInfo.Expiration, secretsInfo.SerialNumber, secretsInfo.IssuedBackend, secretsInfo.SupportedBackends); err != nil {
 					return err
 				}
 			}
+
+			if insight.Spec.MTLS != nil {
+				insight.Spec.MTLS.InboundConnections = connections
+			}
+			if memory != nil {
+				insight.Spec.Memory = memory
+			}
 			return nil
 		})
 	default:
@@ -14,7 +14,9 @@ import (
 	"github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/pkg/core/resources/store"
+	"github.com/kumahq/kuma/pkg/envoy/admin"
 	memory_resources "github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/test/matchers"
 	"github.com/kumahq/kuma/pkg/test/xds"
 	util_proto "github.com/kumahq/kuma/pkg/util/proto"
 	"github.com/kumahq/kuma/pkg/xds/server/callbacks"
@@ -72,6 +74,10 @@ var _ = Describe("DataplaneInsightSink", func() {
 				func() *time.Ticker { return &time.Ticker{C: make(chan time.Time)} },
 				1*time.Millisecond,
 				store,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			// when
@@ -143,6 +149,59 @@ var _ = Describe("DataplaneInsightSink", func() {
 				// no update is good
 			}
 		})
+
+		It("should store memory stats of the proxy", func() {
+			// setup
+			key := core_model.ResourceKey{Mesh: "default", Name: "example-001"}
+			subscription := &mesh_proto.DiscoverySubscription{
+				Id:                     "3287995C-7E11-41FB-9479-7D39337F845D",
+				ControlPlaneInstanceId: "control-plane-01",
+				ConnectTime:            util_proto.MustTimestampProto(t0),
+				Status:                 mesh_proto.NewSubscriptionStatus(),
+			}
+			accessor := &SubscriptionStatusHolder{key, subscription}
+			flushTicks := make(chan time.Time)
+			memoryStatsTicks := make(chan time.Time)
+			memory := &mesh_proto.DataplaneInsight_Memory{
+				LastUpdateTime:   util_proto.MustTimestampProto(t0),
+				Allocated:        1024,
+				HeapSize:         2048,
+				PageheapUnmapped: 512,
+			}
+
+			// given
+			sink := callbacks.NewDataplaneInsightSink(
+				core_mesh.DataplaneType,
+				accessor,
+				&xds.TestSecrets{},
+				func() *time.Ticker { return &time.Ticker{C: flushTicks} },
+				func() *time.Ticker { return &time.Ticker{C: make(chan time.Time)} },
+				1*time.Millisecond,
+				store,
+				func() *time.Ticker { return &time.Ticker{C: memoryStatsTicks} },
+				func(_ context.Context, dataplaneID core_model.ResourceKey) (*mesh_proto.DataplaneInsight_Memory, error) {
+					Expect(dataplaneID).To(Equal(key))
+					return memory, nil
+				},
+				nil,
+				nil,
+			)
+
+			// when
+			go sink.Start(stop)
+
+			// then memory is not stored before it is fetched
+			create := <-recorder.Creates
+			Expect(create.DataplaneInsight_Memory).To(BeNil())
+
+			// when
+			memoryStatsTicks <- t0.Add(time.Second)
+			flushTicks <- t0.Add(2 * time.Second)
+
+			// then
+			update := <-recorder.Updates
+			Expect(update.DataplaneInsight_Memory).To(matchers.MatchProto(memory))
+		})
 	})
 
 	Describe("DataplaneInsightStore", func() {
@@ -172,7 +231,7 @@ var _ = Describe("DataplaneInsightSink", func() {
 			statusStore := callbacks.NewDataplaneInsightStore(manager.NewResourceManager(store))
 
 			// when
-			err := statusStore.Upsert(dataplaneType, key, proto.Clone(subscription).(*mesh_proto.DiscoverySubscription), nil)
+			err := statusStore.Upsert(dataplaneType, key, proto.Clone(subscription).(*mesh_proto.DiscoverySubscription), nil, nil, nil)
 			// then
 			Expect(err).ToNot(HaveOccurred())
 			// and
@@ -206,7 +265,7 @@ var _ = Describe("DataplaneInsightSink", func() {
 			subscription.Status.Lds.ResponsesSent += 1
 			subscription.Status.Total.ResponsesSent += 1
 			// and
-			err = statusStore.Upsert(dataplaneType, key, proto.Clone(subscription).(*mesh_proto.DiscoverySubscription), nil)
+			err = statusStore.Upsert(dataplaneType, key, proto.Clone(subscription).(*mesh_proto.DiscoverySubscription), nil, nil, nil)
 			// then
 			Expect(err).ToNot(HaveOccurred())
 			// and
@@ -239,8 +298,94 @@ var _ = Describe("DataplaneInsightSink", func() {
 `))
 		})
 	})
+
+	Describe("InboundConnectionsFetcher", func() {
+
+		var store core_store.ResourceStore
+		var fetcher callbacks.InboundConnectionsFetcher
+		key := core_model.ResourceKey{Mesh: "default", Name: "web-01"}
+
+		createMesh := func(mode mesh_proto.CertificateAuthorityBackend_Mode) {
+			mesh := core_mesh.NewMeshResource()
+			mesh.Spec.Mtls = &mesh_proto.Mesh_Mtls{
+				EnabledBackend: "ca-1",
+				Backends: []*mesh_proto.CertificateAuthorityBackend{
+					{Name: "ca-1", Type: "builtin", Mode: mode},
+				},
+			}
+			Expect(store.Create(context.Background(), mesh, core_store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			store = memory_resources.NewStore()
+			dataplane := &core_mesh.DataplaneResource{
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{Port: 8080, Tags: map[string]string{mesh_proto.ServiceTag: "web"}},
+							{Port: 8081, Tags: map[string]string{mesh_proto.ServiceTag: "web"}},
+							{Port: 9090, Tags: map[string]string{mesh_proto.ServiceTag: "web-api"}},
+						},
+					},
+				},
+			}
+			Expect(store.Create(context.Background(), dataplane, core_store.CreateBy(key))).To(Succeed())
+			fetcher = callbacks.NewInboundConnectionsFetcher(manager.NewResourceManager(store), &statsEnvoyAdminClient{
+				stats: `{"stats": [
+                  {"name": "listener.192.168.0.1_8080.downstream_cx_total", "value": 10},
+                  {"name": "listener.192.168.0.1_8080.ssl.handshake", "value": 7},
+                  {"name": "listener.192.168.0.1_8081.downstream_cx_total", "value": 2},
+                  {"name": "listener.192.168.0.1_9090.downstream_cx_total", "value": 5},
+                  {"name": "listener.192.168.0.1_9090.ssl.handshake", "value": 5}
+                ]}`,
+			})
+		})
+
+		It("should count inbound connections by services in PERMISSIVE mode", func() {
+			// given
+			createMesh(mesh_proto.CertificateAuthorityBackend_PERMISSIVE)
+
+			// when
+			connections, err := fetcher(context.Background(), key)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(connections.Services).To(HaveLen(2))
+			Expect(connections.Services["web"]).To(matchers.MatchProto(&mesh_proto.DataplaneInsight_MTLS_InboundConnections_Connections{
+				Mtls:      7,
+				Plaintext: 5,
+			}))
+			Expect(connections.Services["web-api"]).To(matchers.MatchProto(&mesh_proto.DataplaneInsight_MTLS_InboundConnections_Connections{
+				Mtls:      5,
+				Plaintext: 0,
+			}))
+		})
+
+		It("should not count inbound connections in STRICT mode", func() {
+			// given
+			createMesh(mesh_proto.CertificateAuthorityBackend_STRICT)
+
+			// when
+			connections, err := fetcher(context.Background(), key)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(connections).To(BeNil())
+		})
+	})
 })
 
+type statsEnvoyAdminClient struct {
+	admin.EnvoyAdminClient
+	stats string
+}
+
+func (s *statsEnvoyAdminClient) Stats(_ context.Context, _ core_model.ResourceWithAddress, opts admin.StatsOpts) ([]byte, error) {
+	Expect(opts.Format).To(Equal(admin.StatsFormatJSON))
+	return []byte(s.stats), nil
+}
+
 var _ callbacks.SubscriptionStatusAccessor = &SubscriptionStatusHolder{}
 
 type SubscriptionStatusHolder struct {
@@ -258,6 +403,7 @@ type DataplaneInsightOperation struct {
 	core_model.ResourceKey
 	*mesh_proto.DiscoverySubscription
 	*mesh_proto.DataplaneInsight_MTLS
+	*mesh_proto.DataplaneInsight_Memory
 }
 
 type DataplaneInsightStoreRecorder struct {
@@ -272,9 +418,10 @@ func (d *DataplaneInsightStoreRecorder) Create(ctx context.Context, resource cor
 	}
 	opts := core_store.NewCreateOptions(optionsFunc...)
 	d.Creates <- DataplaneInsightOperation{
-		ResourceKey:           core_model.ResourceKey{Mesh: opts.Mesh, Name: opts.Name},
-		DiscoverySubscription: resource.GetSpec().(generic.Insight).GetLastSubscription().(*mesh_proto.DiscoverySubscription),
-		DataplaneInsight_MTLS: resource.GetSpec().(*mesh_proto.DataplaneInsight).MTLS,
+		ResourceKey:             core_model.ResourceKey{Mesh: opts.Mesh, Name: opts.Name},
+		DiscoverySubscription:   resource.GetSpec().(generic.Insight).GetLastSubscription().(*mesh_proto.DiscoverySubscription),
+		DataplaneInsight_MTLS:   resource.GetSpec().(*mesh_proto.DataplaneInsight).MTLS,
+		DataplaneInsight_Memory: resource.GetSpec().(*mesh_proto.DataplaneInsight).Memory,
 	}
 	return nil
 }
@@ -284,9 +431,10 @@ func (d *DataplaneInsightStoreRecorder) Update(ctx context.Context, resource cor
 		return err
 	}
 	d.Updates <- DataplaneInsightOperation{
-		ResourceKey:           core_model.ResourceKey{Mesh: resource.GetMeta().GetMesh(), Name: resource.GetMeta().GetName()},
-		DiscoverySubscription: resource.GetSpec().(generic.Insight).GetLastSubscription().(*mesh_proto.DiscoverySubscription),
-		DataplaneInsight_MTLS: resource.GetSpec().(*mesh_proto.DataplaneInsight).MTLS,
+		ResourceKey:             core_model.ResourceKey{Mesh: resource.GetMeta().GetMesh(), Name: resource.GetMeta().GetName()},
+		DiscoverySubscription:   resource.GetSpec().(generic.Insight).GetLastSubscription().(*mesh_proto.DiscoverySubscription),
+		DataplaneInsight_MTLS:   resource.GetSpec().(*mesh_proto.DataplaneInsight).MTLS,
+		DataplaneInsight_Memory: resource.GetSpec().(*mesh_proto.DataplaneInsight).Memory,
 	}
 	return nil
 }
@@ -10,11 +10,9 @@ import (
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	"github.com/kumahq/kuma/pkg/dns/vips"
 	util_xds "github.com/kumahq/kuma/pkg/util/xds"
-	"github.com/kumahq/kuma/pkg/xds/cache/cla"
 	"github.com/kumahq/kuma/pkg/xds/cache/mesh"
 	xds_context "github.com/kumahq/kuma/pkg/xds/context"
 	xds_metrics "github.com/kumahq/kuma/pkg/xds/metrics"
-	"github.com/kumahq/kuma/pkg/xds/secrets"
 	v3 "github.com/kumahq/kuma/pkg/xds/server/v3"
 )
 
@@ -49,6 +47,7 @@ func MeshResourceTypes(exclude map[core_model.ResourceType]bool) []core_model.Re
 func RegisterXDS(rt core_runtime.Runtime) error {
 	// Build common dependencies for V2 and V3 servers.
 	// We want to have same metrics (we cannot register one metric twice) and same caches for both V2 and V3.
+	// ControlPlaneContext is built in the runtime, because it's also used to generate shadow config in the API Server.
 	statsCallbacks, err := util_xds.NewStatsCallbacks(rt.Metrics(), "xds")
 	if err != nil {
 		return err
@@ -73,31 +72,7 @@ func RegisterXDS(rt core_runtime.Runtime) error {
 	if err != nil {
 		return err
 	}
-	claCache, err := cla.NewCache(rt.Config().Store.Cache.ExpirationTime, rt.Metrics())
-	if err != nil {
-		return err
-	}
-
-	idProvider, err := secrets.NewIdentityProvider(rt.CaManagers(), rt.Metrics())
-	if err != nil {
-		return err
-	}
-
-	secrets, err := secrets.NewSecrets(
-		rt.CAProvider(),
-		idProvider,
-		rt.Metrics(),
-	)
-	if err != nil {
-		return err
-	}
-
-	envoyCpCtx, err := xds_context.BuildControlPlaneContext(claCache, secrets, rt.Config().Multizone.Zone.Name)
-	if err != nil {
-		return err
-	}
-
-	if err := v3.RegisterXDS(statsCallbacks, xdsMetrics, meshSnapshotCache, envoyCpCtx, rt); err != nil {
+	if err := v3.RegisterXDS(statsCallbacks, xdsMetrics, meshSnapshotCache, rt.XDSControlPlaneContext(), rt); err != nil {
 		return errors.Wrap(err, "could not register V3 XDS")
 	}
 	return nil
@@ -9,6 +9,8 @@ import (
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/pkg/core"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
 	core_runtime "github.com/kumahq/kuma/pkg/core/runtime"
 	util_xds "github.com/kumahq/kuma/pkg/util/xds"
@@ -79,23 +81,26 @@ func DefaultReconciler(
 	xdsContext XdsContext,
 	statsCallbacks util_xds.StatsCallbacks,
 ) xds_sync.SnapshotReconciler {
-	resolver := xds_template.SequentialResolver(
-		&xds_template.SimpleProxyTemplateResolver{
-			ReadOnlyResourceManager: rt.ReadOnlyResourceManager(),
-		},
-		generator.DefaultTemplateResolver,
-	)
-
 	return &reconciler{
 		generator: &templateSnapshotGenerator{
 			ResourceSetHooks:      rt.XDSHooks().ResourceSetHooks(),
-			ProxyTemplateResolver: resolver,
+			ProxyTemplateResolver: dataplaneProxyTemplateResolver(rt.ReadOnlyResourceManager()),
 		},
 		cacher:         &simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()},
 		statsCallbacks: statsCallbacks,
+		deltaXds:       rt.Config().XdsServer.DeltaXds,
 	}
 }
 
+func dataplaneProxyTemplateResolver(rm core_manager.ReadOnlyResourceManager) xds_template.ProxyTemplateResolver {
+	return xds_template.SequentialResolver(
+		&xds_template.SimpleProxyTemplateResolver{
+			ReadOnlyResourceManager: rm,
+		},
+		generator.DefaultTemplateResolver,
+	)
+}
+
 func DefaultIngressReconciler(
 	rt core_runtime.Runtime,
 	xdsContext XdsContext,
@@ -118,6 +123,7 @@ func DefaultIngressReconciler(
 		},
 		cacher:         &simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()},
 		statsCallbacks: statsCallbacks,
+		deltaXds:       rt.Config().XdsServer.DeltaXds,
 	}
 }
 
@@ -143,12 +149,21 @@ func DefaultEgressReconciler(
 		},
 		cacher:         &simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()},
 		statsCallbacks: statsCallbacks,
+		deltaXds:       rt.Config().XdsServer.DeltaXds,
 	}
 }
 
 func DefaultDataplaneStatusTracker(rt core_runtime.Runtime, secrets secrets.Secrets) xds_callbacks.DataplaneStatusTracker {
 	return xds_callbacks.NewDataplaneStatusTracker(rt,
 		func(dataplaneType core_model.ResourceType, accessor xds_callbacks.SubscriptionStatusAccessor) xds_callbacks.DataplaneInsightSink {
+			var memoryStats xds_callbacks.MemoryStatsFetcher
+			if dataplaneType == core_mesh.DataplaneType && rt.Config().Metrics.Dataplane.MemoryStatsInterval > 0 {
+				memoryStats = xds_callbacks.NewMemoryStatsFetcher(rt.ReadOnlyResourceManager(), rt.EnvoyAdminClient())
+			}
+			var connectionStats xds_callbacks.InboundConnectionsFetcher
+			if dataplaneType == core_mesh.DataplaneType && rt.Config().Metrics.Dataplane.ConnectionStatsInterval > 0 {
+				connectionStats = xds_callbacks.NewInboundConnectionsFetcher(rt.ReadOnlyResourceManager(), rt.EnvoyAdminClient())
+			}
 			return xds_callbacks.NewDataplaneInsightSink(
 				dataplaneType,
 				accessor,
@@ -161,6 +176,14 @@ func DefaultDataplaneStatusTracker(rt core_runtime.Runtime, secrets secrets.Secr
 				},
 				rt.Config().XdsServer.DataplaneStatusFlushInterval/10,
 				xds_callbacks.NewDataplaneInsightStore(rt.ResourceManager()),
+				func() *time.Ticker {
+					return time.NewTicker(rt.Config().Metrics.Dataplane.MemoryStatsInterval)
+				},
+				memoryStats,
+				func() *time.Ticker {
+					return time.NewTicker(rt.Config().Metrics.Dataplane.ConnectionStatsInterval)
+				},
+				connectionStats,
 			)
 		})
 }
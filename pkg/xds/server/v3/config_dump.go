@@ -0,0 +1,216 @@
+package v3
+
+import (
+	"sort"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	model "github.com/kumahq/kuma/pkg/core/xds"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	xds_hooks "github.com/kumahq/kuma/pkg/xds/hooks"
+)
+
+const redacted = "[redacted]"
+
+// ShadowConfigDumper generates XDS config of a Dataplane the same way as DefaultReconciler does,
+// but instead of delivering it to the proxy it returns the config in the format of the Envoy config dump.
+// This way users can preview the config that the Control Plane would deliver to the proxy.
+type ShadowConfigDumper struct {
+	rm    core_manager.ReadOnlyResourceManager
+	hooks *xds_hooks.Hooks
+	cpCtx *xds_context.ControlPlaneContext
+}
+
+func NewShadowConfigDumper(rm core_manager.ReadOnlyResourceManager, hooks *xds_hooks.Hooks, cpCtx *xds_context.ControlPlaneContext) *ShadowConfigDumper {
+	return &ShadowConfigDumper{
+		rm:    rm,
+		hooks: hooks,
+		cpCtx: cpCtx,
+	}
+}
+
+// ConfigDump generates the config of the proxy. Endpoints are included only when includeEDS is true, same as in Envoy.
+// Private keys of TLS certificates are always redacted, same as in Envoy.
+func (d *ShadowConfigDumper) ConfigDump(meshContext xds_context.MeshContext, proxy *model.Proxy, includeEDS bool) (*envoy_admin_v3.ConfigDump, error) {
+	ctx := xds_context.Context{
+		ControlPlane: d.cpCtx,
+		Mesh:         meshContext,
+	}
+	generator := &templateSnapshotGenerator{
+		ResourceSetHooks:      d.hooks.ResourceSetHooks(),
+		ProxyTemplateResolver: dataplaneProxyTemplateResolver(d.rm),
+	}
+	snapshot, err := generator.GenerateSnapshot(ctx, proxy)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate a snapshot")
+	}
+	return SnapshotToConfigDump(snapshot, includeEDS)
+}
+
+// Explain generates resources of the proxy the same way as ConfigDump does and records the steps of the generation in the trace.
+// Resources are returned with the origin of the generator that produced them.
+func (d *ShadowConfigDumper) Explain(meshContext xds_context.MeshContext, proxy *model.Proxy, trace *xds_context.GenerationTrace) (*model.ResourceSet, error) {
+	ctx := xds_context.Context{
+		ControlPlane: d.cpCtx,
+		Mesh:         meshContext,
+		Trace:        trace,
+	}
+	generator := &templateSnapshotGenerator{
+		ResourceSetHooks:      d.hooks.ResourceSetHooks(),
+		ProxyTemplateResolver: dataplaneProxyTemplateResolver(d.rm),
+	}
+	return generator.GenerateResources(ctx, proxy)
+}
+
+// SnapshotToConfigDump converts the snapshot to the config dump with the same layout of the sections as Envoy uses.
+func SnapshotToConfigDump(snapshot envoy_cache.Snapshot, includeEDS bool) (*envoy_admin_v3.ConfigDump, error) {
+	clusters := &envoy_admin_v3.ClustersConfigDump{}
+	if err := forEachResource(snapshot, envoy_resource.ClusterType, func(name string, resource *any.Any) error {
+		clusters.DynamicActiveClusters = append(clusters.DynamicActiveClusters, &envoy_admin_v3.ClustersConfigDump_DynamicCluster{
+			Cluster: resource,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	listeners := &envoy_admin_v3.ListenersConfigDump{}
+	if err := forEachResource(snapshot, envoy_resource.ListenerType, func(name string, resource *any.Any) error {
+		listener := &envoy_listener.Listener{}
+		if err := util_proto.UnmarshalAnyTo(resource, listener); err != nil {
+			return err
+		}
+		for _, filterChain := range append([]*envoy_listener.FilterChain{listener.DefaultFilterChain}, listener.FilterChains...) {
+			if err := redactTransportSocket(filterChain.GetTransportSocket()); err != nil {
+				return err
+			}
+		}
+		redactedListener, err := util_proto.MarshalAnyDeterministic(listener)
+		if err != nil {
+			return err
+		}
+		listeners.DynamicListeners = append(listeners.DynamicListeners, &envoy_admin_v3.ListenersConfigDump_DynamicListener{
+			Name: name,
+			ActiveState: &envoy_admin_v3.ListenersConfigDump_DynamicListenerState{
+				Listener: redactedListener,
+			},
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	routes := &envoy_admin_v3.RoutesConfigDump{}
+	if err := forEachResource(snapshot, envoy_resource.RouteType, func(name string, resource *any.Any) error {
+		routes.DynamicRouteConfigs = append(routes.DynamicRouteConfigs, &envoy_admin_v3.RoutesConfigDump_DynamicRouteConfig{
+			RouteConfig: resource,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	secrets := &envoy_admin_v3.SecretsConfigDump{}
+	if err := forEachResource(snapshot, envoy_resource.SecretType, func(name string, resource *any.Any) error {
+		secret := &envoy_tls.Secret{}
+		if err := util_proto.UnmarshalAnyTo(resource, secret); err != nil {
+			return err
+		}
+		redactPrivateKey(secret.GetTlsCertificate())
+		redactedSecret, err := util_proto.MarshalAnyDeterministic(secret)
+		if err != nil {
+			return err
+		}
+		secrets.DynamicActiveSecrets = append(secrets.DynamicActiveSecrets, &envoy_admin_v3.SecretsConfigDump_DynamicSecret{
+			Name:   name,
+			Secret: redactedSecret,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sections := []envoy_types.Resource{clusters, listeners, routes, secrets}
+
+	if includeEDS {
+		endpoints := &envoy_admin_v3.EndpointsConfigDump{}
+		if err := forEachResource(snapshot, envoy_resource.EndpointType, func(name string, resource *any.Any) error {
+			endpoints.DynamicEndpointConfigs = append(endpoints.DynamicEndpointConfigs, &envoy_admin_v3.EndpointsConfigDump_DynamicEndpointConfig{
+				EndpointConfig: resource,
+			})
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		sections = append(sections, endpoints)
+	}
+
+	configDump := &envoy_admin_v3.ConfigDump{}
+	for _, section := range sections {
+		config, err := util_proto.MarshalAnyDeterministic(section)
+		if err != nil {
+			return nil, err
+		}
+		configDump.Configs = append(configDump.Configs, config)
+	}
+	return configDump, nil
+}
+
+// redactTransportSocket redacts private keys that are inlined in the TLS context of the transport socket.
+func redactTransportSocket(transportSocket *envoy_core.TransportSocket) error {
+	typedConfig := transportSocket.GetTypedConfig()
+	if !typedConfig.MessageIs(&envoy_tls.DownstreamTlsContext{}) {
+		return nil
+	}
+	tlsContext := &envoy_tls.DownstreamTlsContext{}
+	if err := util_proto.UnmarshalAnyTo(typedConfig, tlsContext); err != nil {
+		return err
+	}
+	for _, certificate := range tlsContext.GetCommonTlsContext().GetTlsCertificates() {
+		redactPrivateKey(certificate)
+	}
+	redactedTypedConfig, err := util_proto.MarshalAnyDeterministic(tlsContext)
+	if err != nil {
+		return err
+	}
+	transportSocket.ConfigType = &envoy_core.TransportSocket_TypedConfig{TypedConfig: redactedTypedConfig}
+	return nil
+}
+
+func redactPrivateKey(certificate *envoy_tls.TlsCertificate) {
+	if certificate.GetPrivateKey() != nil {
+		certificate.PrivateKey = &envoy_core.DataSource{
+			Specifier: &envoy_core.DataSource_InlineString{InlineString: redacted},
+		}
+	}
+}
+
+// forEachResource calls fn for every resource of the type in the snapshot. Resources are sorted by name so the config dump is stable.
+func forEachResource(snapshot envoy_cache.Snapshot, typ envoy_resource.Type, fn func(name string, resource *any.Any) error) error {
+	resources := snapshot.GetResources(typ)
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resource, err := util_proto.MarshalAnyDeterministic(resources[name])
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal resource %q", name)
+		}
+		if err := fn(name, resource); err != nil {
+			return errors.Wrapf(err, "could not process resource %q", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package v3
+
+import (
+	"path/filepath"
+
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/pkg/test/matchers"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+)
+
+var _ = Describe("SnapshotToConfigDump", func() {
+
+	inlineBytes := func(value string) *envoy_core.DataSource {
+		return &envoy_core.DataSource{
+			Specifier: &envoy_core.DataSource_InlineBytes{InlineBytes: []byte(value)},
+		}
+	}
+
+	snapshot := func() envoy_cache.Snapshot {
+		snapshot, err := envoy_cache.NewSnapshot("", map[envoy_resource.Type][]envoy_types.Resource{
+			envoy_resource.ClusterType: {
+				&envoy_cluster.Cluster{Name: "outbound:backend"},
+				&envoy_cluster.Cluster{Name: "inbound:passthrough"},
+			},
+			envoy_resource.ListenerType: {
+				&envoy_listener.Listener{Name: "outbound:127.0.0.1:10001"},
+				&envoy_listener.Listener{
+					Name: "kuma:envoy:admin",
+					FilterChains: []*envoy_listener.FilterChain{{
+						TransportSocket: &envoy_core.TransportSocket{
+							Name: "envoy.transport_sockets.tls",
+							ConfigType: &envoy_core.TransportSocket_TypedConfig{
+								TypedConfig: util_proto.MustMarshalAny(&envoy_tls.DownstreamTlsContext{
+									CommonTlsContext: &envoy_tls.CommonTlsContext{
+										TlsCertificates: []*envoy_tls.TlsCertificate{{
+											CertificateChain: inlineBytes("CERT"),
+											PrivateKey:       inlineBytes("KEY"),
+										}},
+									},
+								}),
+							},
+						},
+					}},
+				},
+			},
+			envoy_resource.RouteType: {
+				&envoy_route.RouteConfiguration{Name: "outbound:backend"},
+			},
+			envoy_resource.SecretType: {
+				&envoy_tls.Secret{
+					Name: "identity_cert:secret:default",
+					Type: &envoy_tls.Secret_TlsCertificate{
+						TlsCertificate: &envoy_tls.TlsCertificate{
+							CertificateChain: inlineBytes("CERT"),
+							PrivateKey:       inlineBytes("KEY"),
+						},
+					},
+				},
+				&envoy_tls.Secret{
+					Name: "mesh_ca:secret:default",
+					Type: &envoy_tls.Secret_ValidationContext{
+						ValidationContext: &envoy_tls.CertificateValidationContext{
+							TrustedCa: inlineBytes("CA"),
+						},
+					},
+				},
+			},
+			envoy_resource.EndpointType: {
+				&envoy_endpoint.ClusterLoadAssignment{ClusterName: "outbound:backend"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return snapshot
+	}
+
+	DescribeTable("should convert snapshot to config dump",
+		func(includeEDS bool, goldenFile string) {
+			// when
+			configDump, err := SnapshotToConfigDump(snapshot(), includeEDS)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			actual, err := util_proto.ToYAML(configDump)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(matchers.MatchGoldenYAML(filepath.Join("testdata", goldenFile)))
+		},
+		Entry("without endpoints", false, "config-dump.golden.yaml"),
+		Entry("with endpoints", true, "config-dump-include-eds.golden.yaml"),
+	)
+})
@@ -2,6 +2,9 @@ package v3
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
@@ -31,6 +34,8 @@ type reconciler struct {
 	generator      snapshotGenerator
 	cacher         snapshotCacher
 	statsCallbacks util_xds.StatsCallbacks
+	// deltaXds computes versions of individual resources used by incremental xDS
+	deltaXds bool
 }
 
 func (r *reconciler) Clear(proxyId *model.ProxyId) error {
@@ -68,6 +73,12 @@ func (r *reconciler) Reconcile(ctx xds_context.Context, proxy *model.Proxy) erro
 	}
 
 	snapshot, changed := autoVersion(previous, snapshot)
+	if r.deltaXds {
+		snapshot.VersionMap, err = resourceVersions(previous, snapshot)
+		if err != nil {
+			return errors.Wrap(err, "failed to compute versions of resources")
+		}
+	}
 
 	resKey := proxy.Id.ToResourceKey()
 	log := reconcileLog.WithValues("proxyName", resKey.Name, "mesh", resKey.Mesh)
@@ -163,6 +174,23 @@ type templateSnapshotGenerator struct {
 }
 
 func (s *templateSnapshotGenerator) GenerateSnapshot(ctx xds_context.Context, proxy *model.Proxy) (envoy_cache.Snapshot, error) {
+	rs, err := s.GenerateResources(ctx, proxy)
+	if err != nil {
+		return envoy_cache.Snapshot{}, err
+	}
+
+	version := "" // empty value is a sign to other components to generate the version automatically
+	resources := map[envoy_resource.Type][]envoy_types.Resource{}
+
+	for _, resourceType := range rs.ResourceTypes() {
+		resources[resourceType] = append(resources[resourceType], rs.ListOf(resourceType).Payloads()...)
+	}
+
+	return envoy_cache.NewSnapshot(version, resources)
+}
+
+// GenerateResources generates resources of the proxy from the template, then applies hooks and modifications of the template.
+func (s *templateSnapshotGenerator) GenerateResources(ctx xds_context.Context, proxy *model.Proxy) (*model.ResourceSet, error) {
 	template := s.ProxyTemplateResolver.GetTemplate(proxy)
 
 	gen := generator.ProxyTemplateGenerator{ProxyTemplate: template}
@@ -170,25 +198,21 @@ func (s *templateSnapshotGenerator) GenerateSnapshot(ctx xds_context.Context, pr
 	rs, err := gen.Generate(ctx, proxy)
 	if err != nil {
 		reconcileLog.Error(err, "failed to generate a snapshot", "proxy", proxy, "template", template)
-		return envoy_cache.Snapshot{}, err
+		return nil, err
 	}
 	for _, hook := range s.ResourceSetHooks {
+		start := time.Now()
 		if err := hook.Modify(rs, ctx, proxy); err != nil {
-			return envoy_cache.Snapshot{}, errors.Wrapf(err, "could not apply hook %T", hook)
+			return nil, errors.Wrapf(err, "could not apply hook %T", hook)
 		}
+		ctx.Trace.Record(strings.TrimPrefix(fmt.Sprintf("%T", hook), "*"), start)
 	}
+	start := time.Now()
 	if err := modifications.Apply(rs, template.GetConf().GetModifications(), proxy.APIVersion); err != nil {
-		return envoy_cache.Snapshot{}, errors.Wrap(err, "could not apply modifications")
+		return nil, errors.Wrap(err, "could not apply modifications")
 	}
-
-	version := "" // empty value is a sign to other components to generate the version automatically
-	resources := map[envoy_resource.Type][]envoy_types.Resource{}
-
-	for _, resourceType := range rs.ResourceTypes() {
-		resources[resourceType] = append(resources[resourceType], rs.ListOf(resourceType).Payloads()...)
-	}
-
-	return envoy_cache.NewSnapshot(version, resources)
+	ctx.Trace.Record("modifications", start)
+	return rs, nil
 }
 
 type snapshotCacher interface {
@@ -1,6 +1,8 @@
 package v3
 
 import (
+	"time"
+
 	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
@@ -10,9 +12,11 @@ import (
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
 	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
@@ -136,6 +140,7 @@ var _ = Describe("Reconcile", func() {
 				}),
 				&simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()},
 				statsCallbacks,
+				false,
 			}
 
 			// given
@@ -250,6 +255,87 @@ var _ = Describe("Reconcile", func() {
 			Expect(snapshot.Resources[envoy_types.Endpoint].Version).To(BeEmpty())
 			Expect(snapshot.Resources[envoy_types.Secret].Version).To(BeEmpty())
 		})
+
+		It("should version individual resources for delta xDS", func() {
+			// given
+			updatedCluster := snapshot
+			updatedCluster.Resources[envoy_types.Cluster] = envoy_cache.Resources{
+				Items: map[string]envoy_types.ResourceWithTTL{
+					"cluster": {
+						Resource: &envoy_cluster.Cluster{
+							Name:                 "cluster",
+							ClusterDiscoveryType: &envoy_cluster.Cluster_Type{Type: envoy_cluster.Cluster_EDS},
+							EdsClusterConfig: &envoy_cluster.Cluster_EdsClusterConfig{
+								EdsConfig: &envoy_core.ConfigSource{
+									ResourceApiVersion: envoy_core.ApiVersion_V3,
+									ConfigSourceSpecifier: &envoy_core.ConfigSource_Ads{
+										Ads: &envoy_core.AggregatedConfigSource{},
+									},
+								},
+							},
+							ConnectTimeout: durationpb.New(5 * time.Second),
+						},
+					},
+				},
+			}
+			snapshots := make(chan envoy_cache.Snapshot, 3)
+			snapshots <- snapshot       // initial Dataplane configuration
+			snapshots <- snapshot       // same Dataplane configuration
+			snapshots <- updatedCluster // Cluster is changed
+
+			metrics, err := core_metrics.NewMetrics("standalone")
+			Expect(err).ToNot(HaveOccurred())
+			statsCallbacks, err := util_xds.NewStatsCallbacks(metrics, "xds")
+			Expect(err).ToNot(HaveOccurred())
+
+			r := &reconciler{
+				generator: snapshotGeneratorFunc(func(ctx xds_context.Context, proxy *xds_model.Proxy) (envoy_cache.Snapshot, error) {
+					return <-snapshots, nil
+				}),
+				cacher:         &simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()},
+				statsCallbacks: statsCallbacks,
+				deltaXds:       true,
+			}
+			proxy := &xds_model.Proxy{
+				Id: *xds_model.BuildProxyId("demo", "example"),
+				Dataplane: &core_mesh.DataplaneResource{
+					Meta: &test_model.ResourceMeta{Mesh: "demo", Name: "example"},
+					Spec: &mesh_proto.Dataplane{},
+				},
+			}
+
+			By("reconciling initial configuration")
+			Expect(r.Reconcile(xds_context.Context{}, proxy)).To(Succeed())
+			initial, err := xdsContext.Cache().GetSnapshot("demo.example")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(initial.VersionMap).To(HaveLen(len(initial.Resources)))
+			for _, typ := range []string{
+				envoy_resource.ListenerType, envoy_resource.RouteType, envoy_resource.ClusterType, envoy_resource.EndpointType, envoy_resource.SecretType,
+			} {
+				Expect(initial.VersionMap[typ]).To(HaveLen(1), typ)
+			}
+			clusterV1 := initial.VersionMap[envoy_resource.ClusterType]["cluster"]
+			endpointV1 := initial.VersionMap[envoy_resource.EndpointType]["cluster"]
+			Expect(endpointV1).To(HaveSuffix("-" + clusterV1))
+
+			By("reconciling the same configuration")
+			Expect(r.Reconcile(xds_context.Context{}, proxy)).To(Succeed())
+			same, err := xdsContext.Cache().GetSnapshot("demo.example")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(same.VersionMap).To(Equal(initial.VersionMap))
+
+			By("reconciling updated Cluster")
+			Expect(r.Reconcile(xds_context.Context{}, proxy)).To(Succeed())
+			updated, err := xdsContext.Cache().GetSnapshot("demo.example")
+			Expect(err).ToNot(HaveOccurred())
+			// then Cluster and its endpoints have new versions, so both are sent to Envoy
+			Expect(updated.VersionMap[envoy_resource.ClusterType]["cluster"]).ToNot(Equal(clusterV1))
+			Expect(updated.VersionMap[envoy_resource.EndpointType]["cluster"]).ToNot(Equal(endpointV1))
+			// and versions of the rest of the resources are the same
+			Expect(updated.VersionMap[envoy_resource.ListenerType]).To(Equal(initial.VersionMap[envoy_resource.ListenerType]))
+			Expect(updated.VersionMap[envoy_resource.RouteType]).To(Equal(initial.VersionMap[envoy_resource.RouteType]))
+			Expect(updated.VersionMap[envoy_resource.SecretType]).To(Equal(initial.VersionMap[envoy_resource.SecretType]))
+		})
 	})
 })
 
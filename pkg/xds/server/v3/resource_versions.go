@@ -0,0 +1,96 @@
+package v3
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/golang/protobuf/proto"
+)
+
+// resourceVersions computes versions of individual resources in the snapshot. Incremental xDS compares them with versions
+// that Envoy already has and sends only resources which versions differ.
+//
+// go-control-plane computes the versions by hashing every resource of the snapshot each time a delta watch is created.
+// Instead, versions are computed once when the snapshot is reconciled and versions of resources that are equal to the resources
+// of the previous snapshot are reused, so the cost of it is proportional to the number of changed resources.
+//
+// Version of ClusterLoadAssignment includes the version of its Cluster. Updated EDS Cluster is warming in Envoy until it receives
+// ClusterLoadAssignment (see resourceWarmingForcer), but incremental xDS does not send ClusterLoadAssignment that did not change.
+// Changing the version of ClusterLoadAssignment together with its Cluster makes the control plane send both of them.
+func resourceVersions(old envoy_cache.Snapshot, new envoy_cache.Snapshot) (map[string]map[string]string, error) {
+	versions := map[string]map[string]string{}
+	for typ := range new.Resources {
+		if envoy_types.ResponseType(typ) == envoy_types.Endpoint {
+			continue // endpoints are versioned after clusters
+		}
+		if err := versionResources(old, new, envoy_types.ResponseType(typ), versions, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+	oldClusterVersions, err := edsClusterVersions(old, old.VersionMap)
+	if err != nil {
+		return nil, err
+	}
+	clusterVersions, err := edsClusterVersions(new, versions)
+	if err != nil {
+		return nil, err
+	}
+	if err := versionResources(old, new, envoy_types.Endpoint, versions, oldClusterVersions, clusterVersions); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+func versionResources(
+	old envoy_cache.Snapshot,
+	new envoy_cache.Snapshot,
+	typ envoy_types.ResponseType,
+	versions map[string]map[string]string,
+	oldClusterVersions map[string]string,
+	clusterVersions map[string]string,
+) error {
+	typeURL, err := envoy_cache.GetResponseTypeURL(typ)
+	if err != nil {
+		return err
+	}
+	versions[typeURL] = map[string]string{}
+	for name, resource := range new.Resources[typ].Items {
+		oldResource, found := old.Resources[typ].Items[name]
+		oldVersion, versioned := old.VersionMap[typeURL][name]
+		if found && versioned && oldClusterVersions[name] == clusterVersions[name] && proto.Equal(oldResource.Resource, resource.Resource) {
+			versions[typeURL][name] = oldVersion
+			continue
+		}
+		marshaled, err := envoy_cache.MarshalResource(resource.Resource)
+		if err != nil {
+			return err
+		}
+		version := envoy_cache.HashResource(marshaled)
+		if clusterVersion, ok := clusterVersions[name]; ok {
+			version += "-" + clusterVersion
+		}
+		versions[typeURL][name] = version
+	}
+	return nil
+}
+
+// edsClusterVersions returns versions of EDS clusters by the name of ClusterLoadAssignment that they use
+func edsClusterVersions(snapshot envoy_cache.Snapshot, versions map[string]map[string]string) (map[string]string, error) {
+	typeURL, err := envoy_cache.GetResponseTypeURL(envoy_types.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	clusterVersions := map[string]string{}
+	for name, resource := range snapshot.Resources[envoy_types.Cluster].Items {
+		cluster, ok := resource.Resource.(*envoy_cluster.Cluster)
+		if !ok || cluster.GetEdsClusterConfig() == nil {
+			continue
+		}
+		serviceName := cluster.GetEdsClusterConfig().GetServiceName()
+		if serviceName == "" {
+			serviceName = cluster.GetName()
+		}
+		clusterVersions[serviceName] = versions[typeURL][name]
+	}
+	return clusterVersions, nil
+}
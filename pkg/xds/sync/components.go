@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"runtime"
 
 	kuma_cp "github.com/kumahq/kuma/pkg/config/app/kuma-cp"
 	"github.com/kumahq/kuma/pkg/core"
@@ -99,6 +100,11 @@ func DefaultDataplaneWatchdogFactory(
 		apiVersion,
 	)
 
+	concurrency := config.XdsServer.DataplaneConfigurationGenerationConcurrency
+	if concurrency == 0 {
+		concurrency = runtime.NumCPU()
+	}
+
 	deps := DataplaneWatchdogDependencies{
 		dataplaneProxyBuilder: dataplaneProxyBuilder,
 		dataplaneReconciler:   dataplaneReconciler,
@@ -109,6 +115,7 @@ func DefaultDataplaneWatchdogFactory(
 		envoyCpCtx:            envoyCpCtx,
 		meshCache:             meshSnapshotCache,
 		metadataTracker:       metadataTracker,
+		generationLimiter:     newGenerationLimiter(concurrency),
 	}
 	return NewDataplaneWatchdogFactory(
 		xdsMetrics,
@@ -84,15 +84,19 @@ func (p *DataplaneProxyBuilder) resolveRouting(meshContext xds_context.MeshConte
 	// create a map of selectors to match other dataplanes reachable via given routes
 	destinations := xds_topology.BuildDestinationMap(dataplane, routes)
 
-	// resolve all endpoints that match given selectors
-	outbound := xds_topology.BuildEndpointMap(
+	// resolve endpoints only of the services that the dataplane can reach via its outbounds and the routes of them
+	services := map[string]bool{}
+	for service := range destinations {
+		services[service] = true
+	}
+	outbound := xds_topology.BuildEndpointMapForServices(
 		meshContext.Resource,
 		p.Zone,
-		meshContext.Resources.Dataplanes().Items,
-		meshContext.Resources.ZoneIngresses().Items,
+		meshContext.EndpointMap,
 		meshContext.Resources.ZoneEgresses().Items,
 		matchedExternalServices,
 		meshContext.DataSourceLoader,
+		services,
 	)
 
 	routing := &core_xds.Routing{
@@ -149,10 +153,22 @@ func (p *DataplaneProxyBuilder) matchPolicies(meshContext xds_context.MeshContex
 	ratelimits := ratelimits.BuildRateLimitMap(dataplane, inbounds, resources.RateLimits().Items)
 	matchedPolicies := &core_xds.MatchedPolicies{
 		TrafficPermissions: permissions.BuildTrafficPermissionMap(dataplane, inbounds, resources.TrafficPermissions().Items),
+		TrafficDenies:      permissions.BuildTrafficDenyMap(dataplane, inbounds, resources.TrafficPermissions().Items),
 		TrafficLogs:        logs.BuildTrafficLogMap(dataplane, resources.TrafficLogs().Items),
 		HealthChecks:       xds_topology.BuildHealthCheckMap(dataplane, outboundSelectors, resources.HealthChecks().Items),
 		CircuitBreakers:    xds_topology.BuildCircuitBreakerMap(dataplane, outboundSelectors, resources.CircuitBreakers().Items),
+		ProtocolOptions:    xds_topology.BuildProtocolOptionsMap(dataplane, outboundSelectors, resources.MeshProtocolOptions().Items),
+		ZoneTrafficRoutes:  xds_topology.BuildZoneTrafficRouteMap(dataplane, outboundSelectors, resources.MeshZoneTrafficRoutes().Items),
 		TrafficTrace:       xds_topology.SelectTrafficTrace(dataplane, resources.TrafficTraces().Items),
+		LocalReply:         xds_topology.SelectDataplanePolicy(dataplane, resources.LocalReplies().Items),
+		HeaderModifier:     xds_topology.SelectDataplanePolicy(dataplane, resources.MeshHeaderModifiers().Items),
+		WasmPlugin:         xds_topology.SelectDataplanePolicy(dataplane, resources.MeshWasmPlugins().Items),
+		ExternalAuthz:      xds_topology.SelectDataplanePolicy(dataplane, resources.MeshExternalAuthzs().Items),
+		JWT:                xds_topology.SelectDataplanePolicy(dataplane, resources.MeshJWTs().Items),
+		BandwidthLimit:     xds_topology.SelectDataplanePolicy(dataplane, resources.MeshBandwidthLimits().Items),
+		Compression:        xds_topology.SelectDataplanePolicy(dataplane, resources.MeshCompressions().Items),
+		CORS:               xds_topology.SelectDataplanePolicy(dataplane, resources.MeshCORSes().Items),
+		RequestProtection:  xds_topology.SelectDataplanePolicy(dataplane, resources.MeshRequestProtections().Items),
 		FaultInjections:    faultinjections.BuildFaultInjectionMap(dataplane, inbounds, resources.FaultInjections().Items),
 		Retries:            xds_topology.BuildRetryMap(dataplane, resources.Retries().Items, outboundSelectors),
 		Timeouts:           xds_topology.BuildTimeoutMap(dataplane, resources.Timeouts().Items),
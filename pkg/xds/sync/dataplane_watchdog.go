@@ -24,6 +24,7 @@ type DataplaneWatchdogDependencies struct {
 	envoyCpCtx            *xds_context.ControlPlaneContext
 	meshCache             *mesh.Cache
 	metadataTracker       DataplaneMetadataTracker
+	generationLimiter     generationLimiter
 }
 
 type DataplaneWatchdog struct {
@@ -32,9 +33,10 @@ type DataplaneWatchdog struct {
 	log logr.Logger
 
 	// state of watchdog
-	lastHash         string // last Mesh hash that was used to **successfully** generate Reconcile Envoy config
-	dpType           mesh_proto.ProxyType
-	proxyTypeSettled bool
+	lastHash           string // last Mesh hash that was used to **successfully** generate Reconcile Envoy config
+	lastDependencyHash string // last hash of resources the Dataplane depends on that was used to **successfully** generate Reconcile Envoy config
+	dpType             mesh_proto.ProxyType
+	proxyTypeSettled   bool
 }
 
 func NewDataplaneWatchdog(deps DataplaneWatchdogDependencies, dpKey core_model.ResourceKey) *DataplaneWatchdog {
@@ -84,7 +86,8 @@ func (d *DataplaneWatchdog) Cleanup() error {
 }
 
 // syncDataplane syncs state of the Dataplane.
-// It uses Mesh Hash to decide if we need to regenerate configuration or not.
+// It uses Mesh Hash to decide if we need to rebuild the Proxy and the hash of resources the Dataplane depends on
+// to decide if we need to regenerate configuration or not.
 func (d *DataplaneWatchdog) syncDataplane() error {
 	meshCtx, err := d.meshCache.GetMeshContext(context.Background(), syncLog, d.key.Mesh)
 	if err != nil {
@@ -97,6 +100,24 @@ func (d *DataplaneWatchdog) syncDataplane() error {
 	if !syncForCert && !syncForConfig {
 		return nil
 	}
+
+	proxy, err := d.dataplaneProxyBuilder.Build(d.key, meshCtx)
+	if err != nil {
+		return err
+	}
+	dependencyHash, err := dependencyHash(meshCtx, proxy)
+	if err != nil {
+		return err
+	}
+	if syncForConfig && dependencyHash == d.lastDependencyHash {
+		// Mesh has changed, but none of the resources that the Dataplane depends on.
+		d.log.V(1).Info("snapshot hash updated, but dependencies of the dataplane did not change", "prev", d.lastHash, "current", meshCtx.Hash)
+		d.lastHash = meshCtx.Hash
+		syncForConfig = false
+	}
+	if !syncForCert && !syncForConfig {
+		return nil
+	}
 	if syncForConfig {
 		d.log.V(1).Info("snapshot hash updated, reconcile", "prev", d.lastHash, "current", meshCtx.Hash)
 	}
@@ -108,17 +129,16 @@ func (d *DataplaneWatchdog) syncDataplane() error {
 		ControlPlane: d.envoyCpCtx,
 		Mesh:         meshCtx,
 	}
-	proxy, err := d.dataplaneProxyBuilder.Build(d.key, meshCtx)
-	if err != nil {
-		return err
-	}
 	if !envoyCtx.Mesh.Resource.MTLSEnabled() {
 		d.envoyCpCtx.Secrets.Cleanup(d.key) // we need to cleanup secrets if mtls is disabled
 	}
-	if err := d.dataplaneReconciler.Reconcile(*envoyCtx, proxy); err != nil {
+	if err := d.generationLimiter.run(func() error {
+		return d.dataplaneReconciler.Reconcile(*envoyCtx, proxy)
+	}); err != nil {
 		return err
 	}
 	d.lastHash = meshCtx.Hash
+	d.lastDependencyHash = dependencyHash
 	return nil
 }
 
@@ -132,7 +152,9 @@ func (d *DataplaneWatchdog) syncIngress() error {
 	if err != nil {
 		return err
 	}
-	return d.ingressReconciler.Reconcile(*envoyCtx, proxy)
+	return d.generationLimiter.run(func() error {
+		return d.ingressReconciler.Reconcile(*envoyCtx, proxy)
+	})
 }
 
 // syncEgress syncs state of Egress Dataplane. Notice that it does not use
@@ -148,5 +170,7 @@ func (d *DataplaneWatchdog) syncEgress() error {
 		return err
 	}
 
-	return d.egressReconciler.Reconcile(*envoyCtx, proxy)
+	return d.generationLimiter.run(func() error {
+		return d.egressReconciler.Reconcile(*envoyCtx, proxy)
+	})
 }
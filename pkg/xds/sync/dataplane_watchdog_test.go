@@ -0,0 +1,198 @@
+package sync
+
+import (
+	"context"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	config_manager "github.com/kumahq/kuma/pkg/core/config/manager"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/kumahq/kuma/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	"github.com/kumahq/kuma/pkg/core/resources/store"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/dns/vips"
+	core_metrics "github.com/kumahq/kuma/pkg/metrics"
+	"github.com/kumahq/kuma/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/pkg/xds/cache/mesh"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+	envoy_common "github.com/kumahq/kuma/pkg/xds/envoy"
+	"github.com/kumahq/kuma/pkg/xds/secrets"
+)
+
+type staticMetadataTracker struct{}
+
+func (staticMetadataTracker) Metadata(core_model.ResourceKey) *core_xds.DataplaneMetadata {
+	return &core_xds.DataplaneMetadata{}
+}
+
+type noCertSecrets struct {
+	secrets.Secrets
+}
+
+func (noCertSecrets) Info(core_model.ResourceKey) *secrets.Info {
+	return nil
+}
+
+func (noCertSecrets) Cleanup(core_model.ResourceKey) {}
+
+type countingReconciler struct {
+	reconciles int
+}
+
+func (c *countingReconciler) Reconcile(xds_context.Context, *core_xds.Proxy) error {
+	c.reconciles++
+	return nil
+}
+
+func (c *countingReconciler) Clear(*core_xds.ProxyId) error {
+	return nil
+}
+
+var _ = Describe("Dataplane Watchdog", func() {
+	var resManager core_manager.ResourceManager
+	var reconciler *countingReconciler
+	var watchdog *DataplaneWatchdog
+
+	dataplane := func(name, service, address string, outbounds ...string) *core_mesh.DataplaneResource {
+		dp := core_mesh.NewDataplaneResource()
+		dp.Spec = &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: address,
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+					Port: 8080,
+					Tags: map[string]string{mesh_proto.ServiceTag: service},
+				}},
+			},
+		}
+		for i, outbound := range outbounds {
+			dp.Spec.Networking.Outbound = append(dp.Spec.Networking.Outbound, &mesh_proto.Dataplane_Networking_Outbound{
+				Port: uint32(10001 + i),
+				Tags: map[string]string{mesh_proto.ServiceTag: outbound},
+			})
+		}
+		Expect(resManager.Create(context.Background(), dp, store.CreateByKey(name, "default"))).To(Succeed())
+		return dp
+	}
+
+	update := func(res core_model.Resource, fn func()) {
+		Expect(resManager.Get(context.Background(), res, store.GetBy(core_model.MetaToResourceKey(res.GetMeta())))).To(Succeed())
+		fn()
+		Expect(resManager.Update(context.Background(), res)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		memStore := memory.NewStore()
+		resManager = core_manager.NewResourceManager(memStore)
+		meshContextBuilder := xds_context.NewMeshContextBuilder(
+			resManager,
+			[]core_model.ResourceType{
+				core_mesh.MeshType,
+				core_mesh.DataplaneType,
+				core_mesh.ExternalServiceType,
+				core_mesh.TrafficLogType,
+				core_mesh.TrafficRouteType,
+			},
+			net.LookupIP,
+			"zone-1",
+			vips.NewPersistence(resManager, config_manager.NewConfigManager(memStore)),
+			"mesh",
+		)
+		metrics, err := core_metrics.NewMetrics("")
+		Expect(err).ToNot(HaveOccurred())
+		// expire MeshContext immediately, so every Sync sees the latest state of the store
+		meshCache, err := mesh.NewCache(time.Nanosecond, meshContextBuilder, metrics)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(resManager.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("default", core_model.NoMesh))).To(Succeed())
+		routeAll := core_mesh.NewTrafficRouteResource()
+		routeAll.Spec = &mesh_proto.TrafficRoute{
+			Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+			Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+			Conf: &mesh_proto.TrafficRoute_Conf{
+				Destination: mesh_proto.MatchAnyService(),
+			},
+		}
+		Expect(resManager.Create(context.Background(), routeAll, store.CreateByKey("route-all", "default"))).To(Succeed())
+
+		reconciler = &countingReconciler{}
+		watchdog = NewDataplaneWatchdog(DataplaneWatchdogDependencies{
+			dataplaneProxyBuilder: &DataplaneProxyBuilder{
+				MetadataTracker: staticMetadataTracker{},
+				Zone:            "zone-1",
+				APIVersion:      envoy_common.APIV3,
+			},
+			dataplaneReconciler: reconciler,
+			envoyCpCtx: &xds_context.ControlPlaneContext{
+				Secrets: noCertSecrets{},
+			},
+			meshCache:         meshCache,
+			metadataTracker:   staticMetadataTracker{},
+			generationLimiter: newGenerationLimiter(1),
+		}, core_model.ResourceKey{Mesh: "default", Name: "backend-1"})
+	})
+
+	It("should regenerate configuration only when resources the dataplane depends on change", func() {
+		// given
+		dataplane("backend-1", "backend", "192.168.0.1", "redis")
+		redis := dataplane("redis-1", "redis", "192.168.0.2")
+		web := dataplane("web-1", "web", "192.168.0.3")
+		webLogs := core_mesh.NewTrafficLogResource()
+		webLogs.Spec = &mesh_proto.TrafficLog{
+			Sources:      []*mesh_proto.Selector{{Match: map[string]string{mesh_proto.ServiceTag: "web"}}},
+			Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+		}
+		Expect(resManager.Create(context.Background(), webLogs, store.CreateByKey("web-logs", "default"))).To(Succeed())
+
+		// when
+		Expect(watchdog.Sync()).To(Succeed())
+
+		// then
+		Expect(reconciler.reconciles).To(Equal(1))
+
+		By("changing the policy that is not matched to the dataplane")
+		update(webLogs, func() {
+			webLogs.Spec.Conf = &mesh_proto.TrafficLog_Conf{Backend: "file"}
+		})
+		Expect(watchdog.Sync()).To(Succeed())
+		Expect(reconciler.reconciles).To(Equal(1))
+
+		By("changing the dataplane of a service that is not reachable")
+		update(web, func() {
+			web.Spec.Networking.Address = "192.168.0.4"
+		})
+		Expect(watchdog.Sync()).To(Succeed())
+		Expect(reconciler.reconciles).To(Equal(1))
+
+		By("changing the dataplane of a service that is reachable")
+		update(redis, func() {
+			redis.Spec.Networking.Address = "192.168.0.5"
+		})
+		Expect(watchdog.Sync()).To(Succeed())
+		Expect(reconciler.reconciles).To(Equal(2))
+
+		By("matching the policy to the dataplane")
+		update(webLogs, func() {
+			webLogs.Spec.Sources[0].Match = mesh_proto.MatchAnyService()
+		})
+		Expect(watchdog.Sync()).To(Succeed())
+		Expect(reconciler.reconciles).To(Equal(3))
+
+		By("changing the Mesh")
+		mesh := core_mesh.NewMeshResource()
+		Expect(resManager.Get(context.Background(), mesh, store.GetByKey("default", core_model.NoMesh))).To(Succeed())
+		update(mesh, func() {
+			mesh.Spec.Routing = &mesh_proto.Routing{LocalityAwareLoadBalancing: true}
+		})
+		Expect(watchdog.Sync()).To(Succeed())
+		Expect(reconciler.reconciles).To(Equal(4))
+
+		By("syncing without any change")
+		Expect(watchdog.Sync()).To(Succeed())
+		Expect(reconciler.reconciles).To(Equal(4))
+	})
+})
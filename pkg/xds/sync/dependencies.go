@@ -0,0 +1,135 @@
+package sync
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/pkg/core/resources/model"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	"github.com/kumahq/kuma/pkg/xds/cache/sha256"
+	xds_context "github.com/kumahq/kuma/pkg/xds/context"
+)
+
+// proxyScopedTypes are types of resources that affect the configuration of the sidecar only through the Proxy.
+// Policies of these types are matched to the Dataplane by tags and Dataplanes are resolved to endpoints
+// of the services that the Dataplane can reach. Therefore, a change of such resource that does not change
+// matched policies or endpoints of the Dataplane does not require regenerating its configuration.
+var proxyScopedTypes = map[core_model.ResourceType]bool{
+	core_mesh.DataplaneType:             true,
+	core_mesh.CircuitBreakerType:        true,
+	core_mesh.FaultInjectionType:        true,
+	core_mesh.HealthCheckType:           true,
+	core_mesh.LocalReplyType:            true,
+	core_mesh.MeshBandwidthLimitType:    true,
+	core_mesh.MeshCORSType:              true,
+	core_mesh.MeshCompressionType:       true,
+	core_mesh.MeshExternalAuthzType:     true,
+	core_mesh.MeshHeaderModifierType:    true,
+	core_mesh.MeshJWTType:               true,
+	core_mesh.MeshProtocolOptionsType:   true,
+	core_mesh.MeshRequestProtectionType: true,
+	core_mesh.MeshWasmPluginType:        true,
+	core_mesh.MeshZoneTrafficRouteType:  true,
+	core_mesh.ProxyTemplateType:         true,
+	core_mesh.RateLimitType:             true,
+	core_mesh.RetryType:                 true,
+	core_mesh.TimeoutType:               true,
+	core_mesh.TrafficLogType:            true,
+	core_mesh.TrafficPermissionType:     true,
+	core_mesh.TrafficRouteType:          true,
+	core_mesh.TrafficTraceType:          true,
+}
+
+// dependencyHash returns the hash of everything that the configuration of the sidecar is generated from.
+// It consists of the hashes of resources of the types that are not proxy scoped, the Dataplane itself,
+// the policies matched to the Dataplane and the endpoints of the services that the Dataplane can reach.
+// Builtin gateways and Dataplanes with direct access depend on all resources of the Mesh, so the Mesh hash is returned.
+func dependencyHash(meshCtx xds_context.MeshContext, proxy *core_xds.Proxy) (string, error) {
+	dataplane := proxy.Dataplane
+	if dataplane.Spec.IsBuiltinGateway() || len(dataplane.Spec.GetNetworking().GetTransparentProxying().GetDirectAccessServices()) > 0 {
+		return meshCtx.Hash, nil
+	}
+
+	var deps []string
+	for typ, hash := range meshCtx.ResourceHashes {
+		if !proxyScopedTypes[typ] {
+			deps = append(deps, fmt.Sprintf("%s=%s", typ, hash))
+		}
+	}
+	sort.Strings(deps)
+
+	outbounds, err := json.Marshal(dataplane.Spec.GetNetworking().GetOutbound())
+	if err != nil {
+		return "", err
+	}
+	deps = append(deps,
+		fmt.Sprintf("dataplane=%s:%s:%s", dataplane.GetMeta().GetName(), dataplane.GetMeta().GetVersion(), dataplane.Spec.GetNetworking().GetAddress()),
+		fmt.Sprintf("outbounds=%s", outbounds),
+	)
+	deps = append(deps, policyDependencies("policies", reflect.ValueOf(proxy.Policies))...)
+	deps = append(deps, policyDependencies("routes", reflect.ValueOf(proxy.Routing.TrafficRoutes))...)
+
+	services := make([]string, 0, len(proxy.Routing.OutboundTargets))
+	for service := range proxy.Routing.OutboundTargets {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		endpoints, err := json.Marshal([][]core_xds.Endpoint{
+			proxy.Routing.OutboundTargets[service],
+			meshCtx.EndpointMap[service],
+		})
+		if err != nil {
+			return "", err
+		}
+		deps = append(deps, fmt.Sprintf("endpoints[%s]=%s", service, endpoints))
+	}
+
+	return sha256.Hash(strings.Join(deps, "\n")), nil
+}
+
+var resourceInterface = reflect.TypeOf((*core_model.Resource)(nil)).Elem()
+
+// policyDependencies walks matched policies and returns the identity and the version of every policy
+// together with the path under which it is matched, e.g. policies.TrafficLogs[backend].
+func policyDependencies(path string, value reflect.Value) []string {
+	switch value.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Type().Implements(resourceInterface) {
+			res := value.Interface().(core_model.Resource)
+			return []string{fmt.Sprintf("%s=%s:%s:%s", path, res.Descriptor().Name, res.GetMeta().GetName(), res.GetMeta().GetVersion())}
+		}
+		return policyDependencies(path, value.Elem())
+	case reflect.Struct:
+		var deps []string
+		for i := 0; i < value.NumField(); i++ {
+			if field := value.Type().Field(i); field.IsExported() {
+				deps = append(deps, policyDependencies(path+"."+field.Name, value.Field(i))...)
+			}
+		}
+		return deps
+	case reflect.Map:
+		var deps []string
+		iter := value.MapRange()
+		for iter.Next() {
+			deps = append(deps, policyDependencies(fmt.Sprintf("%s[%v]", path, iter.Key().Interface()), iter.Value())...)
+		}
+		sort.Strings(deps)
+		return deps
+	case reflect.Slice, reflect.Array:
+		var deps []string
+		for i := 0; i < value.Len(); i++ {
+			deps = append(deps, policyDependencies(fmt.Sprintf("%s[%d]", path, i), value.Index(i))...)
+		}
+		return deps
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,20 @@
+package sync
+
+// generationLimiter limits the number of proxies whose configuration is generated in parallel.
+// Every proxy is synced by its own watchdog, so without the limit all proxies of the Mesh
+// would be regenerated at the same time after a change and compete for the CPU.
+type generationLimiter chan struct{}
+
+func newGenerationLimiter(concurrency int) generationLimiter {
+	return make(generationLimiter, concurrency)
+}
+
+// run executes fn when one of the workers is free.
+func (l generationLimiter) run(fn func() error) error {
+	if l == nil {
+		return fn()
+	}
+	l <- struct{}{}
+	defer func() { <-l }()
+	return fn()
+}
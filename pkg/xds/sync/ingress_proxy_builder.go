@@ -94,10 +94,16 @@ func (p *IngressProxyBuilder) buildZoneIngressProxy(ctx context.Context) (*xds.Z
 		return nil, err
 	}
 
+	meshes := &core_mesh.MeshResourceList{}
+	if err := p.ReadOnlyResManager.List(ctx, meshes); err != nil {
+		return nil, err
+	}
+
 	return &xds.ZoneIngressProxy{
 		TrafficRouteList: routes,
 		GatewayRoutes:    gatewayRoutes,
 		MeshGateways:     gateways,
+		MeshResourceList: meshes,
 	}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
@@ -67,6 +68,9 @@ func initializeStore(ctx context.Context, resourceManager core_manager.ResourceM
 		case core_mesh.ExternalServiceType:
 			externalService := res.(*core_mesh.ExternalServiceResource)
 			Expect(resourceManager.Create(ctx, externalService, store.CreateBy(core_model.MetaToResourceKey(externalService.GetMeta())))).To(Succeed())
+		case core_mesh.TrafficRouteType:
+			trafficRoute := res.(*core_mesh.TrafficRouteResource)
+			Expect(resourceManager.Create(ctx, trafficRoute, store.CreateBy(core_model.MetaToResourceKey(trafficRoute.GetMeta())))).To(Succeed())
 		case core_mesh.MeshGatewayType:
 			meshGateway := res.(*core_mesh.MeshGatewayResource)
 			Expect(resourceManager.Create(ctx, meshGateway, store.CreateBy(core_model.MetaToResourceKey(meshGateway.GetMeta())))).To(Succeed())
@@ -106,6 +110,32 @@ var _ = Describe("Proxy Builder", func() {
 	Expect(err).ToNot(HaveOccurred())
 	initializeStore(ctx, rt.ResourceManager(), "default_resources.yaml")
 
+	Describe("Build() dataplane", func() {
+		dataplaneProxyBuilder := sync.DefaultDataplaneProxyBuilder(
+			rt.Config(),
+			tracker,
+			envoy_common.APIV3,
+		)
+
+		It("should resolve endpoints of services reachable only through HTTP routes and mirrors", func() {
+			// given
+			rk := core_model.ResourceKey{Name: "service-1", Mesh: "default"}
+			meshCtx, err := meshCache.GetMeshContext(ctx, logr.Discard(), "default")
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			proxy, err := dataplaneProxyBuilder.Build(rk, meshCtx)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(proxy.Routing.OutboundTargets).To(HaveKey("backend"))
+			Expect(proxy.Routing.OutboundTargets).To(HaveKey("external-service-1"))
+			Expect(proxy.Routing.OutboundTargets["external-service-1"][0].IsExternalService()).To(BeTrue())
+			Expect(proxy.Routing.OutboundTargets).To(HaveKey("service-in-zone-2"))
+			Expect(proxy.Routing.OutboundTargets).ToNot(HaveKey("external-service-zone-1"))
+		})
+	})
+
 	Describe("Build() zone egress", func() {
 		egressProxyBuilder := sync.DefaultEgressProxyBuilder(
 			ctx,
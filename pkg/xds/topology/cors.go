@@ -0,0 +1,14 @@
+package topology
+
+import (
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+// SelectGatewayListenerCORS picks the MeshCORS matching the tags of a gateway listener,
+// which are the merged tags of the dataplane, the gateway and the listener.
+func SelectGatewayListenerCORS(listenerTags map[string]string, corsPolicies []*core_mesh.MeshCORSResource) *core_mesh.MeshCORSResource {
+	return selectDataplanePolicy(func(selector mesh_proto.TagSelector) bool {
+		return selector.Matches(listenerTags)
+	}, corsPolicies)
+}
@@ -0,0 +1,24 @@
+package topology
+
+import (
+	core_policy "github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+)
+
+// SelectDataplanePolicy picks the most specific policy that matches the dataplane, see core_policy.SelectDataplanePolicy.
+// It returns nil when none of the policies matches.
+func SelectDataplanePolicy[T core_policy.DataplanePolicy](dataplane *core_mesh.DataplaneResource, policies []T) T {
+	return selectDataplanePolicy(dataplane.Spec.Matches, policies)
+}
+
+func selectDataplanePolicy[T core_policy.DataplanePolicy](tagMatcher core_policy.TagMatcher, policies []T) T {
+	dataplanePolicies := make([]core_policy.DataplanePolicy, len(policies))
+	for i, policy := range policies {
+		dataplanePolicies[i] = policy
+	}
+	var selected T
+	if policy := core_policy.SelectDataplanePolicyWithMatcher(tagMatcher, dataplanePolicies); policy != nil {
+		selected = policy.(T)
+	}
+	return selected
+}
@@ -0,0 +1,131 @@
+package topology_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_policy "github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	"github.com/kumahq/kuma/pkg/xds/topology"
+)
+
+// selectFn creates policies of one type with the given matches and returns them with the policy selected for the dataplane.
+type selectFn func(dataplane *core_mesh.DataplaneResource, matches ...map[string]string) (core_policy.DataplanePolicy, []core_policy.DataplanePolicy)
+
+func selectPolicy[T core_policy.DataplanePolicy](newPolicy func(selectors []*mesh_proto.Selector) T) selectFn {
+	return func(dataplane *core_mesh.DataplaneResource, matches ...map[string]string) (core_policy.DataplanePolicy, []core_policy.DataplanePolicy) {
+		var policies []T
+		var created []core_policy.DataplanePolicy
+		for i, match := range matches {
+			policy := newPolicy([]*mesh_proto.Selector{{Match: match}})
+			policy.SetMeta(&test_model.ResourceMeta{
+				Name: string(rune('a' + i)),
+				Mesh: "default",
+			})
+			policies = append(policies, policy)
+			created = append(created, policy)
+		}
+		return topology.SelectDataplanePolicy(dataplane, policies), created
+	}
+}
+
+var _ = Describe("SelectDataplanePolicy", func() {
+
+	dataplane := &core_mesh.DataplaneResource{
+		Meta: &test_model.ResourceMeta{
+			Name: "dp1",
+			Mesh: "default",
+		},
+		Spec: &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+					{
+						Port: 8080,
+						Tags: map[string]string{
+							"kuma.io/service": "backend",
+							"version":         "v1",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	DescribeTable("should return the most specific policy",
+		func(selectFn selectFn) {
+			// when
+			picked, policies := selectFn(dataplane,
+				map[string]string{"kuma.io/service": "*"},
+				map[string]string{"kuma.io/service": "backend", "version": "v1"},
+				map[string]string{"kuma.io/service": "web"},
+			)
+
+			// then
+			Expect(picked).To(BeIdenticalTo(policies[1]))
+
+			// when
+			picked, _ = selectFn(dataplane, map[string]string{"kuma.io/service": "web"})
+
+			// then
+			Expect(picked).To(BeNil())
+		},
+		Entry("LocalReply", selectPolicy(func(selectors []*mesh_proto.Selector) *core_mesh.LocalReplyResource {
+			return &core_mesh.LocalReplyResource{Spec: &mesh_proto.LocalReply{Selectors: selectors}}
+		})),
+		Entry("MeshHeaderModifier", selectPolicy(func(selectors []*mesh_proto.Selector) *core_mesh.MeshHeaderModifierResource {
+			return &core_mesh.MeshHeaderModifierResource{Spec: &mesh_proto.MeshHeaderModifier{Selectors: selectors}}
+		})),
+		Entry("MeshWasmPlugin", selectPolicy(func(selectors []*mesh_proto.Selector) *core_mesh.MeshWasmPluginResource {
+			return &core_mesh.MeshWasmPluginResource{Spec: &mesh_proto.MeshWasmPlugin{Selectors: selectors}}
+		})),
+		Entry("MeshExternalAuthz", selectPolicy(func(selectors []*mesh_proto.Selector) *core_mesh.MeshExternalAuthzResource {
+			return &core_mesh.MeshExternalAuthzResource{Spec: &mesh_proto.MeshExternalAuthz{Selectors: selectors}}
+		})),
+		Entry("MeshJWT", selectPolicy(func(selectors []*mesh_proto.Selector) *core_mesh.MeshJWTResource {
+			return &core_mesh.MeshJWTResource{Spec: &mesh_proto.MeshJWT{Selectors: selectors}}
+		})),
+		Entry("MeshBandwidthLimit", selectPolicy(func(selectors []*mesh_proto.Selector) *core_mesh.MeshBandwidthLimitResource {
+			return &core_mesh.MeshBandwidthLimitResource{Spec: &mesh_proto.MeshBandwidthLimit{Selectors: selectors}}
+		})),
+		Entry("MeshCompression", selectPolicy(func(selectors []*mesh_proto.Selector) *core_mesh.MeshCompressionResource {
+			return &core_mesh.MeshCompressionResource{Spec: &mesh_proto.MeshCompression{Selectors: selectors}}
+		})),
+		Entry("MeshCORS", selectPolicy(func(selectors []*mesh_proto.Selector) *core_mesh.MeshCORSResource {
+			return &core_mesh.MeshCORSResource{Spec: &mesh_proto.MeshCORS{Selectors: selectors}}
+		})),
+		Entry("MeshRequestProtection", selectPolicy(func(selectors []*mesh_proto.Selector) *core_mesh.MeshRequestProtectionResource {
+			return &core_mesh.MeshRequestProtectionResource{Spec: &mesh_proto.MeshRequestProtection{Selectors: selectors}}
+		})),
+	)
+
+	It("should return MeshCORS matching tags of the gateway listener", func() {
+		// given
+		cors := func(name string, match map[string]string) *core_mesh.MeshCORSResource {
+			return &core_mesh.MeshCORSResource{
+				Meta: &test_model.ResourceMeta{
+					Name: name,
+					Mesh: "default",
+				},
+				Spec: &mesh_proto.MeshCORS{
+					Selectors: []*mesh_proto.Selector{{Match: match}},
+				},
+			}
+		}
+		all := cors("cors1", map[string]string{"kuma.io/service": "*"})
+		listener := cors("cors2", map[string]string{"kuma.io/service": "edge", "port": "http-8080"})
+		other := cors("cors3", map[string]string{"kuma.io/service": "edge", "port": "http-9090"})
+		listenerTags := map[string]string{
+			"kuma.io/service": "edge",
+			"port":            "http-8080",
+		}
+
+		// when
+		picked := topology.SelectGatewayListenerCORS(listenerTags, []*core_mesh.MeshCORSResource{all, listener, other})
+
+		// then
+		Expect(picked).To(Equal(listener))
+	})
+})
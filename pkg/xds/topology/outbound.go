@@ -43,6 +43,53 @@ func BuildEndpointMap(
 	return outbound
 }
 
+// BuildEndpointMapForServices creates a map of endpoints of the given services only.
+// Endpoints of dataplanes and zone ingresses are taken from the precomputed endpoints of the mesh,
+// so the cost of it is proportional to the number of services that the dataplane depends on
+// rather than the size of the mesh.
+func BuildEndpointMapForServices(
+	mesh *core_mesh.MeshResource,
+	zone string,
+	meshEndpoints core_xds.EndpointMap,
+	zoneEgresses []*core_mesh.ZoneEgressResource,
+	externalServices []*core_mesh.ExternalServiceResource,
+	loader datasource.Loader,
+	services map[string]bool,
+) core_xds.EndpointMap {
+	// endpoints of the mesh contain all ExternalServices through zone egress,
+	// they are replaced with ExternalServices that the dataplane is allowed to reach
+	zoneEgressCoordinates := map[string]bool{}
+	if mesh.ZoneEgressEnabled() {
+		for _, ze := range zoneEgresses {
+			zoneEgressCoordinates[buildCoordinates(ze.Spec.GetNetworking().GetAddress(), ze.Spec.GetNetworking().GetPort())] = true
+		}
+	}
+
+	outbound := core_xds.EndpointMap{}
+	for service := range services {
+		for _, endpoint := range meshEndpoints[service] {
+			if endpoint.IsExternalService() && zoneEgressCoordinates[buildCoordinates(endpoint.Target, endpoint.Port)] {
+				continue
+			}
+			outbound[service] = append(outbound[service], endpoint)
+		}
+	}
+
+	var reachableExternalServices []*core_mesh.ExternalServiceResource
+	for _, externalService := range externalServices {
+		if services[externalService.Spec.GetService()] {
+			reachableExternalServices = append(reachableExternalServices, externalService)
+		}
+	}
+	if mesh.ZoneEgressEnabled() {
+		fillExternalServicesOutboundsThroughEgress(outbound, reachableExternalServices, zoneEgresses, mesh)
+	} else {
+		fillExternalServicesOutbounds(outbound, reachableExternalServices, mesh, loader, zone)
+	}
+
+	return outbound
+}
+
 // BuildRemoteEndpointMap creates a map of endpoints that match given selectors
 // and are not local for the provided zone (external services and services
 // behind remote zone ingress only)
@@ -490,6 +537,10 @@ func NewExternalServiceEndpoint(
 		ClientKey:          clientKey,
 		AllowRenegotiation: tls.GetAllowRenegotiation().GetValue(),
 		ServerName:         tls.GetServerName().GetValue(),
+		SubjectAltNames:    tls.GetSubjectAltNames(),
+		SpkiPins:           tls.GetSpkiPins(),
+		ProxyProtocol:      spec.GetNetworking().GetProxyProtocol(),
+		DNS:                spec.GetNetworking().GetDns(),
 	}
 
 	if es.TLSEnabled {
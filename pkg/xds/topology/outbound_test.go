@@ -1204,4 +1204,130 @@ var _ = Describe("TrafficRoute", func() {
 			)
 		})
 	})
+	Describe("BuildEndpointMapForServices()", func() {
+		dataplanes := []*core_mesh.DataplaneResource{
+			{
+				Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags:        map[string]string{mesh_proto.ServiceTag: "redis"},
+								Port:        6379,
+								ServicePort: 16379,
+							},
+						},
+					},
+				},
+			},
+			{
+				Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+				Spec: &mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.2",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags:        map[string]string{mesh_proto.ServiceTag: "backend"},
+								Port:        8080,
+								ServicePort: 18080,
+							},
+						},
+					},
+				},
+			},
+		}
+		httpbin := &core_mesh.ExternalServiceResource{
+			Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+			Spec: &mesh_proto.ExternalService{
+				Networking: &mesh_proto.ExternalService_Networking{
+					Address: "httpbin.org:80",
+				},
+				Tags: map[string]string{mesh_proto.ServiceTag: "httpbin"},
+			},
+		}
+		example := &core_mesh.ExternalServiceResource{
+			Meta: &test_model.ResourceMeta{Mesh: defaultMeshName},
+			Spec: &mesh_proto.ExternalService{
+				Networking: &mesh_proto.ExternalService_Networking{
+					Address: "example.com:443",
+				},
+				Tags: map[string]string{mesh_proto.ServiceTag: "example"},
+			},
+		}
+		zoneEgresses := []*core_mesh.ZoneEgressResource{
+			{
+				Meta: &test_model.ResourceMeta{Name: "egress", Mesh: defaultMeshName},
+				Spec: &mesh_proto.ZoneEgress{
+					Networking: &mesh_proto.ZoneEgress_Networking{
+						Address: "1.1.1.1",
+						Port:    10002,
+					},
+				},
+			},
+		}
+
+		type testCase struct {
+			mesh     *core_mesh.MeshResource
+			expected core_xds.EndpointMap
+		}
+		DescribeTable("should include only endpoints of given services and ExternalServices matched by TrafficPermissions",
+			func(given testCase) {
+				// given
+				allExternalServices := []*core_mesh.ExternalServiceResource{httpbin, example}
+				meshEndpoints := BuildEdsEndpointMap(given.mesh, "zone-1", dataplanes, nil, zoneEgresses, allExternalServices)
+				services := map[string]bool{"redis": true, "httpbin": true, "example": true}
+
+				// when
+				endpoints := BuildEndpointMapForServices(given.mesh, "zone-1", meshEndpoints, zoneEgresses, []*core_mesh.ExternalServiceResource{httpbin}, dataSourceLoader, services)
+
+				// then
+				Expect(endpoints).To(Equal(given.expected))
+			},
+			Entry("zone egress enabled", testCase{
+				mesh: defaultMeshWithMTLSAndZoneEgress,
+				expected: core_xds.EndpointMap{
+					"redis": []core_xds.Endpoint{
+						{
+							Target: "192.168.0.1",
+							Port:   6379,
+							Tags:   map[string]string{mesh_proto.ServiceTag: "redis"},
+							Weight: 1,
+						},
+					},
+					"httpbin": []core_xds.Endpoint{
+						{
+							Target:          "1.1.1.1",
+							Port:            10002,
+							Tags:            map[string]string{mesh_proto.ServiceTag: "httpbin"},
+							Weight:          1,
+							ExternalService: &core_xds.ExternalService{},
+						},
+					},
+				},
+			}),
+			Entry("zone egress disabled", testCase{
+				mesh: defaultMeshWithMTLS,
+				expected: core_xds.EndpointMap{
+					"redis": []core_xds.Endpoint{
+						{
+							Target: "192.168.0.1",
+							Port:   6379,
+							Tags:   map[string]string{mesh_proto.ServiceTag: "redis"},
+							Weight: 1,
+						},
+					},
+					"httpbin": []core_xds.Endpoint{
+						{
+							Target:          "httpbin.org",
+							Port:            80,
+							Tags:            map[string]string{mesh_proto.ServiceTag: "httpbin"},
+							Weight:          1,
+							ExternalService: &core_xds.ExternalService{},
+						},
+					},
+				},
+			}),
+		)
+	})
 })
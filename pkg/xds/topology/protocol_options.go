@@ -0,0 +1,26 @@
+package topology
+
+import (
+	"github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+)
+
+// BuildProtocolOptionsMap creates a map with protocol options per reachable service.
+func BuildProtocolOptionsMap(dataplane *core_mesh.DataplaneResource, destinations core_xds.DestinationMap, protocolOptions []*core_mesh.MeshProtocolOptionsResource) core_xds.ProtocolOptionsMap {
+	if len(destinations) == 0 || len(protocolOptions) == 0 {
+		return nil
+	}
+	policies := make([]policy.ConnectionPolicy, len(protocolOptions))
+	for i, options := range protocolOptions {
+		policies[i] = options
+	}
+
+	policyMap := policy.SelectConnectionPolicies(dataplane, policy.ToServicesOf(destinations), policies)
+
+	protocolOptionsMap := core_xds.ProtocolOptionsMap{}
+	for service, policy := range policyMap {
+		protocolOptionsMap[service] = policy.(*core_mesh.MeshProtocolOptionsResource)
+	}
+	return protocolOptionsMap
+}
@@ -0,0 +1,76 @@
+package topology_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	util_proto "github.com/kumahq/kuma/pkg/util/proto"
+	. "github.com/kumahq/kuma/pkg/xds/topology"
+)
+
+var _ = Describe("BuildProtocolOptionsMap()", func() {
+	dataplane := &core_mesh.DataplaneResource{
+		Spec: &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+					{Tags: map[string]string{"kuma.io/service": "web"}},
+				},
+				Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+					{Service: "backend"},
+					{Service: "redis"},
+				},
+			},
+		},
+	}
+	destinations := core_xds.DestinationMap{
+		"backend": core_xds.TagSelectorSet{mesh_proto.MatchService("backend")},
+		"redis":   core_xds.TagSelectorSet{mesh_proto.MatchService("redis")},
+	}
+	protocolOptions := func(name string, destination string) *core_mesh.MeshProtocolOptionsResource {
+		return &core_mesh.MeshProtocolOptionsResource{
+			Meta: &test_model.ResourceMeta{
+				Name: name,
+			},
+			Spec: &mesh_proto.MeshProtocolOptions{
+				Sources: []*mesh_proto.Selector{
+					{Match: mesh_proto.TagSelector{"kuma.io/service": "*"}},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{Match: mesh_proto.TagSelector{"kuma.io/service": destination}},
+				},
+				Conf: &mesh_proto.MeshProtocolOptions_Conf{
+					IdleTimeout: util_proto.Duration(time.Minute),
+				},
+			},
+		}
+	}
+
+	It("should return nil when there are no policies", func() {
+		// when
+		protocolOptionsMap := BuildProtocolOptionsMap(dataplane, destinations, nil)
+
+		// then
+		Expect(protocolOptionsMap).To(BeNil())
+	})
+
+	It("should pick the most specific policy for each destination", func() {
+		// given
+		everything := protocolOptions("everything", "*")
+		backend := protocolOptions("backend", "backend")
+
+		// when
+		protocolOptionsMap := BuildProtocolOptionsMap(dataplane, destinations, []*core_mesh.MeshProtocolOptionsResource{everything, backend})
+
+		// then
+		Expect(protocolOptionsMap).To(Equal(core_xds.ProtocolOptionsMap{
+			"backend": backend,
+			"redis":   everything,
+		}))
+	})
+})
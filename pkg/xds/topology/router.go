@@ -119,7 +119,13 @@ func BuildDestinationMap(dataplane *core_mesh.DataplaneResource, routes core_xds
 		outbound := dataplane.Spec.Networking.ToOutboundInterface(oface)
 		route, ok := routes[outbound]
 		if ok {
-			for _, destination := range route.Spec.GetConf().GetSplitWithDestination() {
+			splits := append([]*mesh_proto.TrafficRoute_Split{}, route.Spec.GetConf().GetSplitWithDestination()...)
+			// services reached only through HTTP routes or mirrored by them have to be included, so their endpoints are resolved
+			for _, http := range route.Spec.GetConf().GetHttp() {
+				splits = append(splits, http.GetSplitWithDestination()...)
+				splits = append(splits, http.GetMirror().GetSplitWithDestination()...)
+			}
+			for _, destination := range splits {
 				service, ok := destination.Destination[mesh_proto.ServiceTag]
 				if !ok {
 					// ignore destinations without a `service` tag
@@ -916,6 +916,119 @@ var _ = Describe("TrafficRoute", func() {
 					},
 				},
 			}),
+			Entry("Dataplane with TrafficRoute with HTTP routes", testCase{
+				dataplane: &core_mesh.DataplaneResource{
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+								{Service: "backend", Port: 10001},
+							},
+						},
+					},
+				},
+				routes: core_xds.RouteMap{
+					mesh_proto.OutboundInterface{
+						DataplaneIP:   "127.0.0.1",
+						DataplanePort: 10001,
+					}: &core_mesh.TrafficRouteResource{
+						Spec: &mesh_proto.TrafficRoute{
+							Conf: &mesh_proto.TrafficRoute_Conf{
+								Destination: mesh_proto.TagSelector{"kuma.io/service": "backend"},
+								Http: []*mesh_proto.TrafficRoute_Http{
+									{
+										Match: &mesh_proto.TrafficRoute_Http_Match{
+											Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+												MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix{
+													Prefix: "/api",
+												},
+											},
+										},
+										Split: []*mesh_proto.TrafficRoute_Split{
+											{
+												Weight:      util_proto.UInt32(90),
+												Destination: mesh_proto.TagSelector{"kuma.io/service": "backend-api", "version": "v1"},
+											},
+											{
+												Weight:      util_proto.UInt32(10),
+												Destination: mesh_proto.TagSelector{"kuma.io/service": "backend-api", "version": "v2"},
+											},
+										},
+									},
+									{
+										Match: &mesh_proto.TrafficRoute_Http_Match{
+											Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+												MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix{
+													Prefix: "/external",
+												},
+											},
+										},
+										Destination: mesh_proto.TagSelector{"kuma.io/service": "httpbin"},
+									},
+								},
+							},
+						},
+					},
+				},
+				expected: core_xds.DestinationMap{
+					"backend": []mesh_proto.TagSelector{
+						{"kuma.io/service": "backend"},
+					},
+					"backend-api": []mesh_proto.TagSelector{
+						{"kuma.io/service": "backend-api", "version": "v1"},
+						{"kuma.io/service": "backend-api", "version": "v2"},
+					},
+					"httpbin": []mesh_proto.TagSelector{
+						{"kuma.io/service": "httpbin"},
+					},
+				},
+			}),
+			Entry("Dataplane with TrafficRoute with HTTP mirror", testCase{
+				dataplane: &core_mesh.DataplaneResource{
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+								{Service: "backend", Port: 10001},
+							},
+						},
+					},
+				},
+				routes: core_xds.RouteMap{
+					mesh_proto.OutboundInterface{
+						DataplaneIP:   "127.0.0.1",
+						DataplanePort: 10001,
+					}: &core_mesh.TrafficRouteResource{
+						Spec: &mesh_proto.TrafficRoute{
+							Conf: &mesh_proto.TrafficRoute_Conf{
+								Destination: mesh_proto.TagSelector{"kuma.io/service": "backend"},
+								Http: []*mesh_proto.TrafficRoute_Http{
+									{
+										Match: &mesh_proto.TrafficRoute_Http_Match{
+											Path: &mesh_proto.TrafficRoute_Http_Match_StringMatcher{
+												MatcherType: &mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix{
+													Prefix: "/api",
+												},
+											},
+										},
+										Destination: mesh_proto.TagSelector{"kuma.io/service": "backend"},
+										Mirror: &mesh_proto.TrafficRoute_Http_Mirror{
+											Destination: mesh_proto.TagSelector{"kuma.io/service": "backend-shadow"},
+											Percentage:  util_proto.Double(10),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				expected: core_xds.DestinationMap{
+					"backend": []mesh_proto.TagSelector{
+						{"kuma.io/service": "backend"},
+					},
+					"backend-shadow": []mesh_proto.TagSelector{
+						{"kuma.io/service": "backend-shadow"},
+					},
+				},
+			}),
 		)
 	})
 })
@@ -25,6 +25,7 @@ var _ = Describe("Timeout", func() {
 	var dataplane *core_mesh.DataplaneResource
 
 	BeforeEach(func() {
+		ctx = context.Background()
 		rm = core_manager.NewResourceManager(memory.NewStore())
 
 		err := rm.Create(ctx, core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
@@ -0,0 +1,26 @@
+package topology
+
+import (
+	"github.com/kumahq/kuma/pkg/core/policy"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+)
+
+// BuildZoneTrafficRouteMap creates a map with zone traffic routes per reachable service.
+func BuildZoneTrafficRouteMap(dataplane *core_mesh.DataplaneResource, destinations core_xds.DestinationMap, zoneTrafficRoutes []*core_mesh.MeshZoneTrafficRouteResource) core_xds.ZoneTrafficRouteMap {
+	if len(destinations) == 0 || len(zoneTrafficRoutes) == 0 {
+		return nil
+	}
+	policies := make([]policy.ConnectionPolicy, len(zoneTrafficRoutes))
+	for i, route := range zoneTrafficRoutes {
+		policies[i] = route
+	}
+
+	policyMap := policy.SelectConnectionPolicies(dataplane, policy.ToServicesOf(destinations), policies)
+
+	zoneTrafficRouteMap := core_xds.ZoneTrafficRouteMap{}
+	for service, policy := range policyMap {
+		zoneTrafficRouteMap[service] = policy.(*core_mesh.MeshZoneTrafficRouteResource)
+	}
+	return zoneTrafficRouteMap
+}
@@ -0,0 +1,75 @@
+package topology_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/pkg/core/resources/apis/mesh"
+	core_xds "github.com/kumahq/kuma/pkg/core/xds"
+	test_model "github.com/kumahq/kuma/pkg/test/resources/model"
+	. "github.com/kumahq/kuma/pkg/xds/topology"
+)
+
+var _ = Describe("BuildZoneTrafficRouteMap()", func() {
+	dataplane := &core_mesh.DataplaneResource{
+		Spec: &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+					{Tags: map[string]string{"kuma.io/service": "web"}},
+				},
+				Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+					{Service: "backend"},
+					{Service: "redis"},
+				},
+			},
+		},
+	}
+	destinations := core_xds.DestinationMap{
+		"backend": core_xds.TagSelectorSet{mesh_proto.MatchService("backend")},
+		"redis":   core_xds.TagSelectorSet{mesh_proto.MatchService("redis")},
+	}
+	zoneTrafficRoute := func(name string, destination string) *core_mesh.MeshZoneTrafficRouteResource {
+		return &core_mesh.MeshZoneTrafficRouteResource{
+			Meta: &test_model.ResourceMeta{
+				Name: name,
+			},
+			Spec: &mesh_proto.MeshZoneTrafficRoute{
+				Sources: []*mesh_proto.Selector{
+					{Match: mesh_proto.TagSelector{"kuma.io/service": "*"}},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{Match: mesh_proto.TagSelector{"kuma.io/service": destination}},
+				},
+				Conf: &mesh_proto.MeshZoneTrafficRoute_Conf{
+					Zones: []*mesh_proto.MeshZoneTrafficRoute_Conf_Zone{
+						{Name: "zone-2", Priority: 1},
+					},
+				},
+			},
+		}
+	}
+
+	It("should return nil when there are no policies", func() {
+		// when
+		zoneTrafficRouteMap := BuildZoneTrafficRouteMap(dataplane, destinations, nil)
+
+		// then
+		Expect(zoneTrafficRouteMap).To(BeNil())
+	})
+
+	It("should pick the most specific policy for each destination", func() {
+		// given
+		everything := zoneTrafficRoute("everything", "*")
+		backend := zoneTrafficRoute("backend", "backend")
+
+		// when
+		zoneTrafficRouteMap := BuildZoneTrafficRouteMap(dataplane, destinations, []*core_mesh.MeshZoneTrafficRouteResource{everything, backend})
+
+		// then
+		Expect(zoneTrafficRouteMap).To(Equal(core_xds.ZoneTrafficRouteMap{
+			"backend": backend,
+			"redis":   everything,
+		}))
+	})
+})